@@ -51,6 +51,28 @@ func (acc *Account) SubtractFromBalance(amount uint64) error {
 	return nil
 }
 
+// LockedBalance returns how much of the balance cannot be spent in the block at height
+func (acc *Account) LockedBalance(height uint64) uint64 {
+	var locked uint64
+	for _, lock := range acc.Locks {
+		if height < lock.UnlockHeight {
+			locked += lock.Amount
+		}
+	}
+	return locked
+}
+
+// CheckLocks returns an error if the balance no longer covers what is still locked in the block at height
+func (acc *Account) CheckLocks(height uint64) error {
+	locked := acc.LockedBalance(height)
+	if acc.Balance < locked {
+		return errors.Errorf(errors.Codes.InsufficientBalance,
+			"insufficient funds: %v of the balance of %s is locked at height %d but only %v would remain", locked,
+			acc.Address, height, acc.Balance)
+	}
+	return nil
+}
+
 // Return bytes of any code-type value that is set. EVM, WASM, or native name
 func (acc *Account) Code() []byte {
 	switch {
@@ -91,6 +113,10 @@ func (acc *Account) Copy() *Account {
 	accCopy := *acc
 	accCopy.Permissions.Roles = make([]string, len(acc.Permissions.Roles))
 	copy(accCopy.Permissions.Roles, acc.Permissions.Roles)
+	if acc.Locks != nil {
+		accCopy.Locks = make([]BalanceLock, len(acc.Locks))
+		copy(accCopy.Locks, acc.Locks)
+	}
 	return &accCopy
 }
 
@@ -101,7 +127,7 @@ func (acc *Account) Equal(accOther *Account) bool {
 		return false
 	}
 	accEnc := buf.Bytes()
-	buf.Reset()
+	buf = proto.NewBuffer(nil)
 	err = buf.Marshal(accOther)
 	if err != nil {
 		return false
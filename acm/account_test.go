@@ -38,6 +38,36 @@ func TestAddress(t *testing.T) {
 	assert.Equal(t, addr, addrFromWord256)
 }
 
+func TestAccountEqual(t *testing.T) {
+	acc := NewAccountFromSecret("Super Semi Secret")
+	acc.Sequence = 1
+	other := acc.Copy()
+	assert.True(t, acc.Equal(other))
+	// Same encoded length
+	other.Sequence = 2
+	assert.False(t, acc.Equal(other))
+}
+
+func TestAccountLocks(t *testing.T) {
+	acc := NewAccountFromSecret("Super Semi Secret")
+	acc.Balance = 100
+	acc.Locks = []BalanceLock{{Amount: 30, UnlockHeight: 10}, {Amount: 50, UnlockHeight: 20}}
+	assert.Equal(t, uint64(80), acc.LockedBalance(9))
+	assert.Equal(t, uint64(50), acc.LockedBalance(10))
+	assert.Equal(t, uint64(0), acc.LockedBalance(20))
+
+	require.NoError(t, acc.SubtractFromBalance(20))
+	require.NoError(t, acc.CheckLocks(1))
+	require.NoError(t, acc.SubtractFromBalance(1))
+	require.Error(t, acc.CheckLocks(1))
+	require.NoError(t, acc.CheckLocks(10))
+
+	// Copies do not share locks
+	other := acc.Copy()
+	other.Locks[0].Amount = 0
+	assert.Equal(t, uint64(30), acc.Locks[0].Amount)
+}
+
 func TestMarshalJSON(t *testing.T) {
 	acc := NewAccountFromSecret("Super Semi Secret")
 	acc.EVMCode = []byte{60, 23, 45}
@@ -54,10 +54,13 @@ type Account struct {
 	// The metadata is stored in the deployed account. When the deployed account creates new account
 	// (from Solidity/EVM), they point to the original deployed account where the metadata is stored.
 	// This original account is called the forebear.
-	Forebear             *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,10,opt,name=Forebear,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Forebear,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+	Forebear *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,10,opt,name=Forebear,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Forebear,omitempty"`
+	// Parts of the balance that cannot be spent before the block height at which each unlocks, such as the tranches of
+	// a vesting schedule given at genesis
+	Locks                []BalanceLock `protobuf:"bytes,12,rep,name=Locks,proto3" json:",omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Account) Reset()      { *m = Account{} }
@@ -130,10 +133,73 @@ func (m *Account) GetContractMeta() []*ContractMeta {
 	return nil
 }
 
+func (m *Account) GetLocks() []BalanceLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
 func (*Account) XXX_MessageName() string {
 	return "acm.Account"
 }
 
+type BalanceLock struct {
+	Amount uint64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// The first block height at which Amount may be spent
+	UnlockHeight         uint64   `protobuf:"varint,2,opt,name=UnlockHeight,proto3" json:"UnlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceLock) Reset()         { *m = BalanceLock{} }
+func (m *BalanceLock) String() string { return proto.CompactTextString(m) }
+func (*BalanceLock) ProtoMessage()    {}
+func (*BalanceLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ed775bc0a6adf6, []int{1}
+}
+func (m *BalanceLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BalanceLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceLock.Merge(m, src)
+}
+func (m *BalanceLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceLock proto.InternalMessageInfo
+
+func (m *BalanceLock) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *BalanceLock) GetUnlockHeight() uint64 {
+	if m != nil {
+		return m.UnlockHeight
+	}
+	return 0
+}
+
+func (*BalanceLock) XXX_MessageName() string {
+	return "acm.BalanceLock"
+}
+
 type ContractMeta struct {
 	CodeHash     github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
 	MetadataHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=MetadataHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"MetadataHash"`
@@ -148,7 +214,7 @@ func (m *ContractMeta) Reset()         { *m = ContractMeta{} }
 func (m *ContractMeta) String() string { return proto.CompactTextString(m) }
 func (*ContractMeta) ProtoMessage()    {}
 func (*ContractMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49ed775bc0a6adf6, []int{1}
+	return fileDescriptor_49ed775bc0a6adf6, []int{2}
 }
 func (m *ContractMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,6 +252,8 @@ func (*ContractMeta) XXX_MessageName() string {
 func init() {
 	proto.RegisterType((*Account)(nil), "acm.Account")
 	golang_proto.RegisterType((*Account)(nil), "acm.Account")
+	proto.RegisterType((*BalanceLock)(nil), "acm.BalanceLock")
+	golang_proto.RegisterType((*BalanceLock)(nil), "acm.BalanceLock")
 	proto.RegisterType((*ContractMeta)(nil), "acm.ContractMeta")
 	golang_proto.RegisterType((*ContractMeta)(nil), "acm.ContractMeta")
 }
@@ -194,39 +262,42 @@ func init() { proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
 func init() { golang_proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
 
 var fileDescriptor_49ed775bc0a6adf6 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xee, 0x35, 0x69, 0xe3, 0x5c, 0x22, 0x54, 0x4e, 0x0c, 0xa7, 0x0c, 0x8e, 0xe9, 0x14, 0xa1,
-	0xd6, 0x46, 0x40, 0x97, 0x30, 0xc5, 0x15, 0x55, 0x25, 0x68, 0x54, 0x5c, 0xa9, 0x08, 0xb6, 0xf3,
-	0xf9, 0x29, 0xb1, 0x14, 0xfb, 0xcc, 0xf9, 0x0c, 0x78, 0xe6, 0x4f, 0x30, 0xf2, 0x53, 0x18, 0x33,
-	0x32, 0x56, 0x0c, 0x11, 0x4a, 0xb7, 0xfe, 0x0a, 0xe4, 0x8b, 0x63, 0x9c, 0x0e, 0x95, 0xa0, 0x5b,
-	0x5e, 0xbe, 0xef, 0x7d, 0xdf, 0xf7, 0xde, 0x3d, 0xe3, 0x36, 0xe3, 0x91, 0x9d, 0x48, 0xa1, 0x04,
-	0x69, 0x30, 0x1e, 0xf5, 0x1e, 0x4d, 0xc4, 0x44, 0xe8, 0xda, 0x29, 0x7e, 0xad, 0xa0, 0xde, 0x5e,
-	0x02, 0x32, 0x0a, 0xd3, 0x34, 0x14, 0x71, 0xf9, 0x4f, 0x97, 0xcb, 0x3c, 0x51, 0x25, 0xbe, 0xff,
-	0x75, 0x07, 0xb7, 0x46, 0x9c, 0x8b, 0x2c, 0x56, 0x64, 0x8c, 0x5b, 0xa3, 0x20, 0x90, 0x90, 0xa6,
-	0x14, 0x59, 0x68, 0xd0, 0x75, 0x5f, 0xcc, 0x17, 0xfd, 0xad, 0x5f, 0x8b, 0xfe, 0xc1, 0x24, 0x54,
-	0xd3, 0xcc, 0xb7, 0xb9, 0x88, 0x9c, 0x69, 0x9e, 0x80, 0x9c, 0x41, 0x30, 0x01, 0xe9, 0xf8, 0x99,
-	0x94, 0xe2, 0xb3, 0x53, 0x0a, 0x96, 0xbd, 0xde, 0x5a, 0x84, 0x38, 0xb8, 0x7d, 0x9e, 0xf9, 0xb3,
-	0x90, 0xbf, 0x86, 0x9c, 0x6e, 0x5b, 0x68, 0xd0, 0x79, 0xf6, 0xd0, 0x2e, 0xc9, 0x15, 0xe0, 0xfd,
-	0xe5, 0x90, 0x1e, 0x36, 0x2e, 0xe0, 0x63, 0x06, 0x31, 0x07, 0xda, 0xb0, 0xd0, 0xa0, 0xe9, 0x55,
-	0x35, 0xa1, 0xb8, 0xe5, 0xb2, 0x19, 0x2b, 0xa0, 0xa6, 0x86, 0xd6, 0x25, 0x79, 0x82, 0x5b, 0xaf,
-	0x2e, 0xcf, 0x8e, 0x45, 0x00, 0x74, 0x47, 0xc7, 0xde, 0x2b, 0x63, 0x1b, 0x6e, 0xae, 0x80, 0x8b,
-	0x00, 0xbc, 0x35, 0x81, 0x9c, 0xe0, 0xce, 0x79, 0xb5, 0x90, 0x94, 0xee, 0xea, 0x50, 0xa6, 0x5d,
-	0x5b, 0x52, 0xb9, 0x8c, 0x1a, 0xcb, 0x6d, 0x16, 0x7a, 0x5e, 0xbd, 0x91, 0x0c, 0xb1, 0xf1, 0x6e,
-	0x74, 0xb1, 0x32, 0x6d, 0x69, 0x53, 0xf3, 0xb6, 0xe9, 0xcd, 0xa2, 0x8f, 0x0f, 0x44, 0x14, 0x2a,
-	0x88, 0x12, 0x95, 0x7b, 0x15, 0x9f, 0xd8, 0x18, 0x8f, 0x99, 0x0a, 0x3f, 0xc1, 0x98, 0x45, 0x40,
-	0x3b, 0x16, 0x1a, 0xb4, 0xdd, 0x07, 0xb7, 0xd8, 0x35, 0x06, 0xb9, 0xc4, 0x46, 0xd1, 0x77, 0xca,
-	0xd2, 0x29, 0x35, 0xb4, 0xd7, 0xb0, 0xf4, 0x3a, 0xbc, 0xfb, 0x5d, 0xfc, 0x30, 0x66, 0x32, 0xb7,
-	0x4f, 0xe1, 0x4b, 0x91, 0x29, 0xbd, 0x59, 0xf4, 0xd1, 0xa1, 0x57, 0x69, 0x91, 0x23, 0xdc, 0x3d,
-	0x16, 0xb1, 0x92, 0x8c, 0xab, 0x33, 0x50, 0x8c, 0xb6, 0xad, 0x86, 0x7e, 0xa1, 0xe2, 0xae, 0xea,
-	0x80, 0xb7, 0x41, 0x23, 0x6f, 0xb0, 0x71, 0x22, 0x24, 0xf8, 0xc0, 0x24, 0xc5, 0x3a, 0xce, 0xd3,
-	0x7f, 0x3e, 0x91, 0x4a, 0x61, 0xd8, 0xfc, 0xf6, 0xbd, 0xbf, 0xb5, 0x7f, 0x85, 0x36, 0xb3, 0x90,
-	0xb7, 0xb5, 0x99, 0x57, 0xb7, 0x78, 0xf4, 0x5f, 0x33, 0xd7, 0xc6, 0x7d, 0x8f, 0xbb, 0x85, 0x74,
-	0xc0, 0x14, 0xd3, 0xb2, 0xdb, 0xf7, 0x91, 0xdd, 0x90, 0x2a, 0xee, 0x76, 0x5d, 0xeb, 0xbb, 0x6d,
-	0x7b, 0x55, 0xed, 0xbe, 0x9c, 0x2f, 0x4d, 0xf4, 0x73, 0x69, 0xa2, 0xab, 0xa5, 0x89, 0x7e, 0x2f,
-	0x4d, 0xf4, 0xe3, 0xda, 0x44, 0xf3, 0x6b, 0x13, 0x7d, 0x78, 0x7c, 0xb7, 0x25, 0xe3, 0x91, 0xbf,
-	0xab, 0x3f, 0xd2, 0xe7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x37, 0xf6, 0x93, 0x98, 0xec, 0x03,
-	0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6f, 0xd3, 0x3e,
+	0x18, 0x9d, 0xd7, 0xb4, 0x4d, 0xdd, 0xe8, 0xa7, 0xfe, 0x2c, 0x84, 0xa2, 0x1e, 0xd2, 0xd2, 0x53,
+	0x85, 0xb6, 0x14, 0x01, 0xbb, 0x94, 0x53, 0x33, 0x31, 0x15, 0xb1, 0x55, 0xc3, 0x13, 0x43, 0x70,
+	0x73, 0x1c, 0xab, 0x8d, 0xd6, 0xc4, 0xc5, 0x71, 0x81, 0xfc, 0x27, 0x1c, 0xf9, 0x4f, 0xe0, 0xd8,
+	0x23, 0xc7, 0x89, 0x43, 0x85, 0xba, 0xdb, 0xfe, 0x0a, 0x64, 0x37, 0x0d, 0x69, 0x0f, 0x93, 0x80,
+	0x5b, 0x3f, 0xbf, 0xe7, 0xf7, 0xbe, 0xbe, 0xef, 0x73, 0x60, 0x8d, 0xd0, 0xc8, 0x9d, 0x09, 0x2e,
+	0x39, 0x2a, 0x11, 0x1a, 0x35, 0xef, 0x8d, 0xf9, 0x98, 0xeb, 0xba, 0xa7, 0x7e, 0xad, 0xa1, 0x66,
+	0x63, 0xc6, 0x44, 0x14, 0x26, 0x49, 0xc8, 0xe3, 0xec, 0xc4, 0xa2, 0x22, 0x9d, 0xc9, 0x0c, 0xef,
+	0x7c, 0x2d, 0xc3, 0xea, 0x80, 0x52, 0x3e, 0x8f, 0x25, 0x1a, 0xc1, 0xea, 0x20, 0x08, 0x04, 0x4b,
+	0x12, 0x1b, 0xb4, 0x41, 0xd7, 0xf2, 0x9e, 0x2e, 0x96, 0xad, 0xbd, 0x1f, 0xcb, 0xd6, 0xc1, 0x38,
+	0x94, 0x93, 0xb9, 0xef, 0x52, 0x1e, 0xf5, 0x26, 0xe9, 0x8c, 0x89, 0x29, 0x0b, 0xc6, 0x4c, 0xf4,
+	0xfc, 0xb9, 0x10, 0xfc, 0x63, 0x2f, 0x13, 0xcc, 0xee, 0xe2, 0x8d, 0x08, 0xea, 0xc1, 0xda, 0xf9,
+	0xdc, 0x9f, 0x86, 0xf4, 0x25, 0x4b, 0xed, 0xfd, 0x36, 0xe8, 0xd6, 0x1f, 0xff, 0xef, 0x66, 0xe4,
+	0x1c, 0xc0, 0xbf, 0x39, 0xa8, 0x09, 0xcd, 0x0b, 0xf6, 0x7e, 0xce, 0x62, 0xca, 0xec, 0x52, 0x1b,
+	0x74, 0x0d, 0x9c, 0xd7, 0xc8, 0x86, 0x55, 0x8f, 0x4c, 0x89, 0x82, 0x0c, 0x0d, 0x6d, 0x4a, 0xf4,
+	0x10, 0x56, 0x9f, 0x5f, 0x9e, 0x1d, 0xf3, 0x80, 0xd9, 0x65, 0xdd, 0x76, 0x23, 0x6b, 0xdb, 0xf4,
+	0x52, 0xc9, 0x28, 0x0f, 0x18, 0xde, 0x10, 0xd0, 0x09, 0xac, 0x9f, 0xe7, 0x81, 0x24, 0x76, 0x45,
+	0x37, 0xe5, 0xb8, 0x85, 0x90, 0xb2, 0x30, 0x0a, 0x2c, 0xcf, 0x50, 0x7a, 0xb8, 0x78, 0x11, 0xf5,
+	0xa1, 0xf9, 0x66, 0x70, 0xb1, 0x36, 0xad, 0x6a, 0x53, 0x67, 0xd7, 0xf4, 0x76, 0xd9, 0x82, 0x07,
+	0x3c, 0x0a, 0x25, 0x8b, 0x66, 0x32, 0xc5, 0x39, 0x1f, 0xb9, 0x10, 0x8e, 0x88, 0x0c, 0x3f, 0xb0,
+	0x11, 0x89, 0x98, 0x5d, 0x6f, 0x83, 0x6e, 0xcd, 0xfb, 0x6f, 0x87, 0x5d, 0x60, 0xa0, 0x4b, 0x68,
+	0xaa, 0x7b, 0x43, 0x92, 0x4c, 0x6c, 0x53, 0x7b, 0xf5, 0x33, 0xaf, 0xc3, 0xbb, 0xe7, 0xe2, 0x87,
+	0x31, 0x11, 0xa9, 0x3b, 0x64, 0x9f, 0x54, 0x4f, 0xc9, 0xed, 0xb2, 0x05, 0x0e, 0x71, 0xae, 0x85,
+	0x8e, 0xa0, 0x75, 0xcc, 0x63, 0x29, 0x08, 0x95, 0x67, 0x4c, 0x12, 0xbb, 0xd6, 0x2e, 0xe9, 0x09,
+	0xa9, 0xbd, 0x2a, 0x02, 0x78, 0x8b, 0x86, 0x4e, 0xa1, 0x79, 0xc2, 0x05, 0xf3, 0x19, 0x11, 0x36,
+	0xd4, 0xed, 0x3c, 0xfa, 0xe3, 0x15, 0xc9, 0x15, 0x50, 0x1f, 0x96, 0x4f, 0x39, 0xbd, 0x4a, 0x6c,
+	0x4b, 0xbb, 0x37, 0xb4, 0x7b, 0x36, 0x59, 0x05, 0x78, 0x48, 0xfd, 0xd7, 0x9d, 0x74, 0xd6, 0x57,
+	0xfa, 0xc6, 0xe7, 0x2f, 0xad, 0xbd, 0xce, 0x0b, 0x58, 0x2f, 0xf0, 0xd1, 0x7d, 0x58, 0x19, 0x44,
+	0x6a, 0x82, 0x7a, 0x87, 0x0d, 0x9c, 0x55, 0xa8, 0x03, 0xad, 0xd7, 0xf1, 0x94, 0xd3, 0xab, 0x21,
+	0x0b, 0xc7, 0x13, 0xa9, 0xf7, 0xd1, 0xc0, 0x5b, 0x67, 0x9d, 0x6b, 0xb0, 0x1d, 0x09, 0x7a, 0x55,
+	0x88, 0x7e, 0xfd, 0x24, 0x8e, 0xfe, 0x2a, 0xfa, 0x42, 0xea, 0x6f, 0xa1, 0xa5, 0xa4, 0x03, 0x22,
+	0x89, 0x96, 0xdd, 0xff, 0x17, 0xd9, 0x2d, 0x29, 0xf5, 0x7c, 0x36, 0xb5, 0x7e, 0x3e, 0x35, 0x9c,
+	0xd7, 0xde, 0xb3, 0xc5, 0xca, 0x01, 0xdf, 0x57, 0x0e, 0xb8, 0x5e, 0x39, 0xe0, 0xe7, 0xca, 0x01,
+	0xdf, 0x6e, 0x1c, 0xb0, 0xb8, 0x71, 0xc0, 0xbb, 0x07, 0x77, 0x5b, 0x12, 0x1a, 0xf9, 0x15, 0xfd,
+	0xad, 0x78, 0xf2, 0x6b, 0x00, 0xe5, 0x2a, 0x72, 0x48, 0x73, 0x04, 0x00, 0x00,
 }
 
 func (m *Account) Marshal() (dAtA []byte, err error) {
@@ -253,6 +324,20 @@ func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAcm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.NativeName) > 0 {
 		i -= len(m.NativeName)
 		copy(dAtA[i:], m.NativeName)
@@ -361,6 +446,43 @@ func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BalanceLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UnlockHeight != 0 {
+		i = encodeVarintAcm(dAtA, i, uint64(m.UnlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Amount != 0 {
+		i = encodeVarintAcm(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -466,6 +588,30 @@ func (m *Account) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAcm(uint64(l))
 	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovAcm(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BalanceLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovAcm(uint64(m.Amount))
+	}
+	if m.UnlockHeight != 0 {
+		n += 1 + sovAcm(uint64(m.UnlockHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -867,6 +1013,129 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			}
 			m.NativeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, BalanceLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockHeight", wireType)
+			}
+			m.UnlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAcm(dAtA[iNdEx:])
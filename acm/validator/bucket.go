@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/crypto"
-	"github.com/tendermint/tendermint/types"
 )
 
 // Safety margin determined by Tendermint (see comment on source constant)
@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/storage"
+)
+
+const (
+	// Archiving reads every block it moves so is only done once the hot window has moved this far
+	DefaultInterval = 100
+	// Progress is recorded after each batch of heights so an interrupted archive resumes close to where it stopped
+	batchHeights = 1000
+)
+
+// Archiver moves the blocks and execution events of all but a number of the most recent blocks from the node's
+// databases to their cold storage, from which they are read back transparently when queried
+type Archiver struct {
+	state *state.State
+	// Burrow's database holding state and execution events
+	stateDB *storage.TieredDB
+	// Tendermint's block store database
+	blockDB   *storage.TieredDB
+	hotBlocks uint64
+	interval  uint64
+	// The end height of the last archive started
+	startedHeight uint64
+	// Non-zero while an archive is running
+	archiving int32
+	// Closed when the latest archive has finished
+	archived chan struct{}
+	logger   *logging.Logger
+}
+
+// NewArchiver returns an Archiver keeping the blocks and events of the last hotBlocks blocks in stateDB and blockDB
+func NewArchiver(st *state.State, stateDB, blockDB *storage.TieredDB, hotBlocks uint64,
+	logger *logging.Logger) *Archiver {
+	archived := make(chan struct{})
+	close(archived)
+	return &Archiver{
+		state:     st,
+		stateDB:   stateDB,
+		blockDB:   blockDB,
+		hotBlocks: hotBlocks,
+		interval:  DefaultInterval,
+		archived:  archived,
+		logger:    logger.WithScope("archive.Archiver"),
+	}
+}
+
+// Archive moves the blocks and events that are no longer hot once the block at height has been committed. Moving them
+// can take a while on a slow cold store so runs in the background while blocks are committed. If an archive is still
+// running from before we leave the next one to catch up.
+func (a *Archiver) Archive(height uint64) {
+	if height <= a.hotBlocks {
+		return
+	}
+	endHeight := height - a.hotBlocks + 1
+	if endHeight < a.startedHeight+a.interval {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&a.archiving, 0, 1) {
+		return
+	}
+	a.startedHeight = endHeight
+	archived := make(chan struct{})
+	a.archived = archived
+	go func() {
+		defer close(archived)
+		defer atomic.StoreInt32(&a.archiving, 0)
+		start := time.Now()
+		blocks, err := archiveUpTo(a.blockDB, endHeight, func(startHeight, endHeight uint64) (int, error) {
+			return ArchiveBlocks(a.blockDB, int64(startHeight), int64(endHeight))
+		})
+		if err != nil {
+			a.logger.InfoMsg("Could not archive blocks", "end_height", endHeight, structure.ErrorKey, err)
+			return
+		}
+		events, err := archiveUpTo(a.stateDB, endHeight, a.state.ArchiveEvents)
+		if err != nil {
+			a.logger.InfoMsg("Could not archive events", "end_height", endHeight, structure.ErrorKey, err)
+			return
+		}
+		a.logger.InfoMsg("Archived blocks and events", "end_height", endHeight, "blocks", blocks,
+			"blocks_with_events", events, "duration", time.Since(start).String())
+	}()
+}
+
+// Archived returns a channel that is closed once no archive is running
+func (a *Archiver) Archived() <-chan struct{} {
+	return a.archived
+}
+
+// Archives the heights below endHeight from those db has recorded as archived, returning how many things were
+func archiveUpTo(db *storage.TieredDB, endHeight uint64,
+	archive func(startHeight, endHeight uint64) (int, error)) (int, error) {
+	startHeight, err := db.ArchivedHeight()
+	if err != nil {
+		return 0, err
+	}
+	var archived int
+	for startHeight < endHeight {
+		batchEndHeight := startHeight + batchHeights
+		if batchEndHeight > endHeight {
+			batchEndHeight = endHeight
+		}
+		n, err := archive(startHeight, batchEndHeight)
+		archived += n
+		if err != nil {
+			return archived, err
+		}
+		err = db.SetArchivedHeight(batchEndHeight)
+		if err != nil {
+			return archived, err
+		}
+		startHeight = batchEndHeight
+	}
+	return archived, nil
+}
@@ -0,0 +1,81 @@
+package archive
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestArchiver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestArchiver")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	conf := &storage.ColdStorageConfig{HotBlocks: 10, Location: dir}
+	stateDB := newTieredDB(t, conf, "burrow_state")
+	blockDB := newTieredDB(t, conf, "blockstore")
+
+	st, err := state.MakeGenesisState(stateDB,
+		&genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	const height = 30
+	for i := uint64(1); i <= height; i++ {
+		_, _, err := st.Update(func(ws state.Updatable) error {
+			return ws.AddBlock(&exec.BlockExecution{
+				Height:       i,
+				TxExecutions: []*exec.TxExecution{{TxHeader: &exec.TxHeader{Height: i, TxHash: txHash(i)}}},
+			})
+		})
+		require.NoError(t, err)
+	}
+	hash := st.Hash()
+
+	archiver := NewArchiver(st, stateDB, blockDB, conf.HotBlocks, logging.NewNoopLogger())
+	archiver.interval = 1
+	archiver.Archive(height)
+	<-archiver.Archived()
+	archivedHeight, err := stateDB.ArchivedHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(height-conf.HotBlocks+1), archivedHeight)
+	shards, err := ioutil.ReadDir(filepath.Join(dir, "burrow_state"))
+	require.NoError(t, err)
+	require.NotEmpty(t, shards)
+
+	// Events are read back from cold storage by a fresh state
+	st, err = state.LoadState(stateDB, state.VersionAtHeight(height))
+	require.NoError(t, err)
+	require.Equal(t, hash, st.Hash())
+	var txs uint64
+	err = st.IterateStreamEvents(nil, nil, storage.AscendingSort, func(ev *exec.StreamEvent) error {
+		if ev.BeginTx != nil {
+			txs++
+			require.Equal(t, txs, ev.BeginTx.TxHeader.Height)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(height), txs)
+}
+
+func txHash(height uint64) []byte {
+	hash := make([]byte, 32)
+	binary.BigEndian.PutUint64(hash, height)
+	return hash
+}
+
+func newTieredDB(t *testing.T, conf *storage.ColdStorageConfig, name string) *storage.TieredDB {
+	db, err := conf.Wrap(dbm.NewMemDB(), name)
+	require.NoError(t, err)
+	return db.(*storage.TieredDB)
+}
@@ -0,0 +1,39 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/store"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// ArchiveBlocks moves the blocks from startHeight (inclusive) to endHeight (exclusive) in the block store in db to its
+// cold storage and returns how many there were. Their hashes stay in db so blocks can still be found by hash quickly.
+func ArchiveBlocks(db dbm.DB, startHeight, endHeight int64) (int, error) {
+	blockStore := store.NewBlockStore(storage.NewCometDB(db))
+	if base := blockStore.Base(); startHeight < base {
+		startHeight = base
+	}
+	var archived int
+	for h := startHeight; h < endHeight; h++ {
+		meta := blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			continue
+		}
+		// Tendermint does not export its key formats
+		keys := [][]byte{
+			[]byte(fmt.Sprintf("H:%v", h)),
+			[]byte(fmt.Sprintf("C:%v", h)),
+		}
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", h, p)))
+		}
+		err := storage.Archive(db, keys...)
+		if err != nil {
+			return archived, fmt.Errorf("could not archive block %d: %w", h, err)
+		}
+		archived++
+	}
+	return archived, nil
+}
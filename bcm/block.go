@@ -1,8 +1,8 @@
 package bcm
 
 import (
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/txs"
-	"github.com/tendermint/tendermint/types"
 )
 
 type Block struct {
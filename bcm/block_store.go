@@ -4,10 +4,11 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
-	"github.com/tendermint/tendermint/state"
-	"github.com/tendermint/tendermint/store"
-	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -24,11 +25,11 @@ func NewBlockStore(blockStore state.BlockStore) *BlockStore {
 }
 
 func NewBlockExplorer(dbBackendType dbm.BackendType, dbDir string) (*BlockStore, error) {
-	db, err := dbm.NewDB("blockstore", dbBackendType, dbDir)
+	db, err := storage.NewDB("blockstore", dbBackendType, dbDir)
 	if err != nil {
 		return nil, fmt.Errorf("could not create BlockExplorer: %w", err)
 	}
-	return NewBlockStore(store.NewBlockStore(db)), nil
+	return NewBlockStore(store.NewBlockStore(storage.NewCometDB(db))), nil
 }
 
 func (bs *BlockStore) Block(height int64) (_ *Block, err error) {
@@ -55,6 +56,36 @@ func (bs *BlockStore) BlockMeta(height int64) (_ *types.BlockMeta, err error) {
 	return bs.LoadBlockMeta(height), nil
 }
 
+func (bs *BlockStore) SeenCommit(height int64) (_ *types.Commit, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("BlockStore.SeenCommit() could not get Commit at height %d: %v\n%s",
+				height, r, debug.Stack())
+		}
+	}()
+	commit := bs.LoadSeenCommit(height)
+	if commit == nil {
+		return nil, fmt.Errorf("no commit found at height %d", height)
+	}
+	return commit, nil
+}
+
+// BlockCommit returns the canonical commit for the block at height as included in the block that follows it, unlike
+// SeenCommit which is the commit this node happened to see and may contain a different set of signatures
+func (bs *BlockStore) BlockCommit(height int64) (_ *types.Commit, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("BlockStore.BlockCommit() could not get Commit at height %d: %v\n%s",
+				height, r, debug.Stack())
+		}
+	}()
+	commit := bs.LoadBlockCommit(height)
+	if commit == nil {
+		return nil, fmt.Errorf("no block commit found at height %d", height)
+	}
+	return commit, nil
+}
+
 // Iterate over blocks between start (inclusive) and end (exclusive)
 func (bs *BlockStore) Blocks(start, end int64, iter func(*Block) error) error {
 	if end > 0 && start >= end {
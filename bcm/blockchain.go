@@ -9,8 +9,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/encoding"
-	"github.com/tendermint/tendermint/types"
 
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
@@ -33,6 +33,8 @@ type BlockchainInfo interface {
 	BlockHash(height uint64) ([]byte, error)
 	// GetBlockHeader returns the header at the specified height
 	GetBlockHeader(blockNumber uint64) (*types.Header, error)
+	// GetSignedHeader returns the header at the specified height along with the commit that signed it
+	GetSignedHeader(blockNumber uint64) (*types.SignedHeader, error)
 	// GetNumTxs returns the number of transactions included in a particular block
 	GetNumTxs(blockNumber uint64) (int, error)
 }
@@ -150,6 +152,18 @@ func (bc *Blockchain) CommitWithAppHash(appHash []byte) error {
 	return bc.save()
 }
 
+// RestoreAtHeight sets the last block to one at height having restored the state after it, such as from a snapshot
+// or by rolling back, rather than by committing the blocks before it
+func (bc *Blockchain) RestoreAtHeight(height uint64, blockTime time.Time, appHash []byte) error {
+	bc.Lock()
+	defer bc.Unlock()
+	bc.persistedState.LastBlockHeight = height
+	bc.persistedState.LastBlockTime = blockTime
+	bc.persistedState.AppHashAfterLastBlock = appHash
+	bc.lastBlockHash = nil
+	return bc.save()
+}
+
 func (bc *Blockchain) save() error {
 	if bc.db != nil {
 		encodedState, err := bc.Encode()
@@ -244,11 +258,18 @@ func (bc *Blockchain) BlockHash(height uint64) ([]byte, error) {
 
 func (bc *Blockchain) getBlockMeta(height uint64) (*types.BlockMeta, error) {
 	const errHeader = "getBlockMeta():"
-	if bc == nil {
+	if bc == nil || bc.blockStore == nil {
 		return nil, fmt.Errorf("%s could not get block hash because Blockchain has not been given access to "+
 			"tendermint BlockStore", errHeader)
 	}
-	return bc.blockStore.BlockMeta(int64(height))
+	blockMeta, err := bc.blockStore.BlockMeta(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	if blockMeta == nil {
+		return nil, fmt.Errorf("%s no block at height %d", errHeader, height)
+	}
+	return blockMeta, nil
 }
 
 // GetBlockHeader returns the block header at any given height
@@ -261,6 +282,34 @@ func (bc *Blockchain) GetBlockHeader(height uint64) (*types.Header, error) {
 	return &blockMeta.Header, nil
 }
 
+// GetSignedHeader returns the block header at any given height together with the commit signatures seen for it
+func (bc *Blockchain) GetSignedHeader(height uint64) (*types.SignedHeader, error) {
+	const errHeader = "GetSignedHeader():"
+	header, err := bc.GetBlockHeader(height)
+	if err != nil {
+		return nil, fmt.Errorf("%s could not get header: %v", errHeader, err)
+	}
+	commit, err := bc.blockStore.SeenCommit(int64(height))
+	if err != nil {
+		return nil, fmt.Errorf("%s could not get commit: %v", errHeader, err)
+	}
+	return &types.SignedHeader{
+		Header: header,
+		Commit: commit,
+	}, nil
+}
+
+// GetBlockCommit returns the commit for the block at height that was included in the next block, so it is only available
+// once the next block has been stored and is the same on every node
+func (bc *Blockchain) GetBlockCommit(height uint64) (*types.Commit, error) {
+	const errHeader = "GetBlockCommit():"
+	if bc == nil || bc.blockStore == nil {
+		return nil, fmt.Errorf("%s could not get block commit because Blockchain has not been given access to "+
+			"tendermint BlockStore", errHeader)
+	}
+	return bc.blockStore.BlockCommit(int64(height))
+}
+
 // GetNumTxs returns the number of transactions included in a block
 func (bc *Blockchain) GetNumTxs(height uint64) (int, error) {
 	const errHeader = "GetNumTxs():"
@@ -4,12 +4,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	cli "github.com/jawher/mow.cli"
 	hex "github.com/tmthrgd/go-hex"
 )
 
-// Abi is a command line tool for ABI encoding and decoding. Event encoding/decoding still be added
+// Abi is a command line tool for ABI encoding and decoding
 func Abi(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		cmd.Command("list", "List the functions and events",
@@ -36,28 +37,41 @@ func Abi(output Output) func(cmd *cli.Cmd) {
 		cmd.Command("encode-function-call", "ABI encode function call",
 			func(cmd *cli.Cmd) {
 				abiPath := cmd.StringOpt("abi", ".", "ABI file or directory")
-				fname := cmd.StringArg("FUNCTION", "", "Function name")
+				fname := cmd.StringArg("FUNCTION", "",
+					"Function name, or function signature such as 'transfer(address,uint256)' to encode without an ABI")
 				args := cmd.StringsArg("ARGS", nil, "Function arguments")
 
-				cmd.Spec = "--abi=<path> FUNCTION [ARGS...]"
+				cmd.Spec = "[--abi=<path>] FUNCTION [ARGS...]"
 
 				cmd.Action = func() {
-					spec, err := abi.LoadPath(*abiPath)
-					if err != nil {
-						output.Fatalf("could not read %v: %v", *abiPath, err)
-					}
-
 					argsInInterface := make([]interface{}, len(*args))
 					for i, a := range *args {
 						argsInInterface[i] = a
 					}
 
-					data, _, err := spec.Pack(*fname, argsInInterface...)
-					if err != nil {
-						output.Fatalf("could not encode function call %v", err)
+					var data []byte
+					if strings.Contains(*fname, "(") {
+						fspec, err := abi.ParseFunctionSignature(*fname)
+						if err != nil {
+							output.Fatalf("%v", err)
+						}
+						packedArgs, err := abi.Pack(fspec.Inputs, argsInInterface...)
+						if err != nil {
+							output.Fatalf("could not encode function call %v", err)
+						}
+						data = append(fspec.FunctionID.Bytes(), packedArgs...)
+					} else {
+						spec, err := abi.LoadPath(*abiPath)
+						if err != nil {
+							output.Fatalf("could not read %v: %v", *abiPath, err)
+						}
+						data, _, err = spec.Pack(*fname, argsInInterface...)
+						if err != nil {
+							output.Fatalf("could not encode function call %v", err)
+						}
 					}
 
-					output.Printf("%X\n", data)
+					output.Printf("%X", data)
 				}
 			})
 
@@ -72,48 +86,39 @@ func Abi(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not read %v: %v", *abiPath, err)
 					}
 
-					bs, err := hex.DecodeString(*data)
+					bs, err := decodeHex(*data)
 					if err != nil {
-						output.Fatalf("could not hex decode %s: %v", data, err)
+						output.Fatalf("could not hex decode %s: %v", *data, err)
 					}
 
+					if len(bs) < abi.FunctionIDSize {
+						output.Fatalf("function call %s is shorter than a function ID", *data)
+					}
 					var funcid abi.FunctionID
 					copy(funcid[:], bs)
 					found := false
 					for name, fspec := range spec.Functions {
 						if fspec.FunctionID == funcid {
-							args := make([]string, len(fspec.Inputs))
-							intf := make([]interface{}, len(args))
-							for i := range args {
-								intf[i] = &args[i]
-							}
-							err = abi.Unpack(fspec.Inputs, bs[len(funcid):], intf...)
+							found = true
+							args, err := unpackNamed(fspec.Inputs, bs[len(funcid):])
 							if err != nil {
-								output.Fatalf("unable to decode function %s: %v\n", name, err)
-							}
-							// prepend function argument names
-							for i, a := range args {
-								if fspec.Inputs[i].Name != "" {
-									args[i] = fspec.Inputs[i].Name + "=" + a
-								}
+								output.Fatalf("unable to decode function %s: %v", name, err)
 							}
-
-							output.Printf(fmt.Sprintf("%s(%s)", name, strings.Join(args, ",")))
+							output.Printf("%s(%s)", name, strings.Join(args, ","))
 						}
-
 					}
 
 					if !found {
-						output.Fatalf("could not find function %X\n", funcid)
+						output.Fatalf("could not find function %X", funcid)
 					}
 				}
 			})
 
-		cmd.Command("decode-function-return", "ABI decode function return",
+		cmd.Command("decode-function-return", "ABI decode function return, or the reason given to revert",
 			func(cmd *cli.Cmd) {
 				abiPath := cmd.StringOpt("abi", ".", "ABI file or directory")
 				fname := cmd.StringArg("FUNCTION", "", "Function name")
-				data := cmd.StringArg("DATA", "", "Encoded function call")
+				data := cmd.StringArg("DATA", "", "Encoded function return")
 
 				cmd.Action = func() {
 					spec, err := abi.LoadPath(*abiPath)
@@ -121,34 +126,156 @@ func Abi(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not read %v: %v", *abiPath, err)
 					}
 
-					bs, err := hex.DecodeString(*data)
+					bs, err := decodeHex(*data)
 					if err != nil {
-						output.Fatalf("could not hex decode %s: %v", data, err)
+						output.Fatalf("could not hex decode %s: %v", *data, err)
+					}
+
+					// Return values are whole words whereas a revert starts with the selector of its error
+					if len(bs)%binary.Word256Bytes == abi.FunctionIDSize {
+						if revert := abi.DecodeRevert(bs, spec); revert != nil {
+							output.Printf("reverted with %v", revert)
+							return
+						}
 					}
 
 					fspec, ok := spec.Functions[*fname]
 					if !ok {
-						output.Fatalf("no such function %s\n", *fname)
+						output.Fatalf("no such function %s", *fname)
+					}
+
+					args, err := unpackNamed(fspec.Outputs, bs)
+					if err != nil {
+						output.Fatalf("unable to decode function %s: %v", *fname, err)
 					}
 
-					args := make([]string, len(fspec.Outputs))
-					intf := make([]interface{}, len(args))
-					for i := range args {
-						intf[i] = &args[i]
+					output.Printf(strings.Join(args, "\n"))
+				}
+			})
+
+		cmd.Command("decode-event", "ABI decode event log from its topics and data",
+			func(cmd *cli.Cmd) {
+				abiPath := cmd.StringOpt("abi", ".", "ABI file or directory")
+				eventName := cmd.StringOpt("event", "", "Event name, needed for anonymous events whose first "+
+					"topic is not the event ID")
+				data := cmd.StringOpt("data", "", "Log data holding the event's non-indexed fields")
+				topicArgs := cmd.StringsArg("TOPICS", nil, "Log topics, the first of which is the event ID "+
+					"unless the event is anonymous")
+
+				cmd.Spec = "[--abi=<path>] [--event=<name>] [--data=<hex>] [TOPICS...]"
+
+				cmd.Action = func() {
+					spec, err := abi.LoadPath(*abiPath)
+					if err != nil {
+						output.Fatalf("could not read %v: %v", *abiPath, err)
 					}
-					err = abi.Unpack(fspec.Outputs, bs, intf...)
+
+					bs, err := decodeHex(*data)
 					if err != nil {
-						output.Fatalf("unable to decode function %s: %v\n", *fname, err)
+						output.Fatalf("could not hex decode %s: %v", *data, err)
 					}
-					// prepend function return value names
-					for i, a := range args {
-						if fspec.Outputs[i].Name != "" {
-							args[i] = fspec.Outputs[i].Name + "=" + a
+
+					topics := make([]binary.Word256, len(*topicArgs))
+					for i, t := range *topicArgs {
+						topic, err := decodeHex(t)
+						if err != nil {
+							output.Fatalf("could not hex decode topic %s: %v", t, err)
 						}
+						if len(topic) > binary.Word256Bytes {
+							output.Fatalf("topic %s is longer than %d bytes", t, binary.Word256Bytes)
+						}
+						topics[i] = binary.LeftPadWord256(topic)
 					}
 
-					output.Printf(strings.Join(args, "\n"))
+					var espec *abi.EventSpec
+					if *eventName != "" {
+						espec = spec.EventsByName[*eventName]
+						if espec == nil {
+							output.Fatalf("no such event %s", *eventName)
+						}
+					} else {
+						if len(topics) == 0 {
+							output.Fatalf("the event ID must be given as the first topic, or the event named with --event")
+						}
+						espec = spec.EventsByID[abi.EventID(topics[0])]
+						if espec == nil {
+							output.Fatalf("could not find event %v", topics[0])
+						}
+					}
+
+					decoded, err := decodeEvent(espec, topics, bs)
+					if err != nil {
+						output.Fatalf("%v", err)
+					}
+					output.Printf("%s", decoded)
 				}
 			})
 	}
 }
+
+// Decodes data as the values of args, each prefixed with the name of its argument if it has one
+func unpackNamed(args []abi.Argument, data []byte) ([]string, error) {
+	values := make([]string, len(args))
+	intf := make([]interface{}, len(values))
+	for i := range values {
+		intf[i] = &values[i]
+	}
+	err := abi.Unpack(args, data, intf...)
+	if err != nil {
+		return nil, err
+	}
+	return named(args, values), nil
+}
+
+// Decodes a log as the event of espec, showing each of its fields
+func decodeEvent(espec *abi.EventSpec, topics []binary.Word256, data []byte) (string, error) {
+	indexed := 0
+	if !espec.Anonymous {
+		indexed++
+	}
+	for _, input := range espec.Inputs {
+		if input.Indexed {
+			indexed++
+		}
+	}
+	if len(topics) != indexed {
+		return "", fmt.Errorf("event %s has %d topics but %d were given", espec.Name, indexed, len(topics))
+	}
+
+	values := make([]string, len(espec.Inputs))
+	intf := make([]interface{}, len(values))
+	for i := range values {
+		intf[i] = &values[i]
+	}
+	err := abi.UnpackEvent(espec, topics, data, intf...)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode event %s: %w", espec.Name, err)
+	}
+	// Indexed strings, bytes and arrays are logged as the hash of their value
+	topic := 0
+	if !espec.Anonymous {
+		topic++
+	}
+	for i, input := range espec.Inputs {
+		if input.Indexed {
+			if input.Hashed {
+				values[i] = topics[topic].String()
+			}
+			topic++
+		}
+	}
+	return fmt.Sprintf("%s(%s)", espec.Name, strings.Join(named(espec.Inputs, values), ",")), nil
+}
+
+func named(args []abi.Argument, values []string) []string {
+	for i, v := range values {
+		if args[i].Name != "" {
+			values[i] = args[i].Name + "=" + v
+		}
+	}
+	return values
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+}
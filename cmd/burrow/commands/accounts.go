@@ -2,9 +2,11 @@ package commands
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"time"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/encoding"
 
 	"github.com/hyperledger/burrow/deploy/compile"
@@ -18,8 +20,35 @@ func Accounts(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
 		timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds")
+		publicKeyOpt := cmd.StringOpt("public-key", "", "Only list the accounts with this public key in hex")
+		curveTypeOpt := cmd.StringOpt("curvetype", "ed25519", "The curve type of the public key. Supports "+
+			"'secp256k1' (ethereum), 'ed25519' (tendermint)")
+		codeHashOpt := cmd.StringOpt("code-hash", "", "Only list the accounts whose code has this hash in hex, "+
+			"such as the contracts created by a factory")
 
 		cmd.Action = func() {
+			param := new(rpcquery.ListAccountsParam)
+			if *publicKeyOpt != "" {
+				curveType, err := crypto.CurveTypeFromString(*curveTypeOpt)
+				if err != nil {
+					output.Fatalf("unrecognised curve type %v", *curveTypeOpt)
+				}
+				bs, err := hex.DecodeString(*publicKeyOpt)
+				if err != nil {
+					output.Fatalf("failed to hex decode public key: %v", err)
+				}
+				param.PublicKey, err = crypto.PublicKeyFromBytes(bs, curveType)
+				if err != nil {
+					output.Fatalf("invalid public key: %v", err)
+				}
+			}
+			if *codeHashOpt != "" {
+				err := param.CodeHash.UnmarshalText([]byte(*codeHashOpt))
+				if err != nil {
+					output.Fatalf("failed to hex decode code hash: %v", err)
+				}
+			}
+
 			ctx, cancel := context.WithCancel(context.Background())
 			if *timeoutOpt != 0 {
 				timeout := time.Duration(*timeoutOpt) * time.Second
@@ -34,7 +63,7 @@ func Accounts(output Output) func(cmd *cli.Cmd) {
 
 			qCli := rpcquery.NewQueryClient(conn)
 
-			stream, err := qCli.ListAccounts(context.Background(), &rpcquery.ListAccountsParam{})
+			stream, err := qCli.ListAccounts(context.Background(), param)
 			if err != nil {
 				output.Fatalf("failed to list accounts: %v", err)
 			}
@@ -0,0 +1,272 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	cli "github.com/jawher/mow.cli"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+type callReceipt struct {
+	TxHash  binary.HexBytes
+	Height  uint64
+	GasUsed uint64
+	Return  []string `json:",omitempty"`
+	Events  []string `json:",omitempty"`
+}
+
+type contractCall struct {
+	client  *def.Client
+	source  string
+	address crypto.Address
+	spec    *abi.Spec
+	fspec   *abi.FunctionSpec
+	data    string
+}
+
+// Call queries a contract function without sending a transaction and prints the values it returns
+func Call(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		callOpts := addContractCallOptions(cmd)
+
+		cmd.Action = func() {
+			c := callOpts.setup(output)
+			txe, err := c.client.QueryContract(&def.QueryArg{
+				Input:   c.source,
+				Address: c.address.String(),
+				Data:    c.data,
+			}, logging.NewNoopLogger())
+			if err != nil {
+				output.Fatalf("could not call %s: %v", c.fspec.Name, err)
+			}
+			if txe.Exception != nil {
+				output.Fatalf("call to %s failed: %v", c.fspec.Name, c.failure(txe))
+			}
+			values, err := unpackNamed(c.fspec.Outputs, txe.GetResult().GetReturn())
+			if err != nil {
+				output.Fatalf("unable to decode return of %s: %v", c.fspec.Name, err)
+			}
+			output.Printf("%s", strings.Join(values, "\n"))
+		}
+	}
+}
+
+// Send calls a contract function in a transaction and prints its receipt
+func Send(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		sendOpts := addSendOptions(cmd)
+		callOpts := addContractCallOptions(cmd)
+
+		cmd.Action = func() {
+			c := callOpts.setup(output)
+			logger := logging.NewNoopLogger()
+			tx, err := c.client.Call(&def.CallArg{
+				Input:   c.source,
+				Amount:  *sendOpts.amount,
+				Address: c.address.String(),
+				Fee:     *sendOpts.fee,
+				Gas:     *sendOpts.gas,
+				Data:    c.data,
+			}, logger)
+			if err != nil {
+				output.Fatalf("could not formulate CallTx: %v", err)
+			}
+			txe, err := c.client.SignAndBroadcast(tx, logger)
+			if err != nil {
+				output.Fatalf("could not send call to %s: %v", c.fspec.Name, err)
+			}
+			if txe.Exception != nil {
+				output.Fatalf("transaction %v calling %s failed: %v", txe.Receipt.TxHash, c.fspec.Name,
+					c.failure(txe))
+			}
+			receipt := &callReceipt{
+				TxHash:  txe.Receipt.TxHash,
+				Height:  txe.GetHeight(),
+				GasUsed: txe.GetResult().GetGasUsed(),
+			}
+			receipt.Return, err = unpackNamed(c.fspec.Outputs, txe.GetResult().GetReturn())
+			if err != nil {
+				output.Fatalf("unable to decode return of %s: %v", c.fspec.Name, err)
+			}
+			for _, ev := range txe.Events {
+				if log := ev.GetLog(); log != nil {
+					receipt.Events = append(receipt.Events, c.event(log))
+				}
+			}
+			output.Printf("%s", source.JSONString(receipt))
+		}
+	}
+}
+
+type contractCallOptions struct {
+	config   *configOptions
+	chain    *string
+	timeout  *int
+	source   *string
+	abi      *string
+	address  *string
+	function *string
+	args     *[]string
+}
+
+type sendOptions struct {
+	amount *string
+	fee    *string
+	gas    *string
+}
+
+// Adds the options of send on top of those shared with call
+func addSendOptions(cmd *cli.Cmd) *sendOptions {
+	opts := &sendOptions{
+		amount: cmd.StringOpt("amount", "0", "Amount of value to send to the contract"),
+		fee:    cmd.StringOpt("fee", "99", "Fee to pay the validators"),
+		gas:    cmd.StringOpt("gas", "1111111111", "Gas to send with the call"),
+	}
+	cmd.Spec += " [--amount=<value>] [--fee=<value>] [--gas=<value>]"
+	return opts
+}
+
+// Adds the options shared by call and send
+func addContractCallOptions(cmd *cli.Cmd) *contractCallOptions {
+	opts := &contractCallOptions{
+		config:  addConfigOptions(cmd),
+		chain:   cmd.StringOpt("chain", "", "chain to be used in IP:PORT format"),
+		timeout: cmd.IntOpt("t timeout", 5, "Timeout in seconds"),
+		source:  cmd.StringOpt("s source", "", "Address or key name to call from, if not set config is used"),
+		abi: cmd.StringOpt("abi", "", "ABI file or directory, if not set the metadata the contract was "+
+			"deployed with is used"),
+		address: cmd.StringArg("ADDRESS", "", "Address of the contract"),
+		function: cmd.StringArg("FUNCTION", "", "Function name, or function signature such as "+
+			"'balanceOf(address)(uint256)' to call without an ABI"),
+		args: cmd.StringsArg("ARGS", nil, "Function arguments"),
+	}
+	cmd.Spec += " [--chain=<ip>] [--timeout=<seconds>] [--source=<address>] [--abi=<path>] " +
+		"ADDRESS FUNCTION [ARGS...]"
+	// we don't want config sourcing logs
+	source.LogWriter = ioutil.Discard
+	return opts
+}
+
+// Sets up the call the options describe once they are parsed, connecting to the chain
+func (opts *contractCallOptions) setup(output Output) *contractCall {
+	conf, err := opts.config.obtainBurrowConfig()
+	if err != nil {
+		output.Fatalf("could not set up config: %v", err)
+	}
+	if err := conf.Verify(); err != nil {
+		output.Fatalf("cannot continue with config: %v", err)
+	}
+	chainHost := jobs.FirstOf(*opts.chain, conf.RPC.GRPC.ListenAddress())
+	logger := logging.NewNoopLogger()
+	c := &contractCall{
+		client: def.NewClient(chainHost, conf.Keys.RemoteAddress, true, time.Duration(*opts.timeout)*time.Second),
+		source: callSource(*opts.source, conf.ValidatorAddress),
+	}
+	// Make sure we are connected
+	_, err = c.client.Query(logger)
+	if err != nil {
+		output.Fatalf("could not connect to %s: %v", chainHost, err)
+	}
+	c.address, err = c.client.ParseAddress(*opts.address, logger)
+	if err != nil {
+		output.Fatalf("could not parse address %s: %v", *opts.address, err)
+	}
+
+	if strings.Contains(*opts.function, "(") {
+		c.fspec, err = abi.ParseFunctionSignature(*opts.function)
+		if err != nil {
+			output.Fatalf("%v", err)
+		}
+	} else {
+		if *opts.abi != "" {
+			c.spec, err = abi.LoadPath(*opts.abi)
+			if err != nil {
+				output.Fatalf("could not read %v: %v", *opts.abi, err)
+			}
+		} else {
+			metadata, err := c.client.GetMetadataForAccount(c.address)
+			if err != nil || metadata == "" {
+				output.Fatalf("could not get the metadata of %v, so --abi or a function signature must be "+
+					"given: %v", c.address, err)
+			}
+			c.spec, err = abi.ReadSpec([]byte(metadata))
+			if err != nil {
+				output.Fatalf("could not read the ABI in the metadata of %v: %v", c.address, err)
+			}
+		}
+		c.fspec = c.spec.Functions[*opts.function]
+		if c.fspec == nil {
+			output.Fatalf("no such function %s", *opts.function)
+		}
+	}
+
+	c.data, err = packCall(c.fspec, *opts.args)
+	if err != nil {
+		output.Fatalf("could not encode call to %s: %v", c.fspec.Name, err)
+	}
+	return c
+}
+
+// The account to call from is the one given or else the validator of the config, which read-only nodes may not have
+func callSource(sourceOpt string, validatorAddress *crypto.Address) string {
+	if sourceOpt != "" || validatorAddress == nil {
+		return sourceOpt
+	}
+	return validatorAddress.String()
+}
+
+// Returns the hex call data of a call to the function with its arguments given as strings
+func packCall(fspec *abi.FunctionSpec, args []string) (string, error) {
+	if len(args) != len(fspec.Inputs) {
+		return "", fmt.Errorf("%s takes %d arguments but %d were given", fspec.Name, len(fspec.Inputs), len(args))
+	}
+	values := make([]interface{}, len(args))
+	for i, a := range args {
+		values[i] = a
+	}
+	packedArgs, err := abi.Pack(fspec.Inputs, values...)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(append(fspec.FunctionID.Bytes(), packedArgs...)), nil
+}
+
+// Describes why the call failed, with the reason it reverted if it can be decoded
+func (c *contractCall) failure(txe *exec.TxExecution) error {
+	revert := txe.Exception.Revert
+	if revert == nil {
+		revert = abi.DecodeRevert(txe.GetResult().GetReturn(), c.spec)
+	}
+	if revert != nil {
+		return fmt.Errorf("%v: %v", txe.Exception, revert)
+	}
+	return txe.Exception
+}
+
+// Decodes a log with the contract's ABI, or shows its topics and data if none of its events match
+func (c *contractCall) event(log *exec.LogEvent) string {
+	if c.spec != nil && len(log.Topics) > 0 {
+		if espec := c.spec.EventsByID[abi.EventID(log.Topics[0])]; espec != nil {
+			decoded, err := decodeEvent(espec, log.Topics, log.Data)
+			if err == nil {
+				return decoded
+			}
+		}
+	}
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.String()
+	}
+	return fmt.Sprintf("%v topics=[%s] data=%v", log.Address, strings.Join(topics, ","), log.Data)
+}
@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	cli "github.com/jawher/mow.cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractCallOptions(t *testing.T) {
+	var callOpts *contractCallOptions
+	var sendOpts *sendOptions
+	run := func(args ...string) {
+		callOpts, sendOpts = nil, nil
+		app := cli.App("burrow", "")
+		app.ErrorHandling = flag.ContinueOnError
+		app.Command("call", "", func(cmd *cli.Cmd) {
+			opts := addContractCallOptions(cmd)
+			cmd.Action = func() {
+				callOpts = opts
+			}
+		})
+		app.Command("send", "", func(cmd *cli.Cmd) {
+			sOpts := addSendOptions(cmd)
+			opts := addContractCallOptions(cmd)
+			cmd.Action = func() {
+				callOpts, sendOpts = opts, sOpts
+			}
+		})
+		require.NoError(t, app.Run(append([]string{"burrow"}, args...)))
+	}
+
+	t.Run("Call", func(t *testing.T) {
+		run("call", "--chain", "localhost:10997", "-t", "7", "-s", "alice", "--abi", "bin",
+			"Token", "balanceOf", "Bob")
+		require.NotNil(t, callOpts)
+		assert.Equal(t, "localhost:10997", *callOpts.chain)
+		assert.Equal(t, 7, *callOpts.timeout)
+		assert.Equal(t, "alice", *callOpts.source)
+		assert.Equal(t, "bin", *callOpts.abi)
+		assert.Equal(t, "Token", *callOpts.address)
+		assert.Equal(t, "balanceOf", *callOpts.function)
+		assert.Equal(t, []string{"Bob"}, *callOpts.args)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		// A call needs no more than the contract and function, and signatures may be given in place of an ABI
+		run("call", "Token", "totalSupply()(uint256)")
+		require.NotNil(t, callOpts)
+		assert.Equal(t, "", *callOpts.chain)
+		assert.Equal(t, 5, *callOpts.timeout)
+		assert.Equal(t, "", *callOpts.source)
+		assert.Equal(t, "totalSupply()(uint256)", *callOpts.function)
+		assert.Empty(t, *callOpts.args)
+	})
+
+	t.Run("Send", func(t *testing.T) {
+		run("send", "--amount", "5", "--fee", "1", "--gas", "1000", "-s", "alice",
+			"Token", "transfer(address,uint256)", "Bob", "10")
+		require.NotNil(t, sendOpts)
+		assert.Equal(t, "5", *sendOpts.amount)
+		assert.Equal(t, "1", *sendOpts.fee)
+		assert.Equal(t, "1000", *sendOpts.gas)
+		assert.Equal(t, "alice", *callOpts.source)
+		assert.Equal(t, []string{"Bob", "10"}, *callOpts.args)
+
+		run("send", "Token", "pause()")
+		require.NotNil(t, sendOpts)
+		assert.Equal(t, "0", *sendOpts.amount)
+		assert.Equal(t, "99", *sendOpts.fee)
+		assert.Equal(t, "1111111111", *sendOpts.gas)
+	})
+}
+
+func TestCallSource(t *testing.T) {
+	validator := crypto.Address{1, 2, 3}
+	assert.Equal(t, "alice", callSource("alice", &validator))
+	assert.Equal(t, validator.String(), callSource("", &validator))
+	// Read-only nodes have no validator
+	assert.Equal(t, "", callSource("", nil))
+}
+
+func TestPackCall(t *testing.T) {
+	fspec, err := abi.ParseFunctionSignature("transfer(address,uint256)(bool)")
+	require.NoError(t, err)
+	data, err := packCall(fspec, []string{"0000000000000000000000000000000000000001", "16"})
+	require.NoError(t, err)
+	assert.Equal(t, "a9059cbb"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000010", data)
+
+	_, err = packCall(fspec, []string{"0000000000000000000000000000000000000001"})
+	require.Error(t, err)
+}
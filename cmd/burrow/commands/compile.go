@@ -2,9 +2,11 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 
+	"github.com/hyperledger/burrow/deploy/bind"
 	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/logging"
 	cli "github.com/jawher/mow.cli"
@@ -15,21 +17,32 @@ import (
 func Compile(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		wasmOpt := cmd.BoolOpt("w wasm", false, "Use solang rather than solc")
-		sourceArg := cmd.StringsArg("SOURCE", nil, "Solidity source files to compile")
-		cmd.Spec = "[--wasm] SOURCE..."
+		bindingsOpt := cmd.StringOpt("bindings", "", "Generate bindings in this language (only go) to call the "+
+			"contracts with rather than fixtures")
+		packageOpt := cmd.StringOpt("package", "", "Package of the Go generated (default: the source's directory)")
+		sourceArg := cmd.StringsArg("SOURCE", nil, "Solidity or Vyper (.vy) source files to compile")
+		cmd.Spec = "[--wasm] [--bindings=<language>] [--package=<package>] SOURCE..."
 
 		cmd.Action = func() {
+			if *bindingsOpt != "" && *bindingsOpt != "go" {
+				output.Fatalf("cannot generate bindings in %s, only go", *bindingsOpt)
+			}
 			for _, solfile := range *sourceArg {
 				var resp *compile.Response
 				var err error
 
-				if *wasmOpt {
+				if compile.IsVyper(solfile) {
+					resp, err = compile.Vyper(solfile, "", "", logging.NewNoopLogger())
+					if err != nil {
+						output.Fatalf("failed compile vyper: %v\n", err)
+					}
+				} else if *wasmOpt {
 					resp, err = compile.WASM(solfile, "", logging.NewNoopLogger())
 					if err != nil {
 						output.Fatalf("failed compile solidity to wasm: %v\n", err)
 					}
 				} else {
-					resp, err = compile.EVM(solfile, false, "", nil, logging.NewNoopLogger())
+					resp, err = compile.EVM(solfile, false, "", nil, "", logging.NewNoopLogger())
 					if err != nil {
 						output.Fatalf("failed compile solidity: %v\n", err)
 					}
@@ -43,12 +56,37 @@ func Compile(output Output) func(cmd *cli.Cmd) {
 					output.Printf(resp.Warning)
 				}
 
+				pkg := *packageOpt
+				if pkg == "" {
+					pkg = path.Base(path.Dir(solfile))
+				}
+
+				if *bindingsOpt != "" {
+					var sources []bind.Source
+					for _, c := range resp.Objects {
+						sources = append(sources, bind.Source{
+							Name:     c.Objectname,
+							Abi:      c.Contract.Abi,
+							Bytecode: c.Contract.Code(),
+						})
+					}
+					code, err := bind.Generate(pkg, sources...)
+					if err != nil {
+						output.Fatalf("failed to generate bindings: %v\n", err)
+					}
+					err = ioutil.WriteFile(solfile+".go", code, 0644)
+					if err != nil {
+						output.Fatalf("failed to write bindings: %v\n", err)
+					}
+					continue
+				}
+
 				f, err := os.Create(solfile + ".go")
 				if err != nil {
 					output.Fatalf("failed to create go file: %v\n", err)
 				}
 
-				f.WriteString(fmt.Sprintf("package %s\n\n", path.Base(path.Dir(solfile))))
+				f.WriteString(fmt.Sprintf("package %s\n\n", pkg))
 				f.WriteString("import hex \"github.com/tmthrgd/go-hex\"\n\n")
 
 				for _, c := range resp.Objects {
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/config/source"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Config checks the config a node would start with
+func Config(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		cmd.Command("validate", "Check the config that burrow start would use with the same options, printing it "+
+			"fully resolved and failing with what to change if anything would stop the node",
+			func(cmd *cli.Cmd) {
+				configOpts := addConfigOptions(cmd)
+				jsonOpt := cmd.BoolOpt("j json", false, "Print the resolved config as JSON rather than TOML")
+				yamlOpt := cmd.BoolOpt("y yaml", false, "Print the resolved config as YAML rather than TOML, "+
+					"keeping the comments of a YAML config file")
+				cmd.Spec += " [--json | --yaml]"
+
+				cmd.Action = func() {
+					conf, err := configOpts.obtainBurrowConfig()
+					if err != nil {
+						output.Fatalf("could not read config: %v", err)
+					}
+					unknown, err := unknownConfigFields(*configOpts.configFileOpt)
+					if err != nil {
+						output.Fatalf("could not read config: %v", err)
+					}
+					err = conf.Validate()
+					// The passphrase is not for printing
+					conf.Passphrase = nil
+					if *jsonOpt {
+						output.Printf("%s", conf.JSONString())
+					} else if *yamlOpt {
+						output.Printf("%s", configYAMLString(conf, *configOpts.configFileOpt))
+					} else {
+						output.Printf("%s", conf.TOMLString())
+					}
+					for _, field := range unknown {
+						output.Logf("Ignoring unknown field %s in config, check its spelling and section", field)
+					}
+					if err != nil {
+						output.Fatalf("%v", err)
+					}
+					output.Logf("Config is valid")
+				}
+			})
+
+		cmd.Command("schema", "Print JSONSchema for the config file format, for editors to validate "+
+			config.DefaultBurrowConfigYAMLFileName+" against",
+			func(cmd *cli.Cmd) {
+				cmd.Action = func() {
+					output.Printf("%s", source.JSONString(config.BurrowConfigSchema()))
+				}
+			})
+	}
+}
+
+// Serialises conf as YAML with the comments of configFile (or the default config file if none is given) if that is YAML
+func configYAMLString(conf *config.BurrowConfig, configFile string) string {
+	if configFile == "" && os.Getenv(config.DefaultBurrowConfigEnvironmentVariable) == "" {
+		configFile = defaultConfigFile()
+	}
+	if configFile == "" || configFile == source.STDINFileIdentifier {
+		return conf.YAMLString()
+	}
+	bs, err := source.ReadFile(configFile)
+	if err != nil || source.DetectFileFormat(configFile, string(bs)) != source.YAML {
+		return conf.YAMLString()
+	}
+	return source.YAMLStringWithComments(conf, string(bs))
+}
+
+// Returns the fields of the config that burrowConfigProvider would read from configFile that name no config field
+func unknownConfigFields(configFile string) ([]string, error) {
+	var configString string
+	switch {
+	case configFile == source.STDINFileIdentifier:
+		// Already consumed in reading the config
+		return nil, nil
+	case configFile != "":
+		bs, err := source.ReadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		configString = string(bs)
+	case os.Getenv(config.DefaultBurrowConfigEnvironmentVariable) != "":
+		configString = os.Getenv(config.DefaultBurrowConfigEnvironmentVariable)
+	default:
+		configFile = defaultConfigFile()
+		if configFile == "" {
+			return nil, nil
+		}
+		bs, err := source.ReadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		configString = string(bs)
+	}
+	return source.UnknownFieldsAs(source.DetectFileFormat(configFile, configString), configString,
+		new(config.BurrowConfig))
+}
+
+// Returns the config file in the working directory that burrowConfigProvider would read if not given one, if any
+func defaultConfigFile() string {
+	for _, file := range []string{config.DefaultBurrowConfigTOMLFileName, config.DefaultBurrowConfigYAMLFileName} {
+		if _, err := os.Stat(file); err == nil {
+			return file
+		}
+	}
+	return ""
+}
@@ -1,26 +1,31 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"strings"
 
+	tmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/hyperledger/burrow/config/deployment"
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/genesis/spec"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/logging/logconfig/presets"
+	"github.com/hyperledger/burrow/project"
 	"github.com/hyperledger/burrow/rpc"
 	cli "github.com/jawher/mow.cli"
-	tmjson "github.com/tendermint/tendermint/libs/json"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -34,6 +39,9 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 		jsonOutOpt := cmd.BoolOpt("j json", false, "Emit config in JSON rather than TOML "+
 			"suitable for further processing")
 
+		yamlOutOpt := cmd.BoolOpt("y yaml", false, "Emit config in YAML rather than TOML, keeping the comments of "+
+			"a YAML config file given with --config")
+
 		keysURLOpt := cmd.StringOpt("k keys-url", "", fmt.Sprintf("Provide keys GRPC address, default: %s",
 			keys.DefaultKeysConfig().RemoteAddress))
 
@@ -64,16 +72,35 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 		emptyBlocksOpt := cmd.StringOpt("e empty-blocks", "",
 			"Whether to create empty blocks, one of: 'never' (always wait for transactions before proposing a "+
-				"block, 'always' (at end of each consensus round), or a duration like '1s', '5m', or '6h'")
+				"block), 'always' (at end of each consensus round), 'adaptive' (when transactions are pending or "+
+				"EmptyBlocksInterval has passed since the last block), or a duration like '1s', '5m', or '6h' to wait "+
+				"for transactions before proposing a block until that long has passed since the last")
+
+		restoreDumpOpt := cmd.StringsOpt("restore-dump", nil, "Including AppHash for restored file, give again for "+
+			"each incremental dump to restore after it, in order")
+
+		mnemonicOpt := cmd.StringOpt("mnemonic", "", "BIP-39 mnemonic from which to derive the keys of "+
+			"accounts in the GenesisSpec that have none (other than validators), importing them into the keys "+
+			"store, in place of any mnemonic given in the spec")
+
+		ethereumGenesisOpt := cmd.StringOpt("ethereum-genesis", "", "A geth-style genesis.json whose allocated "+
+			"accounts (with their balances, code, and storage), chain ID, and forks to carry over into the GenesisDoc")
+
+		pool := cmd.BoolOpt("pool", false, "Write config files for all the validators called burrowNNN.toml "+
+			"(or .json or .yaml)")
 
-		restoreDumpOpt := cmd.StringOpt("restore-dump", "", "Including AppHash for restored file")
+		networkOpt := cmd.StringOpt("network", "", "Write a docker-compose.yml, with a directory of config and keys "+
+			"for each validator, and Kubernetes manifests (kubernetes.yaml) for a network of a node per validator, "+
+			"peered with each other, to this directory")
 
-		pool := cmd.BoolOpt("pool", false, "Write config files for all the validators called burrowNNN.toml")
+		imageOpt := cmd.StringOpt("image", "hyperledger/burrow:"+project.History.CurrentVersion().String(),
+			"The docker image nodes of a --network run")
 
 		cmd.Spec = "[--keys-url=<keys URL> | --keys-dir=<keys directory>] [--curve-type=<name>]" +
 			"[ --config-template-in=<text template> --config-out=<output file>]... " +
-			"[--genesis-spec=<GenesisSpec file>] [--separate-genesis-doc=<genesis JSON file>] " +
-			"[--chain-name=<chain name>] [--restore-dump=<dump file>] [--json] [--debug] [--pool] " +
+			"[--genesis-spec=<GenesisSpec file> [--mnemonic=<words>]] [--separate-genesis-doc=<genesis JSON file>] " +
+			"[--chain-name=<chain name>] [--ethereum-genesis=<genesis.json>] [--restore-dump=<dump file>...] " +
+			"[--json | --yaml] [--debug] [--pool | --network=<directory> [--image=<docker image>]] " +
 			"[--logging=<logging program>] [--describe-logging] [--empty-blocks=<'always','never',duration>]"
 
 		// no sourcing logs
@@ -120,12 +147,22 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				if err != nil {
 					output.Fatalf("Could not read GenesisSpec: %v", err)
 				}
+				if *mnemonicOpt != "" {
+					genesisSpec.Mnemonic = *mnemonicOpt
+				}
 				if conf.Keys.RemoteAddress == "" {
 					dir := conf.Keys.KeysDirectory
 					if *keysDir != "" {
 						dir = *keysDir
 					}
 					keyStore := keys.NewFilesystemKeyStore(dir, conf.Keys.AllowBadFilePermissions)
+					err = genesisSpec.DeriveKeys(func(name string, privateKey crypto.PrivateKey) error {
+						_, err := keyStore.Import(context.Background(), importRequest(name, privateKey))
+						return err
+					})
+					if err != nil {
+						output.Fatalf("could not derive keys from mnemonic: %v", err)
+					}
 
 					keyClient := keys.NewLocalKeyClient(keyStore, logging.NewNoopLogger())
 					conf.GenesisDoc, err = genesisSpec.GenesisDoc(keyClient, ct)
@@ -154,6 +191,21 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 						pkg.Keys[addr] = deployment.Key{Name: k, Address: addr, KeyJSON: bs}
 					}
 				} else {
+					if genesisSpec.Mnemonic != "" {
+						conn, err := encoding.GRPCDial(conf.Keys.RemoteAddress)
+						if err != nil {
+							output.Fatalf("could not connect to keys server: %v", err)
+						}
+						keysClient := keys.NewKeysClient(conn)
+						err = genesisSpec.DeriveKeys(func(name string, privateKey crypto.PrivateKey) error {
+							_, err := keysClient.Import(context.Background(), importRequest(name, privateKey))
+							return err
+						})
+						conn.Close()
+						if err != nil {
+							output.Fatalf("could not derive keys from mnemonic: %v", err)
+						}
+					}
 					keyClient, err := keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, logging.NewNoopLogger())
 					if err != nil {
 						output.Fatalf("could not create remote key client: %v", err)
@@ -173,7 +225,25 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				conf.GenesisDoc.ChainName = *chainNameOpt
 			}
 
-			if *restoreDumpOpt != "" {
+			if *ethereumGenesisOpt != "" {
+				if conf.GenesisDoc == nil {
+					output.Fatalf("no GenesisDoc/GenesisSpec provided to give validators for Ethereum genesis")
+				}
+				bs, err := ioutil.ReadFile(*ethereumGenesisOpt)
+				if err != nil {
+					output.Fatalf("could not read Ethereum genesis: %v", err)
+				}
+				ethGenesis, err := genesis.EthereumGenesisFromJSON(bs)
+				if err != nil {
+					output.Fatalf("%v", err)
+				}
+				err = ethGenesis.Import(conf.GenesisDoc)
+				if err != nil {
+					output.Fatalf("could not import Ethereum genesis %s: %v", *ethereumGenesisOpt, err)
+				}
+			}
+
+			if len(*restoreDumpOpt) > 0 {
 				if conf.GenesisDoc == nil {
 					output.Fatalf("no GenesisDoc provided, cannot restore dump")
 				}
@@ -182,7 +252,7 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("on restore, validators must be provided in GenesisDoc or GenesisSpec")
 				}
 
-				reader, err := dump.NewFileReader(*restoreDumpOpt)
+				reader, err := dump.NewFilesReader(*restoreDumpOpt...)
 				if err != nil {
 					output.Fatalf("failed to read restore dump: %v", err)
 				}
@@ -194,7 +264,7 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 				err = dump.Load(reader, st)
 				if err != nil {
-					output.Fatalf("could not restore dump %s: %v", *restoreDumpOpt, err)
+					output.Fatalf("could not restore dump %s: %v", strings.Join(*restoreDumpOpt, ", "), err)
 				}
 
 				conf.GenesisDoc.AppHash = st.Hash()
@@ -221,6 +291,10 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 			if *emptyBlocksOpt != "" {
 				conf.Tendermint.CreateEmptyBlocks = *emptyBlocksOpt
+				_, _, err := conf.Tendermint.EmptyBlocks()
+				if err != nil {
+					output.Fatalf("could not set empty blocks: %v", err)
+				}
 			}
 
 			peers := make([]string, 0)
@@ -269,7 +343,45 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				conf.GenesisDoc = nil
 			}
 
-			if *pool {
+			if *networkOpt != "" {
+				if genesisDoc == nil {
+					output.Fatalf("cannot write network since no GenesisDoc/GenesisSpec was provided")
+				}
+				network, err := deployment.NewNetwork(&pkg, *imageOpt)
+				if err != nil {
+					output.Fatalf("could not make network: %v", err)
+				}
+				network.PeerPort = conf.Tendermint.ListenPort
+				network.GRPCPort = conf.RPC.GRPC.ListenPort
+				network.InfoPort = conf.RPC.Info.ListenPort
+				network.Web3Port = conf.RPC.Web3.ListenPort
+				for i, node := range network.Nodes {
+					conf.GenesisDoc = genesisDoc
+					conf.ValidatorAddress = &pkg.Validators[i].Address
+					conf.BurrowDir = ".burrow"
+					conf.Keys.KeysDirectory = keys.DefaultKeysDir
+					// Kubernetes adds group read to the keys mounted from secrets
+					conf.Keys.AllowBadFilePermissions = true
+					conf.Tendermint.Moniker = node.Name
+					conf.Tendermint.ListenHost = rpc.AnyLocal
+					conf.Tendermint.PersistentPeers = network.PersistentPeers(node)
+					conf.Tendermint.AddrBookStrict = false
+					node.Config = conf.TOMLString()
+				}
+				err = network.WriteDockerCompose(*networkOpt)
+				if err != nil {
+					output.Fatalf("could not write docker-compose network: %v", err)
+				}
+				manifests, err := network.KubernetesManifests()
+				if err != nil {
+					output.Fatalf("could not generate Kubernetes manifests: %v", err)
+				}
+				err = ioutil.WriteFile(filepath.Join(*networkOpt, "kubernetes.yaml"), []byte(manifests), 0600)
+				if err != nil {
+					output.Fatalf("could not write Kubernetes manifests: %v", err)
+				}
+				output.Logf("Wrote network of %d validators to %s", len(network.Nodes), *networkOpt)
+			} else if *pool {
 				for i, val := range pkg.Validators {
 					tmConf, err := conf.Tendermint.Config(fmt.Sprintf(".burrow%03d", i), conf.Execution.TimeoutFactor)
 					if err != nil {
@@ -303,6 +415,9 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 					if *jsonOutOpt {
 						err = ioutil.WriteFile(fmt.Sprintf("burrow%03d.json", i), []byte(conf.JSONString()), 0644)
+					} else if *yamlOutOpt {
+						err = ioutil.WriteFile(fmt.Sprintf("burrow%03d.yaml", i),
+							[]byte(configYAMLString(conf, *configOpts.configFileOpt)), 0644)
 					} else {
 						err = ioutil.WriteFile(fmt.Sprintf("burrow%03d.toml", i), []byte(conf.TOMLString()), 0644)
 					}
@@ -312,6 +427,8 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				}
 			} else if *jsonOutOpt {
 				output.Printf(conf.JSONString())
+			} else if *yamlOutOpt {
+				output.Printf(configYAMLString(conf, *configOpts.configFileOpt))
 			} else {
 				output.Printf(conf.TOMLString())
 			}
@@ -320,6 +437,14 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 	}
 }
 
+func importRequest(name string, privateKey crypto.PrivateKey) *keys.ImportRequest {
+	return &keys.ImportRequest{
+		Name:      name,
+		CurveType: privateKey.CurveType.String(),
+		KeyBytes:  privateKey.RawBytes(),
+	}
+}
+
 func processTemplate(pkg *deployment.Config, templateIn, templateOut string) error {
 	data, err := ioutil.ReadFile(templateIn)
 	if err != nil {
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"github.com/hyperledger/burrow/core"
+	cli "github.com/jawher/mow.cli"
+)
+
+// DB checks and repairs the databases of a stopped node
+func DB(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		cmd.Command("verify", "check the state and its index against the app hashes committed in the block store",
+			func(cmd *cli.Cmd) {
+				configOpts := addConfigOptions(cmd)
+				repairOpt := cmd.BoolOpt("r repair", false, "Delete orphaned state and dangling index entries "+
+					"left after the last block, as when a node stops part way through a commit")
+
+				cmd.Action = func() {
+					conf, err := configOpts.obtainBurrowConfig()
+					if err != nil {
+						output.Fatalf("could not set up config: %v", err)
+					}
+
+					if conf.GenesisDoc == nil {
+						output.Fatalf("no GenesisDoc provided, cannot verify")
+					}
+
+					tmConf, err := conf.TendermintConfig()
+					if err != nil {
+						output.Fatalf("could not build Tendermint config: %v", err)
+					}
+
+					kern, err := core.NewKernel(conf.BurrowDir, conf.Backend())
+					if err != nil {
+						output.Fatalf("could not create Burrow kernel: %v", err)
+					}
+
+					if err = kern.LoadEncryptionFromConfig(conf.DBEncryption); err != nil {
+						output.Fatalf("could not load database encryption: %v", err)
+					}
+
+					if err = kern.LoadColdStorageFromConfig(conf.ColdStorage); err != nil {
+						output.Fatalf("could not load cold storage: %v", err)
+					}
+
+					if err = kern.LoadLoggerFromConfig(conf.Logging); err != nil {
+						output.Fatalf("could not load logger: %v", err)
+					}
+
+					report, err := kern.Verify(conf.GenesisDoc, tmConf, *repairOpt)
+					if err != nil {
+						output.Fatalf("could not verify: %v", err)
+					}
+
+					output.Printf("Checked %d trees of state at height %d with hash %X",
+						report.Trees, report.Height, report.Hash)
+					output.Printf("Checked %d state hashes against the app hashes in the block store",
+						report.AppHashesChecked)
+
+					problems := false
+					for _, prefix := range report.Corrupt {
+						output.Printf("Tree %q is corrupt", string(prefix))
+						problems = true
+					}
+					for _, height := range report.AppHashMismatches {
+						output.Printf("State at height %d does not have the app hash committed for it", height)
+						problems = true
+					}
+					action := "found"
+					if report.Repaired {
+						action = "deleted"
+					}
+					for _, prefix := range report.Orphaned {
+						output.Printf("Orphaned versions of tree %q %s", string(prefix), action)
+					}
+					if report.DanglingTxIndex > 0 {
+						output.Printf("%d dangling transaction index entries %s", report.DanglingTxIndex, action)
+					}
+					orphans := len(report.Orphaned) > 0 || report.DanglingTxIndex > 0
+					if problems || orphans && !report.Repaired {
+						output.Fatalf("state failed verification")
+					}
+					output.Printf("State verified")
+					kern.ShutdownAndExit()
+				}
+			})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-kit/kit/log"
 	pkgs "github.com/hyperledger/burrow/deploy"
 	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/loader"
 	"github.com/hyperledger/burrow/deploy/proposals"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/loggers"
@@ -45,7 +46,8 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			"default gas to use; can be overridden for any single job")
 
 		jobsOpt := cmd.IntOpt("j jobs", 1,
-			"default number of concurrent playbooks to run if multiple are specified")
+			"number of playbooks to run concurrently if multiple are specified, and of independent jobs to run concurrently "+
+				"within each playbook when transactions are signed in the mempool")
 
 		addressOpt := cmd.StringOpt("a address", "",
 			"default address (or account name) to use; operates the same way as the [account] job, only before the deploy file is ran")
@@ -61,6 +63,30 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 
 		wasmOpt := cmd.BoolOpt("wasm", false, "Compile to WASM using solang (experimental)")
 
+		solcOpt := cmd.StringOpt("solc", "", "version of solc to download and compile with unless a playbook or job "+
+			"sets one, or pragma to use the newest release each contract's version pragma allows (default: solc on the PATH)")
+
+		solcCacheOpt := cmd.StringOpt("solc-cache", "", "directory to keep downloaded solc binaries in "+
+			"(default: burrow/solc in the user's cache directory)")
+
+		sourceCacheOpt := cmd.StringOpt("source-cache", "", "directory to keep contracts fetched from URLs, IPFS, "+
+			"and git repositories in (default: burrow/sources in the user's cache directory)")
+
+		ipfsGatewayOpt := cmd.StringOpt("ipfs-gateway", loader.DefaultIPFSGateway, "IPFS HTTP gateway to fetch "+
+			"ipfs:// contracts through")
+
+		gasReportOpt := cmd.StringOpt("gas-report", "", "print the gas used by each job and contract function after "+
+			"each playbook and write it to this file as JSON")
+
+		manifestOpt := cmd.StringOpt("manifest", "", "write the contracts deployed by each playbook, with their "+
+			"addresses, transactions, constructor arguments and ABI hashes, to this file as JSON")
+
+		simulateOpt := cmd.BoolOpt("simulate", false, "simulate deploy and call jobs against the current state "+
+			"without broadcasting them, skipping jobs that send other transactions")
+
+		watchOpt := cmd.BoolOpt("w watch", false, "keep running, deploying again the contracts whose sources "+
+			"change and running again the jobs that depend on them, for developing against a local chain")
+
 		debugOpt := cmd.BoolOpt("d debug", false, "debug level output")
 
 		proposalVerify := cmd.BoolOpt("proposal-verify", false, "Verify any proposal, do NOT create new proposal or vote")
@@ -77,9 +103,10 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			"path to playbook file which deploy should run. if also using the --dir flag, give the relative path to playbooks file, which should be in the same directory")
 
 		cmd.Spec = "[--chain=<host:port>] [--keys=<host:port>] [--mempool-signing] [--dir=<root directory>] " +
-			"[--output=<output file>] [--wasm] [--set=<KEY=VALUE>]... [--bin-path=<path>] [--gas=<gas>] " +
-			"[--jobs=<concurrent playbooks>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] [--local-abi] " +
-			"[--verbose] [--debug] [--timeout=<timeout>] " +
+			"[--output=<output file>] [--wasm] [--solc=<version>] [--solc-cache=<dir>] [--source-cache=<dir>] " +
+			"[--ipfs-gateway=<url>] [--set=<KEY=VALUE>]... [--bin-path=<path>] [--gas=<gas>] " +
+			"[--jobs=<concurrency>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] [--local-abi] " +
+			"[--gas-report=<file>] [--manifest=<file>] [--simulate | --watch] [--verbose] [--debug] [--timeout=<timeout>] " +
 			"[--list-proposals=<state> | --proposal-create| --proposal-verify | --proposal-vote] [FILE...]"
 
 		cmd.Action = func() {
@@ -103,6 +130,13 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			args.Path = *pathOpt
 			args.LocalABI = *localAbiOpt
 			args.Wasm = *wasmOpt
+			args.Solc = *solcOpt
+			args.SolcCache = *solcCacheOpt
+			args.SourceCache = *sourceCacheOpt
+			args.IPFSGateway = *ipfsGatewayOpt
+			args.GasReport = *gasReportOpt
+			args.Manifest = *manifestOpt
+			args.Simulate = *simulateOpt
 			args.DefaultOutput = *defaultOutputOpt
 			args.DefaultSets = *defaultSetsOpt
 			args.BinPath = *binPathOpt
@@ -140,6 +174,13 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 				if len(*playbooksArg) == 0 {
 					output.Fatalf("incorrect usage: missing deployment yaml file(s)")
 				}
+				if *watchOpt {
+					err = pkgs.WatchPlaybooks(args, *playbooksArg, logger)
+					if err != nil {
+						output.Fatalf(err.Error())
+					}
+					return
+				}
 				failures, err := pkgs.RunPlaybooks(args, *playbooksArg, logger)
 				if err != nil {
 					fmt.Fprintln(os.Stderr, err)
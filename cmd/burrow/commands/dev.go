@@ -0,0 +1,217 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/permission"
+	cli "github.com/jawher/mow.cli"
+)
+
+type devOptions struct {
+	accounts       *int
+	balance        *int
+	seed           *string
+	blockTime      *int
+	chainName      *string
+	web3Port       *string
+	grpcPort       *string
+	faucetPort     *string
+	faucetAmount   *int
+	faucetInterval *string
+	noFaucet       *bool
+}
+
+// Dev runs a single node development chain with funded accounts whose state is kept in memory
+func Dev(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		opts := addDevOptions(cmd)
+
+		cmd.Action = func() {
+			// Keys are served by the web3 and GRPC servers from a key store that must be kept on disk
+			dir, err := ioutil.TempDir("", "burrow-dev-")
+			if err != nil {
+				output.Fatalf("could not create keys directory: %v", err)
+			}
+			defer os.RemoveAll(dir)
+
+			conf, privateAccounts, err := opts.devConfig(dir)
+			if err != nil {
+				output.Fatalf("could not configure dev chain: %v", err)
+			}
+
+			kern, err := core.LoadMemoryKernelFromConfig(conf)
+			if err != nil {
+				output.Fatalf("could not configure Burrow kernel: %v", err)
+			}
+			if err = kern.Boot(); err != nil {
+				output.Fatalf("could not boot Burrow kernel: %v", err)
+			}
+
+			output.Printf("Dev chain %s running with accounts:", conf.GenesisDoc.GetChainID())
+			for i, pa := range privateAccounts {
+				output.Printf("(%d) %v private key %s", i, pa.GetAddress(),
+					hex.EncodeToString(pa.PrivateKey().RawBytes()))
+			}
+			output.Printf("Web3 JSON-RPC listening on %s", conf.RPC.Web3.ListenAddress())
+			output.Printf("GRPC listening on %s", conf.RPC.GRPC.ListenAddress())
+			if conf.RPC.Faucet.Enabled {
+				output.Printf("Faucet sending %d from %v every %s listening on %s", conf.RPC.Faucet.Amount,
+					*conf.RPC.Faucet.Address, conf.RPC.Faucet.Interval, conf.RPC.Faucet.ListenAddress())
+			}
+			kern.WaitForShutdown()
+		}
+	}
+}
+
+func addDevOptions(cmd *cli.Cmd) *devOptions {
+	opts := &devOptions{
+		accounts: cmd.IntOpt("a accounts", 10, "Number of funded accounts to create"),
+		balance:  cmd.IntOpt("b balance", 1000000000000000000, "Native balance of each account"),
+		seed: cmd.StringOpt("s seed", "burrow-dev", "Secret from which the keys of accounts are derived, "+
+			"so the same seed gives the same accounts"),
+		blockTime: cmd.IntOpt("block-time", 0, "Seconds between blocks, or zero to commit each "+
+			"transaction in its own block as soon as it is received"),
+		chainName: cmd.StringOpt("n chain-name", "burrow-dev", "Chain name"),
+		web3Port:  cmd.StringOpt("web3-port", "", "Port for the web3 JSON-RPC server"),
+		grpcPort:  cmd.StringOpt("grpc-port", "", "Port for the GRPC server"),
+		faucetPort: cmd.StringOpt("faucet-port", "", "Port for the faucet that sends tokens to any "+
+			"address asking for them"),
+		faucetAmount: cmd.IntOpt("faucet-amount", 0, "Native amount the faucet sends for each request, "+
+			"by default a thousandth of the balance"),
+		faucetInterval: cmd.StringOpt("faucet-interval", "1m", "How long an address or client must wait "+
+			"between requests to the faucet"),
+		noFaucet: cmd.BoolOpt("no-faucet", false, "Do not run the faucet"),
+	}
+	cmd.Spec = "[--accounts=<number>] [--balance=<balance>] [--seed=<secret>] [--block-time=<seconds>] " +
+		"[--chain-name=<chain name>] [--web3-port=<port>] [--grpc-port=<port>] [--faucet-port=<port>] " +
+		"[--faucet-amount=<amount>] [--faucet-interval=<duration>] [--no-faucet]"
+	return opts
+}
+
+// Builds the config of a dev chain keeping its keys in dir, returning it with the funded accounts it creates
+func (opts *devOptions) devConfig(dir string) (*config.BurrowConfig, []*acm.PrivateAccount, error) {
+	if *opts.accounts < 1 {
+		return nil, nil, fmt.Errorf("at least one account is required")
+	}
+	if *opts.balance < 0 || *opts.blockTime < 0 || *opts.faucetAmount < 0 {
+		return nil, nil, fmt.Errorf("balance, block time, and faucet amount must not be negative")
+	}
+
+	conf := config.DefaultBurrowConfig()
+	conf.BurrowDir = dir
+	conf.Keys.KeysDirectory = filepath.Join(dir, "keys")
+	conf.Keys.RemoteAddress = ""
+	// Run alone without consensus committing blocks at the interval we are given
+	conf.Tendermint.Enabled = false
+	conf.Execution.TimeoutFactor = float64(*opts.blockTime)
+	conf.RPC.Web3.Enabled = true
+	if *opts.web3Port != "" {
+		conf.RPC.Web3.ListenPort = *opts.web3Port
+	}
+	if *opts.grpcPort != "" {
+		conf.RPC.GRPC.ListenPort = *opts.grpcPort
+	}
+	conf.RPC.Faucet.Enabled = !*opts.noFaucet
+	conf.RPC.Faucet.Amount = uint64(*opts.faucetAmount)
+	if conf.RPC.Faucet.Amount == 0 {
+		conf.RPC.Faucet.Amount = uint64(*opts.balance) / 1000
+	}
+	conf.RPC.Faucet.Interval = *opts.faucetInterval
+	if *opts.faucetPort != "" {
+		conf.RPC.Faucet.ListenPort = *opts.faucetPort
+	}
+
+	privateAccounts, err := devAccounts(conf, *opts.seed, *opts.accounts, uint64(*opts.balance), *opts.chainName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create dev accounts: %v", err)
+	}
+	return conf, privateAccounts, nil
+}
+
+// Derives the funded accounts and validator from seed storing their keys in the configured key store and setting the
+// GenesisDoc and ValidatorAddress of conf to a chain with them, along with a faucet account funded with the same balance
+// if the faucet is enabled
+func devAccounts(conf *config.BurrowConfig, seed string, n int, balance uint64,
+	chainName string) ([]*acm.PrivateAccount, error) {
+
+	keyStore := keys.NewFilesystemKeyStore(conf.Keys.KeysDirectory, false)
+	storeKey := func(pa *acm.PrivateAccount) error {
+		key, err := keys.NewKeyFromPriv(pa.PrivateKey().CurveType, pa.PrivateKey().RawBytes())
+		if err != nil {
+			return err
+		}
+		return keyStore.StoreKeyPlain(key)
+	}
+
+	privateAccounts := make([]*acm.PrivateAccount, n)
+	accounts := make(map[string]*acm.Account, n+1)
+	for i := range privateAccounts {
+		// Ethereum accounts so that they can be used by web3 tooling
+		var err error
+		privateAccounts[i], err = devEthereumAccount(fmt.Sprintf("%s-%d", seed, i))
+		if err != nil {
+			return nil, err
+		}
+		err = storeKey(privateAccounts[i])
+		if err != nil {
+			return nil, err
+		}
+		account := acm.FromAddressable(privateAccounts[i])
+		account.Balance = balance
+		account.Permissions = permission.AllAccountPermissions.Clone()
+		accounts[fmt.Sprintf("Dev_%d", i)] = account
+	}
+
+	validatorAccount := acm.GeneratePrivateAccountFromSecret(seed + "-validator")
+	err := storeKey(validatorAccount)
+	if err != nil {
+		return nil, err
+	}
+	accounts["Validator"] = acm.FromAddressable(validatorAccount)
+	if conf.RPC.Faucet.Enabled {
+		faucetAccount, err := devEthereumAccount(seed + "-faucet")
+		if err != nil {
+			return nil, err
+		}
+		err = storeKey(faucetAccount)
+		if err != nil {
+			return nil, err
+		}
+		account := acm.FromAddressable(faucetAccount)
+		account.Balance = balance
+		account.Permissions = permission.AllAccountPermissions.Clone()
+		accounts["Faucet"] = account
+		address := faucetAccount.GetAddress()
+		conf.RPC.Faucet.Address = &address
+	}
+	validators := map[string]*validator.Validator{
+		"Validator": validator.FromAccount(accounts["Validator"], 1<<16),
+	}
+
+	conf.GenesisDoc = genesis.MakeGenesisDocFromAccounts(chainName, nil, time.Now(), accounts, validators)
+	address := validatorAccount.GetAddress()
+	conf.ValidatorAddress = &address
+	return privateAccounts, nil
+}
+
+// Derives an Ethereum account from secret, which unlike acm.GenerateEthereumAccountFromSecret gives the same account
+// for the same secret since Go's ecdsa no longer generates keys deterministically from the reader it is given
+func devEthereumAccount(secret string) (*acm.PrivateAccount, error) {
+	privateKey, err := crypto.PrivateKeyFromRawBytes(crypto.Keccak256([]byte(secret)), crypto.CurveTypeSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	return acm.PrivateAccountFromPrivateKey(privateKey), nil
+}
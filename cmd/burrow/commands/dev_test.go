@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/permission"
+	cli "github.com/jawher/mow.cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevConfig(t *testing.T) {
+	parse := func(args ...string) *devOptions {
+		var opts *devOptions
+		app := cli.App("burrow", "")
+		app.ErrorHandling = flag.ContinueOnError
+		app.Command("dev", "", func(cmd *cli.Cmd) {
+			o := addDevOptions(cmd)
+			cmd.Action = func() {
+				opts = o
+			}
+		})
+		require.NoError(t, app.Run(append([]string{"burrow", "dev"}, args...)))
+		require.NotNil(t, opts)
+		return opts
+	}
+
+	dir, err := ioutil.TempDir("", "TestDevConfig")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := parse("--accounts", "3", "--balance", "5000", "--block-time", "2", "--seed", "test",
+		"--chain-name", "test-dev", "--web3-port", "18545")
+	conf, privateAccounts, err := opts.devConfig(dir)
+	require.NoError(t, err)
+
+	// We run without Tendermint so the kernel commits blocks itself every block time
+	assert.False(t, conf.Tendermint.Enabled)
+	assert.Equal(t, 2.0, conf.Execution.TimeoutFactor)
+	assert.True(t, conf.RPC.Web3.Enabled)
+	assert.Equal(t, "18545", conf.RPC.Web3.ListenPort)
+	assert.Equal(t, "test-dev", conf.GenesisDoc.ChainName)
+
+	// The dev accounts, and the faucet, are funded
+	require.Len(t, privateAccounts, 3)
+	accounts := make(map[string]uint64)
+	for _, ga := range conf.GenesisDoc.Accounts {
+		accounts[ga.Address.String()] = ga.Amount
+	}
+	keyStore := keys.NewFilesystemKeyStore(conf.Keys.KeysDirectory, false)
+	for _, pa := range privateAccounts {
+		assert.Equal(t, uint64(5000), accounts[pa.GetAddress().String()])
+		_, err := keyStore.GetKey("", pa.GetAddress().Bytes())
+		require.NoError(t, err)
+	}
+	require.True(t, conf.RPC.Faucet.Enabled)
+	require.NotNil(t, conf.RPC.Faucet.Address)
+	assert.Equal(t, uint64(5000), accounts[conf.RPC.Faucet.Address.String()])
+	assert.Equal(t, uint64(5), conf.RPC.Faucet.Amount)
+	require.NotNil(t, conf.ValidatorAddress)
+	require.Len(t, conf.GenesisDoc.Validators, 1)
+	assert.Equal(t, *conf.ValidatorAddress, conf.GenesisDoc.Validators[0].Address)
+
+	// The same seed gives the same accounts
+	again, err := ioutil.TempDir("", "TestDevConfig")
+	require.NoError(t, err)
+	defer os.RemoveAll(again)
+	_, samePrivateAccounts, err := opts.devConfig(again)
+	require.NoError(t, err)
+	assert.Equal(t, privateAccounts[0].GetAddress(), samePrivateAccounts[0].GetAddress())
+
+	t.Run("Kernel", func(t *testing.T) {
+		conf.Logging = logconfig.New()
+		kern, err := core.LoadMemoryKernelFromConfig(conf)
+		require.NoError(t, err)
+		// Without a Tendermint node the kernel launches its NoConsensus process
+		assert.Nil(t, kern.Node)
+		acc, err := kern.State.GetAccount(privateAccounts[1].GetAddress())
+		require.NoError(t, err)
+		require.NotNil(t, acc)
+		assert.Equal(t, uint64(5000), acc.Balance)
+		canCall, err := acc.Permissions.Base.Get(permission.Call)
+		require.NoError(t, err)
+		assert.True(t, canCall)
+	})
+
+	t.Run("NoFaucet", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "TestDevConfig")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		conf, _, err := parse("--no-faucet").devConfig(dir)
+		require.NoError(t, err)
+		assert.False(t, conf.RPC.Faucet.Enabled)
+		assert.Nil(t, conf.RPC.Faucet.Address)
+		// A block for each transaction as soon as it is received
+		assert.Equal(t, 0.0, conf.Execution.TimeoutFactor)
+		assert.Len(t, conf.GenesisDoc.Accounts, 11)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, err := parse("--accounts", "0").devConfig(dir)
+		require.Error(t, err)
+		_, _, err = parse("--block-time=-1").devConfig(dir)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Diff prints the changes to accounts, storage and names between two heights of a chain
+func Diff(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
+		timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds")
+		fromOpt := cmd.IntOpt("f from", 0, "Height of the state to compare from")
+		toOpt := cmd.IntOpt("to", 0, "Height of the state to compare to, or the latest height if zero")
+		cmd.Spec = "[--chain=<chain GRPC address>] [--timeout=<GRPC timeout seconds>] --from=<height> [--to=<height>]"
+
+		cmd.Action = func() {
+			if *fromOpt < 0 || *toOpt < 0 {
+				output.Fatalf("heights cannot be negative")
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if *timeoutOpt != 0 {
+				timeout := time.Duration(*timeoutOpt) * time.Second
+				ctx, cancel = context.WithTimeout(context.Background(), timeout)
+			}
+			defer cancel()
+
+			conn, err := encoding.GRPCDialContext(ctx, *chainURLOpt)
+			if err != nil {
+				output.Fatalf("failed to connect: %v", err)
+			}
+
+			qCli := rpcquery.NewQueryClient(conn)
+			stream, err := qCli.ListStateChanges(ctx, &rpcquery.ListStateChangesParam{
+				FromHeight: uint64(*fromOpt),
+				ToHeight:   uint64(*toOpt),
+			})
+			if err != nil {
+				output.Fatalf("failed to list state changes: %v", err)
+			}
+
+			// One change per line
+			for {
+				change, err := stream.Recv()
+				if err == io.EOF {
+					return
+				}
+				if err != nil {
+					output.Fatalf("failed to receive state change: %v", err)
+				}
+				bs, err := json.Marshal(change)
+				if err != nil {
+					output.Fatalf("failed to marshal state change: %v", err)
+				}
+				output.Printf("%s", bs)
+			}
+		}
+	}
+}
@@ -3,6 +3,8 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"time"
 
@@ -14,12 +16,47 @@ import (
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	cli "github.com/jawher/mow.cli"
+	"google.golang.org/grpc"
 )
 
 type dumpOptions struct {
 	height            *int
+	since             *int
 	filename          *string
 	useBinaryEncoding *bool
+	format            *string
+	canonical         *bool
+	digest            *bool
+}
+
+// The format to dump in, of which --binary is shorthand for protobuf
+func (opts *dumpOptions) dumpFormat() (dump.Format, error) {
+	if *opts.canonical || *opts.digest {
+		if *opts.format != "" && *opts.format != string(dump.CanonicalFormat) || *opts.useBinaryEncoding {
+			return "", fmt.Errorf("--canonical and --digest cannot be used with another format")
+		}
+		if *opts.since != 0 {
+			return "", fmt.Errorf("incremental dumps are not canonical so cannot be used with --since")
+		}
+		return dump.CanonicalFormat, nil
+	}
+	if *opts.useBinaryEncoding {
+		if *opts.format != "" && *opts.format != string(dump.ProtobufFormat) {
+			return "", fmt.Errorf("--binary dumps in protobuf so cannot be used with --format=%s", *opts.format)
+		}
+		return dump.ProtobufFormat, nil
+	}
+	if *opts.format == "" {
+		return dump.JSONFormat, nil
+	}
+	format, err := dump.ParseFormat(*opts.format)
+	if err != nil {
+		return "", err
+	}
+	if format == dump.CSVFormat && *opts.filename == "" {
+		return "", fmt.Errorf("a FILE is needed as the directory to write a CSV dump to")
+	}
+	return format, nil
 }
 
 func maybeOutput(verbose *bool, output Output, format string, args ...interface{}) {
@@ -29,15 +66,24 @@ func maybeOutput(verbose *bool, output Output, format string, args ...interface{
 }
 
 func addDumpOptions(cmd *cli.Cmd, specOptions ...string) *dumpOptions {
-	cmd.Spec += "[--height=<state height to dump at>] [--binary]"
+	cmd.Spec += "[--height=<state height to dump at>] [--since=<base dump height>] " +
+		"[--binary | --format=<protobuf, json, csv, or canonical> | --canonical | --digest]"
 	for _, spec := range specOptions {
 		cmd.Spec += " " + spec
 	}
 	cmd.Spec += "[FILE]"
 	return &dumpOptions{
-		height:            cmd.IntOpt("h height", 0, "Block height to dump to, defaults to latest block height"),
+		height: cmd.IntOpt("h height", 0, "Block height to dump to, defaults to latest block height"),
+		since: cmd.IntOpt("since", 0, "Height of a previous dump to dump only the changes since, which restore "+
+			"applies on top of that dump"),
 		useBinaryEncoding: cmd.BoolOpt("b binary", false, "Output in binary encoding (default is JSON)"),
-		filename:          cmd.StringArg("FILE", "", "Location to output dump, if no argument is given then this streams to STDOUT"),
+		format: cmd.StringOpt("f format", "", "Output as protobuf (compact), json (a row per line), csv "+
+			"(a directory with a file for each kind of row), or canonical (see --canonical), defaults to json"),
+		canonical: cmd.BoolOpt("canonical", false, "Output only state in protobuf such that every node dumping the "+
+			"same state at the same height outputs the same bytes"),
+		digest: cmd.BoolOpt("digest", false, "Output only the SHA-256 digest of the canonical dump, to compare "+
+			"state with other nodes"),
+		filename: cmd.StringArg("FILE", "", "Location to output dump, if no argument is given then this streams to STDOUT"),
 	}
 }
 
@@ -55,16 +101,28 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 			dumpOpts := addDumpOptions(cmd, configFileSpec, genesisFileSpec)
 
 			cmd.Action = func() {
+				format, err := dumpOpts.dumpFormat()
+				if err != nil {
+					output.Fatalf("could not dump: %v", err)
+				}
 				conf, err := obtainDefaultConfig(*configFileOpt, *genesisFileOpt)
 				if err != nil {
 					output.Fatalf("could not obtain config: %v", err)
 				}
 
-				kern, err := core.NewKernel(conf.BurrowDir)
+				kern, err := core.NewKernel(conf.BurrowDir, conf.Backend())
 				if err != nil {
 					output.Fatalf("could not create burrow kernel: %v", err)
 				}
 
+				if err = kern.LoadEncryptionFromConfig(conf.DBEncryption); err != nil {
+					output.Fatalf("could not load database encryption: %v", err)
+				}
+
+				if err = kern.LoadColdStorageFromConfig(conf.ColdStorage); err != nil {
+					output.Fatalf("could not load cold storage: %v", err)
+				}
+
 				err = kern.LoadState(conf.GenesisDoc)
 				if err != nil {
 					output.Fatalf("could not load burrow state: %v", err)
@@ -76,10 +134,21 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("could not make logger: %v", err)
 				}
 
-				source := dump.NewDumper(kern.State, kern.Blockchain).WithLogger(logger).
-					Source(0, uint64(*dumpOpts.height), dump.All)
+				dumper := dump.NewDumper(kern.State, kern.Blockchain).WithLogger(logger)
+				options := dump.All
+				if format == dump.CanonicalFormat {
+					options = dump.State
+				}
+				source := dumper.Source(0, uint64(*dumpOpts.height), options)
+				if *dumpOpts.since != 0 {
+					source = dumper.IncrementalSource(uint64(*dumpOpts.since), uint64(*dumpOpts.height), options)
+				}
+				if *dumpOpts.digest {
+					outputDigest(source, output)
+					return
+				}
 
-				err = dumpToFile(*dumpOpts.filename, source, *dumpOpts.useBinaryEncoding)
+				err = dumpToFile(*dumpOpts.filename, source, format)
 				if err != nil {
 					output.Fatalf("could not dump to file %s': %v", *dumpOpts.filename, err)
 				}
@@ -87,12 +156,74 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("genesis", "export the state of the local chain at a height as the GenesisDoc of a new chain "+
+			"that carries on from it", func(cmd *cli.Cmd) {
+			configFileOpt := cmd.String(configFileOption)
+			genesisFileOpt := cmd.String(genesisFileOption)
+			heightOpt := cmd.IntOpt("h height", 0, "Block height to export the state at, defaults to latest block height")
+			chainNameOpt := cmd.StringOpt("n chain-name", "", "Name of the new chain, defaults to the name of this one")
+			fileArg := cmd.StringArg("FILE", "", "Location to write the GenesisDoc, if no argument is given then "+
+				"it is written to STDOUT")
+			cmd.Spec += configFileSpec + " " + genesisFileSpec + " [--height=<state height to export at>] " +
+				"[--chain-name=<chain name>] [FILE]"
+
+			cmd.Action = func() {
+				conf, err := obtainDefaultConfig(*configFileOpt, *genesisFileOpt)
+				if err != nil {
+					output.Fatalf("could not obtain config: %v", err)
+				}
+				kern, err := core.NewKernel(conf.BurrowDir, conf.Backend())
+				if err != nil {
+					output.Fatalf("could not create burrow kernel: %v", err)
+				}
+				if err = kern.LoadEncryptionFromConfig(conf.DBEncryption); err != nil {
+					output.Fatalf("could not load database encryption: %v", err)
+				}
+				if err = kern.LoadColdStorageFromConfig(conf.ColdStorage); err != nil {
+					output.Fatalf("could not load cold storage: %v", err)
+				}
+				err = kern.LoadState(conf.GenesisDoc)
+				if err != nil {
+					output.Fatalf("could not load burrow state: %v", err)
+				}
+
+				genesisDoc, err := dump.NewDumper(kern.State, kern.Blockchain).
+					GenesisDoc(conf.GenesisDoc, uint64(*heightOpt))
+				if err != nil {
+					output.Fatalf("could not export state: %v", err)
+				}
+				if *chainNameOpt != "" {
+					genesisDoc.ChainName = *chainNameOpt
+				}
+				if *fileArg == "" {
+					output.Printf("%s", genesisDoc.JSONString())
+					return
+				}
+				err = ioutil.WriteFile(*fileArg, []byte(genesisDoc.JSONString()), 0644)
+				if err != nil {
+					output.Fatalf("could not write GenesisDoc: %v", err)
+				}
+				output.Logf("Wrote GenesisDoc of %s with %d accounts, %d names, and %d validators to %s",
+					genesisDoc.ChainName, len(genesisDoc.Accounts), len(genesisDoc.Names), len(genesisDoc.Validators),
+					*fileArg)
+			}
+		})
+
 		cmd.Command("remote", "pull a dump from a remote Burrow node", func(cmd *cli.Cmd) {
 			chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
 			timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds")
-			dumpOpts := addDumpOptions(cmd, "[--chain=<chain GRPC address>]", "[--timeout=<GRPC timeout seconds>]")
+			resumeOpt := cmd.BoolOpt("r resume", false, "Resume the transfer of a dump partially written to FILE")
+			dumpOpts := addDumpOptions(cmd, "[--chain=<chain GRPC address>]", "[--timeout=<GRPC timeout seconds>]",
+				"[--resume]")
 
 			cmd.Action = func() {
+				if *resumeOpt && *dumpOpts.filename == "" {
+					output.Fatalf("a FILE is needed to resume a dump")
+				}
+				format, err := dumpOpts.dumpFormat()
+				if err != nil {
+					output.Fatalf("could not dump: %v", err)
+				}
 				maybeOutput(verbose, output, "dumping from remote chain at %s", *chainURLOpt)
 
 				ctx, cancel := context.WithCancel(context.Background())
@@ -118,13 +249,18 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 				}
 				maybeOutput(verbose, output, "dumping from chain: %s", string(stat))
 
-				dc := rpcdump.NewDumpClient(conn)
-				receiver, err := dc.GetDump(ctx, &rpcdump.GetDumpParam{Height: uint64(*dumpOpts.height)})
-				if err != nil {
-					output.Fatalf("failed to retrieve dump: %v", err)
+				if *dumpOpts.digest {
+					receiver, err := rpcdump.NewDumpClient(conn).GetDump(ctx,
+						&rpcdump.GetDumpParam{Height: uint64(*dumpOpts.height)})
+					if err != nil {
+						output.Fatalf("failed to retrieve dump: %v", err)
+					}
+					outputDigest(receiver, output)
+					return
 				}
 
-				err = dumpToFile(*dumpOpts.filename, receiver, *dumpOpts.useBinaryEncoding)
+				err = fetchDump(ctx, conn, *dumpOpts.filename, uint64(*dumpOpts.height), uint64(*dumpOpts.since),
+					format, *resumeOpt, output)
 				if err != nil {
 					output.Fatalf("could not dump to file %s': %v", *dumpOpts.filename, err)
 				}
@@ -135,7 +271,81 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 	}
 }
 
-func dumpToFile(filename string, source dump.Source, useBinaryEncoding bool) error {
+// Transfers the dump of the chain served on conn at height, or only the changes since baseHeight if it is not 0, into
+// filename, or if resume is set resumes the transfer of a dump partially written to filename from its last intact row
+func fetchDump(ctx context.Context, conn *grpc.ClientConn, filename string, height, baseHeight uint64,
+	format dump.Format, resume bool, output Output) error {
+	param := &rpcdump.GetDumpParam{Height: height, BaseHeight: baseHeight}
+	if format == dump.CSVFormat {
+		if resume {
+			return fmt.Errorf("transfers of CSV dumps cannot be resumed")
+		}
+		receiver, err := rpcdump.NewDumpClient(conn).GetDump(ctx, param)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve dump: %w", err)
+		}
+		return dump.WriteCSV(filename, receiver)
+	}
+	useBinaryEncoding := format == dump.ProtobufFormat || format == dump.CanonicalFormat
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if resume {
+		token, offset, binary, err := dump.ReadResumeToken(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if token != nil {
+			output.Logf("Resuming dump at height %d from row %d", token.Height, token.Index)
+			param.Resume = token
+			// Continue in the encoding the dump was started in
+			useBinaryEncoding = binary
+			err = os.Truncate(filename, offset)
+			if err != nil {
+				return err
+			}
+			flag = os.O_WRONLY | os.O_APPEND
+		}
+	}
+
+	receiver, err := rpcdump.NewDumpClient(conn).GetDump(ctx, param)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve dump: %w", err)
+	}
+	var source dump.Source = receiver
+	if format == dump.CanonicalFormat {
+		source = dump.Canonical(source)
+	}
+	if filename == "" {
+		return dump.Write(os.Stdout, source, useBinaryEncoding, dump.All)
+	}
+	file, err := os.OpenFile(filename, flag, 0644)
+	if err != nil {
+		return err
+	}
+	err = dump.Write(file, source, useBinaryEncoding, dump.All)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("%w, rerun with --resume to resume the transfer", err)
+	}
+	return file.Close()
+}
+
+// Prints the digest of the canonical dump of source
+func outputDigest(source dump.Source, output Output) {
+	height, digest, err := dump.Digest(source)
+	if err != nil {
+		output.Fatalf("could not compute digest of dump: %v", err)
+	}
+	output.Logf("Digest of state at height %d:", height)
+	output.Printf("%X", digest)
+}
+
+func dumpToFile(filename string, source dump.Source, format dump.Format) error {
+	if format == dump.CSVFormat {
+		return dump.WriteCSV(filename, source)
+	}
+	if format == dump.CanonicalFormat {
+		source = dump.Canonical(source)
+	}
 	var file *os.File
 	var err error
 	if filename == "" {
@@ -148,7 +358,7 @@ func dumpToFile(filename string, source dump.Source, useBinaryEncoding bool) err
 	}
 
 	// Receive
-	err = dump.Write(file, source, useBinaryEncoding, dump.All)
+	err = dump.Write(file, source, format == dump.ProtobufFormat || format == dump.CanonicalFormat, dump.All)
 	if err != nil {
 		return err
 	}
@@ -55,7 +55,7 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 			}
 
 			cmd.Action = func() {
-				replay := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir)
+				replay := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir, conf.Backend())
 				height := uint64(*heightOpt)
 				if height == 0 {
 					height, err = replay.LatestHeight()
@@ -89,11 +89,11 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 
 			cmd.Action = func() {
 				replay1 := forensics.NewReplay(
-					forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir),
+					forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir, conf.Backend()),
 					forensics.NewSourceFromGenesis(conf.GenesisDoc),
 				)
 				replay2 := forensics.NewReplay(
-					forensics.NewSourceFromDir(conf.GenesisDoc, *badDir),
+					forensics.NewSourceFromDir(conf.GenesisDoc, *badDir, conf.Backend()),
 					forensics.NewSourceFromGenesis(conf.GenesisDoc),
 				)
 
@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/rpc/rpcdump"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	cli "github.com/jawher/mow.cli"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The GenesisDoc of a fork is kept in its Burrow directory so that it can be resumed without the remote chain
+const forkGenesisFileName = "fork-genesis.json"
+
+// Fork boots a local node from the state of a remote chain at some height which then runs independently
+func Fork(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		remoteOpt := cmd.StringOpt("r remote", "127.0.0.1:10997", "chain to fork in IP:PORT format")
+		heightOpt := cmd.IntOpt("height", 0, "Block height to fork at, defaults to latest block height")
+		timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds for fetching the remote state")
+		chainNameOpt := cmd.StringOpt("n chain-name", "",
+			"Chain name of the fork, defaults to the remote chain ID suffixed with the fork height")
+
+		cmd.Spec = "[--remote=<remote GRPC address>] [--height=<height to fork at>] " +
+			"[--timeout=<GRPC timeout seconds>] [--chain-name=<chain name>]"
+
+		configOpts := addConfigOptions(cmd)
+
+		cmd.Action = func() {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+
+			if conf.GenesisDoc == nil {
+				output.Fatalf("no GenesisDoc provided, cannot fork chain")
+			}
+
+			// Run alone without consensus committing each transaction in its own block as soon as it is received
+			conf.Tendermint.Enabled = false
+			conf.Execution.TimeoutFactor = 0
+
+			if err := conf.Verify(); err != nil {
+				output.Fatalf("cannot continue with config: %v", err)
+			}
+
+			genesisFile := filepath.Join(conf.BurrowDir, forkGenesisFileName)
+			restoreFile := ""
+			if _, err := os.Stat(genesisFile); err == nil {
+				output.Logf("Resuming fork from %s", genesisFile)
+				conf.GenesisDoc, err = readGenesisDoc(genesisFile)
+				if err != nil {
+					output.Fatalf("could not read fork GenesisDoc: %v", err)
+				}
+			} else {
+				restoreFile, err = fetchFork(conf, *remoteOpt, uint64(*heightOpt), *chainNameOpt, *timeoutOpt, output)
+				if err != nil {
+					output.Fatalf("could not fork remote chain at %s: %v", *remoteOpt, err)
+				}
+			}
+
+			output.Logf("Using validator address: %s", *conf.ValidatorAddress)
+
+			kern, err := core.RestoreKernelFromConfig(conf, restoreFile)
+			if restoreFile != "" {
+				os.Remove(restoreFile)
+			}
+			if err != nil {
+				output.Fatalf("could not configure Burrow kernel: %v", err)
+			}
+
+			if restoreFile != "" {
+				genesisDocJSON, err := conf.GenesisDoc.JSONBytes()
+				if err != nil {
+					output.Fatalf("could not form GenesisDoc JSON: %v", err)
+				}
+				err = ioutil.WriteFile(genesisFile, genesisDocJSON, 0644)
+				if err != nil {
+					output.Fatalf("could not write fork GenesisDoc: %v", err)
+				}
+			}
+
+			if err = kern.Boot(); err != nil {
+				output.Fatalf("could not boot Burrow kernel: %v", err)
+			}
+
+			output.Logf("Forked chain %s running", conf.GenesisDoc.GetChainID())
+			kern.WaitForShutdown()
+		}
+	}
+}
+
+// Dumps the remote state at height to a temporary file and derives the GenesisDoc of the fork from it, which is
+// deterministic given the remote chain, height, and local GenesisDoc
+func fetchFork(conf *config.BurrowConfig, remote string, height uint64, chainName string, timeoutSeconds int,
+	output Output) (_ string, err error) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if timeoutSeconds != 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
+	}
+	defer cancel()
+
+	conn, err := encoding.GRPCDialContext(ctx, remote)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+
+	qCli := rpcquery.NewQueryClient(conn)
+	chainStatus, err := qCli.Status(ctx, &rpcquery.StatusParam{})
+	if err != nil {
+		return "", fmt.Errorf("could not get chain status: %w", err)
+	}
+	if height == 0 {
+		height = chainStatus.SyncInfo.LatestBlockHeight
+	}
+	header, err := qCli.GetBlockHeader(ctx, &rpcquery.GetBlockParam{Height: height})
+	if err != nil {
+		return "", fmt.Errorf("could not get block header at height %d: %w", height, err)
+	}
+
+	output.Logf("Forking chain %s at height %d", chainStatus.ChainID, height)
+
+	file, err := ioutil.TempFile("", "burrow-fork-*.dump")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(file.Name())
+		}
+	}()
+	err = file.Close()
+	if err != nil {
+		return "", err
+	}
+
+	receiver, err := rpcdump.NewDumpClient(conn).GetDump(ctx, &rpcdump.GetDumpParam{Height: height})
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve dump: %w", err)
+	}
+	err = dumpToFile(file.Name(), receiver, dump.ProtobufFormat)
+	if err != nil {
+		return "", fmt.Errorf("could not dump to file %s: %w", file.Name(), err)
+	}
+
+	// A distinct chain ID means transactions signed for the fork cannot be replayed on the remote chain and vice versa
+	if chainName == "" {
+		chainName = fmt.Sprintf("%s-fork-%d", chainStatus.ChainID, height)
+	}
+	conf.GenesisDoc.ChainName = chainName
+	conf.GenesisDoc.ChainID = ""
+	conf.GenesisDoc.GenesisTime = header.Time
+	conf.GenesisDoc.AppHash = nil
+
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), conf.GenesisDoc)
+	if err != nil {
+		return "", fmt.Errorf("could not generate state from genesis: %w", err)
+	}
+	reader, err := dump.NewFileReader(file.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read dump: %w", err)
+	}
+	err = dump.Load(reader, st)
+	if err != nil {
+		return "", fmt.Errorf("could not load dump: %w", err)
+	}
+	conf.GenesisDoc.AppHash = st.Hash()
+
+	// Round trip so the GenesisDoc is identical to the one read on resume
+	genesisDocJSON, err := conf.GenesisDoc.JSONBytes()
+	if err != nil {
+		return "", err
+	}
+	conf.GenesisDoc, err = genesis.GenesisDocFromJSON(genesisDocJSON)
+	if err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func readGenesisDoc(filename string) (*genesis.GenesisDoc, error) {
+	bs, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return genesis.GenesisDocFromJSON(bs)
+}
@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcdump"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+)
+
+func TestFetchFork(t *testing.T) {
+	genesisTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	alice := acm.GeneratePrivateAccountFromSecret("alice")
+	bob := acm.GeneratePrivateAccountFromSecret("bob")
+	remoteValidator := acm.GeneratePrivateAccountFromSecret("remote")
+	key := binary.LeftPadWord256([]byte{1})
+	word := func(b byte) []byte {
+		return binary.LeftPadWord256([]byte{b}).Bytes()
+	}
+
+	// A remote chain on which alice's storage changes and bob is created after the height we fork at
+	remoteGenesis := genesis.MakeGenesisDocFromAccounts("remote", nil, genesisTime,
+		map[string]*acm.Account{"alice": {Address: alice.GetAddress(), Balance: 100,
+			Permissions: permission.DefaultAccountPermissions}},
+		map[string]*validator.Validator{"remote": validator.FromAccount(acm.FromAddressable(remoteValidator), 1<<16)})
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), remoteGenesis)
+	require.NoError(t, err)
+	_, _, err = st.Update(func(up state.Updatable) error {
+		return up.SetStorage(alice.GetAddress(), key, word(1))
+	})
+	require.NoError(t, err)
+	acc, err := st.GetAccount(alice.GetAddress())
+	require.NoError(t, err)
+	acc.Balance = 90
+	_, forkVersion, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(acc)
+	})
+	require.NoError(t, err)
+	forkHeight := state.HeightAtVersion(forkVersion)
+	_, lastVersion, err := st.Update(func(up state.Updatable) error {
+		err := up.SetStorage(alice.GetAddress(), key, word(2))
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(&acm.Account{Address: bob.GetAddress(), Balance: 10})
+	})
+	require.NoError(t, err)
+	lastHeight := state.HeightAtVersion(lastVersion)
+	require.Greater(t, lastHeight, forkHeight)
+
+	blockchain := bcm.NewBlockchain(dbm.NewMemDB(), remoteGenesis)
+	for height := uint64(1); height <= lastHeight; height++ {
+		require.NoError(t, blockchain.CommitBlock(genesisTime.Add(time.Duration(height)*time.Minute),
+			[]byte{byte(height)}, []byte{byte(height)}))
+	}
+	blockTime := genesisTime.Add(time.Duration(forkHeight) * time.Minute)
+	remote := serveFork(t, &forkQueryServer{
+		chainID:    remoteGenesis.GetChainID(),
+		lastHeight: lastHeight,
+		blockTimes: map[uint64]time.Time{forkHeight: blockTime},
+	}, rpcdump.NewDumpServer(st, blockchain, logging.NewNoopLogger()))
+
+	// Our local chain has its own validator
+	localValidator := acm.GeneratePrivateAccountFromSecret("local")
+	dir, err := ioutil.TempDir("", "TestFetchFork")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	conf := config.DefaultBurrowConfig()
+	conf.BurrowDir = dir
+	conf.Tendermint.Enabled = false
+	conf.GenesisDoc = genesis.MakeGenesisDocFromAccounts("local", nil, genesisTime, nil,
+		map[string]*validator.Validator{"local": validator.FromAccount(acm.FromAddressable(localValidator), 1<<16)})
+
+	restoreFile, err := fetchFork(conf, remote, forkHeight, "", 5, testOutput{t})
+	require.NoError(t, err)
+	defer os.Remove(restoreFile)
+
+	// The fork is a distinct chain starting from the time of the block we forked at
+	assert.Equal(t, fmt.Sprintf("%s-fork-%d", remoteGenesis.GetChainID(), forkHeight), conf.GenesisDoc.ChainName)
+	assert.NotEqual(t, remoteGenesis.GetChainID(), conf.GenesisDoc.GetChainID())
+	assert.True(t, blockTime.Equal(conf.GenesisDoc.GenesisTime))
+	require.Len(t, conf.GenesisDoc.Validators, 1)
+	assert.Equal(t, localValidator.GetAddress(), conf.GenesisDoc.Validators[0].Address)
+	require.NotEmpty(t, conf.GenesisDoc.AppHash)
+
+	// The GenesisDoc is deterministic given the remote chain and height so the same fork may be made elsewhere
+	again := *conf
+	again.GenesisDoc = genesis.MakeGenesisDocFromAccounts("local", nil, genesisTime, nil,
+		map[string]*validator.Validator{"local": validator.FromAccount(acm.FromAddressable(localValidator), 1<<16)})
+	againFile, err := fetchFork(&again, remote, forkHeight, "", 5, testOutput{t})
+	require.NoError(t, err)
+	defer os.Remove(againFile)
+	assert.Equal(t, conf.GenesisDoc.GetChainID(), again.GenesisDoc.GetChainID())
+	assert.Equal(t, conf.GenesisDoc.AppHash, again.GenesisDoc.AppHash)
+
+	// Restoring from the fork gives the remote state as it was at the height forked at
+	kern, err := core.NewMemoryKernel()
+	require.NoError(t, err)
+	require.NoError(t, kern.LoadDump(conf.GenesisDoc, []string{restoreFile}, true))
+	require.NoError(t, kern.LoadState(conf.GenesisDoc))
+	acc, err = kern.State.GetAccount(alice.GetAddress())
+	require.NoError(t, err)
+	require.NotNil(t, acc)
+	assert.Equal(t, uint64(90), acc.Balance)
+	value, err := kern.State.GetStorage(alice.GetAddress(), key)
+	require.NoError(t, err)
+	assert.Equal(t, word(1), value)
+	acc, err = kern.State.GetAccount(bob.GetAddress())
+	require.NoError(t, err)
+	assert.Nil(t, acc)
+	assert.Equal(t, []byte(conf.GenesisDoc.AppHash), kern.State.Hash())
+}
+
+// Serves just enough of the query service for a fork to be fetched
+type forkQueryServer struct {
+	rpcquery.UnimplementedQueryServer
+	chainID    string
+	lastHeight uint64
+	blockTimes map[uint64]time.Time
+}
+
+func (qs *forkQueryServer) Status(context.Context, *rpcquery.StatusParam) (*rpc.ResultStatus, error) {
+	return &rpc.ResultStatus{
+		ChainID:  qs.chainID,
+		SyncInfo: &bcm.SyncInfo{LatestBlockHeight: qs.lastHeight},
+	}, nil
+}
+
+func (qs *forkQueryServer) GetBlockHeader(_ context.Context, param *rpcquery.GetBlockParam) (*tmproto.Header, error) {
+	blockTime, ok := qs.blockTimes[param.Height]
+	if !ok {
+		return nil, fmt.Errorf("no block at height %d", param.Height)
+	}
+	return &tmproto.Header{ChainID: qs.chainID, Height: int64(param.Height), Time: blockTime}, nil
+}
+
+// Serves the query and dump services returning their address
+func serveFork(t *testing.T, qs rpcquery.QueryServer, ds rpcdump.DumpServer) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	rpcquery.RegisterQueryServer(server, qs)
+	rpcdump.RegisterDumpServer(server, ds)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+type testOutput struct {
+	t *testing.T
+}
+
+func (out testOutput) Printf(format string, args ...interface{}) {
+	out.t.Logf(format, args...)
+}
+
+func (out testOutput) Logf(format string, args ...interface{}) {
+	out.t.Logf(format, args...)
+}
+
+func (out testOutput) Fatalf(format string, args ...interface{}) {
+	out.t.Fatalf(format, args...)
+}
@@ -40,6 +40,11 @@ func obtainDefaultConfig(configFile, genesisDocFile string) (*config.BurrowConfi
 	if conf.Logging == nil {
 		conf.Logging = logging_config.DefaultNodeLoggingConfig()
 	}
+	// Environment variables for single fields override the config from files but not command line options
+	err = source.EnvironmentOverrides(config.DefaultBurrowConfigEnvironmentPrefix).Apply(conf)
+	if err != nil {
+		return nil, err
+	}
 	return conf, nil
 }
 
@@ -50,6 +55,7 @@ func burrowConfigProvider(configFile string) source.ConfigProvider {
 		source.Environment(config.DefaultBurrowConfigEnvironmentVariable),
 		// Try working directory
 		source.File(config.DefaultBurrowConfigTOMLFileName, true),
+		source.File(config.DefaultBurrowConfigYAMLFileName, true),
 		source.Default(config.DefaultBurrowConfig()))
 }
 
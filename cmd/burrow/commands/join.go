@@ -0,0 +1,247 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Join configures a node to join a running chain from one of its nodes and starts it
+func Join(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		peerOpt := cmd.StringOpt("peer", "", "GRPC address of a node of the chain to join in IP:PORT format")
+		genesisHashOpt := cmd.StringOpt("genesis-hash", "",
+			"hash in hex of the chain's GenesisDoc, obtained from someone you trust, to check the GenesisDoc we fetch")
+		stateSyncOpt := cmd.BoolOpt("state-sync", false,
+			"restore the latest snapshot served by peers rather than replaying every block since genesis")
+		rpcOpt := cmd.StringsOpt("rpc", nil, "Tendermint RPC address of a node to verify snapshots against, "+
+			"give more than once to cross-check, defaults to that of the peer")
+		configOutOpt := cmd.StringOpt("config-out", config.DefaultBurrowConfigTOMLFileName,
+			"file to write the config of the joining node to, as YAML if it ends .yaml")
+		noStartOpt := cmd.BoolOpt("no-start", false, "write the config but do not start the node")
+		timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds for fetching from the peer")
+
+		cmd.Spec = "--peer=<remote GRPC address> [--genesis-hash=<hash>] [--state-sync] " +
+			"[--rpc=<Tendermint RPC address>...] [--config-out=<output file>] [--no-start] " +
+			"[--timeout=<GRPC timeout seconds>]"
+
+		configOpts := addConfigOptions(cmd)
+
+		cmd.Action = func() {
+			if _, err := os.Stat(*configOutOpt); err == nil {
+				output.Fatalf("%s already exists, run burrow start to start a node from it", *configOutOpt)
+			}
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if *timeoutOpt != 0 {
+				ctx, cancel = context.WithTimeout(context.Background(), time.Duration(*timeoutOpt)*time.Second)
+			}
+			defer cancel()
+
+			err = configureJoin(ctx, conf, *peerOpt, *genesisHashOpt, *stateSyncOpt, *rpcOpt, output)
+			if err != nil {
+				output.Fatalf("could not join chain from %s: %v", *peerOpt, err)
+			}
+			configString := conf.TOMLString()
+			if source.DetectFileFormat(*configOutOpt, "") == source.YAML {
+				configString = conf.YAMLString()
+			}
+			err = ioutil.WriteFile(*configOutOpt, []byte(configString), 0644)
+			if err != nil {
+				output.Fatalf("could not write config: %v", err)
+			}
+			output.Logf("Wrote config to %s", *configOutOpt)
+			if *noStartOpt {
+				return
+			}
+
+			if err := conf.Verify(); err != nil {
+				output.Fatalf("cannot continue with config: %v", err)
+			}
+			output.Logf("Using validator address: %s", *conf.ValidatorAddress)
+
+			kern, err := core.LoadKernelFromConfig(conf)
+			if err != nil {
+				output.Fatalf("could not configure Burrow kernel: %v", err)
+			}
+			if err = kern.Boot(); err != nil {
+				output.Fatalf("could not boot Burrow kernel: %v", err)
+			}
+			output.Logf("Joined chain %s", conf.GenesisDoc.GetChainID())
+			kern.WaitForShutdown()
+		}
+	}
+}
+
+// Fetches and checks the GenesisDoc of the peer's chain and sets conf up to connect to the peer, and optionally to
+// state sync from the peer's latest block
+func configureJoin(ctx context.Context, conf *config.BurrowConfig, peer, genesisHash string, stateSync bool,
+	rpcServers []string, output Output) error {
+
+	peerHost, _, err := net.SplitHostPort(peer)
+	if err != nil {
+		return fmt.Errorf("could not parse peer address: %w", err)
+	}
+	conn, err := encoding.GRPCDialContext(ctx, peer)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+
+	qCli := rpcquery.NewQueryClient(conn)
+	chainStatus, err := qCli.Status(ctx, &rpcquery.StatusParam{})
+	if err != nil {
+		return fmt.Errorf("could not get chain status: %w", err)
+	}
+	if chainStatus.NodeInfo == nil {
+		return fmt.Errorf("peer is not running Tendermint so cannot be joined")
+	}
+
+	// The hash of the GenesisDoc determines its chain ID and so every signature on the chain
+	gen, err := qCli.GetGenesis(ctx, &rpcquery.GetGenesisParam{})
+	if err != nil {
+		return fmt.Errorf("could not get GenesisDoc: %w", err)
+	}
+	hash := sha256.Sum256(gen.JSON)
+	if !bytes.Equal(hash[:], chainStatus.GenesisHash) {
+		return fmt.Errorf("GenesisDoc has hash %X but peer reports GenesisHash %v", hash, chainStatus.GenesisHash)
+	}
+	if genesisHash != "" {
+		expected, err := hex.DecodeString(genesisHash)
+		if err != nil {
+			return fmt.Errorf("could not decode genesis hash: %w", err)
+		}
+		if !bytes.Equal(hash[:], expected) {
+			return fmt.Errorf("GenesisDoc has hash %X but %X was expected", hash, expected)
+		}
+	} else {
+		output.Logf("Check with someone you trust that the GenesisDoc of chain %s has hash %X or pass "+
+			"--genesis-hash to check it", chainStatus.ChainID, hash)
+	}
+	conf.GenesisDoc, err = genesis.GenesisDocFromJSON(gen.JSON)
+	if err != nil {
+		return fmt.Errorf("could not read GenesisDoc: %w", err)
+	}
+	if !bytes.Equal(conf.GenesisDoc.Hash(), hash[:]) {
+		return fmt.Errorf("GenesisDoc does not have the same hash once read, it may be from an incompatible " +
+			"version of Burrow")
+	}
+
+	if conf.ValidatorAddress == nil {
+		address, err := generateKey(conf)
+		if err != nil {
+			return fmt.Errorf("could not generate key for node: %w", err)
+		}
+		output.Logf("Generated key with address %v", address)
+		conf.ValidatorAddress = &address
+	}
+	if conf.Tendermint.Moniker == "" {
+		conf.Tendermint.Moniker = fmt.Sprintf("%s_Node_%s", conf.GenesisDoc.GetChainID(), conf.ValidatorAddress)
+	}
+
+	p2pAddress, err := peerAddress(chainStatus.NodeInfo.ListenAddress, peerHost)
+	if err != nil {
+		return fmt.Errorf("could not read P2P address of peer: %w", err)
+	}
+	persistentPeer := fmt.Sprintf("%s@%s", strings.ToLower(chainStatus.NodeInfo.ID.String()), p2pAddress)
+	if conf.Tendermint.PersistentPeers == "" {
+		conf.Tendermint.PersistentPeers = persistentPeer
+	} else {
+		conf.Tendermint.PersistentPeers += "," + persistentPeer
+	}
+
+	if !stateSync {
+		return nil
+	}
+	if len(rpcServers) == 0 {
+		if chainStatus.NodeInfo.RPCAddress == "" {
+			return fmt.Errorf("peer does not serve Tendermint RPC for state sync, pass --rpc")
+		}
+		rpcAddress, err := peerAddress(chainStatus.NodeInfo.RPCAddress, peerHost)
+		if err != nil {
+			return fmt.Errorf("could not read Tendermint RPC address of peer: %w", err)
+		}
+		rpcServers = []string{rpcAddress}
+	}
+	// Tendermint requires a witness, which may be the same server
+	if len(rpcServers) == 1 {
+		rpcServers = append(rpcServers, rpcServers[0])
+	}
+	// The peer's latest header is trusted to start the light client that verifies the snapshot
+	height := chainStatus.SyncInfo.LatestBlockHeight
+	header, err := qCli.GetBlockHeader(ctx, &rpcquery.GetBlockParam{Height: height})
+	if err != nil {
+		return fmt.Errorf("could not get block header at height %d: %w", height, err)
+	}
+	tmHeader, err := types.HeaderFromProto(header)
+	if err != nil {
+		return fmt.Errorf("could not read block header at height %d: %w", height, err)
+	}
+	conf.Tendermint.StateSync = true
+	conf.Tendermint.StateSyncRPCServers = strings.Join(rpcServers, ",")
+	conf.Tendermint.StateSyncTrustHeight = int64(height)
+	conf.Tendermint.StateSyncTrustHash = tmHeader.Hash().String()
+	output.Logf("Will state sync from block %d with hash %v", height, tmHeader.Hash())
+	return nil
+}
+
+// Generates a key for the node in the configured key store
+func generateKey(conf *config.BurrowConfig) (crypto.Address, error) {
+	var keyClient keys.KeyClient
+	if conf.Keys.RemoteAddress != "" {
+		var err error
+		keyClient, err = keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, logging.NewNoopLogger())
+		if err != nil {
+			return crypto.Address{}, err
+		}
+	} else {
+		keyStore := keys.NewFilesystemKeyStore(conf.Keys.KeysDirectory, conf.Keys.AllowBadFilePermissions)
+		keyClient = keys.NewLocalKeyClient(keyStore, logging.NewNoopLogger())
+	}
+	return keyClient.Generate("", crypto.CurveTypeEd25519)
+}
+
+// Nodes advertise the addresses they listen on, which we reach on the peer's host if they listen on all interfaces
+func peerAddress(address, peerHost string) (string, error) {
+	scheme := ""
+	if strings.Contains(address, "://") {
+		u, err := url.Parse(address)
+		if err != nil {
+			return "", err
+		}
+		scheme = u.Scheme + "://"
+		address = u.Host
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", err
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		host = peerHost
+	}
+	return scheme + net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerAddress(t *testing.T) {
+	address, err := peerAddress("0.0.0.0:26656", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:26656", address)
+
+	address, err = peerAddress("tcp://0.0.0.0:26657", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "tcp://10.0.0.1:26657", address)
+
+	address, err = peerAddress("node.example.com:26656", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "node.example.com:26656", address)
+
+	_, err = peerAddress("26656", "10.0.0.1")
+	require.Error(t, err)
+}
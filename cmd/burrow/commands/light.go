@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"time"
+
+	tmlight "github.com/cometbft/cometbft/light"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/light"
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Light runs a local query API that verifies the responses of remote nodes from a trusted header
+func Light(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainOpt := cmd.StringsOpt("c chain", []string{"127.0.0.1:10997"},
+			"chain to query in IP:PORT format, give more than once to cross-check headers with the others")
+		listenOpt := cmd.StringOpt("l listen", "127.0.0.1:10998", "address to serve the verified query API on")
+		trustHeightOpt := cmd.IntOpt("trust-height", 0, "height of a header we trust")
+		trustHashOpt := cmd.StringOpt("trust-hash", "", "hash in hex of the header we trust")
+		trustingPeriodOpt := cmd.StringOpt("trusting-period", "168h",
+			"how long we trust a validator set after its header, which must be less than the unbonding period")
+
+		cmd.Spec = "[--chain=<remote GRPC address>...] [--listen=<GRPC address>] --trust-height=<height> " +
+			"--trust-hash=<hash> [--trusting-period=<duration>]"
+
+		cmd.Action = func() {
+			trustHash, err := hex.DecodeString(*trustHashOpt)
+			if err != nil {
+				output.Fatalf("could not decode trust hash: %v", err)
+			}
+			trustingPeriod, err := time.ParseDuration(*trustingPeriodOpt)
+			if err != nil {
+				output.Fatalf("could not parse trusting period: %v", err)
+			}
+			logger, err := logconfig.New().Logger()
+			if err != nil {
+				output.Fatalf("could not build logger: %v", err)
+			}
+
+			ctx := context.Background()
+			conn, err := encoding.GRPCDialContext(ctx, (*chainOpt)[0])
+			if err != nil {
+				output.Fatalf("could not connect to %s: %v", (*chainOpt)[0], err)
+			}
+			// The chain ID is checked against our trusted header so we can take it from the node
+			status, err := rpcquery.NewQueryClient(conn).Status(ctx, &rpcquery.StatusParam{})
+			conn.Close()
+			if err != nil {
+				output.Fatalf("could not get status of %s: %v", (*chainOpt)[0], err)
+			}
+
+			client, err := light.NewClient(ctx, status.ChainID, tmlight.TrustOptions{
+				Period: trustingPeriod,
+				Height: int64(*trustHeightOpt),
+				Hash:   trustHash,
+			}, *chainOpt, logger)
+			if err != nil {
+				output.Fatalf("could not start light client: %v", err)
+			}
+			defer client.Close()
+
+			listener, err := net.Listen("tcp", *listenOpt)
+			if err != nil {
+				output.Fatalf("could not listen on %s: %v", *listenOpt, err)
+			}
+			grpcServer := rpc.NewGRPCServer(logger)
+			rpcquery.RegisterQueryServer(grpcServer, light.NewProxy(client))
+			output.Logf("Serving verified queries of chain %s on %s", status.ChainID, listener.Addr())
+			err = grpcServer.Serve(listener)
+			if err != nil {
+				output.Fatalf("light client proxy terminated with error: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"github.com/hyperledger/burrow/core"
+	cli "github.com/jawher/mow.cli"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Migrate moves a stopped node's databases to another key-value store backend, or encrypts them
+func Migrate(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpts := addConfigOptions(cmd)
+		backendOpt := cmd.StringOpt("b backend", "", "Backend to migrate to, one of goleveldb, badgerdb or pebbledb")
+		encryptOpt := cmd.BoolOpt("encrypt", false, "Encrypt the databases with the key configured in DBEncryption")
+		cmd.Spec += "[--backend=<backend to migrate to>] [--encrypt]"
+
+		cmd.Action = func() {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+
+			tmConf, err := conf.TendermintConfig()
+			if err != nil {
+				output.Fatalf("could not build Tendermint config: %v", err)
+			}
+
+			from := conf.Backend()
+			to := dbm.BackendType(*backendOpt)
+			if to == "" {
+				if !*encryptOpt {
+					output.Fatalf("--backend or --encrypt is needed to migrate")
+				}
+				to = from
+			}
+			var key []byte
+			if *encryptOpt {
+				if !conf.DBEncryption.Enabled() {
+					output.Fatalf("DBEncryption must be configured with a key to encrypt with")
+				}
+				if conf.ColdStorage.Enabled() {
+					output.Fatalf("cannot encrypt the databases of a node with cold storage since what has been " +
+						"moved there would be left unencrypted")
+				}
+				key, err = conf.DBEncryption.Key()
+				if err != nil {
+					output.Fatalf("could not read database key: %v", err)
+				}
+			}
+			migrated, err := core.MigrateDBs(conf.BurrowDir, tmConf, from, to, key)
+			for _, name := range migrated {
+				output.Logf("Migrated %s from %s to %s", name, from, to)
+			}
+			if err != nil {
+				output.Fatalf("could not migrate: %v", err)
+			}
+			if len(migrated) == 0 {
+				output.Fatalf("no %s databases found to migrate", from)
+			}
+			if to != from {
+				output.Logf("Set DBBackend = \"%s\" in your config before starting the node", to)
+			}
+			output.Logf("The original %s databases have been kept in %s-backup directories and can be deleted once "+
+				"the node is running", from, from)
+		}
+	}
+}
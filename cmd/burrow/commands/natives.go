@@ -16,6 +16,7 @@ import (
 func Natives(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		contractsOpt := cmd.StringsOpt("c contracts", nil, "Contracts to generate")
+		abiOpt := cmd.BoolOpt("a abi", false, "Output the JSON ABI of contracts rather than Solidity interfaces")
 		cmd.Action = func() {
 			callables := native.MustDefaultNatives().Callables()
 			// Index of next contract
@@ -39,6 +40,14 @@ func Natives(output Output) func(cmd *cli.Cmd) {
 						continue
 					}
 				}
+				if *abiOpt {
+					abiJSON, err := contract.ABI()
+					if err != nil {
+						output.Fatalf("Error generating ABI for contract %s: %v", contract.Name, err)
+					}
+					fmt.Println(string(abiJSON))
+					continue
+				}
 				solidity, err := templates.NewSolidityContract(contract).Solidity()
 				if err != nil {
 					fmt.Printf("Error generating solidity for contract %s: %s\n",
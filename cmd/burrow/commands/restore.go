@@ -1,7 +1,12 @@
 package commands
 
 import (
+	"context"
+	"time"
+
 	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/encoding"
 	cli "github.com/jawher/mow.cli"
 )
 
@@ -10,8 +15,14 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		configOpts := addConfigOptions(cmd)
 		silentOpt := cmd.BoolOpt("s silent", false, "If state already exists don't throw error")
-		filename := cmd.StringArg("FILE", "", "Restore from this dump")
-		cmd.Spec += "[--silent] [FILE]"
+		chainOpt := cmd.StringOpt("chain", "", "GRPC address of a node in IP:PORT format to transfer the dump "+
+			"into FILE from first, resuming any transfer already partially written to FILE")
+		heightOpt := cmd.IntOpt("h height", 0, "Block height of the dump to transfer, defaults to latest")
+		timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds for transferring the dump")
+		filenames := cmd.StringsArg("FILE", nil, "Restore from this dump, followed by any incremental dumps "+
+			"made since it, in order")
+		cmd.Spec += "[--silent] [--chain=<chain GRPC address> [--height=<dump height>] [--timeout=<GRPC timeout " +
+			"seconds>]] [FILE...]"
 
 		cmd.Action = func() {
 			conf, err := configOpts.obtainBurrowConfig()
@@ -25,16 +36,42 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 
 			output.Logf("Using validator address: %s", *conf.ValidatorAddress)
 
-			kern, err := core.NewKernel(conf.BurrowDir)
+			kern, err := core.NewKernel(conf.BurrowDir, conf.Backend())
 			if err != nil {
 				output.Fatalf("could not create Burrow kernel: %w", err)
 			}
 
+			if err = kern.LoadEncryptionFromConfig(conf.DBEncryption); err != nil {
+				output.Fatalf("could not load database encryption: %w", err)
+			}
+
 			if err = kern.LoadLoggerFromConfig(conf.Logging); err != nil {
 				output.Fatalf("could not load logger: %w", err)
 			}
 
-			if err = kern.LoadDump(conf.GenesisDoc, *filename, *silentOpt); err != nil {
+			if *chainOpt != "" {
+				if len(*filenames) != 1 {
+					output.Fatalf("a single FILE is needed to transfer the dump into")
+				}
+				filename := (*filenames)[0]
+				ctx, cancel := context.WithCancel(context.Background())
+				if *timeoutOpt != 0 {
+					ctx, cancel = context.WithTimeout(context.Background(), time.Duration(*timeoutOpt)*time.Second)
+				}
+				defer cancel()
+				conn, err := encoding.GRPCDialContext(ctx, *chainOpt)
+				if err != nil {
+					output.Fatalf("failed to connect: %v", err)
+				}
+				err = fetchDump(ctx, conn, filename, uint64(*heightOpt), 0, dump.ProtobufFormat, true, output)
+				if err != nil {
+					output.Fatalf("could not transfer dump to %s: %v", filename, err)
+				}
+				conn.Close()
+				output.Logf("Transferred dump to %s", filename)
+			}
+
+			if err = kern.LoadDump(conf.GenesisDoc, *filenames, *silentOpt); err != nil {
 				output.Fatalf("could not load dump: %v", err)
 			}
 
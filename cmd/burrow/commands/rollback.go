@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"github.com/hyperledger/burrow/core"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Rollback rolls a stopped node's state back some blocks
+func Rollback(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpts := addConfigOptions(cmd)
+		heightOpt := cmd.IntOpt("height", 0, "Height to roll back to so that it is the last block committed")
+		blocksOpt := cmd.IntOpt("n blocks", 1, "Number of blocks to roll back if no height is given")
+		cmd.Spec += "[--height=<height to roll back to> | --blocks=<number of blocks>]"
+
+		cmd.Action = func() {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+
+			if conf.GenesisDoc == nil {
+				output.Fatalf("no GenesisDoc provided, cannot roll back")
+			}
+
+			tmConf, err := conf.TendermintConfig()
+			if err != nil {
+				output.Fatalf("could not build Tendermint config: %v", err)
+			}
+
+			kern, err := core.NewKernel(conf.BurrowDir, conf.Backend())
+			if err != nil {
+				output.Fatalf("could not create Burrow kernel: %v", err)
+			}
+
+			if err = kern.LoadEncryptionFromConfig(conf.DBEncryption); err != nil {
+				output.Fatalf("could not load database encryption: %v", err)
+			}
+
+			if err = kern.LoadColdStorageFromConfig(conf.ColdStorage); err != nil {
+				output.Fatalf("could not load cold storage: %v", err)
+			}
+
+			if err = kern.LoadLoggerFromConfig(conf.Logging); err != nil {
+				output.Fatalf("could not load logger: %v", err)
+			}
+
+			if *blocksOpt < 0 {
+				output.Fatalf("cannot roll back %d blocks", *blocksOpt)
+			}
+
+			height, err := kern.Rollback(conf.GenesisDoc, tmConf, uint64(*heightOpt), uint64(*blocksOpt))
+			if err != nil {
+				output.Fatalf("could not roll back: %v", err)
+			}
+
+			output.Logf("Rolled back to height %d, block %d will be executed again on start", height, height+1)
+			kern.ShutdownAndExit()
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/genesis/spec"
 	cli "github.com/jawher/mow.cli"
 )
@@ -18,6 +20,9 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 			"additional GenesisSpec presets specified by other flags will be merged. GenesisSpecs appearing "+
 			"later take precedent over those appearing early if multiple --base flags are provided")
 
+		accountsOpt := cmd.StringsOpt("a accounts", nil, "Add the accounts listed in a CSV file (with a header row) "+
+			"or a YAML or JSON file, each with any of the fields Name, Address, PublicKey, Curve, Balance, Power, "+
+			"Permissions, and Roles. May be given more than once")
 		accountNamePrefixOpt := cmd.StringOpt("x name-prefix", "", "Prefix added to the names of accounts in GenesisSpec")
 		fullOpt := cmd.IntOpt("f full-accounts", 0, "Number of preset Full type accounts")
 		validatorOpt := cmd.IntOpt("v validator-accounts", 0, "Number of preset Validator type accounts")
@@ -26,9 +31,59 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		participantsOpt := cmd.IntOpt("p participant-accounts", 0, "Number of preset Participant type accounts")
 		chainNameOpt := cmd.StringOpt("n chain-name", "", "Default chain name")
 		proposalThresholdOpt := cmd.IntOpt("param-proposalthreshold", 3, "Number of votes required for a proposal to pass")
+		mnemonicOpt := cmd.StringOpt("m mnemonic", "", "BIP-39 mnemonic from which burrow configure derives the "+
+			"keys of accounts that have none (other than validators), so that the same spec gives the same accounts")
+		newMnemonicOpt := cmd.BoolOpt("new-mnemonic", false, "Generate a mnemonic as for --mnemonic, printing it to "+
+			"stderr")
+		derivationPathOpt := cmd.StringOpt("derivation-path", "", "BIP-32 path under which accounts are derived "+
+			"from the mnemonic, the nth at PATH/n (default "+crypto.DefaultDerivationPath+")")
 
-		cmd.Spec = "[--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
-			"[--developer-accounts] [--participant-accounts] [--chain-name] [--toml] [BASE...]"
+		cmd.Spec = "[--accounts=<account list file>...] [--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
+			"[--developer-accounts] [--participant-accounts] [--chain-name] [--mnemonic=<words> | --new-mnemonic] " +
+			"[--derivation-path=<path>] [--toml] [BASE...]"
+
+		cmd.Command("merge", "Deep-merge layers of partial GenesisSpecs (or GenesisDocs with --genesis), such as "+
+			"a base, an overlay for an environment, and a directory of fragments one for each member, failing if "+
+			"fragments of the same layer disagree",
+			func(cmd *cli.Cmd) {
+				layersArg := cmd.StringsArg("LAYER", nil, "A fragment file, or a directory of .json and .toml "+
+					"fragments that together form one layer. Later layers may override values from earlier ones")
+				genesisOpt := cmd.BoolOpt("g genesis", false, "Merge fragments of a GenesisDoc rather than a GenesisSpec")
+				mergeTOMLOpt := cmd.BoolOpt("t toml", false, "Emit the result as TOML rather than the default JSON")
+
+				cmd.Spec = "[--genesis] [--toml] LAYER..."
+
+				cmd.Action = func() {
+					layers := make([][]*spec.Fragment, len(*layersArg))
+					for i, path := range *layersArg {
+						layer, err := spec.LayerFromPath(path)
+						if err != nil {
+							output.Fatalf("could not read layer: %v", err)
+						}
+						layers[i] = layer
+					}
+					merge, err := spec.MergeLayers(layers...)
+					if err != nil {
+						output.Fatalf("%v", err)
+					}
+					for _, override := range merge.Overrides {
+						output.Logf("Overriding %s", override)
+					}
+					var merged interface{} = new(spec.GenesisSpec)
+					if *genesisOpt {
+						merged = new(genesis.GenesisDoc)
+					}
+					err = merge.Decode(merged)
+					if err != nil {
+						output.Fatalf("could not decode merged fragments: %v", err)
+					}
+					if *mergeTOMLOpt {
+						output.Printf(source.TOMLString(merged))
+					} else {
+						output.Printf(source.JSONString(merged))
+					}
+				}
+			})
 
 		cmd.Action = func() {
 			specs := make([]spec.GenesisSpec, 0, *participantsOpt+*fullOpt)
@@ -40,6 +95,13 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				}
 				specs = append(specs, *genesisSpec)
 			}
+			for _, accountList := range *accountsOpt {
+				accounts, err := spec.AccountsFromFile(accountList)
+				if err != nil {
+					output.Fatalf("could not read account list: %v", err)
+				}
+				specs = append(specs, spec.GenesisSpec{Accounts: accounts})
+			}
 			for i := 0; i < *fullOpt; i++ {
 				specs = append(specs, spec.FullAccount(fmt.Sprintf("%sFull_%v", *accountNamePrefixOpt, i)))
 			}
@@ -60,6 +122,25 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				genesisSpec.ChainName = *chainNameOpt
 			}
 			genesisSpec.Params.ProposalThreshold = uint64(*proposalThresholdOpt)
+			if *newMnemonicOpt {
+				mnemonic, err := crypto.NewMnemonic()
+				if err != nil {
+					output.Fatalf("could not generate mnemonic: %v", err)
+				}
+				output.Logf("Accounts will be derived from the mnemonic: %s", mnemonic)
+				genesisSpec.Mnemonic = mnemonic
+			} else if *mnemonicOpt != "" {
+				genesisSpec.Mnemonic = *mnemonicOpt
+			}
+			if *derivationPathOpt != "" {
+				genesisSpec.DerivationPath = *derivationPathOpt
+			}
+			if genesisSpec.Mnemonic != "" {
+				err := validateMnemonic(genesisSpec.Mnemonic, genesisSpec.DerivationPath)
+				if err != nil {
+					output.Fatalf("%v", err)
+				}
+			}
 			if *tomlOpt {
 				output.Printf(source.TOMLString(genesisSpec))
 			} else {
@@ -68,3 +149,12 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		}
 	}
 }
+
+// Checks a mnemonic and path can derive keys before anyone tries to configure a chain with them
+func validateMnemonic(mnemonic, path string) error {
+	if path == "" {
+		path = crypto.DefaultDerivationPath
+	}
+	_, err := crypto.PrivateKeyFromMnemonic(mnemonic, path+"/0")
+	return err
+}
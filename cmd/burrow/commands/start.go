@@ -2,6 +2,7 @@ package commands
 
 import (
 	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/logging/logconfig"
 	cli "github.com/jawher/mow.cli"
 )
 
@@ -9,6 +10,22 @@ import (
 func Start(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		configOpts := addConfigOptions(cmd)
+		haltHeightOpt := cmd.Int(cli.IntOpt{
+			Name:   "halt-height",
+			Desc:   "Shut down after committing the block at this height and refuse to process later blocks",
+			EnvVar: "BURROW_HALT_HEIGHT",
+		})
+		haltTimeOpt := cmd.String(cli.StringOpt{
+			Name:   "halt-time",
+			Desc:   "Shut down after committing the first block at or after this RFC3339 time and refuse to process later blocks",
+			EnvVar: "BURROW_HALT_TIME",
+		})
+		readOnlyOpt := cmd.Bool(cli.BoolOpt{
+			Name:   "read-only",
+			Desc:   "Follow the chain to serve queries without taking part in consensus or accepting transactions",
+			EnvVar: "BURROW_READ_ONLY",
+		})
+		cmd.Spec += " [--halt-height=<height>] [--halt-time=<time>] [--read-only]"
 
 		cmd.Action = func() {
 			conf, err := configOpts.obtainBurrowConfig()
@@ -16,17 +33,49 @@ func Start(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("could not set up config: %v", err)
 			}
 
+			if *haltHeightOpt > 0 || *haltTimeOpt != "" {
+				if conf.Tendermint == nil || !conf.Tendermint.Enabled {
+					output.Fatalf("cannot halt without Tendermint enabled")
+				}
+				if *haltHeightOpt > 0 {
+					conf.Tendermint.HaltHeight = uint64(*haltHeightOpt)
+				}
+				if *haltTimeOpt != "" {
+					conf.Tendermint.HaltTime = *haltTimeOpt
+				}
+			}
+
+			if *readOnlyOpt {
+				if conf.Tendermint == nil || !conf.Tendermint.Enabled {
+					output.Fatalf("cannot follow a chain read-only without Tendermint enabled")
+				}
+				conf.Tendermint.ReadOnly = true
+			}
+
 			if err := conf.Verify(); err != nil {
 				output.Fatalf("cannot continue with config: %v", err)
 			}
 
-			output.Logf("Using validator address: %s", *conf.ValidatorAddress)
+			if conf.ReadOnly() {
+				output.Logf("Following chain read-only")
+			} else {
+				output.Logf("Using validator address: %s", *conf.ValidatorAddress)
+			}
 
 			kern, err := core.LoadKernelFromConfig(conf)
 			if err != nil {
 				output.Fatalf("could not configure Burrow kernel: %v", err)
 			}
 
+			// Reload logging config from the same sources on SIGHUP
+			kern.Logging.SetSource(func() (*logconfig.LoggingConfig, error) {
+				conf, err := obtainDefaultConfig(*configOpts.configFileOpt, *configOpts.genesisFileOpt)
+				if err != nil {
+					return nil, err
+				}
+				return conf.Logging, nil
+			})
+
 			if err = kern.Boot(); err != nil {
 				output.Fatalf("could not boot Burrow kernel: %v", err)
 			}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Trace prints where a failed transaction failed, down to the line of source where the contracts it failed in were
+// deployed with a source map
+func Trace(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainURLOpt := cmd.StringOpt("c chain", "127.0.0.1:10997", "chain to be used in IP:PORT format")
+		timeoutOpt := cmd.IntOpt("t timeout", 0, "Timeout in seconds")
+		dirOpt := cmd.StringOpt("d dir", ".", "directory the contracts were compiled in, to read their sources from")
+		txArg := cmd.StringArg("TX", "", "Hash of the failed transaction")
+		cmd.Spec = "[--chain=<chain GRPC address>] [--timeout=<GRPC timeout seconds>] [--dir=<directory>] TX"
+
+		cmd.Action = func() {
+			txHash, err := decodeHex(*txArg)
+			if err != nil {
+				output.Fatalf("could not hex decode transaction hash %s: %v", *txArg, err)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if *timeoutOpt != 0 {
+				timeout := time.Duration(*timeoutOpt) * time.Second
+				ctx, cancel = context.WithTimeout(context.Background(), timeout)
+			}
+			defer cancel()
+
+			conn, err := encoding.GRPCDialContext(ctx, *chainURLOpt)
+			if err != nil {
+				output.Fatalf("failed to connect: %v", err)
+			}
+
+			qCli := rpcquery.NewQueryClient(conn)
+			trace, err := qCli.GetRevertTrace(ctx, &rpcquery.GetRevertTraceParam{TxHash: txHash})
+			if err != nil {
+				output.Fatalf("failed to trace transaction %s: %v", *txArg, err)
+			}
+			if trace.Exception == nil {
+				output.Printf("Transaction %s did not fail when replayed without the transactions before it in "+
+					"its block", *txArg)
+				return
+			}
+			output.Printf("%v", trace.Exception)
+			for _, frame := range trace.Frames {
+				output.Printf("  %s", describeFrame(frame, *dirOpt))
+			}
+		}
+	}
+}
+
+// Describes where frame failed, with the line of source it failed at should the source be under dir
+func describeFrame(frame *rpcquery.RevertFrame, dir string) string {
+	name := frame.ContractName
+	if name == "" {
+		name = "unknown contract"
+	}
+	if frame.Create {
+		name = "constructor of " + name
+	}
+	desc := fmt.Sprintf("%s at %v: %s at PC %d", name, frame.CodeAddress, frame.OpCode, frame.PC)
+	if frame.SourceFile == "" {
+		return desc
+	}
+	loc := &compile.SourceLocation{
+		File:   frame.SourceFile,
+		Start:  int(frame.SourceStart),
+		Length: int(frame.SourceLength),
+	}
+	source, err := ioutil.ReadFile(filepath.Join(dir, loc.File))
+	if err != nil {
+		return fmt.Sprintf("%s in %s at bytes %d-%d", desc, loc.File, loc.Start, loc.Start+loc.Length)
+	}
+	line, column := loc.Line(source)
+	desc = fmt.Sprintf("%s in %s:%d:%d", desc, loc.File, line, column)
+	lines := bytes.Split(source, []byte{'\n'})
+	if line > 0 && line <= len(lines) {
+		desc += "\n      " + string(bytes.TrimSpace(lines[line-1]))
+	}
+	return desc
+}
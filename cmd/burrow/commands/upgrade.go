@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"io/ioutil"
+	"time"
+
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/execution/upgrade"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+	cli "github.com/jawher/mow.cli"
+)
+
+type upgradeStatus struct {
+	// The upgrade the chain will halt for, if any
+	Plan *upgrade.Plan `json:",omitempty"`
+	// Whether this binary can continue the chain after the planned upgrade
+	Supported bool
+	// The upgrades this binary supports
+	SupportedUpgrades []string
+}
+
+// Upgrade plans and cancels coordinated upgrades of a running chain
+func Upgrade(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpts := addConfigOptions(cmd)
+		chainOpt := cmd.StringOpt("chain", "", "chain to be used in IP:PORT format")
+		timeoutOpt := cmd.IntOpt("t timeout", 5, "Timeout in seconds")
+		cmd.Spec += "[--chain=<ip>] [--timeout=<seconds>]"
+		// we don't want config sourcing logs
+		source.LogWriter = ioutil.Discard
+
+		var client *def.Client
+		var address string
+		logger := logging.NewNoopLogger()
+
+		cmd.Before = func() {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+			if err := conf.Verify(); err != nil {
+				output.Fatalf("cannot continue with config: %v", err)
+			}
+			chainHost := jobs.FirstOf(*chainOpt, conf.RPC.GRPC.ListenAddress())
+			client = def.NewClient(chainHost, conf.Keys.RemoteAddress, true, time.Duration(*timeoutOpt)*time.Second)
+			address = conf.ValidatorAddress.String()
+		}
+
+		govTx := func(sourceAddress string, plan *upgrade.Plan) *payload.GovTx {
+			input, err := client.TxInput(jobs.FirstOf(sourceAddress, address), "", "", true, logger)
+			if err != nil {
+				output.Fatalf("could not formulate GovTx: %v", err)
+			}
+			return &payload.GovTx{
+				Inputs:      []*payload.TxInput{input},
+				UpgradePlan: plan,
+			}
+		}
+
+		cmd.Command("plan", "plan an upgrade with a GovTx so that nodes halt before its height and only binaries "+
+			"supporting it continue the chain", func(cmd *cli.Cmd) {
+			sourceOpt := cmd.StringOpt("s source", "", "Account with root perm, if not set config is used")
+			nameOpt := cmd.StringOpt("n name", "", "Name of the upgrade known to the new binary, required")
+			heightOpt := cmd.IntOpt("height", 0, "First height to be executed by the new binary, required")
+			infoOpt := cmd.StringOpt("i info", "", "Version of the new binary and where to obtain it")
+			cmd.Spec += "[--source=<address>] --name=<name> --height=<height> [--info=<info>]"
+
+			cmd.Action = func() {
+				if *heightOpt <= 0 {
+					output.Fatalf("upgrade height must be positive")
+				}
+				hash, err := makeTx(client, govTx(*sourceOpt, &upgrade.Plan{
+					Name:   *nameOpt,
+					Height: uint64(*heightOpt),
+					Info:   *infoOpt,
+				}))
+				if err != nil {
+					output.Fatalf("could not plan upgrade: %v", err)
+				}
+				output.Printf("%s", hash)
+			}
+		})
+
+		cmd.Command("cancel", "cancel the pending upgrade with a GovTx", func(cmd *cli.Cmd) {
+			sourceOpt := cmd.StringOpt("s source", "", "Account with root perm, if not set config is used")
+			nameOpt := cmd.StringOpt("n name", "", "Name of the pending upgrade, required")
+			cmd.Spec += "[--source=<address>] --name=<name>"
+
+			cmd.Action = func() {
+				hash, err := makeTx(client, govTx(*sourceOpt, &upgrade.Plan{Name: *nameOpt}))
+				if err != nil {
+					output.Fatalf("could not cancel upgrade: %v", err)
+				}
+				output.Printf("%s", hash)
+			}
+		})
+
+		cmd.Command("status", "show the pending upgrade and whether this binary supports it", func(cmd *cli.Cmd) {
+			cmd.Action = func() {
+				plan, err := upgrade.GetPlan(client)
+				if err != nil {
+					output.Fatalf("could not get upgrade plan: %v", err)
+				}
+				status := &upgradeStatus{
+					Plan:              plan,
+					SupportedUpgrades: upgrade.SupportedUpgrades(),
+				}
+				if plan != nil {
+					status.Supported = upgrade.Supported(plan.Name)
+				}
+				output.Printf("%s", source.JSONString(status))
+			}
+		})
+	}
+}
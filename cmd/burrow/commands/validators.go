@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"io/ioutil"
+	"time"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/execution/unbonding"
+	"github.com/hyperledger/burrow/logging"
+	cli "github.com/jawher/mow.cli"
+)
+
+type validatorsStatus struct {
+	Height     uint64
+	Validators []*validator.Validator
+	// Unbonded power of the account queried still to be paid to its balance
+	PendingWithdrawals []*unbonding.Withdrawal `json:",omitempty"`
+}
+
+// Validators bonds, unbonds and sets the power of validators on a running chain
+func Validators(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpts := addConfigOptions(cmd)
+		chainOpt := cmd.StringOpt("chain", "", "chain to be used in IP:PORT format")
+		timeoutOpt := cmd.IntOpt("t timeout", 5, "Timeout in seconds")
+		cmd.Spec += "[--chain=<ip>] [--timeout=<seconds>]"
+		// we don't want config sourcing logs
+		source.LogWriter = ioutil.Discard
+
+		var client *def.Client
+		var address string
+		logger := logging.NewNoopLogger()
+
+		cmd.Before = func() {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+			if err := conf.Verify(); err != nil {
+				output.Fatalf("cannot continue with config: %v", err)
+			}
+			chainHost := jobs.FirstOf(*chainOpt, conf.RPC.GRPC.ListenAddress())
+			client = def.NewClient(chainHost, conf.Keys.RemoteAddress, true, time.Duration(*timeoutOpt)*time.Second)
+			address = conf.ValidatorAddress.String()
+		}
+
+		cmd.Command("bond", "bond value from an account's balance as validator power", func(cmd *cli.Cmd) {
+			sourceOpt := cmd.StringOpt("s source", "", "Account with bonding perm, if not set config is used")
+			amountOpt := cmd.StringOpt("a amount", "", "Amount of value to bond, required")
+			cmd.Spec += "[--source=<address>] --amount=<value>"
+
+			cmd.Action = func() {
+				bond := &def.Bond{
+					Source: jobs.FirstOf(*sourceOpt, address),
+					Amount: *amountOpt,
+				}
+				if err := bond.Validate(); err != nil {
+					output.Fatalf("could not validate BondTx: %v", err)
+				}
+				tx, err := jobs.FormulateBondJob(bond, address, client, logger)
+				if err != nil {
+					output.Fatalf("could not formulate BondTx: %v", err)
+				}
+				hash, err := makeTx(client, tx)
+				if err != nil {
+					output.Fatalf("could not bond: %v", err)
+				}
+				output.Printf("%s", hash)
+			}
+		})
+
+		cmd.Command("unbond", "unbond validator power, which is paid back to the validator's balance once the "+
+			"chain's unbonding period has passed", func(cmd *cli.Cmd) {
+			sourceOpt := cmd.StringOpt("s source", "", "Validator to unbond, if not set config is used")
+			amountOpt := cmd.StringOpt("a amount", "", "Amount of power to unbond, required")
+			cmd.Spec += "[--source=<address>] --amount=<value>"
+
+			cmd.Action = func() {
+				unbond := &def.Unbond{
+					Source: jobs.FirstOf(*sourceOpt, address),
+					Amount: *amountOpt,
+				}
+				if err := unbond.Validate(); err != nil {
+					output.Fatalf("could not validate UnbondTx: %v", err)
+				}
+				tx, err := jobs.FormulateUnbondJob(unbond, address, client, logger)
+				if err != nil {
+					output.Fatalf("could not formulate UnbondTx: %v", err)
+				}
+				hash, err := makeTx(client, tx)
+				if err != nil {
+					output.Fatalf("could not unbond: %v", err)
+				}
+				output.Printf("%s", hash)
+			}
+		})
+
+		cmd.Command("status", "show the validator set and the withdrawals pending for an account", func(cmd *cli.Cmd) {
+			addressOpt := cmd.StringOpt("a address", "", "Account whose pending withdrawals to show, "+
+				"if not set config is used")
+			cmd.Spec += "[--address=<address>]"
+
+			cmd.Action = func() {
+				set, err := client.GetValidatorSet(logger)
+				if err != nil {
+					output.Fatalf("could not get validator set: %v", err)
+				}
+				status := &validatorsStatus{
+					Height:     set.Height,
+					Validators: set.Set,
+				}
+				account, err := crypto.AddressFromHexString(jobs.FirstOf(*addressOpt, address))
+				if err != nil {
+					output.Fatalf("could not parse address: %v", err)
+				}
+				status.PendingWithdrawals, err = unbonding.Pending(client, account)
+				if err != nil {
+					output.Fatalf("could not get pending withdrawals: %v", err)
+				}
+				output.Printf("%s", source.JSONString(status))
+			}
+		})
+
+		cmd.Command("set-power", "set the power of a validator with a GovTx", func(cmd *cli.Cmd) {
+			sourceOpt := cmd.StringOpt("s source", "", "Account with root perm, if not set config is used")
+			targetOpt := cmd.StringOpt("target", "", "Address or public key of the validator, required")
+			powerOpt := cmd.StringOpt("p power", "", "Power to set, zero removes the validator, required")
+			cmd.Spec += "[--source=<address>] --target=<address or public key> --power=<value>"
+
+			cmd.Action = func() {
+				update := &def.UpdateAccount{
+					Source: jobs.FirstOf(*sourceOpt, address),
+					Target: *targetOpt,
+					Power:  *powerOpt,
+				}
+				if err := update.Validate(); err != nil {
+					output.Fatalf("could not validate GovTx: %v", err)
+				}
+				tx, _, err := jobs.FormulateUpdateAccountJob(update, address, client, logger)
+				if err != nil {
+					output.Fatalf("could not formulate GovTx: %v", err)
+				}
+				hash, err := makeTx(client, tx)
+				if err != nil {
+					output.Fatalf("could not set power: %v", err)
+				}
+				output.Printf("%s", hash)
+			}
+		})
+	}
+}
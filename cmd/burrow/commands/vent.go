@@ -15,6 +15,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/vent/chain"
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/service"
 	"github.com/hyperledger/burrow/vent/sqldb"
@@ -49,17 +50,17 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 
 		cmd.Command("start", "Start the Vent consumer service",
 			func(cmd *cli.Cmd) {
-				cfg := config.DefaultVentConfig()
+				cfg := ventConfig(output)
 
 				dbOpts := sqlDBOpts(cmd, cfg)
 				grpcAddrOpt := cmd.StringOpt("chain-addr", cfg.ChainAddress, "Address to connect to the Hyperledger Burrow gRPC server")
 				httpAddrOpt := cmd.StringOpt("http-addr", cfg.HTTPListenAddress, "Address to bind the HTTP server")
 				logLevelOpt := cmd.StringOpt("log-level", string(LogLevelInfo), "Logging level (none, info, trace)")
-				watchAddressesOpt := cmd.StringsOpt("watch", nil, "Add contract address to global watch filter")
-				minimumHeightOpt := cmd.IntOpt("minimum-height", 0, "Only process block greater than or equal to height passed")
+				watchAddressesOpt := cmd.StringsOpt("watch", addressStrings(cfg.WatchAddresses), "Add contract address to global watch filter")
+				minimumHeightOpt := cmd.IntOpt("minimum-height", int(cfg.MinimumHeight), "Only process block greater than or equal to height passed")
 				maxRetriesOpt := cmd.IntOpt("max-retries", int(cfg.BlockConsumerConfig.MaxRetries), "Maximum number of retries when consuming blocks")
-				maxRequestRateOpt := cmd.StringOpt("max-request-rate", "", "Maximum request rate given as (number of requests)/(time base), e.g. 1000/24h for 1000 requests per day")
-				backoffDurationOpt := cmd.StringOpt("backoff", "",
+				maxRequestRateOpt := cmd.StringOpt("max-request-rate", requestRateString(cfg.BlockConsumerConfig), "Maximum request rate given as (number of requests)/(time base), e.g. 1000/24h for 1000 requests per day")
+				backoffDurationOpt := cmd.StringOpt("backoff", durationString(cfg.BlockConsumerConfig.BaseBackoffDuration),
 					"The minimum duration to wait before asking for new blocks - increases exponentially when errors occur. Values like 200ms, 1s, 2m")
 				batchSizeOpt := cmd.IntOpt("batch-size", int(cfg.BlockConsumerConfig.MaxBlockBatchSize),
 					"The maximum number of blocks from which to request events in a single call - will reduce logarithmically to 1 when errors occur.")
@@ -68,7 +69,7 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				dbBlockOpt := cmd.BoolOpt("blocks", false, "Create block tables and persist related data")
 				dbTxOpt := cmd.BoolOpt("txs", false, "Create tx tables and persist related data")
 
-				announceEveryOpt := cmd.StringOpt("announce-every", "5s", "Announce vent status every period as a Go duration, e.g. 1ms, 3s, 1h")
+				announceEveryOpt := cmd.StringOpt("announce-every", durationString(cfg.AnnounceEvery), "Announce vent status every period as a Go duration, e.g. 1ms, 3s, 1h")
 
 				cmd.Before = func() {
 					var err error
@@ -183,6 +184,14 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				}
 			})
 
+		cmd.Command("config-schema", "Print JSONSchema for the Vent config file format, for editors to validate "+
+			config.DefaultVentConfigYAMLFileName+" against",
+			func(cmd *cli.Cmd) {
+				cmd.Action = func() {
+					output.Printf(source.JSONString(config.VentConfigSchema()))
+				}
+			})
+
 		cmd.Command("spec", "Generate SQLSOL specification from ABIs",
 			func(cmd *cli.Cmd) {
 				abiFileOpt := cmd.StringsOpt("abi", nil, "EVM Contract ABI file or folder")
@@ -210,7 +219,7 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 			func(cmd *cli.Cmd) {
 				const timeLayout = "2006-01-02 15:04:05"
 
-				dbOpts := sqlDBOpts(cmd, config.DefaultVentConfig())
+				dbOpts := sqlDBOpts(cmd, ventConfig(output))
 				timeOpt := cmd.StringOpt("t time", "", fmt.Sprintf("restore time up to which all "+
 					"log entries will be applied to restore DB, in the format '%s'- restores all log entries if omitted",
 					timeLayout))
@@ -269,6 +278,43 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 	}
 }
 
+// The default Vent config with any fields overridden by those of a config file (named by VENT_CONFIG_FILE, or vent.yaml)
+// and then VENT_ environment variables, which are themselves overridden by command line options
+func ventConfig(output Output) *config.VentConfig {
+	cfg := config.DefaultVentConfig()
+	err := source.EachOf(
+		source.FirstOf(
+			source.File(os.Getenv(config.DefaultVentConfigFileEnvironmentVariable), false),
+			source.File(config.DefaultVentConfigYAMLFileName, true)),
+		source.EnvironmentOverrides(config.DefaultVentEnvironmentPrefix)).Apply(cfg)
+	if err != nil {
+		output.Fatalf("could not obtain Vent config: %v", err)
+	}
+	return cfg
+}
+
+func addressStrings(addresses []crypto.Address) []string {
+	strs := make([]string, len(addresses))
+	for i, address := range addresses {
+		strs[i] = address.String()
+	}
+	return strs
+}
+
+func durationString(duration time.Duration) string {
+	if duration == 0 {
+		return ""
+	}
+	return duration.String()
+}
+
+func requestRateString(conf chain.BlockConsumerConfig) string {
+	if conf.MaxRequests == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%v", conf.MaxRequests, conf.TimeBase)
+}
+
 func parseDuration(duration string) (time.Duration, error) {
 	if duration == "" {
 		return 0, nil
@@ -0,0 +1,244 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/txs/payload"
+	cli "github.com/jawher/mow.cli"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// The prefix of the names under which verifications are recorded in the name registry
+const verifiedNamePrefix = "verified/"
+
+// What is recorded in the name registry about a verified contract
+type verification struct {
+	Contract   string `json:"contract"`
+	Compiler   string `json:"compiler"`
+	Optimize   bool   `json:"optimize"`
+	Runs       int    `json:"runs,omitempty"`
+	EVMVersion string `json:"evmVersion,omitempty"`
+	SourceHash string `json:"sourceHash"`
+	CodeHash   string `json:"codeHash"`
+	Exact      bool   `json:"exact"`
+}
+
+// Verify recompiles the source of a contract and checks it against the code deployed at an address
+func Verify(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpts := addConfigOptions(cmd)
+		chainOpt := cmd.StringOpt("chain", "", "chain to be used in IP:PORT format")
+		timeoutOpt := cmd.IntOpt("t timeout", 5, "Timeout in seconds")
+		solcOpt := cmd.StringOpt("solc", "", "version of solc to download and compile with, or pragma to use the "+
+			"newest release the source's version pragma allows (default: solc on the PATH)")
+		solcCacheOpt := cmd.StringOpt("solc-cache", "", "directory to keep downloaded solc binaries in "+
+			"(default: burrow/solc in the user's cache directory)")
+		optimizeOpt := cmd.BoolOpt("optimize", false, "compile with the optimizer enabled")
+		runsOpt := cmd.IntOpt("runs", 0, "number of runs to optimise for (default: solc's)")
+		evmVersionOpt := cmd.StringOpt("evm-version", "", "EVM version to compile for (default: solc's)")
+		librariesOpt := cmd.StringOpt("libraries", "", "addresses of the libraries the contract links to, as "+
+			"comma separated Library:address pairs")
+		contractOpt := cmd.StringOpt("contract", "", "name of the contract in the source (default: the name of "+
+			"the source file)")
+		txOpt := cmd.StringOpt("tx", "", "hash of the transaction that deployed the contract, to also check its "+
+			"creation code and decode its constructor arguments")
+		recordOpt := cmd.BoolOpt("record", false, "record the verification in the name registry under "+
+			verifiedNamePrefix+"ADDRESS")
+		sourceOpt := cmd.StringOpt("s source", "", "Address or key name to record the verification from, if not "+
+			"set config is used")
+		blocksOpt := cmd.IntOpt("blocks", 100000, "number of blocks to register the verification name for")
+		feeOpt := cmd.StringOpt("fee", "99", "Fee to pay the validators for recording the verification")
+		addressArg := cmd.StringArg("ADDRESS", "", "Address of the contract")
+		sourceArg := cmd.StringArg("SOURCE", "", "Solidity source file of the contract")
+		cmd.Spec += " [--chain=<ip>] [--timeout=<seconds>] [--solc=<version>] [--solc-cache=<dir>] [--optimize] " +
+			"[--runs=<n>] [--evm-version=<version>] [--libraries=<libraries>] [--contract=<name>] [--tx=<hash>] " +
+			"[--record] [--source=<address>] [--blocks=<n>] [--fee=<value>] ADDRESS SOURCE"
+		// we don't want config sourcing logs
+		source.LogWriter = ioutil.Discard
+
+		cmd.Action = func() {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+			if err := conf.Verify(); err != nil {
+				output.Fatalf("cannot continue with config: %v", err)
+			}
+			chainHost := jobs.FirstOf(*chainOpt, conf.RPC.GRPC.ListenAddress())
+			client := def.NewClient(chainHost, conf.Keys.RemoteAddress, true, time.Duration(*timeoutOpt)*time.Second)
+			logger := logging.NewNoopLogger()
+
+			_, err = client.Query(logger)
+			if err != nil {
+				output.Fatalf("could not connect to %s: %v", chainHost, err)
+			}
+			address, err := client.ParseAddress(*addressArg, logger)
+			if err != nil {
+				output.Fatalf("could not parse address %s: %v", *addressArg, err)
+			}
+			acc, err := client.GetAccount(address)
+			if err != nil {
+				output.Fatalf("could not get account %v: %v", address, err)
+			}
+			if acc == nil || len(acc.EVMCode) == 0 {
+				output.Fatalf("there is no EVM contract at %v", address)
+			}
+
+			settings := compile.CompilerSettings{
+				Optimize:   *optimizeOpt,
+				Runs:       *runsOpt,
+				EVMVersion: *evmVersionOpt,
+				Libraries:  make(map[string]string),
+			}
+			for _, l := range strings.Split(*librariesOpt, ",") {
+				if l == "" {
+					continue
+				}
+				pair := strings.Split(l, ":")
+				if len(pair) != 2 {
+					output.Fatalf("library %s should be in Library:address format", l)
+				}
+				lib, err := client.ParseAddress(pair[1], logger)
+				if err != nil {
+					output.Fatalf("could not parse address of library %s: %v", pair[0], err)
+				}
+				settings.Libraries[pair[0]] = lib.String()
+			}
+			solc, err := compile.NewSolcVersions(*solcCacheOpt, logger).Binary(*solcOpt, *sourceArg)
+			if err != nil {
+				output.Fatalf("could not get solc: %v", err)
+			}
+			resp, err := compile.EVMWithSettings(*sourceArg, "", settings, solc, logger)
+			if err != nil {
+				output.Fatalf("could not compile %s: %v", *sourceArg, err)
+			}
+			if resp.Error != "" {
+				output.Fatalf("could not compile %s: %s", *sourceArg, resp.Error)
+			}
+
+			name := *contractOpt
+			if name == "" {
+				name = strings.TrimSuffix(filepath.Base(*sourceArg), filepath.Ext(*sourceArg))
+			}
+			var contract *compile.SolidityContract
+			for i, obj := range resp.Objects {
+				if obj.Objectname == name && obj.Filename == *sourceArg {
+					contract = &resp.Objects[i].Contract
+				}
+			}
+			if contract == nil {
+				output.Fatalf("there is no contract %s in %s", name, *sourceArg)
+			}
+
+			exact, err := contract.VerifyRuntime(acc.EVMCode, address)
+			if err != nil {
+				output.Fatalf("%s does not match the contract at %v: %v", name, address, err)
+			}
+			if exact {
+				output.Printf("Runtime code of %v matches %s exactly", address, name)
+			} else {
+				output.Printf("Runtime code of %v matches %s except for the metadata hash, so its source "+
+					"differs only in comments, formatting or file names", address, name)
+			}
+
+			if *txOpt != "" {
+				exact = verifyCreation(output, client, logger, *txOpt, address, name, contract) && exact
+			}
+
+			if !*recordOpt {
+				return
+			}
+			sourceCode, err := ioutil.ReadFile(*sourceArg)
+			if err != nil {
+				output.Fatalf("could not read %s: %v", *sourceArg, err)
+			}
+			var metadata compile.SolidityMetadata
+			_ = json.Unmarshal([]byte(contract.Metadata), &metadata)
+			bs, err := json.Marshal(verification{
+				Contract:   name,
+				Compiler:   metadata.Compiler.Version,
+				Optimize:   settings.Optimize,
+				Runs:       settings.Runs,
+				EVMVersion: settings.EVMVersion,
+				SourceHash: hex.EncodeUpperToString(crypto.Keccak256(sourceCode)),
+				CodeHash:   hex.EncodeUpperToString(crypto.Keccak256(acc.EVMCode)),
+				Exact:      exact,
+			})
+			if err != nil {
+				output.Fatalf("could not encode verification: %v", err)
+			}
+			entry := verifiedNamePrefix + address.String()
+			amount := names.NameCostForExpiryIn(entry, string(bs), uint64(*blocksOpt))
+			tx, err := client.Name(&def.NameArg{
+				Input:  jobs.FirstOf(*sourceOpt, conf.ValidatorAddress.String()),
+				Amount: strconv.FormatUint(amount, 10),
+				Name:   entry,
+				Data:   string(bs),
+				Fee:    *feeOpt,
+			}, logger)
+			if err != nil {
+				output.Fatalf("could not formulate NameTx: %v", err)
+			}
+			_, err = client.SignAndBroadcast(tx, logger)
+			if err != nil {
+				output.Fatalf("could not record verification: %v", err)
+			}
+			output.Printf("Recorded verification as %s", entry)
+		}
+	}
+}
+
+// Checks the creation code sent by the transaction with hash txHash, printing the constructor arguments it was sent
+// with, and returns whether it matches exactly
+func verifyCreation(output Output, client *def.Client, logger *logging.Logger, txHash string,
+	address crypto.Address, name string, contract *compile.SolidityContract) bool {
+	hash, err := decodeHex(txHash)
+	if err != nil {
+		output.Fatalf("could not hex decode transaction hash %s: %v", txHash, err)
+	}
+	events, err := client.Events(logger)
+	if err != nil {
+		output.Fatalf("could not connect: %v", err)
+	}
+	txe, err := events.Tx(context.Background(), &rpcevents.TxRequest{TxHash: hash})
+	if err != nil {
+		output.Fatalf("could not get transaction %s: %v", txHash, err)
+	}
+	callTx, ok := txe.Envelope.Tx.Payload.(*payload.CallTx)
+	if !ok || callTx.Address != nil || txe.Receipt == nil || txe.Receipt.ContractAddress != address {
+		output.Fatalf("transaction %s did not deploy %v", txHash, address)
+	}
+	exact, data, err := contract.VerifyCreation(callTx.Data)
+	if err != nil {
+		output.Fatalf("%s does not match the creation code sent by %s: %v", name, txHash, err)
+	}
+	if exact {
+		output.Printf("Creation code sent by %s matches %s exactly", txHash, name)
+	} else {
+		output.Printf("Creation code sent by %s matches %s except for the metadata hash", txHash, name)
+	}
+	spec, err := abi.ReadSpec(contract.Abi)
+	if err == nil && len(spec.Constructor.Inputs) > 0 {
+		args, err := unpackNamed(spec.Constructor.Inputs, data)
+		if err != nil {
+			output.Fatalf("could not decode constructor arguments: %v", err)
+		}
+		output.Printf("Constructor arguments: %s", strings.Join(args, ", "))
+	}
+	return exact
+}
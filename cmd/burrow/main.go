@@ -47,6 +47,9 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("start", "Start a Burrow node",
 		commands.Start(output))
 
+	app.Command("config", "Check the config a node would start with, or print the schema of config files",
+		commands.Config(output))
+
 	app.Command("spec", "Build a GenesisSpec that acts as a template for a GenesisDoc and the configure command",
 		commands.Spec(output))
 
@@ -75,18 +78,60 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("tx", "Submit a transaction to a burrow node",
 		commands.Tx(output))
 
+	app.Command("validators", "Bond, unbond and set the power of validators on a running chain",
+		commands.Validators(output))
+
+	app.Command("upgrade", "Plan, cancel and check coordinated upgrades of a running chain",
+		commands.Upgrade(output))
+
 	app.Command("restore", "Restore new chain from backup",
 		commands.Restore(output))
 
+	app.Command("dev", "Run a single node development chain with funded accounts whose state is kept in memory",
+		commands.Dev(output))
+
+	app.Command("fork", "Run a local development node from the state of a remote chain at some height",
+		commands.Fork(output))
+
+	app.Command("join", "Configure and start a node that joins a running chain from one of its nodes",
+		commands.Join(output))
+
+	app.Command("rollback", "Roll back the state of a stopped node to an earlier height",
+		commands.Rollback(output))
+
+	app.Command("migrate", "Move the databases of a stopped node to another key-value store backend",
+		commands.Migrate(output))
+
+	app.Command("db", "Verify and repair the state of a stopped node",
+		commands.DB(output))
+
+	app.Command("light", "Serve the query API of a remote chain locally, verifying responses with a light client",
+		commands.Light(output))
+
 	app.Command("accounts", "List accounts and metadata",
 		commands.Accounts(output))
 
+	app.Command("diff", "List the changes to accounts, storage and names between two heights of a chain",
+		commands.Diff(output))
+
+	app.Command("call", "Query a contract function without sending a transaction",
+		commands.Call(output))
+
+	app.Command("send", "Call a contract function in a transaction",
+		commands.Send(output))
+
 	app.Command("abi", "List, decode and encode using ABI",
 		commands.Abi(output))
 
 	app.Command("compile", "Compile solidity files embedding the compilation results as a fixture in a Go file",
 		commands.Compile(output))
 
+	app.Command("verify", "Check the code of a contract against its recompiled source and record the result",
+		commands.Verify(output))
+
+	app.Command("trace", "Find where a failed transaction failed in the source of the contracts it called",
+		commands.Trace(output))
+
 	app.Command("errors", "Print error codes",
 		commands.Errors(output))
 	return app
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/alecthomas/jsonschema"
+	tmConfig "github.com/cometbft/cometbft/config"
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
@@ -12,11 +13,16 @@ import (
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc"
-	tmConfig "github.com/tendermint/tendermint/config"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
 )
 
 const DefaultBurrowConfigTOMLFileName = "burrow.toml"
+const DefaultBurrowConfigYAMLFileName = "burrow.yaml"
 const DefaultBurrowConfigEnvironmentVariable = "BURROW_CONFIG_JSON"
+
+// Overrides each field of the config from an environment variable with this prefix, such as BURROW_RPC_GRPC_LISTENPORT
+const DefaultBurrowConfigEnvironmentPrefix = "BURROW"
 const DefaultGenesisDocJSONFileName = "genesis.json"
 
 type BurrowConfig struct {
@@ -24,17 +30,22 @@ type BurrowConfig struct {
 	ValidatorAddress *crypto.Address `json:",omitempty" toml:",omitempty"`
 	Passphrase       *string         `json:",omitempty" toml:",omitempty"`
 	// From config file
-	BurrowDir  string
+	BurrowDir string
+	// Key-value store for Burrow's and Tendermint's databases, one of goleveldb (the default), badgerdb or pebbledb
+	// (when built with the tag of the same name). Use burrow migrate to change the backend of an existing node.
+	DBBackend  string                             `json:",omitempty" toml:",omitempty"`
 	GenesisDoc *genesis.GenesisDoc                `json:",omitempty" toml:",omitempty"`
 	Tendermint *tendermint.BurrowTendermintConfig `json:",omitempty" toml:",omitempty"`
 	Execution  *execution.ExecutionConfig         `json:",omitempty" toml:",omitempty"`
 	Keys       *keys.KeysConfig                   `json:",omitempty" toml:",omitempty"`
 	RPC        *rpc.RPCConfig                     `json:",omitempty" toml:",omitempty"`
 	Logging    *logconfig.LoggingConfig           `json:",omitempty" toml:",omitempty"`
+	// Move the blocks and execution events of old heights out of the node's databases to a cheaper store
+	ColdStorage *storage.ColdStorageConfig `json:",omitempty" toml:",omitempty"`
+	// Encrypt the values stored in the node's databases with a key read on startup
+	DBEncryption *storage.EncryptionConfig `json:",omitempty" toml:",omitempty"`
 }
 
-var burrowConfigSchema = jsonschema.Reflect(&BurrowConfig{})
-
 func DefaultBurrowConfig() *BurrowConfig {
 	return &BurrowConfig{
 		BurrowDir:  ".burrow",
@@ -47,14 +58,32 @@ func DefaultBurrowConfig() *BurrowConfig {
 }
 
 func (conf *BurrowConfig) Verify() error {
-	if conf.ValidatorAddress == nil {
+	if conf.ValidatorAddress == nil && !conf.ReadOnly() {
 		return fmt.Errorf("could not finalise address - please provide one in config or via --account-address")
 	}
 	return nil
 }
 
+// ReadOnly returns whether the node follows a chain without taking part in consensus or accepting transactions
+func (conf *BurrowConfig) ReadOnly() bool {
+	return conf.Tendermint != nil && conf.Tendermint.ReadOnly
+}
+
+// Backend returns the key-value store to use for databases
+func (conf *BurrowConfig) Backend() dbm.BackendType {
+	if conf.DBBackend == "" {
+		return storage.DefaultDBBackend
+	}
+	return dbm.BackendType(conf.DBBackend)
+}
+
 func (conf *BurrowConfig) TendermintConfig() (*tmConfig.Config, error) {
-	return conf.Tendermint.Config(conf.BurrowDir, conf.Execution.TimeoutFactor)
+	tmConf, err := conf.Tendermint.Config(conf.BurrowDir, conf.Execution.TimeoutFactor)
+	if err != nil {
+		return nil, err
+	}
+	tmConf.DBBackend = string(conf.Backend())
+	return tmConf, nil
 }
 
 func (conf *BurrowConfig) JSONString() string {
@@ -64,3 +93,12 @@ func (conf *BurrowConfig) JSONString() string {
 func (conf *BurrowConfig) TOMLString() string {
 	return source.TOMLString(conf)
 }
+
+func (conf *BurrowConfig) YAMLString() string {
+	return source.YAMLString(conf)
+}
+
+// BurrowConfigSchema is the JSON schema of JSON and YAML config files
+func BurrowConfigSchema() *jsonschema.Schema {
+	return source.Schema(&BurrowConfig{})
+}
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/stretchr/testify/require"
 )
@@ -20,3 +24,44 @@ func TestBurrowConfigSerialise(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, jsonString, confOut.JSONString())
 }
+
+func TestBurrowConfigYAML(t *testing.T) {
+	conf := DefaultBurrowConfig()
+	confOut := new(BurrowConfig)
+	err := source.FromYAMLString(conf.YAMLString(), confOut)
+	require.NoError(t, err)
+	require.Equal(t, conf.JSONString(), confOut.JSONString())
+}
+
+func TestBurrowConfigSchema(t *testing.T) {
+	// Regenerate with make schema
+	published, err := ioutil.ReadFile("../docs/reference/burrow-config.schema.json")
+	require.NoError(t, err)
+	require.Equal(t, source.JSONString(BurrowConfigSchema())+"\n", string(published))
+}
+
+func TestBurrowConfigEnvironmentOverrides(t *testing.T) {
+	address := crypto.Address{1, 2, 3}
+	for key, value := range map[string]string{
+		"BURROW_VALIDATORADDRESS":             address.String(),
+		"BURROW_RPC_GRPC_LISTENPORT":          "20997",
+		"BURROW_RPC_METRICS_ENABLED":          "true",
+		"BURROW_TENDERMINT_PERSISTENTPEERS":   "tcp://a@b:26656",
+		"BURROW_EXECUTION_VMOPTIONS":          "DebugOpcodes,DumpTokens",
+		"BURROW_COLDSTORAGE_LOCATION":         "/cold",
+		"BURROW_KEYS_ALLOWBADFILEPERMISSIONS": "1",
+	} {
+		os.Setenv(key, value)
+		defer os.Unsetenv(key)
+	}
+	conf := DefaultBurrowConfig()
+	require.NoError(t, source.EnvironmentOverrides(DefaultBurrowConfigEnvironmentPrefix).Apply(conf))
+	require.Equal(t, &address, conf.ValidatorAddress)
+	require.Equal(t, "20997", conf.RPC.GRPC.ListenPort)
+	require.Equal(t, DefaultBurrowConfig().RPC.GRPC.ListenHost, conf.RPC.GRPC.ListenHost)
+	require.True(t, conf.RPC.Metrics.Enabled)
+	require.Equal(t, "tcp://a@b:26656", conf.Tendermint.PersistentPeers)
+	require.Equal(t, []execution.VMOption{execution.DebugOpcodes, execution.DumpTokens}, conf.Execution.VMOptions)
+	require.Equal(t, "/cold", conf.ColdStorage.Location)
+	require.True(t, conf.Keys.AllowBadFilePermissions)
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/hyperledger/burrow/crypto"
@@ -51,6 +53,19 @@ var templateFuncs template.FuncMap = map[string]interface{}{
 		b, _ := json.Marshal(rv)
 		return string(b)
 	},
+	// Offsets a port by n
+	"offset": func(port string, n int) (string, error) {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(p + n), nil
+	},
+	// Indents each line of str by n spaces
+	"indent": func(n int, str string) string {
+		pad := strings.Repeat(" ", n)
+		return pad + strings.Replace(strings.TrimRight(str, "\n"), "\n", "\n"+pad, -1)
+	},
 }
 
 const DefaultKeysExportFormat = `{
@@ -0,0 +1,280 @@
+package deployment
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// Network is a network of a node for each validator of a chain, which docker-compose or Kubernetes can stand up from
+// the manifests generated for it
+type Network struct {
+	ChainName string
+	// The Burrow docker image each node runs
+	Image string
+	// The ports each node serves on within its container, which docker-compose publishes offset by the node's index
+	PeerPort string
+	GRPCPort string
+	InfoPort string
+	Web3Port string
+	Nodes    []*Node
+}
+
+// Node is the node of a validator, reachable by its name on the network
+type Node struct {
+	Name  string
+	Index int
+	// The validator's key as stored in a keys directory
+	Key Key
+	// The Tendermint node key by which the node is known to its peers
+	NodeKey Key
+	// The Burrow config of the node in TOML, set once its peers are known
+	Config string
+}
+
+// NewNetwork makes a network of a node for each validator of pkg, whose keys must be in pkg
+func NewNetwork(pkg *Config, image string) (*Network, error) {
+	network := &Network{
+		ChainName: pkg.ChainName,
+		Image:     image,
+	}
+	for i, val := range pkg.Validators {
+		key, ok := pkg.Keys[val.Address]
+		if !ok {
+			return nil, fmt.Errorf("no key for validator %s (%v), which must be in a local keys directory", val.Name,
+				val.Address)
+		}
+		nodeKey, ok := pkg.Keys[val.NodeAddress]
+		if !ok {
+			return nil, fmt.Errorf("no node key for validator %s (%v)", val.Name, val.Address)
+		}
+		network.Nodes = append(network.Nodes, &Node{
+			Name:    fmt.Sprintf("burrow%03d", i),
+			Index:   i,
+			Key:     key,
+			NodeKey: nodeKey,
+		})
+	}
+	return network, nil
+}
+
+// PersistentPeers returns the peer addresses of the nodes other than node, by name
+func (network *Network) PersistentPeers(node *Node) string {
+	var peers []string
+	for _, n := range network.Nodes {
+		if n != node {
+			peers = append(peers, fmt.Sprintf("tcp://%s@%s:%s", strings.ToLower(n.NodeKey.Address.String()), n.Name,
+				network.PeerPort))
+		}
+	}
+	return strings.Join(peers, ",")
+}
+
+// WriteDockerCompose writes a docker-compose.yml to dir with a directory for each node holding its config and keys,
+// which is mounted as the working directory of the node's container
+func (network *Network) WriteDockerCompose(dir string) error {
+	for _, node := range network.Nodes {
+		nodeDir := filepath.Join(dir, node.Name)
+		for file, data := range map[string][]byte{
+			"burrow.toml": []byte(node.Config),
+			filepath.Join(".keys", "data", node.Key.Address.String()+".json"): node.Key.KeyJSON,
+			filepath.Join(".burrow", "config", "node_key.json"):               node.NodeKey.KeyJSON,
+		} {
+			err := writeFile(filepath.Join(nodeDir, file), data)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	compose, err := network.dump(DockerComposeTemplate)
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, "docker-compose.yml"), []byte(compose))
+}
+
+// KubernetesManifests returns the Kubernetes manifests for the network: for each node a Secret of its keys, a
+// ConfigMap of its config, a Service by its name, and a StatefulSet that runs it with a volume for its state
+func (network *Network) KubernetesManifests() (string, error) {
+	return network.dump(KubernetesTemplate)
+}
+
+func (network *Network) dump(tmpl *template.Template) (string, error) {
+	sb := new(strings.Builder)
+	err := tmpl.Execute(sb, network)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not generate %s", tmpl.Name())
+	}
+	return sb.String(), nil
+}
+
+func writeFile(file string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(file), 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0600)
+}
+
+// Each node runs as the user given by BURROW_UID and BURROW_GID, which should own the node directories so the keys
+// within them need only be readable by their owner
+const DockerComposeFormat = `# Network of {{ len .Nodes }} validators for chain {{ .ChainName }}
+version: "3"
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    image: {{ $.Image }}
+    user: "${BURROW_UID:-1000}:${BURROW_GID:-1000}"
+    working_dir: /burrow
+    command: ["start"]
+    volumes:
+      - ./{{ .Name }}:/burrow
+    ports:
+      - "{{ offset $.GRPCPort .Index }}:{{ $.GRPCPort }}"
+      - "{{ offset $.InfoPort .Index }}:{{ $.InfoPort }}"
+      - "{{ offset $.Web3Port .Index }}:{{ $.Web3Port }}"
+    restart: unless-stopped
+{{- end }}
+`
+
+var DockerComposeTemplate = template.Must(template.New("docker-compose.yml").
+	Funcs(templateFuncs).Parse(DockerComposeFormat))
+
+const KubernetesFormat = `# Network of {{ len .Nodes }} validators for chain {{ .ChainName }}
+{{- range .Nodes }}
+---
+apiVersion: v1
+kind: Secret
+type: Opaque
+metadata:
+  name: {{ .Name }}-keys
+  labels:
+    app: burrow
+    node: {{ .Name }}
+data:
+  {{ .Key.Address }}.json: {{ base64 .Key.KeyJSON }}
+  node_key.json: {{ base64 .NodeKey.KeyJSON }}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}-config
+  labels:
+    app: burrow
+    node: {{ .Name }}
+data:
+  burrow.toml: |
+{{ indent 4 .Config }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Name }}
+  labels:
+    app: burrow
+    node: {{ .Name }}
+spec:
+  selector:
+    app: burrow
+    node: {{ .Name }}
+  ports:
+    - name: peer
+      port: {{ $.PeerPort }}
+    - name: grpc
+      port: {{ $.GRPCPort }}
+    - name: info
+      port: {{ $.InfoPort }}
+    - name: web3
+      port: {{ $.Web3Port }}
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: {{ .Name }}
+  labels:
+    app: burrow
+    node: {{ .Name }}
+spec:
+  serviceName: {{ .Name }}
+  replicas: 1
+  selector:
+    matchLabels:
+      app: burrow
+      node: {{ .Name }}
+  template:
+    metadata:
+      labels:
+        app: burrow
+        node: {{ .Name }}
+    spec:
+      securityContext:
+        fsGroup: 101
+        runAsUser: 1000
+      initContainers:
+        - name: init-node-key
+          image: busybox
+          command:
+            - sh
+            - -c
+            - mkdir -p /burrow/.burrow/config && cp /node-key/node_key.json /burrow/.burrow/config/node_key.json
+          volumeMounts:
+            - name: data
+              mountPath: /burrow/.burrow
+            - name: keys
+              mountPath: /node-key
+      containers:
+        - name: node
+          image: {{ $.Image }}
+          workingDir: /burrow
+          args:
+            - start
+          ports:
+            - name: peer
+              containerPort: {{ $.PeerPort }}
+            - name: grpc
+              containerPort: {{ $.GRPCPort }}
+            - name: info
+              containerPort: {{ $.InfoPort }}
+            - name: web3
+              containerPort: {{ $.Web3Port }}
+          volumeMounts:
+            - name: data
+              mountPath: /burrow/.burrow
+            - name: config
+              mountPath: /burrow/burrow.toml
+              subPath: burrow.toml
+            - name: validator-key
+              mountPath: /burrow/.keys/data
+      volumes:
+        - name: config
+          configMap:
+            name: {{ .Name }}-config
+        - name: keys
+          secret:
+            secretName: {{ .Name }}-keys
+            defaultMode: 0400
+        - name: validator-key
+          secret:
+            secretName: {{ .Name }}-keys
+            defaultMode: 0400
+            items:
+              - key: {{ .Key.Address }}.json
+                path: {{ .Key.Address }}.json
+  volumeClaimTemplates:
+    - metadata:
+        name: data
+      spec:
+        accessModes: ["ReadWriteOnce"]
+        resources:
+          requests:
+            storage: 1Gi
+{{- end }}
+`
+
+var KubernetesTemplate = template.Must(template.New("Kubernetes manifests").
+	Funcs(templateFuncs).Parse(KubernetesFormat))
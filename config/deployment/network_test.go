@@ -0,0 +1,126 @@
+package deployment
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func testNetwork(t *testing.T, validators int) *Network {
+	pkg := &Config{
+		Keys:       make(map[crypto.Address]Key),
+		GenesisDoc: &genesis.GenesisDoc{ChainName: "test-chain"},
+	}
+	for i := 0; i < validators; i++ {
+		val := Validator{Name: string(rune('a' + i)), Address: crypto.Address{1, byte(i)},
+			NodeAddress: crypto.Address{2, byte(i)}}
+		pkg.Validators = append(pkg.Validators, val)
+		pkg.Keys[val.Address] = Key{Address: val.Address, KeyJSON: []byte(`{"key":"` + val.Name + `"}`)}
+		pkg.Keys[val.NodeAddress] = Key{Address: val.NodeAddress, KeyJSON: []byte(`{"node":"` + val.Name + `"}`)}
+	}
+	network, err := NewNetwork(pkg, "hyperledger/burrow:test")
+	require.NoError(t, err)
+	network.PeerPort = "26656"
+	network.GRPCPort = "10997"
+	network.InfoPort = "26658"
+	network.Web3Port = "26660"
+	for _, node := range network.Nodes {
+		node.Config = "PersistentPeers = \"" + network.PersistentPeers(node) + "\"\n[Keys]\n  KeysDirectory = \".keys\"\n"
+	}
+	return network
+}
+
+func TestNetwork_PersistentPeers(t *testing.T) {
+	network := testNetwork(t, 3)
+	require.Len(t, network.Nodes, 3)
+	assert.Equal(t, "burrow001", network.Nodes[1].Name)
+	assert.Equal(t, "tcp://0200000000000000000000000000000000000000@burrow000:26656,"+
+		"tcp://0202000000000000000000000000000000000000@burrow002:26656", network.PersistentPeers(network.Nodes[1]))
+}
+
+func TestNewNetwork_MissingKey(t *testing.T) {
+	pkg := &Config{
+		Keys:       make(map[crypto.Address]Key),
+		Validators: []Validator{{Name: "a", Address: crypto.Address{1}, NodeAddress: crypto.Address{2}}},
+		GenesisDoc: &genesis.GenesisDoc{},
+	}
+	_, err := NewNetwork(pkg, "image")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be in a local keys directory")
+}
+
+func TestNetwork_WriteDockerCompose(t *testing.T) {
+	network := testNetwork(t, 2)
+	dir, err := ioutil.TempDir("", "network")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, network.WriteDockerCompose(dir))
+
+	bs, err := ioutil.ReadFile(filepath.Join(dir, "docker-compose.yml"))
+	require.NoError(t, err)
+	compose := make(map[string]interface{})
+	require.NoError(t, yaml.Unmarshal(bs, &compose))
+	services := compose["services"].(map[interface{}]interface{})
+	require.Len(t, services, 2)
+	node := services["burrow001"].(map[interface{}]interface{})
+	assert.Equal(t, "hyperledger/burrow:test", node["image"])
+	assert.Equal(t, []interface{}{"./burrow001:/burrow"}, node["volumes"])
+	assert.Equal(t, []interface{}{"10998:10997", "26659:26658", "26661:26660"}, node["ports"])
+
+	for file, expected := range map[string]string{
+		"burrow001/.keys/data/0101000000000000000000000000000000000000.json": `{"key":"b"}`,
+		"burrow001/.burrow/config/node_key.json":                             `{"node":"b"}`,
+		"burrow001/burrow.toml":                                              network.Nodes[1].Config,
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		bs, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(bs))
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+}
+
+func TestNetwork_KubernetesManifests(t *testing.T) {
+	network := testNetwork(t, 2)
+	manifests, err := network.KubernetesManifests()
+	require.NoError(t, err)
+
+	decoder := yaml.NewDecoder(strings.NewReader(manifests))
+	var kinds []string
+	for {
+		doc := struct {
+			Kind     string
+			Metadata struct{ Name string }
+			Data     map[string]string
+		}{}
+		err := decoder.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		kinds = append(kinds, doc.Kind+"/"+doc.Metadata.Name)
+		switch doc.Kind {
+		case "ConfigMap":
+			// The config survives indentation as a block scalar
+			assert.Equal(t, network.Nodes[len(kinds)/4].Config, doc.Data["burrow.toml"])
+		case "Secret":
+			assert.Contains(t, doc.Data, "node_key.json")
+		}
+	}
+	assert.Equal(t, []string{
+		"Secret/burrow000-keys", "ConfigMap/burrow000-config", "Service/burrow000", "StatefulSet/burrow000",
+		"Secret/burrow001-keys", "ConfigMap/burrow001-config", "Service/burrow001", "StatefulSet/burrow001",
+	}, kinds)
+	assert.Contains(t, manifests, "image: hyperledger/burrow:test")
+}
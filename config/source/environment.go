@@ -0,0 +1,191 @@
+package source
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+// Source each field of config from an environment variable named for the path to the field from prefix, in upper case
+// and separated by underscores, so RPC.GRPC.ListenPort is overridden by PREFIX_RPC_GRPC_LISTENPORT. The fields of
+// embedded structs are named as if they belonged to the struct embedding them. Values are read as a Go program would
+// write them, with durations such as 5s, lists separated by commas, and any type that can unmarshal itself from text
+// (such as addresses) doing so. Values of any other type, lists starting with [, and whole structs are read as JSON,
+// so PREFIX_LOGGING may hold all of the Logging config while PREFIX_LOGGING_TRACE overrides one field of it. Environment
+// variables with the prefix that name no field are ignored, since they may be meant for command line options.
+func EnvironmentOverrides(prefix string) *configSource {
+	env := environmentWithPrefix(prefix + "_")
+	return &configSource{
+		skip: len(env) == 0,
+		from: fmt.Sprintf("'%s_' environment variables", prefix),
+		apply: func(baseConfig interface{}) error {
+			rv := reflect.ValueOf(baseConfig)
+			if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+				return fmt.Errorf("environment overrides can only be applied to a pointer to a struct but got %T",
+					baseConfig)
+			}
+			return overrideStruct(rv.Elem(), prefix, env)
+		},
+	}
+}
+
+// Returns the environment variables starting with prefix by name
+func environmentWithPrefix(prefix string) map[string]string {
+	env := make(map[string]string)
+	for _, kv := range os.Environ() {
+		i := strings.Index(kv, "=")
+		if i > 0 && strings.HasPrefix(kv[:i], prefix) {
+			env[kv[:i]] = kv[i+1:]
+		}
+	}
+	return env
+}
+
+func overrideStruct(rv reflect.Value, path string, env map[string]string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" || field.Tag.Get("json") == "-" && field.Tag.Get("toml") == "-" {
+			continue
+		}
+		fieldPath := path + "_" + strings.ToUpper(field.Name)
+		if field.Anonymous {
+			fieldPath = path
+		}
+		err := overrideValue(rv.Field(i), fieldPath, env)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func overrideValue(rv reflect.Value, path string, env map[string]string) error {
+	str, ok := env[path]
+	if ok {
+		var err error
+		if isLeaf(rv.Type()) {
+			err = setValue(rv, str)
+		} else {
+			// A struct as a whole is read as JSON, and may then have its fields overridden in turn
+			if rv.Kind() == reflect.Ptr && rv.IsNil() {
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			err = json.Unmarshal([]byte(str), rv.Addr().Interface())
+		}
+		if err != nil {
+			return fmt.Errorf("could not set config from environment variable %s: %v", path, err)
+		}
+	}
+	// Descend into structs, allocating those that are nil only if a field within them is set
+	switch {
+	case isLeaf(rv.Type()):
+		return nil
+	case rv.Kind() == reflect.Struct:
+		return overrideStruct(rv, path, env)
+	case rv.Kind() == reflect.Ptr && hasPrefix(env, path+"_"):
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return overrideStruct(rv.Elem(), path, env)
+	}
+	return nil
+}
+
+// Whether values of type are set from an environment variable as a whole rather than field by field
+func isLeaf(rt reflect.Type) bool {
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return rt.Kind() != reflect.Struct || reflect.PtrTo(rt).Implements(textUnmarshalerType)
+}
+
+func hasPrefix(env map[string]string, prefix string) bool {
+	for key := range env {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func setValue(rv reflect.Value, str string) error {
+	if rv.Kind() == reflect.Ptr {
+		ptr := reflect.New(rv.Type().Elem())
+		err := setValue(ptr.Elem(), str)
+		if err != nil {
+			return err
+		}
+		rv.Set(ptr)
+		return nil
+	}
+	if reflect.PtrTo(rv.Type()).Implements(textUnmarshalerType) {
+		return rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str))
+	}
+	if rv.Type() == durationType {
+		duration, err := time.ParseDuration(str)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(int64(duration))
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(str)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(str, 0, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(str, 0, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(str, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	case reflect.Slice:
+		if strings.HasPrefix(strings.TrimSpace(str), "[") || !isLeaf(rv.Type().Elem()) ||
+			rv.Type().Elem().Kind() == reflect.Slice || rv.Type().Elem().Kind() == reflect.Map {
+			return json.Unmarshal([]byte(str), rv.Addr().Interface())
+		}
+		var parts []string
+		if str != "" {
+			parts = strings.Split(str, ",")
+		}
+		slice := reflect.MakeSlice(rv.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			err := setValue(slice.Index(i), strings.TrimSpace(part))
+			if err != nil {
+				return err
+			}
+		}
+		rv.Set(slice)
+	default:
+		return json.Unmarshal([]byte(str), rv.Addr().Interface())
+	}
+	return nil
+}
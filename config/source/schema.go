@@ -0,0 +1,47 @@
+package source
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/alecthomas/jsonschema"
+)
+
+var (
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// Schema returns a JSON schema for config files (in JSON, or YAML with the same fields) that decode into conf, so that
+// editors can check and complete them. No field is required since any left out take their default, but misspelt fields
+// are not allowed.
+func Schema(conf interface{}) *jsonschema.Schema {
+	reflector := &jsonschema.Reflector{
+		RequiredFromJSONSchemaTags: true,
+		TypeMapper:                 schemaType,
+	}
+	return reflector.Reflect(conf)
+}
+
+// Types that serialise themselves are described by what they serialise to rather than their fields
+func schemaType(rt reflect.Type) *jsonschema.Type {
+	if rt == timeType {
+		return nil
+	}
+	if implements(rt, textMarshalerType) {
+		// Addresses, public keys, hex bytes and the like
+		return &jsonschema.Type{Type: "string"}
+	}
+	if implements(rt, jsonMarshalerType) {
+		// Anything at all
+		return &jsonschema.Type{}
+	}
+	return nil
+}
+
+func implements(rt, iface reflect.Type) bool {
+	return rt.Implements(iface) || rt.Kind() != reflect.Ptr && reflect.PtrTo(rt).Implements(iface)
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 
@@ -38,6 +39,7 @@ type Format string
 const (
 	JSON    Format = "JSON"
 	TOML    Format = "TOML"
+	YAML    Format = "YAML"
 	Unknown Format = ""
 )
 
@@ -45,6 +47,9 @@ var LogWriter io.Writer
 
 var jsonRegex = regexp.MustCompile(`^\s*{`)
 
+// A YAML document may be marked as such by starting with ---
+var yamlRegex = regexp.MustCompile(`^\s*---`)
+
 type configSource struct {
 	from  string
 	skip  bool
@@ -201,7 +206,7 @@ func FromFile(configFile string, conf interface{}) error {
 		return err
 	}
 
-	return FromString(string(bs), conf)
+	return FromStringAs(DetectFileFormat(configFile, string(bs)), string(bs), conf)
 }
 
 func FromTOMLString(tomlString string, conf interface{}) error {
@@ -213,23 +218,79 @@ func FromTOMLString(tomlString string, conf interface{}) error {
 }
 
 func FromString(configString string, conf interface{}) error {
-	switch DetectFormat(configString) {
+	return FromStringAs(DetectFormat(configString), configString, conf)
+}
+
+func FromStringAs(format Format, configString string, conf interface{}) error {
+	switch format {
 	case JSON:
 		return FromJSONString(configString, conf)
 	case TOML:
 		return FromTOMLString(configString, conf)
+	case YAML:
+		return FromYAMLString(configString, conf)
 	default:
 		return fmt.Errorf("unknown configuration format:\n%s", configString)
 	}
 }
 
+// UnknownFields returns the keys of configString that name no field of conf, which would otherwise be silently ignored
+// (for JSON and YAML only the first is found)
+func UnknownFields(configString string, conf interface{}) ([]string, error) {
+	return UnknownFieldsAs(DetectFormat(configString), configString, conf)
+}
+
+func UnknownFieldsAs(format Format, configString string, conf interface{}) ([]string, error) {
+	switch format {
+	case YAML:
+		jsonString, err := yamlToJSON(configString)
+		if err != nil {
+			return nil, err
+		}
+		return UnknownFieldsAs(JSON, jsonString, conf)
+	case JSON:
+		decoder := json.NewDecoder(strings.NewReader(configString))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(conf)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "json: unknown field ") {
+				return []string{strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)}, nil
+			}
+			return nil, err
+		}
+		return nil, nil
+	default:
+		md, err := toml.Decode(configString, conf)
+		if err != nil {
+			return nil, err
+		}
+		var unknown []string
+		for _, key := range md.Undecoded() {
+			unknown = append(unknown, key.String())
+		}
+		return unknown, nil
+	}
+}
+
 func DetectFormat(configString string) Format {
 	if jsonRegex.MatchString(configString) {
 		return JSON
 	}
+	if yamlRegex.MatchString(configString) {
+		return YAML
+	}
 	return TOML
 }
 
+// DetectFileFormat takes files ending .yaml or .yml to be YAML and otherwise detects the format from their contents
+func DetectFileFormat(configFile, configString string) Format {
+	switch strings.ToLower(filepath.Ext(configFile)) {
+	case ".yaml", ".yml":
+		return YAML
+	}
+	return DetectFormat(configString)
+}
+
 func FromJSONString(jsonString string, conf interface{}) error {
 	err := json.Unmarshal(([]byte)(jsonString), conf)
 	if err != nil {
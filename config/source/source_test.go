@@ -2,8 +2,10 @@ package source
 
 import (
 	"io/ioutil"
+	"net"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,6 +80,23 @@ func TestDetectFormat(t *testing.T) {
 	assert.Equal(t, TOML, DetectFormat("[Tendermint]\n  Seeds =\"foobar@val0\"}"))
 }
 
+func TestUnknownFields(t *testing.T) {
+	unknown, err := UnknownFields(TOMLString(newTestConfig()), new(animalConfig))
+	require.NoError(t, err)
+	assert.Empty(t, unknown)
+
+	unknown, err = UnknownFields("Name = \"Froggy\"\nNumLgs = 2\n[[Legs]]\n  Leg = 1\n  Color = 3\n", new(animalConfig))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"NumLgs", "Legs.Color"}, unknown)
+
+	unknown, err = UnknownFields(`{"Name": "Froggy", "Tail": true}`, new(animalConfig))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Tail"}, unknown)
+
+	_, err = UnknownFields(`{"Name": 2}`, new(animalConfig))
+	require.Error(t, err)
+}
+
 func writeConfigFile(t *testing.T, conf interface{}) string {
 	tomlString := TOMLString(conf)
 	f, err := ioutil.TempFile("", "source-test.toml")
@@ -116,3 +135,122 @@ func newTestConfig() *animalConfig {
 		},
 	}
 }
+
+func TestEnvironmentOverrides(t *testing.T) {
+	type Server struct {
+		Host string
+		Port uint16
+	}
+	type metrics struct {
+		Server
+		Path string
+	}
+	type config struct {
+		Name     string
+		Enabled  bool
+		Timeout  time.Duration
+		Peers    []string
+		IP       net.IP
+		Tags     map[string]int
+		Server   *Server
+		Admin    *Server
+		Metrics  metrics
+		Legs     []legConfig
+		internal string
+	}
+	for key, value := range map[string]string{
+		"TEST_NAME":            "node",
+		"TEST_ENABLED":         "true",
+		"TEST_TIMEOUT":         "90s",
+		"TEST_PEERS":           "a, b",
+		"TEST_IP":              "10.0.0.1",
+		"TEST_TAGS":            `{"x": 1}`,
+		"TEST_SERVER_PORT":     "0x10",
+		"TEST_ADMIN":           `{"Host": "admin", "Port": 1}`,
+		"TEST_ADMIN_PORT":      "2",
+		"TEST_METRICS_HOST":    "metrics",
+		"TEST_LEGS":            `[{"Leg": 3}]`,
+		"TEST_INTERNAL":        "ignored",
+		"TEST_NO_SUCH_FIELD":   "ignored",
+		"TEST_METRICS_NOTHING": "ignored",
+	} {
+		os.Setenv(key, value)
+		defer os.Unsetenv(key)
+	}
+	conf := &config{Name: "default", Server: &Server{Host: "localhost", Port: 80}, Metrics: metrics{Path: "/metrics"}}
+	require.NoError(t, EnvironmentOverrides("TEST").Apply(conf))
+	assert.Equal(t, &config{
+		Name:    "node",
+		Enabled: true,
+		Timeout: 90 * time.Second,
+		Peers:   []string{"a", "b"},
+		IP:      net.ParseIP("10.0.0.1"),
+		Tags:    map[string]int{"x": 1},
+		Server:  &Server{Host: "localhost", Port: 16},
+		Admin:   &Server{Host: "admin", Port: 2},
+		Metrics: metrics{Server: Server{Host: "metrics"}, Path: "/metrics"},
+		Legs:    []legConfig{{Leg: 3}},
+	}, conf)
+
+	os.Setenv("TEST_ENABLED", "maybe")
+	err := EnvironmentOverrides("TEST").Apply(conf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TEST_ENABLED")
+
+	// Nothing to override
+	assert.True(t, EnvironmentOverrides("NO_SUCH_PREFIX").Skip())
+}
+
+func TestYAML(t *testing.T) {
+	yamlString := YAMLString(newTestConfig())
+	assert.Equal(t, "Name: Froggy!\nNumLegs: 2\nLegs:\n  - Leg: 1\n    Colour: 28\n  - Leg: 2\n    Colour: 28\n", yamlString)
+	conf := new(animalConfig)
+	require.NoError(t, FromYAMLString(yamlString, conf))
+	assert.Equal(t, newTestConfig(), conf)
+
+	// Field names are matched as in JSON and YAML's own syntax is understood
+	conf = new(animalConfig)
+	require.NoError(t, FromYAMLString("leg: &leg\n  Leg: 0x10\nname: 'true'\nlegs:\n  - <<: *leg\n    colour: 1_0\n", conf))
+	assert.Equal(t, &animalConfig{Name: "true", Legs: []legConfig{{Leg: 16, Colour: 10}}}, conf)
+	assert.Equal(t, "Name: \"true\"\nNumLegs: 0\nLegs:\n  - Leg: 16\n    Colour: 10\n", YAMLString(conf))
+
+	unknown, err := UnknownFieldsAs(YAML, "Name: Froggy\nTail: true\n", new(animalConfig))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Tail"}, unknown)
+}
+
+func TestYAMLStringWithComments(t *testing.T) {
+	commented := `# yaml-language-server: $schema=animal.schema.json
+
+# What to call it
+name: Froggy!
+NumLegs: 2 # At least
+Legs:
+  # The first
+  - Leg: 1
+    Tail: false # Gone
+`
+	conf := new(animalConfig)
+	require.NoError(t, FromYAMLString(commented, conf))
+	conf.Legs = append(conf.Legs, legConfig{Leg: 2})
+	assert.Equal(t, `# yaml-language-server: $schema=animal.schema.json
+
+# What to call it
+Name: Froggy!
+NumLegs: 2 # At least
+Legs:
+  # The first
+  - Leg: 1
+    Colour: 0
+  - Leg: 2
+    Colour: 0
+`, YAMLStringWithComments(conf, commented))
+}
+
+func TestDetectFileFormat(t *testing.T) {
+	assert.Equal(t, YAML, DetectFileFormat("burrow.yaml", "{}"))
+	assert.Equal(t, YAML, DetectFileFormat("BURROW.YML", ""))
+	assert.Equal(t, YAML, DetectFileFormat("-", "---\nName: Froggy"))
+	assert.Equal(t, JSON, DetectFileFormat("burrow.toml", "{}"))
+	assert.Equal(t, TOML, DetectFileFormat("burrow.toml", "Name = \"Froggy\""))
+}
@@ -0,0 +1,201 @@
+package source
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAML is read by converting it to JSON so that it names fields as JSON does (by their Go names or json tags, and
+// regardless of case) and values are decoded by the same UnmarshalJSON and UnmarshalText methods
+func FromYAMLString(yamlString string, conf interface{}) error {
+	jsonString, err := yamlToJSON(yamlString)
+	if err != nil {
+		return err
+	}
+	return FromJSONString(jsonString, conf)
+}
+
+// YAMLString serialises conf as YAML with the same field names and values as JSONString, and in the same order
+func YAMLString(conf interface{}) string {
+	return YAMLStringWithComments(conf, "")
+}
+
+// YAMLStringWithComments serialises conf as YAML carrying over the comments of commentsFrom, a YAML document such as
+// an existing config file that conf was read from, to the fields at the same paths so that rewriting a config file
+// does not lose its annotations. Comments on fields that conf does not have are dropped.
+func YAMLStringWithComments(conf interface{}, commentsFrom string) string {
+	node, err := yamlNode(conf)
+	if err != nil {
+		return fmt.Sprintf("<Could not serialise config: %v>", err)
+	}
+	if commentsFrom != "" {
+		from := new(yaml.Node)
+		err = yaml.Unmarshal([]byte(commentsFrom), from)
+		if err != nil {
+			return fmt.Sprintf("<Could not read comments from config: %v>", err)
+		}
+		copyComments(node, from)
+	}
+	buf := new(bytes.Buffer)
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	err = encoder.Encode(node)
+	if err != nil {
+		return fmt.Sprintf("<Could not serialise config: %v>", err)
+	}
+	return buf.String()
+}
+
+// Returns the document node of conf as YAML in block style. Since JSON is YAML we keep the order of fields that
+// json.Marshal gives us by parsing its output rather than going via a map.
+func yamlNode(conf interface{}) (*yaml.Node, error) {
+	bs, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+	node := new(yaml.Node)
+	err = yaml.Unmarshal(bs, node)
+	if err != nil {
+		return nil, err
+	}
+	clearStyle(node)
+	return node, nil
+}
+
+func clearStyle(node *yaml.Node) {
+	// The tags are kept so that strings that look like other things stay quoted
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
+
+func copyComments(to, from *yaml.Node) {
+	if to == nil || from == nil {
+		return
+	}
+	to.HeadComment = from.HeadComment
+	to.LineComment = from.LineComment
+	to.FootComment = from.FootComment
+	switch {
+	case to.Kind == yaml.DocumentNode && from.Kind == yaml.DocumentNode:
+		if len(to.Content) > 0 && len(from.Content) > 0 {
+			copyComments(to.Content[0], from.Content[0])
+		}
+	case to.Kind == yaml.MappingNode && from.Kind == yaml.MappingNode:
+		// Keys are matched as JSON matches them to fields
+		fromValues := make(map[string][2]*yaml.Node)
+		for i := 0; i+1 < len(from.Content); i += 2 {
+			fromValues[strings.ToLower(from.Content[i].Value)] = [2]*yaml.Node{from.Content[i], from.Content[i+1]}
+		}
+		for i := 0; i+1 < len(to.Content); i += 2 {
+			if kv, ok := fromValues[strings.ToLower(to.Content[i].Value)]; ok {
+				copyComments(to.Content[i], kv[0])
+				copyComments(to.Content[i+1], kv[1])
+			}
+		}
+	case to.Kind == yaml.SequenceNode && from.Kind == yaml.SequenceNode:
+		for i := 0; i < len(to.Content) && i < len(from.Content); i++ {
+			copyComments(to.Content[i], from.Content[i])
+		}
+	}
+}
+
+func yamlToJSON(yamlString string) (string, error) {
+	node := new(yaml.Node)
+	err := yaml.Unmarshal([]byte(yamlString), node)
+	if err != nil {
+		return "", err
+	}
+	if len(node.Content) == 0 {
+		// An empty document
+		return "{}", nil
+	}
+	value, err := jsonValue(node.Content[0])
+	if err != nil {
+		return "", err
+	}
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// Converts a YAML node into a value that encodes as the equivalent JSON, keeping integers as json.Number so that none
+// lose precision on the way
+func jsonValue(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return jsonValue(node.Alias)
+	case yaml.MappingNode:
+		obj := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			// Merge keys (<<: *anchor) bring in the fields of another mapping without overriding any given here
+			if key.ShortTag() == "!!merge" {
+				merged, err := jsonValue(value)
+				if err != nil {
+					return nil, err
+				}
+				mergedObj, ok := merged.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("line %d: can only merge a mapping into a mapping", key.Line)
+				}
+				for k, v := range mergedObj {
+					if _, ok := obj[k]; !ok {
+						obj[k] = v
+					}
+				}
+				continue
+			}
+			v, err := jsonValue(value)
+			if err != nil {
+				return nil, err
+			}
+			obj[key.Value] = v
+		}
+		return obj, nil
+	case yaml.SequenceNode:
+		arr := make([]interface{}, len(node.Content))
+		for i, child := range node.Content {
+			v, err := jsonValue(child)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = v
+		}
+		return arr, nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return nil, nil
+		case "!!bool":
+			var b bool
+			err := node.Decode(&b)
+			return b, err
+		case "!!int":
+			// YAML allows 0x and 0o prefixes and underscores, which JSON does not
+			var i int64
+			if err := node.Decode(&i); err == nil {
+				return json.Number(strconv.FormatInt(i, 10)), nil
+			}
+			var u uint64
+			err := node.Decode(&u)
+			return json.Number(strconv.FormatUint(u, 10)), err
+		case "!!float":
+			var f float64
+			err := node.Decode(&f)
+			return f, err
+		default:
+			return node.Value, nil
+		}
+	default:
+		return nil, fmt.Errorf("line %d: unexpected YAML node", node.Line)
+	}
+}
@@ -0,0 +1,338 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/storage"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Problems are everything found wrong with a config, each saying which field to change
+type Problems []string
+
+func (ps Problems) Error() string {
+	return fmt.Sprintf("%d problem(s) with config:\n  %s", len(ps), strings.Join(ps, "\n  "))
+}
+
+func (ps *Problems) add(format string, args ...interface{}) {
+	*ps = append(*ps, fmt.Sprintf(format, args...))
+}
+
+// Validate checks everything about the config that would otherwise stop the node starting, or stop it later on, as
+// far as it can be checked without starting it: that values are in range and consistent with each other, that the
+// files and directories it names are there, and that the validator's key can be found. It returns Problems listing
+// all it finds rather than stopping at the first.
+func (conf *BurrowConfig) Validate() error {
+	var ps Problems
+	if conf.ValidatorAddress == nil && !conf.ReadOnly() {
+		ps.add("no ValidatorAddress: set it in the config or pass --address, --index, or --validator")
+	}
+	conf.validateGenesis(&ps)
+	conf.validateStorage(&ps)
+	conf.validateKeys(&ps)
+	conf.validateTendermint(&ps)
+	conf.validateExecution(&ps)
+	conf.validateListeners(&ps)
+	conf.validateFaucet(&ps)
+	if len(ps) > 0 {
+		return ps
+	}
+	return nil
+}
+
+func (conf *BurrowConfig) validateGenesis(ps *Problems) {
+	genesisDoc := conf.GenesisDoc
+	if genesisDoc == nil {
+		ps.add("no GenesisDoc: embed one in the config, pass --genesis, or put %s in the working directory",
+			DefaultGenesisDocJSONFileName)
+		return
+	}
+	if len(genesisDoc.Validators) == 0 {
+		ps.add("GenesisDoc has no Validators so no blocks can ever be made")
+	}
+	for _, account := range genesisDoc.Accounts {
+		if locked := account.AcmAccount().LockedBalance(0); locked > account.Amount {
+			ps.add("GenesisDoc account %v locks %d but has an Amount of only %d", account.Address, locked,
+				account.Amount)
+		}
+	}
+	err := execution.ParamsFromGenesis(genesisDoc).Validate()
+	if err != nil {
+		ps.add("GenesisDoc.Params: %v", err)
+	}
+}
+
+func (conf *BurrowConfig) validateStorage(ps *Problems) {
+	if conf.BurrowDir == "" {
+		ps.add("BurrowDir must be set to the directory holding the node's state, such as .burrow")
+	} else if info, err := os.Stat(conf.BurrowDir); err == nil && !info.IsDir() {
+		ps.add("BurrowDir %s is a file rather than a directory", conf.BurrowDir)
+	}
+	// Opening a throwaway database tells us whether this binary was built with the backend
+	dir, err := ioutil.TempDir("", "burrow-validate")
+	if err == nil {
+		defer os.RemoveAll(dir)
+		db, err := storage.NewDB("validate", conf.Backend(), dir)
+		if err != nil {
+			ps.add("DBBackend %s cannot be used: %v", conf.Backend(), err)
+		} else {
+			db.Close()
+		}
+	}
+	if conf.ColdStorage != nil {
+		cold := conf.ColdStorage
+		switch {
+		case !cold.Enabled():
+			if cold.HotBlocks > 0 {
+				ps.add("ColdStorage.HotBlocks is set but there is no ColdStorage.Location to move old blocks to")
+			}
+		case strings.HasPrefix(cold.Location, "s3://"):
+			if strings.Trim(strings.TrimPrefix(cold.Location, "s3://"), "/") == "" {
+				ps.add("ColdStorage.Location %s names no S3 bucket, use s3://bucket or s3://bucket/prefix",
+					cold.Location)
+			}
+		default:
+			if info, err := os.Stat(cold.Location); err == nil && !info.IsDir() {
+				ps.add("ColdStorage.Location %s is a file rather than a directory", cold.Location)
+			}
+		}
+	}
+	if conf.DBEncryption.Enabled() {
+		enc := conf.DBEncryption
+		sources := 0
+		for _, source := range []string{enc.KeyFile, enc.KeyEnv, enc.KeyCommand} {
+			if source != "" {
+				sources++
+			}
+		}
+		switch {
+		case sources > 1:
+			ps.add("only one of DBEncryption.KeyFile, KeyEnv, and KeyCommand may be set")
+		case enc.KeyFile != "":
+			if _, err := os.Stat(enc.KeyFile); err != nil {
+				ps.add("DBEncryption.KeyFile cannot be read: %v", err)
+			}
+		case enc.KeyEnv != "":
+			if os.Getenv(enc.KeyEnv) == "" {
+				ps.add("DBEncryption.KeyEnv names environment variable %s, which is not set", enc.KeyEnv)
+			}
+		}
+	}
+}
+
+func (conf *BurrowConfig) validateKeys(ps *Problems) {
+	if conf.Keys == nil {
+		ps.add("no Keys config: add a [Keys] section giving KeysDirectory or RemoteAddress")
+		return
+	}
+	// A remote key server is only known to be there once we connect to it
+	if conf.Keys.RemoteAddress != "" {
+		return
+	}
+	info, err := os.Stat(conf.Keys.KeysDirectory)
+	if err != nil || !info.IsDir() {
+		if conf.ValidatorAddress != nil && !conf.ReadOnly() {
+			ps.add("Keys.KeysDirectory %s is not a directory so holds no key for ValidatorAddress %v, set it to "+
+				"where your keys are or give Keys.RemoteAddress", conf.Keys.KeysDirectory, *conf.ValidatorAddress)
+		}
+		return
+	}
+	if conf.ValidatorAddress == nil || conf.ReadOnly() {
+		return
+	}
+	address := *conf.ValidatorAddress
+	if _, err := os.Stat(filepath.Join(conf.Keys.KeysDirectory, "data")); err != nil {
+		ps.add("no key for ValidatorAddress %v in Keys.KeysDirectory %s", address, conf.Keys.KeysDirectory)
+		return
+	}
+	var passphrase string
+	if conf.Passphrase != nil {
+		passphrase = *conf.Passphrase
+	}
+	_, err = keys.NewFilesystemKeyStore(conf.Keys.KeysDirectory, conf.Keys.AllowBadFilePermissions).
+		GetKey(passphrase, address.Bytes())
+	switch {
+	case os.IsNotExist(err):
+		ps.add("no key for ValidatorAddress %v in Keys.KeysDirectory %s", address, conf.Keys.KeysDirectory)
+	case err != nil:
+		ps.add("could not read key for ValidatorAddress %v (set Keys.AllowBadFilePermissions if the key file is "+
+			"meant to be readable by others): %v", address, err)
+	}
+}
+
+func (conf *BurrowConfig) validateTendermint(ps *Problems) {
+	if conf.Tendermint == nil || !conf.Tendermint.Enabled {
+		return
+	}
+	if conf.Execution == nil {
+		// Reported by validateExecution
+		return
+	}
+	tmConf, err := conf.TendermintConfig()
+	if err != nil {
+		ps.add("Tendermint: %v", err)
+	} else if err = tmConf.ValidateBasic(); err != nil {
+		ps.add("Tendermint: %v", err)
+	}
+	if _, _, err := conf.Tendermint.Retention(); err != nil {
+		ps.add("Tendermint: %v", err)
+	}
+	if _, _, err := conf.Tendermint.Halt(); err != nil {
+		ps.add("Tendermint: %v", err)
+	}
+	peers := map[string]string{
+		"Seeds":           conf.Tendermint.Seeds,
+		"PersistentPeers": conf.Tendermint.PersistentPeers,
+	}
+	for _, field := range []string{"Seeds", "PersistentPeers"} {
+		for _, peer := range strings.Split(peers[field], ",") {
+			peer = strings.TrimSpace(peer)
+			if peer == "" {
+				continue
+			}
+			err := validatePeer(peer)
+			if err != nil {
+				ps.add("Tendermint.%s: peer %s %v, it should look like tcp://<node ID>@<host>:<port>", field,
+					peer, err)
+			}
+		}
+	}
+}
+
+func validatePeer(peer string) error {
+	peer = strings.TrimPrefix(peer, "tcp://")
+	i := strings.Index(peer, "@")
+	if i < 0 {
+		return fmt.Errorf("has no node ID")
+	}
+	id, err := hex.DecodeString(peer[:i])
+	if err != nil || len(id) != crypto.AddressLength {
+		return fmt.Errorf("has node ID %s that is not %d hex bytes", peer[:i], crypto.AddressLength)
+	}
+	_, port, err := net.SplitHostPort(peer[i+1:])
+	if err != nil {
+		return err
+	}
+	return validatePort(port)
+}
+
+func (conf *BurrowConfig) validateExecution(ps *Problems) {
+	ec := conf.Execution
+	if ec == nil {
+		ps.add("no Execution config: add an [Execution] section")
+		return
+	}
+	if ec.TimeoutFactor < 0 {
+		ps.add("Execution.TimeoutFactor %v must not be negative", ec.TimeoutFactor)
+	}
+	for _, option := range ec.VMOptions {
+		if option != execution.DebugOpcodes && option != execution.DumpTokens {
+			ps.add("Execution.VMOptions: '%s' is not one of %s or %s", option, execution.DebugOpcodes,
+				execution.DumpTokens)
+		}
+	}
+	for _, path := range ec.NativePlugins {
+		if _, err := os.Stat(path); err != nil {
+			ps.add("Execution.NativePlugins: cannot load plugin: %v", err)
+		}
+	}
+	if ec.ParallelWorkers < 0 {
+		ps.add("Execution.ParallelWorkers %d must not be negative (0 for the number of CPUs)", ec.ParallelWorkers)
+	}
+	if ec.CodeCacheSize < 0 {
+		ps.add("Execution.CodeCacheSize %d must not be negative (0 for the default)", ec.CodeCacheSize)
+	}
+}
+
+// Checks the port of each enabled server and that no two of them listen on the same one
+func (conf *BurrowConfig) validateListeners(ps *Problems) {
+	type listener struct {
+		field string
+		host  string
+		port  string
+	}
+	var listeners []listener
+	if conf.Tendermint != nil && conf.Tendermint.Enabled {
+		listeners = append(listeners, listener{"Tendermint", conf.Tendermint.ListenHost, conf.Tendermint.ListenPort})
+	}
+	if conf.RPC != nil {
+		servers := map[string]*rpc.ServerConfig{
+			"RPC.Info":     conf.RPC.Info,
+			"RPC.Profiler": conf.RPC.Profiler,
+			"RPC.GRPC":     conf.RPC.GRPC,
+			"RPC.Web3":     conf.RPC.Web3,
+			"RPC.Admin":    conf.RPC.Admin,
+		}
+		if conf.RPC.Metrics != nil {
+			servers["RPC.Metrics"] = &conf.RPC.Metrics.ServerConfig
+		}
+		if conf.RPC.Faucet != nil {
+			servers["RPC.Faucet"] = &conf.RPC.Faucet.ServerConfig
+		}
+		for field, server := range servers {
+			if server != nil && server.Enabled {
+				listeners = append(listeners, listener{field, server.ListenHost, server.ListenPort})
+			}
+		}
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		return listeners[i].field < listeners[j].field
+	})
+	for i, l := range listeners {
+		err := validatePort(l.port)
+		if err != nil {
+			ps.add("%s.ListenPort: %v", l.field, err)
+			continue
+		}
+		// Port 0 picks a free port
+		if l.port == "0" {
+			continue
+		}
+		for _, other := range listeners[:i] {
+			if other.port == l.port && (other.host == l.host || anyHost(other.host) || anyHost(l.host)) {
+				ps.add("%s and %s both listen on port %s, change the ListenPort of one of them", other.field,
+					l.field, l.port)
+			}
+		}
+	}
+}
+
+func (conf *BurrowConfig) validateFaucet(ps *Problems) {
+	if conf.RPC == nil || conf.RPC.Faucet == nil || !conf.RPC.Faucet.Enabled {
+		return
+	}
+	faucet := conf.RPC.Faucet
+	if faucet.Address == nil {
+		ps.add("RPC.Faucet.Address must be set to the account the faucet sends tokens from")
+	}
+	if faucet.Amount == 0 {
+		ps.add("RPC.Faucet.Amount must be set to the amount sent for each request")
+	}
+	if _, err := time.ParseDuration(faucet.Interval); err != nil {
+		ps.add("RPC.Faucet.Interval '%s' is not a duration like 1h: %v", faucet.Interval, err)
+	}
+}
+
+func validatePort(port string) error {
+	_, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return fmt.Errorf("port '%s' is not a number from 0 to 65535", port)
+	}
+	return nil
+}
+
+func anyHost(host string) bool {
+	return host == "" || net.ParseIP(host).IsUnspecified()
+}
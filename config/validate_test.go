@@ -0,0 +1,87 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBurrowConfig_Validate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config-validate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	keysDir := filepath.Join(dir, "keys")
+	key, err := keys.NewKey(crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	require.NoError(t, keys.NewFilesystemKeyStore(keysDir, false).StoreKeyPlain(key))
+
+	validConfig := func() *BurrowConfig {
+		conf := DefaultBurrowConfig()
+		conf.BurrowDir = filepath.Join(dir, ".burrow")
+		conf.Keys.KeysDirectory = keysDir
+		conf.ValidatorAddress = &key.Address
+		conf.GenesisDoc = &genesis.GenesisDoc{
+			ChainName:  "test",
+			Validators: []genesis.Validator{{BasicAccount: genesis.BasicAccount{Address: key.Address, Amount: 1}}},
+		}
+		return conf
+	}
+	require.NoError(t, validConfig().Validate())
+	// Nothing burrow writes is mistaken for a misspelling
+	unknown, err := source.UnknownFields(validConfig().TOMLString(), new(BurrowConfig))
+	require.NoError(t, err)
+	assert.Empty(t, unknown)
+
+	conf := validConfig()
+	conf.GenesisDoc.Params.SelfDestruct = "sometimes"
+	conf.ValidatorAddress = &crypto.Address{1}
+	conf.RPC.Web3.ListenPort = conf.RPC.GRPC.ListenPort
+	conf.RPC.Info.ListenPort = "70000"
+	conf.Tendermint.PersistentPeers = "tcp://abc@burrow001:26656"
+	conf.Tendermint.RetainDuration = "a week"
+	conf.Execution.VMOptions = []execution.VMOption{"Fast"}
+	conf.ColdStorage = &storage.ColdStorageConfig{HotBlocks: 100}
+	conf.DBEncryption = &storage.EncryptionConfig{KeyEnv: "BURROW_TEST_UNSET_KEY"}
+	conf.RPC.Faucet.Enabled = true
+
+	err = conf.Validate()
+	require.Error(t, err)
+	problems, ok := err.(Problems)
+	require.True(t, ok)
+	for i, expected := range []string{
+		"GenesisDoc.Params: ",
+		"ColdStorage.HotBlocks is set",
+		"DBEncryption.KeyEnv names environment variable BURROW_TEST_UNSET_KEY",
+		"no key for ValidatorAddress 0100000000000000000000000000000000000000 in Keys.KeysDirectory",
+		"Tendermint: could not parse RetainDuration 'a week'",
+		"Tendermint.PersistentPeers: peer tcp://abc@burrow001:26656 has node ID abc",
+		"Execution.VMOptions: 'Fast' is not one of",
+		"RPC.Info.ListenPort: port '70000' is not a number",
+		"RPC.GRPC and RPC.Web3 both listen on port 10997",
+		"RPC.Faucet.Address must be set",
+	} {
+		require.True(t, i < len(problems), "missing problem: %s", expected)
+		assert.Contains(t, problems[i], expected)
+	}
+	assert.Len(t, problems, 10)
+
+	conf = validConfig()
+	conf.GenesisDoc = nil
+	conf.ValidatorAddress = nil
+	conf.Tendermint.ReadOnly = true
+	err = conf.Validate()
+	require.Error(t, err)
+	assert.Equal(t, Problems{"no GenesisDoc: embed one in the config, pass --genesis, or put genesis.json in the " +
+		"working directory"}, err)
+}
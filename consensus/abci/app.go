@@ -1,23 +1,33 @@
 package abci
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"runtime/debug"
 	"sync"
+	"time"
 
+	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/encoding"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/archive"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/tendermint/codes"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/upgrade"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/prune"
+	"github.com/hyperledger/burrow/snapshot"
 	"github.com/hyperledger/burrow/txs"
-	"github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto/encoding"
 )
 
 type Validators interface {
@@ -30,27 +40,56 @@ const (
 )
 
 type App struct {
-	// Provides a no-op implementation for all methods (in particular snapshots for now will abort)
+	// Provides a no-op implementation for all methods
 	types.BaseApplication
 	// Node information to return in Info
 	nodeInfo string
 	// State
-	blockchain      *bcm.Blockchain
-	validators      Validators
-	mempoolLocker   sync.Locker
+	blockchain    *bcm.Blockchain
+	validators    Validators
+	mempoolLocker sync.Locker
+	// The lock on the checker still held for the last commit, if any, which is guarded by checkerHandoff
+	checkerHold     *checkerHold
+	checkerHandoff  sync.Mutex
+	mempoolTracker  MempoolTracker
 	authorizedPeers AuthorizedPeers
-	// We need to cache these from BeginBlock for when we need actually need it in Commit
-	block *types.RequestBeginBlock
+	bannedPeers     BannedPeers
+	// We need to cache the block from FinalizeBlock, and the app hash we returned for it, for when we commit it
+	block   *types.RequestFinalizeBlock
+	appHash []byte
 	// Function to use to fail gracefully from panic rather than letting Tendermint make us a zombie
 	panicFunc func(error)
 	checker   execution.BatchExecutor
 	committer execution.BatchCommitter
 	txDecoder txs.Decoder
 	logger    *logging.Logger
+	// When set transactions are executed in parallel at the end of each block rather than as they are delivered
+	parallelExecution bool
+	// When set transactions are executed at the end of each block in the order of the fee they offer
+	feeOrdering bool
+	deferredTxs []*txs.Envelope
+	// When set proposals put the transactions of each input in sequence order and otherwise order them by fee
+	proposalOrdering bool
+	// Serves and restores snapshots of state for state sync, when set
+	snapshots *snapshot.Store
+	// Deletes old blocks and state, when set
+	pruner *prune.Pruner
+	// Moves old blocks and events to cold storage, when set
+	archiver *archive.Archiver
+	// Halt after committing the block at haltHeight or the first block at or after haltTime, when set
+	haltHeight uint64
+	haltTime   time.Time
+	haltFunc   func()
 }
 
 var _ types.Application = &App{}
 
+// MempoolTracker is told when transactions enter and leave the mempool
+type MempoolTracker interface {
+	TxReceived(tx []byte)
+	TxRemoved(tx []byte)
+}
+
 func NewApp(nodeInfo string, blockchain *bcm.Blockchain, validators Validators, checker execution.BatchExecutor,
 	committer execution.BatchCommitter, txDecoder txs.Decoder, authorizedPeers AuthorizedPeers,
 	panicFunc func(error), logger *logging.Logger) *App {
@@ -75,45 +114,95 @@ func (app *App) SetMempoolLocker(mempoolLocker sync.Locker) {
 	app.mempoolLocker = mempoolLocker
 }
 
-func (app *App) Info(info types.RequestInfo) types.ResponseInfo {
-	return types.ResponseInfo{
+// Tell the tracker which transactions we accept into and remove from the mempool
+func (app *App) SetMempoolTracker(mempoolTracker MempoolTracker) {
+	app.mempoolTracker = mempoolTracker
+}
+
+// Refuse the peers that are banned, which requires Tendermint to filter peers
+func (app *App) SetBannedPeers(bannedPeers BannedPeers) {
+	app.bannedPeers = bannedPeers
+}
+
+// Defer execution of the transactions in each block until EndBlock so they can be executed in parallel. All validators
+// must agree on whether execution is deferred since it changes the result of DeliverTx.
+func (app *App) SetParallelExecution(parallelExecution bool) {
+	app.parallelExecution = parallelExecution
+}
+
+// Defer execution of the transactions in each block until EndBlock so they can be ordered by fee. All validators must
+// agree on whether execution is deferred since it changes the result of DeliverTx.
+func (app *App) SetFeeOrdering(feeOrdering bool) {
+	app.feeOrdering = feeOrdering
+}
+
+// Order the transactions of the blocks we propose and refuse proposals that are out of order. All validators must agree
+// on whether proposals are ordered since it changes which blocks they accept.
+func (app *App) SetProposalOrdering(proposalOrdering bool) {
+	app.proposalOrdering = proposalOrdering
+}
+
+// Take snapshots of state after committing blocks and serve them to nodes state syncing, and allow this node to state
+// sync from the snapshots of others
+func (app *App) SetSnapshots(snapshots *snapshot.Store) {
+	app.snapshots = snapshots
+}
+
+// Delete blocks and state that fall outside of the pruner's retention window after committing blocks
+func (app *App) SetPruner(pruner *prune.Pruner) {
+	app.pruner = pruner
+}
+
+// Move blocks and events that fall outside of the archiver's hot window to cold storage after committing blocks
+func (app *App) SetArchiver(archiver *archive.Archiver) {
+	app.archiver = archiver
+}
+
+// Call haltFunc after committing the block at haltHeight or the first block with a time at or after haltTime, and refuse
+// to begin any later block, either of which may be zero to not halt by it. We also halt before the height of any
+// upgrade planned on chain that this binary does not support.
+func (app *App) SetHalt(haltHeight uint64, haltTime time.Time, haltFunc func()) {
+	app.haltHeight = haltHeight
+	app.haltTime = haltTime
+	app.haltFunc = haltFunc
+}
+
+func (app *App) Info(context.Context, *types.RequestInfo) (*types.ResponseInfo, error) {
+	return &types.ResponseInfo{
 		Data:             app.nodeInfo,
 		Version:          project.History.CurrentVersion().String(),
 		LastBlockHeight:  int64(app.blockchain.LastBlockHeight()),
 		LastBlockAppHash: app.blockchain.AppHashAfterLastBlock(),
-	}
-}
-
-func (app *App) SetOption(option types.RequestSetOption) (respSetOption types.ResponseSetOption) {
-	respSetOption.Log = "SetOption not supported"
-	respSetOption.Code = codes.UnsupportedRequestCode
-	return
+	}, nil
 }
 
-func (app *App) Query(reqQuery types.RequestQuery) (respQuery types.ResponseQuery) {
+func (app *App) Query(_ context.Context, reqQuery *types.RequestQuery) (respQuery *types.ResponseQuery, err error) {
+	respQuery = new(types.ResponseQuery)
 	defer func() {
 		if r := recover(); r != nil {
-			app.panicFunc(fmt.Errorf("panic occurred in abci.App/Query: %v\n%s", r, debug.Stack()))
+			err = fmt.Errorf("panic occurred in abci.App/Query: %v\n%s", r, debug.Stack())
+			app.panicFunc(err)
 		}
 	}()
 	respQuery.Log = "Query not supported"
 	respQuery.Code = codes.UnsupportedRequestCode
 
 	switch {
-	case isPeersFilterQuery(&reqQuery):
-		app.peersFilter(&reqQuery, &respQuery)
+	case isPeersFilterQuery(reqQuery):
+		app.peersFilter(reqQuery, respQuery)
 	}
 	return
 }
 
-func (app *App) InitChain(chain types.RequestInitChain) types.ResponseInitChain {
+func (app *App) InitChain(_ context.Context, chain *types.RequestInitChain) (_ *types.ResponseInitChain, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			app.panicFunc(fmt.Errorf("panic occurred in abci.App/InitChain: %v\n%s", r, debug.Stack()))
+			err = fmt.Errorf("panic occurred in abci.App/InitChain: %v\n%s", r, debug.Stack())
+			app.panicFunc(err)
 		}
 	}()
 	currentSet := validator.NewTrimSet()
-	err := validator.Write(currentSet, app.validators.Validators(0))
+	err = validator.Write(currentSet, app.validators.Validators(0))
 	if err != nil {
 		panic(fmt.Errorf("could not build current validator set: %v", err))
 	}
@@ -132,19 +221,144 @@ func (app *App) InitChain(chain types.RequestInitChain) types.ResponseInitChain
 		}
 	}
 	app.logger.InfoMsg("Initial validator set matches")
-	return types.ResponseInitChain{
+	return &types.ResponseInitChain{
 		AppHash: app.blockchain.AppHashAfterLastBlock(),
+	}, nil
+}
+
+// PrepareProposal orders the transactions of the blocks we propose when the chain has ProposalOrdering, and otherwise
+// proposes the transactions CometBFT takes from the mempool in the order they arrived
+func (app *App) PrepareProposal(_ context.Context, req *types.RequestPrepareProposal) (_ *types.ResponsePrepareProposal,
+	err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred in abci.App/PrepareProposal: %v\n%s", r, debug.Stack())
+			app.panicFunc(err)
+		}
+	}()
+	proposal := req.Txs
+	if app.proposalOrdering {
+		proposal = app.orderProposal(req.Txs)
 	}
+	return &types.ResponsePrepareProposal{Txs: limitTxBytes(proposal, req.MaxTxBytes)}, nil
 }
 
-func (app *App) BeginBlock(block types.RequestBeginBlock) (respBeginBlock types.ResponseBeginBlock) {
-	app.block = &block
+func (app *App) orderProposal(candidates [][]byte) [][]byte {
+	txEnvs := make([]*txs.Envelope, 0, len(candidates))
+	txBytes := make(map[*txs.Envelope][]byte, len(candidates))
+	for _, tx := range candidates {
+		txEnv, err := app.txDecoder.DecodeTx(tx)
+		if err != nil {
+			app.logger.InfoMsg("Leaving transaction that cannot be decoded out of proposal", structure.ErrorKey, err)
+			continue
+		}
+		txEnvs = append(txEnvs, txEnv)
+		txBytes[txEnv] = tx
+	}
+	ordered, err := app.committer.OrderProposal(txEnvs)
+	if err != nil {
+		panic(fmt.Errorf("could not order proposal: %v", err))
+	}
+	proposal := make([][]byte, len(ordered))
+	for i, txEnv := range ordered {
+		proposal[i] = txBytes[txEnv]
+	}
+	return proposal
+}
+
+// The longest prefix of proposal that fits in a block's maxTxBytes
+func limitTxBytes(proposal [][]byte, maxTxBytes int64) [][]byte {
+	var size int64
+	for i, tx := range proposal {
+		size += tmTypes.ComputeProtoSizeForTxs([]tmTypes.Tx{tx})
+		if size > maxTxBytes {
+			return proposal[:i]
+		}
+	}
+	return proposal
+}
+
+// ProcessProposal refuses blocks whose transactions are not as PrepareProposal would put them when the chain has
+// ProposalOrdering, and otherwise accepts every block
+func (app *App) ProcessProposal(_ context.Context, req *types.RequestProcessProposal) (_ *types.ResponseProcessProposal,
+	err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			app.panicFunc(fmt.Errorf("panic occurred in abci.App/BeginBlock: %v\n%s", r, debug.Stack()))
+			err = fmt.Errorf("panic occurred in abci.App/ProcessProposal: %v\n%s", r, debug.Stack())
+			app.panicFunc(err)
 		}
 	}()
-	if block.Header.Height > 1 {
+	if app.proposalOrdering {
+		rejection := app.checkProposal(req.Txs)
+		if rejection != nil {
+			app.logger.InfoMsg("Rejecting proposal", "height", req.Height, "hash", req.Hash,
+				structure.ErrorKey, rejection)
+			return &types.ResponseProcessProposal{Status: types.ResponseProcessProposal_REJECT}, nil
+		}
+	}
+	return &types.ResponseProcessProposal{Status: types.ResponseProcessProposal_ACCEPT}, nil
+}
+
+func (app *App) checkProposal(proposal [][]byte) error {
+	txEnvs := make([]*txs.Envelope, len(proposal))
+	for i, tx := range proposal {
+		txEnv, err := app.txDecoder.DecodeTx(tx)
+		if err != nil {
+			return err
+		}
+		txEnvs[i] = txEnv
+	}
+	return execution.CheckProposal(txEnvs, app.blockchain.ChainID())
+}
+
+// FinalizeBlock executes the block CometBFT has decided on and returns the hash state will have once the results are
+// saved in Commit, along with the block itself.
+func (app *App) FinalizeBlock(_ context.Context, block *types.RequestFinalizeBlock) (_ *types.ResponseFinalizeBlock,
+	err error) {
+	app.block = block
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred in abci.App/FinalizeBlock: %v\n%s", r, debug.Stack())
+			app.panicFunc(err)
+		}
+	}()
+	app.beginBlock(block)
+	txResults := make([]*types.ExecTxResult, len(block.Txs))
+	for i, tx := range block.Txs {
+		txResults[i] = app.deliverTx(tx)
+	}
+	validatorUpdates := app.endBlock()
+	// State is only persisted on Commit so that CometBFT can replay this block if we do not get that far
+	appHash, err := app.committer.Finalise(app.blockHeader(block))
+	if err != nil {
+		panic(errors.Wrap(err, "Could not finalise transactions in block to execution state"))
+	}
+	app.appHash = appHash
+	return &types.ResponseFinalizeBlock{
+		TxResults:        txResults,
+		ValidatorUpdates: validatorUpdates,
+		AppHash:          appHash,
+	}, nil
+}
+
+func (app *App) beginBlock(block *types.RequestFinalizeBlock) {
+	// We may have been restarted after halting
+	if app.shouldHalt(app.blockchain.LastBlockHeight(), app.blockchain.LastBlockTime()) {
+		app.logger.InfoMsg("Refusing to begin block after halt",
+			"height", block.Height,
+			"halt_height", app.haltHeight,
+			"halt_time", app.haltTime)
+		app.refuseBlock(block.Height)
+	}
+	if plan := app.unsupportedUpgrade(uint64(block.Height)); plan != nil {
+		app.logger.InfoMsg("Refusing to begin block after upgrade not supported by this binary",
+			"height", block.Height,
+			"upgrade", plan.Name,
+			"upgrade_height", plan.Height,
+			"upgrade_info", plan.Info)
+		app.refuseBlock(block.Height)
+	}
+	if block.Height > 1 {
 		var err error
 		previousValidators := validator.NewTrimSet()
 		// Tendermint runs two blocks behind plus we are updating in end block validators updated last round
@@ -153,19 +367,46 @@ func (app *App) BeginBlock(block types.RequestBeginBlock) (respBeginBlock types.
 		if err != nil {
 			panic(fmt.Errorf("could not build current validator set: %v", err))
 		}
-		if len(block.LastCommitInfo.Votes) != previousValidators.Size() {
-			err = fmt.Errorf("Tendermint passes %d validators to BeginBlock but Burrow's has %d:\n %v",
-				len(block.LastCommitInfo.Votes), previousValidators.Size(), previousValidators.String())
+		if len(block.DecidedLastCommit.Votes) != previousValidators.Size() {
+			err = fmt.Errorf("Tendermint passes %d validators to FinalizeBlock but Burrow's has %d:\n %v",
+				len(block.DecidedLastCommit.Votes), previousValidators.Size(), previousValidators.String())
 			panic(err)
 		}
-		for _, v := range block.LastCommitInfo.Votes {
+		for _, v := range block.DecidedLastCommit.Votes {
 			err = app.checkValidatorMatches(previousValidators, v.Validator)
 			if err != nil {
 				panic(err)
 			}
 		}
 	}
-	return
+	// Record misbehaviour in the block so that it is stored and streamed with its events
+	for _, bv := range block.Misbehavior {
+		ev, err := exec.EvidenceFromABCI(bv)
+		if err != nil {
+			panic(err)
+		}
+		app.logger.InfoMsg("Evidence of validator misbehaviour committed in block",
+			"height", block.Height,
+			"evidence_type", ev.Type,
+			"validator_address", ev.Address,
+			"validator_power", ev.Power,
+			"misbehaviour_height", ev.Height)
+		app.committer.AddEvidence(ev)
+	}
+}
+
+// The header of the block being finalised, which CometBFT saves to its block store before finalising it, to store with
+// its execution. It must be the header every validator stores since it is part of our state.
+func (app *App) blockHeader(block *types.RequestFinalizeBlock) *tmproto.Header {
+	header, err := app.blockchain.GetBlockHeader(uint64(block.Height))
+	if err != nil {
+		panic(fmt.Errorf("could not get header of block %d to finalise: %v", block.Height, err))
+	}
+	if !bytes.Equal(header.Hash(), block.Hash) {
+		panic(fmt.Errorf("header of block %d has hash %X but block to finalise has hash %X", block.Height,
+			header.Hash(), block.Hash))
+	}
+	return header.ToProto()
 }
 
 func (app *App) checkValidatorMatches(ours validator.Reader, v types.Validator) error {
@@ -184,11 +425,12 @@ func (app *App) checkValidatorMatches(ours validator.Reader, v types.Validator)
 	return nil
 }
 
-func (app *App) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
+func (app *App) CheckTx(_ context.Context, req *types.RequestCheckTx) (_ *types.ResponseCheckTx, err error) {
 	const logHeader = "CheckTx"
 	defer func() {
 		if r := recover(); r != nil {
-			app.panicFunc(fmt.Errorf("panic occurred in abci.App/CheckTx: %v\n%s", r, debug.Stack()))
+			err = fmt.Errorf("panic occurred in abci.App/CheckTx: %v\n%s", r, debug.Stack())
+			app.panicFunc(err)
 		}
 	}()
 
@@ -204,18 +446,34 @@ func (app *App) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
 			"log", checkTx.Log)
 	}
 
-	return checkTx
+	if app.mempoolTracker != nil {
+		if checkTx.Code != codes.TxExecutionSuccessCode {
+			// Tendermint drops transactions that fail recheck
+			app.mempoolTracker.TxRemoved(req.GetTx())
+		} else if req.Type == types.CheckTxType_New {
+			app.mempoolTracker.TxReceived(req.GetTx())
+		}
+	}
+
+	return &checkTx, nil
 }
 
-func (app *App) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
+func (app *App) deliverTx(tx []byte) *types.ExecTxResult {
 	const logHeader = "DeliverTx"
-	defer func() {
-		if r := recover(); r != nil {
-			app.panicFunc(fmt.Errorf("panic occurred in abci.App/DeliverTx: %v\n%s", r, debug.Stack()))
+
+	if app.mempoolTracker != nil {
+		app.mempoolTracker.TxRemoved(tx)
+	}
+
+	if app.parallelExecution || app.feeOrdering {
+		txEnv, checkTx := DeferTx(logHeader, app.txDecoder, tx)
+		if txEnv != nil {
+			app.deferredTxs = append(app.deferredTxs, txEnv)
 		}
-	}()
+		return DeliverTxFromCheckTx(checkTx)
+	}
 
-	checkTx := ExecuteTx(logHeader, app.committer, app.txDecoder, req.GetTx())
+	checkTx := ExecuteTx(logHeader, app.committer, app.txDecoder, tx)
 
 	logger := WithEvents(app.logger, checkTx.Events)
 
@@ -230,13 +488,11 @@ func (app *App) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
 	return DeliverTxFromCheckTx(checkTx)
 }
 
-func (app *App) EndBlock(reqEndBlock types.RequestEndBlock) types.ResponseEndBlock {
+func (app *App) endBlock() []types.ValidatorUpdate {
 	var validatorUpdates []types.ValidatorUpdate
-	defer func() {
-		if r := recover(); r != nil {
-			app.panicFunc(fmt.Errorf("panic occurred in abci.App/EndBlock: %v\n%s", r, debug.Stack()))
-		}
-	}()
+	if len(app.deferredTxs) > 0 {
+		app.executeDeferredTxs()
+	}
 	err := app.validators.ValidatorChanges(BurrowValidatorDelayInBlocks).IterateValidators(func(id crypto.Addressable, power *big.Int) error {
 		app.logger.InfoMsg("Updating validator power", "validator_address", id.GetAddress(),
 			"new_power", power)
@@ -254,22 +510,48 @@ func (app *App) EndBlock(reqEndBlock types.RequestEndBlock) types.ResponseEndBlo
 	if err != nil {
 		panic(err)
 	}
-	return types.ResponseEndBlock{
-		ValidatorUpdates: validatorUpdates,
+	return validatorUpdates
+}
+
+// Execute the transactions delivered in the current block before anything at the end of the block depends on them
+func (app *App) executeDeferredTxs() {
+	if app.feeOrdering {
+		app.deferredTxs = app.committer.OrderByFee(app.deferredTxs)
 	}
+	var txes []*exec.TxExecution
+	var errs []error
+	if app.parallelExecution {
+		txes, errs = app.committer.ExecuteParallel(app.deferredTxs)
+	} else {
+		txes = make([]*exec.TxExecution, len(app.deferredTxs))
+		errs = make([]error, len(app.deferredTxs))
+		for i, txEnv := range app.deferredTxs {
+			txes[i], errs[i] = app.committer.Execute(txEnv)
+		}
+	}
+	for i, txEnv := range app.deferredTxs {
+		logger := app.logger.With(structure.TxHashKey, txEnv.Tx.Hash())
+		if errs[i] != nil {
+			logger.InfoMsg("Execution error", structure.ErrorKey, errs[i])
+		} else {
+			logger.InfoMsg("Execution success", "gas_used", txes[i].GetResult().GetGasUsed())
+		}
+	}
+	app.deferredTxs = nil
 }
 
-func (app *App) Commit() types.ResponseCommit {
+func (app *App) Commit(context.Context, *types.RequestCommit) (_ *types.ResponseCommit, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			app.panicFunc(fmt.Errorf("panic occurred in abci.App/Commit: %v\n%s", r, debug.Stack()))
+			err = fmt.Errorf("panic occurred in abci.App/Commit: %v\n%s", r, debug.Stack())
+			app.panicFunc(err)
 		}
 	}()
-	blockTime := app.block.Header.Time
+	blockTime := app.block.Time
 	app.logger.InfoMsg("Committing block",
 		"tag", "Commit",
 		structure.ScopeKey, "Commit()",
-		"height", app.block.Header.Height,
+		"height", app.block.Height,
 		"hash", app.block.Hash,
 		"block_time", blockTime,
 		"last_block_time", app.blockchain.LastBlockTime(),
@@ -278,7 +560,7 @@ func (app *App) Commit() types.ResponseCommit {
 	)
 
 	// Lock the checker while we reset it and possibly while recheckTxs replays transactions
-	app.checker.Lock()
+	app.lockChecker()
 	defer func() {
 		// Tendermint may replay transactions to the check cache during a recheck, which happens after we have returned
 		// from Commit(). The mempool is locked by Tendermint for the duration of the commit phase; during Commit() and
@@ -287,6 +569,7 @@ func (app *App) Commit() types.ResponseCommit {
 		// period we can ensure that anything querying the checker (such as service.MempoolAccounts()) will block until
 		// the full Tendermint commit phase has completed.
 		if app.mempoolLocker != nil {
+			hold := app.holdChecker()
 			go func() {
 				// we won't get this until after the commit and we will acquire strictly after this commit phase has
 				// ended (i.e. when Tendermint's BlockExecutor.Commit() returns
@@ -294,7 +577,7 @@ func (app *App) Commit() types.ResponseCommit {
 				// Prevent any mempool getting relocked while we unlock - we could just unlock immediately but if a new
 				// commit starts gives goroutines blocked on checker a chance to progress before the next commit phase
 				defer app.mempoolLocker.Unlock()
-				app.checker.Unlock()
+				app.releaseChecker(hold)
 			}()
 		} else {
 			// If we have not be provided with access to the mempool lock
@@ -302,10 +585,14 @@ func (app *App) Commit() types.ResponseCommit {
 		}
 	}()
 
-	appHash, err := app.committer.Commit(&app.block.Header)
+	// Save the state FinalizeBlock computed the app hash of
+	appHash, err := app.committer.Commit(nil)
 	if err != nil {
 		panic(errors.Wrap(err, "Could not commit transactions in block to execution state"))
 	}
+	if !bytes.Equal(appHash, app.appHash) {
+		panic(fmt.Errorf("committed state has hash %X but FinalizeBlock returned app hash %X", appHash, app.appHash))
+	}
 	err = app.checker.Reset()
 	if err != nil {
 		panic(errors.Wrap(err, "could not reset check cache during commit"))
@@ -317,8 +604,108 @@ func (app *App) Commit() types.ResponseCommit {
 		panic(fmt.Errorf("could not commit block to blockchain state: %v", err))
 	}
 	app.logger.InfoMsg("Committed block")
+	if app.snapshots != nil {
+		app.snapshots.Take(uint64(app.block.Height), blockTime)
+	}
+	// After taking any snapshot so that its height is retained
+	var retainHeight uint64
+	if app.pruner != nil {
+		retainHeight = app.pruner.Prune(uint64(app.block.Height), blockTime)
+	}
+	if app.archiver != nil {
+		app.archiver.Archive(uint64(app.block.Height))
+	}
+
+	if app.shouldHalt(uint64(app.block.Height), blockTime) {
+		app.logger.InfoMsg("Halting after committing block",
+			"height", app.block.Height,
+			"halt_height", app.haltHeight,
+			"halt_time", app.haltTime)
+		// Shutting down waits for consensus which waits for us
+		go app.haltFunc()
+	} else if plan := app.unsupportedUpgrade(uint64(app.block.Height) + 1); plan != nil {
+		app.logger.InfoMsg("Halting for upgrade not supported by this binary",
+			"height", app.block.Height,
+			"upgrade", plan.Name,
+			"upgrade_height", plan.Height,
+			"upgrade_info", plan.Info)
+		go app.haltFunc()
+	}
+
+	return &types.ResponseCommit{
+		RetainHeight: int64(retainHeight),
+	}, nil
+}
+
+// A lock on the checker held from Commit until CometBFT's commit phase has ended
+type checkerHold struct {
+	// Set when the next Commit has taken over the lock
+	takenOver bool
+}
+
+// Locks the checker for Commit. When catching up CometBFT can begin committing the next block, with the mempool locked,
+// before we have seen the last commit phase end, in which case we take over the lock still held for the last commit
+// rather than wait on the mempool forever.
+func (app *App) lockChecker() {
+	app.checkerHandoff.Lock()
+	hold := app.checkerHold
+	app.checkerHold = nil
+	if hold != nil {
+		hold.takenOver = true
+	}
+	app.checkerHandoff.Unlock()
+	if hold == nil {
+		app.checker.Lock()
+	}
+}
 
-	return types.ResponseCommit{
-		Data: appHash,
+// Records that the checker stays locked after Commit returns
+func (app *App) holdChecker() *checkerHold {
+	app.checkerHandoff.Lock()
+	defer app.checkerHandoff.Unlock()
+	app.checkerHold = new(checkerHold)
+	return app.checkerHold
+}
+
+// Unlocks the checker held for a commit whose commit phase has ended unless the next Commit has taken it over
+func (app *App) releaseChecker(hold *checkerHold) {
+	app.checkerHandoff.Lock()
+	defer app.checkerHandoff.Unlock()
+	if hold.takenOver {
+		return
+	}
+	app.checkerHold = nil
+	app.checker.Unlock()
+}
+
+// Halts rather than begin the block at height, which must never be processed. Halting exits once it has shut down, which
+// it does within a timeout even while consensus waits on us, so should haltFunc return we panic out of the block instead
+// of leaving consensus blocked forever.
+func (app *App) refuseBlock(height int64) {
+	app.haltFunc()
+	panic(fmt.Errorf("halted rather than begin block %d", height))
+}
+
+// Whether the block at height with blockTime is the last block we should commit or after it
+func (app *App) shouldHalt(height uint64, blockTime time.Time) bool {
+	if app.haltFunc == nil || height == 0 {
+		return false
+	}
+	return app.haltHeight > 0 && height >= app.haltHeight ||
+		!app.haltTime.IsZero() && !blockTime.Before(app.haltTime)
+}
+
+// The upgrade planned at or before height that this binary cannot execute the block at height for, if any
+func (app *App) unsupportedUpgrade(height uint64) *upgrade.Plan {
+	if app.haltFunc == nil {
+		return nil
+	}
+	plan, err := upgrade.GetPlan(app.committer)
+	if err != nil {
+		panic(fmt.Errorf("could not get upgrade plan: %v", err))
+	}
+	if plan == nil || plan.Height > height || upgrade.Supported(plan.Name) {
+		return nil
 	}
+	return plan
 }
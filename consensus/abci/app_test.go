@@ -0,0 +1,199 @@
+package abci
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	abciTypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var genesisTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestApp_ShouldHalt(t *testing.T) {
+	haltTime := genesisTime.Add(time.Hour)
+	app := &App{}
+	app.SetHalt(10, haltTime, func() {})
+
+	// We do not halt before the halt height and time
+	assert.False(t, app.shouldHalt(0, haltTime))
+	assert.False(t, app.shouldHalt(9, haltTime.Add(-time.Nanosecond)))
+	// But do at the halt height or after it
+	assert.True(t, app.shouldHalt(10, genesisTime))
+	assert.True(t, app.shouldHalt(11, genesisTime))
+	// Or at the halt time or after it
+	assert.True(t, app.shouldHalt(5, haltTime))
+	assert.True(t, app.shouldHalt(5, haltTime.Add(time.Second)))
+
+	// Either may be left unset
+	app.SetHalt(10, time.Time{}, func() {})
+	assert.False(t, app.shouldHalt(9, haltTime.Add(time.Hour)))
+	assert.True(t, app.shouldHalt(10, genesisTime))
+	app.SetHalt(0, haltTime, func() {})
+	assert.False(t, app.shouldHalt(1000, haltTime.Add(-time.Nanosecond)))
+	assert.True(t, app.shouldHalt(1, haltTime))
+
+	// Nor do we halt without being told how
+	app.SetHalt(10, haltTime, nil)
+	assert.False(t, app.shouldHalt(10, haltTime))
+}
+
+func TestApp_FinalizeBlockAfterHalt(t *testing.T) {
+	blockchain := bcm.NewBlockchain(dbm.NewMemDB(), &genesis.GenesisDoc{ChainName: "halt", GenesisTime: genesisTime})
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, blockchain.CommitBlock(genesisTime.Add(time.Duration(i)*time.Second), []byte{byte(i)},
+			[]byte{byte(i)}))
+	}
+
+	for _, tc := range []struct {
+		name       string
+		haltHeight uint64
+		haltTime   time.Time
+	}{
+		{"Height", 3, time.Time{}},
+		{"Time", 0, genesisTime.Add(3 * time.Second)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// As when we are restarted with the same halt config after having halted
+			var halts int
+			var panicked error
+			app := NewApp("", blockchain, nil, nil, nil, nil, nil, func(err error) {
+				panicked = err
+			}, logging.NewNoopLogger())
+			app.SetHalt(tc.haltHeight, tc.haltTime, func() {
+				halts++
+			})
+
+			_, err := app.FinalizeBlock(context.Background(), &abciTypes.RequestFinalizeBlock{
+				Height: 4,
+				Time:   genesisTime.Add(4 * time.Second),
+			})
+			require.Error(t, err)
+			assert.Equal(t, 1, halts)
+			// Our halt func returned rather than exiting, so rather than block consensus forever we panic out of the block
+			require.Error(t, panicked)
+			assert.Contains(t, panicked.Error(), "halted rather than begin block 4")
+		})
+	}
+}
+
+func TestApp_PrepareAndProcessProposal(t *testing.T) {
+	genesisDoc := &genesis.GenesisDoc{ChainName: "proposals", GenesisTime: genesisTime}
+	blockchain := bcm.NewBlockchain(dbm.NewMemDB(), genesisDoc)
+	codec := txs.NewProtobufCodec()
+	accounts := acmstate.NewMemoryState()
+	alice := acm.GeneratePrivateAccountFromSecret("alice")
+	require.NoError(t, accounts.UpdateAccount(&acm.Account{Address: alice.GetAddress(), Sequence: 1}))
+	signed := func(sequence uint64) []byte {
+		txEnv := txs.Enclose(blockchain.ChainID(), &payload.CallTx{
+			Input:    &payload.TxInput{Address: alice.GetAddress(), Sequence: sequence},
+			GasLimit: 100,
+		})
+		require.NoError(t, txEnv.Sign(alice))
+		tx, err := codec.EncodeTx(txEnv)
+		require.NoError(t, err)
+		return tx
+	}
+	app := NewApp("", blockchain, nil, nil, proposalCommitter{accounts: accounts}, codec, nil, func(err error) {
+		t.Fatal(err)
+	}, logging.NewNoopLogger())
+	ctx := context.Background()
+	// Alice's transactions arrive out of order alongside one she has already made and one that cannot be decoded
+	candidates := [][]byte{signed(3), signed(1), signed(2), []byte("not a transaction")}
+
+	// Without ordering we propose and accept blocks as CometBFT gives them to us
+	prepared, err := app.PrepareProposal(ctx, &abciTypes.RequestPrepareProposal{Txs: candidates, MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	assert.Equal(t, candidates, prepared.Txs)
+	processed, err := app.ProcessProposal(ctx, &abciTypes.RequestProcessProposal{Txs: candidates})
+	require.NoError(t, err)
+	assert.Equal(t, abciTypes.ResponseProcessProposal_ACCEPT, processed.Status)
+
+	app.SetProposalOrdering(true)
+	prepared, err = app.PrepareProposal(ctx, &abciTypes.RequestPrepareProposal{Txs: candidates, MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{candidates[2], candidates[0]}, prepared.Txs)
+	// Validators accept what we propose
+	processed, err = app.ProcessProposal(ctx, &abciTypes.RequestProcessProposal{Txs: prepared.Txs})
+	require.NoError(t, err)
+	assert.Equal(t, abciTypes.ResponseProcessProposal_ACCEPT, processed.Status)
+	// But not proposals out of order or with transactions that cannot be decoded
+	for _, proposal := range [][][]byte{{candidates[0], candidates[2]}, {candidates[2], candidates[3]}} {
+		processed, err = app.ProcessProposal(ctx, &abciTypes.RequestProcessProposal{Txs: proposal})
+		require.NoError(t, err)
+		assert.Equal(t, abciTypes.ResponseProcessProposal_REJECT, processed.Status)
+	}
+
+	// We propose no more than fits in a block
+	prepared, err = app.PrepareProposal(ctx, &abciTypes.RequestPrepareProposal{Txs: candidates,
+		MaxTxBytes: int64(len(candidates[2]) + 10)})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{candidates[2]}, prepared.Txs)
+}
+
+// Orders proposals against accounts, as the committer does against the state of the last block
+type proposalCommitter struct {
+	execution.BatchCommitter
+	accounts acmstate.AccountGetter
+}
+
+var _ execution.BatchCommitter = proposalCommitter{}
+
+func (pc proposalCommitter) OrderProposal(txEnvs []*txs.Envelope) ([]*txs.Envelope, error) {
+	return execution.OrderProposal(txEnvs, pc.accounts, nil)
+}
+
+func TestApp_CheckerHandoff(t *testing.T) {
+	checker := new(lockingChecker)
+	app := &App{checker: checker}
+
+	// The commit phase of the last block ends before the next commit
+	app.lockChecker()
+	app.releaseChecker(app.holdChecker())
+	require.True(t, checker.TryLock(), "checker should be released")
+	checker.Unlock()
+
+	// The next commit begins while the checker is still held for the last, as when catching up
+	app.lockChecker()
+	hold := app.holdChecker()
+	done := make(chan struct{})
+	go func() {
+		app.lockChecker()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("next commit should take over the lock on the checker")
+	}
+	// So the lock is not released when the last commit phase ends, but when the next one does
+	app.releaseChecker(hold)
+	require.False(t, checker.TryLock(), "checker should still be held for the next commit")
+	app.releaseChecker(app.holdChecker())
+	require.True(t, checker.TryLock(), "checker should be released")
+}
+
+type lockingChecker struct {
+	execution.BatchExecutor
+	sync.Mutex
+}
+
+func (lc *lockingChecker) Lock() {
+	lc.Mutex.Lock()
+}
+
+func (lc *lockingChecker) Unlock() {
+	lc.Mutex.Unlock()
+}
@@ -4,13 +4,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cometbft/cometbft/abci/types"
 	"github.com/hyperledger/burrow/consensus/tendermint/codes"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/txs"
-	"github.com/tendermint/tendermint/abci/types"
 )
 
 // Attempt to execute a transaction using ABCI conventions and codes
@@ -35,11 +35,11 @@ func ExecuteTx(logHeader string, executor execution.Executor, txDecoder txs.Deco
 		}
 	}
 
-	tags := []types.EventAttribute{{Key: []byte(structure.TxHashKey), Value: []byte(txEnv.Tx.Hash().String())}}
+	tags := []types.EventAttribute{{Key: structure.TxHashKey, Value: txEnv.Tx.Hash().String()}}
 	if txe.Receipt.CreatesContract {
 		tags = append(tags, types.EventAttribute{
-			Key:   []byte("created_contract_address"),
-			Value: []byte(txe.Receipt.ContractAddress.String()),
+			Key:   "created_contract_address",
+			Value: txe.Receipt.ContractAddress.String(),
 		})
 	}
 
@@ -60,21 +60,40 @@ func ExecuteTx(logHeader string, executor execution.Executor, txDecoder txs.Deco
 	}
 }
 
+// Decodes a transaction to be executed later along with the other transactions in its block. Since the transaction
+// has not been executed the response only acknowledges its delivery.
+func DeferTx(logHeader string, txDecoder txs.Decoder, txBytes []byte) (*txs.Envelope, types.ResponseCheckTx) {
+	txEnv, err := txDecoder.DecodeTx(txBytes)
+	if err != nil {
+		return nil, types.ResponseCheckTx{
+			Code: codes.EncodingErrorCode,
+			Log:  fmt.Sprintf("%s: Decoding error: %s", logHeader, err),
+		}
+	}
+	return txEnv, types.ResponseCheckTx{
+		Code: codes.TxExecutionSuccessCode,
+		Events: []types.Event{{Type: "ExecuteTx", Attributes: []types.EventAttribute{
+			{Key: structure.TxHashKey, Value: txEnv.Tx.Hash().String()},
+		}}},
+		Log: fmt.Sprintf("%s: Execution deferred until end of block - TxExecution in events", logHeader),
+	}
+}
+
 // Some ABCI type helpers
 
 func WithEvents(logger *logging.Logger, events []types.Event) *logging.Logger {
 	for _, e := range events {
 		values := make([]string, 0, len(e.Attributes))
 		for _, kvp := range e.Attributes {
-			values = append(values, fmt.Sprintf("%s:%s", string(kvp.Key), string(kvp.Value)))
+			values = append(values, fmt.Sprintf("%s:%s", kvp.Key, kvp.Value))
 		}
 		logger = logger.With(e.Type, strings.Join(values, ","))
 	}
 	return logger
 }
 
-func DeliverTxFromCheckTx(ctr types.ResponseCheckTx) types.ResponseDeliverTx {
-	return types.ResponseDeliverTx{
+func DeliverTxFromCheckTx(ctr types.ResponseCheckTx) *types.ExecTxResult {
+	return &types.ExecTxResult{
 		Code:      ctr.Code,
 		Log:       ctr.Log,
 		Data:      ctr.Data,
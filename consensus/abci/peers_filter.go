@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	abciTypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/hyperledger/burrow/consensus/tendermint/codes"
-	abciTypes "github.com/tendermint/tendermint/abci/types"
 )
 
 const (
@@ -23,6 +23,11 @@ type AuthorizedPeers interface {
 	QueryPeerByAddress(id string) bool
 }
 
+// BannedPeers provides the nodes refused whether or not they are authorized
+type BannedPeers interface {
+	PeerBanned(idOrAddress string) bool
+}
+
 type PeerLists struct {
 	IDs       map[string]struct{}
 	Addresses map[string]struct{}
@@ -59,6 +64,12 @@ func (app *App) peersFilter(reqQuery *abciTypes.RequestQuery, respQuery *abciTyp
 	filterType := path[3]
 	peer := path[4]
 
+	if app.bannedPeers != nil && app.bannedPeers.PeerBanned(peer) {
+		app.logger.InfoMsg("Peer sync forbidden since peer is banned", "peer", peer)
+		respQuery.Code = codes.PeerFilterForbiddenCode
+		return
+	}
+
 	peerAuthorized := app.authorizedPeers.NumPeers() == 0
 	switch filterType {
 	case "id":
@@ -1,15 +1,16 @@
 package abci
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hyperledger/burrow/logging"
 
+	"github.com/cometbft/cometbft/abci/types"
+	abciTypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/hyperledger/burrow/consensus/tendermint/codes"
 	"github.com/stretchr/testify/assert"
-	"github.com/tendermint/tendermint/abci/types"
-	abciTypes "github.com/tendermint/tendermint/abci/types"
 )
 
 const (
@@ -29,7 +30,7 @@ func TestApp_QueryAuthorizedPeers(t *testing.T) {
 	}
 
 	// When authorized node query is raised with any node id
-	resp := app.Query(*makeTestFilterQuery("id", aNodeId))
+	resp, _ := app.Query(context.Background(), makeTestFilterQuery("id", aNodeId))
 
 	// Then we should authorized any node
 	assert.NotNil(t, resp)
@@ -42,28 +43,28 @@ func TestApp_QueryAuthorizedPeers(t *testing.T) {
 	}
 
 	// When authorized node query is raised for an authorized node by id
-	resp = app.Query(*makeTestFilterQuery("id", aNodeId))
+	resp, _ = app.Query(context.Background(), makeTestFilterQuery("id", aNodeId))
 
 	// Then we should authorize it
 	assert.NotNil(t, resp)
 	assert.Equal(t, codes.PeerFilterAuthorizedCode, resp.Code)
 
 	// When authorized node query is raised for another node by id
-	resp = app.Query(*makeTestFilterQuery("id", "forbiddenId"))
+	resp, _ = app.Query(context.Background(), makeTestFilterQuery("id", "forbiddenId"))
 
 	// Then we should forbid this node to sync
 	assert.NotNil(t, resp)
 	assert.Equal(t, codes.PeerFilterForbiddenCode, resp.Code)
 
 	// When authorized node query is raised for an authorized node by address
-	resp = app.Query(*makeTestFilterQuery("addr", aNodeAddress))
+	resp, _ = app.Query(context.Background(), makeTestFilterQuery("addr", aNodeAddress))
 
 	// Then we should authorize it
 	assert.NotNil(t, resp)
 	assert.Equal(t, codes.PeerFilterAuthorizedCode, resp.Code)
 
 	// When authorized node query is raised for another node
-	resp = app.Query(*makeTestFilterQuery("addr", "forbiddenAddress"))
+	resp, _ = app.Query(context.Background(), makeTestFilterQuery("addr", "forbiddenAddress"))
 
 	// Then we should forbid this node to sync
 	assert.NotNil(t, resp)
@@ -74,10 +75,11 @@ func TestApp_QueryAuthorizedPeers(t *testing.T) {
 	app.authorizedPeers = new(panicker)
 
 	// When authorized node query is raised
-	resp = app.Query(*makeTestFilterQuery("addr", "hackMe"))
+	resp, err := app.Query(context.Background(), makeTestFilterQuery("addr", "hackMe"))
 
 	// The we should recover and mark the query as unsupported, so the node cannot sync
 	assert.True(t, panicked)
+	assert.Error(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, codes.UnsupportedRequestCode, resp.Code)
 }
@@ -97,6 +99,27 @@ func (p panicker) NumPeers() int {
 	panic("peers")
 }
 
+func TestApp_QueryBannedPeers(t *testing.T) {
+	app := &App{
+		logger:          logging.NewNoopLogger(),
+		authorizedPeers: &PeerLists{},
+		bannedPeers:     bannedPeers{aNodeId: true},
+	}
+
+	// Banned peers are forbidden even when any peer is authorized
+	resp, _ := app.Query(context.Background(), makeTestFilterQuery("id", aNodeId))
+	assert.Equal(t, codes.PeerFilterForbiddenCode, resp.Code)
+
+	resp, _ = app.Query(context.Background(), makeTestFilterQuery("id", "anotherId"))
+	assert.Equal(t, codes.PeerFilterAuthorizedCode, resp.Code)
+}
+
+type bannedPeers map[string]bool
+
+func (bp bannedPeers) PeerBanned(idOrAddress string) bool {
+	return bp[idOrAddress]
+}
+
 func TestIsPeersFilterQuery(t *testing.T) {
 	assert.True(t, isPeersFilterQuery(makeTestFilterQuery("id", aNodeId)))
 	assert.True(t, isPeersFilterQuery(makeTestFilterQuery("addr", aNodeAddress)))
@@ -9,11 +9,11 @@ import (
 
 	"github.com/hyperledger/burrow/bcm"
 
+	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/mempool"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/txs"
-	"github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/mempool"
-	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 type Process struct {
@@ -48,7 +48,7 @@ func NewProcess(committer execution.BatchCommitter, blockchain *bcm.Blockchain,
 	return p
 }
 
-func (p *Process) CheckTx(tx tmTypes.Tx, cb func(*types.Response), txInfo mempool.TxInfo) error {
+func (p *Process) CheckTx(tx tmTypes.Tx, cb func(*types.ResponseCheckTx), txInfo mempool.TxInfo) error {
 	const header = "DeliverTx"
 	p.committer.Lock()
 	defer p.committer.Unlock()
@@ -59,7 +59,7 @@ func (p *Process) CheckTx(tx tmTypes.Tx, cb func(*types.Response), txInfo mempoo
 	// invalid transactions in state. Since the state hash is non-deterministic based on when the commits happen it's not
 	// clear this is a problem. The underlying state will be compatible.
 	checkTx := ExecuteTx(header, p.committer, p.txDecoder, tx)
-	cb(types.ToResponseCheckTx(checkTx))
+	cb(&checkTx)
 	p.commitNeeded = true
 	if p.ticker == nil {
 		err := p.commit()
@@ -0,0 +1,96 @@
+package abci
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cometbft/cometbft/abci/types"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/snapshot"
+)
+
+// State sync: Tendermint asks peers for their snapshots, offers them to us most recent first, and then applies the
+// chunks of the one we accept in order. Our snapshots are taken in Commit.
+
+func (app *App) ListSnapshots(context.Context, *types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
+	resp := &types.ResponseListSnapshots{}
+	if app.snapshots == nil {
+		return resp, nil
+	}
+	snapshots, err := app.snapshots.List()
+	if err != nil {
+		app.logger.InfoMsg("Could not list snapshots", structure.ErrorKey, err)
+		return resp, nil
+	}
+	for _, s := range snapshots {
+		abciSnapshot, err := s.ABCI()
+		if err != nil {
+			app.logger.InfoMsg("Could not describe snapshot", "height", s.Height, structure.ErrorKey, err)
+			continue
+		}
+		resp.Snapshots = append(resp.Snapshots, abciSnapshot)
+	}
+	return resp, nil
+}
+
+func (app *App) LoadSnapshotChunk(_ context.Context, req *types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error) {
+	if app.snapshots == nil {
+		return &types.ResponseLoadSnapshotChunk{}, nil
+	}
+	chunk, err := app.snapshots.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		app.logger.InfoMsg("Could not load snapshot chunk", "height", req.Height, "chunk", req.Chunk,
+			structure.ErrorKey, err)
+	}
+	return &types.ResponseLoadSnapshotChunk{Chunk: chunk}, nil
+}
+
+func (app *App) OfferSnapshot(_ context.Context, req *types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error) {
+	if app.snapshots == nil {
+		return &types.ResponseOfferSnapshot{Result: types.ResponseOfferSnapshot_ABORT}, nil
+	}
+	s, err := snapshot.FromABCI(req.Snapshot)
+	if errors.Is(err, snapshot.ErrUnsupportedFormat) {
+		return &types.ResponseOfferSnapshot{Result: types.ResponseOfferSnapshot_REJECT_FORMAT}, nil
+	}
+	if err != nil {
+		app.logger.InfoMsg("Rejecting snapshot", "height", req.Snapshot.GetHeight(), structure.ErrorKey, err)
+		return &types.ResponseOfferSnapshot{Result: types.ResponseOfferSnapshot_REJECT}, nil
+	}
+	err = app.snapshots.Offer(s, req.AppHash)
+	if err != nil {
+		app.logger.InfoMsg("Could not begin restoring snapshot", "height", s.Height, structure.ErrorKey, err)
+		return &types.ResponseOfferSnapshot{Result: types.ResponseOfferSnapshot_ABORT}, nil
+	}
+	return &types.ResponseOfferSnapshot{Result: types.ResponseOfferSnapshot_ACCEPT}, nil
+}
+
+func (app *App) ApplySnapshotChunk(_ context.Context, req *types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error) {
+	if app.snapshots == nil {
+		return &types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_ABORT}, nil
+	}
+	restored, err := app.snapshots.ApplyChunk(req.Index, req.Chunk)
+	if errors.Is(err, snapshot.ErrInvalidChunk) {
+		return &types.ResponseApplySnapshotChunk{
+			Result:        types.ResponseApplySnapshotChunk_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+	}
+	if err != nil {
+		app.logger.InfoMsg("Rejecting snapshot", "chunk", req.Index, structure.ErrorKey, err)
+		return &types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_REJECT_SNAPSHOT}, nil
+	}
+	if restored {
+		// Our executors must now execute the block after the snapshot against the restored state
+		err = app.checker.Restart()
+		if err == nil {
+			err = app.committer.Restart()
+		}
+		if err != nil {
+			app.logger.InfoMsg("Could not restart execution from restored snapshot", structure.ErrorKey, err)
+			return &types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_ABORT}, nil
+		}
+	}
+	return &types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_ACCEPT}, nil
+}
@@ -8,13 +8,14 @@ import (
 	"strings"
 	"time"
 
+	tmConfig "github.com/cometbft/cometbft/config"
 	"github.com/hyperledger/burrow/consensus/abci"
-	tmConfig "github.com/tendermint/tendermint/config"
 )
 
 const (
-	NeverCreateEmptyBlocks  = "never"
-	AlwaysCreateEmptyBlocks = "always"
+	NeverCreateEmptyBlocks    = "never"
+	AlwaysCreateEmptyBlocks   = "always"
+	AdaptiveCreateEmptyBlocks = "adaptive"
 )
 
 // Burrow's view on Tendermint's config. Since we operate as a Tendermint harness not all configuration values
@@ -43,7 +44,37 @@ type BurrowTendermintConfig struct {
 	// EmptyBlocks mode and possible interval between empty blocks in seconds, one of:
 	// "", "never" (to never create unnecessary blocks)
 	// "always" (to create empty blocks each consensus round)
+	// "adaptive" (to create blocks when transactions are pending or EmptyBlocksInterval has passed since the last)
+	// or a duration (e.g. 5m) as shorthand for "adaptive" with that EmptyBlocksInterval
 	CreateEmptyBlocks string
+	// The longest time to go without a block in adaptive mode (e.g. 5m), ignored in other modes
+	EmptyBlocksInterval string `json:",omitempty" toml:",omitempty"`
+	// Take a snapshot of state every SnapshotInterval blocks to serve to nodes joining by state sync, 0 for never
+	SnapshotInterval uint64
+	// Delete blocks and state older than the last RetainBlocks blocks, and older than RetainDuration (e.g. 168h), apart
+	// from what snapshots need. When both are set the longer window is kept, when neither is nothing is deleted.
+	// Validators should retain at least as many blocks as the evidence max age of the chain's consensus params.
+	RetainBlocks   uint64
+	RetainDuration string
+	// Join the network by restoring a snapshot served by peers rather than by replaying every block. Snapshots are
+	// verified by a light client against the StateSyncRPCServers starting from a block that is trusted because its
+	// StateSyncTrustHeight and StateSyncTrustHash were obtained from a node you trust.
+	StateSync bool
+	// Comma-separated Tendermint RPC addresses of at least two nodes (which must set RPCListenAddress)
+	StateSyncRPCServers  string
+	StateSyncTrustHeight int64
+	StateSyncTrustHash   string
+	// How long validators are trusted for after StateSyncTrustHeight, which should be less than the unbonding period
+	StateSyncTrustPeriod string
+	// Address to serve Tendermint's RPC on, which is otherwise disabled, for the light clients of nodes state syncing
+	RPCListenAddress string
+	// Shut down after committing the block at HaltHeight or the first block with a time at or after HaltTime (in
+	// RFC3339 format) and refuse to process any later block, so that validators all stop at the same block to upgrade
+	HaltHeight uint64 `json:",omitempty" toml:",omitempty"`
+	HaltTime   string `json:",omitempty" toml:",omitempty"`
+	// Follow the chain to serve queries without ever signing for consensus, whatever key the node is configured with,
+	// and refuse transactions rather than relaying them
+	ReadOnly bool `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowTendermintConfig() *BurrowTendermintConfig {
@@ -53,14 +84,80 @@ func DefaultBurrowTendermintConfig() *BurrowTendermintConfig {
 		return nil
 	}
 	return &BurrowTendermintConfig{
-		Enabled:           true,
-		ListenHost:        url.Hostname(),
-		ListenPort:        url.Port(),
-		ExternalAddress:   tmDefaultConfig.P2P.ExternalAddress,
-		CreateEmptyBlocks: "5m",
+		Enabled:              true,
+		ListenHost:           url.Hostname(),
+		ListenPort:           url.Port(),
+		ExternalAddress:      tmDefaultConfig.P2P.ExternalAddress,
+		CreateEmptyBlocks:    AdaptiveCreateEmptyBlocks,
+		EmptyBlocksInterval:  "5m",
+		StateSyncTrustPeriod: tmDefaultConfig.StateSync.TrustPeriod.String(),
 	}
 }
 
+// Retention returns how many of the most recent blocks and how long a duration of blocks to retain, zero for no limit
+func (btc *BurrowTendermintConfig) Retention() (uint64, time.Duration, error) {
+	if btc.RetainDuration == "" {
+		return btc.RetainBlocks, 0, nil
+	}
+	retainDuration, err := time.ParseDuration(btc.RetainDuration)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse RetainDuration '%s' as duration (e.g. 24h, 720h): %v",
+			btc.RetainDuration, err)
+	}
+	return btc.RetainBlocks, retainDuration, nil
+}
+
+// EmptyBlocks returns whether Tendermint should create empty blocks and how long it should wait for transactions
+// before doing so, zero for not at all
+func (btc *BurrowTendermintConfig) EmptyBlocks() (bool, time.Duration, error) {
+	switch strings.ToLower(btc.CreateEmptyBlocks) {
+	case NeverCreateEmptyBlocks, "":
+		return false, 0, nil
+	case AlwaysCreateEmptyBlocks:
+		return true, 0, nil
+	case AdaptiveCreateEmptyBlocks:
+		if btc.EmptyBlocksInterval == "" {
+			return false, 0, fmt.Errorf("EmptyBlocksInterval must be set when CreateEmptyBlocks is '%s'",
+				AdaptiveCreateEmptyBlocks)
+		}
+		interval, err := parseEmptyBlocksInterval("EmptyBlocksInterval", btc.EmptyBlocksInterval)
+		if err != nil {
+			return false, 0, err
+		}
+		return true, interval, nil
+	}
+	interval, err := parseEmptyBlocksInterval("CreateEmptyBlocks", btc.CreateEmptyBlocks)
+	if err != nil {
+		return false, 0, fmt.Errorf("%v, or CreateEmptyBlocks must be one of '%s', '%s', or '%s'", err,
+			NeverCreateEmptyBlocks, AlwaysCreateEmptyBlocks, AdaptiveCreateEmptyBlocks)
+	}
+	return true, interval, nil
+}
+
+func parseEmptyBlocksInterval(field, value string) (time.Duration, error) {
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %s '%s' as duration (e.g. 1s, 2m, 4h): %v", field, value, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("%s '%s' must be a positive duration", field, value)
+	}
+	return interval, nil
+}
+
+// Halt returns the height and time at which to halt, either of which may be zero to not halt by it
+func (btc *BurrowTendermintConfig) Halt() (uint64, time.Time, error) {
+	if btc.HaltTime == "" {
+		return btc.HaltHeight, time.Time{}, nil
+	}
+	haltTime, err := time.Parse(time.RFC3339, btc.HaltTime)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("could not parse HaltTime '%s' as RFC3339 time (e.g. 2006-01-02T15:04:05Z): %v",
+			btc.HaltTime, err)
+	}
+	return btc.HaltHeight, haltTime, nil
+}
+
 func (btc *BurrowTendermintConfig) Config(rootDir string, timeoutFactor float64) (*tmConfig.Config, error) {
 	conf := tmConfig.DefaultConfig()
 	// We expose Tendermint config as required, but try to give fewer levers to pull where possible
@@ -74,23 +171,17 @@ func (btc *BurrowTendermintConfig) Config(rootDir string, timeoutFactor float64)
 		// for which we use use TxReceipt (returned from ABCI DeliverTx) - we have our own much richer index
 		conf.TxIndex.Indexer = "null"
 		conf.Mempool.MaxTxBytes = 1024 * 1024 * 4 // 4MB
+		// A read-only node does not pass on transactions gossiped to it
+		conf.Mempool.Broadcast = !btc.ReadOnly
 
 		// Consensus
-		switch strings.ToLower(btc.CreateEmptyBlocks) {
-		case NeverCreateEmptyBlocks, "":
-			conf.Consensus.CreateEmptyBlocks = false
-		case AlwaysCreateEmptyBlocks:
-			conf.Consensus.CreateEmptyBlocks = true
-		default:
-			conf.Consensus.CreateEmptyBlocks = true
-			createEmptyBlocksInterval, err := time.ParseDuration(btc.CreateEmptyBlocks)
-			if err != nil {
-				return nil, fmt.Errorf("could not parse CreateEmptyBlock '%s' "+
-					"as '%s', '%s', or duration (e.g. 1s, 2m, 4h): %v",
-					btc.CreateEmptyBlocks, NeverCreateEmptyBlocks, AlwaysCreateEmptyBlocks, err)
-			}
-			conf.Consensus.CreateEmptyBlocksInterval = createEmptyBlocksInterval
+		// With an interval Tendermint waits for transactions before proposing a block until the interval has passed
+		createEmptyBlocks, createEmptyBlocksInterval, err := btc.EmptyBlocks()
+		if err != nil {
+			return nil, err
 		}
+		conf.Consensus.CreateEmptyBlocks = createEmptyBlocks
+		conf.Consensus.CreateEmptyBlocksInterval = createEmptyBlocksInterval
 		// Assume Tendermint has some mutually consistent values, assume scaling them linearly makes sense
 		conf.Consensus.TimeoutPropose = scaleTimeout(timeoutFactor, conf.Consensus.TimeoutPropose)
 		conf.Consensus.TimeoutProposeDelta = scaleTimeout(timeoutFactor, conf.Consensus.TimeoutProposeDelta)
@@ -115,10 +206,34 @@ func (btc *BurrowTendermintConfig) Config(rootDir string, timeoutFactor float64)
 		// Unfortunately this stops metrics from being used at all
 		conf.Instrumentation.Prometheus = false
 
-		conf.FilterPeers = btc.IdentifyPeers || btc.AuthorizedPeers != ""
+		// Always filter so that peers banned at runtime are refused
+		conf.FilterPeers = true
+
+		// State sync
+		conf.StateSync.Enable = btc.StateSync
+		if btc.StateSync {
+			conf.StateSync.RPCServers = strings.Split(btc.StateSyncRPCServers, ",")
+			conf.StateSync.TrustHeight = btc.StateSyncTrustHeight
+			conf.StateSync.TrustHash = btc.StateSyncTrustHash
+			if btc.StateSyncTrustPeriod != "" {
+				trustPeriod, err := time.ParseDuration(btc.StateSyncTrustPeriod)
+				if err != nil {
+					return nil, fmt.Errorf("could not parse StateSyncTrustPeriod '%s' as duration: %v",
+						btc.StateSyncTrustPeriod, err)
+				}
+				conf.StateSync.TrustPeriod = trustPeriod
+			}
+			err := conf.StateSync.ValidateBasic()
+			if err != nil {
+				return nil, fmt.Errorf("invalid state sync config: %v", err)
+			}
+		}
 	}
-	// Disable Tendermint RPC
+	// Disable Tendermint RPC unless it is needed to serve light clients
 	conf.RPC.ListenAddress = ""
+	if btc != nil {
+		conf.RPC.ListenAddress = btc.RPCListenAddress
+	}
 	return conf, nil
 }
 
@@ -127,6 +242,9 @@ func (btc *BurrowTendermintConfig) DefaultAuthorizedPeersProvider() abci.Authori
 
 	authorizedPeersAddrOrID := strings.Split(btc.AuthorizedPeers, ",")
 	for _, authorizedPeerAddrOrID := range authorizedPeersAddrOrID {
+		if authorizedPeerAddrOrID == "" {
+			continue
+		}
 		_, err := url.Parse(authorizedPeerAddrOrID)
 		isNodeAddress := err != nil
 		if isNodeAddress {
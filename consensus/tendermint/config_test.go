@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,9 +45,65 @@ func TestDefaultBurrowTendermintConfig(t *testing.T) {
 	assert.Equal(t, time.Duration(0), tmConf.Consensus.CreateEmptyBlocksInterval)
 	assert.True(t, tmConf.Consensus.CreateEmptyBlocks)
 
+	btc.CreateEmptyBlocks = "adaptive"
+	btc.EmptyBlocksInterval = "30s"
+	tmConf, err = btc.Config(".burrow", 0.33)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, tmConf.Consensus.CreateEmptyBlocksInterval)
+	assert.True(t, tmConf.Consensus.CreateEmptyBlocks)
+
+	btc.EmptyBlocksInterval = ""
+	_, err = btc.Config(".burrow", 0.33)
+	require.Error(t, err)
+
+	btc.EmptyBlocksInterval = "-1m"
+	_, err = btc.Config(".burrow", 0.33)
+	require.Error(t, err)
+
+	btc.CreateEmptyBlocks = "sometimes"
+	_, err = btc.Config(".burrow", 0.33)
+	require.Error(t, err)
+	btc.CreateEmptyBlocks = "always"
+
 	btc.AuthorizedPeers = ""
 	btc.IdentifyPeers = true
 	tmConf, err = btc.Config(".burrow", 0.33)
 	require.NoError(t, err)
 	assert.Equal(t, true, tmConf.FilterPeers)
+	assert.False(t, tmConf.StateSync.Enable)
+	assert.Equal(t, "", tmConf.RPC.ListenAddress)
+
+	btc.StateSync = true
+	_, err = btc.Config(".burrow", 0.33)
+	require.Error(t, err)
+
+	btc.StateSyncRPCServers = "tcp://10.0.0.1:26657,tcp://10.0.0.2:26657"
+	btc.StateSyncTrustHeight = 1000
+	btc.StateSyncTrustHash = "0B2A9EBB5EB87B38C3F1A4B85CF4B8D36D8D8C7C5DD6B2E5B5EFE8C6A6A3D5C1"
+	btc.StateSyncTrustPeriod = "24h"
+	btc.RPCListenAddress = "tcp://0.0.0.0:26657"
+	tmConf, err = btc.Config(".burrow", 0.33)
+	require.NoError(t, err)
+	assert.True(t, tmConf.StateSync.Enable)
+	assert.Equal(t, []string{"tcp://10.0.0.1:26657", "tcp://10.0.0.2:26657"}, tmConf.StateSync.RPCServers)
+	assert.Equal(t, 24*time.Hour, tmConf.StateSync.TrustPeriod)
+	assert.Equal(t, "tcp://0.0.0.0:26657", tmConf.RPC.ListenAddress)
+}
+
+func TestReadOnlyConfig(t *testing.T) {
+	btc := DefaultBurrowTendermintConfig()
+	tmConf, err := btc.Config(".burrow", 0.33)
+	require.NoError(t, err)
+	assert.True(t, tmConf.Mempool.Broadcast)
+
+	btc.ReadOnly = true
+	tmConf, err = btc.Config(".burrow", 0.33)
+	require.NoError(t, err)
+	assert.False(t, tmConf.Mempool.Broadcast)
+
+	privVal := NewPrivValidatorReadOnly()
+	_, err = privVal.GetPubKey()
+	require.NoError(t, err)
+	require.Error(t, privVal.SignVote("chain", &tmproto.Vote{Height: 1}))
+	require.Error(t, privVal.SignProposal("chain", &tmproto.Proposal{Height: 1}))
 }
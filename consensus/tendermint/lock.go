@@ -0,0 +1,22 @@
+// +build !windows
+
+package tendermint
+
+import (
+	"os"
+	"syscall"
+)
+
+// Opens file holding an exclusive advisory lock on it until it is closed, failing if it is already locked
+func lockFile(file string) (*os.File, error) {
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
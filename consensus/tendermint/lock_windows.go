@@ -0,0 +1,23 @@
+package tendermint
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// Opens file holding an exclusive lock on it until it is closed, failing if it is already locked
+func lockFile(file string) (*os.File, error) {
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	// Lock the first byte, which need not exist for the lock to be taken
+	err = windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY,
+		0, 1, 0, new(windows.Overlapped))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
@@ -1,8 +1,8 @@
 package tendermint
 
 import (
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/hyperledger/burrow/logging"
-	"github.com/tendermint/tendermint/libs/log"
 )
 
 type tendermintLogger struct {
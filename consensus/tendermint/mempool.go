@@ -0,0 +1,169 @@
+package tendermint
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/mempool"
+	tmTypes "github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// MempoolManager lists and evicts the transactions waiting in the mempool of a running node. Tendermint does not record
+// when transactions enter its mempool so the ABCI app tells us as it checks them.
+type MempoolManager struct {
+	sync.Mutex
+	// Set once the node has been created
+	mempool   *mempool.CListMempool
+	received  map[tmTypes.TxKey]time.Time
+	txDecoder txs.Decoder
+	logger    *logging.Logger
+}
+
+type MempoolTx struct {
+	Hash   binary.HexBytes
+	Type   payload.Type
+	Inputs []*payload.TxInput
+	// When the transaction entered the mempool, which is unknown for those received before the node started
+	Received *time.Time `json:",omitempty"`
+	Age      string     `json:",omitempty"`
+}
+
+func NewMempoolManager(txDecoder txs.Decoder, logger *logging.Logger) *MempoolManager {
+	return &MempoolManager{
+		received:  make(map[tmTypes.TxKey]time.Time),
+		txDecoder: txDecoder,
+		logger:    logger.WithScope("tendermint.MempoolManager"),
+	}
+}
+
+// SetMempool provides the mempool of the node whose transactions we manage
+func (mm *MempoolManager) SetMempool(mem mempool.Mempool) error {
+	clistMempool, ok := mem.(*mempool.CListMempool)
+	if !ok {
+		return fmt.Errorf("can only manage a CListMempool but Tendermint provides %T", mem)
+	}
+	mm.Lock()
+	defer mm.Unlock()
+	mm.mempool = clistMempool
+	return nil
+}
+
+// TxReceived records that tx has been accepted into the mempool
+func (mm *MempoolManager) TxReceived(tx []byte) {
+	mm.Lock()
+	defer mm.Unlock()
+	key := tmTypes.Tx(tx).Key()
+	if _, ok := mm.received[key]; !ok {
+		mm.received[key] = time.Now()
+	}
+}
+
+// TxRemoved records that tx has left the mempool
+func (mm *MempoolManager) TxRemoved(tx []byte) {
+	mm.Lock()
+	defer mm.Unlock()
+	delete(mm.received, tmTypes.Tx(tx).Key())
+}
+
+// Txs returns the transactions in the mempool in the order they will be proposed
+func (mm *MempoolManager) Txs() ([]*MempoolTx, error) {
+	mem, err := mm.getMempool()
+	if err != nil {
+		return nil, err
+	}
+	memTxs, _, err := mm.txs(mem, nil)
+	return memTxs, err
+}
+
+// Evict removes the transaction with hash from the mempool and returns it
+func (mm *MempoolManager) Evict(hash binary.HexBytes) ([]*MempoolTx, error) {
+	return mm.evict(func(memTx *MempoolTx) bool {
+		return memTx.Hash.String() == hash.String()
+	})
+}
+
+// EvictSender removes every transaction with an input from address from the mempool and returns them
+func (mm *MempoolManager) EvictSender(address crypto.Address) ([]*MempoolTx, error) {
+	return mm.evict(func(memTx *MempoolTx) bool {
+		for _, input := range memTx.Inputs {
+			if input.Address == address {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Evicted transactions are kept in the mempool's cache so that peers cannot gossip them back to us
+func (mm *MempoolManager) evict(predicate func(memTx *MempoolTx) bool) ([]*MempoolTx, error) {
+	mem, err := mm.getMempool()
+	if err != nil {
+		return nil, err
+	}
+	evicted, txBytes, err := mm.txs(mem, predicate)
+	if err != nil {
+		return nil, err
+	}
+	for i, tx := range txBytes {
+		err = mem.RemoveTxByKey(tx.Key())
+		if err != nil {
+			return nil, err
+		}
+		mm.TxRemoved(tx)
+		mm.logger.InfoMsg("Evicted transaction from mempool",
+			"tx_hash", evicted[i].Hash,
+			"tx_type", evicted[i].Type)
+	}
+	return evicted, nil
+}
+
+// Decodes the transactions in mem that satisfy predicate, or all of them if it is nil
+func (mm *MempoolManager) txs(mem *mempool.CListMempool,
+	predicate func(memTx *MempoolTx) bool) ([]*MempoolTx, []tmTypes.Tx, error) {
+
+	now := time.Now()
+	var memTxs []*MempoolTx
+	var txBytes []tmTypes.Tx
+	mm.Lock()
+	defer mm.Unlock()
+	received := make(map[tmTypes.TxKey]time.Time)
+	for _, tx := range mem.ReapMaxTxs(-1) {
+		txEnv, err := mm.txDecoder.DecodeTx(tx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not decode mempool transaction: %v", err)
+		}
+		memTx := &MempoolTx{
+			Hash:   txEnv.Tx.Hash(),
+			Type:   txEnv.Tx.Type(),
+			Inputs: txEnv.Tx.GetInputs(),
+		}
+		key := tmTypes.Tx(tx).Key()
+		if receivedAt, ok := mm.received[key]; ok {
+			received[key] = receivedAt
+			memTx.Received = &receivedAt
+			memTx.Age = now.Sub(receivedAt).Round(time.Second).String()
+		}
+		if predicate == nil || predicate(memTx) {
+			memTxs = append(memTxs, memTx)
+			txBytes = append(txBytes, tx)
+		}
+	}
+	// Forget any transactions that left the mempool without us being told
+	mm.received = received
+	return memTxs, txBytes, nil
+}
+
+func (mm *MempoolManager) getMempool() (*mempool.CListMempool, error) {
+	mm.Lock()
+	defer mm.Unlock()
+	if mm.mempool == nil {
+		return nil, fmt.Errorf("mempool cannot be managed until the node has started")
+	}
+	return mm.mempool, nil
+}
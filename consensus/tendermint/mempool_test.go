@@ -0,0 +1,74 @@
+package tendermint
+
+import (
+	"testing"
+
+	abciClient "github.com/cometbft/cometbft/abci/client"
+	abciTypes "github.com/cometbft/cometbft/abci/types"
+	tmConfig "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMempoolManager(t *testing.T) {
+	codec := txs.NewProtobufCodec()
+	mm := NewMempoolManager(codec, logging.NewNoopLogger())
+	_, err := mm.Txs()
+	require.Error(t, err, "the mempool cannot be managed before the node has started")
+
+	conn := proxy.NewAppConnMempool(abciClient.NewLocalClient(nil, abciTypes.NewBaseApplication()),
+		proxy.NopMetrics())
+	mem := mempool.NewCListMempool(tmConfig.DefaultMempoolConfig(), conn, 0)
+	require.NoError(t, mm.SetMempool(mem))
+
+	stuck := crypto.Address{1}
+	other := crypto.Address{2}
+	checkTx := func(address crypto.Address, sequence uint64) []byte {
+		tx, err := codec.EncodeTx(txs.Enclose("TestMempoolManager", &payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: address, Amount: 1, Sequence: sequence}},
+			Outputs: []*payload.TxOutput{{Address: other, Amount: 1}},
+		}))
+		require.NoError(t, err)
+		require.NoError(t, mem.CheckTx(tx, nil, mempool.TxInfo{}))
+		mm.TxReceived(tx)
+		return tx
+	}
+	checkTx(stuck, 5)
+	checkTx(stuck, 6)
+	checkTx(other, 1)
+
+	memTxs, err := mm.Txs()
+	require.NoError(t, err)
+	require.Len(t, memTxs, 3)
+	for _, memTx := range memTxs {
+		assert.Equal(t, payload.TypeSend, memTx.Type)
+		assert.NotNil(t, memTx.Received)
+	}
+	assert.Equal(t, uint64(6), memTxs[1].Inputs[0].Sequence)
+
+	evicted, err := mm.EvictSender(stuck)
+	require.NoError(t, err)
+	require.Len(t, evicted, 2)
+	assert.Equal(t, 1, mem.Size())
+
+	evicted, err = mm.Evict(memTxs[2].Hash)
+	require.NoError(t, err)
+	require.Len(t, evicted, 1)
+	assert.Equal(t, other, evicted[0].Inputs[0].Address)
+	assert.Equal(t, 0, mem.Size())
+
+	// Evicted transactions are not accepted again
+	tx, err := codec.EncodeTx(txs.Enclose("TestMempoolManager", &payload.SendTx{
+		Inputs:  []*payload.TxInput{{Address: stuck, Amount: 1, Sequence: 5}},
+		Outputs: []*payload.TxOutput{{Address: other, Amount: 1}},
+	}))
+	require.NoError(t, err)
+	require.Error(t, mem.CheckTx(tx, nil, mempool.TxInfo{}))
+	assert.Empty(t, mm.received)
+}
@@ -0,0 +1,78 @@
+package tendermint
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+const (
+	PeerErrorsReactorName = "PEER_ERRORS"
+	// The number of peer errors we remember
+	MaxPeerErrors = 1000
+)
+
+// PeerErrorsReactor records the errors for which the switch stops peers, such as their sending us invalid messages,
+// so that they can be reported. It registers no channels so exchanges no messages with peers.
+type PeerErrorsReactor struct {
+	p2p.BaseReactor
+	sync.Mutex
+	errors []*PeerError
+}
+
+func NewPeerErrorsReactor() *PeerErrorsReactor {
+	per := new(PeerErrorsReactor)
+	per.BaseReactor = *p2p.NewBaseReactor("PeerErrorsReactor", per)
+	return per
+}
+
+// RemovePeer is called with the reason a peer was stopped, which is nil when it was stopped gracefully
+func (per *PeerErrorsReactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	if reason == nil {
+		return
+	}
+	per.Lock()
+	defer per.Unlock()
+	if len(per.errors) == MaxPeerErrors {
+		per.errors = per.errors[1:]
+	}
+	address := ""
+	if peer.SocketAddr() != nil {
+		address = peer.SocketAddr().String()
+	}
+	per.errors = append(per.errors, &PeerError{
+		ID:      string(peer.ID()),
+		Address: address,
+		Error:   fmt.Sprint(reason),
+		Time:    time.Now(),
+	})
+}
+
+// PeerErrors returns the errors recorded since the node started, oldest first
+func (per *PeerErrorsReactor) PeerErrors() []*PeerError {
+	per.Lock()
+	defer per.Unlock()
+	errs := make([]*PeerError, len(per.errors))
+	copy(errs, per.errors)
+	return errs
+}
+
+// EvidenceFromTendermint converts evidence held by Tendermint, which may implicate several validators, into one
+// Evidence for each of them
+func EvidenceFromTendermint(evidence []types.Evidence) ([]*exec.Evidence, error) {
+	var evs []*exec.Evidence
+	for _, ev := range evidence {
+		for _, abciEv := range ev.ABCI() {
+			execEv, err := exec.EvidenceFromABCI(abciEv)
+			if err != nil {
+				return nil, err
+			}
+			evs = append(evs, execEv)
+		}
+	}
+	return evs, nil
+}
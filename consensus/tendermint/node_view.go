@@ -3,14 +3,15 @@ package tendermint
 import (
 	"fmt"
 
+	"github.com/cometbft/cometbft/consensus"
+	ctypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
-	"github.com/tendermint/tendermint/consensus"
-	ctypes "github.com/tendermint/tendermint/consensus/types"
-	"github.com/tendermint/tendermint/p2p"
-	"github.com/tendermint/tendermint/state"
-	"github.com/tendermint/tendermint/types"
 )
 
 type NodeView struct {
@@ -98,6 +99,60 @@ func (nv *NodeView) MempoolTransactions(maxTxs int) ([]*txs.Envelope, error) {
 	return transactions, nil
 }
 
+// PendingEvidence returns the evidence of validator misbehaviour this node has verified but is yet to be committed
+func (nv *NodeView) PendingEvidence() ([]*exec.Evidence, error) {
+	if nv == nil {
+		return nil, nil
+	}
+	evidence, _ := nv.tmNode.EvidencePool().PendingEvidence(-1)
+	return EvidenceFromTendermint(evidence)
+}
+
+// PeerErrors returns the most recent errors for which peers were stopped
+func (nv *NodeView) PeerErrors() []*PeerError {
+	if nv == nil {
+		return nil
+	}
+	return nv.tmNode.PeerErrors()
+}
+
+// LightBlock returns the signed header of the block at height with the validator set that signed it
+func (nv *NodeView) LightBlock(height int64) (*types.LightBlock, error) {
+	if nv == nil {
+		return nil, fmt.Errorf("light blocks are only available from a node running Tendermint")
+	}
+	blockStore := nv.tmNode.BlockStore()
+	if height < blockStore.Base() || height > blockStore.Height() {
+		return nil, fmt.Errorf("block %d is not stored, which has blocks from %d to %d", height,
+			blockStore.Base(), blockStore.Height())
+	}
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("could not load block %d", height)
+	}
+	// The commit included in the next block is canonical but we only have the commit we saw for the latest block
+	var commit *types.Commit
+	if height < blockStore.Height() {
+		commit = blockStore.LoadBlockCommit(height)
+	} else {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil, fmt.Errorf("could not load commit for block %d", height)
+	}
+	validators, err := nv.tmNode.StateStore().LoadValidators(height)
+	if err != nil {
+		return nil, fmt.Errorf("could not load validators for block %d: %v", height, err)
+	}
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &meta.Header,
+			Commit: commit,
+		},
+		ValidatorSet: validators,
+	}, nil
+}
+
 func (nv *NodeView) RoundState() *ctypes.RoundState {
 	return nv.tmNode.ConsensusState().GetRoundState()
 }
@@ -0,0 +1,338 @@
+package tendermint
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/hyperledger/burrow/logging"
+)
+
+const PeersFileName = "peers.json"
+
+// PeerManager changes the peers of a running node and records the changes so they survive restarts. Peers are banned
+// by refusing them in the ABCI peer filter so Tendermint must filter peers.
+type PeerManager struct {
+	sync.Mutex
+	file    string
+	changes peerChanges
+	// Set once the node has been created
+	sw     *p2p.Switch
+	logger *logging.Logger
+}
+
+// The changes made to peers at runtime
+type peerChanges struct {
+	// Persistent peers added as ID@host:port
+	Added []string `json:",omitempty"`
+	// IDs of configured persistent peers removed
+	Removed []string `json:",omitempty"`
+	// IDs of banned peers and when their bans end, or the zero time if they do not
+	Banned map[string]time.Time `json:",omitempty"`
+}
+
+type PeerInfo struct {
+	ID         string
+	Address    string
+	Moniker    string
+	Outbound   bool
+	Persistent bool
+}
+
+type BannedPeer struct {
+	ID string
+	// When the ban ends, if it does
+	Until *time.Time `json:",omitempty"`
+}
+
+// LoadPeerManager returns a PeerManager recording changes in file, loading any changes already recorded there
+func LoadPeerManager(file string, logger *logging.Logger) (*PeerManager, error) {
+	pm := &PeerManager{
+		file: file,
+		changes: peerChanges{
+			Banned: make(map[string]time.Time),
+		},
+		logger: logger.WithScope("tendermint.PeerManager"),
+	}
+	bs, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return pm, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bs, &pm.changes)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode peers from %s: %v", file, err)
+	}
+	if pm.changes.Banned == nil {
+		pm.changes.Banned = make(map[string]time.Time)
+	}
+	return pm, nil
+}
+
+// SetSwitch provides the switch of the node whose peers we manage
+func (pm *PeerManager) SetSwitch(sw *p2p.Switch) {
+	pm.Lock()
+	defer pm.Unlock()
+	pm.sw = sw
+}
+
+// PersistentPeers returns the comma-separated persistent peers configured with those added at runtime and without those
+// removed at runtime
+func (pm *PeerManager) PersistentPeers(configured string) string {
+	pm.Lock()
+	defer pm.Unlock()
+	var peers []string
+	for _, peer := range append(splitPeers(configured), pm.changes.Added...) {
+		if !contains(peers, peer) && !contains(pm.changes.Removed, peerID(peer)) {
+			peers = append(peers, peer)
+		}
+	}
+	return strings.Join(peers, ",")
+}
+
+// PeerBanned returns whether the peer with idOrAddress is banned, we only ban peers by ID
+func (pm *PeerManager) PeerBanned(idOrAddress string) bool {
+	pm.Lock()
+	defer pm.Unlock()
+	until, ok := pm.changes.Banned[strings.ToLower(idOrAddress)]
+	return ok && (until.IsZero() || time.Now().Before(until))
+}
+
+// Peers returns the peers we are connected to
+func (pm *PeerManager) Peers() ([]*PeerInfo, error) {
+	sw, err := pm.getSwitch()
+	if err != nil {
+		return nil, err
+	}
+	var peers []*PeerInfo
+	for _, peer := range sw.Peers().List() {
+		info := &PeerInfo{
+			ID:         string(peer.ID()),
+			Outbound:   peer.IsOutbound(),
+			Persistent: peer.IsPersistent(),
+		}
+		if addr := peer.SocketAddr(); addr != nil {
+			info.Address = addr.String()
+		}
+		if ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok {
+			info.Moniker = ni.Moniker
+		}
+		peers = append(peers, info)
+	}
+	return peers, nil
+}
+
+// Banned returns the peers that are banned
+func (pm *PeerManager) Banned() []*BannedPeer {
+	pm.Lock()
+	defer pm.Unlock()
+	var banned []*BannedPeer
+	now := time.Now()
+	for id, until := range pm.changes.Banned {
+		peer := &BannedPeer{ID: id}
+		if !until.IsZero() {
+			if !now.Before(until) {
+				continue
+			}
+			until := until
+			peer.Until = &until
+		}
+		banned = append(banned, peer)
+	}
+	sort.Slice(banned, func(i, j int) bool {
+		return banned[i].ID < banned[j].ID
+	})
+	return banned
+}
+
+// AddPersistentPeer connects to the peer at address, given as ID@host:port, and keeps reconnecting to it
+func (pm *PeerManager) AddPersistentPeer(address string) error {
+	sw, err := pm.getSwitch()
+	if err != nil {
+		return err
+	}
+	netAddress, err := p2p.NewNetAddressString(address)
+	if err != nil {
+		return fmt.Errorf("could not parse peer address '%s' (expected ID@host:port): %v", address, err)
+	}
+	address = netAddress.String()
+	err = sw.AddPersistentPeers([]string{address})
+	if err != nil {
+		return err
+	}
+	pm.Lock()
+	defer pm.Unlock()
+	pm.changes.Removed = remove(pm.changes.Removed, string(netAddress.ID))
+	if !contains(pm.changes.Added, address) {
+		pm.changes.Added = append(pm.changes.Added, address)
+	}
+	err = pm.save()
+	if err != nil {
+		return err
+	}
+	pm.logger.InfoMsg("Added persistent peer", "peer_address", address)
+	return sw.DialPeersAsync([]string{address})
+}
+
+// RemovePersistentPeer disconnects from the peer with id and stops reconnecting to it. Until the node restarts Tendermint
+// may still reconnect to it if it is reconnected to by the peer and then dropped.
+func (pm *PeerManager) RemovePersistentPeer(id string) error {
+	id, err := normalisePeerID(id)
+	if err != nil {
+		return err
+	}
+	sw, err := pm.getSwitch()
+	if err != nil {
+		return err
+	}
+	pm.Lock()
+	var added []string
+	for _, peer := range pm.changes.Added {
+		if peerID(peer) != id {
+			added = append(added, peer)
+		}
+	}
+	pm.changes.Added = added
+	if !contains(pm.changes.Removed, id) {
+		pm.changes.Removed = append(pm.changes.Removed, id)
+	}
+	err = pm.save()
+	pm.Unlock()
+	if err != nil {
+		return err
+	}
+	pm.logger.InfoMsg("Removed persistent peer", "peer_id", id)
+	// Stopping gracefully rather than for an error does not reconnect
+	if peer := sw.Peers().Get(p2p.ID(id)); peer != nil {
+		sw.StopPeerGracefully(peer)
+	}
+	return nil
+}
+
+// BanPeer disconnects from the peer with id and refuses to connect to it for duration, or until it is unbanned if
+// duration is zero
+func (pm *PeerManager) BanPeer(id string, duration time.Duration) error {
+	id, err := normalisePeerID(id)
+	if err != nil {
+		return err
+	}
+	var until time.Time
+	if duration > 0 {
+		until = time.Now().Add(duration)
+	}
+	pm.Lock()
+	pm.changes.Banned[id] = until
+	err = pm.save()
+	sw := pm.sw
+	pm.Unlock()
+	if err != nil {
+		return err
+	}
+	pm.logger.InfoMsg("Banned peer", "peer_id", id, "until", until)
+	if sw != nil {
+		if peer := sw.Peers().Get(p2p.ID(id)); peer != nil {
+			sw.StopPeerGracefully(peer)
+		}
+	}
+	return nil
+}
+
+// UnbanPeer lifts any ban on the peer with id
+func (pm *PeerManager) UnbanPeer(id string) error {
+	id, err := normalisePeerID(id)
+	if err != nil {
+		return err
+	}
+	pm.Lock()
+	defer pm.Unlock()
+	delete(pm.changes.Banned, id)
+	err = pm.save()
+	if err != nil {
+		return err
+	}
+	pm.logger.InfoMsg("Unbanned peer", "peer_id", id)
+	return nil
+}
+
+func (pm *PeerManager) getSwitch() (*p2p.Switch, error) {
+	pm.Lock()
+	defer pm.Unlock()
+	if pm.sw == nil {
+		return nil, fmt.Errorf("peers cannot be managed until the node has started")
+	}
+	return pm.sw, nil
+}
+
+// Must hold lock
+func (pm *PeerManager) save() error {
+	// Forget bans that have ended
+	now := time.Now()
+	for id, until := range pm.changes.Banned {
+		if !until.IsZero() && !now.Before(until) {
+			delete(pm.changes.Banned, id)
+		}
+	}
+	bs, err := json.MarshalIndent(pm.changes, "", "\t")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(pm.file), 0700)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(pm.file, bs, 0600)
+}
+
+func normalisePeerID(id string) (string, error) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	bs, err := hex.DecodeString(id)
+	if err != nil || len(bs) != p2p.IDByteLength {
+		return "", fmt.Errorf("peer ID '%s' should be %d hex-encoded bytes", id, p2p.IDByteLength)
+	}
+	return id, nil
+}
+
+// The ID of a peer address, or the address if it has none
+func peerID(address string) string {
+	return strings.ToLower(strings.SplitN(address, "@", 2)[0])
+}
+
+func splitPeers(peers string) []string {
+	var split []string
+	for _, peer := range strings.Split(peers, ",") {
+		if peer = strings.TrimSpace(peer); peer != "" {
+			split = append(split, peer)
+		}
+	}
+	return split
+}
+
+func contains(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(strs []string, str string) []string {
+	var removed []string
+	for _, s := range strs {
+		if s != str {
+			removed = append(removed, s)
+		}
+	}
+	return removed
+}
@@ -0,0 +1,52 @@
+package tendermint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerManager(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestPeerManager")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "data", PeersFileName)
+	const (
+		id1 = "836ab8674a33416718e5a19557a25ed826b2bdd3"
+		id2 = "6b4a1a0b9d6e5a3e4c1d6e7f8a9b0c1d2e3f4a5b"
+	)
+
+	pm, err := LoadPeerManager(file, logging.NewNoopLogger())
+	require.NoError(t, err)
+	configured := id1 + "@10.0.0.1:26656," + id2 + "@10.0.0.2:26656"
+	require.Equal(t, configured, pm.PersistentPeers(configured))
+
+	// Peers cannot be changed before the node has started but can be banned
+	require.Error(t, pm.AddPersistentPeer(id1+"@10.0.0.1:26656"))
+	require.Error(t, pm.BanPeer("not an ID", 0))
+	require.NoError(t, pm.BanPeer("836AB8674A33416718E5A19557A25ED826B2BDD3", 0))
+	require.NoError(t, pm.BanPeer(id2, time.Nanosecond))
+	time.Sleep(time.Millisecond)
+	assert.True(t, pm.PeerBanned(id1))
+	assert.False(t, pm.PeerBanned(id2))
+	assert.False(t, pm.PeerBanned("10.0.0.1:26656"))
+
+	// Changes survive restarts
+	pm.changes.Removed = []string{id2}
+	pm.changes.Added = []string{id2 + "@10.0.0.3:26656"}
+	require.NoError(t, pm.save())
+	pm, err = LoadPeerManager(file, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.True(t, pm.PeerBanned(id1))
+	assert.Equal(t, []*BannedPeer{{ID: id1}}, pm.Banned())
+	assert.Equal(t, id1+"@10.0.0.1:26656", pm.PersistentPeers(configured))
+
+	require.NoError(t, pm.UnbanPeer(id1))
+	assert.False(t, pm.PeerBanned(id1))
+}
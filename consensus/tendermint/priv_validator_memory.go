@@ -1,10 +1,13 @@
 package tendermint
 
 import (
+	"fmt"
+
+	tmCrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/crypto"
-	tmCrypto "github.com/tendermint/tendermint/crypto"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
-	"github.com/tendermint/tendermint/types"
 )
 
 type privValidatorMemory struct {
@@ -25,6 +28,22 @@ func NewPrivValidatorMemory(addressable crypto.Addressable, signer crypto.Signer
 	}
 }
 
+// Create a PrivValidator like NewPrivValidatorMemory that persists what it last signed to stateFile so that after a
+// restart it still refuses to sign data that conflicts with what it signed before. While it is open no other
+// PrivValidator can use stateFile so a validator started twice from the same directory cannot double-sign.
+func NewPrivValidatorPersisted(addressable crypto.Addressable, signer crypto.Signer,
+	stateFile string) (*privValidatorMemory, error) {
+	lastSignedInfo, err := LoadLastSignedInfo(stateFile)
+	if err != nil {
+		return nil, err
+	}
+	return &privValidatorMemory{
+		Addressable:    addressable,
+		signer:         asTendermintSigner(signer),
+		lastSignedInfo: lastSignedInfo,
+	}, nil
+}
+
 func asTendermintSigner(signer crypto.Signer) func(msg []byte) []byte {
 	return func(msg []byte) []byte {
 		sig, err := signer.Sign(msg)
@@ -43,7 +62,6 @@ func (pvm *privValidatorMemory) GetPubKey() (tmCrypto.PubKey, error) {
 	return pvm.GetPublicKey().TendermintPubKey(), nil
 }
 
-// TODO: consider persistence to disk/database to avoid double signing after a crash
 func (pvm *privValidatorMemory) SignVote(chainID string, vote *tmproto.Vote) error {
 	return pvm.lastSignedInfo.SignVote(pvm.signer, chainID, vote)
 }
@@ -51,3 +69,34 @@ func (pvm *privValidatorMemory) SignVote(chainID string, vote *tmproto.Vote) err
 func (pvm *privValidatorMemory) SignProposal(chainID string, proposal *tmproto.Proposal) error {
 	return pvm.lastSignedInfo.SignProposal(pvm.signer, chainID, proposal)
 }
+
+// Close releases the state file of a persisted PrivValidator
+func (pvm *privValidatorMemory) Close() error {
+	return pvm.lastSignedInfo.Close()
+}
+
+type privValidatorReadOnly struct {
+	pubKey tmCrypto.PubKey
+}
+
+var _ types.PrivValidator = &privValidatorReadOnly{}
+
+// Create a PrivValidator for a node that must never take part in consensus. It has a key generated for each run,
+// which is not in the validator set, and refuses to sign anything should it become so.
+func NewPrivValidatorReadOnly() *privValidatorReadOnly {
+	return &privValidatorReadOnly{
+		pubKey: ed25519.GenPrivKey().PubKey(),
+	}
+}
+
+func (pvr *privValidatorReadOnly) GetPubKey() (tmCrypto.PubKey, error) {
+	return pvr.pubKey, nil
+}
+
+func (pvr *privValidatorReadOnly) SignVote(chainID string, vote *tmproto.Vote) error {
+	return fmt.Errorf("refusing to sign vote at height %d since this node is read-only", vote.Height)
+}
+
+func (pvr *privValidatorReadOnly) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	return fmt.Errorf("refusing to sign proposal at height %d since this node is read-only", proposal.Height)
+}
@@ -0,0 +1,125 @@
+package tendermint
+
+import (
+	"fmt"
+
+	tmstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	tmstore "github.com/cometbft/cometbft/proto/tendermint/store"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/version"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// RollbackState reconstructs Tendermint's state as it was once the block at height was committed from the validators
+// and consensus params kept in stateStore and the headers kept in blockStore. Since the results of executing a block
+// are only agreed in the header of the next block, the block after height must be stored.
+func RollbackState(stateStore sm.Store, blockStore sm.BlockStore, height int64) (sm.State, error) {
+	latest, err := stateStore.Load()
+	if err != nil {
+		return sm.State{}, fmt.Errorf("could not load Tendermint state: %w", err)
+	}
+	if latest.IsEmpty() {
+		return sm.State{}, fmt.Errorf("no Tendermint state to roll back")
+	}
+	if height < latest.InitialHeight || height >= latest.LastBlockHeight {
+		return sm.State{}, fmt.Errorf("can only roll back to a height from %d to %d, but height %d requested",
+			latest.InitialHeight, latest.LastBlockHeight-1, height)
+	}
+	if height < blockStore.Base() || height+1 > blockStore.Height() {
+		return sm.State{}, fmt.Errorf("blocks %d and %d are required to roll back to height %d but the block store "+
+			"has blocks from %d to %d", height, height+1, height, blockStore.Base(), blockStore.Height())
+	}
+	meta := blockStore.LoadBlockMeta(height)
+	next := blockStore.LoadBlockMeta(height + 1)
+	if meta == nil || next == nil {
+		return sm.State{}, fmt.Errorf("could not load blocks %d and %d from block store", height, height+1)
+	}
+	lastValidators, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return sm.State{}, err
+	}
+	validators, err := stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextValidators, err := stateStore.LoadValidators(height + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+	params, err := stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	// If validators or params changed after height we no longer know when they last changed before it, but claiming
+	// they changed at the first height we save them for makes Tendermint store them in full so is always safe
+	validatorsChanged := latest.LastHeightValidatorsChanged
+	if validatorsChanged > height+2 {
+		validatorsChanged = height + 2
+	}
+	paramsChanged := latest.LastHeightConsensusParamsChanged
+	if paramsChanged > height+1 {
+		paramsChanged = height + 1
+	}
+	return sm.State{
+		Version: tmstate.Version{
+			Consensus: next.Header.Version,
+			Software:  version.TMCoreSemVer,
+		},
+		ChainID:                          latest.ChainID,
+		InitialHeight:                    latest.InitialHeight,
+		LastBlockHeight:                  height,
+		LastBlockID:                      meta.BlockID,
+		LastBlockTime:                    meta.Header.Time,
+		NextValidators:                   nextValidators,
+		Validators:                       validators,
+		LastValidators:                   lastValidators,
+		LastHeightValidatorsChanged:      validatorsChanged,
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: paramsChanged,
+		LastResultsHash:                  next.Header.LastResultsHash,
+		AppHash:                          next.Header.AppHash,
+	}, nil
+}
+
+// TruncateBlockStore deletes all blocks above height from the block store in db
+func TruncateBlockStore(db dbm.DB, height int64) error {
+	blockStore := store.NewBlockStore(storage.NewCometDB(db))
+	base := blockStore.Base()
+	latest := blockStore.Height()
+	if height >= latest {
+		return nil
+	}
+	if height < base {
+		return fmt.Errorf("cannot truncate block store to height %d below its base %d", height, base)
+	}
+	batch := db.NewBatch()
+	defer batch.Close()
+	for h := height + 1; h <= latest; h++ {
+		meta := blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			continue
+		}
+		// Tendermint does not export its key formats
+		keys := [][]byte{
+			[]byte(fmt.Sprintf("H:%v", h)),
+			[]byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)),
+			[]byte(fmt.Sprintf("C:%v", h)),
+			[]byte(fmt.Sprintf("SC:%v", h)),
+			[]byte(fmt.Sprintf("EC:%v", h)),
+		}
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", h, p)))
+		}
+		for _, key := range keys {
+			err := batch.Delete(key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	// Like pruning, lower the height before deleting blocks so no one reads a missing block
+	store.SaveBlockStoreState(&tmstore.BlockStoreState{Base: base, Height: height}, storage.NewCometDB(db))
+	return batch.WriteSync()
+}
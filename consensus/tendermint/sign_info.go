@@ -2,17 +2,22 @@ package tendermint
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
 	"github.com/gogo/protobuf/proto"
 	"github.com/hyperledger/burrow/binary"
-	"github.com/tendermint/tendermint/libs/protoio"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
-	"github.com/tendermint/tendermint/types"
-	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 // TODO: type ?
@@ -44,6 +49,10 @@ type LastSignedInfo struct {
 	Step      int8            `json:"step"`
 	Signature []byte          `json:"signature,omitempty"` // so we don't lose signatures
 	SignBytes binary.HexBytes `json:"signbytes,omitempty"` // so we don't lose signatures
+	// File we persist to, if any, before releasing any signature
+	file string
+	// Held locked while we may sign so no other process can sign from the same file
+	lock *os.File
 }
 
 func NewLastSignedInfo() *LastSignedInfo {
@@ -52,6 +61,49 @@ func NewLastSignedInfo() *LastSignedInfo {
 	}
 }
 
+// LoadLastSignedInfo loads the LastSignedInfo persisted to file, or a new one if file does not yet exist, which persists
+// to file everything it signs before returning a signature. Another process or LastSignedInfo using the same file is
+// refused until Close is called.
+func LoadLastSignedInfo(file string) (*LastSignedInfo, error) {
+	err := os.MkdirAll(filepath.Dir(file), 0700)
+	if err != nil {
+		return nil, err
+	}
+	lock, err := lockFile(file + ".lock")
+	if err != nil {
+		return nil, fmt.Errorf("could not lock signing state %s, is another node running as this validator? %w",
+			file, err)
+	}
+	lsi := NewLastSignedInfo()
+	bs, err := ioutil.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		lock.Close()
+		return nil, err
+	}
+	if len(bs) > 0 {
+		err = json.Unmarshal(bs, lsi)
+		if err != nil {
+			lock.Close()
+			return nil, fmt.Errorf("could not decode signing state %s: %w", file, err)
+		}
+	}
+	lsi.file = file
+	lsi.lock = lock
+	return lsi, nil
+}
+
+// Close releases the lock on the file LastSignedInfo persists to, after which it refuses to sign
+func (lsi *LastSignedInfo) Close() error {
+	lsi.Lock()
+	defer lsi.Unlock()
+	if lsi.lock == nil {
+		return nil
+	}
+	err := lsi.lock.Close()
+	lsi.lock = nil
+	return err
+}
+
 type tmCryptoSigner func(msg []byte) []byte
 
 // SignVote signs a canonical representation of the vote, along with the
@@ -135,7 +187,10 @@ func (lsi *LastSignedInfo) signVote(sign tmCryptoSigner, chainID string, vote *t
 
 	// It passed the checks. Sign the vote
 	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	err = lsi.saveSigned(height, round, step, signBytes, sig)
+	if err != nil {
+		return err
+	}
 	vote.Signature = sig
 	return nil
 }
@@ -172,20 +227,39 @@ func (lsi *LastSignedInfo) signProposal(sign tmCryptoSigner, chainID string, pro
 
 	// It passed the checks. Sign the proposal
 	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	err = lsi.saveSigned(height, round, step, signBytes, sig)
+	if err != nil {
+		return err
+	}
 	proposal.Signature = sig
 	return nil
 }
 
 // Persist height/round/step and signature
 func (lsi *LastSignedInfo) saveSigned(height int64, round int32, step int8,
-	signBytes []byte, sig []byte) {
+	signBytes []byte, sig []byte) error {
 
+	if lsi.file != "" && lsi.lock == nil {
+		return fmt.Errorf("signing state %s has been closed", lsi.file)
+	}
 	lsi.Height = height
 	lsi.Round = round
 	lsi.Step = step
 	lsi.Signature = sig
 	lsi.SignBytes = signBytes
+	if lsi.file == "" {
+		return nil
+	}
+	bs, err := json.Marshal(lsi)
+	if err != nil {
+		return err
+	}
+	// If this fails we keep our in-memory state so we still refuse to sign anything conflicting until restarted
+	err = tempfile.WriteFileAtomic(lsi.file, bs, 0600)
+	if err != nil {
+		return fmt.Errorf("could not persist signing state: %w", err)
+	}
+	return nil
 }
 
 // String returns a string representation of the LastSignedInfo.
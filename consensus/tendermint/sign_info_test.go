@@ -0,0 +1,90 @@
+package tendermint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLastSignedInfo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestLoadLastSignedInfo")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "data", "priv_validator_state.json")
+	sign := func(msg []byte) []byte {
+		return []byte("signature")
+	}
+	vote := func(height int64, block string) *tmproto.Vote {
+		return &tmproto.Vote{
+			Type:   tmproto.PrevoteType,
+			Height: height,
+			Round:  1,
+			BlockID: tmproto.BlockID{
+				Hash: tmhash.Sum([]byte(block)),
+				PartSetHeader: tmproto.PartSetHeader{
+					Total: 1,
+					Hash:  tmhash.Sum([]byte(block + " parts")),
+				},
+			},
+			Timestamp: time.Unix(1600000000, 0).UTC(),
+		}
+	}
+
+	lsi, err := LoadLastSignedInfo(file)
+	require.NoError(t, err)
+	signed := vote(3, "block")
+	require.NoError(t, lsi.SignVote(sign, "chain", signed))
+	require.Equal(t, []byte("signature"), signed.Signature)
+
+	// Another validator cannot use the same signing state
+	_, err = LoadLastSignedInfo(file)
+	require.Error(t, err)
+
+	require.NoError(t, lsi.Close())
+	require.Error(t, lsi.SignVote(sign, "chain", vote(4, "block")))
+
+	// What we signed survives a restart
+	lsi, err = LoadLastSignedInfo(file)
+	require.NoError(t, err)
+	defer lsi.Close()
+	require.Equal(t, int64(3), lsi.Height)
+	require.Equal(t, int32(1), lsi.Round)
+	require.Equal(t, stepPrevote, lsi.Step)
+
+	// So we refuse to sign a different block at the same height, round, and step
+	require.Error(t, lsi.SignVote(sign, "chain", vote(3, "other block")))
+	// Or anything earlier
+	require.Error(t, lsi.SignVote(sign, "chain", vote(2, "block")))
+
+	// But we can give again the signature of what we signed before restarting
+	resigned := vote(3, "block")
+	require.NoError(t, lsi.SignVote(neverSign(t), "chain", resigned))
+	require.Equal(t, []byte("signature"), resigned.Signature)
+
+	// Including when only the timestamp differs, in which case we give the timestamp we signed
+	retimed := vote(3, "block")
+	retimed.Timestamp = retimed.Timestamp.Add(time.Second)
+	require.NoError(t, lsi.SignVote(neverSign(t), "chain", retimed))
+	require.Equal(t, []byte("signature"), retimed.Signature)
+	require.Equal(t, signed.Timestamp, retimed.Timestamp)
+
+	// And we sign what comes next
+	next := vote(4, "next block")
+	require.NoError(t, lsi.SignVote(sign, "chain", next))
+	require.Equal(t, []byte("signature"), next.Signature)
+	require.Equal(t, int64(4), lsi.Height)
+}
+
+// Signs nothing, since we should be giving back the signature we already made
+func neverSign(t *testing.T) tmCryptoSigner {
+	return func(msg []byte) []byte {
+		t.Fatalf("should not sign again")
+		return nil
+	}
+}
@@ -6,48 +6,96 @@ import (
 	"os"
 	"path"
 
-	"github.com/tendermint/tendermint/version"
-
+	cmtdb "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
-	"github.com/tendermint/tendermint/config"
-	"github.com/tendermint/tendermint/crypto/ed25519"
-	"github.com/tendermint/tendermint/node"
-	"github.com/tendermint/tendermint/p2p"
-	"github.com/tendermint/tendermint/proxy"
-	tmTypes "github.com/tendermint/tendermint/types"
+	"github.com/hyperledger/burrow/storage"
 	dbm "github.com/tendermint/tm-db"
 )
 
-func init() {
-	// Tendermint now sets this dynamically in it's build... we could also automate setting it
-	version.TMCoreSemVer = "0.34.3"
-}
-
 // Serves as a wrapper around the Tendermint node's closeable resources (database connections)
 type Node struct {
 	*node.Node
-	closers []interface {
+	// Tendermint's state database, which Tendermint does not expose
+	stateDB dbm.DB
+	// Tendermint's block store database
+	blockDB dbm.DB
+	// Wraps each of Tendermint's databases as it is opened
+	wrapDB func(db dbm.DB, name string) (dbm.DB, error)
+	// Records the errors for which peers are stopped
+	peerErrors *PeerErrorsReactor
+	// CometBFT only exposes its consensus state to its RPC
+	consensusState *consensus.State
+	closers        []interface {
 		Close() error
 	}
 }
 
 func DBProvider(ID string, backendType dbm.BackendType, dbDir string) (dbm.DB, error) {
-	return dbm.NewDB(ID, backendType, dbDir)
+	return storage.NewDB(ID, backendType, dbDir)
 }
 
-// Since Tendermint doesn't close its DB connections
-func (n *Node) DBProvider(ctx *node.DBContext) (dbm.DB, error) {
+// We close Tendermint's DB connections in Close rather than let it close them as it stops, when its peer routines may
+// still be reading them
+func (n *Node) DBProvider(ctx *config.DBContext) (cmtdb.DB, error) {
 	db, err := DBProvider(ctx.ID, dbm.BackendType(ctx.Config.DBBackend), ctx.Config.DBDir())
 	if err != nil {
 		return nil, err
 	}
 	n.closers = append(n.closers, db)
-	return db, nil
+	if n.wrapDB != nil {
+		db, err = n.wrapDB(db, ctx.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch ctx.ID {
+	case "state":
+		n.stateDB = db
+	case "blockstore":
+		n.blockDB = db
+	}
+	return unclosedDB{storage.NewCometDB(db)}, nil
+}
+
+type unclosedDB struct {
+	storage.CometDB
+}
+
+func (unclosedDB) Close() error {
+	return nil
+}
+
+// BlockDB returns the database of Tendermint's block store
+func (n *Node) BlockDB() dbm.DB {
+	return n.blockDB
+}
+
+// StateStore provides the validator sets and consensus params Tendermint has stored for each height
+func (n *Node) StateStore() sm.Store {
+	return sm.NewStore(storage.NewCometDB(n.stateDB), sm.StoreOptions{})
+}
+
+// ConsensusState returns the state of the node's consensus
+func (n *Node) ConsensusState() *consensus.State {
+	return n.consensusState
+}
+
+// PeerErrors returns the most recent errors for which peers were stopped
+func (n *Node) PeerErrors() []*PeerError {
+	return n.peerErrors.PeerErrors()
 }
 
 func (n *Node) Close() {
@@ -56,8 +104,11 @@ func (n *Node) Close() {
 	}
 }
 
+// NewNode creates a Tendermint node running app whose databases are each passed through wrapDB, if not nil, as they
+// are opened
 func NewNode(conf *config.Config, privValidator tmTypes.PrivValidator, genesisDoc *tmTypes.GenesisDoc,
-	app *abci.App, metricsProvider node.MetricsProvider, logger *logging.Logger, options ...node.Option) (*Node, error) {
+	app *abci.App, metricsProvider node.MetricsProvider, wrapDB func(db dbm.DB, name string) (dbm.DB, error),
+	logger *logging.Logger, options ...node.Option) (*Node, error) {
 
 	var err error
 	// disable Tendermint's RPC
@@ -68,7 +119,17 @@ func NewNode(conf *config.Config, privValidator tmTypes.PrivValidator, genesisDo
 		return nil, err
 	}
 
-	nde := &Node{}
+	nde := &Node{
+		peerErrors: NewPeerErrorsReactor(),
+		wrapDB:     wrapDB,
+	}
+	options = append(options, node.CustomReactors(map[string]p2p.Reactor{
+		PeerErrorsReactorName: nde.peerErrors,
+	}))
+	// Release any lock our PrivValidator holds on its signing state
+	if closer, ok := privValidator.(interface{ Close() error }); ok {
+		nde.closers = append(nde.closers, closer)
+	}
 	nde.Node, err = node.NewNode(conf, privValidator,
 		nodeKey, proxy.NewLocalClientCreator(app),
 		func() (*tmTypes.GenesisDoc, error) {
@@ -82,6 +143,11 @@ func NewNode(conf *config.Config, privValidator tmTypes.PrivValidator, genesisDo
 	if err != nil {
 		return nil, err
 	}
+	env, err := nde.ConfigureRPC()
+	if err != nil {
+		return nil, err
+	}
+	nde.consensusState = env.ConsensusState.(*consensus.State)
 	app.SetMempoolLocker(nde.Mempool())
 	return nde, nil
 }
@@ -97,10 +163,6 @@ func DeriveGenesisDoc(burrowGenesisDoc *genesis.GenesisDoc, appHash []byte) *tmT
 		}
 	}
 	consensusParams := tmTypes.DefaultConsensusParams()
-	// This is the smallest increment we can use to get a strictly increasing sequence
-	// of block time - we set it low to avoid skew
-	// if the BlockTimeIota is longer than the average block time
-	consensusParams.Block.TimeIotaMs = 1
 
 	return &tmTypes.GenesisDoc{
 		ChainID:         burrowGenesisDoc.GetChainID(),
@@ -8,10 +8,13 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 )
@@ -21,6 +24,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -116,37 +120,116 @@ func (m *NodeInfo) GetTxIndex() string {
 func (*NodeInfo) XXX_MessageName() string {
 	return "tendermint.NodeInfo"
 }
+
+// An error for which a peer was disconnected, as when it sent us an invalid message
+type PeerError struct {
+	ID                   string    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Address              string    `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Error                string    `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Time                 time.Time `protobuf:"bytes,4,opt,name=Time,proto3,stdtime" json:"Time"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PeerError) Reset()         { *m = PeerError{} }
+func (m *PeerError) String() string { return proto.CompactTextString(m) }
+func (*PeerError) ProtoMessage()    {}
+func (*PeerError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{1}
+}
+func (m *PeerError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PeerError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerError.Merge(m, src)
+}
+func (m *PeerError) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerError) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerError proto.InternalMessageInfo
+
+func (m *PeerError) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *PeerError) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeerError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PeerError) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (*PeerError) XXX_MessageName() string {
+	return "tendermint.PeerError"
+}
 func init() {
 	proto.RegisterType((*NodeInfo)(nil), "tendermint.NodeInfo")
 	golang_proto.RegisterType((*NodeInfo)(nil), "tendermint.NodeInfo")
+	proto.RegisterType((*PeerError)(nil), "tendermint.PeerError")
+	golang_proto.RegisterType((*PeerError)(nil), "tendermint.PeerError")
 }
 
 func init() { proto.RegisterFile("tendermint.proto", fileDescriptor_04f926c8da23c367) }
 func init() { golang_proto.RegisterFile("tendermint.proto", fileDescriptor_04f926c8da23c367) }
 
 var fileDescriptor_04f926c8da23c367 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x3d, 0x4f, 0xfb, 0x30,
-	0x10, 0xc6, 0xff, 0xce, 0x1f, 0xda, 0x62, 0x81, 0x84, 0x22, 0x06, 0x8b, 0xc1, 0xad, 0x10, 0x43,
-	0x07, 0x48, 0x24, 0x5e, 0x3e, 0x00, 0x6d, 0x07, 0x22, 0x95, 0x0a, 0x22, 0xc4, 0xc0, 0xd6, 0x34,
-	0x47, 0x6a, 0xb5, 0xf5, 0x55, 0xb6, 0xab, 0x36, 0xdf, 0x8e, 0x31, 0x23, 0x6c, 0x88, 0xa1, 0x42,
-	0xe9, 0x17, 0x41, 0x79, 0x29, 0x85, 0x05, 0x36, 0x3f, 0xbf, 0xc7, 0xe7, 0x7b, 0x7c, 0x47, 0xf7,
-	0x0d, 0xc8, 0x10, 0xd4, 0x44, 0x48, 0xe3, 0x4c, 0x15, 0x1a, 0xb4, 0xe9, 0x86, 0x1c, 0x1e, 0x44,
-	0x18, 0x61, 0x8e, 0xdd, 0xec, 0x54, 0xdc, 0x38, 0x7a, 0xb5, 0x68, 0xad, 0x87, 0x21, 0x78, 0xf2,
-	0x09, 0xed, 0x0e, 0xb5, 0xbc, 0x0e, 0x23, 0x0d, 0xd2, 0xdc, 0x6d, 0x5d, 0x24, 0xcb, 0xfa, 0xbf,
-	0xf7, 0x65, 0xfd, 0x24, 0x12, 0x66, 0x38, 0x0b, 0x9c, 0x01, 0x4e, 0xdc, 0x61, 0x3c, 0x05, 0x35,
-	0x86, 0x30, 0x02, 0xe5, 0x06, 0x33, 0xa5, 0x70, 0xee, 0x0e, 0x54, 0x3c, 0x35, 0xe8, 0x5c, 0x85,
-	0xa1, 0x02, 0xad, 0x7d, 0xcb, 0xeb, 0xd8, 0xc7, 0x74, 0xaf, 0x2b, 0xb4, 0x01, 0x59, 0x42, 0x66,
-	0x35, 0x48, 0x73, 0xc7, 0xff, 0x09, 0x6d, 0x46, 0xab, 0x3d, 0x30, 0x73, 0x54, 0x23, 0xf6, 0x3f,
-	0xf7, 0xd7, 0x32, 0x73, 0x1e, 0x40, 0x69, 0x81, 0x92, 0x6d, 0x15, 0x4e, 0x29, 0xed, 0x3b, 0x5a,
-	0x6b, 0x0f, 0xfb, 0x52, 0xc2, 0x58, 0xb3, 0xed, 0x3c, 0xe5, 0x65, 0x99, 0xf2, 0xf4, 0xf7, 0x94,
-	0x81, 0x90, 0x7d, 0x15, 0x3b, 0xd7, 0xb0, 0x68, 0xc5, 0x06, 0xb4, 0xff, 0xf5, 0x4c, 0xd6, 0xec,
-	0x06, 0xa5, 0x18, 0x81, 0x62, 0x95, 0xa2, 0x59, 0x29, 0x6d, 0x4e, 0xa9, 0x7f, 0xdb, 0x5e, 0xff,
-	0xa1, 0x9a, 0x9b, 0xdf, 0x48, 0x56, 0x79, 0xbf, 0xf0, 0x64, 0x08, 0x0b, 0x56, 0x2b, 0x2a, 0x4b,
-	0xd9, 0xea, 0x26, 0x29, 0x27, 0x2f, 0x29, 0x27, 0x6f, 0x29, 0x27, 0x1f, 0x29, 0x27, 0xcf, 0x2b,
-	0x4e, 0x92, 0x15, 0x27, 0x8f, 0x67, 0x7f, 0x0c, 0x13, 0xa5, 0x06, 0xa9, 0x67, 0xda, 0xdd, 0xec,
-	0x2d, 0xa8, 0xe4, 0x8b, 0x3a, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xba, 0x35, 0x49, 0xde,
-	0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x6f, 0xd4, 0x40,
+	0x10, 0x65, 0x8f, 0x24, 0x77, 0xb7, 0x7c, 0x08, 0xad, 0x52, 0xac, 0xae, 0xf0, 0x45, 0x11, 0x45,
+	0x0a, 0xb0, 0xa5, 0x00, 0x12, 0x2d, 0xce, 0x21, 0x61, 0x29, 0x44, 0xc1, 0x3a, 0x51, 0xd0, 0x9d,
+	0xe3, 0x89, 0x6f, 0x95, 0xf3, 0x8e, 0x35, 0xbb, 0x56, 0xce, 0x3f, 0x80, 0x9e, 0x9f, 0x44, 0x79,
+	0x25, 0x74, 0x88, 0x22, 0x20, 0xe7, 0x8f, 0x20, 0xaf, 0xed, 0x84, 0x34, 0xd0, 0xf9, 0xbd, 0x37,
+	0xcf, 0x33, 0x7e, 0xcf, 0xfc, 0x89, 0x05, 0x9d, 0x02, 0xe5, 0x4a, 0x5b, 0xbf, 0x20, 0xb4, 0x28,
+	0xf8, 0x2d, 0x33, 0xd9, 0xcd, 0x30, 0x43, 0x47, 0x07, 0xcd, 0x53, 0x3b, 0x31, 0x99, 0x66, 0x88,
+	0xd9, 0x0a, 0x02, 0x87, 0x92, 0xf2, 0x3c, 0xb0, 0x2a, 0x07, 0x63, 0x17, 0x79, 0xd1, 0x0e, 0xec,
+	0x7f, 0x1f, 0xf0, 0xd1, 0x09, 0xa6, 0x10, 0xe9, 0x73, 0x14, 0x33, 0x3e, 0x88, 0x66, 0x92, 0xed,
+	0xb1, 0x83, 0x87, 0xe1, 0xcb, 0xcd, 0xd5, 0xf4, 0xde, 0xcf, 0xab, 0xe9, 0xb3, 0x4c, 0xd9, 0x65,
+	0x99, 0xf8, 0x67, 0x98, 0x07, 0xcb, 0xaa, 0x00, 0x5a, 0x41, 0x9a, 0x01, 0x05, 0x49, 0x49, 0x84,
+	0x97, 0xc1, 0x19, 0x55, 0x85, 0x45, 0xff, 0x4d, 0x9a, 0x12, 0x18, 0x13, 0x0f, 0xa2, 0x99, 0x78,
+	0xca, 0x1f, 0x1d, 0x2b, 0x63, 0x41, 0x77, 0xa4, 0x1c, 0xec, 0xb1, 0x83, 0x71, 0x7c, 0x97, 0x14,
+	0x92, 0x0f, 0x4f, 0xc0, 0x5e, 0x22, 0x5d, 0xc8, 0xfb, 0x4e, 0xef, 0x61, 0xa3, 0x7c, 0x04, 0x32,
+	0x0a, 0xb5, 0xdc, 0x6a, 0x95, 0x0e, 0x8a, 0x0f, 0x7c, 0x74, 0xb4, 0x5c, 0x68, 0x0d, 0x2b, 0x23,
+	0xb7, 0xdd, 0x95, 0xaf, 0xba, 0x2b, 0x9f, 0xff, 0xfb, 0xca, 0x44, 0xe9, 0x05, 0x55, 0xfe, 0x3b,
+	0x58, 0x87, 0x95, 0x05, 0x13, 0xdf, 0xbc, 0xa6, 0x59, 0xf6, 0x1e, 0xb5, 0xba, 0x00, 0x92, 0x3b,
+	0xed, 0xb2, 0x0e, 0x0a, 0x8f, 0xf3, 0xf8, 0xf4, 0xa8, 0xff, 0x86, 0xa1, 0x13, 0xff, 0x62, 0x1a,
+	0xe7, 0x7c, 0x1d, 0xe9, 0x14, 0xd6, 0x72, 0xd4, 0x3a, 0x3b, 0xb8, 0xff, 0x99, 0xf1, 0xf1, 0x29,
+	0x00, 0xbd, 0x25, 0x42, 0x12, 0x8f, 0x6f, 0x42, 0x1d, 0xbb, 0x78, 0x24, 0x1f, 0xde, 0x0d, 0xa6,
+	0x87, 0x62, 0x97, 0x6f, 0x3b, 0x4b, 0x17, 0x48, 0x0b, 0xc4, 0x6b, 0xbe, 0x35, 0x57, 0x39, 0xb8,
+	0x2c, 0x1e, 0x1c, 0x4e, 0xfc, 0xb6, 0x51, 0xbf, 0x6f, 0xd4, 0x9f, 0xf7, 0x8d, 0x86, 0xa3, 0x26,
+	0x8c, 0x2f, 0xbf, 0xa6, 0x2c, 0x76, 0x8e, 0xf0, 0x78, 0x53, 0x7b, 0xec, 0x5b, 0xed, 0xb1, 0x1f,
+	0xb5, 0xc7, 0x7e, 0xd7, 0x1e, 0xfb, 0x7a, 0xed, 0xb1, 0xcd, 0xb5, 0xc7, 0x3e, 0x1d, 0xfe, 0xa7,
+	0x54, 0xd4, 0x06, 0xb4, 0x29, 0x4d, 0x70, 0xfb, 0x83, 0x25, 0x3b, 0x6e, 0xe3, 0x8b, 0x3f, 0x03,
+	0x00, 0x11, 0x8d, 0x5e, 0x49, 0x87, 0x02, 0x00, 0x00,
 }
 
 func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
@@ -238,6 +321,62 @@ func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeerError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTendermint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTendermint(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTendermint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTendermint(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTendermint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTendermint(v)
 	base := offset
@@ -289,6 +428,32 @@ func (m *NodeInfo) Size() (n int) {
 	return n
 }
 
+func (m *PeerError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTendermint(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTendermint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -604,6 +769,186 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PeerError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTendermint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
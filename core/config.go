@@ -2,20 +2,29 @@ package core
 
 import (
 	"fmt"
+	"path/filepath"
 
+	tmConfig "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/store"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/go-kit/kit/log"
+	"github.com/hyperledger/burrow/archive"
+	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/config"
 	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/registry"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/project"
-	tmConfig "github.com/tendermint/tendermint/config"
-	"github.com/tendermint/tendermint/node"
-	tmTypes "github.com/tendermint/tendermint/types"
+	"github.com/hyperledger/burrow/prune"
+	"github.com/hyperledger/burrow/snapshot"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
 )
 
 // LoadKeysFromConfig sets the keyClient & keyStore based on the given config
@@ -36,7 +45,11 @@ func (kern *Kernel) LoadKeysFromConfig(conf *keys.KeysConfig) (err error) {
 func (kern *Kernel) LoadLoggerFromConfig(conf *logconfig.LoggingConfig) error {
 	logger, err := conf.Logger()
 	kern.SetLogger(logger)
-	return err
+	if err != nil {
+		return err
+	}
+	kern.Logging = logconfig.NewManager(conf, logger)
+	return nil
 }
 
 // LoadExecutionOptionsFromConfig builds the execution options for the kernel
@@ -47,11 +60,58 @@ func (kern *Kernel) LoadExecutionOptionsFromConfig(conf *execution.ExecutionConf
 			return err
 		}
 		kern.exeOptions = exeOptions
+		kern.checkerOptions = conf.CheckerOptions()
 		kern.timeoutFactor = conf.TimeoutFactor
+		kern.txIndex = conf.TxIndex
+		kern.stateCache = conf.StateCache
+		if conf.CodeCacheSize > 0 {
+			evm.SetCodeCacheSize(conf.CodeCacheSize)
+		}
+	}
+	return nil
+}
+
+// LoadEncryptionFromConfig encrypts Burrow's and Tendermint's databases with the key configured, if any. It must be
+// loaded before cold storage so that what is moved there is encrypted too.
+func (kern *Kernel) LoadEncryptionFromConfig(conf *storage.EncryptionConfig) (err error) {
+	if !conf.Enabled() {
+		return nil
+	}
+	kern.dbKey, err = conf.Key()
+	if err != nil {
+		return err
 	}
+	db, err := storage.NewEncryptedDB(kern.database, kern.dbKey)
+	if err != nil {
+		return fmt.Errorf("could not open %s database: %w", BurrowDBName, err)
+	}
+	kern.database = db
 	return nil
 }
 
+// Wraps Tendermint's database called name as it is opened with the encryption and cold storage loaded
+func (kern *Kernel) wrapTendermintDB(db dbm.DB, name string) (dbm.DB, error) {
+	if kern.dbKey != nil {
+		edb, err := storage.NewEncryptedDB(db, kern.dbKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not open Tendermint %s database: %w", name, err)
+		}
+		db = edb
+	}
+	if name == "blockstore" {
+		return kern.coldStorage.Wrap(db, name)
+	}
+	return db, nil
+}
+
+// LoadColdStorageFromConfig reads Burrow's and Tendermint's databases through to the cold storage that old blocks and
+// execution events are moved to, if any is configured
+func (kern *Kernel) LoadColdStorageFromConfig(conf *storage.ColdStorageConfig) (err error) {
+	kern.coldStorage = conf
+	kern.database, err = conf.Wrap(kern.database, BurrowDBName)
+	return err
+}
+
 // LoadTendermintFromConfig loads our consensus engine into the kernel
 func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal tmTypes.PrivValidator) (err error) {
 	if conf.Tendermint == nil || !conf.Tendermint.Enabled {
@@ -74,6 +134,25 @@ func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal
 
 	app := abci.NewApp(kern.info, kern.Blockchain, kern.State, kern.checker, kern.committer, kern.txCodec,
 		authorizedPeersProvider, kern.Panic, kern.Logger)
+	app.SetParallelExecution(kern.Blockchain.GenesisDoc().Params.ParallelExecution)
+	app.SetFeeOrdering(execution.FeeOrdering(kern.Blockchain.GenesisDoc().Params.FeeOrdering).Enabled())
+	app.SetProposalOrdering(kern.Blockchain.GenesisDoc().Params.ProposalOrdering)
+	snapshots := snapshot.NewStore(filepath.Join(conf.BurrowDir, SnapshotsDirName), kern.State, kern.Blockchain,
+		conf.Tendermint.SnapshotInterval, kern.Logger)
+	app.SetSnapshots(snapshots)
+	retainBlocks, retainDuration, err := conf.Tendermint.Retention()
+	if err != nil {
+		return err
+	}
+	if retainBlocks > 0 || retainDuration > 0 {
+		app.SetPruner(prune.NewPruner(kern.State, snapshots, retainBlocks, retainDuration, kern.Logger))
+	}
+	haltHeight, haltTime, err := conf.Tendermint.Halt()
+	if err != nil {
+		return err
+	}
+	// We always halt for upgrades this binary does not support
+	app.SetHalt(haltHeight, haltTime, kern.ShutdownAndExit)
 
 	// We could use this to provide/register our own metrics (though this will register them with us). Unfortunately
 	// Tendermint currently ignores the metrics passed unless its own server is turned on.
@@ -91,13 +170,65 @@ func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal
 	if err != nil {
 		return fmt.Errorf("could not build Tendermint config: %v", err)
 	}
-	kern.Node, err = tendermint.NewNode(tmConf, privVal, tmGenesisDoc, app, metricsProvider, tmLogger)
-	return err
+	// Apply the changes made to peers at runtime
+	kern.Peers, err = tendermint.LoadPeerManager(filepath.Join(tmConf.DBDir(), tendermint.PeersFileName), kern.Logger)
+	if err != nil {
+		return fmt.Errorf("could not load peers: %v", err)
+	}
+	tmConf.P2P.PersistentPeers = kern.Peers.PersistentPeers(tmConf.P2P.PersistentPeers)
+	app.SetBannedPeers(kern.Peers)
+	kern.Mempool = tendermint.NewMempoolManager(kern.txCodec, kern.Logger)
+	app.SetMempoolTracker(kern.Mempool)
+	// CometBFT replays blocks to the app before the node is returned and the app stores their headers from the block
+	// store, until which point it is ours to read
+	wrapDB := func(db dbm.DB, name string) (dbm.DB, error) {
+		db, err := kern.wrapTendermintDB(db, name)
+		if err == nil && name == "blockstore" {
+			kern.Blockchain.SetBlockStore(bcm.NewBlockStore(store.NewBlockStore(storage.NewCometDB(db))))
+		}
+		return db, err
+	}
+	kern.Node, err = tendermint.NewNode(tmConf, privVal, tmGenesisDoc, app, metricsProvider, wrapDB, tmLogger)
+	if err != nil {
+		return err
+	}
+	if kern.coldStorage.Enabled() && kern.coldStorage.HotBlocks > 0 {
+		stateDB, ok := kern.database.(*storage.TieredDB)
+		if !ok {
+			return fmt.Errorf("cannot archive to cold storage since Burrow's database does not read from it")
+		}
+		app.SetArchiver(archive.NewArchiver(kern.State, stateDB, kern.Node.BlockDB().(*storage.TieredDB),
+			kern.coldStorage.HotBlocks, kern.Logger))
+	}
+	kern.Peers.SetSwitch(kern.Node.Switch())
+	return kern.Mempool.SetMempool(kern.Node.Mempool())
 }
 
 // LoadKernelFromConfig builds and returns a Kernel based solely on the supplied configuration
 func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
-	kern, err := NewKernel(conf.BurrowDir)
+	return loadKernelFromConfig(conf, "", false)
+}
+
+// RestoreKernelFromConfig builds and returns a Kernel like LoadKernelFromConfig but first restores its state from the
+// dump in restoreFile, unless state already exists in which case it is resumed
+func RestoreKernelFromConfig(conf *config.BurrowConfig, restoreFile string) (*Kernel, error) {
+	return loadKernelFromConfig(conf, restoreFile, false)
+}
+
+// LoadMemoryKernelFromConfig builds and returns a Kernel like LoadKernelFromConfig but keeps its state in memory rather
+// than in BurrowDir so that it is lost when the Kernel shuts down
+func LoadMemoryKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
+	return loadKernelFromConfig(conf, "", true)
+}
+
+func loadKernelFromConfig(conf *config.BurrowConfig, restoreFile string, inMemory bool) (*Kernel, error) {
+	var kern *Kernel
+	var err error
+	if inMemory {
+		kern, err = NewMemoryKernel()
+	} else {
+		kern, err = NewKernel(conf.BurrowDir, conf.Backend())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("could not create initial kernel: %v", err)
 	}
@@ -116,18 +247,56 @@ func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
 		return nil, fmt.Errorf("could not add execution options: %v", err)
 	}
 
+	if !inMemory {
+		err = kern.LoadEncryptionFromConfig(conf.DBEncryption)
+		if err != nil {
+			return nil, fmt.Errorf("could not load database encryption: %v", err)
+		}
+		err = kern.LoadColdStorageFromConfig(conf.ColdStorage)
+		if err != nil {
+			return nil, fmt.Errorf("could not load cold storage: %v", err)
+		}
+	}
+
+	if restoreFile != "" {
+		err = kern.LoadDump(conf.GenesisDoc, []string{restoreFile}, true)
+		if err != nil {
+			return nil, fmt.Errorf("could not restore state: %v", err)
+		}
+	}
+
 	err = kern.LoadState(conf.GenesisDoc)
 	if err != nil {
 		return nil, fmt.Errorf("could not load state: %v", err)
 	}
 
-	if conf.ValidatorAddress == nil {
-		return nil, fmt.Errorf("Address must be set")
-	}
+	var privVal tmTypes.PrivValidator
+	if conf.ReadOnly() {
+		if !conf.Tendermint.Enabled {
+			return nil, fmt.Errorf("a read-only node follows a chain with Tendermint, which must be enabled")
+		}
+		// Whatever key we are configured with we cannot sign for consensus so cannot duplicate a validator
+		privVal = tendermint.NewPrivValidatorReadOnly()
+		kern.readOnly = true
+	} else {
+		if conf.ValidatorAddress == nil {
+			return nil, fmt.Errorf("Address must be set")
+		}
 
-	privVal, err := kern.PrivValidator(*conf.ValidatorAddress)
-	if err != nil {
-		return nil, fmt.Errorf("could not form PrivValidator from Address: %v", err)
+		// Persist what we sign so we cannot double-sign after a restart or if started twice from the same directory
+		signStateFile := ""
+		if conf.Tendermint != nil && conf.Tendermint.Enabled {
+			tmConf, err := conf.TendermintConfig()
+			if err != nil {
+				return nil, fmt.Errorf("could not build Tendermint config: %v", err)
+			}
+			signStateFile = tmConf.PrivValidatorStateFile()
+		}
+
+		privVal, err = kern.PrivValidator(*conf.ValidatorAddress, signStateFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not form PrivValidator from Address: %v", err)
+		}
 	}
 
 	err = kern.LoadTendermintFromConfig(conf, privVal)
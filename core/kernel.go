@@ -23,20 +23,23 @@ import (
 	"github.com/hyperledger/burrow/rpc/web3"
 
 	// GRPC Codec
+	"github.com/cometbft/cometbft/store"
+	tmTypes "github.com/cometbft/cometbft/types"
 	_ "github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
-	"github.com/tendermint/tendermint/store"
-	tmTypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -46,6 +49,8 @@ const (
 	LoggingCallerDepth     = 5
 	AccountsRingMutexCount = 100
 	BurrowDBName           = "burrow_state"
+	// Directory under the Burrow directory in which state sync snapshots are kept
+	SnapshotsDirName = "snapshots"
 )
 
 // Kernel is the root structure of Burrow
@@ -58,12 +63,20 @@ type Kernel struct {
 	State          *state.State
 	Blockchain     *bcm.Blockchain
 	Node           *tendermint.Node
+	Peers          *tendermint.PeerManager    // Changes the peers of Node at runtime
+	Mempool        *tendermint.MempoolManager // Lists and evicts the transactions in the mempool of Node
 	Transactor     *execution.Transactor
-	RunID          simpleuuid.UUID // Time-based UUID randomly generated each time Burrow is started
+	ContractStats  *exec.ContractStats       // Calls made to each contract by blocks committed since Burrow was started
+	StateSnapshots *execution.StateSnapshots // Records and restores the state of a chain running without consensus
+	RunID          simpleuuid.UUID           // Time-based UUID randomly generated each time Burrow is started
 	Logger         *logging.Logger
+	Logging        *logconfig.Manager // Changes the logging of the running node, when loaded from config
 	database       dbm.DB
+	coldStorage    *storage.ColdStorageConfig
+	dbKey          []byte // Encrypts Burrow's and Tendermint's databases when set
 	txCodec        txs.Codec
 	exeOptions     []execution.Option
+	checkerOptions []execution.Option
 	checker        execution.BatchExecutor
 	committer      execution.BatchCommitter
 	keyClient      keys.KeyClient
@@ -72,27 +85,40 @@ type Kernel struct {
 	processes      map[string]process.Process
 	listeners      map[string]net.Listener
 	timeoutFactor  float64
+	txIndex        *state.TxIndexConfig
+	stateCache     *state.CacheConfig
+	readOnly       bool
 	shutdownNotify chan struct{}
 	shutdownOnce   sync.Once
 }
 
-// NewKernel initializes an empty kernel
-func NewKernel(dbDir string) (*Kernel, error) {
+// NewKernel initializes an empty kernel whose state is kept in dbDir using backend
+func NewKernel(dbDir string, backend dbm.BackendType) (*Kernel, error) {
 	if dbDir == "" {
 		return nil, fmt.Errorf("Burrow requires a database directory")
 	}
-	runID, err := simpleuuid.NewTime(time.Now()) // Create a random ID based on start time
+	db, err := storage.NewDB(BurrowDBName, backend, dbDir)
 	if err != nil {
-		return nil, fmt.Errorf("could not create runID UUID: %w", err)
+		return nil, fmt.Errorf("could not create DB for Kernel: %w", err)
 	}
-	db, err := dbm.NewDB(BurrowDBName, dbm.GoLevelDBBackend, dbDir)
+	return newKernel(db)
+}
+
+// NewMemoryKernel initializes an empty kernel whose state is kept in memory and lost when it shuts down
+func NewMemoryKernel() (*Kernel, error) {
+	return newKernel(dbm.NewMemDB())
+}
+
+func newKernel(db dbm.DB) (*Kernel, error) {
+	runID, err := simpleuuid.NewTime(time.Now()) // Create a random ID based on start time
 	if err != nil {
-		return nil, fmt.Errorf("could not create DB for Kernel: %w", err)
+		return nil, fmt.Errorf("could not create runID UUID: %w", err)
 	}
 	return &Kernel{
 		Logger:         logging.NewNoopLogger(),
 		RunID:          runID,
 		Emitter:        event.NewEmitter(),
+		ContractStats:  exec.NewContractStats(),
 		processes:      make(map[string]process.Process),
 		listeners:      make(map[string]net.Listener),
 		shutdownNotify: make(chan struct{}),
@@ -150,22 +176,26 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 	}
 
 	kern.Logger.InfoMsg("State loading successful")
+	kern.State.SetTxIndex(kern.txIndex)
+	kern.State.SetCacheConfig(kern.stateCache)
 
 	params := execution.ParamsFromGenesis(genesisDoc)
-	kern.checker, err = execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger)
+	kern.checker, err = execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger,
+		kern.checkerOptions...)
 	if err != nil {
 		return fmt.Errorf("could not create BatchChecker: %w", err)
 	}
+	committerOptions := append([]execution.Option{execution.RecordContractStats(kern.ContractStats)}, kern.exeOptions...)
 	kern.committer, err = execution.NewBatchCommitter(kern.State, params, kern.Blockchain, kern.Emitter, kern.Logger,
-		kern.exeOptions...)
+		committerOptions...)
 	if err != nil {
 		return fmt.Errorf("could not create BatchCommitter: %w", err)
 	}
 	return nil
 }
 
-// LoadDump restores chain state from the given dump file
-func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string, silent bool) (err error) {
+// LoadDump restores chain state from the given dump file followed by any incremental dump files made since it
+func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFiles []string, silent bool) (err error) {
 	var exists bool
 	if kern.Blockchain, exists, err = bcm.LoadOrNewBlockchain(kern.database, genesisDoc, kern.Logger); err != nil {
 		return fmt.Errorf("error creating or loading blockchain state: %v", err)
@@ -179,7 +209,7 @@ func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string,
 		return fmt.Errorf("existing state found, please remove before restoring")
 	}
 
-	kern.Blockchain.SetBlockStore(bcm.NewBlockStore(store.NewBlockStore(kern.database)))
+	kern.Blockchain.SetBlockStore(bcm.NewBlockStore(store.NewBlockStore(storage.NewCometDB(kern.database))))
 
 	if kern.State, err = state.MakeGenesisState(kern.database, genesisDoc); err != nil {
 		return fmt.Errorf("could not build genesis state: %v", err)
@@ -189,7 +219,7 @@ func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string,
 		return fmt.Errorf("AppHash is required when restoring chain")
 	}
 
-	reader, err := dump.NewFileReader(restoreFile)
+	reader, err := dump.NewFilesReader(restoreFiles...)
 	if err != nil {
 		return fmt.Errorf("could not create dump file reader: %w", err)
 	}
@@ -241,8 +271,9 @@ func (kern *Kernel) SetKeyStore(store *keys.FilesystemKeyStore) {
 	kern.keyStore = store
 }
 
-// Generates an in-memory Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig)
-func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidator, error) {
+// Generates a Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig) that persists what it signs
+// to stateFile to protect against double-signing, or keeps it in memory if stateFile is empty
+func (kern *Kernel) PrivValidator(validator crypto.Address, stateFile string) (tmTypes.PrivValidator, error) {
 	val, err := keys.AddressableSigner(kern.keyClient, validator)
 	if err != nil {
 		return nil, fmt.Errorf("could not get validator addressable from keys client: %v", err)
@@ -251,7 +282,10 @@ func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidat
 	if err != nil {
 		return nil, err
 	}
-	return tendermint.NewPrivValidatorMemory(val, signer), nil
+	if stateFile == "" {
+		return tendermint.NewPrivValidatorMemory(val, signer), nil
+	}
+	return tendermint.NewPrivValidatorPersisted(val, signer, stateFile)
 }
 
 // Boot the kernel starting Tendermint and RPC layers
@@ -330,7 +364,12 @@ func (kern *Kernel) supervise() {
 	for {
 		select {
 		case <-reloadCh:
-			err := kern.Logger.Reload()
+			var err error
+			if kern.Logging != nil {
+				err = kern.Logging.Reload()
+			} else {
+				err = kern.Logger.Reload()
+			}
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "%v: could not reload logger: %v", kern, err)
 			}
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"fmt"
+	"path/filepath"
+
+	tmConfig "github.com/cometbft/cometbft/config"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The databases Tendermint may have created in its DBDir
+var tendermintDBNames = []string{"blockstore", "state", "evidence", "tx_index"}
+
+// MigrateDBs copies Burrow's database in burrowDir and Tendermint's databases from the from backend to the to backend,
+// encrypting them with key unless it is nil, moving the originals into a backup directory beside each, and returns the
+// names of the databases migrated. The node must not be running.
+func MigrateDBs(burrowDir string, tmConf *tmConfig.Config, from, to dbm.BackendType, key []byte) ([]string, error) {
+	dirs := map[string][]string{burrowDir: {BurrowDBName}}
+	dirs[tmConf.DBDir()] = append(dirs[tmConf.DBDir()], tendermintDBNames...)
+	var migrated []string
+	for dir, names := range dirs {
+		backupDir := filepath.Join(dir, fmt.Sprintf("%s-backup", from))
+		for _, name := range names {
+			ok, err := storage.Migrate(name, dir, from, to, key, backupDir)
+			if err != nil {
+				return migrated, err
+			}
+			if ok {
+				migrated = append(migrated, filepath.Join(dir, name))
+			}
+		}
+	}
+	return migrated, nil
+}
@@ -8,6 +8,11 @@ import (
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 
+	abciTypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	tmTypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/hyperledger/burrow/execution"
@@ -18,15 +23,15 @@ import (
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
 	"github.com/hyperledger/burrow/rpc/metrics"
+	"github.com/hyperledger/burrow/rpc/rpcadmin"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcfaucet"
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
 	"github.com/hyperledger/burrow/rpc/web3"
 	"github.com/hyperledger/burrow/txs"
-	"github.com/tendermint/tendermint/p2p"
-	"github.com/tendermint/tendermint/version"
 	hex "github.com/tmthrgd/go-hex"
 )
 
@@ -40,6 +45,8 @@ const (
 	InfoProcessName        = "rpcConfig/info"
 	GRPCProcessName        = "rpcConfig/GRPC"
 	MetricsProcessName     = "rpcConfig/metrics"
+	AdminProcessName       = "rpcConfig/admin"
+	FaucetProcessName      = "rpcConfig/faucet"
 )
 
 func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig *keys.KeysConfig) []process.Launcher {
@@ -54,6 +61,8 @@ func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig
 		InfoLauncher(kern, rpcConfig.Info),
 		MetricsLauncher(kern, rpcConfig.Metrics),
 		GRPCLauncher(kern, rpcConfig.GRPC, keysConfig),
+		AdminLauncher(kern, rpcConfig.Admin),
+		FaucetLauncher(kern, rpcConfig.Faucet),
 	}
 }
 
@@ -100,7 +109,8 @@ func NoConsensusLauncher(kern *Kernel) process.Launcher {
 			nameRegState := kern.State
 			nodeRegState := kern.State
 			validatorSet := kern.State
-			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorSet, nil, kern.Logger)
+			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorSet,
+				kern.ContractStats, nil, kern.Logger)
 			// TimeoutFactor scales in units of seconds
 			blockDuration := time.Duration(kern.timeoutFactor * float64(time.Second))
 			//proc := abci.NewProcess(kern.checker, kern.committer, kern.Blockchain, kern.txCodec, blockDuration, kern.Panic)
@@ -110,6 +120,8 @@ func NoConsensusLauncher(kern *Kernel) process.Launcher {
 			// Elide consensus and use a CheckTx function that immediately commits any valid transaction
 			kern.Transactor = execution.NewTransactor(kern.Blockchain,
 				kern.Emitter, accounts, proc.CheckTx, "", kern.txCodec, kern.Logger)
+			// Without peers to agree with we are free to return the chain to an earlier state
+			kern.StateSnapshots = execution.NewStateSnapshots(kern.State, kern.Blockchain, kern.committer, kern.checker)
 			return proc, nil
 		},
 	}
@@ -136,6 +148,11 @@ func TendermintLauncher(kern *Kernel) process.Launcher {
 			accounts := execution.NewAccounts(kern.checker, kern.keyClient, AccountsRingMutexCount)
 			// Pass transactions to Tendermint's CheckTx function for broadcast and consensus
 			checkTx := kern.Node.Mempool().CheckTx
+			if kern.readOnly {
+				checkTx = func(tx tmTypes.Tx, callback func(*abciTypes.ResponseCheckTx), txInfo mempool.TxInfo) error {
+					return fmt.Errorf("this node is read-only so does not accept transactions")
+				}
+			}
 			kern.Transactor = execution.NewTransactor(kern.Blockchain,
 				kern.Emitter, accounts, checkTx, id, kern.txCodec, kern.Logger)
 
@@ -144,7 +161,8 @@ func TendermintLauncher(kern *Kernel) process.Launcher {
 			nameRegState := kern.State
 			nodeRegState := kern.State
 			validatorState := kern.State
-			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorState, nodeView, kern.Logger)
+			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorState,
+				kern.ContractStats, nodeView, kern.Logger)
 			kern.EthService = web3.NewEthService(accountState, eventsState, kern.Blockchain, validatorState, nodeView, kern.Transactor, kern.keyStore, kern.Logger)
 
 			if err := kern.Node.Start(); err != nil {
@@ -229,7 +247,55 @@ func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 			if err != nil {
 				return nil, err
 			}
-			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, kern.Logger)
+			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, conf.CORS, kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+			return server, nil
+		},
+	}
+}
+
+func AdminLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
+	return process.Launcher{
+		Name:    AdminProcessName,
+		Enabled: conf != nil && conf.Enabled && kern.Peers != nil,
+		Launch: func() (process.Process, error) {
+			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			if err != nil {
+				return nil, err
+			}
+			err = kern.registerListener(AdminProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+			server, err := rpcadmin.StartServer(kern.Peers, kern.Mempool, kern.Logging, listener, kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+			return server, nil
+		},
+	}
+}
+
+func FaucetLauncher(kern *Kernel, conf *rpc.FaucetConfig) process.Launcher {
+	return process.Launcher{
+		Name:    FaucetProcessName,
+		Enabled: conf != nil && conf.Enabled,
+		Launch: func() (process.Process, error) {
+			faucet, err := rpcfaucet.NewFaucet(conf, kern.Blockchain.ChainID(), kern.Transactor, kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			if err != nil {
+				return nil, err
+			}
+			err = kern.registerListener(FaucetProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+			server, err := rpcfaucet.StartServer(faucet, listener, conf.CORS, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -252,7 +318,8 @@ func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 				return nil, err
 			}
 
-			srv, err := server.StartHTTPServer(listener, web3.NewServer(kern.EthService), kern.Logger)
+			srv, err := server.StartHTTPServer(listener, conf.CORS.Handler(web3.NewServer(kern.EthService)),
+				kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -323,11 +390,13 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 			txCodec := txs.NewProtobufCodec()
 			rpctransact.RegisterTransactServer(grpcServer,
 				rpctransact.NewTransactServer(func() (acmstate.Reader, error) {
-					return kern.State.AtLatestVersion()
-				}, kern.Blockchain, kern.Transactor, txCodec, kern.Logger))
+					return kern.State.ReadAtLatestVersion()
+				}, kern.Blockchain, kern.Transactor, kern.StateSnapshots, txCodec, kern.Logger))
 
 			rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(kern.State,
-				kern.Emitter, kern.Blockchain, kern.Logger))
+				func() (acmstate.Reader, error) {
+					return kern.State.ReadAtLatestVersion()
+				}, kern.Emitter, kern.Blockchain, kern.Logger))
 
 			rpcdump.RegisterDumpServer(grpcServer, rpcdump.NewDumpServer(kern.State, kern.Blockchain, kern.Logger))
 
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tmConfig "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Rollback rolls Burrow and Tendermint state back so that the last block committed is the one at height, or if height
+// is zero the one that many blocks before the last block, and returns that height. The block after it is kept and
+// executed again when the node is next started, later blocks are fetched again from peers. The node must not be running.
+func (kern *Kernel) Rollback(genesisDoc *genesis.GenesisDoc, tmConf *tmConfig.Config, height, blocks uint64) (uint64,
+	error) {
+	var exists bool
+	var err error
+	kern.Blockchain, exists, err = bcm.LoadOrNewBlockchain(kern.database, genesisDoc, kern.Logger)
+	if err != nil {
+		return 0, fmt.Errorf("error loading blockchain state: %v", err)
+	}
+	if !exists {
+		return 0, fmt.Errorf("no existing state found to roll back")
+	}
+	lastHeight := kern.Blockchain.LastBlockHeight()
+	if height == 0 && blocks < lastHeight {
+		height = lastHeight - blocks
+	}
+	if height == 0 || height >= lastHeight {
+		return 0, fmt.Errorf("can only roll back to a height below the last block height %d", lastHeight)
+	}
+
+	backend := dbm.BackendType(tmConf.DBBackend)
+	stateDB, err := tendermint.DBProvider("state", backend, tmConf.DBDir())
+	if err != nil {
+		return 0, fmt.Errorf("could not open Tendermint state: %w", err)
+	}
+	defer stateDB.Close()
+	stateDB, err = kern.wrapTendermintDB(stateDB, "state")
+	if err != nil {
+		return 0, err
+	}
+	blockDB, err := tendermint.DBProvider("blockstore", backend, tmConf.DBDir())
+	if err != nil {
+		return 0, fmt.Errorf("could not open Tendermint block store: %w", err)
+	}
+	defer blockDB.Close()
+	blockDB, err = kern.wrapTendermintDB(blockDB, "blockstore")
+	if err != nil {
+		return 0, err
+	}
+
+	stateStore := sm.NewStore(storage.NewCometDB(stateDB), sm.StoreOptions{})
+	tmState, err := tendermint.RollbackState(stateStore, store.NewBlockStore(storage.NewCometDB(blockDB)), int64(height))
+	if err != nil {
+		return 0, fmt.Errorf("could not roll back Tendermint state: %w", err)
+	}
+
+	// Check our state agrees with the chain before discarding anything
+	kern.State, err = state.LoadState(kern.database, execution.VersionAtHeight(lastHeight))
+	if err != nil {
+		return 0, fmt.Errorf("could not load state: %v", err)
+	}
+	stateHash, err := kern.State.HashAtHeight(height)
+	if err != nil {
+		return 0, fmt.Errorf("could not get state at height %d: %v", height, err)
+	}
+	if !bytes.Equal(stateHash, tmState.AppHash) {
+		return 0, fmt.Errorf("state at height %d has hash %X but the chain agreed on %X so cannot be rolled back to",
+			height, stateHash, tmState.AppHash)
+	}
+
+	// Discards later versions of state
+	kern.State, err = state.LoadState(kern.database, execution.VersionAtHeight(height))
+	if err != nil {
+		return 0, fmt.Errorf("could not roll back state: %v", err)
+	}
+	err = kern.Blockchain.RestoreAtHeight(height, tmState.LastBlockTime, tmState.AppHash)
+	if err != nil {
+		return 0, fmt.Errorf("could not roll back blockchain: %v", err)
+	}
+
+	err = stateStore.Save(tmState)
+	if err != nil {
+		return 0, fmt.Errorf("could not save Tendermint state: %w", err)
+	}
+	err = tendermint.TruncateBlockStore(blockDB, int64(height)+1)
+	if err != nil {
+		return 0, fmt.Errorf("could not truncate Tendermint block store: %w", err)
+	}
+	// Blocks and events from here will be written again so must be archived again
+	for _, db := range []dbm.DB{kern.database, blockDB} {
+		if tdb, ok := db.(*storage.TieredDB); ok {
+			archivedHeight, err := tdb.ArchivedHeight()
+			if err == nil && archivedHeight > height+1 {
+				err = tdb.SetArchivedHeight(height + 1)
+			}
+			if err != nil {
+				return 0, fmt.Errorf("could not reset height archived to cold storage: %w", err)
+			}
+		}
+	}
+	// The consensus WAL records heights we have rolled back which Tendermint would otherwise refuse to run again
+	err = os.RemoveAll(filepath.Dir(tmConf.Consensus.WalFile()))
+	if err != nil {
+		return 0, fmt.Errorf("could not remove Tendermint consensus WAL: %w", err)
+	}
+
+	kern.Logger.InfoMsg("Rolled back state", "height", height, "state_hash", tmState.AppHash)
+	return height, nil
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	tmConfig "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/store"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// VerifyReport describes the integrity of the state checked by Kernel.Verify against the chain
+type VerifyReport struct {
+	*state.VerifyReport
+	// Number of heights whose state hash was checked against the app hash the chain agreed on for them
+	AppHashesChecked int
+	// Heights whose state hash differs from the app hash the chain agreed on for them
+	AppHashMismatches []uint64
+}
+
+// Verify checks the state at the last block height, and the hash of each height of state that has not been pruned
+// against the app hash committed in the block store. If repair is set it deletes orphaned state and index entries left
+// after the last height. The node must not be running.
+func (kern *Kernel) Verify(genesisDoc *genesis.GenesisDoc, tmConf *tmConfig.Config, repair bool) (*VerifyReport,
+	error) {
+	var exists bool
+	var err error
+	kern.Blockchain, exists, err = bcm.LoadOrNewBlockchain(kern.database, genesisDoc, kern.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("error loading blockchain state: %v", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("no existing state found to verify")
+	}
+	lastHeight := kern.Blockchain.LastBlockHeight()
+
+	stateReport, err := state.Verify(kern.database, lastHeight, repair)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify state at height %d: %v", lastHeight, err)
+	}
+	report := &VerifyReport{VerifyReport: stateReport}
+	if len(report.Corrupt) > 0 {
+		return report, nil
+	}
+
+	blockDB, err := tendermint.DBProvider("blockstore", dbm.BackendType(tmConf.DBBackend), tmConf.DBDir())
+	if err != nil {
+		return nil, fmt.Errorf("could not open Tendermint block store: %w", err)
+	}
+	defer blockDB.Close()
+	blockDB, err = kern.wrapTendermintDB(blockDB, "blockstore")
+	if err != nil {
+		return nil, err
+	}
+	blockStore := store.NewBlockStore(storage.NewCometDB(blockDB))
+
+	for version, hash := range report.Hashes {
+		height := state.HeightAtVersion(version)
+		// The header of each block carries the app hash of the state after the block before it
+		var appHash []byte
+		if height == lastHeight {
+			appHash = kern.Blockchain.AppHashAfterLastBlock()
+		} else if meta := blockStore.LoadBlockMeta(int64(height) + 1); meta != nil {
+			appHash = meta.Header.AppHash
+		} else {
+			// Pruned from the block store
+			continue
+		}
+		report.AppHashesChecked++
+		if !bytes.Equal(hash, appHash) {
+			report.AppHashMismatches = append(report.AppHashMismatches, height)
+		}
+	}
+	sort.Slice(report.AppHashMismatches, func(i, j int) bool {
+		return report.AppHashMismatches[i] < report.AppHashMismatches[j]
+	})
+
+	kern.Logger.InfoMsg("Verified state", "height", lastHeight, "state_hash", report.Hash,
+		"trees", report.Trees, "app_hashes_checked", report.AppHashesChecked)
+	return report, nil
+}
@@ -0,0 +1,501 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/hyperledger/burrow/binary"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// The type of the domain of EIP-712 typed data, which need not be declared in its types when it has only the
+// standard fields
+const EIP712DomainType = "EIP712Domain"
+
+// https://eips.ethereum.org/EIPS/eip-712
+var (
+	eip712Prefix = []byte{0x19, 0x01}
+	// The standard domain fields in the order they are declared when we have to
+	eip712DomainFields = []TypedDataField{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+		{Name: "salt", Type: "bytes32"},
+	}
+	typedDataArrayRegex   = regexp.MustCompile(`^(.+)\[(\d*)\]$`)
+	typedDataIntegerRegex = regexp.MustCompile(`^(u?)int(\d*)$`)
+	typedDataBytesRegex   = regexp.MustCompile(`^bytes(\d+)$`)
+	typedDataIdentifier   = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+)
+
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is an EIP-712 message with the types of its structs and the domain it is signed for, as passed to
+// eth_signTypedData_v4. Values are those JSON decodes to (integers may be JSON numbers, or decimal or 0x-prefixed hex
+// strings, and bytes and addresses hex strings) or their Go equivalents.
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      map[string]interface{}      `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+// TypedDataFromJSON reads typed data keeping its numbers exact, since integers of up to 256 bits are common
+func TypedDataFromJSON(bs []byte) (*TypedData, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	td := new(TypedData)
+	err := decoder.Decode(td)
+	if err != nil {
+		return nil, fmt.Errorf("could not read EIP-712 typed data: %w", err)
+	}
+	return td, nil
+}
+
+// Hash returns the digest that is signed for the typed data: keccak256(0x19 0x01 ‖ domainSeparator ‖ hashStruct(message))
+func (td *TypedData) Hash() ([]byte, error) {
+	bs, err := td.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return Keccak256(bs), nil
+}
+
+// Encode returns the bytes whose hash is signed for the typed data. Since PrivateKey.Sign and the keys service hash
+// what they are given, these are what to pass them to sign typed data.
+func (td *TypedData) Encode() ([]byte, error) {
+	domainSeparator, err := td.DomainSeparator()
+	if err != nil {
+		return nil, err
+	}
+	if td.PrimaryType == "" {
+		return nil, fmt.Errorf("EIP-712 typed data has no primaryType")
+	}
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash message: %w", err)
+	}
+	bs := make([]byte, 0, len(eip712Prefix)+2*32)
+	bs = append(bs, eip712Prefix...)
+	bs = append(bs, domainSeparator...)
+	return append(bs, messageHash...), nil
+}
+
+// DomainSeparator returns hashStruct(domain), which contracts verifying signatures hold as DOMAIN_SEPARATOR
+func (td *TypedData) DomainSeparator() ([]byte, error) {
+	hash, err := td.HashStruct(EIP712DomainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash domain: %w", err)
+	}
+	return hash, nil
+}
+
+// ChainID returns the chainId of the domain, or nil if it has none
+func (td *TypedData) ChainID() (*big.Int, error) {
+	value, ok := td.Domain["chainId"]
+	if !ok {
+		return nil, nil
+	}
+	word, err := typedDataInteger(value, true, 256)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain chainId: %w", err)
+	}
+	return new(big.Int).SetBytes(word), nil
+}
+
+// HashStruct returns keccak256(typeHash ‖ encodeData(data)) for data of the struct type named
+func (td *TypedData) HashStruct(typeName string, data map[string]interface{}) ([]byte, error) {
+	bs, err := td.EncodeData(typeName, data)
+	if err != nil {
+		return nil, err
+	}
+	return Keccak256(bs), nil
+}
+
+// TypeHash returns keccak256(encodeType(typeName))
+func (td *TypedData) TypeHash(typeName string) ([]byte, error) {
+	encodedType, err := td.EncodeType(typeName)
+	if err != nil {
+		return nil, err
+	}
+	return Keccak256([]byte(encodedType)), nil
+}
+
+// EncodeType returns the signature of a struct type such as Mail(Person from,Person to,string contents) followed by
+// those of the struct types it refers to, in order of name
+func (td *TypedData) EncodeType(typeName string) (string, error) {
+	deps := make(map[string]bool)
+	err := td.dependencies(typeName, deps)
+	if err != nil {
+		return "", err
+	}
+	delete(deps, typeName)
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sb := new(strings.Builder)
+	for _, name := range append([]string{typeName}, names...) {
+		sb.WriteString(name)
+		sb.WriteString("(")
+		for i, field := range td.fields(name) {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(field.Type)
+			sb.WriteString(" ")
+			sb.WriteString(field.Name)
+		}
+		sb.WriteString(")")
+	}
+	return sb.String(), nil
+}
+
+// EncodeData returns typeHash ‖ the encoding of each field of data, which must have every field of the type. As wallets
+// do, any other fields of data are ignored.
+func (td *TypedData) EncodeData(typeName string, data map[string]interface{}) ([]byte, error) {
+	if !td.isStruct(typeName) {
+		return nil, fmt.Errorf("unknown struct type %s", typeName)
+	}
+	typeHash, err := td.TypeHash(typeName)
+	if err != nil {
+		return nil, err
+	}
+	fields := td.fields(typeName)
+	bs := make([]byte, 0, 32*(len(fields)+1))
+	bs = append(bs, typeHash...)
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing field %s", typeName, field.Name)
+		}
+		encoded, err := td.encodeValue(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode %s.%s: %w", typeName, field.Name, err)
+		}
+		bs = append(bs, encoded...)
+	}
+	return bs, nil
+}
+
+// Returns the 32 byte encoding of a value of any type
+func (td *TypedData) encodeValue(typeName string, value interface{}) ([]byte, error) {
+	if match := typedDataArrayRegex.FindStringSubmatch(typeName); match != nil {
+		elems, err := typedDataArray(value)
+		if err != nil {
+			return nil, err
+		}
+		if match[2] != "" {
+			length, err := strconv.Atoi(match[2])
+			if err != nil || length != len(elems) {
+				return nil, fmt.Errorf("%s has %d elements", typeName, len(elems))
+			}
+		}
+		bs := make([]byte, 0, 32*len(elems))
+		for i, elem := range elems {
+			encoded, err := td.encodeValue(match[1], elem)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			bs = append(bs, encoded...)
+		}
+		return Keccak256(bs), nil
+	}
+	if td.isStruct(typeName) {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object for %s but got %v", typeName, value)
+		}
+		return td.HashStruct(typeName, data)
+	}
+	switch typeName {
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string but got %v", value)
+		}
+		return Keccak256([]byte(str)), nil
+	case "bytes":
+		bs, err := typedDataBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return Keccak256(bs), nil
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool but got %v", value)
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+	case "address":
+		address, err := typedDataAddress(value)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LeftPadWord256(address.Bytes()).Bytes(), nil
+	}
+	if match := typedDataBytesRegex.FindStringSubmatch(typeName); match != nil {
+		size, err := strconv.Atoi(match[1])
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("invalid type %s", typeName)
+		}
+		bs, err := typedDataBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bs) != size {
+			return nil, fmt.Errorf("expected %d bytes for %s but got %d", size, typeName, len(bs))
+		}
+		return binary.RightPadWord256(bs).Bytes(), nil
+	}
+	if match := typedDataIntegerRegex.FindStringSubmatch(typeName); match != nil {
+		bits := 256
+		if match[2] != "" {
+			var err error
+			bits, err = strconv.Atoi(match[2])
+			if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+				return nil, fmt.Errorf("invalid type %s", typeName)
+			}
+		}
+		return typedDataInteger(value, match[1] == "u", bits)
+	}
+	return nil, fmt.Errorf("unknown type %s", typeName)
+}
+
+// Collects the struct types that typeName refers to, including itself
+func (td *TypedData) dependencies(typeName string, deps map[string]bool) error {
+	for match := typedDataArrayRegex.FindStringSubmatch(typeName); match != nil; match = typedDataArrayRegex.FindStringSubmatch(typeName) {
+		typeName = match[1]
+	}
+	if deps[typeName] || !td.isStruct(typeName) {
+		return nil
+	}
+	if !typedDataIdentifier.MatchString(typeName) {
+		return fmt.Errorf("invalid struct type name '%s'", typeName)
+	}
+	deps[typeName] = true
+	for _, field := range td.fields(typeName) {
+		err := td.dependencies(field.Type, deps)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (td *TypedData) isStruct(typeName string) bool {
+	if _, ok := td.Types[typeName]; ok {
+		return true
+	}
+	return typeName == EIP712DomainType
+}
+
+func (td *TypedData) fields(typeName string) []TypedDataField {
+	if fields, ok := td.Types[typeName]; ok || typeName != EIP712DomainType {
+		return fields
+	}
+	// Without a declared domain type the domain is taken to have those of the standard fields it has values for
+	var fields []TypedDataField
+	for _, field := range eip712DomainFields {
+		if _, ok := td.Domain[field.Name]; ok {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func typedDataArray(value interface{}) ([]interface{}, error) {
+	if elems, ok := value.([]interface{}); ok {
+		return elems, nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected an array but got %v", value)
+	}
+	elems := make([]interface{}, rv.Len())
+	for i := range elems {
+		elems[i] = rv.Index(i).Interface()
+	}
+	return elems, nil
+}
+
+func typedDataBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		if !strings.HasPrefix(v, "0x") && !strings.HasPrefix(v, "0X") {
+			return nil, fmt.Errorf("expected 0x-prefixed hex bytes but got '%s'", v)
+		}
+		v = v[2:]
+		if len(v)%2 == 1 {
+			v = "0" + v
+		}
+		return hex.DecodeString(v)
+	default:
+		return nil, fmt.Errorf("expected hex bytes but got %v", value)
+	}
+}
+
+func typedDataAddress(value interface{}) (Address, error) {
+	switch v := value.(type) {
+	case Address:
+		return v, nil
+	case string:
+		bs, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(v, "0x"), "0X"))
+		if err != nil || len(bs) != AddressLength {
+			return ZeroAddress, fmt.Errorf("expected a hex address but got '%s'", v)
+		}
+		return AddressFromBytes(bs)
+	default:
+		return ZeroAddress, fmt.Errorf("expected a hex address but got %v", value)
+	}
+}
+
+// Encodes an integer of the given width as a 32 byte word, in two's complement if signed
+func typedDataInteger(value interface{}, unsigned bool, bits int) ([]byte, error) {
+	n := new(big.Int)
+	switch v := value.(type) {
+	case *big.Int:
+		n.Set(v)
+	case json.Number:
+		if _, ok := n.SetString(v.String(), 10); !ok {
+			return nil, fmt.Errorf("expected an integer but got %v", v)
+		}
+	case string:
+		var ok bool
+		if strings.HasPrefix(v, "0x") || strings.HasPrefix(v, "0X") {
+			_, ok = n.SetString(v[2:], 16)
+		} else {
+			_, ok = n.SetString(v, 10)
+		}
+		if !ok {
+			return nil, fmt.Errorf("expected an integer but got '%s'", v)
+		}
+	case float64:
+		f := new(big.Float).SetFloat64(v)
+		if !f.IsInt() {
+			return nil, fmt.Errorf("expected an integer but got %v", v)
+		}
+		f.Int(n)
+	case int:
+		n.SetInt64(int64(v))
+	case int64:
+		n.SetInt64(v)
+	case uint64:
+		n.SetUint64(v)
+	default:
+		return nil, fmt.Errorf("expected an integer but got %v", value)
+	}
+	if unsigned {
+		if n.Sign() < 0 || n.BitLen() > bits {
+			return nil, fmt.Errorf("%v does not fit in uint%d", n, bits)
+		}
+	} else {
+		bound := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+		if n.Cmp(bound) >= 0 || n.Cmp(new(big.Int).Neg(bound)) < 0 {
+			return nil, fmt.Errorf("%v does not fit in int%d", n, bits)
+		}
+		if n.Sign() < 0 {
+			n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+	}
+	word := make([]byte, 32)
+	bs := n.Bytes()
+	copy(word[32-len(bs):], bs)
+	return word, nil
+}
+
+// EthereumSignature returns a secp256k1 signature in the layout of Ethereum wallets and contracts:
+// r ‖ s ‖ v with v 27 or 28, which is what eth_signTypedData_v4 returns and what ecrecover-based contracts split into
+// their (v, r, s) arguments
+func (sig *Signature) EthereumSignature() ([]byte, error) {
+	if sig.CurveType != CurveTypeSecp256k1 {
+		return nil, fmt.Errorf("can only get Ethereum signature for %v keys, but got %v",
+			CurveTypeSecp256k1, sig.CurveType)
+	}
+	if len(sig.Signature) != secp256k1PublicKeyLength {
+		return nil, fmt.Errorf("must get uncompressed compact layout signature of %v bytes but got %v bytes",
+			secp256k1PublicKeyLength, len(sig.Signature))
+	}
+	// Our compact layout is v ‖ r ‖ s
+	v := sig.Signature[0]
+	if v != 27 && v != 28 {
+		return nil, fmt.Errorf("expected a signature for an uncompressed key with recovery byte 27 or 28 but got %d", v)
+	}
+	bs := make([]byte, 0, 65)
+	bs = append(bs, sig.Signature[1:]...)
+	return append(bs, v), nil
+}
+
+// SplitEthereumSignature returns the (v, r, s) that contract functions such as permit() take for a 65 byte signature
+// r ‖ s ‖ v, normalising v to 27 or 28 since some wallets give 0 or 1
+func SplitEthereumSignature(sig []byte) (v uint8, r, s []byte, err error) {
+	if len(sig) != 65 {
+		return 0, nil, nil, fmt.Errorf("expected a 65 byte signature but got %d bytes", len(sig))
+	}
+	v = sig[64]
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return 0, nil, nil, fmt.Errorf("invalid signature recovery byte %d", sig[64])
+	}
+	return v, sig[:32], sig[32:64], nil
+}
+
+// RecoverEthereumSigner returns the address that made an Ethereum signature r ‖ s ‖ v of a 32 byte hash, as ecrecover
+// does on chain
+func RecoverEthereumSigner(hash, sig []byte) (Address, error) {
+	v, r, s, err := SplitEthereumSignature(sig)
+	if err != nil {
+		return ZeroAddress, err
+	}
+	compactSig := make([]byte, 0, 65)
+	compactSig = append(compactSig, v)
+	compactSig = append(compactSig, r...)
+	compactSig = append(compactSig, s...)
+	publicKey, _, err := btcecdsa.RecoverCompact(compactSig, hash)
+	if err != nil {
+		return ZeroAddress, fmt.Errorf("could not recover signer: %w", err)
+	}
+	return AddressFromBytes(Keccak256(publicKey.SerializeUncompressed()[1:])[12:])
+}
+
+// RecoverSigner returns the address that signed the typed data with an Ethereum signature r ‖ s ‖ v
+func (td *TypedData) RecoverSigner(sig []byte) (Address, error) {
+	hash, err := td.Hash()
+	if err != nil {
+		return ZeroAddress, err
+	}
+	return RecoverEthereumSigner(hash, sig)
+}
+
+// Verify checks that address signed the typed data, as a contract verifying it with ecrecover would
+func (td *TypedData) Verify(address Address, sig []byte) error {
+	signer, err := td.RecoverSigner(sig)
+	if err != nil {
+		return err
+	}
+	if signer != address {
+		return fmt.Errorf("typed data was signed by %v rather than %v", signer, address)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The example of https://eips.ethereum.org/EIPS/eip-712
+const mailTypedData = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallet", "type": "address"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person"},
+      {"name": "contents", "type": "string"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": 1,
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+    "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+    "contents": "Hello, Bob!"
+  }
+}`
+
+func TestTypedData(t *testing.T) {
+	td, err := TypedDataFromJSON([]byte(mailTypedData))
+	require.NoError(t, err)
+
+	encodedType, err := td.EncodeType("Mail")
+	require.NoError(t, err)
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encodedType)
+
+	domainSeparator, err := td.DomainSeparator()
+	require.NoError(t, err)
+	assert.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	require.NoError(t, err)
+	assert.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(messageHash))
+
+	hash, err := td.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hash))
+
+	t.Run("Sign", func(t *testing.T) {
+		// Cow's key is keccak256("cow")
+		privateKey, err := PrivateKeyFromRawBytes(Keccak256([]byte("cow")), CurveTypeSecp256k1)
+		require.NoError(t, err)
+		bs, err := td.Encode()
+		require.NoError(t, err)
+		sig, err := privateKey.Sign(bs)
+		require.NoError(t, err)
+		ethSig, err := sig.EthereumSignature()
+		require.NoError(t, err)
+		assert.Equal(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d"+
+			"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562"+"1c", hex.EncodeToString(ethSig))
+
+		address := privateKey.GetPublicKey().GetAddress()
+		assert.Equal(t, "CD2A3D9F938E13CD947EC05ABC7FE734DF8DD826", address.String())
+		require.NoError(t, td.Verify(address, ethSig))
+
+		v, r, s, err := SplitEthereumSignature(ethSig)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(28), v)
+		assert.Equal(t, ethSig[:32], r)
+		assert.Equal(t, ethSig[32:64], s)
+
+		// Some wallets give v as 0 or 1
+		ethSig[64] = 1
+		require.NoError(t, td.Verify(address, ethSig))
+
+		td.Message["contents"] = "Hello, Alice!"
+		require.Error(t, td.Verify(address, ethSig))
+	})
+
+	t.Run("Undeclared domain type", func(t *testing.T) {
+		td, err := TypedDataFromJSON([]byte(mailTypedData))
+		require.NoError(t, err)
+		delete(td.Types, EIP712DomainType)
+		hash, err := td.Hash()
+		require.NoError(t, err)
+		assert.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hash))
+	})
+
+	t.Run("Missing field", func(t *testing.T) {
+		td, err := TypedDataFromJSON([]byte(mailTypedData))
+		require.NoError(t, err)
+		delete(td.Message, "contents")
+		_, err = td.Hash()
+		require.Error(t, err)
+	})
+}
+
+func TestTypedDataEncodeValue(t *testing.T) {
+	td := &TypedData{
+		Types: map[string][]TypedDataField{
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "value", Type: "uint256"},
+			},
+		},
+	}
+	for _, tc := range []struct {
+		typeName string
+		value    interface{}
+		encoded  string
+	}{
+		{"uint256", "0x10", "0000000000000000000000000000000000000000000000000000000000000010"},
+		{"uint8", "255", "00000000000000000000000000000000000000000000000000000000000000ff"},
+		{"int8", -1, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{"bool", true, "0000000000000000000000000000000000000000000000000000000000000001"},
+		{"bytes4", "0xdeadbeef", "deadbeef00000000000000000000000000000000000000000000000000000000"},
+		{"address", "0x00000000000000000000000000000000000000ff", "00000000000000000000000000000000000000000000000000000000000000ff"},
+		// Arrays are the hash of their encoded elements
+		{"uint8[2]", []interface{}{1, 2}, hex.EncodeToString(Keccak256(append(make([]byte, 31), append([]byte{1}, append(make([]byte, 31), 2)...)...)))},
+	} {
+		encoded, err := td.encodeValue(tc.typeName, tc.value)
+		require.NoError(t, err, tc.typeName)
+		assert.Equal(t, tc.encoded, hex.EncodeToString(encoded), tc.typeName)
+	}
+
+	for _, tc := range []struct {
+		typeName string
+		value    interface{}
+	}{
+		{"uint8", 256},
+		{"uint256", -1},
+		{"int8", 128},
+		{"bytes4", "0xdead"},
+		{"uint8[3]", []interface{}{1, 2}},
+		{"Permit", map[string]interface{}{"owner": "0x00000000000000000000000000000000000000ff"}},
+		{"Unknown", "foo"},
+	} {
+		_, err := td.encodeValue(tc.typeName, tc.value)
+		require.Error(t, err, tc.typeName)
+	}
+}
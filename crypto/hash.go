@@ -2,15 +2,26 @@ package crypto
 
 import (
 	"crypto/sha256"
+	"hash"
+	"sync"
 
 	"golang.org/x/crypto/ripemd160"
 	"golang.org/x/crypto/sha3"
 )
 
+// Keccak hashers are reused since allocating their state dominates hashing the short inputs typical of the EVM
+var keccak256Pool = sync.Pool{
+	New: func() interface{} {
+		return sha3.NewLegacyKeccak256()
+	},
+}
+
 func Keccak256(data []byte) []byte {
-	hash := sha3.NewLegacyKeccak256()
-	hash.Write(data)
-	return hash.Sum(nil)
+	hasher := keccak256Pool.Get().(hash.Hash)
+	defer keccak256Pool.Put(hasher)
+	hasher.Reset()
+	hasher.Write(data)
+	return hasher.Sum(nil)
 }
 
 func SHA256(data []byte) []byte {
@@ -0,0 +1,115 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// The BIP-44 path under which Ethereum wallets and dev chains derive their accounts, the nth at DefaultDerivationPath/n
+const DefaultDerivationPath = "m/44'/60'/0'/0"
+
+// Child indices from this one up derive hardened keys, written with a ' in paths
+const hardenedKeyStart uint32 = 1 << 31
+
+// NewMnemonic returns a random BIP-39 mnemonic of 12 English words
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(128)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// PrivateKeyFromMnemonic derives the secp256k1 key at path (such as m/44'/60'/0'/0/0) from the seed of a BIP-39 mnemonic
+// as BIP-32 wallets do, so the same mnemonic gives the same accounts as it does in other wallets and dev chains
+func PrivateKeyFromMnemonic(mnemonic, path string) (PrivateKey, error) {
+	indices, err := parseDerivationPath(path)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	seed, err := bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), "")
+	if err != nil {
+		return PrivateKey{}, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+	key, chainCode := hmacSHA512([]byte("Bitcoin seed"), seed)
+	if !validScalar(key) {
+		return PrivateKey{}, fmt.Errorf("mnemonic gives an invalid master key")
+	}
+	for _, index := range indices {
+		key, chainCode, err = deriveChild(key, chainCode, index)
+		if err != nil {
+			return PrivateKey{}, fmt.Errorf("could not derive %s: %w", path, err)
+		}
+	}
+	return PrivateKeyFromRawBytes(key, CurveTypeSecp256k1)
+}
+
+// Derives the private child key at index from a parent key and chain code per BIP-32
+func deriveChild(key, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	var data []byte
+	if index >= hardenedKeyStart {
+		data = append([]byte{0}, key...)
+	} else {
+		_, publicKey := btcec.PrivKeyFromBytes(key)
+		data = publicKey.SerializeCompressed()
+	}
+	data = append(data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[len(data)-4:], index)
+	tweak, childChainCode := hmacSHA512(chainCode, data)
+	if !validScalar(tweak) {
+		return nil, nil, fmt.Errorf("index %d gives an invalid key", index)
+	}
+	child := new(big.Int).SetBytes(tweak)
+	child.Add(child, new(big.Int).SetBytes(key))
+	child.Mod(child, btcec.S256().N)
+	if child.Sign() == 0 {
+		return nil, nil, fmt.Errorf("index %d gives an invalid key", index)
+	}
+	childKey := make([]byte, btcec.PrivKeyBytesLen)
+	bs := child.Bytes()
+	copy(childKey[len(childKey)-len(bs):], bs)
+	return childKey, childChainCode, nil
+}
+
+func hmacSHA512(key, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+func validScalar(bs []byte) bool {
+	k := new(big.Int).SetBytes(bs)
+	return k.Sign() > 0 && k.Cmp(btcec.S256().N) < 0
+}
+
+func parseDerivationPath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("derivation path '%s' should start with m/", path)
+	}
+	indices := make([]uint32, len(parts)-1)
+	for i, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(index) >= hardenedKeyStart {
+			return nil, fmt.Errorf("could not parse index '%s' of derivation path '%s'", parts[i+1], path)
+		}
+		indices[i] = uint32(index)
+		if hardened {
+			indices[i] += hardenedKeyStart
+		}
+	}
+	return indices, nil
+}
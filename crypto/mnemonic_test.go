@@ -0,0 +1,39 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateKeyFromMnemonic(t *testing.T) {
+	// The well-known accounts of Hardhat and Anvil
+	const mnemonic = "test test test test test test test test test test test junk"
+	for i, expected := range []struct {
+		address    string
+		privateKey string
+	}{
+		{"F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266", "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"},
+		{"70997970C51812DC3A010C7D01B50E0D17DC79C8", "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d"},
+	} {
+		privateKey, err := PrivateKeyFromMnemonic(mnemonic, fmt.Sprintf("%s/%d", DefaultDerivationPath, i))
+		require.NoError(t, err)
+		assert.Equal(t, expected.privateKey, hex.EncodeToString(privateKey.RawBytes()))
+		assert.Equal(t, expected.address, privateKey.GetPublicKey().GetAddress().String())
+	}
+
+	mnemonic2, err := NewMnemonic()
+	require.NoError(t, err)
+	_, err = PrivateKeyFromMnemonic(mnemonic2, DefaultDerivationPath+"/0")
+	require.NoError(t, err)
+
+	_, err = PrivateKeyFromMnemonic("test test test test test test test test test test test test", "m/0")
+	assert.Error(t, err)
+	_, err = PrivateKeyFromMnemonic(mnemonic, "44'/60'")
+	assert.Error(t, err)
+	_, err = PrivateKeyFromMnemonic(mnemonic, "m/2147483648")
+	assert.Error(t, err)
+}
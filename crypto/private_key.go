@@ -8,7 +8,8 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"golang.org/x/crypto/ed25519"
 )
 
@@ -22,9 +23,9 @@ func PublicKeyFromBytes(bs []byte, curveType CurveType) (*PublicKey, error) {
 				len(bs), ed25519.PublicKeySize)
 		}
 	case CurveTypeSecp256k1:
-		if len(bs) != btcec.PubKeyBytesLenUncompressed {
+		if len(bs) != secp256k1PublicKeyLength {
 			return nil, fmt.Errorf("bytes passed have length %v but secp256k1 public keys have %v bytes",
-				len(bs), btcec.PubKeyBytesLenUncompressed)
+				len(bs), secp256k1PublicKeyLength)
 		}
 	case CurveTypeUnset:
 		if len(bs) > 0 {
@@ -48,8 +49,8 @@ func (p PrivateKey) Sign(msg []byte) (*Signature, error) {
 		privKey := ed25519.PrivateKey(p.PrivateKey)
 		return &Signature{CurveType: CurveTypeEd25519, Signature: ed25519.Sign(privKey, msg)}, nil
 	case CurveTypeSecp256k1:
-		privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), p.PrivateKey)
-		sig, err := btcec.SignCompact(btcec.S256(), privKey, Keccak256(msg), false)
+		privKey, _ := btcec.PrivKeyFromBytes(p.PrivateKey)
+		sig, err := btcecdsa.SignCompact(privKey, Keccak256(msg), false)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +84,8 @@ func GeneratePrivateKey(random io.Reader, curveType CurveType) (PrivateKey, erro
 		if err != nil {
 			return PrivateKey{}, err
 		}
-		return PrivateKeyFromRawBytes(((*btcec.PrivateKey)(privateKey)).Serialize(), CurveTypeSecp256k1)
+		privKey, _ := btcec.PrivKeyFromBytes(privateKey.D.Bytes())
+		return PrivateKeyFromRawBytes(privKey.Serialize(), CurveTypeSecp256k1)
 	default:
 		return PrivateKey{}, ErrInvalidCurve(curveType)
 	}
@@ -103,7 +105,7 @@ func PrivateKeyFromRawBytes(privateKeyBytes []byte, curveType CurveType) (Privat
 			return PrivateKey{}, fmt.Errorf("bytes passed have length %v but secp256k1 private keys have %v bytes",
 				len(privateKeyBytes), btcec.PrivKeyBytesLen)
 		}
-		_, publicKey := btcec.PrivKeyFromBytes(btcec.S256(), privateKeyBytes)
+		_, publicKey := btcec.PrivKeyFromBytes(privateKeyBytes)
 		return PrivateKey{PrivateKey: privateKeyBytes, PublicKey: publicKey.SerializeUncompressed(), CurveType: CurveTypeSecp256k1}, nil
 	default:
 		return PrivateKey{}, ErrInvalidCurve(curveType)
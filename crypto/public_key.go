@@ -4,8 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/btcsuite/btcd/btcec"
-	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	hex "github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/ed25519"
 )
@@ -15,13 +16,16 @@ type PublicKeyJSON struct {
 	PublicKey string
 }
 
+// Length of an uncompressed secp256k1 public key, which is also that of our compact secp256k1 signatures
+const secp256k1PublicKeyLength = 65
+
 // Returns the length in bytes of the public key
 func PublicKeyLength(curveType CurveType) int {
 	switch curveType {
 	case CurveTypeEd25519:
 		return ed25519.PublicKeySize
 	case CurveTypeSecp256k1:
-		return btcec.PubKeyBytesLenUncompressed
+		return secp256k1PublicKeyLength
 	default:
 		// Other functions rely on this
 		return 0
@@ -84,7 +88,7 @@ func (p *PublicKey) Verify(msg []byte, signature *Signature) error {
 		return fmt.Errorf("signature '%X' is not a valid ed25519 signature for message: %s",
 			signature.Signature, string(msg))
 	case CurveTypeSecp256k1:
-		_, _, err := btcec.RecoverCompact(btcec.S256(), signature.Signature, Keccak256(msg))
+		_, _, err := btcecdsa.RecoverCompact(signature.Signature, Keccak256(msg))
 		if err != nil {
 			return fmt.Errorf("signature verification for secp256k1 key failed: %v", err)
 		}
@@ -100,7 +104,7 @@ func (p *PublicKey) GetAddress() Address {
 		addr, _ := AddressFromBytes(tmhash.Sum(p.PublicKey))
 		return addr
 	case CurveTypeSecp256k1:
-		pub, err := btcec.ParsePubKey(p.PublicKey.Bytes(), btcec.S256())
+		pub, err := btcec.ParsePubKey(p.PublicKey.Bytes())
 		if err != nil {
 			return Address{}
 		}
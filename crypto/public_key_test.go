@@ -4,7 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,7 +38,7 @@ func TestGetAddress(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "F97798DF751DEB4B6E39D4CF998EE7CD4DCB9ACC", privKey.GetPublicKey().GetAddress().String())
 
-	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), privBytes)
+	_, pub := btcec.PrivKeyFromBytes(privBytes)
 	pubKey, err := PublicKeyFromBytes(pub.SerializeUncompressed(), CurveTypeSecp256k1)
 	require.NoError(t, err)
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/btcsuite/btcd/btcec"
 	hex "github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/ed25519"
 )
@@ -59,25 +58,27 @@ func (s *EIP155Signature) ToCompactSignature() ([]byte, error) {
 	return bs, nil
 }
 
-/**
-  btcec layout is:
-  input:  [  v   |  r   |  s   ]
-  bytes:  [  1   |  32  |  32  ]
-  Where:
-    v = 27 + recovery id (which of 4 possible x coords do we take as public key) (single byte but padded)
-    r = encrypted random point
-    s = signature proof
-
-  Signature layout required by btcec:
-  sig:    [  r   |  s   |  v  ]
-  bytes:  [  32  |  32  |  1  ]
+/*
+*
+
+	btcec layout is:
+	input:  [  v   |  r   |  s   ]
+	bytes:  [  1   |  32  |  32  ]
+	Where:
+	  v = 27 + recovery id (which of 4 possible x coords do we take as public key) (single byte but padded)
+	  r = encrypted random point
+	  s = signature proof
+
+	Signature layout required by btcec:
+	sig:    [  r   |  s   |  v  ]
+	bytes:  [  32  |  32  |  1  ]
 */
 type CompactSecp256k1Signature struct {
 	Secp256k1Signature
 }
 
 func (s *CompactSecp256k1Signature) Marshal() ([]byte, error) {
-	bs := make([]byte, btcec.PubKeyBytesLenUncompressed)
+	bs := make([]byte, secp256k1PublicKeyLength)
 	bs[0] = byte(new(big.Int).Mod(&s.V, big256).Uint64())
 	copy(bs[1:33], s.R.Bytes())
 	copy(bs[33:], s.S.Bytes())
@@ -85,9 +86,9 @@ func (s *CompactSecp256k1Signature) Marshal() ([]byte, error) {
 }
 
 func (s *CompactSecp256k1Signature) Unmarshal(bs []byte) error {
-	if len(bs) != btcec.PubKeyBytesLenUncompressed {
+	if len(bs) != secp256k1PublicKeyLength {
 		return fmt.Errorf("must get uncompressed compact layout signature of %v bytes but got %v bytes",
-			btcec.PubKeyBytesLenUncompressed, len(bs))
+			secp256k1PublicKeyLength, len(bs))
 	}
 	s.V.SetBytes(bs[0:1])
 	s.R.SetBytes(bs[1:33])
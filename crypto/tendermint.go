@@ -3,10 +3,10 @@ package crypto
 import (
 	"fmt"
 
-	"github.com/btcsuite/btcd/btcec"
-	tmCrypto "github.com/tendermint/tendermint/crypto"
-	tmEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
-	tmSecp256k1 "github.com/tendermint/tendermint/crypto/secp256k1"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	tmCrypto "github.com/cometbft/cometbft/crypto"
+	tmEd25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	tmSecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
 )
 
 func PublicKeyFromTendermintPubKey(pubKey tmCrypto.PubKey) (*PublicKey, error) {
@@ -50,17 +50,14 @@ func (p PublicKey) TendermintAddress() tmCrypto.Address {
 func (sig Signature) TendermintSignature() []byte {
 	switch sig.CurveType {
 	case CurveTypeSecp256k1:
-		sig, err := btcec.ParseDERSignature(sig.GetSignature(), btcec.S256())
+		sig, err := btcecdsa.ParseDERSignature(sig.GetSignature())
 		if err != nil {
 			return nil
 		}
 		// https://github.com/tendermint/tendermint/blob/master/crypto/secp256k1/secp256k1_nocgo.go#L62
-		r := sig.R.Bytes()
-		s := sig.S.Bytes()
-		data := make([]byte, 64)
-		copy(data[32-len(r):32], r)
-		copy(data[64-len(s):64], s)
-		return data
+		r, s := sig.R(), sig.S()
+		rBytes, sBytes := r.Bytes(), s.Bytes()
+		return append(rBytes[:], sBytes[:]...)
 	}
 	return sig.Signature
 }
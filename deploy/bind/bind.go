@@ -0,0 +1,304 @@
+// Package bind provides the client that the Go bindings generated for contracts by burrow compile --bindings=go call
+// them through, along with the generator itself
+package bind
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs/payload"
+	hex "github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc"
+)
+
+// Backend is the part of Burrow's GRPC API that bindings need, which the clients of a connection to a node provide
+type Backend interface {
+	CallTxSync(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	CallTxSim(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	Events(ctx context.Context, in *rpcevents.BlocksRequest, opts ...grpc.CallOption) (rpcevents.ExecutionEvents_EventsClient, error)
+}
+
+type backend struct {
+	rpctransact.TransactClient
+	rpcevents.ExecutionEventsClient
+}
+
+// NewBackend returns the Backend for a connection to a node
+func NewBackend(conn grpc.ClientConnInterface) Backend {
+	return backend{
+		TransactClient:        rpctransact.NewTransactClient(conn),
+		ExecutionEventsClient: rpcevents.NewExecutionEventsClient(conn),
+	}
+}
+
+// CallOpts are the options of calls simulated without a transaction
+type CallOpts struct {
+	// The account the call is made from, which need not exist
+	From crypto.Address
+}
+
+// TransactOpts are the options of calls made by transaction. The node signs transactions with the key for Input, so
+// must hold it (see mempool signing).
+type TransactOpts struct {
+	Input    crypto.Address
+	Amount   uint64
+	GasLimit uint64
+	Fee      uint64
+}
+
+// Contract is a contract deployed at Address with the ABI Spec
+type Contract struct {
+	Address crypto.Address
+	Spec    *abi.Spec
+	Backend Backend
+}
+
+// NewContract returns the Contract at address with the ABI abiJSON
+func NewContract(abiJSON string, address crypto.Address, backend Backend) (*Contract, error) {
+	spec, err := abi.ReadSpec([]byte(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("could not read ABI: %w", err)
+	}
+	return &Contract{Address: address, Spec: spec, Backend: backend}, nil
+}
+
+// Deploy creates a contract with bytecode (hex), passing args to its constructor
+func Deploy(ctx context.Context, opts *TransactOpts, abiJSON, bytecode string, backend Backend,
+	args ...interface{}) (*Contract, *exec.TxExecution, error) {
+	contract, err := NewContract(abiJSON, crypto.Address{}, backend)
+	if err != nil {
+		return nil, nil, err
+	}
+	code, err := hex.DecodeString(bytecode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not decode bytecode, which may have libraries to link: %w", err)
+	}
+	data, _, err := contract.Spec.Pack("", args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	txe, err := backend.CallTxSync(ctx, opts.callTx(nil, append(code, data...)))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := txError(txe); err != nil {
+		return nil, txe, err
+	}
+	if txe.Receipt == nil || !txe.Receipt.CreatesContract {
+		return nil, txe, fmt.Errorf("transaction did not create a contract")
+	}
+	contract.Address = txe.Receipt.ContractAddress
+	return contract, txe, nil
+}
+
+// Call simulates calling fname with args without a transaction, unpacking its return values into rets
+func (c *Contract) Call(ctx context.Context, opts *CallOpts, fname string, args []interface{},
+	rets ...interface{}) error {
+	data, _, err := c.Spec.Pack(fname, args...)
+	if err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = new(CallOpts)
+	}
+	txe, err := c.Backend.CallTxSim(ctx, &payload.CallTx{
+		Input:   &payload.TxInput{Address: opts.From},
+		Address: &c.Address,
+		Data:    data,
+	})
+	if err != nil {
+		return err
+	}
+	if err := txError(txe); err != nil {
+		return err
+	}
+	return c.Unpack(txe, fname, rets...)
+}
+
+// Transact calls fname with args by transaction, returning once it has been executed in a block
+func (c *Contract) Transact(ctx context.Context, opts *TransactOpts, fname string,
+	args ...interface{}) (*exec.TxExecution, error) {
+	data, _, err := c.Spec.Pack(fname, args...)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := c.Backend.CallTxSync(ctx, opts.callTx(&c.Address, data))
+	if err != nil {
+		return nil, err
+	}
+	return txe, txError(txe)
+}
+
+// Unpack unpacks the values fname returned by the call of txe into rets
+func (c *Contract) Unpack(txe *exec.TxExecution, fname string, rets ...interface{}) error {
+	funcSpec, ok := c.Spec.Functions[fname]
+	if !ok {
+		return fmt.Errorf("unknown function %s", fname)
+	}
+	if txe.Result == nil {
+		return fmt.Errorf("call of %s has no result", fname)
+	}
+	return unpack(funcSpec.Outputs, rets, func(args []interface{}) error {
+		return abi.Unpack(funcSpec.Outputs, txe.Result.Return, args...)
+	})
+}
+
+// FilterLogs passes each event logged by the contract in the blocks between start and end to consumer with its fields
+// unpacked into those of a new value returned by newEvent, which must be a pointer to a struct with a field for each
+// input of the event in order. The stream of blocks continues as they are committed if end is a stream bound.
+func (c *Contract) FilterLogs(ctx context.Context, eventName string, start, end *rpcevents.Bound,
+	newEvent func() interface{}, consumer func(ev interface{}, log *exec.Event) error) error {
+	eventSpec, ok := c.Spec.EventsByName[eventName]
+	if !ok {
+		return fmt.Errorf("unknown event %s", eventName)
+	}
+	qb := query.NewBuilder().
+		AndEquals(event.EventTypeKey, exec.TypeLog.String()).
+		AndEquals(event.AddressKey, c.Address)
+	if !eventSpec.Anonymous {
+		qb = qb.AndEquals(exec.LogNKey(0), binary.Word256(eventSpec.ID))
+	}
+	stream, err := c.Backend.Events(ctx, &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(start, end),
+		Query:      qb.String(),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, ev := range resp.Events {
+			if ev.Log == nil {
+				continue
+			}
+			value := newEvent()
+			err = UnpackLog(eventSpec, ev.Log, value)
+			if err != nil {
+				return err
+			}
+			err = consumer(value, ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// UnpackLog unpacks the fields of a logged event into the fields of the struct ev points to
+func UnpackLog(eventSpec *abi.EventSpec, log *exec.LogEvent, ev interface{}) error {
+	rv := reflect.ValueOf(ev).Elem()
+	if rv.NumField() < len(eventSpec.Inputs) {
+		return fmt.Errorf("%d fields expected for event %s, %d received", len(eventSpec.Inputs), eventSpec.Name,
+			rv.NumField())
+	}
+	fields := make([]interface{}, len(eventSpec.Inputs))
+	for i := range fields {
+		field := rv.Field(i)
+		if field.Kind() == reflect.Ptr {
+			// Big integers
+			field.Set(reflect.New(field.Type().Elem()))
+			fields[i] = field.Interface()
+		} else {
+			fields[i] = field.Addr().Interface()
+		}
+	}
+	return unpack(eventSpec.Inputs, fields, func(args []interface{}) error {
+		return abi.UnpackEvent(eventSpec, log.Topics, log.Data, args...)
+	})
+}
+
+// Unpacks into values of the Go types of args, converting from the []interface{} the abi package unpacks arrays into
+func unpack(args []abi.Argument, values []interface{}, unpackArgs func([]interface{}) error) error {
+	if len(values) != len(args) {
+		return fmt.Errorf("%d values expected, %d received", len(args), len(values))
+	}
+	unpacked := make([]interface{}, len(values))
+	for i, arg := range args {
+		if !arg.IsArray || arg.Indexed {
+			unpacked[i] = values[i]
+			continue
+		}
+		elemType := reflect.TypeOf(values[i]).Elem().Elem()
+		elems := make([]interface{}, arg.ArrayLength)
+		for j := range elems {
+			elems[j] = newElem(elemType)
+		}
+		unpacked[i] = &elems
+	}
+	err := unpackArgs(unpacked)
+	if err != nil {
+		return err
+	}
+	for i, arg := range args {
+		if !arg.IsArray || arg.Indexed {
+			continue
+		}
+		elems := *unpacked[i].(*[]interface{})
+		rv := reflect.ValueOf(values[i]).Elem()
+		if rv.Kind() == reflect.Slice {
+			rv.Set(reflect.MakeSlice(rv.Type(), len(elems), len(elems)))
+		} else if rv.Len() != len(elems) {
+			return fmt.Errorf("%d elements expected, %d received", rv.Len(), len(elems))
+		}
+		for j, elem := range elems {
+			ev := reflect.ValueOf(elem)
+			if !ev.Type().AssignableTo(rv.Type().Elem()) {
+				ev = ev.Elem()
+			}
+			if ev.Type().ConvertibleTo(rv.Type().Elem()) {
+				rv.Index(j).Set(ev.Convert(rv.Type().Elem()))
+			} else {
+				reflect.Copy(rv.Index(j), ev)
+			}
+		}
+	}
+	return nil
+}
+
+// Returns a pointer to unpack an element of type elemType into, which is itself a pointer for big integers
+func newElem(elemType reflect.Type) interface{} {
+	if elemType.Kind() == reflect.Ptr {
+		return reflect.New(elemType.Elem()).Interface()
+	}
+	return reflect.New(elemType).Interface()
+}
+
+func (opts *TransactOpts) callTx(address *crypto.Address, data []byte) *payload.CallTx {
+	return &payload.CallTx{
+		Input:    &payload.TxInput{Address: opts.Input, Amount: opts.Amount},
+		Address:  address,
+		GasLimit: opts.GasLimit,
+		Fee:      opts.Fee,
+		Data:     data,
+	}
+}
+
+// Returns the exception of txe as an error with the reason the contract reverted if it gave one
+func txError(txe *exec.TxExecution) error {
+	if txe.Exception == nil {
+		return nil
+	}
+	if txe.Result != nil {
+		reason, err := abi.UnpackRevert(txe.Result.Return)
+		if err == nil && reason != nil {
+			return fmt.Errorf("%w: %s", txe.Exception, *reason)
+		}
+	}
+	return txe.Exception
+}
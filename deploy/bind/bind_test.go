@@ -0,0 +1,185 @@
+package bind_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/bind"
+	"github.com/hyperledger/burrow/deploy/bind/internal/example"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestGenerate(t *testing.T) {
+	// The example bindings must be regenerated when the generator changes
+	expected, err := ioutil.ReadFile("internal/example/storage.go")
+	require.NoError(t, err)
+	code, err := bind.Generate("example", bind.Source{Name: "Storage", Abi: []byte(example.StorageABI), Bytecode: "6001"})
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(code))
+
+	// Functions and events whose types are not supported are skipped
+	code, err = bind.Generate("example", bind.Source{Name: "Fixed",
+		Abi: []byte(`[{"type":"function","name":"rate","inputs":[],"outputs":[{"name":"","type":"fixed128x18"}],
+			"stateMutability":"view"},{"type":"function","name":"call","inputs":[],"outputs":[],"stateMutability":"pure"}]`)})
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "// Skipped function rate")
+	assert.Contains(t, string(code), "func (c *Fixed) CallMethod(")
+	assert.NotContains(t, string(code), "func DeployFixed")
+}
+
+func TestBindings(t *testing.T) {
+	ctx := context.Background()
+	spec, err := abi.ReadSpec([]byte(example.StorageABI))
+	require.NoError(t, err)
+	backend := &fakeBackend{spec: spec, value: big.NewInt(0)}
+	input := crypto.Address{1}
+
+	storage, txe, err := example.DeployStorage(ctx, &bind.TransactOpts{Input: input}, backend, big.NewInt(42))
+	require.NoError(t, err)
+	assert.Equal(t, backend.address, storage.Address)
+	assert.Equal(t, backend.address, txe.Receipt.ContractAddress)
+	assert.Equal(t, int64(42), backend.value.Int64())
+
+	value, err := storage.Get(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), value.Int64())
+
+	_, err = storage.Set(ctx, &bind.TransactOpts{Input: input}, big.NewInt(7))
+	require.NoError(t, err)
+	value, err = storage.Get(ctx, &bind.CallOpts{From: input})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), value.Int64())
+
+	label, owners, hash, err := storage.Describe(ctx, nil, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "three", label)
+	assert.Equal(t, []crypto.Address{{3}, {4}}, owners)
+	assert.Equal(t, [32]byte{3}, hash)
+
+	backend.revert = "not allowed"
+	_, err = storage.Set(ctx, &bind.TransactOpts{Input: input}, big.NewInt(8))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not allowed")
+	backend.revert = ""
+
+	var changes []*example.StorageChanged
+	err = storage.FilterChanged(ctx, rpcevents.AbsoluteBound(1), rpcevents.AbsoluteBound(2),
+		func(changed *example.StorageChanged) error {
+			changes = append(changes, changed)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Contains(t, backend.query, "Log0 = '")
+	require.Len(t, changes, 2)
+	assert.Equal(t, input, changes[1].By)
+	assert.Equal(t, int64(7), changes[1].Value.Int64())
+	assert.Equal(t, [32]byte(binary.LeftPadWord256(crypto.Keccak256([]byte("set")))), changes[1].Label)
+	assert.Equal(t, uint64(2), changes[1].Event.Header.Height)
+}
+
+// Executes the Storage contract's ABI rather than its code
+type fakeBackend struct {
+	spec    *abi.Spec
+	address crypto.Address
+	value   *big.Int
+	revert  string
+	logs    []*exec.Event
+	query   string
+}
+
+func (be *fakeBackend) CallTxSync(ctx context.Context, tx *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	txe := &exec.TxExecution{Receipt: &txs.Receipt{}}
+	if be.revert != "" {
+		txe.Exception = errors.Errorf(errors.Codes.ExecutionReverted, "reverted")
+		reason, _, err := abi.EncodeFunctionCall(`[{"type":"function","name":"Error","inputs":[{"name":"","type":"string"}]}]`,
+			"Error", nil, be.revert)
+		if err != nil {
+			return nil, err
+		}
+		txe.Result = &exec.Result{Return: reason}
+		return txe, nil
+	}
+	var label string
+	if tx.Address == nil {
+		be.address = crypto.Address{9}
+		txe.Receipt.CreatesContract = true
+		txe.Receipt.ContractAddress = be.address
+		label = "deploy"
+		// The constructor arguments follow the code
+		err := abi.Unpack(be.spec.Constructor.Inputs, tx.Data[2:], be.value)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		label = "set"
+		err := abi.Unpack(be.spec.Functions["set"].Inputs, tx.Data[abi.FunctionIDSize:], be.value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	eventSpec := be.spec.EventsByName["Changed"]
+	topics, data, err := abi.PackEvent(eventSpec, tx.Input.Address, new(big.Int).Set(be.value), label)
+	if err != nil {
+		return nil, err
+	}
+	// Solidity logs the hash of indexed strings
+	topics[2] = binary.LeftPadWord256(crypto.Keccak256([]byte(label)))
+	be.logs = append(be.logs, &exec.Event{
+		Header: &exec.Header{Height: uint64(len(be.logs) + 1)},
+		Log:    &exec.LogEvent{Address: be.address, Data: data, Topics: topics},
+	})
+	return txe, nil
+}
+
+func (be *fakeBackend) CallTxSim(ctx context.Context, tx *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	var ret []byte
+	var err error
+	switch {
+	case tx.Data[0] == be.spec.Functions["get"].FunctionID[0]:
+		ret, err = abi.Pack(be.spec.Functions["get"].Outputs, be.value)
+	default:
+		var id uint64
+		err = abi.Unpack(be.spec.Functions["describe"].Inputs, tx.Data[abi.FunctionIDSize:], &id)
+		if err == nil {
+			ret, err = abi.Pack(be.spec.Functions["describe"].Outputs, "three",
+				[]crypto.Address{{byte(id)}, {byte(id + 1)}}, [32]byte{byte(id)})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &exec.TxExecution{Result: &exec.Result{Return: ret}}, nil
+}
+
+func (be *fakeBackend) Events(ctx context.Context, in *rpcevents.BlocksRequest,
+	opts ...grpc.CallOption) (rpcevents.ExecutionEvents_EventsClient, error) {
+	be.query = in.Query
+	return &fakeEventsStream{events: be.logs}, nil
+}
+
+type fakeEventsStream struct {
+	grpc.ClientStream
+	events []*exec.Event
+}
+
+func (stream *fakeEventsStream) Recv() (*rpcevents.EventsResponse, error) {
+	if len(stream.events) == 0 {
+		return nil, io.EOF
+	}
+	ev := stream.events[0]
+	stream.events = stream.events[1:]
+	return &rpcevents.EventsResponse{Height: ev.Header.Height, Events: []*exec.Event{ev}}, nil
+}
@@ -0,0 +1,384 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strings"
+	"text/template"
+
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/iancoleman/strcase"
+)
+
+// Source is a compiled contract to generate bindings for
+type Source struct {
+	Name string
+	Abi  []byte
+	// The hex bytecode to deploy the contract with, without which no Deploy function is generated
+	Bytecode string
+}
+
+const bindingsTemplateText = `// Code generated by burrow compile --bindings=go. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/bind"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+)
+
+// Referenced in case the contracts do not use them
+var (
+	_ = big.NewInt
+	_ = crypto.Address{}
+	_ = rpcevents.LatestBound
+)
+{{range .Contracts}}{{$contract := .}}
+// {{.Name}}ABI is the ABI of {{.Name}}
+const {{.Name}}ABI = {{quote .Abi}}
+{{if .Bytecode}}
+// {{.Name}}Bin is the bytecode to deploy {{.Name}} with
+const {{.Name}}Bin = "{{.Bytecode}}"
+{{end}}
+// {{.Name}} calls the {{.Name}} contract
+type {{.Name}} struct {
+	*bind.Contract
+}
+
+// New{{.Name}} returns the {{.Name}} contract at address
+func New{{.Name}}(address crypto.Address, backend bind.Backend) (*{{.Name}}, error) {
+	contract, err := bind.NewContract({{.Name}}ABI, address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.Name}}{contract}, nil
+}
+{{if .Bytecode}}
+// Deploy{{.Name}} creates a {{.Name}} contract
+func Deploy{{.Name}}(ctx context.Context, opts *bind.TransactOpts, backend bind.Backend{{range .Constructor}}, {{.Name}} {{.Type}}{{end}}) (*{{.Name}}, *exec.TxExecution, error) {
+	contract, txe, err := bind.Deploy(ctx, opts, {{.Name}}ABI, {{.Name}}Bin, backend{{range .Constructor}}, {{.Name}}{{end}})
+	if err != nil {
+		return nil, txe, err
+	}
+	return &{{.Name}}{contract}, txe, nil
+}
+{{end}}{{range .Functions}}{{if .Constant}}
+// {{.GoName}} calls {{.Signature}} without a transaction
+func (c *{{$contract.Name}}) {{.GoName}}(ctx context.Context, opts *bind.CallOpts{{range .Inputs}}, {{.Name}} {{.Type}}{{end}}) ({{range .Outputs}}{{.Type}}, {{end}}error) {
+{{- range .Outputs}}
+	{{if .Pointer}}{{.Name}} := new({{.Elem}}){{else}}var {{.Name}} {{.Type}}{{end}}
+{{- end}}
+	err := c.Call(ctx, opts, "{{.Name}}", []interface{}{ {{- range $i, $in := .Inputs}}{{if $i}}, {{end}}{{.Name}}{{end -}} }
+		{{- range .Outputs}}, {{if not .Pointer}}&{{end}}{{.Name}}{{end}})
+	return {{range .Outputs}}{{.Name}}, {{end}}err
+}
+{{else}}
+// {{.GoName}} calls {{.Signature}} by transaction
+func (c *{{$contract.Name}}) {{.GoName}}(ctx context.Context, opts *bind.TransactOpts{{range .Inputs}}, {{.Name}} {{.Type}}{{end}}) (*exec.TxExecution, error) {
+	return c.Transact(ctx, opts, "{{.Name}}"{{range .Inputs}}, {{.Name}}{{end}})
+}
+{{end}}{{end}}{{range .Events}}
+// {{$contract.Name}}{{.GoName}} is the {{.Name}} event logged by {{$contract.Name}}
+type {{$contract.Name}}{{.GoName}} struct {
+{{- range .Inputs}}
+	{{.Name}} {{.Type}}
+{{- end}}
+	// The event as logged
+	Event *exec.Event
+}
+
+// Filter{{.GoName}} passes the {{.Name}} events logged in the blocks between start and end to consumer, continuing as
+// blocks are committed if end is a stream bound
+func (c *{{$contract.Name}}) Filter{{.GoName}}(ctx context.Context, start, end *rpcevents.Bound,
+	consumer func(*{{$contract.Name}}{{.GoName}}) error) error {
+	return c.FilterLogs(ctx, "{{.Name}}", start, end, func() interface{} { return new({{$contract.Name}}{{.GoName}}) },
+		func(ev interface{}, event *exec.Event) error {
+			value := ev.(*{{$contract.Name}}{{.GoName}})
+			value.Event = event
+			return consumer(value)
+		})
+}
+
+// Watch{{.GoName}} passes the {{.Name}} events logged from the latest block on to consumer as blocks are committed
+func (c *{{$contract.Name}}) Watch{{.GoName}}(ctx context.Context, consumer func(*{{$contract.Name}}{{.GoName}}) error) error {
+	return c.Filter{{.GoName}}(ctx, rpcevents.LatestBound(), rpcevents.StreamBound(), consumer)
+}
+{{end}}{{range .Skipped}}
+// {{.}}
+{{end}}{{end}}`
+
+var bindingsTemplate = template.Must(template.New("GoBindings").
+	Funcs(template.FuncMap{"quote": quote}).
+	Parse(bindingsTemplateText))
+
+// The names of the methods and fields of Contract, which generated methods must not shadow
+var contractMembers = map[string]bool{
+	"Address": true, "Spec": true, "Backend": true, "Call": true, "Transact": true, "Unpack": true, "FilterLogs": true,
+}
+
+type bindings struct {
+	Package   string
+	Contracts []*contractBinding
+}
+
+type contractBinding struct {
+	Name        string
+	Abi         string
+	Bytecode    string
+	Constructor []*param
+	Functions   []*functionBinding
+	Events      []*eventBinding
+	Skipped     []string
+}
+
+type functionBinding struct {
+	Name      string
+	GoName    string
+	Signature string
+	Constant  bool
+	Inputs    []*param
+	Outputs   []*param
+}
+
+type eventBinding struct {
+	Name   string
+	GoName string
+	Inputs []*param
+}
+
+type param struct {
+	Name string
+	Type string
+	// Whether the Go type is a pointer to Elem
+	Pointer bool
+	Elem    string
+}
+
+// The parts of the ABI that abi.Spec does not keep
+type abiEntry struct {
+	Type            string
+	Name            string
+	StateMutability string
+	Constant        bool
+	Inputs          []struct{ Name string }
+	Outputs         []struct{ Name string }
+}
+
+// Generate returns the source of a Go file in package pkg with bindings for each of contracts
+func Generate(pkg string, contracts ...Source) ([]byte, error) {
+	bs := bindings{Package: pkg}
+	for _, source := range contracts {
+		contract, err := bindContract(source)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate bindings for %s: %w", source.Name, err)
+		}
+		bs.Contracts = append(bs.Contracts, contract)
+	}
+	buf := new(bytes.Buffer)
+	err := bindingsTemplate.Execute(buf, bs)
+	if err != nil {
+		return nil, err
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated invalid Go: %w", err)
+	}
+	return code, nil
+}
+
+func bindContract(source Source) (*contractBinding, error) {
+	spec, err := abi.ReadSpec(source.Abi)
+	if err != nil {
+		return nil, err
+	}
+	var entries []abiEntry
+	err = json.Unmarshal(source.Abi, &entries)
+	if err != nil {
+		return nil, err
+	}
+	contract := &contractBinding{
+		Name:     strcase.ToCamel(source.Name),
+		Abi:      string(source.Abi),
+		Bytecode: source.Bytecode,
+	}
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		switch entry.Type {
+		case "constructor":
+			contract.Constructor, err = params(spec.Constructor.Inputs, entry.Inputs, false)
+			if err != nil {
+				return nil, fmt.Errorf("constructor: %w", err)
+			}
+		case "function":
+			// Only the last of overloaded functions is in the spec
+			funcSpec := spec.Functions[entry.Name]
+			if seen["function "+entry.Name] || funcSpec == nil {
+				continue
+			}
+			seen["function "+entry.Name] = true
+			fn, err := bindFunction(entry, funcSpec)
+			if err != nil {
+				contract.Skipped = append(contract.Skipped, fmt.Sprintf("Skipped function %s: %v", entry.Name, err))
+				continue
+			}
+			contract.Functions = append(contract.Functions, fn)
+		case "event":
+			eventSpec := spec.EventsByName[entry.Name]
+			if seen["event "+entry.Name] || eventSpec == nil {
+				continue
+			}
+			seen["event "+entry.Name] = true
+			ev, err := bindEvent(entry, eventSpec)
+			if err != nil {
+				contract.Skipped = append(contract.Skipped, fmt.Sprintf("Skipped event %s: %v", entry.Name, err))
+				continue
+			}
+			contract.Events = append(contract.Events, ev)
+		}
+	}
+	return contract, nil
+}
+
+func bindFunction(entry abiEntry, funcSpec *abi.FunctionSpec) (*functionBinding, error) {
+	fn := &functionBinding{
+		Name:      entry.Name,
+		GoName:    goName(entry.Name, contractMembers),
+		Signature: abi.Signature(entry.Name, funcSpec.Inputs),
+		Constant: entry.Constant || entry.StateMutability == "view" ||
+			entry.StateMutability == "pure",
+	}
+	var err error
+	fn.Inputs, err = params(funcSpec.Inputs, entry.Inputs, false)
+	if err != nil {
+		return nil, err
+	}
+	if fn.Constant {
+		fn.Outputs, err = params(funcSpec.Outputs, entry.Outputs, false)
+		if err != nil {
+			return nil, err
+		}
+		// Distinct from the inputs
+		for i, out := range fn.Outputs {
+			out.Name = fmt.Sprintf("ret%d", i)
+		}
+	}
+	return fn, nil
+}
+
+func bindEvent(entry abiEntry, eventSpec *abi.EventSpec) (*eventBinding, error) {
+	ev := &eventBinding{
+		Name:   entry.Name,
+		GoName: goName(entry.Name, nil),
+	}
+	var err error
+	ev.Inputs, err = params(eventSpec.Inputs, entry.Inputs, true)
+	if err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// Returns the Go parameters of args, named after the ABI's names or the struct fields of an event
+func params(args []abi.Argument, names []struct{ Name string }, fields bool) ([]*param, error) {
+	ps := make([]*param, len(args))
+	used := map[string]bool{"Event": fields}
+	for i, arg := range args {
+		elem, pointer, err := goType(arg.EVM)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", argName(names, i), err)
+		}
+		p := &param{Type: elem, Pointer: pointer, Elem: strings.TrimPrefix(elem, "*")}
+		if arg.IsArray {
+			if arg.Indexed {
+				return nil, fmt.Errorf("%s: indexed arrays are not supported", argName(names, i))
+			}
+			p.Pointer = false
+			if arg.ArrayLength > 0 {
+				p.Type = fmt.Sprintf("[%d]%s", arg.ArrayLength, elem)
+			} else {
+				p.Type = "[]" + elem
+			}
+		}
+		name := strings.TrimLeft(argName(names, i), "_")
+		if fields {
+			name = strcase.ToCamel(name)
+		} else {
+			name = strcase.ToLowerCamel(name)
+		}
+		if name == "" || used[name] || token.Lookup(name).IsKeyword() || reserved[name] {
+			name = fmt.Sprintf("arg%d", i)
+			if fields {
+				name = fmt.Sprintf("Arg%d", i)
+			}
+		}
+		used[name] = true
+		p.Name = name
+		ps[i] = p
+	}
+	return ps, nil
+}
+
+// Identifiers the generated code uses alongside parameters
+var reserved = map[string]bool{
+	"ctx": true, "opts": true, "backend": true, "c": true, "err": true, "contract": true, "txe": true, "bind": true,
+	"big": true, "crypto": true, "exec": true, "rpcevents": true, "context": true,
+}
+
+// Quotes str as a raw string literal if it can be
+func quote(str string) string {
+	if strings.ContainsAny(str, "`\r") {
+		return fmt.Sprintf("%q", str)
+	}
+	return "`" + str + "`"
+}
+
+func argName(names []struct{ Name string }, i int) string {
+	if i < len(names) {
+		return names[i].Name
+	}
+	return ""
+}
+
+func goName(name string, taken map[string]bool) string {
+	goName := strcase.ToCamel(name)
+	if taken[goName] {
+		return goName + "Method"
+	}
+	return goName
+}
+
+// Returns the Go type values of evmType are packed from and unpacked into, and whether it is a pointer
+func goType(evmType abi.EVMType) (string, bool, error) {
+	switch t := evmType.(type) {
+	case abi.EVMBool:
+		return "bool", false, nil
+	case abi.EVMUint:
+		if t.M == 8 || t.M == 16 || t.M == 32 || t.M == 64 {
+			return fmt.Sprintf("uint%d", t.M), false, nil
+		}
+		return "*big.Int", true, nil
+	case abi.EVMInt:
+		if t.M == 8 || t.M == 16 || t.M == 32 || t.M == 64 {
+			return fmt.Sprintf("int%d", t.M), false, nil
+		}
+		return "*big.Int", true, nil
+	case abi.EVMAddress:
+		return "crypto.Address", false, nil
+	case abi.EVMBytes:
+		if t.M == 0 {
+			return "[]byte", false, nil
+		}
+		return fmt.Sprintf("[%d]byte", t.M), false, nil
+	case abi.EVMString:
+		return "string", false, nil
+	}
+	return "", false, fmt.Errorf("%s is not supported", evmType.GetSignature())
+}
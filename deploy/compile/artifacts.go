@@ -0,0 +1,139 @@
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The fields of the contract artifacts written by Hardhat, Truffle, and Foundry that we need to deploy a contract. Hardhat
+// and Truffle give bytecode as a string and Foundry as an object with its link references. Hardhat gives link references
+// alongside the bytecode and Truffle only as placeholders in the bytecode.
+type artifact struct {
+	Format                 string `json:"_format"`
+	ContractName           string
+	SourceName             string
+	SourcePath             string
+	Abi                    json.RawMessage
+	Bytecode               json.RawMessage
+	DeployedBytecode       json.RawMessage
+	LinkReferences         json.RawMessage
+	DeployedLinkReferences json.RawMessage
+	Compiler               struct {
+		Version string
+	}
+	Metadata json.RawMessage
+	// Foundry gives the storage layout when asked for with extra_output
+	StorageLayout *StorageLayout
+}
+
+// Truffle placeholders are the library name padded with underscores to the length of an address
+var trufflePlaceholderRegexp = regexp.MustCompile(fmt.Sprintf("__[A-Za-z0-9_$]{%d}", crypto.AddressHexLength-2))
+
+// LoadArtifact reads a contract from the JSON artifact file written by Hardhat, Truffle, or Foundry, or by burrow
+// deploy itself. Libraries are linked as for contracts we compile.
+func LoadArtifact(file string) (*SolidityContract, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	art := new(artifact)
+	err = json.Unmarshal(bs, art)
+	if err != nil {
+		return nil, fmt.Errorf("could not read artifact %s: %w", file, err)
+	}
+	if art.Abi == nil || art.Bytecode == nil {
+		// Our own format
+		return LoadSolidityContract(file)
+	}
+	contract := &SolidityContract{Abi: art.Abi, StorageLayout: art.StorageLayout}
+	contract.Evm.Bytecode, err = artifactCode(art.Bytecode, art.LinkReferences)
+	if err != nil {
+		return nil, fmt.Errorf("could not read bytecode from artifact %s: %w", file, err)
+	}
+	contract.Evm.DeployedBytecode, err = artifactCode(art.DeployedBytecode, art.DeployedLinkReferences)
+	if err != nil {
+		return nil, fmt.Errorf("could not read deployed bytecode from artifact %s: %w", file, err)
+	}
+	if contract.Evm.Bytecode.Object == "" {
+		return nil, fmt.Errorf("artifact %s has no bytecode", file)
+	}
+	if contract.Evm.DeployedBytecode.Object != "" {
+		contract.MetadataMap = []MetadataMap{{
+			DeployedBytecode: contract.Evm.DeployedBytecode,
+			Metadata: Metadata{
+				ContractName:    firstOf(art.ContractName, strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))),
+				SourceFile:      firstOf(art.SourceName, art.SourcePath),
+				CompilerVersion: firstOf(art.Compiler.Version, artifactCompilerVersion(art.Metadata)),
+				Abi:             art.Abi,
+			},
+		}}
+	}
+	return contract, nil
+}
+
+func artifactCode(bytecode, linkReferences json.RawMessage) (ContractCode, error) {
+	code := ContractCode{LinkReferences: linkReferences}
+	if len(bytecode) == 0 || string(bytecode) == "null" {
+		return code, nil
+	}
+	if bytecode[0] == '{' {
+		err := json.Unmarshal(bytecode, &code)
+		if err != nil {
+			return code, err
+		}
+	} else {
+		err := json.Unmarshal(bytecode, &code.Object)
+		if err != nil {
+			return code, err
+		}
+	}
+	code.Object = strings.TrimPrefix(code.Object, "0x")
+	if strings.Contains(code.Object, "_") && !hasLinkReferences(code.LinkReferences) {
+		code.LinkReferences = truffleLinkReferences(code.Object)
+	}
+	return code, nil
+}
+
+func hasLinkReferences(linkReferences json.RawMessage) bool {
+	var links map[string]json.RawMessage
+	return json.Unmarshal(linkReferences, &links) == nil && len(links) > 0
+}
+
+// Truffle only marks where libraries are linked with placeholders so we find them
+func truffleLinkReferences(bytecode string) json.RawMessage {
+	type reference struct{ Start, Length int }
+	links := make(map[string][]reference)
+	for _, loc := range trufflePlaceholderRegexp.FindAllStringIndex(bytecode, -1) {
+		name := strings.Trim(bytecode[loc[0]:loc[1]], "_")
+		links[name] = append(links[name], reference{Start: loc[0] / 2, Length: crypto.AddressLength})
+	}
+	bs, _ := json.Marshal(map[string]map[string][]reference{"": links})
+	return bs
+}
+
+// Foundry keeps solc's metadata, which it may give as an object or a string
+func artifactCompilerVersion(metadata json.RawMessage) string {
+	var meta SolidityMetadata
+	if json.Unmarshal(metadata, &meta) != nil {
+		var str string
+		if json.Unmarshal(metadata, &str) != nil || json.Unmarshal([]byte(str), &meta) != nil {
+			return ""
+		}
+	}
+	return meta.Compiler.Version
+}
+
+func firstOf(strs ...string) string {
+	for _, str := range strs {
+		if str != "" {
+			return str
+		}
+	}
+	return ""
+}
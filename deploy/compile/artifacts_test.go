@@ -0,0 +1,88 @@
+package compile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	libraryAddress  = "1234567890123456789012345678901234567890"
+	solcPlaceholder = "__$3f1f4d5b6e8a3f0c2d7e9b1a4c6d8e0f2a$__"
+)
+
+func TestLoadArtifact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifacts")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	abi := `[{"type":"function","name":"get","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}]`
+	for name, tc := range map[string]struct {
+		artifact     string
+		bytecode     string
+		compiler     string
+		contractName string
+	}{
+		"Hardhat": {
+			artifact: `{"_format":"hh-sol-artifact-1","contractName":"Storage","sourceName":"contracts/Storage.sol",
+				"abi":` + abi + `,"bytecode":"0x6073` + solcPlaceholder + `00","deployedBytecode":"0x6001",
+				"linkReferences":{"contracts/Lib.sol":{"Lib":[{"start":2,"length":20}]}},"deployedLinkReferences":{}}`,
+			bytecode:     "6073" + libraryAddress + "00",
+			contractName: "Storage",
+		},
+		"Truffle": {
+			artifact: `{"contractName":"Storage","abi":` + abi + `,"bytecode":"0x6073__Lib___________________________________00",
+				"deployedBytecode":"0x6001","sourcePath":"/src/contracts/Storage.sol",
+				"compiler":{"name":"solc","version":"0.5.16+commit.9c3226ce.Emscripten.clang"}}`,
+			bytecode:     "6073" + libraryAddress + "00",
+			compiler:     "0.5.16+commit.9c3226ce.Emscripten.clang",
+			contractName: "Storage",
+		},
+		"Foundry": {
+			artifact: `{"abi":` + abi + `,"bytecode":{"object":"0x6073` + solcPlaceholder + `00",
+				"linkReferences":{"src/Lib.sol":{"Lib":[{"start":2,"length":20}]}}},
+				"deployedBytecode":{"object":"0x6001","linkReferences":{}},
+				"metadata":{"compiler":{"version":"0.8.19+commit.7dd6d404"},"language":"Solidity"}}`,
+			bytecode: "6073" + libraryAddress + "00",
+			compiler: "0.8.19+commit.7dd6d404",
+			// Named after the file
+			contractName: "Foundry",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(dir, name+".json")
+			require.NoError(t, ioutil.WriteFile(file, []byte(tc.artifact), 0644))
+			contract, err := LoadArtifact(file)
+			require.NoError(t, err)
+			assert.JSONEq(t, abi, string(contract.Abi))
+			require.Error(t, contract.Link(nil))
+			require.NoError(t, contract.Link(map[string]string{"Lib": libraryAddress}))
+			assert.Equal(t, tc.bytecode, contract.Code())
+
+			meta, err := contract.GetMetadata(logging.NewNoopLogger())
+			require.NoError(t, err)
+			require.Len(t, meta, 1)
+			for _, m := range meta {
+				metadata := Metadata{}
+				require.NoError(t, json.Unmarshal([]byte(m), &metadata))
+				assert.Equal(t, tc.contractName, metadata.ContractName)
+				assert.Equal(t, tc.compiler, metadata.CompilerVersion)
+			}
+		})
+	}
+
+	t.Run("Burrow", func(t *testing.T) {
+		contract := SolidityContract{Abi: json.RawMessage(abi)}
+		contract.Evm.Bytecode.Object = "6001"
+		require.NoError(t, contract.Save(dir, "Storage.bin.json"))
+		loaded, err := LoadArtifact(filepath.Join(dir, "Storage.bin.json"))
+		require.NoError(t, err)
+		assert.Equal(t, "6001", loaded.Code())
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
@@ -28,7 +29,9 @@ type SolidityInput struct {
 		Libraries map[string]map[string]string `json:"libraries"`
 		Optimizer struct {
 			Enabled bool `json:"enabled"`
+			Runs    int  `json:"runs,omitempty"`
 		} `json:"optimizer"`
+		EVMVersion      string `json:"evmVersion,omitempty"`
 		OutputSelection struct {
 			File struct {
 				OutputType []string `json:"*"`
@@ -46,7 +49,11 @@ type SolidityInputSource struct {
 // SolidityOutput is a structure for the output of the solidity json output form
 type SolidityOutput struct {
 	Contracts map[string]map[string]SolidityContract
-	Errors    []struct {
+	// The ID by which source maps refer to each source file
+	Sources map[string]struct {
+		ID int `json:"id"`
+	}
+	Errors []struct {
 		Component        string
 		FormattedMessage string
 		Message          string
@@ -68,6 +75,8 @@ type SolidityContract struct {
 	Devdoc   json.RawMessage
 	Userdoc  json.RawMessage
 	Metadata string
+	// Given by solc 0.5.13 and later, for checking that an upgrade keeps the storage of the contract it replaces
+	StorageLayout *StorageLayout `json:",omitempty"`
 	// This is not present in the solidity output, but we add it ourselves
 	// This is map from DeployedBytecode to Metadata. A Solidity contract can create any number
 	// of contracts, which have distinct metadata. This is a map for the deployed code to metdata,
@@ -78,6 +87,21 @@ type SolidityContract struct {
 type ContractCode struct {
 	Object         string
 	LinkReferences json.RawMessage
+	// The positions in the deployed code of the values of immutable variables, which are only set on deployment
+	ImmutableReferences json.RawMessage `json:",omitempty"`
+	// The source map from each instruction to the source it was compiled from, given for deployed code
+	SourceMap string `json:",omitempty"`
+}
+
+// CompilerSettings are the settings solc is run with
+type CompilerSettings struct {
+	Optimize bool
+	// The number of runs to optimise for, or zero for solc's default
+	Runs int
+	// The EVM version to compile for, or empty for solc's default
+	EVMVersion string
+	// The addresses of the libraries to link to by name
+	Libraries map[string]string
 }
 
 // SolidityMetadata is the json field metadata
@@ -98,10 +122,15 @@ type SolidityMetadata struct {
 }
 
 type Metadata struct {
-	ContractName    string
-	SourceFile      string
+	ContractName string
+	SourceFile   string
+	// The keccak256 hash of the source file as given in the Solidity metadata
+	SourceHash      string `json:",omitempty"`
 	CompilerVersion string
 	Abi             json.RawMessage
+	// The source map of the deployed code and the files it refers to by ID
+	SourceMap string   `json:",omitempty"`
+	Sources   []string `json:",omitempty"`
 }
 
 type MetadataMap struct {
@@ -138,6 +167,31 @@ func LoadSolidityContract(file string) (*SolidityContract, error) {
 	return &contract, nil
 }
 
+// The magic number and version with which every WASM binary module begins
+var wasmPreamble = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// LoadWASMContract reads a WASM binary module built by any toolchain (such as Rust's wasm32-unknown-unknown target)
+// from file. The ABI is read from a file of the same name with an .abi extension if one exists alongside it.
+func LoadWASMContract(file string) (*SolidityContract, error) {
+	code, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(code, wasmPreamble) {
+		return nil, fmt.Errorf("%s is not a WASM binary module", file)
+	}
+	contract := new(SolidityContract)
+	contract.EWasm.Wasm = hex.EncodeToString(code)
+	abiFile := strings.TrimSuffix(file, filepath.Ext(file)) + ".abi"
+	if _, err := os.Stat(abiFile); err == nil {
+		contract.Abi, err = ioutil.ReadFile(abiFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return contract, nil
+}
+
 // Save persists the contract in its json form to disk
 func (contract *SolidityContract) Save(dir, file string) error {
 	str, err := json.Marshal(*contract)
@@ -192,6 +246,26 @@ func link(bytecode string, linkReferences json.RawMessage, libraries map[string]
 	return bytecode, nil
 }
 
+// LinkReferences returns the names of the libraries that must be linked into the contract before it can be deployed
+func (contract *SolidityContract) LinkReferences() ([]string, error) {
+	if !strings.Contains(contract.Evm.Bytecode.Object, "_") || len(contract.Evm.Bytecode.LinkReferences) == 0 {
+		return nil, nil
+	}
+	var links map[string]map[string]json.RawMessage
+	err := json.Unmarshal(contract.Evm.Bytecode.LinkReferences, &links)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, f := range links {
+		for name := range f {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 // Link will replace the unresolved references with the libraries provided
 func (contract *SolidityContract) Link(libraries map[string]string) error {
 	bin := contract.Evm.Bytecode.Object
@@ -202,6 +276,15 @@ func (contract *SolidityContract) Link(libraries map[string]string) error {
 		}
 		contract.Evm.Bytecode.Object = bin
 	}
+	// Older versions of burrow did not ask solc for the link references of the deployed code
+	bin = contract.Evm.DeployedBytecode.Object
+	if strings.Contains(bin, "_") && len(contract.Evm.DeployedBytecode.LinkReferences) > 0 {
+		bin, err := link(bin, contract.Evm.DeployedBytecode.LinkReferences, libraries)
+		if err != nil {
+			return err
+		}
+		contract.Evm.DeployedBytecode.Object = bin
+	}
 
 	// When compiling a solidity file with many contracts contained it, some of those contracts might
 	// never be created by the contract we're current linking. However, Solidity does not tell us
@@ -232,16 +315,26 @@ func (contract *SolidityContract) Code() (code string) {
 	return
 }
 
-func EVM(file string, optimize bool, workDir string, libraries map[string]string, logger *logging.Logger) (*Response, error) {
+// EVM compiles file with the solc binary at solc, or solc on the PATH if it is empty
+func EVM(file string, optimize bool, workDir string, libraries map[string]string, solc string,
+	logger *logging.Logger) (*Response, error) {
+	return EVMWithSettings(file, workDir, CompilerSettings{Optimize: optimize, Libraries: libraries}, solc, logger)
+}
+
+// EVMWithSettings compiles file with settings using the solc binary at solc, or solc on the PATH if it is empty
+func EVMWithSettings(file string, workDir string, settings CompilerSettings, solc string,
+	logger *logging.Logger) (*Response, error) {
 	input := SolidityInput{Language: "Solidity", Sources: make(map[string]SolidityInputSource)}
 
 	input.Sources[file] = SolidityInputSource{Urls: []string{file}}
-	input.Settings.Optimizer.Enabled = optimize
-	input.Settings.OutputSelection.File.OutputType = []string{"abi", "evm.bytecode.object", "evm.deployedBytecode.object", "evm.bytecode.linkReferences", "metadata", "bin", "devdoc"}
+	input.Settings.Optimizer.Enabled = settings.Optimize
+	input.Settings.Optimizer.Runs = settings.Runs
+	input.Settings.EVMVersion = settings.EVMVersion
+	input.Settings.OutputSelection.File.OutputType = []string{"abi", "evm.bytecode.object", "evm.deployedBytecode.object", "evm.bytecode.linkReferences", "evm.deployedBytecode.linkReferences", "evm.deployedBytecode.immutableReferences", "evm.deployedBytecode.sourceMap", "metadata", "bin", "devdoc", "storageLayout"}
 	input.Settings.Libraries = make(map[string]map[string]string)
 	input.Settings.Libraries[""] = make(map[string]string)
 
-	for l, a := range libraries {
+	for l, a := range settings.Libraries {
 		input.Settings.Libraries[""][l] = "0x" + a
 	}
 
@@ -251,7 +344,7 @@ func EVM(file string, optimize bool, workDir string, libraries map[string]string
 	}
 
 	logger.TraceMsg("Command Input", "command", string(command))
-	result, err := runSolidity(string(command), workDir)
+	result, err := runSolidity(string(command), workDir, solc)
 	if err != nil {
 		return nil, err
 	}
@@ -263,6 +356,14 @@ func EVM(file string, optimize bool, workDir string, libraries map[string]string
 		return nil, err
 	}
 
+	var sources []string
+	for filename, src := range output.Sources {
+		for len(sources) <= src.ID {
+			sources = append(sources, "")
+		}
+		sources[src.ID] = filename
+	}
+
 	// Collect our ABIs
 	metamap := make([]MetadataMap, 0)
 	for filename, src := range output.Contracts {
@@ -275,8 +376,11 @@ func EVM(file string, optimize bool, workDir string, libraries map[string]string
 					Metadata: Metadata{
 						ContractName:    contractname,
 						SourceFile:      filename,
+						SourceHash:      meta.Sources[filename].Keccak256,
 						CompilerVersion: meta.Compiler.Version,
 						Abi:             item.Abi,
+						SourceMap:       item.Evm.DeployedBytecode.SourceMap,
+						Sources:         sources,
 					},
 				})
 			}
@@ -388,9 +492,12 @@ func objectName(contract string) string {
 	return parts[len(parts)-1]
 }
 
-func runSolidity(jsonCmd string, workDir string) (string, error) {
+func runSolidity(jsonCmd string, workDir string, solc string) (string, error) {
 	buf := bytes.NewBufferString(jsonCmd)
-	shellCmd := exec.Command("solc", "--standard-json", "--allow-paths", "/")
+	if solc == "" {
+		solc = "solc"
+	}
+	shellCmd := exec.Command(solc, "--standard-json", "--allow-paths", "/")
 	if workDir != "" {
 		shellCmd.Dir = workDir
 	}
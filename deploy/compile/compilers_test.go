@@ -3,6 +3,7 @@ package compile
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -62,7 +63,7 @@ func TestLocalMulti(t *testing.T) {
 		Version: "",
 		Error:   "",
 	}
-	resp, err := EVM("contractImport1.sol", false, "", make(map[string]string), logging.NewNoopLogger())
+	resp, err := EVM("contractImport1.sol", false, "", make(map[string]string), "", logging.NewNoopLogger())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -110,7 +111,7 @@ func TestLocalSingle(t *testing.T) {
 		Version: "",
 		Error:   "",
 	}
-	resp, err := EVM("simpleContract.sol", false, "", make(map[string]string), logging.NewNoopLogger())
+	resp, err := EVM("simpleContract.sol", false, "", make(map[string]string), "", logging.NewNoopLogger())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -128,7 +129,7 @@ func TestFaultyContract(t *testing.T) {
 	const faultyContractFile = "tests/compilers_fixtures/faultyContract.sol"
 	actualOutput, err := exec.Command("solc", "--combined-json", "bin,abi", faultyContractFile).CombinedOutput()
 	require.EqualError(t, err, "exit status 1")
-	resp, err := EVM(faultyContractFile, false, "", make(map[string]string), logging.NewNoopLogger())
+	resp, err := EVM(faultyContractFile, false, "", make(map[string]string), "", logging.NewNoopLogger())
 	require.NoError(t, err)
 	if err != nil {
 		if string(actualOutput) != resp.Error {
@@ -139,6 +140,31 @@ func TestFaultyContract(t *testing.T) {
 	fmt.Println(output)
 }
 
+func TestLoadWASMContract(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// An empty module
+	wasmFile := filepath.Join(dir, "empty.wasm")
+	require.NoError(t, ioutil.WriteFile(wasmFile, wasmPreamble, 0644))
+	contract, err := LoadWASMContract(wasmFile)
+	require.NoError(t, err)
+	require.Equal(t, "0061736d01000000", contract.Code())
+	require.Nil(t, contract.Abi)
+
+	abiJSON := `[{"type":"function","name":"getFoo","inputs":[],"outputs":[{"name":"","type":"uint64"}]}]`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "empty.abi"), []byte(abiJSON), 0644))
+	contract, err = LoadWASMContract(wasmFile)
+	require.NoError(t, err)
+	require.JSONEq(t, abiJSON, string(contract.Abi))
+
+	notWASMFile := filepath.Join(dir, "contract.wasm")
+	require.NoError(t, ioutil.WriteFile(notWASMFile, []byte("6060604052"), 0644))
+	_, err = LoadWASMContract(notWASMFile)
+	require.Error(t, err)
+}
+
 func testContractPath() string {
 	baseDir, _ := os.Getwd()
 	return filepath.Join(baseDir, "..", "..", "tests", "compilers_fixtures")
@@ -164,3 +190,25 @@ func contains(s []ResponseItem, e ResponseItem) bool {
 	}
 	return false
 }
+
+func TestLink(t *testing.T) {
+	contract := SolidityContract{}
+	contract.Evm.Bytecode.Object = "6073" + solcPlaceholder + "00" + solcPlaceholder
+	contract.Evm.Bytecode.LinkReferences = json.RawMessage(`{"Lib.sol":{"Lib":[{"start":2,"length":20}]},
+		"Other.sol":{"Other":[{"start":23,"length":20}]}}`)
+	contract.Evm.DeployedBytecode.Object = "6073" + solcPlaceholder
+	contract.Evm.DeployedBytecode.LinkReferences = json.RawMessage(`{"Lib.sol":{"Lib":[{"start":2,"length":20}]}}`)
+
+	libs, err := contract.LinkReferences()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Lib", "Other"}, libs)
+
+	require.Error(t, contract.Link(map[string]string{"Lib": libraryAddress}))
+	require.NoError(t, contract.Link(map[string]string{"Lib": libraryAddress, "Other": libraryAddress}))
+	assert.Equal(t, "6073"+libraryAddress+"00"+libraryAddress, contract.Evm.Bytecode.Object)
+	assert.Equal(t, "6073"+libraryAddress, contract.Evm.DeployedBytecode.Object)
+
+	libs, err = contract.LinkReferences()
+	require.NoError(t, err)
+	assert.Empty(t, libs)
+}
@@ -0,0 +1,339 @@
+package compile
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/logging"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Where the Solidity project publishes static solc builds for each platform
+const DefaultSolcReleasesURL = "https://binaries.soliditylang.org"
+
+// Selects the newest release satisfying the version pragma of the contract compiled rather than a fixed version
+const SolcPragma = "pragma"
+
+var pragmaRegexp = regexp.MustCompile(`pragma\s+solidity\s+([^;]+);`)
+
+// SolcVersions downloads the solc binary for each version of the compiler it is asked for, keeping them in a cache
+// directory so each is only downloaded once
+type SolcVersions struct {
+	// The directory binaries are kept in
+	CacheDir string
+	// Serves a list.json of releases for each platform along with the binaries it lists
+	ReleasesURL string
+	mtx         sync.Mutex
+	list        *solcList
+	logger      *logging.Logger
+}
+
+type solcList struct {
+	Builds []struct {
+		Path    string
+		Version string
+		Sha256  string
+	}
+	// The path of the build of each release version
+	Releases map[string]string
+}
+
+// NewSolcVersions returns SolcVersions caching binaries in cacheDir, or a directory in the user's cache directory (or
+// the temporary directory if there is none) if it is empty
+func NewSolcVersions(cacheDir string, logger *logging.Logger) *SolcVersions {
+	if cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			userCacheDir = os.TempDir()
+		}
+		cacheDir = filepath.Join(userCacheDir, "burrow", "solc")
+	}
+	return &SolcVersions{
+		CacheDir:    cacheDir,
+		ReleasesURL: DefaultSolcReleasesURL,
+		logger:      logger,
+	}
+}
+
+// Binary returns the path of the solc binary to compile file with: solc on the PATH if version is empty, the release
+// selected by the version pragma of file if version is SolcPragma, otherwise the release version
+func (sv *SolcVersions) Binary(version, file string) (string, error) {
+	if sv == nil || version == "" {
+		return "solc", nil
+	}
+	sv.mtx.Lock()
+	defer sv.mtx.Unlock()
+	if version == SolcPragma {
+		source, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		match := pragmaRegexp.FindSubmatch(source)
+		if match == nil {
+			return "", fmt.Errorf("%s has no version pragma to select a solc release with", file)
+		}
+		version, err = sv.selectRelease(string(match[1]))
+		if err != nil {
+			return "", fmt.Errorf("could not select solc release for %s: %w", file, err)
+		}
+	}
+	return sv.binary(version)
+}
+
+// Returns the newest release satisfying the version pragma constraint, or the newest release already downloaded if
+// releases cannot be listed
+func (sv *SolcVersions) selectRelease(constraint string) (string, error) {
+	var versions []string
+	list, err := sv.releases()
+	if err == nil {
+		for version := range list.Releases {
+			versions = append(versions, version)
+		}
+	} else {
+		cached, _ := filepath.Glob(filepath.Join(sv.CacheDir, "solc-*"))
+		for _, file := range cached {
+			versions = append(versions, strings.TrimSuffix(strings.TrimPrefix(filepath.Base(file), "solc-"), ".exe"))
+		}
+	}
+	var selected string
+	var newest solcVersion
+	for _, version := range versions {
+		v, verr := parseSolcVersion(version)
+		if verr != nil || len(v) != 3 {
+			continue
+		}
+		ok, cerr := v.satisfies(constraint)
+		if cerr != nil {
+			return "", cerr
+		}
+		if ok && (selected == "" || v.compare(newest) > 0) {
+			selected, newest = version, v
+		}
+	}
+	if selected == "" {
+		if err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("no release satisfies %s", constraint)
+	}
+	return selected, nil
+}
+
+// Returns the path of the binary for version, downloading it if it has not been already
+func (sv *SolcVersions) binary(version string) (string, error) {
+	path := filepath.Join(sv.CacheDir, "solc-"+version)
+	if runtime.GOOS == "windows" {
+		path += ".exe"
+	}
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	list, err := sv.releases()
+	if err != nil {
+		return "", err
+	}
+	buildPath, ok := list.Releases[version]
+	if !ok {
+		return "", fmt.Errorf("solc %s is not a release", version)
+	}
+	var sha string
+	for _, build := range list.Builds {
+		if build.Path == buildPath {
+			sha = strings.TrimPrefix(build.Sha256, "0x")
+		}
+	}
+	if sha == "" {
+		return "", fmt.Errorf("no checksum is listed for solc %s", version)
+	}
+	platform, err := solcPlatform()
+	if err != nil {
+		return "", err
+	}
+	url := fmt.Sprintf("%s/%s/%s", sv.ReleasesURL, platform, buildPath)
+	sv.logger.InfoMsg("Downloading solc", "version", version, "url", url)
+	response, err := http.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("could not download solc %s: %w", version, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not download solc %s from %s: %s", version, url, response.Status)
+	}
+	err = os.MkdirAll(sv.CacheDir, 0755)
+	if err != nil {
+		return "", err
+	}
+	// Write to a temporary file so that an interrupted download is never mistaken for the binary
+	f, err := ioutil.TempFile(sv.CacheDir, "download.*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	hasher := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, hasher), response.Body)
+	if err != nil {
+		f.Close()
+		return "", fmt.Errorf("could not download solc %s: %w", version, err)
+	}
+	err = f.Close()
+	if err != nil {
+		return "", err
+	}
+	if hex.EncodeToString(hasher.Sum(nil)) != strings.ToLower(sha) {
+		return "", fmt.Errorf("solc %s downloaded from %s does not match its checksum %s", version, url, sha)
+	}
+	err = os.Chmod(f.Name(), 0755)
+	if err != nil {
+		return "", err
+	}
+	return path, os.Rename(f.Name(), path)
+}
+
+func (sv *SolcVersions) releases() (*solcList, error) {
+	if sv.list != nil {
+		return sv.list, nil
+	}
+	platform, err := solcPlatform()
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/%s/list.json", sv.ReleasesURL, platform)
+	response, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not list solc releases: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list solc releases from %s: %s", url, response.Status)
+	}
+	list := new(solcList)
+	err = json.NewDecoder(response.Body).Decode(list)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode solc releases from %s: %w", url, err)
+	}
+	sv.list = list
+	return list, nil
+}
+
+func solcPlatform() (string, error) {
+	if runtime.GOARCH == "amd64" {
+		switch runtime.GOOS {
+		case "linux":
+			return "linux-amd64", nil
+		case "darwin":
+			return "macosx-amd64", nil
+		case "windows":
+			return "windows-amd64", nil
+		}
+	}
+	return "", fmt.Errorf("no solc builds are published for %s/%s so solc must be on the PATH",
+		runtime.GOOS, runtime.GOARCH)
+}
+
+// The major, minor, and patch numbers of a version, of which trailing numbers may be missing in a constraint
+type solcVersion []int
+
+func parseSolcVersion(version string) (solcVersion, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("version %s has more than three parts", version)
+	}
+	v := make(solcVersion, 0, 3)
+	for _, part := range parts {
+		if part == "x" || part == "*" {
+			break
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse version %s: %w", version, err)
+		}
+		v = append(v, n)
+	}
+	return v, nil
+}
+
+// Compares the numbers v and o have in common
+func (v solcVersion) compare(o solcVersion) int {
+	for i := 0; i < len(v) && i < len(o); i++ {
+		if v[i] != o[i] {
+			if v[i] < o[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// Reports whether v satisfies a version pragma constraint, which is made of ranges separated by ||, each of which is
+// one or more comparisons that must all hold
+func (v solcVersion) satisfies(constraint string) (bool, error) {
+	for _, rng := range strings.Split(constraint, "||") {
+		comparisons := strings.Fields(rng)
+		if len(comparisons) == 0 {
+			return false, fmt.Errorf("empty range in version constraint %s", constraint)
+		}
+		ok := true
+		for _, comparison := range comparisons {
+			holds, err := v.holds(comparison)
+			if err != nil {
+				return false, err
+			}
+			ok = ok && holds
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (v solcVersion) holds(comparison string) (bool, error) {
+	op := strings.TrimRight(comparison, "0123456789.x*")
+	o, err := parseSolcVersion(comparison[len(op):])
+	if err != nil {
+		return false, err
+	}
+	switch op {
+	case "", "=":
+		// Any version with the numbers given
+		return v.compare(o) == 0, nil
+	case ">":
+		return v.compare(o) > 0, nil
+	case ">=":
+		return v.compare(o) >= 0, nil
+	case "<":
+		return v.compare(o) < 0, nil
+	case "<=":
+		return v.compare(o) <= 0, nil
+	case "~":
+		// Patch releases of the minor version given, or any of the major version if only that is given
+		return v.compare(o) >= 0 && v.compare(o[:min(len(o), 2)]) == 0, nil
+	case "^":
+		// Releases that do not change the leftmost non-zero number
+		fixed := 0
+		for fixed < len(o)-1 && o[fixed] == 0 {
+			fixed++
+		}
+		return v.compare(o) >= 0 && v.compare(o[:fixed+1]) == 0, nil
+	}
+	return false, fmt.Errorf("unknown operator %s in version constraint", op)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
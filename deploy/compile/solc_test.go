@@ -0,0 +1,158 @@
+package compile
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestSolcVersionSatisfies(t *testing.T) {
+	for _, tc := range []struct {
+		version    string
+		constraint string
+		satisfies  bool
+	}{
+		{"0.5.17", "^0.5.0", true},
+		{"0.6.0", "^0.5.0", false},
+		{"0.8.4", "^0.8.0", true},
+		{"0.8.4", ">=0.6.0 <0.8.0", false},
+		{"0.7.6", ">=0.6.0 <0.8.0", true},
+		{"0.6.12", "0.6.12", true},
+		{"0.6.11", "=0.6.12", false},
+		{"0.6.12", "~0.6.2", true},
+		{"0.7.0", "~0.6.2", false},
+		{"0.4.26", "^0.4.24 || ^0.8.0", true},
+		{"0.5.0", "^0.4.24 || ^0.8.0", false},
+		{"0.8.1", "0.8", true},
+		{"0.8.1", "0.8.x", true},
+		{"0.8.1", ">0.8.1", false},
+		{"0.8.1", "<=0.8.1", true},
+	} {
+		v, err := parseSolcVersion(tc.version)
+		require.NoError(t, err)
+		satisfies, err := v.satisfies(tc.constraint)
+		require.NoError(t, err)
+		assert.Equal(t, tc.satisfies, satisfies, "%s satisfies %s", tc.version, tc.constraint)
+	}
+	_, err := solcVersion{0, 8, 1}.satisfies("!0.8.1")
+	require.Error(t, err)
+}
+
+func TestSolcVersions(t *testing.T) {
+	platform, err := solcPlatform()
+	if err != nil {
+		t.Skip(err)
+	}
+	binaries := map[string][]byte{
+		"solc-linux-amd64-v0.6.12+commit.27d51765": []byte("#!/bin/sh\necho 0.6.12\n"),
+		"solc-linux-amd64-v0.8.4+commit.c7e474f2":  []byte("#!/bin/sh\necho 0.8.4\n"),
+	}
+	list := solcList{Releases: map[string]string{
+		"0.6.12": "solc-linux-amd64-v0.6.12+commit.27d51765",
+		"0.8.4":  "solc-linux-amd64-v0.8.4+commit.c7e474f2",
+		// Listed with a checksum that does not match
+		"0.7.6": "solc-linux-amd64-v0.7.6+commit.7338295f",
+	}}
+	binaries["solc-linux-amd64-v0.7.6+commit.7338295f"] = []byte("tampered")
+	for version, path := range list.Releases {
+		sum := sha256.Sum256(binaries[path])
+		if version == "0.7.6" {
+			sum = sha256.Sum256([]byte("original"))
+		}
+		list.Builds = append(list.Builds, struct {
+			Path    string
+			Version string
+			Sha256  string
+		}{Path: path, Version: version, Sha256: "0x" + hex.EncodeToString(sum[:])})
+	}
+
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := filepath.Base(r.URL.Path)
+		if r.URL.Path == fmt.Sprintf("/%s/list.json", platform) {
+			require.NoError(t, json.NewEncoder(w).Encode(list))
+			return
+		}
+		binary, ok := binaries[name]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		downloads++
+		w.Write(binary)
+	}))
+	defer server.Close()
+
+	cacheDir, err := ioutil.TempDir("", "solc")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+	sv := NewSolcVersions(cacheDir, logging.NewNoopLogger())
+	sv.ReleasesURL = server.URL
+
+	t.Run("PATH", func(t *testing.T) {
+		solc, err := sv.Binary("", "")
+		require.NoError(t, err)
+		assert.Equal(t, "solc", solc)
+	})
+
+	t.Run("Version", func(t *testing.T) {
+		solc, err := sv.Binary("0.6.12", "")
+		require.NoError(t, err)
+		bs, err := ioutil.ReadFile(solc)
+		require.NoError(t, err)
+		assert.Equal(t, binaries[list.Releases["0.6.12"]], bs)
+		if runtime.GOOS != "windows" {
+			info, err := os.Stat(solc)
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+		}
+		// Cached
+		_, err = sv.Binary("0.6.12", "")
+		require.NoError(t, err)
+		assert.Equal(t, 1, downloads)
+	})
+
+	t.Run("Pragma", func(t *testing.T) {
+		file := filepath.Join(cacheDir, "contract.sol")
+		require.NoError(t, ioutil.WriteFile(file, []byte("pragma solidity >=0.6.0 <0.9.0;\ncontract C {}\n"), 0644))
+		solc, err := sv.Binary(SolcPragma, file)
+		require.NoError(t, err)
+		assert.Equal(t, "solc-0.8.4", filepath.Base(solc))
+
+		require.NoError(t, ioutil.WriteFile(file, []byte("pragma solidity ^0.5.0;\n"), 0644))
+		_, err = sv.Binary(SolcPragma, file)
+		require.Error(t, err)
+
+		// Falls back to the cached releases when releases cannot be listed
+		offline := NewSolcVersions(cacheDir, logging.NewNoopLogger())
+		offline.ReleasesURL = "http://127.0.0.1:0"
+		require.NoError(t, ioutil.WriteFile(file, []byte("pragma solidity ^0.6.0;\n"), 0644))
+		solc, err = offline.Binary(SolcPragma, file)
+		require.NoError(t, err)
+		assert.Equal(t, "solc-0.6.12", filepath.Base(solc))
+	})
+
+	t.Run("Checksum", func(t *testing.T) {
+		_, err := sv.Binary("0.7.6", "")
+		require.Error(t, err)
+		_, err = os.Stat(filepath.Join(cacheDir, "solc-0.7.6"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("NotRelease", func(t *testing.T) {
+		_, err := sv.Binary("0.9.99", "")
+		require.Error(t, err)
+	})
+}
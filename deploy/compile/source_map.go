@@ -0,0 +1,89 @@
+package compile
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+// SourceLocation is the range of a source file an instruction was compiled from
+type SourceLocation struct {
+	File string
+	// The offset and length of the range in bytes
+	Start  int
+	Length int
+}
+
+// SourceLocation returns where the instruction at pc of code, the code deployed with the metadata, was compiled from,
+// or nil if it was compiled from no source, as for code solc generates itself
+func (meta *Metadata) SourceLocation(code []byte, pc uint64) (*SourceLocation, error) {
+	if meta.SourceMap == "" {
+		return nil, fmt.Errorf("no source map was registered for %s", meta.ContractName)
+	}
+	instruction, err := instructionIndex(code, pc)
+	if err != nil {
+		return nil, err
+	}
+	entries := strings.Split(meta.SourceMap, ";")
+	if instruction >= len(entries) {
+		return nil, fmt.Errorf("instruction at PC %d is not in the source map of %s", pc, meta.ContractName)
+	}
+	// Each entry is s:l:f:j:m where any field left empty, or missing from the end, is that of the entry before
+	fields := make([]string, 3)
+	for _, entry := range entries[:instruction+1] {
+		for i, field := range strings.Split(entry, ":") {
+			if i < len(fields) && field != "" {
+				fields[i] = field
+			}
+		}
+	}
+	var nums [3]int
+	for i, field := range fields {
+		nums[i], err = strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("could not read source map of %s: %v", meta.ContractName, err)
+		}
+	}
+	file := nums[2]
+	if file < 0 {
+		return nil, nil
+	}
+	if file >= len(meta.Sources) {
+		return nil, fmt.Errorf("source map of %s refers to unknown source %d", meta.ContractName, file)
+	}
+	return &SourceLocation{File: meta.Sources[file], Start: nums[0], Length: nums[1]}, nil
+}
+
+// Line returns the line and column, counting from 1, at which the location starts in source, the content of its file
+func (loc *SourceLocation) Line(source []byte) (line, column int) {
+	if loc.Start > len(source) {
+		return 0, 0
+	}
+	before := source[:loc.Start]
+	line = bytes.Count(before, []byte{'\n'}) + 1
+	column = len(before) - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
+
+// Returns the number of instructions in code before pc, skipping the data pushed by PUSH instructions
+func instructionIndex(code []byte, pc uint64) (int, error) {
+	if pc >= uint64(len(code)) {
+		return 0, fmt.Errorf("PC %d is outside code of length %d", pc, len(code))
+	}
+	index := 0
+	for i := uint64(0); i < pc; index++ {
+		op := asm.OpCode(code[i])
+		next := i + 1
+		if op >= asm.PUSH1 && op <= asm.PUSH32 {
+			next += uint64(op - asm.PUSH1 + 1)
+		}
+		if next > pc {
+			return 0, fmt.Errorf("PC %d is inside the data of the PUSH at %d", pc, i)
+		}
+		i = next
+	}
+	return index, nil
+}
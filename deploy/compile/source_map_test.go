@@ -0,0 +1,46 @@
+package compile
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceLocation(t *testing.T) {
+	source := []byte("contract C {\n    function f() public {\n        revert();\n    }\n}\n")
+	code := []byte{byte(asm.PUSH1), 0x80, byte(asm.PUSH2), 0x01, 0x02, byte(asm.JUMPDEST), byte(asm.PUSH1), 0,
+		byte(asm.DUP1), byte(asm.REVERT)}
+	meta := &Metadata{
+		ContractName: "C",
+		// Fields left out are those of the entry before
+		SourceMap: "0:63:1:-:0;;13:48::i;;47:8:1:o;-1:-1:-1",
+		Sources:   []string{"Lib.sol", "C.sol"},
+	}
+
+	loc, err := meta.SourceLocation(code, 9)
+	require.NoError(t, err)
+	assert.Nil(t, loc, "generated code has no location")
+
+	loc, err = meta.SourceLocation(code, 8)
+	require.NoError(t, err)
+	assert.Equal(t, &SourceLocation{File: "C.sol", Start: 47, Length: 8}, loc)
+	line, column := loc.Line(source)
+	assert.Equal(t, 3, line)
+	assert.Equal(t, 9, column)
+
+	loc, err = meta.SourceLocation(code, 5)
+	require.NoError(t, err)
+	assert.Equal(t, &SourceLocation{File: "C.sol", Start: 13, Length: 48}, loc)
+	line, column = loc.Line(source)
+	assert.Equal(t, 2, line)
+	assert.Equal(t, 1, column)
+
+	_, err = meta.SourceLocation(code, 3)
+	assert.Error(t, err, "inside PUSH2 data")
+	_, err = meta.SourceLocation(code, 10)
+	assert.Error(t, err, "outside the code")
+	_, err = (&Metadata{ContractName: "C"}).SourceLocation(code, 0)
+	assert.Error(t, err, "no source map")
+}
@@ -0,0 +1,107 @@
+package compile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StorageLayout is where a contract keeps its state variables, as given by solc's storageLayout output, see:
+// https://docs.soliditylang.org/en/latest/internals/layout_in_storage.html#json-output
+type StorageLayout struct {
+	Storage []StorageVariable      `json:"storage"`
+	Types   map[string]StorageType `json:"types"`
+}
+
+// StorageVariable is a state variable or struct member, which Offset bytes into Slot holds a value of Type (a key
+// of StorageLayout.Types)
+type StorageVariable struct {
+	Contract string `json:"contract,omitempty"`
+	Label    string `json:"label"`
+	Offset   int    `json:"offset"`
+	Slot     string `json:"slot"`
+	Type     string `json:"type"`
+}
+
+// StorageType describes how a type is stored: inplace, mapping, dynamic_array, or bytes. Key and Value are set for
+// mappings, Base for arrays, and Members for structs.
+type StorageType struct {
+	Encoding      string            `json:"encoding"`
+	Label         string            `json:"label"`
+	NumberOfBytes string            `json:"numberOfBytes"`
+	Key           string            `json:"key,omitempty"`
+	Value         string            `json:"value,omitempty"`
+	Base          string            `json:"base,omitempty"`
+	Members       []StorageVariable `json:"members,omitempty"`
+}
+
+// UpgradeConflicts returns how the storage of a contract with layout next would misread the storage written by a
+// contract with layout previous, which must be nothing for the next contract to replace the previous as the
+// implementation of a proxy. Variables may only be added after those of previous, and the variables they share must
+// keep their names, positions, and types.
+func UpgradeConflicts(previous, next *StorageLayout) []string {
+	return variableConflicts(previous, next, previous.Storage, next.Storage, "")
+}
+
+func variableConflicts(previous, next *StorageLayout, previousVars, nextVars []StorageVariable,
+	prefix string) []string {
+	var conflicts []string
+	for i, pv := range previousVars {
+		name := prefix + pv.Label
+		if i >= len(nextVars) {
+			conflicts = append(conflicts, fmt.Sprintf("%s was removed", name))
+			continue
+		}
+		nv := nextVars[i]
+		switch {
+		case pv.Label != nv.Label:
+			conflicts = append(conflicts, fmt.Sprintf("%s was replaced by %s%s", name, prefix, nv.Label))
+		case pv.Slot != nv.Slot || pv.Offset != nv.Offset:
+			conflicts = append(conflicts, fmt.Sprintf("%s moved from slot %s offset %d to slot %s offset %d",
+				name, pv.Slot, pv.Offset, nv.Slot, nv.Offset))
+		default:
+			conflicts = append(conflicts, typeConflicts(previous, next, pv.Type, nv.Type, name)...)
+		}
+	}
+	return conflicts
+}
+
+func typeConflicts(previous, next *StorageLayout, previousType, nextType, name string) []string {
+	pt, ok := previous.Types[previousType]
+	if !ok {
+		return []string{fmt.Sprintf("type %s of %s is not described by the previous layout", previousType, name)}
+	}
+	nt, ok := next.Types[nextType]
+	if !ok {
+		return []string{fmt.Sprintf("type %s of %s is not described by the next layout", nextType, name)}
+	}
+	changed := []string{fmt.Sprintf("%s changed type from %s to %s", name, pt.Label, nt.Label)}
+	if pt.Encoding != nt.Encoding || pt.NumberOfBytes != nt.NumberOfBytes {
+		return changed
+	}
+	switch {
+	case pt.Encoding == "mapping":
+		return append(typeConflicts(previous, next, pt.Key, nt.Key, name+" key"),
+			typeConflicts(previous, next, pt.Value, nt.Value, name+" value")...)
+	case pt.Base != "" || nt.Base != "":
+		return typeConflicts(previous, next, pt.Base, nt.Base, name+" element")
+	case pt.Members != nil || nt.Members != nil:
+		if len(pt.Members) != len(nt.Members) {
+			return changed
+		}
+		return variableConflicts(previous, next, pt.Members, nt.Members, name+".")
+	case elementaryType(pt.Label) != elementaryType(nt.Label):
+		return changed
+	}
+	return nil
+}
+
+// Contracts are stored as addresses and enums by their index, so they may be renamed or extended
+func elementaryType(label string) string {
+	switch {
+	case strings.HasPrefix(label, "contract "):
+		return "address"
+	case strings.HasPrefix(label, "enum "):
+		return "enum"
+	}
+	return label
+}
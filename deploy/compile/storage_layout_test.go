@@ -0,0 +1,99 @@
+package compile
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenLayout = `{
+	"storage": [
+		{"astId": 3, "contract": "Token.sol:Token", "label": "owner", "offset": 0, "slot": "0", "type": "t_address"},
+		{"astId": 5, "contract": "Token.sol:Token", "label": "paused", "offset": 20, "slot": "0", "type": "t_bool"},
+		{"astId": 9, "contract": "Token.sol:Token", "label": "balances", "offset": 0, "slot": "1",
+			"type": "t_mapping(t_address,t_uint256)"},
+		{"astId": 14, "contract": "Token.sol:Token", "label": "grants", "offset": 0, "slot": "2",
+			"type": "t_array(t_struct(Grant)12_storage)dyn_storage"}
+	],
+	"types": {
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+		"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+		"t_int256": {"encoding": "inplace", "label": "int256", "numberOfBytes": "32"},
+		"t_mapping(t_address,t_uint256)": {"encoding": "mapping", "key": "t_address",
+			"label": "mapping(address => uint256)", "numberOfBytes": "32", "value": "t_uint256"},
+		"t_array(t_struct(Grant)12_storage)dyn_storage": {"base": "t_struct(Grant)12_storage",
+			"encoding": "dynamic_array", "label": "struct Token.Grant[]", "numberOfBytes": "32"},
+		"t_struct(Grant)12_storage": {"encoding": "inplace", "label": "struct Token.Grant", "numberOfBytes": "64",
+			"members": [
+				{"astId": 8, "contract": "Token.sol:Token", "label": "to", "offset": 0, "slot": "0", "type": "t_address"},
+				{"astId": 10, "contract": "Token.sol:Token", "label": "amount", "offset": 0, "slot": "1", "type": "t_uint256"}
+			]}
+	}
+}`
+
+func TestUpgradeConflicts(t *testing.T) {
+	read := func() *StorageLayout {
+		layout := new(StorageLayout)
+		require.NoError(t, json.Unmarshal([]byte(tokenLayout), layout))
+		return layout
+	}
+	previous := read()
+
+	t.Run("Same", func(t *testing.T) {
+		assert.Empty(t, UpgradeConflicts(previous, read()))
+	})
+
+	t.Run("Appended", func(t *testing.T) {
+		next := read()
+		next.Storage = append(next.Storage, StorageVariable{Label: "cap", Slot: "3", Type: "t_uint256"})
+		assert.Empty(t, UpgradeConflicts(previous, next))
+	})
+
+	t.Run("Inserted", func(t *testing.T) {
+		next := read()
+		next.Storage = append([]StorageVariable{{Label: "cap", Slot: "0", Type: "t_uint256"}}, next.Storage...)
+		assert.Equal(t, []string{
+			"owner was replaced by cap",
+			"paused was replaced by owner",
+			"balances was replaced by paused",
+			"grants was replaced by balances",
+		}, UpgradeConflicts(previous, next))
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		next := read()
+		next.Storage = next.Storage[:3]
+		assert.Equal(t, []string{"grants was removed"}, UpgradeConflicts(previous, next))
+	})
+
+	t.Run("Moved", func(t *testing.T) {
+		next := read()
+		next.Storage[1].Slot = "1"
+		next.Storage[1].Offset = 0
+		assert.Equal(t, []string{"paused moved from slot 0 offset 20 to slot 1 offset 0"},
+			UpgradeConflicts(previous, next))
+	})
+
+	t.Run("ChangedType", func(t *testing.T) {
+		next := read()
+		mapping := next.Types["t_mapping(t_address,t_uint256)"]
+		mapping.Value = "t_int256"
+		next.Types["t_mapping(t_address,t_uint256)"] = mapping
+		assert.Equal(t, []string{"balances value changed type from uint256 to int256"},
+			UpgradeConflicts(previous, next))
+	})
+
+	t.Run("ChangedStruct", func(t *testing.T) {
+		next := read()
+		grant := next.Types["t_struct(Grant)12_storage"]
+		grant.Members = []StorageVariable{grant.Members[1], grant.Members[0]}
+		next.Types["t_struct(Grant)12_storage"] = grant
+		assert.Equal(t, []string{
+			"grants element.to was replaced by grants element.amount",
+			"grants element.amount was replaced by grants element.to",
+		}, UpgradeConflicts(previous, next))
+	})
+}
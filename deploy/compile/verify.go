@@ -0,0 +1,129 @@
+package compile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// The CBOR keys that precede the hash of the metadata solc embeds in code, and the length of the hash
+var metadataHashPrefixes = []struct {
+	prefix []byte
+	length int
+}{
+	{append([]byte{0x64}, append([]byte("ipfs"), 0x58, 0x22)...), 34},
+	{append([]byte{0x65}, append([]byte("bzzr0"), 0x58, 0x20)...), 32},
+	{append([]byte{0x65}, append([]byte("bzzr1"), 0x58, 0x20)...), 32},
+}
+
+// VerifyRuntime checks that code, the code of the contract at address, is the deployed bytecode of contract. The
+// values of immutable variables and the address a library embeds in its code are set on deployment, so are not
+// compared. Nor are the hashes of the metadata solc embeds, which change with any change to the source files including
+// their comments and paths; exact is whether those match too, in which case the source is exactly that compiled.
+func (contract *SolidityContract) VerifyRuntime(code []byte, address crypto.Address) (exact bool, err error) {
+	compiled, err := decodeLinked(contract.Evm.DeployedBytecode.Object)
+	if err != nil {
+		return false, err
+	}
+	if len(compiled) != len(code) {
+		return false, fmt.Errorf("deployed code is %d bytes long but compiled code is %d", len(code), len(compiled))
+	}
+	deployed := make([]byte, len(code))
+	copy(deployed, code)
+
+	// Library call protection
+	if bytes.HasPrefix(compiled, append([]byte{byte(asm.PUSH20)}, make([]byte, crypto.AddressLength)...)) &&
+		bytes.HasPrefix(deployed, append([]byte{byte(asm.PUSH20)}, address.Bytes()...)) {
+		copy(deployed[1:], make([]byte, crypto.AddressLength))
+	}
+
+	if len(contract.Evm.DeployedBytecode.ImmutableReferences) > 0 {
+		var immutables map[string][]struct{ Start, Length int }
+		err = json.Unmarshal(contract.Evm.DeployedBytecode.ImmutableReferences, &immutables)
+		if err != nil {
+			return false, fmt.Errorf("could not read immutable references: %v", err)
+		}
+		for _, refs := range immutables {
+			for _, ref := range refs {
+				if ref.Start < 0 || ref.Length < 0 || ref.Start+ref.Length > len(compiled) {
+					return false, fmt.Errorf("immutable reference at %d is outside the code", ref.Start)
+				}
+				copy(compiled[ref.Start:], make([]byte, ref.Length))
+				copy(deployed[ref.Start:], make([]byte, ref.Length))
+			}
+		}
+	}
+	return compareMasked(compiled, deployed)
+}
+
+// VerifyCreation checks that input, the data of the transaction that deployed the contract, is the bytecode of
+// contract followed by its constructor arguments, which it returns. As for VerifyRuntime, the hashes of the metadata
+// solc embeds are only compared for exact.
+func (contract *SolidityContract) VerifyCreation(input []byte) (exact bool, arguments []byte, err error) {
+	compiled, err := decodeLinked(contract.Evm.Bytecode.Object)
+	if err != nil {
+		return false, nil, err
+	}
+	if len(input) < len(compiled) {
+		return false, nil, fmt.Errorf("deploy transaction has %d bytes of data but the compiled code is %d bytes long",
+			len(input), len(compiled))
+	}
+	exact, err = compareMasked(compiled, input[:len(compiled)])
+	return exact, input[len(compiled):], err
+}
+
+// Compares compiled with deployed except for the metadata hashes in compiled, returning whether those match too
+func compareMasked(compiled, deployed []byte) (exact bool, err error) {
+	exact = true
+	start := 0
+	for _, span := range metadataHashes(compiled) {
+		if !bytes.Equal(compiled[start:span[0]], deployed[start:span[0]]) {
+			return false, mismatch(compiled, deployed, start)
+		}
+		exact = exact && bytes.Equal(compiled[span[0]:span[1]], deployed[span[0]:span[1]])
+		start = span[1]
+	}
+	if !bytes.Equal(compiled[start:], deployed[start:]) {
+		return false, mismatch(compiled, deployed, start)
+	}
+	return exact, nil
+}
+
+func mismatch(compiled, deployed []byte, start int) error {
+	i := start
+	for compiled[i] == deployed[i] {
+		i++
+	}
+	return fmt.Errorf("deployed code differs from compiled code at byte %d", i)
+}
+
+// Returns the start and end of each metadata hash in code in order
+func metadataHashes(code []byte) [][2]int {
+	var spans [][2]int
+	for i := 0; i < len(code); i++ {
+		for _, mh := range metadataHashPrefixes {
+			end := i + len(mh.prefix) + mh.length
+			if end <= len(code) && bytes.HasPrefix(code[i:], mh.prefix) {
+				spans = append(spans, [2]int{i + len(mh.prefix), end})
+				i = end - 1
+				break
+			}
+		}
+	}
+	return spans
+}
+
+func decodeLinked(object string) ([]byte, error) {
+	if strings.Contains(object, "_") {
+		return nil, fmt.Errorf("compiled code links to libraries whose addresses were not given")
+	}
+	if object == "" {
+		return nil, fmt.Errorf("no code was compiled, the contract may be abstract or an interface")
+	}
+	return hex.DecodeString(object)
+}
@@ -0,0 +1,84 @@
+package compile
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestVerify(t *testing.T) {
+	// CBOR map of the ipfs hash of the metadata and the solc version, followed by its length
+	metadata := func(hash byte) []byte {
+		cbor := append([]byte{0xa2, 0x64}, "ipfs"...)
+		cbor = append(cbor, 0x58, 0x22)
+		cbor = append(cbor, bytes.Repeat([]byte{hash}, 34)...)
+		cbor = append(cbor, 0x64)
+		cbor = append(cbor, "solc"...)
+		cbor = append(cbor, 0x43, 0, 8, 19)
+		return append(cbor, 0, byte(len(cbor)))
+	}
+	address := crypto.Address{1, 2, 3}
+	runtime := append([]byte{0x73}, make([]byte, crypto.AddressLength)...)
+	// PUSH32 of an immutable at 26
+	runtime = append(runtime, 0x30, 0x14, 0x60, 0x80, 0x7f)
+	runtime = append(runtime, make([]byte, 32)...)
+	runtime = append(runtime, 0x00)
+	creation := append([]byte{0x60, 0x80, 0x60, 0x40}, append(runtime, metadata(0xAA)...)...)
+
+	contract := SolidityContract{}
+	contract.Evm.Bytecode.Object = hex.EncodeToString(creation)
+	contract.Evm.DeployedBytecode.Object = hex.EncodeToString(append(runtime, metadata(0xAA)...))
+	contract.Evm.DeployedBytecode.ImmutableReferences = json.RawMessage(`{"3":[{"start":26,"length":32}]}`)
+
+	deployed := func(hash byte, immutable byte) []byte {
+		code := append([]byte{}, runtime...)
+		copy(code[1:], address.Bytes())
+		code[40] = immutable
+		return append(code, metadata(hash)...)
+	}
+
+	exact, err := contract.VerifyRuntime(deployed(0xAA, 7), address)
+	require.NoError(t, err)
+	assert.True(t, exact)
+
+	exact, err = contract.VerifyRuntime(deployed(0xBB, 7), address)
+	require.NoError(t, err)
+	assert.False(t, exact, "metadata hash differs")
+
+	// The library address must be the contract's own
+	_, err = contract.VerifyRuntime(deployed(0xAA, 7), crypto.Address{9})
+	assert.EqualError(t, err, "deployed code differs from compiled code at byte 1")
+
+	code := deployed(0xAA, 7)
+	code[22] = 0x31
+	_, err = contract.VerifyRuntime(code, address)
+	assert.EqualError(t, err, "deployed code differs from compiled code at byte 22")
+
+	_, err = contract.VerifyRuntime(code[:len(code)-1], address)
+	assert.Error(t, err)
+
+	arguments := []byte{0, 0, 0, 42}
+	exact, args, err := contract.VerifyCreation(append(creation, arguments...))
+	require.NoError(t, err)
+	assert.True(t, exact)
+	assert.Equal(t, arguments, args)
+
+	other := append(append([]byte{0x60, 0x80, 0x60, 0x40}, append(runtime, metadata(0xBB)...)...), arguments...)
+	exact, args, err = contract.VerifyCreation(other)
+	require.NoError(t, err)
+	assert.False(t, exact)
+	assert.Equal(t, arguments, args)
+
+	other[0] = 0x61
+	_, _, err = contract.VerifyCreation(other)
+	assert.EqualError(t, err, "deployed code differs from compiled code at byte 0")
+
+	contract.Evm.DeployedBytecode.Object = "73__$3f1f4d5b6e8a3f0c2d7e9b1a4c6d8e0f2a$__"
+	_, err = contract.VerifyRuntime(code, address)
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package compile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// VyperInput is a structure for the vyper compiler input json form, see:
+// https://vyper.readthedocs.io/en/stable/compiling-a-contract.html#input-json-description
+type VyperInput struct {
+	Language string                         `json:"language"`
+	Sources  map[string]SolidityInputSource `json:"sources"`
+	Settings struct {
+		OutputSelection map[string][]string `json:"outputSelection"`
+	} `json:"settings"`
+}
+
+// VyperOutput is a structure for the output of the vyper json output form, which follows that of solidity
+type VyperOutput struct {
+	SolidityOutput
+	Compiler string
+}
+
+// IsVyper reports whether file is Vyper source
+func IsVyper(file string) bool {
+	return filepath.Ext(file) == ".vy"
+}
+
+// Vyper compiles file with the vyper-json binary at vyper, or vyper-json on the PATH if it is empty. Vyper names the
+// contract in each file after the file and has no libraries to link.
+func Vyper(file string, workDir string, vyper string, logger *logging.Logger) (*Response, error) {
+	source, err := ioutil.ReadFile(filepath.Join(workDir, file))
+	if err != nil {
+		return nil, err
+	}
+	input := VyperInput{Language: "Vyper", Sources: map[string]SolidityInputSource{file: {Content: string(source)}}}
+	input.Settings.OutputSelection = map[string][]string{"*": {"abi", "evm.bytecode", "evm.deployedBytecode"}}
+
+	command, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if vyper == "" {
+		vyper = "vyper-json"
+	}
+	shellCmd := exec.Command(vyper)
+	if workDir != "" {
+		shellCmd.Dir = workDir
+	}
+	shellCmd.Stdin = bytes.NewBuffer(command)
+	logger.TraceMsg("Command Input", "command", string(command))
+	result, err := shellCmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			logger.InfoMsg("vyper failed", "output", string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("could not run %s: %w", vyper, err)
+	}
+	logger.TraceMsg("Command Output", "result", string(result))
+
+	output := VyperOutput{}
+	err = json.Unmarshal(result, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	respItemArray := make([]ResponseItem, 0)
+	sourceHash := "0x" + hex.EncodeToString(crypto.Keccak256(source))
+	for filename, src := range output.Contracts {
+		for contractname, item := range src {
+			// Unlike solc vyper prefixes its bytecode
+			item.Evm.Bytecode.Object = strings.TrimPrefix(item.Evm.Bytecode.Object, "0x")
+			item.Evm.DeployedBytecode.Object = strings.TrimPrefix(item.Evm.DeployedBytecode.Object, "0x")
+			if item.Evm.DeployedBytecode.Object != "" {
+				item.MetadataMap = []MetadataMap{{
+					DeployedBytecode: item.Evm.DeployedBytecode,
+					Metadata: Metadata{
+						ContractName:    contractname,
+						SourceFile:      filename,
+						SourceHash:      sourceHash,
+						CompilerVersion: output.Compiler,
+						Abi:             item.Abi,
+					},
+				}}
+			}
+			respItemArray = append(respItemArray, ResponseItem{
+				Filename:   filename,
+				Objectname: objectName(contractname),
+				Contract:   item,
+			})
+		}
+	}
+
+	warnings := ""
+	errors := ""
+	for _, msg := range output.Errors {
+		message := msg.FormattedMessage
+		if message == "" {
+			message = msg.Message
+		}
+		if msg.Severity == "warning" || msg.Type == "Warning" {
+			warnings += message
+		} else {
+			errors += message
+		}
+	}
+
+	for _, re := range respItemArray {
+		logger.TraceMsg("Response formulated",
+			"name", re.Objectname,
+			"bin", re.Contract.Code(),
+			"abi", string(re.Contract.Abi))
+	}
+
+	return &Response{
+		Objects: respItemArray,
+		Warning: warnings,
+		Error:   errors,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package compile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const vyperOutput = `{
+  "compiler": "vyper-0.3.7",
+  "contracts": {
+    "storage.vy": {
+      "storage": {
+        "abi": [{"type": "function", "name": "get", "stateMutability": "view", "inputs": [],
+          "outputs": [{"name": "", "type": "uint256"}]}],
+        "evm": {
+          "bytecode": {"object": "0x6100036100", "opcodes": ""},
+          "deployedBytecode": {"object": "0x600160005260206000f3", "opcodes": ""}
+        }
+      }
+    }
+  },
+  "errors": [{"type": "Warning", "severity": "warning", "message": "unused variable"}]
+}`
+
+func TestVyper(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script in place of vyper-json")
+	}
+	dir, err := ioutil.TempDir("", "vyper")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	source := "@external\n@view\ndef get() -> uint256:\n    return 1\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "storage.vy"), []byte(source), 0644))
+	// Records its input and answers with canned output
+	vyper := filepath.Join(dir, "vyper-json")
+	script := "#!/bin/sh\ncat > input.json\ncat <<'EOF'\n" + vyperOutput + "\nEOF\n"
+	require.NoError(t, ioutil.WriteFile(vyper, []byte(script), 0755))
+
+	assert.True(t, IsVyper("storage.vy"))
+	assert.False(t, IsVyper("storage.sol"))
+
+	resp, err := Vyper("storage.vy", dir, vyper, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	bs, err := ioutil.ReadFile(filepath.Join(dir, "input.json"))
+	require.NoError(t, err)
+	input := VyperInput{}
+	require.NoError(t, json.Unmarshal(bs, &input))
+	assert.Equal(t, "Vyper", input.Language)
+	assert.Equal(t, source, input.Sources["storage.vy"].Content)
+
+	assert.Equal(t, "", resp.Error)
+	assert.Equal(t, "unused variable", resp.Warning)
+	require.Len(t, resp.Objects, 1)
+	item := resp.Objects[0]
+	assert.Equal(t, "storage.vy", item.Filename)
+	assert.Equal(t, "storage", item.Objectname)
+	assert.Equal(t, "6100036100", item.Contract.Code())
+	assert.Equal(t, "600160005260206000f3", item.Contract.Evm.DeployedBytecode.Object)
+
+	meta, err := item.Contract.GetMetadata(logging.NewNoopLogger())
+	require.NoError(t, err)
+	require.Len(t, meta, 1)
+	for _, m := range meta {
+		metadata := Metadata{}
+		require.NoError(t, json.Unmarshal([]byte(m), &metadata))
+		assert.Equal(t, "storage", metadata.ContractName)
+		assert.Equal(t, "vyper-0.3.7", metadata.CompilerVersion)
+		assert.JSONEq(t, string(item.Contract.Abi), string(metadata.Abi))
+	}
+}
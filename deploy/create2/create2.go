@@ -0,0 +1,53 @@
+// Package create2 provides a factory contract that creates contracts with CREATE2, so that the address of a contract
+// depends on the factory and a salt rather than on the account and transaction that deployed it. Deploying the same
+// code with the same salt through factories at the same address on different chains gives it the same address on each.
+// The factory is assembled here rather than compiled so that deploying one does not need solc.
+package create2
+
+import (
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+)
+
+// The name the factory is saved and reported under
+const ContractName = "Create2Factory"
+
+var (
+	// The code of a deployed factory. It is called with a 32 byte salt followed by the init code of the contract to
+	// create, and returns the address of the contract as a word, or reverts with whatever the init code reverted with.
+	Runtime = bc.MustSplice(
+		// Copy the init code to memory and create the contract, sending it any value sent with the call
+		PUSH1, 32, CALLDATASIZE, SUB,
+		DUP1, PUSH1, 32, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 0, CALLDATALOAD, SWAP1, PUSH1, 0, CALLVALUE, CREATE2,
+		DUP1, ISZERO, PUSH1, 31, JUMPI,
+		PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN,
+		// 31: creation failed
+		JUMPDEST,
+		RETURNDATASIZE, PUSH1, 0, DUP1, RETURNDATACOPY,
+		RETURNDATASIZE, PUSH1, 0, REVERT)
+
+	// The code that deploys the factory
+	Code = bc.MustSplice(
+		PUSH1, len(Runtime), DUP1, PUSH1, 11, PUSH1, 0, CODECOPY, PUSH1, 0, RETURN,
+		Runtime)
+)
+
+// Salt mixes salt with the hash of the init code of a contract, since Burrow's CREATE2 derives the address of the
+// contract it creates from the code of the creating contract rather than the code it creates. So that each contract
+// has its own address for a salt, the factory is called with this salt.
+func Salt(salt string, initCode []byte) binary.Word256 {
+	return binary.LeftPadWord256(crypto.Keccak256(append([]byte(salt), crypto.Keccak256(initCode)...)))
+}
+
+// Address is that of the contract created by the factory at factory when called with salt
+func Address(factory crypto.Address, salt binary.Word256) crypto.Address {
+	return crypto.NewContractAddress2(factory, salt, Runtime)
+}
+
+// Call returns the input with which to call the factory to create a contract with initCode and salt
+func Call(salt binary.Word256, initCode []byte) []byte {
+	return append(salt.Bytes(), initCode...)
+}
@@ -0,0 +1,70 @@
+package create2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactory(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	vm := evm.New(engine.Options{})
+	caller := engine.AddressFromName("caller")
+	factory := engine.AddressFromName("factory")
+	require.NoError(t, engine.CreateAccount(st, caller))
+	require.NoError(t, engine.CreateAccount(st, factory))
+
+	run := func(callee crypto.Address, code, input []byte) ([]byte, error) {
+		return vm.Execute(st, new(engine.TestBlockchain), new(exec.Events), engine.CallParams{
+			Caller: caller,
+			Callee: callee,
+			Input:  input,
+			Gas:    big.NewInt(1000000),
+		}, code)
+	}
+
+	runtime, err := run(factory, Code, nil)
+	require.NoError(t, err)
+	assert.Equal(t, Runtime, runtime)
+	require.NoError(t, engine.InitEVMCode(st, factory, runtime))
+
+	// Deploys code that returns 42
+	returns42 := bc.MustSplice(PUSH1, 42, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	initCode := bc.MustSplice(PUSH1, len(returns42), DUP1, PUSH1, 11, PUSH1, 0, CODECOPY, PUSH1, 0, RETURN,
+		returns42)
+
+	salt := Salt("v1", initCode)
+	output, err := run(factory, runtime, Call(salt, initCode))
+	require.NoError(t, err)
+	address := Address(factory, salt)
+	assert.Equal(t, address, crypto.AddressFromWord256(binary.LeftPadWord256(output)))
+
+	acc, err := st.GetAccount(address)
+	require.NoError(t, err)
+	require.NotNil(t, acc)
+	assert.Equal(t, returns42, []byte(acc.EVMCode))
+
+	// The same code cannot be created again with the same salt
+	_, err = run(factory, runtime, Call(salt, initCode))
+	require.Error(t, err)
+
+	// Other code, or another salt, has another address
+	assert.NotEqual(t, address, Address(factory, Salt("v1", returns42)))
+	assert.NotEqual(t, address, Address(factory, Salt("v2", initCode)))
+
+	// Reverts with whatever the init code reverted with
+	reverts := bc.MustSplice(PUSH2, 0xde, 0xad, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, REVERT)
+	output, err = run(factory, runtime, Call(Salt("v1", reverts), reverts))
+	require.Error(t, err)
+	assert.Equal(t, binary.Int64ToWord256(0xdead).Bytes(), output)
+}
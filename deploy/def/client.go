@@ -6,10 +6,11 @@ import (
 	"io"
 	"reflect"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/hyperledger/burrow/encoding"
-	"github.com/tendermint/tendermint/p2p"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
@@ -44,6 +45,16 @@ type Client struct {
 	executionEventsClient rpcevents.ExecutionEventsClient
 	keyClient             keys.KeyClient
 	AllSpecs              *abi.Spec
+	// Simulate transactions against the current state rather than broadcasting them
+	Simulate bool
+	// Code of the contracts deployed in simulation by address and the number deployed
+	simulatedCode    map[crypto.Address][]byte
+	simulatedDeploys uint64
+	// The client this one was derived from with its own timeout, which holds the AllSpecs and simulated contracts
+	// they share
+	root *Client
+	// Jobs may run concurrently so guard the memoised clients, AllSpecs, and simulated contracts
+	mtx sync.RWMutex
 }
 
 func NewClient(chain, keysClientAddress string, mempoolSigning bool, timeout time.Duration) *Client {
@@ -58,6 +69,8 @@ func NewClient(chain, keysClientAddress string, mempoolSigning bool, timeout tim
 
 // Connect GRPC clients using ChainURL
 func (c *Client) dial(logger *logging.Logger) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
 	if c.transactClient == nil {
 		conn, err := encoding.GRPCDial(c.ChainAddress)
 		if err != nil {
@@ -124,6 +137,69 @@ func (c *Client) Status(logger *logging.Logger) (*rpc.ResultStatus, error) {
 	return c.queryClient.Status(ctx, &rpcquery.StatusParam{})
 }
 
+// WithTimeout returns a client that shares the connections, AllSpecs, and simulated contracts of c, but waits up to
+// timeout for each request it makes
+func (c *Client) WithTimeout(timeout time.Duration, logger *logging.Logger) (*Client, error) {
+	c = c.shared()
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return &Client{
+		MempoolSigning:        c.MempoolSigning,
+		ChainAddress:          c.ChainAddress,
+		KeysClientAddress:     c.KeysClientAddress,
+		chainID:               c.chainID,
+		timeout:               timeout,
+		transactClient:        c.transactClient,
+		queryClient:           c.queryClient,
+		executionEventsClient: c.executionEventsClient,
+		keyClient:             c.keyClient,
+		Simulate:              c.Simulate,
+		root:                  c,
+	}, nil
+}
+
+// OnChain returns a client of another chain that signs with the same keys and waits as long as c, and simulates
+// transactions if c does. It shares nothing else with c.
+func (c *Client) OnChain(chain string) *Client {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	client := NewClient(chain, c.KeysClientAddress, c.MempoolSigning, c.timeout)
+	client.Simulate = c.Simulate
+	return client
+}
+
+// The client holding the state shared with the clients derived from it
+func (c *Client) shared() *Client {
+	if c.root != nil {
+		return c.root
+	}
+	return c
+}
+
+// MergeSpec adds the functions and events of spec to AllSpecs
+func (c *Client) MergeSpec(spec *abi.Spec) {
+	c = c.shared()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.AllSpecs = abi.MergeSpec([]*abi.Spec{c.AllSpecs, spec})
+}
+
+// EventSpec looks up the event with id in AllSpecs
+func (c *Client) EventSpec(id abi.EventID) (*abi.EventSpec, bool) {
+	c = c.shared()
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if c.AllSpecs == nil {
+		return nil, false
+	}
+	spec, ok := c.AllSpecs.EventsByID[id]
+	return spec, ok
+}
+
 func (c *Client) ParseAddress(key string, logger *logging.Logger) (crypto.Address, error) {
 	address, err := crypto.AddressFromHexString(key)
 	if err == nil {
@@ -222,11 +298,59 @@ func (c *Client) ListProposals(proposed bool, logger *logging.Logger) ([]*rpcque
 	return nil, err
 }
 
+// ListAccountsByCodeHash returns the accounts whose code has codeHash
+func (c *Client) ListAccountsByCodeHash(codeHash []byte, logger *logging.Logger) ([]*acm.Account, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.queryClient.ListAccounts(context.Background(), &rpcquery.ListAccountsParam{CodeHash: codeHash})
+	if err != nil {
+		return nil, err
+	}
+	var accounts []*acm.Account
+	acc, err := stream.Recv()
+	for err == nil {
+		accounts = append(accounts, acc)
+		acc, err = stream.Recv()
+	}
+	if err == io.EOF {
+		return accounts, nil
+	}
+	return nil, err
+}
+
+// ListContracts returns the metadata of the contracts deployed with the name contractName
+func (c *Client) ListContracts(contractName string, logger *logging.Logger) ([]*rpcquery.ContractMetadata, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.queryClient.ListContracts(context.Background(),
+		&rpcquery.ListContractsParam{ContractName: contractName})
+	if err != nil {
+		return nil, err
+	}
+	var contracts []*rpcquery.ContractMetadata
+	contract, err := stream.Recv()
+	for err == nil {
+		contracts = append(contracts, contract)
+		contract, err = stream.Recv()
+	}
+	if err == io.EOF {
+		return contracts, nil
+	}
+	return nil, err
+}
+
 func (c *Client) SignAndBroadcast(tx payload.Payload, logger *logging.Logger) (*exec.TxExecution, error) {
 	err := c.dial(logger)
 	if err != nil {
 		return nil, err
 	}
+	if c.Simulate {
+		return c.SimulateTx(tx, logger)
+	}
 	txEnv, err := c.SignTx(tx, logger)
 	if err != nil {
 		return nil, err
@@ -306,6 +430,28 @@ func (c *Client) BroadcastEnvelope(txEnv *txs.Envelope, logger *logging.Logger)
 	return unifyErrors(c.transactClient.BroadcastTxSync(ctx, &rpctransact.TxEnvelopeParam{Envelope: txEnv}))
 }
 
+// SnapshotState records the state of the chain under name, which must be running without consensus
+func (c *Client) SnapshotState(name string, logger *logging.Logger) (*rpctransact.StateSnapshot, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return c.transactClient.SnapshotState(ctx, &rpctransact.StateSnapshotParam{Name: name})
+}
+
+// RestoreState returns the chain to the state recorded under name by SnapshotState
+func (c *Client) RestoreState(name string, logger *logging.Logger) (*rpctransact.StateSnapshot, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return c.transactClient.RestoreState(ctx, &rpctransact.StateSnapshotParam{Name: name})
+}
+
 func (c *Client) ParseUint64(amount string) (uint64, error) {
 	if amount == "" {
 		return 0, nil
@@ -330,8 +476,67 @@ func (c *Client) QueryContract(arg *QueryArg, logger *logging.Logger) (*exec.TxE
 	if err != nil {
 		return nil, err
 	}
+	return c.callSim(tx)
+}
+
+// SimulateTx runs a call or deploy against the current state without broadcasting it. Contracts deployed in
+// simulation are given an address derived from the input and the number deployed so far, and are called by running
+// the code their constructor returned. Since each simulation starts from the current state, the storage written by
+// earlier simulated transactions is not seen, and a contract deployed in simulation runs at the address of its caller.
+func (c *Client) SimulateTx(tx payload.Payload, logger *logging.Logger) (*exec.TxExecution, error) {
+	callTx, ok := tx.(*payload.CallTx)
+	if !ok {
+		return nil, fmt.Errorf("only calls and deploys can be simulated, not %v transactions", tx.Type())
+	}
+	if callTx.Address != nil {
+		logger.InfoMsg("Simulating call", "destination", callTx.Address.String())
+		return c.callSim(callTx)
+	}
+	logger.InfoMsg("Simulating deploy")
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	// The constructor runs with the contract's init code and arguments as its code
+	txe, err := unifyErrors(c.transactClient.CallCodeSim(ctx, &rpctransact.CallCodeParam{
+		FromAddress: callTx.Input.Address,
+		Code:        callTx.Data,
+	}))
+	if err != nil {
+		return txe, err
+	}
+	shared := c.shared()
+	shared.mtx.Lock()
+	defer shared.mtx.Unlock()
+	shared.simulatedDeploys++
+	address := crypto.NewContractAddress(callTx.Input.Address, binary.Uint64ToWord256(shared.simulatedDeploys).Bytes())
+	if shared.simulatedCode == nil {
+		shared.simulatedCode = make(map[crypto.Address][]byte)
+	}
+	shared.simulatedCode[address] = txe.GetResult().GetReturn()
+	txe.Receipt = &txs.Receipt{
+		TxType:          payload.TypeCall,
+		TxHash:          txe.TxHash,
+		CreatesContract: true,
+		ContractAddress: address,
+	}
+	return txe, nil
+}
+
+// Simulates tx against the current state, running the code returned by the constructor of a contract deployed in
+// simulation when it is the destination
+func (c *Client) callSim(tx *payload.CallTx) (*exec.TxExecution, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
+	shared := c.shared()
+	shared.mtx.RLock()
+	code, ok := shared.simulatedCode[*tx.Address]
+	shared.mtx.RUnlock()
+	if ok {
+		return unifyErrors(c.transactClient.CallCodeSim(ctx, &rpctransact.CallCodeParam{
+			FromAddress: tx.Input.Address,
+			Code:        code,
+			Data:        tx.Data,
+		}))
+	}
 	return unifyErrors(c.transactClient.CallTxSim(ctx, tx))
 }
 
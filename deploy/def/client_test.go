@@ -1,10 +1,19 @@
 package def
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 func TestArgMap(t *testing.T) {
@@ -15,3 +24,72 @@ func TestArgMap(t *testing.T) {
 	assert.Equal(t, "fooo", mp["Address"])
 	assert.Len(t, mp, 8)
 }
+
+func TestSimulateTx(t *testing.T) {
+	transact := &simTransactClient{}
+	client := &Client{Simulate: true, transactClient: transact}
+	logger := logging.NewNoopLogger()
+	input := &payload.TxInput{Address: crypto.Address{1}}
+
+	// Deploys run the init code and remember the code it returns
+	txe, err := client.SimulateTx(&payload.CallTx{Input: input, Data: []byte("init")}, logger)
+	require.NoError(t, err)
+	assert.True(t, txe.Receipt.CreatesContract)
+	contract := txe.Receipt.ContractAddress
+	assert.Equal(t, []byte("init"), transact.code)
+
+	txe, err = client.SimulateTx(&payload.CallTx{Input: input, Data: []byte("init")}, logger)
+	require.NoError(t, err)
+	assert.NotEqual(t, contract, txe.Receipt.ContractAddress, "each deploy should get its own address")
+
+	// Contracts deployed in simulation are called with that code
+	_, err = client.SimulateTx(&payload.CallTx{Input: input, Address: &contract, Data: []byte("call")}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("code:init"), transact.code)
+	assert.Equal(t, []byte("call"), transact.data)
+
+	// Other contracts are called as they are
+	other := crypto.Address{2}
+	_, err = client.SimulateTx(&payload.CallTx{Input: input, Address: &other, Data: []byte("other")}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("other"), transact.data)
+	assert.Equal(t, 1, transact.calls)
+
+	// Clients with their own timeout share the contracts deployed in simulation
+	derived, err := client.WithTimeout(time.Minute, logger)
+	require.NoError(t, err)
+	_, err = derived.SimulateTx(&payload.CallTx{Input: input, Address: &contract, Data: []byte("derived")}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("code:init"), transact.code)
+	txe, err = derived.SimulateTx(&payload.CallTx{Input: input, Data: []byte("derived")}, logger)
+	require.NoError(t, err)
+	_, err = client.SimulateTx(&payload.CallTx{Input: input, Address: &txe.Receipt.ContractAddress}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("code:derived"), transact.code)
+
+	_, err = client.SimulateTx(&payload.SendTx{}, logger)
+	require.Error(t, err)
+}
+
+type simTransactClient struct {
+	rpctransact.TransactClient
+	code  []byte
+	data  []byte
+	calls int
+}
+
+func (tc *simTransactClient) CallCodeSim(ctx context.Context, in *rpctransact.CallCodeParam,
+	opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	tc.code, tc.data = in.Code, in.Data
+	return &exec.TxExecution{
+		TxHeader: &exec.TxHeader{},
+		Result:   &exec.Result{Return: append([]byte("code:"), in.Code...)},
+	}, nil
+}
+
+func (tc *simTransactClient) CallTxSim(ctx context.Context, in *payload.CallTx,
+	opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	tc.data = in.Data
+	tc.calls++
+	return &exec.TxExecution{TxHeader: &exec.TxHeader{}}, nil
+}
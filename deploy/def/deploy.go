@@ -29,6 +29,13 @@ type DeployArgs struct {
 	ProposeVerify bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeVote   bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeCreate bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	Solc          string   `mapstructure:"," json:"," yaml:"," toml:","`
+	SolcCache     string   `mapstructure:"," json:"," yaml:"," toml:","`
+	SourceCache   string   `mapstructure:"," json:"," yaml:"," toml:","`
+	IPFSGateway   string   `mapstructure:"," json:"," yaml:"," toml:","`
+	GasReport     string   `mapstructure:"," json:"," yaml:"," toml:","`
+	Manifest      string   `mapstructure:"," json:"," yaml:"," toml:","`
+	Simulate      bool     `mapstructure:"," json:"," yaml:"," toml:","`
 }
 
 func (args *DeployArgs) Validate() error {
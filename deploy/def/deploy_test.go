@@ -2,8 +2,11 @@ package def
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,3 +54,42 @@ func TestPackage_Validate(t *testing.T) {
 	err = pkgs.Validate()
 	require.NoError(t, err)
 }
+
+func TestPlaybook_OnTarget(t *testing.T) {
+	parent := &Playbook{
+		Account:  "parent",
+		Manifest: NewManifest("deploy.yaml"),
+		Targets: map[string]*Target{
+			"sidechain": {Chain: "127.0.0.1:20997", Address: "bridger"},
+			"mainnet":   {Chain: "127.0.0.1:30997"},
+		},
+	}
+	require.NoError(t, parent.Validate())
+	playbook := &Playbook{Account: "child", Parent: parent}
+	client := NewClient("127.0.0.1:10997", "127.0.0.1:10998", false, time.Second)
+
+	onTarget, targetClient, err := playbook.OnTarget("sidechain", client)
+	require.NoError(t, err)
+	assert.Equal(t, "bridger", onTarget.Account)
+	assert.Equal(t, "child", playbook.Account)
+	assert.Equal(t, "127.0.0.1:20997", targetClient.ChainAddress)
+	assert.Equal(t, client.KeysClientAddress, targetClient.KeysClientAddress)
+	// The client of a target is made once
+	_, again, err := parent.OnTarget("sidechain", client)
+	require.NoError(t, err)
+	assert.True(t, targetClient == again)
+
+	// A target without an address keeps the account of the playbook
+	onTarget, _, err = playbook.OnTarget("mainnet", client)
+	require.NoError(t, err)
+	assert.Equal(t, "child", onTarget.Account)
+	onTarget.JobManifest("bridge").Deployed("Bridge", crypto.Address{1},
+		&exec.TxExecution{TxHeader: &exec.TxHeader{TxHash: []byte{0xAB}, Height: 3}}, nil, nil)
+	assert.Equal(t, "mainnet", parent.Manifest.Contracts()[0].Target)
+
+	_, _, err = playbook.OnTarget("testnet", client)
+	require.Error(t, err)
+
+	parent.Targets["broken"] = &Target{}
+	require.Error(t, parent.Validate())
+}
@@ -0,0 +1,195 @@
+package def
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The function of a GasUsage that deployed a contract
+const ConstructorFunction = "constructor"
+
+// GasUsage is the gas used by a transaction a job sent to a contract
+type GasUsage struct {
+	Job      string `json:"job"`
+	Contract string `json:"contract"`
+	Address  string `json:"address"`
+	// The function called, or constructor for a deploy
+	Function string `json:"function"`
+	GasUsed  uint64 `json:"gasUsed"`
+}
+
+// FunctionGas totals the gas used by the transactions a playbook sent to one function of a contract
+type FunctionGas struct {
+	Contract string `json:"contract"`
+	Function string `json:"function"`
+	Calls    int    `json:"calls"`
+	Min      uint64 `json:"min"`
+	Max      uint64 `json:"max"`
+	Average  uint64 `json:"average"`
+	Total    uint64 `json:"total"`
+}
+
+// GasReport collects the gas used by the transactions the jobs of a playbook, and any playbooks it runs, send to
+// contracts. Jobs may run concurrently so it is safe to record from several goroutines.
+type GasReport struct {
+	Playbook string
+	mtx      sync.Mutex
+	usages   []GasUsage
+	// Names of the contracts deployed so calls to them can be reported by name
+	contracts map[crypto.Address]string
+}
+
+func NewGasReport(playbook string) *GasReport {
+	return &GasReport{
+		Playbook:  playbook,
+		contracts: make(map[crypto.Address]string),
+	}
+}
+
+// Job returns a JobGas that records the gas used by job in report, which may be nil to record nothing
+func (report *GasReport) Job(job string) *JobGas {
+	if report == nil {
+		return nil
+	}
+	return &JobGas{report: report, job: job}
+}
+
+// Usages returns the gas used by each transaction in the order they were recorded
+func (report *GasReport) Usages() []GasUsage {
+	report.mtx.Lock()
+	defer report.mtx.Unlock()
+	usages := make([]GasUsage, len(report.usages))
+	copy(usages, report.usages)
+	return usages
+}
+
+// Functions returns the gas used by each contract function ordered by contract then function
+func (report *GasReport) Functions() []FunctionGas {
+	byFunction := make(map[[2]string]*FunctionGas)
+	var functions []*FunctionGas
+	for _, usage := range report.Usages() {
+		contract := usage.Contract
+		if contract == "" {
+			contract = usage.Address
+		}
+		key := [2]string{contract, usage.Function}
+		fg, ok := byFunction[key]
+		if !ok {
+			fg = &FunctionGas{Contract: contract, Function: usage.Function, Min: usage.GasUsed}
+			byFunction[key] = fg
+			functions = append(functions, fg)
+		}
+		fg.Calls++
+		fg.Total += usage.GasUsed
+		if usage.GasUsed < fg.Min {
+			fg.Min = usage.GasUsed
+		}
+		if usage.GasUsed > fg.Max {
+			fg.Max = usage.GasUsed
+		}
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		if functions[i].Contract != functions[j].Contract {
+			return functions[i].Contract < functions[j].Contract
+		}
+		return functions[i].Function < functions[j].Function
+	})
+	result := make([]FunctionGas, len(functions))
+	for i, fg := range functions {
+		fg.Average = fg.Total / uint64(fg.Calls)
+		result[i] = *fg
+	}
+	return result
+}
+
+// Total returns the gas used by all the transactions
+func (report *GasReport) Total() uint64 {
+	var total uint64
+	for _, usage := range report.Usages() {
+		total += usage.GasUsed
+	}
+	return total
+}
+
+func (report *GasReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Playbook  string        `json:"playbook"`
+		Jobs      []GasUsage    `json:"jobs"`
+		Functions []FunctionGas `json:"functions"`
+		Total     uint64        `json:"total"`
+	}{
+		Playbook:  report.Playbook,
+		Jobs:      report.Usages(),
+		Functions: report.Functions(),
+		Total:     report.Total(),
+	})
+}
+
+// WriteTable writes the gas used by each job and by each contract function as aligned columns
+func (report *GasReport) WriteTable(w io.Writer) error {
+	// Lines without tabs end a block of aligned columns
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Gas used by %s\n", report.Playbook)
+	fmt.Fprintf(tw, "Job\tContract\tFunction\tGas\n")
+	for _, usage := range report.Usages() {
+		contract := usage.Contract
+		if contract == "" {
+			contract = usage.Address
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\n", usage.Job, contract, usage.Function, usage.GasUsed)
+	}
+	fmt.Fprintf(tw, "\n")
+	fmt.Fprintf(tw, "Contract\tFunction\tCalls\tMin\tMax\tAverage\tTotal\n")
+	for _, fg := range report.Functions() {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%d\n", fg.Contract, fg.Function, fg.Calls, fg.Min, fg.Max,
+			fg.Average, fg.Total)
+	}
+	fmt.Fprintf(tw, "Total\t\t\t\t\t\t%d\n", report.Total())
+	return tw.Flush()
+}
+
+// JobGas records the gas used by the transactions of one job
+type JobGas struct {
+	report *GasReport
+	job    string
+}
+
+// Deployed records the gas used to deploy the contract named contract at address
+func (jg *JobGas) Deployed(contract string, address crypto.Address, gasUsed uint64) {
+	if jg == nil {
+		return
+	}
+	jg.report.mtx.Lock()
+	defer jg.report.mtx.Unlock()
+	jg.report.contracts[address] = contract
+	jg.report.usages = append(jg.report.usages, GasUsage{
+		Job:      jg.job,
+		Contract: contract,
+		Address:  address.String(),
+		Function: ConstructorFunction,
+		GasUsed:  gasUsed,
+	})
+}
+
+// Called records the gas used by a call to function of the contract at address, which is named if it was deployed
+// by the playbook
+func (jg *JobGas) Called(address crypto.Address, function string, gasUsed uint64) {
+	if jg == nil {
+		return
+	}
+	jg.report.mtx.Lock()
+	defer jg.report.mtx.Unlock()
+	jg.report.usages = append(jg.report.usages, GasUsage{
+		Job:      jg.job,
+		Contract: jg.report.contracts[address],
+		Address:  address.String(),
+		Function: function,
+		GasUsed:  gasUsed,
+	})
+}
@@ -0,0 +1,59 @@
+package def
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasReport(t *testing.T) {
+	parent := &Playbook{GasReport: NewGasReport("deploy.yaml")}
+	meta := &Playbook{Parent: parent}
+	token := crypto.Address{1}
+	other := crypto.Address{2}
+
+	parent.JobGas("deployToken").Deployed("Token", token, 300)
+	parent.JobGas("mint1").Called(token, "mint", 50)
+	meta.JobGas("mint2").Called(token, "mint", 70)
+	parent.JobGas("poke").Called(other, "poke", 10)
+	// Playbooks without a report record nothing
+	(&Playbook{}).JobGas("lost").Called(token, "mint", 1000)
+
+	report := parent.GasReport
+	assert.Equal(t, []GasUsage{
+		{Job: "deployToken", Contract: "Token", Address: token.String(), Function: ConstructorFunction, GasUsed: 300},
+		{Job: "mint1", Contract: "Token", Address: token.String(), Function: "mint", GasUsed: 50},
+		{Job: "mint2", Contract: "Token", Address: token.String(), Function: "mint", GasUsed: 70},
+		{Job: "poke", Address: other.String(), Function: "poke", GasUsed: 10},
+	}, report.Usages())
+	assert.Equal(t, []FunctionGas{
+		{Contract: other.String(), Function: "poke", Calls: 1, Min: 10, Max: 10, Average: 10, Total: 10},
+		{Contract: "Token", Function: ConstructorFunction, Calls: 1, Min: 300, Max: 300, Average: 300, Total: 300},
+		{Contract: "Token", Function: "mint", Calls: 2, Min: 50, Max: 70, Average: 60, Total: 120},
+	}, report.Functions())
+	assert.Equal(t, uint64(430), report.Total())
+
+	bs, err := json.Marshal(report)
+	require.NoError(t, err)
+	var decoded struct {
+		Playbook  string
+		Jobs      []GasUsage
+		Functions []FunctionGas
+		Total     uint64
+	}
+	require.NoError(t, json.Unmarshal(bs, &decoded))
+	assert.Equal(t, "deploy.yaml", decoded.Playbook)
+	assert.Equal(t, report.Usages(), decoded.Jobs)
+	assert.Equal(t, report.Functions(), decoded.Functions)
+	assert.Equal(t, uint64(430), decoded.Total)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, report.WriteTable(buf))
+	assert.Contains(t, buf.String(), "Gas used by deploy.yaml\n")
+	assert.Regexp(t, `Token +mint +2 +50 +70 +60 +120\n`, buf.String())
+	assert.Regexp(t, `Total +430\n$`, buf.String())
+}
@@ -17,20 +17,39 @@ import (
 type Job struct {
 	// Name of the job
 	Name string `mapstructure:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" toml:"name"`
+	// The playbook target to run the job against, if not the chain the deploy was run against
+	Target string `mapstructure:"target,omitempty" json:"target,omitempty" yaml:"target,omitempty" toml:"target"`
 	// Not marshalled
 	Intermediate interface{} `json:"-" yaml:"-" toml:"-"`
 	// Not marshalled
 	Result interface{} `json:"-" yaml:"-" toml:"-"`
 	// For multiple values
 	Variables []*abi.Variable `json:"-" yaml:"-" toml:"-"`
+	// How long to wait for each request the job makes to the chain, such as 30s, overriding --timeout
+	Timeout string `mapstructure:"timeout,omitempty" json:"timeout,omitempty" yaml:"timeout,omitempty" toml:"timeout"`
+	// How many times to retry the job should it fail other than by a transaction failing to execute
+	Retries int `mapstructure:"retries,omitempty" json:"retries,omitempty" yaml:"retries,omitempty" toml:"retries"`
+	// How long to wait before the first retry, doubling for each retry after, by default 1s
+	Backoff string `mapstructure:"backoff,omitempty" json:"backoff,omitempty" yaml:"backoff,omitempty" toml:"backoff"`
+	// What to do should the job fail: stop (the default) to stop the playbook, continue to run the rest of the
+	// playbook, or rollback to run the rollback jobs of the jobs that succeeded before it and stop
+	OnFailure string `mapstructure:"on-failure,omitempty" json:"on-failure,omitempty" yaml:"on-failure,omitempty" toml:"on-failure"`
+	// Jobs that undo this one, run should a later job with an on-failure of rollback fail
+	Rollback []*Job `mapstructure:"rollback,omitempty" json:"rollback,omitempty" yaml:"rollback,omitempty" toml:"rollback"`
 	// Create proposal or vote for one
 	Proposal *Proposal `mapstructure:"proposal,omitempty" json:"proposal,omitempty" yaml:"proposal,omitempty" toml:"proposal"`
 	// Sets/Resets the primary account to use
 	Account *Account `mapstructure:"account,omitempty" json:"account,omitempty" yaml:"account,omitempty" toml:"account"`
 	// Set an arbitrary value
 	Set *Set `mapstructure:"set,omitempty" json:"set,omitempty" yaml:"set,omitempty" toml:"set"`
+	// Compute a value with a script
+	Script *Script `mapstructure:"script,omitempty" json:"script,omitempty" yaml:"script,omitempty" toml:"script"`
 	// Run a sequence of other deploy.yamls
 	Meta *Meta `mapstructure:"meta,omitempty" json:"meta,omitempty" yaml:"meta,omitempty" toml:"meta"`
+	// Run other jobs once for each of a list of values
+	ForEach *ForEach `mapstructure:"for-each,omitempty" json:"for-each,omitempty" yaml:"for-each,omitempty" toml:"for-each"`
+	// Run some jobs or others depending on the results of earlier jobs
+	If *If `mapstructure:"if,omitempty" json:"if,omitempty" yaml:"if,omitempty" toml:"if"`
 	// Issue a governance transaction
 	UpdateAccount *UpdateAccount `mapstructure:"update-account,omitempty" json:"update-account,omitempty" yaml:"update-account,omitempty" toml:"update-account"`
 	// Contract compile and send to the chain functions
@@ -52,10 +71,18 @@ type Job struct {
 	Permission *Permission `mapstructure:"permission,omitempty" json:"permission,omitempty" yaml:"permission,omitempty" toml:"permission"`
 	// Sends a transaction to a contract. Will utilize monax-abi under the hood to perform all of the heavy lifting
 	Call *Call `mapstructure:"call,omitempty" json:"call,omitempty" yaml:"call,omitempty" toml:"call"`
+	// Deploys a proxy to an implementation contract
+	Proxy *Proxy `mapstructure:"proxy,omitempty" json:"proxy,omitempty" yaml:"proxy,omitempty" toml:"proxy"`
+	// Switches a proxy to a new implementation contract
+	Upgrade *Upgrade `mapstructure:"upgrade,omitempty" json:"upgrade,omitempty" yaml:"upgrade,omitempty" toml:"upgrade"`
 	// Wrapper for mintdump dump. WIP
 	DumpState *DumpState `mapstructure:"dump-state,omitempty" json:"dump-state,omitempty" yaml:"dump-state,omitempty" toml:"dump-state"`
 	// Wrapper for mintdum restore. WIP
 	RestoreState *RestoreState `mapstructure:"restore-state,omitempty" json:"restore-state,omitempty" yaml:"restore-state,omitempty" toml:"restore-state"`
+	// Records the state of a chain running without consensus under a name
+	Snapshot *Snapshot `mapstructure:"snapshot,omitempty" json:"snapshot,omitempty" yaml:"snapshot,omitempty" toml:"snapshot"`
+	// Returns a chain running without consensus to the state recorded by a snapshot job
+	RestoreSnapshot *RestoreSnapshot `mapstructure:"restore-snapshot,omitempty" json:"restore-snapshot,omitempty" yaml:"restore-snapshot,omitempty" toml:"restore-snapshot"`
 	// Sends a "simulated call,omitempty" to a contract. Predominantly used for accessor functions ("Getters,omitempty" within contracts)
 	QueryContract *QueryContract `mapstructure:"query-contract,omitempty" json:"query-contract,omitempty" yaml:"query-contract,omitempty" toml:"query-contract"`
 	// Queries information from an account.
@@ -66,8 +93,17 @@ type Job struct {
 	QueryVals *QueryVals `mapstructure:"query-vals,omitempty" json:"query-vals,omitempty" yaml:"query-vals,omitempty" toml:"query-vals"`
 	// Makes and assertion (useful for testing purposes)
 	Assert *Assert `mapstructure:"assert,omitempty" json:"assert,omitempty" yaml:"assert,omitempty" toml:"assert"`
+	// Asserts that an earlier call emitted an event
+	AssertEvent *AssertEvent `mapstructure:"assert-event,omitempty" json:"assert-event,omitempty" yaml:"assert-event,omitempty" toml:"assert-event"`
 }
 
+// What to do should a job fail
+const (
+	OnFailureStop     = "stop"
+	OnFailureContinue = "continue"
+	OnFailureRollback = "rollback"
+)
+
 type Payload interface {
 	validation.Validatable
 }
@@ -82,10 +118,31 @@ func (job *Job) Validate() error {
 			Error("must contain word characters; alphanumeric plus underscores/hyphens")),
 		validation.Field(&job.Result, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
 		validation.Field(&job.Variables, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
+		validation.Field(&job.Timeout, rule.Duration),
+		validation.Field(&job.Retries, validation.Min(0)),
+		validation.Field(&job.Backoff, rule.Duration),
+		validation.Field(&job.OnFailure, validation.In(OnFailureStop, OnFailureContinue, OnFailureRollback)),
+		validation.Field(&job.Rollback),
 		validation.Field(payloadField.Addr().Interface()),
 	)
 }
 
+// Copy returns a copy of job with its own copy of the payload, so that the variables in the payload of the copy can be
+// replaced as it runs while those of job are left for running it again, as for the jobs of a for-each job
+func (job *Job) Copy() (*Job, error) {
+	cp := *job
+	cp.Result = nil
+	cp.Variables = nil
+	field, err := cp.PayloadField()
+	if err != nil {
+		return nil, err
+	}
+	payload := reflect.New(field.Type().Elem())
+	payload.Elem().Set(field.Elem())
+	field.Set(payload)
+	return &cp, nil
+}
+
 var payloadType = reflect.TypeOf((*Payload)(nil)).Elem()
 
 func (job *Job) Payload() (Payload, error) {
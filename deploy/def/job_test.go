@@ -40,4 +40,32 @@ func TestJob_Validate(t *testing.T) {
 	job.Account.Address = "blah"
 	err = job.Validate()
 	require.NoError(t, err)
+
+	job.Timeout = "30s"
+	job.Retries = 3
+	job.Backoff = "500ms"
+	job.OnFailure = OnFailureRollback
+	job.Rollback = []*Job{{Name: "undo", Account: &Account{Address: address.String()}}}
+	require.NoError(t, job.Validate())
+
+	job.Timeout = "30"
+	job.OnFailure = "retry"
+	job.Rollback[0].Name = ""
+	err = job.Validate()
+	require.Error(t, err)
+	assert.Len(t, strings.Split(err.Error(), ";"), 3, "Should have errors from timeout, on-failure, and rollback")
+}
+
+func TestJob_Copy(t *testing.T) {
+	job := &Job{
+		Name:   "mint",
+		Result: "done",
+		Call:   &Call{Destination: "$token", Function: "mint"},
+	}
+	cp, err := job.Copy()
+	require.NoError(t, err)
+	assert.Nil(t, cp.Result)
+	cp.Call.Destination = "F1E4"
+	assert.Equal(t, "$token", job.Call.Destination)
+	assert.Equal(t, "mint", cp.Call.Function)
 }
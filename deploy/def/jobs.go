@@ -7,7 +7,9 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 	"github.com/hyperledger/burrow/deploy/def/rule"
+	"github.com/hyperledger/burrow/deploy/script"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
 )
 
 // ------------------------------------------------------------------------
@@ -75,6 +77,76 @@ func (job *Meta) Validate() error {
 	)
 }
 
+// ------------------------------------------------------------------------
+// Control Jobs
+// ------------------------------------------------------------------------
+
+// The variable a for-each job binds each of its values to if it names none
+const DefaultForEachVariable = "item"
+
+// Runs a list of jobs once for each of a list of values, or each integer in a range, one after the other. The value of
+// each run can be referred to by the jobs as a variable.
+type ForEach struct {
+	// (Optional, if from and to set) the values to run the jobs for, which may be variables
+	Items []string `mapstructure:"items" json:"items" yaml:"items" toml:"items"`
+	// (Optional, if items set) the first integer of a range of values to run the jobs for
+	From string `mapstructure:"from" json:"from" yaml:"from" toml:"from"`
+	// (Optional, if items set) the last integer of a range of values to run the jobs for
+	To string `mapstructure:"to" json:"to" yaml:"to" toml:"to"`
+	// (Optional) the name of the variable the jobs refer to the value of each run by, by default item
+	As string `mapstructure:"as" json:"as" yaml:"as" toml:"as"`
+	// (Required) the jobs to run for each value
+	Jobs []*Job `mapstructure:"jobs" json:"jobs" yaml:"jobs" toml:"jobs"`
+}
+
+func (job *ForEach) Validate() error {
+	ranged := len(job.Items) == 0
+	return validation.ValidateStruct(job,
+		validation.Field(&job.From, rule.RequiredIf(ranged), rule.Int64OrPlaceholder),
+		validation.Field(&job.To, rule.RequiredIf(ranged), rule.Int64OrPlaceholder),
+		validation.Field(&job.As, validation.Match(regexp.MustCompile(`^[[:word:]]+$`)).
+			Error("must be a variable name of letters, digits, and underscores")),
+		validation.Field(&job.Jobs, validation.Required, nestableJobs),
+	)
+}
+
+// Runs one list of jobs or another depending on how two values, usually the results of earlier jobs, compare
+type If struct {
+	// (Required) the first value to compare
+	Key string `mapstructure:"key" json:"key" yaml:"key" toml:"key"`
+	// (Required) how to compare the values, as for an assert job
+	Relation string `mapstructure:"relation" json:"relation" yaml:"relation" toml:"relation"`
+	// (Required) the second value to compare
+	Value string `mapstructure:"val" json:"val" yaml:"val" toml:"val"`
+	// (Optional, if else set) the jobs to run should the relation hold
+	Then []*Job `mapstructure:"then" json:"then" yaml:"then" toml:"then"`
+	// (Optional, if then set) the jobs to run should it not
+	Else []*Job `mapstructure:"else" json:"else" yaml:"else" toml:"else"`
+}
+
+func (job *If) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Relation, validation.Required, rule.Relation),
+		validation.Field(&job.Then, rule.RequiredIf(len(job.Else) == 0), nestableJobs),
+		validation.Field(&job.Else, nestableJobs),
+	)
+}
+
+// Meta and proposal jobs are loaded and run from their own playbooks, so cannot be run from within other jobs
+var nestableJobs = validation.By(func(value interface{}) error {
+	jobs, ok := value.([]*Job)
+	if !ok {
+		return fmt.Errorf("should be a list of jobs but is %T", value)
+	}
+	for _, job := range jobs {
+		if job.Meta != nil || job.Proposal != nil {
+			return fmt.Errorf("job %s cannot be run from a for-each or if job since it is a meta or proposal job",
+				job.Name)
+		}
+	}
+	return nil
+})
+
 // ------------------------------------------------------------------------
 // Governance Jobs
 // ------------------------------------------------------------------------
@@ -151,6 +223,23 @@ func (job *Set) Validate() error {
 	)
 }
 
+// Computes a value from the results of earlier jobs with a small script (see the script package), for what placeholders
+// alone cannot express such as deriving an address or packing a struct. The result of the job is the value of the last
+// statement the script runs, and the variables it assigns can be referred to as $job.variable.
+type Script struct {
+	// (Required) the script, whose placeholders are read as values rather than replaced in its text
+	Code string `mapstructure:"code" json:"code" yaml:"code" toml:"code"`
+}
+
+func (job *Script) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Code, validation.Required, validation.By(func(value interface{}) error {
+			_, err := script.Parse(job.Code)
+			return err
+		})),
+	)
+}
+
 // ------------------------------------------------------------------------
 // Transaction Jobs
 // ------------------------------------------------------------------------
@@ -305,6 +394,8 @@ type Build struct {
 	// compilers but rather will just be sent to the chain. Note, if you use a "call" job after deploying
 	// a binary contract then you will be **required** to utilize an abi field in the call job.
 	Contract string `mapstructure:"contract" json:"contract" yaml:"contract" toml:"contract"`
+	// (Optional) the SHA-256 hash of the contract file as sha256:HEX, required for a contract fetched over HTTP
+	Integrity string `mapstructure:"integrity" json:"integrity" yaml:"integrity" toml:"integrity"`
 	// (Optional) where to save the result of the compilation
 	BinPath string `mapstructure:"binpath" json:"binpath" yaml:"binpath" toml:"binpath"`
 	// (Optional) the name of contract to instantiate (it has to be one of the contracts present)
@@ -319,11 +410,15 @@ type Build struct {
 	Store string `mapstructure:"store" json:"store" yaml:"store" toml:"store"`
 	// (Optional) Use solang to compile to wasm
 	Wasm bool `mapstructure:"wasm" json:"wasm" yaml:"wasm" toml:"wasm"`
+	// (Optional) the version of solc to compile with, or pragma to use the newest release the contract's version
+	// pragma allows, overriding the playbook's
+	Solc string `mapstructure:"solc" json:"solc" yaml:"solc" toml:"solc"`
 }
 
 func (job *Build) Validate() error {
 	return validation.ValidateStruct(job,
 		validation.Field(&job.Contract, validation.Required),
+		validation.Field(&job.Integrity, rule.Integrity),
 	)
 }
 
@@ -336,7 +431,11 @@ type Deploy struct {
 	// If contract has a "bin" file extension then it will not be sent to the
 	// compilers but rather will just be sent to the chain. Note, if you use a "call" job after deploying
 	// a binary contract then you will be **required** to utilize an abi field in the call job.
+	// If contract has a "wasm" file extension it is deployed as a WASM binary module (for example one
+	// built from Rust) with its abi read from a file of the same name with an "abi" extension, if present.
 	Contract string `mapstructure:"contract" json:"contract" yaml:"contract" toml:"contract"`
+	// (Optional) the SHA-256 hash of the contract file as sha256:HEX, required for a contract fetched over HTTP
+	Integrity string `mapstructure:"integrity" json:"integrity" yaml:"integrity" toml:"integrity"`
 	// (Optional) the name of contract to instantiate (it has to be one of the contracts present)
 	// in the file defined in Contract above.
 	// When none is provided, the system will choose the contract with the same name as that file.
@@ -345,8 +444,16 @@ type Deploy struct {
 	// the name of the file (or the last one deployed if there are no matching names; not the "last"
 	// one deployed" strategy is non-deterministic and should not be used).
 	Instance string `mapstructure:"instance" json:"instance" yaml:"instance" toml:"instance"`
-	// (Optional) the file path for the linkReferences for contract
+	// (Optional) the addresses of libraries to link the contract to, as a comma separated list of Library:address
+	// pairs. Any other libraries the contract links to are deployed from the contracts compiled with it, or reused
+	// if a library with the same code has already been deployed
 	Libraries string `mapstructure:"libraries" json:"libraries" yaml:"libraries" toml:"libraries"`
+	// (Optional) deploy the libraries the contract links to through a CREATE2 factory with this salt, so that their
+	// addresses depend only on the factory, the salt, and their code
+	Salt string `mapstructure:"salt" json:"salt" yaml:"salt" toml:"salt"`
+	// (Optional) the address of the CREATE2 factory to deploy libraries through when a salt is given. If not set
+	// a factory already on the chain is used, or one is deployed
+	Factory string `mapstructure:"factory" json:"factory" yaml:"factory" toml:"factory"`
 	// (Optional) TODO: additional arguments to send along with the contract code
 	Data interface{} `mapstructure:"data" json:"data" yaml:"data" toml:"data"`
 	// (Optional) amount of tokens to send to the contract which will (after deployment) reside in the
@@ -365,15 +472,20 @@ type Deploy struct {
 	Store string `mapstructure:"store" json:"store" yaml:"store" toml:"store"`
 	// (Optional) Use solang to compile to wasm
 	Wasm bool `mapstructure:"wasm" json:"wasm" yaml:"wasm" toml:"wasm"`
+	// (Optional) the version of solc to compile with, or pragma to use the newest release the contract's version
+	// pragma allows, overriding the playbook's
+	Solc string `mapstructure:"solc" json:"solc" yaml:"solc" toml:"solc"`
 }
 
 func (job *Deploy) Validate() error {
 	return validation.ValidateStruct(job,
 		validation.Field(&job.Contract, validation.Required),
+		validation.Field(&job.Integrity, rule.Integrity),
 		validation.Field(&job.Amount, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Factory, rule.AddressOrPlaceholder),
 	)
 }
 
@@ -408,6 +520,8 @@ type Call struct {
 	Save string `mapstructure:"save" json:"save" yaml:"save" toml:"save"`
 	// (Optional) the call job's returned variables
 	Variables []*abi.Variable
+	// Not marshalled, the events emitted by the call job's transaction
+	Events []*exec.Event `json:"-" yaml:"-" toml:"-"`
 }
 
 // TODO: maybe do for others...
@@ -425,6 +539,78 @@ func (job *Call) Validate() error {
 	)
 }
 
+// Proxy deploys a transparent proxy following ERC-1967 which delegates calls to an implementation contract, so that
+// the implementation can later be replaced with an upgrade job. The proxy can be called with the ABI of its
+// implementation.
+type Proxy struct {
+	// (Optional, if account job or global account set) address of the account from which to send (the
+	// public key for the account must be available to burrow keys)
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) address of the implementation contract, usually the result of a deploy job
+	Implementation string `mapstructure:"implementation" json:"implementation" yaml:"implementation" toml:"implementation"`
+	// (Required) address of the account which may upgrade the proxy. The admin's calls are not delegated to the
+	// implementation, so it should not be an account that uses the contract
+	Admin string `mapstructure:"admin" json:"admin" yaml:"admin" toml:"admin"`
+	// (Optional) function of the implementation to call through the proxy as it is deployed, to initialise
+	// its storage in place of a constructor
+	Function string `mapstructure:"function" json:"function" yaml:"function" toml:"function"`
+	// (Optional) arguments of the function
+	Data interface{} `mapstructure:"data" json:"data" yaml:"data" toml:"data"`
+	// (Optional) validators' fee
+	Fee string `mapstructure:"fee" json:"fee" yaml:"fee" toml:"fee"`
+	// (Optional) amount of gas which should be sent along with the deploy transaction
+	Gas string `mapstructure:"gas" json:"gas" yaml:"gas" toml:"gas"`
+	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
+	// know what you're doing)
+	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
+}
+
+func (job *Proxy) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Implementation, validation.Required),
+		validation.Field(&job.Admin, validation.Required),
+		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+	)
+}
+
+// Upgrade switches a proxy deployed by a proxy job to a new implementation, once it has checked that the new
+// implementation keeps the storage layout of the current one
+type Upgrade struct {
+	// (Optional, if account job or global account set) address of the proxy's admin, from which to send
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) address of the proxy
+	Proxy string `mapstructure:"proxy" json:"proxy" yaml:"proxy" toml:"proxy"`
+	// (Required) address of the new implementation contract, usually the result of a deploy job
+	Implementation string `mapstructure:"implementation" json:"implementation" yaml:"implementation" toml:"implementation"`
+	// (Optional) function of the new implementation to call through the proxy as it is upgraded, to migrate
+	// its storage
+	Function string `mapstructure:"function" json:"function" yaml:"function" toml:"function"`
+	// (Optional) arguments of the function
+	Data interface{} `mapstructure:"data" json:"data" yaml:"data" toml:"data"`
+	// (Optional) upgrade without checking the storage layouts, such as when the current implementation was
+	// compiled without one
+	SkipStorageCheck bool `mapstructure:"skip-storage-check" json:"skip-storage-check" yaml:"skip-storage-check" toml:"skip-storage-check"`
+	// (Optional) validators' fee
+	Fee string `mapstructure:"fee" json:"fee" yaml:"fee" toml:"fee"`
+	// (Optional) amount of gas which should be sent along with the upgrade transaction
+	Gas string `mapstructure:"gas" json:"gas" yaml:"gas" toml:"gas"`
+	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
+	// know what you're doing)
+	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
+}
+
+func (job *Upgrade) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Proxy, validation.Required),
+		validation.Field(&job.Implementation, validation.Required),
+		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+	)
+}
+
 // ------------------------------------------------------------------------
 // State Jobs
 // ------------------------------------------------------------------------
@@ -454,6 +640,31 @@ func (job *RestoreState) Validate() error {
 	return nil
 }
 
+// Records the state of the chain under a name, so that restore-snapshot jobs can return it to that state. The chain
+// must be running without consensus, such as one started with burrow dev.
+type Snapshot struct {
+	// (Required) name to record the state under, replacing any snapshot of that name
+	Name string `mapstructure:"name" json:"name" yaml:"name" toml:"name"`
+}
+
+func (job *Snapshot) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Name, validation.Required),
+	)
+}
+
+// Returns the chain to the state recorded by a snapshot job, discarding the blocks committed since
+type RestoreSnapshot struct {
+	// (Required) name the state was recorded under
+	Name string `mapstructure:"name" json:"name" yaml:"name" toml:"name"`
+}
+
+func (job *RestoreSnapshot) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Name, validation.Required),
+	)
+}
+
 // ------------------------------------------------------------------------
 // Testing Jobs
 // ------------------------------------------------------------------------
@@ -548,3 +759,22 @@ func (job *Assert) Validate() error {
 		validation.Field(&job.Relation, validation.Required, rule.Relation),
 	)
 }
+
+type AssertEvent struct {
+	// (Required) name of the earlier call job whose transaction should have emitted the event
+	Call string `mapstructure:"call" json:"call" yaml:"call" toml:"call"`
+	// (Required) name of the event which should have been emitted
+	Event string `mapstructure:"event" json:"event" yaml:"event" toml:"event"`
+	// (Optional) address of the contract which should have emitted the event, by default any contract
+	Address string `mapstructure:"address" json:"address" yaml:"address" toml:"address"`
+	// (Optional) values the event's arguments should have keyed by argument name. Arguments which are not
+	// given may have any value
+	Args map[string]interface{} `mapstructure:"args" json:"args" yaml:"args" toml:"args"`
+}
+
+func (job *AssertEvent) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Call, validation.Required),
+		validation.Field(&job.Event, validation.Required),
+	)
+}
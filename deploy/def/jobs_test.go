@@ -1,6 +1,7 @@
 package def
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
@@ -47,3 +48,38 @@ func TestKeyNameCurveType(t *testing.T) {
 
 	assert.False(t, NewKeyRegex.MatchString("new"))
 }
+
+func TestForEach_Validate(t *testing.T) {
+	set := &Job{Name: "n", Set: &Set{Value: "$item"}}
+	job := &ForEach{Items: []string{"a", "$b"}, Jobs: []*Job{set}}
+	require.NoError(t, job.Validate())
+
+	job = &ForEach{From: "1", To: "$count", As: "n_1", Jobs: []*Job{set}}
+	require.NoError(t, job.Validate())
+
+	job = &ForEach{From: "one", As: "my item", Jobs: []*Job{{Name: "sub", Meta: &Meta{File: "sub.yaml"}}}}
+	err := job.Validate()
+	require.Error(t, err)
+	assert.Len(t, strings.Split(err.Error(), ";"), 4, "Should have errors from from, to, as, and the meta job")
+}
+
+func TestIf_Validate(t *testing.T) {
+	set := &Job{Name: "n", Set: &Set{Value: "1"}}
+	job := &If{Key: "$supply", Relation: "eq", Value: "0", Else: []*Job{set}}
+	require.NoError(t, job.Validate())
+
+	job = &If{Key: "$supply", Relation: "is"}
+	err := job.Validate()
+	require.Error(t, err)
+	assert.Len(t, strings.Split(err.Error(), ";"), 2, "Should have errors from relation and then")
+}
+
+func TestScript_Validate(t *testing.T) {
+	job := &Script{Code: "salt = keccak256($name)\ncreate2Address($factory, salt, $code)"}
+	require.NoError(t, job.Validate())
+
+	job = &Script{Code: "salt = keccak256($name"}
+	require.Error(t, job.Validate())
+	job = &Script{}
+	require.Error(t, job.Validate())
+}
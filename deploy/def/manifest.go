@@ -0,0 +1,136 @@
+package def
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging/loggers"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// DeployedContract is a contract deployed by a job, as recorded in a manifest
+type DeployedContract struct {
+	Job      string `json:"job"`
+	Contract string `json:"contract"`
+	Address  string `json:"address"`
+	TxHash   string `json:"txHash"`
+	Height   uint64 `json:"height"`
+	// The arguments the contract was deployed with
+	Arguments []string `json:"arguments,omitempty"`
+	// The keccak256 hash of the contract's ABI as JSON
+	AbiHash string `json:"abiHash,omitempty"`
+	// The playbook target the contract was deployed to, if not the chain the deploy was run against
+	Target string `json:"target,omitempty"`
+}
+
+// Manifest records the contracts deployed by the jobs of a playbook, and any playbooks it runs, for tools that need
+// to find them afterwards. Jobs may run concurrently so it is safe to record from several goroutines.
+type Manifest struct {
+	Playbook  string
+	ChainID   string
+	mtx       sync.Mutex
+	contracts []DeployedContract
+	// Redacted from the arguments of contracts when the manifest is written
+	secrets []string
+}
+
+// NewManifest returns a manifest for playbook that keeps each of secrets out of the arguments it writes
+func NewManifest(playbook string, secrets ...string) *Manifest {
+	return &Manifest{Playbook: playbook, secrets: secrets}
+}
+
+// Job returns a JobManifest that records the contracts deployed by job in manifest, which may be nil to record nothing
+func (manifest *Manifest) Job(job string) *JobManifest {
+	if manifest == nil {
+		return nil
+	}
+	return &JobManifest{manifest: manifest, job: job}
+}
+
+// Contracts returns the contracts deployed in the order they were recorded
+func (manifest *Manifest) Contracts() []DeployedContract {
+	manifest.mtx.Lock()
+	defer manifest.mtx.Unlock()
+	contracts := make([]DeployedContract, len(manifest.contracts))
+	copy(contracts, manifest.contracts)
+	return contracts
+}
+
+func (manifest *Manifest) MarshalJSON() ([]byte, error) {
+	contracts := manifest.Contracts()
+	if len(manifest.secrets) > 0 {
+		for i := range contracts {
+			arguments := make([]string, len(contracts[i].Arguments))
+			for j, argument := range contracts[i].Arguments {
+				arguments[j] = loggers.Redact(argument, manifest.secrets...)
+			}
+			contracts[i].Arguments = arguments
+		}
+	}
+	return json.Marshal(struct {
+		Playbook  string             `json:"playbook"`
+		ChainID   string             `json:"chainId,omitempty"`
+		Contracts []DeployedContract `json:"contracts"`
+	}{
+		Playbook:  manifest.Playbook,
+		ChainID:   manifest.ChainID,
+		Contracts: contracts,
+	})
+}
+
+// JobManifest records the contracts deployed by one job
+type JobManifest struct {
+	manifest *Manifest
+	job      string
+	target   string
+}
+
+// OnTarget returns a JobManifest that records the contracts deployed by the job as deployed to the playbook target
+// named, or to the chain the deploy was run against if target is empty
+func (jm *JobManifest) OnTarget(target string) *JobManifest {
+	if jm == nil {
+		return nil
+	}
+	return &JobManifest{manifest: jm.manifest, job: jm.job, target: target}
+}
+
+// Deployed records that the transaction txe deployed the contract named contract at address with the constructor
+// arguments given and the ABI abi
+func (jm *JobManifest) Deployed(contract string, address crypto.Address, txe *exec.TxExecution, arguments []string,
+	abi json.RawMessage) {
+	if jm == nil {
+		return
+	}
+	deployed := DeployedContract{
+		Job:       jm.job,
+		Contract:  contract,
+		Address:   address.String(),
+		TxHash:    txe.TxHash.String(),
+		Height:    txe.GetHeight(),
+		Arguments: arguments,
+		Target:    jm.target,
+	}
+	if len(abi) > 0 {
+		deployed.AbiHash = hex.EncodeUpperToString(crypto.Keccak256(abi))
+	}
+	jm.manifest.mtx.Lock()
+	defer jm.manifest.mtx.Unlock()
+	jm.manifest.contracts = append(jm.manifest.contracts, deployed)
+}
+
+// Reuse records again the contracts previous recorded as deployed by job, for a job whose result is kept from an
+// earlier run rather than run again. Either manifest may be nil.
+func (manifest *Manifest) Reuse(previous *Manifest, job string) {
+	if manifest == nil || previous == nil {
+		return
+	}
+	for _, contract := range previous.Contracts() {
+		if contract.Job == job {
+			manifest.mtx.Lock()
+			manifest.contracts = append(manifest.contracts, contract)
+			manifest.mtx.Unlock()
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package def
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestManifest(t *testing.T) {
+	parent := &Playbook{Manifest: NewManifest("deploy.yaml")}
+	meta := &Playbook{Parent: parent}
+	token := crypto.Address{1}
+	lib := crypto.Address{2}
+	abi := json.RawMessage(`[{"type":"constructor","inputs":[{"name":"supply","type":"uint256"}]}]`)
+
+	txe := func(hash byte, height uint64) *exec.TxExecution {
+		return &exec.TxExecution{TxHeader: &exec.TxHeader{TxHash: []byte{hash}, Height: height}}
+	}
+	parent.JobManifest("deployToken").Deployed("Token", token, txe(0xAB, 12), []string{"1000"}, abi)
+	meta.JobManifest("deployLib").Deployed("Lib", lib, txe(0xCD, 13), nil, nil)
+	// Playbooks without a manifest record nothing
+	(&Playbook{}).JobManifest("lost").Deployed("Lost", token, txe(0xEF, 14), nil, nil)
+
+	manifest := parent.Manifest
+	manifest.ChainID = "TestChain"
+	assert.Equal(t, []DeployedContract{
+		{Job: "deployToken", Contract: "Token", Address: token.String(), TxHash: "AB", Height: 12,
+			Arguments: []string{"1000"}, AbiHash: hex.EncodeUpperToString(crypto.Keccak256(abi))},
+		{Job: "deployLib", Contract: "Lib", Address: lib.String(), TxHash: "CD", Height: 13},
+	}, manifest.Contracts())
+
+	bs, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	var decoded struct {
+		Playbook  string
+		ChainID   string
+		Contracts []DeployedContract
+	}
+	require.NoError(t, json.Unmarshal(bs, &decoded))
+	assert.Equal(t, "deploy.yaml", decoded.Playbook)
+	assert.Equal(t, "TestChain", decoded.ChainID)
+	assert.Equal(t, manifest.Contracts(), decoded.Contracts)
+}
+
+func TestManifestReuse(t *testing.T) {
+	previous := NewManifest("deploy.yaml")
+	txe := &exec.TxExecution{TxHeader: &exec.TxHeader{TxHash: []byte{0xAB}, Height: 12}}
+	previous.Job("deployToken").Deployed("Token", crypto.Address{1}, txe, nil, nil)
+	previous.Job("deployLib").Deployed("Lib", crypto.Address{2}, txe, nil, nil)
+
+	manifest := NewManifest("deploy.yaml")
+	manifest.Reuse(previous, "deployLib")
+	manifest.Reuse(nil, "deployToken")
+	assert.Equal(t, previous.Contracts()[1:], manifest.Contracts())
+	// Nothing to record into
+	(*Manifest)(nil).Reuse(previous, "deployToken")
+}
+
+func TestManifestSecrets(t *testing.T) {
+	manifest := NewManifest("deploy.yaml", "s3cret")
+	txe := &exec.TxExecution{TxHeader: &exec.TxHeader{TxHash: []byte{0xAB}, Height: 12}}
+	manifest.Job("deployToken").Deployed("Token", crypto.Address{1}, txe, []string{"1000", "key=s3cret"}, nil)
+
+	bs, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	assert.NotContains(t, string(bs), "s3cret")
+	assert.Contains(t, string(bs), `"arguments":["1000","key=[redacted]"]`)
+	// Only what is written is redacted
+	assert.Equal(t, []string{"1000", "key=s3cret"}, manifest.Contracts()[0].Arguments)
+}
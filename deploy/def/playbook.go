@@ -1,21 +1,118 @@
 package def
 
-import validation "github.com/go-ozzo/ozzo-validation"
+import (
+	"fmt"
+	"sync"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
 
 type Playbook struct {
 	Filename string
 	Account  string
 	// Prevent this playbook from running at the same time as other playbooks
 	NoParallel bool `mapstructure:"no-parallel,omitempty" json:"no-parallel,omitempty" yaml:"no-parallel,omitempty" toml:"no-parallel,omitempty"`
-	Jobs       []*Job
-	Path       string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
-	BinPath    string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	// The version of solc to compile the playbook's contracts with, or pragma to use the newest release each
+	// contract's version pragma allows
+	Solc string `mapstructure:"solc,omitempty" json:"solc,omitempty" yaml:"solc,omitempty" toml:"solc,omitempty"`
+	// Chains other than that given by --chain that jobs can run against, by name
+	Targets map[string]*Target `mapstructure:"targets,omitempty" json:"targets,omitempty" yaml:"targets,omitempty" toml:"targets,omitempty"`
+	Jobs    []*Job
+	Path    string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	BinPath string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
 	// If we're in a proposal or meta job, reference our parent script
 	Parent *Playbook `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	// Collects the gas used by the jobs of this playbook and those it runs
+	GasReport *GasReport `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	// Records the contracts deployed by the jobs of this playbook and those it runs
+	Manifest *Manifest `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	// The values of the secrets referred to by this playbook and those it runs, to be redacted from logs
+	Secrets []string `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
+	// The target this copy of a playbook runs jobs against, if any (see OnTarget)
+	target string
+}
+
+// Target is a chain that the jobs of a playbook can run against in place of the chain the deploy was run against, so
+// that one playbook can deploy contracts that work together across chains
+type Target struct {
+	// The GRPC address of the chain in IP:PORT format
+	Chain string `mapstructure:"chain" json:"chain" yaml:"chain" toml:"chain"`
+	// The account jobs against the chain send transactions from unless they set their own, in place of the account of
+	// the playbook
+	Address string `mapstructure:"address,omitempty" json:"address,omitempty" yaml:"address,omitempty" toml:"address,omitempty"`
+	// The client of the chain, made when first needed
+	mtx    sync.Mutex
+	client *Client
+}
+
+func (target *Target) Validate() error {
+	return validation.ValidateStruct(target,
+		validation.Field(&target.Chain, validation.Required),
+	)
+}
+
+// OnTarget returns a copy of the playbook for running jobs against the target named, which may be declared by this
+// playbook or one running it, along with a client of the target's chain made like client. The copy shares the jobs,
+// gas report, and manifest of the playbook but sends from the target's account if it sets one.
+func (pkg *Playbook) OnTarget(name string, client *Client) (*Playbook, *Client, error) {
+	target := pkg.Target(name)
+	if target == nil {
+		return nil, nil, fmt.Errorf("no target named %s is declared by the playbook or those running it", name)
+	}
+	onTarget := *pkg
+	onTarget.target = name
+	if target.Address != "" {
+		onTarget.Account = target.Address
+	}
+	return &onTarget, target.Client(client), nil
+}
+
+// Target returns the target named declared by this playbook or the nearest one running it, or nil if there is none
+func (pkg *Playbook) Target(name string) *Target {
+	for ; pkg != nil; pkg = pkg.Parent {
+		if target, ok := pkg.Targets[name]; ok {
+			return target
+		}
+	}
+	return nil
+}
+
+// Client returns a client of the target's chain with the keys, signing, timeout, and simulation settings of client
+func (target *Target) Client(client *Client) *Client {
+	target.mtx.Lock()
+	defer target.mtx.Unlock()
+	if target.client == nil {
+		target.client = client.OnChain(target.Chain)
+	}
+	return target.client
+}
+
+// JobGas returns a JobGas recording the gas used by job in the report of this playbook or the nearest one running it,
+// or nil if none has a report
+func (pkg *Playbook) JobGas(job string) *JobGas {
+	for ; pkg != nil; pkg = pkg.Parent {
+		if pkg.GasReport != nil {
+			return pkg.GasReport.Job(job)
+		}
+	}
+	return nil
+}
+
+// JobManifest returns a JobManifest recording the contracts deployed by job in the manifest of this playbook or the
+// nearest one running it, or nil if none has a manifest
+func (pkg *Playbook) JobManifest(job string) *JobManifest {
+	target := pkg.target
+	for ; pkg != nil; pkg = pkg.Parent {
+		if pkg.Manifest != nil {
+			return pkg.Manifest.Job(job).OnTarget(target)
+		}
+	}
+	return nil
 }
 
 func (pkg *Playbook) Validate() error {
 	return validation.ValidateStruct(pkg,
+		validation.Field(&pkg.Targets),
 		validation.Field(&pkg.Jobs),
 	)
 }
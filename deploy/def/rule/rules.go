@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"strings"
+	"time"
 
 	"reflect"
 
@@ -118,6 +119,13 @@ var (
 
 	Uint64OrPlaceholder = Or(Placeholder, Uint64)
 
+	Int64OrPlaceholder = Or(Placeholder, Int64)
+
+	Duration = validation.NewStringRule(IsDuration, "must be a duration like 30s or 2m")
+
+	Integrity = validation.Match(regexp.MustCompile(`^sha256:[[:xdigit:]]{64}$`)).
+			Error("must be the SHA-256 hash of the contract file in hex like sha256:9f86d081...")
+
 	Uint64 = validation.By(func(value interface{}) error {
 		str, err := validation.EnsureString(value)
 		if err != nil {
@@ -129,8 +137,30 @@ var (
 		}
 		return nil
 	})
+
+	Int64 = validation.By(func(value interface{}) error {
+		str, err := validation.EnsureString(value)
+		if err != nil {
+			return fmt.Errorf("should be a numeric string but '%v' is not a string", value)
+		}
+		_, err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return fmt.Errorf("should be a 64 bit integer")
+		}
+		return nil
+	})
 )
 
+// RequiredIf is validation.Required when required, and otherwise passes any value
+func RequiredIf(required bool) validation.Rule {
+	if required {
+		return validation.Required
+	}
+	return validation.By(func(value interface{}) error {
+		return nil
+	})
+}
+
 func Exactly(identity interface{}) validation.Rule {
 	return validation.By(func(value interface{}) error {
 		if !reflect.DeepEqual(identity, value) {
@@ -162,6 +192,11 @@ func (orr *orRule) Validate(value interface{}) error {
 	return fmt.Errorf("did not validate any requirements: %s", strings.Join(errs, ", "))
 }
 
+func IsDuration(value string) bool {
+	_, err := time.ParseDuration(value)
+	return err == nil
+}
+
 func IsAddress(value string) bool {
 	_, err := crypto.AddressFromHexString(value)
 	return err == nil
@@ -0,0 +1,242 @@
+package jobs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/def/rule"
+)
+
+// How a job touches the chain, which decides which jobs it can run alongside
+type jobAccess int
+
+const (
+	// Only uses the results of other jobs
+	accessNone jobAccess = iota
+	// Reads chain state
+	accessRead
+	// Sends transactions
+	accessWrite
+	// Changes the playbook or runs other jobs, so runs alone
+	accessBarrier
+)
+
+func accessOf(payload def.Payload) jobAccess {
+	switch payload.(type) {
+	case *def.Set, *def.Script, *def.Build, *def.Assert, *def.AssertEvent:
+		return accessNone
+	case *def.QueryContract, *def.QueryAccount, *def.QueryName, *def.QueryVals:
+		return accessRead
+	case *def.Account, *def.Meta, *def.Proposal, *def.DumpState, *def.RestoreState,
+		*def.Snapshot, *def.RestoreSnapshot, *def.ForEach, *def.If:
+		return accessBarrier
+	default:
+		return accessWrite
+	}
+}
+
+// Returns the indices of the earlier jobs each of jobs must wait for. A job waits for the jobs whose results it refers
+// to as variables or whose events it asserts. Since jobs may also depend on each other through the state of the chain,
+// a job that reads state waits for the transactions before it, a transaction waits for the reads before it, calls to
+// the same contract run in order, and jobs that change the playbook (such as account) or run other jobs (such as meta)
+// run on their own.
+func jobDependencies(jobs []*def.Job) ([][]int, error) {
+	deps := make([][]int, len(jobs))
+	byName := make(map[string][]int)
+	barrier := -1
+	var reads, writes []int
+	lastCall := make(map[string]int)
+	for i, job := range jobs {
+		payload, err := job.Payload()
+		if err != nil {
+			return nil, fmt.Errorf("could not get payload of job %s: %v", job.Name, err)
+		}
+		waitFor := make(map[int]bool)
+		access := accessOf(payload)
+		if access == accessBarrier {
+			for j := 0; j < i; j++ {
+				waitFor[j] = true
+			}
+		} else if barrier >= 0 {
+			waitFor[barrier] = true
+		}
+		refs, err := referencedJobs(job.Name, payload)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range refs {
+			for _, j := range byName[ref] {
+				waitFor[j] = true
+			}
+		}
+		switch access {
+		case accessRead:
+			for _, j := range writes {
+				waitFor[j] = true
+			}
+			reads = append(reads, i)
+		case accessWrite:
+			for _, j := range reads {
+				waitFor[j] = true
+			}
+			writes = append(writes, i)
+		case accessBarrier:
+			barrier = i
+			reads, writes = nil, nil
+			lastCall = make(map[string]int)
+		}
+		if destination := destinationOf(payload); destination != "" {
+			if j, ok := lastCall[destination]; ok {
+				waitFor[j] = true
+			}
+			lastCall[destination] = i
+		}
+		for j := range waitFor {
+			deps[i] = append(deps[i], j)
+		}
+		sort.Ints(deps[i])
+		byName[job.Name] = append(byName[job.Name], i)
+	}
+	return deps, nil
+}
+
+// The names of the jobs whose results a job refers to as variables or whose events it asserts
+func referencedJobs(name string, payload def.Payload) ([]string, error) {
+	bs, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not read variables of job %s: %v", name, err)
+	}
+	var refs []string
+	for _, pm := range rule.MatchPlaceholders(string(bs)) {
+		refs = append(refs, pm.JobName)
+	}
+	// An event assertion names the call it checks without a placeholder
+	if assert, ok := payload.(*def.AssertEvent); ok {
+		refs = append(refs, assert.Call)
+	}
+	return refs, nil
+}
+
+// Returns the jobs of previous, an earlier run of the same playbook, whose results can be kept for the jobs of playbook
+// of the same name rather than running them again, by name. Only transactions are kept, since other jobs are cheap to
+// run again and reads may see changes made by those run again. A transaction is run again if it did not succeed before,
+// if it deploys or builds a contract whose compiled code has changed (or deploys a binary after a build that has), or if
+// it refers to the result of a job run again for any of these reasons. Meta and proposal jobs are always run again.
+func reusableJobs(playbook, previous *def.Playbook) (map[string]*def.Job, error) {
+	before := make(map[string]*def.Job)
+	named := make(map[string]int)
+	for _, job := range previous.Jobs {
+		before[job.Name] = job
+		named[job.Name]++
+	}
+	for _, job := range playbook.Jobs {
+		named[job.Name]++
+	}
+	changed := make(map[string]bool)
+	buildChanged := false
+	reused := make(map[string]*def.Job)
+	for _, job := range playbook.Jobs {
+		payload, err := job.Payload()
+		if err != nil {
+			return nil, fmt.Errorf("could not get payload of job %s: %v", job.Name, err)
+		}
+		prev := before[job.Name]
+		// Jobs of the same name cannot be told apart
+		again := prev == nil || named[job.Name] != 2 || prev.Result == nil
+		if !again {
+			again = compiledChanged(job, prev)
+			if deploy, ok := payload.(*def.Deploy); ok && !isSource(deploy.Contract) {
+				again = buildChanged
+			}
+		}
+		if !again {
+			refs, err := referencedJobs(job.Name, payload)
+			if err != nil {
+				return nil, err
+			}
+			for _, ref := range refs {
+				again = again || changed[ref]
+			}
+		}
+		if again {
+			changed[job.Name] = true
+			if _, ok := payload.(*def.Build); ok {
+				buildChanged = true
+			}
+		} else if accessOf(payload) == accessWrite {
+			reused[job.Name] = prev
+		}
+	}
+	return reused, nil
+}
+
+// Whether the contracts job compiles differ from those prev compiled
+func compiledChanged(job, prev *def.Job) bool {
+	current, ok := job.Intermediate.(*compilerJob)
+	if !ok {
+		return false
+	}
+	before, ok := prev.Intermediate.(*compilerJob)
+	if !ok {
+		return true
+	}
+	<-current.done
+	<-before.done
+	return current.codeHash == nil || !bytes.Equal(current.codeHash, before.codeHash)
+}
+
+// The contract a job sends a transaction to, if any, which an upgrade sends to its proxy
+func destinationOf(payload def.Payload) string {
+	switch p := payload.(type) {
+	case *def.Call:
+		return p.Destination
+	case *def.Upgrade:
+		return p.Proxy
+	}
+	return ""
+}
+
+// Runs each of jobs once the jobs it depends on have finished, at most limit at a time. Once a job fails no more are
+// started and the first error is returned once those running have finished.
+func runJobs(jobs []*def.Job, deps [][]int, limit int, run func(job *def.Job) error) error {
+	done := make([]chan struct{}, len(jobs))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	slots := make(chan struct{}, limit)
+	var mtx sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		go func(i int, job *def.Job) {
+			defer wg.Done()
+			defer close(done[i])
+			for _, j := range deps[i] {
+				<-done[j]
+			}
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			mtx.Lock()
+			failed := firstErr != nil
+			mtx.Unlock()
+			if failed {
+				return
+			}
+			err := run(job)
+			if err != nil {
+				mtx.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mtx.Unlock()
+			}
+		}(i, job)
+	}
+	wg.Wait()
+	return firstErr
+}
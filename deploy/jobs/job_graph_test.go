@@ -0,0 +1,159 @@
+package jobs
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobDependencies(t *testing.T) {
+	jobs := []*def.Job{
+		{Name: "owner", Set: &def.Set{Value: "Root_0"}},
+		{Name: "lib", Deploy: &def.Deploy{Contract: "Lib.sol"}},
+		{Name: "token", Deploy: &def.Deploy{Contract: "Token.sol", Libraries: "Lib:$lib", Data: []interface{}{"$owner"}}},
+		{Name: "other", Deploy: &def.Deploy{Contract: "Other.sol"}},
+		{Name: "mint", Call: &def.Call{Destination: "$token", Function: "mint", Data: []interface{}{"${owner}", 10}}},
+		{Name: "burn", Call: &def.Call{Destination: "$token", Function: "burn", Data: []interface{}{5}}},
+		{Name: "supply", QueryContract: &def.QueryContract{Destination: "$token", Function: "totalSupply"}},
+		{Name: "check", Assert: &def.Assert{Key: "$supply", Relation: "eq", Value: "5"}},
+		{Name: "late", Deploy: &def.Deploy{Contract: "Late.sol"}},
+		{Name: "switch", Account: &def.Account{Address: "$owner"}},
+		{Name: "after", Deploy: &def.Deploy{Contract: "After.sol"}},
+		{Name: "minted", AssertEvent: &def.AssertEvent{Call: "mint", Event: "Transfer"}},
+		{Name: "upgrade", Upgrade: &def.Upgrade{Proxy: "$token", Implementation: "$other"}},
+		{Name: "poke", Call: &def.Call{Destination: "$token", Function: "poke"}},
+	}
+	deps, err := jobDependencies(jobs)
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{
+		nil,
+		nil,
+		// Variables
+		{0, 1},
+		nil,
+		{0, 2},
+		// Calls to the same contract run in order
+		{2, 4},
+		// Reads wait for the transactions before them
+		{1, 2, 3, 4, 5},
+		{6},
+		// Transactions wait for the reads before them
+		{6},
+		// Barriers wait for everything before them and everything after waits for them
+		{0, 1, 2, 3, 4, 5, 6, 7, 8},
+		{9},
+		// Event assertions wait for the call they check
+		{4, 9},
+		{2, 3, 9},
+		// Calls to a proxy wait for its upgrades
+		{2, 9, 12},
+	}, deps)
+}
+
+func TestRunJobs(t *testing.T) {
+	jobs := make([]*def.Job, 6)
+	for i := range jobs {
+		jobs[i] = &def.Job{Name: fmt.Sprintf("job%d", i)}
+	}
+	deps := [][]int{nil, nil, nil, {0, 1, 2}, {3}, nil}
+
+	t.Run("Order", func(t *testing.T) {
+		var mtx sync.Mutex
+		finished := make(map[string]bool)
+		running, maxRunning := 0, 0
+		err := runJobs(jobs, deps, 2, func(job *def.Job) error {
+			mtx.Lock()
+			for _, j := range deps[jobIndex(job)] {
+				assert.True(t, finished[jobs[j].Name], "%s ran before %s", job.Name, jobs[j].Name)
+			}
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mtx.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mtx.Lock()
+			running--
+			finished[job.Name] = true
+			mtx.Unlock()
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, finished, len(jobs))
+		assert.Equal(t, 2, maxRunning)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		var mtx sync.Mutex
+		var ran []string
+		err := runJobs(jobs, deps, 1, func(job *def.Job) error {
+			mtx.Lock()
+			defer mtx.Unlock()
+			ran = append(ran, job.Name)
+			if job.Name == "job3" {
+				return fmt.Errorf("job3 failed")
+			}
+			return nil
+		})
+		require.EqualError(t, err, "job3 failed")
+		assert.NotContains(t, ran, "job4")
+	})
+}
+
+func jobIndex(job *def.Job) int {
+	var i int
+	fmt.Sscanf(job.Name, "job%d", &i)
+	return i
+}
+
+func TestReusableJobs(t *testing.T) {
+	compiled := func(code string) *compilerJob {
+		done := make(chan struct{})
+		close(done)
+		return &compilerJob{codeHash: []byte(code), done: done}
+	}
+	playbook := func(lib, token string) *def.Playbook {
+		return &def.Playbook{Jobs: []*def.Job{
+			{Name: "owner", Set: &def.Set{Value: "Root_0"}, Result: "Root_0"},
+			{Name: "lib", Deploy: &def.Deploy{Contract: "Lib.sol"}, Intermediate: compiled(lib), Result: "AA"},
+			{Name: "token", Deploy: &def.Deploy{Contract: "Token.sol", Libraries: "Lib:$lib"},
+				Intermediate: compiled(token), Result: "BB"},
+			{Name: "other", Deploy: &def.Deploy{Contract: "Other.sol"}, Intermediate: compiled("other"), Result: "CC"},
+			{Name: "mint", Call: &def.Call{Destination: "$token", Function: "mint", Data: []interface{}{"$owner"}},
+				Result: ""},
+			{Name: "poke", Call: &def.Call{Destination: "$other", Function: "poke"}, Result: ""},
+			{Name: "supply", QueryContract: &def.QueryContract{Destination: "$other", Function: "totalSupply"},
+				Result: "5"},
+			{Name: "failed", Call: &def.Call{Destination: "$other", Function: "fail"}},
+		}}
+	}
+	names := func(jobs map[string]*def.Job) []string {
+		var names []string
+		for name := range jobs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	previous := playbook("lib", "token")
+
+	reused, err := reusableJobs(playbook("lib", "token"), previous)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lib", "mint", "other", "poke", "token"}, names(reused))
+	assert.Equal(t, previous.Jobs[1], reused["lib"])
+
+	// A library that changes is deployed again along with what links to it and what calls that
+	reused, err = reusableJobs(playbook("lib2", "token"), previous)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other", "poke"}, names(reused))
+
+	reused, err = reusableJobs(playbook("lib", "token2"), previous)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lib", "other", "poke"}, names(reused))
+}
@@ -1,18 +1,26 @@
 package jobs
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hyperledger/burrow/crypto"
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/logging"
 	pbpayload "github.com/hyperledger/burrow/txs/payload"
 )
 
 const (
+	// How long to wait before the first retry of a job that sets no backoff
+	defaultBackoff = time.Second
 	// How many concurrent
 	concurrentSolc = 2
 	// Ensure we have a queue large enough so that we don't have to wait for more work to be queued
@@ -23,37 +31,55 @@ type solidityCompilerWork struct {
 	contractName string
 	workDir      string
 	wasm         bool
+	// The version of solc to compile with, which may be empty or pragma (see compilers.SolcVersions)
+	solc string
 }
 
 type compilerJob struct {
 	work         solidityCompilerWork
 	compilerResp *compilers.Response
 	err          error
-	done         chan struct{}
+	// The hash of the compiled contracts before any are linked, to tell whether they changed between runs
+	codeHash []byte
+	done     chan struct{}
 }
 
-func solidityRunner(jobs chan *compilerJob, logger *logging.Logger) {
+func solidityRunner(jobs chan *compilerJob, solcVersions *compilers.SolcVersions, logger *logging.Logger) {
 	for {
 		job, ok := <-jobs
 		if !ok {
 			return
 		}
-		if job.work.wasm {
+		if compilers.IsVyper(job.work.contractName) {
+			resp, err := compilers.Vyper(job.work.contractName, job.work.workDir, "", logger)
+			(*job).compilerResp = resp
+			(*job).err = err
+		} else if job.work.wasm {
 			resp, err := compilers.WASM(job.work.contractName, job.work.workDir, logger)
 			(*job).compilerResp = resp
 			(*job).err = err
 
 		} else {
-			resp, err := compilers.EVM(job.work.contractName, false, job.work.workDir, nil, logger)
-			(*job).compilerResp = resp
+			solc, err := solcVersions.Binary(job.work.solc, filepath.Join(job.work.workDir, job.work.contractName))
+			if err == nil {
+				(*job).compilerResp, err = compilers.EVM(job.work.contractName, false, job.work.workDir, nil, solc, logger)
+			}
 			(*job).err = err
 
 		}
+		if job.err == nil && job.compilerResp != nil {
+			bs, err := json.Marshal(job.compilerResp.Objects)
+			if err == nil {
+				job.codeHash = crypto.Keccak256(bs)
+			}
+		}
 		close(job.done)
 	}
 }
 
-func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *compilerJob, forceWasm bool) error {
+// Queues the compilation of the contracts of job, which are compiled with the solc version set by the job if it sets
+// one, otherwise that of the playbook, otherwise solc
+func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *compilerJob, forceWasm bool, solc string) error {
 	payload, err := job.Payload()
 	if err != nil {
 		return fmt.Errorf("could not get Job payload: %v", payload)
@@ -68,18 +94,20 @@ func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *compiler
 				contractName: job.Build.Contract,
 				workDir:      playbook.Path,
 				wasm:         job.Build.Wasm || forceWasm,
+				solc:         firstNonEmpty(job.Build.Solc, solc),
 			},
 		}
 		job.Intermediate = &intermediate
 		jobs <- &intermediate
 	case *def.Deploy:
-		if filepath.Ext(job.Deploy.Contract) == ".sol" {
+		if isSource(job.Deploy.Contract) {
 			intermediate := compilerJob{
 				done: make(chan struct{}),
 				work: solidityCompilerWork{
 					contractName: job.Deploy.Contract,
 					workDir:      playbook.Path,
 					wasm:         job.Deploy.Wasm || forceWasm,
+					solc:         firstNonEmpty(job.Deploy.Solc, solc),
 				},
 			}
 			job.Intermediate = &intermediate
@@ -87,20 +115,44 @@ func queueCompilerWork(job *def.Job, playbook *def.Playbook, jobs chan *compiler
 		}
 	case *def.Proposal:
 		for _, job := range job.Proposal.Jobs {
-			err = queueCompilerWork(job, playbook, jobs, forceWasm)
+			err = queueCompilerWork(job, playbook, jobs, forceWasm, solc)
 			if err != nil {
 				return err
 			}
 		}
+	case *def.ForEach:
+		for _, job := range job.ForEach.Jobs {
+			err = queueCompilerWork(job, playbook, jobs, forceWasm, solc)
+			if err != nil {
+				return err
+			}
+		}
+	case *def.If:
+		for _, branch := range [][]*def.Job{job.If.Then, job.If.Else} {
+			for _, job := range branch {
+				err = queueCompilerWork(job, playbook, jobs, forceWasm, solc)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	case *def.Meta:
+		metaSolc := firstNonEmpty(job.Meta.Playbook.Solc, solc)
 		for _, job := range job.Meta.Playbook.Jobs {
-			err = queueCompilerWork(job, playbook, jobs, forceWasm)
+			err = queueCompilerWork(job, playbook, jobs, forceWasm, metaSolc)
 			if err != nil {
 				return err
 			}
 		}
 	}
 
+	for _, rollback := range job.Rollback {
+		err = queueCompilerWork(rollback, playbook, jobs, forceWasm, solc)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -114,188 +166,418 @@ func getCompilerWork(intermediate interface{}) (*compilers.Response, error) {
 	return nil, fmt.Errorf("internal error: no compiler work queued")
 }
 
-func doJobs(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+// Whether contract is source to compile rather than compiled code
+func isSource(contract string) bool {
+	return filepath.Ext(contract) == ".sol" || compilers.IsVyper(contract)
+}
+
+func firstNonEmpty(strs ...string) string {
+	for _, str := range strs {
+		if str != "" {
+			return str
+		}
+	}
+	return ""
+}
+
+// Runs the jobs of playbook, except those in reused whose results are taken from the job they map to instead
+func doJobs(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, reused map[string]*def.Job,
+	logger *logging.Logger) error {
+	// The jobs that have succeeded, in the order they did, to roll back should a job with an on-failure of rollback fail
+	var mtx sync.Mutex
+	var succeeded []*def.Job
+	rollback := false
+	run := func(job *def.Job) error {
+		if previous, ok := reused[job.Name]; ok {
+			logger.InfoMsg("Reusing result of unchanged job", "Job Name", job.Name)
+			job.Result = previous.Result
+			job.Variables = previous.Variables
+			return nil
+		}
+		err := runJob(job, playbook, args, client, logger)
+		mtx.Lock()
+		defer mtx.Unlock()
+		if err == nil {
+			succeeded = append(succeeded, job)
+		} else if job.OnFailure == def.OnFailureRollback {
+			rollback = true
+		}
+		return err
+	}
+	err := doJobsWith(playbook, args, client, logger, run)
+	if err != nil && rollback {
+		rollbackJobs(succeeded, playbook, args, client, logger)
+	}
+	return err
+}
+
+func doJobsWith(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger,
+	run func(job *def.Job) error) error {
+	if args.Jobs > 1 {
+		// Sequence numbers are only assigned as transactions arrive when the node signs them, and are not needed to
+		// simulate transactions
+		if client.MempoolSigning || client.KeysClientAddress == "" || client.Simulate {
+			deps, err := jobDependencies(playbook.Jobs)
+			if err != nil {
+				return err
+			}
+			return runJobs(playbook.Jobs, deps, args.Jobs, run)
+		}
+		logger.InfoMsg("Running jobs one at a time since concurrent jobs need mempool signing")
+	}
 	for _, job := range playbook.Jobs {
-		payload, err := job.Payload()
+		err := run(job)
 		if err != nil {
-			return fmt.Errorf("could not get Job payload: %v", payload)
+			return err
 		}
+	}
+	return nil
+}
 
-		err = util.PreProcessFields(payload, args, playbook, client, logger)
+// Runs job with its own timeout, if it sets one, and retries it should it fail for any reason other than a transaction
+// failing to execute, which would only fail again. A job with an on-failure of continue that still fails is logged and
+// the playbook carries on.
+func runJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	if job.Target != "" {
+		var err error
+		playbook, client, err = playbook.OnTarget(job.Target, client)
+		if err != nil {
+			return fmt.Errorf("could not run job %s: %v", job.Name, err)
+		}
+		logger.InfoMsg("Running job against target", "Job Name", job.Name, "target", job.Target,
+			"chain", client.ChainAddress)
+	}
+	if job.Timeout != "" {
+		timeout, err := time.ParseDuration(job.Timeout)
+		if err != nil {
+			return fmt.Errorf("could not parse timeout of job %s: %v", job.Name, err)
+		}
+		client, err = client.WithTimeout(timeout, logger)
 		if err != nil {
 			return err
 		}
-		// Revalidate with possible replacements
-		err = payload.Validate()
+	}
+	err := retryJob(job, logger, func() error {
+		return doJob(job, playbook, args, client, logger)
+	})
+	if err != nil && job.OnFailure == def.OnFailureContinue {
+		logger.InfoMsg("Job failed, continuing", "Job Name", job.Name, "error", err)
+		return nil
+	}
+	return err
+}
+
+// Runs jobs one after the other as the jobs of a playbook run by playbook, so that they can refer to its jobs as well as
+// to each other and to bound, a job that has already run if not nil. Each job is run as a copy since the same jobs may
+// be run again. Returns the results of the jobs by name.
+func runNestedJobs(jobs []*def.Job, bound *def.Job, args *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) (map[string]interface{}, error) {
+	nested := *playbook
+	nested.Parent = playbook
+	nested.Jobs = nil
+	if bound != nil {
+		nested.Jobs = append(nested.Jobs, bound)
+	}
+	results := make(map[string]interface{}, len(jobs))
+	for _, job := range jobs {
+		job, err := job.Copy()
+		if err != nil {
+			return nil, err
+		}
+		nested.Jobs = append(nested.Jobs, job)
+		err = runJob(job, &nested, args, client, logger)
 		if err != nil {
-			return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+			return nil, err
 		}
+		results[job.Name] = job.Result
+	}
+	return results, nil
+}
 
-		switch payload.(type) {
-		case *def.Proposal:
-			announce(job.Name, "Proposal", logger)
-			job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
+// Calls do until it succeeds, fails with an execution exception, or has been retried job.Retries times, waiting
+// job.Backoff before the first retry and twice as long before each one after
+func retryJob(job *def.Job, logger *logging.Logger, do func() error) error {
+	backoff := defaultBackoff
+	if job.Backoff != "" {
+		var err error
+		backoff, err = time.ParseDuration(job.Backoff)
+		if err != nil {
+			return fmt.Errorf("could not parse backoff of job %s: %v", job.Name, err)
+		}
+	}
+	err := do()
+	for retry := 1; err != nil && retry <= job.Retries && !isExecutionFailure(err); retry++ {
+		logger.InfoMsg("Retrying job", "Job Name", job.Name, "retry", retry, "retries", job.Retries,
+			"backoff", backoff.String(), "error", err)
+		time.Sleep(backoff)
+		backoff *= 2
+		err = do()
+	}
+	return err
+}
 
-		// Meta Job
-		case *def.Meta:
-			announce(job.Name, "Meta", logger)
-			metaPlaybook := job.Meta.Playbook
-			if metaPlaybook.Account == "" {
-				metaPlaybook.Account = playbook.Account
-			}
-			err = doJobs(metaPlaybook, args, client, logger)
+// Whether err is from a transaction that failed to execute rather than from failing to reach the chain
+func isExecutionFailure(err error) bool {
+	var exception *errors.Exception
+	return stderrors.As(err, &exception)
+}
 
-		// Governance
-		case *def.UpdateAccount:
-			announce(job.Name, "UpdateAccount", logger)
-			var tx *pbpayload.GovTx
-			tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			err = UpdateAccountJob(tx, client, logger)
-
-		// Util jobs
-		case *def.Account:
-			announce(job.Name, "Account", logger)
-			job.Result, err = SetAccountJob(job.Account, playbook, logger)
-		case *def.Set:
-			announce(job.Name, "Set", logger)
-			job.Result, err = SetValJob(job.Set, args, logger)
-
-		// Transaction jobs
-		case *def.Send:
-			announce(job.Name, "Send", logger)
-			tx, err := FormulateSendJob(job.Send, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = SendJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Bond:
-			announce(job.Name, "Bond", logger)
-			tx, err := FormulateBondJob(job.Bond, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = BondJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Unbond:
-			announce(job.Name, "Unbond", logger)
-			tx, err := FormulateUnbondJob(job.Unbond, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = UnbondJob(tx, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.RegisterName:
-			announce(job.Name, "RegisterName", logger)
-			txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = RegisterNameJob(txs, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Permission:
-			announce(job.Name, "Permission", logger)
-			tx, err := FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
-			if err != nil {
-				return err
+// Runs the rollback jobs of each of succeeded, the last to succeed first, against the target of the job they roll back
+// unless they set their own. A rollback job that fails is logged and the rest are still run.
+func rollbackJobs(succeeded []*def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client,
+	logger *logging.Logger) {
+	for i := len(succeeded) - 1; i >= 0; i-- {
+		for _, job := range succeeded[i].Rollback {
+			logger.InfoMsg("Rolling back job", "Job Name", succeeded[i].Name, "rollback", job.Name)
+			rollbackPlaybook, rollbackClient := playbook, client
+			var err error
+			if target := firstNonEmpty(job.Target, succeeded[i].Target); target != "" {
+				rollbackPlaybook, rollbackClient, err = playbook.OnTarget(target, client)
 			}
-			job.Result, err = PermissionJob(tx, client, logger)
-			if err != nil {
-				return err
+			if err == nil {
+				err = doJob(job, rollbackPlaybook, args, rollbackClient, logger)
 			}
-		case *def.Identify:
-			announce(job.Name, "Identify", logger)
-			tx, err := FormulateIdentifyJob(job.Identify, playbook.Account, client, logger)
 			if err != nil {
-				return err
-			}
-			job.Result, err = IdentifyJob(tx, client, logger)
-			if err != nil {
-				return err
+				logger.InfoMsg("Rollback job failed", "Job Name", succeeded[i].Name, "rollback", job.Name,
+					"error", err)
 			}
+		}
+	}
+}
 
-		// Contracts jobs
-		case *def.Deploy:
-			announce(job.Name, "Deploy", logger)
-			txs, contracts, ferr := FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, err = DeployJob(job.Deploy, playbook, client, txs, contracts, logger)
+func doJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	payload, err := job.Payload()
+	if err != nil {
+		return fmt.Errorf("could not get Job payload: %v", payload)
+	}
 
-		case *def.Call:
-			announce(job.Name, "Call", logger)
-			CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, job.Variables, err = CallJob(job.Call, CallTx, playbook, client, logger)
-		case *def.Build:
-			announce(job.Name, "Build", logger)
-			var resp *compilers.Response
-			resp, err = getCompilerWork(job.Intermediate)
-			if err != nil {
-				return err
-			}
-			job.Result, err = BuildJob(job.Build, playbook, resp, logger)
-
-		// State jobs
-		case *def.RestoreState:
-			announce(job.Name, "RestoreState", logger)
-			job.Result, err = RestoreStateJob(job.RestoreState)
-		case *def.DumpState:
-			announce(job.Name, "DumpState", logger)
-			job.Result, err = DumpStateJob(job.DumpState)
-
-		// Test jobs
-		case *def.QueryAccount:
-			announce(job.Name, "QueryAccount", logger)
-			job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
-		case *def.QueryContract:
-			announce(job.Name, "QueryContract", logger)
-			job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
-		case *def.QueryName:
-			announce(job.Name, "QueryName", logger)
-			job.Result, err = QueryNameJob(job.QueryName, client, logger)
-		case *def.QueryVals:
-			announce(job.Name, "QueryVals", logger)
-			job.Result, err = QueryValsJob(job.QueryVals, client, logger)
-		case *def.Assert:
-			announce(job.Name, "Assert", logger)
-			job.Result, err = AssertJob(job.Assert, logger)
-
-		default:
-			logger.InfoMsg("Error")
-			return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
-				job)
-		}
-
-		if len(job.Variables) != 0 {
-			for _, theJob := range job.Variables {
-				logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
-			}
+	// A script reads its placeholders as values when it runs rather than having them replaced in its code
+	if _, ok := payload.(*def.Script); !ok {
+		err = util.PreProcessFields(payload, args, playbook, client, logger)
+		if err != nil {
+			return err
+		}
+	}
+	// Revalidate with possible replacements
+	err = payload.Validate()
+	if err != nil {
+		return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+	}
+
+	if args.Simulate && !simulates(payload) {
+		logger.InfoMsg("Skipping job that cannot be simulated", "Job Name", job.Name)
+		return nil
+	}
+
+	switch payload.(type) {
+	case *def.Proposal:
+		announce(job.Name, "Proposal", logger)
+		job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
+
+	// Meta Job
+	case *def.Meta:
+		announce(job.Name, "Meta", logger)
+		metaPlaybook := job.Meta.Playbook
+		if metaPlaybook.Account == "" {
+			metaPlaybook.Account = playbook.Account
 		}
+		err = doJobs(metaPlaybook, args, client, nil, logger)
 
+	// Governance
+	case *def.UpdateAccount:
+		announce(job.Name, "UpdateAccount", logger)
+		var tx *pbpayload.GovTx
+		tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		err = UpdateAccountJob(tx, client, logger)
+
+	// Util jobs
+	case *def.Account:
+		announce(job.Name, "Account", logger)
+		job.Result, err = SetAccountJob(job.Account, playbook, logger)
+	case *def.Set:
+		announce(job.Name, "Set", logger)
+		job.Result, err = SetValJob(job.Set, args, logger)
+	case *def.Script:
+		announce(job.Name, "Script", logger)
+		job.Result, job.Variables, err = ScriptJob(job.Script, args, playbook, client, logger)
+
+	// Transaction jobs
+	case *def.Send:
+		announce(job.Name, "Send", logger)
+		tx, err := FormulateSendJob(job.Send, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = SendJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Bond:
+		announce(job.Name, "Bond", logger)
+		tx, err := FormulateBondJob(job.Bond, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = BondJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Unbond:
+		announce(job.Name, "Unbond", logger)
+		tx, err := FormulateUnbondJob(job.Unbond, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = UnbondJob(tx, client, logger)
 		if err != nil {
 			return err
 		}
+	case *def.RegisterName:
+		announce(job.Name, "RegisterName", logger)
+		txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = RegisterNameJob(txs, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Permission:
+		announce(job.Name, "Permission", logger)
+		tx, err := FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = PermissionJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Identify:
+		announce(job.Name, "Identify", logger)
+		tx, err := FormulateIdentifyJob(job.Identify, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = IdentifyJob(tx, client, logger)
+		if err != nil {
+			return err
+		}
+
+	// Contracts jobs
+	case *def.Deploy:
+		announce(job.Name, "Deploy", logger)
+		txs, contracts, ferr := FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate,
+			playbook.JobGas(job.Name), playbook.JobManifest(job.Name), logger)
+		if ferr != nil {
+			return ferr
+		}
+		job.Result, err = DeployJob(job.Deploy, playbook, client, txs, contracts, playbook.JobGas(job.Name),
+			playbook.JobManifest(job.Name), logger)
+
+	case *def.Call:
+		announce(job.Name, "Call", logger)
+		CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
+		if ferr != nil {
+			return ferr
+		}
+		job.Result, job.Variables, err = CallJob(job.Call, CallTx, playbook, client, playbook.JobGas(job.Name), logger)
+	case *def.Proxy:
+		announce(job.Name, "Proxy", logger)
+		job.Result, err = ProxyJob(job.Proxy, args, playbook, client, playbook.JobGas(job.Name),
+			playbook.JobManifest(job.Name), logger)
+	case *def.Upgrade:
+		announce(job.Name, "Upgrade", logger)
+		job.Result, err = UpgradeJob(job.Upgrade, args, playbook, client, playbook.JobGas(job.Name), logger)
+	case *def.Build:
+		announce(job.Name, "Build", logger)
+		var resp *compilers.Response
+		resp, err = getCompilerWork(job.Intermediate)
+		if err != nil {
+			return err
+		}
+		job.Result, err = BuildJob(job.Build, playbook, resp, logger)
+
+	// State jobs
+	case *def.RestoreState:
+		announce(job.Name, "RestoreState", logger)
+		job.Result, err = RestoreStateJob(job.RestoreState)
+	case *def.DumpState:
+		announce(job.Name, "DumpState", logger)
+		job.Result, err = DumpStateJob(job.DumpState)
+	case *def.Snapshot:
+		announce(job.Name, "Snapshot", logger)
+		job.Result, err = SnapshotJob(job.Snapshot, client, logger)
+	case *def.RestoreSnapshot:
+		announce(job.Name, "RestoreSnapshot", logger)
+		job.Result, err = RestoreSnapshotJob(job.RestoreSnapshot, client, logger)
+
+	// Control jobs
+	case *def.ForEach:
+		announce(job.Name, "ForEach", logger)
+		job.Result, err = ForEachJob(job.ForEach, args, playbook, client, logger)
+	case *def.If:
+		announce(job.Name, "If", logger)
+		job.Result, err = IfJob(job.If, args, playbook, client, logger)
+
+	// Test jobs
+	case *def.QueryAccount:
+		announce(job.Name, "QueryAccount", logger)
+		job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
+	case *def.QueryContract:
+		announce(job.Name, "QueryContract", logger)
+		job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
+	case *def.QueryName:
+		announce(job.Name, "QueryName", logger)
+		job.Result, err = QueryNameJob(job.QueryName, client, logger)
+	case *def.QueryVals:
+		announce(job.Name, "QueryVals", logger)
+		job.Result, err = QueryValsJob(job.QueryVals, client, logger)
+	case *def.Assert:
+		announce(job.Name, "Assert", logger)
+		job.Result, err = AssertJob(job.Assert, logger)
+	case *def.AssertEvent:
+		announce(job.Name, "AssertEvent", logger)
+		job.Result, job.Variables, err = AssertEventJob(job.AssertEvent, args, playbook, client, logger)
+
+	default:
+		logger.InfoMsg("Error")
+		return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
+			job)
 	}
 
-	return nil
+	if len(job.Variables) != 0 {
+		for _, theJob := range job.Variables {
+			logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
+		}
+	}
+
+	return err
+}
+
+// Whether a job can run when simulating, which it can if it sends no transactions or only calls and deploys. Proxies
+// cannot be simulated since they check their implementation is deployed.
+func simulates(payload def.Payload) bool {
+	switch payload.(type) {
+	case *def.Send, *def.Bond, *def.Unbond, *def.RegisterName, *def.Permission, *def.Identify, *def.UpdateAccount,
+		*def.Proposal, *def.Proxy, *def.Upgrade, *def.Snapshot, *def.RestoreSnapshot:
+		return false
+	default:
+		return true
+	}
 }
 
 func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.Client, logger *logging.Logger) error {
+	return ExecutePlaybookAgain(args, playbook, nil, client, logger)
+}
+
+// ExecutePlaybookAgain executes playbook having already executed previous, an earlier load of the same playbook file,
+// keeping the results of the jobs of previous that would only do again what they did then rather than running them
+// (see reusableJobs). With a nil previous every job is run, as by ExecutePlaybook.
+func ExecutePlaybookAgain(args *def.DeployArgs, playbook, previous *def.Playbook, client *def.Client,
+	logger *logging.Logger) error {
 	// ADD DefaultAddr and DefaultSet to jobs array....
 	// These work in reverse order and the addendums to the
 	// the ordering from the loading process is lifo
@@ -312,18 +594,38 @@ func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.C
 		return fmt.Errorf("error validating Burrow deploy file at %s: %v", playbook.Filename, err)
 	}
 
+	solcVersions := compilers.NewSolcVersions(args.SolcCache, logger)
+
 	jobs := make(chan *compilerJob, concurrentSolcWorkQueue)
 	defer close(jobs)
 
 	for i := 0; i < concurrentSolc; i++ {
-		go solidityRunner(jobs, logger)
+		go solidityRunner(jobs, solcVersions, logger)
 	}
 
+	if playbook.GasReport == nil {
+		playbook.GasReport = def.NewGasReport(playbook.Filename)
+	}
+
+	solc := firstNonEmpty(playbook.Solc, args.Solc)
 	for _, job := range playbook.Jobs {
-		queueCompilerWork(job, playbook, jobs, args.Wasm)
+		queueCompilerWork(job, playbook, jobs, args.Wasm, solc)
 	}
 
-	err = doJobs(playbook, args, client, logger)
+	var reused map[string]*def.Job
+	if previous != nil {
+		reused, err = reusableJobs(playbook, previous)
+		if err != nil {
+			return err
+		}
+		for _, job := range playbook.Jobs {
+			if _, ok := reused[job.Name]; ok {
+				playbook.Manifest.Reuse(previous.Manifest, job.Name)
+			}
+		}
+	}
+
+	err = doJobs(playbook, args, client, reused, logger)
 	if err != nil {
 		return err
 	}
@@ -380,6 +682,14 @@ func postProcess(args *def.DeployArgs, playbook *def.Playbook, logger *logging.L
 		results[job.Name] = job.Result
 	}
 
+	// Simulated results would only mislead anything that reads the output file
+	if args.Simulate {
+		for _, job := range playbook.Jobs {
+			logger.InfoMsg("Simulated result", "job", job.Name, "result", job.Result)
+		}
+		return nil
+	}
+
 	// check do.YAMLPath and do.DefaultOutput
 	var yaml string
 	yamlName := strings.LastIndexByte(playbook.Filename, '.')
@@ -397,10 +707,10 @@ func postProcess(args *def.DeployArgs, playbook *def.Playbook, logger *logging.L
 	// if CurrentOutput set, we're in a meta job
 	if args.CurrentOutput != "" {
 		logger.InfoMsg("Writing meta output to current directory", "output", args.CurrentOutput)
-		return WriteJobResultJSON(results, args.CurrentOutput)
+		return WriteJobResultJSON(results, args.CurrentOutput, playbook.Secrets...)
 	}
 
 	// Write the output
 	logger.InfoMsg("Writing to current directory", "output", args.DefaultOutput)
-	return WriteJobResultJSON(results, args.DefaultOutput)
+	return WriteJobResultJSON(results, args.DefaultOutput, playbook.Secrets...)
 }
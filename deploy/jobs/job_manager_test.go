@@ -0,0 +1,84 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryJob(t *testing.T) {
+	logger := logging.NewNoopLogger()
+	job := &def.Job{Name: "flaky", Retries: 2, Backoff: "1ms"}
+
+	t.Run("Succeeds", func(t *testing.T) {
+		attempts := 0
+		err := retryJob(job, logger, func() error {
+			attempts++
+			if attempts < 3 {
+				return fmt.Errorf("connection refused")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("RetriesExhausted", func(t *testing.T) {
+		attempts := 0
+		err := retryJob(job, logger, func() error {
+			attempts++
+			return fmt.Errorf("connection refused")
+		})
+		require.EqualError(t, err, "connection refused")
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("ExecutionFailure", func(t *testing.T) {
+		attempts := 0
+		err := retryJob(job, logger, func() error {
+			attempts++
+			return fmt.Errorf("error in CallJob: %w", errors.Errorf(errors.Codes.ExecutionReverted, "no"))
+		})
+		require.Error(t, err)
+		assert.Equal(t, 1, attempts, "a transaction that failed to execute should not be retried")
+	})
+}
+
+func TestPostProcessSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-deploy-output")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	output := filepath.Join(dir, "deploy.output.json")
+	playbook := &def.Playbook{
+		Filename: "deploy.yaml",
+		Jobs: []*def.Job{
+			{Name: "token", Result: "ABCDEF"},
+			{Name: "login", Result: "s3cret"},
+			{Name: "encoded", Result: "0000000000000000000000000000000000000000000000000000000000000006" +
+				"7333637265740000000000000000000000000000000000000000000000000000"},
+		},
+		Secrets: []string{"s3cret"},
+	}
+	require.NoError(t, postProcess(&def.DeployArgs{DefaultOutput: output}, playbook, logging.NewNoopLogger()))
+
+	bs, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	results := make(map[string]string)
+	require.NoError(t, json.Unmarshal(bs, &results))
+	assert.Equal(t, map[string]string{
+		"token": "ABCDEF",
+		"login": loggers.Redacted,
+		"encoded": "0000000000000000000000000000000000000000000000000000000000000006" + loggers.Redacted +
+			"0000000000000000000000000000000000000000000000000000",
+	}, results)
+}
@@ -91,7 +91,10 @@ func BuildJob(build *def.Build, deployScript *def.Playbook, resp *compilers.Resp
 	return "", nil
 }
 
-func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *def.Playbook, client *def.Client, intermediate interface{}, logger *logging.Logger) (txs []*payload.CallTx, contracts []*compilers.ResponseItem, err error) {
+// FormulateDeployJob returns the transactions deploying the contracts of a deploy job. The libraries they link to that
+// the job does not give the addresses of are deployed first, with their gas recorded in gas and their deployment in
+// manifest, unless gas is nil.
+func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *def.Playbook, client *def.Client, intermediate interface{}, gas *def.JobGas, manifest *def.JobManifest, logger *logging.Logger) (txs []*payload.CallTx, contracts []*compilers.ResponseItem, err error) {
 	deploy.Libraries, _ = util.PreProcessLibs(deploy.Libraries, do, deployScript, client, logger)
 	// trim the extension and path
 	contractName := filepath.Base(deploy.Contract)
@@ -131,10 +134,18 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 	contracts = make([]*compilers.ResponseItem, 0)
 
 	// compile
-	if filepath.Ext(deploy.Contract) != ".sol" {
+	if !isSource(deploy.Contract) {
 		logger.InfoMsg("Binary file detected. Using binary deploy sequence.", "Binary path", contractPath)
 
-		contract, err := compilers.LoadSolidityContract(contractPath)
+		var contract *compilers.SolidityContract
+		switch filepath.Ext(deploy.Contract) {
+		case ".wasm":
+			contract, err = compilers.LoadWASMContract(contractPath)
+		case ".json":
+			contract, err = compilers.LoadArtifact(contractPath)
+		default:
+			contract, err = compilers.LoadSolidityContract(contractPath)
+		}
 		if err != nil {
 			return nil, nil, fmt.Errorf("unable to read contract %s: %v", contractPath, err)
 		}
@@ -142,6 +153,8 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 		if err != nil {
 			return nil, nil, fmt.Errorf("unable to link contract %s: %v", contractPath, err)
 		}
+		// WASM code is sent separately to any constructor arguments which are sent as data
+		wasm := contract.EWasm.Wasm
 		contractCode := contract.Evm.Bytecode.Object
 
 		mergeAbiSpecBytes(client, contract.Abi)
@@ -164,7 +177,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 			return nil, nil, err
 		}
 
-		tx, err := deployTx(client, deploy, contractName, string(contractCode), "", metaMap, logger)
+		tx, err := deployTx(client, deploy, contractName, string(contractCode), wasm, metaMap, logger)
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not deploy binary contract: %v", err)
 		}
@@ -189,6 +202,10 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 		} else if resp.Warning != "" {
 			logger.InfoMsg("Warning during contract compilation", "warning", resp.Warning)
 		}
+		var libraries *libraryDeployer
+		if gas != nil {
+			libraries = newLibraryDeployer(deploy, resp.Objects, libs, client, deployScript, gas, manifest, logger)
+		}
 		// loop through objects returned from compiler
 		switch {
 		case len(resp.Objects) == 1:
@@ -202,7 +219,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 			}
 			mergeAbiSpecBytes(client, response.Contract.Abi)
 
-			tx, err := deployContract(deploy, do, deployScript, client, response, libs, logger)
+			tx, err := deployContract(deploy, do, deployScript, client, response, libs, libraries, logger)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -219,7 +236,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 					continue
 				}
 				mergeAbiSpecBytes(client, response.Contract.Abi)
-				tx, err := deployContract(deploy, do, deployScript, client, response, libs, logger)
+				tx, err := deployContract(deploy, do, deployScript, client, response, libs, libraries, logger)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -256,7 +273,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 						"contract", response.Objectname,
 						"Abi", string(response.Contract.Abi),
 						"Bin", response.Contract.Evm.Bytecode.Object)
-					tx, err := deployContract(deploy, do, deployScript, client, response, libs, logger)
+					tx, err := deployContract(deploy, do, deployScript, client, response, libs, libraries, logger)
 					if err != nil {
 						return nil, nil, err
 					}
@@ -271,7 +288,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 	return
 }
 
-func DeployJob(deploy *def.Deploy, script *def.Playbook, client *def.Client, txs []*payload.CallTx, contracts []*compilers.ResponseItem, logger *logging.Logger) (result string, err error) {
+func DeployJob(deploy *def.Deploy, script *def.Playbook, client *def.Client, txs []*payload.CallTx, contracts []*compilers.ResponseItem, gas *def.JobGas, manifest *def.JobManifest, logger *logging.Logger) (result string, err error) {
 	// saving contract
 	// additional data may be sent along with the contract
 	// these are naively added to the end of the contract code using standard
@@ -279,7 +296,7 @@ func DeployJob(deploy *def.Deploy, script *def.Playbook, client *def.Client, txs
 
 	for i, tx := range txs {
 		// Sign, broadcast, display
-		contractAddress, err := deployFinalize(client, tx, logger)
+		contractAddress, txe, err := deployFinalize(client, tx, logger)
 		if err != nil {
 			return "", fmt.Errorf("error finalizing contract deploy %s: %w", deploy.Contract, err)
 		}
@@ -287,6 +304,9 @@ func DeployJob(deploy *def.Deploy, script *def.Playbook, client *def.Client, txs
 		// saving contract/library abi at abi/address
 		if contracts != nil && contractAddress != nil {
 			contract := contracts[i].Contract
+			gas.Deployed(contracts[i].Objectname, *contractAddress, txe.GetResult().GetGasUsed())
+			manifest.Deployed(contracts[i].Objectname, *contractAddress, txe, constructorArguments(&contract, tx),
+				contract.Abi)
 			// saving binary
 			logger.TraceMsg("Saving Binary", "address", contractAddress.String())
 			err = contract.Save(script.BinPath, fmt.Sprintf("%s.bin", contractAddress.String()))
@@ -303,6 +323,32 @@ func DeployJob(deploy *def.Deploy, script *def.Playbook, client *def.Client, txs
 	return result, nil
 }
 
+// Decodes the constructor arguments appended to the code of contract in the deploy transaction tx, or those it was
+// sent alongside its WASM code
+func constructorArguments(contract *compilers.SolidityContract, tx *payload.CallTx) []string {
+	spec, err := abi.ReadSpec(contract.Abi)
+	if err != nil || len(spec.Constructor.Inputs) == 0 {
+		return nil
+	}
+	data := tx.Data
+	if len(tx.WASM) == 0 {
+		code := len(contract.Evm.Bytecode.Object) / 2
+		if len(data) < code {
+			return nil
+		}
+		data = data[code:]
+	}
+	values := make([]string, len(spec.Constructor.Inputs))
+	args := make([]interface{}, len(values))
+	for i := range values {
+		args[i] = &values[i]
+	}
+	if abi.Unpack(spec.Constructor.Inputs, data, args...) != nil {
+		return nil
+	}
+	return values
+}
+
 func matchInstanceName(objectName, deployInstance string) bool {
 	if objectName == "" {
 		return false
@@ -333,7 +379,7 @@ func findContractFile(contract, binPath string, deployPath string) (string, erro
 }
 
 // TODO [rj] refactor to remove [contractPath] from functions signature => only used in a single error throw.
-func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook, client *def.Client, compilersResponse compilers.ResponseItem, libs map[string]string, logger *logging.Logger) (*payload.CallTx, error) {
+func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook, client *def.Client, compilersResponse compilers.ResponseItem, libs map[string]string, libraries *libraryDeployer, logger *logging.Logger) (*payload.CallTx, error) {
 	contract := compilersResponse.Contract
 	contractName := compilersResponse.Objectname
 	logger.InfoMsg("Saving Binary", "contract", contractName)
@@ -358,6 +404,12 @@ func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook
 	if contract.EWasm.Wasm != "" {
 		wasm = contract.EWasm.Wasm
 	} else {
+		if libraries != nil {
+			err = libraries.Resolve(&contract)
+			if err != nil {
+				return nil, err
+			}
+		}
 		err = contract.Link(libs)
 		if err != nil {
 			return nil, err
@@ -490,7 +542,7 @@ func FormulateCallJob(call *def.Call, do *def.DeployArgs, deployScript *def.Play
 	}, logger)
 }
 
-func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client *def.Client, logger *logging.Logger) (string, []*abi.Variable, error) {
+func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client *def.Client, gas *def.JobGas, logger *logging.Logger) (string, []*abi.Variable, error) {
 
 	// Sign, broadcast, display
 	txe, err := client.SignAndBroadcast(tx, logger)
@@ -501,18 +553,24 @@ func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client
 	if txe.Exception != nil {
 		switch txe.Exception.ErrorCode() {
 		case errors.Codes.ExecutionReverted:
-			message, err := abi.UnpackRevert(txe.Result.Return)
-			if err != nil {
-				return "", nil, err
+			revert := txe.Exception.Revert
+			if revert == nil {
+				// Older servers do not decode the revert
+				revert = abi.DecodeRevert(txe.Result.Return)
 			}
-			if message != nil {
-				logger.InfoMsg("Transaction reverted with reason",
-					"Revert Reason", *message)
-				return *message, nil, txe.Exception.AsError()
-			} else {
+			if revert == nil {
 				logger.InfoMsg("Transaction reverted with no reason")
 				return "", nil, txe.Exception.AsError()
 			}
+			logger.InfoMsg("Transaction reverted with reason",
+				"Revert Name", revert.Name,
+				"Revert Reason", revert.Reason,
+				"Panic Code", revert.PanicCode,
+				"Error Args", revert.Args)
+			if revert.Name == abi.RevertErrorName {
+				return revert.Reason, nil, txe.Exception.AsError()
+			}
+			return revert.String(), nil, txe.Exception.AsError()
 		default:
 			logger.InfoMsg("Transaction execution exception")
 			return "", nil, txe.Exception.AsError()
@@ -520,6 +578,8 @@ func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client
 	}
 
 	logEvents(txe, client, logger)
+	call.Events = txe.Events
+	gas.Called(*tx.Address, FirstOf(call.Function, "fallback"), txe.GetResult().GetGasUsed())
 
 	var result string
 
@@ -555,10 +615,11 @@ func CallJob(call *def.Call, tx *payload.CallTx, playbook *def.Playbook, client
 	return result, call.Variables, nil
 }
 
-func deployFinalize(client *def.Client, tx payload.Payload, logger *logging.Logger) (*crypto.Address, error) {
+// Sends the deploy transaction and returns the address of the contract and the execution that deployed it
+func deployFinalize(client *def.Client, tx payload.Payload, logger *logging.Logger) (*crypto.Address, *exec.TxExecution, error) {
 	txe, err := client.SignAndBroadcast(tx, logger)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	LogTxExecution(txe, logger)
@@ -568,9 +629,9 @@ func deployFinalize(client *def.Client, tx payload.Payload, logger *logging.Logg
 
 	if !txe.Receipt.CreatesContract || txe.Receipt.ContractAddress == crypto.ZeroAddress {
 		// Shouldn't get ZeroAddress when CreatesContract is true, but still
-		return nil, fmt.Errorf("result from SignAndBroadcast does not contain address for the deployed contract")
+		return nil, nil, fmt.Errorf("result from SignAndBroadcast does not contain address for the deployed contract")
 	}
-	return &txe.Receipt.ContractAddress, nil
+	return &txe.Receipt.ContractAddress, txe, nil
 }
 
 func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger) {
@@ -584,14 +645,14 @@ func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger
 
 		eventLog := event.GetLog()
 
-		if eventLog == nil || client.AllSpecs == nil {
+		if eventLog == nil {
 			continue
 		}
 
 		var eventID abi.EventID
 		copy(eventID[:], eventLog.GetTopic(0).Bytes())
 
-		evAbi, ok := client.AllSpecs.EventsByID[eventID]
+		evAbi, ok := client.EventSpec(eventID)
 		if !ok {
 			logger.InfoMsg("Could not find ABI for Event", "Event ID", hex.EncodeUpperToString(eventID[:]))
 			continue
@@ -619,6 +680,6 @@ func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger
 func mergeAbiSpecBytes(client *def.Client, bs []byte) {
 	spec, err := abi.ReadSpec(bs)
 	if err == nil {
-		client.AllSpecs = abi.MergeSpec([]*abi.Spec{client.AllSpecs, spec})
+		client.MergeSpec(spec)
 	}
 }
@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// ForEachJob runs the jobs of forEach once for each of its values in turn, with the value bound to its variable, and
+// returns the results of the jobs of each run by name
+func ForEachJob(forEach *def.ForEach, do *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) ([]map[string]interface{}, error) {
+	values, err := forEachValues(forEach, do, playbook, client, logger)
+	if err != nil {
+		return nil, err
+	}
+	variable := firstNonEmpty(forEach.As, def.DefaultForEachVariable)
+	results := make([]map[string]interface{}, 0, len(values))
+	for i, value := range values {
+		logger.InfoMsg("Running for-each jobs", "run", i+1, "runs", len(values), variable, value)
+		// Bind the value as the result of a set job so the jobs refer to it as to any other
+		bound := &def.Job{
+			Name:   variable,
+			Set:    &def.Set{Value: value},
+			Result: value,
+		}
+		result, err := runNestedJobs(forEach.Jobs, bound, do, playbook, client, logger)
+		if err != nil {
+			return nil, fmt.Errorf("for-each run with %s of %s failed: %w", variable, value, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// The values of forEach with any variables replaced, or else the integers of its range
+func forEachValues(forEach *def.ForEach, do *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) ([]string, error) {
+	if len(forEach.Items) > 0 {
+		values := make([]string, len(forEach.Items))
+		for i, item := range forEach.Items {
+			value, err := util.PreProcess(item, do, playbook, client, logger)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	}
+	from, err := strconv.ParseInt(forEach.From, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse from of for-each: %v", err)
+	}
+	to, err := strconv.ParseInt(forEach.To, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse to of for-each: %v", err)
+	}
+	var values []string
+	for i := from; i <= to; i++ {
+		values = append(values, strconv.FormatInt(i, 10))
+	}
+	return values, nil
+}
+
+// IfJob runs the then jobs of cond should its relation hold and its else jobs otherwise, returning the results of the
+// jobs run by name
+func IfJob(cond *def.If, do *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) (map[string]interface{}, error) {
+	holds, err := relationHolds(cond.Key, cond.Relation, cond.Value)
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoMsg("Condition",
+		"key", cond.Key,
+		"relation", cond.Relation,
+		"value", cond.Value,
+		"holds", holds)
+	jobs := cond.Then
+	if !holds {
+		jobs = cond.Else
+	}
+	return runNestedJobs(jobs, nil, do, playbook, client, logger)
+}
@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachJob(t *testing.T) {
+	logger := logging.NewNoopLogger()
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{{Name: "greeting", Set: &def.Set{Value: "hello"}, Result: "hello"}},
+	}
+	forEach := &def.ForEach{
+		Items: []string{"alice", "$greeting"},
+		As:    "who",
+		Jobs: []*def.Job{
+			{Name: "message", Set: &def.Set{Value: "$greeting $who"}},
+			{Name: "echo", Set: &def.Set{Value: "$message"}},
+		},
+	}
+	results, err := ForEachJob(forEach, &def.DeployArgs{}, playbook, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"message": "hello alice", "echo": "hello alice"},
+		{"message": "hello hello", "echo": "hello hello"},
+	}, results)
+	// The jobs are left as they were to run again
+	assert.Equal(t, "$greeting $who", forEach.Jobs[0].Set.Value)
+	assert.Nil(t, forEach.Jobs[0].Result)
+
+	forEach = &def.ForEach{
+		From: "1",
+		To:   "3",
+		Jobs: []*def.Job{{Name: "n", Set: &def.Set{Value: "$item"}}},
+	}
+	results, err = ForEachJob(forEach, &def.DeployArgs{}, playbook, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"n": "1"}, {"n": "2"}, {"n": "3"}}, results)
+}
+
+func TestIfJob(t *testing.T) {
+	logger := logging.NewNoopLogger()
+	playbook := &def.Playbook{}
+	cond := &def.If{
+		Key:      "5",
+		Relation: "gt",
+		Value:    "3",
+		Then:     []*def.Job{{Name: "big", Set: &def.Set{Value: "yes"}}},
+		Else:     []*def.Job{{Name: "small", Set: &def.Set{Value: "yes"}}},
+	}
+	results, err := IfJob(cond, &def.DeployArgs{}, playbook, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"big": "yes"}, results)
+
+	cond.Relation = "<="
+	results, err = IfJob(cond, &def.DeployArgs{}, playbook, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"small": "yes"}, results)
+
+	cond.Key = "five"
+	_, err = IfJob(cond, &def.DeployArgs{}, playbook, nil, logger)
+	require.Error(t, err)
+}
@@ -0,0 +1,282 @@
+package jobs
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	compilers "github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/create2"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Deploy jobs may run concurrently, so only one at a time looks for and deploys libraries so that each is deployed
+// once and later jobs find it
+var librariesMtx sync.Mutex
+
+// Deploys the libraries a contract links to that were not given in the deploy job
+type libraryDeployer struct {
+	deploy   *def.Deploy
+	objects  []compilers.ResponseItem
+	libs     map[string]string
+	client   *def.Client
+	script   *def.Playbook
+	gas      *def.JobGas
+	manifest *def.JobManifest
+	logger   *logging.Logger
+	factory  *crypto.Address
+	// The libraries being deployed, so that a library that links to itself is caught
+	linking map[string]bool
+}
+
+func newLibraryDeployer(deploy *def.Deploy, objects []compilers.ResponseItem, libs map[string]string,
+	client *def.Client, script *def.Playbook, gas *def.JobGas, manifest *def.JobManifest,
+	logger *logging.Logger) *libraryDeployer {
+	return &libraryDeployer{
+		deploy:   deploy,
+		objects:  objects,
+		libs:     libs,
+		client:   client,
+		script:   script,
+		gas:      gas,
+		manifest: manifest,
+		logger:   logger,
+		linking:  make(map[string]bool),
+	}
+}
+
+// Resolve deploys, or finds already deployed, each library contract links to that has no address yet, along with
+// the libraries those link to, adding their addresses to the libraries to link with
+func (ld *libraryDeployer) Resolve(contract *compilers.SolidityContract) error {
+	librariesMtx.Lock()
+	defer librariesMtx.Unlock()
+	return ld.resolve(contract)
+}
+
+func (ld *libraryDeployer) resolve(contract *compilers.SolidityContract) error {
+	names, err := contract.LinkReferences()
+	if err != nil {
+		return fmt.Errorf("could not read link references: %v", err)
+	}
+	for _, name := range names {
+		if _, ok := ld.libs[name]; ok {
+			continue
+		}
+		if ld.linking[name] {
+			return fmt.Errorf("library %s links to itself", name)
+		}
+		err = ld.deployLibrary(name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ld *libraryDeployer) deployLibrary(name string) error {
+	var lib *compilers.SolidityContract
+	for i, obj := range ld.objects {
+		if obj.Objectname == name && obj.Contract.Evm.Bytecode.Object != "" {
+			contract := ld.objects[i].Contract
+			lib = &contract
+			break
+		}
+	}
+	if lib == nil {
+		return fmt.Errorf("library %s is not defined; it must be compiled with the contract or its address given "+
+			"in libraries", name)
+	}
+	// Linking changes the metadata shared by all the contracts compiled together
+	lib.MetadataMap = append([]compilers.MetadataMap(nil), lib.MetadataMap...)
+
+	ld.linking[name] = true
+	defer delete(ld.linking, name)
+	err := ld.resolve(lib)
+	if err != nil {
+		return err
+	}
+	err = lib.Link(ld.libs)
+	if err != nil {
+		return fmt.Errorf("unable to link library %s: %v", name, err)
+	}
+
+	// Libraries embed their address in their code once deployed, which is left out of the hash of the code compiled
+	var codeHash []byte
+	if runtime, err := hex.DecodeString(lib.Evm.DeployedBytecode.Object); err == nil && len(runtime) > 0 {
+		codeHash = crypto.Keccak256(runtime)
+	}
+
+	var address *crypto.Address
+	if ld.deploy.Salt != "" && !ld.client.Simulate {
+		address, err = ld.create(name, lib, codeHash)
+	} else {
+		address, err = ld.deployed(name, codeHash)
+		if err == nil && address == nil {
+			address, err = ld.send(name, lib)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	err = lib.Save(ld.script.BinPath, fmt.Sprintf("%s.bin", name))
+	if err != nil {
+		return err
+	}
+	err = lib.Save(ld.script.BinPath, fmt.Sprintf("%s.bin", address.String()))
+	if err != nil {
+		return err
+	}
+	ld.libs[name] = address.String()
+	return nil
+}
+
+// Finds a library named name already deployed with the code that has codeHash
+func (ld *libraryDeployer) deployed(name string, codeHash []byte) (*crypto.Address, error) {
+	if codeHash == nil {
+		return nil, nil
+	}
+	contracts, err := ld.client.ListContracts(name, ld.logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not look for deployed library %s: %v", name, err)
+	}
+	for _, contract := range contracts {
+		if bytes.Equal(contract.CodeHash, codeHash) {
+			ld.logger.InfoMsg("Reusing deployed library", "library", name, "address", contract.Address.String())
+			return &contract.Address, nil
+		}
+	}
+	return nil, nil
+}
+
+// Deploys the library in a transaction of its own
+func (ld *libraryDeployer) send(name string, lib *compilers.SolidityContract) (*crypto.Address, error) {
+	metaMap, err := lib.GetMetadata(ld.logger)
+	if err != nil {
+		return nil, err
+	}
+	ld.logger.InfoMsg("Deploying library", "library", name)
+	tx, err := deployTx(ld.client, ld.libraryDeploy(), name, lib.Evm.Bytecode.Object, "", metaMap, ld.logger)
+	if err != nil {
+		return nil, err
+	}
+	address, txe, err := deployFinalize(ld.client, tx, ld.logger)
+	if err != nil {
+		return nil, fmt.Errorf("error deploying library %s: %w", name, err)
+	}
+	ld.gas.Deployed(name, *address, txe.GetResult().GetGasUsed())
+	ld.manifest.Deployed(name, *address, txe, nil, lib.Abi)
+	return address, nil
+}
+
+// Creates the library through the CREATE2 factory with the job's salt, unless it is already at the address that gives
+func (ld *libraryDeployer) create(name string, lib *compilers.SolidityContract,
+	codeHash []byte) (*crypto.Address, error) {
+	factory, err := ld.factoryAddress()
+	if err != nil {
+		return nil, err
+	}
+	initCode, err := hex.DecodeString(lib.Evm.Bytecode.Object)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode code of library %s: %v", name, err)
+	}
+	salt := create2.Salt(ld.deploy.Salt, initCode)
+	address := create2.Address(factory, salt)
+
+	acc, err := ld.client.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if acc != nil && len(acc.EVMCode) > 0 {
+		if codeHash != nil && !bytes.Equal(compilers.GetDeployCodeHash(acc.EVMCode, address), codeHash) {
+			return nil, fmt.Errorf("library %s should be at %v but the code there is different", name, address)
+		}
+		ld.logger.InfoMsg("Reusing deployed library", "library", name, "address", address.String())
+		return &address, nil
+	}
+
+	ld.logger.InfoMsg("Creating library", "library", name, "factory", factory.String(), "salt", ld.deploy.Salt)
+	deploy := ld.libraryDeploy()
+	tx, err := ld.client.Call(&def.CallArg{
+		Input:   deploy.Source,
+		Address: factory.String(),
+		Fee:     deploy.Fee,
+		Gas:     deploy.Gas,
+		Data:    hex.EncodeToString(create2.Call(salt, initCode)),
+	}, ld.logger)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := ld.client.SignAndBroadcast(tx, ld.logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating library %s: %w", name, err)
+	}
+	LogTxExecution(txe, ld.logger)
+	created := crypto.AddressFromWord256(binary.LeftPadWord256(txe.GetResult().GetReturn()))
+	if created != address {
+		return nil, fmt.Errorf("library %s was created at %v rather than %v", name, created, address)
+	}
+	ld.gas.Deployed(name, address, txe.GetResult().GetGasUsed())
+	ld.manifest.Deployed(name, address, txe, nil, lib.Abi)
+	return &address, nil
+}
+
+// Returns the factory given in the job, or the first on the chain, deploying one if there are none
+func (ld *libraryDeployer) factoryAddress() (crypto.Address, error) {
+	if ld.factory != nil {
+		return *ld.factory, nil
+	}
+	if ld.deploy.Factory != "" {
+		address, err := ld.client.ParseAddress(ld.deploy.Factory, ld.logger)
+		if err != nil {
+			return crypto.Address{}, err
+		}
+		acc, err := ld.client.GetAccount(address)
+		if err != nil {
+			return crypto.Address{}, err
+		}
+		if acc == nil || !bytes.Equal(acc.EVMCode, create2.Runtime) {
+			return crypto.Address{}, fmt.Errorf("%v is not a CREATE2 factory", address)
+		}
+		ld.factory = &address
+		return address, nil
+	}
+
+	factories, err := ld.client.ListAccountsByCodeHash(crypto.Keccak256(create2.Runtime), ld.logger)
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("could not look for a CREATE2 factory: %v", err)
+	}
+	if len(factories) > 0 {
+		ld.factory = &factories[0].Address
+		return *ld.factory, nil
+	}
+
+	// The factory is deployed without metadata so that it may create any code
+	ld.logger.InfoMsg("Deploying CREATE2 factory")
+	tx, err := deployTx(ld.client, ld.libraryDeploy(), create2.ContractName, hex.EncodeToString(create2.Code), "", nil,
+		ld.logger)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	address, txe, err := deployFinalize(ld.client, tx, ld.logger)
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("error deploying CREATE2 factory: %w", err)
+	}
+	ld.gas.Deployed(create2.ContractName, *address, txe.GetResult().GetGasUsed())
+	ld.manifest.Deployed(create2.ContractName, *address, txe, nil, nil)
+	ld.factory = address
+	return *address, nil
+}
+
+// Libraries are sent from the job's source with its fee and gas, but none of the value or sequence meant for the
+// contract
+func (ld *libraryDeployer) libraryDeploy() *def.Deploy {
+	deploy := *ld.deploy
+	deploy.Amount = ""
+	deploy.Sequence = ""
+	return &deploy
+}
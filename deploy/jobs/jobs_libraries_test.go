@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"encoding/json"
+	"testing"
+
+	compilers "github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryDeployer(t *testing.T) {
+	placeholder := "__$3f1f4d5b6e8a3f0c2d7e9b1a4c6d8e0f2a$__"
+	linking := func(name string, libs ...string) compilers.ResponseItem {
+		item := compilers.ResponseItem{Filename: "Libs.sol", Objectname: name}
+		item.Contract.Evm.Bytecode.Object = "6073"
+		refs := make(map[string]interface{})
+		for i, lib := range libs {
+			item.Contract.Evm.Bytecode.Object += placeholder
+			refs[lib] = []map[string]int{{"start": 2 + 20*i, "length": 20}}
+		}
+		if len(libs) > 0 {
+			bs, err := json.Marshal(map[string]interface{}{"Libs.sol": refs})
+			require.NoError(t, err)
+			item.Contract.Evm.Bytecode.LinkReferences = bs
+		}
+		return item
+	}
+	deployer := func(libs map[string]string, objects ...compilers.ResponseItem) *libraryDeployer {
+		return newLibraryDeployer(&def.Deploy{}, objects, libs, nil, &def.Playbook{}, nil, nil,
+			logging.NewNoopLogger())
+	}
+	libraryAddress := "1234567890123456789012345678901234567890"
+
+	t.Run("Given", func(t *testing.T) {
+		contract := linking("C", "A", "B")
+		libs := map[string]string{"A": libraryAddress, "B": libraryAddress}
+		require.NoError(t, deployer(libs).Resolve(&contract.Contract))
+		require.NoError(t, contract.Contract.Link(libs))
+		assert.Equal(t, "6073"+libraryAddress+libraryAddress, contract.Contract.Evm.Bytecode.Object)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		contract := linking("C", "A")
+		err := deployer(map[string]string{}, linking("B")).Resolve(&contract.Contract)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "library A is not defined")
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		contract := linking("C", "A")
+		err := deployer(map[string]string{}, linking("A", "B"), linking("B", "A")).Resolve(&contract.Contract)
+		assert.EqualError(t, err, "library A links to itself")
+	})
+}
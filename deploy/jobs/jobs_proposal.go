@@ -108,7 +108,7 @@ func recurseJobs(proposeBatch *payload.BatchTx, jobs []*def.Job, prop *def.Propo
 			if err != nil {
 				return err
 			}
-			deployTxs, _, err := FormulateDeployJob(job.Deploy, do, &script, client, job.Intermediate, logger)
+			deployTxs, _, err := FormulateDeployJob(job.Deploy, do, &script, client, job.Intermediate, nil, nil, logger)
 			if err != nil {
 				return err
 			}
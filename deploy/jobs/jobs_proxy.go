@@ -0,0 +1,250 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	compilers "github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/proxy"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/logging"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// ProxyJob deploys a proxy to an implementation, which it initialises by calling the function the job names. The
+// implementation's bin file is saved as that of the proxy so that later jobs can call the proxy with its ABI.
+func ProxyJob(prx *def.Proxy, do *def.DeployArgs, script *def.Playbook, client *def.Client, gas *def.JobGas,
+	manifest *def.JobManifest, logger *logging.Logger) (string, error) {
+	prx.Source = FirstOf(prx.Source, script.Account)
+	prx.Fee = FirstOf(prx.Fee, do.DefaultFee)
+	prx.Gas = FirstOf(prx.Gas, do.DefaultGas)
+
+	implementation, err := client.ParseAddress(prx.Implementation, logger)
+	if err != nil {
+		return "", err
+	}
+	admin, err := client.ParseAddress(prx.Admin, logger)
+	if err != nil {
+		return "", err
+	}
+	if prx.Admin == prx.Source {
+		logger.InfoMsg("Proxy admin is the source account, whose calls to the proxy will not reach the implementation",
+			"admin", admin.String())
+	}
+	contract, err := loadImplementation(implementation, script)
+	if err != nil {
+		return "", err
+	}
+	data, err := implementationCall(prx.Function, prx.Data, contract, implementation, do, script, client, logger)
+	if err != nil {
+		return "", err
+	}
+	code, err := proxy.Code(implementation, admin, data)
+	if err != nil {
+		return "", err
+	}
+
+	logger.TraceMsg("Deploying Proxy",
+		"implementation", implementation.String(),
+		"admin", admin.String(),
+		"source", prx.Source)
+	tx, err := client.Call(&def.CallArg{
+		Input:    prx.Source,
+		Fee:      prx.Fee,
+		Gas:      prx.Gas,
+		Data:     hex.EncodeToString(code),
+		Sequence: prx.Sequence,
+	}, logger)
+	if err != nil {
+		return "", err
+	}
+	address, txe, err := deployFinalize(client, tx, logger)
+	if err != nil {
+		return "", fmt.Errorf("error deploying proxy to %v: %w", implementation, err)
+	}
+	gas.Deployed(proxy.ContractName, *address, txe.GetResult().GetGasUsed())
+	manifest.Deployed(proxy.ContractName, *address, txe, []string{implementation.String(), admin.String()},
+		json.RawMessage(proxy.ABI))
+
+	// The admin calls the proxy with its own ABI
+	admins := &compilers.SolidityContract{Abi: json.RawMessage(proxy.ABI)}
+	admins.Evm.DeployedBytecode.Object = hex.EncodeUpperToString(proxy.Runtime)
+	err = admins.Save(script.BinPath, fmt.Sprintf("%s.bin", proxy.ContractName))
+	if err != nil {
+		return "", err
+	}
+	if contract != nil {
+		err = contract.Save(script.BinPath, fmt.Sprintf("%s.bin", address))
+		if err != nil {
+			return "", err
+		}
+	}
+	return address.String(), nil
+}
+
+// UpgradeJob switches a proxy to a new implementation, after checking that the job's source is the proxy's admin and,
+// unless the job skips it, that the new implementation keeps the storage layout of the current one
+func UpgradeJob(upgrade *def.Upgrade, do *def.DeployArgs, script *def.Playbook, client *def.Client,
+	gas *def.JobGas, logger *logging.Logger) (string, error) {
+	upgrade.Source = FirstOf(upgrade.Source, script.Account)
+	upgrade.Fee = FirstOf(upgrade.Fee, do.DefaultFee)
+	upgrade.Gas = FirstOf(upgrade.Gas, do.DefaultGas)
+
+	proxyAddress, err := client.ParseAddress(upgrade.Proxy, logger)
+	if err != nil {
+		return "", err
+	}
+	implementation, err := client.ParseAddress(upgrade.Implementation, logger)
+	if err != nil {
+		return "", err
+	}
+	source, err := client.ParseAddress(upgrade.Source, logger)
+	if err != nil {
+		return "", err
+	}
+	// Any other account's upgrade would be delegated to the implementation, which might accept it
+	admin, err := proxySlot(client, proxyAddress, proxy.AdminSlot, logger)
+	if err != nil {
+		return "", err
+	}
+	if admin != source {
+		return "", fmt.Errorf("cannot upgrade proxy %v from %v since its admin is %v", proxyAddress, source, admin)
+	}
+	current, err := proxySlot(client, proxyAddress, proxy.ImplementationSlot, logger)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := loadImplementation(implementation, script)
+	if err != nil {
+		return "", err
+	}
+	if upgrade.SkipStorageCheck {
+		logger.InfoMsg("Skipping storage layout check", "proxy", proxyAddress.String())
+	} else {
+		previous, err := loadImplementation(current, script)
+		if err != nil {
+			return "", err
+		}
+		err = checkUpgrade(previous, next, current, implementation)
+		if err != nil {
+			return "", err
+		}
+	}
+	data, err := implementationCall(upgrade.Function, upgrade.Data, next, implementation, do, script, client, logger)
+	if err != nil {
+		return "", err
+	}
+	calldata, err := proxy.Upgrade(implementation, data)
+	if err != nil {
+		return "", err
+	}
+
+	logger.InfoMsg("Upgrading Proxy",
+		"proxy", proxyAddress.String(),
+		"from", current.String(),
+		"to", implementation.String())
+	tx, err := client.Call(&def.CallArg{
+		Input:    upgrade.Source,
+		Address:  proxyAddress.String(),
+		Fee:      upgrade.Fee,
+		Gas:      upgrade.Gas,
+		Data:     hex.EncodeToString(calldata),
+		Sequence: upgrade.Sequence,
+	}, logger)
+	if err != nil {
+		return "", err
+	}
+	txe, err := client.SignAndBroadcast(tx, logger)
+	if err != nil {
+		return "", fmt.Errorf("error upgrading proxy %v to %v: %w", proxyAddress, implementation, err)
+	}
+	logEvents(txe, client, logger)
+	function := "upgradeTo"
+	if len(data) > 0 {
+		function = "upgradeToAndCall"
+	}
+	gas.Called(proxyAddress, function, txe.GetResult().GetGasUsed())
+
+	if next != nil {
+		err = next.Save(script.BinPath, fmt.Sprintf("%s.bin", proxyAddress))
+		if err != nil {
+			return "", err
+		}
+	}
+	return implementation.String(), nil
+}
+
+// Reads the bin file saved when the implementation at address was deployed, if there is one
+func loadImplementation(address crypto.Address, script *def.Playbook) (*compilers.SolidityContract, error) {
+	contract, err := compilers.LoadSolidityContract(filepath.Join(script.BinPath, fmt.Sprintf("%s.bin", address)))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read bin file of implementation %v: %w", address, err)
+	}
+	return contract, nil
+}
+
+// Encodes the call to function of the implementation, if any, which the proxy makes as it is deployed or upgraded
+func implementationCall(function string, data interface{}, contract *compilers.SolidityContract,
+	implementation crypto.Address, do *def.DeployArgs, script *def.Playbook, client *def.Client,
+	logger *logging.Logger) ([]byte, error) {
+	if function == "" {
+		return nil, nil
+	}
+	if contract == nil {
+		return nil, fmt.Errorf("cannot call %s since implementation %v was not deployed with a bin file in %s",
+			function, implementation, script.BinPath)
+	}
+	function, args, err := util.PreProcessInputData(function, data, do, script, client, false, logger)
+	if err != nil {
+		return nil, err
+	}
+	packed, _, err := abi.EncodeFunctionCall(string(contract.Abi), function, logger, args...)
+	return packed, err
+}
+
+func checkUpgrade(previous, next *compilers.SolidityContract, current, implementation crypto.Address) error {
+	if previous == nil || previous.StorageLayout == nil {
+		return fmt.Errorf("no storage layout for current implementation %v, which must have been compiled by "+
+			"solc 0.5.13 or later with its bin file saved in the bin path, or set skip-storage-check", current)
+	}
+	if next == nil || next.StorageLayout == nil {
+		return fmt.Errorf("no storage layout for new implementation %v, which must have been compiled by "+
+			"solc 0.5.13 or later with its bin file saved in the bin path, or set skip-storage-check", implementation)
+	}
+	conflicts := compilers.UpgradeConflicts(previous.StorageLayout, next.StorageLayout)
+	if len(conflicts) > 0 {
+		return fmt.Errorf("storage layout of implementation %v is not compatible with that of %v: %s",
+			implementation, current, strings.Join(conflicts, "; "))
+	}
+	return nil
+}
+
+// Reads the address held in slot by the proxy at address
+func proxySlot(client *def.Client, address crypto.Address, slot binary.Word256,
+	logger *logging.Logger) (crypto.Address, error) {
+	// Make sure we are connected
+	_, err := client.Query(logger)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	value, err := client.GetStorage(address, slot)
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("could not read proxy %v: %w", address, err)
+	}
+	slotAddress := proxy.SlotAddress(value)
+	if slotAddress == crypto.ZeroAddress {
+		return crypto.Address{}, fmt.Errorf("%v is not a proxy since it has no implementation or admin", address)
+	}
+	return slotAddress, nil
+}
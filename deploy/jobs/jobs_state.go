@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
 )
 
 func DumpStateJob(dump *def.DumpState) (string, error) {
@@ -15,3 +16,21 @@ func RestoreStateJob(restore *def.RestoreState) (string, error) {
 
 	return result, nil
 }
+
+func SnapshotJob(snapshot *def.Snapshot, client *def.Client, logger *logging.Logger) (uint64, error) {
+	logger.InfoMsg("Snapshotting state", "name", snapshot.Name)
+	result, err := client.SnapshotState(snapshot.Name, logger)
+	if err != nil {
+		return 0, err
+	}
+	return result.Height, nil
+}
+
+func RestoreSnapshotJob(restore *def.RestoreSnapshot, client *def.Client, logger *logging.Logger) (uint64, error) {
+	logger.InfoMsg("Restoring state snapshot", "name", restore.Name)
+	result, err := client.RestoreState(restore.Name, logger)
+	if err != nil {
+		return 0, err
+	}
+	return result.Height, nil
+}
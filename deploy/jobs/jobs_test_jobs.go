@@ -2,10 +2,13 @@ package jobs
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	hex "github.com/tmthrgd/go-hex"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/execution/evm/abi"
@@ -134,73 +137,159 @@ func QueryValsJob(query *def.QueryVals, client *def.Client, logger *logging.Logg
 }
 
 func AssertJob(assertion *def.Assert, logger *logging.Logger) (string, error) {
-	// Switch on relation
 	logger.InfoMsg("Assertion",
 		"key", assertion.Key,
 		"relation", assertion.Relation,
 		"value", assertion.Value)
 
-	switch assertion.Relation {
-	case "==", "eq":
-		/*log.Debug("Compare", strings.Compare(assertion.Key, assertion.Value))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Value))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Value))*/
-		if assertion.Key == assertion.Value {
-			return assertPass("==", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("==", assertion.Key, assertion.Value, logger)
-		}
-	case "!=", "ne":
-		if assertion.Key != assertion.Value {
-			return assertPass("!=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("!=", assertion.Key, assertion.Value, logger)
+	holds, err := relationHolds(assertion.Key, assertion.Relation, assertion.Value)
+	if err != nil {
+		return "", err
+	}
+	if holds {
+		return assertPass(relationOperators[assertion.Relation], assertion.Key, assertion.Value, logger)
+	}
+	return assertFail(relationOperators[assertion.Relation], assertion.Key, assertion.Value, logger)
+}
+
+// The operator of each relation that can be tested, by its name or by itself
+var relationOperators = map[string]string{
+	"==": "==", "eq": "==",
+	"!=": "!=", "ne": "!=",
+	">": ">", "gt": ">",
+	">=": ">=", "ge": ">=",
+	"<": "<", "lt": "<",
+	"<=": "<=", "le": "<=",
+}
+
+// Whether key stands in relation to value, comparing them as integers for relations other than equality
+func relationHolds(key, relation, value string) (bool, error) {
+	operator, ok := relationOperators[relation]
+	if !ok {
+		return false, fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", relation)
+	}
+	switch operator {
+	case "==":
+		return key == value, nil
+	case "!=":
+		return key != value, nil
+	}
+	k, v, err := bulkConvert(key, value)
+	if err != nil {
+		_, err = convFail()
+		return false, err
+	}
+	switch operator {
+	case ">":
+		return k > v, nil
+	case ">=":
+		return k >= v, nil
+	case "<":
+		return k < v, nil
+	default:
+		return k <= v, nil
+	}
+}
+
+// AssertEventJob checks that the transaction of an earlier call job emitted the event, decoded with the ABIs known to
+// client, with the given argument values. The arguments of the first matching event are returned as variables.
+func AssertEventJob(assertion *def.AssertEvent, do *def.DeployArgs, playbook *def.Playbook, client *def.Client, logger *logging.Logger) (string, []*abi.Variable, error) {
+	call := findCall(assertion.Call, playbook)
+	if call == nil {
+		return "", nil, fmt.Errorf("assert-event refers to %s, which is not a call job that has run", assertion.Call)
+	}
+	var address *crypto.Address
+	if assertion.Address != "" {
+		addr, err := crypto.AddressFromHexString(assertion.Address)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not parse address of assert-event: %v", err)
 		}
-	case ">", "gt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
+		address = &addr
+	}
+	names := make([]string, 0, len(assertion.Args))
+	want := make(map[string]string, len(assertion.Args))
+	for name, value := range assertion.Args {
+		str, err := util.PreProcess(fmt.Sprint(value), do, playbook, client, logger)
 		if err != nil {
-			return convFail()
+			return "", nil, err
 		}
-		if k > v {
-			return assertPass(">", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail(">", assertion.Key, assertion.Value, logger)
+		names = append(names, name)
+		want[name] = str
+	}
+	sort.Strings(names)
+
+	logger.InfoMsg("Event Assertion",
+		"call", assertion.Call,
+		"event", assertion.Event,
+		"args", fmt.Sprint(want))
+
+	var emitted []string
+	for _, event := range call.Events {
+		eventLog := event.GetLog()
+		if eventLog == nil || len(eventLog.Topics) == 0 {
+			continue
 		}
-	case ">=", "ge":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
+		if address != nil && eventLog.Address != *address {
+			continue
 		}
-		if k >= v {
-			return assertPass(">=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail(">=", assertion.Key, assertion.Value, logger)
+		var eventID abi.EventID
+		copy(eventID[:], eventLog.GetTopic(0).Bytes())
+		evAbi, ok := client.EventSpec(eventID)
+		if !ok || evAbi.Name != assertion.Event {
+			continue
 		}
-	case "<", "lt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
+		vals := make([]interface{}, len(evAbi.Inputs))
+		for i := range vals {
+			vals[i] = new(string)
+		}
+		err := abi.UnpackEvent(evAbi, eventLog.Topics, eventLog.Data, vals...)
 		if err != nil {
-			return convFail()
+			return "", nil, fmt.Errorf("could not decode event %s: %v", evAbi.Name, err)
 		}
-		if k < v {
-			return assertPass("<", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("<", assertion.Key, assertion.Value, logger)
+		variables := make([]*abi.Variable, len(vals))
+		got := make(map[string]string, len(vals))
+		for i, val := range vals {
+			variables[i] = &abi.Variable{Name: evAbi.Inputs[i].Name, Value: *val.(*string)}
+			got[variables[i].Name] = variables[i].Value
 		}
-	case "<=", "le":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
+		matches := true
+		for _, name := range names {
+			value, ok := got[name]
+			if !ok {
+				return "", nil, fmt.Errorf("event %s has no argument named %s", evAbi.Name, name)
+			}
+			// Addresses and hex are not case sensitive
+			if !strings.EqualFold(value, want[name]) {
+				matches = false
+			}
 		}
-		if k <= v {
-			return assertPass("<=", assertion.Key, assertion.Value, logger)
-		} else {
-			return assertFail("<=", assertion.Key, assertion.Value, logger)
+		if matches {
+			result, err := assertPass("event", assertion.Event, fmt.Sprint(got), logger)
+			return result, variables, err
+		}
+		emitted = append(emitted, fmt.Sprint(got))
+	}
+	if len(emitted) == 0 {
+		return assertEventFail(assertion.Event, "not emitted", logger)
+	}
+	return assertEventFail(assertion.Event, strings.Join(emitted, ", "), logger)
+}
+
+// Finds the call job named name in playbook or the playbooks running it
+func findCall(name string, playbook *def.Playbook) *def.Call {
+	for ; playbook != nil; playbook = playbook.Parent {
+		for _, job := range playbook.Jobs {
+			if job.Name == name {
+				return job.Call
+			}
 		}
-	default:
-		return "", fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", assertion.Relation)
 	}
+	return nil
+}
+
+func assertEventFail(event, val string, logger *logging.Logger) (string, []*abi.Variable, error) {
+	result, err := assertFail("event", event, val, logger)
+	return result, nil, err
 }
 
 func bulkConvert(key, value string) (int, int, error) {
@@ -0,0 +1,93 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transferABI = `[{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+	{"name":"from","type":"address","indexed":true},
+	{"name":"to","type":"address","indexed":true},
+	{"name":"value","type":"uint256","indexed":false}]}]`
+
+func TestAssertEventJob(t *testing.T) {
+	spec, err := abi.ReadSpec([]byte(transferABI))
+	require.NoError(t, err)
+	client := def.NewClient("", "", true, 0)
+	client.AllSpecs = spec
+
+	token := crypto.Address{1}
+	from := crypto.Address{2}
+	to := crypto.Address{3}
+	topics, data, err := abi.PackEvent(spec.EventsByName["Transfer"], from, to, 10)
+	require.NoError(t, err)
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{{
+			Name: "transfer",
+			Call: &def.Call{Events: []*exec.Event{
+				{Print: &exec.PrintEvent{Address: token, Data: []byte("hello")}},
+				{Log: &exec.LogEvent{Address: token, Topics: topics, Data: data}},
+			}},
+		}},
+	}
+	logger := logging.NewNoopLogger()
+
+	t.Run("Emitted", func(t *testing.T) {
+		result, variables, err := AssertEventJob(&def.AssertEvent{
+			Call:    "transfer",
+			Event:   "Transfer",
+			Address: token.String(),
+			Args:    map[string]interface{}{"to": to.String(), "value": 10},
+		}, &def.DeployArgs{}, playbook, client, logger)
+		require.NoError(t, err)
+		assert.Equal(t, "passed", result)
+		assert.Equal(t, []*abi.Variable{
+			{Name: "from", Value: from.String()},
+			{Name: "to", Value: to.String()},
+			{Name: "value", Value: "10"},
+		}, variables)
+	})
+
+	t.Run("ArgumentDiffers", func(t *testing.T) {
+		result, _, err := AssertEventJob(&def.AssertEvent{
+			Call:  "transfer",
+			Event: "Transfer",
+			Args:  map[string]interface{}{"value": 11},
+		}, &def.DeployArgs{}, playbook, client, logger)
+		require.Error(t, err)
+		assert.Equal(t, "failed", result)
+	})
+
+	t.Run("OtherContract", func(t *testing.T) {
+		_, _, err := AssertEventJob(&def.AssertEvent{
+			Call:    "transfer",
+			Event:   "Transfer",
+			Address: from.String(),
+		}, &def.DeployArgs{}, playbook, client, logger)
+		require.Error(t, err)
+	})
+
+	t.Run("UnknownArgument", func(t *testing.T) {
+		_, _, err := AssertEventJob(&def.AssertEvent{
+			Call:  "transfer",
+			Event: "Transfer",
+			Args:  map[string]interface{}{"amount": 10},
+		}, &def.DeployArgs{}, playbook, client, logger)
+		require.EqualError(t, err, "event Transfer has no argument named amount")
+	})
+
+	t.Run("NotACall", func(t *testing.T) {
+		_, _, err := AssertEventJob(&def.AssertEvent{
+			Call:  "mint",
+			Event: "Transfer",
+		}, &def.DeployArgs{}, playbook, client, logger)
+		require.Error(t, err)
+	})
+}
@@ -1,7 +1,13 @@
 package jobs
 
 import (
+	"fmt"
+
 	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/def/rule"
+	"github.com/hyperledger/burrow/deploy/script"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging"
 )
 
@@ -23,3 +29,27 @@ func SetValJob(set *def.Set, do *def.DeployArgs, logger *logging.Logger) (string
 	result = set.Value
 	return result, nil
 }
+
+func ScriptJob(job *def.Script, do *def.DeployArgs, playbook *def.Playbook, client *def.Client,
+	logger *logging.Logger) (string, []*abi.Variable, error) {
+	s, err := script.Parse(job.Code)
+	if err != nil {
+		return "", nil, err
+	}
+	result, variables, err := s.Run(func(match rule.PlaceholderMatch) (string, error) {
+		value, err := util.PreProcess(match.Match, do, playbook, client, logger)
+		if err != nil {
+			return "", err
+		}
+		// Placeholders no job answers are left as they are
+		if value == match.Match {
+			return "", fmt.Errorf("%s does not refer to the result of an earlier job", match.Match)
+		}
+		return value, nil
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("script failed at %w", err)
+	}
+	logger.InfoMsg("Script result", "result", result)
+	return result, variables, nil
+}
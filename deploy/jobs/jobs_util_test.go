@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptJob(t *testing.T) {
+	logger := logging.NewNoopLogger()
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{
+			{Name: "quote", Set: &def.Set{Value: `say "hi"`}, Result: `say "hi"`},
+			{Name: "query", QueryContract: &def.QueryContract{}, Result: "(3, 4)",
+				Variables: []*abi.Variable{{Name: "x", Value: "3"}, {Name: "y", Value: "4"}}},
+		},
+	}
+	result, variables, err := ScriptJob(&def.Script{Code: `
+		sum = $query.x + $query.y
+		sum > 5 ? $quote : "quiet"
+	`}, &def.DeployArgs{}, playbook, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, `say "hi"`, result)
+	assert.Equal(t, []*abi.Variable{{Name: "sum", Value: "7"}}, variables)
+
+	_, _, err = ScriptJob(&def.Script{Code: `$missing + 1`}, &def.DeployArgs{}, playbook, nil, logger)
+	require.Error(t, err)
+
+	// Placeholders are read as values rather than replaced in the code, whose quotes they would break
+	forEach := &def.ForEach{
+		Items: []string{"$quote"},
+		Jobs: []*def.Job{
+			{Name: "shout", Script: &def.Script{Code: `upper($item)`}},
+			{Name: "echo", Set: &def.Set{Value: "$shout"}},
+		},
+	}
+	results, err := ForEachJob(forEach, &def.DeployArgs{}, playbook, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"shout": `SAY "HI"`, "echo": `SAY "HI"`}}, results)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+
+	"github.com/hyperledger/burrow/logging/loggers"
 )
 
 // [zr] this should go (currently used by the nameReg writer)
@@ -42,7 +44,8 @@ func WriteJobResultCSV(name, result string) error {
 	return err
 }
 
-func WriteJobResultJSON(results map[string]interface{}, logFile string) error {
+// WriteJobResultJSON writes results to logFile as JSON with each of secrets replaced by [redacted]
+func WriteJobResultJSON(results map[string]interface{}, logFile string, secrets ...string) error {
 	if logFile == "" {
 		return nil
 	}
@@ -56,7 +59,7 @@ func WriteJobResultJSON(results map[string]interface{}, logFile string) error {
 	if err != nil {
 		return err
 	}
-	if _, err = file.Write(res); err != nil {
+	if _, err = file.Write(loggers.RedactJSON(res, secrets...)); err != nil {
 		return err
 	}
 
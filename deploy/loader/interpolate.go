@@ -0,0 +1,149 @@
+package loader
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hyperledger/burrow/deploy/def"
+)
+
+// Matches references to the environment with ${env:NAME}, and to secrets with ${secret:NAME} for an environment
+// variable or ${file:PATH} for the contents of a file
+var interpolationRegex = regexp.MustCompile(`\$\{(env|secret|file):([^}]+)}`)
+
+// Replaces the environment and secret references in the fields of playbook's jobs with their values, which are
+// read once as the playbook is loaded. The values of secrets are added to playbook.Secrets so they can be redacted
+// from logs. Files are relative to the playbook.
+func interpolatePlaybook(playbook *def.Playbook) error {
+	interpolator := &interpolator{dir: playbook.Path}
+	var err error
+	playbook.Account, err = interpolator.interpolate(playbook.Account)
+	if err != nil {
+		return err
+	}
+	for name, target := range playbook.Targets {
+		err = interpolator.interpolateValue(reflect.ValueOf(target).Elem())
+		if err != nil {
+			return fmt.Errorf("could not interpolate target %s: %w", name, err)
+		}
+	}
+	for _, job := range playbook.Jobs {
+		err = interpolator.interpolateValue(reflect.ValueOf(job).Elem())
+		if err != nil {
+			return fmt.Errorf("could not interpolate job %s: %w", job.Name, err)
+		}
+	}
+	playbook.Secrets = append(playbook.Secrets, interpolator.secrets...)
+	return nil
+}
+
+type interpolator struct {
+	dir     string
+	secrets []string
+}
+
+func (in *interpolator) interpolate(str string) (string, error) {
+	var err error
+	str = interpolationRegex.ReplaceAllStringFunc(str, func(match string) string {
+		if err != nil {
+			return match
+		}
+		var value string
+		value, err = in.resolve(interpolationRegex.FindStringSubmatch(match))
+		return value
+	})
+	return str, err
+}
+
+func (in *interpolator) resolve(match []string) (string, error) {
+	kind, name := match[1], match[2]
+	switch kind {
+	case "file":
+		path := name
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(in.dir, path)
+		}
+		bs, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("could not read secret file for %s: %w", match[0], err)
+		}
+		return in.secret(strings.TrimRight(string(bs), "\r\n")), nil
+	default:
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s referred to by %s is not set", name, match[0])
+		}
+		if kind == "secret" {
+			return in.secret(value), nil
+		}
+		return value, nil
+	}
+}
+
+func (in *interpolator) secret(value string) string {
+	if value != "" {
+		in.secrets = append(in.secrets, value)
+	}
+	return value
+}
+
+// Interpolates the strings in rv, which must be settable, and in anything it holds
+func (in *interpolator) interpolateValue(rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.String:
+		str, err := in.interpolate(rv.String())
+		if err != nil {
+			return err
+		}
+		rv.SetString(str)
+	case reflect.Ptr:
+		if !rv.IsNil() {
+			return in.interpolateValue(rv.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			if rv.Field(i).CanSet() {
+				err := in.interpolateValue(rv.Field(i))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			err := in.interpolateValue(rv.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Interface:
+		// The value held by an interface cannot be set so interpolate a copy
+		if !rv.IsNil() {
+			cp, err := in.interpolateCopy(rv.Elem())
+			if err != nil {
+				return err
+			}
+			rv.Set(cp)
+		}
+	case reflect.Map:
+		for _, key := range rv.MapKeys() {
+			cp, err := in.interpolateCopy(rv.MapIndex(key))
+			if err != nil {
+				return err
+			}
+			rv.SetMapIndex(key, cp)
+		}
+	}
+	return nil
+}
+
+func (in *interpolator) interpolateCopy(rv reflect.Value) (reflect.Value, error) {
+	cp := reflect.New(rv.Type()).Elem()
+	cp.Set(rv)
+	return cp, in.interpolateValue(cp)
+}
@@ -0,0 +1,52 @@
+package loader
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolatePlaybook(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-deploy-interpolate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "key.txt"), []byte("hunter2\n"), 0600))
+	os.Setenv("BURROW_TEST_OWNER", "1040E6521541DAB4E7EE57F21226DD17CE9F0FB7")
+	os.Setenv("BURROW_TEST_TOKEN", "s3cret")
+	defer os.Unsetenv("BURROW_TEST_OWNER")
+	defer os.Unsetenv("BURROW_TEST_TOKEN")
+
+	playbook := &def.Playbook{
+		Path:    dir,
+		Account: "${env:BURROW_TEST_OWNER}",
+		Jobs: []*def.Job{
+			{Name: "token", Deploy: &def.Deploy{
+				Contract: "Token.sol",
+				Data:     []interface{}{"${env:BURROW_TEST_OWNER}", 42, "key=${file:key.txt}"},
+			}},
+			{Name: "login", Call: &def.Call{
+				Destination: "$token",
+				Function:    "login",
+				Data:        map[string]interface{}{"token": "${secret:BURROW_TEST_TOKEN}"},
+			}},
+		},
+	}
+	require.NoError(t, interpolatePlaybook(playbook))
+	assert.Equal(t, "1040E6521541DAB4E7EE57F21226DD17CE9F0FB7", playbook.Account)
+	assert.Equal(t, []interface{}{"1040E6521541DAB4E7EE57F21226DD17CE9F0FB7", 42, "key=hunter2"},
+		playbook.Jobs[0].Deploy.Data)
+	assert.Equal(t, "$token", playbook.Jobs[1].Call.Destination)
+	assert.Equal(t, map[string]interface{}{"token": "s3cret"}, playbook.Jobs[1].Call.Data)
+	assert.Equal(t, []string{"hunter2", "s3cret"}, playbook.Secrets)
+
+	err = interpolatePlaybook(&def.Playbook{Jobs: []*def.Job{
+		{Name: "missing", Set: &def.Set{Value: "${env:BURROW_TEST_MISSING}"}},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "BURROW_TEST_MISSING")
+}
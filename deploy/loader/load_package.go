@@ -72,11 +72,24 @@ func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, l
 			Please check that your deploy.yaml is properly formatted: %v`, err)
 	}
 
+	err = interpolatePlaybook(playbook)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO more file sanity check (fail before running)
 	err = playbook.Validate()
 	if err != nil {
 		return nil, err
 	}
+	err = checkTargets(playbook, playbook.Jobs)
+	if err != nil {
+		return nil, err
+	}
+	err = newRemoteContracts(args, logger).resolveJobs(playbook.Jobs)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, job := range playbook.Jobs {
 		if job.Meta != nil {
@@ -84,10 +97,11 @@ func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, l
 			if err != nil {
 				return nil, err
 			}
+			playbook.Secrets = append(playbook.Secrets, metaPlaybook.Secrets...)
 
 			// set the deploy contract jobs relative to the newDo's root directory
 			for _, job := range metaPlaybook.Jobs {
-				if job.Deploy != nil {
+				if job.Deploy != nil && !filepath.IsAbs(job.Deploy.Contract) {
 					job.Deploy.Contract = filepath.Join(metaPlaybook.Path, job.Deploy.Contract)
 				}
 			}
@@ -104,10 +118,11 @@ func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, l
 					if err != nil {
 						return nil, err
 					}
+					playbook.Secrets = append(playbook.Secrets, metaPlaybook.Secrets...)
 
 					// set the deploy contract jobs relative to the newDo's root directory
 					for _, job := range metaPlaybook.Jobs {
-						if job.Deploy != nil {
+						if job.Deploy != nil && !filepath.IsAbs(job.Deploy.Contract) {
 							job.Deploy.Contract = filepath.Join(metaPlaybook.Path, job.Deploy.Contract)
 						}
 					}
@@ -123,3 +138,25 @@ func loadPlaybook(fileName string, args *def.DeployArgs, parent *def.Playbook, l
 
 	return playbook, nil
 }
+
+// Checks that jobs, their rollback jobs, and the jobs they run only run against targets declared by playbook
+func checkTargets(playbook *def.Playbook, jobs []*def.Job) error {
+	for _, job := range jobs {
+		if job.Target != "" && playbook.Target(job.Target) == nil {
+			return fmt.Errorf("job %s runs against target %s, which is not declared by the playbook", job.Name,
+				job.Target)
+		}
+		nested := append([]*def.Job{}, job.Rollback...)
+		if job.ForEach != nil {
+			nested = append(nested, job.ForEach.Jobs...)
+		}
+		if job.If != nil {
+			nested = append(append(nested, job.If.Then...), job.If.Else...)
+		}
+		err := checkTargets(playbook, nested)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -27,6 +27,46 @@ func TestUnmarshal(t *testing.T) {
     data: $val2
     amount: $to_save
     fee: $MinersFee
+`)
+	testUnmarshal(t, `targets:
+  sidechain:
+    chain: 127.0.0.1:20997
+    address: 7B6BBA6B4D3D1F5E2B10F77F3A7EC8F0D9A1E4C2
+
+jobs:
+
+- name: registerBridge
+  target: sidechain
+  register:
+    name: bridge
+    data: $bridge
+`)
+	testUnmarshal(t, `jobs:
+
+- name: registerAll
+  for-each:
+    items: [1, $two]
+    as: n
+    jobs:
+    - name: register
+      register:
+        name: name$n
+        data: $n
+
+- name: registerMore
+  if:
+    key: $count
+    relation: lt
+    val: 3
+    then:
+    - name: register
+      register:
+        name: more
+        data: $count
+    else:
+    - name: skip
+      set:
+        val: none
 `)
 	testUnmarshal(t, `jobs:
 
@@ -0,0 +1,254 @@
+package loader
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// The IPFS gateway ipfs:// contracts are fetched through unless another is given
+const DefaultIPFSGateway = "https://ipfs.io"
+
+// How long a contract may take to download and how large it may be
+const (
+	downloadTimeout = 2 * time.Minute
+	maxDownloadSize = 64 << 20
+)
+
+// Matches a contract in a git repository as git+URL#REF:PATH
+var gitContractRegex = regexp.MustCompile(`^git\+([^#]+)#([^:]+):(.+)$`)
+
+// Matches a full SHA-1 or SHA-256 commit hash
+var commitHashRegex = regexp.MustCompile(`^([0-9a-fA-F]{40}|[0-9a-fA-F]{64})$`)
+
+// remoteContracts fetches the contracts of build and deploy jobs given as an HTTP(S) URL, an ipfs://CID/FILE path, or
+// a file in a git repository at a ref, keeping them in a cache directory so each is only fetched once
+type remoteContracts struct {
+	cacheDir    string
+	ipfsGateway string
+	client      *http.Client
+	maxSize     int64
+	logger      *logging.Logger
+}
+
+func newRemoteContracts(args *def.DeployArgs, logger *logging.Logger) *remoteContracts {
+	cacheDir := args.SourceCache
+	if cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			userCacheDir = os.TempDir()
+		}
+		cacheDir = filepath.Join(userCacheDir, "burrow", "sources")
+	}
+	ipfsGateway := args.IPFSGateway
+	if ipfsGateway == "" {
+		ipfsGateway = DefaultIPFSGateway
+	}
+	return &remoteContracts{
+		cacheDir:    cacheDir,
+		ipfsGateway: strings.TrimSuffix(ipfsGateway, "/"),
+		client:      &http.Client{Timeout: downloadTimeout},
+		maxSize:     maxDownloadSize,
+		logger:      logger,
+	}
+}
+
+// Whether contract is fetched from elsewhere rather than read from a file
+func isRemote(contract string) bool {
+	return strings.HasPrefix(contract, "http://") || strings.HasPrefix(contract, "https://") ||
+		strings.HasPrefix(contract, "ipfs://") || strings.HasPrefix(contract, "git+")
+}
+
+// Replaces the remote contracts of jobs, and of the jobs they run, with the paths of the files fetched for them
+func (rc *remoteContracts) resolveJobs(jobs []*def.Job) error {
+	var err error
+	for _, job := range jobs {
+		switch {
+		case job.Deploy != nil && isRemote(job.Deploy.Contract):
+			job.Deploy.Contract, err = rc.fetch(job.Deploy.Contract, job.Deploy.Integrity)
+		case job.Build != nil && isRemote(job.Build.Contract):
+			job.Build.Contract, err = rc.fetch(job.Build.Contract, job.Build.Integrity)
+		}
+		if err != nil {
+			return fmt.Errorf("could not fetch contract of job %s: %w", job.Name, err)
+		}
+		nested := append([]*def.Job{}, job.Rollback...)
+		if job.ForEach != nil {
+			nested = append(nested, job.ForEach.Jobs...)
+		}
+		if job.If != nil {
+			nested = append(append(nested, job.If.Then...), job.If.Else...)
+		}
+		if job.Proposal != nil {
+			nested = append(nested, job.Proposal.Jobs...)
+		}
+		err = rc.resolveJobs(nested)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the path of the file fetched for contract, checked against integrity if it is given as sha256:HEX. Since
+// the content at a URL or a git ref can change an HTTP(S) contract must be given an integrity hash and a git contract
+// must be pinned to a commit hash.
+func (rc *remoteContracts) fetch(contract, integrity string) (string, error) {
+	if match := gitContractRegex.FindStringSubmatch(contract); match != nil {
+		file, err := rc.git(match[1], match[2], match[3])
+		if err != nil {
+			return "", err
+		}
+		err = checkIntegrity(file, integrity)
+		if err != nil {
+			return "", fmt.Errorf("contract %s %w", contract, err)
+		}
+		return file, nil
+	}
+	if strings.HasPrefix(contract, "git+") {
+		return "", fmt.Errorf("contract %s should be given as git+URL#REF:PATH", contract)
+	}
+	url := contract
+	if cid := strings.TrimPrefix(contract, "ipfs://"); cid != contract {
+		if !strings.Contains(cid, "/") {
+			return "", fmt.Errorf("contract %s should name a file in a directory as ipfs://CID/FILE so that "+
+				"it can be told whether it is source or compiled", contract)
+		}
+		url = rc.ipfsGateway + "/ipfs/" + cid
+	} else if integrity == "" {
+		return "", fmt.Errorf("contract %s has no integrity hash to check it against", contract)
+	}
+	return rc.download(url, integrity)
+}
+
+// Downloads the file at url unless it has been already, keeping its name so that it is compiled or loaded as before
+func (rc *remoteContracts) download(url, integrity string) (string, error) {
+	dir := filepath.Join(rc.cacheDir, "files", cacheKey(url))
+	file := filepath.Join(dir, path.Base(url))
+	if _, err := os.Stat(file); err == nil && checkIntegrity(file, integrity) == nil {
+		return file, nil
+	}
+	rc.logger.InfoMsg("Downloading contract", "url", url)
+	response, err := rc.client.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("could not download %s: %w", url, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not download %s: %s", url, response.Status)
+	}
+	if response.ContentLength > rc.maxSize {
+		return "", fmt.Errorf("could not download %s: its %d bytes are more than the %d allowed", url,
+			response.ContentLength, rc.maxSize)
+	}
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", err
+	}
+	// Write to a temporary file so that an interrupted download is never mistaken for the contract
+	f, err := ioutil.TempFile(dir, "download.*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	n, err := io.Copy(f, io.LimitReader(response.Body, rc.maxSize+1))
+	if err == nil && n > rc.maxSize {
+		err = fmt.Errorf("more than the %d bytes allowed", rc.maxSize)
+	}
+	if err != nil {
+		f.Close()
+		return "", fmt.Errorf("could not download %s: %w", url, err)
+	}
+	err = f.Close()
+	if err != nil {
+		return "", err
+	}
+	err = checkIntegrity(f.Name(), integrity)
+	if err != nil {
+		return "", fmt.Errorf("contract downloaded from %s %w", url, err)
+	}
+	return file, os.Rename(f.Name(), file)
+}
+
+// Checks out repo at the commit ref unless it has been already and returns the path of file within it. The whole tree
+// is checked out so that sources can import the files next to them.
+func (rc *remoteContracts) git(repo, ref, file string) (string, error) {
+	if !commitHashRegex.MatchString(ref) {
+		return "", fmt.Errorf("%s is not a full commit hash, which a git contract must be pinned to", ref)
+	}
+	// Neither may be taken by git for an option
+	if strings.HasPrefix(repo, "-") {
+		return "", fmt.Errorf("%s is not a repository URL", repo)
+	}
+	ref = strings.ToLower(ref)
+	dir := filepath.Join(rc.cacheDir, "git", cacheKey(repo+"#"+ref))
+	contractPath := filepath.Join(dir, filepath.FromSlash(file))
+	if rel, err := filepath.Rel(dir, contractPath); err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%s is not a path within the repository", file)
+	}
+	if _, err := os.Stat(dir); err == nil {
+		return contractPath, nil
+	}
+	rc.logger.InfoMsg("Fetching contracts from git", "repository", repo, "ref", ref)
+	err := os.MkdirAll(rc.cacheDir, 0755)
+	if err != nil {
+		return "", err
+	}
+	// Check out to a temporary directory so that an interrupted fetch is never mistaken for the checkout
+	tmp, err := ioutil.TempDir(rc.cacheDir, "fetch.*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"fetch", "--quiet", "--depth", "1", "--", repo, ref},
+		{"checkout", "--quiet", "--detach", ref},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = tmp
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("could not fetch %s from %s: git %s: %v: %s", ref, repo, args[0], err,
+				strings.TrimSpace(string(output)))
+		}
+	}
+	err = os.MkdirAll(filepath.Dir(dir), 0755)
+	if err != nil {
+		return "", err
+	}
+	return contractPath, os.Rename(tmp, dir)
+}
+
+func checkIntegrity(file, integrity string) error {
+	if integrity == "" {
+		return nil
+	}
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(bs)
+	if want := strings.TrimPrefix(integrity, "sha256:"); !strings.EqualFold(hex.EncodeToString(hash[:]), want) {
+		return fmt.Errorf("does not match its integrity hash %s", integrity)
+	}
+	return nil
+}
+
+func cacheKey(str string) string {
+	hash := sha256.Sum256([]byte(str))
+	return hex.EncodeToString(hash[:8])
+}
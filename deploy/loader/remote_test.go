@@ -0,0 +1,148 @@
+package loader
+
+import (
+	"crypto/sha256"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+const tokenSource = "pragma solidity ^0.8.0;\ncontract Token {}\n"
+
+func TestRemoteContracts(t *testing.T) {
+	hash := sha256.Sum256([]byte(tokenSource))
+	integrity := "sha256:" + hex.EncodeToString(hash[:])
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/suite/Token.sol", "/ipfs/QmSuite/Token.sol":
+			w.Write([]byte(tokenSource))
+		case "/suite/Large.sol":
+			w.Write(make([]byte, 2*len(tokenSource)))
+		case "/suite/Streamed.sol":
+			// Flushing first sends no Content-Length
+			w.(http.Flusher).Flush()
+			w.Write(make([]byte, 2*len(tokenSource)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cacheDir, err := ioutil.TempDir("", "burrow-sources")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+	rc := newRemoteContracts(&def.DeployArgs{SourceCache: cacheDir, IPFSGateway: server.URL + "/"},
+		logging.NewNoopLogger())
+
+	t.Run("HTTP", func(t *testing.T) {
+		file, err := rc.fetch(server.URL+"/suite/Token.sol", integrity)
+		require.NoError(t, err)
+		assert.Equal(t, "Token.sol", filepath.Base(file))
+		assertContents(t, tokenSource, file)
+
+		// Fetched once
+		before := requests
+		_, err = rc.fetch(server.URL+"/suite/Token.sol", integrity)
+		require.NoError(t, err)
+		assert.Equal(t, before, requests)
+
+		_, err = rc.fetch(server.URL+"/suite/Token.sol", "")
+		require.Error(t, err, "should need an integrity hash")
+		_, err = rc.fetch(server.URL+"/suite/Other.sol", integrity)
+		require.Error(t, err)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		small := *rc
+		small.maxSize = int64(len(tokenSource))
+		for _, file := range []string{"Large.sol", "Streamed.sol"} {
+			_, err := small.fetch(server.URL+"/suite/"+file, integrity)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "allowed")
+		}
+	})
+
+	t.Run("IntegrityMismatch", func(t *testing.T) {
+		wrong := "sha256:" + hex.EncodeToString(make([]byte, 32))
+		_, err := rc.fetch(server.URL+"/suite/Token.sol", wrong)
+		require.Error(t, err)
+	})
+
+	t.Run("IPFS", func(t *testing.T) {
+		file, err := rc.fetch("ipfs://QmSuite/Token.sol", "")
+		require.NoError(t, err)
+		assertContents(t, tokenSource, file)
+
+		_, err = rc.fetch("ipfs://QmSuite", "")
+		require.Error(t, err, "should need a file name")
+	})
+
+	t.Run("Git", func(t *testing.T) {
+		repo, err := ioutil.TempDir("", "burrow-suite")
+		require.NoError(t, err)
+		defer os.RemoveAll(repo)
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, "contracts"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(repo, "contracts", "Token.sol"), []byte(tokenSource), 0644))
+		for _, args := range [][]string{
+			{"init", "--quiet"},
+			{"add", "."},
+			{"-c", "user.name=marmot", "-c", "user.email=marmot@example.com", "commit", "--quiet", "-m", "Suite"},
+			{"tag", "v1"},
+		} {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = repo
+			output, err := cmd.CombinedOutput()
+			require.NoError(t, err, string(output))
+		}
+		cmd := exec.Command("git", "rev-parse", "HEAD")
+		cmd.Dir = repo
+		output, err := cmd.Output()
+		require.NoError(t, err)
+		commit := strings.TrimSpace(string(output))
+
+		contract := "git+file://" + repo + "#" + commit + ":contracts/Token.sol"
+		file, err := rc.fetch(contract, integrity)
+		require.NoError(t, err)
+		assertContents(t, tokenSource, file)
+
+		job := &def.Job{
+			Name: "deployAll",
+			ForEach: &def.ForEach{
+				Items: []string{"1"},
+				Jobs:  []*def.Job{{Name: "token", Deploy: &def.Deploy{Contract: contract}}},
+			},
+		}
+		require.NoError(t, rc.resolveJobs([]*def.Job{job}))
+		assert.Equal(t, file, job.ForEach.Jobs[0].Deploy.Contract)
+
+		_, err = rc.fetch("git+file://"+repo+"#"+commit+":../Token.sol", "")
+		require.Error(t, err)
+		_, err = rc.fetch("git+file://"+repo+"#"+strings.Repeat("0", 40)+":contracts/Token.sol", "")
+		require.Error(t, err)
+		_, err = rc.fetch("git+file://"+repo+"#v1:contracts/Token.sol", "")
+		require.Error(t, err, "should need a commit hash rather than a tag")
+		_, err = rc.fetch("git+--upload-pack=touch pwned#"+commit+":contracts/Token.sol", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not a repository URL")
+	})
+}
+
+func assertContents(t *testing.T, expected, file string) {
+	t.Helper()
+	bs, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(bs))
+}
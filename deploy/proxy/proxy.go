@@ -0,0 +1,243 @@
+// Package proxy provides a transparent proxy following ERC-1967, which delegates the calls it receives to an
+// implementation contract that its admin can replace, so that a contract can be upgraded while keeping its address and
+// storage. The proxy is assembled here rather than compiled so that deploying one does not need solc.
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+)
+
+// The name the proxy is saved and reported under
+const ContractName = "TransparentProxy"
+
+// ABI of the proxy, whose functions may only be called by its admin. The calls of any other account are delegated to
+// the implementation.
+const ABI = `[
+	{"type":"constructor","inputs":[
+		{"name":"implementation","type":"address"},
+		{"name":"admin","type":"address"},
+		{"name":"data","type":"bytes"}]},
+	{"type":"function","name":"upgradeTo","inputs":[{"name":"implementation","type":"address"}],"outputs":[]},
+	{"type":"function","name":"upgradeToAndCall","inputs":[
+		{"name":"implementation","type":"address"},
+		{"name":"data","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"changeAdmin","inputs":[{"name":"admin","type":"address"}],"outputs":[]},
+	{"type":"function","name":"implementation","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"admin","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"event","name":"Upgraded","anonymous":false,"inputs":[
+		{"name":"implementation","type":"address","indexed":true}]},
+	{"type":"event","name":"AdminChanged","anonymous":false,"inputs":[
+		{"name":"previousAdmin","type":"address","indexed":false},
+		{"name":"newAdmin","type":"address","indexed":false}]}
+]`
+
+var (
+	// The storage slots ERC-1967 sets aside for the implementation and admin, chosen so they cannot collide with the
+	// storage of the implementation
+	ImplementationSlot = eip1967Slot("eip1967.proxy.implementation")
+	AdminSlot          = eip1967Slot("eip1967.proxy.admin")
+
+	Spec = mustReadSpec(ABI)
+
+	// The code of a deployed proxy
+	Runtime = assemble(
+		// The admin manages the proxy while every other call is delegated to the implementation
+		CALLER, PUSH32, AdminSlot, SLOAD, EQ, ref("admin"), JUMPI,
+		CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 0, PUSH1, 0, CALLDATASIZE, PUSH1, 0, PUSH32, ImplementationSlot, SLOAD, GAS, DELEGATECALL,
+		// Return, or revert, with whatever the delegate call did
+		label("forward"), JUMPDEST,
+		RETURNDATASIZE, PUSH1, 0, PUSH1, 0, RETURNDATACOPY,
+		ref("return"), JUMPI,
+		RETURNDATASIZE, PUSH1, 0, REVERT,
+		label("return"), JUMPDEST,
+		RETURNDATASIZE, PUSH1, 0, RETURN,
+
+		label("admin"), JUMPDEST,
+		PUSH1, 0, CALLDATALOAD, PUSH1, 0xe0, SHR,
+		DUP1, PUSH4, functionID("upgradeTo"), EQ, ref("upgradeTo"), JUMPI,
+		DUP1, PUSH4, functionID("upgradeToAndCall"), EQ, ref("upgradeToAndCall"), JUMPI,
+		DUP1, PUSH4, functionID("changeAdmin"), EQ, ref("changeAdmin"), JUMPI,
+		DUP1, PUSH4, functionID("implementation"), EQ, ref("implementation"), JUMPI,
+		DUP1, PUSH4, functionID("admin"), EQ, ref("getAdmin"), JUMPI,
+		// The admin cannot call the implementation through the proxy, so that its calls cannot be confused with those
+		// of the implementation should they share a function selector
+		label("fail"), JUMPDEST,
+		PUSH1, 0, DUP1, REVERT,
+
+		label("upgradeTo"), JUMPDEST,
+		PUSH1, 4, CALLDATALOAD, addressMask, AND,
+		setImplementation,
+		STOP,
+
+		label("upgradeToAndCall"), JUMPDEST,
+		PUSH1, 4, CALLDATALOAD, addressMask, AND,
+		setImplementation,
+		// Copy the data to memory and delegate it to the new implementation
+		PUSH1, 36, CALLDATALOAD, PUSH1, 4, ADD,
+		DUP1, CALLDATALOAD,
+		DUP1, SWAP2, PUSH1, 32, ADD,
+		PUSH1, 0, CALLDATACOPY,
+		PUSH1, 0, PUSH1, 0, DUP3, PUSH1, 0, PUSH32, ImplementationSlot, SLOAD, GAS, DELEGATECALL,
+		ref("forward"), JUMP,
+
+		label("changeAdmin"), JUMPDEST,
+		PUSH1, 4, CALLDATALOAD, addressMask, AND,
+		DUP1, ISZERO, ref("fail"), JUMPI,
+		PUSH32, AdminSlot, SLOAD, PUSH1, 0, MSTORE,
+		DUP1, PUSH1, 32, MSTORE,
+		PUSH32, AdminSlot, SSTORE,
+		PUSH32, eventID("AdminChanged"), PUSH1, 64, PUSH1, 0, LOG1,
+		STOP,
+
+		label("implementation"), JUMPDEST,
+		PUSH32, ImplementationSlot, SLOAD, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN,
+
+		label("getAdmin"), JUMPDEST,
+		PUSH32, AdminSlot, SLOAD, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN,
+	)
+
+	// The code that deploys a proxy, which is followed by its constructor arguments
+	initCode = assemble(
+		// Copy the arguments from the end of the code to memory
+		ref("args"), CODESIZE, SUB, ref("args"), PUSH1, 0, CODECOPY,
+		PUSH1, 0, MLOAD, addressMask, AND,
+		setImplementation,
+		PUSH1, 32, MLOAD, addressMask, AND,
+		DUP1, PUSH32, AdminSlot, SSTORE,
+		PUSH1, 32, MSTORE, PUSH1, 0, PUSH1, 0, MSTORE,
+		PUSH32, eventID("AdminChanged"), PUSH1, 64, PUSH1, 0, LOG1,
+		// Delegate the data, if any, to the implementation to initialise the proxy's storage
+		PUSH1, 64, MLOAD,
+		DUP1, MLOAD,
+		DUP1, ISZERO, ref("deployed"), JUMPI,
+		PUSH1, 0, PUSH1, 0, DUP3, DUP5, PUSH1, 32, ADD, PUSH32, ImplementationSlot, SLOAD, GAS, DELEGATECALL,
+		ref("deployed"), JUMPI,
+		RETURNDATASIZE, PUSH1, 0, PUSH1, 0, RETURNDATACOPY,
+		RETURNDATASIZE, PUSH1, 0, REVERT,
+		label("deployed"), JUMPDEST,
+		push2(len(Runtime)), ref("runtime"), PUSH1, 0, CODECOPY,
+		push2(len(Runtime)), PUSH1, 0, RETURN,
+		label("fail"), JUMPDEST,
+		PUSH1, 0, DUP1, REVERT,
+		label("runtime"), Runtime,
+		label("args"),
+	)
+)
+
+// Sets the implementation to the address on top of the stack, which must be a contract, and consumes it
+var setImplementation = []interface{}{
+	DUP1, EXTCODESIZE, ISZERO, ref("fail"), JUMPI,
+	DUP1, PUSH32, ImplementationSlot, SSTORE,
+	PUSH32, eventID("Upgraded"), PUSH1, 0, DUP1, LOG2,
+}
+
+// Clears all but the low 20 bytes of the word on top of the stack once followed by AND
+var addressMask = bc.MustSplice(PUSH20, bytes.Repeat([]byte{0xff}, crypto.AddressLength))
+
+// Code returns the code that deploys a proxy to implementation managed by admin. Unless data is empty it is
+// delegated to the implementation as the proxy is deployed, which is how the implementation's storage is initialised
+// since its constructor only runs for the implementation itself.
+func Code(implementation, admin crypto.Address, data []byte) ([]byte, error) {
+	args, _, err := Spec.Pack("", implementation, admin, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack proxy constructor arguments: %w", err)
+	}
+	return bc.Concat(initCode, args), nil
+}
+
+// Upgrade returns the data with which the admin switches a proxy to implementation. Unless data is empty it is
+// delegated to the new implementation, to migrate the proxy's storage, in the same transaction.
+func Upgrade(implementation crypto.Address, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		packed, _, err := Spec.Pack("upgradeTo", implementation)
+		return packed, err
+	}
+	packed, _, err := Spec.Pack("upgradeToAndCall", implementation, data)
+	return packed, err
+}
+
+// SlotAddress returns the address held by the value of ImplementationSlot or AdminSlot
+func SlotAddress(value []byte) crypto.Address {
+	return crypto.AddressFromWord256(binary.LeftPadWord256(value))
+}
+
+func eip1967Slot(name string) binary.Word256 {
+	slot := new(big.Int).SetBytes(crypto.Keccak256([]byte(name)))
+	return binary.BigIntToWord256(slot.Sub(slot, big.NewInt(1)))
+}
+
+func mustReadSpec(abiJSON string) *abi.Spec {
+	spec, err := abi.ReadSpec([]byte(abiJSON))
+	if err != nil {
+		panic(fmt.Errorf("could not read proxy ABI: %v", err))
+	}
+	return spec
+}
+
+func functionID(name string) abi.FunctionID {
+	return Spec.Functions[name].FunctionID
+}
+
+func eventID(name string) abi.EventID {
+	return Spec.EventsByName[name].ID
+}
+
+// A position in code, which must be marked with a JUMPDEST if it is jumped to
+type label string
+
+// Pushes the position of a label
+type ref string
+
+func push2(n int) []byte {
+	return []byte{byte(PUSH2), byte(n >> 8), byte(n)}
+}
+
+// Splices code as bc.MustSplice does, flattening nested slices and resolving refs to labels
+func assemble(code ...interface{}) []byte {
+	labels := make(map[label]int)
+	position := 0
+	walk(code, func(item interface{}) {
+		switch it := item.(type) {
+		case label:
+			labels[it] = position
+		case ref:
+			position += 3
+		default:
+			position += len(bc.MustSplice(it))
+		}
+	})
+	assembled := make([]byte, 0, position)
+	walk(code, func(item interface{}) {
+		switch it := item.(type) {
+		case label:
+		case ref:
+			at, ok := labels[label(it)]
+			if !ok {
+				panic(fmt.Errorf("no label %s in proxy code", it))
+			}
+			assembled = append(assembled, push2(at)...)
+		default:
+			assembled = append(assembled, bc.MustSplice(it)...)
+		}
+	})
+	return assembled
+}
+
+func walk(code []interface{}, visit func(item interface{})) {
+	for _, item := range code {
+		if items, ok := item.([]interface{}); ok {
+			walk(items, visit)
+		} else {
+			visit(item)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package proxy
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+var (
+	// Stores the first word of any call data in slot 0 and returns the word in slot 0
+	counterV1 = bc.MustSplice(
+		CALLDATASIZE, ISZERO, PUSH1, 11, JUMPI,
+		PUSH1, 0, CALLDATALOAD, PUSH1, 0, SSTORE,
+		JUMPDEST, PUSH1, 0, SLOAD, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	// Returns one more than the word in slot 0
+	counterV2 = bc.MustSplice(PUSH1, 0, SLOAD, PUSH1, 1, ADD, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	// Reverts with the word 0xdead
+	reverter = bc.MustSplice(PUSH2, 0xde, 0xad, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, REVERT)
+)
+
+func TestSlots(t *testing.T) {
+	assert.Equal(t, "360894A13BA1A3210667C828492DB98DCA3E2076CC3735A920A3CA505D382BBC", ImplementationSlot.String())
+	assert.Equal(t, "B53127684A568B3173AE13B9F8A6016E243E63B6E8EE1178D6A717850B5D6103", AdminSlot.String())
+}
+
+func TestProxy(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	vm := evm.New(engine.Options{})
+	account := func(name string, code []byte) crypto.Address {
+		address := engine.AddressFromName(name)
+		require.NoError(t, engine.CreateAccount(st, address))
+		if code != nil {
+			require.NoError(t, engine.InitEVMCode(st, address, code))
+		}
+		return address
+	}
+	admin := account("admin", nil)
+	user := account("user", nil)
+	v1 := account("v1", counterV1)
+	v2 := account("v2", counterV2)
+	broken := account("broken", reverter)
+	proxy := account("proxy", nil)
+
+	run := func(caller crypto.Address, code, input []byte) ([]byte, *exec.Events, error) {
+		events := new(exec.Events)
+		output, err := vm.Execute(st, new(engine.TestBlockchain), events, engine.CallParams{
+			Caller: caller,
+			Callee: proxy,
+			Input:  input,
+			Gas:    big.NewInt(1000000),
+		}, code)
+		return output, events, err
+	}
+	call := func(caller crypto.Address, input []byte) ([]byte, error) {
+		output, _, err := run(caller, Runtime, input)
+		return output, err
+	}
+	word := func(n uint64) []byte {
+		return binary.Uint64ToWord256(n).Bytes()
+	}
+
+	// Deploying initialises the proxy's storage through the implementation
+	code, err := Code(v1, admin, word(42))
+	require.NoError(t, err)
+	runtime, events, err := run(admin, code, nil)
+	require.NoError(t, err)
+	assert.Equal(t, Runtime, runtime)
+	require.NoError(t, engine.InitEVMCode(st, proxy, runtime))
+	require.NotEmpty(t, *events)
+	assert.Equal(t, eventID("Upgraded").Bytes(), (*events)[0].Log.Topics[0].Bytes())
+	assert.Equal(t, v1, crypto.AddressFromWord256((*events)[0].Log.Topics[1]))
+
+	stored, err := st.GetStorage(proxy, ImplementationSlot)
+	require.NoError(t, err)
+	assert.Equal(t, v1, SlotAddress(stored))
+	stored, err = st.GetStorage(proxy, binary.Zero256)
+	require.NoError(t, err)
+	assert.Equal(t, word(42), stored)
+
+	t.Run("Delegates", func(t *testing.T) {
+		output, err := call(user, word(7))
+		require.NoError(t, err)
+		assert.Equal(t, word(7), output)
+	})
+
+	t.Run("AdminFunctions", func(t *testing.T) {
+		data, _, err := Spec.Pack("implementation")
+		require.NoError(t, err)
+		output, err := call(admin, data)
+		require.NoError(t, err)
+		assert.Equal(t, v1, crypto.AddressFromWord256(binary.LeftPadWord256(output)))
+
+		// The admin's calls are not delegated
+		_, err = call(admin, word(7))
+		require.Error(t, err)
+	})
+
+	t.Run("OnlyAdminUpgrades", func(t *testing.T) {
+		data, err := Upgrade(v2, nil)
+		require.NoError(t, err)
+		// Any other caller's upgrade goes to the implementation, which just stores it
+		_, err = call(user, data)
+		require.NoError(t, err)
+		stored, err := st.GetStorage(proxy, ImplementationSlot)
+		require.NoError(t, err)
+		assert.Equal(t, v1, SlotAddress(stored))
+	})
+
+	t.Run("Upgrade", func(t *testing.T) {
+		_, err := call(user, word(10))
+		require.NoError(t, err)
+		data, err := Upgrade(v2, nil)
+		require.NoError(t, err)
+		_, err = call(admin, data)
+		require.NoError(t, err)
+
+		// The storage is kept
+		output, err := call(user, nil)
+		require.NoError(t, err)
+		assert.Equal(t, word(11), output)
+	})
+
+	t.Run("UpgradeToAndCall", func(t *testing.T) {
+		data, err := Upgrade(v1, word(100))
+		require.NoError(t, err)
+		_, err = call(admin, data)
+		require.NoError(t, err)
+		output, err := call(user, nil)
+		require.NoError(t, err)
+		assert.Equal(t, word(100), output)
+	})
+
+	t.Run("UpgradeToNonContract", func(t *testing.T) {
+		data, err := Upgrade(user, nil)
+		require.NoError(t, err)
+		_, err = call(admin, data)
+		require.Error(t, err)
+	})
+
+	t.Run("ForwardsRevert", func(t *testing.T) {
+		data, err := Upgrade(broken, nil)
+		require.NoError(t, err)
+		_, err = call(admin, data)
+		require.NoError(t, err)
+		output, err := call(user, nil)
+		require.Error(t, err)
+		assert.Equal(t, hex.EncodeUpperToString(word(0xdead)), hex.EncodeUpperToString(output))
+	})
+
+	t.Run("ChangeAdmin", func(t *testing.T) {
+		data, _, err := Spec.Pack("changeAdmin", user)
+		require.NoError(t, err)
+		_, err = call(admin, data)
+		require.NoError(t, err)
+		stored, err := st.GetStorage(proxy, AdminSlot)
+		require.NoError(t, err)
+		assert.Equal(t, user, SlotAddress(stored))
+
+		data, err = Upgrade(v2, nil)
+		require.NoError(t, err)
+		_, err = call(user, data)
+		require.NoError(t, err)
+		output, err := call(admin, nil)
+		require.NoError(t, err)
+		assert.Equal(t, word(101), output)
+	})
+}
@@ -2,7 +2,10 @@ package pkgs
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sync"
@@ -14,6 +17,7 @@ import (
 	"github.com/hyperledger/burrow/deploy/loader"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/loggers"
 )
 
 type playbookWork struct {
@@ -26,51 +30,31 @@ type playbookResult struct {
 	log      bytes.Buffer
 	err      error
 	duration time.Duration
+	gas      *def.GasReport
+	manifest *def.Manifest
 }
 
 func worker(mtx *sync.RWMutex, playbooks <-chan playbookWork, results chan<- playbookResult, args *def.DeployArgs,
 	logger *logging.Logger) {
 
 	client := def.NewClient(args.Chain, args.KeysService, args.MempoolSign, time.Duration(args.Timeout)*time.Second)
+	client.Simulate = args.Simulate
 
 	for playbook := range playbooks {
+		var gas *def.GasReport
+		var manifest *def.Manifest
 		doWork := func(work playbookWork) (logBuf bytes.Buffer, err error) {
-			// block that triggers if the do.Path was NOT set
-			//   via cli flag... or not
-			fname := filepath.Join(args.Path, work.playbook)
-
-			// if YAMLPath cannot be found, abort
-			if _, err := os.Stat(fname); os.IsNotExist(err) {
-				return logBuf, fmt.Errorf("could not find playbook file (%s)",
-					fname)
-			}
-
 			if args.Jobs != 1 {
 				logger = logging.NewLogger(log.NewLogfmtLogger(&logBuf))
 				if !args.Debug {
 					logger.Trace = log.NewNopLogger()
 				}
 			}
-
-			// Load the package if it doesn't exist
-			script, err := loader.LoadPlaybook(fname, args, logger)
-			if err != nil {
-				return logBuf, err
+			script, err := executePlaybook(mtx, work.playbook, nil, args, client, logger)
+			if script != nil {
+				gas = script.GasReport
+				manifest = script.Manifest
 			}
-
-			// Load existing bin files to decode events
-			var abiError error
-			client.AllSpecs, abiError = abi.LoadPath(script.BinPath)
-			if abiError != nil {
-				logger.InfoMsg("failed to load ABIs for Event parsing", "path", script.BinPath, "error", abiError)
-			}
-			locker := mtx.RLocker()
-			if script.NoParallel {
-				locker = mtx
-			}
-			locker.Lock()
-			defer locker.Unlock()
-			err = jobs.ExecutePlaybook(args, script, client, logger)
 			return
 		}
 
@@ -81,21 +65,63 @@ func worker(mtx *sync.RWMutex, playbooks <-chan playbookWork, results chan<- pla
 			log:      logBuf,
 			err:      err,
 			duration: time.Since(startTime),
+			gas:      gas,
+			manifest: manifest,
 		}
 	}
 }
 
-// RunPlaybooks starts workers, and loads the playbooks in parallel in the workers, and executes them.
-func RunPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logger) (int, error) {
-	// if bin and abi paths are default cli settings then use the
-	//   stated defaults of do.Path plus bin|abi
-	if args.Path == "" {
-		var err error
-		args.Path, err = os.Getwd()
-		if err != nil {
-			panic(fmt.Sprintf("failed to get current directory %v", err))
+// Loads and executes playbook, keeping the results of the jobs of previous that need not run again if it is not nil
+// (see jobs.ExecutePlaybookAgain). The playbook is returned once loaded, with the results of the jobs that ran, even
+// should one fail.
+func executePlaybook(mtx *sync.RWMutex, playbook string, previous *def.Playbook, args *def.DeployArgs,
+	client *def.Client, logger *logging.Logger) (*def.Playbook, error) {
+	// block that triggers if the do.Path was NOT set
+	//   via cli flag... or not
+	fname := filepath.Join(args.Path, playbook)
+
+	// if YAMLPath cannot be found, abort
+	if _, err := os.Stat(fname); os.IsNotExist(err) {
+		return nil, fmt.Errorf("could not find playbook file (%s)",
+			fname)
+	}
+
+	// Load the package if it doesn't exist
+	script, err := loader.LoadPlaybook(fname, args, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load existing bin files to decode events
+	var abiError error
+	client.AllSpecs, abiError = abi.LoadPath(script.BinPath)
+	if abiError != nil {
+		logger.InfoMsg("failed to load ABIs for Event parsing", "path", script.BinPath, "error", abiError)
+	}
+	locker := mtx.RLocker()
+	if script.NoParallel {
+		locker = mtx
+	}
+	locker.Lock()
+	defer locker.Unlock()
+	script.GasReport = def.NewGasReport(playbook)
+	if args.Manifest != "" && !args.Simulate {
+		script.Manifest = def.NewManifest(playbook, script.Secrets...)
+		if status, err := client.Status(logger); err == nil {
+			script.Manifest.ChainID = status.ChainID
 		}
 	}
+	// Keep the values of secrets out of the logs and errors
+	err = jobs.ExecutePlaybookAgain(args, script, previous, client, logger.WithRedaction(script.Secrets...))
+	if err != nil && len(script.Secrets) > 0 {
+		err = errors.New(loggers.Redact(err.Error(), script.Secrets...))
+	}
+	return script, err
+}
+
+// RunPlaybooks starts workers, and loads the playbooks in parallel in the workers, and executes them.
+func RunPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logger) (int, error) {
+	setPath(args)
 
 	// useful for debugging
 	logger.InfoMsg("Using chain", "Chain", args.Chain, "Signer", args.KeysService)
@@ -131,6 +157,9 @@ func RunPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logg
 			if res.err != nil {
 				fmt.Fprintf(os.Stderr, "Error in RunPlaybooks: %v\n", res.err)
 			}
+			if args.GasReport != "" && res.gas != nil {
+				res.gas.WriteTable(os.Stdout)
+			}
 			res.log.Truncate(0)
 			if res.err != nil {
 				failures++
@@ -145,6 +174,23 @@ func RunPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logg
 	}
 	close(resultQ)
 
+	if args.GasReport != "" {
+		err := writeGasReport(args.GasReport, results)
+		if err != nil {
+			return failures, err
+		}
+		logger.InfoMsg("Wrote gas report", "file", args.GasReport)
+	}
+
+	// Nothing is deployed when simulating, and simulated addresses would only mislead
+	if args.Manifest != "" && !args.Simulate {
+		err := writeManifest(args.Manifest, results)
+		if err != nil {
+			return failures, err
+		}
+		logger.InfoMsg("Wrote manifest", "file", args.Manifest)
+	}
+
 	if successes > 0 {
 		logger.InfoMsg("JOBS THAT SUCCEEDED", "count", successes)
 		for i, playbook := range playbooks {
@@ -176,3 +222,45 @@ func RunPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logg
 
 	return failures, nil
 }
+
+// Playbooks are found relative to args.Path, the current directory unless set
+func setPath(args *def.DeployArgs) {
+	if args.Path == "" {
+		var err error
+		args.Path, err = os.Getwd()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get current directory %v", err))
+		}
+	}
+}
+
+// Writes the gas used by each playbook that ran as a JSON array in the order the playbooks were given
+func writeGasReport(file string, results []*playbookResult) error {
+	reports := make([]*def.GasReport, 0, len(results))
+	for _, res := range results {
+		if res.gas != nil {
+			reports = append(reports, res.gas)
+		}
+	}
+	bs, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, bs, 0644)
+}
+
+// Writes the contracts deployed by each playbook that ran as a JSON array in the order the playbooks were given,
+// including those deployed by playbooks that failed before they finished
+func writeManifest(file string, results []*playbookResult) error {
+	manifests := make([]*def.Manifest, 0, len(results))
+	for _, res := range results {
+		if res.manifest != nil {
+			manifests = append(manifests, res.manifest)
+		}
+	}
+	bs, err := json.MarshalIndent(manifests, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, bs, 0644)
+}
@@ -0,0 +1,247 @@
+package script
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/create2"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+type function struct {
+	minArgs int
+	// Or -1 for any number
+	maxArgs int
+	call    func(args []value) (value, error)
+}
+
+func (fn function) arity() string {
+	switch {
+	case fn.maxArgs < 0:
+		return fmt.Sprintf("at least %d arguments", fn.minArgs)
+	case fn.minArgs == fn.maxArgs:
+		return fmt.Sprintf("%d arguments", fn.minArgs)
+	default:
+		return fmt.Sprintf("%d to %d arguments", fn.minArgs, fn.maxArgs)
+	}
+}
+
+var functions = map[string]function{
+	// int(x) reads x as an integer, from decimal, 0x prefixed hex, or big-endian bytes
+	"int": {1, 1, func(args []value) (value, error) {
+		return toInt(args[0])
+	}},
+	// string(x) renders x as the result of a job
+	"string": {1, 1, func(args []value) (value, error) {
+		return toString(args[0]), nil
+	}},
+	// bool(x) reads x as true or false, an integer being true unless zero
+	"bool": {1, 1, func(args []value) (value, error) {
+		return toBool(args[0])
+	}},
+	// bytes(x) reads x as bytes, from hex optionally prefixed with 0x or from an integer as a 32 byte word
+	"bytes": {1, 1, func(args []value) (value, error) {
+		return toBytes(args[0])
+	}},
+	// len(x) is the length of a string, bytes, or list
+	"len": {1, 1, func(args []value) (value, error) {
+		switch x := args[0].(type) {
+		case string:
+			return big.NewInt(int64(len(x))), nil
+		case []byte:
+			return big.NewInt(int64(len(x))), nil
+		case []value:
+			return big.NewInt(int64(len(x))), nil
+		}
+		return nil, fmt.Errorf("%s has no length", typeName(args[0]))
+	}},
+	"lower": {1, 1, func(args []value) (value, error) {
+		return strings.ToLower(toString(args[0])), nil
+	}},
+	"upper": {1, 1, func(args []value) (value, error) {
+		return strings.ToUpper(toString(args[0])), nil
+	}},
+	// contains(x, y) is whether string x contains y, or list x has an element equal to y
+	"contains": {2, 2, func(args []value) (value, error) {
+		if list, ok := args[0].([]value); ok {
+			for _, element := range list {
+				eq, err := equal(element, args[1])
+				if err == nil && eq {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return strings.Contains(toString(args[0]), toString(args[1])), nil
+	}},
+	// substr(s, start, end) is s from byte start up to end, or the end of s if end is not given
+	"substr": {2, 3, func(args []value) (value, error) {
+		str := toString(args[0])
+		start, err := toIndex(args[1], len(str))
+		if err != nil {
+			return nil, err
+		}
+		end := len(str)
+		if len(args) == 3 {
+			end, err = toIndex(args[2], len(str))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if end < start {
+			return nil, fmt.Errorf("end %d is before start %d", end, start)
+		}
+		return str[start:end], nil
+	}},
+	// split(s, sep) is the list of the parts of s between each sep
+	"split": {2, 2, func(args []value) (value, error) {
+		parts := strings.Split(toString(args[0]), toString(args[1]))
+		list := make([]value, len(parts))
+		for i, part := range parts {
+			list[i] = part
+		}
+		return list, nil
+	}},
+	// join(list, sep) is the elements of list as strings with sep between each
+	"join": {2, 2, func(args []value) (value, error) {
+		list, ok := args[0].([]value)
+		if !ok {
+			return nil, fmt.Errorf("can only join a list but got %s", typeName(args[0]))
+		}
+		strs := make([]string, len(list))
+		for i, element := range list {
+			strs[i] = toString(element)
+		}
+		return strings.Join(strs, toString(args[1])), nil
+	}},
+	// concat(x, ...) joins its arguments as strings, even should they be integers
+	"concat": {1, -1, func(args []value) (value, error) {
+		sb := new(strings.Builder)
+		for _, arg := range args {
+			sb.WriteString(toString(arg))
+		}
+		return sb.String(), nil
+	}},
+	// keccak256(x, ...) hashes its arguments packed one after the other as by Solidity's abi.encodePacked, so
+	// strings as UTF-8 and integers as 32 byte words. Pass bytes(x) to hash x as hex.
+	"keccak256": {1, -1, func(args []value) (value, error) {
+		bs, err := packed(args)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256(bs), nil
+	}},
+	// sha256(x, ...) hashes its arguments as keccak256 does
+	"sha256": {1, -1, func(args []value) (value, error) {
+		bs, err := packed(args)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(bs)
+		return hash[:], nil
+	}},
+	// address(x) reads x as an address, from 20 bytes or the last 20 of a 32 byte word
+	"address": {1, 1, func(args []value) (value, error) {
+		address, err := toAddress(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return address.Bytes(), nil
+	}},
+	// create2Address(factory, salt, code) is the address a deploy job with salt deploys code (including any
+	// constructor arguments) to through the CREATE2 factory at factory
+	"create2Address": {3, 3, func(args []value) (value, error) {
+		factory, err := toAddress(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("factory: %w", err)
+		}
+		code, err := toBytes(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("code: %w", err)
+		}
+		address := create2.Address(factory, create2.Salt(toString(args[1]), code))
+		return address.Bytes(), nil
+	}},
+	// selector(signature) is the 4 byte function selector of a signature such as transfer(address,uint256)
+	"selector": {1, 1, func(args []value) (value, error) {
+		spec, err := abi.ParseFunctionSignature(toString(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return spec.FunctionID.Bytes(), nil
+	}},
+	// pack(types, x, ...) ABI encodes its arguments as the comma separated types, such as "address,uint256",
+	// which packs a struct of static fields as Solidity's abi.encode would
+	"pack": {1, -1, func(args []value) (value, error) {
+		spec, err := abi.ParseFunctionSignature("pack(" + toString(args[0]) + ")")
+		if err != nil {
+			return nil, err
+		}
+		return packArgs(spec, args[1:])
+	}},
+	// encodeCall(signature, x, ...) is the input of a call to the function with signature and its arguments
+	"encodeCall": {1, -1, func(args []value) (value, error) {
+		spec, err := abi.ParseFunctionSignature(toString(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		bs, err := packArgs(spec, args[1:])
+		if err != nil {
+			return nil, err
+		}
+		return append(spec.FunctionID.Bytes(), bs...), nil
+	}},
+	// fail(message) stops the script, failing the job with message
+	"fail": {1, 1, func(args []value) (value, error) {
+		return nil, errors.New(toString(args[0]))
+	}},
+}
+
+func toIndex(v value, length int) (int, error) {
+	n, err := toInt(v)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() || n.Int64() < 0 || n.Int64() > int64(length) {
+		return 0, fmt.Errorf("%v out of range of string of length %d", n, length)
+	}
+	return int(n.Int64()), nil
+}
+
+func toAddress(v value) (crypto.Address, error) {
+	bs, err := toBytes(v)
+	if err != nil {
+		return crypto.ZeroAddress, err
+	}
+	if len(bs) == 32 {
+		bs = bs[12:]
+	}
+	return crypto.AddressFromBytes(bs)
+}
+
+func packArgs(spec *abi.FunctionSpec, args []value) ([]byte, error) {
+	if len(args) != len(spec.Inputs) {
+		return nil, fmt.Errorf("%d types but %d values to pack", len(spec.Inputs), len(args))
+	}
+	return abi.Pack(spec.Inputs, abiArgs(args)...)
+}
+
+// The values as the types abi.Pack takes, with integers as strings for the types that do not take a *big.Int
+func abiArgs(args []value) []interface{} {
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch a := arg.(type) {
+		case *big.Int:
+			values[i] = a.String()
+		case []value:
+			values[i] = abiArgs(a)
+		default:
+			values[i] = a
+		}
+	}
+	return values
+}
@@ -0,0 +1,218 @@
+package script
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	// A newline or semicolon ending a statement
+	tokenEnd
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenPlaceholder
+	tokenOperator
+)
+
+func (kind tokenKind) String() string {
+	switch kind {
+	case tokenEOF:
+		return "end of script"
+	case tokenEnd:
+		return "end of statement"
+	case tokenIdent:
+		return "name"
+	case tokenNumber:
+		return "number"
+	case tokenString:
+		return "string"
+	case tokenPlaceholder:
+		return "variable"
+	default:
+		return "operator"
+	}
+}
+
+type position struct {
+	line   int
+	column int
+}
+
+func (pos position) String() string {
+	return fmt.Sprintf("%d:%d", pos.line, pos.column)
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  position
+}
+
+func (tok token) String() string {
+	switch tok.kind {
+	case tokenEOF, tokenEnd:
+		return tok.kind.String()
+	default:
+		return fmt.Sprintf("'%s'", tok.text)
+	}
+}
+
+// Longest first so that each operator is read whole
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||",
+	"(", ")", "[", "]", "{", "}", ",", "=", "<", ">", "+", "-", "*", "/", "%", "!", "?", ":"}
+
+// Splits code into tokens. Newlines end statements except within brackets, so a long expression can be broken over
+// lines inside them.
+func lex(code string) ([]token, error) {
+	var tokens []token
+	runes := []rune(code)
+	pos := position{line: 1, column: 1}
+	depth := 0
+	i := 0
+	advance := func(n int) string {
+		text := string(runes[i : i+n])
+		for _, r := range runes[i : i+n] {
+			if r == '\n' {
+				pos.line++
+				pos.column = 1
+			} else {
+				pos.column++
+			}
+		}
+		i += n
+		return text
+	}
+	span := func(from int, in func(rune) bool) int {
+		n := from
+		for i+n < len(runes) && in(runes[i+n]) {
+			n++
+		}
+		return n
+	}
+	for i < len(runes) {
+		r := runes[i]
+		start := pos
+		switch {
+		case r == '\n' || r == ';':
+			if depth == 0 {
+				tokens = append(tokens, token{kind: tokenEnd, text: advance(1), pos: start})
+			} else {
+				advance(1)
+			}
+		case unicode.IsSpace(r):
+			advance(1)
+		case strings.HasPrefix(string(runes[i:]), "//"):
+			advance(span(0, func(r rune) bool { return r != '\n' }))
+		case isWord(r) && !unicode.IsDigit(r):
+			tokens = append(tokens, token{kind: tokenIdent, text: advance(span(0, isWord)), pos: start})
+		case unicode.IsDigit(r):
+			tokens = append(tokens, token{kind: tokenNumber, text: advance(span(0, isWord)), pos: start})
+		case r == '$':
+			n, err := placeholderLength(runes[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", start, err)
+			}
+			tokens = append(tokens, token{kind: tokenPlaceholder, text: advance(n), pos: start})
+		case r == '"' || r == '\'':
+			text, n, err := readString(runes[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", start, err)
+			}
+			advance(n)
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: start})
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(string(runes[i:]), o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("%v: unexpected character '%c'", start, r)
+			}
+			switch op {
+			case "(", "[":
+				depth++
+			case ")", "]":
+				if depth > 0 {
+					depth--
+				}
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: advance(len(op)), pos: start})
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: pos}), nil
+}
+
+func isWord(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// The length of the placeholder $job, $job.variable, or ${job.variable} at the start of runes
+func placeholderLength(runes []rune) (int, error) {
+	n := 1
+	braced := len(runes) > 1 && runes[1] == '{'
+	if braced {
+		n++
+	}
+	word := func() bool {
+		from := n
+		for n < len(runes) && isWord(runes[n]) {
+			n++
+		}
+		return n > from
+	}
+	if !word() {
+		return 0, fmt.Errorf("expected a job name after $")
+	}
+	if n < len(runes)-1 && runes[n] == '.' && isWord(runes[n+1]) {
+		n++
+		word()
+	}
+	if braced {
+		if n == len(runes) || runes[n] != '}' {
+			return 0, fmt.Errorf("expected } to close ${")
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Reads the quoted string at the start of runes, returning its value and the number of runes it spans. Backslash
+// escapes the quote, a backslash, and n and t for newline and tab.
+func readString(runes []rune) (string, int, error) {
+	quote := runes[0]
+	sb := new(strings.Builder)
+	for n := 1; n < len(runes); n++ {
+		switch r := runes[n]; r {
+		case quote:
+			return sb.String(), n + 1, nil
+		case '\n':
+			return "", 0, fmt.Errorf("string not closed before end of line")
+		case '\\':
+			n++
+			if n == len(runes) {
+				return "", 0, fmt.Errorf("string not closed")
+			}
+			switch e := runes[n]; e {
+			case 'n':
+				sb.WriteRune('\n')
+			case 't':
+				sb.WriteRune('\t')
+			case '\\', '"', '\'':
+				sb.WriteRune(e)
+			default:
+				return "", 0, fmt.Errorf("unknown escape \\%c in string", e)
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return "", 0, fmt.Errorf("string not closed")
+}
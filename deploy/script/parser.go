@@ -0,0 +1,432 @@
+package script
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hyperledger/burrow/deploy/def/rule"
+)
+
+type node interface {
+	eval(env *environment) (value, error)
+}
+
+type statement interface {
+	exec(env *environment) error
+}
+
+type (
+	literal struct {
+		value value
+	}
+	placeholder struct {
+		pos   position
+		match rule.PlaceholderMatch
+	}
+	variable struct {
+		pos  position
+		name string
+	}
+	listLiteral struct {
+		elements []node
+	}
+	unaryOp struct {
+		pos      position
+		operator string
+		operand  node
+	}
+	binaryOp struct {
+		pos         position
+		operator    string
+		left, right node
+	}
+	conditional struct {
+		pos                     position
+		cond, ifTrue, otherwise node
+	}
+	index struct {
+		pos       position
+		container node
+		index     node
+	}
+	call struct {
+		pos  position
+		name string
+		args []node
+	}
+)
+
+type (
+	assignment struct {
+		name  string
+		value node
+	}
+	ifStatement struct {
+		pos       position
+		cond      node
+		then      []statement
+		otherwise []statement
+	}
+	expressionStatement struct {
+		expr node
+	}
+)
+
+type parser struct {
+	tokens []token
+	next   int
+}
+
+func parse(code string) ([]statement, error) {
+	tokens, err := lex(code)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	statements, err := p.statements()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("%v: unexpected %v", tok.pos, tok)
+	}
+	return statements, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) take() token {
+	tok := p.tokens[p.next]
+	if tok.kind != tokenEOF {
+		p.next++
+	}
+	return tok
+}
+
+// Whether the next token is the operator or keyword text, taking it if so
+func (p *parser) accept(text string) bool {
+	tok := p.peek()
+	if (tok.kind == tokenOperator || tok.kind == tokenIdent) && tok.text == text {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		tok := p.peek()
+		return fmt.Errorf("%v: expected '%s' but got %v", tok.pos, text, tok)
+	}
+	return nil
+}
+
+func (p *parser) skipEnds() {
+	for p.peek().kind == tokenEnd {
+		p.next++
+	}
+}
+
+// Reads statements until the end of the script or of the block they are in
+func (p *parser) statements() ([]statement, error) {
+	var statements []statement
+	for {
+		p.skipEnds()
+		tok := p.peek()
+		if tok.kind == tokenEOF || tok.kind == tokenOperator && tok.text == "}" {
+			return statements, nil
+		}
+		stmt, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+		tok = p.peek()
+		if tok.kind != tokenEnd && tok.kind != tokenEOF && !(tok.kind == tokenOperator && tok.text == "}") {
+			return nil, fmt.Errorf("%v: expected end of statement but got %v", tok.pos, tok)
+		}
+	}
+}
+
+func (p *parser) statement() (statement, error) {
+	tok := p.peek()
+	if tok.kind == tokenIdent {
+		switch {
+		case tok.text == "if":
+			p.take()
+			return p.ifStatement(tok.pos)
+		case tok.text == "let":
+			p.take()
+			name := p.take()
+			if name.kind != tokenIdent || isKeyword(name.text) {
+				return nil, fmt.Errorf("%v: expected a variable name after let but got %v", name.pos, name)
+			}
+			return p.assignment(name.text)
+		case p.tokens[p.next+1].kind == tokenOperator && p.tokens[p.next+1].text == "=" && !isKeyword(tok.text):
+			p.take()
+			return p.assignment(tok.text)
+		}
+	}
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return &expressionStatement{expr: expr}, nil
+}
+
+func (p *parser) assignment(name string) (statement, error) {
+	err := p.expect("=")
+	if err != nil {
+		return nil, err
+	}
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return &assignment{name: name, value: expr}, nil
+}
+
+func (p *parser) ifStatement(pos position) (statement, error) {
+	cond, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	then, err := p.block()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &ifStatement{pos: pos, cond: cond, then: then}
+	// Allow else on the line after the closing brace
+	next := p.next
+	p.skipEnds()
+	if !p.accept("else") {
+		p.next = next
+		return stmt, nil
+	}
+	if tok := p.peek(); tok.kind == tokenIdent && tok.text == "if" {
+		p.take()
+		elseIf, err := p.ifStatement(tok.pos)
+		if err != nil {
+			return nil, err
+		}
+		stmt.otherwise = []statement{elseIf}
+		return stmt, nil
+	}
+	stmt.otherwise, err = p.block()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+func (p *parser) block() ([]statement, error) {
+	err := p.expect("{")
+	if err != nil {
+		return nil, err
+	}
+	statements, err := p.statements()
+	if err != nil {
+		return nil, err
+	}
+	return statements, p.expect("}")
+}
+
+func (p *parser) expression() (node, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	tok := p.peek()
+	if !p.accept("?") {
+		return cond, nil
+	}
+	ifTrue, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	err = p.expect(":")
+	if err != nil {
+		return nil, err
+	}
+	otherwise, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return &conditional{pos: tok.pos, cond: cond, ifTrue: ifTrue, otherwise: otherwise}, nil
+}
+
+// Binary operators from loosest to tightest binding
+var precedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) binary(level int) (node, error) {
+	if level == len(precedence) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenOperator || !contains(precedence[level], tok.text) {
+			return left, nil
+		}
+		p.take()
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryOp{pos: tok.pos, operator: tok.text, left: left, right: right}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	tok := p.peek()
+	if p.accept("!") || p.accept("-") {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryOp{pos: tok.pos, operator: tok.text, operand: operand}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	expr, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if !p.accept("[") {
+			return expr, nil
+		}
+		i, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expect("]")
+		if err != nil {
+			return nil, err
+		}
+		expr = &index{pos: tok.pos, container: expr, index: i}
+	}
+}
+
+func (p *parser) primary() (node, error) {
+	tok := p.take()
+	switch tok.kind {
+	case tokenNumber:
+		n, err := parseInt(tok.text)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", tok.pos, err)
+		}
+		return &literal{value: n}, nil
+	case tokenString:
+		return &literal{value: tok.text}, nil
+	case tokenPlaceholder:
+		return &placeholder{pos: tok.pos, match: rule.MatchPlaceholders(tok.text)[0]}, nil
+	case tokenIdent:
+		switch tok.text {
+		case "true", "false":
+			return &literal{value: tok.text == "true"}, nil
+		}
+		if isKeyword(tok.text) {
+			return nil, fmt.Errorf("%v: unexpected %v", tok.pos, tok)
+		}
+		if !p.accept("(") {
+			return &variable{pos: tok.pos, name: tok.text}, nil
+		}
+		args, err := p.list(")")
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := functions[tok.text]; !ok {
+			return nil, fmt.Errorf("%v: unknown function %s", tok.pos, tok.text)
+		}
+		return &call{pos: tok.pos, name: tok.text, args: args}, nil
+	case tokenOperator:
+		switch tok.text {
+		case "(":
+			expr, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			return expr, p.expect(")")
+		case "[":
+			elements, err := p.list("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listLiteral{elements: elements}, nil
+		}
+	}
+	return nil, fmt.Errorf("%v: unexpected %v", tok.pos, tok)
+}
+
+// Reads expressions separated by commas up to closing, allowing a trailing comma
+func (p *parser) list(closing string) ([]node, error) {
+	var elements []node
+	for !p.accept(closing) {
+		expr, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, expr)
+		if !p.accept(",") {
+			err = p.expect(closing)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return elements, nil
+}
+
+func isKeyword(word string) bool {
+	switch word {
+	case "if", "else", "let", "true", "false":
+		return true
+	}
+	return false
+}
+
+func contains(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses a decimal integer, or a hexadecimal one prefixed with 0x
+func parseInt(str string) (*big.Int, error) {
+	str = strings.TrimSpace(str)
+	base := 10
+	digits := str
+	if strings.HasPrefix(str, "0x") || strings.HasPrefix(str, "0X") {
+		base = 16
+		digits = str[2:]
+	} else if strings.HasPrefix(str, "-0x") || strings.HasPrefix(str, "-0X") {
+		base = 16
+		digits = "-" + str[3:]
+	}
+	n, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not an integer", str)
+	}
+	return n, nil
+}
@@ -0,0 +1,335 @@
+// Package script runs the small scripts of script jobs, which compute values from the results of earlier jobs that the
+// placeholders of other jobs cannot, such as the address a contract will be deployed to or the packed fields of a
+// struct. A script is a list of statements, each on its own line or separated by semicolons:
+//
+//	salt = keccak256("token", $index)
+//	if $registry == "" {
+//	  fail("no registry")
+//	}
+//	create2Address($factory, salt, $tokenCode)
+//
+// Statements assign variables, with an optional let, branch with if and else, or are expressions. Expressions are
+// built from strings, integers, true and false, lists in brackets, variables, the placeholders of other jobs, the
+// operators of JavaScript other than assignment, and the functions listed in functions.go, such as keccak256, pack,
+// and create2Address. The result of a script is the value of the last statement it runs.
+//
+// Since the results of jobs are strings a string is taken as an integer where one is needed, and + adds should both
+// sides be integers and joins them as strings otherwise. Bytes render as upper case hex, as addresses do.
+package script
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/deploy/def/rule"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+// Lookup returns the value of a placeholder such as $job or $job.variable
+type Lookup func(match rule.PlaceholderMatch) (string, error)
+
+// Script is a parsed script that can be run any number of times
+type Script struct {
+	statements []statement
+}
+
+func Parse(code string) (*Script, error) {
+	statements, err := parse(code)
+	if err != nil {
+		return nil, err
+	}
+	return &Script{statements: statements}, nil
+}
+
+// Run runs the script with the values of placeholders given by lookup, returning its result and the variables it
+// assigned in the order they were first assigned
+func (s *Script) Run(lookup Lookup) (string, []*abi.Variable, error) {
+	env := &environment{
+		lookup:    lookup,
+		variables: make(map[string]value),
+	}
+	err := execAll(s.statements, env)
+	if err != nil {
+		return "", nil, err
+	}
+	variables := make([]*abi.Variable, len(env.names))
+	for i, name := range env.names {
+		variables[i] = &abi.Variable{Name: name, Value: toString(env.variables[name])}
+	}
+	result := ""
+	if env.result != nil {
+		result = toString(env.result)
+	}
+	return result, variables, nil
+}
+
+type environment struct {
+	lookup    Lookup
+	variables map[string]value
+	// The order in which variables were first assigned
+	names []string
+	// The value of the last statement run
+	result value
+}
+
+func errorAt(pos position, err error) error {
+	return fmt.Errorf("%v: %w", pos, err)
+}
+
+func execAll(statements []statement, env *environment) error {
+	for _, stmt := range statements {
+		err := stmt.exec(env)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (stmt *assignment) exec(env *environment) error {
+	v, err := stmt.value.eval(env)
+	if err != nil {
+		return err
+	}
+	if _, ok := env.variables[stmt.name]; !ok {
+		env.names = append(env.names, stmt.name)
+	}
+	env.variables[stmt.name] = v
+	env.result = v
+	return nil
+}
+
+func (stmt *ifStatement) exec(env *environment) error {
+	v, err := stmt.cond.eval(env)
+	if err != nil {
+		return err
+	}
+	cond, err := toBool(v)
+	if err != nil {
+		return errorAt(stmt.pos, fmt.Errorf("condition of if: %w", err))
+	}
+	if cond {
+		return execAll(stmt.then, env)
+	}
+	return execAll(stmt.otherwise, env)
+}
+
+func (stmt *expressionStatement) exec(env *environment) error {
+	v, err := stmt.expr.eval(env)
+	if err != nil {
+		return err
+	}
+	env.result = v
+	return nil
+}
+
+func (expr *literal) eval(env *environment) (value, error) {
+	return expr.value, nil
+}
+
+func (expr *placeholder) eval(env *environment) (value, error) {
+	str, err := env.lookup(expr.match)
+	if err != nil {
+		return nil, errorAt(expr.pos, err)
+	}
+	return str, nil
+}
+
+func (expr *variable) eval(env *environment) (value, error) {
+	v, ok := env.variables[expr.name]
+	if !ok {
+		return nil, errorAt(expr.pos, fmt.Errorf("variable %s has not been assigned", expr.name))
+	}
+	return v, nil
+}
+
+func (expr *listLiteral) eval(env *environment) (value, error) {
+	list := make([]value, len(expr.elements))
+	for i, element := range expr.elements {
+		v, err := element.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = v
+	}
+	return list, nil
+}
+
+func (expr *unaryOp) eval(env *environment) (value, error) {
+	v, err := expr.operand.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if expr.operator == "!" {
+		b, err := toBool(v)
+		if err != nil {
+			return nil, errorAt(expr.pos, err)
+		}
+		return !b, nil
+	}
+	n, err := toInt(v)
+	if err != nil {
+		return nil, errorAt(expr.pos, err)
+	}
+	return new(big.Int).Neg(n), nil
+}
+
+func (expr *binaryOp) eval(env *environment) (value, error) {
+	left, err := expr.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	// Only evaluate the right of && and || if it decides the result
+	switch expr.operator {
+	case "&&", "||":
+		b, err := toBool(left)
+		if err != nil {
+			return nil, errorAt(expr.pos, err)
+		}
+		if b == (expr.operator == "||") {
+			return b, nil
+		}
+		right, err := expr.right.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		b, err = toBool(right)
+		if err != nil {
+			return nil, errorAt(expr.pos, err)
+		}
+		return b, nil
+	}
+	right, err := expr.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	v, err := operate(expr.operator, left, right)
+	if err != nil {
+		return nil, errorAt(expr.pos, err)
+	}
+	return v, nil
+}
+
+func operate(operator string, left, right value) (value, error) {
+	switch operator {
+	case "==", "!=":
+		eq, err := equal(left, right)
+		return eq == (operator == "=="), err
+	case "<":
+		return compare(left, right) < 0, nil
+	case "<=":
+		return compare(left, right) <= 0, nil
+	case ">":
+		return compare(left, right) > 0, nil
+	case ">=":
+		return compare(left, right) >= 0, nil
+	case "+":
+		if !isInt(left) || !isInt(right) {
+			x, xBytes := left.([]byte)
+			y, yBytes := right.([]byte)
+			if xBytes && yBytes {
+				return append(append([]byte{}, x...), y...), nil
+			}
+			return toString(left) + toString(right), nil
+		}
+	}
+	x, err := toInt(left)
+	if err != nil {
+		return nil, fmt.Errorf("left of %s: %w", operator, err)
+	}
+	y, err := toInt(right)
+	if err != nil {
+		return nil, fmt.Errorf("right of %s: %w", operator, err)
+	}
+	switch operator {
+	case "+":
+		return new(big.Int).Add(x, y), nil
+	case "-":
+		return new(big.Int).Sub(x, y), nil
+	case "*":
+		return new(big.Int).Mul(x, y), nil
+	}
+	if y.Sign() == 0 {
+		return nil, fmt.Errorf("division by zero")
+	}
+	// Truncated as Solidity divides
+	if operator == "/" {
+		return new(big.Int).Quo(x, y), nil
+	}
+	return new(big.Int).Rem(x, y), nil
+}
+
+func (expr *conditional) eval(env *environment) (value, error) {
+	v, err := expr.cond.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	cond, err := toBool(v)
+	if err != nil {
+		return nil, errorAt(expr.pos, err)
+	}
+	if cond {
+		return expr.ifTrue.eval(env)
+	}
+	return expr.otherwise.eval(env)
+}
+
+func (expr *index) eval(env *environment) (value, error) {
+	container, err := expr.container.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	v, err := expr.index.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	n, err := toInt(v)
+	if err != nil {
+		return nil, errorAt(expr.pos, fmt.Errorf("index: %w", err))
+	}
+	length := 0
+	switch c := container.(type) {
+	case []value:
+		length = len(c)
+	case []byte:
+		length = len(c)
+	case string:
+		length = len(c)
+	default:
+		return nil, errorAt(expr.pos, fmt.Errorf("cannot index %s", typeName(container)))
+	}
+	if !n.IsInt64() || n.Int64() < 0 || n.Int64() >= int64(length) {
+		return nil, errorAt(expr.pos, fmt.Errorf("index %v out of range of %s of length %d", n, typeName(container),
+			length))
+	}
+	i := int(n.Int64())
+	switch c := container.(type) {
+	case []value:
+		return c[i], nil
+	case []byte:
+		return big.NewInt(int64(c[i])), nil
+	default:
+		return c.(string)[i : i+1], nil
+	}
+}
+
+func (expr *call) eval(env *environment) (value, error) {
+	args := make([]value, len(expr.args))
+	for i, arg := range expr.args {
+		v, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	fn := functions[expr.name]
+	if len(args) < fn.minArgs || fn.maxArgs >= 0 && len(args) > fn.maxArgs {
+		return nil, errorAt(expr.pos, fmt.Errorf("%s takes %s but was given %d", expr.name, fn.arity(),
+			len(args)))
+	}
+	v, err := fn.call(args)
+	if err != nil {
+		return nil, errorAt(expr.pos, fmt.Errorf("%s: %w", expr.name, err))
+	}
+	return v, nil
+}
@@ -0,0 +1,171 @@
+package script
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/create2"
+	"github.com/hyperledger/burrow/deploy/def/rule"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+var results = map[string]string{
+	"count":    "41",
+	"name":     "token",
+	"flag":     "true",
+	"token":    "B2A2D2AE9D1CFC1C7A4B8ABA96F3C7D20F2A9B8C",
+	"call.out": "7",
+}
+
+func lookup(match rule.PlaceholderMatch) (string, error) {
+	key := match.JobName
+	if match.VariableName != "" {
+		key += "." + match.VariableName
+	}
+	result, ok := results[key]
+	if !ok {
+		return "", fmt.Errorf("no job named %s", key)
+	}
+	return result, nil
+}
+
+func run(t *testing.T, code string) (string, []*abi.Variable) {
+	t.Helper()
+	s, err := Parse(code)
+	require.NoError(t, err)
+	result, variables, err := s.Run(lookup)
+	require.NoError(t, err)
+	return result, variables
+}
+
+func TestRun(t *testing.T) {
+	for code, expected := range map[string]string{
+		`1 + 2 * 3`:                              "7",
+		`(1 + 2) * 3`:                            "9",
+		`$count + 1`:                             "42",
+		`${call.out} * -2`:                       "-14",
+		`7 / 2; 7 % 2`:                           "1",
+		`-7 / 2`:                                 "-3",
+		`0x10 + 1`:                               "17",
+		`$name + "-" + $count`:                   "token-41",
+		`concat(1, 2)`:                           "12",
+		`"1" + "2"`:                              "3",
+		`$count > 40 && $flag`:                   "true",
+		`$count < 40 || !$flag`:                  "false",
+		`$count == "41"`:                         "true",
+		`"abc" < "abd"`:                          "true",
+		`$count >= 41 ? "big" : "small"`:         "big",
+		`upper($name)`:                           "TOKEN",
+		`substr($name, 1, 3)`:                    "ok",
+		`len(split("a,b,c", ","))`:               "3",
+		`join(["a", 1, true], "/")`:              "a/1/true",
+		`["a", "b"][1]`:                          "b",
+		`[1, 2]`:                                 "[1,2]",
+		`contains([1, 2], "2")`:                  "true",
+		`contains($name, "ok")`:                  "true",
+		`bytes("0x0aff")`:                        "0AFF",
+		`bytes(1)[31]`:                           "1",
+		`int(bytes("0100"))`:                     "256",
+		`address($token) == lower($token)`:       "true",
+		`address(bytes(255))`:                    "00000000000000000000000000000000000000FF",
+		`keccak256("")`:                          "C5D2460186F7233C927E7DB2DCC703C0E500B653CA82273B7BFAD8045D85A470",
+		`keccak256("a", "b") == keccak256("ab")`: "true",
+		`selector("transfer(address,uint256)")`:  "A9059CBB",
+		`pack("uint256,bool", 1, true)`: "0000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000001",
+		`encodeCall("set(uint8)", 2)`: "24B8BA5F0000000000000000000000000000000000000000000000000000000000000002",
+		`("multi" + // comment
+		 "line")`: "multiline",
+	} {
+		t.Run(code, func(t *testing.T) {
+			result, _ := run(t, code)
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func TestStatements(t *testing.T) {
+	result, variables := run(t, `
+		let total = $count + 1
+		if total > 100 {
+			size = "large"
+		} else if total > 10 {
+			size = "medium"
+		}
+		else {
+			size = "small"
+		}
+		total = total * 2; size
+	`)
+	assert.Equal(t, "medium", result)
+	assert.Equal(t, []*abi.Variable{{Name: "total", Value: "84"}, {Name: "size", Value: "medium"}}, variables)
+
+	result, _ = run(t, `x = 1; if x == 2 { x = 3 }`)
+	assert.Equal(t, "1", result, "result is that of the last statement run")
+
+	result, variables = run(t, "")
+	assert.Equal(t, "", result)
+	assert.Empty(t, variables)
+}
+
+func TestCreate2Address(t *testing.T) {
+	factory := crypto.Address{1, 2, 3}
+	code := []byte{0x60, 0x00}
+	result, _ := run(t, fmt.Sprintf(`create2Address("%v", "v1", "%X")`, factory, code))
+	assert.Equal(t, create2.Address(factory, create2.Salt("v1", code)).String(), result)
+}
+
+func TestErrors(t *testing.T) {
+	for code, expected := range map[string]string{
+		`1 +`:         "1:4: unexpected end of script",
+		`"open`:       "1:1: string not closed",
+		`1 2`:         "1:3: expected end of statement but got '2'",
+		`if true { 1`: "1:12: expected '}' but got end of script",
+		`let if = 1`:  "1:5: expected a variable name after let but got 'if'",
+		`nope(1)`:     "1:1: unknown function nope",
+		`1 @ 2`:       "1:3: unexpected character '@'",
+		`${name`:      "1:1: expected } to close ${",
+		`$`:           "1:1: expected a job name after $",
+	} {
+		t.Run(code, func(t *testing.T) {
+			_, err := Parse(code)
+			require.Error(t, err)
+			assert.Equal(t, expected, err.Error())
+		})
+	}
+
+	for code, expected := range map[string]string{
+		`x + 1`:                    "1:1: variable x has not been assigned",
+		`$missing`:                 "1:1: no job named missing",
+		`1 / (2 - 2)`:              "1:3: division by zero",
+		`$name * 2`:                "1:7: left of *: 'token' is not an integer",
+		`if $name { 1 }`:           "1:1: condition of if: string 'token' is neither true nor false",
+		`[1][1]`:                   "1:4: index 1 out of range of list of length 1",
+		`len(1, 2)`:                "1:1: len takes 1 arguments but was given 2",
+		`fail("no registry")`:      "1:1: fail: no registry",
+		`address("01")`:            "1:1: address: slice passed as address '01' has 1 bytes but should have 20 bytes",
+		`false && fail("not run")`: "",
+	} {
+		t.Run(code, func(t *testing.T) {
+			s, err := Parse(code)
+			require.NoError(t, err)
+			_, _, err = s.Run(lookup)
+			if expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, expected, err.Error())
+		})
+	}
+}
+
+func TestHex(t *testing.T) {
+	// Bytes render as the results of other jobs do
+	result, _ := run(t, `keccak256(bytes("00"))`)
+	assert.Equal(t, hex.EncodeUpperToString(crypto.Keccak256([]byte{0})), result)
+}
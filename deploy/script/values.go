@@ -0,0 +1,181 @@
+package script
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// The values a script computes with: a string, an integer as a *big.Int, a bool, bytes, or a list of values as
+// []value. The results of jobs are strings, which are taken as integers, bools, or hex bytes where they need to be.
+type value interface{}
+
+// Renders v as the result of a job would be: bytes in upper case hex as addresses are and lists as [a,b] as array
+// arguments are given to calls
+func toString(v value) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case *big.Int:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case []byte:
+		return hex.EncodeUpperToString(v)
+	case []value:
+		elements := make([]string, len(v))
+		for i, element := range v {
+			elements[i] = toString(element)
+		}
+		return "[" + strings.Join(elements, ",") + "]"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func typeName(v value) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case *big.Int:
+		return "integer"
+	case bool:
+		return "bool"
+	case []byte:
+		return "bytes"
+	case []value:
+		return "list"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func toInt(v value) (*big.Int, error) {
+	switch v := v.(type) {
+	case *big.Int:
+		return v, nil
+	case string:
+		return parseInt(v)
+	case []byte:
+		return new(big.Int).SetBytes(v), nil
+	}
+	return nil, fmt.Errorf("%s is not an integer", typeName(v))
+}
+
+func isInt(v value) bool {
+	_, err := toInt(v)
+	_, isBytes := v.([]byte)
+	return err == nil && !isBytes
+}
+
+func toBool(v value) (bool, error) {
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return false, fmt.Errorf("string '%s' is neither true nor false", v)
+	case *big.Int:
+		return v.Sign() != 0, nil
+	}
+	return false, fmt.Errorf("%s is neither true nor false", typeName(v))
+}
+
+// Bytes from hex, optionally prefixed with 0x, or from an integer as a 32 byte word
+func toBytes(v value) ([]byte, error) {
+	switch v := v.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		str := strings.TrimPrefix(strings.TrimPrefix(v, "0x"), "0X")
+		bs, err := hex.DecodeString(str)
+		if err != nil {
+			return nil, fmt.Errorf("string '%s' is not hex: %v", v, err)
+		}
+		return bs, nil
+	case *big.Int:
+		return word(v), nil
+	}
+	return nil, fmt.Errorf("%s cannot be read as bytes", typeName(v))
+}
+
+// The bytes v is hashed as, which are those Solidity's abi.encodePacked would give for a value of its type: a string
+// as its UTF-8 bytes, an integer as a 32 byte word, a bool as a single byte, and a list as its elements one after
+// the other
+func packed(v value) ([]byte, error) {
+	switch v := v.(type) {
+	case string:
+		return []byte(v), nil
+	case *big.Int:
+		return word(v), nil
+	case bool:
+		if v {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case []byte:
+		return v, nil
+	case []value:
+		var bs []byte
+		for _, element := range v {
+			b, err := packed(element)
+			if err != nil {
+				return nil, err
+			}
+			bs = append(bs, b...)
+		}
+		return bs, nil
+	}
+	return nil, fmt.Errorf("%s cannot be hashed", typeName(v))
+}
+
+// An integer as a 32 byte big-endian two's complement word
+func word(n *big.Int) []byte {
+	if n.Sign() < 0 {
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return binary.LeftPadBytes(n.Bytes(), binary.Word256Bytes)
+}
+
+// Values are equal as integers should both be integers, as bytes should either be bytes, and as strings otherwise
+func equal(a, b value) (bool, error) {
+	_, aBytes := a.([]byte)
+	_, bBytes := b.([]byte)
+	switch {
+	case aBytes || bBytes:
+		x, err := toBytes(a)
+		if err != nil {
+			return false, err
+		}
+		y, err := toBytes(b)
+		if err != nil {
+			return false, err
+		}
+		return bytes.Equal(x, y), nil
+	case isInt(a) && isInt(b):
+		x, _ := toInt(a)
+		y, _ := toInt(b)
+		return x.Cmp(y) == 0, nil
+	}
+	return toString(a) == toString(b), nil
+}
+
+// Orders values as integers should both be integers and as strings otherwise
+func compare(a, b value) int {
+	if isInt(a) && isInt(b) {
+		x, _ := toInt(a)
+		y, _ := toInt(b)
+		return x.Cmp(y)
+	}
+	return strings.Compare(toString(a), toString(b))
+}
@@ -0,0 +1,157 @@
+package pkgs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// How long to wait for files to stop changing before running the playbooks again, since editors often write a file
+// in several steps
+const watchSettle = 300 * time.Millisecond
+
+// WatchPlaybooks runs playbooks one after the other, then runs them again each time a contract source or one of the
+// playbooks under args.Path changes. When only sources have changed the contracts whose compiled code changed are
+// deployed again along with the jobs that depend on them, while the other transactions keep their results from the
+// run before (see jobs.ExecutePlaybookAgain). It returns only should it be unable to watch the files.
+func WatchPlaybooks(args *def.DeployArgs, playbooks []string, logger *logging.Logger) error {
+	setPath(args)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("could not watch files: %v", err)
+	}
+	defer watcher.Close()
+	err = watchDirs(watcher, args.Path)
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]int, len(playbooks))
+	for i, playbook := range playbooks {
+		file, err := filepath.Abs(filepath.Join(args.Path, playbook))
+		if err != nil {
+			return err
+		}
+		files[file] = i
+	}
+
+	client := def.NewClient(args.Chain, args.KeysService, args.MempoolSign, time.Duration(args.Timeout)*time.Second)
+	logger.InfoMsg("Using chain", "Chain", args.Chain, "Signer", args.KeysService)
+
+	previous := make([]*def.Playbook, len(playbooks))
+	run := func() {
+		results := make([]*playbookResult, len(playbooks))
+		for i, playbook := range playbooks {
+			startTime := time.Now()
+			script, err := executePlaybook(new(sync.RWMutex), playbook, previous[i], args, client, logger)
+			res := &playbookResult{jobNo: i, err: err, duration: time.Since(startTime)}
+			if script != nil {
+				// Keep what ran even should a job fail so that it need not run again
+				previous[i] = script
+				res.gas = script.GasReport
+				res.manifest = script.Manifest
+			}
+			if err != nil {
+				logger.InfoMsg("Playbook failed", "file", playbook, "error", err, "time", res.duration.String())
+			} else {
+				logger.InfoMsg("Playbook result", "file", playbook, "time", res.duration.String())
+			}
+			if args.GasReport != "" && res.gas != nil {
+				res.gas.WriteTable(os.Stdout)
+			}
+			results[i] = res
+		}
+		if args.GasReport != "" {
+			err := writeGasReport(args.GasReport, results)
+			if err != nil {
+				logger.InfoMsg("Could not write gas report", "file", args.GasReport, "error", err)
+			}
+		}
+		if args.Manifest != "" {
+			err := writeManifest(args.Manifest, results)
+			if err != nil {
+				logger.InfoMsg("Could not write manifest", "file", args.Manifest, "error", err)
+			}
+		}
+		logger.InfoMsg("Watching for changes", "dir", args.Path)
+	}
+
+	run()
+	settle := time.NewTimer(watchSettle)
+	settle.Stop()
+	changed := false
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&fsnotify.Create != 0 {
+				// Watch new directories that may hold sources
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					err = watchDirs(watcher, event.Name)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			file, err := filepath.Abs(event.Name)
+			if err != nil {
+				continue
+			}
+			if i, ok := files[file]; ok {
+				// A playbook that has changed may have different jobs so is run again in full
+				previous[i] = nil
+			} else if !isContractSource(file) {
+				continue
+			}
+			logger.InfoMsg("File changed", "file", event.Name)
+			changed = true
+			settle.Reset(watchSettle)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.InfoMsg("Error watching files", "error", err)
+		case <-settle.C:
+			if changed {
+				changed = false
+				run()
+			}
+		}
+	}
+}
+
+// Watches dir and the directories below it, other than hidden ones and those of node modules
+func watchDirs(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return err
+		}
+		name := info.Name()
+		if path != dir && (strings.HasPrefix(name, ".") || name == "node_modules") {
+			return filepath.SkipDir
+		}
+		err = watcher.Add(path)
+		if err != nil {
+			return fmt.Errorf("could not watch %s: %v", path, err)
+		}
+		return nil
+	})
+}
+
+func isContractSource(file string) bool {
+	switch filepath.Ext(file) {
+	case ".sol", ".vy":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package dump
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// Canonical returns a Source of only the rows of the full dump source that hold state: accounts, their storage, and
+// names. Rows are dumped in key order, chunked by size, and numbered and checksummed the same way by every node, so
+// written in protobuf they make a dump that is byte-identical for the same state at the same height wherever it is
+// made. Events are left out since they are history rather than state, and nodes that were restored from a dump or
+// have archived or pruned blocks hold different events for the same state.
+func Canonical(source Source) Source {
+	return &canonicalSource{source: source}
+}
+
+type canonicalSource struct {
+	source Source
+}
+
+func (cs *canonicalSource) Recv() (*Dump, error) {
+	for {
+		row, err := cs.source.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if row.BaseHeight != 0 {
+			return nil, fmt.Errorf("incremental dumps cannot be made canonical")
+		}
+		// Events come after all state so leaving them out does not renumber the rows we keep
+		if row.EVMEvent == nil {
+			return row, nil
+		}
+	}
+}
+
+// Digest returns the height of the full dump source and the SHA-256 hash of its canonical dump, which is the same for
+// nodes holding the same state at that height however they came by it
+func Digest(source Source) (height uint64, digest []byte, err error) {
+	hasher := sha256.New()
+	err = Write(hasher, &heightSource{Source: Canonical(source), height: &height}, true, State)
+	if err != nil {
+		return 0, nil, err
+	}
+	return height, hasher.Sum(nil), nil
+}
+
+// Records the height of the rows received
+type heightSource struct {
+	Source
+	height *uint64
+}
+
+func (hs *heightSource) Recv() (*Dump, error) {
+	row, err := hs.Source.Recv()
+	if err == nil {
+		*hs.height = row.Height
+	}
+	return row, err
+}
@@ -0,0 +1,54 @@
+package dump
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigest(t *testing.T) {
+	st := testLoad(t, NewMockSource(50, 50, 100, 100))
+	dumper := NewDumper(st, NewMockchain("Mockchain", 0))
+	height, digest, err := Digest(dumper.Source(0, 0, All))
+	require.NoError(t, err)
+	require.Len(t, digest, 32)
+
+	// The canonical dump is what is digested and holds no events
+	buf := new(bytes.Buffer)
+	require.NoError(t, Write(buf, Canonical(dumper.Source(0, 0, All)), true, All))
+	sum := sha256.Sum256(buf.Bytes())
+	require.Equal(t, sum[:], digest)
+	source, err := NewProtobufReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	sink := new(CollectSink)
+	for row, err := source.Recv(); err == nil; row, err = source.Recv() {
+		require.Nil(t, row.EVMEvent)
+		require.NoError(t, sink.Send(row))
+	}
+
+	// State restored from it without any events has the same digest
+	restored, err := state.MakeGenesisState(testDB(t), &genesis.GenesisDoc{
+		GlobalPermissions: permission.DefaultAccountPermissions,
+	})
+	require.NoError(t, err)
+	require.NoError(t, Load(sink, restored))
+	restoredHeight, restoredDigest, err := Digest(NewDumper(restored, NewMockchain("Mockchain", 0)).Source(0, 0, All))
+	require.NoError(t, err)
+	require.Equal(t, height, restoredHeight)
+	require.Equal(t, digest, restoredDigest)
+
+	// Any difference in state changes it
+	other := testLoad(t, NewMockSource(50, 50, 101, 100))
+	_, otherDigest, err := Digest(NewDumper(other, NewMockchain("Mockchain", 0)).Source(0, 0, All))
+	require.NoError(t, err)
+	require.NotEqual(t, digest, otherDigest)
+
+	// Incremental dumps are not canonical
+	_, _, err = Digest(&CollectSink{Rows: []string{`{"BaseHeight": 1}`}})
+	require.Error(t, err)
+}
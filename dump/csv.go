@@ -0,0 +1,406 @@
+package dump
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+)
+
+// A CSV dump is a directory holding a CSV file for each kind of row so that it can be loaded into spreadsheets and
+// data warehouses. Each file holds the rows of its kind in the order they were dumped, and since each kind is restored
+// into its own trees, restoring the files one after another gives the same state as restoring the rows interleaved.
+const (
+	AccountsCSV = "accounts.csv"
+	StorageCSV  = "storage.csv"
+	NamesCSV    = "names.csv"
+	EventsCSV   = "events.csv"
+)
+
+// In the order they are restored
+var csvFiles = []string{AccountsCSV, StorageCSV, NamesCSV, EventsCSV}
+
+var csvHeaders = map[string][]string{
+	AccountsCSV: {"Height", "BaseHeight", "Address", "Removed", "Sequence", "Balance", "EVMCode", "WASMCode",
+		"NativeName", "PublicKey", "Permissions", "ContractMeta", "Forebear"},
+	StorageCSV: {"Height", "BaseHeight", "Address", "Key", "Value"},
+	NamesCSV:   {"Height", "BaseHeight", "Name", "Removed", "Owner", "Data", "Expires"},
+	EventsCSV:  {"Height", "BaseHeight", "ChainID", "Index", "Time", "Address", "Topics", "Data"},
+}
+
+var csvParsers = map[string]func(*csvRecord) *Dump{
+	AccountsCSV: parseAccount,
+	StorageCSV:  parseStorage,
+	NamesCSV:    parseName,
+	EventsCSV:   parseEvent,
+}
+
+// WriteCSV writes a dump by pulling rows from source into a CSV file for each kind of row in dir
+func WriteCSV(dir string, source Source) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	files := make(map[string]*os.File, len(csvFiles))
+	writers := make(map[string]*csv.Writer, len(csvFiles))
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+	for _, name := range csvFiles {
+		f, err := os.OpenFile(filepath.Join(dir, name), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		files[name] = f
+		writers[name] = csv.NewWriter(f)
+		err = writers[name].Write(csvHeaders[name])
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		row, err := source.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to recv dump: %v", err)
+		}
+		err = row.VerifyChecksum()
+		if err != nil {
+			return err
+		}
+		err = writeCSVRow(writers, row)
+		if err != nil {
+			return fmt.Errorf("failed to write dump row %d: %v", row.Index, err)
+		}
+	}
+
+	for _, name := range csvFiles {
+		writers[name].Flush()
+		err = writers[name].Error()
+		if err != nil {
+			return err
+		}
+		err = files[name].Close()
+		delete(files, name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCSVRow(writers map[string]*csv.Writer, row *Dump) error {
+	height := strconv.FormatUint(row.Height, 10)
+	baseHeight := strconv.FormatUint(row.BaseHeight, 10)
+	if row.Account != nil {
+		record, err := accountRecord(row.Account)
+		if err != nil {
+			return err
+		}
+		err = writers[AccountsCSV].Write(append([]string{height, baseHeight}, record...))
+		if err != nil {
+			return err
+		}
+	}
+	if row.RemovedAccount != nil {
+		record := make([]string, len(csvHeaders[AccountsCSV]))
+		record[0], record[1], record[2], record[3] = height, baseHeight, row.RemovedAccount.String(), "true"
+		err := writers[AccountsCSV].Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	if row.AccountStorage != nil {
+		// One storage key per line
+		for _, st := range row.AccountStorage.Storage {
+			err := writers[StorageCSV].Write([]string{height, baseHeight, row.AccountStorage.Address.String(),
+				st.Key.String(), st.Value.String()})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if row.Name != nil {
+		err := writers[NamesCSV].Write([]string{height, baseHeight, row.Name.Name, "false", row.Name.Owner.String(),
+			row.Name.Data, strconv.FormatUint(row.Name.Expires, 10)})
+		if err != nil {
+			return err
+		}
+	}
+	if row.RemovedName != "" {
+		record := make([]string, len(csvHeaders[NamesCSV]))
+		record[0], record[1], record[2], record[3] = height, baseHeight, row.RemovedName, "true"
+		err := writers[NamesCSV].Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	if row.EVMEvent != nil && row.EVMEvent.Event != nil {
+		ev := row.EVMEvent
+		topics := make([]string, len(ev.Event.Topics))
+		for i, topic := range ev.Event.Topics {
+			topics[i] = topic.String()
+		}
+		err := writers[EventsCSV].Write([]string{height, baseHeight, ev.ChainID, strconv.FormatUint(ev.Index, 10),
+			ev.Time.Format(time.RFC3339Nano), ev.Event.Address.String(), strings.Join(topics, " "),
+			ev.Event.Data.String()})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The columns of an account after its heights, with the structured ones as JSON
+func accountRecord(acc *acm.Account) ([]string, error) {
+	var publicKey, contractMeta, forebear string
+	if acc.PublicKey != nil {
+		bs, err := json.Marshal(acc.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		publicKey = string(bs)
+	}
+	permissions, err := json.Marshal(acc.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if len(acc.ContractMeta) > 0 {
+		bs, err := json.Marshal(acc.ContractMeta)
+		if err != nil {
+			return nil, err
+		}
+		contractMeta = string(bs)
+	}
+	if acc.Forebear != nil {
+		forebear = acc.Forebear.String()
+	}
+	return []string{acc.Address.String(), "false", strconv.FormatUint(acc.Sequence, 10),
+		strconv.FormatUint(acc.Balance, 10), acc.EVMCode.String(), acc.WASMCode.String(), acc.NativeName, publicKey,
+		string(permissions), contractMeta, forebear}, nil
+}
+
+// CSVReader is a Source of the rows of a CSV dump
+type CSVReader struct {
+	dir string
+	// Index of the next file to read
+	next   int
+	name   string
+	file   *os.File
+	reader *csv.Reader
+}
+
+var _ Source = &CSVReader{}
+
+// NewCSVReader returns a Source of the rows of the CSV dump in dir. Files for kinds of row that were not dumped may be
+// missing.
+func NewCSVReader(dir string) (*CSVReader, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("CSV dump %s is not a directory", dir)
+	}
+	return &CSVReader{dir: dir}, nil
+}
+
+func (cr *CSVReader) Recv() (*Dump, error) {
+	for {
+		if cr.reader == nil {
+			if cr.next == len(csvFiles) {
+				return nil, io.EOF
+			}
+			err := cr.open(csvFiles[cr.next])
+			cr.next++
+			if os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		fields, err := cr.reader.Read()
+		if err == io.EOF {
+			err = cr.Close()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", cr.name, err)
+		}
+		record := &csvRecord{fields: fields}
+		row := csvParsers[cr.name](record)
+		if record.err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", cr.name, record.err)
+		}
+		return row, nil
+	}
+}
+
+func (cr *CSVReader) open(name string) error {
+	f, err := os.Open(filepath.Join(cr.dir, name))
+	if err != nil {
+		return err
+	}
+	reader := csv.NewReader(f)
+	header, err := reader.Read()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("could not read header of %s: %w", name, err)
+	}
+	if strings.Join(header, ",") != strings.Join(csvHeaders[name], ",") {
+		f.Close()
+		return fmt.Errorf("%s has header %v but should have %v", name, header, csvHeaders[name])
+	}
+	cr.name, cr.file, cr.reader = name, f, reader
+	return nil
+}
+
+// Close closes the file being read
+func (cr *CSVReader) Close() error {
+	if cr.file == nil {
+		return nil
+	}
+	err := cr.file.Close()
+	cr.file, cr.reader = nil, nil
+	return err
+}
+
+// Parses the fields of a record, keeping the first error
+type csvRecord struct {
+	fields []string
+	err    error
+}
+
+func (r *csvRecord) uint64(i int) uint64 {
+	if r.err != nil {
+		return 0
+	}
+	var value uint64
+	value, r.err = strconv.ParseUint(r.fields[i], 10, 64)
+	return value
+}
+
+func (r *csvRecord) bool(i int) bool {
+	if r.err != nil {
+		return false
+	}
+	var value bool
+	value, r.err = strconv.ParseBool(r.fields[i])
+	return value
+}
+
+// Empty fields leave the value unset
+func (r *csvRecord) text(i int, value interface{ UnmarshalText([]byte) error }) {
+	if r.err != nil || r.fields[i] == "" {
+		return
+	}
+	r.err = value.UnmarshalText([]byte(r.fields[i]))
+}
+
+func (r *csvRecord) json(i int, value interface{}) {
+	if r.err != nil || r.fields[i] == "" {
+		return
+	}
+	r.err = json.Unmarshal([]byte(r.fields[i]), value)
+}
+
+func (r *csvRecord) heights() *Dump {
+	return &Dump{Height: r.uint64(0), BaseHeight: r.uint64(1)}
+}
+
+func parseAccount(r *csvRecord) *Dump {
+	row := r.heights()
+	address := new(crypto.Address)
+	r.text(2, address)
+	if r.bool(3) {
+		row.RemovedAccount = address
+		return row
+	}
+	acc := &acm.Account{
+		Address:    *address,
+		Sequence:   r.uint64(4),
+		Balance:    r.uint64(5),
+		NativeName: r.fields[8],
+	}
+	r.text(6, &acc.EVMCode)
+	r.text(7, &acc.WASMCode)
+	r.json(9, &acc.PublicKey)
+	r.json(10, &acc.Permissions)
+	r.json(11, &acc.ContractMeta)
+	if r.fields[12] != "" {
+		acc.Forebear = new(crypto.Address)
+		r.text(12, acc.Forebear)
+	}
+	row.Account = acc
+	return row
+}
+
+func parseStorage(r *csvRecord) *Dump {
+	row := r.heights()
+	st := new(Storage)
+	row.AccountStorage = &AccountStorage{Storage: []*Storage{st}}
+	r.text(2, &row.AccountStorage.Address)
+	r.text(3, &st.Key)
+	// Deleted storage in incremental dumps has an empty value
+	st.Value = binary.HexBytes{}
+	r.text(4, &st.Value)
+	return row
+}
+
+func parseName(r *csvRecord) *Dump {
+	row := r.heights()
+	if r.bool(3) {
+		row.RemovedName = r.fields[2]
+		return row
+	}
+	row.Name = &names.Entry{
+		Name:    r.fields[2],
+		Data:    r.fields[5],
+		Expires: r.uint64(6),
+	}
+	r.text(4, &row.Name.Owner)
+	return row
+}
+
+func parseEvent(r *csvRecord) *Dump {
+	row := r.heights()
+	ev := &EVMEvent{
+		ChainID: r.fields[2],
+		Index:   r.uint64(3),
+		Event:   new(exec.LogEvent),
+	}
+	if r.err == nil {
+		ev.Time, r.err = time.Parse(time.RFC3339Nano, r.fields[4])
+	}
+	r.text(5, &ev.Event.Address)
+	for _, topic := range strings.Fields(r.fields[6]) {
+		var word binary.Word256
+		if r.err == nil {
+			r.err = word.UnmarshalText([]byte(topic))
+		}
+		ev.Event.Topics = append(ev.Event.Topics, word)
+	}
+	ev.Event.Data = binary.HexBytes{}
+	r.text(7, &ev.Event.Data)
+	row.EVMEvent = ev
+	return row
+}
@@ -0,0 +1,47 @@
+package dump
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCSV(t *testing.T) {
+	mockSource := NewMockSource(50, 50, 20, 20)
+	st := testLoad(t, mockSource)
+	dumper := NewDumper(st, mockSource)
+	dir, err := ioutil.TempDir("", "TestWriteCSV")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	csvDir := path.Join(dir, "dump")
+	require.NoError(t, WriteCSV(csvDir, dumper.Source(0, 0, All)))
+	for _, name := range csvFiles {
+		require.FileExists(t, path.Join(csvDir, name))
+	}
+	jsonDump := new(bytes.Buffer)
+	require.NoError(t, Write(jsonDump, dumper.Source(0, 0, All), false, All))
+
+	// Restoring the CSV dump gives the same state as restoring the dump a row at a time
+	fromCSV, err := state.MakeGenesisState(testDB(t),
+		&genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions})
+	require.NoError(t, err)
+	reader, err := NewFileReader(csvDir)
+	require.NoError(t, err)
+	require.NoError(t, Load(reader, fromCSV))
+
+	fromJSON, err := state.MakeGenesisState(testDB(t),
+		&genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions})
+	require.NoError(t, err)
+	loadDumpFromJSONString(t, fromJSON, jsonDump.String())
+
+	require.Equal(t, fromJSON.Hash(), fromCSV.Hash())
+	require.Equal(t, stateRows(t, st, 0), stateRows(t, fromCSV, 0))
+}
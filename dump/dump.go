@@ -3,12 +3,15 @@ package dump
 import (
 	"encoding/json"
 	"fmt"
+	"hash/crc32"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
@@ -27,6 +30,8 @@ const (
 // Chunk account storage into rows that are less than 1 MiB
 const thresholdAccountStorageBytesPerRow = 1 << 20
 
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
 type Sink interface {
 	Send(*Dump) error
 }
@@ -56,26 +61,94 @@ type Option uint64
 const (
 	None Option = 0
 	All         = Accounts | Names | Events
+	// The classes of data that make up state rather than its history
+	State = Accounts | Names
 )
 
 func (options Option) Enabled(option Option) bool {
 	return options&option > 0
 }
 
+// Format is an encoding dumps can be written in
+type Format string
+
+const (
+	// Length-prefixed protobuf rows, the most compact
+	ProtobufFormat Format = "protobuf"
+	// A JSON row per line
+	JSONFormat Format = "json"
+	// A directory with a CSV file for each kind of row
+	CSVFormat Format = "csv"
+	// Protobuf rows of state only, which are byte-identical for the same state at the same height (see Canonical)
+	CanonicalFormat Format = "canonical"
+)
+
+func ParseFormat(format string) (Format, error) {
+	switch f := Format(strings.ToLower(format)); f {
+	case ProtobufFormat, JSONFormat, CSVFormat, CanonicalFormat:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown dump format '%s', expected one of %s, %s, %s, or %s", format, ProtobufFormat,
+		JSONFormat, CSVFormat, CanonicalFormat)
+}
+
 // Transmit Dump rows to the provided Sink over the inclusive range of heights provided, if endHeight is 0 the latest
 // height is used.
-
 func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Option) error {
-	lastHeight := ds.blockchain.LastBlockHeight()
-	if endHeight == 0 || endHeight > lastHeight {
-		endHeight = lastHeight
+	return ds.TransmitFrom(sink, startHeight, endHeight, 0, options)
+}
+
+// TransmitFrom transmits Dump rows like Transmit but only from the row at startIndex. Rows are always produced in the
+// same order for the same heights so this resumes a transfer that was interrupted before startIndex was sent.
+func (ds *Dumper) TransmitFrom(sink Sink, startHeight, endHeight, startIndex uint64, options Option) error {
+	endHeight = ds.endHeight(endHeight)
+	st, err := ds.state.AtHeight(endHeight)
+	if err != nil {
+		return err
+	}
+	return ds.transmit(&indexSink{sink: sink, startIndex: startIndex}, st, nil, startHeight, endHeight, options)
+}
+
+// TransmitIncremental transmits Dump rows of only the accounts, storage, and names written or removed since
+// baseHeight, and the events since, from the row at startIndex. Loaded after a dump up to baseHeight they restore the
+// state at endHeight.
+func (ds *Dumper) TransmitIncremental(sink Sink, baseHeight, endHeight, startIndex uint64, options Option) error {
+	endHeight = ds.endHeight(endHeight)
+	if baseHeight == 0 || baseHeight >= endHeight {
+		return fmt.Errorf("base height of incremental dump must be above 0 and below the end height %d but is %d",
+			endHeight, baseHeight)
+	}
+	base, err := ds.state.AtHeight(baseHeight)
+	if err != nil {
+		return fmt.Errorf("could not get state at base height %d, it may have been pruned: %w", baseHeight, err)
 	}
 	st, err := ds.state.AtHeight(endHeight)
 	if err != nil {
 		return err
 	}
+	sink = &indexSink{sink: sink, startIndex: startIndex, baseHeight: baseHeight}
+	return ds.transmit(sink, st, base, baseHeight+1, endHeight, options)
+}
 
-	if options.Enabled(Accounts) {
+func (ds *Dumper) endHeight(endHeight uint64) uint64 {
+	lastHeight := ds.blockchain.LastBlockHeight()
+	if endHeight == 0 || endHeight > lastHeight {
+		return lastHeight
+	}
+	return endHeight
+}
+
+// Transmits the state st, or if base is not nil only its changes since base, and the events from startHeight
+func (ds *Dumper) transmit(sink Sink, st, base *state.ImmutableState, startHeight, endHeight uint64,
+	options Option) error {
+	var err error
+	if options.Enabled(Accounts) && base != nil {
+		ds.logger.InfoMsg("Dumping account changes")
+		err = ds.transmitAccountChanges(sink, st, base, endHeight)
+		if err != nil {
+			return err
+		}
+	} else if options.Enabled(Accounts) {
 		ds.logger.InfoMsg("Dumping accounts")
 		err = st.IterateAccounts(func(acc *acm.Account) error {
 			// Since we tend to want to handle accounts and their storage as a single unit we multiplex account
@@ -90,15 +163,9 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 				},
 			}
 
-			for _, m := range acc.ContractMeta {
-				var metahash acmstate.MetadataHash
-				copy(metahash[:], m.MetadataHash.Bytes())
-				meta, err := ds.state.GetMetadata(metahash)
-				if err != nil {
-					return err
-				}
-				m.Metadata = meta
-				m.MetadataHash = []byte{}
+			err := ds.inlineMetadata(acc)
+			if err != nil {
+				return err
 			}
 
 			var storageBytes int
@@ -149,7 +216,19 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 		}
 	}
 
-	if options.Enabled(Names) {
+	if options.Enabled(Names) && base != nil {
+		ds.logger.InfoMsg("Dumping name changes")
+		err = st.IterateNameChanges(base,
+			func(entry *names.Entry) error {
+				return sink.Send(&Dump{Height: endHeight, Name: entry})
+			},
+			func(name string) error {
+				return sink.Send(&Dump{Height: endHeight, RemovedName: name})
+			})
+		if err != nil {
+			return err
+		}
+	} else if options.Enabled(Names) {
 		ds.logger.InfoMsg("Dumping names")
 		err = st.IterateNames(func(entry *names.Entry) error {
 			return sink.Send(&Dump{Height: endHeight, Name: entry})
@@ -205,6 +284,135 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 	return nil
 }
 
+// Sends the accounts written and removed since base followed by the storage written since, with deleted keys having
+// an empty value, which when loaded removes them
+func (ds *Dumper) transmitAccountChanges(sink Sink, st, base *state.ImmutableState, endHeight uint64) error {
+	err := st.IterateAccountChanges(base,
+		func(acc *acm.Account) error {
+			err := ds.inlineMetadata(acc)
+			if err != nil {
+				return err
+			}
+			return sink.Send(&Dump{Height: endHeight, Account: acc})
+		},
+		func(address crypto.Address) error {
+			return sink.Send(&Dump{Height: endHeight, RemovedAccount: &address})
+		})
+	if err != nil {
+		return err
+	}
+
+	var row *Dump
+	var storageBytes int
+	err = st.IterateStorageChanges(base, func(address crypto.Address, key binary.Word256, value []byte) error {
+		// Chunk storage by account and size as for full dumps
+		if row != nil && (row.AccountStorage.Address != address || storageBytes > thresholdAccountStorageBytesPerRow) {
+			err := sink.Send(row)
+			if err != nil {
+				return err
+			}
+			row = nil
+		}
+		if row == nil {
+			row = &Dump{
+				Height: endHeight,
+				AccountStorage: &AccountStorage{
+					Address: address,
+					Storage: make([]*Storage, 0),
+				},
+			}
+			storageBytes = 0
+		}
+		if value == nil {
+			value = []byte{}
+		}
+		row.AccountStorage.Storage = append(row.AccountStorage.Storage, &Storage{Key: key, Value: value})
+		storageBytes += len(key) + len(value)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if row != nil {
+		return sink.Send(row)
+	}
+	return nil
+}
+
+// Replaces the hashes of the account's contract metadata with the metadata itself
+func (ds *Dumper) inlineMetadata(acc *acm.Account) error {
+	for _, m := range acc.ContractMeta {
+		var metahash acmstate.MetadataHash
+		copy(metahash[:], m.MetadataHash.Bytes())
+		meta, err := ds.state.GetMetadata(metahash)
+		if err != nil {
+			return err
+		}
+		m.Metadata = meta
+		m.MetadataHash = []byte{}
+	}
+	return nil
+}
+
+// Numbers and checksums rows, sending on those from startIndex
+type indexSink struct {
+	sink       Sink
+	index      uint64
+	startIndex uint64
+	baseHeight uint64
+}
+
+func (is *indexSink) Send(row *Dump) error {
+	row.Index = is.index
+	row.BaseHeight = is.baseHeight
+	is.index++
+	if row.Index < is.startIndex {
+		return nil
+	}
+	err := row.SetChecksum()
+	if err != nil {
+		return err
+	}
+	return is.sink.Send(row)
+}
+
+// SetChecksum sets the Checksum of the row to that of its contents
+func (row *Dump) SetChecksum() error {
+	checksum, err := row.checksum()
+	if err != nil {
+		return err
+	}
+	row.Checksum = checksum
+	return nil
+}
+
+// VerifyChecksum returns an error if the row has a Checksum that does not match its contents. Dumps from before rows
+// were checksummed have none so are not checked.
+func (row *Dump) VerifyChecksum() error {
+	if row.Checksum == 0 {
+		return nil
+	}
+	checksum, err := row.checksum()
+	if err != nil {
+		return err
+	}
+	if checksum != row.Checksum {
+		return fmt.Errorf("dump row %d has checksum %08x but its contents have checksum %08x", row.Index,
+			row.Checksum, checksum)
+	}
+	return nil
+}
+
+func (row *Dump) checksum() (uint32, error) {
+	unsummed := *row
+	unsummed.Checksum = 0
+	bs, err := encoding.Encode(&unsummed)
+	if err != nil {
+		return 0, err
+	}
+	return crc32.Checksum(bs, checksumTable), nil
+}
+
 // Return a Source that is a Pipe fed from this Dumper's Transmit function
 func (ds *Dumper) Source(startHeight, endHeight uint64, options Option) Source {
 	p := make(Pipe)
@@ -218,6 +426,19 @@ func (ds *Dumper) Source(startHeight, endHeight uint64, options Option) Source {
 	return p
 }
 
+// Return a Source that is a Pipe fed from this Dumper's TransmitIncremental function
+func (ds *Dumper) IncrementalSource(baseHeight, endHeight uint64, options Option) Source {
+	p := make(Pipe)
+	go func() {
+		err := ds.TransmitIncremental(p, baseHeight, endHeight, 0, options)
+		if err != nil {
+			p <- msg{err: err}
+		}
+		close(p)
+	}()
+	return p
+}
+
 func (ds *Dumper) WithLogger(logger *logging.Logger) *Dumper {
 	ds.logger = logger
 	return ds
@@ -234,13 +455,17 @@ func Write(out io.Writer, source Source, useBinaryEncoding bool, options Option)
 
 			return fmt.Errorf("failed to recv dump: %v", err)
 		}
+		err = resp.VerifyChecksum()
+		if err != nil {
+			return err
+		}
 
 		if useBinaryEncoding {
 			_, err := encoding.WriteMessage(out, resp)
 			if err != nil {
 				return fmt.Errorf("failed write to binary dump message: %v", err)
 			}
-			return nil
+			continue
 		}
 
 		bs, err := json.Marshal(resp)
@@ -200,14 +200,24 @@ func (*EVMEvent) XXX_MessageName() string {
 }
 
 type Dump struct {
-	Height               uint64          `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Account              *acm.Account    `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
-	AccountStorage       *AccountStorage `protobuf:"bytes,3,opt,name=AccountStorage,proto3" json:"AccountStorage,omitempty"`
-	EVMEvent             *EVMEvent       `protobuf:"bytes,4,opt,name=EVMEvent,proto3" json:"EVMEvent,omitempty"`
-	Name                 *names.Entry    `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Height         uint64          `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Account        *acm.Account    `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	AccountStorage *AccountStorage `protobuf:"bytes,3,opt,name=AccountStorage,proto3" json:"AccountStorage,omitempty"`
+	EVMEvent       *EVMEvent       `protobuf:"bytes,4,opt,name=EVMEvent,proto3" json:"EVMEvent,omitempty"`
+	Name           *names.Entry    `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The position of this row in the dump from zero, so that a transfer can be resumed from the row after it
+	Index uint64 `protobuf:"varint,6,opt,name=Index,proto3" json:"Index,omitempty"`
+	// CRC-32C of this row encoded with Checksum unset, to detect rows corrupted in transfer or storage
+	Checksum uint32 `protobuf:"varint,7,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
+	// Set in incremental dumps, which only hold what has changed since the dump at BaseHeight, to that height
+	BaseHeight uint64 `protobuf:"varint,8,opt,name=BaseHeight,proto3" json:"BaseHeight,omitempty"`
+	// An account removed since BaseHeight, along with its storage
+	RemovedAccount *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,9,opt,name=RemovedAccount,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"RemovedAccount,omitempty"`
+	// A name removed since BaseHeight
+	RemovedName          string   `protobuf:"bytes,10,opt,name=RemovedName,proto3" json:"RemovedName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Dump) Reset()         { *m = Dump{} }
@@ -274,9 +284,104 @@ func (m *Dump) GetName() *names.Entry {
 	return nil
 }
 
+func (m *Dump) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Dump) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+func (m *Dump) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Dump) GetRemovedName() string {
+	if m != nil {
+		return m.RemovedName
+	}
+	return ""
+}
+
 func (*Dump) XXX_MessageName() string {
 	return "dump.Dump"
 }
+
+// Identifies the remainder of a dump to resume its transfer from
+type ResumeToken struct {
+	// The height of the state dumped
+	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The index of the first row to send
+	Index uint64 `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	// The BaseHeight of an incremental dump
+	BaseHeight           uint64   `protobuf:"varint,3,opt,name=BaseHeight,proto3" json:"BaseHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeToken) Reset()         { *m = ResumeToken{} }
+func (m *ResumeToken) String() string { return proto.CompactTextString(m) }
+func (*ResumeToken) ProtoMessage()    {}
+func (*ResumeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{4}
+}
+func (m *ResumeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResumeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeToken.Merge(m, src)
+}
+func (m *ResumeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeToken proto.InternalMessageInfo
+
+func (m *ResumeToken) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ResumeToken) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ResumeToken) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (*ResumeToken) XXX_MessageName() string {
+	return "dump.ResumeToken"
+}
 func init() {
 	proto.RegisterType((*Storage)(nil), "dump.Storage")
 	golang_proto.RegisterType((*Storage)(nil), "dump.Storage")
@@ -286,44 +391,51 @@ func init() {
 	golang_proto.RegisterType((*EVMEvent)(nil), "dump.EVMEvent")
 	proto.RegisterType((*Dump)(nil), "dump.Dump")
 	golang_proto.RegisterType((*Dump)(nil), "dump.Dump")
+	proto.RegisterType((*ResumeToken)(nil), "dump.ResumeToken")
+	golang_proto.RegisterType((*ResumeToken)(nil), "dump.ResumeToken")
 }
 
 func init() { proto.RegisterFile("dump.proto", fileDescriptor_58418148159c29a6) }
 func init() { golang_proto.RegisterFile("dump.proto", fileDescriptor_58418148159c29a6) }
 
 var fileDescriptor_58418148159c29a6 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3d, 0x6f, 0x13, 0x41,
-	0x10, 0x65, 0xe3, 0x73, 0x9c, 0xac, 0x43, 0x8a, 0x95, 0x85, 0x4e, 0x2e, 0xce, 0x96, 0x85, 0xc0,
-	0x42, 0xb0, 0x27, 0x19, 0x82, 0x28, 0xd2, 0xc4, 0xc4, 0x28, 0x51, 0x20, 0xc5, 0x12, 0x05, 0x89,
-	0xee, 0x3e, 0x86, 0xf3, 0x49, 0xbe, 0xdb, 0xd3, 0xde, 0x1e, 0xf8, 0x7e, 0x02, 0x1d, 0x35, 0x05,
-	0xbf, 0x85, 0xd2, 0x25, 0xa2, 0x42, 0x14, 0x01, 0x39, 0x7f, 0x04, 0xdd, 0x7e, 0x10, 0x91, 0x02,
-	0x41, 0x37, 0x33, 0x4f, 0xf3, 0xe6, 0xed, 0x7b, 0x8b, 0x71, 0x5c, 0x65, 0x05, 0x2d, 0x04, 0x97,
-	0x9c, 0x38, 0x4d, 0xdd, 0xef, 0x25, 0x3c, 0xe1, 0x6a, 0xe0, 0x37, 0x95, 0xc6, 0xfa, 0x83, 0x84,
-	0xf3, 0x64, 0x01, 0xbe, 0xea, 0xc2, 0xea, 0x8d, 0x2f, 0xd3, 0x0c, 0x4a, 0x19, 0xd8, 0xe5, 0xfe,
-	0x76, 0x10, 0x65, 0xa6, 0xc4, 0xb0, 0x84, 0xc8, 0xd4, 0xdd, 0x3c, 0xc8, 0xa0, 0xd4, 0xcd, 0xe8,
-	0x13, 0xc2, 0x9d, 0x97, 0x92, 0x8b, 0x20, 0x01, 0xf2, 0x0c, 0xb7, 0x4e, 0xa0, 0x76, 0xd1, 0x10,
-	0x8d, 0x77, 0xa6, 0x8f, 0x56, 0x17, 0x83, 0x1b, 0xdf, 0x2f, 0x06, 0xf7, 0x93, 0x54, 0xce, 0xab,
-	0x90, 0x46, 0x3c, 0xf3, 0xe7, 0x75, 0x01, 0x62, 0x01, 0x71, 0x02, 0xc2, 0x0f, 0x2b, 0x21, 0xf8,
-	0x3b, 0x3f, 0x4c, 0xf3, 0x40, 0xd4, 0xf4, 0x15, 0x17, 0xf1, 0x64, 0xef, 0x31, 0x6b, 0x08, 0xc8,
-	0x09, 0x6e, 0x9f, 0x07, 0x8b, 0x0a, 0xdc, 0x0d, 0xc5, 0xb4, 0x67, 0x98, 0x1e, 0xfc, 0x13, 0xd3,
-	0x11, 0x2c, 0xa7, 0xb5, 0x84, 0x92, 0x69, 0x8e, 0xd1, 0x7b, 0x84, 0x77, 0x0f, 0xa2, 0x88, 0x57,
-	0xb9, 0xb4, 0x3a, 0x4f, 0x71, 0xe7, 0x20, 0x8e, 0x05, 0x94, 0xe5, 0xff, 0x69, 0x8d, 0x44, 0x5d,
-	0x48, 0x4e, 0xcd, 0x2e, 0xb3, 0x24, 0xe4, 0xee, 0x6f, 0x0b, 0xdc, 0x8d, 0x61, 0x6b, 0xdc, 0x9d,
-	0xdc, 0xa4, 0x2a, 0x02, 0x33, 0x64, 0x16, 0x1d, 0x7d, 0x44, 0x78, 0x6b, 0x76, 0xfe, 0x62, 0xf6,
-	0x16, 0x72, 0x49, 0x5c, 0xdc, 0x79, 0x3a, 0x0f, 0xd2, 0xfc, 0xf8, 0x50, 0xa9, 0xd8, 0x66, 0xb6,
-	0x25, 0x3d, 0xdc, 0x3e, 0xce, 0x63, 0x58, 0xba, 0xce, 0x10, 0x8d, 0x1d, 0xa6, 0x1b, 0xf2, 0x04,
-	0x3b, 0x67, 0x69, 0xa6, 0x4d, 0xe9, 0x4e, 0xfa, 0x54, 0xa7, 0x47, 0x6d, 0x7a, 0xf4, 0xcc, 0xa6,
-	0x37, 0xdd, 0x6a, 0x9e, 0xf3, 0xe1, 0xc7, 0x00, 0x31, 0xb5, 0x41, 0x6e, 0xe3, 0xb6, 0x3a, 0xe9,
-	0xb6, 0xd4, 0xea, 0x2e, 0x55, 0x61, 0x3e, 0xe7, 0x89, 0x9a, 0x32, 0x0d, 0x8e, 0xbe, 0x22, 0xec,
-	0x1c, 0x56, 0x59, 0x41, 0x6e, 0xe1, 0xcd, 0x23, 0x48, 0x93, 0xb9, 0x54, 0xba, 0x1c, 0x66, 0x3a,
-	0x72, 0x07, 0x77, 0x8c, 0x91, 0x46, 0xc3, 0x0e, 0x6d, 0x3e, 0x88, 0x99, 0x31, 0x0b, 0x92, 0xfd,
-	0xeb, 0x86, 0x9b, 0xbb, 0x3d, 0xed, 0xca, 0x9f, 0x18, 0xbb, 0x1e, 0xce, 0xbd, 0x2b, 0x8b, 0xd4,
-	0xfb, 0x1b, 0xbd, 0x6a, 0xcf, 0x4e, 0xd9, 0x95, 0x85, 0x43, 0xec, 0x9c, 0x06, 0x19, 0xb8, 0x6d,
-	0x23, 0x47, 0x7f, 0xcc, 0x59, 0x2e, 0x45, 0xcd, 0x14, 0x32, 0xdd, 0x5f, 0xad, 0x3d, 0xf4, 0x65,
-	0xed, 0xa1, 0x6f, 0x6b, 0x0f, 0xfd, 0x5c, 0x7b, 0xe8, 0xf3, 0xa5, 0x87, 0x56, 0x97, 0x1e, 0x7a,
-	0x3d, 0xfa, 0x7b, 0xd6, 0xcd, 0xc9, 0x70, 0x53, 0x99, 0xfb, 0xf0, 0x57, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x6b, 0xbe, 0xfd, 0xc8, 0x52, 0x03, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xe7, 0x1a, 0xa7, 0x49, 0x5f, 0xda, 0x0e, 0xa7, 0x0a, 0x59, 0x19, 0x1c, 0xcb, 0x42, 0x10,
+	0x21, 0xb0, 0x51, 0xa0, 0x88, 0xa1, 0x4b, 0xd3, 0x06, 0xb5, 0x2a, 0x74, 0x38, 0xa2, 0x82, 0x60,
+	0x72, 0xec, 0x87, 0x63, 0x35, 0xf6, 0x45, 0xfe, 0x53, 0xe2, 0x8f, 0xc0, 0xc6, 0xcc, 0x80, 0xf8,
+	0x28, 0x8c, 0x19, 0x19, 0x11, 0x43, 0x41, 0xe9, 0x17, 0x41, 0x3e, 0x9f, 0x93, 0x34, 0x12, 0x88,
+	0x6e, 0xf7, 0x7e, 0xcf, 0xef, 0xf9, 0xf7, 0xe7, 0x01, 0xb8, 0x69, 0x30, 0x36, 0xc7, 0x11, 0x4f,
+	0x38, 0x55, 0xf2, 0x77, 0x73, 0xc7, 0xe3, 0x1e, 0x17, 0x80, 0x95, 0xbf, 0x8a, 0x5e, 0xb3, 0xe5,
+	0x71, 0xee, 0x8d, 0xd0, 0x12, 0xd5, 0x20, 0x7d, 0x6f, 0x25, 0x7e, 0x80, 0x71, 0x62, 0x97, 0xc3,
+	0xcd, 0x0d, 0xdb, 0x09, 0xe4, 0x13, 0x70, 0x82, 0x8e, 0x7c, 0x37, 0x42, 0x3b, 0xc0, 0xb8, 0x28,
+	0x8c, 0x2f, 0x04, 0x6a, 0xaf, 0x12, 0x1e, 0xd9, 0x1e, 0xd2, 0xe7, 0x50, 0x39, 0xc1, 0x4c, 0x25,
+	0x3a, 0x69, 0x6f, 0x76, 0x9f, 0x4c, 0x2f, 0x5b, 0xb7, 0x7e, 0x5e, 0xb6, 0x1e, 0x78, 0x7e, 0x32,
+	0x4c, 0x07, 0xa6, 0xc3, 0x03, 0x6b, 0x98, 0x8d, 0x31, 0x1a, 0xa1, 0xeb, 0x61, 0x64, 0x0d, 0xd2,
+	0x28, 0xe2, 0x1f, 0xac, 0x81, 0x1f, 0xda, 0x51, 0x66, 0xbe, 0xe6, 0x91, 0xdb, 0xd9, 0x7d, 0xca,
+	0xf2, 0x05, 0xf4, 0x04, 0xaa, 0x67, 0xf6, 0x28, 0x45, 0x75, 0x4d, 0x6c, 0xda, 0x95, 0x9b, 0x1e,
+	0xfe, 0xd7, 0xa6, 0x23, 0x9c, 0x74, 0xb3, 0x04, 0x63, 0x56, 0xec, 0x30, 0x3e, 0x12, 0xd8, 0xde,
+	0x77, 0x1c, 0x9e, 0x86, 0x49, 0xc9, 0xf3, 0x14, 0x6a, 0xfb, 0xae, 0x1b, 0x61, 0x1c, 0xdf, 0x8c,
+	0xab, 0x13, 0x65, 0xe3, 0x84, 0x9b, 0x72, 0x96, 0x95, 0x4b, 0xe8, 0xbd, 0xb9, 0x05, 0xea, 0x9a,
+	0x5e, 0x69, 0x37, 0x3a, 0x5b, 0xa6, 0x88, 0x40, 0x82, 0xac, 0xec, 0x1a, 0x9f, 0x09, 0xd4, 0x7b,
+	0x67, 0x2f, 0x7b, 0x17, 0x18, 0x26, 0x54, 0x85, 0xda, 0xc1, 0xd0, 0xf6, 0xc3, 0xe3, 0x43, 0xc1,
+	0x62, 0x83, 0x95, 0x25, 0xdd, 0x81, 0xea, 0x71, 0xe8, 0xe2, 0x44, 0x55, 0x74, 0xd2, 0x56, 0x58,
+	0x51, 0xd0, 0x67, 0xa0, 0xf4, 0xfd, 0xa0, 0x30, 0xa5, 0xd1, 0x69, 0x9a, 0x45, 0x7a, 0x66, 0x99,
+	0x9e, 0xd9, 0x2f, 0xd3, 0xeb, 0xd6, 0x73, 0x39, 0x9f, 0x7e, 0xb5, 0x08, 0x13, 0x13, 0xf4, 0x0e,
+	0x54, 0xc5, 0x2f, 0xd5, 0x8a, 0x18, 0xdd, 0x36, 0x45, 0x98, 0x2f, 0xb8, 0x27, 0x50, 0x56, 0x34,
+	0x8d, 0xaf, 0x15, 0x50, 0x0e, 0xd3, 0x60, 0x4c, 0x6f, 0xc3, 0xfa, 0x11, 0xfa, 0xde, 0x30, 0x11,
+	0xbc, 0x14, 0x26, 0x2b, 0x7a, 0x17, 0x6a, 0xd2, 0x48, 0xc9, 0x61, 0xd3, 0xcc, 0x0f, 0x44, 0x62,
+	0xac, 0x6c, 0xd2, 0xbd, 0x55, 0xc3, 0xe5, 0x7f, 0x77, 0x0a, 0x57, 0xae, 0xf7, 0xd8, 0x6a, 0x38,
+	0xf7, 0x17, 0x16, 0xa9, 0x8a, 0xe4, 0x2b, 0xe6, 0x4a, 0x94, 0x2d, 0x2c, 0xd4, 0x41, 0x39, 0xb5,
+	0x03, 0x54, 0xab, 0x92, 0x4e, 0x71, 0x98, 0xbd, 0x30, 0x89, 0x32, 0x26, 0x3a, 0x0b, 0x2b, 0xd7,
+	0x97, 0xad, 0x6c, 0x42, 0xfd, 0x60, 0x88, 0xce, 0x79, 0x9c, 0x06, 0x6a, 0x4d, 0x27, 0xed, 0x2d,
+	0x36, 0xaf, 0xa9, 0x06, 0xd0, 0xb5, 0x63, 0x94, 0x0e, 0xd4, 0xc5, 0xd8, 0x12, 0x42, 0xdf, 0xc0,
+	0x36, 0xc3, 0x80, 0x5f, 0xa0, 0x5b, 0x9a, 0xb1, 0x21, 0x6e, 0xe8, 0xd1, 0x8d, 0xef, 0x67, 0x65,
+	0x0f, 0xd5, 0xa1, 0x21, 0x11, 0x21, 0x0a, 0xc4, 0x51, 0x2c, 0x43, 0xc6, 0xbb, 0xfc, 0x8b, 0x38,
+	0x0d, 0xb0, 0xcf, 0xcf, 0x31, 0xfc, 0x6b, 0x50, 0x73, 0xd1, 0x6b, 0xcb, 0xa2, 0xaf, 0x0b, 0xab,
+	0xac, 0x0a, 0xeb, 0xee, 0x4d, 0x67, 0x1a, 0xf9, 0x3e, 0xd3, 0xc8, 0x8f, 0x99, 0x46, 0x7e, 0xcf,
+	0x34, 0xf2, 0xed, 0x4a, 0x23, 0xd3, 0x2b, 0x8d, 0xbc, 0x35, 0xfe, 0x2d, 0x2b, 0x4f, 0x67, 0xb0,
+	0x2e, 0xee, 0xf0, 0xf1, 0x9f, 0x01, 0x00, 0x88, 0xf9, 0x4a, 0x38, 0x7d, 0x04, 0x00, 0x00,
 }
 
 func (m *Storage) Marshal() (dAtA []byte, err error) {
@@ -507,6 +619,40 @@ func (m *Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RemovedName) > 0 {
+		i -= len(m.RemovedName)
+		copy(dAtA[i:], m.RemovedName)
+		i = encodeVarintDump(dAtA, i, uint64(len(m.RemovedName)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RemovedAccount != nil {
+		{
+			size := m.RemovedAccount.Size()
+			i -= size
+			if _, err := m.RemovedAccount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDump(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Checksum != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Checksum))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Index != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Name != nil {
 		{
 			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
@@ -563,6 +709,48 @@ func (m *Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResumeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDump(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDump(v)
 	base := offset
@@ -660,6 +848,44 @@ func (m *Dump) Size() (n int) {
 		l = m.Name.Size()
 		n += 1 + l + sovDump(uint64(l))
 	}
+	if m.Index != 0 {
+		n += 1 + sovDump(uint64(m.Index))
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovDump(uint64(m.Checksum))
+	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovDump(uint64(m.BaseHeight))
+	}
+	if m.RemovedAccount != nil {
+		l = m.RemovedAccount.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	l = len(m.RemovedName)
+	if l > 0 {
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResumeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDump(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovDump(uint64(m.Index))
+	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovDump(uint64(m.BaseHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1270,6 +1496,238 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.RemovedAccount = &v
+			if err := m.RemovedAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDump(dAtA[iNdEx:])
@@ -0,0 +1,160 @@
+package dump
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/genesis"
+)
+
+// GenesisDoc exports the state at height (the latest if 0) as the GenesisDoc of a new chain that starts from it, so that
+// a long chain can be rolled over to a fresh one without losing any accounts, contracts, names, or validators. The new
+// chain takes its name, params, and global permissions from base, the GenesisDoc of this chain. Since heights start
+// again from zero, names expire and balances unlock as many blocks after the start of the new chain as they would
+// have after height, with any that have already done so left out, and a CancunHeight already passed becomes zero.
+// Proposals, pending unbondings, and the node registry are not carried over.
+func (ds *Dumper) GenesisDoc(base *genesis.GenesisDoc, height uint64) (*genesis.GenesisDoc, error) {
+	height = ds.endHeight(height)
+	st, err := ds.state.AtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	genesisDoc := &genesis.GenesisDoc{
+		ChainName:         base.ChainName,
+		ChainID:           base.ChainID,
+		Params:            base.Params,
+		Salt:              base.Salt,
+		GlobalPermissions: base.GlobalPermissions,
+	}
+	beginBlock, err := st.LastBeginBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	if beginBlock != nil && beginBlock.Header != nil {
+		genesisDoc.GenesisTime = beginBlock.Header.GetTime()
+	}
+	if cancun := genesisDoc.Params.CancunHeight; cancun != nil {
+		cancunHeight := rebaseHeight(*cancun, height)
+		genesisDoc.Params.CancunHeight = &cancunHeight
+	}
+	// Governance may have changed the gas schedule since genesis
+	update, err := st.GetGasSchedule(height)
+	if err != nil {
+		return nil, err
+	}
+	if update != nil {
+		genesisDoc.Params.GasSchedule = update.Schedule
+	}
+
+	err = st.IterateAccounts(func(acc *acm.Account) error {
+		if acc.Address == acm.GlobalPermissionsAddress {
+			genesisDoc.GlobalPermissions = acc.Permissions
+			return nil
+		}
+		// Natives are provided by the node rather than state
+		if acc.NativeName != "" {
+			return nil
+		}
+		err := ds.inlineMetadata(acc)
+		if err != nil {
+			return err
+		}
+		account := genesis.Account{
+			BasicAccount: genesis.BasicAccount{
+				Address:   acc.Address,
+				PublicKey: acc.PublicKey,
+				Amount:    acc.Balance,
+			},
+			Permissions:  acc.Permissions,
+			EVMCode:      acc.EVMCode,
+			WASMCode:     acc.WASMCode,
+			ContractMeta: acc.ContractMeta,
+			Sequence:     acc.Sequence,
+		}
+		for _, lock := range acc.Locks {
+			if lock.UnlockHeight > height {
+				account.Locks = append(account.Locks, acm.BalanceLock{
+					Amount:       lock.Amount,
+					UnlockHeight: rebaseHeight(lock.UnlockHeight, height),
+				})
+			}
+		}
+		err = st.IterateStorage(acc.Address, func(key binary.Word256, value []byte) error {
+			// As written by the EVM, whereas WASM contracts may store values of any length
+			if len(value) != binary.Word256Bytes {
+				return fmt.Errorf("storage of %v at %v is %d bytes rather than a word so cannot be put in a "+
+					"GenesisDoc", acc.Address, key, len(value))
+			}
+			account.Storage = append(account.Storage, genesis.StorageEntry{
+				Key:   key,
+				Value: binary.LeftPadWord256(value),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		genesisDoc.Accounts = append(genesisDoc.Accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = st.IterateNames(func(entry *names.Entry) error {
+		if entry.Expires <= height {
+			return nil
+		}
+		genesisDoc.Names = append(genesisDoc.Names, &names.Entry{
+			Name:    entry.Name,
+			Owner:   entry.Owner,
+			Data:    entry.Data,
+			Expires: rebaseHeight(entry.Expires, height),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = st.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		if power.Sign() == 0 {
+			return nil
+		}
+		if !power.IsUint64() {
+			return fmt.Errorf("power %v of validator %v is too large for a GenesisDoc", power, id.GetAddress())
+		}
+		genesisDoc.Validators = append(genesisDoc.Validators, genesis.Validator{
+			BasicAccount: genesis.BasicAccount{
+				Address:   id.GetAddress(),
+				PublicKey: id.GetPublicKey(),
+				Amount:    power.Uint64(),
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Name validators by their power so that the largest comes first
+	sort.SliceStable(genesisDoc.Validators, func(i, j int) bool {
+		return genesisDoc.Validators[i].Amount > genesisDoc.Validators[j].Amount
+	})
+	for i := range genesisDoc.Validators {
+		genesisDoc.Validators[i].Name = fmt.Sprintf("Validator_%d", i)
+	}
+	return genesisDoc, nil
+}
+
+// The height in a chain started from height of another that corresponds to h of that one, or zero if it has passed
+func rebaseHeight(h, height uint64) uint64 {
+	if h <= height {
+		return 0
+	}
+	return h - height
+}
@@ -0,0 +1,98 @@
+package dump
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestDumper_GenesisDoc(t *testing.T) {
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	holder := crypto.Address{1}
+	contract := crypto.Address{2}
+	cancunHeight := uint64(1)
+	genesisDoc := &genesis.GenesisDoc{
+		ChainName:         "rollover",
+		GlobalPermissions: permission.DefaultAccountPermissions,
+		Accounts: []genesis.Account{
+			{
+				BasicAccount: genesis.BasicAccount{Address: holder, Amount: 100},
+				Locks:        []acm.BalanceLock{{Amount: 10, UnlockHeight: 1}, {Amount: 20, UnlockHeight: 10}},
+			},
+			{
+				BasicAccount: genesis.BasicAccount{Address: contract},
+				EVMCode:      acm.Bytecode{0x60, 0x00},
+				Storage:      []genesis.StorageEntry{{Key: binary.Int64ToWord256(1), Value: binary.Int64ToWord256(42)}},
+				Sequence:     7,
+				ContractMeta: []*acm.ContractMeta{{CodeHash: []byte{1}, Metadata: `{"ContractName": "C"}`}},
+			},
+		},
+		Validators: []genesis.Validator{{
+			BasicAccount: genesis.BasicAccount{Address: val.GetAddress(), PublicKey: val.GetPublicKey(), Amount: 5},
+		}},
+		Names: []*names.Entry{
+			{Name: "expired", Owner: holder, Data: "gone", Expires: 1},
+			{Name: "kept", Owner: holder, Data: "here", Expires: 20},
+		},
+	}
+	genesisDoc.Params.CancunHeight = &cancunHeight
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	var version int64
+	for i := 0; i < 2; i++ {
+		_, version, err = st.Update(func(up state.Updatable) error { return nil })
+		require.NoError(t, err)
+	}
+	height := state.HeightAtVersion(version)
+	require.Equal(t, uint64(2), height)
+
+	exported, err := NewDumper(st, NewMockchain("rollover", height)).GenesisDoc(genesisDoc, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "rollover", exported.ChainName)
+	assert.Equal(t, uint64(0), *exported.Params.CancunHeight)
+	require.Len(t, exported.Validators, 1)
+	assert.Equal(t, val.GetPublicKey(), exported.Validators[0].PublicKey)
+	assert.Equal(t, uint64(5), exported.Validators[0].Amount)
+	assert.Equal(t, []*names.Entry{{Name: "kept", Owner: holder, Data: "here", Expires: 18}}, exported.Names)
+
+	accounts := make(map[crypto.Address]genesis.Account)
+	for _, account := range exported.Accounts {
+		accounts[account.Address] = account
+	}
+	assert.Equal(t, []acm.BalanceLock{{Amount: 20, UnlockHeight: 8}}, accounts[holder].Locks)
+	assert.Equal(t, genesisDoc.Accounts[1].Storage, accounts[contract].Storage)
+	assert.Equal(t, uint64(7), accounts[contract].Sequence)
+	assert.Equal(t, `{"ContractName": "C"}`, accounts[contract].ContractMeta[0].Metadata)
+
+	// A chain started from the export has the same accounts and contracts
+	rolled, err := state.MakeGenesisState(dbm.NewMemDB(), exported)
+	require.NoError(t, err)
+	require.NoError(t, rolled.InitialCommit())
+	for _, address := range []crypto.Address{holder, contract} {
+		expected, err := st.GetAccount(address)
+		require.NoError(t, err)
+		actual, err := rolled.GetAccount(address)
+		require.NoError(t, err)
+		assert.Equal(t, expected.EVMCode, actual.EVMCode)
+		assert.Equal(t, expected.CodeHash, actual.CodeHash)
+		assert.Equal(t, expected.ContractMeta, actual.ContractMeta)
+		assert.Equal(t, expected.Sequence, actual.Sequence)
+		assert.Equal(t, expected.Balance, actual.Balance)
+	}
+	value, err := rolled.GetStorage(contract, binary.Int64ToWord256(1))
+	require.NoError(t, err)
+	assert.Equal(t, binary.Int64ToWord256(42).Bytes(), value)
+	entry, err := rolled.GetName("kept")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(18), entry.Expires)
+}
@@ -0,0 +1,129 @@
+package dump
+
+import (
+	"bytes"
+	bin "encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransmitIncremental(t *testing.T) {
+	st := testLoad(t, NewMockSource(20, 20, 10, 10))
+	// The loaded state is at height 0, which stands for the latest height, so commit a block above it to be the base
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return nil
+	})
+	require.NoError(t, err)
+	baseHeight := state.HeightAtVersion(st.Version())
+
+	// The mock source numbers accounts from 1
+	var removed, updated crypto.Address
+	bin.BigEndian.PutUint64(removed[:], 1)
+	bin.BigEndian.PutUint64(updated[:], 2)
+	acc, err := st.GetAccount(updated)
+	require.NoError(t, err)
+	var deletedKey, addedKey binary.Word256
+	copy(deletedKey[:8], updated[:8])
+	addedKey = binary.LeftPadWord256([]byte{1, 2, 3})
+
+	_, version, err := st.Update(func(up state.Updatable) error {
+		acc.Balance++
+		err := up.UpdateAccount(acc)
+		if err != nil {
+			return err
+		}
+		err = up.RemoveAccount(removed)
+		if err != nil {
+			return err
+		}
+		err = up.SetStorage(updated, deletedKey, nil)
+		if err != nil {
+			return err
+		}
+		err = up.SetStorage(updated, addedKey, binary.LeftPadWord256([]byte{4, 5, 6}).Bytes())
+		if err != nil {
+			return err
+		}
+		err = up.RemoveName("name1")
+		if err != nil {
+			return err
+		}
+		return up.UpdateName(&names.Entry{Name: "added", Data: "data", Owner: crypto.ZeroAddress, Expires: 1337})
+	})
+	require.NoError(t, err)
+	endHeight := state.HeightAtVersion(version)
+	dumper := NewDumper(st, NewMockchain("Mockchain", endHeight))
+
+	dir, err := ioutil.TempDir("", "TestTransmitIncremental")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	baseFile := path.Join(dir, "base.json")
+	incrementalFile := path.Join(dir, "incremental.json")
+	writeDump(t, baseFile, dumper.Source(0, baseHeight, All))
+	writeDump(t, incrementalFile, dumper.IncrementalSource(baseHeight, endHeight, All))
+
+	// Only the changes are dumped
+	incremental, err := NewFileReader(incrementalFile)
+	require.NoError(t, err)
+	var rows []*Dump
+	for row, err := incremental.Recv(); err == nil; row, err = incremental.Recv() {
+		require.Equal(t, baseHeight, row.BaseHeight)
+		rows = append(rows, row)
+	}
+	require.Len(t, rows, 5)
+
+	restored, err := state.MakeGenesisState(testDB(t),
+		&genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions})
+	require.NoError(t, err)
+	reader, err := NewFilesReader(baseFile, incrementalFile)
+	require.NoError(t, err)
+	require.NoError(t, Load(reader, restored))
+	require.Equal(t, stateRows(t, st, endHeight), stateRows(t, restored, 0))
+
+	// Incremental dumps must follow the dump they were made since
+	reader, err = NewFilesReader(incrementalFile)
+	require.NoError(t, err)
+	_, err = reader.Recv()
+	require.Error(t, err)
+	reader, err = NewFilesReader(baseFile, baseFile)
+	require.NoError(t, err)
+	err = Load(reader, restored)
+	require.Error(t, err)
+}
+
+func writeDump(t *testing.T, filename string, source Source) {
+	buf := new(bytes.Buffer)
+	require.NoError(t, Write(buf, source, false, All))
+	require.NoError(t, ioutil.WriteFile(filename, buf.Bytes(), 0644))
+}
+
+// The accounts, storage, and names of st at height in a canonical order
+func stateRows(t *testing.T, st *state.State, height uint64) []string {
+	sink := CollectSink{Rows: make([]string, 0)}
+	err := NewDumper(st, NewMockchain("Mockchain", height)).Transmit(&sink, 0, height, Accounts|Names)
+	require.NoError(t, err)
+	for i, jsonRow := range sink.Rows {
+		row := new(Dump)
+		require.NoError(t, json.Unmarshal([]byte(jsonRow), row))
+		row.Height = 0
+		row.Index = 0
+		row.Checksum = 0
+		bs, err := json.Marshal(row)
+		require.NoError(t, err)
+		sink.Rows[i] = string(bs)
+	}
+	sort.Strings(sink.Rows)
+	return sink.Rows
+}
@@ -1,112 +1,276 @@
 package dump
 
 import (
+	"context"
 	"crypto/sha256"
 	bin "encoding/binary"
 	"io"
+	"runtime"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/txs/payload"
+	"golang.org/x/sync/errgroup"
 )
 
-// Load a dump into state. We store all the events from the source chain in a single zeroth block with all the events
+// Number of writes queued for each worker loading state
+const loadQueueLength = 1024
+
+// Load a dump, and any incremental dumps following it, into state. We store all the events from the source chain in a single zeroth block with all the events
 // at each height in their own pseudo transaction.
 func Load(source Source, st *state.State) error {
-	_, _, err := st.Update(func(s state.Updatable) error {
-		txs := make([]*exec.TxExecution, 0)
+	return LoadConcurrently(source, st, runtime.GOMAXPROCS(0))
+}
+
+// LoadConcurrently loads like Load but writes state from up to workers goroutines. Accounts, names, and the storage of
+// each account are separate trees of state, which are written and then saved concurrently. Each tree is written in the
+// order of the dump so state has the same hash whatever the number of workers.
+func LoadConcurrently(source Source, st *state.State, workers int) error {
+	if workers < 1 {
+		workers = 1
+	}
+	_, _, err := st.UpdateConcurrently(workers, func(s state.Updatable) error {
+		ld := newLoader(s, workers)
+		err := ld.load(source)
+		// The workers' first error is the cause of any error sending to them
+		if waitErr := ld.wait(); waitErr != nil {
+			return waitErr
+		}
+		if err != nil {
+			return err
+		}
+		return s.AddBlock(&exec.BlockExecution{
+			Height:       0,
+			TxExecutions: ld.txs,
+		})
+	})
+	return err
+}
+
+type loader struct {
+	state state.Updatable
+	// Each worker applies the writes sent to it in order, and every write to a tree is sent to the same worker
+	workers []chan func() error
+	group   *errgroup.Group
+	ctx     context.Context
+	running bool
+	// Trees of state that have been written
+	written map[string]bool
+	txs     []*exec.TxExecution
+	tx      *exec.TxExecution
+}
+
+func newLoader(st state.Updatable, workers int) *loader {
+	ld := &loader{
+		state:   st,
+		workers: make([]chan func() error, workers),
+		written: make(map[string]bool),
+		txs:     make([]*exec.TxExecution, 0),
+	}
+	ld.start()
+	return ld
+}
+
+func (ld *loader) load(source Source) error {
+	for {
+		row, err := source.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
 
-		var tx *exec.TxExecution
+		if row.Account != nil {
+			if row.Account.Address != acm.GlobalPermissionsAddress {
+				account := row.Account
+				err = ld.write("a", 0, func() error {
+					return ld.updateAccount(account)
+				}, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
 
-		for {
-			row, err := source.Recv()
-			if err == io.EOF {
-				break
+		if row.RemovedAccount != nil {
+			// Removing an account deletes its storage tree so we wait for everything written before it
+			err = ld.wait()
+			if err != nil {
+				return err
 			}
+			err = ld.state.RemoveAccount(*row.RemovedAccount)
 			if err != nil {
 				return err
 			}
+			ld.written["a"] = true
+			ld.start()
+		}
 
-			if row.Account != nil {
-				if row.Account.Address != acm.GlobalPermissionsAddress {
-					for _, m := range row.Account.ContractMeta {
-						metahash := acmstate.GetMetadataHash(m.Metadata)
-						err = s.SetMetadata(metahash, m.Metadata)
-						if err != nil {
-							return err
-						}
-						m.MetadataHash = metahash.Bytes()
-						m.Metadata = ""
-					}
-					err := s.UpdateAccount(row.Account)
-					if err != nil {
-						return err
-					}
-				}
+		if row.AccountStorage != nil && len(row.AccountStorage.Storage) > 0 {
+			address := row.AccountStorage.Address
+			storage := row.AccountStorage.Storage
+			worker := int(bin.BigEndian.Uint64(address[:8]) % uint64(len(ld.workers)))
+			err = ld.write("s"+string(address[:]), worker, func() error {
+				return ld.setStorage(address, storage[:1])
+			}, func() error {
+				return ld.setStorage(address, storage[1:])
+			})
+			if err != nil {
+				return err
 			}
+		}
 
-			if row.AccountStorage != nil {
-				for _, storage := range row.AccountStorage.Storage {
-					err := s.SetStorage(row.AccountStorage.Address, storage.Key, storage.Value)
-					if err != nil {
-						return err
-					}
-				}
+		if row.Name != nil {
+			entry := row.Name
+			err = ld.write("n", 1%len(ld.workers), func() error {
+				return ld.state.UpdateName(entry)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if row.RemovedName != "" {
+			name := row.RemovedName
+			err = ld.write("n", 1%len(ld.workers), func() error {
+				return ld.state.RemoveName(name)
+			}, nil)
+			if err != nil {
+				return err
 			}
+		}
+
+		if row.EVMEvent != nil {
+			ld.addEvent(row)
+		}
+	}
+	if ld.tx != nil {
+		ld.txs = append(ld.txs, ld.tx)
+	}
+	return nil
+}
 
-			if row.Name != nil {
-				err := s.UpdateName(row.Name)
+// The order in which trees are first written determines the order in which they are saved and so the hash of state.
+// We make the first write to each tree, first, here so that it happens in the order of the dump and send the rest of
+// the writes of a row, rest, to the tree's worker. Any later writes to the tree are all sent to the worker.
+func (ld *loader) write(tree string, worker int, first, rest func() error) error {
+	op := first
+	if !ld.written[tree] {
+		ld.written[tree] = true
+		err := first()
+		if err != nil {
+			return err
+		}
+		op = rest
+	} else if rest != nil {
+		op = func() error {
+			err := first()
+			if err != nil {
+				return err
+			}
+			return rest()
+		}
+	}
+	if op == nil {
+		return nil
+	}
+	select {
+	case ld.workers[worker] <- op:
+		return nil
+	case <-ld.ctx.Done():
+		return ld.ctx.Err()
+	}
+}
+
+func (ld *loader) start() {
+	ld.group, ld.ctx = errgroup.WithContext(context.Background())
+	for i := range ld.workers {
+		ops := make(chan func() error, loadQueueLength)
+		ld.workers[i] = ops
+		ld.group.Go(func() error {
+			for op := range ops {
+				err := op()
 				if err != nil {
 					return err
 				}
 			}
+			return nil
+		})
+	}
+	ld.running = true
+}
 
-			if row.EVMEvent != nil {
-				if tx != nil && row.Height != tx.Height {
-					txs = append(txs, tx)
-					tx = nil
-				}
-				if tx == nil {
-					tx = &exec.TxExecution{
-						TxHeader: &exec.TxHeader{
-							TxType: payload.TypeCall,
-							TxHash: dumpTxHash(row.EVMEvent.ChainID, row.Height),
-							Height: 0,
-							Index:  uint64(len(txs)),
-							Origin: &exec.Origin{
-								ChainID: row.EVMEvent.ChainID,
-								Height:  row.Height,
-								Time:    row.EVMEvent.Time,
-								Index:   row.EVMEvent.Index,
-							},
-						},
-					}
-				}
+// Waits for the workers to apply every write sent to them and stops them
+func (ld *loader) wait() error {
+	if !ld.running {
+		return nil
+	}
+	ld.running = false
+	for _, ops := range ld.workers {
+		close(ops)
+	}
+	return ld.group.Wait()
+}
 
-				tx.Events = append(tx.Events, &exec.Event{
-					Header: &exec.Header{
-						TxType:    payload.TypeCall,
-						EventType: exec.TypeLog,
-						Height:    row.Height,
-					},
-					Log: row.EVMEvent.Event,
-				})
-			}
+func (ld *loader) updateAccount(account *acm.Account) error {
+	for _, m := range account.ContractMeta {
+		metahash := acmstate.GetMetadataHash(m.Metadata)
+		err := ld.state.SetMetadata(metahash, m.Metadata)
+		if err != nil {
+			return err
 		}
+		m.MetadataHash = metahash.Bytes()
+		m.Metadata = ""
+	}
+	return ld.state.UpdateAccount(account)
+}
 
-		if tx != nil {
-			txs = append(txs, tx)
+func (ld *loader) setStorage(address crypto.Address, storage []*Storage) error {
+	for _, s := range storage {
+		err := ld.state.SetStorage(address, s.Key, s.Value)
+		if err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		return s.AddBlock(&exec.BlockExecution{
-			Height:       0,
-			TxExecutions: txs,
-		})
+// We store the events at each height of the source chain in their own pseudo transaction
+func (ld *loader) addEvent(row *Dump) {
+	if ld.tx != nil && row.Height != ld.tx.Height {
+		ld.txs = append(ld.txs, ld.tx)
+		ld.tx = nil
+	}
+	if ld.tx == nil {
+		ld.tx = &exec.TxExecution{
+			TxHeader: &exec.TxHeader{
+				TxType: payload.TypeCall,
+				TxHash: dumpTxHash(row.EVMEvent.ChainID, row.Height),
+				Height: 0,
+				Index:  uint64(len(ld.txs)),
+				Origin: &exec.Origin{
+					ChainID: row.EVMEvent.ChainID,
+					Height:  row.Height,
+					Time:    row.EVMEvent.Time,
+					Index:   row.EVMEvent.Index,
+				},
+			},
+		}
+	}
+
+	ld.tx.Events = append(ld.tx.Events, &exec.Event{
+		Header: &exec.Header{
+			TxType:    payload.TypeCall,
+			EventType: exec.TypeLog,
+			Height:    row.Height,
+		},
+		Log: row.EVMEvent.Event,
 	})
-	return err
 }
 
 // Provides a psuedo-hash for the singular 'dump tx' that is generated by a restore
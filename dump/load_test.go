@@ -23,6 +23,19 @@ func TestLoad(t *testing.T) {
 	testLoad(t, NewMockSource(100, 10, 20, 1000))
 }
 
+func TestLoadConcurrently(t *testing.T) {
+	st := testLoad(t, NewMockSource(100, 10, 20, 1000))
+	// State has the same hash however many workers load it
+	for _, workers := range []int{1, 2, 7} {
+		stConcurrent, err := state.MakeGenesisState(testDB(t),
+			&genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions})
+		require.NoError(t, err)
+		err = LoadConcurrently(NewMockSource(100, 10, 20, 1000), stConcurrent, workers)
+		require.NoError(t, err)
+		require.Equal(t, st.Hash(), stConcurrent.Hash(), "workers: %d", workers)
+	}
+}
+
 func BenchmarkLoad(b *testing.B) {
 	for f := 1; f <= 64; f *= 2 {
 		b.Run(fmt.Sprintf("factor/%d", f), func(b *testing.B) {
@@ -0,0 +1,97 @@
+package dump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hyperledger/burrow/encoding"
+)
+
+// ReadResumeToken reads the dump in filename, which may have been only partially transferred, and returns the token to
+// resume the transfer from, the length in bytes of the rows that were received intact (after which any partial or
+// corrupted row should be truncated), and whether the dump is protobuf encoded. The token is nil if no row was received.
+func ReadResumeToken(filename string) (*ResumeToken, int64, bool, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	defer f.Close()
+
+	first := make([]byte, 1)
+	_, err = f.Read(first)
+	if err == io.EOF {
+		return nil, 0, false, nil
+	} else if err != nil {
+		return nil, 0, false, err
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	// JSON rows are objects whereas protobuf rows are prefixed with their length as a zigzag varint, whose first byte
+	// is even for any length
+	useBinaryEncoding := first[0] != '{'
+	next := jsonRowReader(bufio.NewReader(f))
+	if useBinaryEncoding {
+		next = protobufRowReader(f)
+	}
+
+	var height, baseHeight, index uint64
+	var offset int64
+	for {
+		row, n, err := next()
+		// Rows from before dumps were checksummed cannot be resumed from
+		if err != nil || row.Checksum == 0 || row.VerifyChecksum() != nil || row.Index != index {
+			// Everything up to here was received intact
+			break
+		}
+		if index == 0 {
+			// Dumps start with the state, whose rows have the height of the state dumped, rather than events, whose
+			// rows have the height of the event
+			if row.EVMEvent != nil {
+				return nil, 0, false, fmt.Errorf("dump %s does not start with accounts or names so cannot be resumed",
+					filename)
+			}
+			height = row.Height
+			baseHeight = row.BaseHeight
+		}
+		index++
+		offset += int64(n)
+	}
+	if index == 0 {
+		return nil, 0, useBinaryEncoding, nil
+	}
+	return &ResumeToken{Height: height, Index: index, BaseHeight: baseHeight}, offset, useBinaryEncoding, nil
+}
+
+// Rows are written one per line
+func jsonRowReader(r *bufio.Reader) func() (*Dump, int, error) {
+	return func() (*Dump, int, error) {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			// Including a final line that was not completely written
+			return nil, 0, err
+		}
+		row := new(Dump)
+		err = json.Unmarshal(bytes.TrimSpace(line), row)
+		if err != nil {
+			return nil, 0, err
+		}
+		return row, len(line), nil
+	}
+}
+
+func protobufRowReader(r io.Reader) func() (*Dump, int, error) {
+	return func() (*Dump, int, error) {
+		row := new(Dump)
+		n, err := encoding.ReadMessage(r, row)
+		if err != nil {
+			return nil, 0, err
+		}
+		return row, n, nil
+	}
+}
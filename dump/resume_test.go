@@ -0,0 +1,70 @@
+package dump
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadResumeToken(t *testing.T) {
+	mockSource := NewMockSource(20, 20, 10, 10)
+	st := testLoad(t, mockSource)
+	dumper := NewDumper(st, mockSource)
+	dir, err := ioutil.TempDir("", "TestReadResumeToken")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, useBinaryEncoding := range []bool{false, true} {
+		full := new(bytes.Buffer)
+		err = Write(full, dumper.Source(0, 0, All), useBinaryEncoding, All)
+		require.NoError(t, err)
+
+		filename := path.Join(dir, "dump")
+		require.NoError(t, ioutil.WriteFile(filename, nil, 0644))
+		token, offset, _, err := ReadResumeToken(filename)
+		require.NoError(t, err)
+		require.Nil(t, token)
+		require.Zero(t, offset)
+
+		// Interrupt the transfer part way through a row
+		require.NoError(t, ioutil.WriteFile(filename, full.Bytes()[:full.Len()/2], 0644))
+		token, offset, binary, err := ReadResumeToken(filename)
+		require.NoError(t, err)
+		require.NotNil(t, token)
+		require.Equal(t, useBinaryEncoding, binary)
+		require.Equal(t, mockSource.LastBlockHeight(), token.Height)
+		require.True(t, offset <= int64(full.Len()/2))
+
+		// Resume from where we left off
+		resumed := bytes.NewBuffer(full.Bytes()[:offset])
+		p := make(Pipe)
+		go func() {
+			err := dumper.TransmitFrom(p, 0, token.Height, token.Index, All)
+			if err != nil {
+				p <- msg{err: err}
+			}
+			close(p)
+		}()
+		err = Write(resumed, p, useBinaryEncoding, All)
+		require.NoError(t, err)
+		require.Equal(t, full.Bytes(), resumed.Bytes())
+
+		// A corrupted row is transferred again, past the length prefix of a binary row
+		corrupted := resumed.Bytes()
+		if corrupted[offset+5] == 0 {
+			corrupted[offset+5] = 1
+		} else {
+			corrupted[offset+5] = 0
+		}
+		require.NoError(t, ioutil.WriteFile(filename, corrupted, 0644))
+		token2, offset2, _, err := ReadResumeToken(filename)
+		require.NoError(t, err)
+		require.NotNil(t, token2)
+		require.Equal(t, token.Index, token2.Index)
+		require.Equal(t, offset, offset2)
+	}
+}
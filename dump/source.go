@@ -18,17 +18,117 @@ type StreamReader struct {
 	decode func(*Dump) error
 }
 
+// NewFileReader returns a Source of the rows of the dump in filename, which may be protobuf or JSON encoded, or a
+// directory of CSV files
 func NewFileReader(filename string) (Source, error) {
+	_, source, err := openFile(filename)
+	return source, err
+}
+
+// Opens a protobuf or JSON dump file, or a CSV dump directory, detecting which it is
+func openFile(filename string) (io.Closer, Source, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.IsDir() {
+		reader, err := NewCSVReader(filename)
+		if err != nil {
+			return nil, nil, err
+		}
+		return reader, reader, nil
+	}
 	f, err := os.OpenFile(filename, os.O_RDONLY, 0644)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	decoder, err := decoderFor(f)
 	if err != nil {
-		return nil, err
+		f.Close()
+		return nil, nil, err
 	}
+	source, err := NewStreamReader(f, decoder)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, source, nil
+}
 
-	return NewStreamReader(f, decoder)
+// FilesReader reads a dump followed by the incremental dumps made since it, in order, as a single Source
+type FilesReader struct {
+	filenames []string
+	// Index of the file being read
+	current int
+	file    io.Closer
+	source  Source
+	// Heights of the state in the previous and current file, or 0 if they have no rows of state
+	previousHeight uint64
+	height         uint64
+}
+
+// NewFilesReader returns a Source of the rows of the dump in the first file followed by those of the incremental dumps
+// in the rest, each of which must have been made since the height of the dump before it
+func NewFilesReader(filenames ...string) (*FilesReader, error) {
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no dump files to read")
+	}
+	return &FilesReader{filenames: filenames}, nil
+}
+
+func (fr *FilesReader) Recv() (*Dump, error) {
+	for fr.current < len(fr.filenames) {
+		filename := fr.filenames[fr.current]
+		if fr.source == nil {
+			var err error
+			fr.file, fr.source, err = openFile(filename)
+			if err != nil {
+				return nil, err
+			}
+		}
+		row, err := fr.source.Recv()
+		if err == io.EOF {
+			err = fr.file.Close()
+			if err != nil {
+				return nil, err
+			}
+			fr.current++
+			fr.file, fr.source = nil, nil
+			fr.previousHeight, fr.height = fr.height, 0
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read dump %s: %w", filename, err)
+		}
+		err = fr.check(row)
+		if err != nil {
+			return nil, fmt.Errorf("cannot restore dump %s: %w", filename, err)
+		}
+		return row, nil
+	}
+	return nil, io.EOF
+}
+
+func (fr *FilesReader) check(row *Dump) error {
+	// Rows of events have the height of the event rather than that of the state dumped
+	if row.EVMEvent == nil {
+		fr.height = row.Height
+	}
+	if fr.current == 0 {
+		if row.BaseHeight != 0 {
+			return fmt.Errorf("it is an incremental dump so must follow the dump at height %d", row.BaseHeight)
+		}
+		return nil
+	}
+	if row.BaseHeight == 0 {
+		return fmt.Errorf("it is not an incremental dump so cannot follow another dump")
+	}
+	// We cannot check dumps that follow an incremental dump of no changes to state
+	if fr.previousHeight != 0 && row.BaseHeight != fr.previousHeight {
+		return fmt.Errorf("it is an incremental dump since height %d but follows the dump at height %d",
+			row.BaseHeight, fr.previousHeight)
+	}
+	return nil
 }
 
 func NewProtobufReader(reader io.Reader) (*StreamReader, error) {
@@ -54,6 +154,11 @@ func (sr *StreamReader) Recv() (*Dump, error) {
 		return nil, err
 	}
 
+	err = row.VerifyChecksum()
+	if err != nil {
+		return nil, err
+	}
+
 	return row, nil
 }
 
@@ -79,6 +184,11 @@ func decoderFor(f *os.File) (func(*Dump) error, error) {
 		return jsonDecoder(f), nil
 	}
 
+	// The JSON decoder will have consumed some of the file
+	_, err := f.Seek(0, 0)
+	if err != nil {
+		return nil, err
+	}
 	_, binErr := encoding.ReadMessage(f, &Dump{})
 	if binErr != nil && binErr != io.EOF {
 		return nil, fmt.Errorf("could decode first row of dump file as protobuf (%v) or JSON (%v)",
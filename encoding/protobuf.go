@@ -76,6 +76,9 @@ func ReadMessage(r io.Reader, pb proto.Message) (int, error) {
 		return br.read, fmt.Errorf("%s: %v", errHeader, err)
 	}
 	read := br.read
+	if msgLength < 0 {
+		return read, fmt.Errorf("%s: negative message length %d", errHeader, msgLength)
+	}
 	// Use any message bytes at end of buffer
 	bs := make([]byte, msgLength)
 	n, err := r.Read(bs)
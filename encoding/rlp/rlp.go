@@ -285,6 +285,49 @@ func decode(in []byte) ([][]byte, error) {
 	return suffix, nil
 }
 
+// DecodeNested decodes a single RLP item into a tree where strings are returned as []byte and lists are returned as
+// []interface{} containing their items. Unlike Decode the nesting of lists is preserved so it can be used for
+// structures like EIP-2930 access lists
+func DecodeNested(src []byte) (interface{}, error) {
+	if len(src) == 0 {
+		return nil, ErrNoInput
+	}
+	item, rest, err := decodeNested(src)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%d bytes of trailing input after RLP item", len(rest))
+	}
+	return item, nil
+}
+
+func decodeNested(in []byte) (interface{}, []byte, error) {
+	if len(in) == 0 {
+		return nil, nil, ErrNoInput
+	}
+	offset, length, typ := decodeLength(in)
+	end := offset + length
+	if end > uint64(len(in)) {
+		return nil, nil, fmt.Errorf("read length prefix of %d but there is only %d bytes of unconsumed input",
+			length, uint64(len(in))-offset)
+	}
+	if typ == reflect.String {
+		return in[offset:end], in[end:], nil
+	}
+	items := make([]interface{}, 0)
+	for body := in[offset:end]; len(body) > 0; {
+		var item interface{}
+		var err error
+		item, body, err = decodeNested(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+	return items, in[end:], nil
+}
+
 func decodeLength(input []byte) (uint64, uint64, reflect.Kind) {
 	magicByte := magicOffset(input[0])
 
@@ -301,7 +344,7 @@ func decodeLength(input []byte) (uint64, uint64, reflect.Kind) {
 	case magicByte < SliceOffset:
 		// long string: length described by magic = 0xb7 + <byte length of length of string>
 		byteLengthOfLength := magicByte - StringOffset - ShortLength
-		length := getUint64(input[1:byteLengthOfLength])
+		length := getUint64(input[1 : byteLengthOfLength+1])
 		offset := uint64(byteLengthOfLength + 1)
 		return offset, length, reflect.String
 
@@ -314,7 +357,7 @@ func decodeLength(input []byte) (uint64, uint64, reflect.Kind) {
 	default:
 		// long string: length described by magic = 0xf7 + <byte length of length of string>
 		byteLengthOfLength := magicByte - SliceOffset - ShortLength
-		length := getUint64(input[1:byteLengthOfLength])
+		length := getUint64(input[1 : byteLengthOfLength+1])
 		offset := uint64(byteLengthOfLength + 1)
 		return offset, length, reflect.Slice
 	}
@@ -7,11 +7,11 @@ import (
 	"context"
 	"math/rand"
 
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/hyperledger/burrow/event/pubsub"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
-	"github.com/tendermint/tendermint/libs/service"
 	hex "github.com/tmthrgd/go-hex"
 )
 
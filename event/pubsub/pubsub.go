@@ -18,10 +18,10 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
-	"github.com/tendermint/tendermint/libs/service"
 )
 
 type operation int
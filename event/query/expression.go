@@ -154,6 +154,44 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 	return stack[0].match, nil
 }
 
+// Excludes returns true if the expression can be shown to match none of some set of tagged values knowing only, through
+// mayEqual, whether any of them may have a tag equal to a value. mayEqual returns known = false for tags it knows
+// nothing about. It is conservative: false means that the set must be searched, not that something in it matches.
+func (e *Expression) Excludes(mayEqual func(tag, value string) (maybe, known bool)) bool {
+	if len(e.errors) > 0 {
+		return false
+	}
+	var left, right *instruction
+	// A match on the stack means the subexpression matches nothing in the set
+	stack := make([]*instruction, 0, len(e.code))
+	var err error
+	for _, in := range e.code {
+		if in.op == OpTerminal {
+			stack = append(stack, in)
+			continue
+		}
+		stack, left, right, err = pop(stack, in.op)
+		if err != nil {
+			return false
+		}
+		ins := &instruction{}
+		switch in.op {
+		case OpAnd:
+			ins.match = left.match || right.match
+		case OpOr:
+			ins.match = left.match && right.match
+		case OpEqual:
+			if right.string != nil {
+				maybe, known := mayEqual(*left.tag, *right.string)
+				ins.match = known && !maybe
+			}
+		}
+		// Nothing else (including the negation of a subexpression that matches nothing) excludes anything
+		stack = append(stack, ins)
+	}
+	return len(stack) == 1 && stack[0].match
+}
+
 func (e *Expression) explainf(fmt string, args ...interface{}) {
 	if e.explainer != nil {
 		e.explainer(fmt, args...)
@@ -30,4 +30,28 @@ func TestExpression(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, matches)
 	})
+	t.Run("Excludes", func(t *testing.T) {
+		// Only something = 'nice' is known to be in the set
+		mayEqual := func(tag, value string) (bool, bool) {
+			if tag != "something" {
+				return false, false
+			}
+			return value == "nice", true
+		}
+		for qs, excludes := range map[string]bool{
+			"something = 'awful'":                                      true,
+			"something = 'nice'":                                       false,
+			"something = 'awful' AND another_thing = 'OKAY'":           true,
+			"something = 'awful' OR another_thing = 'OKAY'":            false,
+			"(something = 'awful' OR something = 'bad') AND foo = 'a'": true,
+			"NOT something = 'awful'":                                  false,
+			"something != 'nice'":                                      false,
+			"another_thing = 'OKAY'":                                   false,
+		} {
+			qry, err := New(qs)
+			require.NoError(t, err)
+			require.Equal(t, excludes, Excludes(qry, mayEqual), qs)
+		}
+		require.False(t, Excludes(Empty{}, mayEqual))
+	})
 }
@@ -107,6 +107,13 @@ func (q *PegQuery) MatchError() error {
 	return q.error
 }
 
+// Excludes returns true if qry can be shown to match none of some set of tagged values knowing only whether any of them
+// may have a tag equal to a value - see Expression.Excludes
+func Excludes(qry Query, mayEqual func(tag, value string) (maybe, known bool)) bool {
+	pq, ok := qry.(*PegQuery)
+	return ok && pq.parser.Excludes(mayEqual)
+}
+
 func (q *PegQuery) ExplainTo(explainer func(fmt string, args ...interface{})) {
 	q.parser.explainer = explainer
 }
@@ -0,0 +1,106 @@
+package execution
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// Records the accounts and storage slots read and changed through it by a transaction
+type accessRecorder struct {
+	acmstate.ReaderWriter
+	reads  map[accessKey]struct{}
+	writes map[accessKey]struct{}
+}
+
+func newAccessRecorder(backend acmstate.ReaderWriter) *accessRecorder {
+	ar := &accessRecorder{ReaderWriter: backend}
+	ar.reset()
+	return ar
+}
+
+func (ar *accessRecorder) reset() {
+	ar.reads = make(map[accessKey]struct{})
+	ar.writes = make(map[accessKey]struct{})
+}
+
+func (ar *accessRecorder) GetAccount(address crypto.Address) (*acm.Account, error) {
+	ar.reads[accessKey{address: address}] = struct{}{}
+	return ar.ReaderWriter.GetAccount(address)
+}
+
+func (ar *accessRecorder) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	ar.reads[accessKey{address: address, key: key, storage: true}] = struct{}{}
+	return ar.ReaderWriter.GetStorage(address, key)
+}
+
+// Caches sync every account they have updated along with all the storage they have read so only those writes that
+// change the current value are recorded
+func (ar *accessRecorder) UpdateAccount(account *acm.Account) error {
+	if account != nil {
+		current, err := ar.ReaderWriter.GetAccount(account.Address)
+		if err != nil {
+			return err
+		}
+		if current == nil || !current.Equal(account) {
+			ar.writes[accessKey{address: account.Address}] = struct{}{}
+		}
+	}
+	return ar.ReaderWriter.UpdateAccount(account)
+}
+
+func (ar *accessRecorder) RemoveAccount(address crypto.Address) error {
+	ar.writes[accessKey{address: address}] = struct{}{}
+	return ar.ReaderWriter.RemoveAccount(address)
+}
+
+func (ar *accessRecorder) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
+	current, err := ar.ReaderWriter.GetStorage(address, key)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(current, value) {
+		ar.writes[accessKey{address: address, key: key, storage: true}] = struct{}{}
+	}
+	return ar.ReaderWriter.SetStorage(address, key, value)
+}
+
+func (ar *accessRecorder) accessSet() *exec.AccessSet {
+	return &exec.AccessSet{
+		Reads:  accesses(ar.reads),
+		Writes: accesses(ar.writes),
+	}
+}
+
+// Group keys by account in ascending order of address and storage key
+func accesses(keys map[accessKey]struct{}) []exec.Access {
+	byAddress := make(map[crypto.Address]*exec.Access)
+	for k := range keys {
+		access, ok := byAddress[k.address]
+		if !ok {
+			access = &exec.Access{Address: k.address}
+			byAddress[k.address] = access
+		}
+		if k.storage {
+			access.Keys = append(access.Keys, k.key)
+		} else {
+			access.Account = true
+		}
+	}
+	as := make([]exec.Access, 0, len(byAddress))
+	for _, access := range byAddress {
+		sort.Slice(access.Keys, func(i, j int) bool {
+			return bytes.Compare(access.Keys[i][:], access.Keys[j][:]) < 0
+		})
+		as = append(as, *access)
+	}
+	sort.Slice(as, func(i, j int) bool {
+		return bytes.Compare(as[i].Address[:], as[j].Address[:]) < 0
+	})
+	return as
+}
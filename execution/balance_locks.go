@@ -0,0 +1,48 @@
+package execution
+
+import (
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Refuses to store or remove an account when that would spend balance still locked in the block being executed, so
+// that neither transfers, fees, bonds, nor self-destructs made by a transaction can spend locked balance
+type balanceLockGuard struct {
+	acmstate.ReaderWriter
+	height func() uint64
+}
+
+func newBalanceLockGuard(backend acmstate.ReaderWriter, height func() uint64) *balanceLockGuard {
+	return &balanceLockGuard{ReaderWriter: backend, height: height}
+}
+
+func (blg *balanceLockGuard) UpdateAccount(account *acm.Account) error {
+	if account != nil && len(account.Locks) > 0 {
+		err := blg.checkSpend(account.Address, account.Balance)
+		if err != nil {
+			return err
+		}
+	}
+	return blg.ReaderWriter.UpdateAccount(account)
+}
+
+func (blg *balanceLockGuard) RemoveAccount(address crypto.Address) error {
+	err := blg.checkSpend(address, 0)
+	if err != nil {
+		return err
+	}
+	return blg.ReaderWriter.RemoveAccount(address)
+}
+
+// Returns an error if leaving the account at address with balance lowers it below what is locked. An account already
+// short of its locks is not refused changes that leave its balance no lower, so it can still be paid.
+func (blg *balanceLockGuard) checkSpend(address crypto.Address, balance uint64) error {
+	previous, err := blg.ReaderWriter.GetAccount(address)
+	if err != nil || previous == nil || balance >= previous.Balance {
+		return err
+	}
+	spent := previous.Copy()
+	spent.Balance = balance
+	return spent.CheckLocks(blg.height())
+}
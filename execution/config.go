@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/execution/state"
 
 	"github.com/hyperledger/burrow/execution/evm"
 )
@@ -25,6 +28,19 @@ type ExecutionConfig struct {
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
 	VMOptions                []VMOption `json:",omitempty" toml:",omitempty"`
+	// Paths of Go plugins that register chain-specific natives when loaded (see native.LoadPlugin)
+	NativePlugins []string `json:",omitempty" toml:",omitempty"`
+	// The number of transactions to execute at once when the chain executes transactions in parallel (defaults to the
+	// number of CPUs)
+	ParallelWorkers int `json:",omitempty" toml:",omitempty"`
+	// Calls offering less than this fee per unit of gas are not accepted into this node's mempool (see FeePerGas)
+	MinimumFeePerGas uint64 `json:",omitempty" toml:",omitempty"`
+	// The attributes by which this node indexes transactions so they can be looked up (transaction hashes by default)
+	TxIndex *state.TxIndexConfig `json:",omitempty" toml:",omitempty"`
+	// The sizes of the caches of state
+	StateCache *state.CacheConfig `json:",omitempty" toml:",omitempty"`
+	// The number of contracts whose analysed code the EVM keeps in memory (1024 by default)
+	CodeCacheSize int `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultExecutionConfig() *ExecutionConfig {
@@ -44,6 +60,26 @@ func VMOptions(vmOptions engine.Options) func(*executor) {
 	}
 }
 
+func ParallelWorkers(workers int) func(*executor) {
+	return func(exe *executor) {
+		exe.parallelWorkers = workers
+	}
+}
+
+// MinimumFeePerGas refuses to check calls offering less than minimum per unit of gas, it has no effect on committing
+func MinimumFeePerGas(minimum uint64) func(*executor) {
+	return func(exe *executor) {
+		exe.minimumFeePerGas = minimum
+	}
+}
+
+// RecordContractStats counts the calls made to each contract by the blocks the executor commits into stats
+func RecordContractStats(stats *exec.ContractStats) func(*executor) {
+	return func(exe *executor) {
+		exe.contractStats = stats
+	}
+}
+
 func (ec *ExecutionConfig) ExecutionOptions() ([]Option, error) {
 	var exeOptions []Option
 	vmOptions := engine.Options{
@@ -62,6 +98,17 @@ func (ec *ExecutionConfig) ExecutionOptions() ([]Option, error) {
 			return nil, fmt.Errorf("VM option '%s' not recognised", option)
 		}
 	}
-	exeOptions = append(exeOptions, VMOptions(vmOptions))
+	for _, path := range ec.NativePlugins {
+		err := native.LoadPlugin(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	exeOptions = append(exeOptions, VMOptions(vmOptions), ParallelWorkers(ec.ParallelWorkers))
 	return exeOptions, nil
 }
+
+// CheckerOptions are the options for the executor that checks transactions for the mempool
+func (ec *ExecutionConfig) CheckerOptions() []Option {
+	return []Option{MinimumFeePerGas(ec.MinimumFeePerGas)}
+}
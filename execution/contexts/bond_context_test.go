@@ -1,16 +1,21 @@
 package contexts
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/unbonding"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestBondContext(t *testing.T) {
@@ -42,3 +47,54 @@ func TestBondContext(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestUnbondContext(t *testing.T) {
+	privKey, err := crypto.GeneratePrivateKey(nil, crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	pubKey := privKey.GetPublicKey()
+	address := pubKey.GetAddress()
+
+	accountState := acmstate.NewMemoryState()
+	accountState.Accounts[address] = &acm.Account{
+		Address:   address,
+		PublicKey: pubKey,
+	}
+	validators := validator.NewSet()
+	validators.ChangePower(pubKey, big.NewInt(1000))
+
+	genesisDoc, _, _ := genesis.NewDeterministicGenesis(3450976).GenesisDoc(1, 1)
+	unbondContext := &UnbondContext{
+		State:           accountState,
+		ValidatorSet:    validators,
+		Blockchain:      bcm.NewBlockchain(dbm.NewMemDB(), genesisDoc),
+		UnbondingBlocks: 5,
+		Logger:          logging.NewNoopLogger(),
+	}
+
+	tx := payload.NewUnbondTx(address, 400)
+	tx.Input = &payload.TxInput{
+		Address: address,
+		Amount:  400,
+	}
+	err = unbondContext.Execute(&exec.TxExecution{}, tx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(600), validators.GetPower(address))
+
+	// The unbonded power is pending rather than paid
+	acc, err := accountState.GetAccount(address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), acc.Balance)
+	pending, err := unbonding.Pending(accountState, address)
+	require.NoError(t, err)
+	require.Equal(t, []*unbonding.Withdrawal{{Address: address, Amount: 400, Height: 6}}, pending)
+
+	due, err := unbonding.Due(accountState, 5)
+	require.NoError(t, err)
+	require.Empty(t, due)
+	due, err = unbonding.Due(accountState, 6)
+	require.NoError(t, err)
+	require.Equal(t, pending, due)
+	pending, err = unbonding.Pending(accountState, address)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
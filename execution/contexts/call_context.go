@@ -12,6 +12,8 @@ import (
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/feemarket"
+	"github.com/hyperledger/burrow/execution/sponsorship"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -26,9 +28,15 @@ type CallContext struct {
 	MetadataState acmstate.MetadataReaderWriter
 	Blockchain    engine.Blockchain
 	RunCall       bool
-	Logger        *logging.Logger
-	tx            *payload.CallTx
-	txe           *exec.TxExecution
+	// Returns the base fee per unit of gas of the current block when the fee market is enabled, otherwise nil
+	BaseFee func() uint64
+	Logger  *logging.Logger
+	tx      *payload.CallTx
+	txe     *exec.TxExecution
+	// The account paying the fees of tx when it is sponsored
+	sponsor *crypto.Address
+	// Whether the fees of tx are waived
+	feesWaived bool
 }
 
 func (ctx *CallContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
@@ -42,8 +50,11 @@ func (ctx *CallContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	if err != nil {
 		return err
 	}
-	// That the fee less than the input amount is checked by Precheck to be greater than or equal to fee
-	value := ctx.tx.Input.Amount - ctx.tx.Fee
+	// That the input amount is greater than or equal to any fee it pays is checked by Precheck
+	value := ctx.tx.Input.Amount
+	if ctx.sponsor == nil && !ctx.feesWaived {
+		value -= ctx.tx.Fee
+	}
 
 	if ctx.RunCall {
 		return ctx.Deliver(inAcc, outAcc, value)
@@ -63,17 +74,36 @@ func (ctx *CallContext) Precheck() (*acm.Account, *acm.Account, error) {
 			"Cannot find input account: %v", ctx.tx.Input)
 	}
 
-	if ctx.tx.Input.Amount < ctx.tx.Fee {
+	// Fees are handle by the CallContext, values transfers (i.e. balances) are handled in the VM (or in Check())
+	payer, err := ctx.feePayer(inAcc)
+	if err != nil {
+		return nil, nil, err
+	}
+	// A sponsored or waived fee is not part of the input amount
+	if payer == inAcc && ctx.tx.Input.Amount < ctx.tx.Fee {
 		return nil, nil, errors.Errorf(errors.Codes.InsufficientFunds,
 			"Send did not send enough to cover the fee: %v", ctx.tx.Input)
 	}
+	if payer != nil {
+		err = payer.SubtractFromBalance(ctx.tx.Fee)
+		if err != nil {
+			return nil, nil, errors.Errorf(errors.Codes.InsufficientFunds,
+				"Account %v (balance: %d) paying the fee does not have sufficient balance to cover input amount: %v",
+				payer.Address, payer.Balance, ctx.tx.Input)
+		}
 
-	// Fees are handle by the CallContext, values transfers (i.e. balances) are handled in the VM (or in Check())
-	err = inAcc.SubtractFromBalance(ctx.tx.Fee)
-	if err != nil {
-		return nil, nil, errors.Errorf(errors.Codes.InsufficientFunds,
-			"Input account %v (balance: %d) does not have sufficient balance to cover input amount: %v",
-			inAcc.Address, inAcc.Balance, ctx.tx.Input)
+		if ctx.BaseFee != nil {
+			err = ctx.reserveGasFee(payer)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if payer != inAcc {
+			err = ctx.State.UpdateAccount(payer)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 	}
 
 	// Calling a nil destination is defined as requesting contract creation
@@ -164,7 +194,7 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 				"callee_address", ctx.tx.Address)
 			ctx.txe.PushError(exception)
 			ctx.CallEvents(exception)
-			return nil
+			return ctx.settleGasFee(caller, 0)
 		}
 		callee = outAcc.Address
 		acc, err := txCache.GetAccount(callee)
@@ -194,6 +224,9 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		Value:  *new(big.Int).SetUint64(value),
 		Gas:    gas,
 	}
+	if createContract {
+		params.CallType = exec.CallTypeCreate
+	}
 
 	if len(wcode) != 0 {
 		// TODO: accept options
@@ -207,7 +240,7 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		} else {
 			ctx.Logger.TraceMsg("Successful execution")
 			if createContract {
-				err := engine.InitWASMCode(txCache, callee, ret)
+				err := initContractCode(txCache, callee, ret, engine.InitWASMCode)
 				if err != nil {
 					return err
 				}
@@ -233,7 +266,7 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		} else {
 			ctx.Logger.TraceMsg("Successful execution")
 			if createContract {
-				err := engine.InitEVMCode(txCache, callee, ret)
+				err := initContractCode(txCache, callee, ret, engine.InitEVMCode)
 				if err != nil {
 					return err
 				}
@@ -246,7 +279,8 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		ctx.CallEvents(err)
 	}
 	// Gas starts life as a uint64 and should only been reduced (used up) over a transaction so .Uint64() is safe
-	ctx.txe.Return(ret, ctx.tx.GasLimit-gas.Uint64())
+	gasUsed := ctx.tx.GasLimit - gas.Uint64()
+	ctx.txe.Return(ret, gasUsed)
 	// Create a receipt from the ret and whether it erred.
 	ctx.Logger.TraceMsg("VM Call complete",
 		"caller", caller,
@@ -254,9 +288,132 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		"return", ret,
 		structure.ErrorKey, err)
 
+	return ctx.settleGasFee(caller, gasUsed)
+}
+
+// Initialises the code of the contract created by the tx unless it self-destructed in its constructor
+func initContractCode(st acmstate.ReaderWriter, address crypto.Address, code []byte,
+	init func(acmstate.ReaderWriter, crypto.Address, []byte) error) error {
+	acc, err := st.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		return nil
+	}
+	return init(st, address, code)
+}
+
+// Returns the account that pays the fees of the tx, which is the input account unless it has a sponsor whose allowance
+// covers the most the tx could pay, or nil if its fees are waived. The allowance of a sponsor is reduced by that amount
+// and any part of it refunded by settleGasFee is restored.
+func (ctx *CallContext) feePayer(inAcc *acm.Account) (*acm.Account, error) {
+	ctx.sponsor = nil
+	ctx.feesWaived = false
+	sponsored, err := sponsorship.Get(ctx.State, inAcc.Address)
+	if err != nil || sponsored == nil {
+		return inAcc, err
+	}
+	if sponsored.Waived {
+		ctx.feesWaived = true
+		return nil, nil
+	}
+	maxFee := ctx.tx.Fee
+	if ctx.BaseFee != nil {
+		maxGasFee, err := gasFee(ctx.tx.GasLimit, ctx.tx.GasPrice)
+		if err != nil {
+			return nil, err
+		}
+		maxFee += maxGasFee
+		if maxFee < maxGasFee {
+			return nil, errors.Errorf(errors.Codes.IntegerOverflow, "maximum fee of tx overflows")
+		}
+	}
+	if !sponsored.Covers(maxFee) || sponsored.Sponsor == inAcc.Address {
+		return inAcc, nil
+	}
+	sponsor, err := ctx.State.GetAccount(sponsored.Sponsor)
+	if err != nil || sponsor == nil {
+		return inAcc, err
+	}
+	sponsored.Allowance -= maxFee
+	err = sponsorship.Set(ctx.State, inAcc.Address, sponsored)
+	if err != nil {
+		return nil, err
+	}
+	ctx.Logger.TraceMsg("Fees paid by sponsor",
+		"input_address", inAcc.Address,
+		"sponsor", sponsor.Address,
+		"allowance", sponsored.Allowance)
+	ctx.sponsor = &sponsor.Address
+	return sponsor, nil
+}
+
+// Subtract the most the tx could pay for gas from the paying account, any excess is refunded by settleGasFee
+func (ctx *CallContext) reserveGasFee(inAcc *acm.Account) error {
+	// The base fee is only known when delivering, in the mempool we just check the maximum fee can be paid
+	if ctx.RunCall {
+		baseFee := ctx.BaseFee()
+		if ctx.tx.GasPrice < baseFee {
+			return errors.Errorf(errors.Codes.InsufficientFunds,
+				"gas price %d offered by %v is below the current base fee %d", ctx.tx.GasPrice, inAcc.Address, baseFee)
+		}
+	}
+	maxGasFee, err := gasFee(ctx.tx.GasLimit, ctx.tx.GasPrice)
+	if err != nil {
+		return err
+	}
+	err = inAcc.SubtractFromBalance(maxGasFee)
+	if err != nil {
+		return errors.Errorf(errors.Codes.InsufficientFunds,
+			"Input account %v (balance: %d) does not have sufficient balance to cover maximum gas fee: %d",
+			inAcc.Address, inAcc.Balance, maxGasFee)
+	}
 	return nil
 }
 
+// Refund the part of the gas fee reserved by reserveGasFee that was not needed to pay for the gas used at the
+// effective gas price. The amount paid (the base fee and priority tip) is removed from circulation as is the Fee.
+func (ctx *CallContext) settleGasFee(caller crypto.Address, gasUsed uint64) error {
+	if ctx.BaseFee == nil || ctx.feesWaived {
+		return nil
+	}
+	gasPrice := feemarket.EffectiveGasPrice(ctx.BaseFee(), ctx.tx.GasPrice, ctx.txe.Envelope.GasTipCap(ctx.tx.GasPrice))
+	// Both are bounded by the reserved fee which did not overflow
+	refund := ctx.tx.GasLimit*ctx.tx.GasPrice - gasUsed*gasPrice
+	if refund == 0 {
+		return nil
+	}
+	payer := caller
+	if ctx.sponsor != nil {
+		payer = *ctx.sponsor
+		sponsored, err := sponsorship.Get(ctx.State, caller)
+		if err != nil {
+			return err
+		}
+		// The sponsor may have changed the sponsorship during the call
+		if sponsored != nil && sponsored.Sponsor == payer {
+			sponsored.Allowance += refund
+			err = sponsorship.Set(ctx.State, caller, sponsored)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return engine.UpdateAccount(ctx.State, payer, func(acc *acm.Account) error {
+		return acc.AddToBalance(refund)
+	})
+}
+
+func gasFee(gas, gasPrice uint64) (uint64, error) {
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(gas), new(big.Int).SetUint64(gasPrice))
+	if !fee.IsUint64() {
+		return 0, errors.Errorf(errors.Codes.IntegerOverflow, "gas fee for %d gas at price %d overflows", gas,
+			gasPrice)
+	}
+	return fee.Uint64(), nil
+}
+
 func (ctx *CallContext) CallEvents(err error) {
 	// Fire Events for sender and receiver a separate event will be fired from vm for each additional call
 	ctx.txe.Input(ctx.tx.Input.Address, errors.AsException(err))
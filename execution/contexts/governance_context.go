@@ -8,8 +8,11 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
+	"github.com/hyperledger/burrow/execution/upgrade"
 	"github.com/hyperledger/burrow/genesis/spec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
@@ -19,6 +22,8 @@ import (
 type GovernanceContext struct {
 	State        acmstate.ReaderWriter
 	ValidatorSet validator.ReaderWriter
+	GasSchedule  gas.Writer
+	Blockchain   engine.Blockchain
 	Logger       *logging.Logger
 	tx           *payload.GovTx
 	txe          *exec.TxExecution
@@ -65,6 +70,32 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload)
 		}
 		txe.GovernAccount(governAccountEvent, nil)
 	}
+
+	if ctx.tx.GasScheduleUpdate != nil {
+		err = ctx.tx.GasScheduleUpdate.Validate(ctx.Blockchain.LastBlockHeight() + 1)
+		if err != nil {
+			return fmt.Errorf("GovTx: %v", err)
+		}
+		err = ctx.GasSchedule.SetGasSchedule(ctx.tx.GasScheduleUpdate)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.tx.UpgradePlan != nil {
+		err = ctx.tx.UpgradePlan.Validate(ctx.Blockchain.LastBlockHeight() + 1)
+		if err != nil {
+			return fmt.Errorf("GovTx: %v", err)
+		}
+		err = upgrade.SetPlan(ctx.State, ctx.tx.UpgradePlan)
+		if err != nil {
+			return fmt.Errorf("GovTx: %v", err)
+		}
+		ctx.Logger.InfoMsg("Upgrade planned",
+			"upgrade", ctx.tx.UpgradePlan.Name,
+			"height", ctx.tx.UpgradePlan.Height,
+			"info", ctx.tx.UpgradePlan.Info)
+	}
 	return nil
 }
 
@@ -6,7 +6,9 @@ import (
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/unbonding"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
 )
@@ -14,8 +16,11 @@ import (
 type UnbondContext struct {
 	State        acmstate.ReaderWriter
 	ValidatorSet validator.ReaderWriter
-	Logger       *logging.Logger
-	tx           *payload.UnbondTx
+	Blockchain   engine.Blockchain
+	// Unbonded power is withdrawn to the validator's balance this many blocks after the block in which it was unbonded
+	UnbondingBlocks uint64
+	Logger          *logging.Logger
+	tx              *payload.UnbondTx
 }
 
 // Execute an UnbondTx to remove a validator
@@ -36,12 +41,25 @@ func (ctx *UnbondContext) Execute(txe *exec.TxExecution, p payload.Payload) erro
 		return err
 	}
 
-	err = account.AddToBalance(power.Uint64())
+	err = validator.SubtractPower(ctx.ValidatorSet, account.PublicKey, power)
 	if err != nil {
 		return err
 	}
 
-	err = validator.SubtractPower(ctx.ValidatorSet, account.PublicKey, power)
+	if ctx.UnbondingBlocks > 0 {
+		withdrawal := &unbonding.Withdrawal{
+			Address: account.Address,
+			Amount:  power.Uint64(),
+			Height:  ctx.Blockchain.LastBlockHeight() + 1 + ctx.UnbondingBlocks,
+		}
+		ctx.Logger.InfoMsg("Unbonded power pending withdrawal",
+			"address", withdrawal.Address,
+			"amount", withdrawal.Amount,
+			"withdrawal_height", withdrawal.Height)
+		return unbonding.Add(ctx.State, withdrawal)
+	}
+
+	err = account.AddToBalance(power.Uint64())
 	if err != nil {
 		return err
 	}
@@ -169,6 +169,50 @@ func UpdateContractMeta(st acmstate.ReaderWriter, metaSt acmstate.MetadataWriter
 	return st.UpdateAccount(acc)
 }
 
+// GetContractMeta returns the metadata entry registered for the code of the contract at address, or nil if there is
+// none
+func GetContractMeta(st acmstate.Reader, address crypto.Address) (*acm.ContractMeta, error) {
+	acc, err := st.GetAccount(address)
+	if err != nil || acc == nil || acc.CodeHash == nil {
+		return nil, err
+	}
+	codehash := acc.CodeHash
+	if acc.Forebear != nil {
+		acc, err = st.GetAccount(*acc.Forebear)
+		if err != nil || acc == nil {
+			return nil, err
+		}
+	}
+	for _, m := range acc.ContractMeta {
+		if bytes.Equal(m.CodeHash, codehash) {
+			return m, nil
+		}
+	}
+	deployCodehash := compile.GetDeployCodeHash(acc.EVMCode, address)
+	for _, m := range acc.ContractMeta {
+		if bytes.Equal(m.CodeHash, deployCodehash) {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetContractMetadata returns the metadata registered for the code of the contract at address, or an empty string if
+// there is none
+func GetContractMetadata(st acmstate.Reader, metaSt acmstate.MetadataReader, address crypto.Address) (string, error) {
+	contractMeta, err := GetContractMeta(st, address)
+	if err != nil || contractMeta == nil {
+		return "", err
+	}
+	if contractMeta.Metadata != "" {
+		// Looks like the metadata is already memoised - (e.g. by native.State)
+		return contractMeta.Metadata, nil
+	}
+	var metadataHash acmstate.MetadataHash
+	copy(metadataHash[:], contractMeta.MetadataHash)
+	return metaSt.GetMetadata(metadataHash)
+}
+
 func RemoveAccount(st acmstate.ReaderWriter, address crypto.Address) error {
 	acc, err := st.GetAccount(address)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/permission"
 )
 
@@ -13,21 +14,27 @@ var big64 = big.NewInt(64)
 // Call provides a standard wrapper for implementing Callable.Call with appropriate error handling and event firing.
 func Call(state State, params CallParams, execute func(State, CallParams) ([]byte, error)) ([]byte, error) {
 	maybe := new(errors.Maybe)
-	if params.CallType == exec.CallTypeCall || params.CallType == exec.CallTypeCode {
+	if params.CallType == exec.CallTypeCall || params.CallType == exec.CallTypeCode || params.CallType.IsCreate() {
 		// NOTE: Delegate and Static CallTypes do not transfer the value to the callee.
 		maybe.PushError(Transfer(state.CallFrame, params.Caller, params.Callee, &params.Value))
 	}
 
+	gas := new(big.Int).Set(params.Gas)
 	output := maybe.Bytes(execute(state, params))
 	// fire the post call event (including exception if applicable) and make sure we return the accumulated call error
-	maybe.PushError(FireCallEvent(state.CallFrame, maybe.Error(), state.EventSink, output, params))
+	callEvent := NewCallEvent(state.CallFrame, output, params)
+	if state.CallTree {
+		callEvent.CodeAddress = params.CodeAddress
+		callEvent.GasUsed = gas.Sub(gas, params.Gas).Uint64()
+	} else if params.CallType.IsCreate() {
+		callEvent.CallType = exec.CallTypeCall
+	}
+	maybe.PushError(state.EventSink.Call(callEvent, errors.AsException(maybe.Error())))
 	return output, maybe.Error()
 }
 
-func FireCallEvent(callFrame *CallFrame, callErr error, eventSink exec.EventSink, output []byte,
-	params CallParams) error {
-	// fire the post call event (including exception if applicable)
-	return eventSink.Call(&exec.CallEvent{
+func NewCallEvent(callFrame *CallFrame, output []byte, params CallParams) *exec.CallEvent {
+	return &exec.CallEvent{
 		CallType: params.CallType,
 		CallData: &exec.CallData{
 			Caller: params.Caller,
@@ -39,7 +46,7 @@ func FireCallEvent(callFrame *CallFrame, callErr error, eventSink exec.EventSink
 		Origin:     params.Origin,
 		StackDepth: callFrame.CallStackDepth(),
 		Return:     output,
-	}, errors.AsException(callErr))
+	}
 }
 
 func CallFromSite(st State, dispatcher Dispatcher, site CallParams, target CallParams) ([]byte, error) {
@@ -49,7 +56,7 @@ func CallFromSite(st State, dispatcher Dispatcher, site CallParams, target CallP
 	}
 	// Get the arguments from the memory
 	// EVM contract
-	err = UseGasNegative(site.Gas, GasGetAccount)
+	err = UseGasNegative(site.Gas, gas.ScheduleOrDefault(st.GasSchedule).GetAccount)
 	if err != nil {
 		return nil, err
 	}
@@ -82,9 +89,11 @@ func CallFromSite(st State, dispatcher Dispatcher, site CallParams, target CallP
 		return nil, err
 	}
 	childState := State{
-		CallFrame:  childCallFrame,
-		Blockchain: st.Blockchain,
-		EventSink:  st.EventSink,
+		CallFrame:   childCallFrame,
+		Blockchain:  st.Blockchain,
+		EventSink:   st.EventSink,
+		GasSchedule: st.GasSchedule,
+		CallTree:    st.CallTree,
 	}
 	// Ensure that gasLimit is reasonable
 	if site.Gas.Cmp(target.Gas) < 0 {
@@ -126,6 +135,7 @@ func CallFromSite(st State, dispatcher Dispatcher, site CallParams, target CallP
 		// Storage: this contract
 		// Code: from target
 
+		target.CodeAddress = &acc.Address
 		target.Caller = site.Callee
 		target.Callee = site.Callee
 
@@ -136,6 +146,7 @@ func CallFromSite(st State, dispatcher Dispatcher, site CallParams, target CallP
 		// Storage: this contract
 		// Code: from target
 
+		target.CodeAddress = &acc.Address
 		target.Caller = site.Caller
 		target.Callee = site.Callee
 
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/permission"
@@ -18,6 +19,17 @@ type CallFrame struct {
 	callStackDepth uint64
 	// Max call stack depth
 	maxCallStackDepth uint64
+	// Frame we were created from (nil at the top of the call stack)
+	parent *CallFrame
+	// Transient storage (EIP-1153) written at this level, discarded once the top-level frame completes
+	transient map[crypto.Address]map[binary.Word256]binary.Word256
+	// Whether this frame or one of its ancestors was put in read-only mode
+	readOnly bool
+	// Gas to be refunded for storage cleared at this level, discarded unless synced. It is negative if this level took
+	// back more refunds than it added.
+	refund int64
+	// Contracts created at this level during the transaction, discarded unless synced
+	created map[crypto.Address]struct{}
 }
 
 // Create a new CallFrame to hold state updates at a particular level in the call stack
@@ -38,6 +50,7 @@ func newCallFrame(st acmstate.ReaderWriter, stackDepth uint64, maxCallStackDepth
 // Put this CallFrame in permanent read-only mode
 func (st *CallFrame) ReadOnly() *CallFrame {
 	acmstate.ReadOnly(st.Cache)
+	st.readOnly = true
 	return st
 }
 
@@ -50,8 +63,11 @@ func (st *CallFrame) NewFrame(cacheOptions ...acmstate.CacheOption) (*CallFrame,
 	if st.maxCallStackDepth > 0 && st.maxCallStackDepth == st.callStackDepth {
 		return nil, errors.Codes.CallStackOverflow
 	}
-	return newCallFrame(st.Cache, st.callStackDepth+1, st.maxCallStackDepth,
-		append(st.cacheOptions, cacheOptions...)...), nil
+	frame := newCallFrame(st.Cache, st.callStackDepth+1, st.maxCallStackDepth,
+		append(st.cacheOptions, cacheOptions...)...)
+	frame.parent = st
+	frame.readOnly = st.readOnly
+	return frame, nil
 }
 
 func (st *CallFrame) Sync() error {
@@ -59,6 +75,92 @@ func (st *CallFrame) Sync() error {
 	if err != nil {
 		return errors.AsException(err)
 	}
+	if st.parent != nil {
+		for address, storage := range st.transient {
+			for key, value := range storage {
+				err = st.parent.SetTransientStorage(address, key, value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	st.transient = nil
+	if st.parent != nil {
+		st.parent.AddRefund(st.refund)
+		st.refund = 0
+		for address := range st.created {
+			st.parent.MarkCreated(address)
+		}
+		st.created = nil
+	}
+	return nil
+}
+
+// Record that the contract at address was created during this transaction, it is only visible to ancestor frames if
+// this frame is synced
+func (st *CallFrame) MarkCreated(address crypto.Address) {
+	if st.created == nil {
+		st.created = make(map[crypto.Address]struct{})
+	}
+	st.created[address] = struct{}{}
+}
+
+// Whether the contract at address has been created during this transaction as visible to this frame
+func (st *CallFrame) Created(address crypto.Address) bool {
+	for frame := st; frame != nil; frame = frame.parent {
+		if _, ok := frame.created[address]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Add gas to be refunded at the end of the transaction, or take it back if negative, it is only credited to ancestor
+// frames if this frame is synced
+func (st *CallFrame) AddRefund(gas int64) {
+	st.refund += gas
+}
+
+// Get the gas to be refunded for this frame and any synced descendants
+func (st *CallFrame) Refund() int64 {
+	return st.refund
+}
+
+// Get the value of storage at the start of the transaction, before any frame wrote to it
+func (st *CallFrame) GetOriginalStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	frame := st
+	for frame.parent != nil {
+		frame = frame.parent
+	}
+	return frame.backend.GetStorage(address, key)
+}
+
+// Get the transient storage value visible to this frame, zero if it has not been set during this transaction
+func (st *CallFrame) GetTransientStorage(address crypto.Address, key binary.Word256) binary.Word256 {
+	for frame := st; frame != nil; frame = frame.parent {
+		if value, ok := frame.transient[address][key]; ok {
+			return value
+		}
+	}
+	return binary.Zero256
+}
+
+// Set transient storage in this frame, it is only visible to ancestor frames if this frame is synced
+func (st *CallFrame) SetTransientStorage(address crypto.Address, key, value binary.Word256) error {
+	if st.readOnly {
+		return errors.Errorf(errors.Codes.IllegalWrite,
+			"TSTORE attempted on account %v in read-only call frame", address)
+	}
+	if st.transient == nil {
+		st.transient = make(map[crypto.Address]map[binary.Word256]binary.Word256)
+	}
+	storage, ok := st.transient[address]
+	if !ok {
+		storage = make(map[binary.Word256]binary.Word256)
+		st.transient[address] = storage
+	}
+	storage[key] = value
 	return nil
 }
 
@@ -23,6 +23,8 @@ type CallParams struct {
 	Input    []byte
 	Value    big.Int
 	Gas      *big.Int
+	// The address of the code run when it is not that of Callee (for CallCode and DelegateCall)
+	CodeAddress *crypto.Address
 }
 
 // Effectively a contract, but can either represent a single function or a contract with multiple functions and a selector
@@ -9,6 +9,8 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 )
 
+// Costs of the default gas schedule (see gas.DefaultSchedule), chains may set their own schedule in genesis and update
+// it through governance
 const (
 	GasSha3          uint64 = 1
 	GasGetAccount    uint64 = 1
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sync"
 
 	"github.com/hyperledger/burrow/execution/errors"
 )
@@ -53,8 +54,40 @@ func NewDynamicMemory(initialCapacity, maximumCapacity uint64, errSink errors.Si
 	}
 }
 
+// Memories of the default capacities are reused across calls since allocating their backing slice dominates the
+// cost of most calls
+var dynamicMemoryPool = sync.Pool{
+	New: func() interface{} {
+		return &dynamicMemory{
+			slice:           make([]byte, defaultInitialMemoryCapacity),
+			maximumCapacity: defaultMaximumMemoryCapacity,
+			pooled:          true,
+		}
+	},
+}
+
 func DefaultDynamicMemoryProvider(errSink errors.Sink) Memory {
-	return NewDynamicMemory(defaultInitialMemoryCapacity, defaultMaximumMemoryCapacity, errSink)
+	mem := dynamicMemoryPool.Get().(*dynamicMemory)
+	mem.errSink = errSink
+	return mem
+}
+
+// Returns memory obtained from DefaultDynamicMemoryProvider for reuse by a later call, after which it must not be
+// used. Other memories are left alone.
+func ReleaseMemory(memory Memory) {
+	mem, ok := memory.(*dynamicMemory)
+	if !ok || !mem.pooled {
+		return
+	}
+	// Reused memory must read as zeroes, which only the bytes written can fail to
+	dirty := mem.slice[:cap(mem.slice)][:mem.written]
+	for i := range dirty {
+		dirty[i] = 0
+	}
+	mem.slice = mem.slice[:defaultInitialMemoryCapacity]
+	mem.written = 0
+	mem.errSink = nil
+	dynamicMemoryPool.Put(mem)
 }
 
 // Implements a bounded dynamic memory that relies on Go's (pretty good) dynamic
@@ -63,6 +96,10 @@ type dynamicMemory struct {
 	slice           []byte
 	maximumCapacity uint64
 	errSink         errors.Sink
+	// The end of the highest write, beyond which the backing array is known to be zero
+	written uint64
+	// Whether the memory is from dynamicMemoryPool
+	pooled bool
 }
 
 func (mem *dynamicMemory) Read(offset, length *big.Int) []byte {
@@ -118,6 +155,9 @@ func (mem *dynamicMemory) write(offset uint64, value []byte) error {
 		return err
 	}
 	copy(mem.slice[offset:capacity], value)
+	if capacity > mem.written {
+		mem.written = capacity
+	}
 	return nil
 }
 
@@ -131,3 +131,31 @@ func TestDynamicMemory_ensureCapacity(t *testing.T) {
 	assert.Error(t, err, "Should not be possible to grow over capacity")
 
 }
+
+// Test pooled memory is indistinguishable from fresh memory when reused
+func TestDynamicMemory_Release(t *testing.T) {
+	maybe := new(errors.Maybe)
+	mem := DefaultDynamicMemoryProvider(maybe).(*dynamicMemory)
+	initialCapacity := mem.Capacity()
+	// Grow beyond the initial capacity
+	offset := new(big.Int).Add(initialCapacity, big.NewInt(10))
+	mem.Write(big.NewInt(3), []byte{1, 2, 3})
+	mem.Write(offset, []byte{4, 5, 6})
+	require.NoError(t, maybe.Error())
+	ReleaseMemory(mem)
+
+	for i := 0; i < 10; i++ {
+		reused := DefaultDynamicMemoryProvider(maybe)
+		assert.Equal(t, initialCapacity, reused.Capacity())
+		assert.Equal(t, make([]byte, 3), reused.Read(big.NewInt(3), big.NewInt(3)))
+		assert.Equal(t, make([]byte, 3), reused.Read(offset, big.NewInt(3)))
+		require.NoError(t, maybe.Error())
+		ReleaseMemory(reused)
+	}
+
+	// Memory not from the pool is left alone
+	fixed := NewDynamicMemory(4, 4, maybe)
+	fixed.Write(big.NewInt(0), []byte{1})
+	ReleaseMemory(fixed)
+	assert.Equal(t, []byte{1}, fixed.Read(big.NewInt(0), big.NewInt(1)))
+}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/logging"
 )
 
@@ -17,4 +18,22 @@ type Options struct {
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
 	Logger                   *logging.Logger
+	// Block height from which PUSH0, MCOPY, TLOAD, and TSTORE are enabled, they are disabled when nil
+	CancunHeight *uint64
+	// Provides the gas schedule in effect at the time of execution, the default schedule is used if nil
+	GasSchedule func() *gas.Schedule
+	// Record contract creations as such and the code address and gas used of each call in call events
+	CallTree bool
+	// Determines the gas refunded by the EVM for clearing storage, none is refunded if empty
+	GasRefunds gas.RefundPolicy
+	// Determines whether self-destructed contracts are removed, they always are if empty
+	SelfDestruct SelfDestructPolicy
+}
+
+// Returns the gas schedule in effect or nil if none has been provided
+func (options Options) Schedule() *gas.Schedule {
+	if options.GasSchedule == nil {
+		return nil
+	}
+	return options.GasSchedule()
 }
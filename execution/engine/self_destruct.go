@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+// SelfDestructPolicy determines what happens when a contract self-destructs. Since it changes the state a transaction
+// leaves behind it is part of its execution that all validators must agree on.
+type SelfDestructPolicy string
+
+const (
+	// The balance of the contract is sent to the receiver and the contract removed, as when no policy is set
+	SelfDestructLegacy SelfDestructPolicy = "legacy"
+	// As for EIP-6780, the contract is only removed if it was created in the same transaction, otherwise only its
+	// balance is sent to the receiver
+	SelfDestructEIP6780 SelfDestructPolicy = "eip6780"
+	// Self-destructing fails, so contracts can never be removed
+	SelfDestructDisabled SelfDestructPolicy = "disabled"
+)
+
+func (policy SelfDestructPolicy) Validate() error {
+	switch policy {
+	case "", SelfDestructLegacy, SelfDestructEIP6780, SelfDestructDisabled:
+		return nil
+	}
+	return fmt.Errorf("unknown self-destruct policy '%s', must be one of '%s', '%s', or '%s'", policy,
+		SelfDestructLegacy, SelfDestructEIP6780, SelfDestructDisabled)
+}
+
+// SelfDestruct sends the balance of address to receiver, which must exist, and removes address unless policy retains it
+func SelfDestruct(st *CallFrame, policy SelfDestructPolicy, address, receiver crypto.Address) error {
+	if policy == SelfDestructDisabled {
+		return errors.Errorf(errors.Codes.Generic, "self-destruct of %v is disabled on this chain", address)
+	}
+	acc, err := MustAccount(st, address)
+	if err != nil {
+		return err
+	}
+	balance := acc.Balance
+	if policy == SelfDestructEIP6780 && !st.Created(address) {
+		if receiver == address {
+			return nil
+		}
+		err = UpdateAccount(st, address, func(account *acm.Account) error {
+			return account.SubtractFromBalance(balance)
+		})
+		if err != nil {
+			return err
+		}
+		return UpdateAccount(st, receiver, func(account *acm.Account) error {
+			return account.AddToBalance(balance)
+		})
+	}
+	err = UpdateAccount(st, receiver, func(account *acm.Account) error {
+		return account.AddToBalance(balance)
+	})
+	if err != nil {
+		return err
+	}
+	return RemoveAccount(st, address)
+}
@@ -2,10 +2,15 @@ package engine
 
 import (
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
 )
 
 type State struct {
 	*CallFrame
 	Blockchain
 	exec.EventSink
+	// The gas schedule for the execution, the default schedule is used if nil
+	GasSchedule *gas.Schedule
+	// Whether call events record contract creations as such along with the code address and gas used of each call
+	CallTree bool
 }
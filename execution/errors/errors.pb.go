@@ -27,8 +27,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Exception struct {
-	CodeNumber           uint32   `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Exception            string   `protobuf:"bytes,2,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	CodeNumber uint32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Exception  string `protobuf:"bytes,2,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	// The decoded revert payload when execution was reverted. It is decoded when an exception is returned over RPC and
+	// is not part of the recorded execution.
+	Revert               *Revert  `protobuf:"bytes,3,opt,name=Revert,proto3" json:"Revert,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -76,31 +79,120 @@ func (m *Exception) GetException() string {
 	return ""
 }
 
+func (m *Exception) GetRevert() *Revert {
+	if m != nil {
+		return m.Revert
+	}
+	return nil
+}
+
 func (*Exception) XXX_MessageName() string {
 	return "errors.Exception"
 }
+
+// The payload of a revert decoded as a reason string, a panic code, or a custom error
+type Revert struct {
+	// Error for a reason string, Panic for a failed assertion, or otherwise the name of a custom error
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The reason string or a description of the panic code
+	Reason string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// The panic code
+	PanicCode uint64 `protobuf:"varint,3,opt,name=PanicCode,proto3" json:"PanicCode,omitempty"`
+	// The arguments of a custom error
+	Args                 []string `protobuf:"bytes,4,rep,name=Args,proto3" json:"Args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Revert) Reset()      { *m = Revert{} }
+func (*Revert) ProtoMessage() {}
+func (*Revert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24fe73c7f0ddb19c, []int{1}
+}
+func (m *Revert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Revert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Revert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revert.Merge(m, src)
+}
+func (m *Revert) XXX_Size() int {
+	return m.Size()
+}
+func (m *Revert) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revert proto.InternalMessageInfo
+
+func (m *Revert) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Revert) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Revert) GetPanicCode() uint64 {
+	if m != nil {
+		return m.PanicCode
+	}
+	return 0
+}
+
+func (m *Revert) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (*Revert) XXX_MessageName() string {
+	return "errors.Revert"
+}
 func init() {
 	proto.RegisterType((*Exception)(nil), "errors.Exception")
 	golang_proto.RegisterType((*Exception)(nil), "errors.Exception")
+	proto.RegisterType((*Revert)(nil), "errors.Revert")
+	golang_proto.RegisterType((*Revert)(nil), "errors.Revert")
 }
 
 func init() { proto.RegisterFile("errors.proto", fileDescriptor_24fe73c7f0ddb19c) }
 func init() { golang_proto.RegisterFile("errors.proto", fileDescriptor_24fe73c7f0ddb19c) }
 
 var fileDescriptor_24fe73c7f0ddb19c = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0x2d, 0x2a, 0xca,
-	0x2f, 0x2a, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0, 0xa4, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x42, 0xfa, 0x20, 0x16, 0x44, 0x56, 0x29, 0x98, 0x8b, 0xd3, 0xb5, 0x22, 0x39,
-	0xb5, 0xa0, 0x24, 0x33, 0x3f, 0x4f, 0x48, 0x89, 0x8b, 0xc5, 0x39, 0x3f, 0x25, 0x55, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xd7, 0x89, 0xef, 0xd1, 0x3d, 0x79, 0x2e, 0x10, 0xdf, 0xaf, 0x34, 0x37, 0x29,
-	0xb5, 0x28, 0x08, 0x2c, 0x27, 0x24, 0x83, 0xa4, 0x41, 0x82, 0x49, 0x81, 0x51, 0x83, 0x33, 0x08,
-	0x21, 0x60, 0xc5, 0x32, 0x63, 0x81, 0x3c, 0x83, 0x93, 0xc7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0xde, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x81, 0xc7, 0x72, 0x8c, 0x27,
-	0x1e, 0xcb, 0x31, 0x46, 0xe9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x67, 0x54, 0x16, 0xa4, 0x16, 0xe5, 0xa4, 0xa6, 0xa4, 0xa7, 0x16, 0xe9, 0x27, 0x95, 0x16, 0x15,
-	0xe5, 0x97, 0xeb, 0xa7, 0x56, 0xa4, 0x26, 0x97, 0x82, 0x0c, 0xd2, 0x87, 0x38, 0x3a, 0x89, 0x0d,
-	0xec, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x6d, 0xcb, 0x28, 0xd3, 0x00, 0x00,
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x50, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0xf6, 0xd9, 0x10, 0xc8, 0xa9, 0x1d, 0x0e, 0x91, 0x20, 0x72, 0x0d, 0x19, 0x24, 0x53, 0x02,
+	0xba, 0xb9, 0x59, 0x11, 0x9c, 0x8a, 0xdc, 0xe8, 0x96, 0xa4, 0x8f, 0x34, 0xd0, 0xf4, 0xc2, 0x4b,
+	0xa2, 0x15, 0xff, 0x11, 0x47, 0xff, 0x14, 0xc7, 0x8e, 0x8e, 0x4e, 0x22, 0xe9, 0x3f, 0x22, 0x77,
+	0x17, 0xb5, 0xdb, 0xf7, 0x23, 0xf9, 0xbe, 0xef, 0x1d, 0x3b, 0x44, 0x22, 0x45, 0x4d, 0x5c, 0x93,
+	0x6a, 0x15, 0x77, 0x2d, 0x3b, 0x3d, 0x2e, 0x54, 0xa1, 0x8c, 0x94, 0x68, 0x64, 0xdd, 0xf0, 0x85,
+	0x79, 0xb7, 0xeb, 0x1c, 0xeb, 0xb6, 0x54, 0x2b, 0x1e, 0x32, 0xe7, 0x46, 0xcd, 0xd1, 0x87, 0x00,
+	0xa2, 0xa3, 0xe9, 0xb8, 0xff, 0x9a, 0x30, 0xcd, 0x67, 0x5d, 0x95, 0x21, 0x49, 0xe3, 0xf1, 0xb3,
+	0x9d, 0x1f, 0xfc, 0xfd, 0x00, 0x22, 0x4f, 0xee, 0x24, 0x9c, 0x33, 0x57, 0xe2, 0x23, 0x52, 0xeb,
+	0x8f, 0x02, 0x88, 0x0e, 0x2e, 0xc6, 0xf1, 0xb0, 0xc5, 0xaa, 0x72, 0x70, 0xaf, 0x9c, 0xd7, 0xb7,
+	0xc9, 0x5e, 0xb8, 0xfc, 0xfd, 0x9a, 0x73, 0xe6, 0xcc, 0xd2, 0xca, 0x36, 0x7b, 0xd2, 0x60, 0x7e,
+	0xa2, 0xdd, 0xb4, 0xf9, 0xab, 0x19, 0x98, 0x5e, 0x70, 0x9f, 0xae, 0xca, 0xdc, 0x4c, 0xd5, 0x35,
+	0x8e, 0xfc, 0x17, 0x74, 0xd2, 0x35, 0x15, 0x8d, 0xef, 0x04, 0x23, 0x9d, 0xa4, 0xb1, 0x6d, 0x9b,
+	0xde, 0x6d, 0x7a, 0x01, 0x1f, 0xbd, 0x80, 0xcf, 0x5e, 0xc0, 0x77, 0x2f, 0xe0, 0x7d, 0x2b, 0x60,
+	0xb3, 0x15, 0xf0, 0x10, 0x17, 0x65, 0xbb, 0xe8, 0xb2, 0x38, 0x57, 0x55, 0xb2, 0x78, 0xae, 0x91,
+	0x96, 0x38, 0x2f, 0x90, 0x92, 0xac, 0x23, 0x52, 0x4f, 0x09, 0xae, 0x31, 0xef, 0xf4, 0x79, 0x89,
+	0x3d, 0x26, 0x73, 0xcd, 0xdb, 0x5d, 0xfe, 0x0c, 0x00, 0xea, 0xab, 0xbc, 0x55, 0x69, 0x01, 0x00,
 	0x00,
 }
 
@@ -128,6 +220,18 @@ func (m *Exception) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Revert != nil {
+		{
+			size, err := m.Revert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintErrors(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Exception) > 0 {
 		i -= len(m.Exception)
 		copy(dAtA[i:], m.Exception)
@@ -143,6 +247,61 @@ func (m *Exception) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Revert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Revert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Revert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintErrors(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PanicCode != 0 {
+		i = encodeVarintErrors(dAtA, i, uint64(m.PanicCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintErrors(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintErrors(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintErrors(dAtA []byte, offset int, v uint64) int {
 	offset -= sovErrors(v)
 	base := offset
@@ -167,6 +326,39 @@ func (m *Exception) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovErrors(uint64(l))
 	}
+	if m.Revert != nil {
+		l = m.Revert.Size()
+		n += 1 + l + sovErrors(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Revert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovErrors(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovErrors(uint64(l))
+	}
+	if m.PanicCode != 0 {
+		n += 1 + sovErrors(uint64(m.PanicCode))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovErrors(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -259,6 +451,208 @@ func (m *Exception) Unmarshal(dAtA []byte) error {
 			}
 			m.Exception = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErrors
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Revert == nil {
+				m.Revert = &Revert{}
+			}
+			if err := m.Revert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErrors(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErrors
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Revert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErrors
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Revert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Revert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PanicCode", wireType)
+			}
+			m.PanicCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PanicCode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErrors(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func NewException(code *Code, exception string) *Exception {
 	if exception == "" {
@@ -79,3 +82,17 @@ func (e *Exception) Equal(ce CodedError) bool {
 func (e *Exception) GetCode() *Code {
 	return Codes.Get(e.CodeNumber)
 }
+
+func (r *Revert) String() string {
+	if r == nil {
+		return ""
+	}
+	switch r.Name {
+	case "Error":
+		return fmt.Sprintf("%s: %s", r.Name, r.Reason)
+	case "Panic":
+		return fmt.Sprintf("%s(0x%x): %s", r.Name, r.PanicCode, r.Reason)
+	default:
+		return fmt.Sprintf("%s(%s)", r.Name, strings.Join(r.Args, ", "))
+	}
+}
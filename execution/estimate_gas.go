@@ -0,0 +1,163 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// The most gas EstimateGas will allow a call when the call does not give a gas limit
+const EstimateGasCap = uint64(100000000)
+
+// EstimateGas stops searching once the estimate is within this many parts per thousand of the least gas limit with
+// which the call succeeds, erring above it
+const estimateGasErrorRatio = 15
+
+// Changes to make to an account before estimating gas, each field that is set replaces that of the account
+type StateOverride struct {
+	Balance  *uint64
+	Sequence *uint64
+	EVMCode  acm.Bytecode
+	// Replaces all of the storage of the account
+	Storage map[binary.Word256][]byte
+	// Replaces only the storage keys given
+	StorageDiff map[binary.Word256][]byte
+}
+
+// EstimateGas returns the least gas limit with which tx succeeds to within estimateGasErrorRatio, found by binary search
+// over calls simulated on reader with any overrides applied. Since gas that is refunded, or withheld from a sub-call by
+// the 63/64ths rule, is needed by a call but not counted in the gas it uses the estimate may be well above the gas
+// used. If tx fails with the most gas it can be given the estimate is zero and the failed execution is returned so that
+// its exception can be reported.
+func EstimateGas(reader acmstate.Reader, blockchain bcm.BlockchainInfo, tx *payload.CallTx,
+	overrides map[crypto.Address]*StateOverride, logger *logging.Logger) (uint64, *exec.TxExecution, error) {
+	if tx.Input == nil {
+		return 0, nil, fmt.Errorf("EstimateGas requires a non-nil input")
+	}
+	st, err := overrideState(reader, overrides)
+	if err != nil {
+		return 0, nil, err
+	}
+	schedule := simulatedGasSchedule(reader, blockchain)
+	metadataState := acmstate.NewMemoryState()
+	call := func(gasLimit uint64) (*exec.TxExecution, error) {
+		callTx := *tx
+		callTx.GasLimit = gasLimit
+		return callSim(acmstate.NewCache(st), metadataState, blockchain, schedule, nil, &callTx, logger)
+	}
+	succeeds := func(gasLimit uint64) (bool, error) {
+		txe, err := call(gasLimit)
+		if err != nil {
+			return false, err
+		}
+		return txe.Exception == nil, nil
+	}
+
+	hi := tx.GasLimit
+	if hi == 0 {
+		hi = EstimateGasCap
+	}
+	txe, err := call(hi)
+	if err != nil || txe.Exception != nil {
+		return 0, txe, err
+	}
+	// The call cannot succeed with less than the gas it used
+	gasUsed := txe.Result.GasUsed
+	lo := gasUsed
+	if lo > 0 {
+		lo--
+	}
+	// Most calls need little more than the gas they use so first try the gas used plus that withheld from a sub-call
+	if guess := gasUsed + gasUsed/63; guess > lo && guess < hi {
+		ok, err := succeeds(guess)
+		if err != nil {
+			return 0, nil, err
+		}
+		if ok {
+			hi = guess
+		} else {
+			lo = guess
+		}
+	}
+	for lo+1 < hi && (hi-lo)*1000 > hi*estimateGasErrorRatio {
+		mid := lo + (hi-lo)/2
+		ok, err := succeeds(mid)
+		if err != nil {
+			return 0, nil, err
+		}
+		if ok {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil, nil
+}
+
+// Accounts whose storage is replaced by an override so should not be read from the underlying state
+type storageOverriddenReader struct {
+	acmstate.Reader
+	overridden map[crypto.Address]bool
+}
+
+func (r *storageOverriddenReader) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	if r.overridden[address] {
+		return nil, nil
+	}
+	return r.Reader.GetStorage(address, key)
+}
+
+func overrideState(reader acmstate.Reader, overrides map[crypto.Address]*StateOverride) (acmstate.Reader, error) {
+	if len(overrides) == 0 {
+		return reader, nil
+	}
+	overridden := make(map[crypto.Address]bool)
+	for address, override := range overrides {
+		if override.Storage != nil {
+			if override.StorageDiff != nil {
+				return nil, fmt.Errorf("cannot override both all storage and some storage of %v", address)
+			}
+			overridden[address] = true
+		}
+	}
+	cache := acmstate.NewCache(&storageOverriddenReader{Reader: reader, overridden: overridden})
+	for address, override := range overrides {
+		acc, err := cache.GetAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		if acc == nil {
+			acc = &acm.Account{Address: address}
+		}
+		if override.Balance != nil {
+			acc.Balance = *override.Balance
+		}
+		if override.Sequence != nil {
+			acc.Sequence = *override.Sequence
+		}
+		if override.EVMCode != nil {
+			acc.EVMCode = override.EVMCode
+			acc.CodeHash = crypto.Keccak256(override.EVMCode)
+		}
+		err = cache.UpdateAccount(acc)
+		if err != nil {
+			return nil, err
+		}
+		for _, storage := range []map[binary.Word256][]byte{override.Storage, override.StorageDiff} {
+			for key, value := range storage {
+				err = cache.SetStorage(address, key, value)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return cache, nil
+}
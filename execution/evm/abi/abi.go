@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"reflect"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/logging"
@@ -246,6 +247,10 @@ func typeFromReflect(v reflect.Type) Argument {
 		arg.EVM = EVMAddress{}
 	} else if v == reflect.TypeOf(big.Int{}) {
 		arg.EVM = EVMInt{M: 256}
+	} else if v == reflect.TypeOf(binary.Word256{}) {
+		arg.EVM = EVMBytes{M: binary.Word256Bytes}
+	} else if v == reflect.TypeOf([]byte{}) {
+		arg.EVM = EVMBytes{}
 	} else {
 		if v.Kind() == reflect.Array {
 			arg.IsArray = true
@@ -2,6 +2,8 @@ package abi
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"golang.org/x/crypto/sha3"
 )
@@ -30,6 +32,59 @@ func NewFunctionSpec(name string, inputs, outputs []Argument) *FunctionSpec {
 	}
 }
 
+var (
+	signatureRegex = regexp.MustCompile(`^\s*([A-Za-z_$][A-Za-z0-9_$]*)\s*\(([^()]*)\)\s*(?:\(([^()]*)\))?\s*$`)
+	arraySuffix    = regexp.MustCompile(`(\[[0-9]*\])+$`)
+)
+
+// ParseFunctionSignature reads a function from its signature, such as transfer(address,uint256), optionally followed
+// by the types it returns, as in balanceOf(address owner)(uint256). Parameters may be named. Tuples are not supported.
+func ParseFunctionSignature(signature string) (*FunctionSpec, error) {
+	m := signatureRegex.FindStringSubmatch(signature)
+	if m == nil {
+		return nil, fmt.Errorf("could not parse function signature '%s', expected name(type,...) "+
+			"or name(type,...)(type,...)", signature)
+	}
+	inputs, err := parseSignatureArgs(m[2])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse inputs of function signature '%s': %w", signature, err)
+	}
+	outputs, err := parseSignatureArgs(m[3])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse outputs of function signature '%s': %w", signature, err)
+	}
+	return NewFunctionSpec(m[1], inputs, outputs), nil
+}
+
+func parseSignatureArgs(list string) ([]Argument, error) {
+	var argsJ []argumentJSON
+	if strings.TrimSpace(list) != "" {
+		for _, param := range strings.Split(list, ",") {
+			fields := strings.Fields(param)
+			switch len(fields) {
+			case 1:
+				argsJ = append(argsJ, argumentJSON{Type: fields[0]})
+			case 2:
+				argsJ = append(argsJ, argumentJSON{Type: fields[0], Name: fields[1]})
+			default:
+				return nil, fmt.Errorf("expected a type optionally followed by a name but got '%s'", param)
+			}
+		}
+	}
+	args, err := readArgSpec(argsJ)
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		// readArgSpec takes any type it does not know to be a contract
+		baseType := arraySuffix.ReplaceAllString(argsJ[i].Type, "")
+		if _, ok := arg.EVM.(EVMAddress); ok && baseType != "address" {
+			return nil, fmt.Errorf("unknown type %s", argsJ[i].Type)
+		}
+	}
+	return args, nil
+}
+
 func GetFunctionID(signature string) (id FunctionID) {
 	hash := sha3.NewLegacyKeccak256()
 	hash.Write([]byte(signature))
@@ -0,0 +1,38 @@
+package abi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestParseFunctionSignature(t *testing.T) {
+	fspec, err := ParseFunctionSignature("transfer(address,uint256)")
+	require.NoError(t, err)
+	assert.Equal(t, "transfer", fspec.Name)
+	assert.Equal(t, "a9059cbb", hex.EncodeToString(fspec.FunctionID.Bytes()))
+	assert.Len(t, fspec.Inputs, 2)
+	assert.Empty(t, fspec.Outputs)
+
+	fspec, err = ParseFunctionSignature("balanceOf(address owner) (uint)")
+	require.NoError(t, err)
+	assert.Equal(t, GetFunctionID("balanceOf(address)"), fspec.FunctionID)
+	assert.Equal(t, "owner", fspec.Inputs[0].Name)
+	assert.Equal(t, []Argument{{EVM: EVMUint{M: 256}}}, fspec.Outputs)
+
+	fspec, err = ParseFunctionSignature("set(bytes32[],uint8[3],string)")
+	require.NoError(t, err)
+	assert.Equal(t, "set(bytes32[],uint8[3],string)", Signature(fspec.Name, fspec.Inputs))
+
+	fspec, err = ParseFunctionSignature("poke()")
+	require.NoError(t, err)
+	assert.Empty(t, fspec.Inputs)
+
+	for _, signature := range []string{"poke", "poke(uint256", "poke((uint256,bool))", "poke(adress)", "poke(uint7)",
+		"poke(uint256 a b)"} {
+		_, err = ParseFunctionSignature(signature)
+		assert.Error(t, err, signature)
+	}
+}
@@ -2,6 +2,7 @@ package abi
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strings"
 
@@ -80,7 +81,8 @@ func argGetter(argSpec []Argument, args []interface{}, ptr bool) (func(int) inte
 		} else if ptr {
 			return nil, fmt.Errorf("struct pointer required in order to set values, but got %v", rv.Kind())
 		}
-		if rv.Kind() != reflect.Struct {
+		// A big.Int is a struct but is a single argument rather than a struct of them
+		if rv.Kind() != reflect.Struct || rv.Type() == reflect.TypeOf(big.Int{}) {
 			if len(args) == 1 {
 				// Treat s single arg
 				return func(i int) interface{} { return args[i] }, nil
@@ -348,7 +350,7 @@ func unpack(argSpec []Argument, data []byte, getArg func(int) interface{}) error
 					o += int64(l)
 				}
 
-				array = &intermediate
+				*array = intermediate
 			}
 
 			// If we were supposed to return a string, convert it back
@@ -97,3 +97,24 @@ func splat(v interface{}) []interface{} {
 
 	return vals
 }
+
+func TestPackBigInt(t *testing.T) {
+	spec, err := ReadSpec([]byte(`[{"type":"function","name":"set","inputs":[{"name":"x","type":"uint256"}],
+		"outputs":[{"name":"","type":"uint256"}]}]`))
+	require.NoError(t, err)
+	data, _, err := spec.Pack("set", big.NewInt(5))
+	require.NoError(t, err)
+	out := new(big.Int)
+	require.NoError(t, spec.Unpack(data[FunctionIDSize:], "set", out))
+	assert.Equal(t, int64(5), out.Int64())
+}
+
+func TestUnpackDynamicArray(t *testing.T) {
+	args := []Argument{{EVM: EVMUint{M: 64}, IsArray: true}}
+	data, err := Pack(args, []uint64{1, 2, 3})
+	require.NoError(t, err)
+	var out []interface{}
+	require.NoError(t, Unpack(args, data, &out))
+	require.Len(t, out, 3)
+	assert.Equal(t, uint64(3), *out[2].(*uint64))
+}
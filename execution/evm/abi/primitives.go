@@ -143,6 +143,10 @@ func (e EVMUint) getGoType() interface{} {
 func (e EVMUint) pack(v interface{}) ([]byte, error) {
 	n := new(big.Int)
 
+	if b, ok := v.(*big.Int); ok {
+		// Parsed as a string below
+		v = b.String()
+	}
 	arg := reflect.ValueOf(v)
 	switch arg.Kind() {
 	case reflect.String:
@@ -587,14 +591,20 @@ func (e EVMBytes) getGoType() interface{} {
 }
 
 func (e EVMBytes) pack(v interface{}) ([]byte, error) {
-	b, ok := v.([]byte)
-	if !ok {
-		s, ok := v.(string)
-		if ok {
-			b = []byte(s)
-		} else {
-			return nil, fmt.Errorf("cannot map from %s to EVM bytes", reflect.ValueOf(v).Kind().String())
+	var b []byte
+	switch x := v.(type) {
+	case []byte:
+		b = x
+	case string:
+		b = []byte(x)
+	default:
+		// Fixed size byte arrays such as binary.Word256
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Array || rv.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, fmt.Errorf("cannot map from %s to EVM bytes", rv.Kind().String())
 		}
+		b = make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
 	}
 
 	if e.M > 0 {
@@ -644,7 +654,7 @@ func (e EVMBytes) unpack(data []byte, offset int, v interface{}) (int, error) {
 		}
 		v2.SetString(string(data[offset+start : offset+end]))
 	case reflect.Array:
-		fallthrough
+		reflect.Copy(v2, reflect.ValueOf(data[offset:offset+int(e.M)]))
 	case reflect.Slice:
 		v2.SetBytes(data[offset : offset+int(e.M)])
 	default:
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,3 +21,17 @@ func TestEVMInt(t *testing.T) {
 		assert.Equal(t, bOut, b)
 	})
 }
+
+func TestEVMBytes(t *testing.T) {
+	t.Run("pack byte array", func(t *testing.T) {
+		e := EVMBytes{M: 32}
+		w := binary.LeftPadWord256([]byte{1, 2, 3})
+		data, err := e.pack(w)
+		require.NoError(t, err)
+		assert.Equal(t, w.Bytes(), data)
+		var wOut binary.Word256
+		_, err = e.unpack(data, 0, &wOut)
+		require.NoError(t, err)
+		assert.Equal(t, w, wOut)
+	})
+}
@@ -0,0 +1,87 @@
+package abi
+
+import (
+	"strconv"
+
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+const (
+	RevertErrorName = "Error"
+	RevertPanicName = "Panic"
+)
+
+var (
+	// Error(string) is given to revert by revert("reason") and require(condition, "reason")
+	revertErrorSpec = NewFunctionSpec(RevertErrorName, []Argument{{EVM: EVMString{}}}, nil)
+	// Panic(uint256) is given to revert by failed assertions and checks inserted by the Solidity compiler
+	revertPanicSpec = NewFunctionSpec(RevertPanicName, []Argument{{EVM: EVMUint{M: 256}}}, nil)
+)
+
+// The panic codes used by Solidity, see https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "conversion to an invalid enum value",
+	0x22: "incorrectly encoded storage byte array",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to a zero-initialised internal function",
+}
+
+// DecodeRevert decodes the data given to revert as an Error(string) reason, a Panic(uint256) code, or one of the custom
+// errors of specs. Returns nil if there is no data or it cannot be decoded.
+func DecodeRevert(data []byte, specs ...*Spec) *errors.Revert {
+	if len(data) < FunctionIDSize {
+		return nil
+	}
+	var id FunctionID
+	copy(id[:], data)
+	errSpec := revertErrorSpec
+	switch id {
+	case revertErrorSpec.FunctionID:
+	case revertPanicSpec.FunctionID:
+		errSpec = revertPanicSpec
+	default:
+		errSpec = nil
+		for _, spec := range specs {
+			if spec != nil && spec.ErrorsByID[id] != nil {
+				errSpec = spec.ErrorsByID[id]
+				break
+			}
+		}
+		if errSpec == nil {
+			return nil
+		}
+	}
+	args := make([]interface{}, len(errSpec.Inputs))
+	for i := range args {
+		args[i] = new(string)
+	}
+	err := Unpack(errSpec.Inputs, data[FunctionIDSize:], args...)
+	if err != nil {
+		return nil
+	}
+	revert := &errors.Revert{
+		Name: errSpec.Name,
+	}
+	switch errSpec {
+	case revertErrorSpec:
+		revert.Reason = *args[0].(*string)
+	case revertPanicSpec:
+		revert.PanicCode, err = strconv.ParseUint(*args[0].(*string), 10, 64)
+		if err != nil {
+			return nil
+		}
+		revert.Reason = panicReasons[revert.PanicCode]
+	default:
+		revert.Args = make([]string, len(args))
+		for i, arg := range args {
+			revert.Args[i] = *arg.(*string)
+		}
+	}
+	return revert
+}
@@ -0,0 +1,47 @@
+package abi
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRevert(t *testing.T) {
+	t.Run("Error", func(t *testing.T) {
+		data, err := Pack(revertErrorSpec.Inputs, "not enough tokens")
+		require.NoError(t, err)
+		revert := DecodeRevert(append(revertErrorSpec.FunctionID.Bytes(), data...))
+		assert.Equal(t, &errors.Revert{Name: "Error", Reason: "not enough tokens"}, revert)
+		assert.Equal(t, "Error: not enough tokens", revert.String())
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		data, err := Pack(revertPanicSpec.Inputs, 0x11)
+		require.NoError(t, err)
+		revert := DecodeRevert(append(revertPanicSpec.FunctionID.Bytes(), data...))
+		assert.Equal(t, &errors.Revert{Name: "Panic", PanicCode: 0x11, Reason: "arithmetic overflow or underflow"}, revert)
+		assert.Equal(t, "Panic(0x11): arithmetic overflow or underflow", revert.String())
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		spec, err := ReadSpec([]byte(`[{"type":"error","name":"InsufficientBalance","inputs":[` +
+			`{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`))
+		require.NoError(t, err)
+		errSpec := spec.ErrorsByID[GetFunctionID("InsufficientBalance(uint256,uint256)")]
+		require.NotNil(t, errSpec)
+		data, err := Pack(errSpec.Inputs, 3, 10)
+		require.NoError(t, err)
+		data = append(errSpec.FunctionID.Bytes(), data...)
+		// Cannot be decoded without the ABI
+		assert.Nil(t, DecodeRevert(data))
+		revert := DecodeRevert(data, spec)
+		assert.Equal(t, &errors.Revert{Name: "InsufficientBalance", Args: []string{"3", "10"}}, revert)
+		assert.Equal(t, "InsufficientBalance(3, 10)", revert.String())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.Nil(t, DecodeRevert(nil))
+	})
+}
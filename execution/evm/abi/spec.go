@@ -20,6 +20,8 @@ type Spec struct {
 	Functions    map[string]*FunctionSpec
 	EventsByName map[string]*EventSpec
 	EventsByID   map[EventID]*EventSpec
+	// Custom errors that may be given to revert
+	ErrorsByID map[FunctionID]*FunctionSpec
 }
 
 type specJSON struct {
@@ -39,6 +41,7 @@ func NewSpec() *Spec {
 		EventsByName: make(map[string]*EventSpec),
 		EventsByID:   make(map[EventID]*EventSpec),
 		Functions:    make(map[string]*FunctionSpec),
+		ErrorsByID:   make(map[FunctionID]*FunctionSpec),
 	}
 }
 
@@ -90,6 +93,13 @@ func ReadSpec(specBytes []byte) (*Spec, error) {
 				return nil, err
 			}
 			abiSpec.Functions[s.Name] = NewFunctionSpec(s.Name, inputs, outputs).SetConstant()
+		case "error":
+			inputs, err := readArgSpec(s.Inputs)
+			if err != nil {
+				return nil, err
+			}
+			errSpec := NewFunctionSpec(s.Name, inputs, nil)
+			abiSpec.ErrorsByID[errSpec.FunctionID] = errSpec
 		}
 	}
 
@@ -113,6 +123,10 @@ func MergeSpec(abiSpec []*Spec) *Spec {
 			newSpec.EventsByName[e.Name] = e
 			newSpec.EventsByID[e.ID] = e
 		}
+
+		for id, e := range s.ErrorsByID {
+			newSpec.ErrorsByID[id] = e
+		}
 	}
 
 	return newSpec
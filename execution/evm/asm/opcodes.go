@@ -90,6 +90,10 @@ const (
 	MSIZE
 	GAS
 	JUMPDEST
+	TLOAD
+	TSTORE
+	MCOPY
+	PUSH0
 )
 
 const (
@@ -258,6 +262,10 @@ var opCodeNames = map[OpCode]string{
 	MSIZE:    "MSIZE",
 	GAS:      "GAS",
 	JUMPDEST: "JUMPDEST",
+	TLOAD:    "TLOAD",
+	TSTORE:   "TSTORE",
+	MCOPY:    "MCOPY",
+	PUSH0:    "PUSH0",
 
 	// 0x60 range - push
 	PUSH1:  "PUSH1",
@@ -1,11 +1,48 @@
 package evm
 
 import (
+	"bytes"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/execution/evm/asm"
 	"github.com/tmthrgd/go-bitset"
 )
 
+// The number of contracts whose analysis is kept by codeCache
+const codeCacheSize = 1024
+
+// Analysed code keyed by code hash, shared by all EVMs since the same contracts tend to be called over and over again
+var codeCache, _ = lru.New(codeCacheSize)
+
+// Counts of codeCache use accessed atomically
+var codeCacheHits, codeCacheMisses uint64
+
+// CodeCacheStats describes the cache of analysed code shared by all EVMs
+type CodeCacheStats struct {
+	// The number of contracts whose analysis is cached
+	Contracts int
+	// The number of calls that found the analysis of their code cached
+	Hits uint64
+	// The number of calls that analysed their code
+	Misses uint64
+}
+
+// SetCodeCacheSize sets the number of contracts whose analysed code is kept for all EVMs, 1024 by default
+func SetCodeCacheSize(size int) {
+	codeCache.Resize(size)
+}
+
+// GetCodeCacheStats returns the size of the code cache and how often it has been used since the process started
+func GetCodeCacheStats() CodeCacheStats {
+	return CodeCacheStats{
+		Contracts: codeCache.Len(),
+		Hits:      atomic.LoadUint64(&codeCacheHits),
+		Misses:    atomic.LoadUint64(&codeCacheMisses),
+	}
+}
+
 type Code struct {
 	Bytecode     acm.Bytecode
 	OpcodeBitset bitset.Bitset
@@ -19,6 +56,25 @@ func NewCode(code []byte) *Code {
 	}
 }
 
+// Returns a Code for code as NewCode does, reusing any previous analysis of code with codeHash
+func cachedCode(code, codeHash []byte) *Code {
+	if len(codeHash) == 0 {
+		return NewCode(code)
+	}
+	if cached, ok := codeCache.Get(string(codeHash)); ok {
+		// Do not trust the code hash of an account to have been kept in step with its code
+		if c := cached.(*Code); bytes.Equal(c.Bytecode, code) {
+			atomic.AddUint64(&codeCacheHits, 1)
+			return c
+		}
+	}
+	atomic.AddUint64(&codeCacheMisses, 1)
+	// Take a copy since the analysis is shared
+	c := NewCode(append([]byte(nil), code...))
+	codeCache.Add(string(codeHash), c)
+	return c
+}
+
 func (code *Code) Length() uint64 {
 	if code == nil {
 		return 0
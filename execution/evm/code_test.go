@@ -76,6 +76,24 @@ func TestCode_IsOpcodeAt(t *testing.T) {
 	assert.False(t, code.IsOpcode(3))
 }
 
+func TestCachedCode(t *testing.T) {
+	code := bc.MustSplice(asm.PUSH2, 2, 3)
+	codeHash := []byte("TestCachedCode")
+	stats := GetCodeCacheStats()
+	cached := cachedCode(code, codeHash)
+	assert.Equal(t, NewCode(code), cached)
+	assert.Same(t, cached, cachedCode(code, codeHash))
+	assert.Equal(t, stats.Hits+1, GetCodeCacheStats().Hits)
+	assert.Equal(t, stats.Misses+1, GetCodeCacheStats().Misses)
+	// The cached analysis is not affected by changes to the code it was made from
+	code[0] = byte(asm.ADD)
+	other := cachedCode(code, codeHash)
+	assert.NotSame(t, cached, other)
+	assert.True(t, other.IsOpcode(1))
+	// Code without a hash is never cached
+	assert.NotSame(t, cachedCode(code, nil), cachedCode(code, nil))
+}
+
 func mkBitset(binaryString string) bitset.Bitset {
 	length := uint(len(binaryString))
 	bs := bitset.New(length)
@@ -17,6 +17,7 @@ import (
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 )
@@ -27,7 +28,15 @@ type Contract struct {
 }
 
 func (c *Contract) Call(state engine.State, params engine.CallParams) ([]byte, error) {
-	return engine.Call(state, params, c.execute)
+	if c.tracer == nil {
+		return engine.Call(state, params, c.execute)
+	}
+	depth := state.CallFrame.CallStackDepth()
+	gas := new(big.Int).Set(params.Gas)
+	c.tracer.CaptureEnter(depth, params, c.GetBytecode())
+	output, err := engine.Call(state, params, c.execute)
+	c.tracer.CaptureExit(depth, output, gas.Sub(gas, params.Gas), err)
+	return output, err
 }
 
 // Executes the EVM code passed in the appropriate context
@@ -53,8 +62,12 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 	maybe := new(errors.Maybe)
 
 	// Provide stack and memory storage - passing in the callState as an error provider
-	stack := NewStack(maybe, c.options.DataStackInitialCapacity, c.options.DataStackMaxDepth, params.Gas)
+	schedule := gas.ScheduleOrDefault(st.GasSchedule)
+	stack := getStack(maybe, c.options.DataStackInitialCapacity, c.options.DataStackMaxDepth, params.Gas)
+	stack.opGas = schedule.StackOp
+	defer stack.release()
 	memory := c.options.MemoryProvider(maybe)
+	defer engine.ReleaseMemory(memory)
 
 	for {
 		// Check for any error in this frame.
@@ -64,8 +77,17 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		var op = c.GetSymbol(pc)
 		c.debugf("(pc) %-3d (op) %-14s (st) %-4d (gas) %d", pc, op.String(), stack.Len(), params.Gas)
+		if c.tracer != nil {
+			c.tracer.CaptureStep(st.CallFrame.CallStackDepth(), pc, op, params.Gas, stack)
+		}
 		// Use BaseOp gas.
-		maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasBaseOp))
+		maybe.PushError(engine.UseGasNegative(params.Gas, schedule.BaseOp))
+
+		if !c.enabled(op, st.Blockchain) {
+			c.debugf("(pc) %-3v Opcode %v not enabled at this height\n", pc, op)
+			maybe.PushError(errors.Errorf(errors.Codes.Generic, "unknown opcode %v", op))
+			return nil, maybe.Error()
+		}
 
 		switch op {
 
@@ -319,7 +341,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			}
 
 		case SHA3: // 0x20
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasSha3))
+			maybe.PushError(engine.UseGasNegative(params.Gas, schedule.Sha3))
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
 			data := memory.Read(offset, size)
 			data = crypto.Keccak256(data)
@@ -332,7 +354,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case BALANCE: // 0x31
 			address := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, schedule.GetAccount))
 			balance := engine.MustGetAccount(st.CallFrame, maybe, address).Balance
 			stack.Push64(balance)
 			c.debugf(" => %v (%v)\n", balance, address)
@@ -387,7 +409,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case EXTCODESIZE: // 0x3B
 			address := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, schedule.GetAccount))
 			acc := engine.MustGetAccount(st.CallFrame, maybe, address)
 			if acc == nil {
 				stack.Push(Zero256)
@@ -399,7 +421,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			}
 		case EXTCODECOPY: // 0x3C
 			address := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, schedule.GetAccount))
 			acc := engine.MustGetAccount(st.CallFrame, maybe, address)
 			if acc == nil {
 				maybe.PushError(errors.Codes.UnknownAddress)
@@ -519,12 +541,26 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			loc := stack.Pop()
 			data := LeftPadWord256(maybe.Bytes(st.CallFrame.GetStorage(params.Callee, loc)))
 			stack.Push(data)
+			if c.tracer != nil {
+				c.tracer.CaptureStorageRead(params.Callee, loc, data.Bytes())
+			}
 			c.debugf("%v {0x%v = 0x%v}\n", params.Callee, loc, data)
 
 		case SSTORE: // 0x55
 			loc, data := stack.Pop(), stack.Pop()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasStorageUpdate))
+			maybe.PushError(engine.UseGasNegative(params.Gas, schedule.StorageUpdate))
+			if c.options.GasRefunds.StorageClearRefund() > 0 {
+				original, err := st.CallFrame.GetOriginalStorage(params.Callee, loc)
+				maybe.PushError(err)
+				current, err := st.CallFrame.GetStorage(params.Callee, loc)
+				maybe.PushError(err)
+				st.CallFrame.AddRefund(c.options.GasRefunds.StorageRefund(LeftPadWord256(original),
+					LeftPadWord256(current), data))
+			}
 			maybe.PushError(st.CallFrame.SetStorage(params.Callee, loc, data.Bytes()))
+			if c.tracer != nil {
+				c.tracer.CaptureStorageWrite(params.Callee, loc, data.Bytes())
+			}
 			c.debugf("%v {%v := %v}\n", params.Callee, loc, data)
 
 		case JUMP: // 0x56
@@ -559,6 +595,33 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case JUMPDEST: // 0x5B
 			c.debugf("\n")
+
+		case TLOAD: // 0x5C
+			loc := stack.Pop()
+			data := st.CallFrame.GetTransientStorage(params.Callee, loc)
+			stack.Push(data)
+			c.debugf("%v {0x%v = 0x%v}\n", params.Callee, loc, data)
+
+		case TSTORE: // 0x5D
+			loc, data := stack.Pop(), stack.Pop()
+			maybe.PushError(st.CallFrame.SetTransientStorage(params.Callee, loc, data))
+			c.debugf("%v {%v := %v}\n", params.Callee, loc, data)
+
+		case MCOPY: // 0x5E
+			memOff := stack.PopBigInt()
+			srcOff := stack.PopBigInt()
+			length := stack.PopBigInt()
+			if length.Sign() != 0 {
+				data := memory.Read(srcOff, length)
+				memory.Write(memOff, data)
+				c.debugf(" => [%v, %v, %v] %X\n", memOff, srcOff, length, data)
+			} else {
+				c.debugf(" => [%v, %v, %v]\n", memOff, srcOff, length)
+			}
+
+		case PUSH0: // 0x5F
+			stack.Push(Zero256)
+			c.debugf(" => 0x%v\n", Zero256)
 			// Do nothing
 
 		case PUSH1, PUSH2, PUSH3, PUSH4, PUSH5, PUSH6, PUSH7, PUSH8, PUSH9, PUSH10, PUSH11, PUSH12, PUSH13, PUSH14, PUSH15, PUSH16, PUSH17, PUSH18, PUSH19, PUSH20, PUSH21, PUSH22, PUSH23, PUSH24, PUSH25, PUSH26, PUSH27, PUSH28, PUSH29, PUSH30, PUSH31, PUSH32:
@@ -601,7 +664,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			input := memory.Read(offset, size)
 
 			// TODO charge for gas to create account _ the code length * GasCreateByte
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasCreateAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, schedule.CreateAccount))
 
 			var newAccountAddress crypto.Address
 			if op == CREATE {
@@ -625,22 +688,26 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			childCallFrame, err := st.CallFrame.NewFrame()
 			maybe.PushError(err)
 			maybe.PushError(engine.CreateAccount(childCallFrame, newAccountAddress))
+			childCallFrame.MarkCreated(newAccountAddress)
 
 			// Run the input to get the contract code.
 			// NOTE: no need to copy 'input' as per Call contract.
 			ret, callErr := c.Contract(input).Call(
 				engine.State{
-					CallFrame:  childCallFrame,
-					Blockchain: st.Blockchain,
-					EventSink:  st.EventSink,
+					CallFrame:   childCallFrame,
+					Blockchain:  st.Blockchain,
+					EventSink:   st.EventSink,
+					GasSchedule: st.GasSchedule,
+					CallTree:    st.CallTree,
 				},
 				engine.CallParams{
-					Origin: params.Origin,
-					Caller: params.Callee,
-					Callee: newAccountAddress,
-					Input:  input,
-					Value:  *contractValue,
-					Gas:    params.Gas,
+					CallType: callTypeFromOpCode(op),
+					Origin:   params.Origin,
+					Caller:   params.Callee,
+					Callee:   newAccountAddress,
+					Input:    input,
+					Value:    *contractValue,
+					Gas:      params.Gas,
 				})
 			if callErr != nil {
 				stack.Push(Zero256)
@@ -648,8 +715,10 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 				// EVM caller
 				returnData = ret
 			} else {
-				// Update the account with its initialised contract code
-				maybe.PushError(engine.InitChildCode(childCallFrame, newAccountAddress, params.Callee, ret))
+				// Update the account with its initialised contract code, unless it self-destructed in its constructor
+				if engine.GetAccount(childCallFrame, maybe, newAccountAddress) != nil {
+					maybe.PushError(engine.InitChildCode(childCallFrame, newAccountAddress, params.Callee, ret))
+				}
 				maybe.PushError(childCallFrame.Sync())
 				stack.PushAddress(newAccountAddress)
 			}
@@ -729,19 +798,16 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 
 		case SELFDESTRUCT: // 0xFF
 			receiver := stack.PopAddress()
-			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasGetAccount))
+			maybe.PushError(engine.UseGasNegative(params.Gas, schedule.GetAccount))
 			if engine.GetAccount(st.CallFrame, maybe, receiver) == nil {
 				// If receiver address doesn't exist, try to create it
-				maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasCreateAccount))
+				maybe.PushError(engine.UseGasNegative(params.Gas, schedule.CreateAccount))
 				if maybe.PushError(st.CallFrame.CreateAccount(params.Callee, receiver)) {
 					continue
 				}
 			}
 			balance := engine.MustGetAccount(st.CallFrame, maybe, params.Callee).Balance
-			maybe.PushError(engine.UpdateAccount(st.CallFrame, receiver, func(account *acm.Account) error {
-				return account.AddToBalance(balance)
-			}))
-			maybe.PushError(engine.RemoveAccount(st.CallFrame, params.Callee))
+			maybe.PushError(engine.SelfDestruct(st.CallFrame, c.options.SelfDestruct, params.Callee, receiver))
 			c.debugf(" => (%X) %v\n", receiver[:4], balance)
 			return nil, maybe.Error()
 
@@ -759,6 +825,15 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 	return nil, maybe.Error()
 }
 
+// Returns false for opcodes introduced by an upgrade that is not yet active for the block being executed
+func (c *Contract) enabled(op OpCode, blockchain engine.Blockchain) bool {
+	switch op {
+	case TLOAD, TSTORE, MCOPY, PUSH0:
+		return c.options.CancunHeight != nil && blockchain.LastBlockHeight()+1 >= *c.options.CancunHeight
+	}
+	return true
+}
+
 func (c *Contract) jump(to uint64, pc *uint64) error {
 	dest := c.GetSymbol(to)
 	if dest != JUMPDEST || c.IsPushData(to) {
@@ -837,6 +912,10 @@ func callTypeFromOpCode(o OpCode) exec.CallType {
 		return exec.CallTypeStatic
 	case DELEGATECALL:
 		return exec.CallTypeDelegate
+	case CREATE:
+		return exec.CallTypeCreate
+	case CREATE2:
+		return exec.CallTypeCreate2
 	default:
 		return exec.CallTypeInvalid
 	}
@@ -5,6 +5,7 @@ package evm
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
@@ -29,6 +30,8 @@ type EVM struct {
 	externalDispatcher engine.Dispatcher
 	// User dispatcher.CallableProvider to get access to other VMs
 	logger *logging.Logger
+	// Optional observer of execution
+	tracer Tracer
 }
 
 func New(options engine.Options) *EVM {
@@ -55,16 +58,36 @@ func (vm *EVM) Execute(st acmstate.ReaderWriter, blockchain engine.Blockchain, e
 	st = native.NewState(vm.options.Natives, st)
 
 	state := engine.State{
-		CallFrame:  engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth),
-		Blockchain: blockchain,
-		EventSink:  eventSink,
+		CallFrame:   engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth),
+		Blockchain:  blockchain,
+		EventSink:   eventSink,
+		GasSchedule: vm.options.Schedule(),
+		CallTree:    vm.options.CallTree,
+	}
+	if params.CallType.IsCreate() {
+		// The account has been created for the contract by the transaction
+		state.CallFrame.MarkCreated(params.Callee)
 	}
 
+	gasLimit := new(big.Int).Set(params.Gas)
 	output, err := vm.Contract(code).Call(state, params)
 	if err == nil {
 		// Only sync back when there was no exception
 		err = state.CallFrame.Sync()
 	}
+	if err == nil {
+		// Refund gas for storage cleared, up to the portion of the gas used allowed by the refund policy
+		refund := vm.options.GasRefunds.MaxRefund(gasLimit.Sub(gasLimit, params.Gas).Uint64())
+		earned := state.CallFrame.Refund()
+		if earned < 0 {
+			// Refunds are only taken back once added so this would be a bug, but we should not charge for it
+			earned = 0
+		}
+		if uint64(earned) < refund {
+			refund = uint64(earned)
+		}
+		params.Gas.Add(params.Gas, new(big.Int).SetUint64(refund))
+	}
 	// Always return output - we may have a reverted exception for which the return is meaningful
 	return output, err
 }
@@ -80,12 +103,20 @@ func (vm *EVM) SetLogger(logger *logging.Logger) {
 	vm.logger = logger
 }
 
+// Sets a Tracer to receive callbacks during subsequent executions, nil disables tracing
+func (vm *EVM) SetTracer(tracer Tracer) {
+	vm.tracer = tracer
+}
+
 func (vm *EVM) Dispatch(acc *acm.Account) engine.Callable {
 	// Let the EVM handle code-less (e.g. those created by a call) contracts (so only return nil if there is _other_ non-EVM code)
 	if len(acc.EVMCode) == 0 && len(acc.Code()) != 0 {
 		return nil
 	}
-	return vm.Contract(acc.EVMCode)
+	return &Contract{
+		EVM:  vm,
+		Code: cachedCode(acc.EVMCode, acc.CodeHash),
+	}
 }
 
 func (vm *EVM) Contract(code []byte) *Contract {
@@ -21,6 +21,7 @@ import (
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/solidity"
 	"github.com/hyperledger/burrow/txs"
@@ -1407,8 +1408,9 @@ func TestEVM(t *testing.T) {
 		for base := -n; base < n; base++ {
 			for exp := -n; exp < n; exp++ {
 				for mod := int64(1); mod < n; mod++ {
-					b := big.NewInt(base)
-					e := big.NewInt(exp)
+					// Values are interpreted as unsigned so negative base and exponent are taken as twos complement
+					b := new(big.Int).SetBytes(BigIntToWord256(big.NewInt(base)).Bytes())
+					e := new(big.Int).SetBytes(BigIntToWord256(big.NewInt(exp)).Bytes())
 					m := big.NewInt(mod)
 					v := new(big.Int).Exp(b, e, m)
 					if v == nil {
@@ -1665,6 +1667,240 @@ func TestEVM(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Cancun", func(t *testing.T) {
+		cancunHeight := uint64(2)
+		cancunVM := New(engine.Options{
+			Natives:      native.MustDefaultNatives(),
+			CancunHeight: &cancunHeight,
+		})
+		st := acmstate.NewMemoryState()
+		blockchain := &engine.TestBlockchain{BlockHeight: cancunHeight - 1}
+		eventSink := exec.NewNoopEventSink()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		execute := func(vm *EVM, blockchain engine.Blockchain, callee crypto.Address, code []byte) ([]byte, error) {
+			return vm.Execute(st, blockchain, eventSink, engine.CallParams{
+				Caller: account1,
+				Callee: callee,
+				Gas:    big.NewInt(100000),
+			}, code)
+		}
+		// Runs callee's code against the caller's (transient) storage
+		delegateCallCode := func(callee crypto.Address) []byte {
+			return MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, callee, PUSH2, 0x10, 0x00, DELEGATECALL)
+		}
+
+		t.Run("NotEnabled", func(t *testing.T) {
+			for _, code := range [][]byte{
+				MustSplice(PUSH0, return1()),
+				MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, MCOPY),
+				MustSplice(PUSH1, 0, TLOAD),
+				MustSplice(PUSH1, 0, PUSH1, 0, TSTORE),
+			} {
+				_, err := execute(vm, blockchain, account2, code)
+				require.Equal(t, errors.Codes.Generic, errors.GetCode(err))
+				_, err = execute(cancunVM, &engine.TestBlockchain{BlockHeight: cancunHeight - 2}, account2, code)
+				require.Equal(t, errors.Codes.Generic, errors.GetCode(err))
+			}
+		})
+
+		t.Run("PUSH0", func(t *testing.T) {
+			output, err := execute(cancunVM, blockchain, account2, MustSplice(PUSH1, 0x2A, PUSH0, return1()))
+			require.NoError(t, err)
+			require.Equal(t, Zero256.Bytes(), output)
+		})
+
+		t.Run("MCOPY", func(t *testing.T) {
+			output, err := execute(cancunVM, blockchain, account2, MustSplice(PUSH1, 0x2A, PUSH1, 0, MSTORE,
+				PUSH1, 32, PUSH1, 0, PUSH1, 32, MCOPY, PUSH1, 32, PUSH1, 32, RETURN))
+			require.NoError(t, err)
+			require.Equal(t, Int64ToWord256(0x2A).Bytes(), output)
+		})
+
+		t.Run("TransientStorage", func(t *testing.T) {
+			storer := makeAccountWithCode(t, st, "storer", MustSplice(PUSH1, 0x2A, PUSH1, 1, TSTORE))
+			reverter := makeAccountWithCode(t, st, "reverter", MustSplice(PUSH1, 0x2B, PUSH1, 1, TSTORE,
+				PUSH1, 0, PUSH1, 0, REVERT))
+			loadCode := MustSplice(PUSH1, 1, TLOAD, return1())
+
+			// Visible within the frame and to the caller of a frame that stored it
+			output, err := execute(cancunVM, blockchain, account2, MustSplice(PUSH1, 0x2C, PUSH1, 1, TSTORE, loadCode))
+			require.NoError(t, err)
+			require.Equal(t, Int64ToWord256(0x2C).Bytes(), output)
+			output, err = execute(cancunVM, blockchain, account2, MustSplice(delegateCallCode(storer), POP, loadCode))
+			require.NoError(t, err)
+			require.Equal(t, Int64ToWord256(0x2A).Bytes(), output)
+
+			// Reverted along with the frame that stored it
+			output, err = execute(cancunVM, blockchain, account2, MustSplice(delegateCallCode(storer), POP,
+				delegateCallCode(reverter), POP, loadCode))
+			require.NoError(t, err)
+			require.Equal(t, Int64ToWord256(0x2A).Bytes(), output)
+
+			// Discarded at the end of execution
+			output, err = execute(cancunVM, blockchain, account2, loadCode)
+			require.NoError(t, err)
+			require.Equal(t, Zero256.Bytes(), output)
+			value, err := st.GetStorage(account2, One256)
+			require.NoError(t, err)
+			require.Empty(t, value)
+
+			// Not writable from a static call
+			_, err = execute(cancunVM, blockchain, account2, MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0,
+				PUSH20, storer, PUSH2, 0x10, 0x00, STATICCALL, return1()))
+			require.Equal(t, errors.Codes.IllegalWrite, errors.GetCode(err))
+		})
+	})
+
+	t.Run("GasRefunds", func(t *testing.T) {
+		schedule := gas.DefaultSchedule()
+		schedule.StorageUpdate = 20000
+		st := acmstate.NewMemoryState()
+		blockchain := new(engine.TestBlockchain)
+		eventSink := exec.NewNoopEventSink()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		clearCode := MustSplice(PUSH1, 0, PUSH1, 1, SSTORE, PUSH1, 0, PUSH1, 2, SSTORE)
+		clearer := makeAccountWithCode(t, st, "clearer", clearCode)
+		reverter := makeAccountWithCode(t, st, "clearReverter", MustSplice(clearCode, PUSH1, 0, PUSH1, 0, REVERT))
+		delegateCallCode := func(callee crypto.Address) []byte {
+			return MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, callee, PUSH3, 0x01, 0x00, 0x00,
+				DELEGATECALL, POP)
+		}
+		// Returns the gas used by code run against account2 with both of its storage slots set
+		gasUsed := func(policy gas.RefundPolicy, code []byte) uint64 {
+			require.NoError(t, policy.Validate())
+			for _, key := range []Word256{One256, Int64ToWord256(2)} {
+				require.NoError(t, st.SetStorage(account2, key, One256.Bytes()))
+			}
+			refundVM := New(engine.Options{
+				Natives:    native.MustDefaultNatives(),
+				GasRefunds: policy,
+				GasSchedule: func() *gas.Schedule {
+					return schedule
+				},
+			})
+			gasLimit := uint64(1000000)
+			params := engine.CallParams{
+				Caller: account1,
+				Callee: account2,
+				Gas:    new(big.Int).SetUint64(gasLimit),
+			}
+			_, err := refundVM.Execute(st, blockchain, eventSink, params, code)
+			require.NoError(t, err)
+			return gasLimit - params.Gas.Uint64()
+		}
+
+		used := gasUsed(gas.NoRefunds, clearCode)
+		require.Equal(t, used, gasUsed("", clearCode))
+		// The refund for two slots exceeds the cap of a fifth of the gas used
+		require.Equal(t, used-used/5, gasUsed(gas.ModernRefunds, clearCode))
+		// The cap is half of the gas used under the legacy policy
+		require.Equal(t, used-used/2, gasUsed(gas.LegacyRefunds, clearCode))
+
+		// Refunds are credited from successful sub-calls but not those that revert
+		used = gasUsed(gas.NoRefunds, delegateCallCode(clearer))
+		require.Equal(t, used-used/5, gasUsed(gas.ModernRefunds, delegateCallCode(clearer)))
+		used = gasUsed(gas.NoRefunds, delegateCallCode(reverter))
+		require.Equal(t, used, gasUsed(gas.ModernRefunds, delegateCallCode(reverter)))
+
+		// Nothing is refunded for writing zero to storage that is already zero
+		zeroCode := MustSplice(PUSH1, 0, PUSH1, 3, SSTORE)
+		require.Equal(t, gasUsed(gas.NoRefunds, zeroCode), gasUsed(gas.ModernRefunds, zeroCode))
+
+		// Clearing a slot that is written again in the same transaction is not refunded, so alternately clearing and
+		// setting one slot earns no more than clearing it once
+		clearOnceCode := MustSplice(PUSH1, 0, PUSH1, 1, SSTORE)
+		var resetCode []byte
+		for i := 0; i < 10; i++ {
+			resetCode = MustSplice(resetCode, clearOnceCode, PUSH1, 2, PUSH1, 1, SSTORE)
+		}
+		farmCode := MustSplice(resetCode, clearOnceCode)
+		require.Equal(t, gasUsed(gas.NoRefunds, resetCode), gasUsed(gas.ModernRefunds, resetCode))
+		require.Equal(t, gasUsed(gas.NoRefunds, farmCode)-4800, gasUsed(gas.ModernRefunds, farmCode))
+		// Whether the slot is set again in the same frame or a sub-call
+		setter := makeAccountWithCode(t, st, "setter", MustSplice(PUSH1, 2, PUSH1, 1, SSTORE))
+		clearThenSetCode := MustSplice(clearOnceCode, delegateCallCode(setter))
+		require.Equal(t, gasUsed(gas.NoRefunds, clearThenSetCode), gasUsed(gas.ModernRefunds, clearThenSetCode))
+		// But a slot set again by a sub-call that reverts is still cleared
+		unsetter := makeAccountWithCode(t, st, "unsetter", MustSplice(PUSH1, 2, PUSH1, 1, SSTORE, PUSH1, 0,
+			PUSH1, 0, REVERT))
+		clearThenRevertCode := MustSplice(clearOnceCode, delegateCallCode(unsetter))
+		require.Equal(t, gasUsed(gas.NoRefunds, clearThenRevertCode)-4800,
+			gasUsed(gas.ModernRefunds, clearThenRevertCode))
+
+		require.Error(t, gas.RefundPolicy("generous").Validate())
+	})
+
+	t.Run("SelfDestruct", func(t *testing.T) {
+		receiver := engine.AddressFromName("receiver")
+		selfDestructCode := MustSplice(PUSH20, receiver, SELFDESTRUCT)
+		// Creates a contract whose constructor self-destructs and returns its address
+		factoryCode := MustSplice(PUSH32, LeftPadBytes(selfDestructCode, 32), PUSH1, 0, MSTORE,
+			PUSH1, len(selfDestructCode), PUSH1, 32-len(selfDestructCode), PUSH1, 0, CREATE,
+			PUSH1, 0, MSTORE, PUSH1, 20, PUSH1, 12, RETURN)
+
+		selfDestruct := func(policy engine.SelfDestructPolicy, callType exec.CallType, code []byte) (acmstate.Reader,
+			crypto.Address, []byte, error) {
+			require.NoError(t, policy.Validate())
+			st := acmstate.NewMemoryState()
+			newAccount(t, st, "receiver")
+			callee := makeAccountWithCode(t, st, "callee", code)
+			policyVM := New(engine.Options{SelfDestruct: policy})
+			params := engine.CallParams{
+				CallType: callType,
+				Caller:   newAccount(t, st, "1"),
+				Callee:   callee,
+				Gas:      big.NewInt(100000),
+			}
+			output, err := policyVM.Execute(st, new(engine.TestBlockchain), exec.NewNoopEventSink(), params, code)
+			return st, callee, output, err
+		}
+		balance := func(st acmstate.Reader, address crypto.Address) uint64 {
+			acc, err := st.GetAccount(address)
+			require.NoError(t, err)
+			require.NotNil(t, acc)
+			return acc.Balance
+		}
+		exists := func(st acmstate.Reader, address crypto.Address) bool {
+			acc, err := st.GetAccount(address)
+			require.NoError(t, err)
+			return acc != nil
+		}
+
+		for _, policy := range []engine.SelfDestructPolicy{"", engine.SelfDestructLegacy} {
+			st, callee, _, err := selfDestruct(policy, exec.CallTypeCall, selfDestructCode)
+			require.NoError(t, err)
+			require.False(t, exists(st, callee))
+			require.Equal(t, uint64(9999999), balance(st, receiver))
+		}
+
+		// An existing contract only sends its balance
+		st, callee, _, err := selfDestruct(engine.SelfDestructEIP6780, exec.CallTypeCall, selfDestructCode)
+		require.NoError(t, err)
+		require.True(t, exists(st, callee))
+		require.Equal(t, uint64(0), balance(st, callee))
+		require.Equal(t, uint64(9999999), balance(st, receiver))
+
+		// But one created by the same transaction is removed
+		st, callee, _, err = selfDestruct(engine.SelfDestructEIP6780, exec.CallTypeCreate, selfDestructCode)
+		require.NoError(t, err)
+		require.False(t, exists(st, callee))
+		require.Equal(t, uint64(9999999), balance(st, receiver))
+
+		// Including by CREATE
+		st, _, output, err := selfDestruct(engine.SelfDestructEIP6780, exec.CallTypeCall, factoryCode)
+		require.NoError(t, err)
+		require.False(t, exists(st, crypto.MustAddressFromBytes(output)))
+
+		st, callee, _, err = selfDestruct(engine.SelfDestructDisabled, exec.CallTypeCall, selfDestructCode)
+		require.Error(t, err)
+		require.True(t, exists(st, callee))
+		require.Equal(t, uint64(0), balance(st, receiver))
+
+		require.Error(t, engine.SelfDestructPolicy("sometimes").Validate())
+	})
 }
 
 // helpers
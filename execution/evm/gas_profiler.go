@@ -0,0 +1,126 @@
+package evm
+
+import (
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+type GasUsage struct {
+	// Number of times the opcode was executed or the function was called
+	Count uint64
+	Gas   uint64
+}
+
+// Identifies a function by the contract it belongs to and its 4-byte selector (empty for calls without input)
+type FunctionKey struct {
+	Address  crypto.Address
+	Selector [4]byte
+}
+
+// GasProfiler aggregates gas used by opcode and by contract function. Gas attributed to an opcode excludes any gas
+// used by the calls it makes whereas gas attributed to a function includes all of the gas used within its call.
+type GasProfiler struct {
+	NoopTracer
+	opcodes   map[asm.OpCode]*GasUsage
+	functions map[FunctionKey]*GasUsage
+	frames    []*profileFrame
+}
+
+type profileFrame struct {
+	function FunctionKey
+	// Gas available on entry to the frame
+	gas *big.Int
+	// The opcode executing and the gas available before it executed
+	op        asm.OpCode
+	opGas     *big.Int
+	opPending bool
+	// Gas used by calls made by the pending opcode
+	childGas uint64
+}
+
+func NewGasProfiler() *GasProfiler {
+	return &GasProfiler{
+		opcodes:   make(map[asm.OpCode]*GasUsage),
+		functions: make(map[FunctionKey]*GasUsage),
+	}
+}
+
+func (gp *GasProfiler) Opcodes() map[asm.OpCode]*GasUsage {
+	return gp.opcodes
+}
+
+func (gp *GasProfiler) Functions() map[FunctionKey]*GasUsage {
+	return gp.functions
+}
+
+func (gp *GasProfiler) CaptureEnter(depth uint64, params engine.CallParams, code []byte) {
+	frame := &profileFrame{
+		function: FunctionKey{Address: params.Callee},
+		gas:      new(big.Int).Set(params.Gas),
+	}
+	copy(frame.function.Selector[:], params.Input)
+	gp.frames = append(gp.frames, frame)
+}
+
+func (gp *GasProfiler) CaptureExit(depth uint64, output []byte, gasUsed *big.Int, err error) {
+	if len(gp.frames) == 0 {
+		return
+	}
+	frame := gp.frames[len(gp.frames)-1]
+	gp.frames = gp.frames[:len(gp.frames)-1]
+	// The frame's last opcode ran until the gas remaining on exit
+	gp.settle(frame, new(big.Int).Sub(frame.gas, gasUsed))
+	gp.function(frame.function).add(gasUsed.Uint64())
+	if len(gp.frames) > 0 {
+		gp.frames[len(gp.frames)-1].childGas += gasUsed.Uint64()
+	}
+}
+
+func (gp *GasProfiler) CaptureStep(depth uint64, pc uint64, op asm.OpCode, gas *big.Int, stack *Stack) {
+	if len(gp.frames) == 0 {
+		return
+	}
+	frame := gp.frames[len(gp.frames)-1]
+	gp.settle(frame, gas)
+	frame.op = op
+	frame.opGas = new(big.Int).Set(gas)
+	frame.opPending = true
+}
+
+// Attributes the gas used since the pending opcode began, less that used by any calls it made, to the opcode
+func (gp *GasProfiler) settle(frame *profileFrame, gas *big.Int) {
+	if !frame.opPending {
+		return
+	}
+	used := new(big.Int).Sub(frame.opGas, gas).Uint64()
+	if frame.childGas < used {
+		used -= frame.childGas
+	} else {
+		used = 0
+	}
+	gp.opcode(frame.op).add(used)
+	frame.opPending = false
+	frame.childGas = 0
+}
+
+func (gp *GasProfiler) opcode(op asm.OpCode) *GasUsage {
+	if gp.opcodes[op] == nil {
+		gp.opcodes[op] = new(GasUsage)
+	}
+	return gp.opcodes[op]
+}
+
+func (gp *GasProfiler) function(fk FunctionKey) *GasUsage {
+	if gp.functions[fk] == nil {
+		gp.functions[fk] = new(GasUsage)
+	}
+	return gp.functions[fk]
+}
+
+func (gu *GasUsage) add(gas uint64) {
+	gu.Count++
+	gu.Gas += gas
+}
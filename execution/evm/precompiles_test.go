@@ -9,7 +9,8 @@ import (
 
 	"github.com/hyperledger/burrow/execution/evm/abi"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/solidity"
@@ -29,10 +30,10 @@ import (
 func TestECRecover(t *testing.T) {
 	message := []byte("THIS MESSAGE IS NOT SIGNED")
 	digest := crypto.Keccak256(message)
-	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	privateKey, err := btcec.NewPrivateKey()
 	require.NoError(t, err)
 
-	sig, err := btcec.SignCompact(btcec.S256(), privateKey, digest, false)
+	sig, err := ecdsa.SignCompact(privateKey, digest, false)
 	require.NoError(t, err)
 
 	st := native.NewState(native.MustDefaultNatives(), acmstate.NewMemoryState())
@@ -0,0 +1,94 @@
+package evm
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// RevertFrame is a call frame that a failed call failed in, with the instruction it failed at
+type RevertFrame struct {
+	// The address of the code run, which is that of the contract called unless by DELEGATECALL or CALLCODE
+	CodeAddress crypto.Address
+	// Whether the code was the init code of a contract being created rather than the code of a deployed contract
+	Create bool
+	PC     uint64
+	// REVERT unless the code failed with some other exception, such as running out of gas
+	OpCode asm.OpCode
+}
+
+// RevertTracer finds the call frames a failed call failed in. A frame that reverts with the same output as the last
+// call it made that failed is taken to have passed on that failure, as Solidity does, so the trace follows it into
+// that call.
+type RevertTracer struct {
+	NoopTracer
+	frames []*revertFrame
+	trace  []RevertFrame
+}
+
+var _ Tracer = &RevertTracer{}
+
+type revertFrame struct {
+	RevertFrame
+	// The output and trace of the last call the frame made, if it failed
+	failedOutput []byte
+	failedTrace  []RevertFrame
+}
+
+func NewRevertTracer() *RevertTracer {
+	return new(RevertTracer)
+}
+
+// Trace returns the frames the call failed in from the outermost to the one that failed first, or nil if the call did
+// not fail
+func (rt *RevertTracer) Trace() []RevertFrame {
+	return rt.trace
+}
+
+func (rt *RevertTracer) CaptureEnter(depth uint64, params engine.CallParams, code []byte) {
+	frame := &revertFrame{
+		RevertFrame: RevertFrame{
+			CodeAddress: params.Callee,
+			Create:      params.CallType == exec.CallTypeCreate || params.CallType == exec.CallTypeCreate2,
+		},
+	}
+	if params.CodeAddress != nil {
+		frame.CodeAddress = *params.CodeAddress
+	}
+	rt.frames = append(rt.frames, frame)
+}
+
+func (rt *RevertTracer) CaptureExit(depth uint64, output []byte, gasUsed *big.Int, err error) {
+	if len(rt.frames) == 0 {
+		return
+	}
+	frame := rt.frames[len(rt.frames)-1]
+	rt.frames = rt.frames[:len(rt.frames)-1]
+	var trace []RevertFrame
+	if err != nil {
+		trace = []RevertFrame{frame.RevertFrame}
+		if frame.failedTrace != nil && bytes.Equal(output, frame.failedOutput) {
+			trace = append(trace, frame.failedTrace...)
+		}
+	}
+	if len(rt.frames) == 0 {
+		rt.trace = trace
+		return
+	}
+	parent := rt.frames[len(rt.frames)-1]
+	parent.failedTrace = trace
+	parent.failedOutput = append([]byte(nil), output...)
+}
+
+func (rt *RevertTracer) CaptureStep(depth uint64, pc uint64, op asm.OpCode, gas *big.Int, stack *Stack) {
+	if len(rt.frames) == 0 {
+		return
+	}
+	frame := rt.frames[len(rt.frames)-1]
+	frame.PC = pc
+	frame.OpCode = op
+}
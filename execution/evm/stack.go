@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sync"
 
 	"github.com/hyperledger/burrow/execution/engine"
 
@@ -21,7 +22,9 @@ type Stack struct {
 	maxCapacity uint64
 	ptr         int
 
-	gas     *big.Int
+	gas *big.Int
+	// Gas charged per push or pop
+	opGas   uint64
 	errSink errors.Sink
 }
 
@@ -31,12 +34,47 @@ func NewStack(errSink errors.Sink, initialCapacity uint64, maxCapacity uint64, g
 		ptr:         0,
 		maxCapacity: maxCapacity,
 		gas:         gas,
+		opGas:       engine.GasStackOp,
 		errSink:     errSink,
 	}
 }
 
+// Stacks of the default initial capacity are reused across calls rather than allocating their backing slice for
+// every call frame
+var stackPool = sync.Pool{
+	New: func() interface{} {
+		return &Stack{slice: make([]Word256, DataStackInitialCapacity)}
+	},
+}
+
+// Returns a Stack as NewStack does, reusing a released one if possible
+func getStack(errSink errors.Sink, initialCapacity uint64, maxCapacity uint64, gas *big.Int) *Stack {
+	if initialCapacity != DataStackInitialCapacity {
+		return NewStack(errSink, initialCapacity, maxCapacity, gas)
+	}
+	st := stackPool.Get().(*Stack)
+	// Values beyond ptr are never read so need not be cleared
+	st.slice = st.slice[:initialCapacity]
+	st.ptr = 0
+	st.maxCapacity = maxCapacity
+	st.gas = gas
+	st.opGas = engine.GasStackOp
+	st.errSink = errSink
+	return st
+}
+
+// Returns a Stack from getStack for reuse, after which it must not be used
+func (st *Stack) release() {
+	if cap(st.slice) < DataStackInitialCapacity {
+		return
+	}
+	st.gas = nil
+	st.errSink = nil
+	stackPool.Put(st)
+}
+
 func (st *Stack) Push(d Word256) {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.opGas)
 	err := st.ensureCapacity(uint64(st.ptr) + 1)
 	if err != nil {
 		st.pushErr(errors.Codes.DataStackOverflow)
@@ -47,7 +85,7 @@ func (st *Stack) Push(d Word256) {
 }
 
 func (st *Stack) Pop() Word256 {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.opGas)
 	if st.ptr == 0 {
 		st.pushErr(errors.Codes.DataStackUnderflow)
 		return Zero256
@@ -110,7 +148,7 @@ func (st *Stack) Len() int {
 }
 
 func (st *Stack) Swap(n int) {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.opGas)
 	if st.ptr < n {
 		st.pushErr(errors.Codes.DataStackUnderflow)
 		return
@@ -119,7 +157,7 @@ func (st *Stack) Swap(n int) {
 }
 
 func (st *Stack) Dup(n int) {
-	st.useGas(engine.GasStackOp)
+	st.useGas(st.opGas)
 	if st.ptr < n {
 		st.pushErr(errors.Codes.DataStackUnderflow)
 		return
@@ -91,3 +91,24 @@ func TestStack_ensureCapacity(t *testing.T) {
 	err = st.ensureCapacity(17)
 	assert.Error(t, err, "Should not be possible to grow over capacity")
 }
+
+// Test a released stack is reset when reused
+func TestStack_release(t *testing.T) {
+	err := new(errors.Maybe)
+	st := getStack(err, DataStackInitialCapacity, 0, maxUint64)
+	for i := 0; i < DataStackInitialCapacity+1; i++ {
+		st.Push64(1)
+	}
+	require.NoError(t, err.Error())
+	st.release()
+
+	for i := 0; i < 10; i++ {
+		reused := getStack(err, DataStackInitialCapacity, DataStackInitialCapacity, maxUint64)
+		assert.Equal(t, 0, reused.Len())
+		assert.Equal(t, DataStackInitialCapacity, len(reused.slice))
+		reused.Pop()
+		assert.Equal(t, errors.Codes.DataStackUnderflow, errors.GetCode(err.Error()))
+		*err = errors.Maybe{}
+		reused.release()
+	}
+}
@@ -0,0 +1,72 @@
+package evm
+
+import (
+	"math/big"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+// Tracer receives callbacks from the EVM interpreter as it executes. Tracers are passive observers, they must not
+// modify the arguments they are passed (which may be live interpreter state) and must copy anything they retain.
+type Tracer interface {
+	// Called on entering a call frame (including the top-level call and the init code of a contract being created)
+	// before any value is transferred. The Gas of params is the gas available to the frame.
+	CaptureEnter(depth uint64, params engine.CallParams, code []byte)
+	// Called on leaving the call frame most recently entered with its output, gas used, and any error
+	CaptureExit(depth uint64, output []byte, gasUsed *big.Int, err error)
+	// Called before each opcode is executed with the gas remaining in the frame
+	CaptureStep(depth uint64, pc uint64, op asm.OpCode, gas *big.Int, stack *Stack)
+	// Called for each SLOAD with the value read
+	CaptureStorageRead(address crypto.Address, key Word256, value []byte)
+	// Called for each SSTORE with the value written
+	CaptureStorageWrite(address crypto.Address, key Word256, value []byte)
+}
+
+// Tracers fans out callbacks to each of its elements in order
+type Tracers []Tracer
+
+var _ Tracer = Tracers{}
+
+func (ts Tracers) CaptureEnter(depth uint64, params engine.CallParams, code []byte) {
+	for _, t := range ts {
+		t.CaptureEnter(depth, params, code)
+	}
+}
+
+func (ts Tracers) CaptureExit(depth uint64, output []byte, gasUsed *big.Int, err error) {
+	for _, t := range ts {
+		t.CaptureExit(depth, output, gasUsed, err)
+	}
+}
+
+func (ts Tracers) CaptureStep(depth uint64, pc uint64, op asm.OpCode, gas *big.Int, stack *Stack) {
+	for _, t := range ts {
+		t.CaptureStep(depth, pc, op, gas, stack)
+	}
+}
+
+func (ts Tracers) CaptureStorageRead(address crypto.Address, key Word256, value []byte) {
+	for _, t := range ts {
+		t.CaptureStorageRead(address, key, value)
+	}
+}
+
+func (ts Tracers) CaptureStorageWrite(address crypto.Address, key Word256, value []byte) {
+	for _, t := range ts {
+		t.CaptureStorageWrite(address, key, value)
+	}
+}
+
+// NoopTracer can be embedded by tracers that are only interested in some callbacks
+type NoopTracer struct{}
+
+var _ Tracer = NoopTracer{}
+
+func (NoopTracer) CaptureEnter(depth uint64, params engine.CallParams, code []byte)               {}
+func (NoopTracer) CaptureExit(depth uint64, output []byte, gasUsed *big.Int, err error)           {}
+func (NoopTracer) CaptureStep(depth uint64, pc uint64, op asm.OpCode, gas *big.Int, stack *Stack) {}
+func (NoopTracer) CaptureStorageRead(address crypto.Address, key Word256, value []byte)           {}
+func (NoopTracer) CaptureStorageWrite(address crypto.Address, key Word256, value []byte)          {}
@@ -0,0 +1,156 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/engine"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type opCounter struct {
+	NoopTracer
+	counts map[OpCode]int
+}
+
+func (oc *opCounter) CaptureStep(depth uint64, pc uint64, op OpCode, gas *big.Int, stack *Stack) {
+	oc.counts[op]++
+}
+
+func TestTracers(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	origin := newAccount(t, st, "origin")
+	// Writes 42 to storage slot 1 then returns it
+	callee := makeAccountWithCode(t, st, "callee",
+		MustSplice(PUSH1, 42, PUSH1, 1, SSTORE, PUSH1, 1, SLOAD, return1()))
+	// Calls callee with the first 4 (zero) bytes of memory as input and returns its output
+	callerCode := MustSplice(PUSH1, 32, PUSH1, 0, PUSH1, 4, PUSH1, 0, PUSH1, 0, PUSH20, callee,
+		PUSH2, 0xFF, 0xFF, CALL, PUSH1, 32, PUSH1, 0, RETURN)
+	caller := makeAccountWithCode(t, st, "caller", callerCode)
+
+	callTree := NewCallTreeTracer()
+	prestate := NewPrestateTracer(st)
+	fourByte := NewFourByteTracer()
+	ops := &opCounter{counts: make(map[OpCode]int)}
+	profiler := NewGasProfiler()
+
+	vm := New(engine.Options{})
+	vm.SetTracer(Tracers{callTree, prestate, fourByte, ops, profiler})
+	input := MustSplice(0x01, 0x02, 0x03, 0x04, Int64ToWord256(7))
+	output, err := call(vm, st, origin, caller, callerCode, input, big.NewInt(100000))
+	require.NoError(t, err)
+	assert.Equal(t, Int64ToWord256(42).Bytes(), output)
+
+	t.Run("CallTree", func(t *testing.T) {
+		root := callTree.Root()
+		require.NotNil(t, root)
+		assert.Equal(t, caller, root.Callee)
+		assert.Equal(t, HexBytes(input), root.Input)
+		assert.Equal(t, HexBytes(output), root.Output)
+		require.Len(t, root.Calls, 1)
+		child := root.Calls[0]
+		assert.Equal(t, caller, child.Caller)
+		assert.Equal(t, callee, child.Callee)
+		assert.Equal(t, HexBytes(Int64ToWord256(42).Bytes()), child.Output)
+		assert.Empty(t, child.Error)
+		assert.True(t, child.GasUsed.Sign() > 0)
+		assert.True(t, root.GasUsed.Cmp(child.GasUsed) > 0)
+	})
+
+	t.Run("Prestate", func(t *testing.T) {
+		require.NoError(t, prestate.Error())
+		assert.Contains(t, prestate.Accounts(), caller)
+		assert.Contains(t, prestate.Accounts(), callee)
+		// Storage is recorded as it was before execution
+		value, ok := prestate.Storage()[callee][Int64ToWord256(1)]
+		assert.True(t, ok)
+		assert.Empty(t, value)
+	})
+
+	t.Run("FourByte", func(t *testing.T) {
+		assert.Equal(t, map[string]uint64{
+			"0x01020304-32": 1,
+			"0x00000000-0":  1,
+		}, fourByte.Counts())
+	})
+
+	t.Run("Steps", func(t *testing.T) {
+		assert.Equal(t, 1, ops.counts[SSTORE])
+		assert.Equal(t, 1, ops.counts[SLOAD])
+		assert.Equal(t, 1, ops.counts[CALL])
+	})
+
+	t.Run("GasProfiler", func(t *testing.T) {
+		root := callTree.Root()
+		// Opcode gas is exclusive of calls made so should account for all gas used exactly once
+		var total uint64
+		for _, usage := range profiler.Opcodes() {
+			total += usage.Gas
+		}
+		assert.Equal(t, root.GasUsed.Uint64(), total)
+		assert.Equal(t, uint64(1), profiler.Opcodes()[SSTORE].Count)
+		// Two stack pops and the storage update
+		assert.Equal(t, engine.GasBaseOp+2*engine.GasStackOp+engine.GasStorageUpdate, profiler.Opcodes()[SSTORE].Gas)
+
+		// Function gas is inclusive
+		assert.Equal(t, &GasUsage{Count: 1, Gas: root.GasUsed.Uint64()},
+			profiler.Functions()[FunctionKey{Address: caller, Selector: [4]byte{1, 2, 3, 4}}])
+		assert.Equal(t, &GasUsage{Count: 1, Gas: root.Calls[0].GasUsed.Uint64()},
+			profiler.Functions()[FunctionKey{Address: callee}])
+	})
+}
+
+func TestRevertTracer(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	origin := newAccount(t, st, "origin")
+	// Reverts with the word 1 at PC 9
+	callee := makeAccountWithCode(t, st, "callee",
+		MustSplice(PUSH1, 1, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, REVERT))
+	callCallee := MustSplice(PUSH1, 32, PUSH1, 0, PUSH1, 4, PUSH1, 0, PUSH1, 0, PUSH20, callee,
+		PUSH2, 0xFF, 0xFF, CALL, POP)
+
+	t.Run("PassedOn", func(t *testing.T) {
+		// Reverts with the output of the call at PC 45
+		code := MustSplice(callCallee, RETURNDATASIZE, PUSH1, 0, PUSH1, 0, RETURNDATACOPY,
+			RETURNDATASIZE, PUSH1, 0, REVERT)
+		caller := makeAccountWithCode(t, st, "passer", code)
+		tracer := NewRevertTracer()
+		vm := New(engine.Options{})
+		vm.SetTracer(tracer)
+		_, err := call(vm, st, origin, caller, code, nil, big.NewInt(100000))
+		require.Error(t, err)
+		assert.Equal(t, []RevertFrame{
+			{CodeAddress: caller, PC: 45, OpCode: REVERT},
+			{CodeAddress: callee, PC: 9, OpCode: REVERT},
+		}, tracer.Trace())
+	})
+
+	t.Run("OwnFailure", func(t *testing.T) {
+		// Reverts with nothing at PC 40
+		code := MustSplice(callCallee, PUSH1, 0, PUSH1, 0, REVERT)
+		caller := makeAccountWithCode(t, st, "reverter", code)
+		tracer := NewRevertTracer()
+		vm := New(engine.Options{})
+		vm.SetTracer(tracer)
+		_, err := call(vm, st, origin, caller, code, nil, big.NewInt(100000))
+		require.Error(t, err)
+		assert.Equal(t, []RevertFrame{{CodeAddress: caller, PC: 40, OpCode: REVERT}}, tracer.Trace())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Returns having caught the failed call
+		code := MustSplice(callCallee, STOP)
+		caller := makeAccountWithCode(t, st, "catcher", code)
+		tracer := NewRevertTracer()
+		vm := New(engine.Options{})
+		vm.SetTracer(tracer)
+		_, err := call(vm, st, origin, caller, code, nil, big.NewInt(100000))
+		require.NoError(t, err)
+		assert.Nil(t, tracer.Trace())
+	})
+}
@@ -0,0 +1,189 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// TracedCall is a node in the tree of calls built by CallTreeTracer
+type TracedCall struct {
+	CallType exec.CallType
+	Caller   crypto.Address
+	Callee   crypto.Address
+	Input    HexBytes
+	Output   HexBytes
+	Value    *big.Int
+	Gas      *big.Int
+	GasUsed  *big.Int
+	Error    string        `json:",omitempty"`
+	Calls    []*TracedCall `json:",omitempty"`
+}
+
+// CallTreeTracer records the nested EVM calls made during execution
+type CallTreeTracer struct {
+	NoopTracer
+	root  *TracedCall
+	stack []*TracedCall
+}
+
+func NewCallTreeTracer() *CallTreeTracer {
+	return &CallTreeTracer{}
+}
+
+// Returns the top-level call or nil if no call has been made
+func (t *CallTreeTracer) Root() *TracedCall {
+	return t.root
+}
+
+func (t *CallTreeTracer) CaptureEnter(depth uint64, params engine.CallParams, code []byte) {
+	call := &TracedCall{
+		CallType: params.CallType,
+		Caller:   params.Caller,
+		Callee:   params.Callee,
+		Input:    copyBytes(params.Input),
+		Value:    new(big.Int).Set(&params.Value),
+		Gas:      new(big.Int).Set(params.Gas),
+	}
+	if len(t.stack) == 0 {
+		t.root = call
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Calls = append(parent.Calls, call)
+	}
+	t.stack = append(t.stack, call)
+}
+
+func (t *CallTreeTracer) CaptureExit(depth uint64, output []byte, gasUsed *big.Int, err error) {
+	if len(t.stack) == 0 {
+		return
+	}
+	call := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	call.Output = copyBytes(output)
+	call.GasUsed = new(big.Int).Set(gasUsed)
+	if err != nil {
+		call.Error = err.Error()
+	}
+}
+
+// PrestateTracer records the accounts and storage touched by execution as they were before execution began
+type PrestateTracer struct {
+	NoopTracer
+	reader   acmstate.Reader
+	accounts map[crypto.Address]*acm.Account
+	storage  map[crypto.Address]map[Word256][]byte
+	err      error
+}
+
+// reader should provide the state against which execution is run, the EVM only writes back to it once execution has
+// completed so it continues to reflect the prestate during execution
+func NewPrestateTracer(reader acmstate.Reader) *PrestateTracer {
+	return &PrestateTracer{
+		reader:   reader,
+		accounts: make(map[crypto.Address]*acm.Account),
+		storage:  make(map[crypto.Address]map[Word256][]byte),
+	}
+}
+
+// Accounts entered by calls as they were before execution, an account that did not exist is recorded as nil
+func (t *PrestateTracer) Accounts() map[crypto.Address]*acm.Account {
+	return t.accounts
+}
+
+// Storage read or written during execution as it was before execution
+func (t *PrestateTracer) Storage() map[crypto.Address]map[Word256][]byte {
+	return t.storage
+}
+
+// Returns the first error encountered reading the prestate
+func (t *PrestateTracer) Error() error {
+	return t.err
+}
+
+func (t *PrestateTracer) CaptureEnter(depth uint64, params engine.CallParams, code []byte) {
+	t.captureAccount(params.Caller)
+	t.captureAccount(params.Callee)
+}
+
+func (t *PrestateTracer) CaptureStorageRead(address crypto.Address, key Word256, value []byte) {
+	t.captureStorage(address, key)
+}
+
+func (t *PrestateTracer) CaptureStorageWrite(address crypto.Address, key Word256, value []byte) {
+	t.captureStorage(address, key)
+}
+
+func (t *PrestateTracer) captureAccount(address crypto.Address) {
+	if _, ok := t.accounts[address]; ok {
+		return
+	}
+	acc, err := t.reader.GetAccount(address)
+	if err != nil {
+		t.setError(err)
+		return
+	}
+	t.accounts[address] = acc
+}
+
+func (t *PrestateTracer) captureStorage(address crypto.Address, key Word256) {
+	storage, ok := t.storage[address]
+	if !ok {
+		storage = make(map[Word256][]byte)
+		t.storage[address] = storage
+	}
+	if _, ok := storage[key]; ok {
+		return
+	}
+	value, err := t.reader.GetStorage(address, key)
+	if err != nil {
+		t.setError(err)
+		return
+	}
+	storage[key] = value
+}
+
+func (t *PrestateTracer) setError(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+// FourByteTracer counts calls by 4-byte function selector and length of the ABI-encoded arguments, keyed like
+// "0xa9059cbb-64". This is useful for identifying the functions called by a transaction without knowing the ABIs.
+type FourByteTracer struct {
+	NoopTracer
+	counts map[string]uint64
+}
+
+func NewFourByteTracer() *FourByteTracer {
+	return &FourByteTracer{
+		counts: make(map[string]uint64),
+	}
+}
+
+func (t *FourByteTracer) Counts() map[string]uint64 {
+	return t.counts
+}
+
+func (t *FourByteTracer) CaptureEnter(depth uint64, params engine.CallParams, code []byte) {
+	// The init code of a contract being created is passed as both its code and input
+	if len(params.Input) < 4 || len(code) == 0 || bytes.Equal(code, params.Input) {
+		return
+	}
+	t.counts[fmt.Sprintf("0x%x-%d", params.Input[:4], len(params.Input)-4)]++
+}
+
+func copyBytes(bs []byte) []byte {
+	if bs == nil {
+		return nil
+	}
+	return append([]byte{}, bs...)
+}
@@ -22,6 +22,10 @@ func (be *BlockExecution) StreamEvents() []*StreamEvent {
 			PredecessorHeight: be.PredecessorHeight,
 			NumTxs:            uint64(len(be.TxExecutions)),
 			Header:            be.Header,
+			BaseFee:           be.BaseFee,
+			GasUsed:           be.GasUsed,
+			Evidence:          be.Evidence,
+			ExpiredNames:      be.ExpiredNames,
 		},
 	})
 	for _, txe := range be.TxExecutions {
@@ -34,6 +38,12 @@ func (be *BlockExecution) StreamEvents() []*StreamEvent {
 	})
 }
 
+// Empty returns whether the block has no transactions, evidence, or expired names, in which case it is not stored in
+// state
+func (be *BlockExecution) Empty() bool {
+	return len(be.TxExecutions) == 0 && len(be.Evidence) == 0 && len(be.ExpiredNames) == 0
+}
+
 func (*BlockExecution) EventType() EventType {
 	return TypeBlockExecution
 }
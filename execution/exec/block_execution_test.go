@@ -3,9 +3,9 @@ package exec
 import (
 	"testing"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/stretchr/testify/require"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func TestBlockExecution_Marshal(t *testing.T) {
@@ -8,6 +8,10 @@ const (
 	CallTypeCode     = CallType(0x01)
 	CallTypeDelegate = CallType(0x02)
 	CallTypeStatic   = CallType(0x03)
+	// Contract creation by a CallTx without an address or by CREATE is only recorded as such when the call tree is
+	// recorded, otherwise it is recorded as a Call
+	CallTypeCreate  = CallType(0x04)
+	CallTypeCreate2 = CallType(0x05)
 )
 
 var nameFromCallType = map[CallType]string{
@@ -16,6 +20,8 @@ var nameFromCallType = map[CallType]string{
 	CallTypeCode:     "CallCode",
 	CallTypeDelegate: "DelegateCall",
 	CallTypeStatic:   "StaticCall",
+	CallTypeCreate:   "Create",
+	CallTypeCreate2:  "Create2",
 }
 
 var callTypeFromName = make(map[string]CallType)
@@ -30,6 +36,11 @@ func CallTypeFromString(name string) CallType {
 	return callTypeFromName[name]
 }
 
+// Whether a call of this type creates a contract
+func (ct CallType) IsCreate() bool {
+	return ct == CallTypeCreate || ct == CallTypeCreate2
+}
+
 func (ct CallType) String() string {
 	name, ok := nameFromCallType[ct]
 	if ok {
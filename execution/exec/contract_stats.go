@@ -0,0 +1,89 @@
+package exec
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// ContractStat counts the calls made to a single contract
+type ContractStat struct {
+	Address crypto.Address
+	// Calls made to the contract by transactions and by other contracts
+	Calls uint64
+	// Calls that failed with an exception
+	Failures uint64
+	// Gas used by transactions that called the contract directly, including that of any calls it made
+	GasUsed uint64
+}
+
+type ContractStatsGetter interface {
+	// Returns the stats of each contract called in descending order of gas used
+	GetContractStats() []ContractStat
+}
+
+// ContractStats accumulates ContractStat from the blocks committed by a node since it started. They are local to the
+// node rather than part of state so may differ between nodes.
+type ContractStats struct {
+	sync.RWMutex
+	contracts map[crypto.Address]*ContractStat
+}
+
+var _ ContractStatsGetter = (*ContractStats)(nil)
+
+func NewContractStats() *ContractStats {
+	return &ContractStats{
+		contracts: make(map[crypto.Address]*ContractStat),
+	}
+}
+
+// AddBlock counts the calls made by the transactions of a committed block
+func (cs *ContractStats) AddBlock(be *BlockExecution) {
+	cs.Lock()
+	defer cs.Unlock()
+	for _, txe := range be.TxExecutions {
+		for _, ev := range txe.Events {
+			if ev.Call == nil {
+				continue
+			}
+			stat := cs.contract(ev.Call.CallData.Callee)
+			stat.Calls++
+			if ev.Header.GetException() != nil {
+				stat.Failures++
+			}
+			if ev.Call.StackDepth == 0 {
+				stat.GasUsed += txe.GetResult().GetGasUsed()
+			}
+		}
+	}
+}
+
+func (cs *ContractStats) GetContractStats() []ContractStat {
+	if cs == nil {
+		return nil
+	}
+	cs.RLock()
+	defer cs.RUnlock()
+	stats := make([]ContractStat, 0, len(cs.contracts))
+	for _, stat := range cs.contracts {
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].GasUsed != stats[j].GasUsed {
+			return stats[i].GasUsed > stats[j].GasUsed
+		}
+		return bytes.Compare(stats[i].Address[:], stats[j].Address[:]) < 0
+	})
+	return stats
+}
+
+func (cs *ContractStats) contract(address crypto.Address) *ContractStat {
+	stat, ok := cs.contracts[address]
+	if !ok {
+		stat = &ContractStat{Address: address}
+		cs.contracts[address] = stat
+	}
+	return stat
+}
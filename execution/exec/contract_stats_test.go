@@ -0,0 +1,50 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractStats_AddBlock(t *testing.T) {
+	token := crypto.Address{1}
+	exchange := crypto.Address{2}
+	call := func(callee crypto.Address, stackDepth uint64, exception *errors.Exception) *Event {
+		return &Event{
+			Header: &Header{Exception: exception},
+			Call: &CallEvent{
+				CallData:   &CallData{Callee: callee},
+				StackDepth: stackDepth,
+			},
+		}
+	}
+
+	stats := NewContractStats()
+	stats.AddBlock(&BlockExecution{
+		TxExecutions: []*TxExecution{
+			{
+				Result: &Result{GasUsed: 100},
+				Events: []*Event{call(token, 0, nil)},
+			},
+			{
+				Result: &Result{GasUsed: 300},
+				Events: []*Event{call(token, 1, nil), call(exchange, 0, nil)},
+			},
+			{
+				Result:    &Result{GasUsed: 50},
+				Exception: errors.AsException(errors.Codes.ExecutionReverted),
+				Events:    []*Event{call(exchange, 0, errors.AsException(errors.Codes.ExecutionReverted))},
+			},
+		},
+	})
+
+	require.Equal(t, []ContractStat{
+		{Address: exchange, Calls: 2, Failures: 1, GasUsed: 350},
+		{Address: token, Calls: 2, GasUsed: 100},
+	}, stats.GetContractStats())
+
+	var none *ContractStats
+	require.Empty(t, none.GetContractStats())
+}
@@ -0,0 +1,24 @@
+package exec
+
+import (
+	"fmt"
+
+	abciTypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// EvidenceFromABCI converts the misbehaviour CometBFT passes to FinalizeBlock
+func EvidenceFromABCI(ev abciTypes.Misbehavior) (*Evidence, error) {
+	address, err := crypto.AddressFromBytes(ev.Validator.Address)
+	if err != nil {
+		return nil, fmt.Errorf("could not read address of validator in evidence: %w", err)
+	}
+	return &Evidence{
+		Type:             ev.Type.String(),
+		Address:          address,
+		Power:            ev.Validator.Power,
+		Height:           uint64(ev.Height),
+		Time:             ev.Time,
+		TotalVotingPower: ev.TotalVotingPower,
+	}, nil
+}
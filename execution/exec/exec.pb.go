@@ -10,11 +10,13 @@ import (
 	math_bits "math/bits"
 	time "time"
 
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
+	acm "github.com/hyperledger/burrow/acm"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 	errors "github.com/hyperledger/burrow/execution/errors"
@@ -24,7 +26,6 @@ import (
 	github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
 	txs "github.com/hyperledger/burrow/txs"
 	github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
-	types "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,11 +175,19 @@ type BeginBlock struct {
 	// The number of transactions in the block (used as a checksum when consuming StreamEvents)
 	NumTxs uint64 `protobuf:"varint,3,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
 	// The height of the most recent block we stored in state (which is the last non-empty block in current implementation)
-	PredecessorHeight    uint64        `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
-	Header               *types.Header `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	PredecessorHeight uint64        `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
+	Header            *types.Header `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	// The base fee per unit of gas when the fee market is enabled
+	BaseFee uint64 `protobuf:"varint,5,opt,name=BaseFee,proto3" json:"BaseFee,omitempty"`
+	// The total gas used by transactions in this block
+	GasUsed uint64 `protobuf:"varint,6,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	// Evidence of validator misbehaviour committed in this block
+	Evidence []*Evidence `protobuf:"bytes,7,rep,name=Evidence,proto3" json:"Evidence,omitempty"`
+	// Name registry entries that expired and were removed from state at the end of this block
+	ExpiredNames         []*names.Entry `protobuf:"bytes,8,rep,name=ExpiredNames,proto3" json:"ExpiredNames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *BeginBlock) Reset()         { *m = BeginBlock{} }
@@ -238,6 +247,34 @@ func (m *BeginBlock) GetHeader() *types.Header {
 	return nil
 }
 
+func (m *BeginBlock) GetBaseFee() uint64 {
+	if m != nil {
+		return m.BaseFee
+	}
+	return 0
+}
+
+func (m *BeginBlock) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BeginBlock) GetEvidence() []*Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *BeginBlock) GetExpiredNames() []*names.Entry {
+	if m != nil {
+		return m.ExpiredNames
+	}
+	return nil
+}
+
 func (*BeginBlock) XXX_MessageName() string {
 	return "exec.BeginBlock"
 }
@@ -296,10 +333,12 @@ type BeginTx struct {
 	// Result of tx execution
 	Result *Result `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
 	// If tx execution was an exception
-	Exception            *errors.Exception `protobuf:"bytes,4,opt,name=Exception,proto3" json:"Exception,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Exception *errors.Exception `protobuf:"bytes,4,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	// The accounts and storage accessed by the transaction (if recorded)
+	AccessSet            *AccessSet `protobuf:"bytes,6,opt,name=AccessSet,proto3" json:"AccessSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *BeginTx) Reset()         { *m = BeginTx{} }
@@ -359,6 +398,13 @@ func (m *BeginTx) GetException() *errors.Exception {
 	return nil
 }
 
+func (m *BeginTx) GetAccessSet() *AccessSet {
+	if m != nil {
+		return m.AccessSet
+	}
+	return nil
+}
+
 func (*BeginTx) XXX_MessageName() string {
 	return "exec.BeginTx"
 }
@@ -485,9 +531,17 @@ type BlockExecution struct {
 	// The height of this block
 	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 	// The height of the most recent block we stored in state (which is the last non-empty block in current implementation)
-	PredecessorHeight    uint64         `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
-	Header               *types.Header  `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
-	TxExecutions         []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	PredecessorHeight uint64         `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
+	Header            *types.Header  `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	TxExecutions      []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	// The base fee per unit of gas when the fee market is enabled
+	BaseFee uint64 `protobuf:"varint,5,opt,name=BaseFee,proto3" json:"BaseFee,omitempty"`
+	// The total gas used by transactions in this block
+	GasUsed uint64 `protobuf:"varint,6,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	// Evidence of validator misbehaviour committed in this block
+	Evidence []*Evidence `protobuf:"bytes,7,rep,name=Evidence,proto3" json:"Evidence,omitempty"`
+	// Name registry entries that expired and were removed from state at the end of this block
+	ExpiredNames         []*names.Entry `protobuf:"bytes,8,rep,name=ExpiredNames,proto3" json:"ExpiredNames,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -550,10 +604,125 @@ func (m *BlockExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *BlockExecution) GetBaseFee() uint64 {
+	if m != nil {
+		return m.BaseFee
+	}
+	return 0
+}
+
+func (m *BlockExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockExecution) GetEvidence() []*Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *BlockExecution) GetExpiredNames() []*names.Entry {
+	if m != nil {
+		return m.ExpiredNames
+	}
+	return nil
+}
+
 func (*BlockExecution) XXX_MessageName() string {
 	return "exec.BlockExecution"
 }
 
+// Evidence that a validator misbehaved, for which it may be slashed
+type Evidence struct {
+	// The kind of misbehaviour, either DUPLICATE_VOTE or LIGHT_CLIENT_ATTACK
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// The address of the misbehaving validator
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The power of the validator at Height
+	Power int64 `protobuf:"varint,3,opt,name=Power,proto3" json:"Power,omitempty"`
+	// The height at which the validator misbehaved
+	Height uint64 `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The time of the block at Height
+	Time time.Time `protobuf:"bytes,5,opt,name=Time,proto3,stdtime" json:"Time"`
+	// The total power of the validator set at Height
+	TotalVotingPower     int64    `protobuf:"varint,6,opt,name=TotalVotingPower,proto3" json:"TotalVotingPower,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Evidence) Reset()         { *m = Evidence{} }
+func (m *Evidence) String() string { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()    {}
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{8}
+}
+func (m *Evidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Evidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Evidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Evidence.Merge(m, src)
+}
+func (m *Evidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Evidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Evidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Evidence proto.InternalMessageInfo
+
+func (m *Evidence) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Evidence) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *Evidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Evidence) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Evidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (*Evidence) XXX_MessageName() string {
+	return "exec.Evidence"
+}
+
 type TxExecutionKey struct {
 	// The block height
 	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
@@ -568,7 +737,7 @@ func (m *TxExecutionKey) Reset()         { *m = TxExecutionKey{} }
 func (m *TxExecutionKey) String() string { return proto.CompactTextString(m) }
 func (*TxExecutionKey) ProtoMessage()    {}
 func (*TxExecutionKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{8}
+	return fileDescriptor_4d737c7315c25422, []int{9}
 }
 func (m *TxExecutionKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,17 +793,19 @@ type TxExecution struct {
 	// If execution was an exception
 	Exception *errors.Exception `protobuf:"bytes,10,opt,name=Exception,proto3" json:"Exception,omitempty"`
 	// A proposal may contain other transactions
-	TxExecutions         []*TxExecution `protobuf:"bytes,11,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	TxExecutions []*TxExecution `protobuf:"bytes,11,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	// The accounts and storage read and changed by the transaction (if recorded)
+	AccessSet            *AccessSet `protobuf:"bytes,12,opt,name=AccessSet,proto3" json:"AccessSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *TxExecution) Reset()         { *m = TxExecution{} }
 func (m *TxExecution) String() string { return proto.CompactTextString(m) }
 func (*TxExecution) ProtoMessage()    {}
 func (*TxExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{9}
+	return fileDescriptor_4d737c7315c25422, []int{10}
 }
 func (m *TxExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,6 +865,13 @@ func (m *TxExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *TxExecution) GetAccessSet() *AccessSet {
+	if m != nil {
+		return m.AccessSet
+	}
+	return nil
+}
+
 func (*TxExecution) XXX_MessageName() string {
 	return "exec.TxExecution"
 }
@@ -716,7 +894,7 @@ func (m *Origin) Reset()         { *m = Origin{} }
 func (m *Origin) String() string { return proto.CompactTextString(m) }
 func (*Origin) ProtoMessage()    {}
 func (*Origin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{10}
+	return fileDescriptor_4d737c7315c25422, []int{11}
 }
 func (m *Origin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +974,7 @@ type Header struct {
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{11}
+	return fileDescriptor_4d737c7315c25422, []int{12}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1061,7 @@ type Event struct {
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{12}
+	return fileDescriptor_4d737c7315c25422, []int{13}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,7 +1158,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{13}
+	return fileDescriptor_4d737c7315c25422, []int{14}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,19 +1216,21 @@ func (*Result) XXX_MessageName() string {
 }
 
 type LogEvent struct {
-	Address              github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Data                 github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
-	Topics               []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Data    github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
+	Topics  []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
+	// The event decoded with the ABI registered for the contract when requested over RPC, not part of the recorded execution
+	Decoded              *Decoded `protobuf:"bytes,4,opt,name=Decoded,proto3" json:"Decoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LogEvent) Reset()         { *m = LogEvent{} }
 func (m *LogEvent) String() string { return proto.CompactTextString(m) }
 func (*LogEvent) ProtoMessage()    {}
 func (*LogEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{14}
+	return fileDescriptor_4d737c7315c25422, []int{15}
 }
 func (m *LogEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,26 +1255,39 @@ func (m *LogEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LogEvent proto.InternalMessageInfo
 
+func (m *LogEvent) GetDecoded() *Decoded {
+	if m != nil {
+		return m.Decoded
+	}
+	return nil
+}
+
 func (*LogEvent) XXX_MessageName() string {
 	return "exec.LogEvent"
 }
 
 type CallEvent struct {
-	CallType             CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
-	CallData             *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
-	Origin               github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
-	StackDepth           uint64                                        `protobuf:"varint,3,opt,name=StackDepth,proto3" json:"StackDepth,omitempty"`
-	Return               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Return,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Return"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+	CallType   CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
+	CallData   *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
+	Origin     github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
+	StackDepth uint64                                        `protobuf:"varint,3,opt,name=StackDepth,proto3" json:"StackDepth,omitempty"`
+	Return     github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Return,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Return"`
+	// The call data decoded with the ABI registered for the callee when requested over RPC, not part of the recorded execution
+	Decoded *Decoded `protobuf:"bytes,6,opt,name=Decoded,proto3" json:"Decoded,omitempty"`
+	// The address of the code run for a CallCode or DelegateCall when the call tree is recorded
+	CodeAddress *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,7,opt,name=CodeAddress,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"CodeAddress,omitempty"`
+	// The gas used by the call, including that used by any calls it made, when the call tree is recorded
+	GasUsed              uint64   `protobuf:"varint,8,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CallEvent) Reset()         { *m = CallEvent{} }
 func (m *CallEvent) String() string { return proto.CompactTextString(m) }
 func (*CallEvent) ProtoMessage()    {}
 func (*CallEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{15}
+	return fileDescriptor_4d737c7315c25422, []int{16}
 }
 func (m *CallEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1140,10 +1333,154 @@ func (m *CallEvent) GetStackDepth() uint64 {
 	return 0
 }
 
+func (m *CallEvent) GetDecoded() *Decoded {
+	if m != nil {
+		return m.Decoded
+	}
+	return nil
+}
+
+func (m *CallEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func (*CallEvent) XXX_MessageName() string {
 	return "exec.CallEvent"
 }
 
+// A function call or event decoded with an ABI
+type Decoded struct {
+	// The name of the function or event
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The signature of the function or event, e.g. Transfer(address,address,uint256)
+	Signature            string             `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Args                 []*DecodedArgument `protobuf:"bytes,3,rep,name=Args,proto3" json:"Args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Decoded) Reset()         { *m = Decoded{} }
+func (m *Decoded) String() string { return proto.CompactTextString(m) }
+func (*Decoded) ProtoMessage()    {}
+func (*Decoded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{17}
+}
+func (m *Decoded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Decoded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Decoded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Decoded.Merge(m, src)
+}
+func (m *Decoded) XXX_Size() int {
+	return m.Size()
+}
+func (m *Decoded) XXX_DiscardUnknown() {
+	xxx_messageInfo_Decoded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Decoded proto.InternalMessageInfo
+
+func (m *Decoded) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Decoded) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *Decoded) GetArgs() []*DecodedArgument {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (*Decoded) XXX_MessageName() string {
+	return "exec.Decoded"
+}
+
+type DecodedArgument struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	// The value formatted as a string
+	Value                string   `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodedArgument) Reset()         { *m = DecodedArgument{} }
+func (m *DecodedArgument) String() string { return proto.CompactTextString(m) }
+func (*DecodedArgument) ProtoMessage()    {}
+func (*DecodedArgument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{18}
+}
+func (m *DecodedArgument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedArgument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DecodedArgument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedArgument.Merge(m, src)
+}
+func (m *DecodedArgument) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedArgument) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedArgument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedArgument proto.InternalMessageInfo
+
+func (m *DecodedArgument) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DecodedArgument) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DecodedArgument) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (*DecodedArgument) XXX_MessageName() string {
+	return "exec.DecodedArgument"
+}
+
 type PrintEvent struct {
 	Address              github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 	Data                 github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
@@ -1156,7 +1493,7 @@ func (m *PrintEvent) Reset()         { *m = PrintEvent{} }
 func (m *PrintEvent) String() string { return proto.CompactTextString(m) }
 func (*PrintEvent) ProtoMessage()    {}
 func (*PrintEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{16}
+	return fileDescriptor_4d737c7315c25422, []int{19}
 }
 func (m *PrintEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1196,7 +1533,7 @@ func (m *GovernAccountEvent) Reset()         { *m = GovernAccountEvent{} }
 func (m *GovernAccountEvent) String() string { return proto.CompactTextString(m) }
 func (*GovernAccountEvent) ProtoMessage()    {}
 func (*GovernAccountEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{17}
+	return fileDescriptor_4d737c7315c25422, []int{20}
 }
 func (m *GovernAccountEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1580,7 @@ func (m *InputEvent) Reset()         { *m = InputEvent{} }
 func (m *InputEvent) String() string { return proto.CompactTextString(m) }
 func (*InputEvent) ProtoMessage()    {}
 func (*InputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{18}
+	return fileDescriptor_4d737c7315c25422, []int{21}
 }
 func (m *InputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1620,7 @@ func (m *OutputEvent) Reset()         { *m = OutputEvent{} }
 func (m *OutputEvent) String() string { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()    {}
 func (*OutputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{19}
+	return fileDescriptor_4d737c7315c25422, []int{22}
 }
 func (m *OutputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1665,7 @@ func (m *CallData) Reset()         { *m = CallData{} }
 func (m *CallData) String() string { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()    {}
 func (*CallData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{20}
+	return fileDescriptor_4d737c7315c25422, []int{23}
 }
 func (m *CallData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,23 +1707,235 @@ func (m *CallData) GetGas() []byte {
 func (*CallData) XXX_MessageName() string {
 	return "exec.CallData"
 }
-func init() {
-	proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
-	golang_proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
-	proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
-	golang_proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
-	proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
-	golang_proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
-	proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
-	golang_proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
-	proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
-	golang_proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
-	proto.RegisterType((*EndTx)(nil), "exec.EndTx")
-	golang_proto.RegisterType((*EndTx)(nil), "exec.EndTx")
-	proto.RegisterType((*TxHeader)(nil), "exec.TxHeader")
+
+// The changes made to state by a transaction
+type StateDiff struct {
+	// Accounts created or updated
+	UpdatedAccounts []*acm.Account                                 `protobuf:"bytes,1,rep,name=UpdatedAccounts,proto3" json:"UpdatedAccounts,omitempty"`
+	RemovedAccounts []github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,rep,name=RemovedAccounts,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"RemovedAccounts"`
+	// Storage slots whose value was changed
+	Storage              []StorageDiff `protobuf:"bytes,3,rep,name=Storage,proto3" json:"Storage"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StateDiff) Reset()         { *m = StateDiff{} }
+func (m *StateDiff) String() string { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()    {}
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{24}
+}
+func (m *StateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiff.Merge(m, src)
+}
+func (m *StateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiff proto.InternalMessageInfo
+
+func (m *StateDiff) GetUpdatedAccounts() []*acm.Account {
+	if m != nil {
+		return m.UpdatedAccounts
+	}
+	return nil
+}
+
+func (m *StateDiff) GetStorage() []StorageDiff {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (*StateDiff) XXX_MessageName() string {
+	return "exec.StateDiff"
+}
+
+// The accounts and storage slots touched by a transaction
+type AccessSet struct {
+	// Accounts and storage slots read
+	Reads []Access `protobuf:"bytes,1,rep,name=Reads,proto3" json:"Reads"`
+	// Accounts and storage slots whose value was changed
+	Writes               []Access `protobuf:"bytes,2,rep,name=Writes,proto3" json:"Writes"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessSet) Reset()         { *m = AccessSet{} }
+func (m *AccessSet) String() string { return proto.CompactTextString(m) }
+func (*AccessSet) ProtoMessage()    {}
+func (*AccessSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{25}
+}
+func (m *AccessSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccessSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessSet.Merge(m, src)
+}
+func (m *AccessSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessSet proto.InternalMessageInfo
+
+func (m *AccessSet) GetReads() []Access {
+	if m != nil {
+		return m.Reads
+	}
+	return nil
+}
+
+func (m *AccessSet) GetWrites() []Access {
+	if m != nil {
+		return m.Writes
+	}
+	return nil
+}
+
+func (*AccessSet) XXX_MessageName() string {
+	return "exec.AccessSet"
+}
+
+type Access struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Whether the account itself was accessed rather than only its storage
+	Account bool `protobuf:"varint,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	// Storage slots accessed in ascending order
+	Keys                 []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Keys,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Keys"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *Access) Reset()         { *m = Access{} }
+func (m *Access) String() string { return proto.CompactTextString(m) }
+func (*Access) ProtoMessage()    {}
+func (*Access) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{26}
+}
+func (m *Access) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Access) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Access) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Access.Merge(m, src)
+}
+func (m *Access) XXX_Size() int {
+	return m.Size()
+}
+func (m *Access) XXX_DiscardUnknown() {
+	xxx_messageInfo_Access.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Access proto.InternalMessageInfo
+
+func (m *Access) GetAccount() bool {
+	if m != nil {
+		return m.Account
+	}
+	return false
+}
+
+func (*Access) XXX_MessageName() string {
+	return "exec.Access"
+}
+
+type StorageDiff struct {
+	Address              github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key                  github_com_hyperledger_burrow_binary.Word256  `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	Value                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *StorageDiff) Reset()         { *m = StorageDiff{} }
+func (m *StorageDiff) String() string { return proto.CompactTextString(m) }
+func (*StorageDiff) ProtoMessage()    {}
+func (*StorageDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{27}
+}
+func (m *StorageDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StorageDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDiff.Merge(m, src)
+}
+func (m *StorageDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDiff proto.InternalMessageInfo
+
+func (*StorageDiff) XXX_MessageName() string {
+	return "exec.StorageDiff"
+}
+func init() {
+	proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
+	golang_proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
+	proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
+	golang_proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
+	proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
+	golang_proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
+	proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
+	golang_proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
+	proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
+	golang_proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
+	proto.RegisterType((*EndTx)(nil), "exec.EndTx")
+	golang_proto.RegisterType((*EndTx)(nil), "exec.EndTx")
+	proto.RegisterType((*TxHeader)(nil), "exec.TxHeader")
 	golang_proto.RegisterType((*TxHeader)(nil), "exec.TxHeader")
 	proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
 	golang_proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
+	proto.RegisterType((*Evidence)(nil), "exec.Evidence")
+	golang_proto.RegisterType((*Evidence)(nil), "exec.Evidence")
 	proto.RegisterType((*TxExecutionKey)(nil), "exec.TxExecutionKey")
 	golang_proto.RegisterType((*TxExecutionKey)(nil), "exec.TxExecutionKey")
 	proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
@@ -1403,6 +1952,10 @@ func init() {
 	golang_proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
 	proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
 	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
+	proto.RegisterType((*Decoded)(nil), "exec.Decoded")
+	golang_proto.RegisterType((*Decoded)(nil), "exec.Decoded")
+	proto.RegisterType((*DecodedArgument)(nil), "exec.DecodedArgument")
+	golang_proto.RegisterType((*DecodedArgument)(nil), "exec.DecodedArgument")
 	proto.RegisterType((*PrintEvent)(nil), "exec.PrintEvent")
 	golang_proto.RegisterType((*PrintEvent)(nil), "exec.PrintEvent")
 	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
@@ -1413,97 +1966,131 @@ func init() {
 	golang_proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
 	proto.RegisterType((*CallData)(nil), "exec.CallData")
 	golang_proto.RegisterType((*CallData)(nil), "exec.CallData")
+	proto.RegisterType((*StateDiff)(nil), "exec.StateDiff")
+	golang_proto.RegisterType((*StateDiff)(nil), "exec.StateDiff")
+	proto.RegisterType((*AccessSet)(nil), "exec.AccessSet")
+	golang_proto.RegisterType((*AccessSet)(nil), "exec.AccessSet")
+	proto.RegisterType((*Access)(nil), "exec.Access")
+	golang_proto.RegisterType((*Access)(nil), "exec.Access")
+	proto.RegisterType((*StorageDiff)(nil), "exec.StorageDiff")
+	golang_proto.RegisterType((*StorageDiff)(nil), "exec.StorageDiff")
 }
 
 func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
 func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
 
 var fileDescriptor_4d737c7315c25422 = []byte{
-	// 1340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xef, 0xda, 0x6b, 0xc7, 0x7e, 0x76, 0xfa, 0x6d, 0x47, 0xf9, 0x22, 0xab, 0xaa, 0xec, 0xb2,
-	0x45, 0xa5, 0x94, 0xb2, 0xae, 0x02, 0x45, 0xa8, 0x48, 0x88, 0xba, 0x09, 0x6d, 0xa0, 0xa4, 0x65,
-	0xea, 0x16, 0x81, 0xe0, 0xb0, 0xf1, 0x4e, 0x9d, 0x55, 0xed, 0xdd, 0xd5, 0xec, 0xb8, 0xac, 0xff,
-	0x05, 0x4e, 0x1c, 0x8b, 0x84, 0x50, 0x6f, 0x48, 0xfc, 0x07, 0x88, 0x03, 0x1c, 0x73, 0xa3, 0x47,
-	0xd4, 0x83, 0x41, 0xe9, 0x5f, 0x80, 0x38, 0xd1, 0x13, 0x9a, 0x99, 0x37, 0xeb, 0x5d, 0xd2, 0x26,
-	0x15, 0x09, 0x52, 0x2f, 0xd1, 0xbc, 0xf7, 0x3e, 0xfb, 0xfc, 0x7e, 0x7c, 0xde, 0x9b, 0x09, 0x00,
-	0x4b, 0xd9, 0xc0, 0x8d, 0x79, 0x24, 0x22, 0x62, 0xcb, 0xf3, 0xb1, 0xa5, 0x61, 0x34, 0x8c, 0x94,
-	0xa2, 0x2b, 0x4f, 0xda, 0x76, 0xec, 0xb8, 0x60, 0xa1, 0xcf, 0xf8, 0x38, 0x08, 0x45, 0x57, 0x4c,
-	0x63, 0x96, 0xe8, 0xbf, 0x68, 0xed, 0x0c, 0xa3, 0x68, 0x38, 0x62, 0x5d, 0x25, 0x6d, 0x4c, 0x6e,
-	0x77, 0x45, 0x30, 0x66, 0x89, 0xf0, 0xc6, 0x31, 0x02, 0x9a, 0x8c, 0xf3, 0x88, 0x1b, 0x78, 0x23,
-	0xf4, 0xc6, 0xd9, 0xb7, 0x75, 0x91, 0x9a, 0xe3, 0x91, 0x58, 0xfe, 0x42, 0x92, 0x04, 0x51, 0x88,
-	0x1a, 0x48, 0x62, 0x13, 0x9e, 0xb3, 0x0a, 0xcd, 0x1b, 0x82, 0x33, 0x6f, 0xbc, 0x7a, 0x97, 0x85,
-	0x22, 0x21, 0xe7, 0x8b, 0x72, 0xcb, 0x3a, 0x51, 0x3e, 0xdd, 0x58, 0x3e, 0xea, 0xaa, 0x8c, 0x72,
-	0x16, 0x5a, 0x80, 0x39, 0x3f, 0x96, 0xa0, 0x91, 0x53, 0x90, 0x73, 0x00, 0x3d, 0x36, 0x0c, 0xc2,
-	0xde, 0x28, 0x1a, 0xdc, 0x69, 0x59, 0x27, 0xac, 0xd3, 0x8d, 0xe5, 0x23, 0xda, 0xc9, 0x5c, 0x4f,
-	0x73, 0x18, 0xf2, 0x32, 0x2c, 0x28, 0xa9, 0x9f, 0xb6, 0x4a, 0x0a, 0xbe, 0x98, 0x83, 0xf7, 0x53,
-	0x6a, 0xac, 0xe4, 0x13, 0xa8, 0xad, 0x86, 0x77, 0xd9, 0x28, 0x8a, 0x59, 0xab, 0x8c, 0x48, 0x99,
-	0xad, 0x51, 0xf6, 0xdc, 0x87, 0xb3, 0xce, 0x99, 0x61, 0x20, 0x36, 0x27, 0x1b, 0xee, 0x20, 0x1a,
-	0x77, 0x37, 0xa7, 0x31, 0xe3, 0x23, 0xe6, 0x0f, 0x19, 0xef, 0x6e, 0x4c, 0x38, 0x8f, 0xbe, 0xe8,
-	0xe6, 0xf1, 0x34, 0x73, 0x47, 0x5e, 0x84, 0x8a, 0x0a, 0xbf, 0x65, 0x2b, 0xbf, 0x0d, 0x1d, 0x81,
-	0xce, 0x57, 0x5b, 0x14, 0x24, 0xf4, 0xfb, 0x69, 0xab, 0x52, 0x80, 0x48, 0x15, 0xd5, 0x16, 0x72,
-	0x46, 0x06, 0xe8, 0xeb, 0xcc, 0xab, 0x0a, 0x75, 0x38, 0x43, 0xe9, 0xbc, 0x33, 0xfb, 0x05, 0x7b,
-	0xeb, 0x7e, 0xc7, 0x72, 0xbe, 0xb1, 0xf2, 0xe5, 0x22, 0x2f, 0x40, 0xf5, 0x0a, 0x0b, 0x86, 0x9b,
-	0x42, 0x15, 0xce, 0xa6, 0x28, 0x49, 0xfd, 0xfa, 0x64, 0xdc, 0x4f, 0x13, 0x95, 0xb7, 0x4d, 0x51,
-	0x22, 0x67, 0xe1, 0xe8, 0x75, 0xce, 0x7c, 0x36, 0x60, 0x49, 0x12, 0x71, 0xfc, 0xd4, 0x56, 0x90,
-	0x9d, 0x06, 0x72, 0x4e, 0x7a, 0xf7, 0x7c, 0xc6, 0xb1, 0xce, 0x2d, 0x77, 0xce, 0x42, 0x57, 0xf3,
-	0x4f, 0xdb, 0x29, 0xe2, 0x1c, 0x67, 0x9e, 0xd0, 0xd3, 0x62, 0x73, 0xbe, 0xb7, 0xb2, 0xfe, 0xc9,
-	0x02, 0xf4, 0x53, 0xfc, 0x0d, 0x2b, 0x5f, 0x00, 0xa3, 0xa5, 0x99, 0x9d, 0x1c, 0x87, 0xfa, 0xfa,
-	0xc4, 0x90, 0xad, 0xa2, 0x5c, 0xce, 0x15, 0xe4, 0x25, 0xa8, 0x52, 0x96, 0x4c, 0x46, 0x02, 0x63,
-	0x6d, 0x6a, 0x3f, 0x5a, 0x47, 0xd1, 0x46, 0xba, 0x50, 0x5f, 0x4d, 0x07, 0x2c, 0x16, 0x41, 0x14,
-	0x62, 0xeb, 0x8e, 0xba, 0x38, 0x1b, 0x99, 0x81, 0xce, 0x31, 0xce, 0x2d, 0x6c, 0x22, 0xf9, 0x10,
-	0xaa, 0xfd, 0xf4, 0x8a, 0x97, 0x6c, 0xaa, 0x8a, 0x36, 0x7b, 0xe7, 0xb7, 0x66, 0x9d, 0x43, 0x0f,
-	0x67, 0x9d, 0xd7, 0x76, 0xa7, 0xcf, 0x46, 0x10, 0x7a, 0x7c, 0xea, 0x5e, 0x61, 0x69, 0x6f, 0x2a,
-	0x58, 0x42, 0xd1, 0x89, 0xf3, 0x97, 0x35, 0xcf, 0x9c, 0xbc, 0x2f, 0x7d, 0xf7, 0xa7, 0x31, 0x53,
-	0x35, 0x58, 0xec, 0x2d, 0x3f, 0x9e, 0x75, 0xdc, 0x3d, 0x69, 0xd9, 0x8d, 0xbd, 0xe9, 0x28, 0xf2,
-	0x7c, 0x57, 0x7e, 0x49, 0xd1, 0x43, 0x2e, 0xce, 0xd2, 0x01, 0xc4, 0x99, 0x6b, 0x62, 0xb9, 0x40,
-	0xb0, 0x25, 0xa8, 0xac, 0x85, 0x3e, 0x4b, 0x91, 0x3c, 0x5a, 0x90, 0x4d, 0xb8, 0xc6, 0x83, 0x61,
-	0x10, 0x22, 0xe7, 0xb1, 0x09, 0x5a, 0x47, 0xd1, 0xe6, 0xfc, 0x64, 0xc1, 0x61, 0x45, 0x91, 0xd5,
-	0x94, 0x0d, 0x26, 0xb2, 0xcc, 0x4f, 0xe5, 0xf1, 0x7f, 0xcc, 0x57, 0xb9, 0xc3, 0xfa, 0x69, 0x16,
-	0x86, 0x9c, 0x96, 0xdc, 0x0e, 0xcb, 0x59, 0x68, 0x01, 0xe6, 0xbc, 0x0b, 0x87, 0x73, 0xf2, 0x07,
-	0x6c, 0xba, 0xdb, 0x20, 0x5e, 0xbb, 0x7d, 0x3b, 0x61, 0x9a, 0x96, 0x36, 0x45, 0xc9, 0xf9, 0xa3,
-	0x04, 0x8d, 0x9c, 0x0b, 0x72, 0x36, 0x0b, 0xfd, 0x89, 0x63, 0xd0, 0xb3, 0x1f, 0xcc, 0x3a, 0x56,
-	0x16, 0x76, 0x7e, 0xb1, 0x55, 0x0f, 0x76, 0xb1, 0x9d, 0x84, 0x2a, 0x8e, 0xd8, 0x82, 0xaa, 0x45,
-	0x61, 0xb3, 0x55, 0x77, 0x0c, 0x5b, 0x6d, 0x97, 0x61, 0x3b, 0x05, 0x0b, 0x94, 0x0d, 0x58, 0x10,
-	0x8b, 0x56, 0x1d, 0x61, 0xf2, 0x47, 0x51, 0x47, 0x8d, 0xb1, 0x38, 0x94, 0xb0, 0xf7, 0x50, 0xee,
-	0xe8, 0x5a, 0xe3, 0xd9, 0xba, 0xf6, 0xa5, 0x65, 0xe8, 0x49, 0x5a, 0xb0, 0x70, 0x69, 0xd3, 0x0b,
-	0xc2, 0xb5, 0x15, 0x55, 0xef, 0x3a, 0x35, 0x62, 0xae, 0x91, 0xa5, 0x27, 0x13, 0xbe, 0x9c, 0x27,
-	0xfc, 0x5b, 0x60, 0xf7, 0x83, 0x31, 0xc3, 0x55, 0x72, 0xcc, 0xd5, 0xf7, 0xb0, 0x6b, 0xee, 0x61,
-	0xb7, 0x6f, 0xee, 0xe1, 0x5e, 0x4d, 0xce, 0xe1, 0x57, 0xbf, 0x75, 0x2c, 0xaa, 0xbe, 0x70, 0x7e,
-	0x29, 0x99, 0x8e, 0x3f, 0xcf, 0xe3, 0xff, 0x2a, 0xd4, 0x55, 0xcb, 0x55, 0x74, 0x65, 0x15, 0xdd,
-	0xe2, 0xe3, 0x59, 0x67, 0xae, 0xa4, 0xf3, 0xa3, 0x2c, 0xaa, 0x12, 0xd6, 0x56, 0x54, 0x3d, 0xea,
-	0xd4, 0x88, 0xb9, 0xa2, 0x56, 0x9e, 0x5c, 0xd4, 0x6a, 0xbe, 0xa8, 0x05, 0x3e, 0x2c, 0xec, 0xcd,
-	0x87, 0x0b, 0xf6, 0xbd, 0xfb, 0x9d, 0x43, 0xce, 0x0f, 0x25, 0xbc, 0x93, 0x25, 0x3d, 0x0b, 0xc3,
-	0x84, 0xf4, 0xfc, 0xc7, 0xec, 0x9f, 0x92, 0x3f, 0x1e, 0x4f, 0xcc, 0x85, 0x81, 0x6f, 0x0e, 0xa5,
-	0xc2, 0x7b, 0x5c, 0x9d, 0xc9, 0x2b, 0x50, 0xbd, 0x36, 0x11, 0x12, 0x58, 0x36, 0xb1, 0xa8, 0xa5,
-	0xa6, 0x74, 0x38, 0x17, 0x5a, 0x20, 0x27, 0xc1, 0xbe, 0xe4, 0x8d, 0x46, 0x48, 0x87, 0xff, 0x69,
-	0xa0, 0xd4, 0x68, 0x98, 0x32, 0x92, 0x13, 0x50, 0xbe, 0x1a, 0x0d, 0x71, 0x43, 0xe2, 0x9c, 0x5f,
-	0x8d, 0x86, 0x1a, 0x22, 0x4d, 0xe4, 0x1d, 0x58, 0xbc, 0x1c, 0xdd, 0x65, 0x3c, 0xbc, 0x38, 0x18,
-	0x44, 0x93, 0x50, 0xe0, 0x8c, 0xb7, 0x34, 0xb6, 0x60, 0xd2, 0x5f, 0x15, 0xe1, 0x32, 0xb3, 0xeb,
-	0x3c, 0x08, 0x05, 0x16, 0x0f, 0x33, 0x53, 0x2a, 0xcc, 0x4c, 0x9d, 0x2f, 0xd4, 0x64, 0xdd, 0xd4,
-	0xb3, 0xe2, 0x9e, 0x65, 0x26, 0x5a, 0xf6, 0x8a, 0x32, 0x31, 0xe1, 0xa1, 0x2a, 0x5e, 0x93, 0xa2,
-	0x24, 0xbb, 0x7b, 0xd9, 0x4b, 0x6e, 0x26, 0xcc, 0xc7, 0xc9, 0x30, 0x22, 0x39, 0x03, 0xf5, 0x75,
-	0x6f, 0xcc, 0x56, 0x43, 0xc1, 0xa7, 0x58, 0xa3, 0xa6, 0xab, 0x9f, 0x98, 0x4a, 0x47, 0xe7, 0x66,
-	0x72, 0x0e, 0x6a, 0xd7, 0x19, 0x1f, 0x5f, 0xe4, 0xc3, 0x04, 0xab, 0xb4, 0xe4, 0xe6, 0x5e, 0x9d,
-	0xc6, 0x46, 0x33, 0x94, 0xf3, 0xa7, 0x05, 0x35, 0x53, 0x1e, 0xb2, 0x0e, 0x0b, 0x17, 0x7d, 0x9f,
-	0xb3, 0x24, 0xd1, 0xd1, 0xf5, 0xde, 0x40, 0x7e, 0x9f, 0xdd, 0x9d, 0xdf, 0x03, 0x3e, 0x8d, 0x45,
-	0xe4, 0xe2, 0xb7, 0xd4, 0x38, 0x21, 0x6b, 0x60, 0xaf, 0x78, 0xc2, 0xdb, 0xdf, 0xb0, 0x28, 0x17,
-	0xe4, 0x2a, 0x54, 0xfb, 0x51, 0x1c, 0x0c, 0xf4, 0x25, 0xf2, 0xcc, 0x91, 0xa1, 0xb3, 0x8f, 0x23,
-	0xee, 0x2f, 0x9f, 0x7f, 0x93, 0xa2, 0x0f, 0xe7, 0xdb, 0x12, 0xd4, 0x33, 0xe2, 0x90, 0xd3, 0x50,
-	0x93, 0x82, 0x9a, 0xc2, 0x8a, 0x9a, 0xc2, 0xe6, 0xe3, 0x59, 0x27, 0xd3, 0xd1, 0xec, 0x24, 0x1f,
-	0x54, 0xf2, 0xac, 0x92, 0x2a, 0xdc, 0x24, 0x46, 0x4b, 0x33, 0xbb, 0x8c, 0x18, 0x6f, 0xeb, 0xd2,
-	0x3e, 0x6a, 0x69, 0x56, 0x6a, 0x1b, 0xe0, 0x86, 0xf0, 0x06, 0x77, 0x56, 0x58, 0x2c, 0x36, 0x71,
-	0x4b, 0xe6, 0x34, 0x72, 0x33, 0x21, 0xaf, 0xec, 0x7d, 0x6d, 0x26, 0xed, 0xc4, 0xf9, 0xce, 0x02,
-	0x98, 0x33, 0xfa, 0x39, 0x26, 0x86, 0xf3, 0x11, 0x90, 0x9d, 0x23, 0x4b, 0xde, 0x86, 0x45, 0x94,
-	0x6f, 0xc6, 0xbe, 0x27, 0x18, 0x76, 0xeb, 0xff, 0xae, 0xfa, 0x8f, 0xab, 0xcf, 0xc6, 0xf1, 0xc8,
-	0x13, 0x0c, 0x21, 0xb4, 0x88, 0x75, 0x3e, 0x03, 0x98, 0xef, 0xa9, 0x83, 0xce, 0xdd, 0xf9, 0x1c,
-	0x1a, 0xb9, 0xe5, 0x76, 0xe0, 0xee, 0xbf, 0x2e, 0x41, 0x81, 0x83, 0xf2, 0x8c, 0xab, 0xfa, 0x5f,
-	0x73, 0x50, 0xfb, 0xc8, 0xbc, 0xb1, 0xfd, 0x31, 0x5a, 0xfb, 0xc8, 0x38, 0x50, 0xde, 0xff, 0x72,
-	0x58, 0x82, 0xca, 0x2d, 0x6f, 0x34, 0xd1, 0x0f, 0x85, 0x26, 0xd5, 0x02, 0x39, 0x02, 0xe5, 0xcb,
-	0x9e, 0xfe, 0x5f, 0xa6, 0x49, 0xe5, 0xb1, 0xf7, 0xde, 0xd6, 0x76, 0xdb, 0x7a, 0xb0, 0xdd, 0xb6,
-	0x7e, 0xdd, 0x6e, 0x5b, 0xbf, 0x6f, 0xb7, 0xad, 0x9f, 0x1f, 0xb5, 0xad, 0xad, 0x47, 0x6d, 0xeb,
-	0xd3, 0x3d, 0x52, 0x60, 0xe6, 0x99, 0xa3, 0x4e, 0x1b, 0x55, 0xf5, 0x02, 0x79, 0xfd, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x10, 0x3f, 0xdf, 0xac, 0x5f, 0x10, 0x00, 0x00,
+	// 1760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0xdc, 0x58,
+	0x11, 0x5f, 0x77, 0xbb, 0xdd, 0xdd, 0xd5, 0x3d, 0xf9, 0x78, 0xca, 0x22, 0x2b, 0x5a, 0x4d, 0x0f,
+	0xde, 0xd5, 0x92, 0x0d, 0x59, 0xf7, 0x10, 0xc8, 0x0a, 0x05, 0x09, 0x31, 0x9d, 0x99, 0x7c, 0x90,
+	0x90, 0x84, 0x37, 0xbd, 0x59, 0x81, 0x00, 0xc9, 0x63, 0xd7, 0x78, 0xac, 0xed, 0xb6, 0x2d, 0xfb,
+	0xf5, 0x6c, 0xf7, 0x95, 0x23, 0x27, 0x8e, 0x8b, 0xc4, 0x21, 0x37, 0x6e, 0xdc, 0x38, 0x20, 0x38,
+	0x70, 0xcc, 0x8d, 0xe5, 0x82, 0x60, 0x0f, 0x03, 0xca, 0xfe, 0x07, 0xdc, 0xc8, 0x09, 0xbd, 0x2f,
+	0xf7, 0xf3, 0x64, 0x76, 0x92, 0xec, 0x0c, 0x52, 0x2e, 0xad, 0x57, 0x55, 0x3f, 0x97, 0x5f, 0xd5,
+	0xfb, 0x55, 0xd5, 0x73, 0x03, 0xe0, 0x1c, 0x43, 0x3f, 0x2f, 0x32, 0x96, 0x11, 0x9b, 0xaf, 0x2f,
+	0x5e, 0x88, 0xb3, 0x38, 0x13, 0x8a, 0x21, 0x5f, 0x49, 0xdb, 0xc5, 0xb7, 0x18, 0xa6, 0x11, 0x16,
+	0xd3, 0x24, 0x65, 0x43, 0xb6, 0xc8, 0xb1, 0x94, 0xbf, 0xca, 0x3a, 0x88, 0xb3, 0x2c, 0x9e, 0xe0,
+	0x50, 0x48, 0x3b, 0xb3, 0xdd, 0x21, 0x4b, 0xa6, 0x58, 0xb2, 0x60, 0x9a, 0x2b, 0x40, 0x37, 0x08,
+	0xa7, 0x6a, 0xd9, 0xc7, 0xa2, 0xc8, 0x0a, 0xfd, 0x64, 0x2f, 0x0d, 0xa6, 0x95, 0x9b, 0x2e, 0x9b,
+	0xeb, 0xe5, 0xb9, 0x9c, 0xbf, 0xac, 0x2c, 0x93, 0x2c, 0x55, 0x1a, 0x28, 0x73, 0xbd, 0x53, 0x6f,
+	0x0b, 0xfa, 0xdb, 0xac, 0xc0, 0x60, 0xba, 0xb5, 0x8f, 0x29, 0x2b, 0xc9, 0xb5, 0xba, 0xec, 0x5a,
+	0x6b, 0xcd, 0x4b, 0xbd, 0xab, 0xe7, 0x7d, 0x11, 0x9c, 0x61, 0xa1, 0x35, 0x98, 0xf7, 0xa7, 0x06,
+	0xf4, 0x0c, 0x05, 0x59, 0x07, 0x18, 0x61, 0x9c, 0xa4, 0xa3, 0x49, 0x16, 0x7e, 0xec, 0x5a, 0x6b,
+	0xd6, 0xa5, 0xde, 0xd5, 0x73, 0xd2, 0xc9, 0x52, 0x4f, 0x0d, 0x0c, 0xf9, 0x06, 0xb4, 0x85, 0x34,
+	0x9e, 0xbb, 0x0d, 0x01, 0x5f, 0x31, 0xe0, 0xe3, 0x39, 0xd5, 0x56, 0xf2, 0x13, 0xe8, 0x6c, 0xa5,
+	0xfb, 0x38, 0xc9, 0x72, 0x74, 0x9b, 0x0a, 0xc9, 0xa3, 0xd5, 0xca, 0x91, 0xff, 0xf9, 0xc1, 0xe0,
+	0x72, 0x9c, 0xb0, 0xbd, 0xd9, 0x8e, 0x1f, 0x66, 0xd3, 0xe1, 0xde, 0x22, 0xc7, 0x62, 0x82, 0x51,
+	0x8c, 0xc5, 0x70, 0x67, 0x56, 0x14, 0xd9, 0x27, 0x43, 0x13, 0x4f, 0x2b, 0x77, 0xe4, 0xeb, 0xd0,
+	0x12, 0xdb, 0x77, 0x6d, 0xe1, 0xb7, 0x27, 0x77, 0x20, 0xe3, 0x95, 0x16, 0x01, 0x49, 0xa3, 0xf1,
+	0xdc, 0x6d, 0xd5, 0x20, 0x5c, 0x45, 0xa5, 0x85, 0x5c, 0xe6, 0x1b, 0x8c, 0x64, 0xe4, 0x8e, 0x40,
+	0x9d, 0xa9, 0x50, 0x32, 0xee, 0xca, 0x7e, 0xdd, 0x7e, 0xf2, 0x78, 0x60, 0x79, 0xbf, 0x6f, 0x98,
+	0xe9, 0x22, 0x5f, 0x03, 0xe7, 0x36, 0x26, 0xf1, 0x1e, 0x13, 0x89, 0xb3, 0xa9, 0x92, 0xb8, 0xfe,
+	0xfe, 0x6c, 0x3a, 0x9e, 0x97, 0x22, 0x6e, 0x9b, 0x2a, 0x89, 0x5c, 0x81, 0xf3, 0x0f, 0x0b, 0x8c,
+	0x30, 0xc4, 0xb2, 0xcc, 0x0a, 0xf5, 0xa8, 0x2d, 0x20, 0xcf, 0x1b, 0xc8, 0x3a, 0xf7, 0x1e, 0x44,
+	0x58, 0xa8, 0x3c, 0xbb, 0xfe, 0x92, 0x90, 0xbe, 0xa4, 0xa2, 0xb4, 0x53, 0x85, 0x23, 0x2e, 0xb4,
+	0x47, 0x41, 0x89, 0x37, 0x11, 0x45, 0xd4, 0x36, 0xd5, 0x22, 0xb7, 0xdc, 0x0a, 0xca, 0x0f, 0x4b,
+	0x8c, 0x44, 0xa4, 0x36, 0xd5, 0xa2, 0x48, 0xc2, 0x7e, 0x12, 0x61, 0x1a, 0xa2, 0xdb, 0x5e, 0x6b,
+	0x1a, 0x49, 0x50, 0x5a, 0x5a, 0xd9, 0xc9, 0x3a, 0xf4, 0xb7, 0xe6, 0x79, 0x52, 0x60, 0x74, 0x9f,
+	0x53, 0xd8, 0xed, 0x08, 0x7c, 0xdf, 0x97, 0x84, 0xde, 0x4a, 0x59, 0xb1, 0xa0, 0x35, 0x84, 0xe7,
+	0x2d, 0x53, 0xfc, 0x65, 0xd9, 0xf2, 0xfe, 0x69, 0x55, 0x8c, 0xe2, 0xbb, 0x19, 0xcf, 0x55, 0xd4,
+	0x96, 0x79, 0x24, 0x5a, 0x4b, 0x2b, 0x3b, 0x79, 0x0b, 0xba, 0xf7, 0x67, 0x9a, 0xfe, 0x32, 0xde,
+	0xa5, 0x82, 0xbc, 0x03, 0x0e, 0xc5, 0x72, 0x36, 0x61, 0x2a, 0x7b, 0x7d, 0xe9, 0x47, 0xea, 0xa8,
+	0xb2, 0x91, 0x21, 0x74, 0xb7, 0xe6, 0x21, 0xe6, 0x2c, 0xc9, 0x52, 0x45, 0xa6, 0xf3, 0xbe, 0xaa,
+	0xd6, 0xca, 0x40, 0x97, 0x18, 0xf2, 0x3e, 0x74, 0x37, 0x42, 0x7e, 0x4c, 0xdb, 0xc8, 0x14, 0x69,
+	0xce, 0x4a, 0xcf, 0x95, 0x9a, 0x2e, 0x11, 0xde, 0x23, 0xc5, 0x42, 0xf2, 0x23, 0x70, 0xc6, 0xf3,
+	0xdb, 0x41, 0xb9, 0x27, 0x28, 0xd1, 0x1f, 0x5d, 0x7b, 0x72, 0x30, 0x78, 0xe3, 0xf3, 0x83, 0xc1,
+	0xfb, 0xc7, 0xf3, 0x7f, 0x27, 0x49, 0x83, 0x62, 0xe1, 0xdf, 0xc6, 0xf9, 0x68, 0xc1, 0xb0, 0xa4,
+	0xca, 0x89, 0xf7, 0x5f, 0x6b, 0x99, 0x28, 0xf2, 0x43, 0xee, 0x7b, 0xbc, 0xc8, 0x51, 0xa4, 0x6c,
+	0x65, 0x74, 0xf5, 0xd9, 0xc1, 0xc0, 0x7f, 0x61, 0x5d, 0x0d, 0xf3, 0x60, 0x31, 0xc9, 0x82, 0xc8,
+	0xe7, 0x4f, 0x52, 0xe5, 0xc1, 0xd8, 0x67, 0xe3, 0x14, 0xf6, 0x69, 0x9c, 0x79, 0xb3, 0x56, 0x21,
+	0x17, 0xa0, 0x75, 0x27, 0x8d, 0x70, 0xae, 0xd8, 0x2f, 0x05, 0x7e, 0x66, 0x0f, 0x8a, 0x24, 0x4e,
+	0x52, 0xb7, 0x65, 0x9e, 0x99, 0xd4, 0x51, 0x65, 0xf3, 0xfe, 0xd6, 0x80, 0x33, 0x82, 0x51, 0x5b,
+	0x73, 0x0c, 0x67, 0xe2, 0x54, 0xbe, 0xac, 0x10, 0xff, 0xdf, 0x05, 0x77, 0x0d, 0xfa, 0xe3, 0x79,
+	0xb5, 0x0d, 0x5e, 0xee, 0x46, 0x13, 0x36, 0x2c, 0xb4, 0x06, 0x7b, 0x0d, 0xeb, 0xf4, 0x97, 0x8d,
+	0xa5, 0x7b, 0x42, 0xc0, 0xae, 0xd8, 0xd4, 0xa5, 0x62, 0x4d, 0xee, 0x43, 0x7b, 0x23, 0x8a, 0x0a,
+	0x2c, 0x4b, 0x45, 0x8c, 0xef, 0x28, 0x62, 0x5c, 0x39, 0x9e, 0x18, 0x61, 0xb1, 0xc8, 0x59, 0xe6,
+	0xab, 0x67, 0xa9, 0x76, 0xc2, 0x09, 0xf0, 0x30, 0xfb, 0x04, 0x0b, 0xc1, 0x8b, 0x26, 0x95, 0x82,
+	0x71, 0x8e, 0x76, 0xed, 0x1c, 0xbf, 0x0b, 0xf6, 0x38, 0x99, 0xa2, 0xa2, 0xc5, 0x45, 0x5f, 0xce,
+	0x5e, 0x5f, 0xcf, 0x5e, 0x7f, 0xac, 0x67, 0xef, 0xa8, 0xc3, 0xb7, 0xf5, 0xeb, 0x7f, 0x0d, 0x2c,
+	0x2a, 0x9e, 0x20, 0x97, 0xe1, 0xdc, 0x38, 0x63, 0xc1, 0xe4, 0x51, 0xc6, 0x92, 0x34, 0x96, 0xaf,
+	0x74, 0xc4, 0x2b, 0x9f, 0xd3, 0x7b, 0x3f, 0x80, 0x33, 0xc6, 0x31, 0xdd, 0xc5, 0xc5, 0x71, 0x0d,
+	0xfe, 0xc1, 0xee, 0x6e, 0x89, 0xb2, 0xb9, 0xd8, 0x54, 0x49, 0xde, 0xe3, 0x26, 0xf4, 0x0c, 0x17,
+	0xe4, 0x4a, 0xc5, 0xa8, 0x23, 0x9b, 0xd9, 0xc8, 0xfe, 0xec, 0x60, 0x60, 0x55, 0x6c, 0x32, 0x07,
+	0xa6, 0x73, 0xba, 0x03, 0xf3, 0x6d, 0x70, 0x54, 0xa3, 0x94, 0xdc, 0xa9, 0x4d, 0x4c, 0xe7, 0xb9,
+	0x96, 0xd9, 0x39, 0xa6, 0x65, 0xbe, 0x0b, 0x6d, 0x8a, 0x21, 0x26, 0x39, 0x73, 0xbb, 0x0a, 0xc6,
+	0x5f, 0xaa, 0x74, 0x54, 0x1b, 0xeb, 0xad, 0x15, 0x5e, 0xa2, 0xb5, 0x1e, 0x2e, 0xa6, 0xde, 0xcb,
+	0x15, 0x53, 0xad, 0x23, 0xf7, 0x5f, 0xd8, 0x91, 0x7f, 0x65, 0xe9, 0x26, 0xc3, 0x8b, 0xed, 0xc6,
+	0x5e, 0x90, 0xa4, 0x77, 0x36, 0x15, 0xd5, 0xb5, 0x68, 0x9c, 0x7b, 0xe3, 0xe8, 0xb6, 0xd5, 0x34,
+	0xdb, 0x96, 0x66, 0xa7, 0xfd, 0xaa, 0xec, 0xf4, 0xfe, 0xda, 0x00, 0xe7, 0xf5, 0x6f, 0xe2, 0xdf,
+	0x84, 0xae, 0x60, 0x88, 0xd8, 0x5d, 0x53, 0xec, 0x6e, 0xe5, 0xd9, 0xc1, 0x60, 0xa9, 0xa4, 0xcb,
+	0x25, 0x4f, 0xaa, 0x10, 0xee, 0x6c, 0x8a, 0x7c, 0x74, 0xa9, 0x16, 0x8d, 0xa4, 0xb6, 0x8e, 0x4e,
+	0xaa, 0x63, 0x26, 0xb5, 0x46, 0x9f, 0xf6, 0x8b, 0xe9, 0x73, 0xdd, 0xfe, 0xf4, 0xf1, 0xe0, 0x0d,
+	0xef, 0x8f, 0x0d, 0x75, 0x35, 0x24, 0xef, 0xe8, 0xd4, 0xba, 0x96, 0xc9, 0xe6, 0x43, 0x1d, 0xfc,
+	0x5d, 0xfe, 0xf2, 0x7c, 0xa6, 0x6f, 0x09, 0xea, 0xea, 0x2b, 0x54, 0xea, 0x3a, 0x29, 0xd6, 0xe4,
+	0x3d, 0x70, 0x1e, 0xcc, 0x18, 0x07, 0x36, 0xf5, 0x5e, 0xc4, 0x68, 0x9a, 0xb1, 0x0a, 0xa9, 0x00,
+	0xe4, 0x6d, 0xb0, 0x6f, 0x04, 0x93, 0x89, 0x6b, 0x9b, 0x5c, 0xe4, 0x1a, 0x09, 0x13, 0x46, 0xb2,
+	0x06, 0xcd, 0x7b, 0x59, 0xec, 0xb6, 0xcc, 0xb6, 0x70, 0x2f, 0x8b, 0x25, 0x84, 0x9b, 0xc8, 0xf7,
+	0x61, 0xe5, 0x56, 0xb6, 0x8f, 0x45, 0xba, 0x11, 0x86, 0xd9, 0x2c, 0xd5, 0xb7, 0x0d, 0x57, 0x62,
+	0x6b, 0x26, 0xf9, 0x54, 0x1d, 0xce, 0x23, 0x7b, 0x58, 0x24, 0x29, 0x73, 0xdb, 0x66, 0x64, 0x42,
+	0xa5, 0x22, 0x13, 0xeb, 0xeb, 0x1d, 0x9e, 0x37, 0x71, 0xbb, 0xfd, 0xd4, 0xd2, 0x0d, 0x80, 0x9f,
+	0x15, 0x45, 0x36, 0x2b, 0x52, 0x91, 0xbc, 0x3e, 0x55, 0x92, 0x39, 0x9f, 0x1a, 0x87, 0xe7, 0x53,
+	0x97, 0x8f, 0x12, 0x31, 0x5c, 0x54, 0x8e, 0xea, 0x03, 0x67, 0x69, 0x26, 0xeb, 0xd0, 0x79, 0x88,
+	0xc5, 0x74, 0xa3, 0x88, 0x4b, 0x95, 0xa5, 0x0b, 0xbe, 0xf1, 0xf1, 0xa3, 0x6d, 0xb4, 0x42, 0x79,
+	0xbf, 0x6d, 0x40, 0x47, 0xa7, 0xc7, 0x9c, 0x45, 0xd6, 0x69, 0xcc, 0xa2, 0x3b, 0x60, 0x6f, 0x06,
+	0x2c, 0x38, 0x59, 0xb1, 0x08, 0x17, 0xe4, 0x1e, 0x38, 0xe3, 0x2c, 0x4f, 0x42, 0x79, 0x15, 0x78,
+	0xe9, 0x9d, 0x29, 0x67, 0x1f, 0x65, 0x45, 0x74, 0xf5, 0xda, 0x07, 0x54, 0xf9, 0xe0, 0x9f, 0x5a,
+	0x9b, 0x18, 0x66, 0x11, 0x46, 0xae, 0x6d, 0x7e, 0x6a, 0x29, 0x25, 0xd5, 0x56, 0xef, 0x0f, 0x4d,
+	0xe8, 0x56, 0x0c, 0x23, 0x97, 0xa0, 0xc3, 0x05, 0x51, 0xae, 0x2d, 0x51, 0xae, 0xfd, 0x67, 0x07,
+	0x83, 0x4a, 0x47, 0xab, 0x15, 0xbf, 0x54, 0xf0, 0xb5, 0x88, 0xbe, 0x36, 0xa1, 0xb4, 0x96, 0x56,
+	0x76, 0x72, 0x4f, 0xf7, 0xcd, 0x13, 0x5d, 0x00, 0x74, 0xef, 0x5d, 0x05, 0xd8, 0x66, 0x41, 0xf8,
+	0xf1, 0x26, 0xe6, 0x6c, 0x4f, 0xb5, 0x53, 0x43, 0xc3, 0x5b, 0x98, 0x22, 0xa0, 0x7d, 0xa2, 0x16,
+	0xa6, 0x78, 0x6b, 0x64, 0xd2, 0x39, 0x2e, 0x93, 0x84, 0x42, 0xef, 0x46, 0x16, 0xa1, 0xe6, 0x57,
+	0x5b, 0xbc, 0x7c, 0xfd, 0x95, 0xc3, 0x34, 0x9d, 0x98, 0x45, 0xd3, 0xa9, 0x15, 0x8d, 0xb7, 0x5b,
+	0x6d, 0x8b, 0x5f, 0xba, 0x78, 0x81, 0xe8, 0x4b, 0x17, 0x5f, 0xf3, 0x2f, 0x9c, 0xed, 0x24, 0x4e,
+	0x03, 0x36, 0x2b, 0x50, 0x64, 0xbd, 0x4b, 0x97, 0x0a, 0xf2, 0x1e, 0xd8, 0xa2, 0x82, 0xe4, 0xa5,
+	0xf3, 0xcd, 0x5a, 0x40, 0x1b, 0x45, 0x3c, 0x9b, 0x8a, 0x6e, 0x23, 0xca, 0xe7, 0x01, 0x9c, 0x3d,
+	0x64, 0x38, 0xf2, 0x7d, 0xfa, 0xe2, 0xd7, 0x30, 0x2e, 0x7e, 0x17, 0xa0, 0xf5, 0x28, 0x98, 0xcc,
+	0x64, 0xe3, 0xef, 0x52, 0x29, 0x78, 0xbf, 0xb3, 0x00, 0x96, 0xad, 0xe4, 0x35, 0xae, 0x48, 0xef,
+	0xc7, 0x40, 0x9e, 0xef, 0x95, 0xe4, 0x7b, 0xb0, 0xa2, 0xe4, 0x0f, 0xf3, 0x28, 0x60, 0xa8, 0xd8,
+	0xff, 0xa6, 0x2f, 0xfe, 0x71, 0x19, 0xe3, 0x34, 0x9f, 0x04, 0x0c, 0x15, 0x84, 0xd6, 0xb1, 0xde,
+	0xcf, 0x00, 0x96, 0x03, 0xe2, 0xb4, 0x63, 0xf7, 0x7e, 0x0e, 0x3d, 0x63, 0xaa, 0x9c, 0xba, 0xfb,
+	0xdf, 0x34, 0xa0, 0x56, 0xd3, 0x7c, 0x8d, 0xc5, 0x89, 0x7c, 0x2b, 0x1f, 0x95, 0x37, 0x3c, 0x59,
+	0x87, 0x90, 0x3e, 0x2a, 0x0e, 0x34, 0x4f, 0xde, 0x95, 0x2b, 0x0e, 0x8b, 0x5e, 0xa2, 0x38, 0x4c,
+	0xce, 0x41, 0xf3, 0x56, 0x20, 0xff, 0x39, 0xe8, 0x53, 0xbe, 0xf4, 0xfe, 0x6e, 0x41, 0x77, 0x9b,
+	0x05, 0x0c, 0x37, 0x93, 0xdd, 0x5d, 0xf2, 0x01, 0x9c, 0x95, 0x07, 0x1e, 0xa9, 0xe3, 0xd7, 0x7f,
+	0xb2, 0xf5, 0x7d, 0xfe, 0xd7, 0x9e, 0x26, 0xc7, 0x61, 0x10, 0xf9, 0x05, 0x9c, 0xa5, 0x38, 0xcd,
+	0xf6, 0x8d, 0xe7, 0x1a, 0x6b, 0xcd, 0xaf, 0x9c, 0x8f, 0xc3, 0xce, 0xc8, 0xb7, 0xa0, 0xbd, 0xcd,
+	0xb2, 0x22, 0x88, 0xb1, 0xfe, 0xbd, 0xa9, 0x94, 0x7c, 0xef, 0x23, 0x9b, 0xbf, 0x8a, 0x6a, 0x9c,
+	0x17, 0x18, 0x77, 0x64, 0x72, 0x09, 0x5a, 0x14, 0x83, 0x68, 0x19, 0x8d, 0x71, 0x59, 0x56, 0x0f,
+	0x4a, 0x00, 0xb9, 0x0c, 0xce, 0x47, 0x45, 0xc2, 0x50, 0x06, 0x70, 0x34, 0x54, 0x21, 0xbc, 0x3f,
+	0x5b, 0xe0, 0x48, 0xc3, 0xa9, 0x77, 0x03, 0x17, 0xda, 0xfa, 0x0e, 0xc4, 0x89, 0xd5, 0xa1, 0x5a,
+	0x24, 0xb7, 0xc1, 0xbe, 0x8b, 0x8b, 0x93, 0x0d, 0x5b, 0xe1, 0xc1, 0xfb, 0x8f, 0x05, 0x3d, 0x95,
+	0x2d, 0x71, 0xf8, 0xa7, 0x1d, 0xc3, 0x4d, 0x68, 0xde, 0xc5, 0xc5, 0xab, 0x15, 0xc6, 0xa1, 0x8d,
+	0x72, 0x07, 0xe4, 0xae, 0xd9, 0x8e, 0xbf, 0x72, 0x59, 0x48, 0x1f, 0xa3, 0x9b, 0x4f, 0x9e, 0xae,
+	0x5a, 0x9f, 0x3d, 0x5d, 0xb5, 0xfe, 0xf1, 0x74, 0xd5, 0xfa, 0xf7, 0xd3, 0x55, 0xeb, 0x2f, 0x5f,
+	0xac, 0x5a, 0x4f, 0xbe, 0x58, 0xb5, 0x7e, 0xfa, 0x82, 0x9d, 0xa1, 0xfe, 0xfc, 0x12, 0xab, 0x1d,
+	0x47, 0x7c, 0xea, 0x7c, 0xfb, 0x7f, 0x03, 0x00, 0x01, 0xbf, 0xe1, 0x45, 0x5a, 0x17, 0x00, 0x00,
 }
 
 func (m *StreamEvents) Marshal() (dAtA []byte, err error) {
@@ -1670,6 +2257,44 @@ func (m *BeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExpiredNames) > 0 {
+		for iNdEx := len(m.ExpiredNames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiredNames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BaseFee != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.BaseFee))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.PredecessorHeight != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.PredecessorHeight))
 		i--
@@ -1756,6 +2381,18 @@ func (m *BeginTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AccessSet != nil {
+		{
+			size, err := m.AccessSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.NumEvents != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.NumEvents))
 		i--
@@ -1925,6 +2562,44 @@ func (m *BlockExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExpiredNames) > 0 {
+		for iNdEx := len(m.ExpiredNames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiredNames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BaseFee != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.BaseFee))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.PredecessorHeight != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.PredecessorHeight))
 		i--
@@ -1964,6 +2639,73 @@ func (m *BlockExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Evidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintExec(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Power != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxExecutionKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2025,13 +2767,25 @@ func (m *TxExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TxExecutions) > 0 {
-		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TxExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+	if m.AccessSet != nil {
+		{
+			size, err := m.AccessSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.TxExecutions) > 0 {
+		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintExec(dAtA, i, uint64(size))
 			}
@@ -2140,12 +2894,12 @@ func (m *Origin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err18 != nil {
-		return 0, err18
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err21 != nil {
+		return 0, err21
 	}
-	i -= n18
-	i = encodeVarintExec(dAtA, i, uint64(n18))
+	i -= n21
+	i = encodeVarintExec(dAtA, i, uint64(n21))
 	i--
 	dAtA[i] = 0x22
 	if m.Index != 0 {
@@ -2442,6 +3196,18 @@ func (m *LogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decoded != nil {
+		{
+			size, err := m.Decoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Topics) > 0 {
 		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2503,6 +3269,35 @@ func (m *CallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GasUsed != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CodeAddress != nil {
+		{
+			size := m.CodeAddress.Size()
+			i -= size
+			if _, err := m.CodeAddress.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Decoded != nil {
+		{
+			size, err := m.Decoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.CallType != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.CallType))
 		i--
@@ -2548,6 +3343,109 @@ func (m *CallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Decoded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Decoded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Decoded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedArgument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedArgument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedArgument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PrintEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2779,92 +3677,352 @@ func (m *CallData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintExec(dAtA []byte, offset int, v uint64) int {
-	offset -= sovExec(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StreamEvents) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.StreamEvents) > 0 {
-		for _, e := range m.StreamEvents {
-			l = e.Size()
-			n += 1 + l + sovExec(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *StateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BeginBlock != nil {
-		l = m.BeginBlock.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	if m.BeginTx != nil {
-		l = m.BeginTx.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	if m.Envelope != nil {
-		l = m.Envelope.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EndTx != nil {
-		l = m.EndTx.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.EndBlock != nil {
-		l = m.EndBlock.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if len(m.RemovedAccounts) > 0 {
+		for iNdEx := len(m.RemovedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RemovedAccounts[iNdEx].Size()
+				i -= size
+				if _, err := m.RemovedAccounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.UpdatedAccounts) > 0 {
+		for iNdEx := len(m.UpdatedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdatedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BeginBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccessSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
-	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	if m.NumTxs != 0 {
-		n += 1 + sovExec(uint64(m.NumTxs))
-	}
-	if m.PredecessorHeight != 0 {
-		n += 1 + sovExec(uint64(m.PredecessorHeight))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Writes) > 0 {
+		for iNdEx := len(m.Writes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Writes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Reads) > 0 {
+		for iNdEx := len(m.Reads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Access) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Access) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Access) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Keys[iNdEx].Size()
+				i -= size
+				if _, err := m.Keys[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Account {
+		i--
+		if m.Account {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Key.Size()
+		i -= size
+		if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExec(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExec(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StreamEvents) > 0 {
+		for _, e := range m.StreamEvents {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.BeginTx != nil {
+		l = m.BeginTx.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.EndTx != nil {
+		l = m.EndTx.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovExec(uint64(m.NumTxs))
+	}
+	if m.PredecessorHeight != 0 {
+		n += 1 + sovExec(uint64(m.PredecessorHeight))
+	}
+	if m.BaseFee != 0 {
+		n += 1 + sovExec(uint64(m.BaseFee))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExec(uint64(m.GasUsed))
+	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.ExpiredNames) > 0 {
+		for _, e := range m.ExpiredNames {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -2905,6 +4063,10 @@ func (m *BeginTx) Size() (n int) {
 	if m.NumEvents != 0 {
 		n += 1 + sovExec(uint64(m.NumEvents))
 	}
+	if m.AccessSet != nil {
+		l = m.AccessSet.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2974,23 +4136,52 @@ func (m *BlockExecution) Size() (n int) {
 	if m.PredecessorHeight != 0 {
 		n += 1 + sovExec(uint64(m.PredecessorHeight))
 	}
+	if m.BaseFee != 0 {
+		n += 1 + sovExec(uint64(m.BaseFee))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExec(uint64(m.GasUsed))
+	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.ExpiredNames) > 0 {
+		for _, e := range m.ExpiredNames {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TxExecutionKey) Size() (n int) {
+func (m *Evidence) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovExec(uint64(m.Power))
+	}
 	if m.Height != 0 {
 		n += 1 + sovExec(uint64(m.Height))
 	}
-	if m.Offset != 0 {
-		n += 1 + sovExec(uint64(m.Offset))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovExec(uint64(l))
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovExec(uint64(m.TotalVotingPower))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -2998,18 +4189,36 @@ func (m *TxExecutionKey) Size() (n int) {
 	return n
 }
 
-func (m *TxExecution) Size() (n int) {
+func (m *TxExecutionKey) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TxHeader != nil {
-		l = m.TxHeader.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
 	}
-	if m.Envelope != nil {
-		l = m.Envelope.Size()
+	if m.Offset != 0 {
+		n += 1 + sovExec(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxHeader != nil {
+		l = m.TxHeader.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
 		n += 1 + l + sovExec(uint64(l))
 	}
 	if len(m.Events) > 0 {
@@ -3036,6 +4245,10 @@ func (m *TxExecution) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if m.AccessSet != nil {
+		l = m.AccessSet.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3183,6 +4396,10 @@ func (m *LogEvent) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if m.Decoded != nil {
+		l = m.Decoded.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3209,6 +4426,67 @@ func (m *CallEvent) Size() (n int) {
 	if m.CallType != 0 {
 		n += 1 + sovExec(uint64(m.CallType))
 	}
+	if m.Decoded != nil {
+		l = m.Decoded.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.CodeAddress != nil {
+		l = m.CodeAddress.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExec(uint64(m.GasUsed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Decoded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecodedArgument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3301,6 +4579,101 @@ func (m *CallData) Size() (n int) {
 	return n
 }
 
+func (m *StateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpdatedAccounts) > 0 {
+		for _, e := range m.UpdatedAccounts {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.RemovedAccounts) > 0 {
+		for _, e := range m.RemovedAccounts {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccessSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reads) > 0 {
+		for _, e := range m.Reads {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.Writes) > 0 {
+		for _, e := range m.Writes {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Access) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Account {
+		n += 2
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovExec(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3418,19 +4791,1079 @@ func (m *StreamEvents) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StreamEvents: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamEvents: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamEvents = append(m.StreamEvents, &StreamEvent{})
+			if err := m.StreamEvents[len(m.StreamEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeginBlock == nil {
+				m.BeginBlock = &BeginBlock{}
+			}
+			if err := m.BeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeginTx == nil {
+				m.BeginTx = &BeginTx{}
+			}
+			if err := m.BeginTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTx == nil {
+				m.EndTx = &EndTx{}
+			}
+			if err := m.EndTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndBlock == nil {
+				m.EndBlock = &EndBlock{}
+			}
+			if err := m.EndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
+			}
+			m.PredecessorHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PredecessorHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			m.BaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &Evidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiredNames = append(m.ExpiredNames, &names.Entry{})
+			if err := m.ExpiredNames[len(m.ExpiredNames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxHeader == nil {
+				m.TxHeader = &TxHeader{}
+			}
+			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEvents", wireType)
+			}
+			m.NumEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessSet == nil {
+				m.AccessSet = &AccessSet{}
+			}
+			if err := m.AccessSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3457,8 +5890,10 @@ func (m *StreamEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamEvents = append(m.StreamEvents, &StreamEvent{})
-			if err := m.StreamEvents[len(m.StreamEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Origin == nil {
+				m.Origin = &Origin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3484,7 +5919,7 @@ func (m *StreamEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamEvent) Unmarshal(dAtA []byte) error {
+func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3507,17 +5942,17 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3527,31 +5962,14 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BeginBlock == nil {
-				m.BeginBlock = &BeginBlock{}
-			}
-			if err := m.BeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3578,16 +5996,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeginTx == nil {
-				m.BeginTx = &BeginTx{}
+			if m.Header == nil {
+				m.Header = &types.Header{}
 			}
-			if err := m.BeginTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3614,18 +6032,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
-			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
+			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
 			}
-			var msglen int
+			m.PredecessorHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3635,31 +6051,52 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PredecessorHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Event == nil {
-				m.Event = &Event{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3686,16 +6123,14 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTx == nil {
-				m.EndTx = &EndTx{}
-			}
-			if err := m.EndTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Evidence = append(m.Evidence, &Evidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredNames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3722,10 +6157,8 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndBlock == nil {
-				m.EndBlock = &EndBlock{}
-			}
-			if err := m.EndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpiredNames = append(m.ExpiredNames, &names.Entry{})
+			if err := m.ExpiredNames[len(m.ExpiredNames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3751,7 +6184,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeginBlock) Unmarshal(dAtA []byte) error {
+func (m *Evidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3774,17 +6207,17 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeginBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3794,16 +6227,29 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3813,33 +6259,30 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &types.Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.NumTxs = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3849,16 +6292,16 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumTxs |= uint64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.PredecessorHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3868,7 +6311,59 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PredecessorHeight |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3895,7 +6390,7 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndBlock) Unmarshal(dAtA []byte) error {
+func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3918,17 +6413,36 @@ func (m *EndBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxExecutionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxExecutionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.Height = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3938,7 +6452,7 @@ func (m *EndBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Offset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3965,7 +6479,7 @@ func (m *EndBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeginTx) Unmarshal(dAtA []byte) error {
+func (m *TxExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3988,10 +6502,10 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeginTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4030,7 +6544,77 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
@@ -4066,7 +6650,43 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &txs.Receipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
 			}
@@ -4102,11 +6722,11 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEvents", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
 			}
-			m.NumEvents = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4116,11 +6736,62 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEvents |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
+			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessSet == nil {
+				m.AccessSet = &AccessSet{}
+			}
+			if err := m.AccessSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4143,7 +6814,7 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndTx) Unmarshal(dAtA []byte) error {
+func (m *Origin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4166,17 +6837,87 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: Origin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Origin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4186,22 +6927,22 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4227,7 +6968,7 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxHeader) Unmarshal(dAtA []byte) error {
+func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4250,10 +6991,10 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4309,6 +7050,57 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -4327,7 +7119,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
@@ -4346,9 +7138,9 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4375,10 +7167,10 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &Origin{}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4404,7 +7196,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockExecution) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4427,32 +7219,13 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
@@ -4482,15 +7255,15 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &types.Header{}
+				m.Header = &Header{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4517,16 +7290,18 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
-			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Input == nil {
+				m.Input = &InputEvent{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			m.PredecessorHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4536,154 +7311,31 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PredecessorHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxExecutionKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxExecutionKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxExecution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Output == nil {
+				m.Output = &OutputEvent{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxExecution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxExecution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4710,16 +7362,16 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxHeader == nil {
-				m.TxHeader = &TxHeader{}
+			if m.Call == nil {
+				m.Call = &CallEvent{}
 			}
-			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4746,16 +7398,16 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			if m.Log == nil {
+				m.Log = &LogEvent{}
 			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4782,14 +7434,16 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GovernAccount == nil {
+				m.GovernAccount = &GovernAccountEvent{}
+			}
+			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Print", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4816,18 +7470,69 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &Result{}
+			if m.Print == nil {
+				m.Print = &PrintEvent{}
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Print.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4837,31 +7542,48 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Receipt == nil {
-				m.Receipt = &txs.Receipt{}
-			}
-			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
+			if m.Return == nil {
+				m.Return = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4888,16 +7610,16 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
+			if m.NameEntry == nil {
+				m.NameEntry = &names.Entry{}
 			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4924,8 +7646,10 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
-			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PermArgs == nil {
+				m.PermArgs = &permission.PermArgs{}
+			}
+			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4951,7 +7675,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Origin) Unmarshal(dAtA []byte) error {
+func (m *LogEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4974,17 +7698,17 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Origin: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Origin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4994,29 +7718,30 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5026,16 +7751,30 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			m.Index = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5045,14 +7784,30 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_binary.Word256
+			m.Topics = append(m.Topics, v)
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5079,7 +7834,10 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Decoded == nil {
+				m.Decoded = &Decoded{}
+			}
+			if err := m.Decoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5105,7 +7863,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *CallEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5128,17 +7886,17 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallData", wireType)
 			}
-			m.TxType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5148,14 +7906,31 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallData == nil {
+				m.CallData = &CallData{}
+			}
+			if err := m.CallData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5182,15 +7957,15 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
 			}
-			m.EventType = 0
+			m.StackDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5200,16 +7975,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventType |= EventType(b&0x7F) << shift
+				m.StackDepth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5219,29 +7994,30 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventID = string(dAtA[iNdEx:postIndex])
+			if err := m.Return.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
 			}
-			m.Height = 0
+			m.CallType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5251,16 +8027,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.CallType |= CallType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5270,16 +8046,33 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Decoded == nil {
+				m.Decoded = &Decoded{}
+			}
+			if err := m.Decoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5289,28 +8082,46 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
-			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.CodeAddress = &v
+			if err := m.CodeAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5333,7 +8144,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *Decoded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5356,17 +8167,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: Decoded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Decoded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5376,33 +8187,29 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5412,31 +8219,27 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &InputEvent{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5463,54 +8266,67 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &OutputEvent{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, &DecodedArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExec
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Call == nil {
-				m.Call = &CallEvent{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
 			}
-			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5520,33 +8336,29 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &LogEvent{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5556,33 +8368,29 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GovernAccount == nil {
-				m.GovernAccount = &GovernAccountEvent{}
-			}
-			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Print", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5592,27 +8400,23 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Print == nil {
-				m.Print = &PrintEvent{}
-			}
-			if err := m.Print.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5636,7 +8440,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *PrintEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5659,15 +8463,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrintEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrintEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5694,35 +8498,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
-			if m.Return == nil {
-				m.Return = []byte{}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5732,31 +8516,79 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NameEntry == nil {
-				m.NameEntry = &names.Entry{}
-			}
-			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernAccountEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernAccountEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5783,10 +8615,10 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PermArgs == nil {
-				m.PermArgs = &permission.PermArgs{}
+			if m.AccountUpdate == nil {
+				m.AccountUpdate = &spec.TemplateAccount{}
 			}
-			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccountUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5812,7 +8644,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEvent) Unmarshal(dAtA []byte) error {
+func (m *InputEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5835,10 +8667,10 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: InputEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5874,42 +8706,60 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5936,9 +8786,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_hyperledger_burrow_binary.Word256
-			m.Topics = append(m.Topics, v)
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5964,7 +8812,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallEvent) Unmarshal(dAtA []byte) error {
+func (m *CallData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5987,17 +8835,17 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6007,31 +8855,28 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CallData == nil {
-				m.CallData = &CallData{}
-			}
-			if err := m.CallData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6058,15 +8903,15 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Callee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.StackDepth = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6076,14 +8921,28 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StackDepth |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6110,15 +8969,16 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Return.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			m.CallType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6128,11 +8988,26 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallType |= CallType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gas = append(m.Gas[:0], dAtA[iNdEx:postIndex]...)
+			if m.Gas == nil {
+				m.Gas = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -6155,7 +9030,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrintEvent) Unmarshal(dAtA []byte) error {
+func (m *StateDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6178,17 +9053,17 @@ func (m *PrintEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrintEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrintEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAccounts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6198,28 +9073,29 @@ func (m *PrintEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpdatedAccounts = append(m.UpdatedAccounts, &acm.Account{})
+			if err := m.UpdatedAccounts[len(m.UpdatedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAccounts", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6246,7 +9122,43 @@ func (m *PrintEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.RemovedAccounts = append(m.RemovedAccounts, v)
+			if err := m.RemovedAccounts[len(m.RemovedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, StorageDiff{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6272,7 +9184,7 @@ func (m *PrintEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
+func (m *AccessSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6295,15 +9207,15 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovernAccountEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovernAccountEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6330,10 +9242,42 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccountUpdate == nil {
-				m.AccountUpdate = &spec.TemplateAccount{}
+			m.Reads = append(m.Reads, Access{})
+			if err := m.Reads[len(m.Reads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.AccountUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writes = append(m.Writes, Access{})
+			if err := m.Writes[len(m.Writes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6359,7 +9303,7 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InputEvent) Unmarshal(dAtA []byte) error {
+func (m *Access) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6382,10 +9326,10 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InputEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Access: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Access: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6421,60 +9365,29 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OutputEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutputEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Account = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6501,7 +9414,9 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_hyperledger_burrow_binary.Word256
+			m.Keys = append(m.Keys, v)
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6527,7 +9442,7 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallData) Unmarshal(dAtA []byte) error {
+func (m *StorageDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6550,15 +9465,15 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallData: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6585,13 +9500,13 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6618,44 +9533,11 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Callee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -6684,43 +9566,8 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Gas = append(m.Gas[:0], dAtA[iNdEx:postIndex]...)
-			if m.Gas == nil {
-				m.Gas = []byte{}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -0,0 +1,86 @@
+package exec
+
+import (
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/tmthrgd/go-hex"
+)
+
+const LogsBloomLength = 256
+
+// An Ethereum-style 2048 bit bloom filter of the addresses and topics of LogEvents. Each address or topic sets 3 bits
+// taken from the low-order 11 bits of each of the first three pairs of bytes in its Keccak 256 hash
+type LogsBloom [LogsBloomLength]byte
+
+// Returns the logs bloom of the LogEvents in the block
+func (be *BlockExecution) LogsBloom() *LogsBloom {
+	bloom := new(LogsBloom)
+	bloom.addTxs(be.TxExecutions)
+	return bloom
+}
+
+func (bloom *LogsBloom) addTxs(txes []*TxExecution) {
+	for _, txe := range txes {
+		for _, ev := range txe.Events {
+			if ev.Log != nil {
+				bloom.AddLog(ev.Log)
+			}
+		}
+		bloom.addTxs(txe.TxExecutions)
+	}
+}
+
+func LogsBloomFromBytes(bs []byte) *LogsBloom {
+	bloom := new(LogsBloom)
+	copy(bloom[:], bs)
+	return bloom
+}
+
+func (bloom *LogsBloom) AddLog(log *LogEvent) {
+	bloom.Add(log.Address.Bytes())
+	for _, topic := range log.Topics {
+		bloom.Add(topic.Bytes())
+	}
+}
+
+func (bloom *LogsBloom) Add(data []byte) {
+	hash := crypto.Keccak256(data)
+	for i := 0; i < 6; i += 2 {
+		bit := (uint(hash[i])<<8 | uint(hash[i+1])) & (LogsBloomLength*8 - 1)
+		bloom[LogsBloomLength-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test returns false if data was certainly not added to the bloom
+func (bloom *LogsBloom) Test(data []byte) bool {
+	other := new(LogsBloom)
+	other.Add(data)
+	for i, b := range other {
+		if bloom[i]&b != b {
+			return false
+		}
+	}
+	return true
+}
+
+// MayMatch returns false if the LogEvents added to the bloom can be shown to include no event matched by qry from the
+// conditions it places on their Address and topics
+func (bloom *LogsBloom) MayMatch(qry query.Query) bool {
+	return !query.Excludes(qry, func(tag, value string) (bool, bool) {
+		bs, err := hex.DecodeString(value)
+		if err != nil {
+			return false, false
+		}
+		if tag == event.AddressKey {
+			return bloom.Test(bs), len(bs) == crypto.AddressLength
+		}
+		// Missing topics read as zero so only a non-zero topic tells us anything
+		if _, ok := logNTopicIndex[tag]; ok && len(bs) == binary.Word256Bytes &&
+			binary.LeftPadWord256(bs) != binary.Zero256 {
+			return bloom.Test(bs), true
+		}
+		return false, false
+	})
+}
@@ -0,0 +1,38 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsBloom_MayMatch(t *testing.T) {
+	address := crypto.Address{1, 2, 3}
+	topic := binary.RightPadWord256([]byte("marmot"))
+	be := &BlockExecution{
+		TxExecutions: []*TxExecution{{
+			Events: []*Event{{Log: &LogEvent{Address: address, Topics: []binary.Word256{topic}}}},
+		}},
+	}
+	bloom := be.LogsBloom()
+	require.True(t, bloom.Test(address.Bytes()))
+	require.True(t, bloom.Test(topic.Bytes()))
+
+	other := binary.RightPadWord256([]byte("badger"))
+	for qs, mayMatch := range map[string]bool{
+		"Address = '" + address.String() + "'":                                true,
+		"Address = '" + crypto.Address{4}.String() + "'":                      false,
+		"Log0 = '" + topic.String() + "'":                                     true,
+		"Log0 = '" + other.String() + "'":                                     false,
+		"Log0 = '" + other.String() + "' OR Log0 = '" + topic.String() + "'":  true,
+		"Log0 = '" + topic.String() + "' AND Log1 = '" + other.String() + "'": false,
+		// Events without a second topic match a zero one
+		"Log1 = '" + binary.Zero256.String() + "'": true,
+		"EventType = 'LogEvent'":                   true,
+	} {
+		require.Equal(t, mayMatch, bloom.MayMatch(query.MustParse(qs)), qs)
+	}
+}
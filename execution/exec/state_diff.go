@@ -0,0 +1,28 @@
+package exec
+
+import (
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// StateDiff implements acmstate.Writer so that it can record the changes synced from a cache
+
+func (sd *StateDiff) UpdateAccount(account *acm.Account) error {
+	sd.UpdatedAccounts = append(sd.UpdatedAccounts, account.Copy())
+	return nil
+}
+
+func (sd *StateDiff) RemoveAccount(address crypto.Address) error {
+	sd.RemovedAccounts = append(sd.RemovedAccounts, address)
+	return nil
+}
+
+func (sd *StateDiff) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
+	sd.Storage = append(sd.Storage, StorageDiff{
+		Address: address,
+		Key:     key,
+		Value:   value,
+	})
+	return nil
+}
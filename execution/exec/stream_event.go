@@ -136,7 +136,7 @@ func (ba *BlockAccumulator) Consume(ev *StreamEvent) (*BlockExecution, error) {
 		}
 		// If we are consuming blocks over the event stream (rather than from state) we may see empty blocks
 		// by definition empty blocks will not be a predecessor
-		if ev.BeginBlock.NumTxs > 0 {
+		if ev.BeginBlock.NumTxs > 0 || len(ev.BeginBlock.Evidence) > 0 || len(ev.BeginBlock.ExpiredNames) > 0 {
 			ba.previousNonEmptyBlockHeight = ev.BeginBlock.Height
 		}
 		ba.numTxs = ev.BeginBlock.NumTxs
@@ -144,6 +144,10 @@ func (ba *BlockAccumulator) Consume(ev *StreamEvent) (*BlockExecution, error) {
 			Height:            ev.BeginBlock.Height,
 			PredecessorHeight: ev.BeginBlock.PredecessorHeight,
 			Header:            ev.BeginBlock.Header,
+			BaseFee:           ev.BeginBlock.BaseFee,
+			GasUsed:           ev.BeginBlock.GasUsed,
+			Evidence:          ev.BeginBlock.Evidence,
+			ExpiredNames:      ev.BeginBlock.ExpiredNames,
 			TxExecutions:      make([]*TxExecution, 0, ba.numTxs),
 		}
 	case ev.BeginTx != nil, ev.Envelope != nil, ev.Event != nil, ev.EndTx != nil:
@@ -186,6 +190,7 @@ func (stack *TxStack) Push(beginTx *BeginTx) {
 		Result:    beginTx.Result,
 		Events:    make([]*Event, 0, beginTx.NumEvents),
 		Exception: beginTx.Exception,
+		AccessSet: beginTx.AccessSet,
 	})
 	stack.numEvents = append(stack.numEvents, beginTx.NumEvents)
 }
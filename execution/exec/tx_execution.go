@@ -43,6 +43,7 @@ func (txe *TxExecution) StreamEvents() []*StreamEvent {
 				NumEvents: uint64(len(txe.Events)),
 				Exception: txe.Exception,
 				Result:    txe.Result,
+				AccessSet: txe.AccessSet,
 			},
 		},
 		&StreamEvent{
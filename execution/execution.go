@@ -11,6 +11,7 @@ import (
 
 	"github.com/hyperledger/burrow/execution/vms"
 
+	"github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
@@ -21,6 +22,8 @@ import (
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/feemarket"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/execution/registry"
@@ -31,7 +34,6 @@ import (
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
-	"github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 type Executor interface {
@@ -46,15 +48,26 @@ func (f ExecutorFunc) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
 
 type ExecutorState interface {
 	Update(updater func(ws state.Updatable) error) (hash []byte, version int64, err error)
+	// Make updates without saving them and return the hash state will have once they are saved
+	Stage(updater func(ws state.Updatable) error) (hash []byte, err error)
+	// Save the updates made by Stage
+	Save() (hash []byte, version int64, err error)
 	LastStoredHeight() (uint64, error)
+	BeginBlockReader
+	gas.Reader
 	acmstate.IterableReader
 	acmstate.MetadataReader
-	names.Reader
+	names.IterableReader
 	registry.Reader
 	proposal.Reader
 	validator.IterableReader
 }
 
+type BeginBlockReader interface {
+	// Get the BeginBlock of the last block stored in state at or below height, or nil if there is no such block
+	LastBeginBlock(height uint64) (*exec.BeginBlock, error)
+}
+
 type BatchExecutor interface {
 	// Provides access to write lock for a BatchExecutor so reads can be prevented for the duration of a commit
 	sync.Locker
@@ -64,12 +77,27 @@ type BatchExecutor interface {
 	Executor
 	// Reset executor to underlying State
 	Reset() error
+	// Reset executor to underlying State and begin the block after the last block in the Blockchain, which is needed
+	// when state has been replaced rather than committed by the executor, such as when restoring a snapshot
+	Restart() error
 }
 
 // Executes transactions
 type BatchCommitter interface {
 	BatchExecutor
-	// Commit execution results to underlying State and provide opportunity to mutate state before it is saved
+	// Execute transactions against block cache in parallel where they do not conflict
+	ParallelExecutor
+	// Order the transactions of the block being executed as the chain's FeeOrdering executes them
+	OrderByFee(txEnvs []*txs.Envelope) []*txs.Envelope
+	// Order the candidate transactions for a proposal of the block being executed as ProposalOrdering puts them
+	OrderProposal(txEnvs []*txs.Envelope) ([]*txs.Envelope, error)
+	// Record evidence of validator misbehaviour committed in the block being executed
+	AddEvidence(evidence ...*exec.Evidence)
+	// Finalise the block being executed and return the hash state will have once it is committed, without persisting
+	// anything
+	Finalise(header *types.Header) (stateHash []byte, err error)
+	// Commit execution results to underlying State and provide opportunity to mutate state before it is saved. The
+	// block being executed is finalised with header first unless it already has been.
 	Commit(header *types.Header) (stateHash []byte, err error)
 }
 
@@ -84,25 +112,80 @@ type executor struct {
 	nodeRegCache     *registry.Cache
 	proposalRegCache *proposal.Cache
 	validatorCache   *validator.Cache
+	gasScheduleCache *gas.Cache
 	emitter          *event.Emitter
 	block            *exec.BlockExecution
 	logger           *logging.Logger
 	vmOptions        engine.Options
 	contexts         map[payload.Type]contexts.Context
+	// The gas schedule in effect for the block being executed
+	gasSchedule *gas.Schedule
+	blockchain  engine.Blockchain
+	// The number of transactions ExecuteParallel may execute at once (GOMAXPROCS if zero)
+	parallelWorkers int
+	// The state transactions read and write through which is stateCache unless accesses are being recorded
+	txState  acmstate.ReaderWriter
+	accesses *accessRecorder
+	// Calls to each contract in committed blocks, if recorded
+	contractStats *exec.ContractStats
+	// Calls offering less fee per gas are refused by the mempool
+	minimumFeePerGas uint64
+	// The block finalised by Finalise to save on Commit
+	finalised *exec.BlockExecution
 }
 
 type Params struct {
 	ChainID           string
 	ProposalThreshold uint64
+	CancunHeight      *uint64
+	FeeMarket         *feemarket.Params
+	ParallelExecution bool
+	AccessSets        bool
+	CallTree          bool
+	GasRefunds        gas.RefundPolicy
+	SelfDestruct      engine.SelfDestructPolicy
+	FeeOrdering       FeeOrdering
+	UnbondingBlocks   uint64
+	SweepExpiredNames bool
 }
 
 func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) Params {
 	return Params{
 		ChainID:           genesisDoc.GetChainID(),
 		ProposalThreshold: genesisDoc.Params.ProposalThreshold,
+		CancunHeight:      genesisDoc.Params.CancunHeight,
+		FeeMarket:         genesisDoc.Params.FeeMarket,
+		ParallelExecution: genesisDoc.Params.ParallelExecution,
+		AccessSets:        genesisDoc.Params.AccessSets,
+		CallTree:          genesisDoc.Params.CallTree,
+		GasRefunds:        genesisDoc.Params.GasRefunds,
+		SelfDestruct:      engine.SelfDestructPolicy(genesisDoc.Params.SelfDestruct),
+		FeeOrdering:       FeeOrdering(genesisDoc.Params.FeeOrdering),
+		UnbondingBlocks:   genesisDoc.Params.UnbondingBlocks,
+		SweepExpiredNames: genesisDoc.Params.SweepExpiredNames,
 	}
 }
 
+// Validate checks the policies and fee market of the params are ones the executor knows
+func (params Params) Validate() error {
+	err := params.GasRefunds.Validate()
+	if err != nil {
+		return err
+	}
+	err = params.SelfDestruct.Validate()
+	if err != nil {
+		return err
+	}
+	err = params.FeeOrdering.Validate()
+	if err != nil {
+		return err
+	}
+	if params.FeeMarket != nil {
+		return params.FeeMarket.Validate()
+	}
+	return nil
+}
+
 var _ BatchExecutor = (*executor)(nil)
 
 // Wraps a cache of what is variously known as the 'check cache' and 'mempool'
@@ -123,6 +206,10 @@ func NewBatchCommitter(backend ExecutorState, params Params, blockchain engine.B
 
 func newExecutor(name string, runCall bool, params Params, backend ExecutorState, blockchain engine.Blockchain,
 	emitter *event.Emitter, logger *logging.Logger, options ...Option) (*executor, error) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
 	// We need to track the last block stored in state
 	predecessor, err := backend.LastStoredHeight()
 	if err != nil {
@@ -138,59 +225,89 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		nodeRegCache:     registry.NewCache(backend),
 		proposalRegCache: proposal.NewCache(backend),
 		validatorCache:   validator.NewCache(backend),
+		gasScheduleCache: gas.NewCache(backend),
 		emitter:          emitter,
 		block: &exec.BlockExecution{
 			Height:            blockchain.LastBlockHeight() + 1,
 			PredecessorHeight: predecessor,
 		},
-		logger: logger.With(structure.ComponentKey, "Executor"),
+		logger:     logger.With(structure.ComponentKey, "Executor"),
+		blockchain: blockchain,
 	}
+	exe.initTxState()
 	for _, option := range options {
 		option(exe)
 	}
+	// Opcode availability is a chain parameter rather than local configuration
+	exe.vmOptions.CancunHeight = params.CancunHeight
+	// What call events record is part of the execution all validators must agree on
+	exe.vmOptions.CallTree = params.CallTree
+	// And the gas refunded for clearing storage
+	exe.vmOptions.GasRefunds = params.GasRefunds
+	// And what self-destructing does
+	exe.vmOptions.SelfDestruct = params.SelfDestruct
+	// As is the gas schedule which may be changed by governance from one block to the next
+	exe.gasSchedule, err = GasScheduleAtHeight(backend, exe.block.Height)
+	if err != nil {
+		return nil, err
+	}
+	exe.vmOptions.GasSchedule = exe.currentGasSchedule
+
+	callContext := &contexts.CallContext{
+		// TODO: expose WASM options to config
+		VMS:           vms.NewConnectedVirtualMachines(exe.vmOptions),
+		Blockchain:    blockchain,
+		State:         exe.txState,
+		MetadataState: exe.metadataCache,
+		RunCall:       runCall,
+		Logger:        exe.logger,
+	}
+	if params.FeeMarket != nil {
+		exe.block.BaseFee, err = BaseFeeAtHeight(params.FeeMarket, backend, exe.block.Height)
+		if err != nil {
+			return nil, err
+		}
+		callContext.BaseFee = exe.baseFee
+	}
 
 	baseContexts := map[payload.Type]contexts.Context{
-		payload.TypeCall: &contexts.CallContext{
-			// TODO: expose WASM options to config
-			VMS:           vms.NewConnectedVirtualMachines(exe.vmOptions),
-			Blockchain:    blockchain,
-			State:         exe.stateCache,
-			MetadataState: exe.metadataCache,
-			RunCall:       runCall,
-			Logger:        exe.logger,
-		},
+		payload.TypeCall: callContext,
 		payload.TypeSend: &contexts.SendContext{
-			State:  exe.stateCache,
+			State:  exe.txState,
 			Logger: exe.logger,
 		},
 		payload.TypeName: &contexts.NameContext{
 			Blockchain: blockchain,
-			State:      exe.stateCache,
+			State:      exe.txState,
 			NameReg:    exe.nameRegCache,
 			Logger:     exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
-			State:  exe.stateCache,
+			State:  exe.txState,
 			Logger: exe.logger,
 		},
 		payload.TypeGovernance: &contexts.GovernanceContext{
 			ValidatorSet: exe.validatorCache,
-			State:        exe.stateCache,
+			State:        exe.txState,
+			GasSchedule:  exe.gasScheduleCache,
+			Blockchain:   blockchain,
 			Logger:       exe.logger,
 		},
 		payload.TypeBond: &contexts.BondContext{
 			ValidatorSet: exe.validatorCache,
-			State:        exe.stateCache,
+			State:        exe.txState,
 			Logger:       exe.logger,
 		},
 		payload.TypeUnbond: &contexts.UnbondContext{
-			ValidatorSet: exe.validatorCache,
-			State:        exe.stateCache,
-			Logger:       exe.logger,
+			ValidatorSet:    exe.validatorCache,
+			State:           exe.txState,
+			Blockchain:      blockchain,
+			UnbondingBlocks: params.UnbondingBlocks,
+			Logger:          exe.logger,
 		},
 		payload.TypeIdentify: &contexts.IdentifyContext{
 			NodeWriter:  exe.nodeRegCache,
-			StateReader: exe.stateCache,
+			StateReader: exe.txState,
 			Logger:      exe.logger,
 		},
 	}
@@ -199,7 +316,7 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		payload.TypeProposal: &contexts.ProposalContext{
 			ChainID:           params.ChainID,
 			ProposalThreshold: params.ProposalThreshold,
-			State:             exe.stateCache,
+			State:             exe.txState,
 			ProposalReg:       exe.proposalRegCache,
 			Logger:            exe.logger,
 			Contexts:          baseContexts,
@@ -214,6 +331,19 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 	return exe, nil
 }
 
+// Transactions read and write state through a recorder when their access sets are recorded
+func (exe *executor) initTxState() {
+	exe.txState = exe.stateCache
+	if exe.params.AccessSets {
+		exe.accesses = newAccessRecorder(exe.stateCache)
+		exe.txState = exe.accesses
+	}
+	// Outermost so that a refused write is not recorded as an access
+	exe.txState = newBalanceLockGuard(exe.txState, func() uint64 {
+		return exe.block.Height
+	})
+}
+
 func (exe *executor) AddContext(ty payload.Type, ctx contexts.Context) *executor {
 	exe.contexts[ty] = ctx
 	return exe
@@ -243,9 +373,28 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 		return nil, err
 	}
 
+	// The minimum fee is a node setting so only applies to what we accept into the mempool, not to blocks
+	if !exe.runCall && exe.minimumFeePerGas > 0 && txEnv.Tx.Type() == payload.TypeCall {
+		feePerGas := FeePerGas(txEnv, exe.feeMarketBaseFee())
+		if feePerGas < exe.minimumFeePerGas {
+			err = errors.Errorf(errors.Codes.InsufficientFunds,
+				"fee per gas %d offered is below the minimum of %d accepted by this node", feePerGas,
+				exe.minimumFeePerGas)
+			logger.InfoMsg("Transaction fee too low", structure.ErrorKey, err)
+			return nil, err
+		}
+	}
+
 	if txExecutor, ok := exe.contexts[txEnv.Tx.Type()]; ok {
 		// Establish new TxExecution
 		txe := exe.block.Tx(txEnv)
+		if exe.accesses != nil {
+			exe.accesses.reset()
+			// Recorded for failed transactions too since they are still included in the block
+			defer func() {
+				txe.AccessSet = exe.accesses.accessSet()
+			}()
+		}
 		defer func() {
 			if r := recover(); r != nil {
 				err = fmt.Errorf("recovered from panic in executor.Execute(%s): %v\n%s", txEnv.String(), r,
@@ -274,6 +423,10 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			txe.PushError(err)
 			return nil, err
 		}
+		if exe.params.FeeMarket != nil {
+			// Only tracked with the fee market enabled so as not to change the blocks stored by existing chains
+			exe.block.GasUsed += txe.GetResult().GetGasUsed()
+		}
 		// Return execution for this tx
 		return txe, nil
 	}
@@ -287,7 +440,7 @@ func (exe *executor) validateInputsAndStorePublicKeys(txEnv *txs.Envelope) error
 		if err != nil {
 			return fmt.Errorf("failed to update public key for input %v: %w", in.Address, err)
 		}
-		acc, err := exe.stateCache.GetAccount(in.Address)
+		acc, err := exe.txState.GetAccount(in.Address)
 		if err != nil {
 			return err
 		}
@@ -309,7 +462,7 @@ func (exe *executor) validateInputsAndStorePublicKeys(txEnv *txs.Envelope) error
 			return errors.Codes.InsufficientFunds
 		}
 		// Check for Input permission
-		globalPerms, err := acmstate.GlobalAccountPermissions(exe.stateCache)
+		globalPerms, err := acmstate.GlobalAccountPermissions(exe.txState)
 		if err != nil {
 			return err
 		}
@@ -327,7 +480,7 @@ func (exe *executor) validateInputsAndStorePublicKeys(txEnv *txs.Envelope) error
 func (exe *executor) updateSignatory(sig txs.Signatory) error {
 	// pointer dereferences are safe since txEnv.Validate() is run by
 	// txEnv.Verify() above which checks they are non-nil
-	acc, err := exe.stateCache.GetAccount(*sig.Address)
+	acc, err := exe.txState.GetAccount(*sig.Address)
 	if err != nil {
 		return fmt.Errorf("error getting account on which to set public key: %v", *sig.Address)
 	} else if acc == nil {
@@ -339,30 +492,50 @@ func (exe *executor) updateSignatory(sig txs.Signatory) error {
 			acc.Address, sig.PublicKey)
 	}
 	acc.PublicKey = sig.PublicKey
-	return exe.stateCache.UpdateAccount(acc)
+	return exe.txState.UpdateAccount(acc)
 }
 
-// Commit the current state - optionally pass in the tendermint ABCI header for that to be included with the BeginBlock
-// StreamEvent
-func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error) {
+// Finalise the current block - optionally pass in the tendermint ABCI header for that to be included with the
+// BeginBlock StreamEvent - and return the hash state will have once it is committed, without persisting anything
+func (exe *executor) Finalise(header *types.Header) (stateHash []byte, err error) {
 	// The write lock to the executor is controlled by the caller (e.g. abci.App) so we do not acquire it here to avoid
 	// deadlock
 	defer func() {
 		if r := recover(); r != nil {
-			err = fmt.Errorf("recovered from panic in executor.Commit(): %v\n%s", r, debug.Stack())
+			err = fmt.Errorf("recovered from panic in executor.Finalise(): %v\n%s", r, debug.Stack())
 		}
 	}()
-	// Capture height
-	height := exe.block.Height
-	exe.logger.InfoMsg("Executor committing", "height", exe.block.Height)
+	if exe.finalised != nil {
+		return nil, fmt.Errorf("block at height %d has already been finalised but not committed",
+			exe.finalised.Height)
+	}
+	exe.logger.InfoMsg("Executor finalising", "height", exe.block.Height)
+	// State is migrated for an upgrade planned for this block before anything else happens at its end
+	err = exe.applyUpgrade()
+	if err != nil {
+		return nil, err
+	}
+	// Calls scheduled for this block are made after all of its transactions
+	err = exe.makeScheduledCalls()
+	if err != nil {
+		return nil, err
+	}
+	// As are the withdrawals of power whose unbonding period ends with this block
+	err = exe.payUnbondedWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	// And name registry entries that expire with this block are removed
+	err = exe.sweepExpiredNames()
+	if err != nil {
+		return nil, err
+	}
 	// Form BlockExecution for this block from TxExecutions and Tendermint block header
 	blockExecution, err := exe.finaliseBlockExecution(header)
 	if err != nil {
 		return nil, err
 	}
-	// First commit the app state, this app hash will not get checkpointed until the next block when we are sure
-	// that nothing in the downstream commit process could have failed. At worst we go back one block.
-	hash, version, err := exe.state.Update(func(ws state.Updatable) error {
+	hash, err := exe.state.Stage(func(ws state.Updatable) error {
 		// flush the caches
 		err := exe.stateCache.Sync(ws)
 		if err != nil {
@@ -388,6 +561,10 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 		if err != nil {
 			return err
 		}
+		err = exe.gasScheduleCache.Sync(ws)
+		if err != nil {
+			return err
+		}
 		err = ws.AddBlock(blockExecution)
 		if err != nil {
 			return err
@@ -397,6 +574,36 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 	if err != nil {
 		return nil, err
 	}
+	exe.finalised = blockExecution
+	return hash, nil
+}
+
+// Commit the current state, finalising the current block first with header unless Finalise already has
+func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error) {
+	// The write lock to the executor is controlled by the caller (e.g. abci.App) so we do not acquire it here to avoid
+	// deadlock
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic in executor.Commit(): %v\n%s", r, debug.Stack())
+		}
+	}()
+	if exe.finalised == nil {
+		_, err = exe.Finalise(header)
+		if err != nil {
+			return nil, err
+		}
+	}
+	blockExecution := exe.finalised
+	exe.finalised = nil
+	// Capture height
+	height := blockExecution.Height
+	exe.logger.InfoMsg("Executor committing", "height", height)
+	// First commit the app state, this app hash will not get checkpointed until the next block when we are sure
+	// that nothing in the downstream commit process could have failed. At worst we go back one block.
+	hash, version, err := exe.state.Save()
+	if err != nil {
+		return nil, err
+	}
 	// Complete flushing of caches by resetting them to the state we have just committed
 	err = exe.Reset()
 	if err != nil {
@@ -408,6 +615,9 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 		return nil, fmt.Errorf("expected height at state tree version %d is %d but actual height is %d",
 			version, expectedHeight, height)
 	}
+	if exe.contractStats != nil {
+		exe.contractStats.AddBlock(blockExecution)
+	}
 	// Now state is fully committed publish events (this should be the last thing we do)
 	exe.publishBlock(blockExecution)
 	return hash, nil
@@ -421,7 +631,29 @@ func (exe *executor) Reset() error {
 	exe.nodeRegCache.Reset(exe.state)
 	exe.proposalRegCache.Reset(exe.state)
 	exe.validatorCache.Reset(exe.state)
-	return nil
+	exe.gasScheduleCache.Reset(exe.state)
+	// Pick up any change to the gas schedule for the next block
+	var err error
+	exe.gasSchedule, err = GasScheduleAtHeight(exe.state, exe.block.Height)
+	return err
+}
+
+func (exe *executor) Restart() error {
+	predecessor, err := exe.state.LastStoredHeight()
+	if err != nil {
+		return err
+	}
+	exe.block = &exec.BlockExecution{
+		Height:            exe.blockchain.LastBlockHeight() + 1,
+		PredecessorHeight: predecessor,
+	}
+	if exe.params.FeeMarket != nil {
+		exe.block.BaseFee, err = BaseFeeAtHeight(exe.params.FeeMarket, exe.state, exe.block.Height)
+		if err != nil {
+			return err
+		}
+	}
+	return exe.Reset()
 }
 
 // executor exposes access to the underlying state cache protected by a RWMutex that prevents access while locked
@@ -457,11 +689,11 @@ func (exe *executor) finaliseBlockExecution(header *types.Header) (*exec.BlockEx
 	// Set the header when provided
 	be.Header = header
 	// My default the predecessor of the next block is the is the predecessor of the current block
-	// (in case the current block has no transactions - since we do not currently store empty blocks in state, see
-	// /execution/state/events.go)
+	// (in case the current block has no transactions or evidence - since we do not currently store empty blocks in
+	// state, see /execution/state/events.go)
 	predecessor := be.PredecessorHeight
-	if len(be.TxExecutions) > 0 {
-		// If the current block has transactions then it will be the predecessor of the next block
+	if !be.Empty() {
+		// If the current block has transactions or evidence then it will be the predecessor of the next block
 		predecessor = be.Height
 	}
 	// Start new execution for the next height
@@ -469,13 +701,71 @@ func (exe *executor) finaliseBlockExecution(header *types.Header) (*exec.BlockEx
 		Height:            exe.block.Height + 1,
 		PredecessorHeight: predecessor,
 	}
+	if exe.params.FeeMarket != nil {
+		exe.block.BaseFee = exe.params.FeeMarket.NextBaseFee(be.BaseFee, be.GasUsed)
+	}
 	return be, nil
 }
 
+// Base fee per unit of gas of the block being executed
+func (exe *executor) baseFee() uint64 {
+	return exe.block.BaseFee
+}
+
+// Base fee of the block being executed or nil if the chain has no fee market
+func (exe *executor) feeMarketBaseFee() *uint64 {
+	if exe.params.FeeMarket == nil {
+		return nil
+	}
+	baseFee := exe.block.BaseFee
+	return &baseFee
+}
+
+func (exe *executor) AddEvidence(evidence ...*exec.Evidence) {
+	exe.block.Evidence = append(exe.block.Evidence, evidence...)
+}
+
+func (exe *executor) OrderByFee(txEnvs []*txs.Envelope) []*txs.Envelope {
+	return OrderByFee(txEnvs, exe.feeMarketBaseFee(), exe.params.FeeOrdering)
+}
+
+func (exe *executor) OrderProposal(txEnvs []*txs.Envelope) ([]*txs.Envelope, error) {
+	return OrderProposal(txEnvs, exe, exe.feeMarketBaseFee())
+}
+
+// Gas schedule of the block being executed
+func (exe *executor) currentGasSchedule() *gas.Schedule {
+	return exe.gasSchedule
+}
+
+// Get the gas schedule in effect at height from state falling back to the default schedule if none has been set
+func GasScheduleAtHeight(st gas.Reader, height uint64) (*gas.Schedule, error) {
+	update, err := st.GetGasSchedule(height)
+	if err != nil {
+		return nil, err
+	}
+	if update == nil {
+		return gas.DefaultSchedule(), nil
+	}
+	return update.Schedule, nil
+}
+
+// Get the base fee of the block at height from the last block stored in state before it
+func BaseFeeAtHeight(params *feemarket.Params, st BeginBlockReader, height uint64) (uint64, error) {
+	if height == 0 {
+		return params.InitialBaseFee, nil
+	}
+	predecessor, err := st.LastBeginBlock(height - 1)
+	if err != nil {
+		return 0, err
+	}
+	return params.BaseFeeAt(height, predecessor.GetHeight(), predecessor.GetBaseFee(), predecessor.GetGasUsed()), nil
+}
+
 // update sequence numbers
 func (exe *executor) updateSequenceNumbers(txEnv *txs.Envelope) error {
 	for _, sig := range txEnv.Signatories {
-		acc, err := exe.stateCache.GetAccount(*sig.Address)
+		acc, err := exe.txState.GetAccount(*sig.Address)
 		if err != nil {
 			return fmt.Errorf("error getting account on which to set public key: %v", *sig.Address)
 		}
@@ -488,7 +778,7 @@ func (exe *executor) updateSequenceNumbers(txEnv *txs.Envelope) error {
 			"new_sequence", acc.Sequence+1)
 
 		acc.Sequence++
-		err = exe.stateCache.UpdateAccount(acc)
+		err = exe.txState.UpdateAccount(acc)
 		if err != nil {
 			return fmt.Errorf("error updating account after incrementing sequence: %v", err)
 		}
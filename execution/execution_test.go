@@ -13,6 +13,7 @@ import (
 
 	"github.com/hyperledger/burrow/execution/engine"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
@@ -25,9 +26,13 @@ import (
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	"github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/feemarket"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/execution/sponsorship"
 	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/execution/upgrade"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
@@ -35,7 +40,6 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	hex "github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/ripemd160"
@@ -1043,6 +1047,93 @@ func TestNameTxs(t *testing.T) {
 	}
 }
 
+func TestSweepExpiredNames(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), testGenesisDoc)
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	// Entries in state before names are first swept, as if restored from a dump
+	restored := []*names.Entry{
+		{Name: "restored/expired", Owner: testPrivAccounts[0].GetAddress(), Expires: 0},
+		{Name: "restored/later", Owner: testPrivAccounts[0].GetAddress(), Expires: 1000},
+	}
+	_, _, err = st.Update(func(up state.Updatable) error {
+		for _, entry := range restored {
+			err := up.UpdateName(entry)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	names.MinNameRegistrationPeriod = 5
+	params := ParamsFromGenesis(testGenesisDoc)
+	params.SweepExpiredNames = true
+	exe := makeExecutorWithParams(st, params)
+	fee := uint64(1000)
+	register := func(name string, blocks uint64) *names.Entry {
+		data := "some data"
+		amt := fee + blocks*names.NameCostPerBlock(names.NameBaseCost(name, data))
+		tx, err := payload.NewNameTx(st, testPrivAccounts[0].GetPublicKey(), name, data, amt, fee)
+		require.NoError(t, err)
+		require.NoError(t, exe.signExecuteCommit(tx, testPrivAccounts[0]))
+		entry, err := st.GetName(name)
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		return entry
+	}
+	expiredAt := func(height uint64) []*names.Entry {
+		bb, err := st.LastBeginBlock(height)
+		require.NoError(t, err)
+		if bb.GetHeight() != height {
+			return nil
+		}
+		return bb.ExpiredNames
+	}
+
+	// The first sweep removes what had already expired
+	expiring := register("expiring", 5)
+	require.Equal(t, restored[:1], expiredAt(exe.Blockchain.LastBlockHeight()))
+	entry, err := st.GetName("restored/expired")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	// A renewed entry is not removed when it would have expired
+	renewed := register("renewed", 5)
+	expires := renewed.Expires
+	renewed = register("renewed", 10)
+	require.True(t, renewed.Expires > expires)
+
+	// Entries are removed at the end of the block at which they expire
+	for exe.Blockchain.LastBlockHeight() < expiring.Expires-1 {
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+	}
+	entry, err = st.GetName("expiring")
+	require.NoError(t, err)
+	require.Equal(t, expiring, entry)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.Equal(t, expiring.Expires, exe.Blockchain.LastBlockHeight())
+	require.Equal(t, []*names.Entry{expiring}, expiredAt(expiring.Expires))
+	entry, err = st.GetName("expiring")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	for exe.Blockchain.LastBlockHeight() < renewed.Expires {
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		entry, err = st.GetName("renewed")
+		require.NoError(t, err)
+		require.Equal(t, exe.Blockchain.LastBlockHeight() < renewed.Expires, entry != nil)
+	}
+	require.Equal(t, []*names.Entry{renewed}, expiredAt(renewed.Expires))
+	entry, err = st.GetName("restored/later")
+	require.NoError(t, err)
+	require.Equal(t, restored[1], entry)
+}
+
 // Test creating a contract from futher down the call stack
 /*
 contract Factory {
@@ -1508,8 +1599,708 @@ func TestPredecessorTracking(t *testing.T) {
 	require.Equal(t, uint64(5), exe.block.Height)
 }
 
+func TestFeeMarket(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, 1)
+	params := ParamsFromGenesis(testGenesisDoc)
+	params.FeeMarket = &feemarket.Params{
+		InitialBaseFee: 10,
+		GasTarget:      2,
+	}
+	acc0 := getAccount(t, st, privAccounts[0].GetAddress())
+	acc1 := getAccount(t, st, privAccounts[1].GetAddress())
+	acc1.EVMCode = bc.MustSplice(PUSH1, 0x01, PUSH1, 0x00, SSTORE)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(acc1)
+	})
+	require.NoError(t, err)
+	exe := makeExecutorWithParams(st, params)
+	// Blocks before the executor's first block are treated as empty
+	baseFee := exe.block.BaseFee
+	require.Equal(t, params.FeeMarket.BaseFeeAt(exe.block.Height, 0, 0, 0), baseFee)
+
+	mkTx := func(sequence, gasPrice uint64) *txs.Envelope {
+		txEnv := txs.Enclose(testChainID, &payload.CallTx{
+			Input: &payload.TxInput{
+				Address:  acc0.Address,
+				Sequence: sequence,
+			},
+			Address:  addressPtr(acc1),
+			GasLimit: 1000,
+			GasPrice: gasPrice,
+		})
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		return txEnv
+	}
+
+	// Legacy transactions pay all of their gas price
+	txe, err := exe.Execute(mkTx(acc0.Sequence+1, 20))
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	gasUsed := txe.Result.GasUsed
+	require.True(t, gasUsed > params.FeeMarket.GasTarget)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.Equal(t, acc0.Balance-gasUsed*20, getAccount(t, st, acc0.Address).Balance)
+
+	// Base fee rises with the gas used above target
+	require.True(t, exe.block.BaseFee > baseFee)
+	require.Equal(t, params.FeeMarket.NextBaseFee(baseFee, gasUsed), exe.block.BaseFee)
+	baseFee = exe.block.BaseFee
+
+	// Transactions offering less than the base fee are rejected
+	_, err = exe.Execute(mkTx(acc0.Sequence+2, baseFee-1))
+	require.Error(t, err)
+	require.NoError(t, exe.Reset())
+
+	// Base fee falls over empty blocks and is consistent with that derived from the blocks stored in state
+	for i := 0; i < 3; i++ {
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		require.True(t, exe.block.BaseFee < baseFee)
+		baseFee = exe.block.BaseFee
+		derived, err := BaseFeeAtHeight(params.FeeMarket, st, exe.block.Height)
+		require.NoError(t, err)
+		require.Equal(t, baseFee, derived)
+	}
+	require.Equal(t, baseFee, makeExecutorWithParams(st, params).block.BaseFee)
+}
+
+func TestGasSchedule(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, 1)
+	acc0 := getAccount(t, st, privAccounts[0].GetAddress())
+	acc0.Permissions.Base.Set(permission.Root, true)
+	acc1 := getAccount(t, st, privAccounts[1].GetAddress())
+	acc1.EVMCode = bc.MustSplice(PUSH1, 0x01, PUSH1, 0x00, SSTORE)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(acc0)
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(acc1)
+	})
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+	require.Equal(t, gas.DefaultSchedule(), exe.gasSchedule)
+
+	sequence := acc0.Sequence
+	execute := func(tx payload.Payload) *exec.TxExecution {
+		sequence++
+		tx.GetInputs()[0].Sequence = sequence
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		txe, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+		require.Nil(t, txe.Exception)
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		return txe
+	}
+	call := func() uint64 {
+		return execute(&payload.CallTx{
+			Input:    &payload.TxInput{Address: acc0.Address},
+			Address:  addressPtr(acc1),
+			GasLimit: 1000,
+		}).Result.GasUsed
+	}
+	defaultGasUsed := call()
+
+	schedule := gas.DefaultSchedule()
+	schedule.StorageUpdate = 100
+	height := exe.block.Height
+
+	// Updates must be for a future height
+	txEnv := txs.Enclose(testChainID, payload.UpdateGasScheduleTx(acc0.Address, height, schedule))
+	txEnv.Tx.GetInputs()[0].Sequence = sequence + 1
+	require.NoError(t, txEnv.Sign(privAccounts[0]))
+	_, err = exe.Execute(txEnv)
+	require.Error(t, err)
+	require.NoError(t, exe.Reset())
+
+	execute(payload.UpdateGasScheduleTx(acc0.Address, height+2, schedule))
+	// Not yet in effect
+	require.Equal(t, defaultGasUsed, call())
+	require.Equal(t, height+2, exe.block.Height)
+	require.Equal(t, defaultGasUsed+schedule.StorageUpdate-gas.DefaultSchedule().StorageUpdate, call())
+
+	stored, err := GasScheduleAtHeight(st, height+2)
+	require.NoError(t, err)
+	require.Equal(t, schedule, stored)
+	require.Equal(t, schedule, makeExecutor(st).gasSchedule)
+}
+
+func TestUpgradePlan(t *testing.T) {
+	st, privAccounts := makeGenesisState(1, 1)
+	acc0 := getAccount(t, st, privAccounts[0].GetAddress())
+	acc0.Permissions.Base.Set(permission.Root, true)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(acc0)
+	})
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	sequence := acc0.Sequence
+	execute := func(tx *payload.GovTx) error {
+		tx.Inputs[0].Sequence = sequence + 1
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		_, err := exe.Execute(txEnv)
+		if err != nil {
+			return exe.Reset()
+		}
+		sequence++
+		_, err = exe.Commit(nil)
+		return err
+	}
+	const name = "TestUpgradePlan"
+	height := exe.block.Height
+
+	// Plans must be for a future height
+	require.NoError(t, execute(payload.UpgradePlanTx(acc0.Address, name, height, "v1.0.0")))
+	require.Equal(t, acc0.Sequence, sequence)
+
+	require.NoError(t, execute(payload.UpgradePlanTx(acc0.Address, name, height+2, "v1.0.0")))
+	plan, err := upgrade.GetPlan(st)
+	require.NoError(t, err)
+	require.Equal(t, &upgrade.Plan{Name: name, Height: height + 2, Info: "v1.0.0"}, plan)
+
+	migrated := false
+	upgrade.Register(name, func(st acmstate.ReaderWriter, logger *logging.Logger) error {
+		migrated = true
+		return nil
+	})
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.False(t, migrated)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.True(t, migrated)
+
+	plan, err = upgrade.GetPlan(st)
+	require.NoError(t, err)
+	require.Nil(t, plan)
+	appliedHeight, err := upgrade.AppliedHeight(st, name)
+	require.NoError(t, err)
+	require.Equal(t, height+2, appliedHeight)
+
+	// An upgrade may not be planned again once applied
+	require.NoError(t, execute(payload.UpgradePlanTx(acc0.Address, name, height+10, "v1.0.0")))
+	require.Equal(t, acc0.Sequence+1, sequence)
+
+	// A binary that does not support the upgrade cannot execute the block at its height
+	require.NoError(t, execute(payload.UpgradePlanTx(acc0.Address, "Unsupported", exe.block.Height+1, "v2.0.0")))
+	_, err = exe.Commit(nil)
+	require.Error(t, err)
+}
+
+func TestExecuteParallel(t *testing.T) {
+	st, privAccounts := makeGenesisState(6, 1)
+	// Every call increments the same counter so calls conflict
+	counter := getAccount(t, st, privAccounts[4].GetAddress())
+	counter.EVMCode = bc.MustSplice(PUSH1, 0x00, SLOAD, PUSH1, 0x01, ADD, PUSH1, 0x00, SSTORE)
+	// Each caller increments their own counter so calls do not conflict
+	callerCounter := getAccount(t, st, privAccounts[5].GetAddress())
+	callerCounter.EVMCode = bc.MustSplice(CALLER, SLOAD, PUSH1, 0x01, ADD, CALLER, SSTORE)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(counter)
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(callerCounter)
+	})
+	require.NoError(t, err)
+
+	sequences := make(map[crypto.Address]uint64)
+	var txEnvs []*txs.Envelope
+	tx := func(i int, tx payload.Payload) {
+		address := privAccounts[i].GetAddress()
+		sequences[address]++
+		tx.GetInputs()[0].Sequence = getAccount(t, st, address).Sequence + sequences[address]
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(privAccounts[i]))
+		txEnvs = append(txEnvs, txEnv)
+	}
+	call := func(i int, address crypto.Address) {
+		tx(i, &payload.CallTx{
+			Input:    &payload.TxInput{Address: privAccounts[i].GetAddress(), Amount: 1},
+			Address:  &address,
+			GasLimit: 1000,
+		})
+	}
+	send := func(i, j int) {
+		sendTx := payload.NewSendTx()
+		require.NoError(t, sendTx.AddInputWithSequence(privAccounts[i].GetPublicKey(), 10, 0))
+		sendTx.AddOutput(privAccounts[j].GetAddress(), 10)
+		tx(i, sendTx)
+	}
+	for i := 0; i < 4; i++ {
+		call(i, callerCounter.Address)
+		call(i, counter.Address)
+	}
+	send(0, 1)
+	send(2, 3)
+	// Received funds are spent
+	send(1, 2)
+	tx(3, payload.NewNameTxWithSequence(privAccounts[3].GetPublicKey(), "foo", "bar", 1000, 1, 0))
+	call(3, callerCounter.Address)
+	call(2, callerCounter.Address)
+	// Invalid sequence
+	send(1, 0)
+	txEnvs[len(txEnvs)-1].Tx.GetInputs()[0].Sequence += 10
+	require.NoError(t, txEnvs[len(txEnvs)-1].Sign(privAccounts[1]))
+
+	serial := makeExecutor(copyState(t, st))
+	var serialErrs []error
+	for _, txEnv := range txEnvs {
+		_, err := serial.Execute(txEnv)
+		serialErrs = append(serialErrs, err)
+	}
+	serialBlock := serial.block
+	serialHash, err := serial.Commit(nil)
+	require.NoError(t, err)
+
+	for _, workers := range []int{1, 4} {
+		parallel := makeExecutor(copyState(t, st))
+		parallel.parallelWorkers = workers
+		txes, errs := parallel.ExecuteParallel(txEnvs)
+		require.Len(t, txes, len(txEnvs))
+		for i := range txEnvs {
+			require.Equal(t, serialErrs[i] == nil, errs[i] == nil, "error for tx %d: %v %v", i, serialErrs[i], errs[i])
+			require.Equal(t, errs[i] == nil, txes[i] != nil)
+		}
+		require.Nil(t, errs[len(errs)-2])
+		assertErrorCode(t, errors.Codes.InvalidSequence, errs[len(errs)-1])
+		require.Equal(t, serialBlock.TxExecutions, parallel.block.TxExecutions)
+		parallelHash, err := parallel.Commit(nil)
+		require.NoError(t, err)
+		require.Equal(t, serialHash, parallelHash)
+
+		value, err := parallel.state.GetStorage(counter.Address, Zero256)
+		require.NoError(t, err)
+		require.Equal(t, LeftPadWord256([]byte{4}).Bytes(), value)
+	}
+}
+
+func TestFinaliseThenCommit(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, 1)
+	sendTx := payload.NewSendTx()
+	require.NoError(t, sendTx.AddInputWithSequence(privAccounts[0].GetPublicKey(), 10, 1))
+	sendTx.AddOutput(privAccounts[1].GetAddress(), 10)
+	txEnv := txs.Enclose(testChainID, sendTx)
+	require.NoError(t, txEnv.Sign(privAccounts[0]))
+
+	committed := makeExecutor(copyState(t, st))
+	_, err := committed.Execute(txEnv)
+	require.NoError(t, err)
+	committedHash, err := committed.Commit(nil)
+	require.NoError(t, err)
+
+	finalisedState := copyState(t, st)
+	finalised := makeExecutor(finalisedState)
+	_, err = finalised.Execute(txEnv)
+	require.NoError(t, err)
+	version := finalisedState.Version()
+	finalisedHash, err := finalised.Finalise(nil)
+	require.NoError(t, err)
+	require.Equal(t, committedHash, finalisedHash)
+	// Nothing is persisted until commit, including the transaction index
+	require.Equal(t, version, finalisedState.Version())
+	txe, err := finalisedState.TxByHash(txEnv.Tx.Hash())
+	require.NoError(t, err)
+	require.Nil(t, txe)
+	_, err = finalised.Finalise(nil)
+	require.Error(t, err, "a block can only be finalised once")
+
+	finalisedHash, err = finalised.Commit(nil)
+	require.NoError(t, err)
+	require.Equal(t, committedHash, finalisedHash)
+	require.Equal(t, version+1, finalisedState.Version())
+	txe, err = finalisedState.TxByHash(txEnv.Tx.Hash())
+	require.NoError(t, err)
+	require.NotNil(t, txe)
+}
+
+func TestAccessSets(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, 1)
+	counter := getAccount(t, st, privAccounts[2].GetAddress())
+	counter.EVMCode = bc.MustSplice(PUSH1, 0x00, SLOAD, PUSH1, 0x01, ADD, PUSH1, 0x00, SSTORE)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(counter)
+	})
+	require.NoError(t, err)
+	caller := privAccounts[0].GetAddress()
+
+	params := ParamsFromGenesis(testGenesisDoc)
+	params.AccessSets = true
+	exe := makeExecutorWithParams(st, params)
+
+	var txEnvs []*txs.Envelope
+	call := func(sequence uint64) *txs.Envelope {
+		txEnv := txs.Enclose(testChainID, &payload.CallTx{
+			Input:    &payload.TxInput{Address: caller, Amount: 1, Sequence: sequence},
+			Address:  &counter.Address,
+			GasLimit: 1000,
+		})
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		txEnvs = append(txEnvs, txEnv)
+		return txEnv
+	}
+	txe, err := exe.Execute(call(1))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []exec.Access{
+		{Address: acm.GlobalPermissionsAddress, Account: true},
+		{Address: caller, Account: true},
+		{Address: counter.Address, Account: true, Keys: []Word256{Zero256}},
+		// Whether the caller is sponsored
+		{Address: sponsorship.Address, Keys: []Word256{LeftPadWord256(caller.Bytes())}},
+	}, txe.AccessSet.Reads)
+	require.ElementsMatch(t, []exec.Access{
+		{Address: caller, Account: true},
+		{Address: counter.Address, Account: true, Keys: []Word256{Zero256}},
+	}, txe.AccessSet.Writes)
+
+	// Failed transactions still record what they read
+	_, err = exe.Execute(call(5))
+	assertErrorCode(t, errors.Codes.InvalidSequence, err)
+	failed := exe.block.TxExecutions[len(exe.block.TxExecutions)-1]
+	require.Equal(t, []exec.Access{{Address: caller, Account: true}}, failed.AccessSet.Reads)
+	require.Len(t, failed.AccessSet.Writes, 0)
+
+	// Parallel execution records the same access sets
+	parallel := makeExecutorWithParams(copyState(t, st), params)
+	txes, errs := parallel.ExecuteParallel(txEnvs)
+	require.NoError(t, errs[0])
+	require.Equal(t, txe.AccessSet, txes[0].AccessSet)
+	require.Equal(t, failed.AccessSet, parallel.block.TxExecutions[1].AccessSet)
+
+	// Access sets are stored with the block
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	stored, err := st.TxByHash(txe.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, txe.AccessSet, stored.AccessSet)
+}
+
+func TestCallTree(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, 1)
+	counter := getAccount(t, st, privAccounts[1].GetAddress())
+	counter.EVMCode = bc.MustSplice(PUSH1, 0x00, SLOAD, PUSH1, 0x01, ADD, PUSH1, 0x00, SSTORE)
+	// Delegate to the counter then create an empty contract
+	proxy := getAccount(t, st, privAccounts[2].GetAddress())
+	proxy.EVMCode = bc.MustSplice(PUSH1, 0x00, PUSH1, 0x00, PUSH1, 0x00, PUSH1, 0x00, PUSH20, counter.Address,
+		PUSH1, 2, GAS, DIV, DELEGATECALL, POP,
+		PUSH1, 0x00, PUSH1, 0x00, PUSH1, 0x00, CREATE, POP)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(counter)
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(proxy)
+	})
+	require.NoError(t, err)
+
+	callEvents := func(callTree bool, sequence uint64) []*exec.CallEvent {
+		params := ParamsFromGenesis(testGenesisDoc)
+		params.CallTree = callTree
+		exe := makeExecutorWithParams(copyState(t, st), params)
+		txEnv := txs.Enclose(testChainID, &payload.CallTx{
+			Input:    &payload.TxInput{Address: privAccounts[0].GetAddress(), Amount: 1, Sequence: sequence},
+			Address:  &proxy.Address,
+			GasLimit: 100000,
+		})
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		txe, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+		require.Nil(t, txe.Exception)
+		var calls []*exec.CallEvent
+		for _, ev := range txe.Events {
+			if ev.Call != nil {
+				calls = append(calls, ev.Call)
+			}
+		}
+		// Innermost calls complete first
+		require.Len(t, calls, 3)
+		return calls
+	}
+
+	calls := callEvents(true, 1)
+	delegate, create, top := calls[0], calls[1], calls[2]
+	assert.Equal(t, exec.CallTypeDelegate, delegate.CallType)
+	assert.Equal(t, proxy.Address, delegate.CallData.Callee)
+	require.NotNil(t, delegate.CodeAddress)
+	assert.Equal(t, counter.Address, *delegate.CodeAddress)
+	assert.NotZero(t, delegate.GasUsed)
+	assert.Equal(t, exec.CallTypeCreate, create.CallType)
+	assert.Equal(t, proxy.Address, create.CallData.Caller)
+	assert.Equal(t, exec.CallTypeCall, top.CallType)
+	assert.Nil(t, top.CodeAddress)
+	assert.Greater(t, top.GasUsed, delegate.GasUsed)
+
+	// Otherwise call events are recorded as they always have been
+	calls = callEvents(false, 1)
+	for _, call := range calls {
+		assert.Nil(t, call.CodeAddress)
+		assert.Zero(t, call.GasUsed)
+	}
+	assert.Equal(t, exec.CallTypeDelegate, calls[0].CallType)
+	assert.Equal(t, exec.CallTypeCall, calls[1].CallType)
+}
+
+func TestScheduledCalls(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, 1)
+	scheduler := native.Scheduler.GetContract("Scheduler")
+	// Scheduled calls are made from the contract that schedules them
+	proxy := getAccount(t, st, privAccounts[1].GetAddress())
+	proxy.EVMCode = callContractCode(scheduler.Address())
+	counter := getAccount(t, st, privAccounts[2].GetAddress())
+	counter.EVMCode = bc.MustSplice(PUSH1, 0x00, SLOAD, PUSH1, 0x01, ADD, PUSH1, 0x00, SSTORE)
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(proxy)
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(counter)
+	})
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	sequence := getAccount(t, st, privAccounts[0].GetAddress()).Sequence
+	call := func(name string, args ...interface{}) *exec.TxExecution {
+		function := scheduler.FunctionByName(name)
+		data, err := abi.Pack(function.Abi().Inputs, args...)
+		require.NoError(t, err)
+		id := function.Abi().FunctionID
+		sequence++
+		txEnv := txs.Enclose(testChainID, &payload.CallTx{
+			Input:    &payload.TxInput{Address: privAccounts[0].GetAddress(), Sequence: sequence},
+			Address:  &proxy.Address,
+			GasLimit: 100000,
+			Data:     bc.MustSplice(id, data),
+		})
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		txe, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+		return txe
+	}
+	commit := func(blockTime time.Time) *exec.BlockExecution {
+		block := exe.block
+		appHash, err := exe.executor.Commit(nil)
+		require.NoError(t, err)
+		require.NoError(t, exe.Blockchain.CommitBlock(blockTime, nil, appHash))
+		return block
+	}
+	count := func() uint64 {
+		value, err := exe.state.GetStorage(counter.Address, Zero256)
+		require.NoError(t, err)
+		return Uint64FromWord256(LeftPadWord256(value))
+	}
+
+	height := exe.block.Height
+	txe := call("scheduleAtHeight", counter.Address, []byte{}, height+1, uint64(1000))
+	require.Nil(t, txe.Exception)
+	require.Equal(t, Uint64ToWord256(1).Bytes(), txe.Result.Return)
+	txe = call("scheduleAtHeight", counter.Address, []byte{}, height+1, uint64(1000))
+	require.Equal(t, Uint64ToWord256(2).Bytes(), txe.Result.Return)
+	txe = call("cancel", uint64(2))
+	require.Nil(t, txe.Exception)
+	// Cannot schedule in the past
+	txe = call("scheduleAtHeight", counter.Address, []byte{}, height-1, uint64(1000))
+	require.NotNil(t, txe.Exception)
+	// Cannot cancel a call that is not scheduled
+	txe = call("cancel", uint64(2))
+	require.NotNil(t, txe.Exception)
+	// Fails since the account does not exist
+	txe = call("scheduleAtHeight", crypto.Address{1, 2, 3}, []byte{}, height+1, uint64(1000))
+	require.Nil(t, txe.Exception)
+
+	block := commit(time.Now())
+	require.Len(t, block.TxExecutions, 6)
+	require.Equal(t, uint64(0), count())
+
+	// The scheduled calls are made in the next block
+	block = commit(time.Now())
+	require.Len(t, block.TxExecutions, 2)
+	scheduled := block.TxExecutions[0]
+	require.Nil(t, scheduled.Exception)
+	require.Equal(t, counter.Address, *scheduled.Envelope.Tx.Payload.(*payload.CallTx).Address)
+	require.NotNil(t, block.TxExecutions[1].Exception)
+	assertErrorCode(t, errors.Codes.InvalidAddress, block.TxExecutions[1].Exception)
+	require.Equal(t, uint64(1), count())
+
+	// Stored calls are removed once made
+	block = commit(time.Now())
+	require.Len(t, block.TxExecutions, 0)
+
+	blockTime := exe.Blockchain.LastBlockTime()
+	txe = call("scheduleAtTime", counter.Address, []byte{}, uint64(blockTime.Unix()+60), uint64(1000))
+	require.Nil(t, txe.Exception)
+	block = commit(blockTime.Add(time.Second))
+	require.Len(t, block.TxExecutions, 1)
+	block = commit(blockTime.Add(time.Minute))
+	require.Len(t, block.TxExecutions, 0)
+	// Made in the first block whose timestamp (that of the block before it) is at or after the time
+	block = commit(blockTime.Add(time.Hour))
+	require.Len(t, block.TxExecutions, 1)
+	require.Nil(t, block.TxExecutions[0].Exception)
+	require.Equal(t, uint64(2), count())
+}
+
+func TestSponsorship(t *testing.T) {
+	st, privAccounts := makeGenesisState(5, 1)
+	sponsorshipContract := native.Sponsorship.GetContract("Sponsorship")
+	// The proxy contract sponsors accounts so pays their fees
+	proxy := getAccount(t, st, privAccounts[1].GetAddress())
+	proxy.EVMCode = callContractCode(sponsorshipContract.Address())
+	require.NoError(t, proxy.Permissions.Base.Set(permission.Root, false))
+	counter := getAccount(t, st, privAccounts[2].GetAddress())
+	counter.EVMCode = bc.MustSplice(PUSH1, 0x00, SLOAD, PUSH1, 0x01, ADD, PUSH1, 0x00, SSTORE)
+	sponsored := getAccount(t, st, privAccounts[3].GetAddress())
+	sponsored.Balance = 0
+	waived := getAccount(t, st, privAccounts[4].GetAddress())
+	waived.Balance = 0
+	_, _, err := st.Update(func(up state.Updatable) error {
+		for _, acc := range []*acm.Account{proxy, counter, sponsored, waived} {
+			err := up.UpdateAccount(acc)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	execute := func(signer *acm.PrivateAccount, address crypto.Address, fee uint64, data []byte) (*exec.TxExecution, error) {
+		txEnv := txs.Enclose(testChainID, &payload.CallTx{
+			Input: &payload.TxInput{
+				Address:  signer.GetAddress(),
+				Sequence: getAccount(t, exe.stateCache, signer.GetAddress()).Sequence + 1,
+			},
+			Address:  &address,
+			Fee:      fee,
+			GasLimit: 100000,
+			Data:     data,
+		})
+		require.NoError(t, txEnv.Sign(signer))
+		return exe.Execute(txEnv)
+	}
+	call := func(name string, args ...interface{}) *exec.TxExecution {
+		function := sponsorshipContract.FunctionByName(name)
+		data, err := abi.Pack(function.Abi().Inputs, args...)
+		require.NoError(t, err)
+		txe, err := execute(privAccounts[0], proxy.Address, 0, bc.MustSplice(function.Abi().FunctionID, data))
+		require.NoError(t, err)
+		return txe
+	}
+	balance := func(address crypto.Address) uint64 {
+		return getAccount(t, exe.stateCache, address).Balance
+	}
+
+	// An account without funds cannot pay its fee, which need not be part of the input amount when paid by a sponsor
+	_, err = execute(privAccounts[3], counter.Address, 10, nil)
+	assertErrorCode(t, errors.Codes.InsufficientFunds, err)
+
+	txe := call("sponsor", sponsored.Address, uint64(25))
+	require.Nil(t, txe.Exception)
+	proxyBalance := balance(proxy.Address)
+	txe, err = execute(privAccounts[3], counter.Address, 10, nil)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	require.Equal(t, proxyBalance-10, balance(proxy.Address))
+	require.Equal(t, uint64(0), balance(sponsored.Address))
+	txe = call("sponsorOf", sponsored.Address)
+	require.Equal(t, LeftPadWord256(proxy.Address.Bytes()).Bytes(), txe.Result.Return)
+	current, err := sponsorship.Get(exe.stateCache, sponsored.Address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), current.Allowance)
+	// Once the allowance no longer covers the fee the account must pay for itself
+	_, err = execute(privAccounts[3], counter.Address, 20, nil)
+	assertErrorCode(t, errors.Codes.InsufficientFunds, err)
+
+	// Only an account with the root permission can waive fees
+	txe = call("waive", waived.Address, true)
+	require.NotNil(t, txe.Exception)
+	proxy = getAccount(t, exe.stateCache, proxy.Address)
+	require.NoError(t, proxy.Permissions.Base.Set(permission.Root, true))
+	exe.updateAccounts(t, proxy)
+	txe = call("waive", waived.Address, true)
+	require.Nil(t, txe.Exception)
+	proxyBalance = balance(proxy.Address)
+	txe, err = execute(privAccounts[4], counter.Address, 10, nil)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	require.Equal(t, proxyBalance, balance(proxy.Address))
+	require.Equal(t, uint64(0), balance(waived.Address))
+	// A waiver cannot be replaced by a sponsorship
+	txe = call("sponsor", waived.Address, uint64(25))
+	require.NotNil(t, txe.Exception)
+	txe = call("waive", waived.Address, false)
+	require.Nil(t, txe.Exception)
+	_, err = execute(privAccounts[4], counter.Address, 10, nil)
+	assertErrorCode(t, errors.Codes.InsufficientFunds, err)
+}
+
 // Helpers
 
+func TestBalanceLocks(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, 1)
+	acc0 := getAccount(t, st, privAccounts[0].GetAddress())
+	acc1 := getAccount(t, st, privAccounts[1].GetAddress())
+	exe := makeExecutor(st)
+	// Lock all but 5 until the block after this one
+	acc0.Locks = []acm.BalanceLock{{Amount: acc0.Balance - 5, UnlockHeight: exe.block.Height + 1}}
+	require.NoError(t, exe.stateCache.UpdateAccount(acc0))
+	// An account already short of what it has locked can still be paid
+	acc1.Locks = []acm.BalanceLock{{Amount: acc1.Balance + 100, UnlockHeight: exe.block.Height + 1}}
+	require.NoError(t, exe.stateCache.UpdateAccount(acc1))
+
+	send := func(amount uint64) error {
+		tx := payload.NewSendTx()
+		require.NoError(t, tx.AddInput(exe.stateCache, privAccounts[0].GetPublicKey(), amount))
+		tx.AddOutput(acc1.Address, amount)
+		return exe.signExecuteCommit(tx, privAccounts[0])
+	}
+	err := send(6)
+	require.Error(t, err)
+	assert.Equal(t, errors.Codes.InsufficientBalance, errors.AsException(err).ErrorCode())
+
+	// What is unlocked can be spent, and once the block is committed the rest unlocks
+	require.NoError(t, send(5))
+	require.NoError(t, send(6))
+	assert.Equal(t, acc1.Balance+11, getAccount(t, exe.stateCache, acc1.Address).Balance)
+}
+
+func TestBalanceLocksSelfDestruct(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, 1)
+	acc0 := getAccount(t, st, privAccounts[0].GetAddress())
+	contract := getAccount(t, st, privAccounts[1].GetAddress())
+	exe := makeExecutor(st)
+	// A self-destructing contract would send its locked balance to the caller and be removed
+	contract.EVMCode = bc.MustSplice(CALLER, SELFDESTRUCT)
+	contract.Locks = []acm.BalanceLock{{Amount: 1, UnlockHeight: exe.block.Height + 2}}
+	require.NoError(t, exe.stateCache.UpdateAccount(contract))
+	_, err := exe.Commit(nil)
+	require.NoError(t, err)
+
+	selfDestruct := func() error {
+		tx := payload.NewCallTxWithSequence(privAccounts[0].GetPublicKey(), addressPtr(contract), nil, 1, 100000, 1,
+			acc0.Sequence+1)
+		return exe.signExecuteCommit(tx, privAccounts[0])
+	}
+	err = selfDestruct()
+	require.Error(t, err)
+	assert.Equal(t, errors.Codes.InsufficientBalance, errors.AsException(err).ErrorCode())
+	require.NotNil(t, getAccount(t, exe.stateCache, contract.Address))
+
+	// Once unlocked it can be removed
+	require.NoError(t, exe.Reset())
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	require.NoError(t, selfDestruct())
+	acc, err := exe.stateCache.GetAccount(contract.Address)
+	require.NoError(t, err)
+	assert.Nil(t, acc)
+}
+
 func makeUsers(n int) []acm.AddressableSigner {
 	users := make([]acm.AddressableSigner, n)
 	for i := 0; i < n; i++ {
@@ -1585,6 +2376,10 @@ type testExecutor struct {
 }
 
 func makeExecutor(state *state.State) *testExecutor {
+	return makeExecutorWithParams(state, ParamsFromGenesis(testGenesisDoc))
+}
+
+func makeExecutorWithParams(state *state.State, params Params) *testExecutor {
 	testDB, err := dbm.NewDB("test", dbBackend, ".")
 	if err != nil {
 		panic(err)
@@ -1594,8 +2389,7 @@ func makeExecutor(state *state.State) *testExecutor {
 	if err != nil {
 		panic(err)
 	}
-	executor, err := newExecutor("makeExecutorCache", true, ParamsFromGenesis(testGenesisDoc), state,
-		blockchain, nil, logger)
+	executor, err := newExecutor("makeExecutorCache", true, params, state, blockchain, nil, logger)
 	if err != nil {
 		panic(err)
 	}
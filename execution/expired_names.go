@@ -0,0 +1,57 @@
+package execution
+
+import (
+	"github.com/hyperledger/burrow/execution/expiry"
+	"github.com/hyperledger/burrow/execution/names"
+)
+
+// Remove the name registry entries that expire with the block being executed, recording them in the block, and queue
+// those registered or renewed by the block to be removed when they expire
+func (exe *executor) sweepExpiredNames() error {
+	if !exe.params.SweepExpiredNames {
+		return nil
+	}
+	height := exe.block.Height
+	err := exe.startNameExpiries(height)
+	if err != nil {
+		return err
+	}
+	due, err := expiry.Due(exe.stateCache, height)
+	if err != nil {
+		return err
+	}
+	for _, name := range due {
+		entry, err := exe.nameRegCache.GetName(name)
+		if err != nil {
+			return err
+		}
+		// Removed or renewed since it was queued, or queued twice
+		if entry == nil || entry.Expires > height {
+			continue
+		}
+		err = exe.nameRegCache.RemoveName(name)
+		if err != nil {
+			return err
+		}
+		exe.block.ExpiredNames = append(exe.block.ExpiredNames, entry)
+		exe.logger.TraceMsg("Removed expired NameReg entry",
+			"name", entry.Name,
+			"expires", entry.Expires)
+	}
+	return exe.nameRegCache.Sync(expiry.Queue(exe.stateCache))
+}
+
+// The first time names are swept every entry already in state, such as those restored from a dump, is queued with those
+// that have already expired due now
+func (exe *executor) startNameExpiries(height uint64) error {
+	started, err := expiry.Start(exe.stateCache)
+	if err != nil || !started {
+		return err
+	}
+	return exe.state.IterateNames(func(entry *names.Entry) error {
+		if entry.Expires < height {
+			return expiry.Add(exe.stateCache, entry.Name, height)
+		}
+		return expiry.Add(exe.stateCache, entry.Name, entry.Expires)
+	})
+}
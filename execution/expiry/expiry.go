@@ -0,0 +1,81 @@
+package expiry
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/names"
+)
+
+// On chains that sweep expired names the name registry entries that may expire at a height are queued under that height
+// in the storage of the account at Address. An entry renewed or removed after it was queued stays queued at its old
+// height so entries are checked against the name registry when they are due rather than trusted.
+var Address = engine.AddressFromName("NameExpiries")
+
+// Start creates the queue, returning whether it did so or had already been started
+func Start(st acmstate.ReaderWriter) (bool, error) {
+	acc, err := st.GetAccount(Address)
+	if err != nil || acc != nil {
+		return false, err
+	}
+	return true, engine.CreateAccount(st, Address)
+}
+
+// Add queues name to be checked for expiry at the end of the block at height
+func Add(st acmstate.ReaderWriter, name string, height uint64) error {
+	if len(name) > names.MaxNameLength {
+		return fmt.Errorf("cannot queue name %s longer than %d bytes", name, names.MaxNameLength)
+	}
+	key := heightKey(height)
+	bs, err := st.GetStorage(Address, key)
+	if err != nil {
+		return err
+	}
+	bs = append(bs, byte(len(name)))
+	return st.SetStorage(Address, key, append(bs, name...))
+}
+
+// Due removes and returns the names queued at height in the order they were queued, which may include the same name
+// more than once
+func Due(st acmstate.ReaderWriter, height uint64) ([]string, error) {
+	key := heightKey(height)
+	bs, err := st.GetStorage(Address, key)
+	if err != nil || len(bs) == 0 {
+		return nil, err
+	}
+	var due []string
+	for i := 0; i < len(bs); {
+		end := i + 1 + int(bs[i])
+		if end > len(bs) {
+			return nil, fmt.Errorf("names due to expire at height %d are truncated", height)
+		}
+		due = append(due, string(bs[i+1:end]))
+		i = end
+	}
+	return due, st.SetStorage(Address, key, nil)
+}
+
+// Queue returns a names.Writer that queues each entry updated through it at the height it expires, for syncing a
+// names.Cache to
+func Queue(st acmstate.ReaderWriter) names.Writer {
+	return queue{st}
+}
+
+type queue struct {
+	st acmstate.ReaderWriter
+}
+
+func (q queue) UpdateName(entry *names.Entry) error {
+	return Add(q.st, entry.Name, entry.Expires)
+}
+
+func (q queue) RemoveName(name string) error {
+	return nil
+}
+
+func heightKey(height uint64) binary.Word256 {
+	return binary.LeftPadWord256(crypto.Keccak256(append([]byte("height"), binary.Uint64ToWord256(height).Bytes()...)))
+}
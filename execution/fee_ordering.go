@@ -0,0 +1,158 @@
+package execution
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+
+	"github.com/hyperledger/burrow/execution/feemarket"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// FeeOrdering determines the order in which the transactions of a block are executed. Since the order changes the
+// results of execution all validators must agree on it.
+type FeeOrdering string
+
+const (
+	// Transactions are executed in the order they appear in the block, as when no ordering is set
+	FeeOrderingNone FeeOrdering = "none"
+	// Transactions offering more fee per gas are executed first and those offering the same fee in the order they
+	// appear in the block, which is the order they arrived in the proposer's mempool
+	FeeOrderingArrival FeeOrdering = "arrival"
+	// Transactions offering more fee per gas are executed first and those offering the same fee in order of their
+	// hashes, so their order does not depend on when they arrived
+	FeeOrderingHash FeeOrdering = "hash"
+)
+
+func (ordering FeeOrdering) Validate() error {
+	switch ordering {
+	case "", FeeOrderingNone, FeeOrderingArrival, FeeOrderingHash:
+		return nil
+	}
+	return fmt.Errorf("unknown fee ordering '%s', must be one of '%s', '%s', or '%s'", ordering,
+		FeeOrderingNone, FeeOrderingArrival, FeeOrderingHash)
+}
+
+// Enabled returns whether transactions are reordered by fee
+func (ordering FeeOrdering) Enabled() bool {
+	return ordering == FeeOrderingArrival || ordering == FeeOrderingHash
+}
+
+// FeePerGas returns what a transaction offers to pay per unit of gas: the gas price it would pay at baseFee, which is
+// nil if the chain has no fee market, plus any flat fee spread over its gas limit. Only calls pay for gas so other
+// transactions offer nothing.
+func FeePerGas(txEnv *txs.Envelope, baseFee *uint64) uint64 {
+	tx, ok := txEnv.Tx.Payload.(*payload.CallTx)
+	if !ok {
+		return 0
+	}
+	feePerGas := tx.Fee
+	if tx.GasLimit > 0 {
+		feePerGas /= tx.GasLimit
+	}
+	if baseFee != nil {
+		gasPrice := feemarket.EffectiveGasPrice(*baseFee, tx.GasPrice, txEnv.GasTipCap(tx.GasPrice))
+		if feePerGas+gasPrice < feePerGas {
+			return ^uint64(0)
+		}
+		feePerGas += gasPrice
+	}
+	return feePerGas
+}
+
+// OrderByFee returns txEnvs in the order ordering executes them given the block's baseFee. The transactions of each
+// input account keep their relative order so that their sequence numbers still follow on from one another, so a
+// transaction is executed once it is the next from its input and offers the most of those that are next.
+func OrderByFee(txEnvs []*txs.Envelope, baseFee *uint64, ordering FeeOrdering) []*txs.Envelope {
+	if !ordering.Enabled() || len(txEnvs) < 2 {
+		return txEnvs
+	}
+	// Queue the transactions of each input in the order they appear
+	var queues feeQueues
+	queueByInput := make(map[string]*feeQueue)
+	for i, txEnv := range txEnvs {
+		ftx := &feeTx{
+			txEnv:     txEnv,
+			index:     i,
+			feePerGas: FeePerGas(txEnv, baseFee),
+		}
+		if ordering == FeeOrderingHash {
+			ftx.hash = txEnv.Tx.Hash()
+		}
+		var input string
+		if inputs := txEnv.Tx.GetInputs(); len(inputs) > 0 {
+			input = inputs[0].Address.String()
+		}
+		queue, ok := queueByInput[input]
+		if !ok || input == "" {
+			queue = &feeQueue{}
+			queueByInput[input] = queue
+			queues = append(queues, queue)
+		}
+		queue.txs = append(queue.txs, ftx)
+	}
+	// Repeatedly take the best transaction at the head of a queue
+	heap.Init(&queues)
+	ordered := make([]*txs.Envelope, 0, len(txEnvs))
+	for len(queues) > 0 {
+		queue := queues[0]
+		ordered = append(ordered, queue.txs[0].txEnv)
+		queue.txs = queue.txs[1:]
+		if len(queue.txs) == 0 {
+			heap.Pop(&queues)
+		} else {
+			heap.Fix(&queues, 0)
+		}
+	}
+	return ordered
+}
+
+type feeTx struct {
+	txEnv     *txs.Envelope
+	index     int
+	feePerGas uint64
+	hash      []byte
+}
+
+// Whether ftx is executed before other
+func (ftx *feeTx) before(other *feeTx) bool {
+	if ftx.feePerGas != other.feePerGas {
+		return ftx.feePerGas > other.feePerGas
+	}
+	if c := bytes.Compare(ftx.hash, other.hash); c != 0 {
+		return c < 0
+	}
+	return ftx.index < other.index
+}
+
+type feeQueue struct {
+	txs []*feeTx
+}
+
+// A heap of queues ordered by their first transaction
+type feeQueues []*feeQueue
+
+func (fqs feeQueues) Len() int {
+	return len(fqs)
+}
+
+func (fqs feeQueues) Less(i, j int) bool {
+	return fqs[i].txs[0].before(fqs[j].txs[0])
+}
+
+func (fqs feeQueues) Swap(i, j int) {
+	fqs[i], fqs[j] = fqs[j], fqs[i]
+}
+
+func (fqs *feeQueues) Push(x interface{}) {
+	*fqs = append(*fqs, x.(*feeQueue))
+}
+
+func (fqs *feeQueues) Pop() interface{} {
+	old := *fqs
+	n := len(old)
+	queue := old[n-1]
+	*fqs = old[:n-1]
+	return queue
+}
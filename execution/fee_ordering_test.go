@@ -0,0 +1,56 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeePerGas(t *testing.T) {
+	baseFee := uint64(10)
+	txEnv := feeTxEnv(1, 1, 200, 100, 15)
+	assert.Equal(t, uint64(2), FeePerGas(txEnv, nil))
+	assert.Equal(t, uint64(17), FeePerGas(txEnv, &baseFee))
+
+	txEnv = txs.Enclose("chain", &payload.SendTx{})
+	assert.Equal(t, uint64(0), FeePerGas(txEnv, &baseFee))
+}
+
+func TestOrderByFee(t *testing.T) {
+	// Input 2 offers the most but its second transaction must wait for its first
+	txEnvs := []*txs.Envelope{
+		feeTxEnv(1, 1, 300, 100, 0),
+		feeTxEnv(2, 1, 100, 100, 0),
+		feeTxEnv(2, 2, 500, 100, 0),
+		feeTxEnv(3, 1, 300, 100, 0),
+		feeTxEnv(4, 1, 400, 100, 0),
+	}
+	require.Equal(t, txEnvs, OrderByFee(txEnvs, nil, FeeOrderingNone))
+
+	ordered := OrderByFee(txEnvs, nil, FeeOrderingArrival)
+	assert.Equal(t, []*txs.Envelope{txEnvs[4], txEnvs[0], txEnvs[3], txEnvs[1], txEnvs[2]}, ordered)
+
+	ordered = OrderByFee(txEnvs, nil, FeeOrderingHash)
+	require.Len(t, ordered, len(txEnvs))
+	assert.Equal(t, txEnvs[4], ordered[0])
+	assert.Equal(t, []*txs.Envelope{txEnvs[1], txEnvs[2]}, ordered[3:])
+	assert.ElementsMatch(t, []*txs.Envelope{txEnvs[0], txEnvs[3]}, ordered[1:3])
+
+	require.Error(t, FeeOrdering("highest").Validate())
+}
+
+func feeTxEnv(input byte, sequence, fee, gasLimit, gasPrice uint64) *txs.Envelope {
+	return txs.Enclose("chain", &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  crypto.Address{input},
+			Sequence: sequence,
+		},
+		Fee:      fee,
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+	})
+}
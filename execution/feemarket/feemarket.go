@@ -0,0 +1,109 @@
+// Package feemarket implements optional EIP-1559 style fee market semantics. Each block has a base fee per unit of gas
+// that is adjusted from block to block according to how far the gas used by the previous block was from a target.
+// Transactions pay the base fee plus a priority tip for each unit of gas they use, up to the gas price they offer.
+package feemarket
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const DefaultBaseFeeChangeDenominator = 8
+
+// Params are chain parameters fixed at genesis
+type Params struct {
+	// Base fee of the first block
+	InitialBaseFee uint64
+	// The base fee will not be adjusted below this value
+	MinBaseFee uint64 `json:",omitempty" toml:",omitempty"`
+	// The gas used by a block at which the base fee of the next block is unchanged
+	GasTarget uint64
+	// Bounds the change in base fee from one block to the next to 1/BaseFeeChangeDenominator of the base fee
+	BaseFeeChangeDenominator uint64 `json:",omitempty" toml:",omitempty"`
+}
+
+func (p *Params) Validate() error {
+	if p.GasTarget == 0 {
+		return fmt.Errorf("fee market GasTarget must be greater than zero")
+	}
+	if p.InitialBaseFee < p.MinBaseFee {
+		return fmt.Errorf("fee market InitialBaseFee %d is below MinBaseFee %d", p.InitialBaseFee, p.MinBaseFee)
+	}
+	return nil
+}
+
+// Returns the base fee of the block following one with baseFee that used gasUsed
+func (p *Params) NextBaseFee(baseFee, gasUsed uint64) uint64 {
+	if gasUsed == p.GasTarget {
+		return baseFee
+	}
+	denominator := p.BaseFeeChangeDenominator
+	if denominator == 0 {
+		denominator = DefaultBaseFeeChangeDenominator
+	}
+	// delta = baseFee * |gasUsed - GasTarget| / GasTarget / denominator
+	delta := new(big.Int)
+	if gasUsed > p.GasTarget {
+		delta.SetUint64(gasUsed - p.GasTarget)
+	} else {
+		delta.SetUint64(p.GasTarget - gasUsed)
+	}
+	delta.Mul(delta, new(big.Int).SetUint64(baseFee))
+	delta.Quo(delta, new(big.Int).SetUint64(p.GasTarget))
+	delta.Quo(delta, new(big.Int).SetUint64(denominator))
+
+	next := new(big.Int).SetUint64(baseFee)
+	if gasUsed > p.GasTarget {
+		// Always increase by at least one so a base fee of zero can recover
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+		next.Add(next, delta)
+		if !next.IsUint64() {
+			return ^uint64(0)
+		}
+	} else {
+		next.Sub(next, delta)
+	}
+	if next.Uint64() < p.MinBaseFee {
+		return p.MinBaseFee
+	}
+	return next.Uint64()
+}
+
+// Returns the base fee of the block at height given its predecessor: the most recent earlier block containing
+// transactions, or a predecessorHeight of zero if there is none. Blocks without transactions are not stored so they
+// are accounted for here as having used no gas.
+func (p *Params) BaseFeeAt(height, predecessorHeight, predecessorBaseFee, predecessorGasUsed uint64) uint64 {
+	if height == 0 {
+		return p.InitialBaseFee
+	}
+	baseFee := p.InitialBaseFee
+	emptyBlocks := height - 1
+	if predecessorHeight > 0 {
+		baseFee = p.NextBaseFee(predecessorBaseFee, predecessorGasUsed)
+		emptyBlocks = height - predecessorHeight - 1
+	}
+	for i := uint64(0); i < emptyBlocks; i++ {
+		next := p.NextBaseFee(baseFee, 0)
+		if next == baseFee {
+			// Reached the floor so further empty blocks will make no difference
+			break
+		}
+		baseFee = next
+	}
+	return baseFee
+}
+
+// Returns the price paid per unit of gas by a transaction offering at most gasFeeCap per unit in total of which at
+// most gasTipCap is a priority tip above the base fee. The gasFeeCap must be at least the base fee.
+func EffectiveGasPrice(baseFee, gasFeeCap, gasTipCap uint64) uint64 {
+	if gasFeeCap < baseFee {
+		return gasFeeCap
+	}
+	tip := gasFeeCap - baseFee
+	if gasTipCap < tip {
+		tip = gasTipCap
+	}
+	return baseFee + tip
+}
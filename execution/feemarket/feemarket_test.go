@@ -0,0 +1,55 @@
+package feemarket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := &Params{
+		InitialBaseFee: 1000,
+		MinBaseFee:     100,
+		GasTarget:      1000,
+	}
+	require.NoError(t, params.Validate())
+	// Unchanged at target
+	require.Equal(t, uint64(1000), params.NextBaseFee(1000, 1000))
+	// At most 1/8 change per block
+	require.Equal(t, uint64(1125), params.NextBaseFee(1000, 2000))
+	require.Equal(t, uint64(875), params.NextBaseFee(1000, 0))
+	require.Equal(t, uint64(1062), params.NextBaseFee(1000, 1500))
+	// Bounded below
+	require.Equal(t, uint64(100), params.NextBaseFee(110, 0))
+	// Increases by at least one
+	require.Equal(t, uint64(101), params.NextBaseFee(100, 1001))
+
+	params.MinBaseFee = 0
+	require.Equal(t, uint64(1), params.NextBaseFee(0, 1001))
+	params.BaseFeeChangeDenominator = 2
+	require.Equal(t, uint64(1500), params.NextBaseFee(1000, 2000))
+}
+
+func TestBaseFeeAt(t *testing.T) {
+	params := &Params{
+		InitialBaseFee: 1000,
+		MinBaseFee:     500,
+		GasTarget:      1000,
+	}
+	require.Equal(t, params.InitialBaseFee, params.BaseFeeAt(1, 0, 0, 0))
+	require.Equal(t, params.NextBaseFee(params.InitialBaseFee, 0), params.BaseFeeAt(2, 0, 0, 0))
+	// The block following the predecessor
+	require.Equal(t, params.NextBaseFee(2000, 3000), params.BaseFeeAt(6, 5, 2000, 3000))
+	// With intervening empty blocks
+	require.Equal(t, params.NextBaseFee(params.NextBaseFee(2000, 3000), 0), params.BaseFeeAt(7, 5, 2000, 3000))
+	// Down to the floor
+	require.Equal(t, params.MinBaseFee, params.BaseFeeAt(1000000, 5, 2000, 3000))
+}
+
+func TestEffectiveGasPrice(t *testing.T) {
+	// Tip capped by fee cap
+	require.Equal(t, uint64(15), EffectiveGasPrice(10, 15, 20))
+	// Tip capped by tip cap
+	require.Equal(t, uint64(12), EffectiveGasPrice(10, 15, 2))
+	require.Equal(t, uint64(10), EffectiveGasPrice(10, 10, 2))
+}
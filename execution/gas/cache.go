@@ -0,0 +1,70 @@
+package gas
+
+import (
+	"sort"
+	"sync"
+)
+
+// Cache buffers schedule updates over a backend Reader until they are written out with Sync
+type Cache struct {
+	sync.RWMutex
+	backend Reader
+	updates map[uint64]*Schedule
+}
+
+var _ ReaderWriter = &Cache{}
+
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend: backend,
+		updates: make(map[uint64]*Schedule),
+	}
+}
+
+func (cache *Cache) GetGasSchedule(height uint64) (*ScheduleUpdate, error) {
+	cache.RLock()
+	defer cache.RUnlock()
+	update, err := cache.backend.GetGasSchedule(height)
+	if err != nil {
+		return nil, err
+	}
+	for h, schedule := range cache.updates {
+		if h <= height && (update == nil || h >= update.Height) {
+			update = &ScheduleUpdate{Height: h, Schedule: schedule}
+		}
+	}
+	return update, nil
+}
+
+func (cache *Cache) SetGasSchedule(update *ScheduleUpdate) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.updates[update.Height] = update.Schedule
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+func (cache *Cache) Sync(state Writer) error {
+	cache.Lock()
+	defer cache.Unlock()
+	heights := make([]uint64, 0, len(cache.updates))
+	for h := range cache.updates {
+		heights = append(heights, h)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	for _, h := range heights {
+		err := state.SetGasSchedule(&ScheduleUpdate{Height: h, Schedule: cache.updates[h]})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.updates = make(map[uint64]*Schedule)
+}
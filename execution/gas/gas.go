@@ -0,0 +1,145 @@
+package gas
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+)
+
+// Reader provides the gas schedule in effect at a height
+type Reader interface {
+	// Get the update in effect at height, that is the last one set at or below height, or nil if none has been set
+	GetGasSchedule(height uint64) (*ScheduleUpdate, error)
+}
+
+type Writer interface {
+	// Set the schedule to be in effect from update.Height onwards
+	SetGasSchedule(update *ScheduleUpdate) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+// DefaultSchedule returns the gas schedule used when none is set in genesis or state
+func DefaultSchedule() *Schedule {
+	return &Schedule{
+		Sha3:          1,
+		GetAccount:    1,
+		StorageUpdate: 1,
+		CreateAccount: 1,
+		BaseOp:        0,
+		StackOp:       1,
+		EcRecover:     1,
+		Sha256Word:    1,
+		Sha256Base:    1,
+		Ripemd160Word: 1,
+		Ripemd160Base: 1,
+		ExpModWord:    1,
+		ExpModBase:    200,
+		IdentityWord:  1,
+		IdentityBase:  1,
+		// As for Ethereum mainnet
+		Blake2FRound:        1,
+		Bls12381G1Add:       375,
+		Bls12381G1Mul:       12000,
+		Bls12381G2Add:       600,
+		Bls12381G2Mul:       22500,
+		Bls12381PairingBase: 37700,
+		Bls12381PairingPair: 32600,
+		Bls12381MapG1:       5500,
+		Bls12381MapG2:       23800,
+		// Life charges one more for each basic block
+		WASMInstruction: 1,
+	}
+}
+
+var defaultSchedule = DefaultSchedule()
+
+// ScheduleOrDefault returns schedule unless it is nil in which case it returns a shared default schedule that must not
+// be modified
+func ScheduleOrDefault(schedule *Schedule) *Schedule {
+	if schedule == nil {
+		return defaultSchedule
+	}
+	return schedule
+}
+
+func (su *ScheduleUpdate) Validate(height uint64) error {
+	if su.Schedule == nil {
+		return fmt.Errorf("gas schedule update must provide a schedule")
+	}
+	if su.Height <= height {
+		return fmt.Errorf("gas schedule update must take effect at a height after the current height %d "+
+			"but has height %d", height, su.Height)
+	}
+	return nil
+}
+
+// RefundPolicy determines the gas refunded for clearing storage. Since refunds reduce the gas used by a transaction
+// they are part of its execution that all validators must agree on.
+type RefundPolicy string
+
+const (
+	// No gas is refunded, as when no policy is set
+	NoRefunds RefundPolicy = "none"
+	// As for Ethereum before the London upgrade, refunds of up to half the gas used by a transaction
+	LegacyRefunds RefundPolicy = "legacy"
+	// As for Ethereum since EIP-3529, refunds of up to a fifth of the gas used by a transaction
+	ModernRefunds RefundPolicy = "modern"
+)
+
+const (
+	legacyStorageClearRefund = 15000
+	modernStorageClearRefund = 4800
+)
+
+func (policy RefundPolicy) Validate() error {
+	switch policy {
+	case "", NoRefunds, LegacyRefunds, ModernRefunds:
+		return nil
+	}
+	return fmt.Errorf("unknown gas refund policy '%s', must be one of '%s', '%s', or '%s'", policy,
+		NoRefunds, LegacyRefunds, ModernRefunds)
+}
+
+// StorageClearRefund returns the gas refunded for setting a non-zero storage value to zero
+func (policy RefundPolicy) StorageClearRefund() uint64 {
+	switch policy {
+	case LegacyRefunds:
+		return legacyStorageClearRefund
+	case ModernRefunds:
+		return modernStorageClearRefund
+	}
+	return 0
+}
+
+// StorageRefund returns the change in the gas to be refunded for writing value to a storage slot whose value was
+// original at the start of the transaction and is current before the write. As for EIP-2200 the refund for clearing a
+// slot is taken back if the slot is written again, so clearing the same slot repeatedly is only refunded once.
+func (policy RefundPolicy) StorageRefund(original, current, value binary.Word256) int64 {
+	refund := int64(policy.StorageClearRefund())
+	if refund == 0 || current == value || original.IsZero() {
+		return 0
+	}
+	if original != current && current.IsZero() {
+		// The slot was cleared earlier in the transaction
+		return -refund
+	}
+	if value.IsZero() {
+		return refund
+	}
+	return 0
+}
+
+// MaxRefund returns the most gas that may be refunded to a transaction that used gasUsed before any refund
+func (policy RefundPolicy) MaxRefund(gasUsed uint64) uint64 {
+	switch policy {
+	case LegacyRefunds:
+		return gasUsed / 2
+	case ModernRefunds:
+		return gasUsed / 5
+	}
+	return 0
+}
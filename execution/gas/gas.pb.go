@@ -0,0 +1,1440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gas.proto
+
+package gas
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Schedule gives the gas charged for operations by the VMs and the precompiled natives
+type Schedule struct {
+	// Per SHA3 operation
+	Sha3 uint64 `protobuf:"varint,1,opt,name=Sha3,proto3" json:"Sha3,omitempty"`
+	// Per account read
+	GetAccount uint64 `protobuf:"varint,2,opt,name=GetAccount,proto3" json:"GetAccount,omitempty"`
+	// Per storage write
+	StorageUpdate uint64 `protobuf:"varint,3,opt,name=StorageUpdate,proto3" json:"StorageUpdate,omitempty"`
+	// Per account created
+	CreateAccount uint64 `protobuf:"varint,4,opt,name=CreateAccount,proto3" json:"CreateAccount,omitempty"`
+	// Per opcode
+	BaseOp uint64 `protobuf:"varint,5,opt,name=BaseOp,proto3" json:"BaseOp,omitempty"`
+	// Per push or pop of the data stack
+	StackOp       uint64 `protobuf:"varint,6,opt,name=StackOp,proto3" json:"StackOp,omitempty"`
+	EcRecover     uint64 `protobuf:"varint,7,opt,name=EcRecover,proto3" json:"EcRecover,omitempty"`
+	Sha256Word    uint64 `protobuf:"varint,8,opt,name=Sha256Word,proto3" json:"Sha256Word,omitempty"`
+	Sha256Base    uint64 `protobuf:"varint,9,opt,name=Sha256Base,proto3" json:"Sha256Base,omitempty"`
+	Ripemd160Word uint64 `protobuf:"varint,10,opt,name=Ripemd160Word,proto3" json:"Ripemd160Word,omitempty"`
+	Ripemd160Base uint64 `protobuf:"varint,11,opt,name=Ripemd160Base,proto3" json:"Ripemd160Base,omitempty"`
+	// Per unit of EIP-2565 complexity (multiplication complexity times iteration count divided by 3)
+	ExpModWord uint64 `protobuf:"varint,12,opt,name=ExpModWord,proto3" json:"ExpModWord,omitempty"`
+	// Minimum charged for modular exponentiation
+	ExpModBase   uint64 `protobuf:"varint,13,opt,name=ExpModBase,proto3" json:"ExpModBase,omitempty"`
+	IdentityWord uint64 `protobuf:"varint,14,opt,name=IdentityWord,proto3" json:"IdentityWord,omitempty"`
+	IdentityBase uint64 `protobuf:"varint,15,opt,name=IdentityBase,proto3" json:"IdentityBase,omitempty"`
+	// Per round of the BLAKE2b compression function
+	Blake2FRound  uint64 `protobuf:"varint,16,opt,name=Blake2FRound,proto3" json:"Blake2FRound,omitempty"`
+	Bls12381G1Add uint64 `protobuf:"varint,17,opt,name=Bls12381G1Add,proto3" json:"Bls12381G1Add,omitempty"`
+	// Per point of a G1 multi-scalar multiplication
+	Bls12381G1Mul uint64 `protobuf:"varint,18,opt,name=Bls12381G1Mul,proto3" json:"Bls12381G1Mul,omitempty"`
+	Bls12381G2Add uint64 `protobuf:"varint,19,opt,name=Bls12381G2Add,proto3" json:"Bls12381G2Add,omitempty"`
+	// Per point of a G2 multi-scalar multiplication
+	Bls12381G2Mul       uint64 `protobuf:"varint,20,opt,name=Bls12381G2Mul,proto3" json:"Bls12381G2Mul,omitempty"`
+	Bls12381PairingBase uint64 `protobuf:"varint,21,opt,name=Bls12381PairingBase,proto3" json:"Bls12381PairingBase,omitempty"`
+	// Per pair of a pairing check
+	Bls12381PairingPair uint64 `protobuf:"varint,22,opt,name=Bls12381PairingPair,proto3" json:"Bls12381PairingPair,omitempty"`
+	Bls12381MapG1       uint64 `protobuf:"varint,23,opt,name=Bls12381MapG1,proto3" json:"Bls12381MapG1,omitempty"`
+	Bls12381MapG2       uint64 `protobuf:"varint,24,opt,name=Bls12381MapG2,proto3" json:"Bls12381MapG2,omitempty"`
+	// Per WASM instruction executed, metered by instrumenting each basic block of the contract
+	WASMInstruction      uint64   `protobuf:"varint,25,opt,name=WASMInstruction,proto3" json:"WASMInstruction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df176b4a803aa869, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetSha3() uint64 {
+	if m != nil {
+		return m.Sha3
+	}
+	return 0
+}
+
+func (m *Schedule) GetGetAccount() uint64 {
+	if m != nil {
+		return m.GetAccount
+	}
+	return 0
+}
+
+func (m *Schedule) GetStorageUpdate() uint64 {
+	if m != nil {
+		return m.StorageUpdate
+	}
+	return 0
+}
+
+func (m *Schedule) GetCreateAccount() uint64 {
+	if m != nil {
+		return m.CreateAccount
+	}
+	return 0
+}
+
+func (m *Schedule) GetBaseOp() uint64 {
+	if m != nil {
+		return m.BaseOp
+	}
+	return 0
+}
+
+func (m *Schedule) GetStackOp() uint64 {
+	if m != nil {
+		return m.StackOp
+	}
+	return 0
+}
+
+func (m *Schedule) GetEcRecover() uint64 {
+	if m != nil {
+		return m.EcRecover
+	}
+	return 0
+}
+
+func (m *Schedule) GetSha256Word() uint64 {
+	if m != nil {
+		return m.Sha256Word
+	}
+	return 0
+}
+
+func (m *Schedule) GetSha256Base() uint64 {
+	if m != nil {
+		return m.Sha256Base
+	}
+	return 0
+}
+
+func (m *Schedule) GetRipemd160Word() uint64 {
+	if m != nil {
+		return m.Ripemd160Word
+	}
+	return 0
+}
+
+func (m *Schedule) GetRipemd160Base() uint64 {
+	if m != nil {
+		return m.Ripemd160Base
+	}
+	return 0
+}
+
+func (m *Schedule) GetExpModWord() uint64 {
+	if m != nil {
+		return m.ExpModWord
+	}
+	return 0
+}
+
+func (m *Schedule) GetExpModBase() uint64 {
+	if m != nil {
+		return m.ExpModBase
+	}
+	return 0
+}
+
+func (m *Schedule) GetIdentityWord() uint64 {
+	if m != nil {
+		return m.IdentityWord
+	}
+	return 0
+}
+
+func (m *Schedule) GetIdentityBase() uint64 {
+	if m != nil {
+		return m.IdentityBase
+	}
+	return 0
+}
+
+func (m *Schedule) GetBlake2FRound() uint64 {
+	if m != nil {
+		return m.Blake2FRound
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381G1Add() uint64 {
+	if m != nil {
+		return m.Bls12381G1Add
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381G1Mul() uint64 {
+	if m != nil {
+		return m.Bls12381G1Mul
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381G2Add() uint64 {
+	if m != nil {
+		return m.Bls12381G2Add
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381G2Mul() uint64 {
+	if m != nil {
+		return m.Bls12381G2Mul
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381PairingBase() uint64 {
+	if m != nil {
+		return m.Bls12381PairingBase
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381PairingPair() uint64 {
+	if m != nil {
+		return m.Bls12381PairingPair
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381MapG1() uint64 {
+	if m != nil {
+		return m.Bls12381MapG1
+	}
+	return 0
+}
+
+func (m *Schedule) GetBls12381MapG2() uint64 {
+	if m != nil {
+		return m.Bls12381MapG2
+	}
+	return 0
+}
+
+func (m *Schedule) GetWASMInstruction() uint64 {
+	if m != nil {
+		return m.WASMInstruction
+	}
+	return 0
+}
+
+func (*Schedule) XXX_MessageName() string {
+	return "gas.Schedule"
+}
+
+// A Schedule to be used from Height onwards
+type ScheduleUpdate struct {
+	// The first height at which Schedule will be in effect, must be greater than the height of the block in which
+	// the update is made
+	Height               uint64    `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Schedule             *Schedule `protobuf:"bytes,2,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ScheduleUpdate) Reset()         { *m = ScheduleUpdate{} }
+func (m *ScheduleUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduleUpdate) ProtoMessage()    {}
+func (*ScheduleUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df176b4a803aa869, []int{1}
+}
+func (m *ScheduleUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduleUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleUpdate.Merge(m, src)
+}
+func (m *ScheduleUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleUpdate proto.InternalMessageInfo
+
+func (m *ScheduleUpdate) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduleUpdate) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (*ScheduleUpdate) XXX_MessageName() string {
+	return "gas.ScheduleUpdate"
+}
+func init() {
+	proto.RegisterType((*Schedule)(nil), "gas.Schedule")
+	golang_proto.RegisterType((*Schedule)(nil), "gas.Schedule")
+	proto.RegisterType((*ScheduleUpdate)(nil), "gas.ScheduleUpdate")
+	golang_proto.RegisterType((*ScheduleUpdate)(nil), "gas.ScheduleUpdate")
+}
+
+func init() { proto.RegisterFile("gas.proto", fileDescriptor_df176b4a803aa869) }
+func init() { golang_proto.RegisterFile("gas.proto", fileDescriptor_df176b4a803aa869) }
+
+var fileDescriptor_df176b4a803aa869 = []byte{
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xc1, 0x6f, 0xd3, 0x30,
+	0x18, 0xc5, 0x15, 0x56, 0xda, 0xd5, 0x5b, 0x37, 0xf0, 0x46, 0x31, 0x08, 0x45, 0xa8, 0xe2, 0x30,
+	0x84, 0xd4, 0xae, 0xa9, 0x98, 0xb8, 0xb6, 0xa8, 0x94, 0x1d, 0xaa, 0xa1, 0x44, 0x68, 0x12, 0x37,
+	0x37, 0xb1, 0x9c, 0x68, 0x59, 0x1c, 0x39, 0x0e, 0x6c, 0x77, 0xfe, 0x30, 0x8e, 0x3b, 0x72, 0xe4,
+	0x88, 0xba, 0x7f, 0x04, 0xf9, 0x4b, 0x43, 0xe2, 0xac, 0xa7, 0xe4, 0x7b, 0xef, 0xe7, 0x97, 0xe7,
+	0xc3, 0x17, 0xd4, 0xe5, 0x34, 0x1b, 0xa6, 0x52, 0x28, 0x81, 0x77, 0x38, 0xcd, 0x5e, 0x1e, 0x73,
+	0xc1, 0x05, 0xcc, 0x23, 0xfd, 0x56, 0x58, 0x83, 0x9f, 0x1d, 0xb4, 0xeb, 0xf9, 0x21, 0x0b, 0xf2,
+	0x98, 0x61, 0x8c, 0x5a, 0x5e, 0x48, 0x27, 0xc4, 0x7a, 0x6d, 0x9d, 0xb4, 0x5c, 0x78, 0xc7, 0x36,
+	0x42, 0x0b, 0xa6, 0xa6, 0xbe, 0x2f, 0xf2, 0x44, 0x91, 0x47, 0xe0, 0xd4, 0x14, 0xfc, 0x06, 0xf5,
+	0x3c, 0x25, 0x24, 0xe5, 0xec, 0x6b, 0x1a, 0x50, 0xc5, 0xc8, 0x0e, 0x20, 0xa6, 0xa8, 0xa9, 0x8f,
+	0x92, 0x51, 0xc5, 0xca, 0xa0, 0x56, 0x41, 0x19, 0x22, 0xee, 0xa3, 0xf6, 0x8c, 0x66, 0xec, 0x22,
+	0x25, 0x8f, 0xc1, 0xde, 0x4c, 0x98, 0xa0, 0x8e, 0xa7, 0xa8, 0x7f, 0x75, 0x91, 0x92, 0x36, 0x18,
+	0xe5, 0x88, 0x5f, 0xa1, 0xee, 0xdc, 0x77, 0x99, 0x2f, 0xbe, 0x33, 0x49, 0x3a, 0xe0, 0x55, 0x82,
+	0xee, 0xee, 0x85, 0xd4, 0x79, 0x7f, 0x76, 0x29, 0x64, 0x40, 0x76, 0x8b, 0xee, 0x95, 0x52, 0xf9,
+	0xfa, 0x3b, 0xa4, 0x5b, 0xf7, 0xb5, 0xa2, 0x5b, 0xbb, 0x51, 0xca, 0xae, 0x83, 0xf1, 0xd9, 0x29,
+	0x44, 0xa0, 0xa2, 0xb5, 0x21, 0x1a, 0x14, 0x04, 0xed, 0x35, 0x28, 0xc8, 0xb2, 0x11, 0x9a, 0xdf,
+	0xa4, 0x4b, 0x11, 0x40, 0xd0, 0x7e, 0xf1, 0xad, 0x4a, 0xa9, 0x7c, 0x88, 0xe8, 0xd5, 0x7d, 0x38,
+	0x3f, 0x40, 0xfb, 0xe7, 0x01, 0x4b, 0x54, 0xa4, 0x6e, 0x21, 0xe1, 0x00, 0x08, 0x43, 0xab, 0x33,
+	0x90, 0x72, 0x68, 0x32, 0x65, 0xce, 0x2c, 0xa6, 0x57, 0xcc, 0xf9, 0xe4, 0x8a, 0x3c, 0x09, 0xc8,
+	0x93, 0x82, 0xa9, 0x6b, 0xfa, 0x46, 0xb3, 0x38, 0x1b, 0x3b, 0x93, 0x0f, 0xe3, 0xc5, 0x78, 0x1a,
+	0x04, 0xe4, 0x69, 0x71, 0x23, 0x43, 0x34, 0xa9, 0x65, 0x1e, 0x13, 0xdc, 0xa4, 0x96, 0x79, 0x6c,
+	0x50, 0x8e, 0xce, 0x3a, 0x6a, 0x50, 0x4e, 0x33, 0xcb, 0xd1, 0x59, 0xc7, 0x4d, 0x4a, 0x67, 0x9d,
+	0xa2, 0xa3, 0x52, 0xf8, 0x42, 0x23, 0x19, 0x25, 0x1c, 0xae, 0xf9, 0x0c, 0xd8, 0x6d, 0xd6, 0x96,
+	0x13, 0xfa, 0x41, 0xfa, 0x5b, 0x4f, 0xe8, 0x47, 0xbd, 0xc9, 0x92, 0xa6, 0x8b, 0x31, 0x79, 0x6e,
+	0x36, 0x01, 0xb1, 0x49, 0x39, 0x84, 0x3c, 0xa4, 0x1c, 0x7c, 0x82, 0x0e, 0x2f, 0xa7, 0xde, 0xf2,
+	0x3c, 0xc9, 0x94, 0xcc, 0x7d, 0x15, 0x89, 0x84, 0xbc, 0x00, 0xae, 0x29, 0x0f, 0x3c, 0x74, 0x50,
+	0x6e, 0xe1, 0x66, 0x63, 0xfa, 0xa8, 0xfd, 0x99, 0x45, 0x3c, 0x54, 0x9b, 0x6d, 0xdc, 0x4c, 0xf8,
+	0x6d, 0xb5, 0xaf, 0xb0, 0x8d, 0x7b, 0x4e, 0x6f, 0xa8, 0x37, 0xbd, 0x14, 0xdd, 0xff, 0xf6, 0x6c,
+	0x7e, 0xb7, 0xb6, 0xad, 0xdf, 0x6b, 0xdb, 0xfa, 0xb3, 0xb6, 0xad, 0xbf, 0x6b, 0xdb, 0xfa, 0x75,
+	0x6f, 0x5b, 0x77, 0xf7, 0xb6, 0xf5, 0xed, 0x1d, 0x8f, 0x54, 0x98, 0xaf, 0x86, 0xbe, 0xb8, 0x1e,
+	0x85, 0xb7, 0x29, 0x93, 0x31, 0x0b, 0x38, 0x93, 0xa3, 0x55, 0x2e, 0xa5, 0xf8, 0x31, 0x62, 0x37,
+	0xcc, 0xcf, 0x75, 0xaf, 0x11, 0xa7, 0xd9, 0xaa, 0x0d, 0x7f, 0x8a, 0xc9, 0xbf, 0x01, 0x00, 0xe1,
+	0x31, 0xf5, 0xce, 0x51, 0x04, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WASMInstruction != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.WASMInstruction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.Bls12381MapG2 != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381MapG2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.Bls12381MapG1 != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381MapG1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Bls12381PairingPair != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381PairingPair))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Bls12381PairingBase != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381PairingBase))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Bls12381G2Mul != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381G2Mul))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Bls12381G2Add != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381G2Add))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.Bls12381G1Mul != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381G1Mul))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Bls12381G1Add != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Bls12381G1Add))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Blake2FRound != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Blake2FRound))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.IdentityBase != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.IdentityBase))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.IdentityWord != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.IdentityWord))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ExpModBase != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.ExpModBase))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ExpModWord != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.ExpModWord))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Ripemd160Base != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Ripemd160Base))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Ripemd160Word != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Ripemd160Word))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Sha256Base != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Sha256Base))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Sha256Word != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Sha256Word))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EcRecover != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.EcRecover))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StackOp != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.StackOp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BaseOp != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.BaseOp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreateAccount != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.CreateAccount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StorageUpdate != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.StorageUpdate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GetAccount != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.GetAccount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sha3 != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Sha3))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGas(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGas(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGas(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sha3 != 0 {
+		n += 1 + sovGas(uint64(m.Sha3))
+	}
+	if m.GetAccount != 0 {
+		n += 1 + sovGas(uint64(m.GetAccount))
+	}
+	if m.StorageUpdate != 0 {
+		n += 1 + sovGas(uint64(m.StorageUpdate))
+	}
+	if m.CreateAccount != 0 {
+		n += 1 + sovGas(uint64(m.CreateAccount))
+	}
+	if m.BaseOp != 0 {
+		n += 1 + sovGas(uint64(m.BaseOp))
+	}
+	if m.StackOp != 0 {
+		n += 1 + sovGas(uint64(m.StackOp))
+	}
+	if m.EcRecover != 0 {
+		n += 1 + sovGas(uint64(m.EcRecover))
+	}
+	if m.Sha256Word != 0 {
+		n += 1 + sovGas(uint64(m.Sha256Word))
+	}
+	if m.Sha256Base != 0 {
+		n += 1 + sovGas(uint64(m.Sha256Base))
+	}
+	if m.Ripemd160Word != 0 {
+		n += 1 + sovGas(uint64(m.Ripemd160Word))
+	}
+	if m.Ripemd160Base != 0 {
+		n += 1 + sovGas(uint64(m.Ripemd160Base))
+	}
+	if m.ExpModWord != 0 {
+		n += 1 + sovGas(uint64(m.ExpModWord))
+	}
+	if m.ExpModBase != 0 {
+		n += 1 + sovGas(uint64(m.ExpModBase))
+	}
+	if m.IdentityWord != 0 {
+		n += 1 + sovGas(uint64(m.IdentityWord))
+	}
+	if m.IdentityBase != 0 {
+		n += 1 + sovGas(uint64(m.IdentityBase))
+	}
+	if m.Blake2FRound != 0 {
+		n += 2 + sovGas(uint64(m.Blake2FRound))
+	}
+	if m.Bls12381G1Add != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381G1Add))
+	}
+	if m.Bls12381G1Mul != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381G1Mul))
+	}
+	if m.Bls12381G2Add != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381G2Add))
+	}
+	if m.Bls12381G2Mul != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381G2Mul))
+	}
+	if m.Bls12381PairingBase != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381PairingBase))
+	}
+	if m.Bls12381PairingPair != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381PairingPair))
+	}
+	if m.Bls12381MapG1 != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381MapG1))
+	}
+	if m.Bls12381MapG2 != 0 {
+		n += 2 + sovGas(uint64(m.Bls12381MapG2))
+	}
+	if m.WASMInstruction != 0 {
+		n += 2 + sovGas(uint64(m.WASMInstruction))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGas(uint64(m.Height))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovGas(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovGas(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGas(x uint64) (n int) {
+	return sovGas(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha3", wireType)
+			}
+			m.Sha3 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sha3 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAccount", wireType)
+			}
+			m.GetAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GetAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUpdate", wireType)
+			}
+			m.StorageUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageUpdate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAccount", wireType)
+			}
+			m.CreateAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOp", wireType)
+			}
+			m.BaseOp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseOp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackOp", wireType)
+			}
+			m.StackOp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StackOp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcRecover", wireType)
+			}
+			m.EcRecover = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EcRecover |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256Word", wireType)
+			}
+			m.Sha256Word = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sha256Word |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256Base", wireType)
+			}
+			m.Sha256Base = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sha256Base |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ripemd160Word", wireType)
+			}
+			m.Ripemd160Word = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ripemd160Word |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ripemd160Base", wireType)
+			}
+			m.Ripemd160Base = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ripemd160Base |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpModWord", wireType)
+			}
+			m.ExpModWord = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpModWord |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpModBase", wireType)
+			}
+			m.ExpModBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpModBase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityWord", wireType)
+			}
+			m.IdentityWord = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdentityWord |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityBase", wireType)
+			}
+			m.IdentityBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdentityBase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blake2FRound", wireType)
+			}
+			m.Blake2FRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blake2FRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381G1Add", wireType)
+			}
+			m.Bls12381G1Add = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381G1Add |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381G1Mul", wireType)
+			}
+			m.Bls12381G1Mul = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381G1Mul |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381G2Add", wireType)
+			}
+			m.Bls12381G2Add = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381G2Add |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381G2Mul", wireType)
+			}
+			m.Bls12381G2Mul = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381G2Mul |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381PairingBase", wireType)
+			}
+			m.Bls12381PairingBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381PairingBase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381PairingPair", wireType)
+			}
+			m.Bls12381PairingPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381PairingPair |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381MapG1", wireType)
+			}
+			m.Bls12381MapG1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381MapG1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381MapG2", wireType)
+			}
+			m.Bls12381MapG2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bls12381MapG2 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMInstruction", wireType)
+			}
+			m.WASMInstruction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WASMInstruction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGas(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGas
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGas
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGas
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGas        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGas          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGas = fmt.Errorf("proto: unexpected end of group")
+)
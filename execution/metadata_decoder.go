@@ -0,0 +1,231 @@
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// MetadataDecoder decodes call data, events and the payloads given to revert with the ABIs in the metadata registered
+// for contracts. Since a TxExecution or Event may be shared between subscribers they are never modified; decoding
+// returns a copy. The ABI of each contract is read once so a decoder should not outlive the state it reads.
+type MetadataDecoder struct {
+	st    acmstate.Reader
+	specs map[crypto.Address]*abi.Spec
+}
+
+// NewMetadataDecoder returns a decoder that reads metadata from st, which can only decode Error and Panic reverts
+// unless st is also an acmstate.MetadataReader
+func NewMetadataDecoder(st acmstate.Reader) *MetadataDecoder {
+	return &MetadataDecoder{
+		st:    st,
+		specs: make(map[crypto.Address]*abi.Spec),
+	}
+}
+
+// Spec returns the ABI registered for the contract at address, or nil if there is none
+func (d *MetadataDecoder) Spec(address crypto.Address) *abi.Spec {
+	spec, ok := d.specs[address]
+	if !ok {
+		spec = d.readSpec(address)
+		d.specs[address] = spec
+	}
+	return spec
+}
+
+func (d *MetadataDecoder) readSpec(address crypto.Address) *abi.Spec {
+	metaSt, ok := d.st.(acmstate.MetadataReader)
+	if !ok {
+		return nil
+	}
+	metadata, err := engine.GetContractMetadata(d.st, metaSt, address)
+	if err != nil || metadata == "" {
+		return nil
+	}
+	meta := new(compile.Metadata)
+	err = json.Unmarshal([]byte(metadata), meta)
+	if err != nil {
+		return nil
+	}
+	spec, err := abi.ReadSpec(meta.Abi)
+	if err != nil {
+		return nil
+	}
+	return spec
+}
+
+// DecodeTxExecution returns a copy of txe with its call data and events, those of any nested executions, and any
+// payload given to revert decoded
+func (d *MetadataDecoder) DecodeTxExecution(txe *exec.TxExecution) *exec.TxExecution {
+	if txe == nil {
+		return nil
+	}
+	txe = d.DecodeRevert(txe)
+	decoded := *txe
+	decoded.Events = make([]*exec.Event, len(txe.Events))
+	for i, ev := range txe.Events {
+		decoded.Events[i] = d.DecodeEvent(ev)
+	}
+	if len(txe.TxExecutions) > 0 {
+		decoded.TxExecutions = make([]*exec.TxExecution, len(txe.TxExecutions))
+		for i, txeNested := range txe.TxExecutions {
+			decoded.TxExecutions[i] = d.DecodeTxExecution(txeNested)
+		}
+	}
+	return &decoded
+}
+
+// DecodeEvent returns a copy of ev with the call data of a call event or the topics and data of a log event decoded,
+// or ev itself if it cannot be decoded
+func (d *MetadataDecoder) DecodeEvent(ev *exec.Event) *exec.Event {
+	switch {
+	case ev.Log != nil:
+		decoded := d.decodeLog(ev.Log)
+		if decoded == nil {
+			return ev
+		}
+		log := *ev.Log
+		log.Decoded = decoded
+		return &exec.Event{Header: ev.Header, Log: &log}
+	case ev.Call != nil && ev.Call.CallData != nil:
+		decoded := d.decodeCallData(ev.Call.CallData)
+		if decoded == nil {
+			return ev
+		}
+		call := *ev.Call
+		call.Decoded = decoded
+		return &exec.Event{Header: ev.Header, Call: &call}
+	}
+	return ev
+}
+
+// DecodeRevert returns a copy of txe with the payload given to revert, if it was reverted, decoded into its exception.
+// Custom errors are decoded with the ABIs of the contracts called, innermost first.
+func (d *MetadataDecoder) DecodeRevert(txe *exec.TxExecution) *exec.TxExecution {
+	if txe == nil || txe.Exception == nil || txe.Exception.Revert != nil ||
+		txe.Exception.ErrorCode() != errors.Codes.ExecutionReverted {
+		return txe
+	}
+	var specs []*abi.Spec
+	for _, address := range calledAddresses(txe) {
+		if spec := d.Spec(address); spec != nil {
+			specs = append(specs, spec)
+		}
+	}
+	revert := abi.DecodeRevert(txe.GetResult().GetReturn(), specs...)
+	if revert == nil {
+		return txe
+	}
+	exception := *txe.Exception
+	exception.Revert = revert
+	decoded := *txe
+	decoded.Exception = &exception
+	return &decoded
+}
+
+func (d *MetadataDecoder) decodeLog(log *exec.LogEvent) *exec.Decoded {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	spec := d.Spec(log.Address)
+	if spec == nil {
+		return nil
+	}
+	eventSpec := spec.EventsByID[abi.EventID(log.Topics[0])]
+	if eventSpec == nil || eventSpec.Anonymous {
+		return nil
+	}
+	args := stringArgs(len(eventSpec.Inputs))
+	err := abi.UnpackEvent(eventSpec, log.Topics, log.Data, args...)
+	if err != nil {
+		return nil
+	}
+	return decoded(eventSpec.Name, eventSpec.Inputs, args)
+}
+
+func (d *MetadataDecoder) decodeCallData(callData *exec.CallData) *exec.Decoded {
+	if len(callData.Data) < abi.FunctionIDSize {
+		return nil
+	}
+	spec := d.Spec(callData.Callee)
+	if spec == nil {
+		return nil
+	}
+	var id abi.FunctionID
+	copy(id[:], callData.Data)
+	for _, funcSpec := range spec.Functions {
+		if funcSpec.FunctionID == id {
+			args := stringArgs(len(funcSpec.Inputs))
+			err := abi.Unpack(funcSpec.Inputs, callData.Data[abi.FunctionIDSize:], args...)
+			if err != nil {
+				return nil
+			}
+			return decoded(funcSpec.Name, funcSpec.Inputs, args)
+		}
+	}
+	return nil
+}
+
+func stringArgs(n int) []interface{} {
+	args := make([]interface{}, n)
+	for i := range args {
+		args[i] = new(string)
+	}
+	return args
+}
+
+// Indexed event arguments of dynamic type are given as the bytes32 hash stored in their topic
+func decoded(name string, inputs []abi.Argument, args []interface{}) *exec.Decoded {
+	dec := &exec.Decoded{
+		Name:      name,
+		Signature: abi.Signature(name, inputs),
+		Args:      make([]*exec.DecodedArgument, len(inputs)),
+	}
+	for i, input := range inputs {
+		argType := input.EVM.GetSignature()
+		if input.IsArray {
+			if input.ArrayLength > 0 {
+				argType += fmt.Sprintf("[%d]", input.ArrayLength)
+			} else {
+				argType += "[]"
+			}
+		}
+		dec.Args[i] = &exec.DecodedArgument{
+			Name:  input.Name,
+			Type:  argType,
+			Value: *args[i].(*string),
+		}
+	}
+	return dec
+}
+
+// The addresses called by txe, innermost call first since that is where a revert originates
+func calledAddresses(txe *exec.TxExecution) []crypto.Address {
+	var addresses []crypto.Address
+	seen := make(map[crypto.Address]bool)
+	add := func(address crypto.Address) {
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	for i := len(txe.Events) - 1; i >= 0; i-- {
+		if call := txe.Events[i].Call; call != nil && call.CallData != nil {
+			add(call.CallData.Callee)
+		}
+	}
+	if txe.Envelope != nil {
+		if tx, ok := txe.Envelope.Tx.Payload.(*payload.CallTx); ok && tx.Address != nil {
+			add(*tx.Address)
+		}
+	}
+	return addresses
+}
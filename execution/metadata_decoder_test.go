@@ -0,0 +1,97 @@
+package execution
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const decoderTestABI = `[
+  {"type":"function","name":"set","inputs":[{"name":"key","type":"string"},{"name":"value","type":"uint256"}],"outputs":[]},
+  {"type":"event","name":"Set","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+  {"type":"error","name":"TooBig","inputs":[{"name":"value","type":"uint256"}]}
+]`
+
+func TestMetadataDecoder(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	address := crypto.Address{1, 2, 3}
+	from := crypto.Address{4, 5, 6}
+	code := acm.Bytecode{0x60, 0x00, 0xfd}
+	require.NoError(t, st.UpdateAccount(&acm.Account{
+		Address:  address,
+		EVMCode:  code,
+		CodeHash: crypto.Keccak256(code),
+	}))
+	meta, err := json.Marshal(compile.Metadata{
+		ContractName:    "Store",
+		SourceFile:      "store.sol",
+		SourceHash:      "0xabcd",
+		CompilerVersion: "0.8.4",
+		Abi:             json.RawMessage(decoderTestABI),
+	})
+	require.NoError(t, err)
+	require.NoError(t, engine.UpdateContractMeta(st, st, address, []*payload.ContractMeta{{
+		CodeHash: crypto.Keccak256(code),
+		Meta:     string(meta),
+	}}))
+
+	spec, err := abi.ReadSpec([]byte(decoderTestABI))
+	require.NoError(t, err)
+	callData, _, err := spec.Pack("set", "foo", 42)
+	require.NoError(t, err)
+	topics, logData, err := abi.PackEvent(spec.EventsByName["Set"], from, 42)
+	require.NoError(t, err)
+	revertData, err := abi.Pack(spec.ErrorsByID[abi.GetFunctionID("TooBig(uint256)")].Inputs, 7)
+	require.NoError(t, err)
+	revertData = append(abi.GetFunctionID("TooBig(uint256)").Bytes(), revertData...)
+
+	txe := &exec.TxExecution{
+		Events: []*exec.Event{
+			{Call: &exec.CallEvent{CallData: &exec.CallData{Caller: from, Callee: address, Data: callData}}},
+			{Log: &exec.LogEvent{Address: address, Topics: topics, Data: logData}},
+			// Not a contract with metadata
+			{Log: &exec.LogEvent{Address: from, Topics: topics, Data: logData}},
+		},
+		Result:    &exec.Result{Return: revertData},
+		Exception: errors.Errorf(errors.Codes.ExecutionReverted, "reverted"),
+	}
+
+	decoded := NewMetadataDecoder(st).DecodeTxExecution(txe)
+
+	call := decoded.Events[0].Call.Decoded
+	require.NotNil(t, call)
+	assert.Equal(t, "set", call.Name)
+	assert.Equal(t, "set(string,uint256)", call.Signature)
+	assert.Equal(t, []*exec.DecodedArgument{
+		{Name: "key", Type: "string", Value: "foo"},
+		{Name: "value", Type: "uint256", Value: "42"},
+	}, call.Args)
+
+	log := decoded.Events[1].Log.Decoded
+	require.NotNil(t, log)
+	assert.Equal(t, "Set(address,uint256)", log.Signature)
+	assert.Equal(t, []*exec.DecodedArgument{
+		{Name: "from", Type: "address", Value: from.String()},
+		{Name: "value", Type: "uint256", Value: "42"},
+	}, log.Args)
+
+	assert.Nil(t, decoded.Events[2].Log.Decoded)
+	require.NotNil(t, decoded.Exception.Revert)
+	assert.Equal(t, "TooBig(7)", decoded.Exception.Revert.String())
+
+	// The original is shared so must not be modified
+	assert.Nil(t, txe.Events[0].Call.Decoded)
+	assert.Nil(t, txe.Events[1].Log.Decoded)
+	assert.Nil(t, txe.Exception.Revert)
+}
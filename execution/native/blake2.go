@@ -0,0 +1,116 @@
+package native
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/hyperledger/burrow/execution/engine"
+)
+
+// EIP-152 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-152.md)
+const blake2FInputLength = 213
+
+var blake2bIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+var blake2bSigma = [10][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+func blake2F(ctx Context) (output []byte, err error) {
+	const errHeader = "blake2F"
+	// layout is:
+	// input:  [ rounds | h      | m       | t      | f ]
+	// bytes:  [ 4      | 8 * 8  | 16 * 8  | 2 * 8  | 1 ]
+	// Where rounds is big-endian and the words of h, m, and t are little-endian
+	if len(ctx.Input) != blake2FInputLength {
+		return nil, fmt.Errorf("%s: input must be exactly %d bytes but is %d bytes", errHeader, blake2FInputLength,
+			len(ctx.Input))
+	}
+	rounds := binary.BigEndian.Uint32(ctx.Input[:4])
+
+	// Deduct gas
+	err = engine.UseGasNegative(ctx.Gas, uint64(rounds)*ctx.Schedule().Blake2FRound)
+	if err != nil {
+		return nil, err
+	}
+
+	var final bool
+	switch ctx.Input[212] {
+	case 0:
+	case 1:
+		final = true
+	default:
+		return nil, fmt.Errorf("%s: final block indicator flag must be 0 or 1", errHeader)
+	}
+
+	var h [8]uint64
+	var m [16]uint64
+	var t [2]uint64
+	for i := range h {
+		h[i] = binary.LittleEndian.Uint64(ctx.Input[4+i*8:])
+	}
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(ctx.Input[68+i*8:])
+	}
+	t[0] = binary.LittleEndian.Uint64(ctx.Input[196:])
+	t[1] = binary.LittleEndian.Uint64(ctx.Input[204:])
+
+	blake2bF(&h, &m, t, final, rounds)
+
+	output = make([]byte, 64)
+	for i := range h {
+		binary.LittleEndian.PutUint64(output[i*8:], h[i])
+	}
+	return output, nil
+}
+
+// The BLAKE2b compression function F as described by RFC 7693 with a variable number of rounds
+func blake2bF(h *[8]uint64, m *[16]uint64, t [2]uint64, final bool, rounds uint32) {
+	var v [16]uint64
+	copy(v[:8], h[:])
+	copy(v[8:], blake2bIV[:])
+	v[12] ^= t[0]
+	v[13] ^= t[1]
+	if final {
+		v[14] = ^v[14]
+	}
+	for i := uint32(0); i < rounds; i++ {
+		s := &blake2bSigma[i%10]
+		blake2bG(&v, 0, 4, 8, 12, m[s[0]], m[s[1]])
+		blake2bG(&v, 1, 5, 9, 13, m[s[2]], m[s[3]])
+		blake2bG(&v, 2, 6, 10, 14, m[s[4]], m[s[5]])
+		blake2bG(&v, 3, 7, 11, 15, m[s[6]], m[s[7]])
+		blake2bG(&v, 0, 5, 10, 15, m[s[8]], m[s[9]])
+		blake2bG(&v, 1, 6, 11, 12, m[s[10]], m[s[11]])
+		blake2bG(&v, 2, 7, 8, 13, m[s[12]], m[s[13]])
+		blake2bG(&v, 3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+	for i := range h {
+		h[i] ^= v[i] ^ v[i+8]
+	}
+}
+
+// The mixing function G
+func blake2bG(v *[16]uint64, a, b, c, d int, x, y uint64) {
+	v[a] = v[a] + v[b] + x
+	v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+	v[c] = v[c] + v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+	v[a] = v[a] + v[b] + y
+	v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+	v[c] = v[c] + v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+}
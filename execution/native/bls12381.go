@@ -0,0 +1,276 @@
+package native
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/engine"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// BLS12-381 curve operations as specified by EIP-2537 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-2537.md).
+// Base field elements are encoded as 64 bytes big-endian where the top 16 bytes must be zero, elements of the quadratic
+// extension as the encoding of c0 followed by that of c1, points as the encoding of x followed by that of y (with all
+// zeros for the point at infinity), and scalars as 32 bytes big-endian. Gas for multi-scalar multiplication is charged
+// per point without the discount applied on Ethereum mainnet.
+const (
+	blsFieldElementLength  = 64
+	blsFieldElementPadding = 16
+	blsG1PointLength       = 2 * blsFieldElementLength
+	blsG2PointLength       = 4 * blsFieldElementLength
+	blsScalarLength        = binary.Word256Bytes
+)
+
+func bls12381G1Add(ctx Context) ([]byte, error) {
+	const errHeader = "bls12381G1Add"
+	var err error = engine.UseGasNegative(ctx.Gas, ctx.Schedule().Bls12381G1Add)
+	if err != nil {
+		return nil, err
+	}
+	if len(ctx.Input) != 2*blsG1PointLength {
+		return nil, fmt.Errorf("%s: input must be %d bytes", errHeader, 2*blsG1PointLength)
+	}
+	g1 := bls12381.NewG1()
+	p1, err := decodeBLSG1(g1, ctx.Input[:blsG1PointLength])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	p2, err := decodeBLSG1(g1, ctx.Input[blsG1PointLength:])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return encodeBLSG1(g1, g1.Add(g1.New(), p1, p2)), nil
+}
+
+func bls12381G1MSM(ctx Context) ([]byte, error) {
+	const errHeader = "bls12381G1MSM"
+	const pairLength = blsG1PointLength + blsScalarLength
+	k := len(ctx.Input) / pairLength
+	var err error = engine.UseGasNegative(ctx.Gas, uint64(k)*ctx.Schedule().Bls12381G1Mul)
+	if err != nil {
+		return nil, err
+	}
+	if k == 0 || len(ctx.Input)%pairLength != 0 {
+		return nil, fmt.Errorf("%s: input must be a non-zero multiple of %d bytes", errHeader, pairLength)
+	}
+	g1 := bls12381.NewG1()
+	points := make([]*bls12381.PointG1, k)
+	scalars := make([]*big.Int, k)
+	for i := 0; i < k; i++ {
+		pair := ctx.Input[i*pairLength : (i+1)*pairLength]
+		points[i], err = decodeBLSG1(g1, pair[:blsG1PointLength])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+		if !g1.InCorrectSubgroup(points[i]) {
+			return nil, fmt.Errorf("%s: G1 point is not in the correct subgroup", errHeader)
+		}
+		scalars[i] = new(big.Int).Mod(new(big.Int).SetBytes(pair[blsG1PointLength:]), g1.Q())
+	}
+	r, err := g1.MultiExpBig(g1.New(), points, scalars)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return encodeBLSG1(g1, r), nil
+}
+
+func bls12381G2Add(ctx Context) ([]byte, error) {
+	const errHeader = "bls12381G2Add"
+	var err error = engine.UseGasNegative(ctx.Gas, ctx.Schedule().Bls12381G2Add)
+	if err != nil {
+		return nil, err
+	}
+	if len(ctx.Input) != 2*blsG2PointLength {
+		return nil, fmt.Errorf("%s: input must be %d bytes", errHeader, 2*blsG2PointLength)
+	}
+	g2 := bls12381.NewG2()
+	p1, err := decodeBLSG2(g2, ctx.Input[:blsG2PointLength])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	p2, err := decodeBLSG2(g2, ctx.Input[blsG2PointLength:])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return encodeBLSG2(g2, g2.Add(g2.New(), p1, p2)), nil
+}
+
+func bls12381G2MSM(ctx Context) ([]byte, error) {
+	const errHeader = "bls12381G2MSM"
+	const pairLength = blsG2PointLength + blsScalarLength
+	k := len(ctx.Input) / pairLength
+	var err error = engine.UseGasNegative(ctx.Gas, uint64(k)*ctx.Schedule().Bls12381G2Mul)
+	if err != nil {
+		return nil, err
+	}
+	if k == 0 || len(ctx.Input)%pairLength != 0 {
+		return nil, fmt.Errorf("%s: input must be a non-zero multiple of %d bytes", errHeader, pairLength)
+	}
+	g2 := bls12381.NewG2()
+	points := make([]*bls12381.PointG2, k)
+	scalars := make([]*big.Int, k)
+	for i := 0; i < k; i++ {
+		pair := ctx.Input[i*pairLength : (i+1)*pairLength]
+		points[i], err = decodeBLSG2(g2, pair[:blsG2PointLength])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+		if !g2.InCorrectSubgroup(points[i]) {
+			return nil, fmt.Errorf("%s: G2 point is not in the correct subgroup", errHeader)
+		}
+		scalars[i] = new(big.Int).Mod(new(big.Int).SetBytes(pair[blsG2PointLength:]), g2.Q())
+	}
+	r, err := g2.MultiExpBig(g2.New(), points, scalars)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return encodeBLSG2(g2, r), nil
+}
+
+// Returns a word containing 1 if the product of the pairings of each G1 and G2 point pair is the identity and 0 otherwise
+func bls12381Pairing(ctx Context) ([]byte, error) {
+	const errHeader = "bls12381Pairing"
+	const pairLength = blsG1PointLength + blsG2PointLength
+	k := len(ctx.Input) / pairLength
+	gasRequired := ctx.Schedule().Bls12381PairingBase + uint64(k)*ctx.Schedule().Bls12381PairingPair
+	var err error = engine.UseGasNegative(ctx.Gas, gasRequired)
+	if err != nil {
+		return nil, err
+	}
+	if k == 0 || len(ctx.Input)%pairLength != 0 {
+		return nil, fmt.Errorf("%s: input must be a non-zero multiple of %d bytes", errHeader, pairLength)
+	}
+	e := bls12381.NewEngine()
+	for i := 0; i < k; i++ {
+		pair := ctx.Input[i*pairLength : (i+1)*pairLength]
+		p1, err := decodeBLSG1(e.G1, pair[:blsG1PointLength])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+		if !e.G1.InCorrectSubgroup(p1) {
+			return nil, fmt.Errorf("%s: G1 point is not in the correct subgroup", errHeader)
+		}
+		p2, err := decodeBLSG2(e.G2, pair[blsG1PointLength:])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", errHeader, err)
+		}
+		if !e.G2.InCorrectSubgroup(p2) {
+			return nil, fmt.Errorf("%s: G2 point is not in the correct subgroup", errHeader)
+		}
+		e.AddPair(p1, p2)
+	}
+	output := make([]byte, binary.Word256Bytes)
+	if e.Check() {
+		output[len(output)-1] = 1
+	}
+	return output, nil
+}
+
+func bls12381MapG1(ctx Context) ([]byte, error) {
+	const errHeader = "bls12381MapG1"
+	var err error = engine.UseGasNegative(ctx.Gas, ctx.Schedule().Bls12381MapG1)
+	if err != nil {
+		return nil, err
+	}
+	fe, err := decodeBLSFieldElement(ctx.Input)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	g1 := bls12381.NewG1()
+	p, err := g1.MapToCurve(fe)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return encodeBLSG1(g1, p), nil
+}
+
+func bls12381MapG2(ctx Context) ([]byte, error) {
+	const errHeader = "bls12381MapG2"
+	var err error = engine.UseGasNegative(ctx.Gas, ctx.Schedule().Bls12381MapG2)
+	if err != nil {
+		return nil, err
+	}
+	fe2, err := decodeBLSFieldElement2(ctx.Input)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	g2 := bls12381.NewG2()
+	p, err := g2.MapToCurve(fe2)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return encodeBLSG2(g2, p), nil
+}
+
+// Returns the 48 byte big-endian encoding of a base field element used by the curve library
+func decodeBLSFieldElement(in []byte) ([]byte, error) {
+	if len(in) != blsFieldElementLength {
+		return nil, fmt.Errorf("field element must be %d bytes", blsFieldElementLength)
+	}
+	if !binary.IsZeros(in[:blsFieldElementPadding]) {
+		return nil, fmt.Errorf("top %d bytes of field element must be zero", blsFieldElementPadding)
+	}
+	return in[blsFieldElementPadding:], nil
+}
+
+// Returns the encoding of an element of the quadratic extension used by the curve library, which orders c1 before c0
+func decodeBLSFieldElement2(in []byte) ([]byte, error) {
+	if len(in) != 2*blsFieldElementLength {
+		return nil, fmt.Errorf("quadratic extension field element must be %d bytes", 2*blsFieldElementLength)
+	}
+	c0, err := decodeBLSFieldElement(in[:blsFieldElementLength])
+	if err != nil {
+		return nil, err
+	}
+	c1, err := decodeBLSFieldElement(in[blsFieldElementLength:])
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, c1...), c0...), nil
+}
+
+func decodeBLSG1(g1 *bls12381.G1, in []byte) (*bls12381.PointG1, error) {
+	x, err := decodeBLSFieldElement(in[:blsFieldElementLength])
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBLSFieldElement(in[blsFieldElementLength:])
+	if err != nil {
+		return nil, err
+	}
+	return g1.FromBytes(append(append([]byte{}, x...), y...))
+}
+
+func decodeBLSG2(g2 *bls12381.G2, in []byte) (*bls12381.PointG2, error) {
+	x, err := decodeBLSFieldElement2(in[:2*blsFieldElementLength])
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBLSFieldElement2(in[2*blsFieldElementLength:])
+	if err != nil {
+		return nil, err
+	}
+	return g2.FromBytes(append(x, y...))
+}
+
+func encodeBLSG1(g1 *bls12381.G1, p *bls12381.PointG1) []byte {
+	const n = blsFieldElementLength - blsFieldElementPadding
+	bs := g1.ToBytes(p)
+	out := make([]byte, blsG1PointLength)
+	copy(out[blsFieldElementPadding:], bs[:n])
+	copy(out[blsFieldElementLength+blsFieldElementPadding:], bs[n:])
+	return out
+}
+
+func encodeBLSG2(g2 *bls12381.G2, p *bls12381.PointG2) []byte {
+	const n = blsFieldElementLength - blsFieldElementPadding
+	// The curve library encodes x.c1 | x.c0 | y.c1 | y.c0
+	bs := g2.ToBytes(p)
+	out := make([]byte, blsG2PointLength)
+	copy(out[blsFieldElementPadding:], bs[n:2*n])
+	copy(out[blsFieldElementLength+blsFieldElementPadding:], bs[:n])
+	copy(out[2*blsFieldElementLength+blsFieldElementPadding:], bs[3*n:])
+	copy(out[3*blsFieldElementLength+blsFieldElementPadding:], bs[2*n:3*n])
+	return out
+}
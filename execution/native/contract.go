@@ -4,6 +4,7 @@
 package native
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hyperledger/burrow/acm"
@@ -157,8 +158,11 @@ func (c *Contract) Functions() []*Function {
 }
 
 func (c *Contract) ContractMeta() []*acm.ContractMeta {
-	// FIXME: make this return actual ABI metadata
-	metadata := "{}"
+	metadata, err := c.Metadata()
+	if err != nil {
+		c.logger.InfoMsg("could not generate native contract metadata", "error", err)
+		metadata = "{}"
+	}
 	metadataHash := acmstate.GetMetadataHash(metadata)
 	return []*acm.ContractMeta{
 		{
@@ -168,3 +172,65 @@ func (c *Contract) ContractMeta() []*acm.ContractMeta {
 		},
 	}
 }
+
+// Metadata returns the contract's ABI in the form of the metadata stored for deployed contracts
+func (c *Contract) Metadata() (string, error) {
+	abiJSON, err := c.ABI()
+	if err != nil {
+		return "", err
+	}
+	bs, err := json.Marshal(struct {
+		ContractName string
+		Abi          json.RawMessage
+	}{
+		ContractName: c.Name,
+		Abi:          abiJSON,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// ABI returns the Solidity JSON ABI of the contract's functions
+func (c *Contract) ABI() ([]byte, error) {
+	type argumentJSON struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+	type functionJSON struct {
+		Type            string         `json:"type"`
+		Name            string         `json:"name"`
+		Inputs          []argumentJSON `json:"inputs"`
+		Outputs         []argumentJSON `json:"outputs"`
+		StateMutability string         `json:"stateMutability"`
+	}
+	arguments := func(args []abi.Argument) []argumentJSON {
+		argsJ := make([]argumentJSON, len(args))
+		for i, arg := range args {
+			argsJ[i] = argumentJSON{Name: arg.Name, Type: arg.EVM.GetSignature()}
+			if arg.IsArray {
+				if arg.ArrayLength > 0 {
+					argsJ[i].Type += fmt.Sprintf("[%d]", arg.ArrayLength)
+				} else {
+					argsJ[i].Type += "[]"
+				}
+			}
+		}
+		return argsJ
+	}
+	functionsJ := make([]functionJSON, len(c.functions))
+	for i, f := range c.functions {
+		functionsJ[i] = functionJSON{
+			Type:            "function",
+			Name:            f.name,
+			Inputs:          arguments(f.abi.Inputs),
+			Outputs:         arguments(f.abi.Outputs),
+			StateMutability: "nonpayable",
+		}
+		if f.Pure {
+			functionsJ[i].StateMutability = "view"
+		}
+	}
+	return json.Marshal(functionsJ)
+}
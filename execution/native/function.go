@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
 )
@@ -25,6 +26,9 @@ type Function struct {
 	PermFlag permission.PermFlag
 	// Whether this function writes to state
 	Pure bool
+	// Gas charged before the function is called given its input (excluding any function selector). Functions may
+	// charge further gas from Context.Gas as they execute.
+	Gas func(input []byte) uint64
 	// Native function to which calls will be dispatched when a containing
 	F interface{}
 	// Following fields are for only for memoization
@@ -66,6 +70,11 @@ func NewFunction(comment string, address crypto.Address, permFlag permission.Per
 	return function, nil
 }
 
+// Schedule returns the gas schedule in effect for the call
+func (ctx Context) Schedule() *gas.Schedule {
+	return gas.ScheduleOrDefault(ctx.State.GasSchedule)
+}
+
 func (f *Function) SetExternals(externals engine.Dispatcher) {
 	// Wrap it to treat nil dispatcher as empty list
 	f.externals = engine.NewDispatchers(externals)
@@ -85,6 +94,13 @@ func (f *Function) execute(state engine.State, params engine.CallParams) ([]byte
 		return nil, &errors.LacksNativePermission{Address: params.Caller, NativeName: f.name}
 	}
 
+	if f.Gas != nil {
+		err = engine.UseGasNegative(params.Gas, f.Gas(params.Input))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx := Context{
 		State:      state,
 		CallParams: params,
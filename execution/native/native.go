@@ -9,7 +9,7 @@ func MustDefaultNatives() *Natives {
 }
 
 func DefaultNatives() (*Natives, error) {
-	ns, err := Merge(Permissions, Precompiles)
+	ns, err := Merge(append([]*Natives{Permissions, Randomness, Scheduler, Sponsorship, Precompiles}, Registered()...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,8 +1,18 @@
 package native
 
 import (
+	"encoding/json"
+	"math/big"
 	"testing"
 
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/permission"
 	"github.com/stretchr/testify/require"
 )
 
@@ -10,3 +20,81 @@ func TestDefaultNatives(t *testing.T) {
 	_, err := DefaultNatives()
 	require.NoError(t, err)
 }
+
+func TestRegister(t *testing.T) {
+	ns := New().MustFunction("Return the input", AddressFromIndex(0x1, 0x0), permission.None, echo)
+	require.NoError(t, Register(ns))
+	defer func() {
+		registry.natives = nil
+	}()
+	natives, err := DefaultNatives()
+	require.NoError(t, err)
+	require.True(t, natives.IsRegistered(AddressFromIndex(0x1, 0x0)))
+	require.True(t, natives.IsRegistered(leftPadAddress(1)))
+
+	// Clashes with the precompile at the same address
+	err = Register(New().MustFunction("Another identity", AddressFromIndex(4), permission.None, identity))
+	require.Error(t, err)
+	// Clashes with the previously registered function by name
+	err = Register(New().MustFunction("Another echo", AddressFromIndex(0x2, 0x0), permission.None, echo))
+	require.Error(t, err)
+	require.Len(t, Registered(), 1)
+}
+
+func TestFunctionGas(t *testing.T) {
+	function, err := NewFunction("Return the input", AddressFromIndex(0x1, 0x0), permission.None, echo)
+	require.NoError(t, err)
+	function.Gas = func(input []byte) uint64 {
+		return 10 * uint64(len(input))
+	}
+	ns := New().MustAdd(function)
+
+	st := acmstate.NewMemoryState()
+	caller := &acm.Account{
+		Address: crypto.Address{1, 1, 1},
+	}
+	require.NoError(t, st.UpdateAccount(caller))
+	state := engine.State{
+		CallFrame: engine.NewCallFrame(st),
+		EventSink: exec.NewNoopEventSink(),
+	}
+	params := engine.CallParams{
+		Caller: caller.Address,
+		Input:  []byte{1, 2, 3},
+		Gas:    big.NewInt(1000),
+	}
+	output, err := ns.GetByAddress(function.Address()).Call(state, params)
+	require.NoError(t, err)
+	require.Equal(t, params.Input, output)
+	require.Equal(t, big.NewInt(970), params.Gas)
+
+	params.Gas = big.NewInt(20)
+	_, err = ns.GetByAddress(function.Address()).Call(state, params)
+	require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+}
+
+func TestContractABI(t *testing.T) {
+	contract := Permissions.GetContract("Permissions")
+	abiJSON, err := contract.ABI()
+	require.NoError(t, err)
+	spec, err := abi.ReadSpec(abiJSON)
+	require.NoError(t, err)
+	require.Len(t, spec.Functions, len(contract.Functions()))
+	for _, f := range contract.Functions() {
+		require.Equal(t, f.Abi().FunctionID, spec.Functions[f.Name()].FunctionID)
+	}
+
+	metas := contract.ContractMeta()
+	require.Len(t, metas, 1)
+	metadata := new(struct {
+		ContractName string
+		Abi          json.RawMessage
+	})
+	require.NoError(t, json.Unmarshal([]byte(metas[0].Metadata), metadata))
+	require.Equal(t, "Permissions", metadata.ContractName)
+	require.JSONEq(t, string(abiJSON), string(metadata.Abi))
+}
+
+func echo(ctx Context) ([]byte, error) {
+	return ctx.Input, nil
+}
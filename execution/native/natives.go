@@ -126,6 +126,23 @@ func (ns *Natives) Function(comment string, address crypto.Address, permFlag per
 	return ns, nil
 }
 
+// Add registers a contract or function constructed with NewContract or NewFunction, for example to set its Gas
+func (ns *Natives) Add(callable engine.Native) (*Natives, error) {
+	err := ns.register(callable)
+	if err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
+func (ns *Natives) MustAdd(callable engine.Native) *Natives {
+	ns, err := ns.Add(callable)
+	if err != nil {
+		panic(err)
+	}
+	return ns
+}
+
 func (ns *Natives) register(callable engine.Native) error {
 	name := callable.FullName()
 	address := callable.Address()
@@ -6,8 +6,10 @@ import (
 	"math/big"
 
 	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/gas"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
@@ -37,6 +39,38 @@ var Precompiles = New().
 		leftPadAddress(5),
 		permission.None,
 		expMod).
+	MustFunction(`Compute the BLAKE2b compression function F as specified by EIP-152`,
+		leftPadAddress(9),
+		permission.None,
+		blake2F).
+	MustFunction(`Add two BLS12-381 G1 points`,
+		leftPadAddress(0x0b),
+		permission.None,
+		bls12381G1Add).
+	MustFunction(`Compute a BLS12-381 G1 multi-scalar multiplication`,
+		leftPadAddress(0x0c),
+		permission.None,
+		bls12381G1MSM).
+	MustFunction(`Add two BLS12-381 G2 points`,
+		leftPadAddress(0x0d),
+		permission.None,
+		bls12381G2Add).
+	MustFunction(`Compute a BLS12-381 G2 multi-scalar multiplication`,
+		leftPadAddress(0x0e),
+		permission.None,
+		bls12381G2MSM).
+	MustFunction(`Check that the product of BLS12-381 pairings of G1 and G2 points is the identity`,
+		leftPadAddress(0x0f),
+		permission.None,
+		bls12381Pairing).
+	MustFunction(`Map a BLS12-381 base field element to a G1 point`,
+		leftPadAddress(0x10),
+		permission.None,
+		bls12381MapG1).
+	MustFunction(`Map a BLS12-381 quadratic extension field element to a G2 point`,
+		leftPadAddress(0x11),
+		permission.None,
+		bls12381MapG2).
 	MustFunction(`Compute the keccak256 hash of input`,
 		leftPadAddress(20),
 		permission.None,
@@ -49,7 +83,7 @@ func leftPadAddress(bs ...byte) crypto.Address {
 // SECP256K1 Recovery
 func ecrecover(ctx Context) ([]byte, error) {
 	// Deduct gas
-	gasRequired := engine.GasEcRecover
+	gasRequired := ctx.Schedule().EcRecover
 	var err error = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -81,7 +115,7 @@ func ecrecover(ctx Context) ([]byte, error) {
 	// Copy in v to last element of sig
 	sig[2*binary.Word256Bytes] = v[len(v)-1]
 
-	publicKey, isCompressed, err := btcec.RecoverCompact(btcec.S256(), sig, hash)
+	publicKey, isCompressed, err := ecdsa.RecoverCompact(sig, hash)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +134,7 @@ func ecrecover(ctx Context) ([]byte, error) {
 
 func sha256(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasSha256Word + engine.GasSha256Base
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*ctx.Schedule().Sha256Word + ctx.Schedule().Sha256Base
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -114,7 +148,7 @@ func sha256(ctx Context) (output []byte, err error) {
 
 func ripemd160Func(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasRipemd160Word + engine.GasRipemd160Base
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*ctx.Schedule().Ripemd160Word + ctx.Schedule().Ripemd160Base
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -128,7 +162,7 @@ func ripemd160Func(ctx Context) (output []byte, err error) {
 
 func keccak256Func(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasRipemd160Word + engine.GasRipemd160Base
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*ctx.Schedule().Ripemd160Word + ctx.Schedule().Ripemd160Base
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -142,7 +176,7 @@ func keccak256Func(ctx Context) (output []byte, err error) {
 
 func identity(ctx Context) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := wordsIn(uint64(len(ctx.Input)))*engine.GasIdentityWord + engine.GasIdentityBase
+	gasRequired := wordsIn(uint64(len(ctx.Input)))*ctx.Schedule().IdentityWord + ctx.Schedule().IdentityBase
 	err = engine.UseGasNegative(ctx.Gas, gasRequired)
 	if err != nil {
 		return nil, err
@@ -151,71 +185,116 @@ func identity(ctx Context) (output []byte, err error) {
 	return ctx.Input, nil
 }
 
-// expMod: function that implements the EIP 198 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-198.md with
-// a fixed gas requirement)
+// expMod: function that implements EIP-198 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-198.md) with the
+// gas rules of EIP-2565 (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-2565.md) parameterised by the schedule
 func expMod(ctx Context) (output []byte, err error) {
 	const errHeader = "expMod"
 
-	input, segments, err := cut(ctx.Input, binary.Word256Bytes, binary.Word256Bytes, binary.Word256Bytes)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %v", errHeader, err)
-	}
+	// Input is implicitly right-padded with zeros
+	input := ctx.Input
+	segments := make([][]byte, 3)
+	input, segments[0] = cutPadded(input, binary.Word256Bytes)
+	input, segments[1] = cutPadded(input, binary.Word256Bytes)
+	input, segments[2] = cutPadded(input, binary.Word256Bytes)
 
 	// get the lengths of base, exp and mod
-	baseLength := getUint64(segments[0])
-	expLength := getUint64(segments[1])
-	modLength := getUint64(segments[2])
+	baseLength := new(big.Int).SetBytes(segments[0])
+	expLength := new(big.Int).SetBytes(segments[1])
+	modLength := new(big.Int).SetBytes(segments[2])
 
-	// TODO: implement non-trivial gas schedule for this operation. Probably a parameterised version of the one
-	// described in EIP though that one seems like a bit of a complicated fudge
-	gasRequired := engine.GasExpModBase + engine.GasExpModWord*(wordsIn(baseLength)*wordsIn(expLength)*wordsIn(modLength))
+	// The first 32 bytes of the exponent (or all of it if shorter) determine the iteration count
+	var expHead *big.Int
+	if baseLength.IsUint64() && baseLength.Uint64() < uint64(len(input)) {
+		headLength := uint64(binary.Word256Bytes)
+		if expLength.Cmp(new(big.Int).SetUint64(headLength)) < 0 {
+			headLength = expLength.Uint64()
+		}
+		_, head := cutPadded(input[baseLength.Uint64():], headLength)
+		expHead = new(big.Int).SetBytes(head)
+	} else {
+		expHead = new(big.Int)
+	}
 
-	err = engine.UseGasNegative(ctx.Gas, gasRequired)
+	gasRequired := expModGas(ctx.Schedule(), baseLength, expLength, modLength, expHead)
+	if !gasRequired.IsUint64() {
+		return nil, errors.Codes.InsufficientGas
+	}
+	err = engine.UseGasNegative(ctx.Gas, gasRequired.Uint64())
 	if err != nil {
 		return nil, err
 	}
 
-	input, segments, err = cut(input, baseLength, expLength, modLength)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %v", errHeader, err)
+	if baseLength.Sign() == 0 && modLength.Sign() == 0 {
+		return []byte{}, nil
+	}
+	// Lengths that pass the default schedule are far smaller than this, but the schedule is a chain parameter so
+	// guard against lengths we could not sensibly allocate
+	if !baseLength.IsUint64() || !expLength.IsUint64() || !modLength.IsUint64() ||
+		baseLength.Uint64() > maxExpModLength || expLength.Uint64() > maxExpModLength ||
+		modLength.Uint64() > maxExpModLength {
+		return nil, fmt.Errorf("%s: base, exponent, or modulus length exceeds %d bytes", errHeader, maxExpModLength)
 	}
 
 	// get the values of base, exp and mod
-	base := getBigInt(segments[0], baseLength)
-	exp := getBigInt(segments[1], expLength)
-	mod := getBigInt(segments[2], modLength)
+	input, segments[0] = cutPadded(input, baseLength.Uint64())
+	input, segments[1] = cutPadded(input, expLength.Uint64())
+	_, segments[2] = cutPadded(input, modLength.Uint64())
+	base := new(big.Int).SetBytes(segments[0])
+	exp := new(big.Int).SetBytes(segments[1])
+	mod := new(big.Int).SetBytes(segments[2])
 
 	// handle mod 0
 	if mod.Sign() == 0 {
-		return binary.LeftPadBytes([]byte{}, int(modLength)), nil
+		return make([]byte, modLength.Uint64()), nil
 	}
 
 	// return base**exp % mod left padded
-	return binary.LeftPadBytes(new(big.Int).Exp(base, exp, mod).Bytes(), int(modLength)), nil
+	return binary.LeftPadBytes(new(big.Int).Exp(base, exp, mod).Bytes(), int(modLength.Uint64())), nil
 }
 
-// Partition the head of input into segments for each length in lengths. The first return value is the unconsumed tail
-// of input and the seconds is the segments. Returns an error if input is of insufficient length to establish each segment.
-func cut(input []byte, lengths ...uint64) ([]byte, [][]byte, error) {
-	segments := make([][]byte, len(lengths))
-	for i, length := range lengths {
-		if uint64(len(input)) < length {
-			return nil, nil, fmt.Errorf("input is not long enough")
-		}
-		segments[i] = input[:length]
-		input = input[length:]
+const maxExpModLength = 1 << 20
+
+// Returns max(ExpModBase, ExpModWord * multiplicationComplexity * iterationCount / 3) as in EIP-2565
+func expModGas(schedule *gas.Schedule, baseLength, expLength, modLength, expHead *big.Int) *big.Int {
+	// ceil(max(baseLength, modLength) / 8) ** 2
+	complexity := new(big.Int).Set(baseLength)
+	if modLength.Cmp(complexity) > 0 {
+		complexity.Set(modLength)
 	}
-	return input, segments, nil
-}
+	complexity.Add(complexity, big.NewInt(7))
+	complexity.Rsh(complexity, 3)
+	complexity.Mul(complexity, complexity)
 
-func getBigInt(bs []byte, numBytes uint64) *big.Int {
-	bits := uint(numBytes) * 8
-	// Push bytes into big.Int and interpret as twos complement encoding with of bits width
-	return binary.FromTwosComplement(new(big.Int).SetBytes(bs), bits)
+	// The index of the highest bit of the exponent approximately
+	iterations := new(big.Int)
+	if expLength.Cmp(big.NewInt(binary.Word256Bytes)) > 0 {
+		iterations.Sub(expLength, big.NewInt(binary.Word256Bytes))
+		iterations.Lsh(iterations, 3)
+	}
+	if bitLength := expHead.BitLen(); bitLength > 0 {
+		iterations.Add(iterations, big.NewInt(int64(bitLength-1)))
+	}
+	if iterations.Sign() == 0 {
+		iterations.SetUint64(1)
+	}
+
+	gasRequired := complexity.Mul(complexity, iterations)
+	gasRequired.Mul(gasRequired, new(big.Int).SetUint64(schedule.ExpModWord))
+	gasRequired.Quo(gasRequired, big.NewInt(3))
+	if minimum := new(big.Int).SetUint64(schedule.ExpModBase); gasRequired.Cmp(minimum) < 0 {
+		return minimum
+	}
+	return gasRequired
 }
 
-func getUint64(bs []byte) uint64 {
-	return binary.Uint64FromWord256(binary.LeftPadWord256(bs))
+// Returns the tail of input after the first length bytes and those bytes right-padded with zeros to length
+func cutPadded(input []byte, length uint64) ([]byte, []byte) {
+	if uint64(len(input)) >= length {
+		return input[length:], input[:length]
+	}
+	segment := make([]byte, length)
+	copy(segment, input)
+	return nil, segment
 }
 
 func wordsIn(numBytes uint64) uint64 {
@@ -0,0 +1,173 @@
+package native
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	bls12381 "github.com/kilic/bls12-381"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestBlake2F(t *testing.T) {
+	// Compress the single block of BLAKE2b-512("abc")
+	input := make([]byte, blake2FInputLength)
+	input[3] = 12
+	for i, h := range blake2bIV {
+		if i == 0 {
+			// Parameter block for a 64 byte digest without a key
+			h ^= 0x01010040
+		}
+		binary.LittleEndian.PutUint64(input[4+i*8:], h)
+	}
+	copy(input[68:], "abc")
+	input[196] = 3
+	input[212] = 1
+
+	gas := big.NewInt(100)
+	output, err := blake2F(precompileContext(input, gas))
+	require.NoError(t, err)
+	expected := blake2b.Sum512([]byte("abc"))
+	require.Equal(t, expected[:], output)
+	require.Equal(t, int64(88), gas.Int64())
+
+	input[212] = 2
+	_, err = blake2F(precompileContext(input, big.NewInt(100)))
+	require.Error(t, err)
+
+	_, err = blake2F(precompileContext(input[1:], big.NewInt(100)))
+	require.Error(t, err)
+}
+
+func TestExpMod(t *testing.T) {
+	header := func(baseLength, expLength, modLength int64) []byte {
+		return MustSplice(
+			LeftPadWord256(big.NewInt(baseLength).Bytes()),
+			LeftPadWord256(big.NewInt(expLength).Bytes()),
+			LeftPadWord256(big.NewInt(modLength).Bytes()))
+	}
+
+	gas := big.NewInt(1000)
+	output, err := expMod(precompileContext(MustSplice(header(1, 1, 1), 3, 5, 7), gas))
+	require.NoError(t, err)
+	require.Equal(t, []byte{5}, output)
+	require.Equal(t, int64(800), gas.Int64())
+
+	// Values are unsigned
+	output, err = expMod(precompileContext(MustSplice(header(1, 1, 1), 0xff, 1, 0x80), big.NewInt(1000)))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x7f}, output)
+
+	// Missing input is taken to be zeros so the modulus is zero here
+	output, err = expMod(precompileContext(MustSplice(header(1, 1, 2), 3, 5), big.NewInt(1000)))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0}, output)
+
+	// Nothing to compute
+	output, err = expMod(precompileContext(header(0, 1<<40, 0), big.NewInt(1000)))
+	require.NoError(t, err)
+	require.Len(t, output, 0)
+
+	// Large exponents are charged for
+	gas = big.NewInt(1000000)
+	_, err = expMod(precompileContext(MustSplice(header(32, 64, 32), make([]byte, 128)), gas))
+	require.NoError(t, err)
+	// 16 * 8 * 32 / 3
+	require.Equal(t, int64(1000000-1365), gas.Int64())
+
+	// Lengths too large to be paid for
+	input := MustSplice(make([]byte, 64), bytes.Repeat([]byte{0xff}, Word256Bytes))
+	_, err = expMod(precompileContext(input, big.NewInt(1000)))
+	require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+}
+
+func TestBLS12381(t *testing.T) {
+	g1 := bls12381.NewG1()
+	g2 := bls12381.NewG2()
+	gas := big.NewInt(1000000)
+
+	one := encodeBLSG1(g1, g1.One())
+	two, err := bls12381G1Add(precompileContext(MustSplice(one, one), gas))
+	require.NoError(t, err)
+	output, err := bls12381G1MSM(precompileContext(MustSplice(one, LeftPadWord256([]byte{2})), gas))
+	require.NoError(t, err)
+	require.Equal(t, two, output)
+	// G1 point at infinity is the identity
+	output, err = bls12381G1Add(precompileContext(MustSplice(one, make([]byte, blsG1PointLength)), gas))
+	require.NoError(t, err)
+	require.Equal(t, one, output)
+
+	one2 := encodeBLSG2(g2, g2.One())
+	two2, err := bls12381G2Add(precompileContext(MustSplice(one2, one2), gas))
+	require.NoError(t, err)
+	output, err = bls12381G2MSM(precompileContext(MustSplice(one2, LeftPadWord256([]byte{2})), gas))
+	require.NoError(t, err)
+	require.Equal(t, two2, output)
+
+	// e(2 * P, Q) * e(-P, 2 * Q) == 1
+	minusOne := encodeBLSG1(g1, g1.Neg(g1.New(), g1.One()))
+	output, err = bls12381Pairing(precompileContext(MustSplice(two, one2, minusOne, two2), gas))
+	require.NoError(t, err)
+	require.Equal(t, LeftPadWord256([]byte{1}).Bytes(), output)
+	// e(2 * P, Q) * e(-P, Q) != 1
+	output, err = bls12381Pairing(precompileContext(MustSplice(two, one2, minusOne, one2), gas))
+	require.NoError(t, err)
+	require.Equal(t, Zero256.Bytes(), output)
+
+	// Mapped points are in the subgroup so can be used in a multi-scalar multiplication
+	fe := LeftPadBytes([]byte{42}, blsFieldElementLength)
+	p, err := bls12381MapG1(precompileContext(fe, gas))
+	require.NoError(t, err)
+	_, err = bls12381G1MSM(precompileContext(MustSplice(p, LeftPadWord256([]byte{3})), gas))
+	require.NoError(t, err)
+	p, err = bls12381MapG2(precompileContext(MustSplice(fe, fe), gas))
+	require.NoError(t, err)
+	_, err = bls12381G2MSM(precompileContext(MustSplice(p, LeftPadWord256([]byte{3})), gas))
+	require.NoError(t, err)
+
+	// Field elements must be padded with zeros
+	fe[0] = 1
+	_, err = bls12381MapG1(precompileContext(fe, gas))
+	require.Error(t, err)
+	// Not on the curve
+	_, err = bls12381G1Add(precompileContext(MustSplice(one[:len(one)-1], 0, one), gas))
+	require.Error(t, err)
+
+	// Gas is charged per point
+	gas = big.NewInt(100000)
+	_, err = bls12381G1MSM(precompileContext(MustSplice(one, LeftPadWord256([]byte{2}),
+		one, LeftPadWord256([]byte{3})), gas))
+	require.NoError(t, err)
+	require.Equal(t, int64(100000-2*12000), gas.Int64())
+}
+
+func TestBLS12381Encoding(t *testing.T) {
+	// The generator of G2 as given by EIP-2537
+	expected, err := hex.DecodeString("" +
+		"00000000000000000000000000000000024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8" +
+		"0000000000000000000000000000000013e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e" +
+		"000000000000000000000000000000000ce5d527727d6e118cc9cdc6da2e351aadfd9baa8cbdd3a76d429a695160d12c923ac9cc3baca289e193548608b82801" +
+		"000000000000000000000000000000000606c4a02ea734cc32acd2b02bc28b99cb3e287e85a763af267492ab572e99ab3f370d275cec1da1aaa9075ff05f79be")
+	require.NoError(t, err)
+	g2 := bls12381.NewG2()
+	require.Equal(t, expected, encodeBLSG2(g2, g2.One()))
+	p, err := decodeBLSG2(g2, expected)
+	require.NoError(t, err)
+	require.True(t, g2.Equal(g2.One(), p))
+}
+
+func precompileContext(input []byte, gas *big.Int) Context {
+	return Context{
+		CallParams: engine.CallParams{
+			Input: input,
+			Gas:   gas,
+		},
+	}
+}
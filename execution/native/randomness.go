@@ -0,0 +1,109 @@
+package native
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/permission"
+)
+
+var Randomness = New().MustContract("Randomness",
+	`* Interface for deterministic per-block randomness derived from validator signatures.
+		* @dev The randomness of a block is the Keccak-256 hash of the signatures of the validators that committed it
+		* @dev as included in the following block, so it is only available once that block has been committed. It
+		* @dev can be verified by anyone with that block by checking the signatures against the validator set.
+		* @dev Validators sign deterministically so none can choose their contribution, but the proposer of the
+		* @dev following block chooses which of the signatures it has received to include.
+		`,
+	Function{
+		Comment: `
+			* @notice Returns the randomness of a block
+			* @param _height the block height which must be below the current block height
+			* @return _result the randomness of the block
+			`,
+		PermFlag: permission.None,
+		F:        randomness,
+	},
+	Function{
+		Comment: `
+			* @notice Returns the randomness of the latest block for which it is available
+			* @return _height the block height
+			* @return _result the randomness of the block
+			`,
+		PermFlag: permission.None,
+		F:        latestRandomness,
+	},
+)
+
+// Implemented by blockchains that can provide the canonical commit for a block from which its randomness is derived
+type CommitReader interface {
+	GetBlockCommit(height uint64) (*types.Commit, error)
+}
+
+// BlockRandomness returns the randomness of the block signed by commit
+func BlockRandomness(commit *types.Commit) binary.Word256 {
+	var signatures []byte
+	for _, sig := range commit.Signatures {
+		if sig.BlockIDFlag == types.BlockIDFlagCommit {
+			signatures = append(signatures, sig.Signature...)
+		}
+	}
+	return binary.LeftPadWord256(crypto.Keccak256(signatures))
+}
+
+type randomnessArgs struct {
+	Height uint64
+}
+
+type randomnessRets struct {
+	Result binary.Word256
+}
+
+func randomness(ctx Context, args randomnessArgs) (randomnessRets, error) {
+	result, err := blockRandomness(ctx, args.Height)
+	if err != nil {
+		return randomnessRets{}, err
+	}
+	return randomnessRets{Result: result}, nil
+}
+
+type latestRandomnessArgs struct {
+}
+
+type latestRandomnessRets struct {
+	Height uint64
+	Result binary.Word256
+}
+
+func latestRandomness(ctx Context, args latestRandomnessArgs) (latestRandomnessRets, error) {
+	lastBlockHeight := ctx.State.Blockchain.LastBlockHeight()
+	if lastBlockHeight < 2 {
+		return latestRandomnessRets{}, fmt.Errorf("latestRandomness: no randomness is available before block 2")
+	}
+	height := lastBlockHeight - 1
+	result, err := blockRandomness(ctx, height)
+	if err != nil {
+		return latestRandomnessRets{}, err
+	}
+	return latestRandomnessRets{Height: height, Result: result}, nil
+}
+
+// The commit for a block is included in the next so is known to every node once that block is the last block
+func blockRandomness(ctx Context, height uint64) (binary.Word256, error) {
+	const errHeader = "blockRandomness"
+	if height == 0 || height >= ctx.State.Blockchain.LastBlockHeight() {
+		return binary.Zero256, fmt.Errorf("%s: randomness of block %d is not available at height %d", errHeader,
+			height, ctx.State.Blockchain.LastBlockHeight()+1)
+	}
+	commits, ok := ctx.State.Blockchain.(CommitReader)
+	if !ok {
+		return binary.Zero256, fmt.Errorf("%s: blockchain does not provide block commits", errHeader)
+	}
+	commit, err := commits.GetBlockCommit(height)
+	if err != nil {
+		return binary.Zero256, fmt.Errorf("%s: %v", errHeader, err)
+	}
+	return BlockRandomness(commit), nil
+}
@@ -0,0 +1,88 @@
+package native
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/require"
+)
+
+type commitBlockchain struct {
+	engine.TestBlockchain
+	commits map[uint64]*types.Commit
+}
+
+func (b *commitBlockchain) GetBlockCommit(height uint64) (*types.Commit, error) {
+	return b.commits[height], nil
+}
+
+func TestRandomness(t *testing.T) {
+	commit := func(height int64, sigs ...string) *types.Commit {
+		c := &types.Commit{Height: height}
+		for _, sig := range sigs {
+			c.Signatures = append(c.Signatures, types.CommitSig{
+				BlockIDFlag: types.BlockIDFlagCommit,
+				Signature:   []byte(sig),
+			})
+		}
+		return c
+	}
+	blockchain := &commitBlockchain{
+		TestBlockchain: engine.TestBlockchain{BlockHeight: 3},
+		commits: map[uint64]*types.Commit{
+			1: commit(1, "a", "b"),
+			2: commit(2, "c", "d"),
+		},
+	}
+	st := acmstate.NewMemoryState()
+	caller := &acm.Account{
+		Address: crypto.Address{1, 1, 1},
+	}
+	require.NoError(t, st.UpdateAccount(caller))
+	state := engine.State{
+		CallFrame:  engine.NewCallFrame(st),
+		Blockchain: blockchain,
+		EventSink:  exec.NewNoopEventSink(),
+	}
+	contract := Randomness.GetContract("Randomness")
+	call := func(name string, args ...interface{}) ([]byte, error) {
+		funcID := contract.FunctionByName(name).Abi().FunctionID
+		return contract.Call(state, engine.CallParams{
+			Caller: caller.Address,
+			Input:  MustSplice(append([]interface{}{funcID[:]}, args...)...),
+			Gas:    big.NewInt(1000),
+		})
+	}
+
+	output, err := call("randomness", Uint64ToWord256(1))
+	require.NoError(t, err)
+	expected := BlockRandomness(blockchain.commits[1])
+	require.Equal(t, expected.Bytes(), output)
+	require.NotEqual(t, BlockRandomness(blockchain.commits[2]), expected)
+
+	// The commit for the last block is not included in a block yet
+	_, err = call("randomness", Uint64ToWord256(3))
+	require.Error(t, err)
+
+	output, err = call("latestRandomness")
+	require.NoError(t, err)
+	expected = BlockRandomness(blockchain.commits[2])
+	require.Equal(t, MustSplice(Uint64ToWord256(2), expected), output)
+
+	// Only signatures for the block contribute
+	absent := commit(1, "a", "b")
+	absent.Signatures = append(absent.Signatures, types.NewCommitSigAbsent())
+	require.Equal(t, BlockRandomness(blockchain.commits[1]), BlockRandomness(absent))
+
+	state.Blockchain = &blockchain.TestBlockchain
+	_, err = call("randomness", Uint64ToWord256(1))
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package native
+
+import (
+	"fmt"
+	"plugin"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Chain-specific natives can be included alongside the defaults without modifying this package by registering them
+// from the init() function of a package that is compiled into, or loaded by, the burrow binary:
+//
+// package mynatives
+//
+// func init() {
+//	native.MustRegister(native.New().
+//		MustFunction("Check an identity credential", native.AddressFromIndex(0x100), permission.None, checkCredential))
+// }
+//
+// Such a package can be imported from a file under cmd/burrow guarded by a build tag so that it is only built in
+// with, for example, `go build -tags mynatives`, or it can be built as a Go plugin with `go build -buildmode=plugin`
+// and listed under NativePlugins in the [Execution] section of burrow.toml to be loaded at startup (see LoadPlugin).
+//
+// Registered natives are dispatched at their fixed addresses by every VM created with the default natives so they
+// must be registered identically on all validators of a chain.
+
+var registry = struct {
+	sync.Mutex
+	natives []*Natives
+}{}
+
+// Register adds natives to be included by DefaultNatives. It returns an error if any of them clash by name or address
+// with the built-in natives or with those previously registered.
+func Register(ns *Natives) error {
+	registry.Lock()
+	defer registry.Unlock()
+	_, err := Merge(append([]*Natives{Permissions, Randomness, Scheduler, Sponsorship, Precompiles, ns}, registry.natives...)...)
+	if err != nil {
+		return fmt.Errorf("could not register natives: %w", err)
+	}
+	registry.natives = append(registry.natives, ns)
+	return nil
+}
+
+func MustRegister(ns *Natives) {
+	err := Register(ns)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Registered returns the natives added by Register in order of registration
+func Registered() []*Natives {
+	registry.Lock()
+	defer registry.Unlock()
+	nss := make([]*Natives, len(registry.natives))
+	copy(nss, registry.natives)
+	return nss
+}
+
+// LoadPlugin opens the Go plugin at path, running the init() functions of its packages which are expected to call
+// Register. Opening the same plugin more than once has no further effect.
+func LoadPlugin(path string) error {
+	_, err := plugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not load natives plugin: %w", err)
+	}
+	return nil
+}
+
+// AddressFromIndex returns the address with bs as its big-endian suffix in the manner of the precompile addresses.
+// Chain-specific natives mounted directly at an address should choose indices well clear of the Ethereum precompiles.
+func AddressFromIndex(bs ...byte) crypto.Address {
+	return leftPadAddress(bs...)
+}
@@ -0,0 +1,134 @@
+package native
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/schedule"
+	"github.com/hyperledger/burrow/permission"
+)
+
+var Scheduler = New().MustContract("Scheduler",
+	`* Interface for scheduling calls to be made automatically by the chain.
+		* @dev A scheduled call is made from the account that scheduled it at the end of the block in which it is due.
+		* @dev The gas limit of the call is paid for up front from the gas of the call that schedules it and is not
+		* @dev refunded if the call is cancelled. Each call made is recorded as a transaction in its block along with
+		* @dev any exception it raised.
+		`,
+	Function{
+		Comment: `
+			* @notice Schedules a call to be made in the block at a height
+			* @param _callee the account to call
+			* @param _data the input to the call
+			* @param _height the height of the block in which to make the call, which must not be below the current height
+			* @param _gasLimit the gas available to the call
+			* @return _id the identifier of the scheduled call
+			`,
+		PermFlag: permission.Call,
+		F:        scheduleAtHeight,
+	},
+	Function{
+		Comment: `
+			* @notice Schedules a call to be made in the first block whose timestamp is at or after a time
+			* @param _callee the account to call
+			* @param _data the input to the call
+			* @param _time the time in seconds since the Unix epoch, which must be after the current block timestamp
+			* @param _gasLimit the gas available to the call
+			* @return _id the identifier of the scheduled call
+			`,
+		PermFlag: permission.Call,
+		F:        scheduleAtTime,
+	},
+	Function{
+		Comment: `
+			* @notice Cancels a call scheduled by the caller that has not yet been made
+			* @param _id the identifier of the scheduled call
+			`,
+		PermFlag: permission.Call,
+		F:        cancel,
+	},
+)
+
+type scheduleAtHeightArgs struct {
+	Callee   crypto.Address
+	Data     []byte
+	Height   uint64
+	GasLimit uint64
+}
+
+type scheduleRets struct {
+	ID uint64
+}
+
+func scheduleAtHeight(ctx Context, args scheduleAtHeightArgs) (scheduleRets, error) {
+	// The current block is the one after the last block
+	if args.Height <= ctx.State.Blockchain.LastBlockHeight() {
+		return scheduleRets{}, fmt.Errorf("scheduleAtHeight: cannot schedule a call at height %d before the "+
+			"current height %d", args.Height, ctx.State.Blockchain.LastBlockHeight()+1)
+	}
+	return scheduleCall(ctx, &schedule.ScheduledCall{
+		Callee:   args.Callee,
+		Data:     args.Data,
+		Height:   args.Height,
+		GasLimit: args.GasLimit,
+	})
+}
+
+type scheduleAtTimeArgs struct {
+	Callee   crypto.Address
+	Data     []byte
+	Time     uint64
+	GasLimit uint64
+}
+
+func scheduleAtTime(ctx Context, args scheduleAtTimeArgs) (scheduleRets, error) {
+	blockTime := uint64(ctx.State.Blockchain.LastBlockTime().Unix())
+	if args.Time <= blockTime {
+		return scheduleRets{}, fmt.Errorf("scheduleAtTime: cannot schedule a call at time %d which is not after "+
+			"the current block time %d", args.Time, blockTime)
+	}
+	return scheduleCall(ctx, &schedule.ScheduledCall{
+		Callee:   args.Callee,
+		Data:     args.Data,
+		Time:     args.Time,
+		GasLimit: args.GasLimit,
+	})
+}
+
+func scheduleCall(ctx Context, call *schedule.ScheduledCall) (scheduleRets, error) {
+	var err error = engine.UseGasNegative(ctx.Gas, call.GasLimit)
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	call.Caller = ctx.Caller
+	id, err := schedule.Add(ctx.State.CallFrame, call)
+	if err != nil {
+		return scheduleRets{}, err
+	}
+	ctx.Logger.Trace.Log("function", "schedule",
+		"id", id,
+		"caller", call.Caller,
+		"callee", call.Callee,
+		"height", call.Height,
+		"time", call.Time)
+	return scheduleRets{ID: id}, nil
+}
+
+type cancelArgs struct {
+	ID uint64
+}
+
+type cancelRets struct {
+}
+
+func cancel(ctx Context, args cancelArgs) (cancelRets, error) {
+	call, err := schedule.Get(ctx.State.CallFrame, args.ID)
+	if err != nil {
+		return cancelRets{}, err
+	}
+	if call == nil || call.Caller != ctx.Caller {
+		return cancelRets{}, fmt.Errorf("cancel: no call with ID %d has been scheduled by %v", args.ID, ctx.Caller)
+	}
+	return cancelRets{}, schedule.Remove(ctx.State.CallFrame, args.ID)
+}
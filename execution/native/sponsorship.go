@@ -0,0 +1,124 @@
+package native
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/sponsorship"
+	"github.com/hyperledger/burrow/permission"
+)
+
+var Sponsorship = New().MustContract("Sponsorship",
+	`* Interface for paying the fees of the transactions of other accounts.
+		* @dev The fee and any gas fee of a CallTx whose input account is sponsored are paid by its sponsor, up to the
+		* @dev allowance the sponsor has set, rather than by the input account so that it need not hold any of the
+		* @dev native token. Once the allowance is spent the input account pays its own fees. Fees may also be waived
+		* @dev entirely for an account by an account with the root permission.
+		`,
+	Function{
+		Comment: `
+			* @notice Pays the fees of an account's transactions up to an allowance or stops paying them
+			* @param _account the account to sponsor, which must not be sponsored by another account with allowance left
+			* @param _allowance the most to pay in total from now on, zero to stop paying
+			`,
+		PermFlag: permission.Call,
+		F:        sponsor,
+	},
+	Function{
+		Comment: `
+			* @notice Waives the fees of an account's transactions or stops waiving them
+			* @param _account the account whose fees to waive
+			* @param _waived whether to waive its fees
+			`,
+		PermFlag: permission.Root,
+		F:        waive,
+	},
+	Function{
+		Comment: `
+			* @notice Returns the sponsorship of an account
+			* @param _account the account
+			* @return _sponsor the account paying its fees, the zero address if there is none
+			* @return _allowance the most its sponsor will pay
+			* @return _waived whether its fees are waived
+			`,
+		PermFlag: permission.None,
+		F:        sponsorOf,
+	},
+)
+
+type sponsorArgs struct {
+	Account   crypto.Address
+	Allowance uint64
+}
+
+type sponsorRets struct {
+}
+
+func sponsor(ctx Context, args sponsorArgs) (sponsorRets, error) {
+	current, err := sponsorship.Get(ctx.State.CallFrame, args.Account)
+	if err != nil {
+		return sponsorRets{}, err
+	}
+	if current != nil && (current.Waived || current.Sponsor != ctx.Caller && current.Allowance > 0) {
+		return sponsorRets{}, fmt.Errorf("sponsor: %v is already sponsored", args.Account)
+	}
+	var next *sponsorship.Sponsorship
+	if args.Allowance > 0 {
+		next = &sponsorship.Sponsorship{
+			Sponsor:   ctx.Caller,
+			Allowance: args.Allowance,
+		}
+	}
+	ctx.Logger.Trace.Log("function", "sponsor",
+		"sponsor", ctx.Caller,
+		"account", args.Account,
+		"allowance", args.Allowance)
+	return sponsorRets{}, sponsorship.Set(ctx.State.CallFrame, args.Account, next)
+}
+
+type waiveArgs struct {
+	Account crypto.Address
+	Waived  bool
+}
+
+type waiveRets struct {
+}
+
+func waive(ctx Context, args waiveArgs) (waiveRets, error) {
+	current, err := sponsorship.Get(ctx.State.CallFrame, args.Account)
+	if err != nil {
+		return waiveRets{}, err
+	}
+	ctx.Logger.Trace.Log("function", "waive",
+		"account", args.Account,
+		"waived", args.Waived)
+	if args.Waived {
+		return waiveRets{}, sponsorship.Set(ctx.State.CallFrame, args.Account, &sponsorship.Sponsorship{Waived: true})
+	}
+	if current != nil && current.Waived {
+		return waiveRets{}, sponsorship.Set(ctx.State.CallFrame, args.Account, nil)
+	}
+	return waiveRets{}, nil
+}
+
+type sponsorOfArgs struct {
+	Account crypto.Address
+}
+
+type sponsorOfRets struct {
+	Sponsor   crypto.Address
+	Allowance uint64
+	Waived    bool
+}
+
+func sponsorOf(ctx Context, args sponsorOfArgs) (sponsorOfRets, error) {
+	current, err := sponsorship.Get(ctx.State.CallFrame, args.Account)
+	if err != nil || current == nil {
+		return sponsorOfRets{}, err
+	}
+	return sponsorOfRets{
+		Sponsor:   current.Sponsor,
+		Allowance: current.Allowance,
+		Waived:    current.Waived,
+	}, nil
+}
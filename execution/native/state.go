@@ -77,9 +77,8 @@ func (s *State) ensureNonNative(address crypto.Address, action string) error {
 
 func account(callable engine.Native) *acm.Account {
 	return &acm.Account{
-		Address:    callable.Address(),
-		NativeName: callable.FullName(),
-		// TODO: this is not populated yet, see FIXME note on native.Contract
+		Address:      callable.Address(),
+		NativeName:   callable.FullName(),
 		ContractMeta: callable.ContractMeta(),
 	}
 }
@@ -0,0 +1,260 @@
+package execution
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/defaults"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/vms"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type ParallelExecutor interface {
+	// Execute a sequence of transactions with the same results as calling Execute on each in turn. The TxExecution
+	// and error returned for each transaction are those Execute would have returned.
+	ExecuteParallel(txEnvs []*txs.Envelope) ([]*exec.TxExecution, []error)
+}
+
+var _ ParallelExecutor = (*executor)(nil)
+
+// A key identifying an account (when storage is false) or one of its storage slots
+type accessKey struct {
+	address crypto.Address
+	key     binary.Word256
+	storage bool
+}
+
+// The outcome of executing a transaction against its own cache over the state at the start of the batch
+type speculation struct {
+	executor *executor
+	reads    *readRecorder
+	txe      *exec.TxExecution
+	err      error
+}
+
+// Transactions are executed optimistically in parallel against the state at the start of the batch recording the
+// accounts and storage they read. Their writes are then applied in order, any transaction that read something written
+// by a transaction before it in the batch is executed again against the state resulting from its predecessors.
+// Only CallTx and SendTx are executed in parallel, other transactions are executed in order and cause all those after
+// them to be executed again.
+func (exe *executor) ExecuteParallel(txEnvs []*txs.Envelope) ([]*exec.TxExecution, []error) {
+	txes := make([]*exec.TxExecution, len(txEnvs))
+	errs := make([]error, len(txEnvs))
+	// Natives are looked up once rather than for each speculative executor
+	vmOptions := defaults.CompleteOptions(exe.vmOptions)
+
+	speculations := make([]*speculation, len(txEnvs))
+	indices := make(chan int)
+	wg := new(sync.WaitGroup)
+	workers := exe.parallelWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				speculations[i] = exe.speculate(txEnvs[i], vmOptions)
+			}
+		}()
+	}
+	for i, txEnv := range txEnvs {
+		if speculative(txEnv) {
+			indices <- i
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	// Accounts and storage written since the start of the batch
+	written := make(map[accessKey]struct{})
+	serialised := false
+	for i, txEnv := range txEnvs {
+		spec := speculations[i]
+		if spec == nil {
+			// Other transactions may touch state we do not track so we cannot trust any speculation after them
+			txes[i], errs[i] = exe.Execute(txEnv)
+			serialised = true
+			continue
+		}
+		if serialised || spec.reads.readAny(written) {
+			exe.logger.TraceMsg("Re-executing conflicting transaction", "tx_hash", txEnv.Tx.Hash())
+			// Nothing can have been written since this read the current state
+			spec = exe.speculate(txEnv, vmOptions)
+		}
+		err := spec.apply(exe, written)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		txes[i], errs[i] = spec.txe, spec.err
+	}
+	return txes, errs
+}
+
+func speculative(txEnv *txs.Envelope) bool {
+	switch txEnv.Tx.Type() {
+	case payload.TypeCall, payload.TypeSend:
+		return true
+	}
+	return false
+}
+
+// Execute a transaction against a cache over the current state
+func (exe *executor) speculate(txEnv *txs.Envelope, vmOptions engine.Options) *speculation {
+	reads := newReadRecorder(exe.stateCache)
+	spec := &executor{
+		runCall:       exe.runCall,
+		params:        exe.params,
+		stateCache:    acmstate.NewCache(reads),
+		metadataCache: acmstate.NewMetadataCache(exe.metadataCache),
+		block: &exec.BlockExecution{
+			Height:  exe.block.Height,
+			BaseFee: exe.block.BaseFee,
+		},
+		logger:      exe.logger,
+		vmOptions:   vmOptions,
+		gasSchedule: exe.gasSchedule,
+		blockchain:  exe.blockchain,
+	}
+	spec.initTxState()
+	callContext := &contexts.CallContext{
+		VMS:           vms.NewConnectedVirtualMachines(vmOptions),
+		Blockchain:    exe.blockchain,
+		State:         spec.txState,
+		MetadataState: spec.metadataCache,
+		RunCall:       exe.runCall,
+		Logger:        exe.logger,
+	}
+	if exe.params.FeeMarket != nil {
+		callContext.BaseFee = spec.baseFee
+	}
+	spec.contexts = map[payload.Type]contexts.Context{
+		payload.TypeCall: callContext,
+		payload.TypeSend: &contexts.SendContext{
+			State:  spec.txState,
+			Logger: exe.logger,
+		},
+	}
+	txe, err := spec.Execute(txEnv)
+	return &speculation{
+		executor: spec,
+		reads:    reads,
+		txe:      txe,
+		err:      err,
+	}
+}
+
+// Apply the writes of the speculation to the executor as if the transaction had been executed by it, adding them to
+// written
+func (spec *speculation) apply(exe *executor, written map[accessKey]struct{}) error {
+	err := spec.executor.stateCache.Sync(&writeRecorder{
+		Writer:  exe.stateCache,
+		reads:   spec.reads,
+		written: written,
+	})
+	if err != nil {
+		return err
+	}
+	err = spec.executor.metadataCache.Sync(exe.metadataCache)
+	if err != nil {
+		return err
+	}
+	// Execute does not return the TxExecution of a failed transaction but it is still part of the block
+	exe.block.AppendTxs(spec.executor.block.TxExecutions...)
+	exe.block.GasUsed += spec.executor.block.GasUsed
+	return nil
+}
+
+// Records the value of each account and storage slot read through it
+type readRecorder struct {
+	acmstate.Reader
+	sync.Mutex
+	accounts map[crypto.Address]*acm.Account
+	storage  map[accessKey][]byte
+}
+
+func newReadRecorder(backend acmstate.Reader) *readRecorder {
+	return &readRecorder{
+		Reader:   backend,
+		accounts: make(map[crypto.Address]*acm.Account),
+		storage:  make(map[accessKey][]byte),
+	}
+}
+
+func (rr *readRecorder) GetAccount(address crypto.Address) (*acm.Account, error) {
+	account, err := rr.Reader.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	rr.Lock()
+	defer rr.Unlock()
+	rr.accounts[address] = account
+	return account.Copy(), nil
+}
+
+func (rr *readRecorder) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	value, err := rr.Reader.GetStorage(address, key)
+	if err != nil {
+		return nil, err
+	}
+	rr.Lock()
+	defer rr.Unlock()
+	rr.storage[accessKey{address: address, key: key, storage: true}] = value
+	return value, nil
+}
+
+// Whether anything recorded is in keys
+func (rr *readRecorder) readAny(keys map[accessKey]struct{}) bool {
+	for address := range rr.accounts {
+		if _, ok := keys[accessKey{address: address}]; ok {
+			return true
+		}
+	}
+	for key := range rr.storage {
+		if _, ok := keys[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Passes on writes that change the value read by a transaction recording what they change. Since the cache syncs
+// every account it has updated along with all the storage it has read for that account not all writes are changes.
+type writeRecorder struct {
+	acmstate.Writer
+	reads   *readRecorder
+	written map[accessKey]struct{}
+}
+
+func (wr *writeRecorder) UpdateAccount(account *acm.Account) error {
+	if read, ok := wr.reads.accounts[account.Address]; ok && read != nil && read.Equal(account) {
+		return nil
+	}
+	wr.written[accessKey{address: account.Address}] = struct{}{}
+	return wr.Writer.UpdateAccount(account)
+}
+
+func (wr *writeRecorder) RemoveAccount(address crypto.Address) error {
+	wr.written[accessKey{address: address}] = struct{}{}
+	return wr.Writer.RemoveAccount(address)
+}
+
+func (wr *writeRecorder) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
+	k := accessKey{address: address, key: key, storage: true}
+	if read, ok := wr.reads.storage[k]; ok && bytes.Equal(read, value) {
+		return nil
+	}
+	wr.written[k] = struct{}{}
+	return wr.Writer.SetStorage(address, key, value)
+}
@@ -0,0 +1,107 @@
+package execution
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// OrderProposal returns the transactions a proposer puts in a block from the candidates in txEnvs when the chain has
+// ProposalOrdering. The transactions of each input are put in sequence order and any whose sequence does not follow on
+// from the input account's sequence in accounts, or from its previous transaction, are dropped since they could only
+// fail. What is left is ordered as FeeOrderingArrival orders it given the block's baseFee, so the highest offer from
+// the next transaction of each input goes first and ties keep the order they arrived in.
+func OrderProposal(txEnvs []*txs.Envelope, accounts acmstate.AccountGetter, baseFee *uint64) ([]*txs.Envelope, error) {
+	// The positions in txEnvs of the transactions from each input
+	var inputs []crypto.Address
+	positions := make(map[crypto.Address][]int)
+	for i, txEnv := range txEnvs {
+		input, ok := firstInput(txEnv)
+		if !ok {
+			continue
+		}
+		if _, ok := positions[input.Address]; !ok {
+			inputs = append(inputs, input.Address)
+		}
+		positions[input.Address] = append(positions[input.Address], i)
+	}
+	kept := make([]*txs.Envelope, len(txEnvs))
+	for i, txEnv := range txEnvs {
+		if _, ok := firstInput(txEnv); !ok {
+			kept[i] = txEnv
+		}
+	}
+	for _, address := range inputs {
+		acc, err := accounts.GetAccount(address)
+		if err != nil {
+			return nil, fmt.Errorf("could not get input account %v: %w", address, err)
+		}
+		if acc == nil {
+			continue
+		}
+		group := make([]*txs.Envelope, len(positions[address]))
+		for j, i := range positions[address] {
+			group[j] = txEnvs[i]
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			return sequence(group[i]) < sequence(group[j])
+		})
+		// Those that follow on take the earliest positions of the input so its place in the order of arrival is kept
+		next := acc.Sequence + 1
+		j := 0
+		for _, txEnv := range group {
+			if sequence(txEnv) == next {
+				kept[positions[address][j]] = txEnv
+				next++
+				j++
+			}
+		}
+	}
+	ordered := make([]*txs.Envelope, 0, len(txEnvs))
+	for _, txEnv := range kept {
+		if txEnv != nil {
+			ordered = append(ordered, txEnv)
+		}
+	}
+	return OrderByFee(ordered, baseFee, FeeOrderingArrival), nil
+}
+
+// CheckProposal returns an error if any of the transactions of a block proposed on a chain with ProposalOrdering does
+// not verify, or does not follow on from the sequence of the previous transaction of its input. Any proposal from
+// OrderProposal passes.
+func CheckProposal(txEnvs []*txs.Envelope, chainID string) error {
+	sequences := make(map[crypto.Address]uint64)
+	for _, txEnv := range txEnvs {
+		err := txEnv.Verify(chainID)
+		if err != nil {
+			return err
+		}
+		input, ok := firstInput(txEnv)
+		if !ok {
+			continue
+		}
+		if previous, ok := sequences[input.Address]; ok && input.Sequence != previous+1 {
+			return fmt.Errorf("transaction %v from %v has sequence %d but follows a transaction with sequence %d",
+				txEnv.Tx.Hash(), input.Address, input.Sequence, previous)
+		}
+		sequences[input.Address] = input.Sequence
+	}
+	return nil
+}
+
+func firstInput(txEnv *txs.Envelope) (*payload.TxInput, bool) {
+	inputs := txEnv.Tx.GetInputs()
+	if len(inputs) == 0 {
+		return nil, false
+	}
+	return inputs[0], true
+}
+
+func sequence(txEnv *txs.Envelope) uint64 {
+	input, _ := firstInput(txEnv)
+	return input.Sequence
+}
@@ -0,0 +1,55 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderProposal(t *testing.T) {
+	accounts := acmstate.NewMemoryState()
+	for input, sequence := range map[byte]uint64{1: 0, 2: 4, 3: 0} {
+		require.NoError(t, accounts.UpdateAccount(&acm.Account{Address: crypto.Address{input}, Sequence: sequence}))
+	}
+	// Input 1's transactions arrive out of order, input 2 has a gap, input 3 is repeated, and input 4 has no account
+	txEnvs := []*txs.Envelope{
+		feeTxEnv(1, 2, 100, 100, 0),
+		feeTxEnv(2, 5, 100, 100, 0),
+		feeTxEnv(1, 1, 100, 100, 0),
+		feeTxEnv(3, 1, 300, 100, 0),
+		feeTxEnv(2, 7, 100, 100, 0),
+		feeTxEnv(4, 1, 900, 100, 0),
+		feeTxEnv(3, 1, 300, 100, 0),
+		txs.Enclose("chain", &payload.GovTx{}),
+	}
+	ordered, err := OrderProposal(txEnvs, accounts, nil)
+	require.NoError(t, err)
+	// The highest offer goes first, then the others in order of arrival with input 1's in sequence order
+	assert.Equal(t, []*txs.Envelope{txEnvs[3], txEnvs[2], txEnvs[1], txEnvs[0], txEnvs[7]}, ordered)
+}
+
+func TestCheckProposal(t *testing.T) {
+	const chainID = "TestCheckProposal"
+	alice := acm.GeneratePrivateAccountFromSecret("alice")
+	bob := acm.GeneratePrivateAccountFromSecret("bob")
+	signed := func(signer *acm.PrivateAccount, sequence uint64) *txs.Envelope {
+		txEnv := txs.Enclose(chainID, &payload.CallTx{
+			Input:    &payload.TxInput{Address: signer.GetAddress(), Sequence: sequence},
+			GasLimit: 100,
+		})
+		require.NoError(t, txEnv.Sign(signer))
+		return txEnv
+	}
+
+	assert.NoError(t, CheckProposal([]*txs.Envelope{signed(alice, 3), signed(bob, 1), signed(alice, 4)}, chainID))
+	assert.Error(t, CheckProposal([]*txs.Envelope{signed(alice, 4), signed(alice, 3)}, chainID))
+	assert.Error(t, CheckProposal([]*txs.Envelope{signed(alice, 3), signed(alice, 5)}, chainID))
+	// Those that do not verify are refused too
+	assert.Error(t, CheckProposal([]*txs.Envelope{signed(alice, 3)}, "another chain"))
+}
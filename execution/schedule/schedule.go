@@ -0,0 +1,207 @@
+package schedule
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Scheduled calls are kept in the storage of the account at Address so that they can be recorded by the Scheduler
+// native from within the VM and made by the executor at the end of the block in which they are due. Calls due at a
+// height are queued under that height and calls due at a time are queued together in order of time.
+var Address = engine.AddressFromName("ScheduledCalls")
+
+var (
+	nextIDKey    = binary.Zero256
+	timeQueueKey = binary.One256
+)
+
+// Add stores call assigning it the next ID
+func Add(st acmstate.ReaderWriter, call *ScheduledCall) (uint64, error) {
+	if (call.Height == 0) == (call.Time == 0) {
+		return 0, fmt.Errorf("a scheduled call must be due at either a height or a time")
+	}
+	acc, err := st.GetAccount(Address)
+	if err != nil {
+		return 0, err
+	}
+	if acc == nil {
+		err = engine.CreateAccount(st, Address)
+		if err != nil {
+			return 0, err
+		}
+	}
+	bs, err := st.GetStorage(Address, nextIDKey)
+	if err != nil {
+		return 0, err
+	}
+	// IDs start from 1
+	call.ID = binary.Uint64FromWord256(binary.LeftPadWord256(bs)) + 1
+	err = st.SetStorage(Address, nextIDKey, binary.Uint64ToWord256(call.ID).Bytes())
+	if err != nil {
+		return 0, err
+	}
+	err = set(st, callKey(call.ID), call)
+	if err != nil {
+		return 0, err
+	}
+	key := queueKey(call)
+	queue, err := getQueue(st, key)
+	if err != nil {
+		return 0, err
+	}
+	// Calls at the same time are made in the order they were scheduled
+	i := sort.Search(len(queue.Calls), func(i int) bool {
+		return queue.Calls[i].Time > call.Time
+	})
+	queue.Calls = append(queue.Calls, ScheduledCallRef{})
+	copy(queue.Calls[i+1:], queue.Calls[i:])
+	queue.Calls[i] = ScheduledCallRef{ID: call.ID, Time: call.Time}
+	return call.ID, set(st, key, queue)
+}
+
+// Get returns the scheduled call with id or nil if there is no such call
+func Get(st acmstate.Reader, id uint64) (*ScheduledCall, error) {
+	bs, err := st.GetStorage(Address, callKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	call := new(ScheduledCall)
+	err = encoding.Decode(bs, call)
+	if err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// Remove removes the scheduled call with id so that it will not be made
+func Remove(st acmstate.ReaderWriter, id uint64) error {
+	call, err := Get(st, id)
+	if err != nil {
+		return err
+	}
+	if call == nil {
+		return fmt.Errorf("no call scheduled with ID %d", id)
+	}
+	key := queueKey(call)
+	queue, err := getQueue(st, key)
+	if err != nil {
+		return err
+	}
+	for i, ref := range queue.Calls {
+		if ref.ID == id {
+			queue.Calls = append(queue.Calls[:i], queue.Calls[i+1:]...)
+			break
+		}
+	}
+	err = set(st, key, queue)
+	if err != nil {
+		return err
+	}
+	return st.SetStorage(Address, callKey(id), nil)
+}
+
+// Due removes and returns the calls due at height and time in the order they should be made, first those scheduled for
+// the height and then those scheduled for a time at or before time
+func Due(st acmstate.ReaderWriter, height, time uint64) ([]*ScheduledCall, error) {
+	acc, err := st.GetAccount(Address)
+	if err != nil || acc == nil {
+		// Nothing has ever been scheduled
+		return nil, err
+	}
+	heightQueue, err := getQueue(st, heightKey(height))
+	if err != nil {
+		return nil, err
+	}
+	timeQueue, err := getQueue(st, timeQueueKey)
+	if err != nil {
+		return nil, err
+	}
+	n := sort.Search(len(timeQueue.Calls), func(i int) bool {
+		return timeQueue.Calls[i].Time > time
+	})
+	refs := append(heightQueue.Calls, timeQueue.Calls[:n]...)
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	calls := make([]*ScheduledCall, len(refs))
+	for i, ref := range refs {
+		calls[i], err = Get(st, ref.ID)
+		if err != nil {
+			return nil, err
+		}
+		err = st.SetStorage(Address, callKey(ref.ID), nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = st.SetStorage(Address, heightKey(height), nil)
+	if err != nil {
+		return nil, err
+	}
+	timeQueue.Calls = timeQueue.Calls[n:]
+	return calls, set(st, timeQueueKey, timeQueue)
+}
+
+func getQueue(st acmstate.Reader, key binary.Word256) (*ScheduledCallQueue, error) {
+	queue := new(ScheduledCallQueue)
+	bs, err := st.GetStorage(Address, key)
+	if err != nil {
+		return nil, err
+	}
+	return queue, encoding.Decode(bs, queue)
+}
+
+// An empty message encodes to nothing which removes the value from storage
+func set(st acmstate.Writer, key binary.Word256, msg proto.Message) error {
+	bs, err := encoding.Encode(msg)
+	if err != nil {
+		return err
+	}
+	return st.SetStorage(Address, key, bs)
+}
+
+func queueKey(call *ScheduledCall) binary.Word256 {
+	if call.Height != 0 {
+		return heightKey(call.Height)
+	}
+	return timeQueueKey
+}
+
+func callKey(id uint64) binary.Word256 {
+	return hashKey("call", id)
+}
+
+func heightKey(height uint64) binary.Word256 {
+	return hashKey("height", height)
+}
+
+func hashKey(prefix string, n uint64) binary.Word256 {
+	return binary.LeftPadWord256(crypto.Keccak256(append([]byte(prefix), binary.Uint64ToWord256(n).Bytes()...)))
+}
+
+// Envelope returns the unsigned transaction recording the call in the block in which it is made. Its input is the
+// account holding scheduled calls with the ID of the call as its sequence so that its hash is unique.
+func (call *ScheduledCall) Envelope(chainID string) *txs.Envelope {
+	callee := call.Callee
+	return txs.Enclose(chainID, &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  Address,
+			Sequence: call.ID,
+		},
+		Address:  &callee,
+		GasLimit: call.GasLimit,
+		Data:     call.Data,
+	})
+}
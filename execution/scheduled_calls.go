@@ -0,0 +1,83 @@
+package execution
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/schedule"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Make the calls scheduled with the Scheduler native that are due in the block being executed, each is recorded as a
+// transaction at the end of the block whether or not it succeeds
+func (exe *executor) makeScheduledCalls() error {
+	calls, err := schedule.Due(exe.stateCache, exe.block.Height, uint64(exe.blockchain.LastBlockTime().Unix()))
+	if err != nil {
+		return err
+	}
+	for _, call := range calls {
+		err = exe.makeScheduledCall(call)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exe *executor) makeScheduledCall(call *schedule.ScheduledCall) error {
+	txe := exe.block.Tx(call.Envelope(exe.params.ChainID))
+	if exe.accesses != nil {
+		exe.accesses.reset()
+		defer func() {
+			txe.AccessSet = exe.accesses.accessSet()
+		}()
+	}
+	logger := exe.logger.With(structure.TxHashKey, txe.TxHash, "scheduled_call_id", call.ID)
+	callContext, ok := exe.contexts[payload.TypeCall].(*contexts.CallContext)
+	if !ok {
+		return fmt.Errorf("cannot make scheduled call %d without a CallContext", call.ID)
+	}
+	cache := acmstate.NewCache(exe.txState, acmstate.Named("ScheduledCallCache"))
+	acc, err := cache.GetAccount(call.Callee)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		exception := errors.Errorf(errors.Codes.InvalidAddress,
+			"scheduled call %d to an address (%v) that does not exist", call.ID, call.Callee)
+		logger.InfoMsg("Scheduled call failed", structure.ErrorKey, exception)
+		txe.PushError(exception)
+		return nil
+	}
+	gas := new(big.Int).SetUint64(call.GasLimit)
+	params := engine.CallParams{
+		Origin: call.Caller,
+		Caller: call.Caller,
+		Callee: call.Callee,
+		Input:  call.Data,
+		Gas:    gas,
+	}
+	var ret []byte
+	if len(acc.WASMCode) != 0 {
+		ret, err = callContext.VMS.WVM.Execute(cache, exe.blockchain, txe, params, acc.WASMCode)
+	} else {
+		callContext.VMS.EVM.SetNonce(txe.TxHash)
+		ret, err = callContext.VMS.EVM.Execute(cache, exe.blockchain, txe, params, acc.EVMCode)
+	}
+	if err != nil {
+		logger.InfoMsg("Scheduled call failed", structure.ErrorKey, err)
+		txe.PushError(err)
+	} else {
+		err = cache.Sync(exe.txState)
+		if err != nil {
+			return err
+		}
+	}
+	txe.Return(ret, call.GasLimit-gas.Uint64())
+	return nil
+}
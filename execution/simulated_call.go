@@ -1,13 +1,19 @@
 package execution
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/execution/vms"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs"
@@ -18,25 +24,101 @@ import (
 // Cannot be used to create new contracts
 func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, data []byte,
 	logger *logging.Logger) (*exec.TxExecution, error) {
-	cache := acmstate.NewCache(reader)
+	return callSim(acmstate.NewCache(reader), acmstate.NewMemoryState(), blockchain,
+		simulatedGasSchedule(reader, blockchain), nil, simulatedCallTx(fromAddress, address, data), logger)
+}
+
+// Run a contract's code on an isolated and unpersisted state as CallSim does while profiling the gas used
+func CallSimProfile(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address,
+	data []byte, logger *logging.Logger) (*exec.TxExecution, *evm.GasProfiler, error) {
+	profiler := evm.NewGasProfiler()
+	txe, err := callSim(acmstate.NewCache(reader), acmstate.NewMemoryState(), blockchain,
+		simulatedGasSchedule(reader, blockchain), profiler, simulatedCallTx(fromAddress, address, data), logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	return txe, profiler, nil
+}
+
+// Run a call on an isolated and unpersisted state as CallSim does, but with the value and gas limit of tx, tracing the
+// call frames it failed in should it fail
+func CallSimRevertTrace(reader acmstate.Reader, blockchain bcm.BlockchainInfo, tx *payload.CallTx,
+	logger *logging.Logger) (*exec.TxExecution, *evm.RevertTracer, error) {
+	if tx.Input == nil || tx.Address == nil {
+		return nil, nil, fmt.Errorf("CallSimRevertTrace requires a call with a non-nil input and address")
+	}
+	simTx := simulatedCallTx(tx.Input.Address, *tx.Address, tx.Data)
+	simTx.Input.Amount = tx.Input.Amount
+	if tx.GasLimit != 0 {
+		simTx.GasLimit = tx.GasLimit
+	}
+	tracer := evm.NewRevertTracer()
+	txe, err := callSim(acmstate.NewCache(reader), acmstate.NewMemoryState(), blockchain,
+		simulatedGasSchedule(reader, blockchain), tracer, simTx, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	return txe, tracer, nil
+}
+
+// Run each of the calls in order on a single isolated and unpersisted state so that each call sees the changes made by
+// those before it. Returns the TxExecution of each call along with the changes it made to state.
+// Cannot be used to create new contracts
+func CallSimBundle(reader acmstate.Reader, blockchain bcm.BlockchainInfo, callTxs []*payload.CallTx,
+	logger *logging.Logger) ([]*exec.TxExecution, []*exec.StateDiff, error) {
+	bundleCache := acmstate.NewCache(reader)
+	schedule := simulatedGasSchedule(reader, blockchain)
+	metadataState := acmstate.NewMemoryState()
+	txes := make([]*exec.TxExecution, len(callTxs))
+	diffs := make([]*exec.StateDiff, len(callTxs))
+	for i, callTx := range callTxs {
+		if callTx.Input == nil || callTx.Address == nil {
+			return nil, nil, fmt.Errorf("call %d in bundle requires a non-nil input and address", i)
+		}
+		callCache := acmstate.NewCache(bundleCache)
+		txe, err := callSim(callCache, metadataState, blockchain, schedule, nil,
+			simulatedCallTx(callTx.Input.Address, *callTx.Address, callTx.Data), logger)
+		if err != nil {
+			return nil, nil, fmt.Errorf("call %d in bundle failed: %w", i, err)
+		}
+		// Record the changes before applying them to the bundle state so that storage that was only read can be
+		// filtered out
+		diff := new(exec.StateDiff)
+		err = callCache.Sync(&changedStorageWriter{Writer: diff, reader: bundleCache})
+		if err != nil {
+			return nil, nil, err
+		}
+		err = callCache.Sync(bundleCache)
+		if err != nil {
+			return nil, nil, err
+		}
+		txes[i] = txe
+		diffs[i] = diff
+	}
+	return txes, diffs, nil
+}
+
+func callSim(st acmstate.ReaderWriter, metadataState acmstate.MetadataReaderWriter, blockchain bcm.BlockchainInfo,
+	schedule *gas.Schedule, tracer evm.Tracer, tx *payload.CallTx, logger *logging.Logger) (*exec.TxExecution, error) {
 	exe := contexts.CallContext{
-		VMS:           vms.NewConnectedVirtualMachines(engine.Options{}),
+		VMS: vms.NewConnectedVirtualMachines(engine.Options{
+			CancunHeight: blockchain.GenesisDoc().Params.CancunHeight,
+			CallTree:     blockchain.GenesisDoc().Params.CallTree,
+			GasRefunds:   blockchain.GenesisDoc().Params.GasRefunds,
+			SelfDestruct: engine.SelfDestructPolicy(blockchain.GenesisDoc().Params.SelfDestruct),
+			GasSchedule: func() *gas.Schedule {
+				return schedule
+			},
+		}),
 		RunCall:       true,
-		State:         cache,
-		MetadataState: acmstate.NewMemoryState(),
+		State:         st,
+		MetadataState: metadataState,
 		Blockchain:    blockchain,
 		Logger:        logger,
 	}
+	exe.VMS.SetTracer(tracer)
 
-	txe := exec.NewTxExecution(txs.Enclose(blockchain.ChainID(),
-		&payload.CallTx{
-			Input: &payload.TxInput{
-				Address: fromAddress,
-			},
-			Address:  &address,
-			Data:     data,
-			GasLimit: contexts.GasLimit,
-		}))
+	txe := exec.NewTxExecution(txs.Enclose(blockchain.ChainID(), tx))
 
 	// Set height for downstream synchronisation purposes
 	txe.Height = blockchain.LastBlockHeight()
@@ -62,5 +144,46 @@ func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddr
 	if err != nil {
 		return nil, err
 	}
-	return CallSim(cache, blockchain, fromAddress, address, data, logger)
+	return callSim(acmstate.NewCache(cache), acmstate.NewMemoryState(), blockchain,
+		simulatedGasSchedule(reader, blockchain), nil, simulatedCallTx(fromAddress, address, data), logger)
+}
+
+func simulatedCallTx(fromAddress, address crypto.Address, data []byte) *payload.CallTx {
+	return &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: fromAddress,
+		},
+		Address:  &address,
+		Data:     data,
+		GasLimit: contexts.GasLimit,
+	}
+}
+
+// Simulated calls use the gas schedule of the next block when the state they read from can provide it, otherwise
+// they fall back to the genesis schedule
+func simulatedGasSchedule(reader acmstate.Reader, blockchain bcm.BlockchainInfo) *gas.Schedule {
+	if gasReader, ok := reader.(gas.Reader); ok {
+		schedule, err := GasScheduleAtHeight(gasReader, blockchain.LastBlockHeight()+1)
+		if err == nil {
+			return schedule
+		}
+	}
+	return blockchain.GenesisDoc().Params.GasSchedule
+}
+
+// Passes through only those storage writes that change the value held by reader
+type changedStorageWriter struct {
+	acmstate.Writer
+	reader acmstate.Reader
+}
+
+func (w *changedStorageWriter) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
+	prev, err := w.reader.GetStorage(address, key)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(prev, value) {
+		return nil
+	}
+	return w.Writer.SetStorage(address, key, value)
 }
@@ -9,15 +9,20 @@ import (
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/solidity"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
 	"golang.org/x/sync/errgroup"
@@ -129,3 +134,207 @@ func TestCallSimDelegate(t *testing.T) {
 
 	require.NoError(t, g.Wait())
 }
+
+func TestCallSimBundle(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+
+	from := crypto.PrivateKeyFromSecret("bundler", crypto.CurveTypeEd25519)
+	contractAddress := crypto.Address{1, 2, 3, 4, 5}
+	blockchain := &bcm.Blockchain{}
+
+	_, _, err = st.Update(func(up state.Updatable) error {
+		err = up.UpdateAccount(&acm.Account{
+			Address:     from.GetAddress(),
+			PublicKey:   from.GetPublicKey(),
+			Balance:     9999999,
+			Permissions: permission.DefaultAccountPermissions,
+		})
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(&acm.Account{
+			Address: contractAddress,
+			EVMCode: solidity.DeployedBytecode_DelegateProxy,
+		})
+	})
+	require.NoError(t, err)
+
+	delegate := crypto.Address{0xBE, 0xEF, 0, 0xFA, 0xCE}
+	setDelegateCall, _, err := abi.EncodeFunctionCall(string(solidity.Abi_DelegateProxy), "setDelegate", logger,
+		delegate)
+	require.NoError(t, err)
+	getDelegateCall, _, err := abi.EncodeFunctionCall(string(solidity.Abi_DelegateProxy), "getDelegate", logger)
+	require.NoError(t, err)
+
+	callTx := func(data []byte) *payload.CallTx {
+		return &payload.CallTx{
+			Input:   &payload.TxInput{Address: from.GetAddress()},
+			Address: &contractAddress,
+			Data:    data,
+		}
+	}
+
+	txes, diffs, err := CallSimBundle(st, blockchain, []*payload.CallTx{
+		callTx(getDelegateCall),
+		callTx(setDelegateCall),
+		callTx(getDelegateCall),
+	}, logger)
+	require.NoError(t, err)
+	require.Len(t, txes, 3)
+	require.Len(t, diffs, 3)
+	for _, txe := range txes {
+		require.NoError(t, txe.GetException().AsError())
+	}
+
+	// The second get sees the delegate set within the bundle
+	assert.Equal(t, crypto.ZeroAddress.Bytes(), txes[0].GetResult().Return[12:])
+	assert.Equal(t, delegate.Bytes(), txes[2].GetResult().Return[12:])
+
+	// Only the set changes storage
+	assert.Empty(t, diffs[0].Storage)
+	require.Len(t, diffs[1].Storage, 1)
+	assert.Equal(t, contractAddress, diffs[1].Storage[0].Address)
+	assert.Empty(t, diffs[2].Storage)
+
+	// Nothing is persisted
+	txe, err := CallSim(st, blockchain, from.GetAddress(), contractAddress, getDelegateCall, logger)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.ZeroAddress.Bytes(), txe.GetResult().Return[12:])
+}
+
+func TestCallSimRevertTrace(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+
+	from := crypto.PrivateKeyFromSecret("reverter", crypto.CurveTypeEd25519)
+	contractAddress := crypto.Address{1, 2, 3, 4, 5}
+	blockchain := &bcm.Blockchain{}
+
+	_, _, err = st.Update(func(up state.Updatable) error {
+		err = up.UpdateAccount(&acm.Account{
+			Address:     from.GetAddress(),
+			PublicKey:   from.GetPublicKey(),
+			Balance:     9999999,
+			Permissions: permission.DefaultAccountPermissions,
+		})
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(&acm.Account{
+			Address: contractAddress,
+			EVMCode: []byte{byte(asm.PUSH1), 0, byte(asm.PUSH1), 0, byte(asm.REVERT)},
+		})
+	})
+	require.NoError(t, err)
+
+	txe, tracer, err := CallSimRevertTrace(st, blockchain, &payload.CallTx{
+		Input:    &payload.TxInput{Address: from.GetAddress()},
+		Address:  &contractAddress,
+		GasLimit: 10000,
+	}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, errors.Codes.ExecutionReverted, txe.GetException().ErrorCode())
+	assert.Equal(t, []evm.RevertFrame{{CodeAddress: contractAddress, PC: 4, OpCode: asm.REVERT}}, tracer.Trace())
+
+	_, _, err = CallSimRevertTrace(st, blockchain, &payload.CallTx{
+		Input: &payload.TxInput{Address: from.GetAddress()},
+	}, logger)
+	assert.Error(t, err, "cannot trace creation")
+}
+
+func TestEstimateGas(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+
+	from := crypto.PrivateKeyFromSecret("estimator", crypto.CurveTypeEd25519)
+	contractAddress := crypto.Address{1, 2, 3, 4, 5}
+	recipient := crypto.Address{9}
+	blockchain := &bcm.Blockchain{}
+
+	_, _, err = st.Update(func(up state.Updatable) error {
+		err = up.UpdateAccount(&acm.Account{
+			Address:     from.GetAddress(),
+			PublicKey:   from.GetPublicKey(),
+			Permissions: permission.DefaultAccountPermissions,
+		})
+		if err != nil {
+			return err
+		}
+		err = up.UpdateAccount(&acm.Account{Address: recipient})
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(&acm.Account{
+			Address: contractAddress,
+			EVMCode: solidity.DeployedBytecode_DelegateProxy,
+		})
+	})
+	require.NoError(t, err)
+
+	setDelegateCall, _, err := abi.EncodeFunctionCall(string(solidity.Abi_DelegateProxy), "setDelegate", logger,
+		crypto.Address{0xBE, 0xEF})
+	require.NoError(t, err)
+	transferTx := &payload.CallTx{
+		Input:   &payload.TxInput{Address: from.GetAddress(), Amount: 1000},
+		Address: &recipient,
+	}
+
+	// The sender cannot pay the amount
+	_, txe, err := EstimateGas(st, blockchain, transferTx, nil, logger)
+	require.NoError(t, err)
+	require.NotNil(t, txe)
+	assert.Equal(t, errors.Codes.InsufficientBalance, txe.Exception.ErrorCode())
+
+	// Unless given a balance
+	var balance uint64 = 9999999
+	overrides := map[crypto.Address]*StateOverride{
+		from.GetAddress(): {Balance: &balance},
+	}
+	_, txe, err = EstimateGas(st, blockchain, transferTx, overrides, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe)
+
+	callTx := &payload.CallTx{
+		Input:   &payload.TxInput{Address: from.GetAddress()},
+		Address: &contractAddress,
+		Data:    setDelegateCall,
+	}
+	estimate, txe, err := EstimateGas(st, blockchain, callTx, overrides, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe)
+
+	call := func(gasLimit uint64) *exec.TxExecution {
+		tx := *callTx
+		tx.GasLimit = gasLimit
+		st, err := overrideState(st, overrides)
+		require.NoError(t, err)
+		txe, err := callSim(acmstate.NewCache(st), acmstate.NewMemoryState(), blockchain, genesisDoc.Params.GasSchedule,
+			nil, &tx, logger)
+		require.NoError(t, err)
+		return txe
+	}
+	txe = call(estimate)
+	require.NoError(t, txe.GetException().AsError())
+	assert.LessOrEqual(t, txe.Result.GasUsed, estimate)
+	// The estimate is close to the least gas with which the call succeeds
+	assert.Error(t, call(estimate*97/100).GetException().AsError())
+
+	// Fails with the gas limit given
+	callTx.GasLimit = estimate / 2
+	estimate, txe, err = EstimateGas(st, blockchain, callTx, overrides, logger)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), estimate)
+	require.NotNil(t, txe)
+	assert.Equal(t, errors.Codes.InsufficientGas, txe.Exception.ErrorCode())
+
+	// Storage can be overridden wholly or in part but not both
+	overrides[contractAddress] = &StateOverride{Storage: map[binary.Word256][]byte{}}
+	callTx.GasLimit = 0
+	_, txe, err = EstimateGas(st, blockchain, callTx, overrides, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe)
+	overrides[contractAddress].StorageDiff = map[binary.Word256][]byte{}
+	_, _, err = EstimateGas(st, blockchain, callTx, overrides, logger)
+	require.Error(t, err)
+}
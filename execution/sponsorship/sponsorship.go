@@ -0,0 +1,61 @@
+package sponsorship
+
+import (
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/engine"
+)
+
+// Sponsorships are kept in the storage of the account at Address, keyed by the sponsored account, so that they can be
+// arranged by the Sponsorship native from within the VM and applied by the CallContext when charging fees
+var Address = engine.AddressFromName("Sponsorships")
+
+// Get returns the sponsorship of account or nil if it has none
+func Get(st acmstate.Reader, account crypto.Address) (*Sponsorship, error) {
+	bs, err := st.GetStorage(Address, key(account))
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	sponsorship := new(Sponsorship)
+	err = encoding.Decode(bs, sponsorship)
+	if err != nil {
+		return nil, err
+	}
+	return sponsorship, nil
+}
+
+// Set stores the sponsorship of account, or removes it if sponsorship is nil
+func Set(st acmstate.ReaderWriter, account crypto.Address, sponsorship *Sponsorship) error {
+	if sponsorship == nil {
+		return st.SetStorage(Address, key(account), nil)
+	}
+	acc, err := st.GetAccount(Address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		err = engine.CreateAccount(st, Address)
+		if err != nil {
+			return err
+		}
+	}
+	bs, err := encoding.Encode(sponsorship)
+	if err != nil {
+		return err
+	}
+	return st.SetStorage(Address, key(account), bs)
+}
+
+// Covers returns whether the sponsorship pays, or waives, fees of amount
+func (s *Sponsorship) Covers(amount uint64) bool {
+	return s.Waived || s.Allowance >= amount
+}
+
+func key(account crypto.Address) binary.Word256 {
+	return binary.LeftPadWord256(account.Bytes())
+}
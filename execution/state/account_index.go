@@ -0,0 +1,101 @@
+package state
+
+import (
+	"bytes"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// Accounts are indexed by public key and code hash so that, for instance, the contracts deployed by a factory can be
+// found. Entries are not removed when an account is removed or its code changes, since state may be rolled back to
+// before then, so each entry is checked against state when read.
+
+// Indexes account by its public key and code hash
+func (ws *writeState) indexAccount(account *acm.Account) error {
+	for _, key := range accountIndexKeys(account) {
+		has, err := ws.plain.Has(key)
+		if err != nil {
+			return err
+		}
+		if !has {
+			err = ws.plain.Set(key, []byte{})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func accountIndexKeys(account *acm.Account) [][]byte {
+	var indexKeys [][]byte
+	if account.PublicKey != nil && len(account.PublicKey.PublicKey) > 0 {
+		indexKeys = append(indexKeys, keys.PublicKey.Key(account.PublicKey.GetAddress(), account.Address))
+	}
+	if len(account.CodeHash) == binary.Word256Bytes {
+		indexKeys = append(indexKeys, keys.CodeHash.Key([]byte(account.CodeHash), account.Address))
+	}
+	return indexKeys
+}
+
+// Indexes the accounts in state if they have not been already, as when state was written before accounts were indexed
+func (s *State) ensureAccountIndex() error {
+	indexed, err := s.Plain.Has(keys.Indexed.Key())
+	if err != nil || indexed {
+		return err
+	}
+	err = s.IterateAccounts(s.writeState.indexAccount)
+	if err != nil {
+		return err
+	}
+	return s.Plain.Set(keys.Indexed.Key(), []byte{})
+}
+
+// IterateAccountsByPublicKey passes consumer each account with publicKey
+func (s *ReadState) IterateAccountsByPublicKey(publicKey *crypto.PublicKey, consumer func(*acm.Account) error) error {
+	return s.iterateIndexedAccounts(keys.PublicKey.Fix(publicKey.GetAddress()), func(acc *acm.Account) bool {
+		return acc.PublicKey != nil && acc.PublicKey.GetAddress() == publicKey.GetAddress()
+	}, consumer)
+}
+
+// IterateAccountsByCodeHash passes consumer each account whose code has codeHash, such as the contracts created by a
+// factory
+func (s *ReadState) IterateAccountsByCodeHash(codeHash []byte, consumer func(*acm.Account) error) error {
+	if len(codeHash) != binary.Word256Bytes {
+		return nil
+	}
+	return s.iterateIndexedAccounts(keys.CodeHash.Fix(codeHash), func(acc *acm.Account) bool {
+		return bytes.Equal(acc.CodeHash, codeHash)
+	}, consumer)
+}
+
+func (s *ReadState) iterateIndexedAccounts(keyFormat *storage.MustKeyFormat, matches func(*acm.Account) bool,
+	consumer func(*acm.Account) error) error {
+	it, err := keyFormat.Iterator(s.Plain, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		address, err := crypto.AddressFromBytes(it.Key())
+		if err != nil {
+			return err
+		}
+		acc, err := s.GetAccount(address)
+		if err != nil {
+			return err
+		}
+		// The entry may be stale
+		if acc == nil || !matches(acc) {
+			continue
+		}
+		err = consumer(acc)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
@@ -0,0 +1,90 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestAccountIndex(t *testing.T) {
+	db := dbm.NewMemDB()
+	s := NewState(db)
+	user := acm.NewAccountFromSecret("user")
+	code := acm.Bytecode{1, 2, 3}
+	var contracts []*acm.Account
+	for i := 0; i < 3; i++ {
+		contract := &acm.Account{
+			Address:  crypto.NewContractAddress(user.Address, []byte{byte(i)}),
+			EVMCode:  code,
+			CodeHash: crypto.Keccak256(code),
+		}
+		contracts = append(contracts, contract)
+	}
+	_, _, err := s.Update(func(ws Updatable) error {
+		err := ws.UpdateAccount(user)
+		if err != nil {
+			return err
+		}
+		for _, contract := range contracts {
+			err = ws.UpdateAccount(contract)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	byPublicKey := func() []crypto.Address {
+		var addresses []crypto.Address
+		err := s.IterateAccountsByPublicKey(user.PublicKey, func(acc *acm.Account) error {
+			addresses = append(addresses, acc.Address)
+			return nil
+		})
+		require.NoError(t, err)
+		return addresses
+	}
+	byCodeHash := func() []crypto.Address {
+		var addresses []crypto.Address
+		err := s.IterateAccountsByCodeHash(crypto.Keccak256(code), func(acc *acm.Account) error {
+			addresses = append(addresses, acc.Address)
+			return nil
+		})
+		require.NoError(t, err)
+		return addresses
+	}
+	assert.Equal(t, []crypto.Address{user.Address}, byPublicKey())
+	assert.ElementsMatch(t, []crypto.Address{contracts[0].Address, contracts[1].Address, contracts[2].Address},
+		byCodeHash())
+
+	// Stale entries are skipped
+	_, _, err = s.Update(func(ws Updatable) error {
+		return ws.RemoveAccount(contracts[0].Address)
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []crypto.Address{contracts[1].Address, contracts[2].Address}, byCodeHash())
+
+	// State written before accounts were indexed is indexed when loaded
+	for _, prefix := range []storage.Prefix{keys.PublicKey.Prefix(), keys.CodeHash.Prefix(), keys.Indexed.Prefix()} {
+		it, err := s.Plain.Iterator(prefix, prefix.Above())
+		require.NoError(t, err)
+		var indexKeys [][]byte
+		for ; it.Valid(); it.Next() {
+			indexKeys = append(indexKeys, it.Key())
+		}
+		require.NoError(t, it.Close())
+		for _, key := range indexKeys {
+			require.NoError(t, s.Plain.Delete(key))
+		}
+	}
+	assert.Empty(t, byCodeHash())
+	s, err = LoadState(db, s.Version())
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.Address{user.Address}, byPublicKey())
+	assert.ElementsMatch(t, []crypto.Address{contracts[1].Address, contracts[2].Address}, byCodeHash())
+}
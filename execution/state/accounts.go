@@ -65,7 +65,7 @@ func (ws *writeState) UpdateAccount(account *acm.Account) error {
 		if updated {
 			ws.statsAddAccount(account)
 		}
-		return nil
+		return ws.indexAccount(account)
 	})
 }
 
@@ -139,22 +139,26 @@ func (ws *writeState) SetStorage(address crypto.Address, key binary.Word256, val
 }
 
 func (s *ImmutableState) IterateStorage(address crypto.Address, consumer func(key binary.Word256, value []byte) error) error {
+	return s.IterateStorageFrom(address, binary.Zero256, consumer)
+}
+
+// Iterates through the storage of the account at address in order of key starting from the key start
+func (s *ImmutableState) IterateStorageFrom(address crypto.Address, start binary.Word256,
+	consumer func(key binary.Word256, value []byte) error) error {
 	keyFormat := keys.Storage.Fix(address)
 	tree, err := s.Forest.Reader(keyFormat.Prefix())
 	if err != nil {
 		return err
 	}
-	return tree.Iterate(nil, nil, true,
+	return tree.Iterate(start.Bytes(), nil, true,
 		func(key []byte, value []byte) error {
 
 			if len(key) != binary.Word256Bytes {
 				return fmt.Errorf("key '%X' stored for account %s is not a %v-byte word",
 					key, address, binary.Word256Bytes)
 			}
-			if len(value) != binary.Word256Bytes {
-				return fmt.Errorf("value '%X' stored for account %s is not a %v-byte word",
-					key, address, binary.Word256Bytes)
-			}
+			// Values are whatever SetStorage was given, which is a word from the EVM but may be longer from native
+			// contracts, so we pass them on as they are just as IterateStorageChanges does
 			return consumer(binary.LeftPadWord256(key), value)
 		})
 }
@@ -0,0 +1,35 @@
+package state
+
+import (
+	"github.com/hyperledger/burrow/storage"
+)
+
+// CacheConfig sizes the caches of state, each of which is 1024 unless set
+type CacheConfig struct {
+	// The number of trees of state kept loaded. Accounts are kept in one tree and the storage of each contract in
+	// another, so this should cover the contracts called most.
+	Trees int `json:",omitempty" toml:",omitempty"`
+	// The number of nodes of each tree kept in memory. The larger the storage of the contracts called most the more
+	// nodes are needed to avoid reading them from the database.
+	TreeNodes int `json:",omitempty" toml:",omitempty"`
+}
+
+// SetCacheConfig resizes the caches of state. Trees already loaded keep their node caches until they are next loaded so
+// this is best called just after loading state.
+func (s *State) SetCacheConfig(config *CacheConfig) {
+	trees, nodes := defaultCacheCapacity, defaultCacheCapacity
+	if config != nil {
+		if config.Trees > 0 {
+			trees = config.Trees
+		}
+		if config.TreeNodes > 0 {
+			nodes = config.TreeNodes
+		}
+	}
+	s.writeState.forest.SetCacheSizes(trees, nodes)
+}
+
+// CacheStats returns the sizes of the caches of state and how often they have been used
+func (s *State) CacheStats() storage.CacheStats {
+	return s.writeState.forest.CacheStats()
+}
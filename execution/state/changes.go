@@ -0,0 +1,130 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// IterateAccountChanges calls set with each account written since base, an earlier version of the same state, and
+// removed with the address of each account removed since
+func (s *ImmutableState) IterateAccountChanges(base *ImmutableState, set func(*acm.Account) error,
+	removed func(crypto.Address) error) error {
+	forest, baseForest, err := s.forests(base)
+	if err != nil {
+		return err
+	}
+	return forest.IterateChanges(baseForest, keys.Account.Prefix(),
+		func(key []byte, value []byte) error {
+			account := new(acm.Account)
+			err := encoding.Decode(value, account)
+			if err != nil {
+				return fmt.Errorf("IterateAccountChanges could not decode account: %v", err)
+			}
+			return set(account)
+		},
+		func(key []byte) error {
+			address, err := crypto.AddressFromBytes(key)
+			if err != nil {
+				return err
+			}
+			return removed(address)
+		})
+}
+
+// IterateStorageChanges calls consumer with each key of the storage of each account that still exists whose value has
+// been set since base, an earlier version of the same state. The value is nil for keys that have been deleted since.
+func (s *ImmutableState) IterateStorageChanges(base *ImmutableState,
+	consumer func(address crypto.Address, key binary.Word256, value []byte) error) error {
+	forest, baseForest, err := s.forests(base)
+	if err != nil {
+		return err
+	}
+	return forest.IterateChangedTrees(baseForest, keys.Storage.Prefix(), func(treePrefix []byte) error {
+		address, err := crypto.AddressFromBytes(keys.Storage.Prefix().Suffix(treePrefix))
+		if err != nil {
+			return err
+		}
+		// The storage of removed accounts is removed with them
+		account, err := s.GetAccount(address)
+		if err != nil || account == nil {
+			return err
+		}
+		return forest.IterateChanges(baseForest, treePrefix,
+			func(key []byte, value []byte) error {
+				return consumer(address, binary.LeftPadWord256(key), value)
+			},
+			func(key []byte) error {
+				return consumer(address, binary.LeftPadWord256(key), nil)
+			})
+	})
+}
+
+// IterateNameChanges calls set with each name entry written since base, an earlier version of the same state, and
+// removed with each name removed since
+func (s *ImmutableState) IterateNameChanges(base *ImmutableState, set func(*names.Entry) error,
+	removed func(name string) error) error {
+	forest, baseForest, err := s.forests(base)
+	if err != nil {
+		return err
+	}
+	baseTree, err := base.Forest.Reader(keys.Name.Prefix())
+	if err != nil {
+		return err
+	}
+	decode := func(value []byte) (*names.Entry, error) {
+		entry := new(names.Entry)
+		err := encoding.Decode(value, entry)
+		if err != nil {
+			return nil, fmt.Errorf("IterateNameChanges could not decode name entry: %v", err)
+		}
+		return entry, nil
+	}
+	return forest.IterateChanges(baseForest, keys.Name.Prefix(),
+		func(key []byte, value []byte) error {
+			entry, err := decode(value)
+			if err != nil {
+				return err
+			}
+			return set(entry)
+		},
+		func(key []byte) error {
+			// Read the name from its entry rather than decode it from the key
+			value, err := baseTree.Get(key)
+			if err != nil {
+				return err
+			}
+			entry, err := decode(value)
+			if err != nil {
+				return err
+			}
+			return removed(entry.Name)
+		})
+}
+
+func (s *ImmutableState) forests(base *ImmutableState) (*storage.ImmutableForest, *storage.ImmutableForest, error) {
+	forest, err := immutableForest(s.Forest)
+	if err != nil {
+		return nil, nil, err
+	}
+	baseForest, err := immutableForest(base.Forest)
+	if err != nil {
+		return nil, nil, err
+	}
+	return forest, baseForest, nil
+}
+
+func immutableForest(forest storage.ForestReader) (*storage.ImmutableForest, error) {
+	switch f := forest.(type) {
+	case *storage.ImmutableForest:
+		return f, nil
+	case *storage.MutableForest:
+		return &f.ImmutableForest, nil
+	}
+	return nil, fmt.Errorf("cannot iterate over the changes to forest of type %T", forest)
+}
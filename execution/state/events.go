@@ -11,31 +11,39 @@ import (
 )
 
 func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
-	// If there are no transactions, do not store anything. This reduces the amount of data we store and
+	// If there are neither transactions nor evidence, do not store anything. This reduces the amount of data we store and
 	// prevents the iavl tree from changing, which means the AppHash does not change. If the AppHash changes then
 	// Tendermint will always produce another block. If we change the AppHash on empty blocks then we will continue
 	// creating empty blocks even if we have been configure to not do so.
 	// TODO: we would prefer not to do this and instead store sequential monotonic blocks, once this:
 	// https://github.com/tendermint/tendermint/issues/1909 is resolved we should be able to suppress empty blocks
 	// even when the AppHash changes
-	if len(be.TxExecutions) == 0 {
+	if be.Empty() {
 		return nil
 	}
+	err := ws.indexEvidence(be)
+	if err != nil {
+		return err
+	}
+	err = ws.indexLogsBloom(be)
+	if err != nil {
+		return err
+	}
 	buf := new(bytes.Buffer)
 	var offset int
+	// Transactions begin in the same order in our stream
+	txes := flattenTxs(be.TxExecutions)
+	var txIndex int
 	for _, ev := range be.StreamEvents() {
-		switch {
-		case ev.BeginTx != nil:
-			val := &exec.TxExecutionKey{Height: be.Height, Offset: uint64(offset)}
-			bs, err := encoding.Encode(val)
-			if err != nil {
-				return err
-			}
-			// Set reference to TxExecution
-			err = ws.plain.Set(keys.TxHash.Key(ev.BeginTx.TxHeader.TxHash), bs)
-			if err != nil {
-				return err
+		if ev.BeginTx != nil {
+			if !ws.txIndex.Disabled {
+				// Set reference to TxExecution
+				err := ws.indexTx(txes[txIndex], &exec.TxExecutionKey{Height: be.Height, Offset: uint64(offset)})
+				if err != nil {
+					return err
+				}
 			}
+			txIndex++
 		}
 
 		n, err := encoding.WriteMessage(buf, ev)
@@ -68,24 +76,28 @@ func (s *ImmutableState) IterateStreamEvents(startHeight, endHeight *uint64, sor
 		endKey = keys.Event.KeyNoPrefix(*endHeight + 1)
 	}
 	return tree.Iterate(startKey, endKey, sortOrder == storage.AscendingSort, func(_, value []byte) error {
-		buf := bytes.NewBuffer(value)
-
-		for {
-			ev := new(exec.StreamEvent)
-			_, err := encoding.ReadMessage(buf, ev)
-			if err != nil {
-				if err == io.EOF {
-					return nil
-				}
-				return err
-			}
+		return readStreamEvents(value, consumer)
+	})
+}
 
-			err = consumer(ev)
-			if err != nil {
-				return err
+// Passes consumer each of the StreamEvents stored for a block
+func readStreamEvents(bs []byte, consumer func(*exec.StreamEvent) error) error {
+	buf := bytes.NewBuffer(bs)
+	for {
+		ev := new(exec.StreamEvent)
+		_, err := encoding.ReadMessage(buf, ev)
+		if err != nil {
+			if err == io.EOF {
+				return nil
 			}
+			return err
 		}
-	})
+
+		err = consumer(ev)
+		if err != nil {
+			return err
+		}
+	}
 }
 
 func (s *ImmutableState) TxsAtHeight(height uint64) ([]*exec.TxExecution, error) {
@@ -110,7 +122,9 @@ func (s *ImmutableState) TxsAtHeight(height uint64) ([]*exec.TxExecution, error)
 }
 
 func (s *ReadState) TxByHash(txHash []byte) (*exec.TxExecution, error) {
-	const errHeader = "TxByHash():"
+	if s.txIndex != nil && s.txIndex.Disabled {
+		return nil, fmt.Errorf("TxByHash(): transaction index is disabled on this node")
+	}
 	bs, err := s.Plain.Get(keys.TxHash.Key(txHash))
 	if err != nil {
 		return nil, err
@@ -124,18 +138,26 @@ func (s *ReadState) TxByHash(txHash []byte) (*exec.TxExecution, error) {
 	if err != nil {
 		return nil, err
 	}
+	return s.txAtKey(key)
+}
 
+// Reads the TxExecution that starts at key in the events of its block
+func (s *ReadState) txAtKey(key *exec.TxExecutionKey) (*exec.TxExecution, error) {
+	const errHeader = "txAtKey():"
 	blockTree, err := s.Forest.Reader(keys.Event.Prefix())
 	if err != nil {
 		return nil, err
 	}
 
-	bs, err = blockTree.Get(keys.Event.KeyNoPrefix(key.Height))
+	bs, err := blockTree.Get(keys.Event.KeyNoPrefix(key.Height))
 	if err != nil {
 		return nil, err
 	} else if len(bs) == 0 {
-		return nil, fmt.Errorf("%s could not retrieve transaction with TxHash %X despite finding reference",
-			errHeader, txHash)
+		return nil, fmt.Errorf("%s could not retrieve transaction at height %d despite finding reference",
+			errHeader, key.Height)
+	} else if key.Offset >= uint64(len(bs)) {
+		return nil, fmt.Errorf("%s offset %d is beyond the %d bytes of events at height %d",
+			errHeader, key.Offset, len(bs), key.Height)
 	}
 
 	buf := bytes.NewBuffer(bs[key.Offset:])
@@ -174,3 +196,20 @@ func (s *ImmutableState) LastStoredHeight() (uint64, error) {
 	}
 	return height, nil
 }
+
+// Get the BeginBlock of the last block stored in state at or below height, or nil if there is no such block
+func (s *ImmutableState) LastBeginBlock(height uint64) (*exec.BeginBlock, error) {
+	var beginBlock *exec.BeginBlock
+	err := s.IterateStreamEvents(nil, &height, storage.DescendingSort,
+		func(event *exec.StreamEvent) error {
+			if event.BeginBlock != nil {
+				beginBlock = event.BeginBlock
+				return io.EOF
+			}
+			return nil
+		})
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("LastBeginBlock: %w", err)
+	}
+	return beginBlock, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
@@ -114,6 +115,53 @@ func TestReadState_TxByHash(t *testing.T) {
 	}
 }
 
+func TestReadState_TxsByTag(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	s.SetTxIndex(&TxIndexConfig{Sender: true, EventNames: true, Tags: []string{"Address"}})
+	maxHeight := uint64(3)
+	numTxs := uint64(4)
+	events := uint64(2)
+	for height := uint64(0); height < maxHeight; height++ {
+		addBlock(t, s, height, numTxs, events)
+	}
+	countTxs := func(tag, value string, start, end uint64) int {
+		var count int
+		err := s.TxsByTag(tag, value, start, end, func(txe *exec.TxExecution) error {
+			require.GreaterOrEqual(t, txe.Height, start)
+			require.LessOrEqual(t, txe.Height, end)
+			count++
+			return nil
+		})
+		require.NoError(t, err)
+		return count
+	}
+
+	sender := crypto.Address{1, 2, 3}.String()
+	require.Equal(t, int(maxHeight*numTxs), countTxs(SenderTag, sender, 0, maxHeight))
+	require.Equal(t, int(numTxs), countTxs(SenderTag, sender, 1, 1))
+	require.Equal(t, 0, countTxs(SenderTag, crypto.Address{3, 2, 1}.String(), 0, maxHeight))
+	eventName := binary.Word256{1, 2, 3}.String()
+	require.Equal(t, int(maxHeight*numTxs), countTxs(EventNameTag, eventName, 0, maxHeight))
+	// Logged from an address made of the height and the event index
+	require.Equal(t, int(numTxs), countTxs("Address", crypto.Address{2, 1}.String(), 0, maxHeight))
+
+	err := s.TxsByTag(CalleeTag, sender, 0, maxHeight, func(txe *exec.TxExecution) error {
+		return nil
+	})
+	require.Error(t, err, "should not look up tags that are not indexed")
+
+	s.SetTxIndex(&TxIndexConfig{Disabled: true})
+	addBlock(t, s, maxHeight, numTxs, events)
+	_, err = s.TxByHash(mkTxExecution(0, 0, events).TxHash)
+	require.Error(t, err)
+	// Blocks added while indexing is disabled are never indexed
+	s.SetTxIndex(&TxIndexConfig{Sender: true})
+	require.Equal(t, int(maxHeight*numTxs), countTxs(SenderTag, sender, 0, maxHeight))
+	txOut, err := s.TxByHash(mkTxExecution(maxHeight, 0, events).TxHash)
+	require.NoError(t, err)
+	require.Nil(t, txOut)
+}
+
 func TestLastBlockStored(t *testing.T) {
 	s := NewState(dbm.NewMemDB())
 	// Add first block
@@ -137,6 +185,75 @@ func TestLastBlockStored(t *testing.T) {
 	require.Equal(t, lastStoredHeight, uint64(3))
 }
 
+func TestReadState_IterateEvidence(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	addBlock(t, s, 1, 1, 0)
+	// A block with evidence is stored even though it has no transactions
+	block := mkBlock(2, 0, 0)
+	block.Evidence = []*exec.Evidence{
+		{Type: "DUPLICATE_VOTE", Address: crypto.Address{1}, Power: 10, Height: 1, TotalVotingPower: 30},
+		{Type: "DUPLICATE_VOTE", Address: crypto.Address{2}, Power: 20, Height: 1, TotalVotingPower: 30},
+	}
+	_, _, err := s.Update(func(ws Updatable) error {
+		return ws.AddBlock(block)
+	})
+	require.NoError(t, err)
+	lastStoredHeight, err := s.LastStoredHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), lastStoredHeight)
+
+	var evidence []*exec.Evidence
+	err = s.IterateEvidence(0, 2, func(ev *exec.Evidence) error {
+		evidence = append(evidence, ev)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, evidence, 2)
+	require.Equal(t, crypto.Address{1}, evidence[0].Address)
+	require.Equal(t, int64(20), evidence[1].Power)
+
+	evidence = nil
+	err = s.IterateEvidence(0, 1, func(ev *exec.Evidence) error {
+		evidence = append(evidence, ev)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, evidence, 0)
+}
+
+func TestReadState_IterateStreamEventsFiltered(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	for height := uint64(1); height <= 5; height++ {
+		addBlock(t, s, height, 1, 2)
+	}
+	// Blocks stored before logs blooms were are read regardless
+	for height := uint64(1); height <= 2; height++ {
+		require.NoError(t, s.Plain.Delete(keys.LogsBloom.Key(height)))
+	}
+	qry := query.MustParse(fmt.Sprintf("Address = '%v'", crypto.Address{4, 1}))
+
+	var tested, read []uint64
+	err := s.IterateStreamEventsFiltered(0, 5, func(height uint64, bloom *exec.LogsBloom) bool {
+		tested = append(tested, height)
+		return bloom.MayMatch(qry)
+	}, func(ev *exec.StreamEvent) error {
+		if ev.BeginBlock != nil {
+			read = append(read, ev.BeginBlock.Height)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5}, tested)
+	require.Equal(t, []uint64{1, 2, 4}, read)
+
+	bloom, err := s.LogsBloom(4)
+	require.NoError(t, err)
+	require.True(t, bloom.Test(crypto.Address{4, 1}.Bytes()))
+	bloom, err = s.LogsBloom(2)
+	require.NoError(t, err)
+	require.Nil(t, bloom)
+}
+
 func BenchmarkAddBlockAndIterator(b *testing.B) {
 	s := NewState(dbm.NewMemDB())
 	numTxs := uint64(5)
@@ -0,0 +1,44 @@
+package state
+
+import (
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// Indexes the evidence committed in be so it can be listed without reading every block
+func (ws *writeState) indexEvidence(be *exec.BlockExecution) error {
+	for i, ev := range be.Evidence {
+		bs, err := encoding.Encode(ev)
+		if err != nil {
+			return err
+		}
+		err = ws.plain.Set(keys.Evidence.Key(be.Height, uint64(i)), bs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IterateEvidence passes consumer the evidence of validator misbehaviour committed in the blocks from startHeight to
+// endHeight inclusive in order
+func (s *ReadState) IterateEvidence(startHeight, endHeight uint64, consumer func(*exec.Evidence) error) error {
+	it, err := keys.Evidence.Iterator(s.Plain, keys.Evidence.KeyNoPrefix(startHeight),
+		keys.Evidence.KeyNoPrefix(endHeight+1))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		ev := new(exec.Evidence)
+		err = encoding.Decode(it.Value(), ev)
+		if err != nil {
+			return err
+		}
+		err = consumer(ev)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
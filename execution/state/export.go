@@ -0,0 +1,132 @@
+package state
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Export writes the state at height to w such that Import reproduces it with the same hash. Unlike a dump this
+// preserves the versions of the merkle trees that make up state so is only of use to a node of the same chain.
+func (s *State) Export(height uint64, w io.Writer) error {
+	err := s.writeState.forest.Export(VersionAtHeight(height), w)
+	if err != nil {
+		return err
+	}
+	// The plain store is not versioned so we export it as it is now
+	return s.writeState.plain.Export(w)
+}
+
+// Import replaces all state with state exported at height. Versions of state before height are not available
+// afterwards so the validator history is loaded as if validators did not change before height.
+func (s *State) Import(height uint64, r storage.ExportReader) error {
+	s.Lock()
+	defer s.Unlock()
+	for _, prefix := range []string{forestPrefix, plainPrefix} {
+		err := deletePrefix(s.db, prefix)
+		if err != nil {
+			return fmt.Errorf("could not clear existing state: %v", err)
+		}
+	}
+	forest, err := storage.NewMutableForest(storage.NewPrefixDB(s.db, forestPrefix), defaultCacheCapacity)
+	if err != nil {
+		return err
+	}
+	version := VersionAtHeight(height)
+	err = forest.Import(version, r)
+	if err != nil {
+		return err
+	}
+	err = s.writeState.plain.Import(r)
+	if err != nil {
+		return err
+	}
+	return s.replaceForest(forest, version)
+}
+
+// RollbackTo discards the versions of state committed after the block at height so that state is as it was after
+// that block. Unlike loading state at height this happens in place so that a chain can be rolled back while it runs,
+// but the executors running against state must then be restarted. The plain store is not versioned so is left as it
+// is, and transactions committed after height may still be found by hash.
+func (s *State) RollbackTo(height uint64) error {
+	s.Lock()
+	defer s.Unlock()
+	forest, err := storage.NewMutableForest(storage.NewPrefixDB(s.db, forestPrefix), defaultCacheCapacity)
+	if err != nil {
+		return err
+	}
+	version := VersionAtHeight(height)
+	// Loading for writing deletes the later versions
+	err = forest.Load(version)
+	if err != nil {
+		return fmt.Errorf("could not load state at height %d: %w", height, err)
+	}
+	return s.replaceForest(forest, version)
+}
+
+// Replaces the forest of state with forest, which is at version, and the stats and validator history read from it
+func (s *State) replaceForest(forest *storage.MutableForest, version int64) error {
+	s.writeState.forest = forest
+	s.ReadState.Forest = forest
+	s.writeState.accountStats = acmstate.AccountStats{}
+	s.writeState.nodeStats = registry.NewNodeStats()
+	err := s.loadAccountStats()
+	if err != nil {
+		return err
+	}
+	err = s.loadNodeStats()
+	if err != nil {
+		return err
+	}
+	err = s.ensureAccountIndex()
+	if err != nil {
+		return err
+	}
+	ring, err := LoadValidatorRing(version, DefaultValidatorsWindowSize, s.forestAtVersion)
+	if err != nil {
+		return err
+	}
+	s.writeState.ring = ring
+	s.ReadState.History = ring
+	return nil
+}
+
+// Get the forest at version or at the earliest later version that exists if state has been imported since
+func (s *State) forestAtVersion(version int64) (*storage.ImmutableForest, error) {
+	latest := s.writeState.forest.Version()
+	for version < latest && !s.writeState.forest.VersionExists(version) {
+		version++
+	}
+	return s.writeState.forest.GetImmutable(version)
+}
+
+func deletePrefix(db dbm.DB, prefix string) error {
+	pdb := storage.NewPrefixDB(db, prefix)
+	it, err := pdb.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	// We cannot write to the domain of an open iterator
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return err
+	}
+	batch := pdb.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		err = batch.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
@@ -0,0 +1,43 @@
+package state
+
+import (
+	"io"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/gas"
+	"github.com/hyperledger/burrow/storage"
+)
+
+var _ gas.Reader = &State{}
+
+func (s *ImmutableState) GetGasSchedule(height uint64) (*gas.ScheduleUpdate, error) {
+	tree, err := s.Forest.Reader(keys.GasSchedule.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	var update *gas.ScheduleUpdate
+	// Take the last update at or below height
+	err = tree.Iterate(nil, keys.GasSchedule.KeyNoPrefix(height+1), false, func(_, value []byte) error {
+		update = new(gas.ScheduleUpdate)
+		err := encoding.Decode(value, update)
+		if err != nil {
+			return err
+		}
+		return io.EOF
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return update, nil
+}
+
+func (ws *writeState) SetGasSchedule(update *gas.ScheduleUpdate) error {
+	return ws.forest.Write(keys.GasSchedule.Prefix(), func(tree *storage.RWTree) error {
+		bs, err := encoding.Encode(update)
+		if err != nil {
+			return err
+		}
+		tree.Set(keys.GasSchedule.KeyNoPrefix(update.Height), bs)
+		return nil
+	})
+}
@@ -0,0 +1,94 @@
+package state
+
+import (
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// Stores the logs bloom of be so that range queries can skip the blocks without matching LogEvents without reading them
+func (ws *writeState) indexLogsBloom(be *exec.BlockExecution) error {
+	return ws.plain.Set(keys.LogsBloom.Key(be.Height), be.LogsBloom()[:])
+}
+
+// LogsBloom returns the logs bloom of the block at height, or nil if none is stored for it
+func (s *ReadState) LogsBloom(height uint64) (*exec.LogsBloom, error) {
+	bs, err := s.Plain.Get(keys.LogsBloom.Key(height))
+	if err != nil || len(bs) == 0 {
+		return nil, err
+	}
+	return exec.LogsBloomFromBytes(bs), nil
+}
+
+// IterateStreamEventsFiltered iterates StreamEvents over the closed interval [startHeight, endHeight] in ascending order
+// like IterateStreamEvents, but does not read the events of blocks whose logs bloom mayMatch returns false for. Blocks
+// stored before this node stored logs blooms are always read.
+func (s *ReadState) IterateStreamEventsFiltered(startHeight, endHeight uint64,
+	mayMatch func(height uint64, bloom *exec.LogsBloom) bool, consumer func(*exec.StreamEvent) error) error {
+	firstHeight, ok, err := s.firstLogsBloomHeight()
+	if err != nil {
+		return err
+	}
+	if !ok || startHeight < firstHeight {
+		lastHeight := endHeight
+		if ok && firstHeight-1 < lastHeight {
+			lastHeight = firstHeight - 1
+		}
+		err = s.IterateStreamEvents(&startHeight, &lastHeight, storage.AscendingSort, consumer)
+		if err != nil || lastHeight == endHeight {
+			return err
+		}
+		startHeight = firstHeight
+	}
+
+	tree, err := s.Forest.Reader(keys.Event.Prefix())
+	if err != nil {
+		return err
+	}
+	it, err := keys.LogsBloom.Iterator(s.Plain, keys.LogsBloom.KeyNoPrefix(startHeight),
+		keys.LogsBloom.KeyNoPrefix(endHeight+1))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var height uint64
+		err = keys.LogsBloom.ScanNoPrefix(it.Key(), &height)
+		if err != nil {
+			return err
+		}
+		if !mayMatch(height, exec.LogsBloomFromBytes(it.Value())) {
+			continue
+		}
+		bs, err := tree.Get(keys.Event.KeyNoPrefix(height))
+		if err != nil {
+			return err
+		}
+		// A bloom may outlive its block when state is rolled back
+		if len(bs) == 0 {
+			continue
+		}
+		err = readStreamEvents(bs, consumer)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Returns the lowest height with a logs bloom, and whether there is any
+func (s *ReadState) firstLogsBloomHeight() (uint64, bool, error) {
+	it, err := keys.LogsBloom.Iterator(s.Plain, nil, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return 0, false, it.Error()
+	}
+	var height uint64
+	err = keys.LogsBloom.ScanNoPrefix(it.Key(), &height)
+	if err != nil {
+		return 0, false, err
+	}
+	return height, true, nil
+}
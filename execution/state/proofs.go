@@ -0,0 +1,95 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// The state at a height is proved against its hash, which is the AppHash in the header of the block at the next height
+
+func (s *ImmutableState) ProveAccount(address crypto.Address) (*storage.ForestProof, error) {
+	return s.Forest.GetWithProof(accountKey(address))
+}
+
+func (s *ImmutableState) ProveStorage(address crypto.Address, key binary.Word256) (*storage.ForestProof, error) {
+	return s.Forest.GetWithProof(storageKey(address, key))
+}
+
+func (s *ImmutableState) ProveName(name string) (*storage.ForestProof, error) {
+	return s.Forest.GetWithProof(nameKey(name))
+}
+
+// VerifyAccount checks proof against the state hash and returns the account at address it proves, or nil if it proves
+// there is no such account
+func VerifyAccount(proof *storage.ForestProof, address crypto.Address, hash []byte) (*acm.Account, error) {
+	prefix, key := accountKey(address)
+	err := verify(proof, prefix, key, hash)
+	if err != nil || len(proof.Value) == 0 {
+		return nil, err
+	}
+	account := new(acm.Account)
+	err = encoding.Decode(proof.Value, account)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Account: %v", err)
+	}
+	return account, nil
+}
+
+// VerifyStorage checks proof against the state hash and returns the value at key in the storage of the account at
+// address it proves, which is empty for unset storage
+func VerifyStorage(proof *storage.ForestProof, address crypto.Address, key binary.Word256, hash []byte) ([]byte, error) {
+	prefix, treeKey := storageKey(address, key)
+	err := verify(proof, prefix, treeKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	return proof.Value, nil
+}
+
+// VerifyName checks proof against the state hash and returns the entry for name it proves, or nil if it proves there
+// is no such entry
+func VerifyName(proof *storage.ForestProof, name string, hash []byte) (*names.Entry, error) {
+	prefix, key := nameKey(name)
+	err := verify(proof, prefix, key, hash)
+	if err != nil || len(proof.Value) == 0 {
+		return nil, err
+	}
+	entry := new(names.Entry)
+	err = encoding.Decode(proof.Value, entry)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode name Entry: %v", err)
+	}
+	return entry, nil
+}
+
+// A valid proof of some other key proves nothing about the one asked for
+func verify(proof *storage.ForestProof, prefix, key, hash []byte) error {
+	if proof == nil {
+		return fmt.Errorf("no proof given")
+	}
+	if !bytes.Equal(proof.Prefix, prefix) || !bytes.Equal(proof.Key, key) {
+		return fmt.Errorf("proof is of key %X in tree %X but key %X in tree %X was asked for",
+			proof.Key, proof.Prefix, key, prefix)
+	}
+	return proof.Verify(hash)
+}
+
+func accountKey(address crypto.Address) (prefix, key []byte) {
+	return keys.Account.Prefix(), keys.Account.KeyNoPrefix(address)
+}
+
+func storageKey(address crypto.Address, key binary.Word256) (prefix, treeKey []byte) {
+	keyFormat := keys.Storage.Fix(address)
+	return keyFormat.Prefix(), keyFormat.KeyNoPrefix(key)
+}
+
+func nameKey(name string) (prefix, key []byte) {
+	return keys.Name.Prefix(), keys.Name.KeyNoPrefix(name)
+}
@@ -0,0 +1,65 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestState_Proofs(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	account := acm.NewAccountFromSecret("Foo")
+	other := acm.NewAccountFromSecret("Bar")
+	key := binary.LeftPadWord256([]byte{1})
+	value := binary.LeftPadBytes([]byte{2}, 32)
+	hash, version, err := s.Update(func(ws Updatable) error {
+		err := ws.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		err = ws.SetStorage(account.Address, key, value)
+		if err != nil {
+			return err
+		}
+		return ws.UpdateName(&names.Entry{Name: "foo", Owner: account.Address, Data: "bar", Expires: 100})
+	})
+	require.NoError(t, err)
+	st, err := s.AtVersion(version)
+	require.NoError(t, err)
+
+	proof, err := st.ProveAccount(account.Address)
+	require.NoError(t, err)
+	accountOut, err := VerifyAccount(proof, account.Address, hash)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address, accountOut.Address)
+	_, err = VerifyAccount(proof, other.Address, hash)
+	require.Error(t, err, "a proof of one account should not verify another")
+
+	proof, err = st.ProveAccount(other.Address)
+	require.NoError(t, err)
+	accountOut, err = VerifyAccount(proof, other.Address, hash)
+	require.NoError(t, err)
+	assert.Nil(t, accountOut)
+
+	proof, err = st.ProveStorage(account.Address, key)
+	require.NoError(t, err)
+	valueOut, err := VerifyStorage(proof, account.Address, key, hash)
+	require.NoError(t, err)
+	assert.Equal(t, value, valueOut)
+	_, err = VerifyStorage(proof, other.Address, key, hash)
+	require.Error(t, err)
+
+	proof, err = st.ProveName("foo")
+	require.NoError(t, err)
+	entry, err := VerifyName(proof, "foo", hash)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", entry.Data)
+	_, err = VerifyName(proof, "foo", crypto.Keccak256([]byte("not the hash")))
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/genesis"
@@ -42,15 +43,22 @@ var _ names.IterableReader = &State{}
 var _ Updatable = &writeState{}
 
 type KeyFormatStore struct {
-	Account   *storage.MustKeyFormat
-	Storage   *storage.MustKeyFormat
-	Name      *storage.MustKeyFormat
-	Proposal  *storage.MustKeyFormat
-	Validator *storage.MustKeyFormat
-	Event     *storage.MustKeyFormat
-	Registry  *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
-	Abi       *storage.MustKeyFormat
+	Account     *storage.MustKeyFormat
+	Storage     *storage.MustKeyFormat
+	Name        *storage.MustKeyFormat
+	Proposal    *storage.MustKeyFormat
+	Validator   *storage.MustKeyFormat
+	Event       *storage.MustKeyFormat
+	Registry    *storage.MustKeyFormat
+	GasSchedule *storage.MustKeyFormat
+	TxHash      *storage.MustKeyFormat
+	TxTag       *storage.MustKeyFormat
+	Evidence    *storage.MustKeyFormat
+	Abi         *storage.MustKeyFormat
+	PublicKey   *storage.MustKeyFormat
+	CodeHash    *storage.MustKeyFormat
+	Indexed     *storage.MustKeyFormat
+	LogsBloom   *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -69,12 +77,26 @@ var keys = KeyFormatStore{
 	Event: storage.NewMustKeyFormat("e", uint64Length),
 	// Validator -> NodeIdentity
 	Registry: storage.NewMustKeyFormat("r", crypto.AddressLength),
+	// Height -> ScheduleUpdate
+	GasSchedule: storage.NewMustKeyFormat("g", uint64Length),
 
 	// Stored on the plain
 	// TxHash -> TxHeight, TxIndex
 	TxHash: storage.NewMustKeyFormat("th", txs.HashLength),
+	// TagHash, TxHeight, TxOffset -> nil
+	TxTag: storage.NewMustKeyFormat("tt", sha256.Size, uint64Length, uint64Length),
+	// Height, Index -> Evidence
+	Evidence: storage.NewMustKeyFormat("ev", uint64Length, uint64Length),
 	// CodeHash -> Abi
 	Abi: storage.NewMustKeyFormat("abi", sha256.Size),
+	// PublicKeyAddress, AccountAddress -> nil
+	PublicKey: storage.NewMustKeyFormat("pk", crypto.AddressLength, crypto.AddressLength),
+	// CodeHash, AccountAddress -> nil
+	CodeHash: storage.NewMustKeyFormat("ch", binary.Word256Bytes, crypto.AddressLength),
+	// Set once accounts are indexed by PublicKey and CodeHash -> nil
+	Indexed: storage.NewMustKeyFormat("ai"),
+	// Height -> LogsBloom
+	LogsBloom: storage.NewMustKeyFormat("lb", uint64Length),
 }
 
 var Prefixes [][]byte
@@ -94,6 +116,7 @@ type Updatable interface {
 	registry.Writer
 	validator.Writer
 	acmstate.MetadataWriter
+	gas.Writer
 	AddBlock(blockExecution *exec.BlockExecution) error
 }
 
@@ -104,6 +127,7 @@ type writeState struct {
 	ring         *validator.Ring
 	accountStats acmstate.AccountStats
 	nodeStats    registry.NodeStats
+	txIndex      *TxIndexConfig
 }
 
 // This is the immutable merklised read state at a given finalised height
@@ -114,7 +138,8 @@ type ImmutableState struct {
 
 type ReadState struct {
 	ImmutableState
-	Plain *storage.PrefixDB
+	Plain   *storage.PrefixDB
+	txIndex *TxIndexConfig
 }
 
 // Writers to state are responsible for calling State.Lock() before calling
@@ -123,7 +148,9 @@ type State struct {
 	db dbm.DB
 	ReadState
 	writeState writeState
-	logger     *logging.Logger
+	// Writes outside of the merkle trees held by Stage until Save
+	staged dbm.DB
+	logger *logging.Logger
 }
 
 // NewState creates a new State object
@@ -135,6 +162,7 @@ func NewState(db dbm.DB) *State {
 	}
 	plain := storage.NewPrefixDB(db, plainPrefix)
 	ring := validator.NewRing(nil, DefaultValidatorsWindowSize)
+	txIndex := DefaultTxIndexConfig()
 	return &State{
 		db: db,
 		ReadState: ReadState{
@@ -142,13 +170,15 @@ func NewState(db dbm.DB) *State {
 				Forest:  forest,
 				History: ring,
 			},
-			Plain: plain,
+			Plain:   plain,
+			txIndex: txIndex,
 		},
 		writeState: writeState{
 			forest:    forest,
 			plain:     plain,
 			ring:      ring,
 			nodeStats: registry.NewNodeStats(),
+			txIndex:   txIndex,
 		},
 		logger: logging.NewNoopLogger(),
 	}
@@ -166,11 +196,49 @@ func MakeGenesisState(db dbm.DB, genesisDoc *genesis.GenesisDoc) (*State, error)
 			Address:     genAcc.Address,
 			Balance:     genAcc.Amount,
 			Permissions: perm,
+			Locks:       genAcc.Locks,
+			Sequence:    genAcc.Sequence,
+		}
+		// Otherwise the account could never be updated, not even to receive funds, until enough unlocks
+		if locked := acc.LockedBalance(0); locked > acc.Balance {
+			return nil, fmt.Errorf("%s account %v locks %d but has an Amount of only %d", errHeader, acc.Address,
+				locked, acc.Balance)
+		}
+		if len(genAcc.EVMCode) > 0 {
+			acc.EVMCode = genAcc.EVMCode
+			acc.CodeHash = crypto.Keccak256(genAcc.EVMCode)
+		}
+		if len(genAcc.WASMCode) > 0 {
+			acc.WASMCode = genAcc.WASMCode
+			acc.CodeHash = crypto.Keccak256(genAcc.WASMCode)
+		}
+		for _, m := range genAcc.ContractMeta {
+			metahash := acmstate.GetMetadataHash(m.Metadata)
+			err := s.writeState.SetMetadata(metahash, m.Metadata)
+			if err != nil {
+				return nil, fmt.Errorf("%s %v", errHeader, err)
+			}
+			acc.ContractMeta = append(acc.ContractMeta, &acm.ContractMeta{
+				CodeHash:     m.CodeHash,
+				MetadataHash: metahash.Bytes(),
+			})
 		}
 		err := s.writeState.UpdateAccount(acc)
 		if err != nil {
 			return nil, fmt.Errorf("%s %v", errHeader, err)
 		}
+		for _, entry := range genAcc.Storage {
+			err = s.writeState.SetStorage(genAcc.Address, entry.Key, entry.Value.Bytes())
+			if err != nil {
+				return nil, fmt.Errorf("%s %v", errHeader, err)
+			}
+		}
+	}
+	for _, entry := range genesisDoc.Names {
+		err := s.writeState.UpdateName(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s %v", errHeader, err)
+		}
 	}
 	// Make genesis validators
 	err := s.writeState.MakeGenesisValidators(genesisDoc)
@@ -182,6 +250,13 @@ func MakeGenesisState(db dbm.DB, genesisDoc *genesis.GenesisDoc) (*State, error)
 	if err != nil {
 		return nil, fmt.Errorf("%s %v", errHeader, err)
 	}
+	// The genesis gas schedule is in effect until updated
+	if genesisDoc.Params.GasSchedule != nil {
+		err = s.writeState.SetGasSchedule(&gas.ScheduleUpdate{Schedule: genesisDoc.Params.GasSchedule})
+		if err != nil {
+			return nil, fmt.Errorf("%s %v", errHeader, err)
+		}
+	}
 
 	return s, nil
 }
@@ -217,8 +292,13 @@ func LoadState(db dbm.DB, version int64) (*State, error) {
 		return nil, err
 	}
 
+	err = s.ensureAccountIndex()
+	if err != nil {
+		return nil, err
+	}
+
 	// load the validator ring
-	ring, err := LoadValidatorRing(version, DefaultValidatorsWindowSize, s.writeState.forest.GetImmutable)
+	ring, err := LoadValidatorRing(version, DefaultValidatorsWindowSize, s.forestAtVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -254,10 +334,50 @@ func (s *State) Hash() []byte {
 	return s.writeState.forest.Hash()
 }
 
+// HashAtHeight returns the hash state had after the block at height was committed
+func (s *State) HashAtHeight(height uint64) ([]byte, error) {
+	return s.writeState.forest.HashAtVersion(VersionAtHeight(height))
+}
+
+// Prune deletes the state of all heights below height so that it can no longer be read or rolled back to. Events are
+// kept in the latest state so are not lost.
+func (s *State) Prune(height uint64) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.writeState.forest.DeleteVersionsBelow(VersionAtHeight(height))
+}
+
+// Compact reclaims the space on disk left by pruning. It does not need the lock so can run alongside commits.
+func (s *State) Compact() error {
+	return storage.Compact(s.db)
+}
+
+// ArchiveEvents moves the execution events of the blocks from startHeight (inclusive) to endHeight (exclusive) to the
+// cold storage of the state's database, which must have one, and returns how many blocks had events. They are still
+// read as before and the state hash is unchanged. It does not need the lock so can run alongside commits.
+func (s *State) ArchiveEvents(startHeight, endHeight uint64) (int, error) {
+	return s.writeState.forest.ArchiveLeaves(keys.Event.Prefix(), keys.Event.KeyNoPrefix(startHeight),
+		keys.Event.KeyNoPrefix(endHeight))
+}
+
 func (s *State) AtLatestVersion() (*ImmutableState, error) {
 	return s.AtVersion(s.Version())
 }
 
+// Return a concurrent-safe read state at the latest version that can also read metadata, which is stored by its hash
+// outside of the versioned state so is the same at every version
+func (s *State) ReadAtLatestVersion() (*ReadState, error) {
+	st, err := s.AtLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	return &ReadState{
+		ImmutableState: *st,
+		Plain:          s.Plain,
+		txIndex:        s.txIndex,
+	}, nil
+}
+
 // Return a concurrent-safe immutable read state at the given height
 func (s *State) AtHeight(height uint64) (*ImmutableState, error) {
 	return s.AtVersion(VersionAtHeight(height))
@@ -269,7 +389,7 @@ func (s *State) AtVersion(version int64) (*ImmutableState, error) {
 	if err != nil {
 		return nil, err
 	}
-	ring, err := LoadValidatorRing(version, DefaultValidatorsWindowSize, s.writeState.forest.GetImmutable)
+	ring, err := LoadValidatorRing(version, DefaultValidatorsWindowSize, s.forestAtVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -291,9 +411,78 @@ func (s *State) Update(updater func(up Updatable) error) ([]byte, int64, error)
 	return s.commit()
 }
 
+// Stage performs updates to state whilst holding the write lock like Update but, rather than saving them, returns the
+// hash state will have once they are saved by Save. Writes outside of the merkle trees are held in memory until then so
+// that nothing is persisted.
+func (s *State) Stage(updater func(up Updatable) error) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.staged != nil {
+		return nil, fmt.Errorf("State.Stage() called with updates already staged")
+	}
+	staged := dbm.NewMemDB()
+	plain := s.writeState.plain
+	s.writeState.plain = storage.NewPrefixDB(staged, plainPrefix)
+	err := updater(&s.writeState)
+	s.writeState.plain = plain
+	if err != nil {
+		return nil, err
+	}
+	s.staged = staged
+	return s.writeState.forest.WorkingHash()
+}
+
+// Save the updates made by Stage
+func (s *State) Save() ([]byte, int64, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.staged == nil {
+		return nil, 0, fmt.Errorf("State.Save() called without updates staged")
+	}
+	// The keys in staged already have the plain prefix
+	it, err := s.staged.Iterator(nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		err = batch.Set(it.Key(), it.Value())
+		if err != nil {
+			it.Close()
+			return nil, 0, err
+		}
+	}
+	it.Close()
+	err = batch.Write()
+	if err != nil {
+		return nil, 0, err
+	}
+	s.staged = nil
+	return s.commit()
+}
+
+// UpdateConcurrently performs updates like Update but updater may write from up to workers goroutines at once, and the
+// trees written are saved as concurrently. For state to have a deterministic hash each tree of state must only be
+// written from one goroutine and trees must be first written in a deterministic order, which is the order they are
+// saved in. Accounts are counted as they are written so must all be written from the same goroutine.
+func (s *State) UpdateConcurrently(workers int, updater func(up Updatable) error) ([]byte, int64, error) {
+	s.Lock()
+	defer s.Unlock()
+	err := updater(&s.writeState)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.commitConcurrently(workers)
+}
+
 func (s *State) commit() ([]byte, int64, error) {
+	return s.commitConcurrently(1)
+}
+
+func (s *State) commitConcurrently(workers int) ([]byte, int64, error) {
 	// save state at a new version may still be orphaned before we save the version against the hash
-	hash, version, err := s.writeState.forest.Save()
+	hash, version, err := s.writeState.forest.SaveConcurrently(workers)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -334,6 +523,18 @@ func (s *State) SetLogger(logger *logging.Logger) {
 	s.logger = logger
 }
 
+// SetTxIndex chooses how the transactions of the blocks added from now on are indexed, blocks already added keep
+// their index entries
+func (s *State) SetTxIndex(config *TxIndexConfig) {
+	s.Lock()
+	defer s.Unlock()
+	if config == nil {
+		config = DefaultTxIndexConfig()
+	}
+	s.ReadState.txIndex = config
+	s.writeState.txIndex = config
+}
+
 func (s *State) Dump() string {
 	return s.writeState.forest.Dump()
 }
@@ -18,7 +18,11 @@ import (
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/gas"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,3 +44,114 @@ func TestState_UpdateAccount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, source.JSONString(account), source.JSONString(accountOut))
 }
+
+func TestMakeGenesisState_Contract(t *testing.T) {
+	address := crypto.Address{1}
+	code := acm.Bytecode{0x60, 0x00, 0x54}
+	genesisDoc := &genesis.GenesisDoc{
+		Accounts: []genesis.Account{{
+			BasicAccount: genesis.BasicAccount{Address: address, Amount: 10},
+			EVMCode:      code,
+			Storage:      []genesis.StorageEntry{{Key: binary.Int64ToWord256(1), Value: binary.Int64ToWord256(42)}},
+		}},
+	}
+	s, err := MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+	require.NoError(t, s.InitialCommit())
+
+	account, err := s.GetAccount(address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), account.Balance)
+	assert.Equal(t, code, account.EVMCode)
+	assert.Equal(t, crypto.Keccak256(code), account.CodeHash.Bytes())
+	value, err := s.GetStorage(address, binary.Int64ToWord256(1))
+	require.NoError(t, err)
+	assert.Equal(t, binary.Int64ToWord256(42).Bytes(), value)
+}
+
+func TestMakeGenesisState_Locks(t *testing.T) {
+	address := crypto.Address{1}
+	genesisDoc := &genesis.GenesisDoc{
+		Accounts: []genesis.Account{{
+			BasicAccount: genesis.BasicAccount{Address: address, Amount: 10},
+			Locks:        []acm.BalanceLock{{Amount: 10, UnlockHeight: 100}},
+		}},
+	}
+	s, err := MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+	require.NoError(t, s.InitialCommit())
+	account, err := s.GetAccount(address)
+	require.NoError(t, err)
+	assert.Equal(t, genesisDoc.Accounts[0].Locks, account.Locks)
+
+	genesisDoc.Accounts[0].Locks = append(genesisDoc.Accounts[0].Locks, acm.BalanceLock{Amount: 1, UnlockHeight: 5})
+	_, err = MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "locks 11 but has an Amount of only 10")
+}
+
+func TestState_GasSchedule(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	update, err := s.GetGasSchedule(10)
+	require.NoError(t, err)
+	require.Nil(t, update)
+
+	schedule := gas.DefaultSchedule()
+	schedule.StorageUpdate = 20
+	_, _, err = s.Update(func(ws Updatable) error {
+		err := ws.SetGasSchedule(&gas.ScheduleUpdate{Height: 5, Schedule: gas.DefaultSchedule()})
+		if err != nil {
+			return err
+		}
+		return ws.SetGasSchedule(&gas.ScheduleUpdate{Height: 8, Schedule: schedule})
+	})
+	require.NoError(t, err)
+
+	update, err = s.GetGasSchedule(4)
+	require.NoError(t, err)
+	require.Nil(t, update)
+	for _, height := range []uint64{5, 7} {
+		update, err = s.GetGasSchedule(height)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), update.Height)
+		require.Equal(t, gas.DefaultSchedule(), update.Schedule)
+	}
+	for _, height := range []uint64{8, 100} {
+		update, err = s.GetGasSchedule(height)
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), update.Height)
+		require.Equal(t, schedule, update.Schedule)
+	}
+}
+
+func TestState_RollbackTo(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	require.NoError(t, s.InitialCommit())
+	account := acm.NewAccountFromSecret("Foo")
+	setBalance := func(balance uint64) int64 {
+		account.Balance = balance
+		_, version, err := s.Update(func(ws Updatable) error {
+			return ws.UpdateAccount(account)
+		})
+		require.NoError(t, err)
+		return version
+	}
+	setBalance(1)
+	setBalance(2)
+	require.Equal(t, VersionAtHeight(2), s.Version())
+
+	require.NoError(t, s.RollbackTo(1))
+	assert.Equal(t, VersionAtHeight(1), s.Version())
+	accountOut, err := s.GetAccount(account.Address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), accountOut.Balance)
+	assert.Equal(t, uint64(1), s.writeState.accountStats.AccountsWithoutCode)
+
+	// The heights after are committed again
+	assert.Equal(t, VersionAtHeight(2), setBalance(3))
+	accountOut, err = s.GetAccount(account.Address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), accountOut.Balance)
+
+	require.Error(t, s.RollbackTo(5))
+}
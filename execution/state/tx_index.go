@@ -0,0 +1,170 @@
+package state
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Tags under which transactions are indexed other than those named in TxIndexConfig.Tags
+const (
+	SenderTag    = "Sender"
+	CalleeTag    = "Callee"
+	EventNameTag = "Log0"
+)
+
+// TxIndexConfig chooses which attributes of the transactions in each block a node indexes so that they can be looked
+// up, trading the queries the node can answer against the disk and CPU it spends on each block. Transactions are
+// always indexed by hash unless indexing is disabled.
+type TxIndexConfig struct {
+	// Index nothing, not even transaction hashes, as suits a validator that serves no queries
+	Disabled bool `json:",omitempty" toml:",omitempty"`
+	// Index transactions by the address of each of their inputs
+	Sender bool `json:",omitempty" toml:",omitempty"`
+	// Index calls by the address of the contract they call or create
+	Callee bool `json:",omitempty" toml:",omitempty"`
+	// Index transactions by the first topic of each event they log, which for Solidity is the hash of the event
+	// signature
+	EventNames bool `json:",omitempty" toml:",omitempty"`
+	// Index transactions by the value of each of these tags of the transaction or its events as used in queries
+	// (e.g. 'Log1' or 'EventType')
+	Tags []string `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultTxIndexConfig() *TxIndexConfig {
+	return &TxIndexConfig{}
+}
+
+// Indexes returns whether transactions are indexed by tag
+func (tic *TxIndexConfig) Indexes(tag string) bool {
+	if tic == nil || tic.Disabled {
+		return false
+	}
+	switch tag {
+	case SenderTag:
+		return tic.Sender || tic.indexesTag(tag)
+	case CalleeTag:
+		return tic.Callee || tic.indexesTag(tag)
+	case EventNameTag:
+		return tic.EventNames || tic.indexesTag(tag)
+	}
+	return tic.indexesTag(tag)
+}
+
+func (tic *TxIndexConfig) indexesTag(tag string) bool {
+	for _, t := range tic.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the distinct tag and value pairs by which txe is indexed
+func (tic *TxIndexConfig) tags(txe *exec.TxExecution) [][2]string {
+	var tags [][2]string
+	seen := make(map[[2]string]struct{})
+	add := func(tag string, value interface{}) {
+		tv := [2]string{tag, fmt.Sprint(value)}
+		if _, ok := seen[tv]; !ok {
+			seen[tv] = struct{}{}
+			tags = append(tags, tv)
+		}
+	}
+	if tic.Sender && txe.Envelope != nil && txe.Envelope.Tx != nil {
+		for _, input := range txe.Envelope.Tx.GetInputs() {
+			add(SenderTag, input.Address)
+		}
+	}
+	if tic.Callee && txe.Receipt != nil && txe.Receipt.TxType == payload.TypeCall {
+		add(CalleeTag, txe.Receipt.ContractAddress)
+	}
+	if tic.EventNames {
+		for _, ev := range txe.Events {
+			if ev.Log != nil && len(ev.Log.Topics) > 0 {
+				value, _ := ev.Log.Get(EventNameTag)
+				add(EventNameTag, value)
+			}
+		}
+	}
+	for _, tag := range tic.Tags {
+		if value, ok := txe.Get(tag); ok {
+			add(tag, value)
+		}
+		for _, ev := range txe.Events {
+			if value, ok := ev.Get(tag); ok {
+				add(tag, value)
+			}
+		}
+	}
+	return tags
+}
+
+// Indexes txe, which starts at key in the events of its block
+func (ws *writeState) indexTx(txe *exec.TxExecution, key *exec.TxExecutionKey) error {
+	bs, err := encoding.Encode(key)
+	if err != nil {
+		return err
+	}
+	err = ws.plain.Set(keys.TxHash.Key(txe.TxHash), bs)
+	if err != nil {
+		return err
+	}
+	for _, tv := range ws.txIndex.tags(txe) {
+		err = ws.plain.Set(keys.TxTag.Key(tagHash(tv[0], tv[1]), key.Height, key.Offset), []byte{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TxsByTag passes consumer each transaction indexed with value for tag in the blocks from startHeight to endHeight
+// inclusive in order
+func (s *ReadState) TxsByTag(tag, value string, startHeight, endHeight uint64,
+	consumer func(*exec.TxExecution) error) error {
+	if !s.txIndex.Indexes(tag) {
+		return fmt.Errorf("transactions are not indexed by tag '%s' on this node", tag)
+	}
+	keyFormat := keys.TxTag.Fix(tagHash(tag, value))
+	it, err := keyFormat.Iterator(s.Plain, keyFormat.KeyNoPrefix(startHeight), keyFormat.KeyNoPrefix(endHeight+1))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := new(exec.TxExecutionKey)
+		err = keyFormat.ScanNoPrefix(it.Key(), &key.Height, &key.Offset)
+		if err != nil {
+			return err
+		}
+		txe, err := s.txAtKey(key)
+		if err != nil {
+			return fmt.Errorf("could not read transaction indexed with %s = %s: %v", tag, value, err)
+		}
+		err = consumer(txe)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Tags of any length are indexed under a hash of the pair
+func tagHash(tag, value string) []byte {
+	hash := sha256.Sum256([]byte(tag + "\x00" + value))
+	return hash[:]
+}
+
+// Lists each transaction and the transactions nested within it in the order their events are streamed
+func flattenTxs(txes []*exec.TxExecution) []*exec.TxExecution {
+	var flat []*exec.TxExecution
+	for _, txe := range txes {
+		flat = append(flat, txe)
+		flat = append(flat, flattenTxs(txe.TxExecutions)...)
+	}
+	return flat
+}
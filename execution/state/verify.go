@@ -0,0 +1,118 @@
+package state
+
+import (
+	"bytes"
+
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// VerifyReport describes the integrity of the state checked by Verify
+type VerifyReport struct {
+	*storage.ForestReport
+	// Height of the state verified
+	Height uint64
+	// Entries of the transaction index that do not refer to the start of a transaction in the state verified, as when a
+	// node stops part way through a commit. The index is not merklised so these are not caught by the forest's hashes.
+	DanglingTxIndex int
+}
+
+// Verify checks the state stored in db at height: that its trees hash to the hashes committed for them, and that its
+// transaction index refers only to transactions it holds. If repair is set it deletes orphaned tree versions and
+// dangling index entries, which leaves the state as it was when height was committed. The state must not be open.
+func Verify(db dbm.DB, height uint64, repair bool) (*VerifyReport, error) {
+	forestDB := storage.NewPrefixDB(db, forestPrefix)
+	forestReport, err := storage.VerifyForest(forestDB, VersionAtHeight(height), repair)
+	if err != nil {
+		return nil, err
+	}
+	report := &VerifyReport{
+		ForestReport: forestReport,
+		Height:       height,
+	}
+	if len(forestReport.Corrupt) > 0 {
+		// We cannot trust the events the index refers to
+		return report, nil
+	}
+	forest, err := storage.LoadImmutableForest(forestDB, VersionAtHeight(height), defaultCacheCapacity)
+	if err != nil {
+		return nil, err
+	}
+	s := &ReadState{
+		ImmutableState: ImmutableState{Forest: forest},
+		Plain:          storage.NewPrefixDB(db, plainPrefix),
+	}
+	dangling, err := s.danglingTxIndex(height)
+	if err != nil {
+		return nil, err
+	}
+	report.DanglingTxIndex = len(dangling)
+	if repair {
+		for _, key := range dangling {
+			err = s.Plain.Delete(key)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return report, nil
+}
+
+// Returns the keys of the entries of the transaction index that do not refer to the start of a transaction at or below
+// height
+func (s *ReadState) danglingTxIndex(height uint64) ([][]byte, error) {
+	type txKey struct{ height, offset uint64 }
+	// Transactions can be indexed under their hash and many tags so remember those we have read
+	valid := make(map[txKey]bool)
+	refersToTx := func(key *exec.TxExecutionKey, txHash []byte) bool {
+		k := txKey{key.Height, key.Offset}
+		ok, read := valid[k]
+		if read && txHash == nil {
+			return ok
+		}
+		if key.Height > height {
+			return false
+		}
+		txe, err := s.txAtKey(key)
+		ok = err == nil && txe != nil
+		valid[k] = ok
+		return ok && (txHash == nil || bytes.Equal(txe.TxHash, txHash))
+	}
+
+	var dangling [][]byte
+	it, err := keys.TxHash.Iterator(s.Plain, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for ; it.Valid(); it.Next() {
+		key := new(exec.TxExecutionKey)
+		err = encoding.Decode(it.Value(), key)
+		if err != nil || !refersToTx(key, it.Key()) {
+			dangling = append(dangling, keys.TxHash.Prefix().Key(it.Key()))
+		}
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	it, err = keys.TxTag.Iterator(s.Plain, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := new(exec.TxExecutionKey)
+		err = keys.TxTag.ScanNoPrefix(it.Key(), nil, &key.Height, &key.Offset)
+		if err != nil {
+			return nil, err
+		}
+		if !refersToTx(key, nil) {
+			dangling = append(dangling, keys.TxTag.Prefix().Key(it.Key()))
+		}
+	}
+	return dangling, it.Error()
+}
@@ -0,0 +1,94 @@
+package execution
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/execution/state"
+)
+
+// StateSnapshot is the state of a chain after a block, recorded under a name so that the chain can be returned to it
+type StateSnapshot struct {
+	Name      string
+	Height    uint64
+	BlockTime time.Time
+	AppHash   []byte
+}
+
+// StateSnapshots records the state of a chain running without consensus, such as a development chain, so that it can
+// be returned to it later, for instance to give each of a suite of tests the same fixture without deploying it again.
+// A chain run by consensus cannot be returned to an earlier state without its peers.
+type StateSnapshots struct {
+	state      *state.State
+	blockchain *bcm.Blockchain
+	// The executors running against state, the first of which commits it
+	executors []BatchExecutor
+	mtx       sync.Mutex
+	snapshots map[string]*StateSnapshot
+}
+
+func NewStateSnapshots(st *state.State, blockchain *bcm.Blockchain, committer BatchCommitter,
+	executors ...BatchExecutor) *StateSnapshots {
+	return &StateSnapshots{
+		state:      st,
+		blockchain: blockchain,
+		executors:  append([]BatchExecutor{committer}, executors...),
+		snapshots:  make(map[string]*StateSnapshot),
+	}
+}
+
+// Take records the state after the last block committed under name, replacing any snapshot of that name. Transactions
+// not yet committed in a block are not part of the snapshot.
+func (ss *StateSnapshots) Take(name string) (*StateSnapshot, error) {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+	// Hold the committer so that the block we record is not committed part way
+	ss.executors[0].Lock()
+	defer ss.executors[0].Unlock()
+	snapshot := &StateSnapshot{
+		Name:      name,
+		Height:    ss.blockchain.LastBlockHeight(),
+		BlockTime: ss.blockchain.LastBlockTime(),
+		AppHash:   ss.blockchain.AppHashAfterLastBlock(),
+	}
+	ss.snapshots[name] = snapshot
+	return snapshot, nil
+}
+
+// Restore returns the chain to the state recorded under name, discarding the blocks committed after it and any
+// transactions not yet committed. The snapshots taken after it are discarded too since their state no longer exists,
+// but the snapshot itself can be restored again.
+func (ss *StateSnapshots) Restore(name string) (*StateSnapshot, error) {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+	snapshot, ok := ss.snapshots[name]
+	if !ok {
+		return nil, fmt.Errorf("no state snapshot named %s has been taken", name)
+	}
+	for _, exe := range ss.executors {
+		exe.Lock()
+		defer exe.Unlock()
+	}
+	err := ss.state.RollbackTo(snapshot.Height)
+	if err != nil {
+		return nil, fmt.Errorf("could not roll back state to snapshot %s: %w", name, err)
+	}
+	err = ss.blockchain.RestoreAtHeight(snapshot.Height, snapshot.BlockTime, snapshot.AppHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not roll back blockchain to snapshot %s: %w", name, err)
+	}
+	for _, exe := range ss.executors {
+		err = exe.Restart()
+		if err != nil {
+			return nil, fmt.Errorf("could not restart execution from snapshot %s: %w", name, err)
+		}
+	}
+	for other, s := range ss.snapshots {
+		if s.Height > snapshot.Height {
+			delete(ss.snapshots, other)
+		}
+	}
+	return snapshot, nil
+}
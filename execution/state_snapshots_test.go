@@ -0,0 +1,47 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateSnapshots(t *testing.T) {
+	st, privAccounts := makeGenesisState(1, 1)
+	exe := makeExecutor(st)
+	snapshots := NewStateSnapshots(st, exe.Blockchain, exe.executor)
+	address := privAccounts[0].GetAddress()
+	setBalance := func(balance uint64) {
+		acc := exe.getAccount(t, address)
+		acc.Balance = balance
+		exe.updateAccounts(t, acc)
+	}
+
+	setBalance(100)
+	fixture, err := snapshots.Take("fixture")
+	require.NoError(t, err)
+	assert.Equal(t, exe.Blockchain.LastBlockHeight(), fixture.Height)
+
+	setBalance(200)
+	_, err = snapshots.Take("later")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		restored, err := snapshots.Restore("fixture")
+		require.NoError(t, err)
+		assert.Equal(t, fixture, restored)
+		assert.Equal(t, fixture.Height, exe.Blockchain.LastBlockHeight())
+		assert.Equal(t, fixture.AppHash, st.Hash())
+		assert.Equal(t, uint64(100), exe.getAccount(t, address).Balance)
+
+		// The chain carries on from the snapshot
+		setBalance(300)
+		assert.Equal(t, fixture.Height+1, exe.Blockchain.LastBlockHeight())
+		assert.Equal(t, uint64(300), exe.getAccount(t, address).Balance)
+	}
+
+	// Snapshots taken after the one restored are gone with the blocks they recorded
+	_, err = snapshots.Restore("later")
+	require.Error(t, err)
+}
@@ -9,6 +9,10 @@ import (
 	"fmt"
 	"sync"
 
+	abciTypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/tendermint/codes"
@@ -18,17 +22,13 @@ import (
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/txs"
-	abciTypes "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/mempool"
-	"github.com/tendermint/tendermint/p2p"
-	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 const (
 	SubscribeBufferSize = 10
 )
 
-type txChecker func(tx tmTypes.Tx, callback func(*abciTypes.Response), txInfo mempool.TxInfo) error
+type txChecker func(tx tmTypes.Tx, callback func(*abciTypes.ResponseCheckTx), txInfo mempool.TxInfo) error
 
 // Transactor is responsible for helping to formulate, sign, and broadcast transactions to tendermint
 //
@@ -202,8 +202,8 @@ func (trans *Transactor) SignTx(txEnv *txs.Envelope) (*txs.Envelope, error) {
 }
 
 func (trans *Transactor) CheckTxSyncRaw(ctx context.Context, txBytes []byte) (*txs.Receipt, error) {
-	responseCh := make(chan *abciTypes.Response, 3)
-	err := trans.CheckTxAsyncRaw(txBytes, func(res *abciTypes.Response) {
+	responseCh := make(chan *abciTypes.ResponseCheckTx, 3)
+	err := trans.CheckTxAsyncRaw(txBytes, func(res *abciTypes.ResponseCheckTx) {
 		responseCh <- res
 	})
 	if err != nil {
@@ -213,8 +213,7 @@ func (trans *Transactor) CheckTxSyncRaw(ctx context.Context, txBytes []byte) (*t
 	select {
 	case <-ctx.Done():
 		return nil, fmt.Errorf("waiting for CheckTx response in CheckTxSyncRaw: %v", ctx.Err())
-	case response := <-responseCh:
-		checkTxResponse := response.GetCheckTx()
+	case checkTxResponse := <-responseCh:
 		if checkTxResponse == nil {
 			return nil, fmt.Errorf("application did not return CheckTx response")
 		}
@@ -233,11 +232,11 @@ func (trans *Transactor) CheckTxSyncRaw(ctx context.Context, txBytes []byte) (*t
 	}
 }
 
-func (trans *Transactor) CheckTxAsyncRaw(txBytes []byte, callback func(res *abciTypes.Response)) error {
+func (trans *Transactor) CheckTxAsyncRaw(txBytes []byte, callback func(res *abciTypes.ResponseCheckTx)) error {
 	return trans.checkTxAsync(txBytes, callback, mempool.TxInfo{SenderP2PID: trans.nodeID})
 }
 
-func (trans *Transactor) CheckTxAsync(txEnv *txs.Envelope, callback func(res *abciTypes.Response)) error {
+func (trans *Transactor) CheckTxAsync(txEnv *txs.Envelope, callback func(res *abciTypes.ResponseCheckTx)) error {
 	err := txEnv.Validate()
 	if err != nil {
 		return err
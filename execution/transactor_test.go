@@ -7,6 +7,9 @@ import (
 	"context"
 	"testing"
 
+	abciTypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/mempool"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
@@ -20,9 +23,6 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	abciTypes "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/mempool"
-	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 func TestTransactor_BroadcastTxSync(t *testing.T) {
@@ -44,7 +44,7 @@ func TestTransactor_BroadcastTxSync(t *testing.T) {
 	height := uint64(35)
 	trans := NewTransactor(bc, evc, NewAccounts(acmstate.NewMemoryState(),
 		keys.NewLocalKeyClient(keys.NewMemoryKeyStore(privAccount), logger), 100),
-		func(tx tmTypes.Tx, cb func(*abciTypes.Response), txInfo mempool.TxInfo) error {
+		func(tx tmTypes.Tx, cb func(*abciTypes.ResponseCheckTx), txInfo mempool.TxInfo) error {
 			txe := exec.NewTxExecution(txEnv)
 			txe.Height = height
 			err := evc.Publish(context.Background(), txe, txe)
@@ -55,10 +55,10 @@ func TestTransactor_BroadcastTxSync(t *testing.T) {
 			if err != nil {
 				return err
 			}
-			cb(abciTypes.ToResponseCheckTx(abciTypes.ResponseCheckTx{
+			cb(&abciTypes.ResponseCheckTx{
 				Code: codes.TxExecutionSuccessCode,
 				Data: bs,
-			}))
+			})
 			return nil
 		}, "", txCodec, logger)
 	txe, err := trans.BroadcastTxSync(context.Background(), txEnv)
@@ -0,0 +1,38 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/execution/unbonding"
+)
+
+// Pay the withdrawals of power unbonded one unbonding period before the block being executed back to the balances of
+// their validators
+func (exe *executor) payUnbondedWithdrawals() error {
+	withdrawals, err := unbonding.Due(exe.stateCache, exe.block.Height)
+	if err != nil {
+		return err
+	}
+	for _, withdrawal := range withdrawals {
+		acc, err := exe.stateCache.GetAccount(withdrawal.Address)
+		if err != nil {
+			return err
+		}
+		if acc == nil {
+			return fmt.Errorf("cannot pay unbonded withdrawal of %d to account %v that does not exist",
+				withdrawal.Amount, withdrawal.Address)
+		}
+		err = acc.AddToBalance(withdrawal.Amount)
+		if err != nil {
+			return err
+		}
+		err = exe.stateCache.UpdateAccount(acc)
+		if err != nil {
+			return err
+		}
+		exe.logger.InfoMsg("Paid unbonded withdrawal",
+			"address", withdrawal.Address,
+			"amount", withdrawal.Amount)
+	}
+	return nil
+}
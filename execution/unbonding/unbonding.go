@@ -0,0 +1,161 @@
+package unbonding
+
+import (
+	bin "encoding/binary"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+)
+
+// Power unbonded by an UnbondTx on a chain with an unbonding period is not returned to the validator's balance straight
+// away but is kept as a pending withdrawal in the storage of the account at Address until the period has passed. The
+// withdrawals released at a height are queued under that height and those of each validator under its address.
+var Address = engine.AddressFromName("UnbondingWithdrawals")
+
+const (
+	// Address, Amount
+	heightEntryLength = crypto.AddressLength + 8
+	// Height, Amount
+	accountEntryLength = 8 + 8
+)
+
+type Withdrawal struct {
+	// The validator whose balance is paid
+	Address crypto.Address
+	Amount  uint64
+	// The withdrawal is paid at the end of the block at this height
+	Height uint64
+}
+
+// Add records withdrawal to be paid at its height
+func Add(st acmstate.ReaderWriter, withdrawal *Withdrawal) error {
+	if withdrawal.Amount == 0 {
+		return nil
+	}
+	acc, err := st.GetAccount(Address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		err = engine.CreateAccount(st, Address)
+		if err != nil {
+			return err
+		}
+	}
+	key := heightKey(withdrawal.Height)
+	bs, err := st.GetStorage(Address, key)
+	if err != nil {
+		return err
+	}
+	entry := make([]byte, heightEntryLength)
+	copy(entry, withdrawal.Address.Bytes())
+	bin.BigEndian.PutUint64(entry[crypto.AddressLength:], withdrawal.Amount)
+	err = st.SetStorage(Address, key, append(bs, entry...))
+	if err != nil {
+		return err
+	}
+	key = accountKey(withdrawal.Address)
+	bs, err = st.GetStorage(Address, key)
+	if err != nil {
+		return err
+	}
+	entry = make([]byte, accountEntryLength)
+	bin.BigEndian.PutUint64(entry, withdrawal.Height)
+	bin.BigEndian.PutUint64(entry[8:], withdrawal.Amount)
+	return st.SetStorage(Address, key, append(bs, entry...))
+}
+
+// Pending returns the withdrawals not yet paid to address in the order they were made
+func Pending(st acmstate.Reader, address crypto.Address) ([]*Withdrawal, error) {
+	acc, err := st.GetAccount(Address)
+	if err != nil || acc == nil {
+		return nil, err
+	}
+	bs, err := st.GetStorage(Address, accountKey(address))
+	if err != nil {
+		return nil, err
+	}
+	if len(bs)%accountEntryLength != 0 {
+		return nil, fmt.Errorf("pending withdrawals of %v have length %d which is not a multiple of %d",
+			address, len(bs), accountEntryLength)
+	}
+	withdrawals := make([]*Withdrawal, 0, len(bs)/accountEntryLength)
+	for i := 0; i < len(bs); i += accountEntryLength {
+		withdrawals = append(withdrawals, &Withdrawal{
+			Address: address,
+			Height:  bin.BigEndian.Uint64(bs[i:]),
+			Amount:  bin.BigEndian.Uint64(bs[i+8:]),
+		})
+	}
+	return withdrawals, nil
+}
+
+// Due removes and returns the withdrawals to be paid at height in the order they were made
+func Due(st acmstate.ReaderWriter, height uint64) ([]*Withdrawal, error) {
+	acc, err := st.GetAccount(Address)
+	if err != nil || acc == nil {
+		// Nothing has ever been unbonded
+		return nil, err
+	}
+	key := heightKey(height)
+	bs, err := st.GetStorage(Address, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	if len(bs)%heightEntryLength != 0 {
+		return nil, fmt.Errorf("withdrawals due at height %d have length %d which is not a multiple of %d",
+			height, len(bs), heightEntryLength)
+	}
+	var withdrawals []*Withdrawal
+	for i := 0; i < len(bs); i += heightEntryLength {
+		withdrawal := &Withdrawal{
+			Address: crypto.MustAddressFromBytes(bs[i : i+crypto.AddressLength]),
+			Amount:  bin.BigEndian.Uint64(bs[i+crypto.AddressLength:]),
+			Height:  height,
+		}
+		err = removePending(st, withdrawal)
+		if err != nil {
+			return nil, err
+		}
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals, st.SetStorage(Address, key, nil)
+}
+
+func removePending(st acmstate.ReaderWriter, withdrawal *Withdrawal) error {
+	pending, err := Pending(st, withdrawal.Address)
+	if err != nil {
+		return err
+	}
+	var bs []byte
+	removed := false
+	for _, w := range pending {
+		if !removed && w.Height == withdrawal.Height && w.Amount == withdrawal.Amount {
+			removed = true
+			continue
+		}
+		entry := make([]byte, accountEntryLength)
+		bin.BigEndian.PutUint64(entry, w.Height)
+		bin.BigEndian.PutUint64(entry[8:], w.Amount)
+		bs = append(bs, entry...)
+	}
+	return st.SetStorage(Address, accountKey(withdrawal.Address), bs)
+}
+
+func heightKey(height uint64) binary.Word256 {
+	return hashKey([]byte("height"), binary.Uint64ToWord256(height).Bytes())
+}
+
+func accountKey(address crypto.Address) binary.Word256 {
+	return hashKey([]byte("account"), address.Bytes())
+}
+
+func hashKey(prefix, bs []byte) binary.Word256 {
+	return binary.LeftPadWord256(crypto.Keccak256(append(prefix, bs...)))
+}
@@ -0,0 +1,56 @@
+package upgrade
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// Migration changes state as an upgrade requires. It runs as part of the block at the upgrade's height on every node
+// so it must be deterministic, depending only on the state it is passed.
+type Migration func(st acmstate.ReaderWriter, logger *logging.Logger) error
+
+// The upgrades this binary supports and their migrations
+var migrations = make(map[string]Migration)
+
+// Register declares that this binary supports the upgrade with name and will run migration, which may be nil if the
+// upgrade needs none, in the block at the upgrade's height. It should be called from an init function.
+func Register(name string, migration Migration) {
+	if _, ok := migrations[name]; ok {
+		panic(fmt.Errorf("upgrade %s is already registered", name))
+	}
+	migrations[name] = migration
+}
+
+// Supported returns whether this binary can execute the blocks from the height of the upgrade with name
+func Supported(name string) bool {
+	_, ok := migrations[name]
+	return ok
+}
+
+// SupportedUpgrades returns the names of the upgrades this binary supports
+func SupportedUpgrades() []string {
+	names := make([]string, 0, len(migrations))
+	for name := range migrations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Migrate runs the migration registered for plan and records that it has been applied
+func Migrate(st acmstate.ReaderWriter, plan *Plan, logger *logging.Logger) error {
+	migration, ok := migrations[plan.Name]
+	if !ok {
+		return fmt.Errorf("this binary does not support upgrade %s, which requires %s", plan.Name, plan.Info)
+	}
+	if migration != nil {
+		err := migration(st, logger)
+		if err != nil {
+			return fmt.Errorf("could not migrate state for upgrade %s: %v", plan.Name, err)
+		}
+	}
+	return complete(st, plan)
+}
@@ -0,0 +1,110 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+)
+
+// The pending upgrade plan and the heights at which upgrades were applied are kept in the storage of the account at
+// Address. It is derived from a name as engine.AddressFromName derives the addresses of natives, which we cannot use
+// here since payload depends on us.
+var Address = crypto.MustAddressFromBytes(crypto.Keccak256([]byte("UpgradePlan"))[32-crypto.AddressLength:])
+
+var planKey = binary.Zero256
+
+// Validate checks the plan can be made in the block at height
+func (p *Plan) Validate(height uint64) error {
+	if p.Name == "" {
+		return fmt.Errorf("upgrade plan must have a name")
+	}
+	if p.Height != 0 && p.Height <= height {
+		return fmt.Errorf("upgrade plan must take effect at a height after the current height %d "+
+			"but has height %d", height, p.Height)
+	}
+	return nil
+}
+
+// GetPlan returns the pending upgrade plan or nil if there is none
+func GetPlan(st acmstate.Reader) (*Plan, error) {
+	acc, err := st.GetAccount(Address)
+	if err != nil || acc == nil {
+		return nil, err
+	}
+	bs, err := st.GetStorage(Address, planKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	plan := new(Plan)
+	return plan, encoding.Decode(bs, plan)
+}
+
+// SetPlan makes plan the pending upgrade plan replacing any other, or cancels the pending plan of the same name if
+// plan has a height of zero
+func SetPlan(st acmstate.ReaderWriter, plan *Plan) error {
+	appliedHeight, err := AppliedHeight(st, plan.Name)
+	if err != nil {
+		return err
+	}
+	if appliedHeight > 0 {
+		return fmt.Errorf("upgrade %s was already applied at height %d", plan.Name, appliedHeight)
+	}
+	if plan.Height == 0 {
+		pending, err := GetPlan(st)
+		if err != nil {
+			return err
+		}
+		if pending == nil || pending.Name != plan.Name {
+			return fmt.Errorf("there is no pending upgrade %s to cancel", plan.Name)
+		}
+		return st.SetStorage(Address, planKey, nil)
+	}
+	acc, err := st.GetAccount(Address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		err = st.UpdateAccount(&acm.Account{Address: Address})
+		if err != nil {
+			return err
+		}
+	}
+	bs, err := encoding.Encode(plan)
+	if err != nil {
+		return err
+	}
+	return st.SetStorage(Address, planKey, bs)
+}
+
+// AppliedHeight returns the height at which the upgrade with name was applied or zero if it has not been
+func AppliedHeight(st acmstate.Reader, name string) (uint64, error) {
+	acc, err := st.GetAccount(Address)
+	if err != nil || acc == nil {
+		return 0, err
+	}
+	bs, err := st.GetStorage(Address, appliedKey(name))
+	if err != nil {
+		return 0, err
+	}
+	return binary.Uint64FromWord256(binary.LeftPadWord256(bs)), nil
+}
+
+// Records that plan has been applied at its height and clears it
+func complete(st acmstate.ReaderWriter, plan *Plan) error {
+	err := st.SetStorage(Address, appliedKey(plan.Name), binary.Uint64ToWord256(plan.Height).Bytes())
+	if err != nil {
+		return err
+	}
+	return st.SetStorage(Address, planKey, nil)
+}
+
+func appliedKey(name string) binary.Word256 {
+	return binary.LeftPadWord256(crypto.Keccak256(append([]byte("applied"), name...)))
+}
@@ -0,0 +1,19 @@
+package execution
+
+import (
+	"github.com/hyperledger/burrow/execution/upgrade"
+)
+
+// Run the migration of the upgrade planned for the block being executed, which only a binary supporting the upgrade
+// can do since nodes running the old binary halt before executing the block
+func (exe *executor) applyUpgrade() error {
+	plan, err := upgrade.GetPlan(exe.stateCache)
+	if err != nil || plan == nil || plan.Height > exe.block.Height {
+		return err
+	}
+	exe.logger.InfoMsg("Applying upgrade",
+		"upgrade", plan.Name,
+		"info", plan.Info,
+		"height", exe.block.Height)
+	return upgrade.Migrate(exe.stateCache, plan, exe.logger)
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/go-interpreter/wagon/wasm/leb128"
+	"github.com/perlin-network/life/compiler"
 	lifeExec "github.com/perlin-network/life/exec"
 	hex "github.com/tmthrgd/go-hex"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 )
@@ -31,6 +34,19 @@ const Revert = 2
 
 const ValueByteSize = 16
 
+// The value Life panics with when the instructions it has metered exceed its gas limit
+const lifeGasLimitExceeded = "gas limit exceeded"
+
+// Life instruments each basic block of a contract to add up the cost of its instructions (plus one for the block)
+// before it is executed, so metering does not depend on timing or the host
+type instructionGasPolicy int64
+
+var _ compiler.GasPolicy = instructionGasPolicy(0)
+
+func (policy instructionGasPolicy) GetCost(instr compiler.Instr) int64 {
+	return int64(policy)
+}
+
 func (c *Contract) Call(state engine.State, params engine.CallParams) (output []byte, err error) {
 	return engine.Call(state, params, c.execute)
 }
@@ -46,8 +62,15 @@ func (c *Contract) execute(state engine.State, params engine.CallParams) ([]byte
 		code:     c.code,
 	}
 
+	if params.Gas.Sign() <= 0 {
+		return nil, errors.Codes.InsufficientGas
+	}
+	vmConfig := c.vm.vmConfig
+	vmConfig.GasLimit = ctx.gasLeft()
+	gasPolicy := instructionGasPolicy(gas.ScheduleOrDefault(state.GasSchedule).WASMInstruction)
+
 	// panics in ResolveFunc() will be recovered for us, no need for our own
-	vm, err := lifeExec.NewVirtualMachine(c.code[0:int(wasmSize(c.code))], c.vm.vmConfig, ctx, nil)
+	vm, err := lifeExec.NewVirtualMachine(c.code[0:int(wasmSize(c.code))], vmConfig, ctx, gasPolicy)
 	if err != nil {
 		return nil, errors.Errorf(errors.Codes.InvalidContract, "%s: motherfucker %v", errHeader, err)
 	}
@@ -58,7 +81,15 @@ func (c *Contract) execute(state engine.State, params engine.CallParams) ([]byte
 	}
 
 	_, err = vm.Run(entryID)
-	if err != nil && errors.GetCode(err) == errors.Codes.ExecutionReverted {
+	if err != nil && err.Error() == lifeGasLimitExceeded {
+		return nil, errors.Codes.InsufficientGas
+	}
+	// Charge for any instructions executed since the last host function call
+	if gasErr := engine.UseGasNegative(params.Gas, vm.Gas-ctx.metered); gasErr != nil {
+		return nil, gasErr
+	}
+	if err != nil && (errors.GetCode(err) == errors.Codes.ExecutionReverted ||
+		errors.GetCode(err) == errors.Codes.InsufficientGas) {
 		return nil, err
 	}
 
@@ -77,10 +108,43 @@ type context struct {
 	output     []byte
 	returnData []byte
 	sequence   uint64
+	// The gas metered by Life that has already been charged to params
+	metered uint64
 }
 
 var _ lifeExec.ImportResolver = (*context)(nil)
 
+// Charge the gas for the instructions Life has metered since it was last charged along with gasToUse for a host
+// function, then allow Life to meter whatever gas remains
+func (ctx *context) useGas(vm *lifeExec.VirtualMachine, gasToUse uint64) {
+	err := engine.UseGasNegative(ctx.params.Gas, vm.Gas-ctx.metered)
+	if err == nil {
+		err = engine.UseGasNegative(ctx.params.Gas, gasToUse)
+	}
+	if err != nil {
+		panic(err)
+	}
+	ctx.metered = vm.Gas
+	ctx.resetGasLimit(vm)
+}
+
+// Life will panic once the gas it has metered exceeds its limit, which must be moved whenever params.Gas changes
+func (ctx *context) resetGasLimit(vm *lifeExec.VirtualMachine) {
+	vm.Config.GasLimit = ctx.metered + ctx.gasLeft()
+}
+
+// The gas left to the contract, capped so that it can be added to that metered by Life
+func (ctx *context) gasLeft() uint64 {
+	if !ctx.params.Gas.IsInt64() {
+		return math.MaxInt64
+	}
+	return ctx.params.Gas.Uint64()
+}
+
+func (ctx *context) schedule() *gas.Schedule {
+	return gas.ScheduleOrDefault(ctx.state.GasSchedule)
+}
+
 func (ctx *context) ResolveGlobal(module, field string) int64 {
 	panic(fmt.Sprintf("global %s module %s not found", field, module))
 }
@@ -238,6 +302,8 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 			var data []byte
 			copy(data, vm.Memory[dataPtr:dataPtr+dataLen])
 
+			ctx.useGas(vm, ctx.schedule().CreateAccount)
+
 			ctx.sequence++
 			nonce := make([]byte, txs.HashLength+8)
 			copy(nonce, ctx.vm.options.Nonce)
@@ -253,6 +319,7 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 			if err != nil {
 				return Error
 			}
+			ctx.state.CallFrame.MarkCreated(newAccountAddress)
 
 			res, err := ctx.vm.Contract(vm.Memory[dataPtr:dataPtr+dataLen]).Call(ctx.state, engine.CallParams{
 				Caller: ctx.params.Caller,
@@ -261,6 +328,8 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 				Value:  *value,
 				Gas:    ctx.params.Gas,
 			})
+			// The constructor has used gas from this contract's allowance
+			ctx.resetGasLimit(vm)
 
 			if err != nil {
 				if errors.GetCode(err) == errors.Codes.ExecutionReverted {
@@ -316,21 +385,7 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 					panic(err)
 				}
 			}
-			acc, err := ctx.state.GetAccount(ctx.params.Callee)
-			if err != nil {
-				panic(err)
-			}
-			balance := acc.Balance
-			err = acc.AddToBalance(balance)
-			if err != nil {
-				panic(err)
-			}
-
-			err = ctx.state.CallFrame.UpdateAccount(acc)
-			if err != nil {
-				panic(err)
-			}
-			err = ctx.state.CallFrame.RemoveAccount(ctx.params.Callee)
+			err = engine.SelfDestruct(ctx.state.CallFrame, ctx.vm.options.SelfDestruct, ctx.params.Callee, receiver)
 			if err != nil {
 				panic(err)
 			}
@@ -365,12 +420,14 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 				callType = exec.CallTypeCode
 			case "callStatic":
 				callType = exec.CallTypeStatic
-			case "callDeletegate":
+			case "callDelegate":
 				callType = exec.CallTypeDelegate
 			default:
 				panic("should not happen")
 			}
 
+			ctx.useGas(vm, 0)
+
 			var err error
 			ctx.returnData, err = engine.CallFromSite(ctx.state, ctx.vm.externalDispatcher, ctx.params,
 				engine.CallParams{
@@ -383,6 +440,7 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 			// Refund any remaining gas to be used on subsequent calls
 			ctx.params.Gas.Add(ctx.params.Gas, gasLimit)
+			ctx.resetGasLimit(vm)
 
 			// TODO[Silas]: we may need to consider trapping and non-trapping errors here in a bit more of a principled way
 			//   (e.g. we may be currently handling things that should abort execution, it might be better to clasify
@@ -464,6 +522,8 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 			copy(key[:], vm.Memory[keyPtr:keyPtr+32])
 			copy(value, vm.Memory[dataPtr:dataPtr+32])
 
+			ctx.useGas(vm, ctx.schedule().StorageUpdate)
+
 			err := ctx.state.SetStorage(ctx.params.Callee, key, value)
 			if err != nil {
 				panic(err)
@@ -537,6 +597,8 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 			address := crypto.Address{}
 
+			ctx.useGas(vm, ctx.schedule().GetAccount)
+
 			copy(address[:], vm.Memory[addressPtr:addressPtr+crypto.AddressLength])
 			acc, err := ctx.state.GetAccount(address)
 			if err != nil {
@@ -582,12 +644,14 @@ func (ctx *context) ResolveFunc(module, field string) lifeExec.FunctionImport {
 
 	case "getBlockGasLimit":
 		return func(vm *lifeExec.VirtualMachine) int64 {
+			ctx.useGas(vm, 0)
 			return ctx.params.Gas.Int64()
 		}
 
 	case "getGasLeft":
 		return func(vm *lifeExec.VirtualMachine) int64 {
 			// do the same as EVM
+			ctx.useGas(vm, 0)
 			return ctx.params.Gas.Int64()
 		}
 
@@ -51,9 +51,15 @@ func (vm *WVM) Execute(st acmstate.ReaderWriter, blockchain engine.Blockchain, e
 	st = native.NewState(vm.options.Natives, st)
 
 	state := engine.State{
-		CallFrame:  engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth),
-		Blockchain: blockchain,
-		EventSink:  eventSink,
+		CallFrame:   engine.NewCallFrame(st).WithMaxCallStackDepth(vm.options.CallStackMaxDepth),
+		Blockchain:  blockchain,
+		EventSink:   eventSink,
+		GasSchedule: vm.options.Schedule(),
+		CallTree:    vm.options.CallTree,
+	}
+	if params.CallType.IsCreate() {
+		// The account has been created for the contract by the transaction
+		state.CallFrame.MarkCreated(params.Callee)
 	}
 
 	output, err := vm.Contract(code).Call(state, params)
@@ -13,7 +13,9 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/gas"
 
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/stretchr/testify/require"
@@ -28,7 +30,7 @@ func TestStaticCallWithValue(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(10000000),
 	}
 
 	vm := Default()
@@ -93,7 +95,7 @@ func TestCREATE(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(10000000),
 	}
 
 	vm := New(engine.Options{Natives: native.MustDefaultNatives()})
@@ -145,7 +147,7 @@ func TestSelfDestruct(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(10000000),
 	}
 
 	vm := New(engine.Options{Natives: native.MustDefaultNatives()})
@@ -179,7 +181,7 @@ func TestMisc(t *testing.T) {
 		Callee: crypto.ZeroAddress,
 		Input:  []byte{},
 		Value:  *big.NewInt(0),
-		Gas:    big.NewInt(1000),
+		Gas:    big.NewInt(10000000),
 	}
 
 	vm := New(engine.Options{Natives: native.MustDefaultNatives()})
@@ -213,6 +215,51 @@ func TestMisc(t *testing.T) {
 	require.Equal(t, "0000000000000000000000000000000000000000000000000000000000000001", hex.EncodeToString(res))
 }
 
+func TestGasMetering(t *testing.T) {
+	blockchain := new(engine.TestBlockchain)
+	eventSink := exec.NewNoopEventSink()
+
+	// Returns the gas used to run the storage_test constructor when charging instructionCost for each WASM instruction
+	gasUsed := func(instructionCost uint64, gasLimit int64) (uint64, error) {
+		schedule := gas.DefaultSchedule()
+		schedule.WASMInstruction = instructionCost
+		vm := New(engine.Options{
+			GasSchedule: func() *gas.Schedule {
+				return schedule
+			},
+		})
+		params := engine.CallParams{
+			Origin: crypto.ZeroAddress,
+			Caller: crypto.ZeroAddress,
+			Callee: crypto.ZeroAddress,
+			Input:  []byte{},
+			Value:  *big.NewInt(0),
+			Gas:    big.NewInt(gasLimit),
+		}
+		_, err := vm.Execute(acmstate.NewMemoryState(), blockchain, eventSink, params, Bytecode_storage_test)
+		return uint64(gasLimit) - params.Gas.Uint64(), err
+	}
+
+	used, err := gasUsed(1, 10000000)
+	require.NoError(t, err)
+	require.True(t, used > 0)
+
+	// Metering is deterministic
+	usedAgain, err := gasUsed(1, 10000000)
+	require.NoError(t, err)
+	require.Equal(t, used, usedAgain)
+
+	// And scales with the cost of each instruction
+	usedDouble, err := gasUsed(2, 10000000)
+	require.NoError(t, err)
+	require.True(t, usedDouble > used)
+
+	_, err = gasUsed(1, int64(used/2))
+	require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+	_, err = gasUsed(1, 0)
+	require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+}
+
 func blockHashGetter(height uint64) []byte {
 	return binary.LeftPadWord256([]byte(fmt.Sprintf("block_hash_%d", height))).Bytes()
 }
@@ -11,6 +11,9 @@ import (
 	"fmt"
 	"path"
 
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
 	"github.com/fatih/color"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/binary"
@@ -22,11 +25,9 @@ import (
 	"github.com/hyperledger/burrow/forensics/storage"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
+	burrowStorage "github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/pkg/errors"
-	sm "github.com/tendermint/tendermint/state"
-	"github.com/tendermint/tendermint/store"
-	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	"github.com/xlab/treeprint"
 )
@@ -47,7 +48,7 @@ func NewSource(burrowDB, tmDB dbm.DB, genesisDoc *genesis.GenesisDoc) *Source {
 	// Avoid writing through to underlying DB
 	cacheDB := storage.NewCacheDB(burrowDB)
 	return &Source{
-		Explorer:   bcm.NewBlockStore(store.NewBlockStore(tmDB)),
+		Explorer:   bcm.NewBlockStore(store.NewBlockStore(burrowStorage.NewCometDB(tmDB))),
 		db:         burrowDB,
 		cacheDB:    cacheDB,
 		blockchain: bcm.NewBlockchain(cacheDB, genesisDoc),
@@ -56,12 +57,12 @@ func NewSource(burrowDB, tmDB dbm.DB, genesisDoc *genesis.GenesisDoc) *Source {
 	}
 }
 
-func NewSourceFromDir(genesisDoc *genesis.GenesisDoc, dbDir string) *Source {
-	burrowDB, err := dbm.NewDB(core.BurrowDBName, dbm.GoLevelDBBackend, dbDir)
+func NewSourceFromDir(genesisDoc *genesis.GenesisDoc, dbDir string, backend dbm.BackendType) *Source {
+	burrowDB, err := tendermint.DBProvider(core.BurrowDBName, backend, dbDir)
 	if err != nil {
 		panic(fmt.Errorf("could not create core DB for replay source: %w", err))
 	}
-	tmDB, err := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, path.Join(dbDir, "data"))
+	tmDB, err := tendermint.DBProvider("blockstore", backend, path.Join(dbDir, "data"))
 	if err != nil {
 		panic(fmt.Errorf("could not create blockstore DB for replay source: %w", err))
 	}
@@ -79,7 +80,7 @@ func NewSourceFromGenesis(genesisDoc *genesis.GenesisDoc) *Source {
 	if err != nil {
 		panic(err)
 	}
-	stateStore := sm.NewStore(tmDB)
+	stateStore := sm.NewStore(burrowStorage.NewCometDB(tmDB), sm.StoreOptions{})
 	err = stateStore.Save(st)
 	if err != nil {
 		panic(err)
@@ -15,13 +15,14 @@ import (
 
 	"github.com/hyperledger/burrow/consensus/tendermint"
 
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
+	burrowStorage "github.com/hyperledger/burrow/storage"
 	"github.com/stretchr/testify/require"
-	sm "github.com/tendermint/tendermint/state"
-	"github.com/tendermint/tendermint/store"
-	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -71,7 +72,7 @@ func makeChain(t *testing.T, max uint64) (*genesis.GenesisDoc, dbm.DB, dbm.DB) {
 	genesisDoc, _, validators := genesis.NewDeterministicGenesis(0).GenesisDoc(0, 1)
 
 	tmDB := dbm.NewMemDB()
-	bs := store.NewBlockStore(tmDB)
+	bs := store.NewBlockStore(burrowStorage.NewCometDB(tmDB))
 	gd := tendermint.DeriveGenesisDoc(genesisDoc, nil)
 	st, err := sm.MakeGenesisState(&types.GenesisDoc{
 		ChainID:    gd.ChainID,
@@ -117,12 +118,17 @@ func makeBlock(t *testing.T, st sm.State, bs *store.BlockStore, commit func(*typ
 		st.Validators.GetProposer().Address)
 
 	commit(block)
-	partSet := block.MakePartSet(2)
+	partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
 	commitSigs := []types.CommitSig{{Timestamp: time.Time{}}}
-	seenCommit := types.NewCommit(height, 0, types.BlockID{
-		Hash:          block.Hash(),
-		PartSetHeader: partSet.Header(),
-	}, commitSigs)
+	seenCommit := &types.Commit{
+		Height: height,
+		BlockID: types.BlockID{
+			Hash:          block.Hash(),
+			PartSetHeader: partSet.Header(),
+		},
+		Signatures: commitSigs,
+	}
 	bs.SaveBlock(block, partSet, seenCommit)
 }
 
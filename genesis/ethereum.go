@@ -0,0 +1,214 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/gas"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// EthereumGenesis is the genesis.json of a geth-style Ethereum chain, from which the accounts, contracts, and chain
+// parameters of an existing Ethereum testnet can be carried over to a Burrow chain
+type EthereumGenesis struct {
+	Config    EthereumChainConfig `json:"config"`
+	Timestamp string              `json:"timestamp"`
+	// Accounts by hex address
+	Alloc map[string]EthereumAccount `json:"alloc"`
+}
+
+// EthereumChainConfig holds those fields of the config of an Ethereum genesis that have a counterpart in Burrow
+type EthereumChainConfig struct {
+	ChainID      *big.Int `json:"chainId"`
+	LondonBlock  *big.Int `json:"londonBlock"`
+	ShanghaiTime *uint64  `json:"shanghaiTime"`
+	CancunTime   *uint64  `json:"cancunTime"`
+}
+
+// EthereumAccount is an account allocated by an Ethereum genesis, whose balance (in wei) may be decimal or 0x prefixed
+// hex and whose code and storage are hex. Its nonce has no counterpart in Burrow so is ignored.
+type EthereumAccount struct {
+	Balance string            `json:"balance"`
+	Code    string            `json:"code"`
+	Storage map[string]string `json:"storage"`
+	Nonce   string            `json:"nonce"`
+}
+
+func EthereumGenesisFromJSON(jsonBlob []byte) (*EthereumGenesis, error) {
+	ethGenesis := new(EthereumGenesis)
+	err := json.Unmarshal(jsonBlob, ethGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read Ethereum genesis: %v", err)
+	}
+	return ethGenesis, nil
+}
+
+// Accounts returns the allocated accounts ordered by address, with balances in wei rounded down to whole native tokens.
+// Accounts left with nothing in them, such as the precompiles given a balance of 1 wei by dev chains, are dropped.
+func (ethGenesis *EthereumGenesis) Accounts() ([]Account, error) {
+	accounts := make([]Account, 0, len(ethGenesis.Alloc))
+	for addressHex, ethAccount := range ethGenesis.Alloc {
+		address, err := crypto.AddressFromHexString(strings.TrimPrefix(addressHex, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("could not read address %s of Ethereum genesis account: %v", addressHex, err)
+		}
+		account, err := ethAccount.account(address)
+		if err != nil {
+			return nil, fmt.Errorf("Ethereum genesis account %v: %v", address, err)
+		}
+		if account.Amount == 0 && len(account.EVMCode) == 0 && len(account.Storage) == 0 {
+			continue
+		}
+		accounts = append(accounts, account)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address.Bytes(), accounts[j].Address.Bytes()) < 0
+	})
+	return accounts, nil
+}
+
+func (ethAccount EthereumAccount) account(address crypto.Address) (Account, error) {
+	account := Account{
+		BasicAccount: BasicAccount{
+			Address: address,
+		},
+	}
+	wei, err := parseEthereumInt(ethAccount.Balance)
+	if err != nil {
+		return account, fmt.Errorf("could not read balance: %v", err)
+	}
+	native := balance.WeiToNative(wei)
+	if !native.IsUint64() {
+		return account, fmt.Errorf("balance of %v native tokens does not fit in 64 bits", native)
+	}
+	account.Amount = native.Uint64()
+	code, err := hex.DecodeString(strings.TrimPrefix(ethAccount.Code, "0x"))
+	if err != nil {
+		return account, fmt.Errorf("could not read code: %v", err)
+	}
+	if len(code) > 0 {
+		account.EVMCode = code
+	}
+	for keyHex, valueHex := range ethAccount.Storage {
+		key, err := parseEthereumWord(keyHex)
+		if err != nil {
+			return account, fmt.Errorf("could not read storage key %s: %v", keyHex, err)
+		}
+		value, err := parseEthereumWord(valueHex)
+		if err != nil {
+			return account, fmt.Errorf("could not read storage value %s at %v: %v", valueHex, key, err)
+		}
+		// Zero slots are not stored
+		if value != binary.Zero256 {
+			account.Storage = append(account.Storage, StorageEntry{Key: key, Value: value})
+		}
+	}
+	sort.Slice(account.Storage, func(i, j int) bool {
+		return account.Storage[i].Key.Compare(account.Storage[j].Key) < 0
+	})
+	return account, nil
+}
+
+// Import carries ethGenesis over into genesisDoc, which provides the validators (and any other accounts) of the
+// Burrow chain. The Ethereum chain ID is kept so that Ethereum clients and signed transactions continue to work, as is
+// the genesis time unless zero. The London, Shanghai, and Cancun upgrades active at genesis set the Burrow params
+// closest to them. An allocated account already in genesisDoc has the imported balance added to its own and takes any
+// imported code and storage, otherwise imported accounts take the global permissions. Burrow prices gas in native
+// tokens rather than wei, so no fee market is carried over from the base fee of London.
+func (ethGenesis *EthereumGenesis) Import(genesisDoc *GenesisDoc) error {
+	accounts, err := ethGenesis.Accounts()
+	if err != nil {
+		return err
+	}
+	if ethGenesis.Config.ChainID != nil {
+		genesisDoc.ChainID = ethGenesis.Config.ChainID.String()
+	}
+	timestamp, err := parseEthereumInt(ethGenesis.Timestamp)
+	if err != nil {
+		return fmt.Errorf("could not read timestamp of Ethereum genesis: %v", err)
+	}
+	if timestamp.Sign() != 0 {
+		if !timestamp.IsInt64() {
+			return fmt.Errorf("timestamp %v of Ethereum genesis is out of range", timestamp)
+		}
+		genesisDoc.GenesisTime = time.Unix(timestamp.Int64(), 0).UTC()
+	}
+	config := ethGenesis.Config
+	if config.LondonBlock != nil && config.LondonBlock.Sign() == 0 {
+		genesisDoc.Params.GasRefunds = gas.ModernRefunds
+	}
+	// Burrow enables Shanghai's PUSH0 with the Cancun opcodes, so the later of the two decides
+	if activeAtGenesis(config.ShanghaiTime, timestamp) && activeAtGenesis(config.CancunTime, timestamp) {
+		var height uint64
+		genesisDoc.Params.CancunHeight = &height
+		genesisDoc.Params.SelfDestruct = "eip6780"
+	}
+	indices := make(map[crypto.Address]int, len(genesisDoc.Accounts))
+	for i, account := range genesisDoc.Accounts {
+		indices[account.Address] = i
+	}
+	for _, account := range accounts {
+		i, ok := indices[account.Address]
+		if !ok {
+			genesisDoc.Accounts = append(genesisDoc.Accounts, account)
+			continue
+		}
+		existing := &genesisDoc.Accounts[i]
+		if existing.Amount+account.Amount < existing.Amount {
+			return fmt.Errorf("balance of account %v overflows when adding its Ethereum genesis balance",
+				account.Address)
+		}
+		existing.Amount += account.Amount
+		if len(account.EVMCode) > 0 {
+			existing.EVMCode = account.EVMCode
+			existing.Storage = account.Storage
+		}
+	}
+	return nil
+}
+
+func activeAtGenesis(forkTime *uint64, timestamp *big.Int) bool {
+	return forkTime != nil && new(big.Int).SetUint64(*forkTime).Cmp(timestamp) <= 0
+}
+
+// Parses a decimal integer, or a hexadecimal one prefixed with 0x, as in Ethereum genesis files, with empty being zero
+func parseEthereumInt(str string) (*big.Int, error) {
+	if str == "" {
+		return new(big.Int), nil
+	}
+	base := 10
+	digits := str
+	if strings.HasPrefix(str, "0x") || strings.HasPrefix(str, "0X") {
+		base = 16
+		digits = str[2:]
+	}
+	n, ok := new(big.Int).SetString(digits, base)
+	if !ok || n.Sign() < 0 {
+		return nil, fmt.Errorf("'%s' is not a non-negative integer", str)
+	}
+	return n, nil
+}
+
+// Parses hex of up to 32 bytes, left padding it to a word
+func parseEthereumWord(str string) (binary.Word256, error) {
+	str = strings.TrimPrefix(str, "0x")
+	if len(str)%2 == 1 {
+		str = "0" + str
+	}
+	bs, err := hex.DecodeString(str)
+	if err != nil {
+		return binary.Zero256, err
+	}
+	if len(bs) > binary.Word256Bytes {
+		return binary.Zero256, fmt.Errorf("longer than %d bytes", binary.Word256Bytes)
+	}
+	return binary.LeftPadWord256(bs), nil
+}
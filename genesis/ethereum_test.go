@@ -0,0 +1,131 @@
+package genesis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/gas"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ethereumGenesisJSON = `{
+  "config": {
+    "chainId": 1337,
+    "homesteadBlock": 0,
+    "londonBlock": 0,
+    "shanghaiTime": 0,
+    "cancunTime": 0
+  },
+  "timestamp": "0x6553f100",
+  "gasLimit": "0x1c9c380",
+  "baseFeePerGas": "0x3b9aca00",
+  "alloc": {
+    "0x0000000000000000000000000000000000000001": {"balance": "0x1"},
+    "0x00000000000000000000000000000000000000aa": {"balance": "2000000000000000000"},
+    "00000000000000000000000000000000000000bb": {
+      "balance": "0xde0b6b3a7640000",
+      "code": "0x60005460010160005500",
+      "nonce": "0x1",
+      "storage": {
+        "0x00": "0x05",
+        "0x0000000000000000000000000000000000000000000000000000000000000002": "0x0",
+        "0x01": "0xff"
+      }
+    }
+  }
+}`
+
+func TestEthereumGenesis_Accounts(t *testing.T) {
+	ethGenesis, err := EthereumGenesisFromJSON([]byte(ethereumGenesisJSON))
+	require.NoError(t, err)
+	accounts, err := ethGenesis.Accounts()
+	require.NoError(t, err)
+	// The precompile with 1 wei is dropped
+	require.Len(t, accounts, 2)
+
+	assert.Equal(t, crypto.Address{19: 0xaa}, accounts[0].Address)
+	assert.Equal(t, uint64(2), accounts[0].Amount)
+	assert.Empty(t, accounts[0].EVMCode)
+
+	assert.Equal(t, crypto.Address{19: 0xbb}, accounts[1].Address)
+	assert.Equal(t, uint64(1), accounts[1].Amount)
+	assert.Equal(t, acm.Bytecode{0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x60, 0x00, 0x55, 0x00}, accounts[1].EVMCode)
+	assert.Equal(t, []StorageEntry{
+		{Key: binary.Int64ToWord256(0), Value: binary.Int64ToWord256(5)},
+		{Key: binary.Int64ToWord256(1), Value: binary.Int64ToWord256(255)},
+	}, accounts[1].Storage)
+}
+
+func TestEthereumGenesis_Import(t *testing.T) {
+	ethGenesis, err := EthereumGenesisFromJSON([]byte(ethereumGenesisJSON))
+	require.NoError(t, err)
+	genDoc := MakeGenesisDocFromAccounts("test-chain", nil, genesisTime, nil, validatorMap("Foo"))
+	genDoc.Accounts = []Account{{
+		BasicAccount: BasicAccount{Address: crypto.Address{19: 0xaa}, Amount: 3},
+		Name:         "aa",
+		Permissions:  permission.AllAccountPermissions,
+	}}
+	require.NoError(t, ethGenesis.Import(genDoc))
+
+	assert.Equal(t, "1337", genDoc.ChainID)
+	assert.Equal(t, time.Unix(0x6553f100, 0).UTC(), genDoc.GenesisTime)
+	assert.Equal(t, gas.ModernRefunds, genDoc.Params.GasRefunds)
+	require.NotNil(t, genDoc.Params.CancunHeight)
+	assert.Equal(t, uint64(0), *genDoc.Params.CancunHeight)
+	assert.Equal(t, "eip6780", genDoc.Params.SelfDestruct)
+	assert.Nil(t, genDoc.Params.FeeMarket)
+	assert.Len(t, genDoc.Validators, 1)
+
+	require.Len(t, genDoc.Accounts, 2)
+	// The existing account keeps its name and permissions
+	assert.Equal(t, "aa", genDoc.Accounts[0].Name)
+	assert.Equal(t, uint64(5), genDoc.Accounts[0].Amount)
+	assert.Equal(t, permission.AllAccountPermissions, genDoc.Accounts[0].Permissions)
+	assert.Equal(t, crypto.Address{19: 0xbb}, genDoc.Accounts[1].Address)
+	assert.Equal(t, permission.ZeroAccountPermissions, genDoc.Accounts[1].Permissions)
+
+	// Code and storage survive a round trip
+	bs, err := genDoc.JSONBytes()
+	require.NoError(t, err)
+	genDocOut, err := GenesisDocFromJSON(bs)
+	require.NoError(t, err)
+	assert.Equal(t, genDoc.Accounts[1], genDocOut.Accounts[1])
+}
+
+func TestEthereumGenesis_Forks(t *testing.T) {
+	later := uint64(100)
+	ethGenesis := &EthereumGenesis{Config: EthereumChainConfig{ShanghaiTime: new(uint64), CancunTime: &later}}
+	genDoc := new(GenesisDoc)
+	require.NoError(t, ethGenesis.Import(genDoc))
+	assert.Nil(t, genDoc.Params.CancunHeight, "Cancun is not active at genesis")
+	assert.Equal(t, gas.RefundPolicy(""), genDoc.Params.GasRefunds)
+	assert.Equal(t, "", genDoc.ChainID)
+}
+
+func TestEthereumGenesis_Errors(t *testing.T) {
+	const aa = `"0x00000000000000000000000000000000000000aa"`
+	for alloc, expected := range map[string]string{
+		`{"0xzz": {}}`:                      "could not read address 0xzz",
+		`{"0x01": {}}`:                      "could not read address 0x01",
+		`{` + aa + `: {"balance": "lots"}}`: "could not read balance",
+		`{` + aa + `: {"balance": "0x1` + zeros(36) + `"}}`:            "does not fit in 64 bits",
+		`{` + aa + `: {"code": "0xf"}}`:                                "could not read code",
+		`{` + aa + `: {"storage": {"0x01": "0x01` + zeros(64) + `"}}}`: "longer than 32 bytes",
+	} {
+		ethGenesis, err := EthereumGenesisFromJSON([]byte(`{"alloc": ` + alloc + `}`))
+		require.NoError(t, err)
+		_, err = ethGenesis.Accounts()
+		require.Error(t, err, alloc)
+		assert.Contains(t, err.Error(), expected)
+	}
+}
+
+func zeros(n int) string {
+	return strings.Repeat("0", n)
+}
@@ -15,6 +15,9 @@ import (
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/feemarket"
+	"github.com/hyperledger/burrow/execution/gas"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/permission"
 )
 
@@ -37,6 +40,25 @@ type Account struct {
 	BasicAccount
 	Name        string
 	Permissions permission.AccountPermissions
+	// The EVM code of a contract account carried over from another chain
+	EVMCode acm.Bytecode `json:",omitempty" toml:",omitempty"`
+	// The storage of a contract account carried over from another chain, ordered by key
+	Storage []StorageEntry `json:",omitempty" toml:",omitempty"`
+	// Parts of Amount that cannot be spent until the block height at which each unlocks, such as the tranches of a
+	// vesting schedule (see LinearVesting) or the whole of Amount to lock the account until some height
+	Locks []acm.BalanceLock `json:",omitempty" toml:",omitempty"`
+	// The sequence number of an account carried over from another chain, so that the addresses of contracts it goes
+	// on to create do not collide with those it created there
+	Sequence uint64 `json:",omitempty" toml:",omitempty"`
+	// The WASM code of a contract account carried over from another chain
+	WASMCode acm.Bytecode `json:",omitempty" toml:",omitempty"`
+	// The metadata of a contract account carried over from another chain, with each Metadata inline
+	ContractMeta []*acm.ContractMeta `json:",omitempty" toml:",omitempty"`
+}
+
+type StorageEntry struct {
+	Key   binary.Word256
+	Value binary.Word256
 }
 
 type Validator struct {
@@ -51,6 +73,42 @@ var DefaultPermissionsAccount = PermissionsAccount(permission.DefaultAccountPerm
 
 type params struct {
 	ProposalThreshold uint64
+	// Block height from which the Shanghai/Cancun opcodes PUSH0, MCOPY, TLOAD, and TSTORE are enabled (disabled if nil)
+	CancunHeight *uint64 `json:",omitempty" toml:",omitempty"`
+	// Enables EIP-1559 fee market semantics with a base fee per unit of gas adjusted each block (disabled if nil)
+	FeeMarket *feemarket.Params `json:",omitempty" toml:",omitempty"`
+	// The gas charged for VM operations until updated by governance (the default schedule if nil)
+	GasSchedule *gas.Schedule `json:",omitempty" toml:",omitempty"`
+	// Transactions in a block are executed together in parallel after they have all been delivered rather than one at a
+	// time as each is delivered. The results are the same either way but transactions are acknowledged differently by
+	// consensus so all validators must agree.
+	ParallelExecution bool `json:",omitempty" toml:",omitempty"`
+	// Record the accounts and storage each transaction reads and changes with its execution. These are stored with
+	// the block so all validators must agree.
+	AccessSets bool `json:",omitempty" toml:",omitempty"`
+	// Record contract creations as such, and the code address and gas used of each internal call, in the call events
+	// of transactions. These are stored with the block so all validators must agree.
+	CallTree bool `json:",omitempty" toml:",omitempty"`
+	// How gas is refunded for clearing storage: 'legacy' or 'modern' (EIP-3529) as for Ethereum before and since the
+	// London upgrade, or 'none' (the default)
+	GasRefunds gas.RefundPolicy `json:",omitempty" toml:",omitempty"`
+	// What happens when a contract self-destructs: 'legacy' (the default) removes it, 'eip6780' only removes it within
+	// the transaction that created it, otherwise just sending its balance, and 'disabled' makes self-destructing fail
+	SelfDestruct string `json:",omitempty" toml:",omitempty"`
+	// The order the transactions of a block are executed in: 'arrival' or 'hash' execute those offering the most fee
+	// per gas first, breaking ties by their order in the block or by their hashes, and 'none' (the default) keeps the
+	// order of the block. Changes the results of execution so all validators must agree.
+	FeeOrdering string `json:",omitempty" toml:",omitempty"`
+	// Proposers put the transactions of each input in sequence order, leaving out those that cannot follow on, and
+	// order the rest by the fee they offer. Validators refuse proposals that do not verify or are out of sequence order
+	// so all validators must agree.
+	ProposalOrdering bool `json:",omitempty" toml:",omitempty"`
+	// The number of blocks after an UnbondTx before the power it unbonds is paid back to the validator's balance, during
+	// which it is pending withdrawal. Zero (the default) pays it straight away.
+	UnbondingBlocks uint64 `json:",omitempty" toml:",omitempty"`
+	// Remove name registry entries from state at the end of the block at which they expire, recording them in that
+	// block. Changes state so all validators must agree.
+	SweepExpiredNames bool `json:",omitempty" toml:",omitempty"`
 }
 
 type GenesisDoc struct {
@@ -64,6 +122,8 @@ type GenesisDoc struct {
 	GlobalPermissions permission.AccountPermissions
 	Accounts          []Account
 	Validators        []Validator
+	// Name registry entries carried over from another chain, expiring at heights of this one
+	Names []*names.Entry `json:",omitempty" toml:",omitempty"`
 	// memo
 	chainID string
 	hash    []byte
@@ -157,6 +217,7 @@ func GenesisAccountFromAccount(name string, account *acm.Account) Account {
 			Address: account.Address,
 			Amount:  account.Balance,
 		},
+		Locks: account.Locks,
 	}
 }
 
@@ -168,8 +229,14 @@ func (genesisAccount *Account) Clone() Account {
 			Address: genesisAccount.Address,
 			Amount:  genesisAccount.Amount,
 		},
-		Name:        genesisAccount.Name,
-		Permissions: genesisAccount.Permissions.Clone(),
+		Name:         genesisAccount.Name,
+		Permissions:  genesisAccount.Permissions.Clone(),
+		EVMCode:      genesisAccount.EVMCode,
+		Storage:      genesisAccount.Storage,
+		Locks:        genesisAccount.Locks,
+		Sequence:     genesisAccount.Sequence,
+		WASMCode:     genesisAccount.WASMCode,
+		ContractMeta: genesisAccount.ContractMeta,
 	}
 }
 
@@ -179,7 +246,30 @@ func (genesisAccount *Account) AcmAccount() *acm.Account {
 		PublicKey:   genesisAccount.PublicKey,
 		Balance:     genesisAccount.Amount,
 		Permissions: genesisAccount.Permissions,
+		Locks:       genesisAccount.Locks,
+	}
+}
+
+// LinearVesting locks amount so that it unlocks in equal tranches (with any remainder in the last) at evenly spaced
+// heights from the height after fromHeight up to toHeight, by which all of it is unlocked
+func LinearVesting(amount, fromHeight, toHeight, tranches uint64) ([]acm.BalanceLock, error) {
+	if tranches == 0 || toHeight <= fromHeight {
+		return nil, fmt.Errorf("vesting needs at least one tranche and a toHeight after fromHeight, but got %d "+
+			"tranches from %d to %d", tranches, fromHeight, toHeight)
+	}
+	if tranches > toHeight-fromHeight {
+		tranches = toHeight - fromHeight
+	}
+	locks := make([]acm.BalanceLock, tranches)
+	for i := range locks {
+		n := uint64(i) + 1
+		locks[i] = acm.BalanceLock{
+			Amount:       amount / tranches,
+			UnlockHeight: fromHeight + (toHeight-fromHeight)*n/tranches,
+		}
 	}
+	locks[tranches-1].Amount += amount % tranches
+	return locks, nil
 }
 
 //------------------------------------------------------------
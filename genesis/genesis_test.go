@@ -46,6 +46,24 @@ func TestGenesisStability(t *testing.T) {
 	require.Equal(t, "C5B64E6AD231221C328271ADCE401AA11F9DF12830F7DA2FC3B2C923E929C532", genDoc.Hash().String())
 }
 
+func TestLinearVesting(t *testing.T) {
+	locks, err := LinearVesting(1000, 100, 400, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []acm.BalanceLock{
+		{Amount: 333, UnlockHeight: 200},
+		{Amount: 333, UnlockHeight: 300},
+		{Amount: 334, UnlockHeight: 400},
+	}, locks)
+
+	// No more tranches than blocks
+	locks, err = LinearVesting(10, 0, 2, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []acm.BalanceLock{{Amount: 5, UnlockHeight: 1}, {Amount: 5, UnlockHeight: 2}}, locks)
+
+	_, err = LinearVesting(10, 5, 5, 1)
+	require.Error(t, err)
+}
+
 func accountMap(names ...string) map[string]*acm.Account {
 	accounts := make(map[string]*acm.Account, len(names))
 	for _, name := range names {
@@ -0,0 +1,249 @@
+package spec
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/permission"
+	hex "github.com/tmthrgd/go-hex"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// The fields of each account in an account list. Names are matched ignoring case, spaces, hyphens, and underscores, so
+// PublicKey, public-key, and public_key are the same field.
+const (
+	accountFieldName        = "name"
+	accountFieldAddress     = "address"
+	accountFieldPublicKey   = "publickey"
+	accountFieldCurve       = "curve"
+	accountFieldBalance     = "balance"
+	accountFieldPower       = "power"
+	accountFieldPermissions = "permissions"
+	accountFieldRoles       = "roles"
+)
+
+var accountFields = []string{accountFieldName, accountFieldAddress, accountFieldPublicKey, accountFieldCurve,
+	accountFieldBalance, accountFieldPower, accountFieldPermissions, accountFieldRoles}
+
+// AccountsFromFile reads the accounts of a GenesisSpec from a list kept in a CSV file, or in a YAML or JSON file
+// otherwise, so that the accounts of a chain with many members can be kept as a spreadsheet rather than written into
+// a GenesisSpec by hand. Each account may have a name, an address or a hex public key (optionally with its curve), a
+// balance, a validator power, and lists of permissions and roles. An account with neither address nor public key is
+// given a new key when the GenesisSpec is realised, and one without permissions the default permissions.
+func AccountsFromFile(file string) ([]TemplateAccount, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var accounts []TemplateAccount
+	if strings.EqualFold(filepath.Ext(file), ".csv") {
+		accounts, err = AccountsFromCSV(f)
+	} else {
+		accounts, err = AccountsFromYAML(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read accounts from %s: %w", file, err)
+	}
+	return accounts, nil
+}
+
+// AccountsFromCSV reads accounts from CSV with a header row naming the field of each column. In a CSV file the
+// permissions and roles of an account are separated by spaces or semicolons.
+func AccountsFromCSV(r io.Reader) ([]TemplateAccount, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read header: %w", err)
+	}
+	columns := make([]string, len(header))
+	for i, column := range header {
+		columns[i], err = accountField(column)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var entries []map[string]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry := make(map[string]string, len(record))
+		for i, cell := range record {
+			entry[columns[i]] = strings.TrimSpace(cell)
+		}
+		entries = append(entries, entry)
+	}
+	return accountsFromEntries(entries, "row", 2)
+}
+
+// AccountsFromYAML reads accounts from a YAML (or JSON) list of objects with a key for each field given
+func AccountsFromYAML(r io.Reader) ([]TemplateAccount, error) {
+	var list []map[string]yamlAccountValue
+	err := yaml.NewDecoder(r).Decode(&list)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	entries := make([]map[string]string, len(list))
+	for i, item := range list {
+		entries[i] = make(map[string]string, len(item))
+		for key, value := range item {
+			field, err := accountField(key)
+			if err != nil {
+				return nil, fmt.Errorf("account %d: %w", i+1, err)
+			}
+			entries[i][field] = string(value)
+		}
+	}
+	return accountsFromEntries(entries, "account", 1)
+}
+
+// A value in a YAML account list kept as written, so that an address of only digits is not read as a number, with a
+// list joined as in a CSV cell
+type yamlAccountValue string
+
+func (value *yamlAccountValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if unmarshal(&list) == nil {
+		*value = yamlAccountValue(strings.Join(list, ";"))
+		return nil
+	}
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return fmt.Errorf("should be a value or a list: %w", err)
+	}
+	*value = yamlAccountValue(str)
+	return nil
+}
+
+func accountField(name string) (string, error) {
+	field := strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+	for _, f := range accountFields {
+		if f == field {
+			return field, nil
+		}
+	}
+	return "", fmt.Errorf("unknown account field '%s', expected one of %s", name,
+		strings.Join(accountFields, ", "))
+}
+
+// Reads the entries, numbered from first as the kind of thing they were read from in errors, checking no two share a
+// name or address
+func accountsFromEntries(entries []map[string]string, kind string, first int) ([]TemplateAccount, error) {
+	accounts := make([]TemplateAccount, 0, len(entries))
+	names := make(map[string]int)
+	addresses := make(map[crypto.Address]int)
+	for i, entry := range entries {
+		n := first + i
+		account, err := accountFromEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s %d: %w", kind, n, err)
+		}
+		if account.Name != "" {
+			if m, ok := names[account.Name]; ok {
+				return nil, fmt.Errorf("%s %d: account name %s is already given to %s %d", kind, n, account.Name,
+					kind, m)
+			}
+			names[account.Name] = n
+		}
+		if account.Address != nil {
+			if m, ok := addresses[*account.Address]; ok {
+				return nil, fmt.Errorf("%s %d: address %v is already given to %s %d", kind, n, account.Address,
+					kind, m)
+			}
+			addresses[*account.Address] = n
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+func accountFromEntry(entry map[string]string) (TemplateAccount, error) {
+	account := TemplateAccount{Name: entry[accountFieldName]}
+	if str := entry[accountFieldAddress]; str != "" {
+		address, err := crypto.AddressFromHexString(strings.TrimPrefix(str, "0x"))
+		if err != nil {
+			return account, fmt.Errorf("could not read address '%s': %w", str, err)
+		}
+		account.Address = &address
+	}
+	if str := entry[accountFieldPublicKey]; str != "" {
+		curve := crypto.CurveTypeEd25519
+		if entry[accountFieldCurve] != "" {
+			var err error
+			curve, err = crypto.CurveTypeFromString(strings.ToLower(entry[accountFieldCurve]))
+			if err != nil {
+				return account, err
+			}
+		}
+		bs, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+		if err != nil {
+			return account, fmt.Errorf("could not read public key '%s': %w", str, err)
+		}
+		account.PublicKey, err = crypto.PublicKeyFromBytes(bs, curve)
+		if err != nil {
+			return account, fmt.Errorf("could not read public key '%s': %w", str, err)
+		}
+		address := account.PublicKey.GetAddress()
+		if account.Address != nil && *account.Address != address {
+			return account, fmt.Errorf("address %v is not that of public key %v", account.Address,
+				account.PublicKey)
+		}
+		account.Address = &address
+	}
+	for _, b := range []struct {
+		field string
+		add   func(balance.Balances, uint64) balance.Balances
+	}{
+		{accountFieldBalance, balance.Balances.Native},
+		{accountFieldPower, balance.Balances.Power},
+	} {
+		if str := entry[b.field]; str != "" {
+			amount, err := strconv.ParseUint(str, 10, 64)
+			if err != nil {
+				return account, fmt.Errorf("could not read %s '%s': %w", b.field, str, err)
+			}
+			account.Amounts = b.add(account.Amounts, amount)
+		}
+	}
+	if account.Balances().HasPower() && account.PublicKey != nil &&
+		account.PublicKey.CurveType != crypto.CurveTypeEd25519 {
+		return account, fmt.Errorf("a validator must have an ed25519 public key")
+	}
+	if str := entry[accountFieldPermissions]; str != "" {
+		account.Permissions = listOf(str)
+		_, err := permission.PermFlagFromStringList(account.Permissions)
+		if err != nil {
+			return account, err
+		}
+	}
+	if str := entry[accountFieldRoles]; str != "" {
+		account.Roles = listOf(str)
+	}
+	return account, nil
+}
+
+func listOf(str string) []string {
+	return strings.FieldsFunc(str, func(r rune) bool {
+		return r == ';' || unicode.IsSpace(r)
+	})
+}
@@ -0,0 +1,105 @@
+package spec
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountsFromFile(t *testing.T) {
+	key := crypto.PrivateKeyFromSecret("validator", crypto.CurveTypeEd25519)
+	publicKey := key.GetPublicKey()
+	address := crypto.Address{1, 2, 3}
+	expected := []TemplateAccount{
+		{
+			Name:        "alice",
+			Address:     &address,
+			Amounts:     balance.New().Native(100),
+			Permissions: []string{permission.SendString, permission.CallString},
+			Roles:       []string{"member", "auditor"},
+		},
+		{
+			Name:      "validator",
+			Address:   publicKeyAddress(publicKey),
+			PublicKey: publicKey,
+			Amounts:   balance.New().Native(5).Power(10),
+		},
+		{
+			Name: "bob",
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "accounts")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	csvFile := filepath.Join(dir, "accounts.csv")
+	require.NoError(t, ioutil.WriteFile(csvFile, []byte(`Name,Address,Public Key,Balance,Power,Permissions,Roles
+alice,`+address.String()+`,,100,,send;call,"member auditor"
+validator,,`+publicKey.String()+`,5,10,,
+bob,,,,,,
+`), 0644))
+	accounts, err := AccountsFromFile(csvFile)
+	require.NoError(t, err)
+	assert.Equal(t, expected, accounts)
+
+	yamlFile := filepath.Join(dir, "accounts.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte(`
+- name: alice
+  address: `+address.String()+`
+  balance: 100
+  permissions: [send, call]
+  roles: [member, auditor]
+- name: validator
+  public-key: `+publicKey.String()+`
+  curve: ed25519
+  balance: 5
+  power: 10
+- name: bob
+`), 0644))
+	accounts, err = AccountsFromFile(yamlFile)
+	require.NoError(t, err)
+	assert.Equal(t, expected, accounts)
+
+	// The accounts make a genesis
+	keyClient := keys.NewLocalKeyClient(keys.NewMemoryKeyStore(), logging.NewNoopLogger())
+	_, err = keyClient.Generate("", crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	genesisSpec := GenesisSpec{Accounts: accounts[1:]}
+	genesisDoc, err := genesisSpec.GenesisDoc(keyClient, crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	require.Len(t, genesisDoc.Accounts, 2)
+	require.Len(t, genesisDoc.Validators, 1)
+	assert.Equal(t, publicKey.GetAddress(), genesisDoc.Validators[0].Address)
+}
+
+func TestAccountsFromCSV_Errors(t *testing.T) {
+	for csv, expected := range map[string]string{
+		"Name,Nickname\n":               "unknown account field 'Nickname'",
+		"Name,Balance\nalice,lots\n":    "row 2: could not read balance 'lots'",
+		"Name,Permissions\nalice,fly\n": "row 2: ",
+		"Name\nalice\nbob\nalice\n":     "row 4: account name alice is already given to row 2",
+		"Address\nnot-hex\n":            "row 2: could not read address 'not-hex'",
+		"PublicKey,Curve,Power\n" + crypto.PrivateKeyFromSecret("v", crypto.CurveTypeSecp256k1).GetPublicKey().String() +
+			",secp256k1,1\n": "row 2: a validator must have an ed25519 public key",
+	} {
+		_, err := AccountsFromCSV(strings.NewReader(csv))
+		require.Error(t, err, csv)
+		assert.Contains(t, err.Error(), expected)
+	}
+}
+
+func publicKeyAddress(publicKey *crypto.PublicKey) *crypto.Address {
+	address := publicKey.GetAddress()
+	return &address
+}
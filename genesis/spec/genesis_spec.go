@@ -9,6 +9,8 @@ import (
 
 	"github.com/hyperledger/burrow/acm/balance"
 	crypto "github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/feemarket"
+	"github.com/hyperledger/burrow/execution/gas"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/permission"
@@ -30,10 +32,28 @@ type GenesisSpec struct {
 	Salt              []byte            `json:",omitempty" toml:",omitempty"`
 	GlobalPermissions []string          `json:",omitempty" toml:",omitempty"`
 	Accounts          []TemplateAccount `json:",omitempty" toml:",omitempty"`
+	// A BIP-39 mnemonic from which to derive the keys of accounts with neither an Address nor a PublicKey (other than
+	// validators) so that everyone configuring a dev chain from the spec gets the same well-known accounts
+	Mnemonic string `json:",omitempty" toml:",omitempty"`
+	// The path under which the keys are derived, the nth such account at DerivationPath/n, by default that of
+	// Ethereum wallets
+	DerivationPath string `json:",omitempty" toml:",omitempty"`
 }
 
 type params struct {
-	ProposalThreshold uint64 `json:",omitempty" toml:",omitempty"`
+	ProposalThreshold uint64            `json:",omitempty" toml:",omitempty"`
+	CancunHeight      *uint64           `json:",omitempty" toml:",omitempty"`
+	FeeMarket         *feemarket.Params `json:",omitempty" toml:",omitempty"`
+	GasSchedule       *gas.Schedule     `json:",omitempty" toml:",omitempty"`
+	ParallelExecution bool              `json:",omitempty" toml:",omitempty"`
+	AccessSets        bool              `json:",omitempty" toml:",omitempty"`
+	CallTree          bool              `json:",omitempty" toml:",omitempty"`
+	GasRefunds        gas.RefundPolicy  `json:",omitempty" toml:",omitempty"`
+	SelfDestruct      string            `json:",omitempty" toml:",omitempty"`
+	FeeOrdering       string            `json:",omitempty" toml:",omitempty"`
+	ProposalOrdering  bool              `json:",omitempty" toml:",omitempty"`
+	UnbondingBlocks   uint64            `json:",omitempty" toml:",omitempty"`
+	SweepExpiredNames bool              `json:",omitempty" toml:",omitempty"`
 }
 
 // Produce a fully realised GenesisDoc from a template GenesisDoc that may omit values
@@ -54,6 +74,18 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, curve crypto.CurveTy
 	if gs.Params.ProposalThreshold != 0 {
 		genesisDoc.Params.ProposalThreshold = genesis.DefaultProposalThreshold
 	}
+	genesisDoc.Params.CancunHeight = gs.Params.CancunHeight
+	genesisDoc.Params.FeeMarket = gs.Params.FeeMarket
+	genesisDoc.Params.GasSchedule = gs.Params.GasSchedule
+	genesisDoc.Params.ParallelExecution = gs.Params.ParallelExecution
+	genesisDoc.Params.AccessSets = gs.Params.AccessSets
+	genesisDoc.Params.CallTree = gs.Params.CallTree
+	genesisDoc.Params.GasRefunds = gs.Params.GasRefunds
+	genesisDoc.Params.SelfDestruct = gs.Params.SelfDestruct
+	genesisDoc.Params.FeeOrdering = gs.Params.FeeOrdering
+	genesisDoc.Params.ProposalOrdering = gs.Params.ProposalOrdering
+	genesisDoc.Params.UnbondingBlocks = gs.Params.UnbondingBlocks
+	genesisDoc.Params.SweepExpiredNames = gs.Params.SweepExpiredNames
 
 	if len(gs.GlobalPermissions) == 0 {
 		genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions.Clone()
@@ -101,6 +133,36 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, curve crypto.CurveTy
 	return genesisDoc, nil
 }
 
+// DeriveKeys sets the PublicKey of each account that would otherwise have a key generated for it to one derived from
+// the Mnemonic, passing the private key and name of the account to importKey so that it can be put in a key store.
+// Validators keep their keys generated, since they must be ed25519.
+func (gs *GenesisSpec) DeriveKeys(importKey func(name string, privateKey crypto.PrivateKey) error) error {
+	if gs.Mnemonic == "" {
+		return nil
+	}
+	path := gs.DerivationPath
+	if path == "" {
+		path = crypto.DefaultDerivationPath
+	}
+	n := 0
+	for i, ta := range gs.Accounts {
+		if ta.Address != nil || ta.PublicKey != nil || ta.Balances().HasPower() {
+			continue
+		}
+		privateKey, err := crypto.PrivateKeyFromMnemonic(gs.Mnemonic, fmt.Sprintf("%s/%d", path, n))
+		if err != nil {
+			return err
+		}
+		n++
+		err = importKey(ta.Name, privateKey)
+		if err != nil {
+			return fmt.Errorf("could not import key of account %d: %v", i, err)
+		}
+		gs.Accounts[i].PublicKey = privateKey.GetPublicKey()
+	}
+	return nil
+}
+
 func (gs *GenesisSpec) JSONBytes() ([]byte, error) {
 	bs, err := json.Marshal(gs)
 	if err != nil {
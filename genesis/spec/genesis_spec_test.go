@@ -83,3 +83,36 @@ func TestGenesisSpec_GenesisDoc(t *testing.T) {
 
 func TestTemplateAccount_AccountPermissions(t *testing.T) {
 }
+
+func TestGenesisSpec_DeriveKeys(t *testing.T) {
+	keyClient := keys.NewLocalKeyClient(keys.NewMemoryKeyStore(), logging.NewNoopLogger())
+	publicKey := crypto.PrivateKeyFromSecret("known", crypto.CurveTypeSecp256k1).GetPublicKey()
+	genesisSpec := GenesisSpec{
+		Mnemonic: "test test test test test test test test test test test junk",
+		Accounts: []TemplateAccount{
+			{Name: "validator", Amounts: balance.New().Power(10)},
+			{Name: "known", PublicKey: publicKey},
+			{Name: "dev_0"},
+			{Name: "dev_1"},
+		},
+	}
+	imported := make(map[string]crypto.Address)
+	err := genesisSpec.DeriveKeys(func(name string, privateKey crypto.PrivateKey) error {
+		imported[name] = privateKey.GetPublicKey().GetAddress()
+		return nil
+	})
+	require.NoError(t, err)
+	dev0, err := crypto.AddressFromHexString("F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266")
+	require.NoError(t, err)
+	dev1, err := crypto.AddressFromHexString("70997970C51812DC3A010C7D01B50E0D17DC79C8")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]crypto.Address{"dev_0": dev0, "dev_1": dev1}, imported)
+
+	genesisDoc, err := genesisSpec.GenesisDoc(keyClient, crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	require.Len(t, genesisDoc.Accounts, 4)
+	assert.Equal(t, crypto.CurveTypeEd25519, genesisDoc.Validators[0].PublicKey.CurveType)
+	assert.Equal(t, publicKey.GetAddress(), genesisDoc.Accounts[1].Address)
+	assert.Equal(t, dev0, genesisDoc.Accounts[2].Address)
+	assert.Equal(t, dev1, genesisDoc.Accounts[3].Address)
+}
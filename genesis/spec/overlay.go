@@ -0,0 +1,311 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hyperledger/burrow/config/source"
+)
+
+// Fields that identify an element of a list of objects, so that fragments can add to the same account, validator, or
+// balance rather than appending another one. The first one an element has is used.
+var identifyingFields = []string{"Name", "Address", "PublicKey", "Type"}
+
+// A Fragment is part of a GenesisSpec or GenesisDoc read from a JSON or TOML file, holding only the fields it sets
+type Fragment struct {
+	Source string
+	Fields map[string]interface{}
+}
+
+// Reads a single Fragment from file
+func FragmentFromFile(file string) (*Fragment, error) {
+	bs, err := source.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := fragmentFields(string(bs))
+	if err != nil {
+		return nil, fmt.Errorf("could not read fragment %s: %v", file, err)
+	}
+	return &Fragment{Source: file, Fields: fields}, nil
+}
+
+// Reads a layer of fragments from path, which is either a single file or a directory of them (taken in name order, not
+// descending into subdirectories)
+func LayerFromPath(path string) ([]*Fragment, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		fragment, err := FragmentFromFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return []*Fragment{fragment}, nil
+	}
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var layer []*Fragment
+	for _, info := range infos {
+		switch filepath.Ext(info.Name()) {
+		case ".json", ".toml":
+			fragment, err := FragmentFromFile(filepath.Join(path, info.Name()))
+			if err != nil {
+				return nil, err
+			}
+			layer = append(layer, fragment)
+		}
+	}
+	if len(layer) == 0 {
+		return nil, fmt.Errorf("directory %s has no .json or .toml fragments", path)
+	}
+	return layer, nil
+}
+
+// Decodes a fragment so that every number is a json.Number whichever format it came in, since float64 cannot hold
+// every uint64 amount
+func fragmentFields(fragmentString string) (map[string]interface{}, error) {
+	if source.DetectFormat(fragmentString) == source.TOML {
+		fields := make(map[string]interface{})
+		_, err := toml.Decode(fragmentString, &fields)
+		if err != nil {
+			return nil, err
+		}
+		bs, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		fragmentString = string(bs)
+	}
+	decoder := json.NewDecoder(strings.NewReader(fragmentString))
+	decoder.UseNumber()
+	fields := make(map[string]interface{})
+	err := decoder.Decode(&fields)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// Conflicts are the values that fragments of the same layer disagree on, or that cannot be merged at all
+type Conflicts []string
+
+func (cs Conflicts) Error() string {
+	return fmt.Sprintf("%d conflict(s) between fragments:\n  %s", len(cs), strings.Join(cs, "\n  "))
+}
+
+// A Merge is the result of merging layers of fragments
+type Merge struct {
+	Fields map[string]interface{}
+	// Values set by one layer and then changed by a later one
+	Overrides []string
+	setBy     map[string]origin
+	conflicts Conflicts
+}
+
+type origin struct {
+	source string
+	layer  int
+}
+
+// MergeLayers deep-merges layers of fragments in order, so a network can be defined by a base layer, then an overlay
+// for its environment, then one of fragments for each of its members. Objects are merged field by field, lists of
+// objects by the Name, Address, PublicKey, or Type of their elements, and other lists by taking the union. A later
+// layer may change a value set by an earlier one, which is recorded in Overrides, but fragments in the same layer
+// must agree and any values they disagree on are returned as Conflicts.
+func MergeLayers(layers ...[]*Fragment) (*Merge, error) {
+	merge := &Merge{
+		Fields: make(map[string]interface{}),
+		setBy:  make(map[string]origin),
+	}
+	for i, layer := range layers {
+		for _, fragment := range layer {
+			merge.mergeObject("", merge.Fields, fragment.Fields, origin{source: fragment.Source, layer: i})
+		}
+	}
+	if len(merge.conflicts) > 0 {
+		return nil, merge.conflicts
+	}
+	return merge, nil
+}
+
+// Decodes the merged fields into conf, such as a GenesisSpec or GenesisDoc, failing on any field it does not have
+func (merge *Merge) Decode(conf interface{}) error {
+	bs, err := json.Marshal(merge.Fields)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(conf)
+}
+
+func (merge *Merge) mergeObject(path string, base, overlay map[string]interface{}, from origin) {
+	keys := make([]string, 0, len(overlay))
+	for key := range overlay {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := overlay[key]
+		if value == nil {
+			continue
+		}
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		existing, ok := base[key]
+		if !ok {
+			base[key] = value
+			merge.recordSet(fieldPath, value, from)
+			continue
+		}
+		base[key] = merge.mergeValue(fieldPath, existing, value, from)
+	}
+}
+
+func (merge *Merge) mergeValue(path string, base, overlay interface{}, from origin) interface{} {
+	switch overlayValue := overlay.(type) {
+	case map[string]interface{}:
+		baseValue, ok := base.(map[string]interface{})
+		if !ok {
+			return merge.conflict(path, base, overlay, from)
+		}
+		merge.mergeObject(path, baseValue, overlayValue, from)
+		return baseValue
+	case []interface{}:
+		baseValue, ok := base.([]interface{})
+		if !ok {
+			return merge.conflict(path, base, overlay, from)
+		}
+		return merge.mergeList(path, baseValue, overlayValue, from)
+	}
+	if reflect.DeepEqual(base, overlay) {
+		return base
+	}
+	if _, ok := base.(map[string]interface{}); ok {
+		return merge.conflict(path, base, overlay, from)
+	}
+	if _, ok := base.([]interface{}); ok {
+		return merge.conflict(path, base, overlay, from)
+	}
+	previous := merge.setBy[path]
+	if previous.layer == from.layer {
+		return merge.conflict(path, base, overlay, from)
+	}
+	merge.Overrides = append(merge.Overrides, fmt.Sprintf("%s: %s from %s overridden by %s from %s", path,
+		jsonValue(base), previous.source, jsonValue(overlay), from.source))
+	merge.setBy[path] = from
+	return overlay
+}
+
+func (merge *Merge) mergeList(path string, base, overlay []interface{}, from origin) []interface{} {
+	for _, element := range overlay {
+		object, ok := element.(map[string]interface{})
+		if !ok {
+			if !containsValue(base, element) {
+				base = append(base, element)
+			}
+			continue
+		}
+		id := identify(object)
+		if id == "" {
+			base = append(base, object)
+			merge.recordSet(fmt.Sprintf("%s[%d]", path, len(base)-1), object, from)
+			continue
+		}
+		elementPath := fmt.Sprintf("%s[%s]", path, id)
+		if i := indexOf(base, id); i >= 0 {
+			base[i] = merge.mergeValue(elementPath, base[i], object, from)
+			continue
+		}
+		base = append(base, object)
+		merge.recordSet(elementPath, object, from)
+	}
+	return base
+}
+
+// Records where each scalar value under path came from so a later conflict can name both sources
+func (merge *Merge) recordSet(path string, value interface{}, from origin) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			merge.recordSet(path+"."+key, field, from)
+		}
+	case []interface{}:
+		for i, element := range v {
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+			if object, ok := element.(map[string]interface{}); ok {
+				if id := identify(object); id != "" {
+					elementPath = fmt.Sprintf("%s[%s]", path, id)
+				}
+			}
+			merge.recordSet(elementPath, element, from)
+		}
+	default:
+		merge.setBy[path] = from
+	}
+}
+
+func (merge *Merge) conflict(path string, base, overlay interface{}, from origin) interface{} {
+	previous := merge.setBy[path]
+	if previous.source == "" {
+		// Set below path, take the source of any value there
+		for setPath, setFrom := range merge.setBy {
+			below := strings.HasPrefix(setPath, path+".") || strings.HasPrefix(setPath, path+"[")
+			if below && (previous.source == "" || setFrom.source < previous.source) {
+				previous = setFrom
+			}
+		}
+	}
+	merge.conflicts = append(merge.conflicts, fmt.Sprintf("%s: %s from %s conflicts with %s from %s", path,
+		jsonValue(base), previous.source, jsonValue(overlay), from.source))
+	return base
+}
+
+func identify(object map[string]interface{}) string {
+	for _, field := range identifyingFields {
+		if id, ok := object[field].(string); ok && id != "" {
+			return field + "=" + id
+		}
+	}
+	return ""
+}
+
+func indexOf(list []interface{}, id string) int {
+	for i, element := range list {
+		if object, ok := element.(map[string]interface{}); ok && identify(object) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsValue(list []interface{}, value interface{}) bool {
+	for _, element := range list {
+		if reflect.DeepEqual(element, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonValue(value interface{}) string {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(bs)
+}
@@ -0,0 +1,86 @@
+package spec
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeLayers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spec-overlay")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	write := func(name, contents string) string {
+		file := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0700))
+		require.NoError(t, ioutil.WriteFile(file, []byte(contents), 0600))
+		return file
+	}
+	base := write("base.json", `{
+		"ChainName": "burrow",
+		"Params": {"ProposalThreshold": 3},
+		"GlobalPermissions": ["send"],
+		"Accounts": [{"Name": "ops", "Amounts": [{"Type": "Native", "Amount": 18446744073709551615}]}]
+	}`)
+	env := write("prod.toml", `
+ChainName = "burrow-prod"
+GlobalPermissions = ["call"]
+[Params]
+  UnbondingBlocks = 100
+`)
+	write("members/a.json", `{
+		"Accounts": [{"Name": "a", "Amounts": [{"Type": "Power", "Amount": 10}], "Permissions": ["bond"]}]
+	}`)
+	write("members/b.json", `{
+		"Accounts": [{"Name": "ops", "Permissions": ["root"]}, {"Name": "b", "Amounts": [{"Type": "Power", "Amount": 20}]}]
+	}`)
+	write("members/README.md", "Not a fragment")
+
+	var layers [][]*Fragment
+	for _, path := range []string{base, env, filepath.Join(dir, "members")} {
+		layer, err := LayerFromPath(path)
+		require.NoError(t, err)
+		layers = append(layers, layer)
+	}
+	merge, err := MergeLayers(layers...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`ChainName: "burrow" from ` + base + ` overridden by "burrow-prod" from ` + env}, merge.Overrides)
+
+	genesisSpec := new(GenesisSpec)
+	require.NoError(t, merge.Decode(genesisSpec))
+	assert.Equal(t, "burrow-prod", genesisSpec.ChainName)
+	assert.Equal(t, uint64(3), genesisSpec.Params.ProposalThreshold)
+	assert.Equal(t, uint64(100), genesisSpec.Params.UnbondingBlocks)
+	assert.Equal(t, []string{permission.SendString, permission.CallString}, genesisSpec.GlobalPermissions)
+	require.Len(t, genesisSpec.Accounts, 3)
+	assert.Equal(t, "ops", genesisSpec.Accounts[0].Name)
+	assert.Equal(t, balance.New().Native(18446744073709551615), genesisSpec.Accounts[0].Balances())
+	assert.Equal(t, []string{permission.RootString}, genesisSpec.Accounts[0].Permissions)
+	assert.Equal(t, balance.New().Power(20), genesisSpec.Accounts[2].Balances())
+
+	// Members may not disagree with each other
+	write("members/c.json", `{"Accounts": [{"Name": "a", "Amounts": [{"Type": "Power", "Amount": 11}]}]}`)
+	members, err := LayerFromPath(filepath.Join(dir, "members"))
+	require.NoError(t, err)
+	_, err = MergeLayers(layers[0], layers[1], members)
+	require.Error(t, err)
+	assert.Equal(t, Conflicts{"Accounts[Name=a].Amounts[Type=Power].Amount: 10 from " +
+		filepath.Join(dir, "members", "a.json") + " conflicts with 11 from " + filepath.Join(dir, "members", "c.json")},
+		err)
+
+	// Nor may any layer change the shape of a value
+	_, err = MergeLayers(layers[0], []*Fragment{{Source: "bad", Fields: map[string]interface{}{"Params": "none"}}})
+	require.Error(t, err)
+
+	// Misspelt fields are caught on decoding
+	merge, err = MergeLayers([]*Fragment{{Source: "typo", Fields: map[string]interface{}{"ChianName": "x"}}})
+	require.NoError(t, err)
+	require.Error(t, merge.Decode(new(GenesisSpec)))
+}
@@ -87,6 +87,13 @@ func MergeGenesisSpecs(genesisSpecs ...GenesisSpec) GenesisSpec {
 		if genesisSpec.ChainName != "" {
 			mergedGenesisSpec.ChainName = genesisSpec.ChainName
 		}
+		// As for the mnemonic accounts are derived from
+		if genesisSpec.Mnemonic != "" {
+			mergedGenesisSpec.Mnemonic = genesisSpec.Mnemonic
+		}
+		if genesisSpec.DerivationPath != "" {
+			mergedGenesisSpec.DerivationPath = genesisSpec.DerivationPath
+		}
 		// Take the max genesis time
 		if mergedGenesisSpec.GenesisTime == nil ||
 			(genesisSpec.GenesisTime != nil && genesisSpec.GenesisTime.After(*mergedGenesisSpec.GenesisTime)) {
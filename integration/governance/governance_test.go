@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/acm/validator"
@@ -23,8 +24,6 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	tmcore "github.com/tendermint/tendermint/rpc/core"
-	"github.com/tendermint/tendermint/rpc/jsonrpc/types"
 )
 
 func TestGovernance(t *testing.T) {
@@ -90,9 +89,9 @@ func TestGovernance(t *testing.T) {
 			err = rpctest.WaitNBlocks(ecli, 6)
 			require.NoError(t, err)
 			height := int64(genesisKernels[0].Blockchain.LastBlockHeight())
-			err = genesisKernels[0].Node.ConfigureRPC()
+			env, err := genesisKernels[0].Node.ConfigureRPC()
 			require.NoError(t, err)
-			tmVals, err := tmcore.Validators(&types.Context{}, &height, nil, nil)
+			tmVals, err := env.Validators(&types.Context{}, &height, nil, nil)
 			require.NoError(t, err)
 			vsOut = validator.NewTrimSet()
 
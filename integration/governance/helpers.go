@@ -9,6 +9,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/config"
@@ -24,7 +25,6 @@ import (
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
-	"github.com/tendermint/tendermint/p2p"
 )
 
 func newConfig(genesisDoc *genesis.GenesisDoc, account *acm.PrivateAccount,
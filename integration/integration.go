@@ -111,7 +111,7 @@ func TestKernel(validatorAccount *acm.PrivateAccount, keysAccounts []*acm.Privat
 
 	fmt.Println("Creating integration test Kernel...")
 
-	kern, err := core.NewKernel(testConfig.BurrowDir)
+	kern, err := core.NewKernel(testConfig.BurrowDir, testConfig.Backend())
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +215,25 @@ func TestExecutionEventsTest(t *testing.T) {
 			// waiting for an empty block at each iteration after the bug is triggered
 			require.Less(t, elapsed, time.Duration(n)*wait*10)
 		})
+
+		t.Run("BlockHeaders", func(t *testing.T) {
+			blockRange := doSends(t, 2, tcli, kern, inputAddress0, 999)
+			stream, err := ecli.BlockHeaders(context.Background(), &rpcevents.BlockHeadersRequest{
+				BlockRange: blockRange,
+			})
+			require.NoError(t, err)
+			start := blockRange.Start.Bound(kern.Blockchain.LastBlockHeight())
+			end := blockRange.End.Bound(kern.Blockchain.LastBlockHeight())
+			height := start
+			for header, err := stream.Recv(); err != io.EOF; header, err = stream.Recv() {
+				require.NoError(t, err)
+				assert.Equal(t, int64(height), header.Header.Height)
+				assert.Equal(t, header.Header.Height, header.Commit.Height)
+				assert.NotEmpty(t, header.Commit.Signatures)
+				height++
+			}
+			assert.Equal(t, end+1, height, "should see every header in range")
+		})
 	})
 }
 
@@ -16,13 +16,14 @@ import (
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/jsonrpc"
 
-	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmjson "github.com/cometbft/cometbft/libs/json"
 
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/txs/payload"
 
 	"github.com/hyperledger/burrow/core"
 
+	ctypes "github.com/cometbft/cometbft/consensus/types"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -32,7 +33,6 @@ import (
 	"github.com/hyperledger/burrow/txs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	ctypes "github.com/tendermint/tendermint/consensus/types"
 )
 
 const timeout = 5 * time.Second
@@ -10,10 +10,13 @@ import (
 
 	"github.com/hyperledger/burrow/integration"
 
-	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/integration/rpctest"
@@ -87,6 +90,44 @@ func TestQueryServer(t *testing.T) {
 		assert.Len(t, accs, len(rpctest.GenesisDoc.Accounts)+1)
 	})
 
+	t.Run("ListStorage", func(t *testing.T) {
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		ecli := rpctest.NewExecutionEventsClient(t, kern.GRPCListenAddress().String())
+		// So that there is a height before the contract is created
+		require.NoError(t, rpctest.WaitNBlocks(ecli, 1))
+		// Creation code that stores n values without deploying any code
+		n := 5
+		var code []byte
+		for i := 1; i <= n; i++ {
+			code = bc.MustSplice(code, asm.PUSH1, byte(i*10), asm.PUSH1, byte(i), asm.SSTORE)
+		}
+		txe, err := rpctest.CreateEVMContract(tcli, rpctest.PrivateAccounts[0].GetAddress(), code, nil)
+		require.NoError(t, err)
+		address := txe.Receipt.ContractAddress
+
+		entries := receiveStorage(t, qcli, &rpcquery.ListStorageParam{Address: address})
+		require.Len(t, entries, n)
+		for i, entry := range entries {
+			assert.Equal(t, binary.Int64ToWord256(int64(i+1)), entry.Key)
+			assert.Equal(t, binary.Int64ToWord256(int64((i+1)*10)).Bytes(), []byte(entry.Value))
+		}
+
+		// Page through the storage
+		var paged []*rpcquery.StorageEntry
+		param := &rpcquery.ListStorageParam{Address: address, Height: txe.Height, Limit: 2}
+		for page := receiveStorage(t, qcli, param); len(page) > 0; page = receiveStorage(t, qcli, param) {
+			assert.LessOrEqual(t, len(page), 2)
+			paged = append(paged, page...)
+			param.Start = binary.Uint64ToWord256(binary.Uint64FromWord256(page[len(page)-1].Key) + 1)
+		}
+		assert.Equal(t, entries, paged)
+
+		// Nothing was stored before the contract was created
+		entries = receiveStorage(t, qcli, &rpcquery.ListStorageParam{Address: address, Height: txe.Height - 1})
+		assert.Len(t, entries, 0)
+	})
+
 	t.Run("ListNames", func(t *testing.T) {
 		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
 		dataA, dataB := "NO TAMBOURINES", "ELEPHANTS WELCOME"
@@ -110,6 +151,52 @@ func TestQueryServer(t *testing.T) {
 		}
 	})
 
+	t.Run("ListStateChanges", func(t *testing.T) {
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		ecli := rpctest.NewExecutionEventsClient(t, kern.GRPCListenAddress().String())
+		require.NoError(t, rpctest.WaitNBlocks(ecli, 1))
+		n := 3
+		var code []byte
+		for i := 1; i <= n; i++ {
+			code = bc.MustSplice(code, asm.PUSH1, byte(i*10), asm.PUSH1, byte(i), asm.SSTORE)
+		}
+		txe, err := rpctest.CreateEVMContract(tcli, rpctest.PrivateAccounts[0].GetAddress(), code, nil)
+		require.NoError(t, err)
+		address := txe.Receipt.ContractAddress
+
+		changes := receiveStateChanges(t, qcli, &rpcquery.ListStateChangesParam{
+			FromHeight: txe.Height - 1,
+			ToHeight:   txe.Height,
+		})
+		var created bool
+		var stored []*rpcquery.StorageChange
+		for _, change := range changes {
+			if change.Account != nil && change.Account.Address == address {
+				assert.Nil(t, change.Account.Before)
+				require.NotNil(t, change.Account.After)
+				created = true
+			}
+			if change.Storage != nil && change.Storage.Address == address {
+				stored = append(stored, change.Storage)
+			}
+		}
+		assert.True(t, created, "contract account should be created")
+		require.Len(t, stored, n)
+		for i, change := range stored {
+			assert.Equal(t, binary.Int64ToWord256(int64(i+1)), change.Key)
+			assert.Len(t, change.Before, 0)
+			assert.Equal(t, binary.Int64ToWord256(int64((i+1)*10)).Bytes(), []byte(change.After))
+		}
+
+		// Nothing changes between a height and itself
+		changes = receiveStateChanges(t, qcli, &rpcquery.ListStateChangesParam{
+			FromHeight: txe.Height,
+			ToHeight:   txe.Height,
+		})
+		assert.Len(t, changes, 0)
+	})
+
 	t.Run("GetBlockHeader", func(t *testing.T) {
 		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
 		ecli := rpctest.NewExecutionEventsClient(t, kern.GRPCListenAddress().String())
@@ -140,3 +227,34 @@ func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*name
 	}
 	return entries
 }
+
+func receiveStorage(t testing.TB, qcli rpcquery.QueryClient, param *rpcquery.ListStorageParam) []*rpcquery.StorageEntry {
+	stream, err := qcli.ListStorage(context.Background(), param)
+	require.NoError(t, err)
+	var entries []*rpcquery.StorageEntry
+	entry, err := stream.Recv()
+	for err == nil {
+		entries = append(entries, entry)
+		entry, err = stream.Recv()
+	}
+	if err != io.EOF {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return entries
+}
+
+func receiveStateChanges(t testing.TB, qcli rpcquery.QueryClient,
+	param *rpcquery.ListStateChangesParam) []*rpcquery.StateChange {
+	stream, err := qcli.ListStateChanges(context.Background(), param)
+	require.NoError(t, err)
+	var changes []*rpcquery.StateChange
+	change, err := stream.Recv()
+	for err == nil {
+		changes = append(changes, change)
+		change, err = stream.Recv()
+	}
+	if err != io.EOF {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return changes
+}
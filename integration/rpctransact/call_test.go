@@ -5,7 +5,9 @@ package rpctransact
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math/big"
 	"sync"
 	"testing"
@@ -19,6 +21,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/core"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/execution/evm/asm"
 	"github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -365,6 +368,56 @@ func testCallTx(t *testing.T, kern *core.Kernel, cli rpctransact.TransactClient)
 			return
 		})
 
+		t.Run("DecodeEvents", func(t *testing.T) {
+			t.Parallel()
+			meta, err := json.Marshal(compile.Metadata{
+				ContractName: "StrangeLoop",
+				SourceFile:   "strange_loop.sol",
+				Abi:          solidity.Abi_StrangeLoop,
+			})
+			require.NoError(t, err)
+			createTxe, err := rpctest.CreateEVMContract(cli, inputAddress, solidity.Bytecode_StrangeLoop,
+				[]rpctest.MetadataMap{{DeployedCode: solidity.DeployedBytecode_StrangeLoop, Abi: meta}})
+			require.NoError(t, err)
+			address := lastCall(createTxe.Events).CallData.Callee
+
+			qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+			contract, err := qcli.GetContract(context.Background(), &rpcquery.GetContractParam{Address: address})
+			require.NoError(t, err)
+			assert.Equal(t, "StrangeLoop", contract.ContractName)
+			assert.Equal(t, "strange_loop.sol", contract.SourceFile)
+			assert.JSONEq(t, string(solidity.Abi_StrangeLoop), contract.Abi)
+			stream, err := qcli.ListContracts(context.Background(), &rpcquery.ListContractsParam{ContractName: "StrangeLoop"})
+			require.NoError(t, err)
+			var listed []crypto.Address
+			for {
+				contract, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				assert.Equal(t, "StrangeLoop", contract.ContractName)
+				listed = append(listed, contract.Address)
+			}
+			assert.Contains(t, listed, address)
+
+			spec, err := abi.ReadSpec(solidity.Abi_StrangeLoop)
+			require.NoError(t, err)
+			data, _, err := spec.Pack("UpsieDownsie")
+			require.NoError(t, err)
+			callTxe, err := rpctest.CallContract(cli, inputAddress, address, data)
+			require.NoError(t, err)
+			call := filterCalls(callTxe.Events)[0]
+			require.NotNil(t, call.Decoded)
+			assert.Equal(t, "UpsieDownsie()", call.Decoded.Signature)
+			log := filterLogs(callTxe.Events)[0]
+			require.NotNil(t, log.Decoded)
+			assert.Equal(t, "ChangeLevel", log.Decoded.Name)
+			require.Len(t, log.Decoded.Args, 2)
+			assert.Equal(t, "newDepth", log.Decoded.Args[1].Name)
+			assert.Equal(t, "18", log.Decoded.Args[1].Value)
+		})
+
 		t.Run("EventEmitter", func(t *testing.T) {
 			t.Parallel()
 			createTxe, err := rpctest.CreateEVMContract(cli, inputAddress, solidity.Bytecode_EventEmitter, nil)
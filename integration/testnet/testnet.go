@@ -0,0 +1,216 @@
+package testnet
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Rounds of consensus to allow for each block
+	blockTimeoutRounds = 10
+	// Time to allow for each block however short the consensus timeouts, in which a restarted node redials its peers
+	minBlockTimeout = 30 * time.Second
+)
+
+// Network is a chain of Burrow nodes running in the test process and connected over localhost
+type Network struct {
+	GenesisDoc *genesis.GenesisDoc
+	// The account of each node, which validates for the validator nodes
+	Accounts []*acm.PrivateAccount
+	Nodes    []*Node
+}
+
+// Node is a member of a Network that can be stopped and started again from the state it has committed
+type Node struct {
+	Index   int
+	Account *acm.PrivateAccount
+	Config  *config.BurrowConfig
+	// Nil while the node is stopped
+	Kernel *core.Kernel
+	// Signs with the keys of every account in the network
+	keysAccounts []*acm.PrivateAccount
+}
+
+// Start boots a network of n nodes the first validators of which are validators with equal power and connects every
+// node to every other as persistent peers. The options are applied to the config of each node. The nodes are shut down
+// and their directories removed when the test ends.
+func Start(t testing.TB, n, validators int, options ...func(*config.BurrowConfig)) *Network {
+	require.True(t, validators > 0 && validators <= n, "a network of %d nodes must have between 1 and %d validators",
+		n, n)
+	accounts := integration.MakePrivateAccounts("testnet", n)
+	vals := make([]int, validators)
+	for i := range vals {
+		vals[i] = i
+	}
+	network := &Network{
+		GenesisDoc: integration.TestGenesisDoc(accounts, vals...),
+		Accounts:   accounts,
+		Nodes:      make([]*Node, n),
+	}
+	for i, account := range accounts {
+		conf, cleanup := integration.NewTestConfig(network.GenesisDoc, options...)
+		t.Cleanup(cleanup)
+		// Tendermint advertises the port it is configured with so we cannot let it choose one
+		port, err := freePort()
+		require.NoError(t, err)
+		conf.Tendermint.ListenPort = port
+		network.Nodes[i] = &Node{
+			Index:        i,
+			Account:      account,
+			Config:       conf,
+			keysAccounts: accounts,
+		}
+	}
+	// Registered after the cleanups so it runs before them
+	t.Cleanup(network.Stop)
+	for _, node := range network.Nodes {
+		require.NoError(t, node.Start())
+	}
+	require.NoError(t, network.Connect())
+	return network
+}
+
+// Connect makes every running node a persistent peer of every other
+func (nw *Network) Connect() error {
+	for _, node := range nw.Nodes {
+		for _, peer := range nw.Nodes {
+			if peer == node || !node.Running() || !peer.Running() {
+				continue
+			}
+			address, err := peer.Kernel.Node.NodeInfo().NetAddress()
+			if err != nil {
+				return fmt.Errorf("could not get address of node %d: %v", peer.Index, err)
+			}
+			err = node.Kernel.Peers.AddPersistentPeer(address.String())
+			if err != nil {
+				return fmt.Errorf("could not connect node %d to node %d: %v", node.Index, peer.Index, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Stop shuts down every running node
+func (nw *Network) Stop() {
+	for _, node := range nw.Nodes {
+		node.Stop()
+	}
+}
+
+// WaitForHeight waits until every running node has committed the block at height, failing if any node goes longer
+// than its BlockTimeout without committing a block. Waiting on progress rather than for a fixed time keeps tests
+// reliable on loaded machines without waiting long on a network that has stalled.
+func (nw *Network) WaitForHeight(height uint64) error {
+	for _, node := range nw.Nodes {
+		if !node.Running() {
+			continue
+		}
+		timeout := node.BlockTimeout()
+		lastHeight := node.Kernel.Blockchain.LastBlockHeight()
+		deadline := time.Now().Add(timeout)
+		for lastHeight < height {
+			time.Sleep(50 * time.Millisecond)
+			if h := node.Kernel.Blockchain.LastBlockHeight(); h > lastHeight {
+				lastHeight = h
+				deadline = time.Now().Add(timeout)
+			} else if time.Now().After(deadline) {
+				return fmt.Errorf("node %d stalled at height %d of %d for %v", node.Index, lastHeight, height,
+					timeout)
+			}
+		}
+	}
+	return nil
+}
+
+// Start boots the node, which resumes from any state it committed before it was stopped and reconnects to the peers
+// it was connected to
+func (node *Node) Start() error {
+	if node.Running() {
+		return fmt.Errorf("node %d is already running", node.Index)
+	}
+	kern, err := integration.TestKernel(node.Account, node.keysAccounts, node.Config)
+	if err != nil {
+		return fmt.Errorf("could not create kernel for node %d: %v", node.Index, err)
+	}
+	err = kern.Boot()
+	if err != nil {
+		return fmt.Errorf("could not boot node %d: %v", node.Index, err)
+	}
+	node.Kernel = kern
+	return nil
+}
+
+// Stop shuts the node down if it is running
+func (node *Node) Stop() {
+	if !node.Running() {
+		return
+	}
+	integration.Shutdown(node.Kernel)
+	node.Kernel = nil
+}
+
+// BlockTimeout is how long to allow the node to commit its next block, which is long enough for several rounds of
+// consensus to time out at the node's timeouts, since these scale with its Execution.TimeoutFactor, or for a restarted
+// node to reconnect to its peers
+func (node *Node) BlockTimeout() time.Duration {
+	tmConf, err := node.Config.Tendermint.Config(node.Config.BurrowDir, node.Config.Execution.TimeoutFactor)
+	if err != nil {
+		return minBlockTimeout
+	}
+	consensus := tmConf.Consensus
+	timeout := blockTimeoutRounds * (consensus.TimeoutPropose + consensus.TimeoutPrevote + consensus.TimeoutPrecommit +
+		consensus.TimeoutCommit)
+	if timeout < minBlockTimeout {
+		return minBlockTimeout
+	}
+	return timeout
+}
+
+func (node *Node) Running() bool {
+	return node.Kernel != nil
+}
+
+// BlockHash returns the hash of the block at height as stored by the node
+func (node *Node) BlockHash(height uint64) []byte {
+	meta := node.Kernel.Node.BlockStore().LoadBlockMeta(int64(height))
+	if meta == nil {
+		return nil
+	}
+	return meta.BlockID.Hash
+}
+
+func (node *Node) GRPCAddress() string {
+	return node.Kernel.GRPCListenAddress().String()
+}
+
+func (node *Node) QueryClient(t testing.TB) rpcquery.QueryClient {
+	return rpctest.NewQueryClient(t, node.GRPCAddress())
+}
+
+func (node *Node) TransactClient(t testing.TB) rpctransact.TransactClient {
+	return rpctest.NewTransactClient(t, node.GRPCAddress())
+}
+
+// Try and grab a free port - this is not foolproof since there is race between other concurrent tests after we close
+// the listener and start the node
+func freePort() (string, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+	_, port, err := net.SplitHostPort(l.Addr().String())
+	return port, err
+}
@@ -0,0 +1,31 @@
+// +build integration
+
+package testnet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetwork(t *testing.T) {
+	nw := Start(t, 4, 4)
+	require.NoError(t, nw.WaitForHeight(3))
+	hash := nw.Nodes[0].BlockHash(3)
+	require.NotEmpty(t, hash)
+	for _, node := range nw.Nodes[1:] {
+		require.Equal(t, hash, node.BlockHash(3))
+	}
+
+	t.Run("ToleratesOneFault", func(t *testing.T) {
+		stopped := nw.Nodes[3]
+		stopped.Stop()
+		height := nw.Nodes[0].Kernel.Blockchain.LastBlockHeight()
+		require.NoError(t, nw.WaitForHeight(height+3))
+
+		require.NoError(t, stopped.Start())
+		// Catches up with its persistent peers
+		require.NoError(t, nw.WaitForHeight(height+5))
+		require.Equal(t, nw.Nodes[0].BlockHash(height+5), stopped.BlockHash(height+5))
+	})
+}
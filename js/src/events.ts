@@ -74,7 +74,7 @@ class EndOfStreamError extends Error implements Signal<'endOfStream'> {
   }
 }
 
-const endOfStreamError = Object.freeze(new EndOfStreamError());
+export const endOfStreamError = Object.freeze(new EndOfStreamError());
 
 export function isBurrowSignal(value: unknown): value is Signal<SignalCodes> {
   const v = value as Signal<SignalCodes>;
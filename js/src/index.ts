@@ -21,5 +21,6 @@ export {
   reduceEvents,
   Signal,
 } from './events';
-export { build } from './solts/build';
+export { build, buildFromBin } from './solts/build';
 export { Caller, defaultCall, Provider } from './solts/interface.gd';
+export { Web3Client, Web3Error } from './web3';
@@ -117,6 +117,43 @@ export async function build(srcPathOrFiles: string | string[], opts?: Partial<Bu
   ]);
 }
 
+/**
+ * Generates typescript code wrapping contracts already compiled by burrow deploy or burrow compile from the bin files
+ * they write, so that clients bind to the same bytecode and ABI that was deployed. Each Name.bin file is written to
+ * Name.abi.ts alongside it.
+ */
+export async function buildFromBin(
+  binFiles: string[],
+  opts?: Partial<Pick<BuildOptions, 'burrowImportPath'>>,
+): Promise<void> {
+  const { burrowImportPath } = { ...defaultBuildOptions, ...opts };
+  await Promise.all(
+    binFiles.map(async (binFile) => {
+      const contract: BurrowContract = JSON.parse(await fs.readFile(binFile, 'utf8'));
+      const compiled = {
+        name: path.basename(binFile).replace(/\.[^/.]+$/, ''),
+        abi: contract.Abi,
+        bytecode: contract.Evm.Bytecode.Object,
+        deployedBytecode: contract.Evm.DeployedBytecode.Object,
+        links: tokenizeLinks(contract.Evm.Bytecode.LinkReferences ?? {}),
+      };
+      await fs.writeFile(
+        binFile.replace(/\.[^/.]+$/, '.abi.ts'),
+        printNodes(...newFile([compiled], burrowImportPath(binFile))),
+      );
+    }),
+  );
+}
+
+// The fields we need of the bin files written by burrow deploy
+type BurrowContract = {
+  Abi: Compiled['abi'];
+  Evm: {
+    Bytecode: { Object: string; LinkReferences?: Record<string, Record<string, unknown>> };
+    DeployedBytecode: { Object: string };
+  };
+};
+
 function getCompiled(name: string, contract: Solidity.Contract): Compiled {
   return {
     name,
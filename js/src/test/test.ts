@@ -1,5 +1,8 @@
 import { Client } from '../index';
+import { Web3Client } from '../web3';
 
 const url = process.env.BURROW_URL || 'localhost:20123';
+const web3Url = process.env.BURROW_WEB3_URL || 'http://localhost:26660';
 const addr = process.env.SIGNING_ADDRESS || 'C9F239591C593CB8EE192B0009C6A0F2C9F8D768';
 export const client = new Client(url, addr);
+export const web3Client = new Web3Client(web3Url, addr, 100);
@@ -0,0 +1,40 @@
+import * as assert from 'assert';
+import { readEvents, Signal } from '../events';
+import { Addition } from '../solts/sol/Addition.abi';
+import { Eventer } from '../solts/sol/Eventer.abi';
+import { NegationLib } from '../solts/sol/NegationLib.abi';
+import { web3Client } from './test';
+
+describe('web3', () => {
+  it('can deploy and call from codegen', async () => {
+    const libraries = { NegationLib: await NegationLib.deploy({ client: web3Client }) };
+    const adder = await Addition.deployContract({ client: web3Client, libraries: libraries });
+    const { sum } = await adder.functions.add(2342, 23432);
+    assert.strictEqual(sum, 25774);
+  });
+
+  it('can receive events', async () => {
+    const eventer = await Eventer.deployContract({ client: web3Client });
+    await eventer.functions.announce();
+    await eventer.functions.announce();
+    const events = await readEvents(eventer.listeners.Init);
+    assert.strictEqual(events.length, 2);
+    assert.strictEqual(events[0].controller, 'C9F239591C593CB8EE192B0009C6A0F2C9F8D768');
+    assert.strictEqual(events[0].metadata, 'bacon,beans,eggs,tomato');
+  });
+
+  it('can stream events', async () => {
+    const eventer = await Eventer.deployContract({ client: web3Client });
+    const event = new Promise<number>((resolve, reject) =>
+      eventer.listeners.MonoRampage((err, event) => {
+        if (err || !event) {
+          return reject(err);
+        }
+        resolve(event.timestamp);
+        return Signal.cancelStream;
+      }),
+    );
+    await eventer.functions.announce();
+    assert.strictEqual(await event, 123);
+  });
+});
@@ -0,0 +1,213 @@
+import { Interface } from '@ethersproject/abi';
+import { ContractCodec, getContractCodec } from './codec';
+import { Address } from './contracts/abi';
+import { DEFAULT_GAS } from './contracts/call';
+import { prefixedHexString, toBuffer, unprefixedHexString } from './convert';
+import { Bounds, endOfStreamError, Event, EventCallback, isCancelStream } from './events';
+import { Provider } from './solts/interface.gd';
+
+export const DEFAULT_POLL_INTERVAL = 1000;
+
+// The error of a JSON-RPC request, whose data is the decoded revert when the code is 3 (execution reverted)
+export class Web3Error extends Error {
+  constructor(
+    public readonly method: string,
+    public readonly code: number,
+    message: string,
+    public readonly data?: unknown,
+  ) {
+    super(`${method}: ${message}`);
+  }
+}
+
+export type Web3Stream = {
+  cancel(): void;
+};
+
+type Web3Log = {
+  address: string;
+  blockNumber: string;
+  data: string;
+  logIndex: string;
+  topics: string[];
+  transactionHash: string;
+};
+
+type Web3Receipt = {
+  contractAddress?: string;
+  status: string;
+};
+
+/**
+ * Web3Client implements the Provider that solts codegen calls through over Burrow's web3 JSON-RPC endpoint, so
+ * generated contracts can be used from browsers, which cannot speak GRPC. The node signs transactions for account,
+ * so must hold its key. Events are read by polling eth_getLogs.
+ */
+export class Web3Client implements Provider {
+  private requestId = 0;
+
+  constructor(
+    public readonly url: string,
+    public readonly account: string,
+    public readonly pollInterval: number = DEFAULT_POLL_INTERVAL,
+  ) {}
+
+  async request<T>(method: string, ...params: unknown[]): Promise<T> {
+    const response = await fetch(this.url, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ jsonrpc: '2.0', id: ++this.requestId, method, params }),
+    });
+    if (!response.ok) {
+      throw new Web3Error(method, response.status, response.statusText);
+    }
+    const { result, error } = await response.json();
+    if (error) {
+      throw new Web3Error(method, error.code, error.message, error.data);
+    }
+    return result as T;
+  }
+
+  async latestHeight(): Promise<number> {
+    return Number(await this.request<string>('eth_blockNumber'));
+  }
+
+  // Methods below implement the generated codegen provider
+
+  // Contract metadata cannot be sent over web3 so contractMeta is ignored, deploy with burrow deploy or Client to
+  // store it with the contract
+  async deploy(data: string | Uint8Array): Promise<Address> {
+    const receipt = await this.sendTransaction(data);
+    if (!receipt.contractAddress) {
+      throw new Error(`deploy appears to have succeeded but contract address is missing from receipt`);
+    }
+    return unprefixedHexString(receipt.contractAddress);
+  }
+
+  // Web3 gives no return data for transactions, so this returns that of the same call simulated against the latest
+  // state just before the transaction is sent
+  async call(data: string | Uint8Array, address: string): Promise<Uint8Array | undefined> {
+    const returnData = await this.callSim(data, address);
+    await this.sendTransaction(data, address);
+    return returnData;
+  }
+
+  async callSim(data: string | Uint8Array, address: string): Promise<Uint8Array | undefined> {
+    const returnData = await this.request<string>(
+      'eth_call',
+      {
+        from: prefixedHexString(this.account),
+        to: prefixedHexString(address),
+        data: prefixedHexString(toBuffer(data)),
+      },
+      'latest',
+    );
+    return toBuffer(returnData);
+  }
+
+  listen(
+    signatures: string[],
+    address: string,
+    callback: EventCallback<Event>,
+    start: Bounds = 'latest',
+    end: Bounds = 'stream',
+  ): Web3Stream {
+    let cancelled = false;
+    const stream = {
+      cancel: () => {
+        cancelled = true;
+      },
+    };
+    this.poll(signatures, address, callback, start, end, () => cancelled).catch((err) => {
+      if (!cancelled) {
+        stream.cancel();
+        callback(err);
+      }
+    });
+    return stream;
+  }
+
+  contractCodec(contractABI: string): ContractCodec {
+    const iface = new Interface(contractABI);
+    return getContractCodec(iface);
+  }
+
+  private async sendTransaction(data: string | Uint8Array, address?: string): Promise<Web3Receipt> {
+    const hash = await this.request<string>('eth_sendTransaction', {
+      from: prefixedHexString(this.account),
+      to: address ? prefixedHexString(address) : undefined,
+      gas: '0x' + DEFAULT_GAS.toString(16),
+      data: prefixedHexString(toBuffer(data)),
+    });
+    // Burrow only returns once the transaction has been committed so the receipt is available
+    const receipt = await this.request<Web3Receipt>('eth_getTransactionReceipt', hash);
+    if (Number(receipt.status) !== 1) {
+      throw new Error(`transaction ${hash} failed`);
+    }
+    return receipt;
+  }
+
+  private async poll(
+    signatures: string[],
+    address: string,
+    callback: EventCallback<Event>,
+    start: Bounds,
+    end: Bounds,
+    cancelled: () => boolean,
+  ): Promise<void> {
+    const latest = await this.latestHeight();
+    let from = start === 'stream' ? latest + 1 : this.height(start, latest);
+    const to = end === 'stream' ? undefined : this.height(end, latest);
+    while (!cancelled()) {
+      const height = to === undefined ? await this.latestHeight() : to;
+      if (height >= from) {
+        const logs = await this.request<Web3Log[]>('eth_getLogs', {
+          fromBlock: '0x' + from.toString(16),
+          toBlock: '0x' + height.toString(16),
+          address: prefixedHexString(address),
+          topics: [signatures.map((signature) => prefixedHexString(signature))],
+        });
+        for (const log of logs) {
+          if (cancelled()) {
+            return;
+          }
+          if (isCancelStream(callback(undefined, web3LogToInterfaceEvent(log)))) {
+            return;
+          }
+        }
+        from = height + 1;
+      }
+      if (to !== undefined) {
+        callback(endOfStreamError);
+        return;
+      }
+      await new Promise((resolve) => setTimeout(resolve, this.pollInterval));
+    }
+  }
+
+  private height(bounds: Exclude<Bounds, 'stream'>, latest: number): number {
+    switch (bounds) {
+      case 'first':
+        return 0;
+      case 'latest':
+        return latest;
+      default:
+        return bounds;
+    }
+  }
+}
+
+export function web3LogToInterfaceEvent(log: Web3Log): Event {
+  return {
+    log: {
+      data: toBuffer(log.data),
+      topics: log.topics.map(toBuffer),
+    },
+    header: {
+      height: Number(log.blockNumber),
+      index: Number(log.logIndex),
+      eventId: 'Log/' + unprefixedHexString(log.address),
+      txHash: unprefixedHexString(log.transactionHash).toLowerCase(),
+    },
+  };
+}
@@ -9,6 +9,7 @@ trap test_teardown EXIT
 cd $this
 export SIGNING_ADDRESS="$key1_addr"
 export BURROW_URL="$BURROW_HOST:$BURROW_GRPC_PORT"
+export BURROW_WEB3_URL="http://$BURROW_HOST:$BURROW_WEB3_PORT"
 
 "$@"
 
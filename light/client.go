@@ -0,0 +1,190 @@
+// Package light provides a client that verifies what it reads from Burrow nodes against block headers it has verified
+// from a trusted header, so that it need not trust the nodes it queries
+package light
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmlight "github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/light/store/db"
+	"github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"google.golang.org/grpc"
+)
+
+// Client tracks the validator set of a chain from a trusted header using Tendermint's light client verification and
+// checks the Merkle proofs of the state it reads against the AppHash of verified headers
+type Client struct {
+	light *tmlight.Client
+	query rpcquery.QueryClient
+	conns []*grpc.ClientConn
+}
+
+// NewClient connects to the Burrow nodes at addresses, the first of which is the primary that we query and the rest
+// witnesses that we cross-check headers with. Tendermint requires at least one witness so when only one address is
+// given the primary is its own witness, in which case a primary that forks the chain will not be caught until we
+// fail to verify the headers it gives us.
+func NewClient(ctx context.Context, chainID string, trust tmlight.TrustOptions, addresses []string,
+	logger *logging.Logger) (*Client, error) {
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("at least one address of a Burrow node is required")
+	}
+	client := new(Client)
+	providers := make([]provider.Provider, len(addresses))
+	for i, address := range addresses {
+		conn, err := encoding.GRPCDialContext(ctx, address)
+		if err != nil {
+			client.Close()
+			return nil, fmt.Errorf("could not dial Burrow node at %s: %w", address, err)
+		}
+		client.conns = append(client.conns, conn)
+		query := rpcquery.NewQueryClient(conn)
+		if i == 0 {
+			client.query = query
+		}
+		providers[i] = NewProvider(chainID, address, query)
+	}
+	witnesses := providers[1:]
+	if len(witnesses) == 0 {
+		witnesses = providers
+	}
+	var err error
+	client.light, err = tmlight.NewClient(ctx, chainID, trust, providers[0], witnesses,
+		db.New(dbm.NewMemDB(), chainID), tmlight.Logger(tendermint.NewLogger(logger.WithScope("light.Client"))))
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("could not start light client: %w", err)
+	}
+	return client, nil
+}
+
+func (c *Client) ChainID() string {
+	return c.light.ChainID()
+}
+
+// Update verifies the latest header from the primary and returns the latest verified light block
+func (c *Client) Update(ctx context.Context) (*types.LightBlock, error) {
+	_, err := c.light.Update(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("could not update to the latest header: %w", err)
+	}
+	return c.light.TrustedLightBlock(0)
+}
+
+// GetLightBlock returns the verified light block at height, or the latest light block if height is zero
+func (c *Client) GetLightBlock(ctx context.Context, height uint64) (*types.LightBlock, error) {
+	if height == 0 {
+		return c.Update(ctx)
+	}
+	lightBlock, err := c.light.VerifyLightBlockAtHeight(ctx, int64(height), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("could not verify header at height %d: %w", height, err)
+	}
+	return lightBlock, nil
+}
+
+// GetBlockHeader returns the verified header at height, or the latest header if height is zero
+func (c *Client) GetBlockHeader(ctx context.Context, height uint64) (*types.Header, error) {
+	lightBlock, err := c.GetLightBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return lightBlock.Header, nil
+}
+
+// GetAccount returns the verified account at address in the state at height, or in the latest state that can be
+// verified if height is zero. It returns nil if the account does not exist.
+func (c *Client) GetAccount(ctx context.Context, address crypto.Address, height uint64) (*acm.Account, error) {
+	proof, err := c.query.GetAccountProof(ctx, &rpcquery.GetAccountProofParam{Address: address, Height: height})
+	if err != nil {
+		return nil, err
+	}
+	return c.verifyAccount(ctx, proof, address, height)
+}
+
+// GetStorage returns the verified value at key in the storage of the account at address in the state at height, or
+// in the latest state that can be verified if height is zero
+func (c *Client) GetStorage(ctx context.Context, address crypto.Address, key binary.Word256,
+	height uint64) ([]byte, error) {
+	proof, err := c.query.GetStorageProof(ctx, &rpcquery.GetStorageProofParam{
+		Address: address,
+		Key:     key,
+		Height:  height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.verifyStorage(ctx, proof, address, key, height)
+}
+
+// GetName returns the verified name registry entry for name in the state at height, or in the latest state that can
+// be verified if height is zero. It returns nil if there is no such entry.
+func (c *Client) GetName(ctx context.Context, name string, height uint64) (*names.Entry, error) {
+	proof, err := c.query.GetNameProof(ctx, &rpcquery.GetNameProofParam{Name: name, Height: height})
+	if err != nil {
+		return nil, err
+	}
+	return c.verifyName(ctx, proof, name, height)
+}
+
+func (c *Client) Close() error {
+	var err error
+	for _, conn := range c.conns {
+		closeErr := conn.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func (c *Client) verifyAccount(ctx context.Context, proof *rpcquery.StateProof, address crypto.Address,
+	height uint64) (*acm.Account, error) {
+	hash, err := c.appHash(ctx, proof, height)
+	if err != nil {
+		return nil, err
+	}
+	return state.VerifyAccount(proof.Proof, address, hash)
+}
+
+func (c *Client) verifyStorage(ctx context.Context, proof *rpcquery.StateProof, address crypto.Address,
+	key binary.Word256, height uint64) ([]byte, error) {
+	hash, err := c.appHash(ctx, proof, height)
+	if err != nil {
+		return nil, err
+	}
+	return state.VerifyStorage(proof.Proof, address, key, hash)
+}
+
+func (c *Client) verifyName(ctx context.Context, proof *rpcquery.StateProof, name string,
+	height uint64) (*names.Entry, error) {
+	hash, err := c.appHash(ctx, proof, height)
+	if err != nil {
+		return nil, err
+	}
+	return state.VerifyName(proof.Proof, name, hash)
+}
+
+// The hash of the state at a height is the AppHash in the header of the block at the next height
+func (c *Client) appHash(ctx context.Context, proof *rpcquery.StateProof, height uint64) ([]byte, error) {
+	if height != 0 && proof.Height != height {
+		return nil, fmt.Errorf("proof is of state at height %d but %d was asked for", proof.Height, height)
+	}
+	header, err := c.GetBlockHeader(ctx, proof.Height+1)
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
@@ -0,0 +1,74 @@
+package light
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/types"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Provider serves light blocks to Tendermint's light client from the query API of a Burrow node
+type Provider struct {
+	chainID string
+	address string
+	query   rpcquery.QueryClient
+}
+
+var _ provider.Provider = &Provider{}
+
+func NewProvider(chainID, address string, query rpcquery.QueryClient) *Provider {
+	return &Provider{
+		chainID: chainID,
+		address: address,
+		query:   query,
+	}
+}
+
+func (p *Provider) ChainID() string {
+	return p.chainID
+}
+
+// LightBlock returns the unverified light block at height, or at the latest height if it is zero
+func (p *Provider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("cannot get light block at negative height %d", height)
+	}
+	pb, err := p.query.GetLightBlock(ctx, &rpcquery.GetBlockParam{Height: uint64(height)})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, provider.ErrLightBlockNotFound
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return nil, provider.ErrNoResponse
+		}
+		return nil, err
+	}
+	lightBlock, err := types.LightBlockFromProto(pb)
+	if err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+	if lightBlock.ChainID != p.chainID {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("light block is from chain %s but expected %s", lightBlock.ChainID, p.chainID),
+		}
+	}
+	if height != 0 && lightBlock.Height != height {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("light block is at height %d but %d was asked for", lightBlock.Height, height),
+		}
+	}
+	return lightBlock, nil
+}
+
+// ReportEvidence is not supported since Burrow does not accept evidence over its RPC
+func (p *Provider) ReportEvidence(ctx context.Context, ev types.Evidence) error {
+	return fmt.Errorf("cannot report evidence to %v: not supported by Burrow", p)
+}
+
+func (p *Provider) String() string {
+	return fmt.Sprintf("Burrow node at %s", p.address)
+}
@@ -0,0 +1,114 @@
+package light
+
+import (
+	"context"
+	"fmt"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Proxy serves the query API locally, answering only those queries whose responses it can verify with its Client.
+// Other queries return codes.Unimplemented rather than unverified responses.
+type Proxy struct {
+	rpcquery.UnimplementedQueryServer
+	client *Client
+}
+
+var _ rpcquery.QueryServer = &Proxy{}
+
+func NewProxy(client *Client) *Proxy {
+	return &Proxy{
+		client: client,
+	}
+}
+
+func (p *Proxy) GetAccount(ctx context.Context, param *rpcquery.GetAccountParam) (*acm.Account, error) {
+	acc, err := p.client.GetAccount(ctx, param.Address, 0)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		acc = &acm.Account{}
+	}
+	return acc, nil
+}
+
+func (p *Proxy) GetStorage(ctx context.Context, param *rpcquery.GetStorageParam) (*rpcquery.StorageValue, error) {
+	value, err := p.client.GetStorage(ctx, param.Address, param.Key, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcquery.StorageValue{Value: value}, nil
+}
+
+func (p *Proxy) GetName(ctx context.Context, param *rpcquery.GetNameParam) (*names.Entry, error) {
+	entry, err := p.client.GetName(ctx, param.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("name %s not found", param.Name))
+	}
+	return entry, nil
+}
+
+func (p *Proxy) GetBlockHeader(ctx context.Context, param *rpcquery.GetBlockParam) (*tmproto.Header, error) {
+	header, err := p.client.GetBlockHeader(ctx, param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return header.ToProto(), nil
+}
+
+func (p *Proxy) GetLightBlock(ctx context.Context, param *rpcquery.GetBlockParam) (*tmproto.LightBlock, error) {
+	lightBlock, err := p.client.GetLightBlock(ctx, param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return lightBlock.ToProto()
+}
+
+// The proofs are passed through having been checked so our clients may verify them against headers of their own
+
+func (p *Proxy) GetAccountProof(ctx context.Context,
+	param *rpcquery.GetAccountProofParam) (*rpcquery.StateProof, error) {
+	proof, err := p.client.query.GetAccountProof(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	_, err = p.client.verifyAccount(ctx, proof, param.Address, param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+func (p *Proxy) GetStorageProof(ctx context.Context,
+	param *rpcquery.GetStorageProofParam) (*rpcquery.StateProof, error) {
+	proof, err := p.client.query.GetStorageProof(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	_, err = p.client.verifyStorage(ctx, proof, param.Address, param.Key, param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+func (p *Proxy) GetNameProof(ctx context.Context, param *rpcquery.GetNameProofParam) (*rpcquery.StateProof, error) {
+	proof, err := p.client.query.GetNameProof(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	_, err = p.client.verifyName(ctx, proof, param.Name, param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
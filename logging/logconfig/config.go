@@ -90,9 +90,7 @@ func (lc *LoggingConfig) Logger() (*logging.Logger, error) {
 		return nil, err
 	}
 	logger := logging.NewLogger(outputLogger)
-	if !lc.Trace {
-		logger.Trace = log.NewNopLogger()
-	}
+	logger.SetTrace(lc.Trace, nil)
 	go func() {
 		err := <-errCh.Out()
 		if err != nil {
@@ -109,6 +107,7 @@ func (lc *LoggingConfig) UpdateLogger(logger *logging.Logger) (channels.Channel,
 		return channels.NewDeadChannel(), err
 	}
 	logger.SwapOutput(outputLogger)
+	logger.SetTrace(lc.Trace, nil)
 	return errCh, nil
 }
 
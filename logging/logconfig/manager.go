@@ -0,0 +1,166 @@
+package logconfig
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+// Tendermint's log lines are tagged with the module that emits them
+const tendermintModuleKey = "module"
+
+// Manager changes the logging of a running node without restarting it
+type Manager struct {
+	sync.Mutex
+	config *LoggingConfig
+	logger *logging.Logger
+	// Loads the config from the node's config sources when reloading, when set
+	source func() (*LoggingConfig, error)
+	trace  *TraceStatus
+	// Reverts a temporary trace
+	traceTimer *time.Timer
+}
+
+// TraceStatus describes trace enabled on top of the logging config
+type TraceStatus struct {
+	// Only trace the scope (for Burrow) or module (for Tendermint) matching this regex, or everything if empty
+	Module string `json:",omitempty"`
+	// When the trace will be turned off again, or never if nil
+	Until *time.Time `json:",omitempty"`
+}
+
+type LoggingStatus struct {
+	Config *LoggingConfig
+	// Trace enabled at runtime, if any
+	Trace *TraceStatus `json:",omitempty"`
+}
+
+// NewManager manages logger, which must have been built from config
+func NewManager(config *LoggingConfig, logger *logging.Logger) *Manager {
+	if config == nil {
+		config = New().None()
+	}
+	return &Manager{
+		config: config,
+		logger: logger,
+	}
+}
+
+// SetSource provides the function from which Reload loads the logging config
+func (lm *Manager) SetSource(source func() (*LoggingConfig, error)) {
+	lm.Lock()
+	defer lm.Unlock()
+	lm.source = source
+}
+
+func (lm *Manager) Status() *LoggingStatus {
+	lm.Lock()
+	defer lm.Unlock()
+	return &LoggingStatus{
+		Config: lm.config,
+		Trace:  lm.trace,
+	}
+}
+
+// SetConfig rebuilds the sinks of the logger from config, which ends any trace set with SetTrace
+func (lm *Manager) SetConfig(config *LoggingConfig) error {
+	lm.Lock()
+	defer lm.Unlock()
+	return lm.setConfig(config)
+}
+
+// Reload loads the logging config from our source and applies it then reopens any files we log to (e.g. for
+// logrotate)
+func (lm *Manager) Reload() error {
+	lm.Lock()
+	defer lm.Unlock()
+	if lm.source != nil {
+		config, err := lm.source()
+		if err != nil {
+			return fmt.Errorf("could not load logging config: %v", err)
+		}
+		err = lm.setConfig(config)
+		if err != nil {
+			return err
+		}
+	}
+	return lm.logger.Reload()
+}
+
+// SetTrace outputs trace log lines from the scope or module matching the module regex, or from everywhere if it is
+// empty, until the duration has passed or forever if it is zero
+func (lm *Manager) SetTrace(module string, duration time.Duration) error {
+	var filter func([]interface{}) bool
+	if module != "" {
+		var err error
+		filter, err = BuildKeyValuesPredicate([]*KeyValuePredicateConfig{{
+			KeyRegex:   fmt.Sprintf("^(%s|%s)$", structure.ScopeKey, tendermintModuleKey),
+			ValueRegex: module,
+		}}, false)
+		if err != nil {
+			return fmt.Errorf("could not compile module regex '%s': %v", module, err)
+		}
+	}
+	lm.Lock()
+	defer lm.Unlock()
+	lm.stopTraceTimer()
+	trace := &TraceStatus{Module: module}
+	if duration > 0 {
+		until := time.Now().Add(duration)
+		trace.Until = &until
+		lm.traceTimer = time.AfterFunc(duration, func() {
+			lm.Lock()
+			defer lm.Unlock()
+			// Unless it has been replaced since
+			if lm.trace == trace {
+				lm.resetTrace()
+			}
+		})
+	}
+	lm.trace = trace
+	lm.logger.SetTrace(true, filter)
+	return nil
+}
+
+// ResetTrace ends any trace set with SetTrace so that we trace as our logging config says
+func (lm *Manager) ResetTrace() {
+	lm.Lock()
+	defer lm.Unlock()
+	lm.resetTrace()
+}
+
+func (lm *Manager) setConfig(config *LoggingConfig) error {
+	if config == nil {
+		config = New().None()
+	}
+	errCh, err := config.UpdateLogger(lm.logger)
+	if err != nil {
+		return fmt.Errorf("could not build logger from config: %v", err)
+	}
+	go func() {
+		err := <-errCh.Out()
+		if err != nil {
+			fmt.Printf("Logging error: %v", err)
+		}
+	}()
+	lm.stopTraceTimer()
+	lm.trace = nil
+	lm.config = config
+	return nil
+}
+
+func (lm *Manager) resetTrace() {
+	lm.stopTraceTimer()
+	lm.trace = nil
+	lm.logger.SetTrace(lm.config.Trace, nil)
+}
+
+func (lm *Manager) stopTraceTimer() {
+	if lm.traceTimer != nil {
+		lm.traceTimer.Stop()
+		lm.traceTimer = nil
+	}
+}
@@ -0,0 +1,45 @@
+package logconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/loggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_SetTrace(t *testing.T) {
+	capture := loggers.NewCaptureLogger(log.NewNopLogger(), 100, false)
+	logger := logging.NewLogger(capture)
+	logger.SetTrace(false, nil)
+	lm := NewManager(New(), logger)
+
+	consensus := logger.WithScope("consensus")
+	state := logger.WithScope("state")
+	traceBoth := func() int {
+		consensus.TraceMsg("tracing consensus")
+		state.TraceMsg("tracing state")
+		state.InfoMsg("info from state")
+		return len(capture.FlushLogLines())
+	}
+
+	assert.Equal(t, 1, traceBoth(), "trace should be off")
+	require.NoError(t, lm.SetTrace("consensus", 0))
+	assert.Equal(t, "consensus", lm.Status().Trace.Module)
+	assert.Equal(t, 2, traceBoth(), "only consensus should be traced")
+	lm.ResetTrace()
+	assert.Nil(t, lm.Status().Trace)
+	assert.Equal(t, 1, traceBoth(), "trace should be off")
+
+	require.NoError(t, lm.SetTrace("", 100*time.Millisecond))
+	assert.NotNil(t, lm.Status().Trace.Until)
+	assert.Equal(t, 3, traceBoth(), "everything should be traced")
+	time.Sleep(300 * time.Millisecond)
+	assert.Nil(t, lm.Status().Trace)
+	assert.Equal(t, 1, traceBoth(), "trace should have been turned off again")
+
+	require.Error(t, lm.SetTrace("(", 0))
+}
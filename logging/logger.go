@@ -5,6 +5,7 @@ package logging
 
 import (
 	"github.com/go-kit/kit/log"
+	"github.com/hyperledger/burrow/logging/loggers"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/util/slice"
 )
@@ -28,6 +29,8 @@ type Logger struct {
 	// instead.
 	Trace  log.Logger
 	Output *log.SwapLogger
+	// Switches the Trace channel between Output and nothing at runtime
+	traceSwitch *log.SwapLogger
 }
 
 // Create an InfoTraceLogger by passing the initial outputLogger.
@@ -36,6 +39,8 @@ func NewLogger(outputLogger log.Logger) *Logger {
 	// long will start dropping log lines by using a ring buffer.
 	swapLogger := new(log.SwapLogger)
 	swapLogger.Swap(outputLogger)
+	traceSwitch := new(log.SwapLogger)
+	traceSwitch.Swap(swapLogger)
 
 	return &Logger{
 		Output: swapLogger,
@@ -43,9 +48,10 @@ func NewLogger(outputLogger log.Logger) *Logger {
 		Info: log.With(swapLogger,
 			structure.ChannelKey, structure.InfoChannelName,
 		),
-		Trace: log.With(swapLogger,
+		Trace: log.With(traceSwitch,
 			structure.ChannelKey, structure.TraceChannelName,
 		),
+		traceSwitch: traceSwitch,
 	}
 }
 
@@ -91,9 +97,10 @@ func (l *Logger) With(keyvals ...interface{}) *Logger {
 		return nil
 	}
 	return &Logger{
-		Output: l.Output,
-		Info:   log.With(l.Info, keyvals...),
-		Trace:  log.With(l.Trace, keyvals...),
+		Output:      l.Output,
+		Info:        log.With(l.Info, keyvals...),
+		Trace:       log.With(l.Trace, keyvals...),
+		traceSwitch: l.traceSwitch,
 	}
 }
 
@@ -103,9 +110,10 @@ func (l *Logger) WithInfo(keyvals ...interface{}) *Logger {
 		return nil
 	}
 	return &Logger{
-		Output: l.Output,
-		Info:   log.With(l.Info, keyvals...),
-		Trace:  l.Trace,
+		Output:      l.Output,
+		Info:        log.With(l.Info, keyvals...),
+		Trace:       l.Trace,
+		traceSwitch: l.traceSwitch,
 	}
 }
 
@@ -115,9 +123,10 @@ func (l *Logger) WithTrace(keyvals ...interface{}) *Logger {
 		return nil
 	}
 	return &Logger{
-		Output: l.Output,
-		Info:   l.Info,
-		Trace:  log.With(l.Trace, keyvals...),
+		Output:      l.Output,
+		Info:        l.Info,
+		Trace:       log.With(l.Trace, keyvals...),
+		traceSwitch: l.traceSwitch,
 	}
 }
 
@@ -126,9 +135,44 @@ func (l *Logger) WithPrefix(keyvals ...interface{}) *Logger {
 		return nil
 	}
 	return &Logger{
-		Output: l.Output,
-		Info:   log.WithPrefix(l.Info, keyvals...),
-		Trace:  log.WithPrefix(l.Trace, keyvals...),
+		Output:      l.Output,
+		Info:        log.WithPrefix(l.Info, keyvals...),
+		Trace:       log.WithPrefix(l.Trace, keyvals...),
+		traceSwitch: l.traceSwitch,
+	}
+}
+
+// WithRedaction returns a logger that replaces each of secrets with [redacted] wherever it appears in the values logged
+func (l *Logger) WithRedaction(secrets ...string) *Logger {
+	if l == nil || len(secrets) == 0 {
+		return l
+	}
+	return &Logger{
+		Output:      l.Output,
+		Info:        loggers.RedactLogger(l.Info, secrets...),
+		Trace:       loggers.RedactLogger(l.Trace, secrets...),
+		traceSwitch: l.traceSwitch,
+	}
+}
+
+// SetTrace switches the Trace channel of this logger and every logger derived from the same root on or off. When
+// filter is provided only the Trace log lines it matches are output.
+func (l *Logger) SetTrace(enabled bool, filter func(keyvals []interface{}) bool) {
+	if l == nil || l.traceSwitch == nil {
+		return
+	}
+	switch {
+	case !enabled:
+		l.traceSwitch.Swap(log.NewNopLogger())
+	case filter != nil:
+		l.traceSwitch.Swap(log.LoggerFunc(func(keyvals ...interface{}) error {
+			if filter(keyvals) {
+				return l.Output.Log(keyvals...)
+			}
+			return nil
+		}))
+	default:
+		l.traceSwitch.Swap(l.Output)
 	}
 }
 
@@ -0,0 +1,81 @@
+package loggers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+const Redacted = "[redacted]"
+
+// RedactLogger replaces each of secrets wherever it appears in the values of lines logged to it with [redacted] before
+// passing them on to outputLogger
+func RedactLogger(outputLogger log.Logger, secrets ...string) log.Logger {
+	if len(secrets) == 0 {
+		return outputLogger
+	}
+	return log.LoggerFunc(func(keyvals ...interface{}) error {
+		redacted := make([]interface{}, len(keyvals))
+		for i, kv := range keyvals {
+			redacted[i] = kv
+			if i%2 == 0 {
+				continue
+			}
+			str, ok := kv.(string)
+			if !ok {
+				str = fmt.Sprint(kv)
+			}
+			if red := Redact(str, secrets...); red != str {
+				redacted[i] = red
+			}
+		}
+		return outputLogger.Log(redacted...)
+	})
+}
+
+// Redact replaces each of secrets in str with [redacted], along with the forms it takes once hex-encoded, as it is
+// in transaction data and ABI-encoded arguments
+func Redact(str string, secrets ...string) string {
+	for _, secret := range secrets {
+		for _, form := range encodings(secret) {
+			str = strings.ReplaceAll(str, form, Redacted)
+		}
+	}
+	return str
+}
+
+// RedactJSON replaces each of secrets in the JSON bs with [redacted] as Redact does, including where a secret has been
+// escaped in a JSON string
+func RedactJSON(bs []byte, secrets ...string) []byte {
+	escaped := make([]string, 0, 2*len(secrets))
+	for _, secret := range secrets {
+		// With and without the escaping of HTML characters that json.Marshal does by default
+		for _, escapeHTML := range []bool{true, false} {
+			buf := new(bytes.Buffer)
+			encoder := json.NewEncoder(buf)
+			encoder.SetEscapeHTML(escapeHTML)
+			if encoder.Encode(secret) == nil {
+				quoted := strings.TrimSpace(buf.String())
+				escaped = append(escaped, quoted[1:len(quoted)-1])
+			}
+		}
+	}
+	return []byte(Redact(string(bs), append(escaped, secrets...)...))
+}
+
+// Returns secret, its bytes hex-encoded in either case, and if secret is itself hex, its digits in either case
+func encodings(secret string) []string {
+	if secret == "" {
+		return nil
+	}
+	forms := []string{secret, hex.EncodeToString([]byte(secret)), hex.EncodeUpperToString([]byte(secret))}
+	digits := strings.TrimPrefix(strings.TrimPrefix(secret, "0x"), "0X")
+	if _, err := hex.DecodeString(digits); err == nil && digits != "" {
+		forms = append(forms, strings.ToLower(digits), strings.ToUpper(digits))
+	}
+	return forms
+}
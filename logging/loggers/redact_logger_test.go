@@ -0,0 +1,33 @@
+package loggers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactLogger(t *testing.T) {
+	testLogger := NewChannelLogger(100)
+	redactLogger := RedactLogger(testLogger, "hunter2", "s3cret")
+	redactLogger.Log("password", "hunter2", "data", []string{"foo", "s3cret"}, "count", 3)
+	redactLogger.Log("hunter2", "key is not redacted")
+	assert.Equal(t, [][]interface{}{
+		{"password", Redacted, "data", fmt.Sprintf("[foo %s]", Redacted), "count", 3},
+		{"hunter2", "key is not redacted"},
+	}, testLogger.FlushLogLines())
+}
+
+func TestRedactEncoded(t *testing.T) {
+	// s3cret ABI-encoded as a string argument of a call
+	data := "0000000000000000000000000000000000000000000000000000000000000006" +
+		"7333637265740000000000000000000000000000000000000000000000000000"
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000006"+Redacted+
+		"0000000000000000000000000000000000000000000000000000", Redact(data, "s3cret"))
+	assert.Equal(t, "Data: "+Redacted+"00", Redact("Data: 73336372657400", "s3cret"))
+	// A hex secret passed as bytes may be logged in either case
+	assert.Equal(t, "key "+Redacted+", "+Redacted, Redact("key DEADBEEF, deadbeef", "0xDeadBeef"))
+	for _, bs := range []string{`{"password": "a<b\"c"}`, `{"password": "a\u003cb\"c"}`} {
+		assert.Equal(t, `{"password": "`+Redacted+`"}`, string(RedactJSON([]byte(bs), `a<b"c`)))
+	}
+}
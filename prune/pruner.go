@@ -0,0 +1,183 @@
+package prune
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/snapshot"
+)
+
+const (
+	// State needs enough versions below the last block to load its validator ring so we always keep at least this many
+	MinRetainBlocks = state.DefaultValidatorsWindowSize + 1
+	// Deleting versions of state visits every tree so is only done once the retained height has moved this far
+	DefaultStateInterval = 100
+)
+
+// Pruner deletes the blocks and versions of state that fall outside of a retention window of a number of the most
+// recent blocks or of the blocks from some recent duration. When both are set the longer window is kept.
+type Pruner struct {
+	state *state.State
+	// Snapshots whose heights must be kept, if any
+	snapshots      *snapshot.Store
+	retainBlocks   uint64
+	retainDuration time.Duration
+	stateInterval  uint64
+	// State below this height has already been pruned
+	prunedHeight uint64
+	// No block before this height is within retainDuration
+	durationHeight uint64
+	// Non-zero while a compaction is running
+	compacting int32
+	// Closed when the latest compaction has finished
+	compacted chan struct{}
+	logger    *logging.Logger
+}
+
+// NewPruner returns a Pruner retaining the last retainBlocks blocks and the blocks of the last retainDuration, either of
+// which can be zero to not retain by it. If both are zero nothing is pruned.
+func NewPruner(st *state.State, snapshots *snapshot.Store, retainBlocks uint64, retainDuration time.Duration,
+	logger *logging.Logger) *Pruner {
+	if retainBlocks > 0 && retainBlocks < MinRetainBlocks {
+		retainBlocks = MinRetainBlocks
+	}
+	return &Pruner{
+		state:          st,
+		snapshots:      snapshots,
+		retainBlocks:   retainBlocks,
+		retainDuration: retainDuration,
+		stateInterval:  DefaultStateInterval,
+		compacted:      closedChan(),
+		logger:         logger.WithScope("prune.Pruner"),
+	}
+}
+
+// Prune deletes the state no longer retained once the block at height with blockTime has been committed and returns the
+// height below which Tendermint may delete blocks, or zero if it should keep them all
+func (p *Pruner) Prune(height uint64, blockTime time.Time) uint64 {
+	retainHeight, err := p.RetainHeight(height, blockTime)
+	if err != nil {
+		p.logger.InfoMsg("Could not determine height to retain from", "height", height, structure.ErrorKey, err)
+		return 0
+	}
+	if retainHeight == 0 || retainHeight < p.prunedHeight+p.stateInterval {
+		return retainHeight
+	}
+	err = p.state.Prune(retainHeight)
+	if err != nil {
+		// Versions may have readers so try again later
+		p.logger.InfoMsg("Could not prune state", "retain_height", retainHeight, structure.ErrorKey, err)
+		return retainHeight
+	}
+	p.prunedHeight = retainHeight
+	p.logger.InfoMsg("Pruned state", "retain_height", retainHeight)
+	p.compact()
+	return retainHeight
+}
+
+// Compacted returns a channel that is closed once no compaction is running
+func (p *Pruner) Compacted() <-chan struct{} {
+	return p.compacted
+}
+
+// Compacting the database can take minutes on a large state so runs in the background while blocks are committed. If
+// a compaction is still running from the last prune we leave it to reclaim the space from this one too.
+func (p *Pruner) compact() {
+	if !atomic.CompareAndSwapInt32(&p.compacting, 0, 1) {
+		return
+	}
+	compacted := make(chan struct{})
+	p.compacted = compacted
+	go func() {
+		defer close(compacted)
+		defer atomic.StoreInt32(&p.compacting, 0)
+		start := time.Now()
+		err := p.state.Compact()
+		if err != nil {
+			p.logger.InfoMsg("Could not compact state", structure.ErrorKey, err)
+			return
+		}
+		p.logger.InfoMsg("Compacted state", "duration", time.Since(start).String())
+	}()
+}
+
+// RetainHeight returns the lowest height whose block and state must be kept once the block at height with blockTime
+// has been committed, or zero if all must be kept
+func (p *Pruner) RetainHeight(height uint64, blockTime time.Time) (uint64, error) {
+	if p.retainBlocks == 0 && p.retainDuration == 0 || height < MinRetainBlocks {
+		return 0, nil
+	}
+	retainHeight := height - MinRetainBlocks + 1
+	if p.retainBlocks > 0 {
+		if height < p.retainBlocks {
+			return 0, nil
+		}
+		retainHeight = height - p.retainBlocks + 1
+	}
+	if p.retainDuration > 0 {
+		durationHeight, err := p.heightSince(blockTime.Add(-p.retainDuration), height)
+		if err != nil {
+			return 0, err
+		}
+		if p.retainBlocks == 0 || durationHeight < retainHeight {
+			retainHeight = durationHeight
+		}
+		if maxRetainHeight := height - MinRetainBlocks + 1; retainHeight > maxRetainHeight {
+			retainHeight = maxRetainHeight
+		}
+	}
+	if p.snapshots != nil {
+		snapshotHeight, err := p.snapshots.RetainHeight()
+		if err != nil {
+			return 0, err
+		}
+		if snapshotHeight > 0 && snapshotHeight < retainHeight {
+			retainHeight = snapshotHeight
+		}
+	}
+	return retainHeight, nil
+}
+
+// The lowest height up to height of a block committed at or after since. Block times only increase so we can search
+// from the height found last time.
+func (p *Pruner) heightSince(since time.Time, height uint64) (uint64, error) {
+	st, err := p.state.AtLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+	from := p.durationHeight
+	if from == 0 || from > height {
+		from = 1
+	}
+	var searchErr error
+	n := sort.Search(int(height-from+1), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		// Empty blocks are not stored so this may be the time of an earlier block, which just retains more
+		beginBlock, err := st.LastBeginBlock(from + uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return beginBlock != nil && !beginBlock.Header.Time.Before(since)
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+	p.durationHeight = from + uint64(n)
+	if p.durationHeight > height {
+		p.durationHeight = height
+	}
+	return p.durationHeight, nil
+}
+
+func closedChan() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
@@ -0,0 +1,54 @@
+package prune
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestPruner(t *testing.T) {
+	db := dbm.NewMemDB()
+	st, err := state.MakeGenesisState(db,
+		&genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	const height = 30
+	for i := 1; i <= height; i++ {
+		_, _, err := st.Update(func(ws state.Updatable) error {
+			return ws.UpdateAccount(acm.NewAccountFromSecret(fmt.Sprintf("account%d", i)))
+		})
+		require.NoError(t, err)
+	}
+
+	// Nothing is pruned unless asked
+	pruner := NewPruner(st, nil, 0, 0, logging.NewNoopLogger())
+	require.Equal(t, uint64(0), pruner.Prune(height, time.Now()))
+
+	// We never retain fewer blocks than we need
+	pruner = NewPruner(st, nil, 1, 0, logging.NewNoopLogger())
+	require.Equal(t, uint64(height-MinRetainBlocks+1), pruner.Prune(height, time.Now()))
+
+	pruner = NewPruner(st, nil, 15, 0, logging.NewNoopLogger())
+	pruner.stateInterval = 1
+	require.Equal(t, uint64(16), pruner.Prune(height, time.Now()))
+	<-pruner.Compacted()
+	_, err = st.HashAtHeight(15)
+	require.Error(t, err)
+	_, err = st.HashAtHeight(16)
+	require.NoError(t, err)
+	acc, err := st.GetAccount(acm.NewAccountFromSecret("account1").Address)
+	require.NoError(t, err)
+	require.NotNil(t, acc)
+
+	// Enough state is kept to load the latest version
+	_, err = state.LoadState(db, state.VersionAtHeight(height))
+	require.NoError(t, err)
+}
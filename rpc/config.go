@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"net"
+
+	"github.com/hyperledger/burrow/crypto"
 )
 
 // 'LocalHost' gets interpreted as ipv6
@@ -15,12 +17,29 @@ type RPCConfig struct {
 	GRPC     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	Metrics  *MetricsConfig `json:",omitempty" toml:",omitempty"`
 	Web3     *ServerConfig  `json:",omitempty" toml:",omitempty"`
+	// Serves methods that change the running node, such as its peers, so should only be reachable by operators
+	Admin *ServerConfig `json:",omitempty" toml:",omitempty"`
+	// Hands out native tokens to any address that asks, so should only be enabled on development and test chains
+	Faucet *FaucetConfig `json:",omitempty" toml:",omitempty"`
 }
 
 type ServerConfig struct {
 	Enabled    bool
 	ListenHost string
 	ListenPort string
+	// Cross-origin resource sharing policy for browser clients, only used by HTTP servers (Info and Web3)
+	CORS *CORSConfig `json:",omitempty" toml:",omitempty"`
+}
+
+type CORSConfig struct {
+	// Origins from which browsers may make cross-origin requests, for example "http://localhost:3000", or "*" for any
+	AllowedOrigins []string
+	// Methods allowed in cross-origin requests
+	AllowedMethods []string
+	// Request headers allowed in cross-origin requests, if empty any headers requested by the browser are allowed
+	AllowedHeaders []string
+	// Seconds for which the browser may cache the result of a preflight request
+	MaxAge int
 }
 
 func (sc *ServerConfig) ListenAddress() string {
@@ -33,6 +52,16 @@ type MetricsConfig struct {
 	BlockSampleSize int
 }
 
+type FaucetConfig struct {
+	ServerConfig
+	// Account that tokens are sent from, whose key must be held by this node
+	Address *crypto.Address `json:",omitempty" toml:",omitempty"`
+	// Native amount sent for each request
+	Amount uint64
+	// How long an address, or a client, must wait between requests, as a duration like "1h"
+	Interval string
+}
+
 func DefaultRPCConfig() *RPCConfig {
 	return &RPCConfig{
 		Info:     DefaultInfoConfig(),
@@ -40,6 +69,8 @@ func DefaultRPCConfig() *RPCConfig {
 		GRPC:     DefaultGRPCConfig(),
 		Metrics:  DefaultMetricsConfig(),
 		Web3:     DefaultWeb3Config(),
+		Admin:    DefaultAdminConfig(),
+		Faucet:   DefaultFaucetConfig(),
 	}
 }
 
@@ -86,3 +117,23 @@ func DefaultWeb3Config() *ServerConfig {
 		ListenPort: "26660",
 	}
 }
+
+func DefaultAdminConfig() *ServerConfig {
+	return &ServerConfig{
+		Enabled:    false,
+		ListenHost: LocalHost,
+		ListenPort: "26661",
+	}
+}
+
+func DefaultFaucetConfig() *FaucetConfig {
+	return &FaucetConfig{
+		ServerConfig: ServerConfig{
+			Enabled:    false,
+			ListenHost: AnyLocal,
+			ListenPort: "26662",
+		},
+		Amount:   1000000,
+		Interval: "1h",
+	}
+}
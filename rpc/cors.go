@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Handler wraps handler to apply the CORS policy, answering preflight requests directly. If the CORSConfig is nil
+// handler is returned unchanged.
+func (cc *CORSConfig) Handler(handler http.Handler) http.Handler {
+	if cc == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			// Not a cross-origin request
+			handler.ServeHTTP(w, r)
+			return
+		}
+		header := w.Header()
+		header.Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		if !cc.AllowsOrigin(origin) {
+			// Remove any headers set by default so that the browser will refuse the response
+			header.Del("Access-Control-Allow-Origin")
+			header.Del("Access-Control-Allow-Credentials")
+			if preflight {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			handler.ServeHTTP(w, r)
+			return
+		}
+		header.Set("Access-Control-Allow-Origin", origin)
+		if !preflight {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		if !cc.AllowsMethod(r.Header.Get("Access-Control-Request-Method")) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		header.Set("Access-Control-Allow-Methods", strings.Join(cc.AllowedMethods, ", "))
+		if len(cc.AllowedHeaders) > 0 {
+			header.Set("Access-Control-Allow-Headers", strings.Join(cc.AllowedHeaders, ", "))
+		} else if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+			header.Set("Access-Control-Allow-Headers", requested)
+		}
+		if cc.MaxAge > 0 {
+			header.Set("Access-Control-Max-Age", strconv.Itoa(cc.MaxAge))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (cc *CORSConfig) AllowsOrigin(origin string) bool {
+	for _, allowed := range cc.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cc *CORSConfig) AllowsMethod(method string) bool {
+	for _, allowed := range cc.AllowedMethods {
+		if strings.EqualFold(allowed, method) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSConfig_Handler(t *testing.T) {
+	cors := &CORSConfig{
+		AllowedOrigins: []string{"http://localhost:3000"},
+		AllowedMethods: []string{http.MethodPost},
+		MaxAge:         600,
+	}
+	handler := cors.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method, origin, requestMethod string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if requestMethod != "" {
+			req.Header.Set("Access-Control-Request-Method", requestMethod)
+			req.Header.Set("Access-Control-Request-Headers", "Content-Type")
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("SameOrigin", func(t *testing.T) {
+		rec := serve(http.MethodPost, "", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("AllowedOrigin", func(t *testing.T) {
+		rec := serve(http.MethodPost, "http://localhost:3000", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "http://localhost:3000", rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("DisallowedOrigin", func(t *testing.T) {
+		rec := serve(http.MethodPost, "http://evil.example", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("Preflight", func(t *testing.T) {
+		rec := serve(http.MethodOptions, "http://localhost:3000", http.MethodPost)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "http://localhost:3000", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, http.MethodPost, rec.Header().Get("Access-Control-Allow-Methods"))
+		assert.Equal(t, "Content-Type", rec.Header().Get("Access-Control-Allow-Headers"))
+		assert.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("PreflightDisallowedMethod", func(t *testing.T) {
+		rec := serve(http.MethodOptions, "http://localhost:3000", http.MethodDelete)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("PreflightDisallowedOrigin", func(t *testing.T) {
+		rec := serve(http.MethodOptions, "http://evil.example", http.MethodPost)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
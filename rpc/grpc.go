@@ -3,6 +3,7 @@ package rpc
 import (
 	"fmt"
 	"runtime/debug"
+	"sync"
 
 	"github.com/hyperledger/burrow/encoding"
 
@@ -12,12 +13,47 @@ import (
 	"google.golang.org/grpc"
 )
 
+var interceptors struct {
+	sync.RWMutex
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+// RegisterUnaryInterceptor adds an interceptor that will be run on every unary call made to GRPC servers created by
+// NewGRPCServer after it is registered. Intended to be called from an init() function of a package compiled into a
+// custom build of Burrow in order to implement authentication, billing, logging, etc. Interceptors are run in
+// registration order, inside Burrow's own logging and panic recovery interceptor.
+func RegisterUnaryInterceptor(interceptor grpc.UnaryServerInterceptor) {
+	interceptors.Lock()
+	defer interceptors.Unlock()
+	interceptors.unary = append(interceptors.unary, interceptor)
+}
+
+// RegisterStreamInterceptor is the streaming analogue of RegisterUnaryInterceptor
+func RegisterStreamInterceptor(interceptor grpc.StreamServerInterceptor) {
+	interceptors.Lock()
+	defer interceptors.Unlock()
+	interceptors.stream = append(interceptors.stream, interceptor)
+}
+
 func NewGRPCServer(logger *logging.Logger) *grpc.Server {
-	return grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor(logger)),
-		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"))),
+	return grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors(logger)...),
+		grpc.ChainStreamInterceptor(streamInterceptors(logger.WithScope("NewGRPCServer"))...),
 		grpc.CustomCodec(&encoding.GRPCCodec{}))
 }
 
+func unaryInterceptors(logger *logging.Logger) []grpc.UnaryServerInterceptor {
+	interceptors.RLock()
+	defer interceptors.RUnlock()
+	return append([]grpc.UnaryServerInterceptor{unaryInterceptor(logger)}, interceptors.unary...)
+}
+
+func streamInterceptors(logger *logging.Logger) []grpc.StreamServerInterceptor {
+	interceptors.RLock()
+	defer interceptors.RUnlock()
+	return append([]grpc.StreamServerInterceptor{streamInterceptor(logger)}, interceptors.stream...)
+}
+
 func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestRegisterUnaryInterceptor(t *testing.T) {
+	var calls []string
+	RegisterUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, info.FullMethod)
+		return handler(ctx, req)
+	})
+	defer func() {
+		interceptors.unary = nil
+	}()
+
+	chain := unaryInterceptors(logging.NewNoopLogger())
+	require.Len(t, chain, 2)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcquery.Query/Status"}
+	// Compose the chain as grpc would with the first interceptor outermost
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		interceptor, next := chain[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	resp, err := handler(context.Background(), "request")
+	require.NoError(t, err)
+	assert.Equal(t, "request", resp)
+	assert.Equal(t, []string{"/rpcquery.Query/Status"}, calls)
+}
+
+func TestRegisteredInterceptorPanicRecovered(t *testing.T) {
+	RegisterUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		panic("billing failure")
+	})
+	defer func() {
+		interceptors.unary = nil
+	}()
+
+	chain := unaryInterceptors(logging.NewNoopLogger())
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcquery.Query/Status"}
+	_, err := chain[0](context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return chain[1](ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return req, nil
+		})
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "billing failure")
+}
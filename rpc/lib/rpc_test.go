@@ -16,11 +16,11 @@ import (
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/process"
 
+	cmn "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/hyperledger/burrow/rpc/lib/server"
 	"github.com/hyperledger/burrow/rpc/lib/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	cmn "github.com/tendermint/tendermint/libs/bytes"
 )
 
 // Client and Server should work over tcp or unix sockets
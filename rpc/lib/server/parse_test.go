@@ -5,8 +5,8 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/stretchr/testify/assert"
-	"github.com/tendermint/tendermint/libs/bytes"
 )
 
 func TestParseJSONMap(t *testing.T) {
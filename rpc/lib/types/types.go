@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	tmpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	"github.com/pkg/errors"
-	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 )
 
 type RPCRequest struct {
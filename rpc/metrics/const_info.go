@@ -1,15 +1,18 @@
 package metrics
 
 import (
+	core_types "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/rpc"
-	core_types "github.com/tendermint/tendermint/rpc/core/types"
-	"github.com/tendermint/tendermint/types"
 )
 
 // For mocking purposes
 type constInfo struct {
 	acmstate.AccountStats
+	ContractStatList []exec.ContractStat
+	*rpc.ResultCacheStats
 	*rpc.ResultUnconfirmedTxs
 	*rpc.ResultStatus
 	NodePeers  []core_types.Peer
@@ -53,3 +56,15 @@ func (is *constInfo) Stats() acmstate.AccountStatsGetter {
 func (is *constInfo) GetAccountStats() acmstate.AccountStats {
 	return is.AccountStats
 }
+
+func (is *constInfo) ContractStats(limit int) (*rpc.ResultContractStats, error) {
+	stats := is.ContractStatList
+	if limit > 0 && len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return &rpc.ResultContractStats{Contracts: stats}, nil
+}
+
+func (is *constInfo) CacheStats() (*rpc.ResultCacheStats, error) {
+	return is.ResultCacheStats, nil
+}
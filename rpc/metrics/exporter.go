@@ -7,17 +7,19 @@ import (
 	"math"
 	"sort"
 
-	"github.com/tendermint/tendermint/types"
+	"github.com/cometbft/cometbft/types"
 
+	core_types "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/prometheus/client_golang/prometheus"
-	core_types "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 const maxUnconfirmedTxsToFetch = 10000000000
+const maxContractsToExport = 100
 const significantFiguresForSeconds = 3
 
 type HistogramBuilder func(values []float64) (buckets map[float64]uint64, sum float64)
@@ -43,6 +45,8 @@ type InfoService interface {
 	Peers() []core_types.Peer
 	Blocks(minHeight, maxHeight int64) (*rpc.ResultBlocks, error)
 	Stats() acmstate.AccountStatsGetter
+	ContractStats(limit int) (*rpc.ResultContractStats, error)
+	CacheStats() (*rpc.ResultCacheStats, error)
 }
 
 // Datum is used to store data from all the relevant endpoints
@@ -59,6 +63,8 @@ type Datum struct {
 	TimePerBlockBuckets map[float64]uint64
 	AccountsWithCode    float64
 	AccountsWithoutCode float64
+	ContractStats       []exec.ContractStat
+	CacheStats          rpc.ResultCacheStats
 }
 
 // Exporter uses the InfoService to provide pre-aggregated metrics of various types that are then passed to prometheus
@@ -162,6 +168,56 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		e.chainID,
 		e.validatorMoniker,
 	)
+	for _, stat := range e.datum.ContractStats {
+		address := stat.Address.String()
+		ch <- prometheus.MustNewConstMetric(
+			ContractCalls,
+			prometheus.CounterValue,
+			float64(stat.Calls),
+			e.chainID,
+			e.validatorMoniker,
+			address,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			ContractFailures,
+			prometheus.CounterValue,
+			float64(stat.Failures),
+			e.chainID,
+			e.validatorMoniker,
+			address,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			ContractGasUsed,
+			prometheus.CounterValue,
+			float64(stat.GasUsed),
+			e.chainID,
+			e.validatorMoniker,
+			address,
+		)
+	}
+	cacheStats := e.datum.CacheStats
+	for _, metric := range []struct {
+		desc      *prometheus.Desc
+		valueType prometheus.ValueType
+		value     float64
+	}{
+		{StateCacheTrees, prometheus.GaugeValue, float64(cacheStats.State.Trees)},
+		{StateCacheTreeHits, prometheus.CounterValue, float64(cacheStats.State.TreeHits)},
+		{StateCacheTreeMisses, prometheus.CounterValue, float64(cacheStats.State.TreeMisses)},
+		{StateCacheTreeNodes, prometheus.GaugeValue, float64(cacheStats.State.TreeNodes)},
+		{StateCacheTreeNodeReads, prometheus.CounterValue, float64(cacheStats.State.TreeNodeReads)},
+		{CodeCacheContracts, prometheus.GaugeValue, float64(cacheStats.Code.Contracts)},
+		{CodeCacheHits, prometheus.CounterValue, float64(cacheStats.Code.Hits)},
+		{CodeCacheMisses, prometheus.CounterValue, float64(cacheStats.Code.Misses)},
+	} {
+		ch <- prometheus.MustNewConstMetric(
+			metric.desc,
+			metric.valueType,
+			metric.value,
+			e.chainID,
+			e.validatorMoniker,
+		)
+	}
 
 	e.logger.InfoMsg("All Metrics successfully collected")
 }
@@ -195,6 +251,14 @@ func (e *Exporter) gatherData() error {
 		return err
 	}
 	e.getAccountStats()
+	err = e.getContractStats()
+	if err != nil {
+		return err
+	}
+	err = e.getCacheStats()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -306,6 +370,25 @@ func (e *Exporter) getAccountStats() {
 	e.datum.AccountsWithoutCode = float64(stats.AccountsWithoutCode)
 }
 
+// Only the contracts that have used the most gas are exported to bound the number of series
+func (e *Exporter) getContractStats() error {
+	res, err := e.service.ContractStats(maxContractsToExport)
+	if err != nil {
+		return err
+	}
+	e.datum.ContractStats = res.Contracts
+	return nil
+}
+
+func (e *Exporter) getCacheStats() error {
+	res, err := e.service.CacheStats()
+	if err != nil {
+		return err
+	}
+	e.datum.CacheStats = *res
+	return nil
+}
+
 // Returns a function that builds a histogram.
 //
 // The builder takes a slice of values one for each entity in a sample, sorts it, and computes histogram buckets as
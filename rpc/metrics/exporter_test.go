@@ -17,7 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
-	"github.com/tendermint/tendermint/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestExporter_Collect_Histogram(t *testing.T) {
@@ -130,6 +130,7 @@ func infoService() *constInfo {
 			},
 		},
 		ResultUnconfirmedTxs: &rpc.ResultUnconfirmedTxs{},
+		ResultCacheStats:     &rpc.ResultCacheStats{},
 		BlockMetas:           genBlocks(numBlocks),
 	}
 }
@@ -49,6 +49,61 @@ var (
 		prometheus.BuildFQName("burrow", "accounts", "users"),
 		"Current users on the chain",
 		[]string{"chain_id", "moniker"})
+
+	ContractCalls = newDesc(
+		prometheus.BuildFQName("burrow", "contract", "calls"),
+		"Calls made to a contract by transactions and other contracts since the node started",
+		[]string{"chain_id", "moniker", "address"})
+
+	ContractFailures = newDesc(
+		prometheus.BuildFQName("burrow", "contract", "failures"),
+		"Calls made to a contract that failed since the node started",
+		[]string{"chain_id", "moniker", "address"})
+
+	ContractGasUsed = newDesc(
+		prometheus.BuildFQName("burrow", "contract", "gas_used"),
+		"Gas used by transactions calling a contract since the node started",
+		[]string{"chain_id", "moniker", "address"})
+
+	StateCacheTrees = newDesc(
+		prometheus.BuildFQName("burrow", "state_cache", "trees"),
+		"Current trees of state loaded in the tree cache",
+		[]string{"chain_id", "moniker"})
+
+	StateCacheTreeHits = newDesc(
+		prometheus.BuildFQName("burrow", "state_cache", "tree_hits"),
+		"Reads of state that found their tree loaded since the node started",
+		[]string{"chain_id", "moniker"})
+
+	StateCacheTreeMisses = newDesc(
+		prometheus.BuildFQName("burrow", "state_cache", "tree_misses"),
+		"Reads of state that loaded their tree from the database since the node started",
+		[]string{"chain_id", "moniker"})
+
+	StateCacheTreeNodes = newDesc(
+		prometheus.BuildFQName("burrow", "state_cache", "tree_nodes"),
+		"Nodes each tree of state loaded keeps in memory",
+		[]string{"chain_id", "moniker"})
+
+	StateCacheTreeNodeReads = newDesc(
+		prometheus.BuildFQName("burrow", "state_cache", "tree_node_reads"),
+		"Reads of the database by trees of state, mostly for nodes not in memory, since the node started",
+		[]string{"chain_id", "moniker"})
+
+	CodeCacheContracts = newDesc(
+		prometheus.BuildFQName("burrow", "code_cache", "contracts"),
+		"Current contracts whose analysed code is cached by the EVM",
+		[]string{"chain_id", "moniker"})
+
+	CodeCacheHits = newDesc(
+		prometheus.BuildFQName("burrow", "code_cache", "hits"),
+		"Calls that found their analysed code cached since the node started",
+		[]string{"chain_id", "moniker"})
+
+	CodeCacheMisses = newDesc(
+		prometheus.BuildFQName("burrow", "code_cache", "misses"),
+		"Calls that analysed their code since the node started",
+		[]string{"chain_id", "moniker"})
 )
 
 func newDesc(fqName, help string, variableLabels []string) *prometheus.Desc {
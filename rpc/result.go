@@ -4,19 +4,23 @@
 package rpc
 
 import (
+	ctypes "github.com/cometbft/cometbft/consensus/types"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	core_types "github.com/cometbft/cometbft/rpc/core/types"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/execution/unbonding"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
-	ctypes "github.com/tendermint/tendermint/consensus/types"
-	tmjson "github.com/tendermint/tendermint/libs/json"
-	core_types "github.com/tendermint/tendermint/rpc/core/types"
-	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 type ResultStorage struct {
@@ -104,6 +108,11 @@ type ResultValidators struct {
 	UnbondingValidators []*validator.Validator
 }
 
+type ResultUnbonding struct {
+	BlockHeight uint64
+	Withdrawals []*unbonding.Withdrawal
+}
+
 type ResultConsensusState struct {
 	*core_types.ResultDumpConsensusState
 }
@@ -157,6 +166,15 @@ type ResultAccountStats struct {
 	AccountsWithoutCode uint64
 }
 
+type ResultContractStats struct {
+	Contracts []exec.ContractStat
+}
+
+type ResultCacheStats struct {
+	State storage.CacheStats
+	Code  evm.CodeCacheStats
+}
+
 type ResultUnconfirmedTxs struct {
 	NumTxs int
 	Txs    []*txs.Envelope
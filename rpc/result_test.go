@@ -7,10 +7,10 @@ import (
 	"encoding/json"
 	"testing"
 
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 func TestResultListAccounts(t *testing.T) {
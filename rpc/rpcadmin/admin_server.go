@@ -0,0 +1,182 @@
+package rpcadmin
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/rpc/lib/server"
+)
+
+// Method names
+const (
+	Peers      = "peers"
+	AddPeer    = "add_peer"
+	RemovePeer = "remove_peer"
+	BanPeer    = "ban_peer"
+	UnbanPeer  = "unban_peer"
+
+	Mempool     = "mempool"
+	EvictTx     = "evict_tx"
+	EvictSender = "evict_sender"
+
+	Logging       = "logging"
+	SetLogging    = "set_logging"
+	ReloadLogging = "reload_logging"
+	Trace         = "trace"
+	ResetTrace    = "reset_trace"
+)
+
+type ResultPeers struct {
+	// The peers we are connected to
+	Peers  []*tendermint.PeerInfo
+	Banned []*tendermint.BannedPeer
+}
+
+type ResultMempool struct {
+	// The transactions listed or evicted
+	Txs []*tendermint.MempoolTx
+}
+
+// The methods below change the running node so are mounted on their own admin server (specified in config at
+// RPC/Admin), which should only be reachable by operators, in the same form as the info server:
+//
+// http://127.0.0.1:26661/add_peer?address="<ID>@<host>:<port>"
+// http://127.0.0.1:26661/ban_peer?id="<ID>"&duration="24h"
+// http://127.0.0.1:26661/evict_sender?address="<address>"
+// http://127.0.0.1:26661/trace?module="consensus"&duration="10m"
+//
+// The peer methods return our peers once any change has been made, the mempool methods return the transactions
+// listed or evicted, and the logging methods return our logging config and any trace enabled on top of it
+func GetRoutes(peers *tendermint.PeerManager, mempool *tendermint.MempoolManager,
+	loggingManager *logconfig.Manager) map[string]*server.RPCFunc {
+	result := func() (*ResultPeers, error) {
+		connected, err := peers.Peers()
+		if err != nil {
+			return nil, err
+		}
+		return &ResultPeers{
+			Peers:  connected,
+			Banned: peers.Banned(),
+		}, nil
+	}
+	loggingStatus := func(err error) (*logconfig.LoggingStatus, error) {
+		if err != nil {
+			return nil, err
+		}
+		return loggingManager.Status(), nil
+	}
+	routes := map[string]*server.RPCFunc{
+		Peers: server.NewRPCFunc(result, ""),
+		AddPeer: server.NewRPCFunc(func(address string) (*ResultPeers, error) {
+			err := peers.AddPersistentPeer(address)
+			if err != nil {
+				return nil, err
+			}
+			return result()
+		}, "address"),
+		RemovePeer: server.NewRPCFunc(func(id string) (*ResultPeers, error) {
+			err := peers.RemovePersistentPeer(id)
+			if err != nil {
+				return nil, err
+			}
+			return result()
+		}, "id"),
+		BanPeer: server.NewRPCFunc(func(id, duration string) (*ResultPeers, error) {
+			var banFor time.Duration
+			if duration != "" {
+				var err error
+				banFor, err = time.ParseDuration(duration)
+				if err != nil {
+					return nil, fmt.Errorf("could not parse ban duration '%s': %v", duration, err)
+				}
+			}
+			err := peers.BanPeer(id, banFor)
+			if err != nil {
+				return nil, err
+			}
+			return result()
+		}, "id,duration"),
+		UnbanPeer: server.NewRPCFunc(func(id string) (*ResultPeers, error) {
+			err := peers.UnbanPeer(id)
+			if err != nil {
+				return nil, err
+			}
+			return result()
+		}, "id"),
+		Mempool: server.NewRPCFunc(func() (*ResultMempool, error) {
+			txs, err := mempool.Txs()
+			if err != nil {
+				return nil, err
+			}
+			return &ResultMempool{Txs: txs}, nil
+		}, ""),
+		EvictTx: server.NewRPCFunc(func(hash binary.HexBytes) (*ResultMempool, error) {
+			evicted, err := mempool.Evict(hash)
+			if err != nil {
+				return nil, err
+			}
+			if len(evicted) == 0 {
+				return nil, fmt.Errorf("transaction %v is not in the mempool", hash)
+			}
+			return &ResultMempool{Txs: evicted}, nil
+		}, "hash"),
+		EvictSender: server.NewRPCFunc(func(address crypto.Address) (*ResultMempool, error) {
+			evicted, err := mempool.EvictSender(address)
+			if err != nil {
+				return nil, err
+			}
+			return &ResultMempool{Txs: evicted}, nil
+		}, "address"),
+	}
+	// Logging is only managed when loaded from config
+	if loggingManager == nil {
+		return routes
+	}
+	routes[Logging] = server.NewRPCFunc(func() (*logconfig.LoggingStatus, error) {
+		return loggingStatus(nil)
+	}, "")
+	routes[SetLogging] = server.NewRPCFunc(func(config string) (*logconfig.LoggingStatus, error) {
+		loggingConfig := new(logconfig.LoggingConfig)
+		err := source.FromString(config, loggingConfig)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse logging config: %v", err)
+		}
+		return loggingStatus(loggingManager.SetConfig(loggingConfig))
+	}, "config")
+	routes[ReloadLogging] = server.NewRPCFunc(func() (*logconfig.LoggingStatus, error) {
+		return loggingStatus(loggingManager.Reload())
+	}, "")
+	routes[Trace] = server.NewRPCFunc(func(module, duration string) (*logconfig.LoggingStatus, error) {
+		var traceFor time.Duration
+		if duration != "" {
+			var err error
+			traceFor, err = time.ParseDuration(duration)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse trace duration '%s': %v", duration, err)
+			}
+		}
+		return loggingStatus(loggingManager.SetTrace(module, traceFor))
+	}, "module,duration")
+	routes[ResetTrace] = server.NewRPCFunc(func() (*logconfig.LoggingStatus, error) {
+		loggingManager.ResetTrace()
+		return loggingStatus(nil)
+	}, "")
+	return routes
+}
+
+func StartServer(peers *tendermint.PeerManager, mempool *tendermint.MempoolManager, loggingManager *logconfig.Manager,
+	listener net.Listener, logger *logging.Logger) (*http.Server, error) {
+	logger = logger.With(structure.ComponentKey, "RPC_Admin")
+	mux := http.NewServeMux()
+	server.RegisterRPCFuncs(mux, GetRoutes(peers, mempool, loggingManager), logger)
+	return server.StartHTTPServer(listener, mux, logger)
+}
@@ -21,5 +21,12 @@ func NewDumpServer(state *state.State, blockchain bcm.BlockchainInfo, logger *lo
 }
 
 func (ds *dumpServer) GetDump(param *GetDumpParam, stream Dump_GetDumpServer) error {
-	return ds.dumper.Transmit(stream, 0, param.Height, dump.All)
+	height, baseHeight, index := param.Height, param.BaseHeight, uint64(0)
+	if param.Resume != nil {
+		height, baseHeight, index = param.Resume.Height, param.Resume.BaseHeight, param.Resume.Index
+	}
+	if baseHeight != 0 {
+		return ds.dumper.TransmitIncremental(stream, baseHeight, height, index, dump.All)
+	}
+	return ds.dumper.TransmitFrom(stream, 0, height, index, dump.All)
 }
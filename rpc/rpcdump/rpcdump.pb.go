@@ -12,7 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	golang_proto "github.com/golang/protobuf/proto"
-	_ "github.com/hyperledger/burrow/dump"
+	dump "github.com/hyperledger/burrow/dump"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,7 +28,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetDumpParam struct {
-	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Resume a partially transferred dump from the row the token identifies, in which case height is ignored
+	Resume *dump.ResumeToken `protobuf:"bytes,2,opt,name=Resume,proto3" json:"Resume,omitempty"`
+	// Only send what has changed since the dump at BaseHeight, if set
+	BaseHeight           uint64   `protobuf:"varint,3,opt,name=BaseHeight,proto3" json:"BaseHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -70,6 +74,20 @@ func (m *GetDumpParam) GetHeight() uint64 {
 	return 0
 }
 
+func (m *GetDumpParam) GetResume() *dump.ResumeToken {
+	if m != nil {
+		return m.Resume
+	}
+	return nil
+}
+
+func (m *GetDumpParam) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
 func (*GetDumpParam) XXX_MessageName() string {
 	return "rpcdump.GetDumpParam"
 }
@@ -82,20 +100,22 @@ func init() { proto.RegisterFile("rpcdump.proto", fileDescriptor_80c0fd6a8168e01
 func init() { golang_proto.RegisterFile("rpcdump.proto", fileDescriptor_80c0fd6a8168e015) }
 
 var fileDescriptor_80c0fd6a8168e015 = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
+	// 237 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x2a, 0x48, 0x4e,
 	0x29, 0xcd, 0x2d, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x72, 0xa5, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x62, 0xfa, 0x20, 0x16, 0x44, 0x5a, 0x8a, 0x0b, 0xa1, 0x54, 0x49, 0x8d,
+	0xf3, 0xd3, 0xf3, 0xc1, 0x62, 0xfa, 0x20, 0x16, 0x44, 0x5a, 0x8a, 0x0b, 0xa1, 0x54, 0xa9, 0x90,
 	0x8b, 0xc7, 0x3d, 0xb5, 0xc4, 0xa5, 0x34, 0xb7, 0x20, 0x20, 0xb1, 0x28, 0x31, 0x57, 0x48, 0x8c,
 	0x8b, 0x2d, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x08, 0xca,
-	0x33, 0x32, 0xe3, 0x62, 0x01, 0x29, 0x12, 0xd2, 0xe3, 0x62, 0x87, 0xaa, 0x17, 0x12, 0xd5, 0x83,
-	0xd9, 0x8a, 0x6c, 0x82, 0x14, 0x97, 0x1e, 0x58, 0x0c, 0x24, 0x60, 0xc0, 0xe8, 0xe4, 0x7c, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x37, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x78,
-	0xe0, 0xb1, 0x1c, 0xe3, 0x89, 0xc7, 0x72, 0x8c, 0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x19, 0x95, 0x05, 0xa9, 0x45, 0x39, 0xa9, 0x29, 0xe9, 0xa9, 0x45,
-	0xfa, 0x49, 0xa5, 0x45, 0x45, 0xf9, 0xe5, 0xfa, 0x45, 0x05, 0xc9, 0xfa, 0x50, 0xf3, 0x93, 0xd8,
-	0xc0, 0x6e, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x62, 0x82, 0xa1, 0xe7, 0x00, 0x00,
-	0x00,
+	0x13, 0xd2, 0xe4, 0x62, 0x0b, 0x4a, 0x2d, 0x2e, 0xcd, 0x4d, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0,
+	0x36, 0x12, 0xd4, 0x03, 0x1b, 0x02, 0x11, 0x0b, 0xc9, 0xcf, 0x4e, 0xcd, 0x0b, 0x82, 0x2a, 0x10,
+	0x92, 0xe3, 0xe2, 0x72, 0x4a, 0x2c, 0x4e, 0xf5, 0x80, 0x18, 0xc3, 0x0c, 0x36, 0x06, 0x49, 0xc4,
+	0xc8, 0x8c, 0x8b, 0x05, 0x64, 0x9f, 0x90, 0x1e, 0x17, 0x3b, 0xd4, 0x6a, 0x21, 0x51, 0x3d, 0x98,
+	0x07, 0x90, 0x1d, 0x23, 0xc5, 0x05, 0xb1, 0x04, 0x24, 0x60, 0xc0, 0xe8, 0xe4, 0x7c, 0xe2, 0x91,
+	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x37, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x78, 0xe0,
+	0xb1, 0x1c, 0xe3, 0x89, 0xc7, 0x72, 0x8c, 0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
+	0xc9, 0xf9, 0xb9, 0xfa, 0x19, 0x95, 0x05, 0xa9, 0x45, 0x39, 0xa9, 0x29, 0xe9, 0xa9, 0x45, 0xfa,
+	0x49, 0xa5, 0x45, 0x45, 0xf9, 0xe5, 0xfa, 0x45, 0x05, 0xc9, 0xfa, 0x50, 0xf3, 0x93, 0xd8, 0xc0,
+	0xde, 0x36, 0x06, 0x0c, 0x00, 0xe4, 0x2c, 0xb7, 0xc3, 0x32, 0x01, 0x00, 0x00,
 }
 
 func (m *GetDumpParam) Marshal() (dAtA []byte, err error) {
@@ -122,6 +142,23 @@ func (m *GetDumpParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintRpcdump(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Resume != nil {
+		{
+			size, err := m.Resume.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcdump(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Height != 0 {
 		i = encodeVarintRpcdump(dAtA, i, uint64(m.Height))
 		i--
@@ -150,6 +187,13 @@ func (m *GetDumpParam) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovRpcdump(uint64(m.Height))
 	}
+	if m.Resume != nil {
+		l = m.Resume.Size()
+		n += 1 + l + sovRpcdump(uint64(l))
+	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovRpcdump(uint64(m.BaseHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -210,6 +254,61 @@ func (m *GetDumpParam) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcdump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcdump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcdump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resume == nil {
+				m.Resume = &dump.ResumeToken{}
+			}
+			if err := m.Resume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcdump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcdump(dAtA[iNdEx:])
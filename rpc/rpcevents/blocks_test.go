@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBlockRange_Bounds(t *testing.T) {
@@ -14,3 +15,27 @@ func TestBlockRange_Bounds(t *testing.T) {
 	assert.Equal(t, latestHeight, end)
 	assert.False(t, streaming)
 }
+
+func TestBlocksRequest_ResumedBlockRange(t *testing.T) {
+	br := &BlocksRequest{
+		BlockRange: NewBlockRange(AbsoluteBound(3), StreamBound()),
+	}
+	blockRange, err := br.ResumedBlockRange()
+	require.NoError(t, err)
+	assert.Equal(t, br.BlockRange, blockRange)
+
+	br.ResumeToken = NewResumeToken(42)
+	blockRange, err = br.ResumedBlockRange()
+	require.NoError(t, err)
+	start, _, streaming := blockRange.Bounds(100)
+	assert.Equal(t, uint64(42), start)
+	assert.True(t, streaming)
+
+	br.ResumeToken = []byte{2, 0, 0, 0, 0, 0, 0, 0, 42}
+	_, err = br.ResumedBlockRange()
+	require.Error(t, err)
+
+	br.ResumeToken = []byte{1, 42}
+	_, err = br.ResumedBlockRange()
+	require.Error(t, err)
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/storage"
 )
 
@@ -19,23 +22,30 @@ type Provider interface {
 	// Get transactions
 	IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
 		consumer func(*exec.StreamEvent) error) (err error)
+	// Get transactions in ascending order without reading the blocks whose logs bloom mayMatch rejects
+	IterateStreamEventsFiltered(startHeight, endHeight uint64, mayMatch func(height uint64, bloom *exec.LogsBloom) bool,
+		consumer func(*exec.StreamEvent) error) error
 	// Get a particular TxExecution by hash
 	TxByHash(txHash []byte) (*exec.TxExecution, error)
+	// Get the transactions indexed with a value for a tag
+	TxsByTag(tag, value string, startHeight, endHeight uint64, consumer func(*exec.TxExecution) error) error
 }
 
 type executionEventsServer struct {
 	UnimplementedExecutionEventsServer
 	eventsProvider Provider
+	stateSnapshot  func() (acmstate.Reader, error)
 	emitter        *event.Emitter
 	tip            bcm.BlockchainInfo
 	logger         *logging.Logger
 }
 
-func NewExecutionEventsServer(eventsProvider Provider, emitter *event.Emitter,
-	tip bcm.BlockchainInfo, logger *logging.Logger) ExecutionEventsServer {
+func NewExecutionEventsServer(eventsProvider Provider, stateSnapshotter func() (acmstate.Reader, error),
+	emitter *event.Emitter, tip bcm.BlockchainInfo, logger *logging.Logger) ExecutionEventsServer {
 
 	return &executionEventsServer{
 		eventsProvider: eventsProvider,
+		stateSnapshot:  stateSnapshotter,
 		emitter:        emitter,
 		tip:            tip,
 		logger:         logger.WithScope("NewExecutionEventsServer"),
@@ -48,7 +58,7 @@ func (ees *executionEventsServer) Tx(ctx context.Context, request *TxRequest) (*
 		return nil, err
 	}
 	if txe != nil {
-		return txe, nil
+		return ees.decode(request.Decode, txe), nil
 	}
 	if !request.Wait {
 		return nil, fmt.Errorf("transaction with hash %v not found in state", request.TxHash)
@@ -64,7 +74,7 @@ func (ees *executionEventsServer) Tx(ctx context.Context, request *TxRequest) (*
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
-			return msg.(*exec.TxExecution), nil
+			return ees.decode(request.Decode, msg.(*exec.TxExecution)), nil
 		}
 	}
 	return nil, fmt.Errorf("subscription waiting for tx %v ended prematurely", request.TxHash)
@@ -75,11 +85,22 @@ func (ees *executionEventsServer) Stream(request *BlocksRequest, stream Executio
 	if err != nil {
 		return fmt.Errorf("could not parse TxExecution query: %v", err)
 	}
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(ev *exec.StreamEvent) error {
-		if qry.Matches(ev) {
-			return stream.Send(ev)
+	blockRange, err := request.ResumedBlockRange()
+	if err != nil {
+		return err
+	}
+	var decoder *execution.MetadataDecoder
+	return ees.streamEvents(stream.Context(), blockRange, nil, func(ev *exec.StreamEvent) error {
+		if request.Decode && ev.BeginBlock != nil {
+			decoder = ees.newDecoder()
 		}
-		return nil
+		if !qry.Matches(ev) {
+			return nil
+		}
+		if decoder != nil && ev.Event != nil {
+			ev = &exec.StreamEvent{Event: decoder.DecodeEvent(ev.Event)}
+		}
+		return stream.Send(ev)
 	})
 }
 
@@ -89,16 +110,27 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 	if err != nil {
 		return fmt.Errorf("could not parse Event query: %v", err)
 	}
+	blockRange, err := request.ResumedBlockRange()
+	if err != nil {
+		return fmt.Errorf("%s: %v", errHeader, err)
+	}
 	var response *EventsResponse
 	var stack exec.TxStack
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(sev *exec.StreamEvent) error {
+	var decoder *execution.MetadataDecoder
+	// Blocks without LogEvents that could match qry have no events we would send
+	return ees.streamEvents(stream.Context(), blockRange, qry, func(sev *exec.StreamEvent) error {
 		switch {
 		case sev.BeginBlock != nil:
 			response = &EventsResponse{
 				Height: sev.BeginBlock.Height,
 			}
+			if request.Decode {
+				decoder = ees.newDecoder()
+			}
 
 		case sev.EndBlock != nil && len(response.Events) > 0:
+			// Each response contains all the events for its block so we can resume from the next
+			response.ResumeToken = NewResumeToken(response.Height + 1)
 			return stream.Send(response)
 
 		default:
@@ -110,6 +142,9 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 			if txe != nil && txe.Exception == nil {
 				for _, ev := range txe.Events {
 					if qry.Matches(ev) {
+						if decoder != nil {
+							ev = decoder.DecodeEvent(ev)
+						}
 						response.Events = append(response.Events, ev)
 					}
 				}
@@ -120,7 +155,140 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 	})
 }
 
-func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange,
+func (ees *executionEventsServer) AccessSets(request *BlocksRequest, stream ExecutionEvents_AccessSetsServer) error {
+	const errHeader = "AccessSets()"
+	qry, err := query.NewOrEmpty(request.Query)
+	if err != nil {
+		return fmt.Errorf("could not parse TxExecution query: %v", err)
+	}
+	blockRange, err := request.ResumedBlockRange()
+	if err != nil {
+		return fmt.Errorf("%s: %v", errHeader, err)
+	}
+	var response *AccessSetsResponse
+	var stack exec.TxStack
+	return ees.streamEvents(stream.Context(), blockRange, nil, func(sev *exec.StreamEvent) error {
+		switch {
+		case sev.BeginBlock != nil:
+			response = &AccessSetsResponse{
+				Height: sev.BeginBlock.Height,
+			}
+
+		case sev.EndBlock != nil && len(response.TxAccessSets) > 0:
+			response.ResumeToken = NewResumeToken(response.Height + 1)
+			return stream.Send(response)
+
+		default:
+			txe, err := stack.Consume(sev)
+			if err != nil {
+				return fmt.Errorf("%s: %v", errHeader, err)
+			}
+			// Access sets are only present on chains that record them
+			if txe != nil && txe.AccessSet != nil && qry.Matches(txe) {
+				response.TxAccessSets = append(response.TxAccessSets, &TxAccessSet{
+					TxHash:    txe.TxHash,
+					AccessSet: txe.AccessSet,
+				})
+			}
+		}
+
+		return nil
+	})
+}
+
+func (ees *executionEventsServer) TxsByTag(request *TxsByTagRequest, stream ExecutionEvents_TxsByTagServer) error {
+	// The index only covers blocks already committed so we do not stream new blocks
+	start, end, _ := request.BlockRange.Bounds(ees.tip.LastBlockHeight())
+	var decoder *execution.MetadataDecoder
+	if request.Decode {
+		decoder = ees.newDecoder()
+	}
+	return ees.eventsProvider.TxsByTag(request.Tag, request.Value, start, end, func(txe *exec.TxExecution) error {
+		if decoder != nil {
+			txe = decoder.DecodeTxExecution(txe)
+		}
+		return stream.Send(txe)
+	})
+}
+
+func (ees *executionEventsServer) BlockHeaders(request *BlockHeadersRequest, stream ExecutionEvents_BlockHeadersServer) error {
+	lastBlockHeight := ees.tip.LastBlockHeight()
+	start, end, streaming := request.BlockRange.Bounds(lastBlockHeight)
+	if start == 0 {
+		// Tendermint blocks begin at height 1
+		start = 1
+	}
+	ees.logger.TraceMsg("Streaming block headers", "start", start, "end", end, "streaming", streaming)
+
+	sendHeaders := func(endHeight uint64) error {
+		for ; start <= endHeight; start++ {
+			header, err := ees.tip.GetSignedHeader(start)
+			if err != nil {
+				return err
+			}
+			err = stream.Send(header.ToProto())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Send headers that have already been committed
+	if !streaming && end <= lastBlockHeight {
+		return sendHeaders(end)
+	}
+	err := sendHeaders(lastBlockHeight)
+	if err != nil {
+		return err
+	}
+
+	err = ees.subscribeBlockExecution(stream.Context(), func(block *exec.BlockExecution) error {
+		// Catch up on any blocks we have not yet sent (including those dropped by pubsub) up to and including this one
+		endHeight := block.Height
+		if !streaming && endHeight > end {
+			endHeight = end
+		}
+		err := sendHeaders(endHeight)
+		if err != nil {
+			return err
+		}
+		if !streaming && start > end {
+			return io.EOF
+		}
+		return nil
+	})
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// Returns a decoder reading metadata from the latest state, in which that of any contracts that have emitted events
+// will have been registered, or nil if the state cannot be read
+func (ees *executionEventsServer) newDecoder() *execution.MetadataDecoder {
+	st, err := ees.stateSnapshot()
+	if err != nil {
+		ees.logger.InfoMsg("Could not get state to decode events", structure.ErrorKey, err)
+		return nil
+	}
+	return execution.NewMetadataDecoder(st)
+}
+
+func (ees *executionEventsServer) decode(decode bool, txe *exec.TxExecution) *exec.TxExecution {
+	if !decode {
+		return txe
+	}
+	decoder := ees.newDecoder()
+	if decoder == nil {
+		return txe
+	}
+	return decoder.DecodeTxExecution(txe)
+}
+
+// Streams the events of the blocks in blockRange to consumer. If logsQuery is not nil blocks in state whose logs bloom
+// shows they contain no LogEvent it could match may be skipped.
+func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange, logsQuery query.Query,
 	consumer func(execution *exec.StreamEvent) error) error {
 
 	lastBlockHeight := ees.tip.LastBlockHeight()
@@ -129,7 +297,7 @@ func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *
 
 	// Pull blocks from state and receive the upper bound (exclusive) on the what we were able to send
 	// Set this to start since it will be the start of next streaming batch (if needed)
-	start, err := ees.iterateStreamEvents(start, end, consumer)
+	start, err := ees.iterateStreamEvents(start, end, logsQuery, consumer)
 
 	// If we are not streaming and all (non-empty) blocks up to and including end are available from state then we are done
 	if !streaming && end <= lastBlockHeight {
@@ -151,7 +319,7 @@ func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *
 			if catchupEnd > end {
 				catchupEnd = end
 			}
-			start, err = ees.iterateStreamEvents(start, catchupEnd, consumer)
+			start, err = ees.iterateStreamEvents(start, catchupEnd, logsQuery, consumer)
 			if err != nil {
 				return err
 			}
@@ -202,19 +370,32 @@ func (ees *executionEventsServer) subscribeBlockExecution(ctx context.Context,
 	return nil
 }
 
-func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64,
+func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64, logsQuery query.Query,
 	consumer func(*exec.StreamEvent) error) (uint64, error) {
 	// Assume that we have seen the previous block before start to have ended up here
 	// NOTE: this will underflow when start is 0 (as it often will be - and needs to be for restored chains)
 	// however we at most underflow by 1 and we always add 1 back on when returning so we get away with this.
 	lastHeightSeen := startHeight - 1
-	err := ees.eventsProvider.IterateStreamEvents(&startHeight, &endHeight, storage.AscendingSort,
-		func(ev *exec.StreamEvent) error {
-			if ev.EndBlock != nil {
-				lastHeightSeen = ev.EndBlock.GetHeight()
-			}
-			return consumer(ev)
-		})
+	consume := func(ev *exec.StreamEvent) error {
+		if ev.EndBlock != nil {
+			lastHeightSeen = ev.EndBlock.GetHeight()
+		}
+		return consumer(ev)
+	}
+	var err error
+	if logsQuery == nil {
+		err = ees.eventsProvider.IterateStreamEvents(&startHeight, &endHeight, storage.AscendingSort, consume)
+	} else {
+		err = ees.eventsProvider.IterateStreamEventsFiltered(startHeight, endHeight,
+			func(height uint64, bloom *exec.LogsBloom) bool {
+				if bloom.MayMatch(logsQuery) {
+					return true
+				}
+				// A skipped block counts as seen
+				lastHeightSeen = height
+				return false
+			}, consume)
+	}
 	// Returns the appropriate _next_ starting block - the one after the one we have seen - from which to stream next
 	return lastHeightSeen + 1, err
 }
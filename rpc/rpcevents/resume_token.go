@@ -0,0 +1,44 @@
+package rpcevents
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Resume tokens are opaque to clients, the version allows the encoded position to be extended without
+// misinterpreting tokens issued by older servers
+const resumeTokenVersion = 1
+
+const resumeTokenLength = 1 + 8
+
+// Encodes the height of the next block that a resumed stream should start from
+func NewResumeToken(nextHeight uint64) []byte {
+	token := make([]byte, resumeTokenLength)
+	token[0] = resumeTokenVersion
+	binary.BigEndian.PutUint64(token[1:], nextHeight)
+	return token
+}
+
+// Returns the height of the next block encoded by a resume token
+func DecodeResumeToken(token []byte) (nextHeight uint64, err error) {
+	if len(token) != resumeTokenLength {
+		return 0, fmt.Errorf("resume token should be %d bytes but is %d bytes", resumeTokenLength, len(token))
+	}
+	if token[0] != resumeTokenVersion {
+		return 0, fmt.Errorf("unsupported resume token version %d", token[0])
+	}
+	return binary.BigEndian.Uint64(token[1:]), nil
+}
+
+// Returns the BlockRange from which to stream taking into account any ResumeToken, which takes precedence over the
+// start of BlockRange
+func (br *BlocksRequest) ResumedBlockRange() (*BlockRange, error) {
+	if len(br.GetResumeToken()) == 0 {
+		return br.GetBlockRange(), nil
+	}
+	nextHeight, err := DecodeResumeToken(br.ResumeToken)
+	if err != nil {
+		return nil, err
+	}
+	return NewBlockRange(AbsoluteBound(nextHeight), br.GetBlockRange().GetEnd()), nil
+}
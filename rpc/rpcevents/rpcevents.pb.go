@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	_ "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	golang_proto "github.com/golang/protobuf/proto"
@@ -64,7 +65,7 @@ func (x Bound_BoundType) String() string {
 }
 
 func (Bound_BoundType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{6, 0}
+	return fileDescriptor_580b21d8d2fd68e4, []int{10, 0}
 }
 
 type GetBlockRequest struct {
@@ -128,7 +129,9 @@ type TxRequest struct {
 	// Height of block required
 	TxHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
 	// Whether to wait for the block to become available
-	Wait                 bool     `protobuf:"varint,2,opt,name=Wait,proto3" json:"Wait,omitempty"`
+	Wait bool `protobuf:"varint,2,opt,name=Wait,proto3" json:"Wait,omitempty"`
+	// Whether to decode call data, events and revert errors with the ABIs registered for the contracts involved
+	Decode               bool     `protobuf:"varint,3,opt,name=Decode,proto3" json:"Decode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -170,6 +173,13 @@ func (m *TxRequest) GetWait() bool {
 	return false
 }
 
+func (m *TxRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
 func (*TxRequest) XXX_MessageName() string {
 	return "rpcevents.TxRequest"
 }
@@ -211,7 +221,13 @@ type BlocksRequest struct {
 	//
 	// For example:
 	// EventType = 'LogEvent' AND EventID CONTAINS 'bar' AND TxHash = '020304' AND Height >= 34 AND Index < 3 AND Address = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF'
-	Query                string   `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	// A ResumeToken previously issued by the server in an EventsResponse. If provided the stream continues from the
+	// position immediately after the one encoded by the token (so without gaps or duplicates) and the Start of
+	// BlockRange is ignored
+	ResumeToken []byte `protobuf:"bytes,3,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+	// Whether to decode call data and events with the ABIs registered for the contracts involved
+	Decode               bool     `protobuf:"varint,4,opt,name=Decode,proto3" json:"Decode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -260,23 +276,86 @@ func (m *BlocksRequest) GetQuery() string {
 	return ""
 }
 
+func (m *BlocksRequest) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
+func (m *BlocksRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
 func (*BlocksRequest) XXX_MessageName() string {
 	return "rpcevents.BlocksRequest"
 }
 
+type BlockHeadersRequest struct {
+	BlockRange           *BlockRange `protobuf:"bytes,1,opt,name=BlockRange,proto3" json:"BlockRange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BlockHeadersRequest) Reset()         { *m = BlockHeadersRequest{} }
+func (m *BlockHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockHeadersRequest) ProtoMessage()    {}
+func (*BlockHeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{3}
+}
+func (m *BlockHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeadersRequest.Merge(m, src)
+}
+func (m *BlockHeadersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeadersRequest proto.InternalMessageInfo
+
+func (m *BlockHeadersRequest) GetBlockRange() *BlockRange {
+	if m != nil {
+		return m.BlockRange
+	}
+	return nil
+}
+
+func (*BlockHeadersRequest) XXX_MessageName() string {
+	return "rpcevents.BlockHeadersRequest"
+}
+
 type EventsResponse struct {
-	Height               uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Events               []*exec.Event `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Height uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Events []*exec.Event `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
+	// An opaque token that can be passed in BlocksRequest to resume the stream after this response if disconnected
+	ResumeToken          []byte   `protobuf:"bytes,3,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
 func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
 func (*EventsResponse) ProtoMessage()    {}
 func (*EventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{3}
+	return fileDescriptor_580b21d8d2fd68e4, []int{4}
 }
 func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,10 +394,204 @@ func (m *EventsResponse) GetEvents() []*exec.Event {
 	return nil
 }
 
+func (m *EventsResponse) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
 func (*EventsResponse) XXX_MessageName() string {
 	return "rpcevents.EventsResponse"
 }
 
+type AccessSetsResponse struct {
+	Height       uint64         `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	TxAccessSets []*TxAccessSet `protobuf:"bytes,2,rep,name=TxAccessSets,proto3" json:"TxAccessSets,omitempty"`
+	// An opaque token that can be passed in BlocksRequest to resume the stream after this response if disconnected
+	ResumeToken          []byte   `protobuf:"bytes,3,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessSetsResponse) Reset()         { *m = AccessSetsResponse{} }
+func (m *AccessSetsResponse) String() string { return proto.CompactTextString(m) }
+func (*AccessSetsResponse) ProtoMessage()    {}
+func (*AccessSetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{5}
+}
+func (m *AccessSetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessSetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccessSetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessSetsResponse.Merge(m, src)
+}
+func (m *AccessSetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessSetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessSetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessSetsResponse proto.InternalMessageInfo
+
+func (m *AccessSetsResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AccessSetsResponse) GetTxAccessSets() []*TxAccessSet {
+	if m != nil {
+		return m.TxAccessSets
+	}
+	return nil
+}
+
+func (m *AccessSetsResponse) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
+func (*AccessSetsResponse) XXX_MessageName() string {
+	return "rpcevents.AccessSetsResponse"
+}
+
+type TxAccessSet struct {
+	TxHash               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	AccessSet            *exec.AccessSet                               `protobuf:"bytes,2,opt,name=AccessSet,proto3" json:"AccessSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *TxAccessSet) Reset()         { *m = TxAccessSet{} }
+func (m *TxAccessSet) String() string { return proto.CompactTextString(m) }
+func (*TxAccessSet) ProtoMessage()    {}
+func (*TxAccessSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{6}
+}
+func (m *TxAccessSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxAccessSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TxAccessSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxAccessSet.Merge(m, src)
+}
+func (m *TxAccessSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxAccessSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxAccessSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxAccessSet proto.InternalMessageInfo
+
+func (m *TxAccessSet) GetAccessSet() *exec.AccessSet {
+	if m != nil {
+		return m.AccessSet
+	}
+	return nil
+}
+
+func (*TxAccessSet) XXX_MessageName() string {
+	return "rpcevents.TxAccessSet"
+}
+
+type TxsByTagRequest struct {
+	// The blocks to search, which ends at the latest block when streaming is requested
+	BlockRange *BlockRange `protobuf:"bytes,1,opt,name=BlockRange,proto3" json:"BlockRange,omitempty"`
+	// The tag by which transactions are indexed
+	Tag string `protobuf:"bytes,2,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	// The value of the tag as it appears in queries, where addresses and hashes are upper case hex
+	Value string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	// Whether to decode call data, events and revert errors with the ABIs registered for the contracts involved
+	Decode               bool     `protobuf:"varint,4,opt,name=Decode,proto3" json:"Decode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxsByTagRequest) Reset()         { *m = TxsByTagRequest{} }
+func (m *TxsByTagRequest) String() string { return proto.CompactTextString(m) }
+func (*TxsByTagRequest) ProtoMessage()    {}
+func (*TxsByTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_580b21d8d2fd68e4, []int{7}
+}
+func (m *TxsByTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxsByTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TxsByTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxsByTagRequest.Merge(m, src)
+}
+func (m *TxsByTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxsByTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxsByTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxsByTagRequest proto.InternalMessageInfo
+
+func (m *TxsByTagRequest) GetBlockRange() *BlockRange {
+	if m != nil {
+		return m.BlockRange
+	}
+	return nil
+}
+
+func (m *TxsByTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TxsByTagRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TxsByTagRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
+func (*TxsByTagRequest) XXX_MessageName() string {
+	return "rpcevents.TxsByTagRequest"
+}
+
 type GetTxsRequest struct {
 	StartHeight          uint64   `protobuf:"varint,1,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
 	EndHeight            uint64   `protobuf:"varint,2,opt,name=EndHeight,proto3" json:"EndHeight,omitempty"`
@@ -332,7 +605,7 @@ func (m *GetTxsRequest) Reset()         { *m = GetTxsRequest{} }
 func (m *GetTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxsRequest) ProtoMessage()    {}
 func (*GetTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{4}
+	return fileDescriptor_580b21d8d2fd68e4, []int{8}
 }
 func (m *GetTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +667,7 @@ func (m *GetTxsResponse) Reset()         { *m = GetTxsResponse{} }
 func (m *GetTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxsResponse) ProtoMessage()    {}
 func (*GetTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{5}
+	return fileDescriptor_580b21d8d2fd68e4, []int{9}
 }
 func (m *GetTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +722,7 @@ func (m *Bound) Reset()         { *m = Bound{} }
 func (m *Bound) String() string { return proto.CompactTextString(m) }
 func (*Bound) ProtoMessage()    {}
 func (*Bound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{6}
+	return fileDescriptor_580b21d8d2fd68e4, []int{10}
 }
 func (m *Bound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +783,7 @@ func (m *BlockRange) Reset()         { *m = BlockRange{} }
 func (m *BlockRange) String() string { return proto.CompactTextString(m) }
 func (*BlockRange) ProtoMessage()    {}
 func (*BlockRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_580b21d8d2fd68e4, []int{7}
+	return fileDescriptor_580b21d8d2fd68e4, []int{11}
 }
 func (m *BlockRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,8 +834,16 @@ func init() {
 	golang_proto.RegisterType((*TxRequest)(nil), "rpcevents.TxRequest")
 	proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
 	golang_proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
+	proto.RegisterType((*BlockHeadersRequest)(nil), "rpcevents.BlockHeadersRequest")
+	golang_proto.RegisterType((*BlockHeadersRequest)(nil), "rpcevents.BlockHeadersRequest")
 	proto.RegisterType((*EventsResponse)(nil), "rpcevents.EventsResponse")
 	golang_proto.RegisterType((*EventsResponse)(nil), "rpcevents.EventsResponse")
+	proto.RegisterType((*AccessSetsResponse)(nil), "rpcevents.AccessSetsResponse")
+	golang_proto.RegisterType((*AccessSetsResponse)(nil), "rpcevents.AccessSetsResponse")
+	proto.RegisterType((*TxAccessSet)(nil), "rpcevents.TxAccessSet")
+	golang_proto.RegisterType((*TxAccessSet)(nil), "rpcevents.TxAccessSet")
+	proto.RegisterType((*TxsByTagRequest)(nil), "rpcevents.TxsByTagRequest")
+	golang_proto.RegisterType((*TxsByTagRequest)(nil), "rpcevents.TxsByTagRequest")
 	proto.RegisterType((*GetTxsRequest)(nil), "rpcevents.GetTxsRequest")
 	golang_proto.RegisterType((*GetTxsRequest)(nil), "rpcevents.GetTxsRequest")
 	proto.RegisterType((*GetTxsResponse)(nil), "rpcevents.GetTxsResponse")
@@ -577,44 +858,58 @@ func init() { proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd6
 func init() { golang_proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd68e4) }
 
 var fileDescriptor_580b21d8d2fd68e4 = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5d, 0x6b, 0xd4, 0x40,
-	0x14, 0xed, 0xec, 0x17, 0xcd, 0xdd, 0x7e, 0xc4, 0xa1, 0xca, 0xba, 0x48, 0xba, 0x44, 0x90, 0x82,
-	0x34, 0x5b, 0x56, 0x8a, 0x4f, 0x22, 0x1b, 0x88, 0x6d, 0xa5, 0x45, 0x9c, 0x8c, 0x1f, 0x88, 0x20,
-	0xd9, 0xec, 0x90, 0x5d, 0x6c, 0x93, 0x98, 0x4c, 0x34, 0xfb, 0x53, 0xfc, 0x2b, 0x3e, 0xf9, 0xd8,
-	0x47, 0x1f, 0xc5, 0x87, 0x22, 0xdb, 0x3f, 0x22, 0x99, 0x49, 0xb2, 0xd9, 0x5a, 0xeb, 0xcb, 0x32,
-	0xf7, 0x9e, 0x73, 0xef, 0x3d, 0x7b, 0xe6, 0x4e, 0x60, 0x33, 0x0a, 0x5d, 0xf6, 0x99, 0xf9, 0x3c,
-	0x36, 0xc2, 0x28, 0xe0, 0x01, 0x56, 0xca, 0x44, 0x77, 0xcb, 0x0b, 0xbc, 0x40, 0x64, 0xfb, 0xd9,
-	0x49, 0x12, 0xba, 0xc0, 0x52, 0xe6, 0xca, 0xb3, 0xfe, 0x04, 0x36, 0x0f, 0x18, 0x37, 0x4f, 0x03,
-	0xf7, 0x23, 0x61, 0x9f, 0x12, 0x16, 0x73, 0x7c, 0x07, 0x5a, 0x87, 0x6c, 0xea, 0x4d, 0x78, 0x07,
-	0xf5, 0xd0, 0x4e, 0x83, 0xe4, 0x11, 0xc6, 0xd0, 0x78, 0xe3, 0x4c, 0x79, 0xa7, 0xd6, 0x43, 0x3b,
-	0xab, 0x44, 0x9c, 0x75, 0x1f, 0x14, 0x9a, 0x16, 0x85, 0x27, 0xd0, 0xa2, 0xe9, 0xa1, 0x13, 0x4f,
-	0x44, 0xe1, 0x9a, 0xb9, 0x7f, 0x7e, 0xb1, 0xbd, 0xf2, 0xeb, 0x62, 0x7b, 0xd7, 0x9b, 0xf2, 0x49,
-	0x32, 0x32, 0xdc, 0xe0, 0xac, 0x3f, 0x99, 0x85, 0x2c, 0x3a, 0x65, 0x63, 0x8f, 0x45, 0xfd, 0x51,
-	0x12, 0x45, 0xc1, 0x97, 0xfe, 0x68, 0xea, 0x3b, 0xd1, 0xcc, 0x38, 0x64, 0xa9, 0x39, 0xe3, 0x2c,
-	0x26, 0x79, 0x93, 0x6b, 0xe7, 0xbd, 0x87, 0x75, 0xa1, 0x35, 0x2e, 0x66, 0xee, 0x03, 0x48, 0xf1,
-	0x8e, 0xef, 0x31, 0x31, 0xb7, 0x3d, 0xb8, 0x6d, 0x2c, 0x2c, 0x59, 0x80, 0xa4, 0x42, 0xc4, 0x5b,
-	0xd0, 0x7c, 0x99, 0xb0, 0x68, 0x26, 0x9a, 0x2b, 0x44, 0x06, 0xfa, 0x09, 0x6c, 0x58, 0xa2, 0x8c,
-	0xb0, 0x38, 0x0c, 0xfc, 0x98, 0xfd, 0xd3, 0x8b, 0xfb, 0xd0, 0x92, 0xcc, 0x4e, 0xad, 0x57, 0xdf,
-	0x69, 0x0f, 0xda, 0x86, 0xf0, 0x54, 0xe4, 0x48, 0x0e, 0xe9, 0x0c, 0xd6, 0x0f, 0x18, 0xa7, 0x69,
-	0x29, 0xb6, 0x07, 0x6d, 0x9b, 0x3b, 0x11, 0x5f, 0x6a, 0x59, 0x4d, 0xe1, 0x7b, 0xa0, 0x58, 0xfe,
-	0x38, 0xc7, 0x6b, 0x02, 0x5f, 0x24, 0x16, 0xaa, 0xeb, 0x55, 0xd5, 0x1f, 0x60, 0xa3, 0x18, 0xf3,
-	0x1f, 0xd5, 0xfb, 0xb0, 0x46, 0x53, 0x2b, 0x65, 0x6e, 0xc2, 0xa7, 0x81, 0x5f, 0x68, 0xbf, 0x25,
-	0xb5, 0x57, 0x10, 0xb2, 0x44, 0xd3, 0xbf, 0x22, 0x68, 0x9a, 0x41, 0xe2, 0x8f, 0xb1, 0x01, 0x0d,
-	0x3a, 0x0b, 0xa5, 0xcf, 0x1b, 0x83, 0x6e, 0xd5, 0xe7, 0x0c, 0x97, 0xbf, 0x19, 0x83, 0x08, 0x5e,
-	0x26, 0xf8, 0xc8, 0x1f, 0xb3, 0x34, 0xff, 0x2b, 0x32, 0xd0, 0x9f, 0x83, 0x52, 0x12, 0xf1, 0x1a,
-	0xac, 0x0e, 0x4d, 0xfb, 0xc5, 0xf1, 0x2b, 0x6a, 0xa9, 0x2b, 0x59, 0x44, 0xac, 0xe3, 0x21, 0x3d,
-	0x7a, 0x6d, 0xa9, 0x08, 0x2b, 0xd0, 0x7c, 0x76, 0x44, 0x6c, 0xaa, 0xd6, 0x30, 0x40, 0xeb, 0x78,
-	0x48, 0x2d, 0x9b, 0xaa, 0xf5, 0xec, 0x6c, 0x53, 0x62, 0x0d, 0x4f, 0xd4, 0x86, 0xfe, 0xb6, 0x7a,
-	0xff, 0xf8, 0x01, 0x34, 0x85, 0x9b, 0xf9, 0x22, 0xa8, 0x57, 0x05, 0x12, 0x09, 0x63, 0x1d, 0xea,
-	0x96, 0x3f, 0x16, 0xaa, 0xae, 0x63, 0x65, 0xe0, 0xe0, 0x1b, 0x82, 0xcd, 0xd2, 0x04, 0x79, 0xa3,
-	0xf8, 0x31, 0xb4, 0x6c, 0x1e, 0x31, 0xe7, 0x0c, 0x77, 0xae, 0xee, 0x58, 0x71, 0xc9, 0xdd, 0xdc,
-	0x4e, 0xc9, 0x13, 0x75, 0x7b, 0x08, 0xef, 0x42, 0x8d, 0xa6, 0x78, 0xab, 0x52, 0x54, 0x3e, 0x9b,
-	0xee, 0xdf, 0xfe, 0xe3, 0xa7, 0xc5, 0x7a, 0xdd, 0x30, 0xe7, 0x6e, 0x05, 0x59, 0xde, 0xda, 0x3d,
-	0x64, 0x5a, 0xe7, 0x73, 0x0d, 0xfd, 0x98, 0x6b, 0xe8, 0xe7, 0x5c, 0x43, 0xbf, 0xe7, 0x1a, 0xfa,
-	0x7e, 0xa9, 0xa1, 0xf3, 0x4b, 0x0d, 0xbd, 0x7b, 0x78, 0xf3, 0x63, 0x8c, 0x42, 0xb7, 0x5f, 0xf6,
-	0x1d, 0xb5, 0xc4, 0x47, 0xe2, 0xd1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x17, 0xe1, 0x08, 0x02,
-	0x64, 0x04, 0x00, 0x00,
+	// 804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x51, 0x6f, 0xe3, 0x44,
+	0x10, 0xbe, 0x4d, 0xd2, 0xa8, 0x9e, 0xe4, 0x9a, 0xb0, 0x94, 0x53, 0x88, 0x0e, 0x5f, 0x64, 0x24,
+	0x54, 0x09, 0xd5, 0x89, 0x82, 0x2a, 0x24, 0x10, 0x42, 0x89, 0x30, 0x6d, 0x51, 0x4f, 0xc0, 0x7a,
+	0x39, 0x10, 0x2f, 0xc8, 0xb5, 0x47, 0x6e, 0x74, 0xad, 0x1d, 0xec, 0x35, 0x38, 0x7f, 0x00, 0x21,
+	0x78, 0xe3, 0x01, 0x89, 0x7f, 0xc3, 0x63, 0x1f, 0x79, 0x44, 0x3c, 0x9c, 0x50, 0xef, 0x8f, 0x20,
+	0xef, 0x3a, 0xf1, 0xba, 0x5c, 0xef, 0x4e, 0xea, 0xbd, 0x58, 0xbb, 0xf3, 0x7d, 0xbb, 0x33, 0x3b,
+	0xf3, 0xcd, 0x18, 0x7a, 0xc9, 0xd2, 0xc7, 0x1f, 0x30, 0x12, 0xa9, 0xbd, 0x4c, 0x62, 0x11, 0x53,
+	0x63, 0x63, 0x18, 0xee, 0x86, 0x71, 0x18, 0x4b, 0xeb, 0xb8, 0x58, 0x29, 0xc2, 0x10, 0x30, 0x47,
+	0xbf, 0x5c, 0xdf, 0x17, 0x18, 0x05, 0x98, 0x5c, 0x2c, 0x22, 0x31, 0x16, 0xab, 0x25, 0xa6, 0xea,
+	0xab, 0x50, 0xeb, 0x23, 0xe8, 0x1d, 0xa2, 0x98, 0x9f, 0xc7, 0xfe, 0x63, 0x86, 0xdf, 0x67, 0x98,
+	0x0a, 0x7a, 0x0f, 0xda, 0x47, 0xb8, 0x08, 0xcf, 0xc4, 0x80, 0x8c, 0xc8, 0x5e, 0x8b, 0x95, 0x3b,
+	0x4a, 0xa1, 0xf5, 0xb5, 0xb7, 0x10, 0x83, 0xc6, 0x88, 0xec, 0x6d, 0x33, 0xb9, 0xb6, 0x7e, 0x22,
+	0x60, 0xf0, 0x7c, 0x7d, 0xf2, 0x21, 0xb4, 0x79, 0x7e, 0xe4, 0xa5, 0x67, 0xf2, 0x64, 0x77, 0x7e,
+	0x70, 0xf9, 0xe4, 0xc1, 0x9d, 0x7f, 0x9e, 0x3c, 0xd8, 0x0f, 0x17, 0xe2, 0x2c, 0x3b, 0xb5, 0xfd,
+	0xf8, 0x62, 0x7c, 0xb6, 0x5a, 0x62, 0x72, 0x8e, 0x41, 0x88, 0xc9, 0xf8, 0x34, 0x4b, 0x92, 0xf8,
+	0xc7, 0xf1, 0xe9, 0x22, 0xf2, 0x92, 0x95, 0x7d, 0x84, 0xf9, 0x7c, 0x25, 0x30, 0x65, 0xe5, 0x25,
+	0xcf, 0x72, 0x58, 0x04, 0xf7, 0x09, 0xfa, 0x71, 0x80, 0x83, 0xa6, 0xb4, 0x96, 0x3b, 0xeb, 0x77,
+	0x02, 0x77, 0xe5, 0x2b, 0xd2, 0x75, 0x30, 0x07, 0x00, 0xea, 0x59, 0x5e, 0x14, 0xa2, 0x0c, 0xa8,
+	0x33, 0x7d, 0xc3, 0xae, 0x52, 0x59, 0x81, 0x4c, 0x23, 0xd2, 0x5d, 0xd8, 0xfa, 0x32, 0xc3, 0x64,
+	0x25, 0xbd, 0x1a, 0x4c, 0x6d, 0xe8, 0x08, 0x3a, 0x0c, 0xd3, 0xec, 0x02, 0x79, 0xfc, 0x18, 0x23,
+	0xe9, 0xbb, 0xcb, 0x74, 0x93, 0x16, 0x58, 0xab, 0x16, 0xd8, 0x09, 0xbc, 0x2e, 0x6f, 0x3f, 0x42,
+	0x2f, 0xc0, 0xe4, 0x96, 0xd1, 0x59, 0x31, 0xec, 0x38, 0x92, 0xc0, 0x30, 0x5d, 0xc6, 0x51, 0x8a,
+	0x37, 0x56, 0xeb, 0x6d, 0x68, 0x2b, 0xe6, 0xa0, 0x31, 0x6a, 0xee, 0x75, 0xa6, 0x1d, 0x5b, 0x6a,
+	0x42, 0xda, 0x58, 0x09, 0xbd, 0xf8, 0x59, 0xd6, 0x2f, 0x04, 0xe8, 0xcc, 0xf7, 0x31, 0x4d, 0x5d,
+	0x7c, 0x09, 0xaf, 0x1f, 0x40, 0x97, 0xe7, 0x15, 0xbf, 0xf4, 0x7d, 0x4f, 0x7b, 0x98, 0x06, 0xb3,
+	0x1a, 0xf7, 0x25, 0x82, 0xf9, 0x95, 0x40, 0x47, 0x3b, 0xf2, 0xaa, 0xf5, 0xb6, 0x0f, 0xc6, 0xe6,
+	0x6e, 0x59, 0xfe, 0xce, 0xb4, 0xa7, 0xb2, 0x56, 0x85, 0x5c, 0x31, 0xac, 0x9f, 0x09, 0xf4, 0x78,
+	0x9e, 0xce, 0x57, 0xdc, 0x0b, 0x6f, 0x29, 0xba, 0x3e, 0x34, 0xb9, 0x17, 0x96, 0x92, 0x2b, 0x96,
+	0x85, 0x0c, 0x1f, 0x79, 0xe7, 0x99, 0x92, 0xb9, 0xc1, 0xd4, 0xe6, 0x46, 0x91, 0x21, 0xdc, 0x3d,
+	0x44, 0xc1, 0xf3, 0x8d, 0xbc, 0x46, 0xd0, 0x71, 0x85, 0x97, 0x88, 0x5a, 0x91, 0x74, 0x13, 0xbd,
+	0x0f, 0x86, 0x13, 0x05, 0x25, 0xde, 0x90, 0x78, 0x65, 0xa8, 0xba, 0xa0, 0xa9, 0x75, 0x81, 0xf5,
+	0x1d, 0xec, 0xac, 0xdd, 0xbc, 0x40, 0x07, 0x07, 0x85, 0x0e, 0x9c, 0x1c, 0xfd, 0x4c, 0x2c, 0xe2,
+	0x68, 0xad, 0x83, 0xd7, 0x54, 0x36, 0x35, 0x84, 0xd5, 0x68, 0xd6, 0x1f, 0x04, 0xb6, 0xe6, 0x71,
+	0x16, 0x05, 0xd4, 0x86, 0x16, 0x5f, 0x2d, 0x55, 0x0a, 0x77, 0xa6, 0x43, 0x3d, 0x85, 0x05, 0xae,
+	0xbe, 0x05, 0x83, 0x49, 0x5e, 0x11, 0xf0, 0x71, 0x14, 0x60, 0x5e, 0x3e, 0x45, 0x6d, 0xac, 0xcf,
+	0xc0, 0xd8, 0x10, 0x69, 0x17, 0xb6, 0x67, 0x73, 0xf7, 0xf3, 0x93, 0xaf, 0xb8, 0xd3, 0xbf, 0x53,
+	0xec, 0x98, 0x73, 0x32, 0xe3, 0xc7, 0x8f, 0x9c, 0x3e, 0xa1, 0x06, 0x6c, 0x7d, 0x7a, 0xcc, 0x5c,
+	0xde, 0x6f, 0x50, 0x80, 0xf6, 0xc9, 0x8c, 0x3b, 0x2e, 0xef, 0x37, 0x8b, 0xb5, 0xcb, 0x99, 0x33,
+	0x7b, 0xd8, 0x6f, 0x59, 0xdf, 0xe8, 0xa5, 0xa5, 0xef, 0xc0, 0x96, 0xcc, 0x66, 0x59, 0xe3, 0xfe,
+	0xf5, 0x00, 0x99, 0x82, 0xa9, 0x05, 0x4d, 0x27, 0x0a, 0x06, 0x8d, 0x1b, 0x58, 0x05, 0x38, 0xfd,
+	0xad, 0x09, 0xbd, 0x4d, 0x12, 0xca, 0xce, 0x7c, 0x1f, 0xda, 0xae, 0x48, 0xd0, 0xbb, 0xa0, 0x83,
+	0xeb, 0xf2, 0x59, 0x17, 0x79, 0x58, 0xa6, 0x53, 0xf1, 0xe4, 0xb9, 0x09, 0xa1, 0xfb, 0xd0, 0xe0,
+	0x39, 0xdd, 0xad, 0x75, 0xdc, 0xb5, 0x03, 0x5a, 0xca, 0xe9, 0xc7, 0xeb, 0x31, 0xf1, 0x1c, 0x3f,
+	0x6f, 0x6a, 0x48, 0x7d, 0xfa, 0x4c, 0x08, 0xfd, 0x02, 0xba, 0xfa, 0x7c, 0xa3, 0xe6, 0xf5, 0x6b,
+	0xea, 0x83, 0x6f, 0x68, 0xda, 0xd5, 0xff, 0xc8, 0x56, 0x7f, 0x22, 0x77, 0x11, 0x46, 0x18, 0x28,
+	0xde, 0x84, 0xd0, 0x43, 0x00, 0x6d, 0x2a, 0xdc, 0x1c, 0xd6, 0x5b, 0x1a, 0xf2, 0xff, 0x11, 0x35,
+	0x21, 0xf4, 0x43, 0xd8, 0x5e, 0xf7, 0x27, 0x1d, 0xd6, 0x12, 0x52, 0x6b, 0xda, 0x67, 0xa4, 0x65,
+	0x42, 0xe6, 0xce, 0xe5, 0x95, 0x49, 0xfe, 0xba, 0x32, 0xc9, 0xdf, 0x57, 0x26, 0xf9, 0xf7, 0xca,
+	0x24, 0x7f, 0x3e, 0x35, 0xc9, 0xe5, 0x53, 0x93, 0x7c, 0xfb, 0xee, 0xf3, 0xa7, 0x4b, 0xb2, 0xf4,
+	0xc7, 0x1b, 0x5f, 0xa7, 0x6d, 0xf9, 0x9b, 0x7d, 0xef, 0xbf, 0x01, 0x00, 0xcd, 0xe9, 0x59, 0x73,
+	0xc4, 0x07, 0x00, 0x00,
 }
 
 func (m *GetBlockRequest) Marshal() (dAtA []byte, err error) {
@@ -683,6 +978,16 @@ func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Wait {
 		i--
 		if m.Wait {
@@ -730,6 +1035,23 @@ func (m *BlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ResumeToken) > 0 {
+		i -= len(m.ResumeToken)
+		copy(dAtA[i:], m.ResumeToken)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.ResumeToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Query) > 0 {
 		i -= len(m.Query)
 		copy(dAtA[i:], m.Query)
@@ -752,7 +1074,7 @@ func (m *BlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeadersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -762,12 +1084,12 @@ func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeadersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeadersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -776,29 +1098,22 @@ func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	if m.BlockRange != nil {
+		{
+			size, err := m.BlockRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
 		}
-	}
-	if m.Height != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxsRequest) Marshal() (dAtA []byte, err error) {
+func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -808,12 +1123,12 @@ func (m *GetTxsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -822,27 +1137,36 @@ func (m *GetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
+	if len(m.ResumeToken) > 0 {
+		i -= len(m.ResumeToken)
+		copy(dAtA[i:], m.ResumeToken)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.ResumeToken)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.EndHeight != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.StartHeight))
+	if m.Height != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
+func (m *AccessSetsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -852,12 +1176,12 @@ func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccessSetsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccessSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -866,10 +1190,17 @@ func (m *GetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TxExecutions) > 0 {
-		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ResumeToken) > 0 {
+		i -= len(m.ResumeToken)
+		copy(dAtA[i:], m.ResumeToken)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.ResumeToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxAccessSets) > 0 {
+		for iNdEx := len(m.TxAccessSets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TxExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TxAccessSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -888,7 +1219,7 @@ func (m *GetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Bound) Marshal() (dAtA []byte, err error) {
+func (m *TxAccessSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -898,12 +1229,12 @@ func (m *Bound) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxAccessSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxAccessSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -912,7 +1243,209 @@ func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Index != 0 {
+	if m.AccessSet != nil {
+		{
+			size, err := m.AccessSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxsByTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxsByTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxsByTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockRange != nil {
+		{
+			size, err := m.BlockRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcevents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TxExecutions) > 0 {
+		for iNdEx := len(m.TxExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcevents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Index != 0 {
 		i = encodeVarintRpcevents(dAtA, i, uint64(m.Index))
 		i--
 		dAtA[i] = 0x10
@@ -1016,6 +1549,9 @@ func (m *TxRequest) Size() (n int) {
 	if m.Wait {
 		n += 2
 	}
+	if m.Decode {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1036,26 +1572,28 @@ func (m *BlocksRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcevents(uint64(l))
 	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.Decode {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *EventsResponse) Size() (n int) {
+func (m *BlockHeadersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRpcevents(uint64(m.Height))
-	}
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovRpcevents(uint64(l))
-		}
+	if m.BlockRange != nil {
+		l = m.BlockRange.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1063,19 +1601,22 @@ func (m *EventsResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetTxsRequest) Size() (n int) {
+func (m *EventsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovRpcevents(uint64(m.StartHeight))
+	if m.Height != 0 {
+		n += 1 + sovRpcevents(uint64(m.Height))
 	}
-	if m.EndHeight != 0 {
-		n += 1 + sovRpcevents(uint64(m.EndHeight))
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovRpcevents(uint64(l))
+		}
 	}
-	l = len(m.Query)
+	l = len(m.ResumeToken)
 	if l > 0 {
 		n += 1 + l + sovRpcevents(uint64(l))
 	}
@@ -1085,7 +1626,7 @@ func (m *GetTxsRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetTxsResponse) Size() (n int) {
+func (m *AccessSetsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1094,29 +1635,33 @@ func (m *GetTxsResponse) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovRpcevents(uint64(m.Height))
 	}
-	if len(m.TxExecutions) > 0 {
-		for _, e := range m.TxExecutions {
+	if len(m.TxAccessSets) > 0 {
+		for _, e := range m.TxAccessSets {
 			l = e.Size()
 			n += 1 + l + sovRpcevents(uint64(l))
 		}
 	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Bound) Size() (n int) {
+func (m *TxAccessSet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRpcevents(uint64(m.Type))
-	}
-	if m.Index != 0 {
-		n += 1 + sovRpcevents(uint64(m.Index))
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.AccessSet != nil {
+		l = m.AccessSet.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1124,33 +1669,595 @@ func (m *Bound) Size() (n int) {
 	return n
 }
 
-func (m *BlockRange) Size() (n int) {
+func (m *TxsByTagRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Start != nil {
-		l = m.Start.Size()
+	if m.BlockRange != nil {
+		l = m.BlockRange.Size()
 		n += 1 + l + sovRpcevents(uint64(l))
 	}
-	if m.End != nil {
-		l = m.End.Size()
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
 		n += 1 + l + sovRpcevents(uint64(l))
 	}
+	if m.Decode {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *GetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovRpcevents(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRpcevents(uint64(m.EndHeight))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcevents(uint64(m.Height))
+	}
+	if len(m.TxExecutions) > 0 {
+		for _, e := range m.TxExecutions {
+			l = e.Size()
+			n += 1 + l + sovRpcevents(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRpcevents(uint64(m.Type))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRpcevents(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcevents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcevents(x uint64) (n int) {
+	return sovRpcevents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRange == nil {
+				m.BlockRange = &BlockRange{}
+			}
+			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeadersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeadersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRange == nil {
+				m.BlockRange = &BlockRange{}
+			}
+			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovRpcevents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRpcevents(x uint64) (n int) {
-	return sovRpcevents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1173,10 +2280,10 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1199,10 +2306,10 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1212,12 +2319,60 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &exec.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -1240,7 +2395,7 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxRequest) Unmarshal(dAtA []byte) error {
+func (m *AccessSetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1263,17 +2418,36 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessSetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAccessSets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1283,30 +2457,31 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcevents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TxAccessSets = append(m.TxAccessSets, &TxAccessSet{})
+			if err := m.TxAccessSets[len(m.TxAccessSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1316,12 +2491,26 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -1344,7 +2533,7 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *TxAccessSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1367,17 +2556,17 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxAccessSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxAccessSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1387,33 +2576,30 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcevents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRange == nil {
-				m.BlockRange = &BlockRange{}
-			}
-			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1423,23 +2609,27 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcevents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			if m.AccessSet == nil {
+				m.AccessSet = &exec.AccessSet{}
+			}
+			if err := m.AccessSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1463,7 +2653,7 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventsResponse) Unmarshal(dAtA []byte) error {
+func (m *TxsByTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,17 +2676,17 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxsByTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxsByTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1506,16 +2696,33 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRange == nil {
+				m.BlockRange = &BlockRange{}
+			}
+			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1525,26 +2732,76 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcevents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &exec.Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package rpcevents
 import (
 	context "context"
 
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	exec "github.com/hyperledger/burrow/execution/exec"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -27,6 +28,15 @@ type ExecutionEventsClient interface {
 	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
 	// are guaranteed to be delivered in each GetEventsResponse
 	Events(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_EventsClient, error)
+	// BlockHeaders streams the signed header of each block in range as it is committed without the execution events
+	// the block contains - intended for monitoring and light clients
+	BlockHeaders(ctx context.Context, in *BlockHeadersRequest, opts ...grpc.CallOption) (ExecutionEvents_BlockHeadersClient, error)
+	// AccessSets provides the accounts and storage read and changed by each transaction matching the query one block
+	// at a time - only available on chains that record access sets
+	AccessSets(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_AccessSetsClient, error)
+	// TxsByTag streams the transactions in a range of blocks indexed with a value for a tag, such as the Sender or
+	// Callee of a transaction - only available for the tags the node is configured to index
+	TxsByTag(ctx context.Context, in *TxsByTagRequest, opts ...grpc.CallOption) (ExecutionEvents_TxsByTagClient, error)
 }
 
 type executionEventsClient struct {
@@ -110,6 +120,102 @@ func (x *executionEventsEventsClient) Recv() (*EventsResponse, error) {
 	return m, nil
 }
 
+func (c *executionEventsClient) BlockHeaders(ctx context.Context, in *BlockHeadersRequest, opts ...grpc.CallOption) (ExecutionEvents_BlockHeadersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutionEvents_ServiceDesc.Streams[2], "/rpcevents.ExecutionEvents/BlockHeaders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executionEventsBlockHeadersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutionEvents_BlockHeadersClient interface {
+	Recv() (*types.SignedHeader, error)
+	grpc.ClientStream
+}
+
+type executionEventsBlockHeadersClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionEventsBlockHeadersClient) Recv() (*types.SignedHeader, error) {
+	m := new(types.SignedHeader)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executionEventsClient) AccessSets(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_AccessSetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutionEvents_ServiceDesc.Streams[3], "/rpcevents.ExecutionEvents/AccessSets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executionEventsAccessSetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutionEvents_AccessSetsClient interface {
+	Recv() (*AccessSetsResponse, error)
+	grpc.ClientStream
+}
+
+type executionEventsAccessSetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionEventsAccessSetsClient) Recv() (*AccessSetsResponse, error) {
+	m := new(AccessSetsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executionEventsClient) TxsByTag(ctx context.Context, in *TxsByTagRequest, opts ...grpc.CallOption) (ExecutionEvents_TxsByTagClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExecutionEvents_ServiceDesc.Streams[4], "/rpcevents.ExecutionEvents/TxsByTag", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executionEventsTxsByTagClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutionEvents_TxsByTagClient interface {
+	Recv() (*exec.TxExecution, error)
+	grpc.ClientStream
+}
+
+type executionEventsTxsByTagClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionEventsTxsByTagClient) Recv() (*exec.TxExecution, error) {
+	m := new(exec.TxExecution)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ExecutionEventsServer is the server API for ExecutionEvents service.
 // All implementations must embed UnimplementedExecutionEventsServer
 // for forward compatibility
@@ -121,6 +227,15 @@ type ExecutionEventsServer interface {
 	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
 	// are guaranteed to be delivered in each GetEventsResponse
 	Events(*BlocksRequest, ExecutionEvents_EventsServer) error
+	// BlockHeaders streams the signed header of each block in range as it is committed without the execution events
+	// the block contains - intended for monitoring and light clients
+	BlockHeaders(*BlockHeadersRequest, ExecutionEvents_BlockHeadersServer) error
+	// AccessSets provides the accounts and storage read and changed by each transaction matching the query one block
+	// at a time - only available on chains that record access sets
+	AccessSets(*BlocksRequest, ExecutionEvents_AccessSetsServer) error
+	// TxsByTag streams the transactions in a range of blocks indexed with a value for a tag, such as the Sender or
+	// Callee of a transaction - only available for the tags the node is configured to index
+	TxsByTag(*TxsByTagRequest, ExecutionEvents_TxsByTagServer) error
 	mustEmbedUnimplementedExecutionEventsServer()
 }
 
@@ -137,6 +252,15 @@ func (UnimplementedExecutionEventsServer) Tx(context.Context, *TxRequest) (*exec
 func (UnimplementedExecutionEventsServer) Events(*BlocksRequest, ExecutionEvents_EventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method Events not implemented")
 }
+func (UnimplementedExecutionEventsServer) BlockHeaders(*BlockHeadersRequest, ExecutionEvents_BlockHeadersServer) error {
+	return status.Errorf(codes.Unimplemented, "method BlockHeaders not implemented")
+}
+func (UnimplementedExecutionEventsServer) AccessSets(*BlocksRequest, ExecutionEvents_AccessSetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AccessSets not implemented")
+}
+func (UnimplementedExecutionEventsServer) TxsByTag(*TxsByTagRequest, ExecutionEvents_TxsByTagServer) error {
+	return status.Errorf(codes.Unimplemented, "method TxsByTag not implemented")
+}
 func (UnimplementedExecutionEventsServer) mustEmbedUnimplementedExecutionEventsServer() {}
 
 // UnsafeExecutionEventsServer may be embedded to opt out of forward compatibility for this service.
@@ -210,6 +334,69 @@ func (x *executionEventsEventsServer) Send(m *EventsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ExecutionEvents_BlockHeaders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockHeadersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).BlockHeaders(m, &executionEventsBlockHeadersServer{stream})
+}
+
+type ExecutionEvents_BlockHeadersServer interface {
+	Send(*types.SignedHeader) error
+	grpc.ServerStream
+}
+
+type executionEventsBlockHeadersServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsBlockHeadersServer) Send(m *types.SignedHeader) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_AccessSets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).AccessSets(m, &executionEventsAccessSetsServer{stream})
+}
+
+type ExecutionEvents_AccessSetsServer interface {
+	Send(*AccessSetsResponse) error
+	grpc.ServerStream
+}
+
+type executionEventsAccessSetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsAccessSetsServer) Send(m *AccessSetsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_TxsByTag_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TxsByTagRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).TxsByTag(m, &executionEventsTxsByTagServer{stream})
+}
+
+type ExecutionEvents_TxsByTagServer interface {
+	Send(*exec.TxExecution) error
+	grpc.ServerStream
+}
+
+type executionEventsTxsByTagServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsTxsByTagServer) Send(m *exec.TxExecution) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ExecutionEvents_ServiceDesc is the grpc.ServiceDesc for ExecutionEvents service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +420,21 @@ var ExecutionEvents_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ExecutionEvents_Events_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BlockHeaders",
+			Handler:       _ExecutionEvents_BlockHeaders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AccessSets",
+			Handler:       _ExecutionEvents_AccessSets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TxsByTag",
+			Handler:       _ExecutionEvents_TxsByTag_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpcevents.proto",
 }
@@ -0,0 +1,213 @@
+package rpcfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/lib/server"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// How long to wait for a dispensing transaction to be committed
+const DispenseTimeout = 30 * time.Second
+
+type Transactor interface {
+	BroadcastTxSync(ctx context.Context, txEnv *txs.Envelope) (*exec.TxExecution, error)
+}
+
+// The faucet itself
+type ResultFaucet struct {
+	Address  crypto.Address
+	Amount   uint64
+	Interval string
+}
+
+// Tokens sent to an address
+type ResultDispense struct {
+	Address crypto.Address
+	Amount  uint64
+	TxHash  binary.HexBytes
+}
+
+// Returned when an address or client asks again before the interval is up
+type ErrTooSoon struct {
+	Wait time.Duration
+}
+
+func (err ErrTooSoon) Error() string {
+	return fmt.Sprintf("tokens were already sent here recently, try again in %v", err.Wait.Round(time.Second))
+}
+
+// Faucet sends a fixed amount of native token to any address that asks, no more often than once an interval for
+// each address and for each client
+type Faucet struct {
+	address    crypto.Address
+	amount     uint64
+	interval   time.Duration
+	chainID    string
+	transactor Transactor
+	// When each address and client last received tokens
+	last   map[string]time.Time
+	mtx    sync.Mutex
+	now    func() time.Time
+	logger *logging.Logger
+}
+
+func NewFaucet(conf *rpc.FaucetConfig, chainID string, transactor Transactor, logger *logging.Logger) (*Faucet, error) {
+	if conf.Address == nil {
+		return nil, fmt.Errorf("the faucet needs an Address to send tokens from")
+	}
+	if conf.Amount == 0 {
+		return nil, fmt.Errorf("the faucet needs a non-zero Amount to send")
+	}
+	interval, err := time.ParseDuration(conf.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse faucet Interval '%s': %v", conf.Interval, err)
+	}
+	return &Faucet{
+		address:    *conf.Address,
+		amount:     conf.Amount,
+		interval:   interval,
+		chainID:    chainID,
+		transactor: transactor,
+		last:       make(map[string]time.Time),
+		now:        time.Now,
+		logger:     logger.With(structure.ComponentKey, "RPC_Faucet"),
+	}, nil
+}
+
+func (f *Faucet) Info() *ResultFaucet {
+	return &ResultFaucet{
+		Address:  f.address,
+		Amount:   f.amount,
+		Interval: f.interval.String(),
+	}
+}
+
+// Dispense sends tokens to address on behalf of client (such as its IP address), returning ErrTooSoon if either has
+// had tokens within the interval
+func (f *Faucet) Dispense(ctx context.Context, client string, address crypto.Address) (*ResultDispense, error) {
+	keys := []string{"address:" + address.String(), "client:" + client}
+	err := f.reserve(keys)
+	if err != nil {
+		return nil, err
+	}
+	tx := &payload.SendTx{
+		Inputs: []*payload.TxInput{{
+			Address: f.address,
+			Amount:  f.amount,
+		}},
+		Outputs: []*payload.TxOutput{{
+			Address: address,
+			Amount:  f.amount,
+		}},
+	}
+	ctx, cancel := context.WithTimeout(ctx, DispenseTimeout)
+	defer cancel()
+	txe, err := f.transactor.BroadcastTxSync(ctx, txs.Enclose(f.chainID, tx))
+	if err == nil {
+		err = txe.Exception.AsError()
+	}
+	if err != nil {
+		// Nothing was sent so let them try again
+		f.release(keys)
+		return nil, err
+	}
+	f.logger.InfoMsg("Dispensed tokens", "address", address, "client", client, "amount", f.amount,
+		structure.TxHashKey, txe.TxHash)
+	return &ResultDispense{
+		Address: address,
+		Amount:  f.amount,
+		TxHash:  txe.TxHash,
+	}, nil
+}
+
+// Serves GET or POST requests with an address parameter, or information about the faucet without one:
+//
+// curl http://127.0.0.1:26662/?address=<address>
+func (f *Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	addressString := r.FormValue("address")
+	if addressString == "" {
+		f.respond(w, http.StatusOK, f.Info())
+		return
+	}
+	address, err := crypto.AddressFromHexString(strings.TrimPrefix(strings.TrimPrefix(addressString, "0x"), "0X"))
+	if err != nil {
+		f.respond(w, http.StatusBadRequest, fmt.Errorf("could not parse address '%s': %v", addressString, err))
+		return
+	}
+	client, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		client = r.RemoteAddr
+	}
+	result, err := f.Dispense(r.Context(), client, address)
+	switch err := err.(type) {
+	case nil:
+		f.respond(w, http.StatusOK, result)
+	case ErrTooSoon:
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(err.Wait.Seconds()))))
+		f.respond(w, http.StatusTooManyRequests, err)
+	default:
+		f.respond(w, http.StatusInternalServerError, err)
+	}
+}
+
+func (f *Faucet) respond(w http.ResponseWriter, status int, result interface{}) {
+	if err, ok := result.(error); ok {
+		result = map[string]string{"error": err.Error()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(result)
+	if err != nil {
+		f.logger.InfoMsg("Could not write faucet response", structure.ErrorKey, err)
+	}
+}
+
+func (f *Faucet) reserve(keys []string) error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	now := f.now()
+	for key, last := range f.last {
+		if now.Sub(last) >= f.interval {
+			delete(f.last, key)
+		}
+	}
+	for _, key := range keys {
+		if last, ok := f.last[key]; ok {
+			return ErrTooSoon{Wait: f.interval - now.Sub(last)}
+		}
+	}
+	for _, key := range keys {
+		f.last[key] = now
+	}
+	return nil
+}
+
+func (f *Faucet) release(keys []string) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	for _, key := range keys {
+		delete(f.last, key)
+	}
+}
+
+func StartServer(faucet *Faucet, listener net.Listener, cors *rpc.CORSConfig,
+	logger *logging.Logger) (*http.Server, error) {
+	return server.StartHTTPServer(listener, cors.Handler(faucet), logger.With(structure.ComponentKey, "RPC_Faucet"))
+}
@@ -0,0 +1,105 @@
+package rpcfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transactor struct {
+	sent []*payload.SendTx
+	err  error
+}
+
+func (trans *transactor) BroadcastTxSync(ctx context.Context, txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	if trans.err != nil {
+		return nil, trans.err
+	}
+	trans.sent = append(trans.sent, txEnv.Tx.Payload.(*payload.SendTx))
+	return &exec.TxExecution{TxHeader: &exec.TxHeader{TxHash: txEnv.Tx.Hash()}}, nil
+}
+
+func TestFaucet(t *testing.T) {
+	from := crypto.Address{1}
+	conf := rpc.DefaultFaucetConfig()
+	conf.Address = &from
+	conf.Amount = 100
+	trans := new(transactor)
+	faucet, err := NewFaucet(conf, "test-chain", trans, logging.NewNoopLogger())
+	require.NoError(t, err)
+	now := time.Now()
+	faucet.now = func() time.Time { return now }
+
+	to := crypto.Address{2}
+	result, err := faucet.Dispense(context.Background(), "10.0.0.1", to)
+	require.NoError(t, err)
+	assert.Equal(t, to, result.Address)
+	require.Len(t, trans.sent, 1)
+	assert.Equal(t, from, trans.sent[0].Inputs[0].Address)
+	assert.Equal(t, to, trans.sent[0].Outputs[0].Address)
+	assert.Equal(t, uint64(100), trans.sent[0].Outputs[0].Amount)
+
+	// Neither the same address from elsewhere nor another address from the same client
+	_, err = faucet.Dispense(context.Background(), "10.0.0.2", to)
+	assert.Equal(t, ErrTooSoon{Wait: time.Hour}, err)
+	_, err = faucet.Dispense(context.Background(), "10.0.0.1", crypto.Address{3})
+	assert.IsType(t, ErrTooSoon{}, err)
+
+	// A failed request does not count
+	trans.err = fmt.Errorf("mempool is full")
+	_, err = faucet.Dispense(context.Background(), "10.0.0.3", crypto.Address{4})
+	require.Error(t, err)
+	trans.err = nil
+	_, err = faucet.Dispense(context.Background(), "10.0.0.3", crypto.Address{4})
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	_, err = faucet.Dispense(context.Background(), "10.0.0.1", to)
+	require.NoError(t, err)
+	assert.Len(t, trans.sent, 3)
+}
+
+func TestFaucet_ServeHTTP(t *testing.T) {
+	from := crypto.Address{1}
+	conf := rpc.DefaultFaucetConfig()
+	conf.Address = &from
+	faucet, err := NewFaucet(conf, "test-chain", new(transactor), logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	get := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		faucet.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		return w
+	}
+	w := get("/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	info := new(ResultFaucet)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), info))
+	assert.Equal(t, faucet.Info(), info)
+
+	w = get("/?address=0x0200000000000000000000000000000000000000")
+	assert.Equal(t, http.StatusOK, w.Code)
+	result := new(ResultDispense)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+	assert.Equal(t, crypto.Address{2}, result.Address)
+
+	w = get("/?address=0200000000000000000000000000000000000000")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "3600", w.Header().Get("Retry-After"))
+
+	w = get("/?address=nonsense")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
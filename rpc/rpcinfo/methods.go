@@ -24,6 +24,8 @@ const (
 	DumpStorage     = "dump_storage"
 	GetAccountHuman = "account_human"
 	AccountStats    = "account_stats"
+	ContractStats   = "contract_stats"
+	CacheStats      = "cache_stats"
 
 	// Names
 	Name  = "name"
@@ -38,6 +40,7 @@ const (
 	// Consensus
 	UnconfirmedTxs = "unconfirmed_txs"
 	Validators     = "validators"
+	Unbonding      = "unbonding"
 	Consensus      = "consensus"
 )
 
@@ -77,6 +80,8 @@ func GetRoutes(service *rpc.Service) map[string]*server.RPCFunc {
 		DumpStorage:     server.NewRPCFunc(service.DumpStorage, "address"),
 		GetAccountHuman: server.NewRPCFunc(service.AccountHumanReadable, "address"),
 		AccountStats:    server.NewRPCFunc(service.AccountStats, ""),
+		ContractStats:   server.NewRPCFunc(service.ContractStats, "limit"),
+		CacheStats:      server.NewRPCFunc(service.CacheStats, ""),
 
 		// Blockchain
 		Genesis: server.NewRPCFunc(service.Genesis, ""),
@@ -87,6 +92,7 @@ func GetRoutes(service *rpc.Service) map[string]*server.RPCFunc {
 		// Consensus
 		UnconfirmedTxs: server.NewRPCFunc(service.UnconfirmedTxs, "maxTxs"),
 		Validators:     server.NewRPCFunc(service.Validators, ""),
+		Unbonding:      server.NewRPCFunc(service.Unbonding, "address"),
 		Consensus:      server.NewRPCFunc(service.ConsensusState, ""),
 
 		// Names
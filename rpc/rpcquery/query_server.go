@@ -3,25 +3,34 @@ package rpcquery
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/execution/registry"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs/payload"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
-	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -43,6 +52,11 @@ type QueryState interface {
 	registry.IterableReader
 	proposal.IterableReader
 	validator.History
+	AtHeight(height uint64) (*state.ImmutableState, error)
+	IterateAccountsByPublicKey(publicKey *crypto.PublicKey, consumer func(*acm.Account) error) error
+	IterateAccountsByCodeHash(codeHash []byte, consumer func(*acm.Account) error) error
+	IterateEvidence(startHeight, endHeight uint64, consumer func(*exec.Evidence) error) error
+	TxByHash(txHash []byte) (*exec.TxExecution, error)
 }
 
 func NewQueryServer(state QueryState, blockchain bcm.BlockchainInfo, nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
@@ -58,6 +72,15 @@ func (qs *queryServer) Status(ctx context.Context, param *StatusParam) (*rpc.Res
 	return rpc.Status(qs.blockchain, qs.state, qs.nodeView, param.BlockTimeWithin, param.BlockSeenTimeWithin)
 }
 
+func (qs *queryServer) GetGenesis(ctx context.Context, param *GetGenesisParam) (*Genesis, error) {
+	genesisDoc := qs.blockchain.GenesisDoc()
+	bs, err := genesisDoc.JSONBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &Genesis{JSON: bs}, nil
+}
+
 // Account state
 
 func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (*acm.Account, error) {
@@ -72,53 +95,12 @@ func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (
 // by metadata hash
 func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam) (*MetadataResult, error) {
 	metadata := &MetadataResult{}
-	var contractMeta *acm.ContractMeta
 	var err error
 	if param.Address != nil {
-		acc, err := qs.state.GetAccount(*param.Address)
-		if err != nil {
-			return metadata, err
-		}
-		if acc != nil && acc.CodeHash != nil {
-			codehash := acc.CodeHash
-			if acc.Forebear != nil {
-				acc, err = qs.state.GetAccount(*acc.Forebear)
-				if err != nil {
-					return metadata, err
-				}
-			}
-
-			for _, m := range acc.ContractMeta {
-				if bytes.Equal(m.CodeHash, codehash) {
-					contractMeta = m
-					break
-				}
-			}
-
-			if contractMeta == nil {
-				deployCodehash := compile.GetDeployCodeHash(acc.EVMCode, *param.Address)
-				for _, m := range acc.ContractMeta {
-					if bytes.Equal(m.CodeHash, deployCodehash) {
-						contractMeta = m
-						break
-					}
-				}
-			}
-		}
+		metadata.Metadata, err = engine.GetContractMetadata(qs.state, qs.state, *param.Address)
 	} else if param.MetadataHash != nil {
-		contractMeta = &acm.ContractMeta{
-			MetadataHash: *param.MetadataHash,
-		}
-	}
-	if contractMeta == nil {
-		return metadata, nil
-	}
-	if contractMeta.Metadata != "" {
-		// Looks like the metadata is already memoised - (e.g. by native.State)
-		metadata.Metadata = contractMeta.Metadata
-	} else {
 		var metadataHash acmstate.MetadataHash
-		copy(metadataHash[:], contractMeta.MetadataHash)
+		copy(metadataHash[:], *param.MetadataHash)
 		metadata.Metadata, err = qs.state.GetMetadata(metadataHash)
 	}
 	return metadata, err
@@ -134,24 +116,105 @@ func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListA
 	if err != nil {
 		return err
 	}
-	var streamErr error
-	err = qs.state.IterateAccounts(func(acc *acm.Account) error {
+	consumer := func(acc *acm.Account) error {
 		if qry.Matches(acc) {
 			return stream.Send(acc)
 		} else {
 			return nil
 		}
+	}
+	switch {
+	case param.PublicKey != nil:
+		return qs.state.IterateAccountsByPublicKey(param.PublicKey, consumer)
+	case len(param.CodeHash) > 0:
+		return qs.state.IterateAccountsByCodeHash(param.CodeHash, consumer)
+	default:
+		return qs.state.IterateAccounts(consumer)
+	}
+}
+
+func (qs *queryServer) GetContract(ctx context.Context, param *GetContractParam) (*ContractMetadata, error) {
+	contract, err := qs.contractMetadata(param.Address)
+	if contract == nil && err == nil {
+		err = status.Error(codes.NotFound, fmt.Sprintf("no metadata registered for contract at %v", param.Address))
+	}
+	return contract, err
+}
+
+func (qs *queryServer) ListContracts(param *ListContractsParam, stream Query_ListContractsServer) error {
+	return qs.state.IterateAccounts(func(acc *acm.Account) error {
+		if acc.CodeHash == nil {
+			return nil
+		}
+		contract, err := qs.contractMetadata(acc.Address)
+		if err != nil {
+			return err
+		}
+		if contract == nil || param.ContractName != "" && contract.ContractName != param.ContractName {
+			return nil
+		}
+		return stream.Send(contract)
 	})
+}
+
+// Returns the metadata registered for the contract at address, or nil if there is none
+func (qs *queryServer) contractMetadata(address crypto.Address) (*ContractMetadata, error) {
+	contractMeta, err := engine.GetContractMeta(qs.state, address)
+	if err != nil || contractMeta == nil {
+		return nil, err
+	}
+	metadata, err := engine.GetContractMetadata(qs.state, qs.state, address)
 	if err != nil {
+		return nil, err
+	}
+	// Metadata need not be in the form produced by the compiler, in which case only the hashes are known
+	meta := new(compile.Metadata)
+	_ = json.Unmarshal([]byte(metadata), meta)
+	return &ContractMetadata{
+		Address:         address,
+		CodeHash:        contractMeta.CodeHash,
+		MetadataHash:    contractMeta.MetadataHash,
+		ContractName:    meta.ContractName,
+		SourceFile:      meta.SourceFile,
+		SourceHash:      meta.SourceHash,
+		CompilerVersion: meta.CompilerVersion,
+		Abi:             string(meta.Abi),
+	}, nil
+}
+
+func (qs *queryServer) ListStorage(param *ListStorageParam, stream Query_ListStorageServer) error {
+	height := param.Height
+	lastHeight := qs.blockchain.LastBlockHeight()
+	if height == 0 {
+		height = lastHeight
+	} else if height > lastHeight {
+		return fmt.Errorf("cannot list storage at height %d after the last height %d", height, lastHeight)
+	}
+	st, err := qs.state.AtHeight(height)
+	if err != nil {
+		return fmt.Errorf("could not get state at height %d: %w", height, err)
+	}
+	var n uint64
+	err = st.IterateStorageFrom(param.Address, param.Start, func(key binary.Word256, value []byte) error {
+		if param.Limit > 0 && n == param.Limit {
+			return io.EOF
+		}
+		n++
+		return stream.Send(&StorageEntry{Key: key, Value: value})
+	})
+	if err != nil && err != io.EOF {
 		return err
 	}
-	return streamErr
+	return nil
 }
 
 // Names
 
 func (qs *queryServer) GetName(ctx context.Context, param *GetNameParam) (entry *names.Entry, err error) {
 	entry, err = qs.state.GetName(param.Name)
+	if entry != nil && !param.IncludeExpired && qs.expired(entry) {
+		entry = nil
+	}
 	if entry == nil && err == nil {
 		err = status.Error(codes.NotFound, fmt.Sprintf("name %s not found", param.Name))
 	}
@@ -165,7 +228,7 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 	}
 	var streamErr error
 	err = qs.state.IterateNames(func(entry *names.Entry) error {
-		if qry.Matches(entry) {
+		if (param.IncludeExpired || !qs.expired(entry)) && qry.Matches(entry) {
 			return stream.Send(entry)
 		} else {
 			return nil
@@ -177,6 +240,12 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 	return streamErr
 }
 
+// An entry has expired once another account can claim it in the next block. Unless the chain sweeps expired names it
+// stays in state until it is claimed or removed.
+func (qs *queryServer) expired(entry *names.Entry) bool {
+	return entry.Expires <= qs.blockchain.LastBlockHeight()
+}
+
 // Validators
 
 func (qs *queryServer) GetValidatorSet(ctx context.Context, param *GetValidatorSetParam) (*ValidatorSet, error) {
@@ -266,3 +335,255 @@ func (qs *queryServer) GetBlockHeader(ctx context.Context, param *GetBlockParam)
 	abciHeader := tmtypes.TM2PB.Header(header)
 	return &abciHeader, nil
 }
+
+func (qs *queryServer) GetLightBlock(ctx context.Context, param *GetBlockParam) (*tmproto.LightBlock, error) {
+	lastHeight := qs.blockchain.LastBlockHeight()
+	height := param.Height
+	if height == 0 {
+		height = lastHeight
+	} else if height > lastHeight {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("block %d is after the last block %d", height, lastHeight))
+	}
+	lightBlock, err := qs.nodeView.LightBlock(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return lightBlock.ToProto()
+}
+
+// Proofs
+
+func (qs *queryServer) GetAccountProof(ctx context.Context, param *GetAccountProofParam) (*StateProof, error) {
+	return qs.prove(param.Height, func(st *state.ImmutableState) (*storage.ForestProof, error) {
+		return st.ProveAccount(param.Address)
+	})
+}
+
+func (qs *queryServer) GetStorageProof(ctx context.Context, param *GetStorageProofParam) (*StateProof, error) {
+	return qs.prove(param.Height, func(st *state.ImmutableState) (*storage.ForestProof, error) {
+		return st.ProveStorage(param.Address, param.Key)
+	})
+}
+
+func (qs *queryServer) GetNameProof(ctx context.Context, param *GetNameProofParam) (*StateProof, error) {
+	return qs.prove(param.Height, func(st *state.ImmutableState) (*storage.ForestProof, error) {
+		return st.ProveName(param.Name)
+	})
+}
+
+// Misbehaviour
+
+func (qs *queryServer) GetMisbehaviour(ctx context.Context, param *GetMisbehaviourParam) (*Misbehaviour, error) {
+	endHeight := param.EndHeight
+	if endHeight == 0 {
+		endHeight = qs.blockchain.LastBlockHeight()
+	}
+	misbehaviour := &Misbehaviour{
+		PeerErrors: qs.nodeView.PeerErrors(),
+	}
+	err := qs.state.IterateEvidence(param.StartHeight, endHeight, func(ev *exec.Evidence) error {
+		misbehaviour.Committed = append(misbehaviour.Committed, ev)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read committed evidence: %w", err)
+	}
+	misbehaviour.Pending, err = qs.nodeView.PendingEvidence()
+	if err != nil {
+		return nil, fmt.Errorf("could not read pending evidence: %w", err)
+	}
+	return misbehaviour, nil
+}
+
+// State changes
+
+func (qs *queryServer) ListStateChanges(param *ListStateChangesParam, stream Query_ListStateChangesServer) error {
+	toHeight := param.ToHeight
+	lastHeight := qs.blockchain.LastBlockHeight()
+	if toHeight == 0 {
+		toHeight = lastHeight
+	} else if toHeight > lastHeight {
+		return fmt.Errorf("cannot list state changes to height %d after the last height %d", toHeight, lastHeight)
+	}
+	if param.FromHeight > toHeight {
+		return fmt.Errorf("cannot list state changes from height %d to the earlier height %d", param.FromHeight,
+			toHeight)
+	}
+	base, err := qs.state.AtHeight(param.FromHeight)
+	if err != nil {
+		return fmt.Errorf("could not get state at height %d: %w", param.FromHeight, err)
+	}
+	st, err := qs.state.AtHeight(toHeight)
+	if err != nil {
+		return fmt.Errorf("could not get state at height %d: %w", toHeight, err)
+	}
+
+	sendAccount := func(address crypto.Address, after *acm.Account) error {
+		before, err := base.GetAccount(address)
+		if err != nil {
+			return err
+		}
+		// Values may be written again without changing
+		if before != nil && after != nil {
+			same, err := sameEncoding(before, after)
+			if err != nil || same {
+				return err
+			}
+		}
+		return stream.Send(&StateChange{Account: &AccountChange{Address: address, Before: before, After: after}})
+	}
+	err = st.IterateAccountChanges(base,
+		func(acc *acm.Account) error {
+			return sendAccount(acc.Address, acc)
+		},
+		func(address crypto.Address) error {
+			return sendAccount(address, nil)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = st.IterateStorageChanges(base, func(address crypto.Address, key binary.Word256, value []byte) error {
+		before, err := base.GetStorage(address, key)
+		if err != nil || bytes.Equal(before, value) {
+			return err
+		}
+		return stream.Send(&StateChange{Storage: &StorageChange{Address: address, Key: key, Before: before,
+			After: value}})
+	})
+	if err != nil {
+		return err
+	}
+
+	sendName := func(name string, after *names.Entry) error {
+		before, err := base.GetName(name)
+		if err != nil {
+			return err
+		}
+		if before != nil && after != nil {
+			same, err := sameEncoding(before, after)
+			if err != nil || same {
+				return err
+			}
+		}
+		return stream.Send(&StateChange{Name: &NameChange{Name: name, Before: before, After: after}})
+	}
+	return st.IterateNameChanges(base,
+		func(entry *names.Entry) error {
+			return sendName(entry.Name, entry)
+		},
+		func(name string) error {
+			return sendName(name, nil)
+		})
+}
+
+// Revert traces
+
+func (qs *queryServer) GetRevertTrace(ctx context.Context, param *GetRevertTraceParam) (*RevertTrace, error) {
+	txe, err := qs.state.TxByHash(param.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if txe.Exception == nil {
+		return nil, fmt.Errorf("transaction %v did not fail", param.TxHash)
+	}
+	callTx, ok := txe.Envelope.Tx.Payload.(*payload.CallTx)
+	if !ok || callTx.Address == nil {
+		return nil, fmt.Errorf("transaction %v is not a call to a contract", param.TxHash)
+	}
+	// The transaction is replayed without those before it in its block, so may not fail the same way
+	st, err := qs.state.AtHeight(txe.Height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state at height %d: %w", txe.Height-1, err)
+	}
+	replay, tracer, err := execution.CallSimRevertTrace(st, qs.blockchain, callTx, qs.logger)
+	if err != nil {
+		return nil, err
+	}
+	trace := &RevertTrace{Exception: replay.Exception}
+	for _, frame := range tracer.Trace() {
+		revertFrame := &RevertFrame{
+			CodeAddress: frame.CodeAddress,
+			Create:      frame.Create,
+			PC:          frame.PC,
+			OpCode:      frame.OpCode.Name(),
+		}
+		if !frame.Create {
+			err = qs.locateSource(st, revertFrame)
+			if err != nil {
+				qs.logger.InfoMsg("Could not locate source of revert", "address", frame.CodeAddress,
+					"pc", frame.PC, "error", err)
+			}
+		}
+		trace.Frames = append(trace.Frames, revertFrame)
+	}
+	return trace, nil
+}
+
+// Fills in the contract name and source location of frame from the metadata registered for its code, should there be
+// any
+func (qs *queryServer) locateSource(st acmstate.Reader, frame *RevertFrame) error {
+	acc, err := st.GetAccount(frame.CodeAddress)
+	if err != nil || acc == nil {
+		return err
+	}
+	metadata, err := engine.GetContractMetadata(st, qs.state, frame.CodeAddress)
+	if err != nil || metadata == "" {
+		return err
+	}
+	meta := new(compile.Metadata)
+	err = json.Unmarshal([]byte(metadata), meta)
+	if err != nil {
+		return err
+	}
+	frame.ContractName = meta.ContractName
+	if meta.SourceMap == "" {
+		return nil
+	}
+	loc, err := meta.SourceLocation(acc.EVMCode, frame.PC)
+	if err != nil || loc == nil {
+		return err
+	}
+	frame.SourceFile = loc.File
+	frame.SourceStart = uint64(loc.Start)
+	frame.SourceLength = uint64(loc.Length)
+	return nil
+}
+
+func sameEncoding(before, after proto.Message) (bool, error) {
+	beforeBytes, err := encoding.Encode(before)
+	if err != nil {
+		return false, err
+	}
+	afterBytes, err := encoding.Encode(after)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(beforeBytes, afterBytes), nil
+}
+
+func (qs *queryServer) prove(height uint64,
+	prove func(st *state.ImmutableState) (*storage.ForestProof, error)) (*StateProof, error) {
+	lastHeight := qs.blockchain.LastBlockHeight()
+	if height == 0 {
+		// The header of the last block holds the hash of the state before it
+		if lastHeight == 0 {
+			return nil, fmt.Errorf("no state hash has been committed in a block header yet")
+		}
+		height = lastHeight - 1
+	} else if height > lastHeight {
+		return nil, fmt.Errorf("cannot prove state at height %d after the last height %d", height, lastHeight)
+	}
+	st, err := qs.state.AtHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state at height %d: %w", height, err)
+	}
+	proof, err := prove(st)
+	if err != nil {
+		return nil, err
+	}
+	return &StateProof{
+		Height: height,
+		Proof:  proof,
+	}, nil
+}
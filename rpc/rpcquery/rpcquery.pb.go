@@ -9,18 +9,23 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	_ "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	golang_proto "github.com/golang/protobuf/proto"
-	_ "github.com/hyperledger/burrow/acm"
+	acm "github.com/hyperledger/burrow/acm"
 	validator "github.com/hyperledger/burrow/acm/validator"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	tendermint "github.com/hyperledger/burrow/consensus/tendermint"
+	crypto "github.com/hyperledger/burrow/crypto"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-	_ "github.com/hyperledger/burrow/execution/names"
+	errors "github.com/hyperledger/burrow/execution/errors"
+	exec "github.com/hyperledger/burrow/execution/exec"
+	names "github.com/hyperledger/burrow/execution/names"
 	registry "github.com/hyperledger/burrow/execution/registry"
 	_ "github.com/hyperledger/burrow/rpc"
+	storage "github.com/hyperledger/burrow/storage"
 	payload "github.com/hyperledger/burrow/txs/payload"
-	_ "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -299,18 +304,123 @@ func (*StorageValue) XXX_MessageName() string {
 	return "rpcquery.StorageValue"
 }
 
-type ListAccountsParam struct {
-	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+type ListStorageParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The height at which to read storage, or the latest height if zero. Use the same height for each page.
+	Height uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The key from which to list storage (inclusive)
+	Start github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,opt,name=Start,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Start"`
+	// The most entries to list, or all entries if zero
+	Limit                uint64   `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *ListStorageParam) Reset()         { *m = ListStorageParam{} }
+func (m *ListStorageParam) String() string { return proto.CompactTextString(m) }
+func (*ListStorageParam) ProtoMessage()    {}
+func (*ListStorageParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{6}
+}
+func (m *ListStorageParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListStorageParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ListStorageParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStorageParam.Merge(m, src)
+}
+func (m *ListStorageParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListStorageParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStorageParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStorageParam proto.InternalMessageInfo
+
+func (m *ListStorageParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ListStorageParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (*ListStorageParam) XXX_MessageName() string {
+	return "rpcquery.ListStorageParam"
+}
+
+type StorageEntry struct {
+	Key                  github_com_hyperledger_burrow_binary.Word256  `protobuf:"bytes,1,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	Value                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *StorageEntry) Reset()         { *m = StorageEntry{} }
+func (m *StorageEntry) String() string { return proto.CompactTextString(m) }
+func (*StorageEntry) ProtoMessage()    {}
+func (*StorageEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{7}
+}
+func (m *StorageEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StorageEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageEntry.Merge(m, src)
+}
+func (m *StorageEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageEntry proto.InternalMessageInfo
+
+func (*StorageEntry) XXX_MessageName() string {
+	return "rpcquery.StorageEntry"
+}
+
+type ListAccountsParam struct {
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Only list the accounts with this public key if set
+	PublicKey *crypto.PublicKey `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	// Only list the accounts whose code has this hash if set, such as the contracts created by a factory
+	CodeHash             github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
 func (m *ListAccountsParam) Reset()         { *m = ListAccountsParam{} }
 func (m *ListAccountsParam) String() string { return proto.CompactTextString(m) }
 func (*ListAccountsParam) ProtoMessage()    {}
 func (*ListAccountsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{6}
+	return fileDescriptor_88e25d9b99e39f02, []int{8}
 }
 func (m *ListAccountsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,12 +452,193 @@ func (m *ListAccountsParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListAccountsParam) GetPublicKey() *crypto.PublicKey {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
 func (*ListAccountsParam) XXX_MessageName() string {
 	return "rpcquery.ListAccountsParam"
 }
 
+type GetContractParam struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *GetContractParam) Reset()         { *m = GetContractParam{} }
+func (m *GetContractParam) String() string { return proto.CompactTextString(m) }
+func (*GetContractParam) ProtoMessage()    {}
+func (*GetContractParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{9}
+}
+func (m *GetContractParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetContractParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GetContractParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractParam.Merge(m, src)
+}
+func (m *GetContractParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetContractParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractParam proto.InternalMessageInfo
+
+func (*GetContractParam) XXX_MessageName() string {
+	return "rpcquery.GetContractParam"
+}
+
+type ListContractsParam struct {
+	// Only list contracts with this name if set
+	ContractName         string   `protobuf:"bytes,1,opt,name=ContractName,proto3" json:"ContractName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListContractsParam) Reset()         { *m = ListContractsParam{} }
+func (m *ListContractsParam) String() string { return proto.CompactTextString(m) }
+func (*ListContractsParam) ProtoMessage()    {}
+func (*ListContractsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{10}
+}
+func (m *ListContractsParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListContractsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ListContractsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListContractsParam.Merge(m, src)
+}
+func (m *ListContractsParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListContractsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListContractsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListContractsParam proto.InternalMessageInfo
+
+func (m *ListContractsParam) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (*ListContractsParam) XXX_MessageName() string {
+	return "rpcquery.ListContractsParam"
+}
+
+type ContractMetadata struct {
+	Address      github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	CodeHash     github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=CodeHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"CodeHash"`
+	MetadataHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=MetadataHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"MetadataHash"`
+	ContractName string                                        `protobuf:"bytes,4,opt,name=ContractName,proto3" json:"ContractName,omitempty"`
+	SourceFile   string                                        `protobuf:"bytes,5,opt,name=SourceFile,proto3" json:"SourceFile,omitempty"`
+	// The keccak256 hash of the source file
+	SourceHash      string `protobuf:"bytes,6,opt,name=SourceHash,proto3" json:"SourceHash,omitempty"`
+	CompilerVersion string `protobuf:"bytes,7,opt,name=CompilerVersion,proto3" json:"CompilerVersion,omitempty"`
+	// The ABI of the contract as JSON
+	Abi                  string   `protobuf:"bytes,8,opt,name=Abi,proto3" json:"Abi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractMetadata) Reset()         { *m = ContractMetadata{} }
+func (m *ContractMetadata) String() string { return proto.CompactTextString(m) }
+func (*ContractMetadata) ProtoMessage()    {}
+func (*ContractMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{11}
+}
+func (m *ContractMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContractMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMetadata.Merge(m, src)
+}
+func (m *ContractMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMetadata proto.InternalMessageInfo
+
+func (m *ContractMetadata) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *ContractMetadata) GetSourceFile() string {
+	if m != nil {
+		return m.SourceFile
+	}
+	return ""
+}
+
+func (m *ContractMetadata) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
+func (m *ContractMetadata) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *ContractMetadata) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (*ContractMetadata) XXX_MessageName() string {
+	return "rpcquery.ContractMetadata"
+}
+
 type GetNameParam struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Return the entry even if it has expired
+	IncludeExpired       bool     `protobuf:"varint,2,opt,name=IncludeExpired,proto3" json:"IncludeExpired,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -357,7 +648,7 @@ func (m *GetNameParam) Reset()         { *m = GetNameParam{} }
 func (m *GetNameParam) String() string { return proto.CompactTextString(m) }
 func (*GetNameParam) ProtoMessage()    {}
 func (*GetNameParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{7}
+	return fileDescriptor_88e25d9b99e39f02, []int{12}
 }
 func (m *GetNameParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,12 +680,21 @@ func (m *GetNameParam) GetName() string {
 	return ""
 }
 
+func (m *GetNameParam) GetIncludeExpired() bool {
+	if m != nil {
+		return m.IncludeExpired
+	}
+	return false
+}
+
 func (*GetNameParam) XXX_MessageName() string {
 	return "rpcquery.GetNameParam"
 }
 
 type ListNamesParam struct {
-	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// List entries that have expired as well as those that have not
+	IncludeExpired       bool     `protobuf:"varint,2,opt,name=IncludeExpired,proto3" json:"IncludeExpired,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -404,7 +704,7 @@ func (m *ListNamesParam) Reset()         { *m = ListNamesParam{} }
 func (m *ListNamesParam) String() string { return proto.CompactTextString(m) }
 func (*ListNamesParam) ProtoMessage()    {}
 func (*ListNamesParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{8}
+	return fileDescriptor_88e25d9b99e39f02, []int{13}
 }
 func (m *ListNamesParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,6 +736,13 @@ func (m *ListNamesParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListNamesParam) GetIncludeExpired() bool {
+	if m != nil {
+		return m.IncludeExpired
+	}
+	return false
+}
+
 func (*ListNamesParam) XXX_MessageName() string {
 	return "rpcquery.ListNamesParam"
 }
@@ -450,7 +757,7 @@ func (m *GetNetworkRegistryParam) Reset()         { *m = GetNetworkRegistryParam
 func (m *GetNetworkRegistryParam) String() string { return proto.CompactTextString(m) }
 func (*GetNetworkRegistryParam) ProtoMessage()    {}
 func (*GetNetworkRegistryParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{9}
+	return fileDescriptor_88e25d9b99e39f02, []int{14}
 }
 func (m *GetNetworkRegistryParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +796,7 @@ func (m *GetValidatorSetParam) Reset()         { *m = GetValidatorSetParam{} }
 func (m *GetValidatorSetParam) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorSetParam) ProtoMessage()    {}
 func (*GetValidatorSetParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{10}
+	return fileDescriptor_88e25d9b99e39f02, []int{15}
 }
 func (m *GetValidatorSetParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +837,7 @@ func (m *GetValidatorSetHistoryParam) Reset()         { *m = GetValidatorSetHist
 func (m *GetValidatorSetHistoryParam) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorSetHistoryParam) ProtoMessage()    {}
 func (*GetValidatorSetHistoryParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{11}
+	return fileDescriptor_88e25d9b99e39f02, []int{16}
 }
 func (m *GetValidatorSetHistoryParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +884,7 @@ func (m *NetworkRegistry) Reset()         { *m = NetworkRegistry{} }
 func (m *NetworkRegistry) String() string { return proto.CompactTextString(m) }
 func (*NetworkRegistry) ProtoMessage()    {}
 func (*NetworkRegistry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{12}
+	return fileDescriptor_88e25d9b99e39f02, []int{17}
 }
 func (m *NetworkRegistry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +932,7 @@ func (m *RegisteredValidator) Reset()         { *m = RegisteredValidator{} }
 func (m *RegisteredValidator) String() string { return proto.CompactTextString(m) }
 func (*RegisteredValidator) ProtoMessage()    {}
 func (*RegisteredValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{13}
+	return fileDescriptor_88e25d9b99e39f02, []int{18}
 }
 func (m *RegisteredValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +979,7 @@ func (m *ValidatorSetHistory) Reset()         { *m = ValidatorSetHistory{} }
 func (m *ValidatorSetHistory) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetHistory) ProtoMessage()    {}
 func (*ValidatorSetHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{14}
+	return fileDescriptor_88e25d9b99e39f02, []int{19}
 }
 func (m *ValidatorSetHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,7 +1027,7 @@ func (m *ValidatorSet) Reset()         { *m = ValidatorSet{} }
 func (m *ValidatorSet) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSet) ProtoMessage()    {}
 func (*ValidatorSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{15}
+	return fileDescriptor_88e25d9b99e39f02, []int{20}
 }
 func (m *ValidatorSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +1081,7 @@ func (m *GetProposalParam) Reset()         { *m = GetProposalParam{} }
 func (m *GetProposalParam) String() string { return proto.CompactTextString(m) }
 func (*GetProposalParam) ProtoMessage()    {}
 func (*GetProposalParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{16}
+	return fileDescriptor_88e25d9b99e39f02, []int{21}
 }
 func (m *GetProposalParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +1128,7 @@ func (m *ListProposalsParam) Reset()         { *m = ListProposalsParam{} }
 func (m *ListProposalsParam) String() string { return proto.CompactTextString(m) }
 func (*ListProposalsParam) ProtoMessage()    {}
 func (*ListProposalsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{17}
+	return fileDescriptor_88e25d9b99e39f02, []int{22}
 }
 func (m *ListProposalsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +1176,7 @@ func (m *ProposalResult) Reset()         { *m = ProposalResult{} }
 func (m *ProposalResult) String() string { return proto.CompactTextString(m) }
 func (*ProposalResult) ProtoMessage()    {}
 func (*ProposalResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{18}
+	return fileDescriptor_88e25d9b99e39f02, []int{23}
 }
 func (m *ProposalResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1229,7 @@ func (m *GetStatsParam) Reset()         { *m = GetStatsParam{} }
 func (m *GetStatsParam) String() string { return proto.CompactTextString(m) }
 func (*GetStatsParam) ProtoMessage()    {}
 func (*GetStatsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{19}
+	return fileDescriptor_88e25d9b99e39f02, []int{24}
 }
 func (m *GetStatsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1270,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{20}
+	return fileDescriptor_88e25d9b99e39f02, []int{25}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1324,7 @@ func (m *GetBlockParam) Reset()         { *m = GetBlockParam{} }
 func (m *GetBlockParam) String() string { return proto.CompactTextString(m) }
 func (*GetBlockParam) ProtoMessage()    {}
 func (*GetBlockParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{21}
+	return fileDescriptor_88e25d9b99e39f02, []int{26}
 }
 func (m *GetBlockParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,856 +1359,1138 @@ func (m *GetBlockParam) GetHeight() uint64 {
 func (*GetBlockParam) XXX_MessageName() string {
 	return "rpcquery.GetBlockParam"
 }
-func init() {
-	proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
-	golang_proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
-	proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
-	golang_proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
-	proto.RegisterType((*GetMetadataParam)(nil), "rpcquery.GetMetadataParam")
-	golang_proto.RegisterType((*GetMetadataParam)(nil), "rpcquery.GetMetadataParam")
-	proto.RegisterType((*MetadataResult)(nil), "rpcquery.MetadataResult")
-	golang_proto.RegisterType((*MetadataResult)(nil), "rpcquery.MetadataResult")
-	proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
-	golang_proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
-	proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
-	golang_proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
-	proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
-	golang_proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
-	proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
-	golang_proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
-	proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
-	golang_proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
-	proto.RegisterType((*GetNetworkRegistryParam)(nil), "rpcquery.GetNetworkRegistryParam")
-	golang_proto.RegisterType((*GetNetworkRegistryParam)(nil), "rpcquery.GetNetworkRegistryParam")
-	proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
-	golang_proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
-	proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
-	golang_proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
-	proto.RegisterType((*NetworkRegistry)(nil), "rpcquery.NetworkRegistry")
-	golang_proto.RegisterType((*NetworkRegistry)(nil), "rpcquery.NetworkRegistry")
-	proto.RegisterType((*RegisteredValidator)(nil), "rpcquery.RegisteredValidator")
-	golang_proto.RegisterType((*RegisteredValidator)(nil), "rpcquery.RegisteredValidator")
-	proto.RegisterType((*ValidatorSetHistory)(nil), "rpcquery.ValidatorSetHistory")
-	golang_proto.RegisterType((*ValidatorSetHistory)(nil), "rpcquery.ValidatorSetHistory")
-	proto.RegisterType((*ValidatorSet)(nil), "rpcquery.ValidatorSet")
-	golang_proto.RegisterType((*ValidatorSet)(nil), "rpcquery.ValidatorSet")
-	proto.RegisterType((*GetProposalParam)(nil), "rpcquery.GetProposalParam")
-	golang_proto.RegisterType((*GetProposalParam)(nil), "rpcquery.GetProposalParam")
-	proto.RegisterType((*ListProposalsParam)(nil), "rpcquery.ListProposalsParam")
-	golang_proto.RegisterType((*ListProposalsParam)(nil), "rpcquery.ListProposalsParam")
-	proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
-	golang_proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
-	proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
-	golang_proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
-	proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
-	golang_proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
-	proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
-	golang_proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
+
+type GetAccountProofParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The height of the state to prove, or the latest height whose AppHash has been committed in a header if zero
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
-func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
-
-var fileDescriptor_88e25d9b99e39f02 = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xff, 0x6f, 0xf3, 0x7d, 0xec, 0xd8, 0xed, 0x24, 0x7f, 0xd7, 0xdd, 0xb6, 0x4e, 0x19, 0x89,
-	0x34, 0x44, 0x65, 0x6d, 0x4c, 0xc3, 0x05, 0x5c, 0xa0, 0x3a, 0x80, 0x1d, 0x4a, 0xa3, 0xb0, 0x86,
-	0x56, 0x02, 0x09, 0x69, 0xb2, 0x3b, 0xb2, 0x57, 0x5d, 0xef, 0x98, 0xd9, 0xd9, 0x16, 0x3f, 0x06,
-	0x2f, 0xc0, 0x3d, 0x0f, 0xc0, 0x3d, 0x97, 0xb9, 0xe4, 0x12, 0xf5, 0x22, 0x42, 0xe9, 0x8b, 0xa0,
-	0x9d, 0x0f, 0xef, 0x47, 0xdc, 0x08, 0x10, 0xdc, 0x58, 0x73, 0xce, 0x9c, 0x0f, 0x9f, 0x33, 0xe7,
-	0xf7, 0x3b, 0x0b, 0x35, 0x3e, 0xf5, 0xbe, 0x4f, 0x28, 0x9f, 0x39, 0x53, 0xce, 0x04, 0x43, 0xeb,
-	0x46, 0xb6, 0xb7, 0x47, 0x6c, 0xc4, 0xa4, 0xb2, 0x9d, 0x9e, 0xd4, 0xbd, 0x7d, 0x47, 0xd0, 0xc8,
-	0xa7, 0x7c, 0x12, 0x44, 0xa2, 0x2d, 0x66, 0x53, 0x1a, 0xab, 0x5f, 0x7d, 0x5b, 0x89, 0xc8, 0x64,
-	0x2e, 0x6c, 0x10, 0x6f, 0xa2, 0x8f, 0xf5, 0x17, 0x24, 0x0c, 0x7c, 0x22, 0x18, 0xd7, 0x8a, 0x1a,
-	0xa7, 0xa3, 0x20, 0x16, 0x26, 0xad, 0xbd, 0xc1, 0xa7, 0x9e, 0x3e, 0x6e, 0x4e, 0xc9, 0x2c, 0x64,
-	0xc4, 0x57, 0x22, 0x0e, 0xa0, 0x32, 0x14, 0x44, 0x24, 0xf1, 0x09, 0xe1, 0x64, 0x82, 0xf6, 0xa0,
-	0xde, 0x0b, 0x99, 0xf7, 0xfc, 0xab, 0x60, 0x42, 0x9f, 0x05, 0x62, 0x1c, 0x44, 0x4d, 0xeb, 0x9e,
-	0xb5, 0xb7, 0xe1, 0x96, 0xd5, 0xa8, 0x03, 0x5b, 0x52, 0x35, 0xa4, 0x34, 0xca, 0x59, 0x5f, 0x93,
-	0xd6, 0x8b, 0xae, 0x30, 0x81, 0x7a, 0x9f, 0x8a, 0x47, 0x9e, 0xc7, 0x92, 0x48, 0xa8, 0x74, 0xc7,
-	0xb0, 0xf6, 0xc8, 0xf7, 0x39, 0x8d, 0x63, 0x99, 0xa6, 0xda, 0x7b, 0x78, 0x76, 0xbe, 0xf3, 0xbf,
-	0x57, 0xe7, 0x3b, 0x0f, 0x46, 0x81, 0x18, 0x27, 0xa7, 0x8e, 0xc7, 0x26, 0xed, 0xf1, 0x6c, 0x4a,
-	0x79, 0x48, 0xfd, 0x11, 0xe5, 0xed, 0xd3, 0x84, 0x73, 0xf6, 0xb2, 0xed, 0xf1, 0xd9, 0x54, 0x30,
-	0x47, 0xfb, 0xba, 0x26, 0x08, 0xfe, 0xc5, 0x82, 0xeb, 0x7d, 0x2a, 0x9e, 0x50, 0x41, 0x7c, 0x22,
-	0x88, 0x4a, 0xf2, 0x79, 0x39, 0x49, 0xe7, 0x1f, 0x27, 0x40, 0x5f, 0x43, 0xd5, 0x04, 0x1f, 0x90,
-	0x78, 0x2c, 0xcb, 0xad, 0xf6, 0xde, 0x7b, 0x75, 0xbe, 0xf3, 0xee, 0xd5, 0x01, 0x4f, 0x83, 0x88,
-	0xf0, 0x99, 0x33, 0xa0, 0x3f, 0xf4, 0x66, 0x82, 0xc6, 0x6e, 0x21, 0x0c, 0x7e, 0x00, 0x35, 0x23,
-	0xbb, 0x34, 0x4e, 0x42, 0x81, 0x6c, 0x58, 0x37, 0x1a, 0xfd, 0x02, 0x73, 0x19, 0xff, 0x6c, 0xc9,
-	0x4e, 0x0e, 0x05, 0xe3, 0x64, 0x44, 0xff, 0x93, 0x4e, 0xa2, 0xcf, 0x60, 0xe9, 0x31, 0x9d, 0xe9,
-	0xfa, 0xfe, 0x62, 0x2c, 0x5d, 0xe3, 0x33, 0xc6, 0xfd, 0xee, 0xc1, 0x07, 0x6e, 0x1a, 0x00, 0x7f,
-	0x0b, 0x55, 0xfd, 0x3f, 0x9f, 0x92, 0x30, 0xa1, 0xe8, 0x31, 0xac, 0xc8, 0x83, 0xfe, 0x97, 0x07,
-	0x3a, 0xf2, 0xdf, 0xec, 0x9e, 0x8a, 0x81, 0xdf, 0x81, 0x1b, 0x5f, 0x04, 0xb1, 0x19, 0x29, 0x3d,
-	0xc2, 0xdb, 0xb0, 0xf2, 0x65, 0x8a, 0x30, 0xdd, 0x36, 0x25, 0x60, 0x0c, 0xd5, 0x3e, 0x15, 0xc7,
-	0x64, 0xa2, 0xfb, 0x85, 0x60, 0x39, 0x15, 0xb4, 0x91, 0x3c, 0xe3, 0x5d, 0xa8, 0xa5, 0xe1, 0xd2,
-	0xf3, 0x95, 0xb1, 0x6e, 0xc1, 0xcd, 0x34, 0x16, 0x15, 0x2f, 0x19, 0x7f, 0xee, 0x6a, 0xa4, 0x49,
-	0x07, 0xdc, 0x80, 0xed, 0x3e, 0x15, 0x4f, 0x0d, 0x1c, 0x87, 0x54, 0x0d, 0x3a, 0xee, 0xc3, 0xed,
-	0x92, 0x7e, 0x10, 0xc4, 0x82, 0x69, 0xb7, 0x14, 0x76, 0x47, 0x91, 0x17, 0x26, 0x3e, 0x3d, 0xe1,
-	0xf4, 0x45, 0xc0, 0x12, 0xf5, 0x8a, 0x4b, 0x6e, 0x59, 0x8d, 0x7b, 0x50, 0x2f, 0x25, 0x46, 0x6d,
-	0x58, 0x1a, 0x52, 0xd1, 0xb4, 0xee, 0x2d, 0xed, 0x55, 0xba, 0x77, 0x9d, 0x39, 0xe3, 0x28, 0x03,
-	0xca, 0xa9, 0x3f, 0xcf, 0xeb, 0xa6, 0x96, 0xf8, 0x47, 0x0b, 0xb6, 0x16, 0x5c, 0xfe, 0xeb, 0x33,
-	0xb4, 0x0f, 0xcb, 0xc7, 0xcc, 0xa7, 0x72, 0x88, 0x2a, 0xdd, 0x86, 0x33, 0x27, 0xa5, 0x54, 0x7b,
-	0xe4, 0xd3, 0x48, 0x04, 0x62, 0xe6, 0x4a, 0x1b, 0xdc, 0x87, 0xad, 0x05, 0xdd, 0x41, 0x1d, 0x58,
-	0xd3, 0x47, 0x5d, 0x5f, 0x23, 0xab, 0x2f, 0x6f, 0xef, 0x1a, 0x33, 0x7c, 0x0c, 0xd5, 0xfc, 0x05,
-	0x6a, 0xc0, 0xea, 0x98, 0x06, 0xa3, 0xb1, 0x90, 0x35, 0x2d, 0xbb, 0x5a, 0x42, 0xbb, 0xaa, 0x6b,
-	0xd7, 0x64, 0xd4, 0x6d, 0x27, 0x63, 0xd0, 0x52, 0xb3, 0x76, 0x25, 0xa3, 0x9c, 0x70, 0x36, 0x65,
-	0x31, 0x09, 0xe7, 0xc3, 0x23, 0xd1, 0x2f, 0xbb, 0xe4, 0xca, 0x33, 0xee, 0x00, 0x4a, 0x87, 0xc7,
-	0x18, 0xea, 0x01, 0xb2, 0x61, 0x5d, 0x69, 0xa8, 0x2f, 0xad, 0xd7, 0xdd, 0xb9, 0x8c, 0x9f, 0x40,
-	0xcd, 0x58, 0x6b, 0xd0, 0x2f, 0x88, 0x8b, 0xee, 0xc3, 0x6a, 0x8f, 0x84, 0x21, 0x13, 0xba, 0x8d,
-	0x75, 0xc7, 0x10, 0xb8, 0x52, 0xbb, 0xfa, 0x1a, 0xd7, 0x61, 0x53, 0x92, 0x02, 0xd1, 0x40, 0xc0,
-	0x14, 0x56, 0xa4, 0x84, 0xf6, 0xe1, 0xba, 0x81, 0x48, 0x4a, 0xc5, 0x87, 0xe9, 0x9b, 0xa8, 0x66,
-	0x5c, 0xd2, 0xa7, 0xb4, 0x9e, 0xd7, 0xb1, 0x44, 0x1c, 0x9a, 0x27, 0x5c, 0x76, 0x17, 0x5d, 0xe1,
-	0xfb, 0x32, 0xaf, 0x24, 0x7c, 0x55, 0x73, 0x03, 0x56, 0x07, 0x85, 0x8e, 0x2b, 0xa9, 0xfb, 0xd3,
-	0x9a, 0x46, 0x13, 0xea, 0xc2, 0xaa, 0x5a, 0x3a, 0xe8, 0xff, 0xd9, 0x73, 0xe6, 0xd6, 0x90, 0x7d,
-	0x23, 0x55, 0x3b, 0xaa, 0x2b, 0xda, 0xf2, 0x00, 0x20, 0xdb, 0x1e, 0xe8, 0x56, 0xe6, 0x57, 0xda,
-	0x29, 0x76, 0xd5, 0x49, 0x17, 0xa3, 0x31, 0x3c, 0x84, 0x4a, 0x6e, 0x21, 0x20, 0xbb, 0xe0, 0x57,
-	0xd8, 0x13, 0x76, 0x33, 0xbb, 0x2b, 0x91, 0xf1, 0xc7, 0x32, 0xb7, 0xe6, 0xb1, 0x52, 0xee, 0x3c,
-	0x0b, 0xdb, 0x8d, 0x7c, 0x39, 0x39, 0xd6, 0xfb, 0x08, 0xaa, 0x79, 0xa2, 0x42, 0xb7, 0x33, 0xbb,
-	0x4b, 0x04, 0x56, 0x2c, 0xa0, 0x63, 0xa1, 0x36, 0xac, 0x69, 0xea, 0x42, 0x8d, 0x42, 0xea, 0x39,
-	0x9b, 0xd9, 0x55, 0x47, 0x7d, 0x19, 0x7c, 0x1a, 0xa5, 0x84, 0x70, 0x00, 0x1b, 0x73, 0x1e, 0x43,
-	0xcd, 0x62, 0xaa, 0x8c, 0xdc, 0x8a, 0x4e, 0x1d, 0x0b, 0xb9, 0x80, 0x2e, 0xd3, 0x1a, 0x7a, 0xab,
-	0x98, 0x72, 0x01, 0xe9, 0xd9, 0xb9, 0x86, 0x94, 0xbd, 0x8f, 0xe4, 0xa6, 0x2a, 0x00, 0xb2, 0x55,
-	0x08, 0x78, 0x89, 0x2a, 0xed, 0x37, 0x20, 0x1c, 0x7d, 0x07, 0x8d, 0xc5, 0x14, 0x8a, 0xde, 0x7e,
-	0x63, 0xc4, 0x3c, 0xc9, 0xda, 0x77, 0x17, 0x07, 0x36, 0x51, 0x3e, 0x94, 0x93, 0x62, 0x10, 0x59,
-	0x9a, 0x94, 0x02, 0xfe, 0xed, 0x32, 0x06, 0xd1, 0x11, 0x6c, 0x16, 0xc0, 0x8f, 0xee, 0x14, 0xbb,
-	0x5e, 0x64, 0x85, 0xfc, 0xa4, 0x15, 0x19, 0xa0, 0x63, 0xa1, 0x87, 0xb0, 0x6e, 0x60, 0x8c, 0x6e,
-	0x96, 0x26, 0xcd, 0x40, 0xdb, 0xae, 0x17, 0x61, 0x13, 0xa3, 0x43, 0xa8, 0x19, 0x10, 0x0e, 0x28,
-	0xf1, 0x29, 0x2f, 0xf9, 0x66, 0xf0, 0xb4, 0x9b, 0x4e, 0xf6, 0x8d, 0xe9, 0xa8, 0xaf, 0x4b, 0xe5,
-	0xd2, 0xfb, 0xe4, 0xec, 0xa2, 0x65, 0xfd, 0x76, 0xd1, 0xb2, 0x7e, 0xbf, 0x68, 0x59, 0x7f, 0x5c,
-	0xb4, 0xac, 0x5f, 0x5f, 0xb7, 0xac, 0xb3, 0xd7, 0x2d, 0xeb, 0x9b, 0xfd, 0xab, 0x17, 0x00, 0x9f,
-	0x7a, 0x6d, 0x93, 0xed, 0x74, 0x55, 0x7e, 0x58, 0xbe, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0xd8, 0xa5, 0xb6, 0xfb, 0x0a, 0x00, 0x00,
+func (m *GetAccountProofParam) Reset()         { *m = GetAccountProofParam{} }
+func (m *GetAccountProofParam) String() string { return proto.CompactTextString(m) }
+func (*GetAccountProofParam) ProtoMessage()    {}
+func (*GetAccountProofParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{27}
 }
-
-func (m *StatusParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetAccountProofParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountProofParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *StatusParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetAccountProofParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountProofParam.Merge(m, src)
+}
+func (m *GetAccountProofParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountProofParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountProofParam.DiscardUnknown(m)
 }
 
-func (m *StatusParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.BlockSeenTimeWithin) > 0 {
-		i -= len(m.BlockSeenTimeWithin)
-		copy(dAtA[i:], m.BlockSeenTimeWithin)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.BlockSeenTimeWithin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BlockTimeWithin) > 0 {
-		i -= len(m.BlockTimeWithin)
-		copy(dAtA[i:], m.BlockTimeWithin)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.BlockTimeWithin)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GetAccountProofParam proto.InternalMessageInfo
+
+func (m *GetAccountProofParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetAccountParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (*GetAccountProofParam) XXX_MessageName() string {
+	return "rpcquery.GetAccountProofParam"
+}
+
+type GetStorageProofParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key     github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	// The height of the state to prove, or the latest height whose AppHash has been committed in a header if zero
+	Height               uint64   `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStorageProofParam) Reset()         { *m = GetStorageProofParam{} }
+func (m *GetStorageProofParam) String() string { return proto.CompactTextString(m) }
+func (*GetStorageProofParam) ProtoMessage()    {}
+func (*GetStorageProofParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{28}
+}
+func (m *GetStorageProofParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStorageProofParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *GetAccountParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetStorageProofParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageProofParam.Merge(m, src)
+}
+func (m *GetStorageProofParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStorageProofParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageProofParam.DiscardUnknown(m)
 }
 
-func (m *GetAccountParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	{
-		size := m.Address.Size()
-		i -= size
-		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+var xxx_messageInfo_GetStorageProofParam proto.InternalMessageInfo
+
+func (m *GetStorageProofParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetMetadataParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (*GetStorageProofParam) XXX_MessageName() string {
+	return "rpcquery.GetStorageProofParam"
+}
+
+type GetNameProofParam struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The height of the state to prove, or the latest height whose AppHash has been committed in a header if zero
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNameProofParam) Reset()         { *m = GetNameProofParam{} }
+func (m *GetNameProofParam) String() string { return proto.CompactTextString(m) }
+func (*GetNameProofParam) ProtoMessage()    {}
+func (*GetNameProofParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{29}
+}
+func (m *GetNameProofParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNameProofParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *GetMetadataParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetNameProofParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNameProofParam.Merge(m, src)
+}
+func (m *GetNameProofParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNameProofParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNameProofParam.DiscardUnknown(m)
 }
 
-func (m *GetMetadataParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.MetadataHash != nil {
-		{
-			size := m.MetadataHash.Size()
-			i -= size
-			if _, err := m.MetadataHash.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintRpcquery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Address != nil {
-		{
-			size := m.Address.Size()
-			i -= size
-			if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintRpcquery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GetNameProofParam proto.InternalMessageInfo
+
+func (m *GetNameProofParam) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MetadataResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetNameProofParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MetadataResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*GetNameProofParam) XXX_MessageName() string {
+	return "rpcquery.GetNameProofParam"
 }
 
-func (m *MetadataResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type StateProof struct {
+	// The height of the state proved, whose hash is the AppHash in the header of the block at Height + 1
+	Height               uint64               `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Proof                *storage.ForestProof `protobuf:"bytes,2,opt,name=Proof,proto3" json:"Proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetStorageParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{30}
+}
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *GetStorageParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
 }
 
-func (m *GetStorageParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	{
-		size := m.Key.Size()
-		i -= size
-		if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRpcquery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Address.Size()
-		i -= size
-		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *StorageValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateProof) GetProof() *storage.ForestProof {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StorageValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*StateProof) XXX_MessageName() string {
+	return "rpcquery.StateProof"
 }
 
-func (m *StorageValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	{
-		size := m.Value.Size()
-		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRpcquery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type GetMisbehaviourParam struct {
+	// The first block from which to return committed evidence
+	StartHeight uint64 `protobuf:"varint,1,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	// The last block from which to return committed evidence, or the latest block if zero
+	EndHeight            uint64   `protobuf:"varint,2,opt,name=EndHeight,proto3" json:"EndHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListAccountsParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetMisbehaviourParam) Reset()         { *m = GetMisbehaviourParam{} }
+func (m *GetMisbehaviourParam) String() string { return proto.CompactTextString(m) }
+func (*GetMisbehaviourParam) ProtoMessage()    {}
+func (*GetMisbehaviourParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{31}
+}
+func (m *GetMisbehaviourParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMisbehaviourParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ListAccountsParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetMisbehaviourParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMisbehaviourParam.Merge(m, src)
+}
+func (m *GetMisbehaviourParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMisbehaviourParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMisbehaviourParam.DiscardUnknown(m)
 }
 
-func (m *ListAccountsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GetMisbehaviourParam proto.InternalMessageInfo
+
+func (m *GetMisbehaviourParam) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetNameParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetMisbehaviourParam) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetNameParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*GetMisbehaviourParam) XXX_MessageName() string {
+	return "rpcquery.GetMisbehaviourParam"
 }
 
-func (m *GetNameParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type Misbehaviour struct {
+	// Evidence committed in blocks
+	Committed []*exec.Evidence `protobuf:"bytes,1,rep,name=Committed,proto3" json:"Committed,omitempty"`
+	// Evidence this node has verified that has not yet been committed
+	Pending []*exec.Evidence `protobuf:"bytes,2,rep,name=Pending,proto3" json:"Pending,omitempty"`
+	// The most recent errors for which this node disconnected peers
+	PeerErrors           []*tendermint.PeerError `protobuf:"bytes,3,rep,name=PeerErrors,proto3" json:"PeerErrors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *ListNamesParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
+func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
+func (*Misbehaviour) ProtoMessage()    {}
+func (*Misbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{32}
+}
+func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Misbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ListNamesParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Misbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Misbehaviour.Merge(m, src)
+}
+func (m *Misbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *Misbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_Misbehaviour.DiscardUnknown(m)
 }
 
-func (m *ListNamesParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
+
+func (m *Misbehaviour) GetCommitted() []*exec.Evidence {
+	if m != nil {
+		return m.Committed
 	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Misbehaviour) GetPending() []*exec.Evidence {
+	if m != nil {
+		return m.Pending
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetNetworkRegistryParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Misbehaviour) GetPeerErrors() []*tendermint.PeerError {
+	if m != nil {
+		return m.PeerErrors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetNetworkRegistryParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*Misbehaviour) XXX_MessageName() string {
+	return "rpcquery.Misbehaviour"
 }
 
-func (m *GetNetworkRegistryParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+type GetGenesisParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetValidatorSetParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetGenesisParam) Reset()         { *m = GetGenesisParam{} }
+func (m *GetGenesisParam) String() string { return proto.CompactTextString(m) }
+func (*GetGenesisParam) ProtoMessage()    {}
+func (*GetGenesisParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{33}
+}
+func (m *GetGenesisParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGenesisParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *GetGenesisParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGenesisParam.Merge(m, src)
+}
+func (m *GetGenesisParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGenesisParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGenesisParam.DiscardUnknown(m)
 }
 
-func (m *GetValidatorSetParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GetGenesisParam proto.InternalMessageInfo
+
+func (*GetGenesisParam) XXX_MessageName() string {
+	return "rpcquery.GetGenesisParam"
 }
 
-func (m *GetValidatorSetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+type Genesis struct {
+	JSON                 []byte   `protobuf:"bytes,1,opt,name=JSON,proto3" json:"JSON,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetValidatorSetHistoryParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Genesis) Reset()         { *m = Genesis{} }
+func (m *Genesis) String() string { return proto.CompactTextString(m) }
+func (*Genesis) ProtoMessage()    {}
+func (*Genesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{34}
+}
+func (m *Genesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Genesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *GetValidatorSetHistoryParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Genesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Genesis.Merge(m, src)
+}
+func (m *Genesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *Genesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_Genesis.DiscardUnknown(m)
 }
 
-func (m *GetValidatorSetHistoryParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IncludePrevious != 0 {
-		i = encodeVarintRpcquery(dAtA, i, uint64(m.IncludePrevious))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_Genesis proto.InternalMessageInfo
+
+func (m *Genesis) GetJSON() []byte {
+	if m != nil {
+		return m.JSON
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *NetworkRegistry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (*Genesis) XXX_MessageName() string {
+	return "rpcquery.Genesis"
+}
+
+type ListStateChangesParam struct {
+	// The height of the state to compare from
+	FromHeight uint64 `protobuf:"varint,1,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	// The height of the state to compare to, or the latest height if zero
+	ToHeight             uint64   `protobuf:"varint,2,opt,name=ToHeight,proto3" json:"ToHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListStateChangesParam) Reset()         { *m = ListStateChangesParam{} }
+func (m *ListStateChangesParam) String() string { return proto.CompactTextString(m) }
+func (*ListStateChangesParam) ProtoMessage()    {}
+func (*ListStateChangesParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{35}
+}
+func (m *ListStateChangesParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListStateChangesParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *ListStateChangesParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStateChangesParam.Merge(m, src)
+}
+func (m *ListStateChangesParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListStateChangesParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStateChangesParam.DiscardUnknown(m)
 }
 
-func (m *NetworkRegistry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ListStateChangesParam proto.InternalMessageInfo
+
+func (m *ListStateChangesParam) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
 }
 
-func (m *NetworkRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ListStateChangesParam) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	if len(m.Set) > 0 {
-		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Set[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRpcquery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RegisteredValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (*ListStateChangesParam) XXX_MessageName() string {
+	return "rpcquery.ListStateChangesParam"
+}
+
+// Exactly one of the changes is set. Accounts are sent first, then storage, then names.
+type StateChange struct {
+	Account              *AccountChange `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
+	Storage              *StorageChange `protobuf:"bytes,2,opt,name=Storage,proto3" json:"Storage,omitempty"`
+	Name                 *NameChange    `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StateChange) Reset()         { *m = StateChange{} }
+func (m *StateChange) String() string { return proto.CompactTextString(m) }
+func (*StateChange) ProtoMessage()    {}
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{36}
+}
+func (m *StateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *RegisteredValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChange.Merge(m, src)
+}
+func (m *StateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChange.DiscardUnknown(m)
 }
 
-func (m *RegisteredValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Node != nil {
-		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRpcquery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_StateChange proto.InternalMessageInfo
+
+func (m *StateChange) GetAccount() *AccountChange {
+	if m != nil {
+		return m.Account
 	}
-	{
-		size := m.Address.Size()
-		i -= size
-		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *StateChange) GetStorage() *StorageChange {
+	if m != nil {
+		return m.Storage
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValidatorSetHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateChange) GetName() *NameChange {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorSetHistory) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*StateChange) XXX_MessageName() string {
+	return "rpcquery.StateChange"
 }
 
-func (m *ValidatorSetHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.History) > 0 {
-		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRpcquery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type AccountChange struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Unset if the account was created
+	Before *acm.Account `protobuf:"bytes,2,opt,name=Before,proto3" json:"Before,omitempty"`
+	// Unset if the account was removed, in which case its storage is removed with it
+	After                *acm.Account `protobuf:"bytes,3,opt,name=After,proto3" json:"After,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccountChange) Reset()         { *m = AccountChange{} }
+func (m *AccountChange) String() string { return proto.CompactTextString(m) }
+func (*AccountChange) ProtoMessage()    {}
+func (*AccountChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{37}
+}
+func (m *AccountChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ValidatorSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccountChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountChange.Merge(m, src)
+}
+func (m *AccountChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountChange.DiscardUnknown(m)
 }
 
-func (m *ValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Set) > 0 {
-		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Set[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRpcquery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Height != 0 {
-		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_AccountChange proto.InternalMessageInfo
+
+func (m *AccountChange) GetBefore() *acm.Account {
+	if m != nil {
+		return m.Before
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetProposalParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountChange) GetAfter() *acm.Account {
+	if m != nil {
+		return m.After
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetProposalParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*AccountChange) XXX_MessageName() string {
+	return "rpcquery.AccountChange"
 }
 
-func (m *GetProposalParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type StorageChange struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key     github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	// Empty if the key was unset
+	Before github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Before,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Before"`
+	// Empty if the key was deleted
+	After                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=After,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"After"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func (m *ListProposalsParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StorageChange) Reset()         { *m = StorageChange{} }
+func (m *StorageChange) String() string { return proto.CompactTextString(m) }
+func (*StorageChange) ProtoMessage()    {}
+func (*StorageChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{38}
+}
+func (m *StorageChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *StorageChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageChange.Merge(m, src)
+}
+func (m *StorageChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageChange.DiscardUnknown(m)
 }
 
-func (m *ListProposalsParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StorageChange proto.InternalMessageInfo
+
+func (*StorageChange) XXX_MessageName() string {
+	return "rpcquery.StorageChange"
 }
 
-func (m *ListProposalsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Proposed {
-		i--
-		if m.Proposed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type NameChange struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Unset if the name was registered
+	Before *names.Entry `protobuf:"bytes,2,opt,name=Before,proto3" json:"Before,omitempty"`
+	// Unset if the name was removed
+	After                *names.Entry `protobuf:"bytes,3,opt,name=After,proto3" json:"After,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ProposalResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NameChange) Reset()         { *m = NameChange{} }
+func (m *NameChange) String() string { return proto.CompactTextString(m) }
+func (*NameChange) ProtoMessage()    {}
+func (*NameChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{39}
+}
+func (m *NameChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *NameChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameChange.Merge(m, src)
+}
+func (m *NameChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameChange.DiscardUnknown(m)
 }
 
-func (m *ProposalResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_NameChange proto.InternalMessageInfo
+
+func (m *NameChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ProposalResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *NameChange) GetBefore() *names.Entry {
+	if m != nil {
+		return m.Before
 	}
-	if m.Ballot != nil {
-		{
-			size, err := m.Ballot.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRpcquery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *NameChange) GetAfter() *names.Entry {
+	if m != nil {
+		return m.After
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (*NameChange) XXX_MessageName() string {
+	return "rpcquery.NameChange"
+}
+
+type GetRevertTraceParam struct {
+	TxHash               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *GetRevertTraceParam) Reset()         { *m = GetRevertTraceParam{} }
+func (m *GetRevertTraceParam) String() string { return proto.CompactTextString(m) }
+func (*GetRevertTraceParam) ProtoMessage()    {}
+func (*GetRevertTraceParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{40}
+}
+func (m *GetRevertTraceParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRevertTraceParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *GetRevertTraceParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevertTraceParam.Merge(m, src)
+}
+func (m *GetRevertTraceParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRevertTraceParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevertTraceParam.DiscardUnknown(m)
 }
 
-func (m *GetStatsParam) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_GetRevertTraceParam proto.InternalMessageInfo
+
+func (*GetRevertTraceParam) XXX_MessageName() string {
+	return "rpcquery.GetRevertTraceParam"
+}
+
+type RevertTrace struct {
+	// The exception the replayed call failed with, which may differ from that of the transaction, or be unset, should
+	// the transaction have depended on another before it in its block
+	Exception *errors.Exception `protobuf:"bytes,1,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	// The frames the failure passed through, from the call of the transaction to the call that failed first
+	Frames               []*RevertFrame `protobuf:"bytes,2,rep,name=Frames,proto3" json:"Frames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RevertTrace) Reset()         { *m = RevertTrace{} }
+func (m *RevertTrace) String() string { return proto.CompactTextString(m) }
+func (*RevertTrace) ProtoMessage()    {}
+func (*RevertTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{41}
+}
+func (m *RevertTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RevertTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertTrace.Merge(m, src)
+}
+func (m *RevertTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertTrace proto.InternalMessageInfo
+
+func (m *RevertTrace) GetException() *errors.Exception {
+	if m != nil {
+		return m.Exception
+	}
+	return nil
+}
+
+func (m *RevertTrace) GetFrames() []*RevertFrame {
+	if m != nil {
+		return m.Frames
+	}
+	return nil
+}
+
+func (*RevertTrace) XXX_MessageName() string {
+	return "rpcquery.RevertTrace"
+}
+
+type RevertFrame struct {
+	// The address of the code that failed, which is that of the contract called unless by DELEGATECALL or CALLCODE
+	CodeAddress github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=CodeAddress,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"CodeAddress"`
+	// Whether the code was creating a contract rather than deployed
+	Create bool   `protobuf:"varint,2,opt,name=Create,proto3" json:"Create,omitempty"`
+	PC     uint64 `protobuf:"varint,3,opt,name=PC,proto3" json:"PC,omitempty"`
+	// REVERT unless the code failed with some other exception
+	OpCode string `protobuf:"bytes,4,opt,name=OpCode,proto3" json:"OpCode,omitempty"`
+	// The name of the contract registered for the code
+	ContractName string `protobuf:"bytes,5,opt,name=ContractName,proto3" json:"ContractName,omitempty"`
+	// The range of the source file the failing instruction was compiled from, if the contract was deployed with a
+	// source map and the instruction was compiled from source
+	SourceFile           string   `protobuf:"bytes,6,opt,name=SourceFile,proto3" json:"SourceFile,omitempty"`
+	SourceStart          uint64   `protobuf:"varint,7,opt,name=SourceStart,proto3" json:"SourceStart,omitempty"`
+	SourceLength         uint64   `protobuf:"varint,8,opt,name=SourceLength,proto3" json:"SourceLength,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertFrame) Reset()         { *m = RevertFrame{} }
+func (m *RevertFrame) String() string { return proto.CompactTextString(m) }
+func (*RevertFrame) ProtoMessage()    {}
+func (*RevertFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{42}
+}
+func (m *RevertFrame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RevertFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertFrame.Merge(m, src)
+}
+func (m *RevertFrame) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertFrame proto.InternalMessageInfo
+
+func (m *RevertFrame) GetCreate() bool {
+	if m != nil {
+		return m.Create
+	}
+	return false
+}
+
+func (m *RevertFrame) GetPC() uint64 {
+	if m != nil {
+		return m.PC
+	}
+	return 0
+}
+
+func (m *RevertFrame) GetOpCode() string {
+	if m != nil {
+		return m.OpCode
+	}
+	return ""
+}
+
+func (m *RevertFrame) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *RevertFrame) GetSourceFile() string {
+	if m != nil {
+		return m.SourceFile
+	}
+	return ""
+}
+
+func (m *RevertFrame) GetSourceStart() uint64 {
+	if m != nil {
+		return m.SourceStart
+	}
+	return 0
+}
+
+func (m *RevertFrame) GetSourceLength() uint64 {
+	if m != nil {
+		return m.SourceLength
+	}
+	return 0
+}
+
+func (*RevertFrame) XXX_MessageName() string {
+	return "rpcquery.RevertFrame"
+}
+func init() {
+	proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
+	golang_proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
+	proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
+	golang_proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
+	proto.RegisterType((*GetMetadataParam)(nil), "rpcquery.GetMetadataParam")
+	golang_proto.RegisterType((*GetMetadataParam)(nil), "rpcquery.GetMetadataParam")
+	proto.RegisterType((*MetadataResult)(nil), "rpcquery.MetadataResult")
+	golang_proto.RegisterType((*MetadataResult)(nil), "rpcquery.MetadataResult")
+	proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
+	golang_proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
+	proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
+	golang_proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
+	proto.RegisterType((*ListStorageParam)(nil), "rpcquery.ListStorageParam")
+	golang_proto.RegisterType((*ListStorageParam)(nil), "rpcquery.ListStorageParam")
+	proto.RegisterType((*StorageEntry)(nil), "rpcquery.StorageEntry")
+	golang_proto.RegisterType((*StorageEntry)(nil), "rpcquery.StorageEntry")
+	proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
+	golang_proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
+	proto.RegisterType((*GetContractParam)(nil), "rpcquery.GetContractParam")
+	golang_proto.RegisterType((*GetContractParam)(nil), "rpcquery.GetContractParam")
+	proto.RegisterType((*ListContractsParam)(nil), "rpcquery.ListContractsParam")
+	golang_proto.RegisterType((*ListContractsParam)(nil), "rpcquery.ListContractsParam")
+	proto.RegisterType((*ContractMetadata)(nil), "rpcquery.ContractMetadata")
+	golang_proto.RegisterType((*ContractMetadata)(nil), "rpcquery.ContractMetadata")
+	proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
+	golang_proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
+	proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
+	golang_proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
+	proto.RegisterType((*GetNetworkRegistryParam)(nil), "rpcquery.GetNetworkRegistryParam")
+	golang_proto.RegisterType((*GetNetworkRegistryParam)(nil), "rpcquery.GetNetworkRegistryParam")
+	proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
+	golang_proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
+	proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
+	golang_proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
+	proto.RegisterType((*NetworkRegistry)(nil), "rpcquery.NetworkRegistry")
+	golang_proto.RegisterType((*NetworkRegistry)(nil), "rpcquery.NetworkRegistry")
+	proto.RegisterType((*RegisteredValidator)(nil), "rpcquery.RegisteredValidator")
+	golang_proto.RegisterType((*RegisteredValidator)(nil), "rpcquery.RegisteredValidator")
+	proto.RegisterType((*ValidatorSetHistory)(nil), "rpcquery.ValidatorSetHistory")
+	golang_proto.RegisterType((*ValidatorSetHistory)(nil), "rpcquery.ValidatorSetHistory")
+	proto.RegisterType((*ValidatorSet)(nil), "rpcquery.ValidatorSet")
+	golang_proto.RegisterType((*ValidatorSet)(nil), "rpcquery.ValidatorSet")
+	proto.RegisterType((*GetProposalParam)(nil), "rpcquery.GetProposalParam")
+	golang_proto.RegisterType((*GetProposalParam)(nil), "rpcquery.GetProposalParam")
+	proto.RegisterType((*ListProposalsParam)(nil), "rpcquery.ListProposalsParam")
+	golang_proto.RegisterType((*ListProposalsParam)(nil), "rpcquery.ListProposalsParam")
+	proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
+	golang_proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
+	proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
+	golang_proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
+	proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
+	golang_proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
+	proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
+	golang_proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
+	proto.RegisterType((*GetAccountProofParam)(nil), "rpcquery.GetAccountProofParam")
+	golang_proto.RegisterType((*GetAccountProofParam)(nil), "rpcquery.GetAccountProofParam")
+	proto.RegisterType((*GetStorageProofParam)(nil), "rpcquery.GetStorageProofParam")
+	golang_proto.RegisterType((*GetStorageProofParam)(nil), "rpcquery.GetStorageProofParam")
+	proto.RegisterType((*GetNameProofParam)(nil), "rpcquery.GetNameProofParam")
+	golang_proto.RegisterType((*GetNameProofParam)(nil), "rpcquery.GetNameProofParam")
+	proto.RegisterType((*StateProof)(nil), "rpcquery.StateProof")
+	golang_proto.RegisterType((*StateProof)(nil), "rpcquery.StateProof")
+	proto.RegisterType((*GetMisbehaviourParam)(nil), "rpcquery.GetMisbehaviourParam")
+	golang_proto.RegisterType((*GetMisbehaviourParam)(nil), "rpcquery.GetMisbehaviourParam")
+	proto.RegisterType((*Misbehaviour)(nil), "rpcquery.Misbehaviour")
+	golang_proto.RegisterType((*Misbehaviour)(nil), "rpcquery.Misbehaviour")
+	proto.RegisterType((*GetGenesisParam)(nil), "rpcquery.GetGenesisParam")
+	golang_proto.RegisterType((*GetGenesisParam)(nil), "rpcquery.GetGenesisParam")
+	proto.RegisterType((*Genesis)(nil), "rpcquery.Genesis")
+	golang_proto.RegisterType((*Genesis)(nil), "rpcquery.Genesis")
+	proto.RegisterType((*ListStateChangesParam)(nil), "rpcquery.ListStateChangesParam")
+	golang_proto.RegisterType((*ListStateChangesParam)(nil), "rpcquery.ListStateChangesParam")
+	proto.RegisterType((*StateChange)(nil), "rpcquery.StateChange")
+	golang_proto.RegisterType((*StateChange)(nil), "rpcquery.StateChange")
+	proto.RegisterType((*AccountChange)(nil), "rpcquery.AccountChange")
+	golang_proto.RegisterType((*AccountChange)(nil), "rpcquery.AccountChange")
+	proto.RegisterType((*StorageChange)(nil), "rpcquery.StorageChange")
+	golang_proto.RegisterType((*StorageChange)(nil), "rpcquery.StorageChange")
+	proto.RegisterType((*NameChange)(nil), "rpcquery.NameChange")
+	golang_proto.RegisterType((*NameChange)(nil), "rpcquery.NameChange")
+	proto.RegisterType((*GetRevertTraceParam)(nil), "rpcquery.GetRevertTraceParam")
+	golang_proto.RegisterType((*GetRevertTraceParam)(nil), "rpcquery.GetRevertTraceParam")
+	proto.RegisterType((*RevertTrace)(nil), "rpcquery.RevertTrace")
+	golang_proto.RegisterType((*RevertTrace)(nil), "rpcquery.RevertTrace")
+	proto.RegisterType((*RevertFrame)(nil), "rpcquery.RevertFrame")
+	golang_proto.RegisterType((*RevertFrame)(nil), "rpcquery.RevertFrame")
+}
+
+func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
+func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
+
+var fileDescriptor_88e25d9b99e39f02 = []byte{
+	// 2008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5f, 0x6f, 0x1b, 0xc7,
+	0x11, 0xef, 0x91, 0x12, 0x25, 0x0d, 0x29, 0x4a, 0x5a, 0xcb, 0x32, 0x73, 0xb6, 0x69, 0x77, 0xd1,
+	0x3a, 0x82, 0xe1, 0x90, 0x8c, 0x1a, 0x15, 0x45, 0xfa, 0x10, 0x48, 0xac, 0x44, 0xd9, 0x96, 0x14,
+	0xe6, 0xe8, 0x2a, 0x68, 0x0b, 0x14, 0x38, 0xf1, 0xd6, 0xe4, 0x35, 0xe4, 0x1d, 0xbb, 0x5c, 0x3a,
+	0xe2, 0x4b, 0xbf, 0x43, 0x3f, 0x40, 0x9f, 0xfa, 0xd4, 0x87, 0xa2, 0xed, 0x43, 0xdf, 0x0b, 0x14,
+	0x28, 0xfc, 0xd8, 0xc7, 0x22, 0x28, 0x8c, 0xc2, 0xf9, 0x22, 0xc5, 0xfe, 0xbb, 0xdb, 0x3d, 0x52,
+	0x4e, 0x13, 0xc9, 0x45, 0x5e, 0x88, 0xdb, 0x99, 0xd9, 0x99, 0xdd, 0x99, 0xdd, 0xd9, 0xf9, 0x0d,
+	0xa1, 0x4c, 0x47, 0xdd, 0x5f, 0x4f, 0x08, 0x9d, 0xd6, 0x46, 0x34, 0x66, 0x31, 0x5a, 0xd6, 0x63,
+	0x77, 0xb3, 0x17, 0xf7, 0x62, 0x41, 0xac, 0xf3, 0x2f, 0xc9, 0x77, 0xef, 0x30, 0x12, 0x05, 0x84,
+	0x0e, 0xc3, 0x88, 0xd5, 0xd9, 0x74, 0x44, 0xc6, 0xf2, 0x57, 0x71, 0x8b, 0x91, 0x3f, 0x4c, 0x06,
+	0x2b, 0x7e, 0x77, 0xa8, 0x3e, 0x4b, 0x5d, 0x3a, 0x1d, 0x31, 0xad, 0x63, 0xed, 0x85, 0x3f, 0x08,
+	0x03, 0x9f, 0xc5, 0x54, 0x11, 0xca, 0x94, 0xf4, 0xc2, 0x31, 0xd3, 0x8b, 0x70, 0x57, 0xe8, 0xa8,
+	0xab, 0x3e, 0x57, 0x47, 0xfe, 0x74, 0x10, 0xfb, 0x81, 0x1e, 0x8e, 0x59, 0x4c, 0xfd, 0x1e, 0x51,
+	0x43, 0x20, 0x17, 0x44, 0x4b, 0x96, 0x08, 0xa5, 0x31, 0xd5, 0xc6, 0xd7, 0xd3, 0x75, 0x4a, 0x0a,
+	0x0e, 0xa1, 0xd8, 0x61, 0x3e, 0x9b, 0x8c, 0xdb, 0x3e, 0xf5, 0x87, 0x68, 0x1b, 0xd6, 0xf6, 0x07,
+	0x71, 0xf7, 0xb3, 0x67, 0xe1, 0x90, 0x7c, 0x1a, 0xb2, 0x7e, 0x18, 0x55, 0x9c, 0xfb, 0xce, 0xf6,
+	0x8a, 0x97, 0x25, 0xa3, 0x06, 0xdc, 0x10, 0xa4, 0x0e, 0x21, 0x91, 0x21, 0x9d, 0x13, 0xd2, 0xf3,
+	0x58, 0xd8, 0x87, 0xb5, 0x16, 0x61, 0x7b, 0xdd, 0x6e, 0x3c, 0x89, 0x98, 0x34, 0x77, 0x0a, 0x4b,
+	0x7b, 0x41, 0x40, 0xc9, 0x78, 0x2c, 0xcc, 0x94, 0xf6, 0x3f, 0x78, 0xf9, 0xea, 0xde, 0x77, 0xbe,
+	0x78, 0x75, 0xef, 0x51, 0x2f, 0x64, 0xfd, 0xc9, 0x79, 0xad, 0x1b, 0x0f, 0xeb, 0xfd, 0xe9, 0x88,
+	0xd0, 0x01, 0x09, 0x7a, 0x84, 0xd6, 0xcf, 0x27, 0x94, 0xc6, 0x9f, 0xd7, 0x95, 0xe3, 0xd4, 0x5c,
+	0x4f, 0x2b, 0xc1, 0x7f, 0x75, 0x60, 0xbd, 0x45, 0xd8, 0x09, 0x61, 0x7e, 0xe0, 0x33, 0x5f, 0x1a,
+	0x79, 0x92, 0x35, 0xd2, 0xf8, 0xc6, 0x06, 0xd0, 0x4f, 0xa1, 0xa4, 0x95, 0x1f, 0xf9, 0xe3, 0xbe,
+	0xd8, 0x6e, 0x69, 0xff, 0xfd, 0x2f, 0x5e, 0xdd, 0x7b, 0xef, 0xcd, 0x0a, 0xcf, 0xc3, 0xc8, 0xa7,
+	0xd3, 0xda, 0x11, 0xb9, 0xd8, 0x9f, 0x32, 0x32, 0xf6, 0x2c, 0x35, 0xf8, 0x11, 0x94, 0xf5, 0xd8,
+	0x23, 0xe3, 0xc9, 0x80, 0x21, 0x17, 0x96, 0x35, 0x45, 0x45, 0x20, 0x19, 0xe3, 0x3f, 0x38, 0xc2,
+	0x93, 0x1d, 0x19, 0xf4, 0xb7, 0xe2, 0x49, 0x74, 0x08, 0xf9, 0xa7, 0x64, 0x5a, 0xc9, 0x7d, 0x1d,
+	0x5d, 0x6a, 0x8f, 0x9f, 0xc6, 0x34, 0xd8, 0xd9, 0xfd, 0xa1, 0xc7, 0x15, 0xe0, 0x5f, 0x40, 0x49,
+	0xad, 0xf3, 0xcc, 0x1f, 0x4c, 0x08, 0x7a, 0x0a, 0x8b, 0xe2, 0x43, 0xad, 0x72, 0x57, 0x69, 0xfe,
+	0x9a, 0xde, 0x93, 0x3a, 0xf0, 0xbf, 0x1d, 0x58, 0x3f, 0x0e, 0xc7, 0x6f, 0xd7, 0x13, 0x5b, 0x50,
+	0x38, 0x22, 0x61, 0xaf, 0xcf, 0x84, 0x33, 0x16, 0x3c, 0x35, 0x42, 0x4f, 0x60, 0xb1, 0xc3, 0x7c,
+	0xca, 0x2a, 0xf9, 0x2b, 0xf8, 0x48, 0xaa, 0x40, 0x9b, 0xb0, 0x78, 0x1c, 0x0e, 0x43, 0x56, 0x59,
+	0x10, 0x26, 0xe4, 0x00, 0xff, 0xde, 0x49, 0x9c, 0x77, 0x10, 0x31, 0x3a, 0xd5, 0x41, 0x71, 0xae,
+	0x18, 0x94, 0x34, 0x08, 0xb9, 0x6b, 0x08, 0xc2, 0x1f, 0x1d, 0xd8, 0xe0, 0x41, 0x50, 0x17, 0x5b,
+	0x25, 0x92, 0x4d, 0x58, 0xfc, 0x84, 0x27, 0x4c, 0x75, 0x78, 0xe5, 0x00, 0xd5, 0x61, 0xa5, 0x3d,
+	0x39, 0x1f, 0x84, 0x5d, 0x7d, 0xb6, 0x8a, 0x3b, 0x1b, 0x35, 0xe5, 0xf8, 0x84, 0xe1, 0xa5, 0x32,
+	0xe8, 0x13, 0x58, 0x6e, 0xc6, 0x01, 0x11, 0x77, 0x2d, 0x7f, 0x95, 0xc5, 0x26, 0x6a, 0xf0, 0xb9,
+	0x48, 0x11, 0xcd, 0x38, 0x62, 0xd4, 0xef, 0xbe, 0xa5, 0x3c, 0xf4, 0x23, 0x40, 0xdc, 0x25, 0xda,
+	0x88, 0xf2, 0x09, 0x86, 0x92, 0xa6, 0x9c, 0xfa, 0x43, 0xa2, 0x5c, 0x63, 0xd1, 0xf0, 0x9f, 0xf3,
+	0xb0, 0xae, 0x09, 0xfa, 0xc2, 0x5f, 0xfb, 0x91, 0x36, 0xbd, 0x9a, 0xbb, 0x16, 0xaf, 0xa2, 0x9f,
+	0x65, 0x12, 0xe3, 0x95, 0x82, 0x65, 0xa9, 0x9a, 0x71, 0xdb, 0xc2, 0xac, 0xdb, 0x50, 0x15, 0xa0,
+	0x13, 0x4f, 0x68, 0x97, 0x1c, 0x86, 0x03, 0x52, 0x59, 0x14, 0x12, 0x06, 0x25, 0xe5, 0x8b, 0xc5,
+	0x15, 0x4c, 0xbe, 0xb0, 0xb1, 0x0d, 0x6b, 0xcd, 0x78, 0x38, 0x0a, 0x07, 0x84, 0x9e, 0x11, 0x3a,
+	0x0e, 0xe3, 0xa8, 0xb2, 0x24, 0xdf, 0xbd, 0x0c, 0x19, 0xad, 0x43, 0x7e, 0xef, 0x3c, 0xac, 0x2c,
+	0x0b, 0x2e, 0xff, 0xc4, 0x4f, 0xa0, 0xd4, 0x22, 0x62, 0x19, 0x32, 0xcc, 0x08, 0x16, 0x8c, 0xf0,
+	0x8a, 0x6f, 0xf4, 0x00, 0xca, 0x8f, 0xa3, 0xee, 0x60, 0x12, 0x90, 0x83, 0x8b, 0x51, 0x48, 0x49,
+	0x20, 0xfc, 0xbe, 0xec, 0x65, 0xa8, 0xf8, 0x14, 0xca, 0xfc, 0xe0, 0xf0, 0x39, 0x6f, 0xbc, 0x48,
+	0xff, 0xab, 0xbe, 0x77, 0xe0, 0x16, 0x5f, 0x1b, 0x61, 0x9f, 0xc7, 0xf4, 0x33, 0x4f, 0xd5, 0x13,
+	0x42, 0x31, 0xde, 0x82, 0xcd, 0x16, 0x61, 0x67, 0xba, 0xe8, 0xe8, 0x10, 0x79, 0x17, 0x70, 0x0b,
+	0x6e, 0x67, 0xe8, 0x47, 0xe1, 0x98, 0xc5, 0x6a, 0x1a, 0xf7, 0x94, 0xb2, 0xd1, 0xa6, 0xe4, 0x45,
+	0x18, 0x4f, 0xe4, 0x99, 0xcc, 0x7b, 0x59, 0x32, 0xde, 0x87, 0xb5, 0x8c, 0x61, 0x54, 0x87, 0x7c,
+	0x87, 0xb0, 0x8a, 0x73, 0x3f, 0xbf, 0x5d, 0xdc, 0xb9, 0x5b, 0x4b, 0xaa, 0x2c, 0x29, 0x40, 0x28,
+	0x09, 0x12, 0xbb, 0x1e, 0x97, 0xc4, 0xbf, 0x75, 0xe0, 0xc6, 0x1c, 0xe6, 0xb5, 0xdf, 0x88, 0x87,
+	0xb0, 0x70, 0x1a, 0x07, 0x44, 0xe5, 0xa4, 0xad, 0x5a, 0x52, 0x7a, 0x71, 0xea, 0xe3, 0x80, 0x44,
+	0x2c, 0x64, 0x53, 0x4f, 0xc8, 0xe0, 0x16, 0xdc, 0x98, 0xe3, 0x1d, 0xd4, 0x80, 0x25, 0xf5, 0xa9,
+	0xf6, 0xb7, 0x95, 0xee, 0xcf, 0x94, 0xf7, 0xb4, 0x18, 0x3e, 0x85, 0x92, 0xc9, 0xe0, 0x2f, 0x4d,
+	0x5f, 0xbe, 0x34, 0x8e, 0x7c, 0x69, 0xe4, 0x08, 0x3d, 0x90, 0x5e, 0xcb, 0x09, 0xad, 0x9b, 0xb5,
+	0xb4, 0x4e, 0xcc, 0x38, 0xeb, 0x81, 0xc8, 0x6c, 0x6d, 0x1a, 0x8f, 0xe2, 0xb1, 0x3f, 0x48, 0x0e,
+	0xa3, 0x38, 0xf2, 0xc2, 0x4b, 0x9e, 0xf8, 0xc6, 0x0d, 0x99, 0x9d, 0xb4, 0xa0, 0x3a, 0x68, 0x2e,
+	0x2c, 0x4b, 0x0a, 0x09, 0x84, 0xf4, 0xb2, 0x97, 0x8c, 0xf1, 0x09, 0x94, 0xb5, 0xb4, 0xaa, 0x4f,
+	0xe6, 0xe8, 0x45, 0xef, 0x42, 0x61, 0xdf, 0x1f, 0x0c, 0x62, 0xa6, 0xdc, 0xb8, 0x56, 0xd3, 0x65,
+	0xaa, 0x24, 0x7b, 0x8a, 0x8d, 0xd7, 0x60, 0x55, 0xd4, 0x2f, 0xbe, 0xca, 0x8c, 0x98, 0x88, 0xb7,
+	0x94, 0xf1, 0x38, 0xac, 0xeb, 0x77, 0x84, 0x57, 0x8d, 0x3c, 0xbd, 0x28, 0x67, 0xcc, 0xd0, 0x79,
+	0x05, 0x6a, 0xd2, 0xe2, 0x09, 0x6b, 0xea, 0x10, 0x2e, 0x78, 0xf3, 0x58, 0xf8, 0x5d, 0x61, 0x57,
+	0xd4, 0xa6, 0x72, 0xcf, 0xe9, 0xdb, 0xee, 0x98, 0x6f, 0x3b, 0xfe, 0x8d, 0xb8, 0x1b, 0xba, 0x54,
+	0xa5, 0x71, 0xfc, 0xfc, 0xff, 0x5a, 0x5b, 0xe0, 0x7f, 0x38, 0x62, 0x01, 0xba, 0xae, 0x79, 0x7b,
+	0x0b, 0xb8, 0xa6, 0x32, 0xcf, 0xd8, 0x48, 0xde, 0xda, 0xc8, 0x47, 0xb0, 0xa1, 0x73, 0x63, 0xba,
+	0x89, 0x79, 0x09, 0xf2, 0x32, 0x4f, 0xb4, 0x01, 0xf8, 0xc9, 0x90, 0xd3, 0x2f, 0x8b, 0x17, 0x7a,
+	0x08, 0x8b, 0x42, 0x40, 0x1d, 0xbc, 0xcd, 0x9a, 0x06, 0x44, 0x87, 0x31, 0x25, 0x63, 0x19, 0x41,
+	0x4f, 0x8a, 0xe0, 0x33, 0xe1, 0xda, 0x93, 0x70, 0x7c, 0x4e, 0xfa, 0x3e, 0xcf, 0x54, 0x54, 0xae,
+	0xea, 0xbe, 0x40, 0x42, 0x94, 0x59, 0x06, 0x4c, 0x12, 0xba, 0x03, 0x2b, 0x07, 0x51, 0x60, 0x2d,
+	0x33, 0x25, 0xe0, 0xdf, 0x39, 0x50, 0x32, 0xb5, 0xa2, 0x47, 0xb0, 0xd2, 0x8c, 0x87, 0xc3, 0x90,
+	0x31, 0x71, 0xa3, 0xf8, 0xe5, 0x2d, 0xd7, 0x04, 0x34, 0x3b, 0x78, 0x11, 0x06, 0x24, 0xea, 0x12,
+	0x2f, 0x15, 0x40, 0xdb, 0xb0, 0xd4, 0x26, 0x51, 0x10, 0x46, 0xbd, 0x4a, 0x6e, 0xae, 0xac, 0x66,
+	0xa3, 0x5d, 0x80, 0x36, 0x21, 0xf4, 0x40, 0x00, 0xbb, 0x4a, 0x5e, 0x08, 0xdf, 0xac, 0x19, 0xc8,
+	0x2e, 0xe1, 0x7a, 0x86, 0x20, 0xde, 0x10, 0xa0, 0xa1, 0x45, 0x22, 0x32, 0x0e, 0xd5, 0xb5, 0xbb,
+	0x0b, 0x4b, 0x6a, 0xcc, 0x63, 0xf2, 0xa4, 0xf3, 0xf1, 0xa9, 0xbe, 0xcf, 0xfc, 0x1b, 0x77, 0xe0,
+	0xa6, 0xac, 0xae, 0x7d, 0x46, 0x9a, 0x7d, 0x3f, 0xea, 0xe9, 0x37, 0xa9, 0x0a, 0x70, 0x48, 0xe3,
+	0xa1, 0xe5, 0x29, 0x83, 0xc2, 0x53, 0xc9, 0xb3, 0xd8, 0xf2, 0x53, 0x32, 0xe6, 0x6e, 0x2a, 0x1a,
+	0x1a, 0xd1, 0xfb, 0xb0, 0xa4, 0xee, 0x99, 0x50, 0x54, 0xdc, 0xb9, 0x95, 0xa6, 0x4d, 0xc5, 0x90,
+	0x92, 0x9e, 0x96, 0xe3, 0x53, 0xd4, 0xcd, 0xa8, 0xe4, 0xb2, 0x53, 0x14, 0x43, 0x4f, 0x51, 0x43,
+	0xb4, 0xad, 0x8e, 0x5c, 0x5e, 0x9d, 0x8f, 0x44, 0x9e, 0x53, 0x95, 0xb0, 0x90, 0xe0, 0xe0, 0x6a,
+	0xd5, 0xb2, 0x7b, 0xed, 0x77, 0xee, 0x7b, 0x50, 0xd8, 0x27, 0xcf, 0x63, 0xaa, 0x57, 0x5f, 0xaa,
+	0xf1, 0x96, 0x80, 0xb2, 0xe9, 0x29, 0x1e, 0xc2, 0xb0, 0xb8, 0xf7, 0x9c, 0x11, 0x5a, 0xc9, 0xcf,
+	0x11, 0x92, 0x2c, 0xfc, 0xf7, 0x1c, 0xac, 0x5a, 0x1b, 0xfe, 0xd6, 0xe6, 0x87, 0x93, 0x64, 0xcf,
+	0x57, 0x2a, 0x0c, 0xb5, 0x73, 0x9e, 0x6a, 0xe7, 0x2c, 0x5c, 0x09, 0xc0, 0x48, 0x2f, 0xfe, 0x0a,
+	0x20, 0x3d, 0x05, 0x73, 0x93, 0xd3, 0x6c, 0xc4, 0x64, 0x47, 0x47, 0xa0, 0xb2, 0xcb, 0x23, 0x66,
+	0x0a, 0x29, 0x5b, 0x01, 0xdc, 0x68, 0x11, 0xe6, 0x91, 0x17, 0x84, 0xb2, 0x67, 0xd4, 0xef, 0xaa,
+	0x92, 0xf1, 0x04, 0x0a, 0xcf, 0x2e, 0xd2, 0xf7, 0xf4, 0x1b, 0xbb, 0x47, 0x2a, 0xc1, 0x43, 0x28,
+	0x1a, 0x26, 0x38, 0xea, 0x3a, 0xb8, 0xe8, 0x92, 0x11, 0xe3, 0x65, 0xad, 0xa3, 0x50, 0x97, 0xea,
+	0x0b, 0x25, 0x0c, 0x2f, 0x95, 0x41, 0xef, 0x41, 0xe1, 0x90, 0xf2, 0xc5, 0xab, 0x54, 0x74, 0xd3,
+	0xac, 0xd4, 0xb8, 0x5e, 0xc1, 0xf5, 0x94, 0x10, 0xfe, 0x53, 0x4e, 0xdb, 0x13, 0x04, 0x74, 0x06,
+	0x45, 0xfe, 0xdc, 0x5e, 0xc7, 0x41, 0x34, 0x15, 0xf1, 0xec, 0xdf, 0xa4, 0xc4, 0x67, 0x44, 0x15,
+	0xbb, 0x6a, 0x84, 0xca, 0x90, 0x6b, 0x37, 0xd5, 0xc3, 0x93, 0x6b, 0x37, 0xb9, 0xdc, 0xc7, 0x23,
+	0x3e, 0x51, 0x41, 0x05, 0x35, 0x9a, 0x01, 0x12, 0x8b, 0x5f, 0x09, 0x24, 0x0a, 0x33, 0x40, 0x82,
+	0xbf, 0x12, 0x62, 0x24, 0xb1, 0xff, 0x92, 0x7a, 0x25, 0x52, 0x12, 0xb7, 0x22, 0x87, 0xc7, 0x24,
+	0xea, 0xb1, 0xbe, 0x40, 0x0a, 0x0b, 0x9e, 0x45, 0xdb, 0xf9, 0xcb, 0xaa, 0xaa, 0xea, 0xd1, 0x0e,
+	0x14, 0x64, 0xff, 0x0d, 0xdd, 0x34, 0x93, 0x58, 0xd2, 0x91, 0x73, 0x37, 0x38, 0xb9, 0x26, 0xab,
+	0x2e, 0x25, 0xb9, 0x0b, 0x90, 0x56, 0x27, 0xe8, 0x9d, 0x74, 0x5e, 0xa6, 0xbd, 0xe6, 0x5a, 0x49,
+	0x03, 0x35, 0xa1, 0x68, 0xf4, 0xc6, 0x90, 0x6b, 0xcd, 0xb3, 0x5a, 0x66, 0x6e, 0x25, 0xe5, 0x65,
+	0xfa, 0x52, 0x1f, 0x09, 0xdb, 0x3a, 0xad, 0xda, 0xb6, 0xcd, 0x36, 0x8c, 0xbb, 0x35, 0x93, 0x93,
+	0x65, 0x03, 0xa8, 0x09, 0x45, 0xa3, 0x65, 0x63, 0xae, 0x22, 0xdb, 0xc9, 0x99, 0xa3, 0x42, 0xdc,
+	0xa5, 0x86, 0x83, 0x7e, 0x0c, 0x25, 0xb3, 0xe5, 0x80, 0x6e, 0xdb, 0x5a, 0xac, 0x56, 0x84, 0xed,
+	0x85, 0x86, 0x83, 0x0e, 0x84, 0x1f, 0x74, 0xd4, 0x33, 0x7e, 0xb0, 0xfa, 0x02, 0xae, 0xc1, 0x9b,
+	0x01, 0xe5, 0x4f, 0x61, 0xd5, 0xc2, 0xf8, 0xe8, 0x8e, 0xbd, 0x08, 0x1b, 0xfc, 0xbf, 0x49, 0x55,
+	0xc3, 0x41, 0x75, 0xfe, 0x12, 0xcb, 0x13, 0xb8, 0x65, 0xad, 0x27, 0x81, 0x95, 0xae, 0x95, 0x4f,
+	0xd0, 0x2e, 0xac, 0x24, 0x40, 0x11, 0x55, 0x6c, 0xcb, 0x29, 0x7a, 0xb4, 0x27, 0x35, 0x1c, 0xe4,
+	0x01, 0x9a, 0xc5, 0x83, 0xe8, 0xbb, 0xb6, 0xc9, 0x39, 0x68, 0xd1, 0x35, 0x22, 0x9d, 0x9d, 0xfd,
+	0x58, 0x14, 0x16, 0x16, 0x92, 0xa9, 0x5a, 0x0a, 0x67, 0x30, 0xa6, 0x7b, 0x09, 0x34, 0x42, 0xbf,
+	0x84, 0xad, 0xf9, 0xd8, 0x13, 0x7d, 0xff, 0x52, 0x8d, 0x26, 0x3a, 0x75, 0xef, 0xce, 0x57, 0xac,
+	0xb5, 0x7c, 0x28, 0x42, 0xaf, 0xa1, 0x4c, 0x26, 0xf4, 0x16, 0x70, 0x72, 0xb3, 0xe0, 0x05, 0x3d,
+	0x96, 0xf1, 0xd6, 0x52, 0x33, 0xf1, 0xb6, 0xe1, 0x94, 0x79, 0x85, 0x6c, 0xe8, 0xd4, 0x70, 0xd0,
+	0x07, 0xb0, 0xac, 0xf1, 0x0f, 0xba, 0x95, 0xb9, 0x42, 0x1a, 0x13, 0xb9, 0x6b, 0x76, 0x3e, 0x18,
+	0xa3, 0x26, 0x94, 0x35, 0x7a, 0x39, 0x22, 0x7e, 0x40, 0x68, 0x66, 0x6e, 0x8a, 0x6b, 0xdc, 0x8a,
+	0x59, 0x0e, 0xca, 0xbf, 0x22, 0xd4, 0x94, 0x43, 0x01, 0x81, 0x8e, 0x79, 0x29, 0x26, 0xe4, 0x2f,
+	0xd7, 0x71, 0x67, 0x56, 0x87, 0x31, 0xad, 0x65, 0x35, 0xf3, 0x45, 0x71, 0x5e, 0x9d, 0x9b, 0x88,
+	0x92, 0xb2, 0xdf, 0xdd, 0xb4, 0x37, 0xa4, 0x4a, 0xfa, 0x96, 0xd5, 0xcb, 0x9e, 0xa3, 0x68, 0x06,
+	0x04, 0x5d, 0xa2, 0x68, 0x2f, 0x6d, 0xc3, 0x88, 0xf1, 0xed, 0xd9, 0x7b, 0xf4, 0x55, 0x2a, 0xe4,
+	0x49, 0xb6, 0x8a, 0x78, 0x7b, 0x2d, 0x33, 0xa8, 0xc1, 0x3c, 0xc9, 0xd6, 0xbc, 0x0f, 0x45, 0x9e,
+	0xd4, 0xd5, 0xb5, 0x9d, 0x27, 0xcd, 0x1a, 0xdc, 0xdd, 0x30, 0x59, 0x52, 0xfa, 0x58, 0x77, 0xb5,
+	0xd3, 0xba, 0x1b, 0xdd, 0xcb, 0xe6, 0xc9, 0x4c, 0x4d, 0xee, 0x66, 0x9e, 0x0f, 0xc5, 0x6c, 0x38,
+	0xe8, 0x50, 0x1c, 0x1b, 0xb3, 0x1e, 0xb8, 0x6b, 0xad, 0x26, 0x5b, 0x8c, 0xb8, 0x33, 0xaf, 0xbd,
+	0xe0, 0xed, 0xff, 0xe4, 0xe5, 0xeb, 0xaa, 0xf3, 0xcf, 0xd7, 0x55, 0xe7, 0x5f, 0xaf, 0xab, 0xce,
+	0x7f, 0x5e, 0x57, 0x9d, 0xbf, 0x7d, 0x59, 0x75, 0x5e, 0x7e, 0x59, 0x75, 0x7e, 0xfe, 0xf0, 0xcd,
+	0x4f, 0x3a, 0x1d, 0x75, 0xeb, 0x5a, 0xe3, 0x79, 0x41, 0xfc, 0xed, 0xf4, 0x83, 0xff, 0x0e, 0x00,
+	0x96, 0xea, 0xea, 0x3f, 0x62, 0x1b, 0x00, 0x00,
+}
+
+func (m *StatusParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1911,12 +2500,12 @@ func (m *GetStatsParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetStatsParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatusParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetStatsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StatusParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1925,10 +2514,24 @@ func (m *GetStatsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BlockSeenTimeWithin) > 0 {
+		i -= len(m.BlockSeenTimeWithin)
+		copy(dAtA[i:], m.BlockSeenTimeWithin)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.BlockSeenTimeWithin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockTimeWithin) > 0 {
+		i -= len(m.BlockTimeWithin)
+		copy(dAtA[i:], m.BlockTimeWithin)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.BlockTimeWithin)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Stats) Marshal() (dAtA []byte, err error) {
+func (m *GetAccountParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1938,12 +2541,12 @@ func (m *Stats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAccountParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetAccountParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1952,20 +2555,20 @@ func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AccountsWithoutCode != 0 {
-		i = encodeVarintRpcquery(dAtA, i, uint64(m.AccountsWithoutCode))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AccountsWithCode != 0 {
-		i = encodeVarintRpcquery(dAtA, i, uint64(m.AccountsWithCode))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockParam) Marshal() (dAtA []byte, err error) {
+func (m *GetMetadataParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1975,12 +2578,12 @@ func (m *GetBlockParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMetadataParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetMetadataParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1989,390 +2592,5100 @@ func (m *GetBlockParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Height != 0 {
-		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+	if m.MetadataHash != nil {
+		{
+			size := m.MetadataHash.Size()
+			i -= size
+			if _, err := m.MetadataHash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintRpcquery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRpcquery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.Address != nil {
+		{
+			size := m.Address.Size()
+			i -= size
+			if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *StatusParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockTimeWithin)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	l = len(m.BlockSeenTimeWithin)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *MetadataResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetAccountParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovRpcquery(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *MetadataResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetadataParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MetadataResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Address != nil {
-		l = m.Address.Size()
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.MetadataHash != nil {
-		l = m.MetadataHash.Size()
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MetadataResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetStorageParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetStorageParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovRpcquery(uint64(l))
-	l = m.Key.Size()
-	n += 1 + l + sovRpcquery(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *GetStorageParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StorageValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetStorageParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovRpcquery(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ListAccountsParam) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
+	{
+		size := m.Key.Size()
+		i -= size
+		if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetNameParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StorageValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListNamesParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *StorageValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetNetworkRegistryParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StorageValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetValidatorSetParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListStorageParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetValidatorSetHistoryParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListStorageParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStorageParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IncludePrevious != 0 {
-		n += 1 + sovRpcquery(uint64(m.IncludePrevious))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *NetworkRegistry) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Limit != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if len(m.Set) > 0 {
-		for _, e := range m.Set {
-			l = e.Size()
-			n += 1 + l + sovRpcquery(uint64(l))
+	{
+		size := m.Start.Size()
+		i -= size
+		if _, err := m.Start.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovRpcquery(uint64(l))
-	if m.Node != nil {
-		l = m.Node.Size()
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StorageEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValidatorSetHistory) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StorageEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.History) > 0 {
-		for _, e := range m.History {
-			l = e.Size()
-			n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Key.Size()
+		i -= size
+		if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAccountsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountsParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAccountsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRpcquery(uint64(m.Height))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Set) > 0 {
-		for _, e := range m.Set {
-			l = e.Size()
-			n += 1 + l + sovRpcquery(uint64(l))
+	{
+		size := m.CodeHash.Size()
+		i -= size
+		if _, err := m.CodeHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x1a
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetProposalParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetContractParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListProposalsParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetContractParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetContractParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Proposed {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ProposalResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListContractsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListContractsParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListContractsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
-	if m.Ballot != nil {
-		l = m.Ballot.Size()
-		n += 1 + l + sovRpcquery(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetStatsParam) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceFile) > 0 {
+		i -= len(m.SourceFile)
+		copy(dAtA[i:], m.SourceFile)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.SourceFile)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MetadataHash.Size()
+		i -= size
+		if _, err := m.MetadataHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CodeHash.Size()
+		i -= size
+		if _, err := m.CodeHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Stats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetNameParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNameParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNameParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AccountsWithCode != 0 {
-		n += 1 + sovRpcquery(uint64(m.AccountsWithCode))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AccountsWithoutCode != 0 {
-		n += 1 + sovRpcquery(uint64(m.AccountsWithoutCode))
+	if m.IncludeExpired {
+		i--
+		if m.IncludeExpired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListNamesParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNamesParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListNamesParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.IncludeExpired {
+		i--
+		if m.IncludeExpired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockParam) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetNetworkRegistryParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNetworkRegistryParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNetworkRegistryParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRpcquery(uint64(m.Height))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorSetParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorSetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorSetHistoryParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetHistoryParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorSetHistoryParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludePrevious != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.IncludePrevious))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Set[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Set[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProposalParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProposalParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProposalParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProposalsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProposalsParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProposalsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Proposed {
+		i--
+		if m.Proposed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ballot != nil {
+		{
+			size, err := m.Ballot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStatsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStatsParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStatsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AccountsWithoutCode != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.AccountsWithoutCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AccountsWithCode != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.AccountsWithCode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountProofParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountProofParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountProofParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStorageProofParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStorageProofParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStorageProofParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Key.Size()
+		i -= size
+		if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNameProofParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNameProofParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNameProofParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMisbehaviourParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMisbehaviourParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMisbehaviourParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Misbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PeerErrors) > 0 {
+		for iNdEx := len(m.PeerErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeerErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Committed) > 0 {
+		for iNdEx := len(m.Committed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Committed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGenesisParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGenesisParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGenesisParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Genesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Genesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Genesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JSON) > 0 {
+		i -= len(m.JSON)
+		copy(dAtA[i:], m.JSON)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.JSON)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListStateChangesParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStateChangesParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStateChangesParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Name != nil {
+		{
+			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Storage != nil {
+		{
+			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.After.Size()
+		i -= size
+		if _, err := m.After.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Before.Size()
+		i -= size
+		if _, err := m.Before.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Key.Size()
+		i -= size
+		if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NameChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRevertTraceParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRevertTraceParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRevertTraceParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RevertTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Exception != nil {
+		{
+			size, err := m.Exception.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevertFrame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertFrame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertFrame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SourceLength != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.SourceLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SourceStart != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.SourceStart))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SourceFile) > 0 {
+		i -= len(m.SourceFile)
+		copy(dAtA[i:], m.SourceFile)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.SourceFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OpCode) > 0 {
+		i -= len(m.OpCode)
+		copy(dAtA[i:], m.OpCode)
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.OpCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PC != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.PC))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Create {
+		i--
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.CodeAddress.Size()
+		i -= size
+		if _, err := m.CodeAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcquery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcquery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StatusParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockTimeWithin)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.BlockSeenTimeWithin)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAccountParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMetadataParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.MetadataHash != nil {
+		l = m.MetadataHash.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStorageParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListStorageParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	l = m.Start.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAccountsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = m.CodeHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetContractParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListContractsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.CodeHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.MetadataHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.SourceFile)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetNameParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.IncludeExpired {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListNamesParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.IncludeExpired {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetNetworkRegistryParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorSetParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorSetHistoryParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludePrevious != 0 {
+		n += 1 + sovRpcquery(uint64(m.IncludePrevious))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NetworkRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegisteredValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Node != nil {
+		l = m.Node.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorSetHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProposalParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListProposalsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposalResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Ballot != nil {
+		l = m.Ballot.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStatsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Stats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountsWithCode != 0 {
+		n += 1 + sovRpcquery(uint64(m.AccountsWithCode))
+	}
+	if m.AccountsWithoutCode != 0 {
+		n += 1 + sovRpcquery(uint64(m.AccountsWithoutCode))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetBlockParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAccountProofParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStorageProofParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetNameProofParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMisbehaviourParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovRpcquery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRpcquery(uint64(m.EndHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Misbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committed) > 0 {
+		for _, e := range m.Committed {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if len(m.PeerErrors) > 0 {
+		for _, e := range m.PeerErrors {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGenesisParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JSON)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListStateChangesParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovRpcquery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovRpcquery(uint64(m.ToHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Storage != nil {
+		l = m.Storage.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Before.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NameChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRevertTraceParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevertTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, e := range m.Frames {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevertFrame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeAddress.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Create {
+		n += 2
+	}
+	if m.PC != 0 {
+		n += 1 + sovRpcquery(uint64(m.PC))
+	}
+	l = len(m.OpCode)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.SourceFile)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.SourceStart != 0 {
+		n += 1 + sovRpcquery(uint64(m.SourceStart))
+	}
+	if m.SourceLength != 0 {
+		n += 1 + sovRpcquery(uint64(m.SourceLength))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcquery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcquery(x uint64) (n int) {
+	return sovRpcquery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StatusParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeWithin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockTimeWithin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSeenTimeWithin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockSeenTimeWithin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAccountParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAccountParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMetadataParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_binary.HexBytes
+			m.MetadataHash = &v
+			if err := m.MetadataHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStorageParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStorageParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStorageParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStorageParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStorageParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAccountsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAccountsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &crypto.PublicKey{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContractParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContractParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContractParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListContractsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListContractsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListContractsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetadataHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNameParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNameParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNameParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeExpired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeExpired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListNamesParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListNamesParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeExpired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeExpired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNetworkRegistryParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNetworkRegistryParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNetworkRegistryParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorSetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetHistoryParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetHistoryParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrevious", wireType)
+			}
+			m.IncludePrevious = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncludePrevious |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkRegistry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkRegistry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = append(m.Set, &RegisteredValidator{})
+			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &registry.NodeIdentity{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &ValidatorSet{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = append(m.Set, &validator.Validator{})
+			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProposalParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProposalParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *ListProposalsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProposalsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProposalsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Proposed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovRpcquery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRpcquery(x uint64) (n int) {
-	return sovRpcquery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StatusParam) Unmarshal(dAtA []byte) error {
+func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2395,17 +7708,17 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeWithin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -2415,29 +7728,31 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockTimeWithin = string(dAtA[iNdEx:postIndex])
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSeenTimeWithin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballot", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -2447,24 +7762,168 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockSeenTimeWithin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if m.Ballot == nil {
+				m.Ballot = &payload.Ballot{}
+			}
+			if err := m.Ballot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStatsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStatsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStatsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountsWithCode", wireType)
+			}
+			m.AccountsWithCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountsWithCode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountsWithoutCode", wireType)
+			}
+			m.AccountsWithoutCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountsWithoutCode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -2487,7 +7946,7 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
+func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2510,17 +7969,17 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAccountParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBlockParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAccountParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBlockParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -2530,25 +7989,11 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -2571,7 +8016,7 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataParam) Unmarshal(dAtA []byte) error {
+func (m *GetAccountProofParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2594,10 +8039,10 @@ func (m *GetMetadataParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAccountProofParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAccountProofParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2629,17 +8074,15 @@ func (m *GetMetadataParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_hyperledger_burrow_crypto.Address
-			m.Address = &v
 			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -2649,27 +8092,11 @@ func (m *GetMetadataParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_hyperledger_burrow_binary.HexBytes
-			m.MetadataHash = &v
-			if err := m.MetadataHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -2692,7 +8119,7 @@ func (m *GetMetadataParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataResult) Unmarshal(dAtA []byte) error {
+func (m *GetStorageProofParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2715,17 +8142,17 @@ func (m *MetadataResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStorageProofParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStorageProofParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -2735,78 +8162,28 @@ func (m *MetadataResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcquery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcquery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetStorageParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStorageParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2833,15 +8210,15 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -2851,25 +8228,11 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -2892,7 +8255,7 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageValue) Unmarshal(dAtA []byte) error {
+func (m *GetNameProofParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2915,17 +8278,17 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNameProofParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNameProofParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -2935,25 +8298,43 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -2976,7 +8357,7 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
+func (m *StateProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2999,17 +8380,36 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAccountsParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAccountsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3019,23 +8419,27 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			if m.Proof == nil {
+				m.Proof = &storage.ForestProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3059,7 +8463,7 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNameParam) Unmarshal(dAtA []byte) error {
+func (m *GetMisbehaviourParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3082,17 +8486,17 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNameParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMisbehaviourParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNameParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMisbehaviourParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3102,24 +8506,30 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcquery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -3142,7 +8552,7 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
+func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3165,17 +8575,17 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListNamesParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListNamesParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3185,75 +8595,94 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcquery(dAtA[iNdEx:])
-			if err != nil {
+			m.Committed = append(m.Committed, &exec.Evidence{})
+			if err := m.Committed[len(m.Committed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetNetworkRegistryParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcquery
+			m.Pending = append(m.Pending, &exec.Evidence{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PeerErrors = append(m.PeerErrors, &tendermint.PeerError{})
+			if err := m.PeerErrors[len(m.PeerErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetNetworkRegistryParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNetworkRegistryParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -3276,7 +8705,7 @@ func (m *GetNetworkRegistryParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorSetParam) Unmarshal(dAtA []byte) error {
+func (m *GetGenesisParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3299,10 +8728,10 @@ func (m *GetValidatorSetParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorSetParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGenesisParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorSetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGenesisParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3327,7 +8756,7 @@ func (m *GetValidatorSetParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
+func (m *Genesis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3350,17 +8779,17 @@ func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorSetHistoryParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: Genesis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorSetHistoryParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Genesis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrevious", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSON", wireType)
 			}
-			m.IncludePrevious = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3370,11 +8799,26 @@ func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IncludePrevious |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSON = append(m.JSON[:0], dAtA[iNdEx:postIndex]...)
+			if m.JSON == nil {
+				m.JSON = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -3397,7 +8841,7 @@ func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkRegistry) Unmarshal(dAtA []byte) error {
+func (m *ListStateChangesParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3420,17 +8864,17 @@ func (m *NetworkRegistry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkRegistry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListStateChangesParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListStateChangesParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			var msglen int
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3440,26 +8884,30 @@ func (m *NetworkRegistry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			m.Set = append(m.Set, &RegisteredValidator{})
-			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -3482,7 +8930,7 @@ func (m *NetworkRegistry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredValidator) Unmarshal(dAtA []byte) error {
+func (m *StateChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3505,17 +8953,17 @@ func (m *RegisteredValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3525,28 +8973,31 @@ func (m *RegisteredValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Account == nil {
+				m.Account = &AccountChange{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3569,71 +9020,20 @@ func (m *RegisteredValidator) Unmarshal(dAtA []byte) error {
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Node == nil {
-				m.Node = &registry.NodeIdentity{}
-			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcquery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpcquery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcquery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSetHistory: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSetHistory: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage == nil {
+				m.Storage = &StorageChange{}
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3660,8 +9060,10 @@ func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, &ValidatorSet{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Name == nil {
+				m.Name = &NameChange{}
+			}
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3687,7 +9089,7 @@ func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *AccountChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3710,17 +9112,17 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3730,14 +9132,28 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3764,8 +9180,46 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Set = append(m.Set, &validator.Validator{})
-			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Before == nil {
+				m.Before = &acm.Account{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &acm.Account{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3791,7 +9245,7 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
+func (m *StorageChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3814,15 +9268,15 @@ func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetProposalParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProposalParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3849,67 +9303,81 @@ func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcquery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListProposalsParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcquery
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListProposalsParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProposalsParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3919,12 +9387,25 @@ func (m *ListProposalsParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Proposed = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -3947,7 +9428,7 @@ func (m *ListProposalsParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposalResult) Unmarshal(dAtA []byte) error {
+func (m *NameChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3970,17 +9451,17 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: NameChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NameChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -3990,29 +9471,63 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcquery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ballot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &names.Entry{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4039,10 +9554,10 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ballot == nil {
-				m.Ballot = &payload.Ballot{}
+			if m.After == nil {
+				m.After = &names.Entry{}
 			}
-			if err := m.Ballot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4068,7 +9583,7 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStatsParam) Unmarshal(dAtA []byte) error {
+func (m *GetRevertTraceParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4091,12 +9606,45 @@ func (m *GetStatsParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStatsParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRevertTraceParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStatsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRevertTraceParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -4119,7 +9667,7 @@ func (m *GetStatsParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Stats) Unmarshal(dAtA []byte) error {
+func (m *RevertTrace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4142,17 +9690,17 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevertTrace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevertTrace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountsWithCode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
 			}
-			m.AccountsWithCode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -4162,16 +9710,33 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountsWithCode |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountsWithoutCode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			m.AccountsWithoutCode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -4181,11 +9746,26 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountsWithoutCode |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frames = append(m.Frames, &RevertFrame{})
+			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -4208,7 +9788,7 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
+func (m *RevertFrame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4231,17 +9811,50 @@ func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevertFrame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevertFrame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
 			}
-			m.Height = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -4251,7 +9864,161 @@ func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Create = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PC", wireType)
+			}
+			m.PC = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PC |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceStart", wireType)
+			}
+			m.SourceStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceLength", wireType)
+			}
+			m.SourceLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5,11 +5,11 @@ package rpcquery
 import (
 	context "context"
 
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	acm "github.com/hyperledger/burrow/acm"
 	names "github.com/hyperledger/burrow/execution/names"
 	rpc "github.com/hyperledger/burrow/rpc"
 	payload "github.com/hyperledger/burrow/txs/payload"
-	types "github.com/tendermint/tendermint/proto/tendermint/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -28,7 +28,14 @@ type QueryClient interface {
 	GetAccount(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*acm.Account, error)
 	GetMetadata(ctx context.Context, in *GetMetadataParam, opts ...grpc.CallOption) (*MetadataResult, error)
 	GetStorage(ctx context.Context, in *GetStorageParam, opts ...grpc.CallOption) (*StorageValue, error)
+	// ListStorage streams the storage of an account in order of key at a height. A page of at most Limit entries is
+	// returned; the next page starts from the key after the last key received.
+	ListStorage(ctx context.Context, in *ListStorageParam, opts ...grpc.CallOption) (Query_ListStorageClient, error)
 	ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error)
+	// GetContract returns the metadata registered for the code deployed at an address
+	GetContract(ctx context.Context, in *GetContractParam, opts ...grpc.CallOption) (*ContractMetadata, error)
+	// ListContracts streams the metadata registered for each contract account with metadata
+	ListContracts(ctx context.Context, in *ListContractsParam, opts ...grpc.CallOption) (Query_ListContractsClient, error)
 	GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error)
 	ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error)
 	// GetNetworkRegistry returns for each validator address, the list of their identified node at the current state
@@ -39,6 +46,29 @@ type QueryClient interface {
 	ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error)
 	GetStats(ctx context.Context, in *GetStatsParam, opts ...grpc.CallOption) (*Stats, error)
 	GetBlockHeader(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.Header, error)
+	// GetLightBlock returns the signed header of the block at a height with the validator set that signed it, from which
+	// light clients can verify the chain
+	GetLightBlock(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.LightBlock, error)
+	// GetAccountProof returns a Merkle proof of an account at a height against the AppHash in the header of the block
+	// at the next height
+	GetAccountProof(ctx context.Context, in *GetAccountProofParam, opts ...grpc.CallOption) (*StateProof, error)
+	// GetStorageProof returns a Merkle proof of a storage slot of an account at a height against the AppHash in the
+	// header of the block at the next height
+	GetStorageProof(ctx context.Context, in *GetStorageProofParam, opts ...grpc.CallOption) (*StateProof, error)
+	// GetNameProof returns a Merkle proof of a name registry entry at a height against the AppHash in the header of the
+	// block at the next height
+	GetNameProof(ctx context.Context, in *GetNameProofParam, opts ...grpc.CallOption) (*StateProof, error)
+	// GetMisbehaviour returns the evidence of validator misbehaviour committed in a range of blocks along with the
+	// evidence this node holds that is yet to be committed and the errors for which it recently disconnected peers
+	GetMisbehaviour(ctx context.Context, in *GetMisbehaviourParam, opts ...grpc.CallOption) (*Misbehaviour, error)
+	// GetGenesis returns the GenesisDoc of the chain as the JSON whose SHA256 hash is the chain's GenesisHash
+	GetGenesis(ctx context.Context, in *GetGenesisParam, opts ...grpc.CallOption) (*Genesis, error)
+	// ListStateChanges streams the accounts, storage and names that differ between the state at two heights, with their
+	// values at each
+	ListStateChanges(ctx context.Context, in *ListStateChangesParam, opts ...grpc.CallOption) (Query_ListStateChangesClient, error)
+	// GetRevertTrace replays a failed call transaction against the state at the start of its block to find the code it
+	// failed in and, for contracts deployed with a source map, the source it failed at
+	GetRevertTrace(ctx context.Context, in *GetRevertTraceParam, opts ...grpc.CallOption) (*RevertTrace, error)
 }
 
 type queryClient struct {
@@ -85,8 +115,40 @@ func (c *queryClient) GetStorage(ctx context.Context, in *GetStorageParam, opts
 	return out, nil
 }
 
+func (c *queryClient) ListStorage(ctx context.Context, in *ListStorageParam, opts ...grpc.CallOption) (Query_ListStorageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], "/rpcquery.Query/ListStorage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListStorageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListStorageClient interface {
+	Recv() (*StorageEntry, error)
+	grpc.ClientStream
+}
+
+type queryListStorageClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListStorageClient) Recv() (*StorageEntry, error) {
+	m := new(StorageEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], "/rpcquery.Query/ListAccounts", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], "/rpcquery.Query/ListAccounts", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +179,47 @@ func (x *queryListAccountsClient) Recv() (*acm.Account, error) {
 	return m, nil
 }
 
+func (c *queryClient) GetContract(ctx context.Context, in *GetContractParam, opts ...grpc.CallOption) (*ContractMetadata, error) {
+	out := new(ContractMetadata)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListContracts(ctx context.Context, in *ListContractsParam, opts ...grpc.CallOption) (Query_ListContractsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[2], "/rpcquery.Query/ListContracts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListContractsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListContractsClient interface {
+	Recv() (*ContractMetadata, error)
+	grpc.ClientStream
+}
+
+type queryListContractsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListContractsClient) Recv() (*ContractMetadata, error) {
+	m := new(ContractMetadata)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error) {
 	out := new(names.Entry)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetName", in, out, opts...)
@@ -127,7 +230,7 @@ func (c *queryClient) GetName(ctx context.Context, in *GetNameParam, opts ...grp
 }
 
 func (c *queryClient) ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[1], "/rpcquery.Query/ListNames", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[3], "/rpcquery.Query/ListNames", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +298,7 @@ func (c *queryClient) GetProposal(ctx context.Context, in *GetProposalParam, opt
 }
 
 func (c *queryClient) ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[2], "/rpcquery.Query/ListProposals", opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[4], "/rpcquery.Query/ListProposals", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +347,101 @@ func (c *queryClient) GetBlockHeader(ctx context.Context, in *GetBlockParam, opt
 	return out, nil
 }
 
+func (c *queryClient) GetLightBlock(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.LightBlock, error) {
+	out := new(types.LightBlock)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetLightBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAccountProof(ctx context.Context, in *GetAccountProofParam, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStorageProof(ctx context.Context, in *GetStorageProofParam, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNameProof(ctx context.Context, in *GetNameProofParam, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetNameProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMisbehaviour(ctx context.Context, in *GetMisbehaviourParam, opts ...grpc.CallOption) (*Misbehaviour, error) {
+	out := new(Misbehaviour)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetMisbehaviour", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetGenesis(ctx context.Context, in *GetGenesisParam, opts ...grpc.CallOption) (*Genesis, error) {
+	out := new(Genesis)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStateChanges(ctx context.Context, in *ListStateChangesParam, opts ...grpc.CallOption) (Query_ListStateChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[5], "/rpcquery.Query/ListStateChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListStateChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListStateChangesClient interface {
+	Recv() (*StateChange, error)
+	grpc.ClientStream
+}
+
+type queryListStateChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListStateChangesClient) Recv() (*StateChange, error) {
+	m := new(StateChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) GetRevertTrace(ctx context.Context, in *GetRevertTraceParam, opts ...grpc.CallOption) (*RevertTrace, error) {
+	out := new(RevertTrace)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetRevertTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -252,7 +450,14 @@ type QueryServer interface {
 	GetAccount(context.Context, *GetAccountParam) (*acm.Account, error)
 	GetMetadata(context.Context, *GetMetadataParam) (*MetadataResult, error)
 	GetStorage(context.Context, *GetStorageParam) (*StorageValue, error)
+	// ListStorage streams the storage of an account in order of key at a height. A page of at most Limit entries is
+	// returned; the next page starts from the key after the last key received.
+	ListStorage(*ListStorageParam, Query_ListStorageServer) error
 	ListAccounts(*ListAccountsParam, Query_ListAccountsServer) error
+	// GetContract returns the metadata registered for the code deployed at an address
+	GetContract(context.Context, *GetContractParam) (*ContractMetadata, error)
+	// ListContracts streams the metadata registered for each contract account with metadata
+	ListContracts(*ListContractsParam, Query_ListContractsServer) error
 	GetName(context.Context, *GetNameParam) (*names.Entry, error)
 	ListNames(*ListNamesParam, Query_ListNamesServer) error
 	// GetNetworkRegistry returns for each validator address, the list of their identified node at the current state
@@ -263,6 +468,29 @@ type QueryServer interface {
 	ListProposals(*ListProposalsParam, Query_ListProposalsServer) error
 	GetStats(context.Context, *GetStatsParam) (*Stats, error)
 	GetBlockHeader(context.Context, *GetBlockParam) (*types.Header, error)
+	// GetLightBlock returns the signed header of the block at a height with the validator set that signed it, from which
+	// light clients can verify the chain
+	GetLightBlock(context.Context, *GetBlockParam) (*types.LightBlock, error)
+	// GetAccountProof returns a Merkle proof of an account at a height against the AppHash in the header of the block
+	// at the next height
+	GetAccountProof(context.Context, *GetAccountProofParam) (*StateProof, error)
+	// GetStorageProof returns a Merkle proof of a storage slot of an account at a height against the AppHash in the
+	// header of the block at the next height
+	GetStorageProof(context.Context, *GetStorageProofParam) (*StateProof, error)
+	// GetNameProof returns a Merkle proof of a name registry entry at a height against the AppHash in the header of the
+	// block at the next height
+	GetNameProof(context.Context, *GetNameProofParam) (*StateProof, error)
+	// GetMisbehaviour returns the evidence of validator misbehaviour committed in a range of blocks along with the
+	// evidence this node holds that is yet to be committed and the errors for which it recently disconnected peers
+	GetMisbehaviour(context.Context, *GetMisbehaviourParam) (*Misbehaviour, error)
+	// GetGenesis returns the GenesisDoc of the chain as the JSON whose SHA256 hash is the chain's GenesisHash
+	GetGenesis(context.Context, *GetGenesisParam) (*Genesis, error)
+	// ListStateChanges streams the accounts, storage and names that differ between the state at two heights, with their
+	// values at each
+	ListStateChanges(*ListStateChangesParam, Query_ListStateChangesServer) error
+	// GetRevertTrace replays a failed call transaction against the state at the start of its block to find the code it
+	// failed in and, for contracts deployed with a source map, the source it failed at
+	GetRevertTrace(context.Context, *GetRevertTraceParam) (*RevertTrace, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -282,9 +510,18 @@ func (UnimplementedQueryServer) GetMetadata(context.Context, *GetMetadataParam)
 func (UnimplementedQueryServer) GetStorage(context.Context, *GetStorageParam) (*StorageValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStorage not implemented")
 }
+func (UnimplementedQueryServer) ListStorage(*ListStorageParam, Query_ListStorageServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListStorage not implemented")
+}
 func (UnimplementedQueryServer) ListAccounts(*ListAccountsParam, Query_ListAccountsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
+func (UnimplementedQueryServer) GetContract(context.Context, *GetContractParam) (*ContractMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContract not implemented")
+}
+func (UnimplementedQueryServer) ListContracts(*ListContractsParam, Query_ListContractsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListContracts not implemented")
+}
 func (UnimplementedQueryServer) GetName(context.Context, *GetNameParam) (*names.Entry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetName not implemented")
 }
@@ -312,6 +549,30 @@ func (UnimplementedQueryServer) GetStats(context.Context, *GetStatsParam) (*Stat
 func (UnimplementedQueryServer) GetBlockHeader(context.Context, *GetBlockParam) (*types.Header, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeader not implemented")
 }
+func (UnimplementedQueryServer) GetLightBlock(context.Context, *GetBlockParam) (*types.LightBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLightBlock not implemented")
+}
+func (UnimplementedQueryServer) GetAccountProof(context.Context, *GetAccountProofParam) (*StateProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountProof not implemented")
+}
+func (UnimplementedQueryServer) GetStorageProof(context.Context, *GetStorageProofParam) (*StateProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageProof not implemented")
+}
+func (UnimplementedQueryServer) GetNameProof(context.Context, *GetNameProofParam) (*StateProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNameProof not implemented")
+}
+func (UnimplementedQueryServer) GetMisbehaviour(context.Context, *GetMisbehaviourParam) (*Misbehaviour, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMisbehaviour not implemented")
+}
+func (UnimplementedQueryServer) GetGenesis(context.Context, *GetGenesisParam) (*Genesis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenesis not implemented")
+}
+func (UnimplementedQueryServer) ListStateChanges(*ListStateChangesParam, Query_ListStateChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListStateChanges not implemented")
+}
+func (UnimplementedQueryServer) GetRevertTrace(context.Context, *GetRevertTraceParam) (*RevertTrace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevertTrace not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -397,6 +658,27 @@ func _Query_GetStorage_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListStorage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListStorageParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListStorage(m, &queryListStorageServer{stream})
+}
+
+type Query_ListStorageServer interface {
+	Send(*StorageEntry) error
+	grpc.ServerStream
+}
+
+type queryListStorageServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListStorageServer) Send(m *StorageEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_ListAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ListAccountsParam)
 	if err := stream.RecvMsg(m); err != nil {
@@ -418,6 +700,45 @@ func (x *queryListAccountsServer) Send(m *acm.Account) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetContract(ctx, req.(*GetContractParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListContracts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListContractsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListContracts(m, &queryListContractsServer{stream})
+}
+
+type Query_ListContractsServer interface {
+	Send(*ContractMetadata) error
+	grpc.ServerStream
+}
+
+type queryListContractsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListContractsServer) Send(m *ContractMetadata) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_GetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNameParam)
 	if err := dec(in); err != nil {
@@ -586,6 +907,153 @@ func _Query_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetLightBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLightBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetLightBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLightBlock(ctx, req.(*GetBlockParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountProofParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountProof(ctx, req.(*GetAccountProofParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageProofParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetStorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStorageProof(ctx, req.(*GetStorageProofParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNameProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNameProofParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNameProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetNameProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNameProof(ctx, req.(*GetNameProofParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMisbehaviour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMisbehaviourParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMisbehaviour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetMisbehaviour",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMisbehaviour(ctx, req.(*GetMisbehaviourParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGenesisParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetGenesis(ctx, req.(*GetGenesisParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStateChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListStateChangesParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListStateChanges(m, &queryListStateChangesServer{stream})
+}
+
+type Query_ListStateChangesServer interface {
+	Send(*StateChange) error
+	grpc.ServerStream
+}
+
+type queryListStateChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListStateChangesServer) Send(m *StateChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_GetRevertTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevertTraceParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRevertTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetRevertTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRevertTrace(ctx, req.(*GetRevertTraceParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -609,6 +1077,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStorage",
 			Handler:    _Query_GetStorage_Handler,
 		},
+		{
+			MethodName: "GetContract",
+			Handler:    _Query_GetContract_Handler,
+		},
 		{
 			MethodName: "GetName",
 			Handler:    _Query_GetName_Handler,
@@ -637,13 +1109,51 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockHeader",
 			Handler:    _Query_GetBlockHeader_Handler,
 		},
+		{
+			MethodName: "GetLightBlock",
+			Handler:    _Query_GetLightBlock_Handler,
+		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _Query_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetStorageProof",
+			Handler:    _Query_GetStorageProof_Handler,
+		},
+		{
+			MethodName: "GetNameProof",
+			Handler:    _Query_GetNameProof_Handler,
+		},
+		{
+			MethodName: "GetMisbehaviour",
+			Handler:    _Query_GetMisbehaviour_Handler,
+		},
+		{
+			MethodName: "GetGenesis",
+			Handler:    _Query_GetGenesis_Handler,
+		},
+		{
+			MethodName: "GetRevertTrace",
+			Handler:    _Query_GetRevertTrace_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListStorage",
+			Handler:       _Query_ListStorage_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListAccounts",
 			Handler:       _Query_ListAccounts_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListContracts",
+			Handler:       _Query_ListContracts_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListNames",
 			Handler:       _Query_ListNames_Handler,
@@ -654,6 +1164,11 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Query_ListProposals_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListStateChanges",
+			Handler:       _Query_ListStateChanges_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpcquery.proto",
 }
@@ -0,0 +1,47 @@
+package rpctransact
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+func NewCallTxProfileResult(txe *exec.TxExecution, profiler *evm.GasProfiler) *CallTxProfileResult {
+	result := &CallTxProfileResult{
+		TxExecution: txe,
+	}
+	for op, usage := range profiler.Opcodes() {
+		result.Opcodes = append(result.Opcodes, &OpcodeGas{
+			OpCode: op.Name(),
+			Count:  usage.Count,
+			Gas:    usage.Gas,
+		})
+	}
+	sort.Slice(result.Opcodes, func(i, j int) bool {
+		if result.Opcodes[i].Gas != result.Opcodes[j].Gas {
+			return result.Opcodes[i].Gas > result.Opcodes[j].Gas
+		}
+		return result.Opcodes[i].OpCode < result.Opcodes[j].OpCode
+	})
+	for fk, usage := range profiler.Functions() {
+		result.Functions = append(result.Functions, &FunctionGas{
+			Address:  fk.Address,
+			Selector: append([]byte{}, fk.Selector[:]...),
+			Calls:    usage.Count,
+			Gas:      usage.Gas,
+		})
+	}
+	sort.Slice(result.Functions, func(i, j int) bool {
+		fi, fj := result.Functions[i], result.Functions[j]
+		if fi.Gas != fj.Gas {
+			return fi.Gas > fj.Gas
+		}
+		if fi.Address != fj.Address {
+			return bytes.Compare(fi.Address.Bytes(), fj.Address.Bytes()) < 0
+		}
+		return bytes.Compare(fi.Selector, fj.Selector) < 0
+	})
+	return result
+}
@@ -14,8 +14,9 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-	_ "github.com/hyperledger/burrow/execution/exec"
+	exec "github.com/hyperledger/burrow/execution/exec"
 	_ "github.com/hyperledger/burrow/txs"
 	github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
 	payload "github.com/hyperledger/burrow/txs/payload"
@@ -91,6 +92,355 @@ func (*CallCodeParam) XXX_MessageName() string {
 	return "rpctransact.CallCodeParam"
 }
 
+type CallTxBundleParam struct {
+	CallTxs              []*payload.CallTx `protobuf:"bytes,1,rep,name=CallTxs,proto3" json:"CallTxs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CallTxBundleParam) Reset()         { *m = CallTxBundleParam{} }
+func (m *CallTxBundleParam) String() string { return proto.CompactTextString(m) }
+func (*CallTxBundleParam) ProtoMessage()    {}
+func (*CallTxBundleParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{1}
+}
+func (m *CallTxBundleParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallTxBundleParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CallTxBundleParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallTxBundleParam.Merge(m, src)
+}
+func (m *CallTxBundleParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallTxBundleParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallTxBundleParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallTxBundleParam proto.InternalMessageInfo
+
+func (m *CallTxBundleParam) GetCallTxs() []*payload.CallTx {
+	if m != nil {
+		return m.CallTxs
+	}
+	return nil
+}
+
+func (*CallTxBundleParam) XXX_MessageName() string {
+	return "rpctransact.CallTxBundleParam"
+}
+
+type CallTxBundleResult struct {
+	// The result of each call in the bundle in order
+	Results []*CallTxSimResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	// The total gas used by all calls in the bundle
+	GasUsed              uint64   `protobuf:"varint,2,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallTxBundleResult) Reset()         { *m = CallTxBundleResult{} }
+func (m *CallTxBundleResult) String() string { return proto.CompactTextString(m) }
+func (*CallTxBundleResult) ProtoMessage()    {}
+func (*CallTxBundleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{2}
+}
+func (m *CallTxBundleResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallTxBundleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CallTxBundleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallTxBundleResult.Merge(m, src)
+}
+func (m *CallTxBundleResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallTxBundleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallTxBundleResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallTxBundleResult proto.InternalMessageInfo
+
+func (m *CallTxBundleResult) GetResults() []*CallTxSimResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *CallTxBundleResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (*CallTxBundleResult) XXX_MessageName() string {
+	return "rpctransact.CallTxBundleResult"
+}
+
+type CallTxSimResult struct {
+	TxExecution *exec.TxExecution `protobuf:"bytes,1,opt,name=TxExecution,proto3" json:"TxExecution,omitempty"`
+	// The changes to state made by this call that are visible to subsequent calls in the bundle
+	StateDiff            *exec.StateDiff `protobuf:"bytes,2,opt,name=StateDiff,proto3" json:"StateDiff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CallTxSimResult) Reset()         { *m = CallTxSimResult{} }
+func (m *CallTxSimResult) String() string { return proto.CompactTextString(m) }
+func (*CallTxSimResult) ProtoMessage()    {}
+func (*CallTxSimResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{3}
+}
+func (m *CallTxSimResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallTxSimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CallTxSimResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallTxSimResult.Merge(m, src)
+}
+func (m *CallTxSimResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallTxSimResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallTxSimResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallTxSimResult proto.InternalMessageInfo
+
+func (m *CallTxSimResult) GetTxExecution() *exec.TxExecution {
+	if m != nil {
+		return m.TxExecution
+	}
+	return nil
+}
+
+func (m *CallTxSimResult) GetStateDiff() *exec.StateDiff {
+	if m != nil {
+		return m.StateDiff
+	}
+	return nil
+}
+
+func (*CallTxSimResult) XXX_MessageName() string {
+	return "rpctransact.CallTxSimResult"
+}
+
+type CallTxProfileResult struct {
+	TxExecution *exec.TxExecution `protobuf:"bytes,1,opt,name=TxExecution,proto3" json:"TxExecution,omitempty"`
+	// Gas used by each opcode executed excluding that used by any calls it made, in descending order of gas
+	Opcodes []*OpcodeGas `protobuf:"bytes,2,rep,name=Opcodes,proto3" json:"Opcodes,omitempty"`
+	// Gas used by each contract function called including that used by any calls it made, in descending order of gas
+	Functions            []*FunctionGas `protobuf:"bytes,3,rep,name=Functions,proto3" json:"Functions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CallTxProfileResult) Reset()         { *m = CallTxProfileResult{} }
+func (m *CallTxProfileResult) String() string { return proto.CompactTextString(m) }
+func (*CallTxProfileResult) ProtoMessage()    {}
+func (*CallTxProfileResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{4}
+}
+func (m *CallTxProfileResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallTxProfileResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CallTxProfileResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallTxProfileResult.Merge(m, src)
+}
+func (m *CallTxProfileResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallTxProfileResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallTxProfileResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallTxProfileResult proto.InternalMessageInfo
+
+func (m *CallTxProfileResult) GetTxExecution() *exec.TxExecution {
+	if m != nil {
+		return m.TxExecution
+	}
+	return nil
+}
+
+func (m *CallTxProfileResult) GetOpcodes() []*OpcodeGas {
+	if m != nil {
+		return m.Opcodes
+	}
+	return nil
+}
+
+func (m *CallTxProfileResult) GetFunctions() []*FunctionGas {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (*CallTxProfileResult) XXX_MessageName() string {
+	return "rpctransact.CallTxProfileResult"
+}
+
+type OpcodeGas struct {
+	OpCode string `protobuf:"bytes,1,opt,name=OpCode,proto3" json:"OpCode,omitempty"`
+	// The number of times the opcode was executed
+	Count                uint64   `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Gas                  uint64   `protobuf:"varint,3,opt,name=Gas,proto3" json:"Gas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpcodeGas) Reset()         { *m = OpcodeGas{} }
+func (m *OpcodeGas) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGas) ProtoMessage()    {}
+func (*OpcodeGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{5}
+}
+func (m *OpcodeGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpcodeGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OpcodeGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGas.Merge(m, src)
+}
+func (m *OpcodeGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpcodeGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeGas proto.InternalMessageInfo
+
+func (m *OpcodeGas) GetOpCode() string {
+	if m != nil {
+		return m.OpCode
+	}
+	return ""
+}
+
+func (m *OpcodeGas) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *OpcodeGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (*OpcodeGas) XXX_MessageName() string {
+	return "rpctransact.OpcodeGas"
+}
+
+type FunctionGas struct {
+	// The contract called
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The 4-byte function selector taken from the call's input
+	Selector github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Selector,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Selector"`
+	// The number of times the function was called
+	Calls                uint64   `protobuf:"varint,3,opt,name=Calls,proto3" json:"Calls,omitempty"`
+	Gas                  uint64   `protobuf:"varint,4,opt,name=Gas,proto3" json:"Gas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FunctionGas) Reset()         { *m = FunctionGas{} }
+func (m *FunctionGas) String() string { return proto.CompactTextString(m) }
+func (*FunctionGas) ProtoMessage()    {}
+func (*FunctionGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{6}
+}
+func (m *FunctionGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunctionGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FunctionGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionGas.Merge(m, src)
+}
+func (m *FunctionGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunctionGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionGas proto.InternalMessageInfo
+
+func (m *FunctionGas) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+func (m *FunctionGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (*FunctionGas) XXX_MessageName() string {
+	return "rpctransact.FunctionGas"
+}
+
 type TxEnvelope struct {
 	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
@@ -102,7 +452,7 @@ func (m *TxEnvelope) Reset()         { *m = TxEnvelope{} }
 func (m *TxEnvelope) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelope) ProtoMessage()    {}
 func (*TxEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_039da6ebb58a8dc9, []int{1}
+	return fileDescriptor_039da6ebb58a8dc9, []int{7}
 }
 func (m *TxEnvelope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +499,7 @@ func (m *TxEnvelopeParam) Reset()         { *m = TxEnvelopeParam{} }
 func (m *TxEnvelopeParam) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelopeParam) ProtoMessage()    {}
 func (*TxEnvelopeParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_039da6ebb58a8dc9, []int{2}
+	return fileDescriptor_039da6ebb58a8dc9, []int{8}
 }
 func (m *TxEnvelopeParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,55 +541,197 @@ func (m *TxEnvelopeParam) GetTimeout() time.Duration {
 func (*TxEnvelopeParam) XXX_MessageName() string {
 	return "rpctransact.TxEnvelopeParam"
 }
+
+type StateSnapshotParam struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateSnapshotParam) Reset()         { *m = StateSnapshotParam{} }
+func (m *StateSnapshotParam) String() string { return proto.CompactTextString(m) }
+func (*StateSnapshotParam) ProtoMessage()    {}
+func (*StateSnapshotParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{9}
+}
+func (m *StateSnapshotParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateSnapshotParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StateSnapshotParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSnapshotParam.Merge(m, src)
+}
+func (m *StateSnapshotParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateSnapshotParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSnapshotParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSnapshotParam proto.InternalMessageInfo
+
+func (m *StateSnapshotParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (*StateSnapshotParam) XXX_MessageName() string {
+	return "rpctransact.StateSnapshotParam"
+}
+
+type StateSnapshot struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The height of the last block in the snapshot
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateSnapshot) Reset()         { *m = StateSnapshot{} }
+func (m *StateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*StateSnapshot) ProtoMessage()    {}
+func (*StateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{10}
+}
+func (m *StateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSnapshot.Merge(m, src)
+}
+func (m *StateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSnapshot proto.InternalMessageInfo
+
+func (m *StateSnapshot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StateSnapshot) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*StateSnapshot) XXX_MessageName() string {
+	return "rpctransact.StateSnapshot"
+}
 func init() {
 	proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
 	golang_proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
+	proto.RegisterType((*CallTxBundleParam)(nil), "rpctransact.CallTxBundleParam")
+	golang_proto.RegisterType((*CallTxBundleParam)(nil), "rpctransact.CallTxBundleParam")
+	proto.RegisterType((*CallTxBundleResult)(nil), "rpctransact.CallTxBundleResult")
+	golang_proto.RegisterType((*CallTxBundleResult)(nil), "rpctransact.CallTxBundleResult")
+	proto.RegisterType((*CallTxSimResult)(nil), "rpctransact.CallTxSimResult")
+	golang_proto.RegisterType((*CallTxSimResult)(nil), "rpctransact.CallTxSimResult")
+	proto.RegisterType((*CallTxProfileResult)(nil), "rpctransact.CallTxProfileResult")
+	golang_proto.RegisterType((*CallTxProfileResult)(nil), "rpctransact.CallTxProfileResult")
+	proto.RegisterType((*OpcodeGas)(nil), "rpctransact.OpcodeGas")
+	golang_proto.RegisterType((*OpcodeGas)(nil), "rpctransact.OpcodeGas")
+	proto.RegisterType((*FunctionGas)(nil), "rpctransact.FunctionGas")
+	golang_proto.RegisterType((*FunctionGas)(nil), "rpctransact.FunctionGas")
 	proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	golang_proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
 	golang_proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
+	proto.RegisterType((*StateSnapshotParam)(nil), "rpctransact.StateSnapshotParam")
+	golang_proto.RegisterType((*StateSnapshotParam)(nil), "rpctransact.StateSnapshotParam")
+	proto.RegisterType((*StateSnapshot)(nil), "rpctransact.StateSnapshot")
+	golang_proto.RegisterType((*StateSnapshot)(nil), "rpctransact.StateSnapshot")
 }
 
 func init() { proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58a8dc9) }
 func init() { golang_proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58a8dc9) }
 
 var fileDescriptor_039da6ebb58a8dc9 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0x7e, 0xfd, 0x02, 0xfd, 0x18, 0x37, 0x0a, 0x5d, 0x21, 0x11, 0x22, 0xe4, 0xa0, 0x1c, 0x10,
-	0x42, 0x65, 0x1d, 0x85, 0x1e, 0xf9, 0x50, 0xdc, 0x0f, 0x6e, 0xa8, 0x72, 0x2c, 0x24, 0xb8, 0x6d,
-	0xec, 0xc5, 0xb5, 0x64, 0x7b, 0xad, 0xf5, 0x1a, 0x9c, 0x5f, 0xc1, 0x85, 0x03, 0x3f, 0x87, 0x63,
-	0x8e, 0x48, 0x5c, 0x50, 0x0f, 0x01, 0xa5, 0x7f, 0x04, 0xad, 0xd7, 0x2e, 0x76, 0xd2, 0xb4, 0x5c,
-	0xb8, 0x8d, 0x9f, 0xf1, 0xf3, 0xcc, 0x3c, 0x33, 0xb3, 0xb0, 0xcb, 0x13, 0x57, 0x70, 0x12, 0xa7,
-	0xc4, 0x15, 0x38, 0xe1, 0x4c, 0x30, 0xa4, 0xd7, 0xa0, 0xee, 0x1d, 0x9f, 0xf9, 0xac, 0xc0, 0x4d,
-	0x19, 0xa9, 0x5f, 0xba, 0x86, 0xcf, 0x98, 0x1f, 0x52, 0xb3, 0xf8, 0x9a, 0x64, 0xef, 0x4d, 0x2f,
-	0xe3, 0x44, 0x04, 0x2c, 0x2e, 0xf3, 0x40, 0x73, 0xea, 0x96, 0x71, 0x2b, 0x21, 0xd3, 0x90, 0x11,
-	0xaf, 0xfc, 0xdc, 0x16, 0x79, 0xaa, 0xc2, 0xfe, 0x27, 0x0d, 0x5a, 0x07, 0x24, 0x0c, 0x0f, 0x98,
-	0x47, 0x4f, 0x08, 0x27, 0x11, 0x7a, 0x03, 0xfa, 0x31, 0x67, 0xd1, 0xc8, 0xf3, 0x38, 0x4d, 0xd3,
-	0x8e, 0xf6, 0x40, 0x7b, 0xb4, 0x63, 0xed, 0xcf, 0xe6, 0xbd, 0xff, 0xce, 0xe6, 0xbd, 0x3d, 0x3f,
-	0x10, 0xa7, 0xd9, 0x04, 0xbb, 0x2c, 0x32, 0x4f, 0xa7, 0x09, 0xe5, 0x21, 0xf5, 0x7c, 0xca, 0xcd,
-	0x49, 0xc6, 0x39, 0xfb, 0x68, 0xba, 0x7c, 0x9a, 0x08, 0x86, 0x4b, 0xae, 0x5d, 0x17, 0x42, 0x08,
-	0x6e, 0xca, 0x22, 0x9d, 0xff, 0xa5, 0xa0, 0x5d, 0xc4, 0x12, 0x3b, 0x24, 0x82, 0x74, 0x6e, 0x28,
-	0x4c, 0xc6, 0x7d, 0x1f, 0xc0, 0xc9, 0x8f, 0xe2, 0x0f, 0x34, 0x64, 0x09, 0x45, 0x6f, 0x61, 0xab,
-	0x8a, 0x8b, 0x56, 0xf4, 0x61, 0x0b, 0xcb, 0xee, 0x2b, 0xd0, 0xc2, 0x67, 0xf3, 0xde, 0xe3, 0xab,
-	0xbb, 0xaa, 0xff, 0x6f, 0x5f, 0xc8, 0xf5, 0xbf, 0x6b, 0xd0, 0xfe, 0x53, 0x49, 0x99, 0xff, 0x77,
-	0xe5, 0xd0, 0x43, 0xd8, 0x3c, 0x51, 0x5b, 0x28, 0x46, 0xa0, 0x0f, 0x77, 0x70, 0xb5, 0x95, 0x51,
-	0x3c, 0xb5, 0xab, 0x24, 0x7a, 0x0e, 0x9b, 0x4e, 0x10, 0x51, 0x96, 0x89, 0x62, 0x2c, 0xfa, 0xf0,
-	0x1e, 0x56, 0x9b, 0xc6, 0xd5, 0xa6, 0xf1, 0x61, 0xb9, 0x69, 0x6b, 0x4b, 0xae, 0xe5, 0xcb, 0xcf,
-	0x9e, 0x66, 0x57, 0x9c, 0xe1, 0xe7, 0x5b, 0xb0, 0xe5, 0x94, 0x97, 0x83, 0x2c, 0x68, 0x5b, 0x9c,
-	0x11, 0xcf, 0x25, 0xa9, 0x70, 0xf2, 0xf1, 0x34, 0x76, 0xd1, 0x7d, 0x5c, 0xbf, 0xb6, 0x25, 0xff,
-	0xdd, 0x5d, 0x5c, 0x5c, 0x8d, 0x93, 0x1f, 0xe5, 0xd4, 0xcd, 0x64, 0x0d, 0xf4, 0x02, 0x6e, 0xd7,
-	0x34, 0x46, 0xe9, 0xf5, 0x22, 0x3b, 0xc5, 0xc8, 0x6c, 0xea, 0xd2, 0x20, 0x11, 0xe8, 0x25, 0x6c,
-	0x8c, 0x03, 0x3f, 0x76, 0xf2, 0x6b, 0x58, 0x77, 0xd7, 0x64, 0xd1, 0x3e, 0xe8, 0xc7, 0x8c, 0x47,
-	0x59, 0x48, 0x04, 0x75, 0x72, 0xd4, 0x18, 0xdb, 0x7a, 0xd6, 0x00, 0x40, 0xde, 0x75, 0xe9, 0xba,
-	0x7d, 0x41, 0x52, 0xe0, 0x65, 0x46, 0xf7, 0x40, 0x57, 0x49, 0xe5, 0x71, 0x85, 0xd2, 0xb4, 0x65,
-	0xc2, 0x76, 0xa9, 0x1f, 0x44, 0x7f, 0x25, 0xff, 0x4c, 0xc9, 0xcb, 0xbb, 0x97, 0x94, 0x6e, 0xa3,
-	0xf1, 0xc6, 0x13, 0xbc, 0x8c, 0x3d, 0x00, 0x18, 0xd3, 0xd8, 0x5b, 0xb1, 0xa3, 0xc0, 0x35, 0x76,
-	0x54, 0x72, 0xd9, 0x4e, 0x49, 0x69, 0xda, 0x19, 0x00, 0xbc, 0x26, 0x11, 0x5d, 0xd1, 0x57, 0xe0,
-	0x1a, 0x7d, 0x95, 0x5c, 0xd6, 0x2f, 0x29, 0x0d, 0x7d, 0xeb, 0xd5, 0x6c, 0x61, 0x68, 0xdf, 0x16,
-	0x86, 0xf6, 0x63, 0x61, 0x68, 0xbf, 0x16, 0x86, 0xf6, 0xf5, 0xdc, 0xd0, 0x66, 0xe7, 0x86, 0xf6,
-	0xee, 0xc9, 0xd5, 0xaf, 0x89, 0x27, 0xae, 0x59, 0x9b, 0xd4, 0x64, 0xa3, 0x78, 0x05, 0x4f, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x69, 0x8d, 0x35, 0xa5, 0x35, 0x05, 0x00, 0x00,
+	// 922 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xe7, 0x1a, 0x13, 0xc7, 0xe3, 0x58, 0x49, 0x96, 0xaa, 0x18, 0x0b, 0xd9, 0xd5, 0x3d, 0xa0,
+	0x80, 0xda, 0x73, 0x94, 0x96, 0xbe, 0x00, 0x45, 0x71, 0xfe, 0x55, 0x2a, 0x6a, 0xc3, 0xda, 0x20,
+	0xc1, 0xdb, 0xfa, 0x6e, 0xed, 0x9c, 0x74, 0xbe, 0x3d, 0xed, 0xed, 0xc1, 0xf9, 0x53, 0xf0, 0xca,
+	0x07, 0xe1, 0x03, 0xf0, 0x18, 0xf1, 0x84, 0xc4, 0x4b, 0xd5, 0x87, 0x80, 0xd2, 0x2f, 0x82, 0xf6,
+	0x9f, 0x73, 0xe7, 0x3f, 0x0d, 0x52, 0xe8, 0xdb, 0xec, 0xcc, 0xfc, 0x7e, 0x3b, 0x33, 0x3b, 0x33,
+	0x0b, 0x3b, 0x3c, 0xf1, 0x05, 0x27, 0x71, 0x4a, 0x7c, 0xe1, 0x25, 0x9c, 0x09, 0x86, 0xea, 0x05,
+	0x55, 0xeb, 0xee, 0x98, 0x8d, 0x99, 0xd2, 0x77, 0xa5, 0xa4, 0x5d, 0x5a, 0xed, 0x31, 0x63, 0xe3,
+	0x88, 0x76, 0xd5, 0x69, 0x98, 0x8d, 0xba, 0x41, 0xc6, 0x89, 0x08, 0x59, 0x6c, 0xec, 0x40, 0x73,
+	0xea, 0x1b, 0xb9, 0x91, 0x90, 0x69, 0xc4, 0x48, 0x60, 0x8e, 0x35, 0x91, 0xa7, 0x5a, 0x74, 0x7f,
+	0x71, 0xa0, 0x71, 0x48, 0xa2, 0xe8, 0x90, 0x05, 0xf4, 0x8c, 0x70, 0x32, 0x41, 0xdf, 0x43, 0xfd,
+	0x84, 0xb3, 0xc9, 0x41, 0x10, 0x70, 0x9a, 0xa6, 0x4d, 0xe7, 0xbe, 0xb3, 0xbb, 0xd9, 0x7b, 0x7c,
+	0x71, 0xd9, 0x79, 0xef, 0xf5, 0x65, 0xe7, 0xc1, 0x38, 0x14, 0xe7, 0xd9, 0xd0, 0xf3, 0xd9, 0xa4,
+	0x7b, 0x3e, 0x4d, 0x28, 0x8f, 0x68, 0x30, 0xa6, 0xbc, 0x3b, 0xcc, 0x38, 0x67, 0x3f, 0x77, 0x7d,
+	0x3e, 0x4d, 0x04, 0xf3, 0x0c, 0x16, 0x17, 0x89, 0x10, 0x82, 0x8a, 0xbc, 0xa4, 0x79, 0x47, 0x12,
+	0x62, 0x25, 0x4b, 0xdd, 0x11, 0x11, 0xa4, 0xb9, 0xa6, 0x75, 0x52, 0x76, 0x9f, 0xc2, 0x8e, 0x0c,
+	0x68, 0x90, 0xf7, 0xb2, 0x38, 0x88, 0x4c, 0x50, 0x9f, 0x42, 0x55, 0x2b, 0x65, 0x40, 0x6b, 0xbb,
+	0xf5, 0xfd, 0x2d, 0xcf, 0xa6, 0xa4, 0xf5, 0xd8, 0xda, 0xdd, 0x11, 0xa0, 0x22, 0x1e, 0xd3, 0x34,
+	0x8b, 0x04, 0x7a, 0x02, 0x55, 0x2d, 0x59, 0x82, 0x8f, 0xbd, 0x62, 0xd5, 0x35, 0xa2, 0x1f, 0x4e,
+	0xb4, 0x13, 0xb6, 0xce, 0xa8, 0x09, 0xd5, 0x53, 0x92, 0x7e, 0x97, 0xd2, 0x40, 0x05, 0x5e, 0xc1,
+	0xf6, 0xe8, 0x66, 0xb0, 0x35, 0x87, 0x42, 0x8f, 0xa0, 0x3e, 0xc8, 0x8f, 0x73, 0xea, 0x67, 0xf2,
+	0x1d, 0x54, 0xe9, 0xea, 0xfb, 0x3b, 0x9e, 0x7a, 0x88, 0x82, 0x01, 0x17, 0xbd, 0xd0, 0x43, 0xa8,
+	0xf5, 0x05, 0x11, 0xf4, 0x28, 0x1c, 0x8d, 0xd4, 0x1d, 0x32, 0x39, 0x05, 0x99, 0xa9, 0xf1, 0xb5,
+	0x87, 0xfb, 0x9b, 0x03, 0x1f, 0xe8, 0x7b, 0xcf, 0x38, 0x1b, 0x85, 0x11, 0xbd, 0xcd, 0xdd, 0x7b,
+	0x50, 0x7d, 0x99, 0xf8, 0x2c, 0xa0, 0x69, 0xf3, 0x8e, 0xaa, 0xca, 0xbd, 0x52, 0x55, 0xb4, 0xed,
+	0x94, 0xa4, 0xd8, 0xba, 0xa1, 0x27, 0x50, 0x3b, 0xc9, 0x62, 0x5f, 0xa2, 0xd3, 0xe6, 0x9a, 0xc2,
+	0x34, 0x4b, 0x18, 0x6b, 0x95, 0xa8, 0x6b, 0x57, 0xf7, 0x39, 0xd4, 0x66, 0x6c, 0xe8, 0x1e, 0xac,
+	0xbf, 0x4c, 0x54, 0x33, 0xc8, 0x30, 0x6b, 0xd8, 0x9c, 0xd0, 0x5d, 0x78, 0xff, 0x90, 0x65, 0xb1,
+	0x30, 0xa5, 0xd6, 0x07, 0xb4, 0x0d, 0x6b, 0xa7, 0x24, 0x55, 0x3d, 0x52, 0xc1, 0x52, 0x74, 0x5f,
+	0x39, 0x50, 0x2f, 0xdc, 0x83, 0x5e, 0x40, 0xf5, 0xff, 0x68, 0x57, 0x4b, 0x82, 0xbe, 0x85, 0x8d,
+	0x3e, 0x8d, 0xa8, 0x2f, 0x18, 0xd7, 0xed, 0xda, 0xfb, 0xdc, 0x10, 0x3e, 0x7c, 0x3b, 0xe1, 0x30,
+	0x8c, 0x09, 0x9f, 0x7a, 0xcf, 0x68, 0xde, 0x9b, 0x0a, 0x9a, 0xe2, 0x19, 0x8d, 0x4a, 0x8d, 0x44,
+	0x91, 0x4d, 0x43, 0x1f, 0x6c, 0x6a, 0x95, 0xeb, 0xd4, 0xc6, 0x00, 0x83, 0xfc, 0x38, 0xfe, 0x89,
+	0x46, 0x2c, 0xa1, 0xe8, 0x07, 0xd8, 0xb0, 0xb2, 0x79, 0xd1, 0x86, 0x27, 0x67, 0xd7, 0x2a, 0x7b,
+	0xde, 0xeb, 0xcb, 0xce, 0x67, 0x6f, 0x8f, 0xa9, 0xe8, 0x8f, 0x67, 0x74, 0xee, 0x5f, 0x0e, 0x6c,
+	0x5d, 0xdf, 0xa4, 0xa7, 0xec, 0xdd, 0x5d, 0x87, 0x3e, 0x81, 0xea, 0x99, 0x1e, 0x58, 0xd3, 0xe3,
+	0x9b, 0xb3, 0x01, 0x3e, 0x88, 0xa7, 0xd8, 0x1a, 0xd1, 0x57, 0x50, 0x1d, 0x84, 0x13, 0xca, 0x32,
+	0xa1, 0x2a, 0x55, 0xdf, 0xff, 0xc8, 0xd3, 0x7b, 0xce, 0xb3, 0x7b, 0xce, 0x3b, 0x32, 0x7b, 0xae,
+	0xb7, 0x21, 0x1f, 0xe5, 0xd7, 0xbf, 0x3b, 0x0e, 0xb6, 0x18, 0x77, 0x17, 0x90, 0x1a, 0x95, 0x7e,
+	0x4c, 0x92, 0xf4, 0x9c, 0x09, 0x9d, 0x17, 0x82, 0xca, 0x0b, 0x32, 0xb1, 0xdd, 0xa6, 0x64, 0xf7,
+	0x0b, 0x68, 0x94, 0x3c, 0x97, 0x39, 0xc9, 0x46, 0x7d, 0x46, 0xc3, 0xf1, 0xb9, 0xed, 0x48, 0x73,
+	0xda, 0xff, 0xa3, 0x0a, 0x1b, 0x03, 0xd3, 0xf1, 0xa8, 0x07, 0x5b, 0x3d, 0xce, 0x48, 0xe0, 0x93,
+	0x54, 0x0c, 0xf2, 0xfe, 0x34, 0xf6, 0x51, 0x79, 0xb9, 0xcc, 0x95, 0xb9, 0xb5, 0x38, 0x95, 0xe8,
+	0x29, 0x6c, 0x17, 0x38, 0x0e, 0xd2, 0x9b, 0x49, 0x36, 0xd5, 0xcb, 0x60, 0xea, 0xd3, 0x30, 0x11,
+	0xe8, 0x6b, 0x58, 0xef, 0x87, 0xe3, 0x78, 0x90, 0xdf, 0x80, 0xfa, 0x70, 0x85, 0x15, 0x3d, 0x86,
+	0xfa, 0x09, 0xe3, 0x93, 0x2c, 0x22, 0x82, 0x0e, 0x72, 0x54, 0x7a, 0x9d, 0xd5, 0xa8, 0x3d, 0x00,
+	0xb3, 0x03, 0x65, 0xc0, 0xf3, 0x3b, 0x79, 0x59, 0xa2, 0x0f, 0xa0, 0xae, 0x8d, 0x07, 0xe9, 0x52,
+	0x48, 0x39, 0xad, 0x2e, 0xd4, 0x66, 0x3b, 0xf6, 0x3f, 0xd1, 0x7f, 0xa9, 0xe9, 0xe5, 0x36, 0x91,
+	0x90, 0xd6, 0xc2, 0x92, 0x9f, 0xfd, 0x73, 0xcb, 0xd0, 0xb8, 0xb0, 0xd2, 0xf5, 0xef, 0x81, 0xda,
+	0x4b, 0xbe, 0x89, 0xc2, 0xc7, 0xd4, 0xea, 0xac, 0xb4, 0x9b, 0xbd, 0x7c, 0x0c, 0xdb, 0x33, 0x4e,
+	0xb3, 0xb1, 0x17, 0x33, 0xb9, 0xbf, 0x84, 0xa5, 0xbc, 0xde, 0xf7, 0x00, 0xfa, 0x34, 0x0e, 0x16,
+	0x2a, 0xad, 0x95, 0x2b, 0x2a, 0xad, 0x8d, 0xf3, 0x95, 0x36, 0x90, 0x72, 0xa5, 0xf7, 0x00, 0x64,
+	0xc7, 0x2f, 0xf0, 0x6b, 0xe5, 0x0a, 0x7e, 0x6d, 0x9c, 0xe7, 0x37, 0x90, 0x32, 0xff, 0x37, 0xd0,
+	0xb0, 0x93, 0xa6, 0xc6, 0x0e, 0x95, 0x0b, 0xb7, 0x38, 0xb4, 0xad, 0xd6, 0x6a, 0x07, 0xf4, 0x1c,
+	0x36, 0x31, 0x4d, 0x05, 0xe3, 0xf4, 0xf6, 0x64, 0xbd, 0xd3, 0x8b, 0xab, 0xb6, 0xf3, 0xe7, 0x55,
+	0xdb, 0x79, 0x75, 0xd5, 0x76, 0xfe, 0xb9, 0x6a, 0x3b, 0xbf, 0xbf, 0x69, 0x3b, 0x17, 0x6f, 0xda,
+	0xce, 0x8f, 0x37, 0x6c, 0x7b, 0x9e, 0xf8, 0xdd, 0x02, 0xed, 0x70, 0x5d, 0xad, 0xa8, 0x47, 0xff,
+	0x0e, 0x00, 0x37, 0x85, 0xa5, 0xb5, 0xd0, 0x09, 0x00, 0x00,
 }
 
 func (m *CallCodeParam) Marshal() (dAtA []byte, err error) {
@@ -293,7 +785,7 @@ func (m *CallCodeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
+func (m *CallTxBundleParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -303,12 +795,12 @@ func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
+func (m *CallTxBundleParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CallTxBundleParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -317,22 +809,24 @@ func (m *TxEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Envelope != nil {
-		{
-			size := m.Envelope.Size()
-			i -= size
-			if _, err := m.Envelope.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.CallTxs) > 0 {
+		for iNdEx := len(m.CallTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpctransact(dAtA, i, uint64(size))
 			}
-			i = encodeVarintRpctransact(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxEnvelopeParam) Marshal() (dAtA []byte, err error) {
+func (m *CallTxBundleResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -342,12 +836,12 @@ func (m *TxEnvelopeParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxEnvelopeParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *CallTxBundleResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxEnvelopeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CallTxBundleResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -356,17 +850,55 @@ func (m *TxEnvelopeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
-	if err2 != nil {
-		return 0, err2
+	if m.GasUsed != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
 	}
-	i -= n2
-	i = encodeVarintRpctransact(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	if m.Payload != nil {
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpctransact(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallTxSimResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallTxSimResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallTxSimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StateDiff != nil {
 		{
-			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StateDiff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -376,13 +908,13 @@ func (m *TxEnvelopeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Envelope != nil {
+	if m.TxExecution != nil {
 		{
-			size := m.Envelope.Size()
-			i -= size
-			if _, err := m.Envelope.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.TxExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintRpctransact(dAtA, i, uint64(size))
 		}
 		i--
@@ -391,84 +923,1329 @@ func (m *TxEnvelopeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRpctransact(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRpctransact(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CallTxProfileResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CallCodeParam) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CallTxProfileResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallTxProfileResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Functions) > 0 {
+		for iNdEx := len(m.Functions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Functions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpctransact(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Opcodes) > 0 {
+		for iNdEx := len(m.Opcodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Opcodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpctransact(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TxExecution != nil {
+		{
+			size, err := m.TxExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpctransact(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpcodeGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OpcodeGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpcodeGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FromAddress.Size()
-	n += 1 + l + sovRpctransact(uint64(l))
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovRpctransact(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpctransact(uint64(l))
+	if m.Gas != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OpCode) > 0 {
+		i -= len(m.OpCode)
+		copy(dAtA[i:], m.OpCode)
+		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.OpCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunctionGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FunctionGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunctionGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Gas != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Calls != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Selector.Size()
+		i -= size
+		if _, err := m.Selector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpctransact(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpctransact(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TxEnvelope) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if m.Envelope != nil {
-		l = m.Envelope.Size()
-		n += 1 + l + sovRpctransact(uint64(l))
+		{
+			size := m.Envelope.Size()
+			i -= size
+			if _, err := m.Envelope.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpctransact(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxEnvelopeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TxEnvelopeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxEnvelopeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintRpctransact(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpctransact(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Envelope != nil {
+		{
+			size := m.Envelope.Size()
+			i -= size
+			if _, err := m.Envelope.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpctransact(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateSnapshotParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateSnapshotParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateSnapshotParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpctransact(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpctransact(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CallCodeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromAddress.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CallTxBundleParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CallTxs) > 0 {
+		for _, e := range m.CallTxs {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CallTxBundleResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovRpctransact(uint64(m.GasUsed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CallTxSimResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxExecution != nil {
+		l = m.TxExecution.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.StateDiff != nil {
+		l = m.StateDiff.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CallTxProfileResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxExecution != nil {
+		l = m.TxExecution.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if len(m.Opcodes) > 0 {
+		for _, e := range m.Opcodes {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	if len(m.Functions) > 0 {
+		for _, e := range m.Functions {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OpcodeGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OpCode)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRpctransact(uint64(m.Count))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovRpctransact(uint64(m.Gas))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FunctionGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	l = m.Selector.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.Calls != 0 {
+		n += 1 + sovRpctransact(uint64(m.Calls))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovRpctransact(uint64(m.Gas))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxEnvelopeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateSnapshotParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpctransact(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpctransact(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpctransact(x uint64) (n int) {
+	return sovRpctransact(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallCodeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallCodeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallTxBundleParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTxBundleParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTxBundleParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallTxs = append(m.CallTxs, &payload.CallTx{})
+			if err := m.CallTxs[len(m.CallTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallTxBundleResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTxBundleResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTxBundleResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &CallTxSimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallTxSimResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTxSimResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTxSimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxExecution == nil {
+				m.TxExecution = &exec.TxExecution{}
+			}
+			if err := m.TxExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateDiff == nil {
+				m.StateDiff = &exec.StateDiff{}
+			}
+			if err := m.StateDiff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallTxProfileResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTxProfileResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTxProfileResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxExecution == nil {
+				m.TxExecution = &exec.TxExecution{}
+			}
+			if err := m.TxExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcodes = append(m.Opcodes, &OpcodeGas{})
+			if err := m.Opcodes[len(m.Opcodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Functions = append(m.Functions, &FunctionGas{})
+			if err := m.Functions[len(m.Functions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpcodeGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TxEnvelopeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Envelope != nil {
-		l = m.Envelope.Size()
-		n += 1 + l + sovRpctransact(uint64(l))
-	}
-	if m.Payload != nil {
-		l = m.Payload.Size()
-		n += 1 + l + sovRpctransact(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
-	n += 1 + l + sovRpctransact(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovRpctransact(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRpctransact(x uint64) (n int) {
-	return sovRpctransact(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
+func (m *FunctionGas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -491,15 +2268,15 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallCodeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunctionGas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallCodeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunctionGas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -526,13 +2303,13 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -559,16 +2336,15 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
-			var byteLen int
+			m.Calls = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpctransact
@@ -578,26 +2354,30 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Calls |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpctransact
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpctransact
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpctransact(dAtA[iNdEx:])
@@ -863,6 +2643,191 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StateSnapshotParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateSnapshotParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateSnapshotParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRpctransact(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -40,6 +40,11 @@ type TransactClient interface {
 	CallTxSim(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(ctx context.Context, in *CallCodeParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// Perform a 'simulated' call of each CallTx in order against a single snapshot of the current committed EVM state
+	// where each call sees the changes made by those before it, without any changes being saved
+	CallTxSimBundle(ctx context.Context, in *CallTxBundleParam, opts ...grpc.CallOption) (*CallTxBundleResult, error)
+	// Perform a 'simulated' call of a contract as CallTxSim does and profile the gas used by opcode and by function
+	CallTxSimProfile(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*CallTxProfileResult, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -48,6 +53,12 @@ type TransactClient interface {
 	NameTxSync(ctx context.Context, in *payload.NameTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate a NameTx signed server-side
 	NameTxAsync(ctx context.Context, in *payload.NameTx, opts ...grpc.CallOption) (*txs.Receipt, error)
+	// Record the state after the last block under a name - only available on chains running without consensus, such
+	// as burrow dev
+	SnapshotState(ctx context.Context, in *StateSnapshotParam, opts ...grpc.CallOption) (*StateSnapshot, error)
+	// Return the chain to the state recorded under a name, discarding the blocks committed since - only available on
+	// chains running without consensus, such as burrow dev
+	RestoreState(ctx context.Context, in *StateSnapshotParam, opts ...grpc.CallOption) (*StateSnapshot, error)
 }
 
 type transactClient struct {
@@ -130,6 +141,24 @@ func (c *transactClient) CallCodeSim(ctx context.Context, in *CallCodeParam, opt
 	return out, nil
 }
 
+func (c *transactClient) CallTxSimBundle(ctx context.Context, in *CallTxBundleParam, opts ...grpc.CallOption) (*CallTxBundleResult, error) {
+	out := new(CallTxBundleResult)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/CallTxSimBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactClient) CallTxSimProfile(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*CallTxProfileResult, error) {
+	out := new(CallTxProfileResult)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/CallTxSimProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactClient) SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
 	out := new(exec.TxExecution)
 	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SendTxSync", in, out, opts...)
@@ -166,6 +195,24 @@ func (c *transactClient) NameTxAsync(ctx context.Context, in *payload.NameTx, op
 	return out, nil
 }
 
+func (c *transactClient) SnapshotState(ctx context.Context, in *StateSnapshotParam, opts ...grpc.CallOption) (*StateSnapshot, error) {
+	out := new(StateSnapshot)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SnapshotState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactClient) RestoreState(ctx context.Context, in *StateSnapshotParam, opts ...grpc.CallOption) (*StateSnapshot, error) {
+	out := new(StateSnapshot)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/RestoreState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactServer is the server API for Transact service.
 // All implementations must embed UnimplementedTransactServer
 // for forward compatibility
@@ -188,6 +235,11 @@ type TransactServer interface {
 	CallTxSim(context.Context, *payload.CallTx) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(context.Context, *CallCodeParam) (*exec.TxExecution, error)
+	// Perform a 'simulated' call of each CallTx in order against a single snapshot of the current committed EVM state
+	// where each call sees the changes made by those before it, without any changes being saved
+	CallTxSimBundle(context.Context, *CallTxBundleParam) (*CallTxBundleResult, error)
+	// Perform a 'simulated' call of a contract as CallTxSim does and profile the gas used by opcode and by function
+	CallTxSimProfile(context.Context, *payload.CallTx) (*CallTxProfileResult, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(context.Context, *payload.SendTx) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -196,6 +248,12 @@ type TransactServer interface {
 	NameTxSync(context.Context, *payload.NameTx) (*exec.TxExecution, error)
 	// Formulate a NameTx signed server-side
 	NameTxAsync(context.Context, *payload.NameTx) (*txs.Receipt, error)
+	// Record the state after the last block under a name - only available on chains running without consensus, such
+	// as burrow dev
+	SnapshotState(context.Context, *StateSnapshotParam) (*StateSnapshot, error)
+	// Return the chain to the state recorded under a name, discarding the blocks committed since - only available on
+	// chains running without consensus, such as burrow dev
+	RestoreState(context.Context, *StateSnapshotParam) (*StateSnapshot, error)
 	mustEmbedUnimplementedTransactServer()
 }
 
@@ -227,6 +285,12 @@ func (UnimplementedTransactServer) CallTxSim(context.Context, *payload.CallTx) (
 func (UnimplementedTransactServer) CallCodeSim(context.Context, *CallCodeParam) (*exec.TxExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallCodeSim not implemented")
 }
+func (UnimplementedTransactServer) CallTxSimBundle(context.Context, *CallTxBundleParam) (*CallTxBundleResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallTxSimBundle not implemented")
+}
+func (UnimplementedTransactServer) CallTxSimProfile(context.Context, *payload.CallTx) (*CallTxProfileResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallTxSimProfile not implemented")
+}
 func (UnimplementedTransactServer) SendTxSync(context.Context, *payload.SendTx) (*exec.TxExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTxSync not implemented")
 }
@@ -239,6 +303,12 @@ func (UnimplementedTransactServer) NameTxSync(context.Context, *payload.NameTx)
 func (UnimplementedTransactServer) NameTxAsync(context.Context, *payload.NameTx) (*txs.Receipt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NameTxAsync not implemented")
 }
+func (UnimplementedTransactServer) SnapshotState(context.Context, *StateSnapshotParam) (*StateSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotState not implemented")
+}
+func (UnimplementedTransactServer) RestoreState(context.Context, *StateSnapshotParam) (*StateSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreState not implemented")
+}
 func (UnimplementedTransactServer) mustEmbedUnimplementedTransactServer() {}
 
 // UnsafeTransactServer may be embedded to opt out of forward compatibility for this service.
@@ -396,6 +466,42 @@ func _Transact_CallCodeSim_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_CallTxSimBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallTxBundleParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).CallTxSimBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/CallTxSimBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).CallTxSimBundle(ctx, req.(*CallTxBundleParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transact_CallTxSimProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.CallTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).CallTxSimProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/CallTxSimProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).CallTxSimProfile(ctx, req.(*payload.CallTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_SendTxSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.SendTx)
 	if err := dec(in); err != nil {
@@ -468,6 +574,42 @@ func _Transact_NameTxAsync_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_SnapshotState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateSnapshotParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).SnapshotState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/SnapshotState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).SnapshotState(ctx, req.(*StateSnapshotParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transact_RestoreState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateSnapshotParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).RestoreState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/RestoreState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).RestoreState(ctx, req.(*StateSnapshotParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Transact_ServiceDesc is the grpc.ServiceDesc for Transact service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -507,6 +649,14 @@ var Transact_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CallCodeSim",
 			Handler:    _Transact_CallCodeSim_Handler,
 		},
+		{
+			MethodName: "CallTxSimBundle",
+			Handler:    _Transact_CallTxSimBundle_Handler,
+		},
+		{
+			MethodName: "CallTxSimProfile",
+			Handler:    _Transact_CallTxSimProfile_Handler,
+		},
 		{
 			MethodName: "SendTxSync",
 			Handler:    _Transact_SendTxSync_Handler,
@@ -523,6 +673,14 @@ var Transact_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NameTxAsync",
 			Handler:    _Transact_NameTxAsync_Handler,
 		},
+		{
+			MethodName: "SnapshotState",
+			Handler:    _Transact_SnapshotState_Handler,
+		},
+		{
+			MethodName: "RestoreState",
+			Handler:    _Transact_RestoreState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpctransact.proto",
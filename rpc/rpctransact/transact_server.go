@@ -7,6 +7,7 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
 
 	"github.com/hyperledger/burrow/bcm"
 
@@ -20,21 +21,27 @@ import (
 // This is probably silly
 const maxBroadcastSyncTimeout = time.Hour
 
+var errNoSnapshots = fmt.Errorf("state snapshots are only available on chains running without consensus, " +
+	"such as burrow dev")
+
 type transactServer struct {
 	UnimplementedTransactServer
 	stateSnapshot func() (acmstate.Reader, error)
 	blockchain    bcm.BlockchainInfo
 	transactor    *execution.Transactor
+	snapshots     *execution.StateSnapshots
 	txCodec       txs.Codec
 	logger        *logging.Logger
 }
 
 func NewTransactServer(stateSnapshotter func() (acmstate.Reader, error), blockchain bcm.BlockchainInfo,
-	transactor *execution.Transactor, txCodec txs.Codec, logger *logging.Logger) TransactServer {
+	transactor *execution.Transactor, snapshots *execution.StateSnapshots, txCodec txs.Codec,
+	logger *logging.Logger) TransactServer {
 	return &transactServer{
 		stateSnapshot: stateSnapshotter,
 		blockchain:    blockchain,
 		transactor:    transactor,
+		snapshots:     snapshots,
 		txCodec:       txCodec,
 		logger:        logger.WithScope("NewTransactServer()"),
 	}
@@ -51,7 +58,11 @@ func (ts *transactServer) BroadcastTxSync(ctx context.Context, param *TxEnvelope
 	if txEnv == nil {
 		return nil, fmt.Errorf("%s no transaction envelope or payload provided", errHeader)
 	}
-	return ts.transactor.BroadcastTxSync(ctx, txEnv)
+	txe, err := ts.transactor.BroadcastTxSync(ctx, txEnv)
+	if err != nil {
+		return nil, err
+	}
+	return ts.decode(txe), nil
 }
 
 func (ts *transactServer) BroadcastTxAsync(ctx context.Context, param *TxEnvelopeParam) (*txs.Receipt, error) {
@@ -107,7 +118,11 @@ func (ts *transactServer) CallTxSim(ctx context.Context, param *payload.CallTx)
 	if err != nil {
 		return nil, err
 	}
-	return execution.CallSim(st, ts.blockchain, param.Input.Address, *param.Address, param.Data, ts.logger)
+	txe, err := execution.CallSim(st, ts.blockchain, param.Input.Address, *param.Address, param.Data, ts.logger)
+	if err != nil {
+		return nil, err
+	}
+	return execution.NewMetadataDecoder(st).DecodeTxExecution(txe), nil
 }
 
 func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam) (*exec.TxExecution, error) {
@@ -116,8 +131,53 @@ func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam)
 	if err != nil {
 		return nil, err
 	}
-	return execution.CallCodeSim(st, ts.blockchain, param.FromAddress, param.FromAddress, param.Code, param.Data,
+	txe, err := execution.CallCodeSim(st, ts.blockchain, param.FromAddress, param.FromAddress, param.Code, param.Data,
 		ts.logger)
+	if err != nil {
+		return nil, err
+	}
+	return execution.NewMetadataDecoder(st).DecodeTxExecution(txe), nil
+}
+
+func (ts *transactServer) CallTxSimBundle(ctx context.Context, param *CallTxBundleParam) (*CallTxBundleResult, error) {
+	// Get a consistent state view for duration of the simulated calls
+	st, err := ts.stateSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	txes, diffs, err := execution.CallSimBundle(st, ts.blockchain, param.CallTxs, ts.logger)
+	if err != nil {
+		return nil, err
+	}
+	decoder := execution.NewMetadataDecoder(st)
+	result := &CallTxBundleResult{
+		Results: make([]*CallTxSimResult, len(txes)),
+	}
+	for i, txe := range txes {
+		result.Results[i] = &CallTxSimResult{
+			TxExecution: decoder.DecodeTxExecution(txe),
+			StateDiff:   diffs[i],
+		}
+		result.GasUsed += txe.GetResult().GetGasUsed()
+	}
+	return result, nil
+}
+
+func (ts *transactServer) CallTxSimProfile(ctx context.Context, param *payload.CallTx) (*CallTxProfileResult, error) {
+	if param.Address == nil {
+		return nil, fmt.Errorf("CallTxSimProfile requires a non-nil address from which to retrieve code")
+	}
+	// Get a consistent state view for duration of simulated call
+	st, err := ts.stateSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	txe, profiler, err := execution.CallSimProfile(st, ts.blockchain, param.Input.Address, *param.Address, param.Data,
+		ts.logger)
+	if err != nil {
+		return nil, err
+	}
+	return NewCallTxProfileResult(execution.NewMetadataDecoder(st).DecodeTxExecution(txe), profiler), nil
 }
 
 func (ts *transactServer) SendTxSync(ctx context.Context, param *payload.SendTx) (*exec.TxExecution, error) {
@@ -136,6 +196,39 @@ func (ts *transactServer) NameTxAsync(ctx context.Context, param *payload.NameTx
 	return ts.BroadcastTxAsync(ctx, &TxEnvelopeParam{Payload: param.Any()})
 }
 
+func (ts *transactServer) SnapshotState(ctx context.Context, param *StateSnapshotParam) (*StateSnapshot, error) {
+	if ts.snapshots == nil {
+		return nil, errNoSnapshots
+	}
+	snapshot, err := ts.snapshots.Take(param.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &StateSnapshot{Name: snapshot.Name, Height: snapshot.Height}, nil
+}
+
+func (ts *transactServer) RestoreState(ctx context.Context, param *StateSnapshotParam) (*StateSnapshot, error) {
+	if ts.snapshots == nil {
+		return nil, errNoSnapshots
+	}
+	snapshot, err := ts.snapshots.Restore(param.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &StateSnapshot{Name: snapshot.Name, Height: snapshot.Height}, nil
+}
+
+// Decode call data, events and the payload given to revert against the latest state in which the metadata of any
+// contracts created will have been registered
+func (ts *transactServer) decode(txe *exec.TxExecution) *exec.TxExecution {
+	st, err := ts.stateSnapshot()
+	if err != nil {
+		ts.logger.InfoMsg("Could not get state to decode TxExecution", structure.ErrorKey, err)
+		return txe
+	}
+	return execution.NewMetadataDecoder(st).DecodeTxExecution(txe)
+}
+
 func (te *TxEnvelopeParam) GetEnvelope(chainID string) *txs.Envelope {
 	if te == nil {
 		return nil
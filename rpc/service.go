@@ -9,6 +9,10 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/p2p"
+	core_types "github.com/cometbft/cometbft/rpc/core/types"
+	tmTypes "github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
@@ -16,17 +20,17 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/execution/unbonding"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
-	"github.com/tendermint/tendermint/consensus"
-	"github.com/tendermint/tendermint/p2p"
-	core_types "github.com/tendermint/tendermint/rpc/core/types"
-	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 // Magic! Should probably be configurable, but not shouldn't be so huge we
@@ -35,28 +39,31 @@ const MaxBlockLookback = 1000
 
 // Base service that provides implementation for all underlying RPC methods
 type Service struct {
-	state      acmstate.IterableStatsReader
-	nameReg    names.IterableReader
-	nodeReg    registry.IterableReader
-	blockchain bcm.BlockchainInfo
-	validators validator.History
-	nodeView   *tendermint.NodeView
-	logger     *logging.Logger
+	state         acmstate.IterableStatsReader
+	nameReg       names.IterableReader
+	nodeReg       registry.IterableReader
+	blockchain    bcm.BlockchainInfo
+	validators    validator.History
+	contractStats exec.ContractStatsGetter
+	nodeView      *tendermint.NodeView
+	logger        *logging.Logger
 }
 
 // Service provides an internal query and information service with serialisable return types on which can accomodate
 // a number of transport front ends
 func NewService(state acmstate.IterableStatsReader, nameReg names.IterableReader, nodeReg registry.IterableReader, blockchain bcm.BlockchainInfo,
-	validators validator.History, nodeView *tendermint.NodeView, logger *logging.Logger) *Service {
+	validators validator.History, contractStats exec.ContractStatsGetter, nodeView *tendermint.NodeView,
+	logger *logging.Logger) *Service {
 
 	return &Service{
-		state:      state,
-		nameReg:    nameReg,
-		nodeReg:    nodeReg,
-		blockchain: blockchain,
-		validators: validators,
-		nodeView:   nodeView,
-		logger:     logger.With(structure.ComponentKey, "Service"),
+		state:         state,
+		nameReg:       nameReg,
+		nodeReg:       nodeReg,
+		blockchain:    blockchain,
+		validators:    validators,
+		contractStats: contractStats,
+		nodeView:      nodeView,
+		logger:        logger.With(structure.ComponentKey, "Service"),
 	}
 }
 
@@ -257,6 +264,31 @@ func (s *Service) AccountStats() (*ResultAccountStats, error) {
 	}, nil
 }
 
+// ContractStats returns the calls made to the limit contracts with the most gas used, or all of them if limit is 0
+func (s *Service) ContractStats(limit int) (*ResultContractStats, error) {
+	var stats []exec.ContractStat
+	if s.contractStats != nil {
+		stats = s.contractStats.GetContractStats()
+	}
+	if limit > 0 && len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return &ResultContractStats{
+		Contracts: stats,
+	}, nil
+}
+
+// CacheStats returns the sizes of the caches of state and of the EVM's analysed code, and how often they have been used
+func (s *Service) CacheStats() (*ResultCacheStats, error) {
+	result := &ResultCacheStats{
+		Code: evm.GetCodeCacheStats(),
+	}
+	if cached, ok := s.state.(interface{ CacheStats() storage.CacheStats }); ok {
+		result.State = cached.CacheStats()
+	}
+	return result, nil
+}
+
 // Name registry
 func (s *Service) Name(name string) (*ResultName, error) {
 	entry, err := s.nameReg.GetName(name)
@@ -347,6 +379,18 @@ func (s *Service) Validators() (*ResultValidators, error) {
 	}, nil
 }
 
+// Unbonding returns the withdrawals of unbonded power still to be paid to the validator at address
+func (s *Service) Unbonding(address crypto.Address) (*ResultUnbonding, error) {
+	withdrawals, err := unbonding.Pending(s.state, address)
+	if err != nil {
+		return nil, err
+	}
+	return &ResultUnbonding{
+		BlockHeight: s.blockchain.LastBlockHeight(),
+		Withdrawals: withdrawals,
+	}, nil
+}
+
 func (s *Service) ConsensusState() (*ResultConsensusState, error) {
 	if s.nodeView == nil {
 		return nil, fmt.Errorf("cannot pull ConsensusState because NodeView not mounted")
@@ -355,7 +399,7 @@ func (s *Service) ConsensusState() (*ResultConsensusState, error) {
 	peerStates := make([]core_types.PeerStateInfo, len(peers))
 	for i, peer := range peers {
 		peerState := peer.Get(tmTypes.PeerStateKey).(*consensus.PeerState)
-		peerStateJSON, err := peerState.ToJSON()
+		peerStateJSON, err := peerState.MarshalJSON()
 		if err != nil {
 			return nil, err
 		}
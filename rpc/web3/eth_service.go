@@ -3,30 +3,33 @@ package web3
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 
 	"github.com/hyperledger/burrow/encoding"
-	"github.com/hyperledger/burrow/encoding/rlp"
 	"github.com/hyperledger/burrow/encoding/web3hex"
 
+	tmConfig "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/types"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/acm/validator"
 	bcm "github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/feemarket"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/project"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
-	tmConfig "github.com/tendermint/tendermint/config"
-	"github.com/tendermint/tendermint/types"
 )
 
 const (
@@ -36,6 +39,9 @@ const (
 	pending      = "null"
 )
 
+// Most blocks we will report in a single eth_feeHistory response
+const maxFeeHistoryBlocks = 1024
+
 // EthService is a web3 provider
 type EthService struct {
 	accounts   acmstate.IterableStatsReader
@@ -86,6 +92,10 @@ var _ Service = &EthService{}
 type EventsReader interface {
 	TxsAtHeight(height uint64) ([]*exec.TxExecution, error)
 	TxByHash(txHash []byte) (*exec.TxExecution, error)
+	LogsBloom(height uint64) (*exec.LogsBloom, error)
+	IterateStreamEventsFiltered(startHeight, endHeight uint64, mayMatch func(height uint64, bloom *exec.LogsBloom) bool,
+		consumer func(*exec.StreamEvent) error) error
+	execution.BeginBlockReader
 }
 
 var _ EventsReader = &state.State{}
@@ -164,7 +174,7 @@ func (srv *EthService) EthCall(req *EthCallParams) (*EthCallResult, error) {
 	if err != nil {
 		return nil, err
 	} else if txe.Exception != nil {
-		return nil, txe.Exception.AsError()
+		return nil, srv.txError(txe)
 	}
 
 	var result string
@@ -535,20 +545,213 @@ func (srv *EthService) EthPendingTransactions() (*EthPendingTransactionsResult,
 	}, nil
 }
 
+// EthEstimateGas returns the least gas limit, to within a small margin above it, with which the transaction succeeds
+// when simulated against the latest state with any state overrides applied
 func (srv *EthService) EthEstimateGas(req *EthEstimateGasParams) (*EthEstimateGasResult, error) {
-	// TODO
+	d := new(web3hex.Decoder)
+	tx := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: d.Address(req.Transaction.From),
+		},
+		Data: d.Bytes(req.Transaction.Data),
+	}
+	if to := req.Transaction.To; to != "" {
+		addr := d.Address(to)
+		tx.Address = &addr
+	}
+	if gasLimit := req.Transaction.Gas; gasLimit != "" {
+		tx.GasLimit = d.Uint64(gasLimit)
+	}
+	if value := req.Transaction.Value; value != "" {
+		tx.Input.Amount = d.Uint64(value)
+	}
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
+	overrides, err := decodeStateOverrides(req.StateOverride)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, txe, err := execution.EstimateGas(srv.accounts, srv.blockchain, tx, overrides, srv.logger)
+	if err != nil {
+		return nil, err
+	} else if txe != nil {
+		return nil, srv.txError(txe)
+	}
 	return &EthEstimateGasResult{
-		GasUsed: hexZero,
+		GasUsed: web3hex.Encoder.Uint64(gasLimit),
 	}, nil
 }
 
+func decodeStateOverrides(stateOverride map[string]StateOverride) (map[crypto.Address]*execution.StateOverride, error) {
+	if len(stateOverride) == 0 {
+		return nil, nil
+	}
+	d := new(web3hex.Decoder)
+	decodeStorage := func(storage map[string]string) map[binary.Word256][]byte {
+		if storage == nil {
+			return nil
+		}
+		values := make(map[binary.Word256][]byte, len(storage))
+		for key, value := range storage {
+			values[binary.LeftPadWord256(d.Bytes(key))] = binary.LeftPadBytes(d.Bytes(value), binary.Word256Bytes)
+		}
+		return values
+	}
+	overrides := make(map[crypto.Address]*execution.StateOverride, len(stateOverride))
+	for address, override := range stateOverride {
+		decoded := &execution.StateOverride{
+			Storage:     decodeStorage(override.State),
+			StorageDiff: decodeStorage(override.StateDiff),
+		}
+		if override.Balance != "" {
+			amount := balance.WeiToNative(d.BigInt(override.Balance)).Uint64()
+			decoded.Balance = &amount
+		}
+		if override.Nonce != "" {
+			sequence := d.Uint64(override.Nonce)
+			decoded.Sequence = &sequence
+		}
+		if override.Code != "" {
+			decoded.EVMCode = d.Bytes(override.Code)
+		}
+		overrides[d.Address(address)] = decoded
+	}
+	if d.Err() != nil {
+		return nil, fmt.Errorf("failed to parse state override: %v", d.Err())
+	}
+	return overrides, nil
+}
+
 func (srv *EthService) EthGasPrice() (*EthGasPriceResult, error) {
-	// TODO
+	feeMarket := srv.blockchain.GenesisDoc().Params.FeeMarket
+	if feeMarket == nil {
+		return &EthGasPriceResult{
+			GasPrice: hexZero,
+		}, nil
+	}
+	// The base fee of the next block - we do not suggest a priority fee, see EthMaxPriorityFeePerGas
+	baseFee, _, err := srv.blockFees(feeMarket, srv.blockchain.LastBlockHeight()+1)
+	if err != nil {
+		return nil, err
+	}
 	return &EthGasPriceResult{
-		GasPrice: hexZero,
+		GasPrice: web3hex.Encoder.Uint64(baseFee),
+	}, nil
+}
+
+// EthMaxPriorityFeePerGas returns the suggested priority fee, which is zero since transactions are not prioritised by
+// their tip when proposing blocks
+func (srv *EthService) EthMaxPriorityFeePerGas() (*EthMaxPriorityFeePerGasResult, error) {
+	return &EthMaxPriorityFeePerGasResult{
+		MaxPriorityFeePerGas: hexZero,
 	}, nil
 }
 
+// EthFeeHistory returns the base fees and gas used over a range of blocks ending at NewestBlock along with the
+// priority fees paid per gas at each of the RewardPercentiles weighted by gas used
+func (srv *EthService) EthFeeHistory(req *EthFeeHistoryParams) (*EthFeeHistoryResult, error) {
+	feeMarket := srv.blockchain.GenesisDoc().Params.FeeMarket
+	if feeMarket == nil {
+		return nil, fmt.Errorf("fee market is not enabled on this chain")
+	}
+	d := new(web3hex.Decoder)
+	blockCount := d.Uint64(req.BlockCount)
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
+	newest, err := srv.getHeightByWordOrNumber(req.NewestBlock)
+	if err != nil {
+		return nil, err
+	}
+	for i, percentile := range req.RewardPercentiles {
+		if percentile < 0 || percentile > 100 || (i > 0 && percentile < req.RewardPercentiles[i-1]) {
+			return nil, fmt.Errorf("reward percentiles must be ascending values between 0 and 100")
+		}
+	}
+	if blockCount > maxFeeHistoryBlocks {
+		blockCount = maxFeeHistoryBlocks
+	}
+	if blockCount > newest+1 {
+		blockCount = newest + 1
+	}
+	history := FeeHistory{
+		OldestBlock:   web3hex.Encoder.Uint64(newest + 1 - blockCount),
+		BaseFeePerGas: make([]string, 0, blockCount+1),
+		GasUsedRatio:  make([]float64, 0, blockCount),
+	}
+	if blockCount == 0 {
+		return &EthFeeHistoryResult{FeeHistory: history}, nil
+	}
+	// The fee market targets half of the gas notionally available to a block as Ethereum does
+	gasLimit := 2 * float64(feeMarket.GasTarget)
+	var baseFee, gasUsed uint64
+	for height := newest + 1 - blockCount; height <= newest; height++ {
+		baseFee, gasUsed, err = srv.blockFees(feeMarket, height)
+		if err != nil {
+			return nil, err
+		}
+		history.BaseFeePerGas = append(history.BaseFeePerGas, web3hex.Encoder.Uint64(baseFee))
+		history.GasUsedRatio = append(history.GasUsedRatio, float64(gasUsed)/gasLimit)
+		if len(req.RewardPercentiles) > 0 {
+			rewards, err := srv.priorityFeePercentiles(height, baseFee, req.RewardPercentiles)
+			if err != nil {
+				return nil, err
+			}
+			history.Reward = append(history.Reward, rewards)
+		}
+	}
+	// Include the base fee of the block following the range
+	history.BaseFeePerGas = append(history.BaseFeePerGas,
+		web3hex.Encoder.Uint64(feeMarket.NextBaseFee(baseFee, gasUsed)))
+	return &EthFeeHistoryResult{FeeHistory: history}, nil
+}
+
+// Get the priority fee per gas at each of the percentiles of the gas used by the transactions in the block at height
+func (srv *EthService) priorityFeePercentiles(height, baseFee uint64, percentiles []float64) ([]string, error) {
+	rewards := make([]string, len(percentiles))
+	txes, err := srv.events.TxsAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	type txTip struct {
+		tip     uint64
+		gasUsed uint64
+	}
+	var tips []txTip
+	var totalGasUsed uint64
+	for _, txe := range txes {
+		_, tx, err := getHashAndCallTxFromExecution(txe)
+		// Transactions offering less than the base fee are rejected
+		if err != nil || tx.GasPrice < baseFee {
+			continue
+		}
+		gasPrice := feemarket.EffectiveGasPrice(baseFee, tx.GasPrice, txe.Envelope.GasTipCap(tx.GasPrice))
+		tips = append(tips, txTip{tip: gasPrice - baseFee, gasUsed: txe.GetResult().GetGasUsed()})
+		totalGasUsed += txe.GetResult().GetGasUsed()
+	}
+	if len(tips) == 0 {
+		for i := range rewards {
+			rewards[i] = hexZero
+		}
+		return rewards, nil
+	}
+	sort.Slice(tips, func(i, j int) bool {
+		return tips[i].tip < tips[j].tip
+	})
+	var i int
+	cumulativeGasUsed := tips[0].gasUsed
+	for p, percentile := range percentiles {
+		threshold := uint64(float64(totalGasUsed) * percentile / 100)
+		for cumulativeGasUsed < threshold && i < len(tips)-1 {
+			i++
+			cumulativeGasUsed += tips[i].gasUsed
+		}
+		rewards[p] = web3hex.Encoder.Uint64(tips[i].tip)
+	}
+	return rewards, nil
+}
+
 func (srv *EthService) EthGetRawTransactionByHash(req *EthGetRawTransactionByHashParams) (*EthGetRawTransactionByHashResult, error) {
 	// TODO
 	return nil, ErrNotFound
@@ -573,8 +776,8 @@ func (srv *EthService) EthSendRawTransaction(req *EthSendRawTransactionParams) (
 		return nil, d.Err()
 	}
 
-	rawTx := txs.NewEthRawTx(srv.chainID)
-	err := rlp.Decode(data, rawTx)
+	// Accepts both legacy and EIP-2718 typed (access list and dynamic fee) transactions
+	rawTx, err := txs.DecodeEthRawTx(data, srv.chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -601,7 +804,8 @@ func (srv *EthService) EthSendRawTransaction(req *EthSendRawTransactionParams) (
 				Signature: signature,
 			},
 		},
-		Encoding: txs.Envelope_RLP,
+		Encoding:   txs.Envelope_RLP,
+		EthTypedTx: rawTx.Typed(),
 		Tx: &txs.Tx{
 			ChainID: srv.blockchain.ChainID(),
 			Payload: &payload.CallTx{
@@ -625,7 +829,7 @@ func (srv *EthService) EthSendRawTransaction(req *EthSendRawTransactionParams) (
 	if err != nil {
 		return nil, err
 	} else if txe.Exception != nil {
-		return nil, txe.Exception.AsError()
+		return nil, srv.txError(txe)
 	}
 
 	return &EthSendRawTransactionResult{
@@ -670,6 +874,17 @@ func hexKeccakAddress(data []byte) string {
 
 func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (Block, error) {
 	doc := srv.blockchain.GenesisDoc()
+	var baseFeePerGas OptionalQuantity
+	var gasUsed uint64
+	if feeMarket := doc.Params.FeeMarket; feeMarket != nil {
+		baseFee, used, err := srv.blockFees(feeMarket, height)
+		if err != nil {
+			return Block{}, err
+		}
+		encoded := web3hex.Encoder.Uint64(baseFee)
+		baseFeePerGas = &encoded
+		gasUsed = used
+	}
 	if height == 0 {
 		// genesis
 		return Block{
@@ -689,6 +904,7 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (Blo
 			TotalDifficulty: hexZero,
 			GasLimit:        hexZero,
 			GasUsed:         hexZero,
+			BaseFeePerGas:   baseFeePerGas,
 		}, nil
 	}
 	block, err := srv.getBlockHeaderAtHeight(height)
@@ -703,6 +919,14 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (Blo
 		return Block{}, err
 	}
 
+	logsBloom := hexZero
+	bloom, err := srv.events.LogsBloom(height)
+	if err != nil {
+		return Block{}, err
+	} else if bloom != nil {
+		logsBloom = web3hex.Encoder.Bytes(bloom[:])
+	}
+
 	transactions := make([]Transactions, 0)
 	if includeTxs {
 		txes, err := srv.events.TxsAtHeight(height)
@@ -731,18 +955,35 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (Blo
 		Number:           web3hex.Encoder.Uint64(uint64(block.Height)),
 		Miner:            web3hex.Encoder.Bytes(block.ProposerAddress.Bytes()),
 		Sha3Uncles:       hexZero,
-		LogsBloom:        hexZero,
+		LogsBloom:        logsBloom,
 		ExtraData:        hexZero,
 		Difficulty:       hexZero,
 		TotalDifficulty:  hexZero,
-		GasUsed:          hexZero,
+		GasUsed:          web3hex.Encoder.Uint64(gasUsed),
 		GasLimit:         web3hex.Encoder.Uint64(maxGasLimit),
 		Timestamp:        web3hex.Encoder.Uint64(uint64(block.Time.Unix())),
 		Transactions:     transactions,
 		Uncles:           []string{},
+		BaseFeePerGas:    baseFeePerGas,
 	}, nil
 }
 
+// Get the base fee and gas used of the block at height when the fee market is enabled
+func (srv *EthService) blockFees(feeMarket *feemarket.Params, height uint64) (baseFee, gasUsed uint64, err error) {
+	if height == 0 {
+		return feeMarket.InitialBaseFee, 0, nil
+	}
+	beginBlock, err := srv.events.LastBeginBlock(height)
+	if err != nil {
+		return 0, 0, err
+	}
+	if beginBlock.GetHeight() == height {
+		return beginBlock.BaseFee, beginBlock.GasUsed, nil
+	}
+	// Blocks without transactions are not stored in state
+	return feeMarket.BaseFeeAt(height, beginBlock.GetHeight(), beginBlock.GetBaseFee(), beginBlock.GetGasUsed()), 0, nil
+}
+
 func getTransaction(block *types.Header, hash []byte, tx *payload.CallTx) Transaction {
 	// TODO: sensible defaults for non-call
 	transaction := Transaction{
@@ -866,7 +1107,7 @@ func (srv *EthService) EthSendTransaction(req *EthSendTransactionParams) (*EthSe
 	if err != nil {
 		return nil, err
 	} else if txe.Exception != nil {
-		return nil, txe.Exception.AsError()
+		return nil, srv.txError(txe)
 	}
 
 	return &EthSendTransactionResult{
@@ -931,6 +1172,54 @@ func (srv *EthService) EthSign(req *EthSignParams) (*EthSignResult, error) {
 	}, nil
 }
 
+// EthSignTypedDataV4: https://eips.ethereum.org/EIPS/eip-712#specification-of-the-eth_signtypeddata-json-rpc
+func (srv *EthService) EthSignTypedDataV4(req *EthSignTypedDataV4Params) (*EthSignTypedDataV4Result, error) {
+	d := new(web3hex.Decoder)
+	from := d.Address(req.Address)
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
+
+	typedData := []byte(req.TypedData)
+	// Wallets pass the typed data as a string of JSON
+	var str string
+	if json.Unmarshal(typedData, &str) == nil {
+		typedData = []byte(str)
+	}
+	td, err := crypto.TypedDataFromJSON(typedData)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := td.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	if chainID != nil && chainID.Cmp(srv.chainID) != 0 {
+		return nil, fmt.Errorf("typed data is for chain %v but this is chain %v", chainID, srv.chainID)
+	}
+	msg, err := td.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := keys.AddressableSigner(srv.keyClient, from)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	ethSig, err := sig.EthereumSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	return &EthSignTypedDataV4Result{
+		Signature: web3hex.Encoder.Bytes(ethSig),
+	}, nil
+}
+
 // N / A
 
 func (srv *EthService) EthUninstallFilter(*EthUninstallFilterParams) (*EthUninstallFilterResult, error) {
@@ -993,6 +1282,95 @@ func (srv *EthService) EthCoinbase() (*EthCoinbaseResult, error) {
 	return nil, ErrNotFound
 }
 
+// EthGetLogs returns the logs of successful transactions matching the filter, skipping the blocks whose logs bloom
+// shows they have none
 func (srv *EthService) EthGetLogs(req *EthGetLogsParams) (*EthGetLogsResult, error) {
-	return nil, ErrNotFound
+	qry, err := logsQuery(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	from, err := srv.getHeightByWordOrNumber(orLatest(req.FromBlock))
+	if err != nil {
+		return nil, err
+	}
+	to, err := srv.getHeightByWordOrNumber(orLatest(req.ToBlock))
+	if err != nil {
+		return nil, err
+	}
+	result := &EthGetLogsResult{Logs: []Logs{}}
+	if to < from {
+		return result, nil
+	}
+
+	var blockHash string
+	var logIndex uint64
+	var stack exec.TxStack
+	err = srv.events.IterateStreamEventsFiltered(from, to, func(_ uint64, bloom *exec.LogsBloom) bool {
+		return bloom.MayMatch(qry)
+	}, func(sev *exec.StreamEvent) error {
+		if sev.BeginBlock != nil {
+			block, err := srv.getBlockHeaderAtHeight(sev.BeginBlock.Height)
+			if err != nil {
+				return err
+			} else if block == nil {
+				return fmt.Errorf("block at height %d does not exist", sev.BeginBlock.Height)
+			}
+			blockHash = hexKeccak(block.Hash().Bytes())
+			logIndex = 0
+		}
+		txe, err := stack.Consume(sev)
+		if err != nil {
+			return err
+		}
+		if txe == nil || txe.Exception != nil {
+			return nil
+		}
+		for _, ev := range txe.Events {
+			if ev.Log == nil {
+				continue
+			}
+			if qry.Matches(ev) {
+				topics := make([]string, len(ev.Log.Topics))
+				for i, topic := range ev.Log.Topics {
+					topics[i] = web3hex.Encoder.Bytes(topic.Bytes())
+				}
+				result.Logs = append(result.Logs, Logs{
+					LogIndex:         web3hex.Encoder.Uint64(logIndex),
+					TransactionIndex: web3hex.Encoder.Uint64(txe.GetIndex()),
+					TransactionHash:  web3hex.Encoder.Bytes(txe.GetTxHash().Bytes()),
+					Address:          web3hex.Encoder.Bytes(ev.Log.Address.Bytes()),
+					BlockHash:        blockHash,
+					BlockNumber:      web3hex.Encoder.Uint64(txe.GetHeight()),
+					Data:             web3hex.Encoder.Bytes(ev.Log.Data),
+					Topics:           topics,
+				})
+			}
+			logIndex++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Block parameters that are omitted default to the latest block
+func orLatest(height string) string {
+	if height == "" {
+		return "latest"
+	}
+	return height
+}
+
+// Returns the exception raised by txe as an error, as a RevertError if it was reverted with a payload that can be decoded
+func (srv *EthService) txError(txe *exec.TxExecution) error {
+	txe = execution.NewMetadataDecoder(srv.accounts).DecodeRevert(txe)
+	if txe.Exception.Revert == nil {
+		return txe.Exception.AsError()
+	}
+	return &RevertError{
+		Exception: txe.Exception,
+		Data:      txe.GetResult().GetReturn(),
+	}
 }
@@ -2,6 +2,7 @@ package web3_test
 
 import (
 	"context"
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/encoding/web3hex"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/solidity"
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
@@ -250,6 +252,50 @@ func TestWeb3Service(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, web3hex.Encoder.BytesTrim(rpc.DeployedBytecode_HelloWorld), strings.ToLower(result.Bytes))
 		})
+
+		t.Run("EthGetLogs", func(t *testing.T) {
+			from := web3hex.Encoder.BytesTrim(genesisAccounts[3].GetAddress().Bytes())
+			sendResult, err := eth.EthSendTransaction(&web3.EthSendTransactionParams{
+				Transaction: web3.Transaction{
+					From: from,
+					Gas:  web3hex.Encoder.Uint64(1000000),
+					Data: web3hex.Encoder.BytesTrim(solidity.Bytecode_EventEmitter),
+				},
+			})
+			require.NoError(t, err)
+			receiptResult, err := eth.EthGetTransactionReceipt(&web3.EthGetTransactionReceiptParams{
+				TransactionHash: sendResult.TransactionHash,
+			})
+			require.NoError(t, err)
+			emitterAddress := receiptResult.Receipt.ContractAddress
+
+			packed, _, err := abi.EncodeFunctionCall(string(solidity.Abi_EventEmitter), "EmitOne", logger)
+			require.NoError(t, err)
+			sendResult, err = eth.EthSendTransaction(&web3.EthSendTransactionParams{
+				Transaction: web3.Transaction{
+					From: from,
+					To:   emitterAddress,
+					Gas:  web3hex.Encoder.Uint64(1000000),
+					Data: web3hex.Encoder.BytesTrim(packed),
+				},
+			})
+			require.NoError(t, err)
+
+			result, err := eth.EthGetLogs(&web3.EthGetLogsParams{
+				Filter: web3.Filter{FromBlock: "earliest", Address: web3.FilterValues{emitterAddress}},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Logs, 1)
+			require.Equal(t, emitterAddress, result.Logs[0].Address)
+			require.Equal(t, sendResult.TransactionHash, result.Logs[0].TransactionHash)
+
+			// Nothing is logged by the HelloWorld contract
+			result, err = eth.EthGetLogs(&web3.EthGetLogsParams{
+				Filter: web3.Filter{FromBlock: "earliest", Address: web3.FilterValues{contractAddress}},
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Logs)
+		})
 	})
 
 	t.Run("EthMining", func(t *testing.T) {
@@ -278,6 +324,47 @@ func TestWeb3Service(t *testing.T) {
 		require.Equal(t, `0x1ba96f3dd6cbbc27aaaafe9d68a5368653f72a5677e365e2505ad207a5e8741949717cfc1cc107583142bfe54b9ba4840f5ad7cb12b224dd97b2fb6a735b93c57a`, result.Signature)
 	})
 
+	t.Run("EthSignTypedDataV4", func(t *testing.T) {
+		typedData := `{
+		  "types": {
+		    "Permit": [
+		      {"name": "owner", "type": "address"},
+		      {"name": "spender", "type": "address"},
+		      {"name": "value", "type": "uint256"},
+		      {"name": "nonce", "type": "uint256"},
+		      {"name": "deadline", "type": "uint256"}
+		    ]
+		  },
+		  "primaryType": "Permit",
+		  "domain": {"name": "Token", "version": "1", "chainId": ` + chainID + `, "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"},
+		  "message": {
+		    "owner": "0x` + genesisAccounts[1].GetAddress().String() + `",
+		    "spender": "0x` + genesisAccounts[2].GetAddress().String() + `",
+		    "value": "1000000000000000000000",
+		    "nonce": 0,
+		    "deadline": "0xffffffffffffffff"
+		  }
+		}`
+		// As wallets send it, a string of JSON
+		param, err := json.Marshal(typedData)
+		require.NoError(t, err)
+		result, err := eth.EthSignTypedDataV4(&web3.EthSignTypedDataV4Params{
+			Address:   "0x" + genesisAccounts[1].GetAddress().String(),
+			TypedData: param,
+		})
+		require.NoError(t, err)
+
+		td, err := crypto.TypedDataFromJSON([]byte(typedData))
+		require.NoError(t, err)
+		require.NoError(t, td.Verify(genesisAccounts[1].GetAddress(), d.Bytes(result.Signature)))
+
+		_, err = eth.EthSignTypedDataV4(&web3.EthSignTypedDataV4Params{
+			Address:   "0x" + genesisAccounts[1].GetAddress().String(),
+			TypedData: json.RawMessage(strings.Replace(typedData, chainID, "1", 1)),
+		})
+		require.Error(t, err)
+	})
+
 	t.Run("EthGetBlock", func(t *testing.T) {
 		numberResult, err := eth.EthGetBlockByNumber(&web3.EthGetBlockByNumberParams{BlockNumber: web3hex.Encoder.Uint64(1)})
 		require.NoError(t, err)
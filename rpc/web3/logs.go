@@ -0,0 +1,69 @@
+package web3
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// FilterValues holds a filter field that may be given as a single value or as an array of values any of which match.
+// It is empty when the field is null, which matches anything.
+type FilterValues []string
+
+func (fv *FilterValues) UnmarshalJSON(data []byte) error {
+	var value *string
+	err := json.Unmarshal(data, &value)
+	if err == nil {
+		*fv = nil
+		if value != nil {
+			*fv = FilterValues{*value}
+		}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(fv))
+}
+
+// Returns the query matching the LogEvents selected by filter
+func logsQuery(filter Filter) (query.Query, error) {
+	d := new(web3hex.Decoder)
+	qb := query.NewBuilder().AndEquals(event.EventTypeKey, exec.TypeLog.String())
+	if len(filter.Address) > 0 {
+		addresses := make([]interface{}, len(filter.Address))
+		for i, address := range filter.Address {
+			addresses[i] = d.Address(address)
+		}
+		qb = qb.And(anyEquals(event.AddressKey, addresses))
+	}
+	for i, topics := range filter.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		words := make([]interface{}, len(topics))
+		for j, topic := range topics {
+			bs := d.Bytes(topic)
+			if len(bs) > binary.Word256Bytes {
+				return nil, fmt.Errorf("topic %s is longer than %d bytes", topic, binary.Word256Bytes)
+			}
+			words[j] = binary.LeftPadWord256(bs)
+		}
+		qb = qb.And(anyEquals(exec.LogNKey(i), words))
+	}
+	if d.Err() != nil {
+		return nil, d.Err()
+	}
+	return qb.Query()
+}
+
+// Returns a parenthesised disjunction of tag = operand for each of operands
+func anyEquals(tag string, operands []interface{}) *query.Builder {
+	qb := query.NewBuilder()
+	for _, operand := range operands {
+		qb = qb.Or(query.NewBuilder().AndEquals(tag, operand))
+	}
+	return query.NewBuilder("(" + qb.String() + ")")
+}
@@ -0,0 +1,48 @@
+package web3
+
+import (
+	"github.com/hyperledger/burrow/encoding/web3hex"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+// The error code used by Ethereum clients when execution is reverted
+const revertErrorCode = 3
+
+// RevertError is returned when execution is reverted with a payload that could be decoded
+type RevertError struct {
+	*errors.Exception
+	// The raw payload given to revert
+	Data []byte
+}
+
+// RevertErrorData is the data of the JSON-RPC error returned for a RevertError
+type RevertErrorData struct {
+	// Error for a reason string, Panic for a failed assertion, or otherwise the name of a custom error
+	Name string `json:"name"`
+	// The reason string or a description of the panic code
+	Reason string `json:"reason,omitempty"`
+	// The panic code
+	PanicCode string `json:"panicCode,omitempty"`
+	// The arguments of a custom error
+	Args []string `json:"args,omitempty"`
+	// The raw payload given to revert
+	Data string `json:"data"`
+}
+
+func (err *RevertError) RPCError() *RPCError {
+	revert := err.Exception.Revert
+	data := RevertErrorData{
+		Name:   revert.Name,
+		Reason: revert.Reason,
+		Args:   revert.Args,
+		Data:   web3hex.Encoder.Bytes(err.Data),
+	}
+	if revert.Name == "Panic" {
+		data.PanicCode = web3hex.Encoder.Uint64(revert.PanicCode)
+	}
+	return &RPCError{
+		Code:    revertErrorCode,
+		Message: "execution reverted: " + revert.String(),
+		Data:    data,
+	}
+}
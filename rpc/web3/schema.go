@@ -0,0 +1,42 @@
+package web3
+
+import "encoding/json"
+
+// The types below are named in openrpc.json by schemas without a type of their own, since go-openrpc can only
+// generate structs of strings, so that types.go refers to them rather than trying to generate them
+
+// OptionalQuantity is a hex quantity that is null when absent
+type OptionalQuantity *string
+
+// StateOverrides holds the changes to make to accounts keyed by address
+type StateOverrides map[string]StateOverride
+
+type StateOverride struct {
+	// Hex representation of the balance in Wei
+	Balance string `json:"balance"`
+	// Hex representation of the nonce
+	Nonce string `json:"nonce"`
+	// Hex representation of the code
+	Code string `json:"code"`
+	// Values replacing all of the storage of the account keyed by storage slot
+	State map[string]string `json:"state"`
+	// Values replacing only the storage slots given
+	StateDiff map[string]string `json:"stateDiff"`
+}
+
+// Percentiles holds ascending values between 0 and 100
+type Percentiles []float64
+
+type FeeHistory struct {
+	// Hex representation of the height of the lowest block in the range
+	OldestBlock string `json:"oldestBlock"`
+	// Base fee per gas of each block in the range and of the block following the range
+	BaseFeePerGas []string `json:"baseFeePerGas"`
+	// Ratio of the gas used to the gas limit of each block in the range
+	GasUsedRatio []float64 `json:"gasUsedRatio"`
+	// Priority fees per gas at the requested percentiles for each block in the range
+	Reward [][]string `json:"reward,omitempty"`
+}
+
+// TypedDataJSON holds EIP-712 typed data as it was given, which may be a JSON object or a string of one
+type TypedDataJSON = json.RawMessage
@@ -285,6 +285,12 @@ func (srv *Server) Do(in RPCRequest) interface{} {
 		if err == nil {
 			out, err = srv.service.EthEstimateGas(req)
 		}
+	case "eth_feeHistory":
+		req := new(EthFeeHistoryParams)
+		err = ParamsToStruct(in.Params, req)
+		if err == nil {
+			out, err = srv.service.EthFeeHistory(req)
+		}
 	case "eth_gasPrice":
 		out, err = srv.service.EthGasPrice()
 	case "eth_getBalance":
@@ -431,6 +437,8 @@ func (srv *Server) Do(in RPCRequest) interface{} {
 		out, err = srv.service.EthHashrate()
 	case "eth_mining":
 		out, err = srv.service.EthMining()
+	case "eth_maxPriorityFeePerGas":
+		out, err = srv.service.EthMaxPriorityFeePerGas()
 	case "eth_newBlockFilter":
 		out, err = srv.service.EthNewBlockFilter()
 	case "eth_newFilter":
@@ -451,6 +459,12 @@ func (srv *Server) Do(in RPCRequest) interface{} {
 		if err == nil {
 			out, err = srv.service.EthSign(req)
 		}
+	case "eth_signTypedData_v4":
+		req := new(EthSignTypedDataV4Params)
+		err = ParamsToStruct(in.Params, req)
+		if err == nil {
+			out, err = srv.service.EthSignTypedDataV4(req)
+		}
 	case "eth_accounts":
 		out, err = srv.service.EthAccounts()
 	case "eth_sendTransaction":
@@ -487,7 +501,9 @@ func (srv *Server) Do(in RPCRequest) interface{} {
 		}
 	}
 
-	if err != nil {
+	if revertErr, ok := err.(*RevertError); ok {
+		return revertErr.RPCError().AsRPCErrorResponse(in.ID)
+	} else if err != nil {
 		return ErrInternal.RPCErrorWithMessage(err.Error()).AsRPCErrorResponse(in.ID)
 	}
 
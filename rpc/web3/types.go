@@ -22,6 +22,8 @@ type Service interface {
 	EthCoinbase() (*EthCoinbaseResult, error)
 	// Generates and returns an estimate of how much gas is necessary to allow the transaction to complete. The transaction will not be added to the blockchain. Note that the estimate may be significantly more than the amount of gas actually used by the transaction, for a variety of reasons including EVM mechanics and node performance.
 	EthEstimateGas(*EthEstimateGasParams) (*EthEstimateGasResult, error)
+	// Returns the base fee per gas and the ratio of gas used in each of a range of blocks, along with the priority fees paid at the requested percentiles.
+	EthFeeHistory(*EthFeeHistoryParams) (*EthFeeHistoryResult, error)
 	// Returns the current price per gas in wei
 	EthGasPrice() (*EthGasPriceResult, error)
 	// Returns Ether balance of a given or account or contract
@@ -76,6 +78,8 @@ type Service interface {
 	EthHashrate() (*EthHashrateResult, error)
 	// Returns true if client is actively mining new blocks.
 	EthMining() (*EthMiningResult, error)
+	// Returns the current suggested priority fee per gas in wei
+	EthMaxPriorityFeePerGas() (*EthMaxPriorityFeePerGasResult, error)
 	// Creates a filter in the node, to notify when a new block arrives. To check if the state has changed, call eth_getFilterChanges.
 	EthNewBlockFilter() (*EthNewBlockFilterResult, error)
 	// Creates a filter object, based on filter options, to notify when the state changes (logs). To check if the state has changed, call eth_getFilterChanges.
@@ -88,6 +92,8 @@ type Service interface {
 	EthProtocolVersion() (*EthProtocolVersionResult, error)
 	// The sign method calculates an Ethereum specific signature.
 	EthSign(*EthSignParams) (*EthSignResult, error)
+	// Calculates an EIP-712 signature of typed structured data.
+	EthSignTypedDataV4(*EthSignTypedDataV4Params) (*EthSignTypedDataV4Result, error)
 	// Returns a list of addresses owned by client.
 	EthAccounts() (*EthAccountsResult, error)
 	// Creates new message call transaction or a contract creation, if the data field contains code.
@@ -134,44 +140,44 @@ type BlockNumber struct {
 type EthBlockNumberResult struct {
 	BlockNumber string `json:"blockNumber"`
 }
-type TransactionIndex struct {
-	// Hex representation of the integer
-	Integer string `json:"integer"`
-}
 type Transaction struct {
-	// Integer of the transaction's index position in the block. null when its pending
-	TransactionIndex string `json:"transactionIndex"`
+	// A number only to be used once
+	Nonce string `json:"nonce"`
+	// ECDSA recovery id
+	V string `json:"v"`
+	// ECDSA signature r
+	R string `json:"r"`
+	// ECDSA signature s
+	S string `json:"s"`
 	// Hash of the block where this transaction was in. null when its pending
 	BlockHash string `json:"blockHash"`
+	// Block number where this transaction was in. null when its pending
+	BlockNumber string `json:"blockNumber"`
 	// Address of the sender
 	From string `json:"from"`
 	// Hex representation of a Keccak 256 hash
 	Hash string `json:"hash"`
 	// The data field sent with the transaction
 	Data string `json:"data"`
-	// A number only to be used once
-	Nonce string `json:"nonce"`
-	// The gas limit provided by the sender in Wei
-	Gas string `json:"gas"`
+	// address of the receiver. null when its a contract creation transaction
+	To string `json:"to"`
+	// Integer of the transaction's index position in the block. null when its pending
+	TransactionIndex string `json:"transactionIndex"`
 	// Hex representation of a Keccak 256 hash
 	Value string `json:"value"`
-	// ECDSA recovery id
-	V string `json:"v"`
-	// ECDSA signature s
-	S string `json:"s"`
+	// The gas limit provided by the sender in Wei
+	Gas string `json:"gas"`
 	// The gas price willing to be paid by the sender in Wei
 	GasPrice string `json:"gasPrice"`
-	// address of the receiver. null when its a contract creation transaction
-	To string `json:"to"`
-	// Block number where this transaction was in. null when its pending
-	BlockNumber string `json:"blockNumber"`
-	// ECDSA signature r
-	R string `json:"r"`
 }
 type BlockHash struct {
 	// Hex representation of a Keccak 256 hash
 	Keccak string `json:"keccak"`
 }
+type TransactionIndex struct {
+	// Hex representation of the integer
+	Integer string `json:"integer"`
+}
 type EthCallParams struct {
 	Transaction
 
@@ -191,11 +197,26 @@ type EthCoinbaseResult struct {
 }
 type EthEstimateGasParams struct {
 	Transaction
+	// The block at which to estimate, only the latest block is supported
+	BlockNumber string `json:"blockNumber"`
+	// Changes to make to accounts before estimating keyed by address
+	StateOverride StateOverrides `json:"stateOverride"`
 }
 type EthEstimateGasResult struct {
 	// Hex representation of the integer
 	GasUsed string `json:"gasUsed"`
 }
+type EthFeeHistoryParams struct {
+	// Hex representation of the number of blocks in the requested range
+	BlockCount string `json:"blockCount"`
+	// The hex representation of the height of the highest block in the requested range, or a block tag
+	NewestBlock string `json:"newestBlock"`
+	// Percentiles of the priority fees per gas paid in each block to sample
+	RewardPercentiles Percentiles `json:"rewardPercentiles"`
+}
+type EthFeeHistoryResult struct {
+	FeeHistory
+}
 type EthGasPriceResult struct {
 	// Hex representation of the integer
 	GasPrice string `json:"gasPrice"`
@@ -219,69 +240,71 @@ type EthGetBlockByHashParams struct {
 	// If `true` it returns the full transaction objects, if `false` only the hashes of the transactions.
 	IsTransactionsIncluded bool `json:"isTransactionsIncluded"`
 }
+type Miner struct {
+	Address string `json:"address"`
+}
 type Block struct {
-	// Hex representation of a Keccak 256 hash
-	Sha3Uncles string `json:"sha3Uncles"`
-	// Hex representation of a Keccak 256 hash
-	TransactionsRoot string `json:"transactionsRoot"`
-	// Hex representation of a Keccak 256 hash
-	ParentHash string `json:"parentHash"`
 	// The address of the beneficiary to whom the mining rewards were given or null when its the pending block
 	Miner string `json:"miner"`
-	// Integer of the difficulty for this block
-	Difficulty string `json:"difficulty"`
+	// Integer of the total difficulty of the chain until this block
+	TotalDifficulty string `json:"totalDifficulty"`
+	// Integer the size of this block in bytes
+	Size string `json:"size"`
 	// The total used gas by all transactions in this block
 	GasUsed string `json:"gasUsed"`
-	// The unix timestamp for when the block was collated
-	Timestamp string `json:"timestamp"`
 	// Array of transaction objects, or 32 Bytes transaction hashes depending on the last given parameter
 	Transactions []Transactions `json:"transactions"`
-	// The block number or null when its the pending block
-	Number string `json:"number"`
+	// The base fee per gas of the block, or null when the fee market is not enabled
+	BaseFeePerGas OptionalQuantity `json:"baseFeePerGas"`
 	// The block hash or null when its the pending block
 	Hash string `json:"hash"`
-	// Array of uncle hashes
-	Uncles []string `json:"uncles"`
+	// Randomly selected number to satisfy the proof-of-work or null when its the pending block
+	Nonce string `json:"nonce"`
 	// Hex representation of a Keccak 256 hash
-	ReceiptsRoot string `json:"receiptsRoot"`
+	TransactionsRoot string `json:"transactionsRoot"`
 	// The 'extra data' field of this block
 	ExtraData string `json:"extraData"`
-	// Hex representation of a Keccak 256 hash
-	StateRoot string `json:"stateRoot"`
-	// Integer of the total difficulty of the chain until this block
-	TotalDifficulty string `json:"totalDifficulty"`
-	// Integer the size of this block in bytes
-	Size string `json:"size"`
 	// The maximum gas allowed in this block
 	GasLimit string `json:"gasLimit"`
-	// Randomly selected number to satisfy the proof-of-work or null when its the pending block
-	Nonce string `json:"nonce"`
+	// Array of uncle hashes
+	Uncles []string `json:"uncles"`
+	// Integer of the difficulty for this block
+	Difficulty string `json:"difficulty"`
+	// The block number or null when its the pending block
+	Number string `json:"number"`
+	// Hex representation of a Keccak 256 hash
+	ParentHash string `json:"parentHash"`
+	// Hex representation of a Keccak 256 hash
+	Sha3Uncles string `json:"sha3Uncles"`
+	// Hex representation of a Keccak 256 hash
+	StateRoot string `json:"stateRoot"`
+	// The unix timestamp for when the block was collated
+	Timestamp string `json:"timestamp"`
 	// The bloom filter for the logs of the block or null when its the pending block
 	LogsBloom string `json:"logsBloom"`
+	// Hex representation of a Keccak 256 hash
+	ReceiptsRoot string `json:"receiptsRoot"`
 }
-type Miner struct {
-	Address string `json:"address"`
+type TotalDifficulty struct {
+	// Hex representation of the integer
+	Integer string `json:"integer"`
 }
 type Transactions struct {
 	Transaction
 }
-type Number struct {
-	// Hex representation of the integer
-	Integer string `json:"integer"`
-}
 type Hash struct {
 	// Hex representation of a Keccak 256 hash
 	Keccak string `json:"keccak"`
 }
+type Nonce struct {
+	// Hex representation of the integer
+	Integer string `json:"integer"`
+}
 type Uncles struct {
 	// Hex representation of a Keccak 256 hash
 	Keccak string `json:"keccak"`
 }
-type TotalDifficulty struct {
-	// Hex representation of the integer
-	Integer string `json:"integer"`
-}
-type Nonce struct {
+type Number struct {
 	// Hex representation of the integer
 	Integer string `json:"integer"`
 }
@@ -335,42 +358,47 @@ type EthGetFilterChangesParams struct {
 	// An identifier used to reference the filter.
 	FilterId string `json:"filterId"`
 }
+type Topics struct {
+	// Hex representation of a 256 bit unit of data
+	DataWord string `json:"dataWord"`
+}
 type Log struct {
-	Topics []Topics `json:"topics"`
-	// Hex representation of a Keccak 256 hash
-	TransactionHash string `json:"transactionHash"`
+	// Array of 32 Bytes DATA topics
+	Topics []string `json:"topics"`
 	// Sender of the transaction
 	Address string `json:"address"`
+	// Hex representation of a variable length byte array
+	Data string `json:"data"`
+	// Hex representation of a Keccak 256 hash
+	TransactionHash string `json:"transactionHash"`
+	// Hex representation of the integer
+	TransactionIndex string `json:"transactionIndex"`
 	// The hex representation of the Keccak 256 of the RLP encoded block
 	BlockHash string `json:"blockHash"`
 	// The hex representation of the block's height
 	BlockNumber string `json:"blockNumber"`
-	// Hex representation of a variable length byte array
-	Data string `json:"data"`
 	// Hex representation of the integer
 	LogIndex string `json:"logIndex"`
-	// Hex representation of the integer
-	TransactionIndex string `json:"transactionIndex"`
 }
 type LogResult struct {
 	// An indexed event generated during a transaction
 	Log
-
-	Topics []Topics `json:"topics"`
-	// Hex representation of a Keccak 256 hash
-	TransactionHash string `json:"transactionHash"`
 	// Sender of the transaction
 	Address string `json:"address"`
+	// Hex representation of a variable length byte array
+	Data string `json:"data"`
+	// Hex representation of a Keccak 256 hash
+	TransactionHash string `json:"transactionHash"`
+	// Hex representation of the integer
+	TransactionIndex string `json:"transactionIndex"`
 	// The hex representation of the Keccak 256 of the RLP encoded block
 	BlockHash string `json:"blockHash"`
 	// The hex representation of the block's height
 	BlockNumber string `json:"blockNumber"`
-	// Hex representation of a variable length byte array
-	Data string `json:"data"`
 	// Hex representation of the integer
 	LogIndex string `json:"logIndex"`
-	// Hex representation of the integer
-	TransactionIndex string `json:"transactionIndex"`
+	// Array of 32 Bytes DATA topics
+	Topics []string `json:"topics"`
 }
 type EthGetFilterChangesResult struct {
 	LogResult []LogResult `json:"logResult"`
@@ -382,22 +410,22 @@ type EthGetFilterLogsParams struct {
 type Logs struct {
 	// An indexed event generated during a transaction
 	Log
-	// Hex representation of the integer
-	LogIndex string `json:"logIndex"`
-	// Hex representation of the integer
-	TransactionIndex string `json:"transactionIndex"`
-	// Hex representation of a Keccak 256 hash
-	TransactionHash string `json:"transactionHash"`
-	// Sender of the transaction
-	Address string `json:"address"`
 	// The hex representation of the Keccak 256 of the RLP encoded block
 	BlockHash string `json:"blockHash"`
 	// The hex representation of the block's height
 	BlockNumber string `json:"blockNumber"`
+	// Hex representation of the integer
+	LogIndex string `json:"logIndex"`
+	// Array of 32 Bytes DATA topics
+	Topics []string `json:"topics"`
+	// Sender of the transaction
+	Address string `json:"address"`
 	// Hex representation of a variable length byte array
 	Data string `json:"data"`
-
-	Topics []Topics `json:"topics"`
+	// Hex representation of a Keccak 256 hash
+	TransactionHash string `json:"transactionHash"`
+	// Hex representation of the integer
+	TransactionIndex string `json:"transactionIndex"`
 }
 type EthGetFilterLogsResult struct {
 	Logs []Logs `json:"logs"`
@@ -434,18 +462,10 @@ type Filter struct {
 	FromBlock string `json:"fromBlock"`
 	// The hex representation of the block's height
 	ToBlock string `json:"toBlock"`
-
-	Address string `json:"address"`
+	// An address or array of addresses from any of which logs may come
+	Address FilterValues `json:"address"`
 	// Array of 32 Bytes DATA topics. Topics are order-dependent. Each topic can also be an array of DATA with 'or' options
-	Topics []string `json:"topics"`
-}
-type Address struct {
-	// Address of the contract from which to monitor events
-	Address string `json:"address"`
-}
-type Topics struct {
-	// Hex representation of a 256 bit unit of data
-	DataWord string `json:"dataWord"`
+	Topics []FilterValues `json:"topics"`
 }
 type EthGetLogsParams struct {
 	// A filter used to monitor the blockchain for log/events
@@ -509,30 +529,30 @@ type EthGetTransactionReceiptParams struct {
 	TransactionHash string `json:"transactionHash"`
 }
 type Receipt struct {
-	// The hex representation of the block's height
-	BlockNumber string `json:"blockNumber"`
-	// Hex representation of the integer
-	CumulativeGasUsed string `json:"cumulativeGasUsed"`
 	// Hex representation of the integer
 	GasUsed string `json:"gasUsed"`
 	// An array of all the logs triggered during the transaction
 	Logs []Logs `json:"logs"`
-	// A 2048 bit bloom filter from the logs of the transaction. Each log sets 3 bits though taking the low-order 11 bits of each of the first three pairs of bytes in a Keccak 256 hash of the log's byte series
-	TransactionIndex string `json:"transactionIndex"`
-	// Whether or not the transaction threw an error.
-	Status string `json:"status"`
-	// The hex representation of the Keccak 256 of the RLP encoded block
-	BlockHash string `json:"blockHash"`
+	// Destination address of the transaction
+	To string `json:"to"`
+	// Hex representation of a Keccak 256 hash
+	TransactionHash string `json:"transactionHash"`
 	// The contract address created, if the transaction was a contract creation, otherwise null
 	ContractAddress string `json:"contractAddress"`
 	// The sender of the transaction
 	From string `json:"from"`
 	// A 2048 bit bloom filter from the logs of the transaction. Each log sets 3 bits though taking the low-order 11 bits of each of the first three pairs of bytes in a Keccak 256 hash of the log's byte series
 	LogsBloom string `json:"logsBloom"`
-	// Destination address of the transaction
-	To string `json:"to"`
-	// Hex representation of a Keccak 256 hash
-	TransactionHash string `json:"transactionHash"`
+	// A 2048 bit bloom filter from the logs of the transaction. Each log sets 3 bits though taking the low-order 11 bits of each of the first three pairs of bytes in a Keccak 256 hash of the log's byte series
+	TransactionIndex string `json:"transactionIndex"`
+	// Whether or not the transaction threw an error.
+	Status string `json:"status"`
+	// The hex representation of the Keccak 256 of the RLP encoded block
+	BlockHash string `json:"blockHash"`
+	// The hex representation of the block's height
+	BlockNumber string `json:"blockNumber"`
+	// Hex representation of the integer
+	CumulativeGasUsed string `json:"cumulativeGasUsed"`
 }
 type EthGetTransactionReceiptResult struct {
 	// returns either a receipt or null
@@ -545,42 +565,42 @@ type EthGetUncleByBlockHashAndIndexParams struct {
 	Index string `json:"index"`
 }
 type Uncle struct {
-	// Randomly selected number to satisfy the proof-of-work or null when its the pending block
-	Nonce string `json:"nonce"`
-	// Hex representation of a Keccak 256 hash
-	TransactionsRoot string `json:"transactionsRoot"`
 	// Integer of the total difficulty of the chain until this block
 	TotalDifficulty string `json:"totalDifficulty"`
-	// Integer the size of this block in bytes
-	Size string `json:"size"`
-	// The total used gas by all transactions in this block
-	GasUsed string `json:"gasUsed"`
+	// The unix timestamp for when the block was collated
+	Timestamp string `json:"timestamp"`
 	// Array of uncle hashes
 	Uncles []string `json:"uncles"`
 	// The block number or null when its the pending block
 	Number string `json:"number"`
+	// Hex representation of a Keccak 256 hash
+	StateRoot string `json:"stateRoot"`
+	// Hex representation of a Keccak 256 hash
+	ReceiptsRoot string `json:"receiptsRoot"`
 	// The block hash or null when its the pending block
 	Hash string `json:"hash"`
 	// Hex representation of a Keccak 256 hash
 	Sha3Uncles string `json:"sha3Uncles"`
-	// Hex representation of a Keccak 256 hash
-	StateRoot string `json:"stateRoot"`
 	// The 'extra data' field of this block
 	ExtraData string `json:"extraData"`
-	// The unix timestamp for when the block was collated
-	Timestamp string `json:"timestamp"`
-	// Hex representation of a Keccak 256 hash
-	ReceiptsRoot string `json:"receiptsRoot"`
-	// The address of the beneficiary to whom the mining rewards were given or null when its the pending block
-	Miner string `json:"miner"`
+	// Integer the size of this block in bytes
+	Size string `json:"size"`
 	// The maximum gas allowed in this block
 	GasLimit string `json:"gasLimit"`
+	// The total used gas by all transactions in this block
+	GasUsed string `json:"gasUsed"`
 	// Hex representation of a Keccak 256 hash
 	ParentHash string `json:"parentHash"`
 	// The bloom filter for the logs of the block or null when its the pending block
 	LogsBloom string `json:"logsBloom"`
+	// Hex representation of a Keccak 256 hash
+	TransactionsRoot string `json:"transactionsRoot"`
 	// Integer of the difficulty for this block
 	Difficulty string `json:"difficulty"`
+	// Randomly selected number to satisfy the proof-of-work or null when its the pending block
+	Nonce string `json:"nonce"`
+	// The address of the beneficiary to whom the mining rewards were given or null when its the pending block
+	Miner string `json:"miner"`
 }
 type UncleOrNull struct {
 	// Orphaned blocks that can be included in the chain but at a lower block reward. NOTE: An uncle doesn’t contain individual transactions.
@@ -653,12 +673,12 @@ type Proof struct {
 	ProofNode string `json:"proofNode"`
 }
 type StorageProof struct {
-	// The set of node values needed to traverse a patricia merkle tree (from root to leaf) to retrieve a value
-	Proof []string `json:"proof"`
 	// Hex representation of the integer
 	Key string `json:"key"`
 	// Hex representation of the integer
 	Value string `json:"value"`
+	// The set of node values needed to traverse a patricia merkle tree (from root to leaf) to retrieve a value
+	Proof []string `json:"proof"`
 }
 type AccountProof struct {
 	// Hex representation of a variable length byte array
@@ -682,6 +702,10 @@ type EthMiningResult struct {
 	// Whether of not the client is mining
 	Mining bool `json:"mining"`
 }
+type EthMaxPriorityFeePerGasResult struct {
+	// Hex representation of the integer
+	MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas"`
+}
 type EthNewBlockFilterResult struct {
 	// Hex representation of the integer
 	FilterId string `json:"filterId"`
@@ -700,34 +724,34 @@ type EthNewPendingTransactionFilterResult struct {
 }
 type PendingTransactions struct {
 	Transaction
-	// Integer of the transaction's index position in the block. null when its pending
-	TransactionIndex string `json:"transactionIndex"`
 	// Hash of the block where this transaction was in. null when its pending
 	BlockHash string `json:"blockHash"`
+	// Block number where this transaction was in. null when its pending
+	BlockNumber string `json:"blockNumber"`
 	// Address of the sender
 	From string `json:"from"`
 	// Hex representation of a Keccak 256 hash
 	Hash string `json:"hash"`
 	// The data field sent with the transaction
 	Data string `json:"data"`
-	// A number only to be used once
-	Nonce string `json:"nonce"`
-	// The gas limit provided by the sender in Wei
-	Gas string `json:"gas"`
+	// address of the receiver. null when its a contract creation transaction
+	To string `json:"to"`
+	// Integer of the transaction's index position in the block. null when its pending
+	TransactionIndex string `json:"transactionIndex"`
 	// Hex representation of a Keccak 256 hash
 	Value string `json:"value"`
-	// ECDSA recovery id
-	V string `json:"v"`
-	// ECDSA signature s
-	S string `json:"s"`
+	// The gas limit provided by the sender in Wei
+	Gas string `json:"gas"`
 	// The gas price willing to be paid by the sender in Wei
 	GasPrice string `json:"gasPrice"`
-	// address of the receiver. null when its a contract creation transaction
-	To string `json:"to"`
-	// Block number where this transaction was in. null when its pending
-	BlockNumber string `json:"blockNumber"`
+	// A number only to be used once
+	Nonce string `json:"nonce"`
+	// ECDSA recovery id
+	V string `json:"v"`
 	// ECDSA signature r
 	R string `json:"r"`
+	// ECDSA signature s
+	S string `json:"s"`
 }
 type EthPendingTransactionsResult struct {
 	PendingTransactions []PendingTransactions `json:"pendingTransactions"`
@@ -745,6 +769,15 @@ type EthSignResult struct {
 	// Hex representation of a variable length byte array
 	Signature string `json:"signature"`
 }
+type EthSignTypedDataV4Params struct {
+	Address string `json:"address"`
+	// EIP-712 typed data as a JSON object, or a string of it as most wallets send it
+	TypedData TypedDataJSON `json:"typedData"`
+}
+type EthSignTypedDataV4Result struct {
+	// Hex representation of a 65 byte signature r ‖ s ‖ v
+	Signature string `json:"signature"`
+}
 type Addresses struct {
 	Address string `json:"address"`
 }
@@ -790,16 +823,16 @@ type EthSubmitWorkResult struct {
 	SolutionValid bool `json:"solutionValid"`
 }
 type SyncStatus struct {
+	// Hex representation of the integer
+	KnownStates string `json:"knownStates"`
+	// Hex representation of the integer
+	PulledStates string `json:"pulledStates"`
 	// Hex representation of the integer
 	StartingBlock string `json:"startingBlock"`
 	// Hex representation of the integer
 	CurrentBlock string `json:"currentBlock"`
 	// Hex representation of the integer
 	HighestBlock string `json:"highestBlock"`
-	// Hex representation of the integer
-	KnownStates string `json:"knownStates"`
-	// Hex representation of the integer
-	PulledStates string `json:"pulledStates"`
 }
 type Syncing struct {
 	// An object with sync status data
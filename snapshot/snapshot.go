@@ -0,0 +1,65 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/abci/types"
+	"github.com/hyperledger/burrow/binary"
+)
+
+// Format of the snapshots taken by Store, which is an export of state.State split into chunks. Nodes can only restore
+// snapshots in the formats they know, so this must change with any change to the export.
+const Format uint32 = 1
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported snapshot format")
+	ErrInvalidChunk      = errors.New("snapshot chunk does not match its hash")
+)
+
+// Snapshot describes a snapshot of state after the block at Height
+type Snapshot struct {
+	Height uint64
+	Format uint32
+	// Hash of the whole export
+	Hash binary.HexBytes
+	// Time of the block at Height
+	BlockTime time.Time
+	// Hash of each chunk of the export in order
+	ChunkHashes []binary.HexBytes
+}
+
+// ABCI describes the snapshot to Tendermint, which passes its metadata on to the nodes it is offered to
+func (s *Snapshot) ABCI() (*types.Snapshot, error) {
+	metadata, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Snapshot{
+		Height:   s.Height,
+		Format:   s.Format,
+		Chunks:   uint32(len(s.ChunkHashes)),
+		Hash:     s.Hash,
+		Metadata: metadata,
+	}, nil
+}
+
+// FromABCI recovers the Snapshot described by a snapshot offered by Tendermint
+func FromABCI(snapshot *types.Snapshot) (*Snapshot, error) {
+	if snapshot.Format != Format {
+		return nil, ErrUnsupportedFormat
+	}
+	s := new(Snapshot)
+	err := json.Unmarshal(snapshot.Metadata, s)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode snapshot metadata: %w", err)
+	}
+	if s.Height != snapshot.Height || s.Format != snapshot.Format || !bytes.Equal(s.Hash, snapshot.Hash) ||
+		len(s.ChunkHashes) != int(snapshot.Chunks) {
+		return nil, fmt.Errorf("snapshot metadata does not match snapshot at height %d", snapshot.Height)
+	}
+	return s, nil
+}
@@ -0,0 +1,401 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+const (
+	// Snapshots are split into chunks of this size, which must be comfortably less than Tendermint's maximum message
+	DefaultChunkSize = 4 << 20
+	// Snapshots older than this many of the most recent snapshots are deleted
+	DefaultKeepRecent = 2
+	metadataFileName  = "snapshot.json"
+	restoreFileName   = "restore"
+)
+
+// Store takes snapshots of state every interval blocks and keeps them in a directory for serving to nodes that are state
+// syncing. It also restores state from the snapshot chunks passed to it by Tendermint when this node is state syncing.
+type Store struct {
+	sync.Mutex
+	dir        string
+	state      *state.State
+	blockchain *bcm.Blockchain
+	interval   uint64
+	chunkSize  int
+	keepRecent int
+	// The height of the snapshot being taken, or zero if none is
+	taking uint64
+	// The snapshot being restored, if any
+	restore *restore
+	logger  *logging.Logger
+}
+
+type restore struct {
+	snapshot *Snapshot
+	// The hash state should have once the snapshot is restored
+	appHash []byte
+	// Chunks are accumulated in file until the last one is applied
+	file *os.File
+	hash hash.Hash
+	next uint32
+}
+
+// NewStore returns a Store keeping snapshots in dir that takes snapshots every interval blocks, or never if interval
+// is zero
+func NewStore(dir string, st *state.State, blockchain *bcm.Blockchain, interval uint64, logger *logging.Logger) *Store {
+	return &Store{
+		dir:        dir,
+		state:      st,
+		blockchain: blockchain,
+		interval:   interval,
+		chunkSize:  DefaultChunkSize,
+		keepRecent: DefaultKeepRecent,
+		logger:     logger.WithScope("snapshot.Store"),
+	}
+}
+
+// Take a snapshot of state after the block at height in the background if height falls on the snapshot interval. If
+// the previous snapshot is still being taken then none is taken at this height.
+func (st *Store) Take(height uint64, blockTime time.Time) {
+	if st.interval == 0 || height%st.interval != 0 {
+		return
+	}
+	st.Lock()
+	defer st.Unlock()
+	if st.taking != 0 {
+		st.logger.InfoMsg("Skipping snapshot since previous snapshot is still being taken", "height", height)
+		return
+	}
+	st.taking = height
+	go func() {
+		err := st.take(height, blockTime)
+		st.Lock()
+		st.taking = 0
+		st.Unlock()
+		if err != nil {
+			st.logger.InfoMsg("Could not take snapshot", "height", height, structure.ErrorKey, err)
+			return
+		}
+		st.logger.InfoMsg("Took snapshot", "height", height)
+	}()
+}
+
+// List the snapshots in the store, most recent first
+func (st *Store) List() ([]*Snapshot, error) {
+	entries, err := ioutil.ReadDir(st.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []*Snapshot
+	for _, entry := range entries {
+		height, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			// Snapshots in progress and restores
+			continue
+		}
+		snapshot, err := st.load(height)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Height > snapshots[j].Height
+	})
+	return snapshots, nil
+}
+
+// RetainHeight returns the lowest height that must be kept for the snapshots in the store to be served and for the
+// snapshot being taken to be finished, or zero if there are none. Nodes restoring a snapshot fetch the blocks after it
+// from peers.
+func (st *Store) RetainHeight() (uint64, error) {
+	st.Lock()
+	retainHeight := st.taking
+	st.Unlock()
+	snapshots, err := st.List()
+	if err != nil {
+		return 0, err
+	}
+	if len(snapshots) > 0 {
+		oldest := snapshots[len(snapshots)-1].Height
+		if retainHeight == 0 || oldest < retainHeight {
+			retainHeight = oldest
+		}
+	}
+	return retainHeight, nil
+}
+
+// LoadChunk returns the chunk at index of the snapshot at height
+func (st *Store) LoadChunk(height uint64, format uint32, index uint32) ([]byte, error) {
+	if format != Format {
+		return nil, ErrUnsupportedFormat
+	}
+	return ioutil.ReadFile(filepath.Join(st.snapshotDir(height), strconv.FormatUint(uint64(index), 10)))
+}
+
+// Offer begins restoring snapshot, which should restore state with appHash, abandoning any restore in progress
+func (st *Store) Offer(snapshot *Snapshot, appHash []byte) error {
+	st.Lock()
+	defer st.Unlock()
+	st.abandonRestore()
+	if len(snapshot.ChunkHashes) == 0 {
+		return fmt.Errorf("snapshot at height %d has no chunks", snapshot.Height)
+	}
+	err := os.MkdirAll(st.dir, 0700)
+	if err != nil {
+		return err
+	}
+	file, err := os.Create(filepath.Join(st.dir, restoreFileName))
+	if err != nil {
+		return err
+	}
+	st.restore = &restore{
+		snapshot: snapshot,
+		appHash:  appHash,
+		file:     file,
+		hash:     sha256.New(),
+	}
+	st.logger.InfoMsg("Restoring snapshot", "height", snapshot.Height, "chunks", len(snapshot.ChunkHashes))
+	return nil
+}
+
+// ApplyChunk applies the next chunk of the snapshot being restored. Once the last chunk is applied state and the
+// blockchain are restored and true is returned.
+func (st *Store) ApplyChunk(index uint32, chunk []byte) (bool, error) {
+	st.Lock()
+	defer st.Unlock()
+	r := st.restore
+	if r == nil {
+		return false, fmt.Errorf("no snapshot is being restored")
+	}
+	if index != r.next {
+		return false, fmt.Errorf("expected chunk %d of snapshot but got chunk %d", r.next, index)
+	}
+	chunkHash := sha256.Sum256(chunk)
+	if !bytes.Equal(chunkHash[:], r.snapshot.ChunkHashes[index]) {
+		return false, ErrInvalidChunk
+	}
+	_, err := r.file.Write(chunk)
+	if err != nil {
+		return false, err
+	}
+	r.hash.Write(chunk)
+	r.next++
+	if int(r.next) < len(r.snapshot.ChunkHashes) {
+		return false, nil
+	}
+	defer st.abandonRestore()
+	if !bytes.Equal(r.hash.Sum(nil), r.snapshot.Hash) {
+		return false, fmt.Errorf("snapshot has hash %X but expected %X", r.hash.Sum(nil), r.snapshot.Hash)
+	}
+	_, err = r.file.Seek(0, io.SeekStart)
+	if err != nil {
+		return false, err
+	}
+	err = st.state.Import(r.snapshot.Height, bufio.NewReader(r.file))
+	if err != nil {
+		return false, fmt.Errorf("could not import state from snapshot: %w", err)
+	}
+	if !bytes.Equal(st.state.Hash(), r.appHash) {
+		return false, fmt.Errorf("state restored from snapshot has hash %X but expected %X", st.state.Hash(),
+			r.appHash)
+	}
+	err = st.blockchain.RestoreAtHeight(r.snapshot.Height, r.snapshot.BlockTime, r.appHash)
+	if err != nil {
+		return false, err
+	}
+	st.logger.InfoMsg("Restored snapshot", "height", r.snapshot.Height, "state_hash", r.appHash)
+	return true, nil
+}
+
+func (st *Store) take(height uint64, blockTime time.Time) error {
+	tmpDir := st.snapshotDir(height) + ".tmp"
+	err := os.RemoveAll(tmpDir)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(tmpDir, 0700)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	cw := &chunkWriter{
+		dir:       tmpDir,
+		size:      st.chunkSize,
+		hash:      sha256.New(),
+		chunkHash: sha256.New(),
+	}
+	err = st.state.Export(height, cw)
+	if err != nil {
+		cw.Close()
+		return err
+	}
+	err = cw.Close()
+	if err != nil {
+		return err
+	}
+	bs, err := json.Marshal(&Snapshot{
+		Height:      height,
+		Format:      Format,
+		Hash:        cw.hash.Sum(nil),
+		BlockTime:   blockTime,
+		ChunkHashes: cw.chunkHashes,
+	})
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(filepath.Join(tmpDir, metadataFileName), bs, 0600)
+	if err != nil {
+		return err
+	}
+	// Replace any snapshot taken at this height before restarting
+	err = os.RemoveAll(st.snapshotDir(height))
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmpDir, st.snapshotDir(height))
+	if err != nil {
+		return err
+	}
+	return st.prune()
+}
+
+// Delete all but the most recent snapshots
+func (st *Store) prune() error {
+	snapshots, err := st.List()
+	if err != nil {
+		return err
+	}
+	for i := st.keepRecent; i < len(snapshots); i++ {
+		err = os.RemoveAll(st.snapshotDir(snapshots[i].Height))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (st *Store) load(height uint64) (*Snapshot, error) {
+	bs, err := ioutil.ReadFile(filepath.Join(st.snapshotDir(height), metadataFileName))
+	if err != nil {
+		return nil, err
+	}
+	snapshot := new(Snapshot)
+	err = json.Unmarshal(bs, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode snapshot at height %d: %w", height, err)
+	}
+	return snapshot, nil
+}
+
+func (st *Store) snapshotDir(height uint64) string {
+	return filepath.Join(st.dir, strconv.FormatUint(height, 10))
+}
+
+func (st *Store) abandonRestore() {
+	if st.restore != nil {
+		st.restore.file.Close()
+		os.Remove(st.restore.file.Name())
+		st.restore = nil
+	}
+}
+
+// Splits what is written to it into files of size bytes named by their index in dir
+type chunkWriter struct {
+	dir         string
+	size        int
+	file        *os.File
+	buf         *bufio.Writer
+	written     int
+	hash        hash.Hash
+	chunkHash   hash.Hash
+	chunkHashes []binary.HexBytes
+}
+
+func (cw *chunkWriter) Write(bs []byte) (int, error) {
+	n := 0
+	for len(bs) > 0 {
+		if cw.file == nil {
+			err := cw.openChunk()
+			if err != nil {
+				return n, err
+			}
+		}
+		m := cw.size - cw.written
+		if m > len(bs) {
+			m = len(bs)
+		}
+		_, err := cw.buf.Write(bs[:m])
+		if err != nil {
+			return n, err
+		}
+		cw.hash.Write(bs[:m])
+		cw.chunkHash.Write(bs[:m])
+		cw.written += m
+		n += m
+		bs = bs[m:]
+		if cw.written == cw.size {
+			err = cw.closeChunk()
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (cw *chunkWriter) Close() error {
+	if cw.file == nil {
+		return nil
+	}
+	return cw.closeChunk()
+}
+
+func (cw *chunkWriter) openChunk() error {
+	file, err := os.Create(filepath.Join(cw.dir, strconv.Itoa(len(cw.chunkHashes))))
+	if err != nil {
+		return err
+	}
+	cw.file = file
+	cw.buf = bufio.NewWriter(file)
+	cw.written = 0
+	cw.chunkHash.Reset()
+	return nil
+}
+
+func (cw *chunkWriter) closeChunk() error {
+	err := cw.buf.Flush()
+	if err != nil {
+		cw.file.Close()
+		return err
+	}
+	err = cw.file.Close()
+	if err != nil {
+		return err
+	}
+	cw.chunkHashes = append(cw.chunkHashes, cw.chunkHash.Sum(nil))
+	cw.file = nil
+	return nil
+}
@@ -0,0 +1,87 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestStore(t *testing.T) {
+	genesisDoc := &genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions}
+	st := testState(t, genesisDoc)
+	const height = 3
+	for i := 0; i < height; i++ {
+		_, _, err := st.Update(func(ws state.Updatable) error {
+			return ws.UpdateAccount(acm.NewAccountFromSecret(fmt.Sprintf("account%d", i)))
+		})
+		require.NoError(t, err)
+	}
+
+	store := NewStore(testDir(t), st, bcm.NewBlockchain(dbm.NewMemDB(), genesisDoc), 1, logging.NewNoopLogger())
+	store.chunkSize = 64
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, store.take(height, blockTime))
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	abciSnapshot, err := snapshots[0].ABCI()
+	require.NoError(t, err)
+	require.Greater(t, abciSnapshot.Chunks, uint32(1))
+	offered, err := FromABCI(abciSnapshot)
+	require.NoError(t, err)
+
+	// A new node restores the snapshot over its genesis state
+	restoredState := testState(t, genesisDoc)
+	blockchain := bcm.NewBlockchain(dbm.NewMemDB(), genesisDoc)
+	restoreStore := NewStore(testDir(t), restoredState, blockchain, 0, logging.NewNoopLogger())
+	require.NoError(t, restoreStore.Offer(offered, st.Hash()))
+	for i := uint32(0); i < abciSnapshot.Chunks; i++ {
+		chunk, err := store.LoadChunk(height, Format, i)
+		require.NoError(t, err)
+		if i == 0 {
+			_, err = restoreStore.ApplyChunk(i, chunk[1:])
+			require.Equal(t, ErrInvalidChunk, err)
+		}
+		restored, err := restoreStore.ApplyChunk(i, chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == abciSnapshot.Chunks-1, restored)
+	}
+	require.Equal(t, st.Hash(), restoredState.Hash())
+	require.Equal(t, uint64(height), blockchain.LastBlockHeight())
+	require.Equal(t, blockTime, blockchain.LastBlockTime())
+	acc, err := restoredState.GetAccount(acm.NewAccountFromSecret("account1").Address)
+	require.NoError(t, err)
+	require.NotNil(t, acc)
+
+	// Snapshots in other formats are rejected
+	abciSnapshot.Format++
+	_, err = FromABCI(abciSnapshot)
+	require.Equal(t, ErrUnsupportedFormat, err)
+}
+
+func testState(t *testing.T, genesisDoc *genesis.GenesisDoc) *state.State {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	return st
+}
+
+func testDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "TestStore")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	return dir
+}
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"sync/atomic"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// CacheStats describes the caches of a forest. Every read of a tree goes through the tree cache, and a tree reads its
+// nodes from the database when they are not in its node cache, so a high rate of misses or node reads relative to the
+// work done suggests the caches are too small for the state being used.
+type CacheStats struct {
+	// The number of trees loaded in the tree cache
+	Trees int
+	// The number of times a tree was found in the tree cache
+	TreeHits uint64
+	// The number of times a tree had to be loaded from the database
+	TreeMisses uint64
+	// The number of nodes each tree loaded keeps in memory
+	TreeNodes int
+	// The number of times a tree read from the database, mostly for nodes not in its node cache
+	TreeNodeReads uint64
+}
+
+// Counts of cache use accessed atomically
+type cacheCounters struct {
+	treeHits   uint64
+	treeMisses uint64
+	nodeReads  uint64
+}
+
+// Counts the reads of a database by the IAVL tree using it, which only reads nodes it has not cached
+type readCountingDB struct {
+	dbm.DB
+	reads *uint64
+}
+
+func (rcdb *readCountingDB) Get(key []byte) ([]byte, error) {
+	atomic.AddUint64(rcdb.reads, 1)
+	return rcdb.DB.Get(key)
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/tendermint/tm-db"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+const s3Scheme = "s3://"
+
+// ColdStore holds the values of keys moved out of a database by TieredDB, which is usually slower to read but cheaper
+// to keep than the database itself. Get returns nil for a key it does not hold.
+type ColdStore interface {
+	Get(key []byte) ([]byte, error)
+	Set(key, value []byte) error
+	Delete(key []byte) error
+}
+
+// ColdStorageConfig configures moving the blocks and execution events of old heights out of a node's databases to a
+// cheaper store from which they are read back when queried
+type ColdStorageConfig struct {
+	// Keep the blocks and execution events of the last HotBlocks blocks in the node's databases and move older ones to
+	// Location, zero to move nothing more
+	HotBlocks uint64
+	// A directory, or an S3 bucket and optional key prefix as s3://bucket/prefix. Once anything has been moved there
+	// it must remain configured for the node to read it. S3 credentials are read from the AWS_ACCESS_KEY_ID,
+	// AWS_SECRET_ACCESS_KEY, and optionally AWS_SESSION_TOKEN environment variables.
+	Location string
+	// S3 region, us-east-1 by default
+	S3Region string `json:",omitempty" toml:",omitempty"`
+	// Endpoint of an S3 compatible service to use instead of AWS (e.g. https://minio.local:9000)
+	S3Endpoint string `json:",omitempty" toml:",omitempty"`
+}
+
+// Enabled returns whether there is a cold store configured to read from
+func (csc *ColdStorageConfig) Enabled() bool {
+	return csc != nil && csc.Location != ""
+}
+
+// ColdStore returns the cold store for the database called name
+func (csc *ColdStorageConfig) ColdStore(name string) (ColdStore, error) {
+	if !csc.Enabled() {
+		return nil, fmt.Errorf("no cold storage Location is configured")
+	}
+	if strings.HasPrefix(csc.Location, s3Scheme) {
+		bucket := strings.TrimPrefix(csc.Location, s3Scheme)
+		var prefix string
+		if i := strings.Index(bucket, "/"); i >= 0 {
+			bucket, prefix = bucket[:i], strings.Trim(bucket[i+1:], "/")
+		}
+		if prefix != "" {
+			name = prefix + "/" + name
+		}
+		return NewS3ColdStore(bucket, name, csc.S3Region, csc.S3Endpoint)
+	}
+	return NewFileColdStore(filepath.Join(csc.Location, name))
+}
+
+// Wrap returns db as a TieredDB reading through to the cold store for the database called name, or db itself if no
+// cold store is configured. If db is an EncryptedDB what is moved to the cold store is encrypted in the same way.
+func (csc *ColdStorageConfig) Wrap(db dbm.DB, name string) (dbm.DB, error) {
+	if !csc.Enabled() {
+		return db, nil
+	}
+	cold, err := csc.ColdStore(name)
+	if err != nil {
+		return nil, fmt.Errorf("could not open cold storage for %s: %w", name, err)
+	}
+	if edb, ok := db.(*EncryptedDB); ok {
+		cold = edb.encryptColdStore(cold)
+	}
+	return NewTieredDB(db, cold), nil
+}
+
+// FileColdStore keeps each value in a file named by its key under a directory, such as an archive volume or a network
+// file system
+type FileColdStore struct {
+	dir string
+}
+
+func NewFileColdStore(dir string) (*FileColdStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	return &FileColdStore{dir: dir}, nil
+}
+
+func (fcs *FileColdStore) Get(key []byte) ([]byte, error) {
+	bs, err := ioutil.ReadFile(fcs.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return bs, err
+}
+
+// Set writes the value to a temporary file first so that a partly written value is never read
+func (fcs *FileColdStore) Set(key, value []byte) error {
+	path := fcs.path(key)
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(value)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (fcs *FileColdStore) Delete(key []byte) error {
+	err := os.Remove(fcs.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Keys sharing a prefix would crowd a single directory so we spread them by a hash of the key
+func (fcs *FileColdStore) path(key []byte) string {
+	hash := sha256.Sum256(key)
+	return filepath.Join(fcs.dir, hex.EncodeToString(hash[:1]), hex.EncodeToString(hash[1:2]), hex.EncodeToString(key))
+}
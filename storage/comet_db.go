@@ -0,0 +1,35 @@
+package storage
+
+import (
+	cmtdb "github.com/cometbft/cometbft-db"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// CometDB adapts one of our databases to CometBFT's DB interface so that CometBFT's stores are kept in databases we
+// open, and so are encrypted, tiered, and migrated along with our own
+type CometDB struct {
+	dbm.DB
+}
+
+var _ cmtdb.DB = CometDB{}
+
+func NewCometDB(db dbm.DB) CometDB {
+	return CometDB{DB: db}
+}
+
+func (cdb CometDB) Iterator(start, end []byte) (cmtdb.Iterator, error) {
+	return cdb.DB.Iterator(start, end)
+}
+
+func (cdb CometDB) ReverseIterator(start, end []byte) (cmtdb.Iterator, error) {
+	return cdb.DB.ReverseIterator(start, end)
+}
+
+func (cdb CometDB) NewBatch() cmtdb.Batch {
+	return cdb.DB.NewBatch()
+}
+
+// Compact compacts the whole of the database since our backends cannot compact a range
+func (cdb CometDB) Compact(start, end []byte) error {
+	return Compact(cdb.DB)
+}
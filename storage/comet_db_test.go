@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"testing"
+
+	tmstore "github.com/cometbft/cometbft/proto/tendermint/store"
+	"github.com/cometbft/cometbft/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCometDB(t *testing.T) {
+	db := NewPrefixDB(dbm.NewMemDB(), "comet")
+	cdb := NewCometDB(db)
+
+	// CometBFT's stores read and write through to our database
+	store.SaveBlockStoreState(&tmstore.BlockStoreState{Base: 3, Height: 7}, cdb)
+	blockStore := store.NewBlockStore(cdb)
+	assert.Equal(t, int64(3), blockStore.Base())
+	assert.Equal(t, int64(7), blockStore.Height())
+
+	batch := cdb.NewBatch()
+	require.NoError(t, batch.Set([]byte("key"), []byte("value")))
+	require.NoError(t, batch.WriteSync())
+	require.NoError(t, batch.Close())
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	it, err := cdb.ReverseIterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	require.True(t, it.Valid())
+	assert.Equal(t, []byte("key"), it.Key())
+
+	require.NoError(t, cdb.Compact(nil, nil))
+}
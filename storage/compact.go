@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Compact reclaims the space left by deleted keys in db. Deleting keys from LevelDB only writes tombstones that are
+// merged away by compaction, which may not otherwise happen for a long time. It does nothing for backends that cannot
+// be compacted on demand.
+func Compact(db dbm.DB) error {
+	switch d := db.(type) {
+	case *PrefixDB:
+		return Compact(d.db)
+	case *TieredDB:
+		return Compact(d.hot)
+	case *EncryptedDB:
+		return Compact(d.db)
+	case *dbm.GoLevelDB:
+		return d.DB().CompactRange(util.Range{})
+	case compacter:
+		return d.Compact()
+	}
+	return nil
+}
+
+type compacter interface {
+	Compact() error
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCompact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestCompact")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db, err := dbm.NewGoLevelDB("compact", dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	pdb := NewPrefixDB(db, "p")
+	for i := 0; i < 100; i++ {
+		require.NoError(t, pdb.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value")))
+	}
+	for i := 0; i < 50; i++ {
+		require.NoError(t, pdb.Delete([]byte(fmt.Sprintf("key%d", i))))
+	}
+	require.NoError(t, Compact(pdb))
+	value, err := pdb.Get([]byte("key50"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	value, err = pdb.Get([]byte("key0"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// Other backends have nothing to do
+	require.NoError(t, Compact(dbm.NewMemDB()))
+}
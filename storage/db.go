@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// PebbleDBBackend stores keys in Pebble (github.com/cockroachdb/pebble), which is only available in binaries built
+	// with the pebbledb build tag
+	PebbleDBBackend dbm.BackendType = "pebbledb"
+	// The backend used when none is configured, and by nodes from before the backend was configurable
+	DefaultDBBackend = dbm.GoLevelDBBackend
+	// The number of keys written to the new database between flushes when migrating
+	migrateBatchSize = 10000
+)
+
+// Keys from here up are reserved for the records of the databases wrapping others (TieredDB, EncryptedDB) and hidden
+// from their iteration
+var reservedPrefix = []byte{0xff}
+
+type dbCreator func(name, dir string) (dbm.DB, error)
+
+// Backends we provide ourselves since tm-db only knows its own
+var backends = map[dbm.BackendType]dbCreator{}
+
+// NewDB opens (creating if necessary) the database name in dir with backend, which if empty is DefaultDBBackend.
+// Backends other than goleveldb and memdb may need their build tag (e.g. badgerdb, pebbledb).
+func NewDB(name string, backend dbm.BackendType, dir string) (dbm.DB, error) {
+	if backend == "" {
+		backend = DefaultDBBackend
+	}
+	if creator, ok := backends[backend]; ok {
+		return creator(name, dir)
+	}
+	return dbm.NewDB(name, backend, dir)
+}
+
+// Clamps the end of an iteration to below the reserved keys
+func reservedEnd(end []byte) []byte {
+	if end == nil || bytes.Compare(end, reservedPrefix) > 0 {
+		return reservedPrefix
+	}
+	return end
+}
+
+// DBPath returns the path of the file or directory holding the database name in dir for backend
+func DBPath(name string, backend dbm.BackendType, dir string) string {
+	switch backend {
+	case dbm.BadgerDBBackend:
+		return filepath.Join(dir, name)
+	default:
+		return filepath.Join(dir, name+".db")
+	}
+}
+
+// Copy writes every key in src to dst
+func Copy(dst, src dbm.DB) error {
+	it, err := src.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	batch := dst.NewBatch()
+	n := 0
+	for ; it.Valid(); it.Next() {
+		err = batch.Set(it.Key(), it.Value())
+		if err != nil {
+			return err
+		}
+		n++
+		if n%migrateBatchSize == 0 {
+			err = batch.Write()
+			if err != nil {
+				return err
+			}
+			batch.Close()
+			batch = dst.NewBatch()
+		}
+	}
+	if err = it.Error(); err != nil {
+		return err
+	}
+	defer batch.Close()
+	return batch.WriteSync()
+}
+
+// Migrate copies the database name in dir from the from backend to the to backend, which replaces it, encrypting it
+// with key unless key is nil. The original is moved into backupDir rather than deleted. Returns false if there was no
+// database to migrate.
+func Migrate(name, dir string, from, to dbm.BackendType, key []byte, backupDir string) (bool, error) {
+	if from == "" {
+		from = DefaultDBBackend
+	}
+	if from == to && key == nil {
+		return false, fmt.Errorf("database %s is already stored with backend %s", name, to)
+	}
+	fromPath := DBPath(name, from, dir)
+	if _, err := os.Stat(fromPath); os.IsNotExist(err) {
+		return false, nil
+	}
+	backupPath := DBPath(name, from, backupDir)
+	if _, err := os.Stat(backupPath); err == nil {
+		return false, fmt.Errorf("backup %s already exists", backupPath)
+	}
+
+	// The paths for different backends may be the same so we write the new database elsewhere first
+	tmpDir, err := ioutil.TempDir(dir, "migrate-"+name)
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(tmpDir)
+	err = copyDB(name, tmpDir, to, dir, from, key)
+	if err != nil {
+		return false, fmt.Errorf("could not copy database %s: %w", name, err)
+	}
+
+	err = os.MkdirAll(backupDir, 0700)
+	if err != nil {
+		return false, err
+	}
+	err = os.Rename(fromPath, backupPath)
+	if err != nil {
+		return false, fmt.Errorf("could not back up database %s: %w", name, err)
+	}
+	err = os.Rename(DBPath(name, to, tmpDir), DBPath(name, to, dir))
+	if err != nil {
+		return false, fmt.Errorf("could not move migrated database %s into place, the original is in %s: %w",
+			name, backupPath, err)
+	}
+	return true, nil
+}
+
+func copyDB(name, dstDir string, dstBackend dbm.BackendType, srcDir string, srcBackend dbm.BackendType,
+	key []byte) error {
+	src, err := NewDB(name, srcBackend, srcDir)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	if key != nil {
+		encrypted, err := src.Has(encryptionCheckKey)
+		if err != nil {
+			return err
+		}
+		if encrypted {
+			return fmt.Errorf("database is already encrypted")
+		}
+	}
+	dst, err := NewDB(name, dstBackend, dstDir)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	if key != nil {
+		dst, err = NewEncryptedDB(dst, key)
+		if err != nil {
+			return err
+		}
+	}
+	return Copy(dst, src)
+}
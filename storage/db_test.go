@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCopy(t *testing.T) {
+	src := dbm.NewMemDB()
+	for i := 0; i < migrateBatchSize+10; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key%06d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	dst := dbm.NewMemDB()
+	require.NoError(t, Copy(dst, src))
+	require.Equal(t, src.Stats(), dst.Stats())
+	value, err := dst.Get([]byte("key010009"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value10009"), value)
+}
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestMigrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Nothing to migrate
+	ok, err := Migrate("test", dir, dbm.GoLevelDBBackend, dbm.BadgerDBBackend, nil, dir+"/backup")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = Migrate("test", dir, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend, nil, dir+"/backup")
+	require.Error(t, err)
+
+	db, err := NewDB("test", "", dir)
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte("foo"), []byte("bar")))
+	require.NoError(t, db.Close())
+
+	// A backend that is not built in fails before anything is moved
+	_, err = Migrate("test", dir, dbm.GoLevelDBBackend, "nosuchdb", nil, dir+"/backup")
+	require.Error(t, err)
+	db, err = NewDB("test", dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	value, err := db.Get([]byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), value)
+	require.NoError(t, db.Close())
+}
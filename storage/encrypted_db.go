@@ -0,0 +1,346 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	dbm "github.com/tendermint/tm-db"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// The length of the AES-256 key databases are encrypted with
+const EncryptionKeyLength = 32
+
+// Records that a database is encrypted and checks that it is opened with the key it was encrypted with
+var encryptionCheckKey = []byte("\xffencrypted")
+
+// EncryptionConfig configures encrypting the values a node stores in its databases with a 256-bit AES key, read when
+// the node starts from exactly one of a file, an environment variable, or the output of a command. The key is encoded
+// as hex or base64.
+type EncryptionConfig struct {
+	// A file holding the key
+	KeyFile string `json:",omitempty" toml:",omitempty"`
+	// The name of an environment variable holding the key
+	KeyEnv string `json:",omitempty" toml:",omitempty"`
+	// A shell command printing the key, such as one asking a key management service to decrypt a data key
+	KeyCommand string `json:",omitempty" toml:",omitempty"`
+}
+
+// Enabled returns whether databases are to be encrypted
+func (ec *EncryptionConfig) Enabled() bool {
+	return ec != nil && (ec.KeyFile != "" || ec.KeyEnv != "" || ec.KeyCommand != "")
+}
+
+// Key reads the key from wherever it is configured to come from
+func (ec *EncryptionConfig) Key() ([]byte, error) {
+	sources := 0
+	for _, source := range []string{ec.KeyFile, ec.KeyEnv, ec.KeyCommand} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, fmt.Errorf("exactly one of KeyFile, KeyEnv, or KeyCommand must be set to encrypt databases")
+	}
+	var encoded []byte
+	var err error
+	switch {
+	case ec.KeyFile != "":
+		encoded, err = ioutil.ReadFile(ec.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read database key: %w", err)
+		}
+	case ec.KeyEnv != "":
+		encoded = []byte(os.Getenv(ec.KeyEnv))
+		if len(encoded) == 0 {
+			return nil, fmt.Errorf("environment variable %s holding the database key is not set", ec.KeyEnv)
+		}
+	default:
+		cmd := exec.Command("sh", "-c", ec.KeyCommand)
+		cmd.Stderr = os.Stderr
+		encoded, err = cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("could not run command for database key: %w", err)
+		}
+	}
+	return DecodeEncryptionKey(string(encoded))
+}
+
+// DecodeEncryptionKey decodes a hex or base64 encoded key, ignoring surrounding whitespace
+func DecodeEncryptionKey(encoded string) ([]byte, error) {
+	encoded = strings.TrimSpace(encoded)
+	key, err := hex.DecodeString(encoded)
+	if err != nil {
+		key, err = base64.StdEncoding.DecodeString(encoded)
+	}
+	if err != nil || len(key) != EncryptionKeyLength {
+		return nil, fmt.Errorf("database key must be %d bytes encoded as hex or base64", EncryptionKeyLength)
+	}
+	return key, nil
+}
+
+// EncryptedDB encrypts the values stored in a database with AES-256-GCM. Keys are stored in the clear so that the
+// database can still be iterated in order, which means the structure of what is stored is not hidden, only its
+// content. Each value is bound to its key so cannot be moved to another key without failing to decrypt.
+type EncryptedDB struct {
+	db     dbm.DB
+	cipher *valueCipher
+}
+
+var _ dbm.DB = &EncryptedDB{}
+
+// NewEncryptedDB returns db encrypted with key. A database is encrypted from when it is created so it is an error to
+// open a database holding unencrypted values, or one encrypted with another key.
+func NewEncryptedDB(db dbm.DB, key []byte) (*EncryptedDB, error) {
+	vc, err := newValueCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	edb := &EncryptedDB{
+		db:     db,
+		cipher: vc,
+	}
+	check, err := db.Get(encryptionCheckKey)
+	if err != nil {
+		return nil, err
+	}
+	if check != nil {
+		_, err = vc.open(encryptionCheckKey, check)
+		if err != nil {
+			return nil, fmt.Errorf("database was encrypted with a different key")
+		}
+		return edb, nil
+	}
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	empty := !it.Valid()
+	it.Close()
+	if !empty {
+		return nil, fmt.Errorf("database holds unencrypted values, use burrow migrate --encrypt to encrypt it")
+	}
+	check, err = vc.seal(encryptionCheckKey, encryptionCheckKey)
+	if err != nil {
+		return nil, err
+	}
+	return edb, db.SetSync(encryptionCheckKey, check)
+}
+
+// DB implementation
+
+func (edb *EncryptedDB) Get(key []byte) ([]byte, error) {
+	value, err := edb.db.Get(key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return edb.cipher.open(key, value)
+}
+
+func (edb *EncryptedDB) Has(key []byte) (bool, error) {
+	return edb.db.Has(key)
+}
+
+func (edb *EncryptedDB) Set(key, value []byte) error {
+	sealed, err := edb.cipher.seal(key, value)
+	if err != nil {
+		return err
+	}
+	return edb.db.Set(key, sealed)
+}
+
+func (edb *EncryptedDB) SetSync(key, value []byte) error {
+	sealed, err := edb.cipher.seal(key, value)
+	if err != nil {
+		return err
+	}
+	return edb.db.SetSync(key, sealed)
+}
+
+func (edb *EncryptedDB) Delete(key []byte) error {
+	return edb.db.Delete(key)
+}
+
+func (edb *EncryptedDB) DeleteSync(key []byte) error {
+	return edb.db.DeleteSync(key)
+}
+
+func (edb *EncryptedDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	it, err := edb.db.Iterator(start, reservedEnd(end))
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptedIterator(it, edb.cipher), nil
+}
+
+func (edb *EncryptedDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	it, err := edb.db.ReverseIterator(start, reservedEnd(end))
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptedIterator(it, edb.cipher), nil
+}
+
+func (edb *EncryptedDB) Close() error {
+	return edb.db.Close()
+}
+
+// Print prints the encrypted values as stored
+func (edb *EncryptedDB) Print() error {
+	return edb.db.Print()
+}
+
+func (edb *EncryptedDB) Stats() map[string]string {
+	stats := make(map[string]string)
+	for key, value := range edb.db.Stats() {
+		stats["EncryptedDB."+key] = value
+	}
+	return stats
+}
+
+func (edb *EncryptedDB) NewBatch() dbm.Batch {
+	return &encryptedBatch{
+		Batch:  edb.db.NewBatch(),
+		cipher: edb.cipher,
+	}
+}
+
+// Returns cold with its values encrypted like those of the database, so that moving them out of it does not store
+// them unencrypted
+func (edb *EncryptedDB) encryptColdStore(cold ColdStore) ColdStore {
+	return &encryptedColdStore{
+		ColdStore: cold,
+		cipher:    edb.cipher,
+	}
+}
+
+type encryptedBatch struct {
+	dbm.Batch
+	cipher *valueCipher
+}
+
+func (eb *encryptedBatch) Set(key, value []byte) error {
+	sealed, err := eb.cipher.seal(key, value)
+	if err != nil {
+		return err
+	}
+	return eb.Batch.Set(key, sealed)
+}
+
+// Decrypts each value as it is reached, stopping at the first that cannot be decrypted with its error
+type encryptedIterator struct {
+	dbm.Iterator
+	cipher *valueCipher
+	value  []byte
+	err    error
+}
+
+func newEncryptedIterator(it dbm.Iterator, vc *valueCipher) *encryptedIterator {
+	ei := &encryptedIterator{
+		Iterator: it,
+		cipher:   vc,
+	}
+	ei.open()
+	return ei
+}
+
+func (ei *encryptedIterator) Valid() bool {
+	return ei.err == nil && ei.Iterator.Valid()
+}
+
+func (ei *encryptedIterator) Next() {
+	ei.Iterator.Next()
+	ei.open()
+}
+
+func (ei *encryptedIterator) Value() []byte {
+	return ei.value
+}
+
+func (ei *encryptedIterator) Error() error {
+	if ei.err != nil {
+		return ei.err
+	}
+	return ei.Iterator.Error()
+}
+
+func (ei *encryptedIterator) open() {
+	ei.value = nil
+	if ei.Valid() {
+		ei.value, ei.err = ei.cipher.open(ei.Iterator.Key(), ei.Iterator.Value())
+	}
+}
+
+type encryptedColdStore struct {
+	ColdStore
+	cipher *valueCipher
+}
+
+func (ecs *encryptedColdStore) Get(key []byte) ([]byte, error) {
+	value, err := ecs.ColdStore.Get(key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return ecs.cipher.open(key, value)
+}
+
+func (ecs *encryptedColdStore) Set(key, value []byte) error {
+	sealed, err := ecs.cipher.seal(key, value)
+	if err != nil {
+		return err
+	}
+	return ecs.ColdStore.Set(key, sealed)
+}
+
+// Encrypts values as a random nonce followed by the AES-GCM ciphertext authenticating the key the value is stored under
+type valueCipher struct {
+	aead cipher.AEAD
+}
+
+func newValueCipher(key []byte) (*valueCipher, error) {
+	if len(key) != EncryptionKeyLength {
+		return nil, fmt.Errorf("database key must be %d bytes but is %d", EncryptionKeyLength, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &valueCipher{aead: aead}, nil
+}
+
+func (vc *valueCipher) seal(key, value []byte) ([]byte, error) {
+	nonceSize := vc.aead.NonceSize()
+	nonce := make([]byte, nonceSize, nonceSize+len(value)+vc.aead.Overhead())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return vc.aead.Seal(nonce, nonce, value, key), nil
+}
+
+func (vc *valueCipher) open(key, sealed []byte) ([]byte, error) {
+	nonceSize := vc.aead.NonceSize()
+	if len(sealed) < nonceSize+vc.aead.Overhead() {
+		return nil, fmt.Errorf("value of key %X is too short to be encrypted", key)
+	}
+	value, err := vc.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], key)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt value of key %X: %w", key, err)
+	}
+	// An empty value is still a value
+	if value == nil {
+		value = []byte{}
+	}
+	return value, nil
+}
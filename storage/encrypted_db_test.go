@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestEncryptedDB(t *testing.T) {
+	raw := dbm.NewMemDB()
+	key := bytes.Repeat([]byte{1}, EncryptionKeyLength)
+	db, err := NewEncryptedDB(raw, key)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("empty"), []byte{}))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	// Values are not stored in the clear
+	stored, err := raw.Get([]byte("key1"))
+	require.NoError(t, err)
+	require.NotContains(t, string(stored), "value1")
+	value, err := db.Get([]byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), value)
+	value, err = db.Get([]byte("empty"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{}, value)
+	value, err = db.Get([]byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// Iteration is in key order and never visits our own record
+	it, err := db.ReverseIterator([]byte("key7"), nil)
+	require.NoError(t, err)
+	var values []string
+	for ; it.Valid(); it.Next() {
+		values = append(values, string(it.Value()))
+	}
+	require.NoError(t, it.Error())
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"value9", "value8", "value7"}, values)
+
+	// A value moved to another key does not decrypt
+	require.NoError(t, raw.Set([]byte("key2"), stored))
+	_, err = db.Get([]byte("key2"))
+	require.Error(t, err)
+	it, err = db.Iterator([]byte("key2"), nil)
+	require.NoError(t, err)
+	require.False(t, it.Valid())
+	require.Error(t, it.Error())
+	require.NoError(t, it.Close())
+
+	// The database can only be opened again with the same key
+	_, err = NewEncryptedDB(raw, key)
+	require.NoError(t, err)
+	_, err = NewEncryptedDB(raw, bytes.Repeat([]byte{2}, EncryptionKeyLength))
+	require.Error(t, err)
+
+	// An unencrypted database cannot be opened encrypted
+	raw = dbm.NewMemDB()
+	require.NoError(t, raw.Set([]byte("foo"), []byte("bar")))
+	_, err = NewEncryptedDB(raw, key)
+	require.Error(t, err)
+}
+
+func TestEncryptedDB_ColdStorage(t *testing.T) {
+	edb, err := NewEncryptedDB(dbm.NewMemDB(), bytes.Repeat([]byte{1}, EncryptionKeyLength))
+	require.NoError(t, err)
+	cold := newMemColdStore()
+	tdb := NewTieredDB(edb, edb.encryptColdStore(cold))
+	require.NoError(t, tdb.Set([]byte("foo"), []byte("bar")))
+	require.NoError(t, tdb.Archive([]byte("foo")))
+	require.NotContains(t, string(cold["foo"]), "bar")
+	value, err := tdb.Get([]byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), value)
+}
+
+func TestEncryptionConfig_Key(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, EncryptionKeyLength)
+	dir, err := ioutil.TempDir("", "TestEncryptionConfig_Key")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	keyFile := filepath.Join(dir, "key")
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(hex.EncodeToString(key)+"\n"), 0600))
+	require.NoError(t, os.Setenv("TEST_BURROW_DB_KEY", base64.StdEncoding.EncodeToString(key)))
+	defer os.Unsetenv("TEST_BURROW_DB_KEY")
+
+	for _, conf := range []*EncryptionConfig{
+		{KeyFile: keyFile},
+		{KeyEnv: "TEST_BURROW_DB_KEY"},
+		{KeyCommand: "cat " + keyFile},
+	} {
+		require.True(t, conf.Enabled())
+		read, err := conf.Key()
+		require.NoError(t, err)
+		require.Equal(t, key, read)
+	}
+
+	var conf *EncryptionConfig
+	require.False(t, conf.Enabled())
+	_, err = (&EncryptionConfig{KeyFile: keyFile, KeyEnv: "TEST_BURROW_DB_KEY"}).Key()
+	require.Error(t, err)
+	_, err = (&EncryptionConfig{KeyCommand: "echo 0102"}).Key()
+	require.Error(t, err)
+}
+
+func TestMigrate_Encrypt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestMigrate_Encrypt")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	key := bytes.Repeat([]byte{1}, EncryptionKeyLength)
+
+	db, err := NewDB("test", "", dir)
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte("foo"), []byte("bar")))
+	require.NoError(t, db.Close())
+
+	ok, err := Migrate("test", dir, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend, key, dir+"/backup")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	db, err = NewDB("test", dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	edb, err := NewEncryptedDB(db, key)
+	require.NoError(t, err)
+	value, err := edb.Get([]byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), value)
+	require.NoError(t, db.Close())
+
+	// Encrypting again would encrypt twice
+	_, err = Migrate("test", dir, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend, key, dir+"/backup2")
+	require.Error(t, err)
+}
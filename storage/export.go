@@ -0,0 +1,296 @@
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/iavl"
+)
+
+// Records making up an export. Exported trees are made up of a record naming the tree followed by its nodes in the
+// order IAVL exports them, which is the order they must be imported in to reproduce the same tree.
+const (
+	exportTreeRecord byte = iota + 1
+	exportNodeRecord
+	exportKVRecord
+	exportEndRecord
+)
+
+// ExportReader is the input to Import, it is satisfied by a bufio.Reader
+type ExportReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// Export writes the forest at version to w. This is the commits tree at version followed by every tree it references
+// at the version of its commit, exported node by node so that Import reproduces the forest with the same hash.
+func (muf *MutableForest) Export(version int64, w io.Writer) error {
+	commitsTree, err := muf.commitsTree.GetImmutable(version)
+	if err != nil {
+		return fmt.Errorf("MutableForest.Export() could not get commits tree at version %d: %v", version, err)
+	}
+	err = exportTree(w, nil, commitsTree)
+	if err != nil {
+		return err
+	}
+	err = commitsTree.Iterate(nil, nil, true, func(prefix []byte, bs []byte) error {
+		commitID, err := unmarshalCommitID(bs)
+		if err != nil {
+			return err
+		}
+		tree, err := NewMutableTree(NewPrefixDB(muf.treeDB, string(prefix)), muf.cacheSize)
+		if err != nil {
+			return err
+		}
+		immutable, err := tree.GetImmutable(commitID.Version)
+		if err != nil {
+			return fmt.Errorf("MutableForest.Export() could not get tree %X at version %d: %v", prefix,
+				commitID.Version, err)
+		}
+		return exportTree(w, prefix, immutable)
+	})
+	if err != nil {
+		return err
+	}
+	return writeRecord(w, exportEndRecord)
+}
+
+// Import reads a forest exported at version from r. The forest must have been created against an empty database.
+func (muf *MutableForest) Import(version int64, r ExportReader) error {
+	const errHeader = "MutableForest.Import():"
+	var importer *iavl.Importer
+	var imported int
+	defer func() {
+		if importer != nil {
+			importer.Close()
+		}
+	}()
+	for {
+		record, err := r.ReadByte()
+		if err != nil {
+			return fmt.Errorf("%s could not read record: %v", errHeader, err)
+		}
+		switch record {
+		case exportTreeRecord:
+			prefix, err := readBytes(r)
+			if err != nil {
+				return fmt.Errorf("%s could not read tree prefix: %v", errHeader, err)
+			}
+			if importer != nil {
+				err = muf.commitImport(importer, imported, version)
+				if err != nil {
+					return fmt.Errorf("%s %v", errHeader, err)
+				}
+			}
+			importer, err = muf.startImport(prefix, imported, version)
+			if err != nil {
+				return fmt.Errorf("%s could not import tree %X: %v", errHeader, prefix, err)
+			}
+			imported++
+		case exportNodeRecord:
+			if importer == nil {
+				return fmt.Errorf("%s node found before any tree", errHeader)
+			}
+			node, err := readNode(r)
+			if err != nil {
+				return fmt.Errorf("%s could not read node: %v", errHeader, err)
+			}
+			err = importer.Add(node)
+			if err != nil {
+				return fmt.Errorf("%s could not import node: %v", errHeader, err)
+			}
+		case exportEndRecord:
+			if importer == nil {
+				return fmt.Errorf("%s export contains no commits tree", errHeader)
+			}
+			err = muf.commitImport(importer, imported, version)
+			if err != nil {
+				return fmt.Errorf("%s %v", errHeader, err)
+			}
+			importer = nil
+			return nil
+		default:
+			return fmt.Errorf("%s unexpected record type %d", errHeader, record)
+		}
+	}
+}
+
+// VersionExists returns whether the forest can be read at version, which it cannot before the version it was imported
+// at
+func (muf *MutableForest) VersionExists(version int64) bool {
+	return muf.commitsTree.VersionExists(version)
+}
+
+// The commits tree is always imported first since we need the versions of the other trees to import them
+func (muf *MutableForest) startImport(prefix []byte, imported int, version int64) (*iavl.Importer, error) {
+	if imported == 0 {
+		return muf.commitsTree.tree.Import(version)
+	}
+	commitID, err := muf.commitID(prefix)
+	if err != nil {
+		return nil, err
+	}
+	if commitID.Version == 0 {
+		return nil, fmt.Errorf("tree has no commit in the commits tree")
+	}
+	tree, err := NewMutableTree(NewPrefixDB(muf.treeDB, string(prefix)), muf.cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Import(commitID.Version)
+}
+
+func (muf *MutableForest) commitImport(importer *iavl.Importer, imported int, version int64) error {
+	err := importer.Commit()
+	if err != nil {
+		return fmt.Errorf("could not commit imported tree: %v", err)
+	}
+	if imported == 1 {
+		// Make the commits tree readable so we can look up the commits of the trees that follow
+		return muf.Load(version)
+	}
+	return nil
+}
+
+// Export writes every key and value in the database to w
+func (pdb *PrefixDB) Export(w io.Writer) error {
+	it, err := pdb.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		err = writeRecord(w, exportKVRecord, it.Key(), it.Value())
+		if err != nil {
+			return err
+		}
+	}
+	err = it.Error()
+	if err != nil {
+		return err
+	}
+	return writeRecord(w, exportEndRecord)
+}
+
+// Import sets the keys and values written by Export in the database
+func (pdb *PrefixDB) Import(r ExportReader) error {
+	const errHeader = "PrefixDB.Import():"
+	batch := pdb.NewBatch()
+	defer batch.Close()
+	for {
+		record, err := r.ReadByte()
+		if err != nil {
+			return fmt.Errorf("%s could not read record: %v", errHeader, err)
+		}
+		switch record {
+		case exportKVRecord:
+			key, err := readBytes(r)
+			if err != nil {
+				return fmt.Errorf("%s could not read key: %v", errHeader, err)
+			}
+			value, err := readBytes(r)
+			if err != nil {
+				return fmt.Errorf("%s could not read value: %v", errHeader, err)
+			}
+			if value == nil {
+				value = []byte{}
+			}
+			err = batch.Set(key, value)
+			if err != nil {
+				return err
+			}
+		case exportEndRecord:
+			return batch.WriteSync()
+		default:
+			return fmt.Errorf("%s unexpected record type %d", errHeader, record)
+		}
+	}
+}
+
+func exportTree(w io.Writer, prefix []byte, tree *ImmutableTree) error {
+	err := writeRecord(w, exportTreeRecord, prefix)
+	if err != nil {
+		return err
+	}
+	exporter := tree.Export()
+	defer exporter.Close()
+	for {
+		node, err := exporter.Next()
+		if err == iavl.ExportDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = writeRecord(w, exportNodeRecord, node.Key, node.Value)
+		if err != nil {
+			return err
+		}
+		buf := make([]byte, binary.MaxVarintLen64+1)
+		n := binary.PutVarint(buf, node.Version)
+		buf[n] = byte(node.Height)
+		_, err = w.Write(buf[:n+1])
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func readNode(r ExportReader) (*iavl.ExportNode, error) {
+	key, err := readBytes(r)
+	if err != nil {
+		return nil, err
+	}
+	value, err := readBytes(r)
+	if err != nil {
+		return nil, err
+	}
+	version, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	height, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	// Only inner nodes have nil values
+	if height == 0 && value == nil {
+		value = []byte{}
+	}
+	return &iavl.ExportNode{
+		Key:     key,
+		Value:   value,
+		Version: version,
+		Height:  int8(height),
+	}, nil
+}
+
+// Write a record type followed by length-prefixed fields
+func writeRecord(w io.Writer, record byte, fields ...[]byte) error {
+	buf := []byte{record}
+	for _, field := range fields {
+		length := make([]byte, binary.MaxVarintLen64)
+		n := binary.PutUvarint(length, uint64(len(field)))
+		buf = append(buf, length[:n]...)
+		buf = append(buf, field...)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func readBytes(r ExportReader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length == 0 {
+		return nil, nil
+	}
+	bs := make([]byte, length)
+	_, err = io.ReadFull(r, bs)
+	if err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMutableForest_Export(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	write := func(prefix string, n int) {
+		err := forest.Write([]byte(prefix), func(tree *RWTree) error {
+			for i := 0; i < n; i++ {
+				tree.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%s%d", prefix, i)))
+			}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	write("accounts", 20)
+	write("names", 3)
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+	// Trees at differing versions
+	write("accounts", 25)
+	hash, version, err := forest.Save()
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, forest.Export(version, buf))
+
+	imported, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	require.NoError(t, imported.Import(version, bufio.NewReader(buf)))
+	require.Equal(t, hash, imported.Hash())
+	require.Equal(t, version, imported.Version())
+	require.False(t, imported.VersionExists(version-1))
+
+	reader, err := imported.Reader([]byte("names"))
+	require.NoError(t, err)
+	value, err := reader.Get([]byte("key2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("names2"), value)
+
+	// Saving the same writes to both gives the same hash
+	for _, f := range []*MutableForest{forest, imported} {
+		err = f.Write([]byte("names"), func(tree *RWTree) error {
+			tree.Set([]byte("key0"), []byte("renamed"))
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	hash, _, err = forest.Save()
+	require.NoError(t, err)
+	importedHash, _, err := imported.Save()
+	require.NoError(t, err)
+	require.Equal(t, hash, importedHash)
+}
+
+func TestPrefixDB_Export(t *testing.T) {
+	pdb := NewPrefixDB(dbm.NewMemDB(), "h")
+	require.NoError(t, pdb.Set([]byte("a"), []byte("1")))
+	require.NoError(t, pdb.Set([]byte("b"), []byte{}))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, pdb.Export(buf))
+
+	imported := NewPrefixDB(dbm.NewMemDB(), "h")
+	require.NoError(t, imported.Import(bufio.NewReader(buf)))
+	value, err := imported.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	ok, err := imported.Has([]byte("b"))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
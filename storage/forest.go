@@ -1,14 +1,17 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/golang/protobuf/proto"
 
 	lru "github.com/hashicorp/golang-lru"
 	dbm "github.com/tendermint/tm-db"
 	"github.com/xlab/treeprint"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -19,6 +22,7 @@ const (
 // Access the read path of a forest
 type ForestReader interface {
 	Reader(prefix []byte) (KVCallbackIterableReader, error)
+	GetWithProof(prefix, key []byte) (*ForestProof, error)
 }
 
 // MutableForest is a collection of versioned lazily-loaded RWTrees organised by prefix. It maintains a global state hash
@@ -86,8 +90,10 @@ type ImmutableForest struct {
 	// Cache for frequently used trees
 	treeCache *lru.Cache
 	// Cache size is used in multiple places - for the LRU cache and node cache for any trees created - it probably
-	// makes sense for them to be roughly the same size
+	// makes sense for them to be roughly the same size unless set otherwise by SetCacheSizes
 	cacheSize int
+	// Shared with copies of the forest
+	cacheCounters *cacheCounters
 	// Determines whether we use LoadVersionForOverwriting on underlying MutableTrees - since ImmutableForest is used
 	// by MutableForest in a writing context sometimes we do need to load a version destructively
 	overwriting bool
@@ -126,10 +132,11 @@ func NewImmutableForest(commitsTree KVCallbackIterableReader, treeDB dbm.DB, cac
 		return nil, fmt.Errorf("NewImmutableForest() could not create cache: %v", err)
 	}
 	imf := &ImmutableForest{
-		commitsTree: commitsTree,
-		treeDB:      treeDB,
-		treeCache:   cache,
-		cacheSize:   cacheSize,
+		commitsTree:   commitsTree,
+		treeDB:        treeDB,
+		treeCache:     cache,
+		cacheSize:     cacheSize,
+		cacheCounters: new(cacheCounters),
 	}
 	for _, opt := range options {
 		opt(imf)
@@ -137,6 +144,24 @@ func NewImmutableForest(commitsTree KVCallbackIterableReader, treeDB dbm.DB, cac
 	return imf, nil
 }
 
+// LoadImmutableForest reads the forest stored in db at version without loading it for writing, which would delete any
+// later versions
+func LoadImmutableForest(db dbm.DB, version int64, cacheSize int) (*ImmutableForest, error) {
+	commitsTree, err := NewMutableTree(NewPrefixDB(db, commitsPrefix), cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	err = commitsTree.Load(version, false)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := commitsTree.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+	return NewImmutableForest(commits, NewPrefixDB(db, treePrefix), cacheSize)
+}
+
 // Load mutable forest from database.
 func (muf *MutableForest) Load(version int64) error {
 	return muf.commitsTree.Load(version, true)
@@ -144,16 +169,45 @@ func (muf *MutableForest) Load(version int64) error {
 
 // Save accumulated writes into the latest version of the forest.
 func (muf *MutableForest) Save() (hash []byte, version int64, _ error) {
+	return muf.SaveConcurrently(1)
+}
+
+// SaveConcurrently saves like Save but writes up to workers trees to the database at once. The commits of the trees are
+// recorded in the same order as by Save so the forest has the same hash.
+func (muf *MutableForest) SaveConcurrently(workers int) (hash []byte, version int64, _ error) {
 	muf.Lock()
 	defer muf.Unlock()
 	// Save each tree in forest that requires save
+	var prefixes []string
 	for _, prefix := range muf.dirtyPrefixes {
-		tree := muf.dirty[prefix]
-		if tree.Updated() {
-			err := muf.saveTree([]byte(prefix), tree)
+		if muf.dirty[prefix].Updated() {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	commits := make([]CommitID, len(prefixes))
+	group := new(errgroup.Group)
+	sem := make(chan struct{}, workers)
+	for i, prefix := range prefixes {
+		i, tree := i, muf.dirty[prefix]
+		sem <- struct{}{}
+		group.Go(func() error {
+			defer func() { <-sem }()
+			hash, version, err := tree.Save()
 			if err != nil {
-				return nil, 0, err
+				return fmt.Errorf("MutableForest.SaveConcurrently() could not save tree: %v", err)
 			}
+			commits[i] = CommitID{Hash: hash, Version: version}
+			return nil
+		})
+	}
+	err := group.Wait()
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, prefix := range prefixes {
+		err = muf.setCommit([]byte(prefix), commits[i].Hash, commits[i].Version)
+		if err != nil {
+			return nil, 0, err
 		}
 	}
 	// empty dirty cache
@@ -162,6 +216,26 @@ func (muf *MutableForest) Save() (hash []byte, version int64, _ error) {
 	return muf.commitsTree.Save()
 }
 
+// WorkingHash returns the hash the forest will have once the writes accumulated since it was last saved are saved,
+// without saving anything.
+func (muf *MutableForest) WorkingHash() ([]byte, error) {
+	muf.Lock()
+	defer muf.Unlock()
+	for _, prefix := range muf.dirtyPrefixes {
+		tree := muf.dirty[prefix]
+		if tree.Updated() {
+			hash, version := tree.WorkingCommit()
+			// Saving sets the same commit again
+			err := muf.setCommit([]byte(prefix), hash, version)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	hash, _ := muf.commitsTree.WorkingCommit()
+	return hash, nil
+}
+
 // Get an immutable snapshot of tree state at the given previously committed version.
 func (muf *MutableForest) GetImmutable(version int64) (*ImmutableForest, error) {
 	commitsTree, err := muf.commitsTree.GetImmutable(version)
@@ -232,12 +306,66 @@ func (muf *MutableForest) Version() int64 {
 	return muf.commitsTree.Version()
 }
 
-func (muf *MutableForest) saveTree(prefix []byte, tree *RWTree) error {
-	hash, version, err := tree.Save()
+// Get the global hash for all trees in this forest at a previously committed version.
+func (muf *MutableForest) HashAtVersion(version int64) ([]byte, error) {
+	commitsTree, err := muf.commitsTree.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("MutableForest.HashAtVersion() could not get commits tree for version %d: %v",
+			version, err)
+	}
+	return commitsTree.Hash(), nil
+}
+
+// Delete all versions of the forest below version so they can no longer be read. Each tree keeps the version it had at
+// version of the forest and those after it.
+func (muf *MutableForest) DeleteVersionsBelow(version int64) error {
+	commitsTree, err := muf.commitsTree.GetImmutable(version)
+	if err != nil {
+		return fmt.Errorf("MutableForest.DeleteVersionsBelow() could not get commits tree for version %d: %v",
+			version, err)
+	}
+	err = commitsTree.Iterate(nil, nil, true, func(prefix []byte, bs []byte) error {
+		commitID, err := unmarshalCommitID(bs)
+		if err != nil {
+			return err
+		}
+		tree, err := muf.loadOrCreateTree(prefix)
+		if err != nil {
+			return err
+		}
+		return tree.DeleteVersionsBelow(commitID.Version)
+	})
+	if err != nil {
+		return fmt.Errorf("MutableForest.DeleteVersionsBelow() could not delete versions of trees: %v", err)
+	}
+	return muf.commitsTree.DeleteVersionsBelow(version)
+}
+
+// ArchiveLeaves moves the leaf nodes of the keys from start (inclusive) to end (exclusive) in the last saved version of
+// the tree at prefix to the cold storage of the forest's database and returns how many there were. Later versions share
+// a leaf until its key is written again so this suits keys written once. The hashes of the tree are unchanged and its
+// leaves are read back from cold storage as needed.
+func (muf *MutableForest) ArchiveLeaves(prefix, start, end []byte) (int, error) {
+	tree, err := muf.loadOrCreateTree(prefix)
 	if err != nil {
-		return fmt.Errorf("MutableForest.saveTree() could not save tree: %v", err)
+		return 0, err
 	}
-	return muf.setCommit(prefix, hash, version)
+	_, _, proof, err := tree.readTree.Load().(*ImmutableTree).GetRangeWithProof(start, end, 0)
+	if err != nil || proof == nil {
+		return 0, err
+	}
+	var nodeKeys [][]byte
+	// The proof includes the leaves either side of the range
+	for _, leaf := range proof.Leaves {
+		if bytes.Compare(leaf.Key, start) >= 0 && (end == nil || bytes.Compare(leaf.Key, end) < 0) {
+			nodeKeys = append(nodeKeys, iavlNodeKey(leaf.Hash()))
+		}
+	}
+	err = Archive(NewPrefixDB(muf.treeDB, string(prefix)), nodeKeys...)
+	if err != nil {
+		return 0, fmt.Errorf("MutableForest.ArchiveLeaves() could not archive leaves of tree %X: %v", prefix, err)
+	}
+	return len(nodeKeys), nil
 }
 
 func (muf *MutableForest) setCommit(prefix, hash []byte, version int64) error {
@@ -283,6 +411,113 @@ func (imf *ImmutableForest) Dump() string {
 	return dump.String()
 }
 
+// IterateChanges iterates over the changes to the tree at prefix since the forest was at base, an earlier version of
+// the same forest. It calls set with each key whose value was written since, and deleted with each key base had that the
+// tree no longer has. Trees that have not been written since base are skipped.
+func (imf *ImmutableForest) IterateChanges(base *ImmutableForest, prefix []byte, set func(key, value []byte) error,
+	deleted func(key []byte) error) error {
+	baseCommitID, err := base.commitID(prefix)
+	if err != nil {
+		return err
+	}
+	commitID, err := imf.commitID(prefix)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(baseCommitID.Hash, commitID.Hash) {
+		return nil
+	}
+	tree, err := imf.loadOrCreateTree(prefix)
+	if err != nil {
+		return err
+	}
+	// The tree may have been deleted and created afresh since base, or its base version pruned, in which case
+	// everything in it is new to us
+	var since int64
+	if baseCommitID.Version > 0 && tree.VersionExists(baseCommitID.Version) {
+		baseTree, err := tree.GetImmutable(baseCommitID.Version)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(baseTree.Hash(), baseCommitID.Hash) {
+			since = baseCommitID.Version
+		}
+	}
+	err = tree.IterateSince(nil, since, set)
+	if err != nil {
+		return err
+	}
+	if baseCommitID.Version == 0 {
+		return nil
+	}
+	baseTree, err := base.loadOrCreateTree(prefix)
+	if err != nil {
+		return err
+	}
+	return baseTree.Iterate(nil, nil, true, func(key []byte, _ []byte) error {
+		has, err := tree.Has(key)
+		if err != nil || has {
+			return err
+		}
+		return deleted(key)
+	})
+}
+
+// IterateChangedTrees calls fn with the prefix of each tree whose prefix starts with prefix that has been written or
+// deleted since the forest was at base, an earlier version of the same forest
+func (imf *ImmutableForest) IterateChangedTrees(base *ImmutableForest, prefix []byte,
+	fn func(treePrefix []byte) error) error {
+	commitsTree, ok := imf.commitsTree.(interface {
+		IterateSince(prefix []byte, version int64, fn func(key []byte, value []byte) error) error
+	})
+	if !ok {
+		return fmt.Errorf("ImmutableForest.IterateChangedTrees() cannot iterate changes to commits tree %T",
+			imf.commitsTree)
+	}
+	baseCommitsTree, ok := base.commitsTree.(Versioned)
+	if !ok {
+		return fmt.Errorf("ImmutableForest.IterateChangedTrees() cannot get version of commits tree %T",
+			base.commitsTree)
+	}
+	// The commits tree is saved with every version of the forest so its leaves record when each tree was last written
+	err := commitsTree.IterateSince(prefix, baseCommitsTree.Version(), func(treePrefix []byte, _ []byte) error {
+		return fn(treePrefix)
+	})
+	if err != nil {
+		return err
+	}
+	return base.commitsTree.Iterate(prefix, Prefix(prefix).Above(), true, func(treePrefix []byte, _ []byte) error {
+		has, err := imf.commitsTree.Has(treePrefix)
+		if err != nil || has {
+			return err
+		}
+		return fn(treePrefix)
+	})
+}
+
+// SetCacheSizes keeps up to trees trees loaded, and up to nodes nodes of each tree loaded from now on in memory. Trees
+// already loaded keep the size of node cache they were loaded with until they are loaded again.
+func (imf *ImmutableForest) SetCacheSizes(trees, nodes int) {
+	imf.Lock()
+	defer imf.Unlock()
+	imf.treeCache.Resize(trees)
+	imf.cacheSize = nodes
+}
+
+// CacheStats returns the sizes of the forest's caches and how often they have been used since it was created
+func (imf *ImmutableForest) CacheStats() CacheStats {
+	imf.Lock()
+	nodes := imf.cacheSize
+	imf.Unlock()
+	return CacheStats{
+		Trees:         imf.treeCache.Len(),
+		TreeHits:      atomic.LoadUint64(&imf.cacheCounters.treeHits),
+		TreeMisses:    atomic.LoadUint64(&imf.cacheCounters.treeMisses),
+		TreeNodes:     nodes,
+		TreeNodeReads: atomic.LoadUint64(&imf.cacheCounters.nodeReads),
+	}
+}
+
 // Shared implementation - these methods
 
 // Lazy load tree
@@ -291,6 +526,7 @@ func (imf *ImmutableForest) loadOrCreateTree(prefix []byte) (*RWTree, error) {
 	// Try cache
 	value, ok := imf.treeCache.Get(string(prefix))
 	if ok {
+		atomic.AddUint64(&imf.cacheCounters.treeHits, 1)
 		return value.(*RWTree), nil
 	}
 	// Not in caches but non-negative version - we should be able to load into memory
@@ -299,9 +535,15 @@ func (imf *ImmutableForest) loadOrCreateTree(prefix []byte) (*RWTree, error) {
 	// Check we haven't missed a cache fill
 	value, ok = imf.treeCache.Get(string(prefix))
 	if ok {
+		atomic.AddUint64(&imf.cacheCounters.treeHits, 1)
 		return value.(*RWTree), nil
 	}
-	tree, err := NewRWTree(NewPrefixDB(imf.treeDB, string(prefix)), imf.cacheSize)
+	atomic.AddUint64(&imf.cacheCounters.treeMisses, 1)
+	treeDB := &readCountingDB{
+		DB:    NewPrefixDB(imf.treeDB, string(prefix)),
+		reads: &imf.cacheCounters.nodeReads,
+	}
+	tree, err := NewRWTree(treeDB, imf.cacheSize)
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +92,25 @@ func TestMutableForest_Save(t *testing.T) {
         	            	`)
 }
 
+func TestMutableForest_WorkingHash(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	for i, prefix := range []string{"fooos", "prefixo", "fooos"} {
+		err = forest.Write([]byte(prefix), func(tree *RWTree) error {
+			tree.Set([]byte("bar"), []byte{byte(i)})
+			return nil
+		})
+		require.NoError(t, err)
+		workingHash, err := forest.WorkingHash()
+		require.NoError(t, err)
+		// Nothing is saved until Save
+		require.Equal(t, int64(i), forest.Version())
+		hash, _, err := forest.Save()
+		require.NoError(t, err)
+		require.Equal(t, hash, workingHash)
+	}
+}
+
 func TestMutableForest_Load(t *testing.T) {
 	db := dbm.NewMemDB()
 	forest, err := NewMutableForest(db, 100)
@@ -120,6 +139,47 @@ func TestMutableForest_Load(t *testing.T) {
 	require.Equal(t, dump, forest.Dump())
 }
 
+func TestMutableForest_Rollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	prefix := []byte("fooos")
+	set := func(key, value string) {
+		err := forest.Write(prefix, func(tree *RWTree) error {
+			tree.Set([]byte(key), []byte(value))
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	set("bar", "nog")
+	hash1, version1, err := forest.Save()
+	require.NoError(t, err)
+	set("bar", "egg")
+	_, version2, err := forest.Save()
+	require.NoError(t, err)
+
+	hash, err := forest.HashAtVersion(version1)
+	require.NoError(t, err)
+	require.Equal(t, hash1, hash)
+
+	// Loading an earlier version discards later versions so they can be written again
+	forest, err = NewMutableForest(db, 100)
+	require.NoError(t, err)
+	err = forest.Load(version1)
+	require.NoError(t, err)
+	require.Equal(t, hash1, forest.Hash())
+	set("bar", "flip")
+	_, version, err := forest.Save()
+	require.NoError(t, err)
+	require.Equal(t, version2, version)
+	reader, err := forest.Reader(prefix)
+	require.NoError(t, err)
+	value, err := reader.Get([]byte("bar"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("flip"), value)
+}
+
 func TestSorted(t *testing.T) {
 	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
 	require.NoError(t, err)
@@ -299,3 +359,89 @@ func trimMargin(str string) string {
 	}
 	return buf.String()
 }
+
+func TestMutableForest_DeleteVersionsBelow(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	set := func(prefix, key, value string) {
+		err := forest.Write([]byte(prefix), func(tree *RWTree) error {
+			tree.Set([]byte(key), []byte(value))
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	// The names tree is only written at the first version but is still part of later versions
+	set("names", "Cora", "female")
+	set("balances", "Cora", "1")
+	_, version1, err := forest.Save()
+	require.NoError(t, err)
+	set("balances", "Cora", "2")
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+	set("balances", "Cora", "3")
+	hash3, version3, err := forest.Save()
+	require.NoError(t, err)
+
+	err = forest.DeleteVersionsBelow(version3)
+	require.NoError(t, err)
+	_, err = forest.GetImmutable(version1)
+	require.Error(t, err)
+
+	// Everything at the versions kept can still be read, including after loading again
+	forest, err = NewMutableForest(db, 100)
+	require.NoError(t, err)
+	require.NoError(t, forest.Load(version3))
+	require.Equal(t, hash3, forest.Hash())
+	imf, err := forest.GetImmutable(version3)
+	require.NoError(t, err)
+	for prefix, value := range map[string]string{"names": "female", "balances": "3"} {
+		reader, err := imf.Reader([]byte(prefix))
+		require.NoError(t, err)
+		bs, err := reader.Get([]byte("Cora"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(value), bs)
+	}
+}
+
+func TestMutableForest_CacheStats(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	for _, prefix := range []string{"a", "b", "c"} {
+		err = forest.Write([]byte(prefix), func(tree *RWTree) error {
+			tree.Set([]byte("key"), []byte(prefix))
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	_, version, err := forest.Save()
+	require.NoError(t, err)
+	stats := forest.CacheStats()
+	require.Equal(t, 3, stats.Trees)
+	require.Equal(t, uint64(3), stats.TreeMisses)
+	require.Equal(t, 100, stats.TreeNodes)
+
+	// Trees loaded afresh read their nodes from the database
+	forest, err = NewMutableForest(db, 100)
+	require.NoError(t, err)
+	require.NoError(t, forest.Load(version))
+	forest.SetCacheSizes(1, 10)
+	for _, prefix := range []string{"a", "a", "b"} {
+		reader, err := forest.Reader([]byte(prefix))
+		require.NoError(t, err)
+		value, err := reader.Get([]byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(prefix), value)
+	}
+	stats = forest.CacheStats()
+	require.Equal(t, CacheStats{
+		Trees:         1,
+		TreeHits:      1,
+		TreeMisses:    2,
+		TreeNodes:     10,
+		TreeNodeReads: stats.TreeNodeReads,
+	}, stats)
+	require.NotZero(t, stats.TreeNodeReads)
+}
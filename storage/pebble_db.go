@@ -0,0 +1,282 @@
+// +build pebbledb
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	errPebbleKeyEmpty    = errors.New("key cannot be empty")
+	errPebbleValueNil    = errors.New("value cannot be nil")
+	errPebbleBatchClosed = errors.New("batch has been written or closed")
+)
+
+func init() {
+	backends[PebbleDBBackend] = func(name, dir string) (dbm.DB, error) {
+		return NewPebbleDB(name, dir)
+	}
+}
+
+// PebbleDB implements tm-db's DB over Pebble, whose compactions stall writes far less than LevelDB's
+type PebbleDB struct {
+	db *pebble.DB
+}
+
+var _ dbm.DB = (*PebbleDB)(nil)
+
+// NewPebbleDB opens (creating if necessary) the Pebble database name in dir
+func NewPebbleDB(name, dir string) (*PebbleDB, error) {
+	path := DBPath(name, PebbleDBBackend, dir)
+	err := os.MkdirAll(path, 0755)
+	if err != nil {
+		return nil, err
+	}
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return &PebbleDB{db: db}, nil
+}
+
+func (pdb *PebbleDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errPebbleKeyEmpty
+	}
+	value, closer, err := pdb.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	// The value is only valid until closed
+	return append([]byte{}, value...), nil
+}
+
+func (pdb *PebbleDB) Has(key []byte) (bool, error) {
+	value, err := pdb.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return value != nil, nil
+}
+
+func (pdb *PebbleDB) Set(key, value []byte) error {
+	return pdb.set(key, value, pebble.NoSync)
+}
+
+func (pdb *PebbleDB) SetSync(key, value []byte) error {
+	return pdb.set(key, value, pebble.Sync)
+}
+
+func (pdb *PebbleDB) set(key, value []byte, opts *pebble.WriteOptions) error {
+	if len(key) == 0 {
+		return errPebbleKeyEmpty
+	}
+	if value == nil {
+		return errPebbleValueNil
+	}
+	return pdb.db.Set(key, value, opts)
+}
+
+func (pdb *PebbleDB) Delete(key []byte) error {
+	return pdb.delete(key, pebble.NoSync)
+}
+
+func (pdb *PebbleDB) DeleteSync(key []byte) error {
+	return pdb.delete(key, pebble.Sync)
+}
+
+func (pdb *PebbleDB) delete(key []byte, opts *pebble.WriteOptions) error {
+	if len(key) == 0 {
+		return errPebbleKeyEmpty
+	}
+	return pdb.db.Delete(key, opts)
+}
+
+func (pdb *PebbleDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return pdb.newIterator(start, end, false)
+}
+
+func (pdb *PebbleDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return pdb.newIterator(start, end, true)
+}
+
+func (pdb *PebbleDB) newIterator(start, end []byte, reverse bool) (dbm.Iterator, error) {
+	if start != nil && len(start) == 0 || end != nil && len(end) == 0 {
+		return nil, errPebbleKeyEmpty
+	}
+	it, err := pdb.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	if err != nil {
+		return nil, err
+	}
+	if reverse {
+		it.Last()
+	} else {
+		it.First()
+	}
+	return &pebbleIterator{
+		source:  it,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}, nil
+}
+
+func (pdb *PebbleDB) Close() error {
+	return pdb.db.Close()
+}
+
+func (pdb *PebbleDB) NewBatch() dbm.Batch {
+	return &pebbleBatch{batch: pdb.db.NewBatch()}
+}
+
+func (pdb *PebbleDB) Print() error {
+	it, err := pdb.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", it.Key(), it.Value())
+	}
+	return it.Error()
+}
+
+func (pdb *PebbleDB) Stats() map[string]string {
+	return map[string]string{"pebble.metrics": pdb.db.Metrics().String()}
+}
+
+// Compact compacts every key so that the space of deleted keys is reclaimed
+func (pdb *PebbleDB) Compact() error {
+	it, err := pdb.db.NewIter(nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	if !it.First() {
+		return it.Error()
+	}
+	first := append([]byte{}, it.Key()...)
+	if !it.Last() {
+		return it.Error()
+	}
+	// The end of the range is exclusive
+	last := append(append([]byte{}, it.Key()...), 0)
+	return pdb.db.Compact(first, last, false)
+}
+
+type pebbleIterator struct {
+	source  *pebble.Iterator
+	start   []byte
+	end     []byte
+	reverse bool
+}
+
+var _ dbm.Iterator = (*pebbleIterator)(nil)
+
+func (pi *pebbleIterator) Domain() ([]byte, []byte) {
+	return pi.start, pi.end
+}
+
+func (pi *pebbleIterator) Valid() bool {
+	return pi.source.Valid()
+}
+
+func (pi *pebbleIterator) Next() {
+	if !pi.Valid() {
+		panic("iterator is invalid")
+	}
+	if pi.reverse {
+		pi.source.Prev()
+	} else {
+		pi.source.Next()
+	}
+}
+
+// Keys and values are only valid until the iterator moves so are copied
+func (pi *pebbleIterator) Key() []byte {
+	if !pi.Valid() {
+		panic("iterator is invalid")
+	}
+	return append([]byte{}, pi.source.Key()...)
+}
+
+func (pi *pebbleIterator) Value() []byte {
+	if !pi.Valid() {
+		panic("iterator is invalid")
+	}
+	return append([]byte{}, pi.source.Value()...)
+}
+
+func (pi *pebbleIterator) Error() error {
+	return pi.source.Error()
+}
+
+func (pi *pebbleIterator) Close() error {
+	return pi.source.Close()
+}
+
+type pebbleBatch struct {
+	batch *pebble.Batch
+}
+
+var _ dbm.Batch = (*pebbleBatch)(nil)
+
+func (pb *pebbleBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errPebbleKeyEmpty
+	}
+	if value == nil {
+		return errPebbleValueNil
+	}
+	if pb.batch == nil {
+		return errPebbleBatchClosed
+	}
+	return pb.batch.Set(key, value, nil)
+}
+
+func (pb *pebbleBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errPebbleKeyEmpty
+	}
+	if pb.batch == nil {
+		return errPebbleBatchClosed
+	}
+	return pb.batch.Delete(key, nil)
+}
+
+func (pb *pebbleBatch) Write() error {
+	return pb.write(pebble.NoSync)
+}
+
+func (pb *pebbleBatch) WriteSync() error {
+	return pb.write(pebble.Sync)
+}
+
+func (pb *pebbleBatch) write(opts *pebble.WriteOptions) error {
+	if pb.batch == nil {
+		return errPebbleBatchClosed
+	}
+	err := pb.batch.Commit(opts)
+	if err != nil {
+		return err
+	}
+	// Like the other backends a batch cannot be used once written
+	return pb.Close()
+}
+
+func (pb *pebbleBatch) Close() error {
+	if pb.batch != nil {
+		err := pb.batch.Close()
+		pb.batch = nil
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// +build pebbledb
+
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestPebbleDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestPebbleDB")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := NewDB("test", PebbleDBBackend, dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	value, err := db.Get([]byte("key3"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value3"), value)
+	value, err = db.Get([]byte("nokey"))
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	_, err = db.Get(nil)
+	assert.Error(t, err)
+	assert.Error(t, db.Set([]byte("key"), nil))
+
+	require.NoError(t, db.Delete([]byte("key3")))
+	has, err := db.Has([]byte("key3"))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	t.Run("Iterator", func(t *testing.T) {
+		assert.Equal(t, []string{"key1", "key2", "key4"}, pebbleKeys(t, db, []byte("key1"), []byte("key5"), false))
+		assert.Equal(t, []string{"key4", "key2", "key1"}, pebbleKeys(t, db, []byte("key1"), nil, true))
+		assert.Equal(t, []string{"key0", "key1"}, pebbleKeys(t, db, nil, []byte("key2"), false))
+		_, err := db.Iterator([]byte{}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Batch", func(t *testing.T) {
+		batch := db.NewBatch()
+		require.NoError(t, batch.Set([]byte("key5"), []byte("value5")))
+		require.NoError(t, batch.Delete([]byte("key0")))
+		// Nothing is written until the batch is
+		has, err := db.Has([]byte("key5"))
+		require.NoError(t, err)
+		assert.False(t, has)
+		require.NoError(t, batch.WriteSync())
+		assert.Equal(t, []string{"key1", "key2", "key4", "key5"}, pebbleKeys(t, db, nil, nil, false))
+		// Nor may it be written twice
+		assert.Error(t, batch.Write())
+		assert.Error(t, batch.Set([]byte("key6"), []byte("value6")))
+		require.NoError(t, batch.Close())
+	})
+}
+
+func TestMigrateToPebbleDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestMigrateToPebbleDB")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := NewDB("test", dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	for i := 0; i < migrateBatchSize+10; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%06d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(t, db.Close())
+
+	ok, err := Migrate("test", dir, dbm.GoLevelDBBackend, PebbleDBBackend, nil, dir+"/backup")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	db, err = NewDB("test", PebbleDBBackend, dir)
+	require.NoError(t, err)
+	defer db.Close()
+	value, err := db.Get([]byte("key010009"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value10009"), value)
+	assert.Len(t, pebbleKeys(t, db, nil, nil, false), migrateBatchSize+10)
+}
+
+func pebbleKeys(t *testing.T, db dbm.DB, start, end []byte, reverse bool) []string {
+	var it dbm.Iterator
+	var err error
+	if reverse {
+		it, err = db.ReverseIterator(start, end)
+	} else {
+		it, err = db.Iterator(start, end)
+	}
+	require.NoError(t, err)
+	defer it.Close()
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	require.NoError(t, it.Error())
+	return keys
+}
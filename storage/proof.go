@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/cosmos/iavl"
+	iavlproto "github.com/cosmos/iavl/proto"
+)
+
+// ProvableReader reads from an IAVL tree with proofs against its hash
+type ProvableReader interface {
+	GetWithProof(key []byte) ([]byte, *iavl.RangeProof, error)
+}
+
+var _ ProvableReader = &RWTree{}
+var _ ProvableReader = &ImmutableTree{}
+
+// GetWithProof returns the value at key in the tree at prefix with a proof of it, or of its absence, against the hash
+// of the forest. Thread-safe.
+func (imf *ImmutableForest) GetWithProof(prefix, key []byte) (*ForestProof, error) {
+	const errHeader = "ImmutableForest.GetWithProof():"
+	commitsTree, ok := imf.commitsTree.(ProvableReader)
+	if !ok {
+		return nil, fmt.Errorf("%s commits tree %T cannot provide proofs", errHeader, imf.commitsTree)
+	}
+	commitID, commitProof, err := commitsTree.GetWithProof(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("%s could not prove commit of tree %X: %v", errHeader, prefix, err)
+	}
+	proof := &ForestProof{
+		Prefix:   prefix,
+		Key:      key,
+		CommitID: commitID,
+	}
+	proof.CommitProof, err = marshalRangeProof(commitProof)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	if len(commitID) == 0 {
+		// There is no tree so the key is absent
+		return proof, nil
+	}
+	tree, err := imf.loadOrCreateTree(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	value, treeProof, err := tree.GetWithProof(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s could not prove key %X in tree %X: %v", errHeader, key, prefix, err)
+	}
+	proof.Value = value
+	proof.TreeProof, err = marshalRangeProof(treeProof)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	return proof, nil
+}
+
+// Verify checks that the proof holds against the hash of a forest, such as the AppHash of a block, so that Value is
+// stored at Key in the tree at Prefix, or that Key is absent if Value is empty
+func (fp *ForestProof) Verify(hash []byte) error {
+	err := verifyRangeProof(fp.CommitProof, hash, fp.Prefix, fp.CommitID)
+	if err != nil {
+		return fmt.Errorf("could not verify commit of tree %X: %v", fp.Prefix, err)
+	}
+	if len(fp.CommitID) == 0 {
+		if len(fp.Value) != 0 {
+			return fmt.Errorf("value given for key %X of tree %X that is absent", fp.Key, fp.Prefix)
+		}
+		return nil
+	}
+	commitID, err := unmarshalCommitID(fp.CommitID)
+	if err != nil {
+		return err
+	}
+	err = verifyRangeProof(fp.TreeProof, commitID.Hash, fp.Key, fp.Value)
+	if err != nil {
+		return fmt.Errorf("could not verify key %X of tree %X: %v", fp.Key, fp.Prefix, err)
+	}
+	return nil
+}
+
+func marshalRangeProof(proof *iavl.RangeProof) ([]byte, error) {
+	// An empty tree has no proof
+	if proof == nil {
+		return nil, nil
+	}
+	bs, err := proof.ToProto().Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode RangeProof: %v", err)
+	}
+	return bs, nil
+}
+
+// Verifies that value is stored at key in the tree with root hash, or that key is absent if value is empty
+func verifyRangeProof(bs, root, key, value []byte) error {
+	if len(bs) == 0 {
+		if len(root) != 0 {
+			return fmt.Errorf("no proof given for non-empty tree")
+		}
+		if len(value) != 0 {
+			return fmt.Errorf("value given for key absent from empty tree")
+		}
+		return nil
+	}
+	pb := new(iavlproto.RangeProof)
+	err := pb.Unmarshal(bs)
+	if err != nil {
+		return fmt.Errorf("could not decode RangeProof: %v", err)
+	}
+	proof, err := iavl.RangeProofFromProto(pb)
+	if err != nil {
+		return err
+	}
+	if len(proof.Leaves) == 0 {
+		return fmt.Errorf("RangeProof has no leaves")
+	}
+	err = proof.Verify(root)
+	if err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		return proof.VerifyAbsence(key)
+	}
+	return proof.VerifyItem(key, value)
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestImmutableForest_GetWithProof(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	for _, prefix := range []string{"balances", "names"} {
+		err = forest.Write([]byte(prefix), func(tree *RWTree) error {
+			tree.Set([]byte("Cora"), []byte(prefix+" of Cora"))
+			tree.Set([]byte("Edward"), []byte(prefix+" of Edward"))
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	hash, version, err := forest.Save()
+	require.NoError(t, err)
+
+	// Move on so that we prove against an older version
+	err = forest.Write([]byte("balances"), func(tree *RWTree) error {
+		tree.Set([]byte("Cora"), []byte("spent"))
+		return nil
+	})
+	require.NoError(t, err)
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+	imf, err := forest.GetImmutable(version)
+	require.NoError(t, err)
+
+	proof, err := imf.GetWithProof([]byte("balances"), []byte("Cora"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("balances of Cora"), proof.Value)
+	require.NoError(t, proof.Verify(hash))
+	require.Error(t, proof.Verify(forest.Hash()))
+
+	proof.Value = []byte("spent")
+	require.Error(t, proof.Verify(hash), "a value other than the one proved should not verify")
+	proof.Value = nil
+	require.Error(t, proof.Verify(hash), "a key that is present should not be proved absent")
+
+	// Absent key
+	proof, err = imf.GetWithProof([]byte("names"), []byte("Lindsay"))
+	require.NoError(t, err)
+	assert.Nil(t, proof.Value)
+	require.NoError(t, proof.Verify(hash))
+	proof.Value = []byte("names of Lindsay")
+	require.Error(t, proof.Verify(hash))
+
+	// Absent tree
+	proof, err = imf.GetWithProof([]byte("genders"), []byte("Cora"))
+	require.NoError(t, err)
+	assert.Empty(t, proof.CommitID)
+	require.NoError(t, proof.Verify(hash))
+
+	// Proofs against the latest version
+	proof, err = forest.GetWithProof([]byte("balances"), []byte("Cora"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("spent"), proof.Value)
+	require.NoError(t, proof.Verify(forest.Hash()))
+}
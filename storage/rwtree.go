@@ -86,6 +86,13 @@ func (rwt *RWTree) Save() ([]byte, int64, error) {
 	return hash, version, nil
 }
 
+// Returns the hash and version the write tree will have once saved, without saving it
+func (rwt *RWTree) WorkingCommit() ([]byte, int64) {
+	rwt.RLock()
+	defer rwt.RUnlock()
+	return rwt.tree.WorkingHash(), rwt.tree.Version() + 1
+}
+
 func (rwt *RWTree) Set(key, value []byte) bool {
 	rwt.Lock()
 	defer rwt.Unlock()
@@ -100,6 +107,17 @@ func (rwt *RWTree) Delete(key []byte) ([]byte, bool) {
 	return rwt.tree.Remove(key)
 }
 
+// Delete all saved versions of the tree below version so they can no longer be read
+func (rwt *RWTree) DeleteVersionsBelow(version int64) error {
+	rwt.Lock()
+	defer rwt.Unlock()
+	err := rwt.tree.DeleteVersionsBelow(version)
+	if err != nil {
+		return fmt.Errorf("RWTree.DeleteVersionsBelow() could not delete versions below %d: %v", version, err)
+	}
+	return nil
+}
+
 // Write-side read methods - of the mutable tree - synchronised by read-lock of RWMutex
 
 // Returns true if there have been any writes since last save
@@ -115,6 +133,12 @@ func (rwt *RWTree) GetImmutable(version int64) (*ImmutableTree, error) {
 	return rwt.tree.GetImmutable(version)
 }
 
+func (rwt *RWTree) VersionExists(version int64) bool {
+	rwt.RLock()
+	defer rwt.RUnlock()
+	return rwt.tree.VersionExists(version)
+}
+
 func (rwt *RWTree) IterateWriteTree(start, end []byte, ascending bool, fn func(key []byte, value []byte) error) error {
 	rwt.RLock()
 	defer rwt.RUnlock()
@@ -135,6 +159,11 @@ func (rwt *RWTree) Get(key []byte) ([]byte, error) {
 	return rwt.readTree.Load().(*ImmutableTree).Get(key)
 }
 
+// GetWithProof returns the value at key, or nil if there is none, with a proof of it against Hash()
+func (rwt *RWTree) GetWithProof(key []byte) ([]byte, *iavl.RangeProof, error) {
+	return rwt.readTree.Load().(*ImmutableTree).GetWithProof(key)
+}
+
 func (rwt *RWTree) Has(key []byte) (bool, error) {
 	return rwt.readTree.Load().(*ImmutableTree).Has(key)
 }
@@ -143,6 +172,11 @@ func (rwt *RWTree) Iterate(low, high []byte, ascending bool, fn func(key []byte,
 	return rwt.readTree.Load().(*ImmutableTree).Iterate(low, high, ascending, fn)
 }
 
+// IterateSince iterates over the keys starting with prefix that were written after version of the tree
+func (rwt *RWTree) IterateSince(prefix []byte, version int64, fn func(key []byte, value []byte) error) error {
+	return rwt.readTree.Load().(*ImmutableTree).IterateSince(prefix, version, fn)
+}
+
 // Tree printing
 
 func (rwt *RWTree) Dump() string {
@@ -0,0 +1,189 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	hex "github.com/tmthrgd/go-hex"
+)
+
+const (
+	DefaultS3Region = "us-east-1"
+	s3Timeout       = time.Minute
+	amzDateFormat   = "20060102T150405Z"
+)
+
+// S3ColdStore keeps each value in an object named by its key in an S3 bucket (or that of an S3 compatible service).
+// Requests are signed with AWS Signature Version 4 and address the bucket in the path so that bucket names containing
+// dots and services without virtual hosting work.
+type S3ColdStore struct {
+	client       *http.Client
+	endpoint     *url.URL
+	bucket       string
+	prefix       string
+	region       string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+}
+
+// NewS3ColdStore returns a cold store keeping objects under prefix in bucket, using credentials from the environment.
+// An empty endpoint is AWS S3 in region.
+func NewS3ColdStore(bucket, prefix, region, endpoint string) (*S3ColdStore, error) {
+	if bucket == "" {
+		return nil, fmt.Errorf("S3 cold storage location must name a bucket")
+	}
+	if region == "" {
+		region = DefaultS3Region
+	}
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse S3 endpoint %s: %w", endpoint, err)
+	}
+	s3cs := &S3ColdStore{
+		client:       &http.Client{Timeout: s3Timeout},
+		endpoint:     endpointURL,
+		bucket:       bucket,
+		prefix:       prefix,
+		region:       region,
+		accessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if s3cs.accessKey == "" || s3cs.secretKey == "" {
+		return nil, fmt.Errorf("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to use S3 cold storage")
+	}
+	return s3cs, nil
+}
+
+func (s3cs *S3ColdStore) Get(key []byte) ([]byte, error) {
+	response, err := s3cs.do(http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, s3Error(response)
+	}
+	return ioutil.ReadAll(response.Body)
+}
+
+func (s3cs *S3ColdStore) Set(key, value []byte) error {
+	response, err := s3cs.do(http.MethodPut, key, value)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return s3Error(response)
+	}
+	return nil
+}
+
+func (s3cs *S3ColdStore) Delete(key []byte) error {
+	response, err := s3cs.do(http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK &&
+		response.StatusCode != http.StatusNotFound {
+		return s3Error(response)
+	}
+	return nil
+}
+
+func (s3cs *S3ColdStore) do(method string, key, body []byte) (*http.Response, error) {
+	segments := []string{s3cs.bucket}
+	if s3cs.prefix != "" {
+		segments = append(segments, strings.Split(s3cs.prefix, "/")...)
+	}
+	segments = append(segments, hex.EncodeToString(key))
+	path := strings.TrimSuffix(s3cs.endpoint.Path, "/")
+	rawPath := path
+	for _, segment := range segments {
+		path += "/" + segment
+		rawPath += "/" + uriEncode(segment)
+	}
+	u := *s3cs.endpoint
+	u.Path = path
+	u.RawPath = rawPath
+	request, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s3cs.sign(request, rawPath, body, time.Now().UTC())
+	return s3cs.client.Do(request)
+}
+
+// Signs the request with AWS Signature Version 4 as described in
+// https://docs.aws.amazon.com/general/latest/gr/sigv4-create-canonical-request.html
+func (s3cs *S3ColdStore) sign(request *http.Request, rawPath string, body []byte, now time.Time) {
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+	payloadHash := sha256.Sum256(body)
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n", request.URL.Host,
+		hex.EncodeToString(payloadHash[:]), amzDate)
+	if s3cs.sessionToken != "" {
+		request.Header.Set("X-Amz-Security-Token", s3cs.sessionToken)
+		signedHeaders += ";x-amz-security-token"
+		canonicalHeaders += fmt.Sprintf("x-amz-security-token:%s\n", s3cs.sessionToken)
+	}
+	canonicalRequest := strings.Join([]string{request.Method, rawPath, "", canonicalHeaders, signedHeaders,
+		hex.EncodeToString(payloadHash[:])}, "\n")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s3cs.region)
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope,
+		hex.EncodeToString(canonicalHash[:])}, "\n")
+	key := hmacSHA256([]byte("AWS4"+s3cs.secretKey), date)
+	key = hmacSHA256(key, s3cs.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3cs.accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// Encodes every byte but the unreserved characters as signing requires
+func uriEncode(s string) string {
+	sb := new(strings.Builder)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+func s3Error(response *http.Response) error {
+	body, _ := ioutil.ReadAll(response.Body)
+	return fmt.Errorf("S3 %s %s returned %s: %s", response.Request.Method, response.Request.URL.Path,
+		response.Status, bytes.TrimSpace(body))
+}
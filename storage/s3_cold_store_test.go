@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3ColdStore(t *testing.T) {
+	var lock sync.Mutex
+	objects := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=marmot/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			object, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(object)
+		case http.MethodPut:
+			object, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			objects[r.URL.Path] = object
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	for key, value := range map[string]string{"AWS_ACCESS_KEY_ID": "marmot", "AWS_SECRET_ACCESS_KEY": "secret"} {
+		previous, ok := os.LookupEnv(key)
+		require.NoError(t, os.Setenv(key, value))
+		if ok {
+			defer os.Setenv(key, previous)
+		} else {
+			defer os.Unsetenv(key)
+		}
+	}
+	conf := &ColdStorageConfig{Location: "s3://bucket/archive/", S3Endpoint: server.URL}
+	cold, err := conf.ColdStore("blockstore")
+	require.NoError(t, err)
+	testColdStore(t, cold)
+
+	require.NoError(t, cold.Set([]byte("H:1"), []byte("block")))
+	require.Contains(t, objects, "/bucket/archive/blockstore/483a31")
+}
@@ -81,28 +81,127 @@ func (m *CommitID) GetHash() []byte {
 func (*CommitID) XXX_MessageName() string {
 	return "storage.CommitID"
 }
+
+// Proves the value of a key in a tree of a forest, or its absence, against the hash of the forest
+type ForestProof struct {
+	Prefix []byte `protobuf:"bytes,1,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Key    []byte `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	// The value stored at Key, or empty if there is none
+	Value []byte `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	// The CommitID of the tree at Prefix as stored in the commits tree, or empty if there is no such tree
+	CommitID []byte `protobuf:"bytes,4,opt,name=CommitID,proto3" json:"CommitID,omitempty"`
+	// IAVL RangeProof of CommitID at Prefix in the commits tree
+	CommitProof []byte `protobuf:"bytes,5,opt,name=CommitProof,proto3" json:"CommitProof,omitempty"`
+	// IAVL RangeProof of Value at Key in the tree, or empty if there is no tree or the tree is empty
+	TreeProof            []byte   `protobuf:"bytes,6,opt,name=TreeProof,proto3" json:"TreeProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForestProof) Reset()         { *m = ForestProof{} }
+func (m *ForestProof) String() string { return proto.CompactTextString(m) }
+func (*ForestProof) ProtoMessage()    {}
+func (*ForestProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{1}
+}
+func (m *ForestProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForestProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ForestProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForestProof.Merge(m, src)
+}
+func (m *ForestProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForestProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForestProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForestProof proto.InternalMessageInfo
+
+func (m *ForestProof) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *ForestProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ForestProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ForestProof) GetCommitID() []byte {
+	if m != nil {
+		return m.CommitID
+	}
+	return nil
+}
+
+func (m *ForestProof) GetCommitProof() []byte {
+	if m != nil {
+		return m.CommitProof
+	}
+	return nil
+}
+
+func (m *ForestProof) GetTreeProof() []byte {
+	if m != nil {
+		return m.TreeProof
+	}
+	return nil
+}
+
+func (*ForestProof) XXX_MessageName() string {
+	return "storage.ForestProof"
+}
 func init() {
 	proto.RegisterType((*CommitID)(nil), "storage.CommitID")
 	golang_proto.RegisterType((*CommitID)(nil), "storage.CommitID")
+	proto.RegisterType((*ForestProof)(nil), "storage.ForestProof")
+	golang_proto.RegisterType((*ForestProof)(nil), "storage.ForestProof")
 }
 
 func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
 func init() { golang_proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
 
 var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 177 bytes of a gzipped FileDescriptorProto
+	// 269 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x2e, 0xc9, 0x2f,
 	0x4a, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x72, 0xa5, 0x44, 0xd2,
 	0xf3, 0xd3, 0xf3, 0xc1, 0x62, 0xfa, 0x20, 0x16, 0x44, 0x5a, 0xc9, 0x8e, 0x8b, 0xc3, 0x39, 0x3f,
 	0x37, 0x37, 0xb3, 0xc4, 0xd3, 0x45, 0x48, 0x82, 0x8b, 0x3d, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x3f,
 	0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0x39, 0x08, 0xc6, 0x15, 0x12, 0xe2, 0x62, 0xf1, 0x48, 0x2c,
 	0xce, 0x90, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x09, 0x02, 0xb3, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67,
-	0x70, 0xb2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x1b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x3c, 0xf0, 0x58, 0x8e, 0xf1, 0xc4, 0x63, 0x39, 0xc6, 0x28, 0xd5, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x8c, 0xca, 0x82, 0xd4, 0xa2, 0x9c, 0xd4,
-	0x94, 0xf4, 0xd4, 0x22, 0xfd, 0xa4, 0xd2, 0xa2, 0xa2, 0xfc, 0x72, 0x7d, 0xa8, 0xb3, 0x92, 0xd8,
-	0xc0, 0xee, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xff, 0x1e, 0xd4, 0x9e, 0xb7, 0x00, 0x00,
-	0x00,
+	0x50, 0x5a, 0xc9, 0xc8, 0xc5, 0xed, 0x96, 0x5f, 0x94, 0x5a, 0x5c, 0x12, 0x50, 0x94, 0x9f, 0x9f,
+	0x26, 0x24, 0xc6, 0xc5, 0x16, 0x50, 0x94, 0x9a, 0x96, 0x59, 0x01, 0x36, 0x82, 0x27, 0x08, 0xca,
+	0x13, 0x12, 0xe0, 0x62, 0xf6, 0x4e, 0xad, 0x84, 0x1a, 0x00, 0x62, 0x0a, 0x89, 0x70, 0xb1, 0x86,
+	0x25, 0xe6, 0x94, 0xa6, 0x4a, 0x30, 0x83, 0xc5, 0x20, 0x1c, 0x21, 0x29, 0x84, 0x7b, 0x24, 0x58,
+	0xc0, 0x12, 0x08, 0xf7, 0x29, 0x70, 0x71, 0x43, 0xd8, 0x60, 0xab, 0x24, 0x58, 0xc1, 0xd2, 0xc8,
+	0x42, 0x42, 0x32, 0x5c, 0x9c, 0x21, 0x45, 0xa9, 0xa9, 0x10, 0x79, 0x36, 0xb0, 0x3c, 0x42, 0xc0,
+	0xc9, 0xfe, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x6f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
+	0x48, 0x8e, 0xf1, 0xc0, 0x63, 0x39, 0xc6, 0x13, 0x8f, 0xe5, 0x18, 0xa3, 0x54, 0xd3, 0x33, 0x4b,
+	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x33, 0x2a, 0x0b, 0x52, 0x8b, 0x72, 0x52, 0x53,
+	0xd2, 0x53, 0x8b, 0xf4, 0x93, 0x4a, 0x8b, 0x8a, 0xf2, 0xcb, 0xf5, 0xa1, 0x41, 0x98, 0xc4, 0x06,
+	0x0e, 0x33, 0x63, 0xc0, 0x00, 0x5f, 0x32, 0x52, 0x8a, 0x63, 0x01, 0x00, 0x00,
 }
 
 func (m *CommitID) Marshal() (dAtA []byte, err error) {
@@ -144,6 +243,75 @@ func (m *CommitID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForestProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForestProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForestProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TreeProof) > 0 {
+		i -= len(m.TreeProof)
+		copy(dAtA[i:], m.TreeProof)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.TreeProof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CommitProof) > 0 {
+		i -= len(m.CommitProof)
+		copy(dAtA[i:], m.CommitProof)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.CommitProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CommitID) > 0 {
+		i -= len(m.CommitID)
+		copy(dAtA[i:], m.CommitID)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.CommitID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStorage(v)
 	base := offset
@@ -174,6 +342,42 @@ func (m *CommitID) Size() (n int) {
 	return n
 }
 
+func (m *ForestProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.CommitID)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.CommitProof)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.TreeProof)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovStorage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -284,6 +488,261 @@ func (m *CommitID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ForestProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForestProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForestProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitID = append(m.CommitID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitID == nil {
+				m.CommitID = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitProof = append(m.CommitProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitProof == nil {
+				m.CommitProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreeProof = append(m.TreeProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.TreeProof == nil {
+				m.TreeProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStorage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,244 @@
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	// Marks a key whose value has been moved to cold storage
+	archivedKeyPrefix = Prefix("\xffcold:")
+	// The height below which the caller has moved what it wants to cold storage
+	archivedHeightKey = []byte("\xffcold-height")
+)
+
+// TieredDB is a DB whose keys can be moved from a hot database to a cold store by Archive, after which they are read
+// from the cold store transparently. Iteration only visits the keys still in the hot database. Setting an archived key
+// stores its new value in the hot database again and deleting it deletes it from both.
+type TieredDB struct {
+	hot  dbm.DB
+	cold ColdStore
+}
+
+var _ dbm.DB = &TieredDB{}
+
+func NewTieredDB(hot dbm.DB, cold ColdStore) *TieredDB {
+	return &TieredDB{
+		hot:  hot,
+		cold: cold,
+	}
+}
+
+// Archive moves the values of keys to the cold store, ignoring any not in the hot database
+func (tdb *TieredDB) Archive(keys ...[]byte) error {
+	batch := tdb.hot.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		value, err := tdb.hot.Get(key)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			continue
+		}
+		err = tdb.cold.Set(key, value)
+		if err != nil {
+			return fmt.Errorf("could not move key %X to cold storage: %w", key, err)
+		}
+		err = batch.Set(archivedKeyPrefix.Key(key), []byte{1})
+		if err != nil {
+			return err
+		}
+		err = batch.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// ArchivedHeight returns the height recorded by SetArchivedHeight, or zero if none has been
+func (tdb *TieredDB) ArchivedHeight() (uint64, error) {
+	bs, err := tdb.hot.Get(archivedHeightKey)
+	if err != nil || len(bs) != 8 {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bs), nil
+}
+
+// SetArchivedHeight records the height below which the caller has archived what it wants to, to resume from later
+func (tdb *TieredDB) SetArchivedHeight(height uint64) error {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, height)
+	return tdb.hot.SetSync(archivedHeightKey, bs)
+}
+
+// DB implementation
+
+func (tdb *TieredDB) Get(key []byte) ([]byte, error) {
+	value, err := tdb.hot.Get(key)
+	if err != nil || value != nil {
+		return value, err
+	}
+	archived, err := tdb.hot.Has(archivedKeyPrefix.Key(key))
+	if err != nil || !archived {
+		return nil, err
+	}
+	value, err = tdb.cold.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not read key %X from cold storage: %w", key, err)
+	}
+	if value == nil {
+		return nil, fmt.Errorf("key %X was moved to cold storage but is missing from it", key)
+	}
+	return value, nil
+}
+
+func (tdb *TieredDB) Has(key []byte) (bool, error) {
+	has, err := tdb.hot.Has(key)
+	if err != nil || has {
+		return has, err
+	}
+	return tdb.hot.Has(archivedKeyPrefix.Key(key))
+}
+
+func (tdb *TieredDB) Set(key, value []byte) error {
+	return tdb.hot.Set(key, value)
+}
+
+func (tdb *TieredDB) SetSync(key, value []byte) error {
+	return tdb.hot.SetSync(key, value)
+}
+
+func (tdb *TieredDB) Delete(key []byte) error {
+	return tdb.delete(key, false)
+}
+
+func (tdb *TieredDB) DeleteSync(key []byte) error {
+	return tdb.delete(key, true)
+}
+
+func (tdb *TieredDB) delete(key []byte, sync bool) error {
+	batch := tdb.NewBatch()
+	defer batch.Close()
+	err := batch.Delete(key)
+	if err != nil {
+		return err
+	}
+	if sync {
+		return batch.WriteSync()
+	}
+	return batch.Write()
+}
+
+func (tdb *TieredDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return tdb.hot.Iterator(start, reservedEnd(end))
+}
+
+func (tdb *TieredDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return tdb.hot.ReverseIterator(start, reservedEnd(end))
+}
+
+func (tdb *TieredDB) Close() error {
+	return tdb.hot.Close()
+}
+
+func (tdb *TieredDB) Print() error {
+	return tdb.hot.Print()
+}
+
+func (tdb *TieredDB) Stats() map[string]string {
+	stats := make(map[string]string)
+	stats["TieredDB.cold"] = fmt.Sprintf("%T", tdb.cold)
+	for key, value := range tdb.hot.Stats() {
+		stats["TieredDB.hot."+key] = value
+	}
+	return stats
+}
+
+func (tdb *TieredDB) NewBatch() dbm.Batch {
+	return &tieredBatch{
+		db:    tdb,
+		batch: tdb.hot.NewBatch(),
+	}
+}
+
+// Deletes keys from the cold store once the deletion of their hot copies is written
+type tieredBatch struct {
+	db      *TieredDB
+	batch   dbm.Batch
+	deleted [][]byte
+}
+
+func (tb *tieredBatch) Set(key, value []byte) error {
+	return tb.batch.Set(key, value)
+}
+
+func (tb *tieredBatch) Delete(key []byte) error {
+	tb.deleted = append(tb.deleted, key)
+	return tb.batch.Delete(key)
+}
+
+func (tb *tieredBatch) Write() error {
+	return tb.write(tb.batch.Write)
+}
+
+func (tb *tieredBatch) WriteSync() error {
+	return tb.write(tb.batch.WriteSync)
+}
+
+func (tb *tieredBatch) write(write func() error) error {
+	var archived [][]byte
+	for _, key := range tb.deleted {
+		marker := archivedKeyPrefix.Key(key)
+		has, err := tb.db.hot.Has(marker)
+		if err != nil {
+			return err
+		}
+		if has {
+			archived = append(archived, key)
+			err = tb.batch.Delete(marker)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err := write()
+	if err != nil {
+		return err
+	}
+	// If we fail from here the values left in the cold store are never read
+	for _, key := range archived {
+		err = tb.db.cold.Delete(key)
+		if err != nil {
+			return fmt.Errorf("could not delete key %X from cold storage: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (tb *tieredBatch) Close() error {
+	return tb.batch.Close()
+}
+
+// Archive moves the values of keys in db to cold storage if db is, or is a PrefixDB of, a TieredDB
+func Archive(db dbm.DB, keys ...[]byte) error {
+	switch d := db.(type) {
+	case *PrefixDB:
+		prefixed := make([][]byte, len(keys))
+		for i, key := range keys {
+			prefixed[i] = d.prefix.Key(key)
+		}
+		return Archive(d.db, prefixed...)
+	case *TieredDB:
+		return d.Archive(keys...)
+	}
+	return fmt.Errorf("cannot archive keys of %T since it has no cold storage", db)
+}
+
+// IAVL stores each node under its hash with this prefix but does not export its key formats
+func iavlNodeKey(hash []byte) []byte {
+	return append([]byte{'n'}, hash...)
+}
@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestTieredDB(t *testing.T) {
+	hot := dbm.NewMemDB()
+	cold := newMemColdStore()
+	db := NewTieredDB(hot, cold)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(t, db.Archive([]byte("key1"), []byte("key2"), []byte("missing")))
+	require.Len(t, cold, 2)
+
+	// Archived keys are read from the cold store
+	value, err := hot.Get([]byte("key1"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	value, err = db.Get([]byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), value)
+	has, err := db.Has([]byte("key2"))
+	require.NoError(t, err)
+	require.True(t, has)
+	value, err = db.Get([]byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// Only hot keys are iterated and never our own records
+	it, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"key0", "key3", "key4", "key5", "key6", "key7", "key8", "key9"}, keys)
+
+	// Setting an archived key makes it hot again
+	require.NoError(t, db.Set([]byte("key1"), []byte("hot")))
+	value, err = db.Get([]byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("hot"), value)
+
+	// Deleting deletes from both
+	batch := db.NewBatch()
+	require.NoError(t, batch.Delete([]byte("key1")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	require.NoError(t, db.Delete([]byte("key2")))
+	require.Len(t, cold, 0)
+	for _, key := range []string{"key1", "key2"} {
+		has, err = db.Has([]byte(key))
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+
+	height, err := db.ArchivedHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), height)
+	require.NoError(t, db.SetArchivedHeight(42))
+	height, err = db.ArchivedHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), height)
+}
+
+func TestMutableForest_ArchiveLeaves(t *testing.T) {
+	hot := dbm.NewMemDB()
+	cold := newMemColdStore()
+	db := NewPrefixDB(NewTieredDB(hot, cold), "f")
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	prefix := []byte("e")
+	for i := 0; i < 20; i++ {
+		require.NoError(t, forest.Write(prefix, func(tree *RWTree) error {
+			tree.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%d", i)))
+			return nil
+		}))
+		_, _, err = forest.Save()
+		require.NoError(t, err)
+	}
+	hash, version := forest.Hash(), forest.Version()
+
+	archived, err := forest.ArchiveLeaves(prefix, []byte("key05"), []byte("key10"))
+	require.NoError(t, err)
+	require.Equal(t, 5, archived)
+	require.Len(t, cold, 5)
+
+	// Read with empty caches so that leaves come from the cold store
+	forest, err = NewMutableForest(db, 100)
+	require.NoError(t, err)
+	require.NoError(t, forest.Load(version))
+	require.Equal(t, hash, forest.Hash())
+	reader, err := forest.Reader(prefix)
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		value, err := reader.Get([]byte(fmt.Sprintf("key%02d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+	}
+
+	// Nowhere to archive to without cold storage
+	forest, err = NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	require.NoError(t, forest.Write(prefix, func(tree *RWTree) error {
+		tree.Set([]byte("key"), []byte("value"))
+		return nil
+	}))
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+	_, err = forest.ArchiveLeaves(prefix, nil, nil)
+	require.Error(t, err)
+}
+
+func TestFileColdStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestFileColdStore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	conf := &ColdStorageConfig{Location: dir}
+	cold, err := conf.ColdStore("burrow_state")
+	require.NoError(t, err)
+	testColdStore(t, cold)
+}
+
+func testColdStore(t *testing.T, cold ColdStore) {
+	key := []byte("H:1")
+	value, err := cold.Get(key)
+	require.NoError(t, err)
+	require.Nil(t, value)
+	require.NoError(t, cold.Set(key, []byte("block")))
+	value, err = cold.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, []byte("block"), value)
+	require.NoError(t, cold.Delete(key))
+	value, err = cold.Get(key)
+	require.NoError(t, err)
+	require.Nil(t, value)
+	require.NoError(t, cold.Delete(key))
+}
+
+type memColdStore map[string][]byte
+
+func newMemColdStore() memColdStore {
+	return make(memColdStore)
+}
+
+func (mcs memColdStore) Get(key []byte) ([]byte, error) {
+	return mcs[string(key)], nil
+}
+
+func (mcs memColdStore) Set(key, value []byte) error {
+	mcs[string(key)] = value
+	return nil
+}
+
+func (mcs memColdStore) Delete(key []byte) error {
+	delete(mcs, string(key))
+	return nil
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/iavl"
@@ -44,6 +45,15 @@ func (mut *MutableTree) Load(version int64, overwriting bool) error {
 	return nil
 }
 
+// Delete all saved versions of the tree below version
+func (mut *MutableTree) DeleteVersionsBelow(version int64) error {
+	versions := mut.AvailableVersions()
+	if len(versions) == 0 || int64(versions[0]) >= version {
+		return nil
+	}
+	return mut.DeleteVersionsRange(int64(versions[0]), version)
+}
+
 func (mut *MutableTree) Iterate(start, end []byte, ascending bool, fn func(key []byte, value []byte) error) error {
 	return mut.asImmutable().Iterate(start, end, ascending, fn)
 }
@@ -94,6 +104,23 @@ func (imt *ImmutableTree) Iterate(start, end []byte, ascending bool, fn func(key
 	return err
 }
 
+// IterateSince iterates in ascending order over the keys starting with prefix whose values were written after version
+// of the tree, which IAVL records for each leaf.
+func (imt *ImmutableTree) IterateSince(prefix []byte, version int64, fn func(key []byte, value []byte) error) error {
+	var err error
+	imt.ImmutableTree.IterateRangeInclusive(prefix, nil, true, func(key, value []byte, leafVersion int64) bool {
+		if !bytes.HasPrefix(key, prefix) {
+			return true
+		}
+		if leafVersion <= version {
+			return false
+		}
+		err = fn(key, value)
+		return err != nil
+	})
+	return err
+}
+
 // Get the current working tree as an ImmutableTree (for the methods - not immutable!)
 func (mut *MutableTree) asImmutable() *ImmutableTree {
 	return &ImmutableTree{mut.MutableTree.ImmutableTree}
@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/iavl"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Trees are read through once when verifying so there is little to gain from caching their nodes
+const verifyCacheSize = 1000
+
+// ForestReport describes the integrity of a forest checked by VerifyForest
+type ForestReport struct {
+	// Hash of the forest at the version verified
+	Hash []byte
+	// Hashes of every version of the forest up to the version verified that has not been pruned
+	Hashes map[int64][]byte
+	// Number of trees checked, including the commits tree
+	Trees int
+	// Trees whose nodes do not hash to the hash committed for them, or are missing, which cannot be repaired
+	Corrupt []Prefix
+	// Trees with versions saved after the version committed for them, as when a node stops part way through a
+	// commit, whose nodes nothing refers to. The commits tree has the empty prefix.
+	Orphaned []Prefix
+	// Whether the orphaned versions were deleted
+	Repaired bool
+}
+
+// VerifyForest checks that the nodes of the commits tree of the forest stored in db at version, and those of every
+// tree it refers to, hash to the hashes committed for them. If repair is set it deletes versions of trees saved after
+// they were committed, which includes every version of the forest after version. The forest must not be open.
+func VerifyForest(db dbm.DB, version int64, repair bool) (*ForestReport, error) {
+	report := &ForestReport{
+		Hashes:   make(map[int64][]byte),
+		Repaired: repair,
+	}
+	commitsTree, err := NewMutableTree(NewPrefixDB(db, commitsPrefix), verifyCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	latest, commits, err := loadTree(commitsTree, version)
+	if err != nil {
+		return nil, fmt.Errorf("VerifyForest() could not get commits tree at version %d: %v", version, err)
+	}
+	if latest > version {
+		report.Orphaned = append(report.Orphaned, Prefix{})
+		if repair {
+			err = commitsTree.Load(version, true)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, v := range commitsTree.AvailableVersions() {
+		if int64(v) > version {
+			continue
+		}
+		var tree *ImmutableTree
+		err = recovering(func() (err error) {
+			tree, err = commitsTree.GetImmutable(int64(v))
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("VerifyForest() could not get commits tree at version %d: %v", v, err)
+		}
+		report.Hashes[int64(v)] = tree.Hash()
+	}
+	report.Hash = commits.Hash()
+	report.Trees++
+	if !verifyTree(commits, commits.Hash()) {
+		// We cannot trust the commits it holds
+		report.Corrupt = append(report.Corrupt, Prefix{})
+		return report, nil
+	}
+
+	treeDB := NewPrefixDB(db, treePrefix)
+	err = commits.Iterate(nil, nil, true, func(prefix []byte, bs []byte) error {
+		commitID, err := unmarshalCommitID(bs)
+		if err != nil {
+			return err
+		}
+		report.Trees++
+		tree, err := NewMutableTree(NewPrefixDB(treeDB, string(prefix)), verifyCacheSize)
+		if err != nil {
+			return err
+		}
+		latest, immutable, err := loadTree(tree, commitID.Version)
+		if err != nil || !verifyTree(immutable, commitID.Hash) {
+			report.Corrupt = append(report.Corrupt, prefix)
+			return nil
+		}
+		if latest > commitID.Version {
+			report.Orphaned = append(report.Orphaned, prefix)
+			if repair {
+				return tree.Load(commitID.Version, true)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Loads tree returning its latest version and the tree at version
+func loadTree(tree *MutableTree, version int64) (latest int64, immutable *ImmutableTree, err error) {
+	err = recovering(func() error {
+		latest, err = tree.MutableTree.Load()
+		if err != nil {
+			return err
+		}
+		immutable, err = tree.GetImmutable(version)
+		return err
+	})
+	return latest, immutable, err
+}
+
+// Returns any panic from fn as an error since IAVL panics when nodes are missing or cannot be decoded
+func recovering(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return fn()
+}
+
+// Rebuilds tree from its nodes, which computes their hashes afresh rather than trusting those they are stored under,
+// and checks it has hash
+func verifyTree(tree *ImmutableTree, hash []byte) bool {
+	// Read every node here first since the exporter reads them in its own goroutine, where we cannot recover
+	err := recovering(func() error {
+		tree.ImmutableTree.IterateRangeInclusive(nil, nil, true, func(key, value []byte, version int64) bool {
+			return false
+		})
+		return nil
+	})
+	if err != nil {
+		return false
+	}
+	rebuilt, err := iavl.NewMutableTree(dbm.NewMemDB(), verifyCacheSize)
+	if err != nil {
+		return false
+	}
+	importer, err := rebuilt.Import(tree.Version())
+	if err != nil {
+		return false
+	}
+	defer importer.Close()
+	exporter := tree.Export()
+	defer exporter.Close()
+	for {
+		node, err := exporter.Next()
+		if err == iavl.ExportDone {
+			break
+		} else if err != nil {
+			return false
+		}
+		err = importer.Add(node)
+		if err != nil {
+			return false
+		}
+	}
+	err = importer.Commit()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(rebuilt.Hash(), hash)
+}
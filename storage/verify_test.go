@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestVerifyForest(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	set := func(prefix, key, value string) {
+		err := forest.Write([]byte(prefix), func(tree *RWTree) error {
+			tree.Set([]byte(key), []byte(value))
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	set("fooos", "bar", "nog")
+	set("baaas", "bar", "nog")
+	hash1, version1, err := forest.Save()
+	require.NoError(t, err)
+	set("fooos", "bar", "egg")
+	hash2, version2, err := forest.Save()
+	require.NoError(t, err)
+
+	report, err := VerifyForest(db, version2, false)
+	require.NoError(t, err)
+	require.Equal(t, hash2, report.Hash)
+	require.Equal(t, hash1, report.Hashes[version1])
+	require.Equal(t, hash2, report.Hashes[version2])
+	require.Equal(t, 3, report.Trees)
+	require.Empty(t, report.Corrupt)
+	require.Empty(t, report.Orphaned)
+
+	t.Run("Orphaned", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		forest, err := NewMutableForest(db, 100)
+		require.NoError(t, err)
+		write := func(value string) {
+			err := forest.Write([]byte("fooos"), func(tree *RWTree) error {
+				tree.Set([]byte("bar"), []byte(value))
+				return nil
+			})
+			require.NoError(t, err)
+		}
+		write("nog")
+		hash, version, err := forest.Save()
+		require.NoError(t, err)
+		// As though the chain never committed the next version
+		write("egg")
+		_, _, err = forest.Save()
+		require.NoError(t, err)
+
+		report, err := VerifyForest(db, version, false)
+		require.NoError(t, err)
+		require.Equal(t, hash, report.Hash)
+		require.Empty(t, report.Corrupt)
+		require.Equal(t, []Prefix{{}, Prefix("fooos")}, report.Orphaned)
+
+		report, err = VerifyForest(db, version, true)
+		require.NoError(t, err)
+		require.True(t, report.Repaired)
+
+		report, err = VerifyForest(db, version, false)
+		require.NoError(t, err)
+		require.Empty(t, report.Orphaned)
+		require.Empty(t, report.Corrupt)
+	})
+
+	t.Run("Corrupt", func(t *testing.T) {
+		treeDB := NewPrefixDB(db, treePrefix+"fooos")
+		it, err := treeDB.Iterator(nil, nil)
+		require.NoError(t, err)
+		corrupted := make(map[string][]byte)
+		for ; it.Valid(); it.Next() {
+			// IAVL stores nodes under keys starting with 'n'
+			if it.Key()[0] == 'n' {
+				value := it.Value()
+				value[len(value)-1] ^= 0xFF
+				corrupted[string(it.Key())] = value
+			}
+		}
+		require.NoError(t, it.Close())
+		require.NotEmpty(t, corrupted)
+		for key, value := range corrupted {
+			require.NoError(t, treeDB.Set([]byte(key), value))
+		}
+
+		report, err := VerifyForest(db, version2, false)
+		require.NoError(t, err)
+		require.Equal(t, []Prefix{Prefix("fooos")}, report.Corrupt)
+	})
+}
@@ -0,0 +1,16 @@
+pragma solidity >=0.5.13;
+
+contract Box {
+    address public owner;
+    uint public value;
+
+    function initialize(address _owner, uint _value) public {
+        require(owner == address(0), "already initialised");
+        owner = _owner;
+        value = _value;
+    }
+
+    function set(uint _value) public {
+        value = _value;
+    }
+}
@@ -0,0 +1,16 @@
+pragma solidity >=0.5.13;
+
+contract BoxV2 {
+    address public owner;
+    uint public value;
+    uint public increments;
+
+    function set(uint _value) public {
+        value = _value;
+    }
+
+    function increment() public {
+        value += 1;
+        increments += 1;
+    }
+}
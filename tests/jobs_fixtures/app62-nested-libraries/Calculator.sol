@@ -0,0 +1,13 @@
+pragma solidity >=0.5.0;
+
+import "./Libraries.sol";
+
+contract Calculator {
+    function quadruple(uint a) public pure returns (uint) {
+        return Doubler.twice(Doubler.twice(a));
+    }
+
+    function sum(uint a, uint b) public pure returns (uint) {
+        return Adder.add(a, b);
+    }
+}
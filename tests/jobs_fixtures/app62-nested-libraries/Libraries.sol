@@ -0,0 +1,13 @@
+pragma solidity >=0.5.0;
+
+library Adder {
+    function add(uint a, uint b) public pure returns (uint) {
+        return a + b;
+    }
+}
+
+library Doubler {
+    function twice(uint a) public pure returns (uint) {
+        return Adder.add(a, a);
+    }
+}
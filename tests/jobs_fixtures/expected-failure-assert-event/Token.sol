@@ -0,0 +1,12 @@
+pragma solidity >=0.0.0;
+
+contract Token {
+    mapping(address => uint) public balances;
+
+    event Transfer(address indexed from, address indexed to, uint value);
+
+    function mint(address to, uint value) public {
+        balances[to] += value;
+        emit Transfer(address(0), to, value);
+    }
+}
@@ -0,0 +1,6 @@
+pragma solidity >=0.5.13;
+
+contract BoxV2 {
+    uint public value;
+    address public owner;
+}
@@ -40,6 +40,7 @@ fi
 # Ports etc must match those in burrow.toml
 export BURROW_HOST=127.0.0.1
 export BURROW_GRPC_PORT=20123
+export BURROW_WEB3_PORT=26660
 
 
 export chain_dir="$script_dir/chain"
@@ -79,9 +79,26 @@ func (txEnv *Envelope) Validate() error {
 			return fmt.Errorf("Signatory %v is invalid: %v", i, err)
 		}
 	}
+	if txEnv.EthTypedTx != nil && txEnv.GetEncoding() != Envelope_RLP {
+		return fmt.Errorf("transaction envelope contains typed Ethereum transaction fields but has encoding %v",
+			txEnv.GetEncoding())
+	}
 	return nil
 }
 
+// SignBytes returns the bytes signed by the Signatories according to the Envelope's encoding
+func (txEnv *Envelope) SignBytes() ([]byte, error) {
+	if txEnv.EthTypedTx == nil || txEnv.GetEncoding() != Envelope_RLP {
+		return txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	}
+	rawTx, err := txEnv.Tx.RLPRawTx()
+	if err != nil {
+		return nil, err
+	}
+	rawTx.typed = txEnv.EthTypedTx
+	return rawTx.SignBytes()
+}
+
 func (sig *Signatory) Validate() error {
 	if sig.Address == nil {
 		return fmt.Errorf("has nil Address: %v", sig)
@@ -109,7 +126,7 @@ func (txEnv *Envelope) Verify(chainID string) error {
 		return fmt.Errorf("%s: number of inputs (= %v) should equal number of signatories (= %v)",
 			errPrefix, len(inputs), len(txEnv.Signatories))
 	}
-	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	signBytes, err := txEnv.SignBytes()
 	if err != nil {
 		return fmt.Errorf("%s: could not generate SignBytes: %v", errPrefix, err)
 	}
@@ -132,7 +149,7 @@ func (txEnv *Envelope) Verify(chainID string) error {
 func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 	// Clear any existing
 	txEnv.Signatories = nil
-	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	signBytes, err := txEnv.SignBytes()
 	if err != nil {
 		return err
 	}
@@ -161,6 +178,15 @@ func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 	return nil
 }
 
+// GasTipCap returns the most the transaction offers per unit of gas above the base fee given the gas price it offers in
+// total. Only EIP-1559 dynamic fee transactions cap their tip separately, otherwise all of the gas price is offered.
+func (txEnv *Envelope) GasTipCap(gasPrice uint64) uint64 {
+	if typed := txEnv.GetEthTypedTx(); typed.GetType() == EthDynamicFeeTxType {
+		return typed.MaxPriorityFeePerGas
+	}
+	return gasPrice
+}
+
 func (txEnv *Envelope) Get(key string) (interface{}, bool) {
 	if txEnv == nil {
 		return nil, false
@@ -4,13 +4,21 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/encoding/rlp"
 	"github.com/tmthrgd/go-hex"
 )
 
+// EIP-2718 transaction types
+const (
+	EthLegacyTxType     = 0x00
+	EthAccessListTxType = 0x01
+	EthDynamicFeeTxType = 0x02
+)
+
 // Order matters for serialisation
 type EthRawTx struct {
 	Sequence uint64   `json:"nonce"`
@@ -25,12 +33,76 @@ type EthRawTx struct {
 	S *big.Int
 	// Included in hash but not part of serialised message
 	chainID *big.Int
+	// Set for EIP-2718 typed transactions which are serialised explicitly rather than by reflection over the fields
+	// above, for dynamic fee transactions GasPrice holds the fee cap (maxFeePerGas) and V holds the y-parity
+	typed *EthTypedTx
 }
 
 func NewEthRawTx(chainID *big.Int) *EthRawTx {
 	return &EthRawTx{chainID: chainID}
 }
 
+// DecodeEthRawTx decodes either a legacy RLP transaction or an EIP-2718 typed transaction envelope (EIP-2930 access
+// list or EIP-1559 dynamic fee) as sent to eth_sendRawTransaction
+func DecodeEthRawTx(data []byte, chainID *big.Int) (*EthRawTx, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("cannot decode empty raw transaction")
+	}
+	tx := NewEthRawTx(chainID)
+	// Legacy transactions are RLP lists so always begin with a byte >= 0xc0, typed transactions begin with their type
+	if data[0] >= 0xc0 {
+		err := rlp.Decode(data, tx)
+		if err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+	txType := data[0]
+	if txType != EthAccessListTxType && txType != EthDynamicFeeTxType {
+		return nil, fmt.Errorf("unsupported EIP-2718 transaction type %d", txType)
+	}
+	decoded, err := rlp.DecodeNested(data[1:])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode typed transaction: %w", err)
+	}
+	fields, ok := decoded.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("typed transaction payload should be an RLP list")
+	}
+	numFields := 11
+	if txType == EthDynamicFeeTxType {
+		numFields = 12
+	}
+	if len(fields) != numFields {
+		return nil, fmt.Errorf("typed transaction of type %d should have %d fields but has %d",
+			txType, numFields, len(fields))
+	}
+	d := new(rlpFieldDecoder)
+	txChainID := d.BigInt(fields[0])
+	if d.err == nil && txChainID.Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("transaction has chain ID %v but this chain has ID %v", txChainID, chainID)
+	}
+	tx.typed = &EthTypedTx{Type: uint32(txType)}
+	tx.Sequence = d.Uint64(fields[1])
+	if txType == EthDynamicFeeTxType {
+		tx.typed.MaxPriorityFeePerGas = d.Uint64(fields[2])
+		fields = fields[1:]
+	}
+	tx.GasPrice = d.Uint64(fields[2])
+	tx.GasLimit = d.Uint64(fields[3])
+	tx.To = d.Bytes(fields[4])
+	tx.Amount = d.BigInt(fields[5])
+	tx.Data = d.Bytes(fields[6])
+	tx.typed.AccessList = d.AccessList(fields[7])
+	tx.V = d.BigInt(fields[8])
+	tx.R = d.BigInt(fields[9])
+	tx.S = d.BigInt(fields[10])
+	if d.err != nil {
+		return nil, fmt.Errorf("could not decode typed transaction: %w", d.err)
+	}
+	return tx, nil
+}
+
 func EthRawTxFromEnvelope(txEnv *Envelope) (*EthRawTx, error) {
 	if txEnv.GetEncoding() != Envelope_RLP {
 		return nil, fmt.Errorf("can only form EthRawTx from RLP-encoded Envelope")
@@ -49,6 +121,11 @@ func EthRawTxFromEnvelope(txEnv *Envelope) (*EthRawTx, error) {
 	if err != nil {
 		return nil, err
 	}
+	if txEnv.EthTypedTx != nil {
+		rawTx.typed = txEnv.EthTypedTx
+		// Typed transactions carry the bare y-parity rather than the EIP-155 recovery ID
+		sig.V.SetUint64(uint64(sig.RecoveryIndex()))
+	}
 	// Link signature values into EthRawTx
 	rawTx.V = &sig.V
 	rawTx.R = &sig.R
@@ -64,9 +141,14 @@ func (tx *EthRawTx) RecoverPublicKey() (*crypto.PublicKey, *crypto.Signature, er
 	if tx.R.Sign() == 0 || tx.S.Sign() == 0 {
 		return nil, nil, fmt.Errorf("EthRawTx does not appear to be signed")
 	}
+	v := tx.V
+	if tx.typed != nil {
+		// Convert y-parity to EIP-155 form
+		v = crypto.GetEthSignatureRecoveryID(tx.chainID, tx.V)
+	}
 	ethSig := crypto.EIP155Signature{
 		Secp256k1Signature: crypto.Secp256k1Signature{
-			V: *tx.V,
+			V: *v,
 			R: *tx.R,
 			S: *tx.S,
 		},
@@ -75,7 +157,7 @@ func (tx *EthRawTx) RecoverPublicKey() (*crypto.PublicKey, *crypto.Signature, er
 	if err != nil {
 		return nil, nil, err
 	}
-	pubKey, compressed, err := btcec.RecoverCompact(btcec.S256(), compactSig, hash)
+	pubKey, compressed, err := ecdsa.RecoverCompact(compactSig, hash)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -96,7 +178,23 @@ func (tx *EthRawTx) RecoverPublicKey() (*crypto.PublicKey, *crypto.Signature, er
 	return publicKey, signature, nil
 }
 
+// Type returns the EIP-2718 transaction type
+func (tx *EthRawTx) Type() uint8 {
+	if tx.typed == nil {
+		return EthLegacyTxType
+	}
+	return uint8(tx.typed.Type)
+}
+
+// Typed returns the fields specific to EIP-2718 typed transactions or nil for legacy transactions
+func (tx *EthRawTx) Typed() *EthTypedTx {
+	return tx.typed
+}
+
 func (tx *EthRawTx) SignBytes() ([]byte, error) {
+	if tx.typed != nil {
+		return tx.encodeTyped(false)
+	}
 	return rlp.Encode([]interface{}{
 		tx.Sequence,
 		tx.GasPrice,
@@ -118,9 +216,37 @@ func (tx *EthRawTx) Hash() ([]byte, error) {
 }
 
 func (tx *EthRawTx) Marshal() ([]byte, error) {
+	if tx.typed != nil {
+		return tx.encodeTyped(true)
+	}
 	return rlp.Encode(tx)
 }
 
+// Encodes as type || rlp(fields) as defined by EIP-2930 and EIP-1559, including the signature or not
+func (tx *EthRawTx) encodeTyped(signed bool) ([]byte, error) {
+	accessList := make([]interface{}, len(tx.typed.AccessList))
+	for i, tuple := range tx.typed.AccessList {
+		keys := make([][]byte, len(tuple.StorageKeys))
+		for j, key := range tuple.StorageKeys {
+			keys[j] = key.Bytes()
+		}
+		accessList[i] = []interface{}{tuple.Address.Bytes(), keys}
+	}
+	fields := []interface{}{tx.chainID, tx.Sequence}
+	if tx.Type() == EthDynamicFeeTxType {
+		fields = append(fields, tx.typed.MaxPriorityFeePerGas)
+	}
+	fields = append(fields, tx.GasPrice, tx.GasLimit, tx.To, tx.Amount, tx.Data, accessList)
+	if signed {
+		fields = append(fields, tx.V, tx.R, tx.S)
+	}
+	bs, err := rlp.Encode(fields)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{tx.Type()}, bs...), nil
+}
+
 func (tx *EthRawTx) MarshalString() (string, error) {
 	bs, err := tx.Marshal()
 	if err != nil {
@@ -128,3 +254,68 @@ func (tx *EthRawTx) MarshalString() (string, error) {
 	}
 	return "0x" + hex.EncodeToString(bs), nil
 }
+
+// Accumulates the first error encountered while converting the leaves of rlp.DecodeNested
+type rlpFieldDecoder struct {
+	err error
+}
+
+func (d *rlpFieldDecoder) Bytes(field interface{}) []byte {
+	bs, ok := field.([]byte)
+	if !ok && d.err == nil {
+		d.err = fmt.Errorf("expected RLP string but got list")
+	}
+	return bs
+}
+
+func (d *rlpFieldDecoder) BigInt(field interface{}) *big.Int {
+	return new(big.Int).SetBytes(d.Bytes(field))
+}
+
+func (d *rlpFieldDecoder) Uint64(field interface{}) uint64 {
+	bi := d.BigInt(field)
+	if !bi.IsUint64() && d.err == nil {
+		d.err = fmt.Errorf("integer %v overflows uint64", bi)
+	}
+	return bi.Uint64()
+}
+
+func (d *rlpFieldDecoder) AccessList(field interface{}) []EthAccessTuple {
+	items, ok := field.([]interface{})
+	if !ok {
+		if d.err == nil {
+			d.err = fmt.Errorf("expected access list to be an RLP list")
+		}
+		return nil
+	}
+	accessList := make([]EthAccessTuple, len(items))
+	for i, item := range items {
+		tuple, ok := item.([]interface{})
+		if !ok || len(tuple) != 2 {
+			if d.err == nil {
+				d.err = fmt.Errorf("access list entry %d should be a list of address and storage keys", i)
+			}
+			return nil
+		}
+		address, err := crypto.AddressFromBytes(d.Bytes(tuple[0]))
+		if err != nil && d.err == nil {
+			d.err = fmt.Errorf("access list entry %d: %w", i, err)
+		}
+		accessList[i].Address = address
+		keys, ok := tuple[1].([]interface{})
+		if !ok {
+			if d.err == nil {
+				d.err = fmt.Errorf("access list entry %d storage keys should be an RLP list", i)
+			}
+			return nil
+		}
+		for _, key := range keys {
+			bs := d.Bytes(key)
+			if len(bs) != binary.Word256Bytes && d.err == nil {
+				d.err = fmt.Errorf("access list entry %d has storage key of length %d", i, len(bs))
+			}
+			accessList[i].StorageKeys = append(accessList[i].StorageKeys, binary.LeftPadWord256(bs))
+		}
+	}
+	return accessList
+}
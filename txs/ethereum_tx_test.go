@@ -0,0 +1,116 @@
+package txs
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeEthRawTx(t *testing.T) {
+	signer := acm.PrivateAccountFromPrivateKey(crypto.PrivateKeyFromSecret("eth-signer", crypto.CurveTypeSecp256k1))
+	to := makePrivateAccount("eth-receiver").GetAddress()
+	ethChainID := encoding.GetEthChainID(chainID)
+
+	newEnvelope := func(typed *EthTypedTx) *Envelope {
+		txEnv := Enclose(chainID, &payload.CallTx{
+			Input: &payload.TxInput{
+				Address:  signer.GetAddress(),
+				Amount:   2,
+				Sequence: 7,
+			},
+			Address:  &to,
+			GasLimit: 100000,
+			GasPrice: 20,
+			// Long enough to require a long-form RLP length prefix
+			Data: make([]byte, 100),
+		})
+		txEnv.Encoding = Envelope_RLP
+		txEnv.EthTypedTx = typed
+		require.NoError(t, txEnv.Sign(signer))
+		return txEnv
+	}
+
+	cases := map[string]*EthTypedTx{
+		"Legacy": nil,
+		"AccessList": {
+			Type: EthAccessListTxType,
+			AccessList: []EthAccessTuple{{
+				Address:     to,
+				StorageKeys: []binary.Word256{binary.Int64ToWord256(1), binary.Int64ToWord256(2)},
+			}},
+		},
+		"DynamicFee": {
+			Type:                 EthDynamicFeeTxType,
+			MaxPriorityFeePerGas: 3,
+			AccessList:           []EthAccessTuple{},
+		},
+	}
+
+	for name, typed := range cases {
+		t.Run(name, func(t *testing.T) {
+			txEnv := newEnvelope(typed)
+			rawTx, err := EthRawTxFromEnvelope(txEnv)
+			require.NoError(t, err)
+			bs, err := rawTx.Marshal()
+			require.NoError(t, err)
+			if typed != nil {
+				assert.Equal(t, byte(typed.Type), bs[0])
+			}
+
+			decoded, err := DecodeEthRawTx(bs, ethChainID)
+			require.NoError(t, err)
+			assert.Equal(t, rawTx.Type(), decoded.Type())
+			assert.Equal(t, uint64(7), decoded.Sequence)
+			assert.Equal(t, uint64(20), decoded.GasPrice)
+			assert.Equal(t, uint64(100000), decoded.GasLimit)
+			assert.Equal(t, to.Bytes(), decoded.To)
+			assert.Equal(t, make([]byte, 100), decoded.Data)
+			if typed != nil {
+				assert.Equal(t, typed.MaxPriorityFeePerGas, decoded.Typed().MaxPriorityFeePerGas)
+				assert.Equal(t, len(typed.AccessList), len(decoded.Typed().AccessList))
+				for i, tuple := range typed.AccessList {
+					assert.Equal(t, tuple, decoded.Typed().AccessList[i])
+				}
+			}
+
+			// Round trip
+			bsOut, err := decoded.Marshal()
+			require.NoError(t, err)
+			assert.Equal(t, bs, bsOut)
+
+			publicKey, _, err := decoded.RecoverPublicKey()
+			require.NoError(t, err)
+			assert.Equal(t, signer.GetPublicKey(), publicKey)
+		})
+	}
+
+	t.Run("WrongChainID", func(t *testing.T) {
+		rawTx, err := EthRawTxFromEnvelope(newEnvelope(&EthTypedTx{Type: EthDynamicFeeTxType}))
+		require.NoError(t, err)
+		bs, err := rawTx.Marshal()
+		require.NoError(t, err)
+		_, err = DecodeEthRawTx(bs, new(big.Int).Add(ethChainID, big.NewInt(1)))
+		require.Error(t, err)
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := DecodeEthRawTx([]byte{0x03, 0xc0}, ethChainID)
+		require.Error(t, err)
+	})
+
+	t.Run("EnvelopeVerify", func(t *testing.T) {
+		txEnv := newEnvelope(&EthTypedTx{Type: EthDynamicFeeTxType, MaxPriorityFeePerGas: 3})
+		require.NoError(t, txEnv.Verify(chainID))
+		signBytes, err := txEnv.SignBytes()
+		require.NoError(t, err)
+		assert.Equal(t, byte(EthDynamicFeeTxType), signBytes[0])
+		assert.Equal(t, crypto.CurveTypeSecp256k1, txEnv.Signatories[0].PublicKey.CurveType)
+	})
+}
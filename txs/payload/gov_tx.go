@@ -5,6 +5,8 @@ import (
 
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/gas"
+	"github.com/hyperledger/burrow/execution/upgrade"
 	spec "github.com/hyperledger/burrow/genesis/spec"
 	permission "github.com/hyperledger/burrow/permission"
 )
@@ -21,7 +23,14 @@ func (tx *GovTx) GetInputs() []*TxInput {
 }
 
 func (tx *GovTx) String() string {
-	return fmt.Sprintf("GovTx{%v -> %v}", tx.Inputs, tx.AccountUpdates)
+	str := fmt.Sprintf("GovTx{%v -> %v", tx.Inputs, tx.AccountUpdates)
+	if tx.GasScheduleUpdate != nil {
+		str += fmt.Sprintf(", %v", tx.GasScheduleUpdate)
+	}
+	if tx.UpgradePlan != nil {
+		str += fmt.Sprintf(", %v", tx.UpgradePlan)
+	}
+	return str + "}"
 }
 
 func (tx *GovTx) Any() *Any {
@@ -73,3 +82,31 @@ func UpdateAccountTx(inputAddress crypto.Address, updates ...*spec.TemplateAccou
 		AccountUpdates: updates,
 	}
 }
+
+// Creates a GovTx that replaces the gas schedule with schedule from height onwards
+func UpdateGasScheduleTx(inputAddress crypto.Address, height uint64, schedule *gas.Schedule) *GovTx {
+	return &GovTx{
+		Inputs: []*TxInput{{
+			Address: inputAddress,
+		}},
+		GasScheduleUpdate: &gas.ScheduleUpdate{
+			Height:   height,
+			Schedule: schedule,
+		},
+	}
+}
+
+// Creates a GovTx that plans an upgrade to a new binary at height, or cancels the pending upgrade with name if height
+// is zero
+func UpgradePlanTx(inputAddress crypto.Address, name string, height uint64, info string) *GovTx {
+	return &GovTx{
+		Inputs: []*TxInput{{
+			Address: inputAddress,
+		}},
+		UpgradePlan: &upgrade.Plan{
+			Name:   name,
+			Height: height,
+			Info:   info,
+		},
+	}
+}
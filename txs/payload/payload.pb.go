@@ -14,7 +14,9 @@ import (
 	golang_proto "github.com/golang/protobuf/proto"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	gas "github.com/hyperledger/burrow/execution/gas"
 	registry "github.com/hyperledger/burrow/execution/registry"
+	upgrade "github.com/hyperledger/burrow/execution/upgrade"
 	spec "github.com/hyperledger/burrow/genesis/spec"
 	permission "github.com/hyperledger/burrow/permission"
 )
@@ -685,11 +687,15 @@ func (*UnbondTx) XXX_MessageName() string {
 }
 
 type GovTx struct {
-	Inputs               []*TxInput              `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
-	AccountUpdates       []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Inputs         []*TxInput              `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	AccountUpdates []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
+	// Schedules a change to the gas charged for VM operations at a future height
+	GasScheduleUpdate *gas.ScheduleUpdate `protobuf:"bytes,3,opt,name=GasScheduleUpdate,proto3" json:"GasScheduleUpdate,omitempty"`
+	// Plans a coordinated upgrade of the chain to a new binary at a future height
+	UpgradePlan          *upgrade.Plan `protobuf:"bytes,4,opt,name=UpgradePlan,proto3" json:"UpgradePlan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *GovTx) Reset()      { *m = GovTx{} }
@@ -1035,76 +1041,79 @@ func init() { proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d5
 func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
 
 var fileDescriptor_678c914f1bee6d56 = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x66, 0x37, 0xb6, 0xfb, 0xe2, 0xe4, 0xeb, 0xce, 0xb7, 0xad, 0xac, 0x48, 0xd8, 0x91,
-	0x41, 0x90, 0x96, 0xd6, 0x86, 0x94, 0x1f, 0x22, 0x37, 0xff, 0x4a, 0x1a, 0xd4, 0x26, 0x66, 0xbc,
-	0x69, 0x11, 0x88, 0xc3, 0x7a, 0x3d, 0xac, 0x57, 0xb2, 0x77, 0x96, 0xdd, 0x71, 0xd9, 0xe5, 0xc4,
-	0x81, 0x03, 0x77, 0x2e, 0x1c, 0xf3, 0x1f, 0x20, 0xfe, 0x03, 0x4e, 0x28, 0x47, 0x8e, 0x88, 0x43,
-	0x84, 0xd2, 0x0b, 0xe2, 0xaf, 0x40, 0x33, 0x3b, 0xbb, 0x1e, 0xbb, 0x55, 0xeb, 0x44, 0x88, 0xdb,
-	0xcc, 0x7b, 0x9f, 0x79, 0xef, 0xcd, 0x7b, 0x9f, 0x79, 0x6f, 0x60, 0xc3, 0xb7, 0xe2, 0x31, 0xb5,
-	0x86, 0x75, 0x3f, 0xa0, 0x8c, 0xa2, 0xbc, 0xdc, 0x6e, 0xdd, 0x70, 0xa8, 0x43, 0x85, 0xac, 0xc1,
-	0x57, 0x89, 0x7a, 0xab, 0xe4, 0x93, 0x60, 0xe2, 0x86, 0xa1, 0x4b, 0x3d, 0x29, 0xd9, 0x0c, 0x88,
-	0xe3, 0x86, 0x2c, 0x88, 0xe5, 0x1e, 0x42, 0x9f, 0xd8, 0xc9, 0xba, 0xf6, 0xab, 0x0e, 0x7a, 0xd3,
-	0x8b, 0xd1, 0x5b, 0x90, 0x6b, 0x5b, 0xe3, 0xb1, 0x19, 0x95, 0xb5, 0x6d, 0x6d, 0x67, 0x7d, 0xf7,
-	0x7f, 0xf5, 0xd4, 0x69, 0x22, 0xc6, 0x52, 0xcd, 0x81, 0x7d, 0xe2, 0x0d, 0xcd, 0xa8, 0xbc, 0xba,
-	0x00, 0x4c, 0xc4, 0x58, 0xaa, 0x39, 0xf0, 0xc8, 0x9a, 0x10, 0x33, 0x2a, 0xeb, 0x0b, 0xc0, 0x44,
-	0x8c, 0xa5, 0x1a, 0xdd, 0x81, 0x7c, 0x8f, 0x04, 0x93, 0xd0, 0x8c, 0xca, 0x86, 0x40, 0x96, 0x32,
-	0xa4, 0x94, 0xe3, 0x14, 0x80, 0xde, 0x80, 0xb5, 0x03, 0xfa, 0xd4, 0x8c, 0xca, 0x6b, 0x02, 0xb9,
-	0x99, 0x21, 0x85, 0x14, 0x27, 0x4a, 0xee, 0xba, 0x45, 0x45, 0x8c, 0xb9, 0x05, 0xd7, 0x89, 0x18,
-	0x4b, 0x35, 0xba, 0x07, 0x85, 0x13, 0x6f, 0x90, 0x40, 0xf3, 0x02, 0x7a, 0x3d, 0x83, 0xa6, 0x0a,
-	0x9c, 0x41, 0x78, 0xa4, 0x2d, 0x8b, 0xd9, 0x23, 0x33, 0x2a, 0x17, 0x16, 0x22, 0x95, 0x72, 0x9c,
-	0x02, 0xd0, 0x7d, 0x80, 0x5e, 0x40, 0x7d, 0x1a, 0x5a, 0x3c, 0xa9, 0xd7, 0x04, 0xfc, 0xff, 0xb3,
-	0x8b, 0x65, 0x2a, 0xac, 0xc0, 0xf8, 0xa1, 0xc3, 0x21, 0xf1, 0x98, 0xfb, 0x65, 0x6c, 0x46, 0x65,
-	0x58, 0x38, 0x34, 0x53, 0x61, 0x05, 0xb6, 0x67, 0x9c, 0x9d, 0x56, 0xb5, 0xda, 0x0f, 0x1a, 0xe4,
-	0xcd, 0xe8, 0xd0, 0xf3, 0xa7, 0x0c, 0x1d, 0x41, 0xbe, 0x39, 0x1c, 0x06, 0x24, 0x0c, 0x45, 0x35,
-	0x8b, 0xad, 0xf7, 0xce, 0xce, 0xab, 0x2b, 0x7f, 0x9c, 0x57, 0xef, 0x3a, 0x2e, 0x1b, 0x4d, 0x07,
-	0x75, 0x9b, 0x4e, 0x1a, 0xa3, 0xd8, 0x27, 0xc1, 0x98, 0x0c, 0x1d, 0x12, 0x34, 0x06, 0xd3, 0x20,
-	0xa0, 0x5f, 0x37, 0xec, 0x20, 0xf6, 0x19, 0xad, 0xcb, 0xb3, 0x38, 0x35, 0x82, 0x6e, 0x41, 0xae,
-	0x39, 0xa1, 0x53, 0x8f, 0x89, 0x9a, 0x1b, 0x58, 0xee, 0xd0, 0x16, 0x14, 0xfa, 0xe4, 0xab, 0x29,
-	0xf1, 0x6c, 0x22, 0x8a, 0x6c, 0xe0, 0x6c, 0xbf, 0x67, 0xfc, 0x78, 0x5a, 0x5d, 0xa9, 0x45, 0x50,
-	0x30, 0xa3, 0xe3, 0x29, 0xfb, 0x0f, 0xa3, 0x92, 0x9e, 0x7f, 0xd2, 0x53, 0x46, 0xa3, 0x37, 0x61,
-	0x4d, 0xe4, 0x45, 0x52, 0x7b, 0x56, 0x34, 0x99, 0x2f, 0x9c, 0xa8, 0xd1, 0xc7, 0xb3, 0x00, 0x57,
-	0x45, 0x80, 0xef, 0x5c, 0x3d, 0xb8, 0x2d, 0x28, 0x1c, 0x58, 0xe1, 0x43, 0x77, 0xe2, 0xb2, 0x34,
-	0x35, 0xe9, 0x1e, 0x95, 0x40, 0xdf, 0x27, 0x44, 0x90, 0xdd, 0xc0, 0x7c, 0x89, 0x0e, 0xc1, 0xe8,
-	0x58, 0xcc, 0x12, 0xac, 0x2e, 0xb6, 0xde, 0x97, 0x79, 0xb9, 0xf7, 0x72, 0xd7, 0x03, 0xd7, 0xb3,
-	0x82, 0xb8, 0xfe, 0x80, 0x44, 0xad, 0x98, 0x91, 0x10, 0x0b, 0x13, 0xe8, 0x73, 0x30, 0x9e, 0x34,
-	0xfb, 0x8f, 0x04, 0xf3, 0x8b, 0xad, 0x83, 0x2b, 0x99, 0xfa, 0xfb, 0xbc, 0xba, 0xc9, 0x2c, 0x27,
-	0xbc, 0x4b, 0x27, 0x2e, 0x23, 0x13, 0x9f, 0xc5, 0x58, 0x18, 0x45, 0x1f, 0x41, 0xb1, 0x4d, 0x3d,
-	0x16, 0x58, 0x36, 0x7b, 0x44, 0x98, 0x55, 0xce, 0x6f, 0xeb, 0x3b, 0xeb, 0xbb, 0x37, 0x67, 0xbd,
-	0x42, 0x51, 0xe2, 0x39, 0xa8, 0x4c, 0x48, 0x2f, 0x70, 0x6d, 0x22, 0x1e, 0x4f, 0x92, 0x10, 0xb1,
-	0x97, 0x15, 0x9b, 0xce, 0x1b, 0x47, 0x9f, 0x40, 0xa1, 0x4d, 0x87, 0xe4, 0x81, 0x15, 0x8e, 0x24,
-	0x61, 0xae, 0x98, 0x98, 0xcc, 0x0c, 0x42, 0x60, 0x88, 0xb8, 0x79, 0x79, 0xaf, 0x61, 0xb1, 0xae,
-	0xb9, 0x69, 0x43, 0x43, 0x3b, 0x90, 0x13, 0x44, 0xe0, 0xfc, 0xd4, 0x5f, 0x48, 0x14, 0xa9, 0x47,
-	0x6f, 0x43, 0x3e, 0x21, 0x35, 0x67, 0x8a, 0x3e, 0xd7, 0x36, 0x52, 0xba, 0xe3, 0x14, 0xb1, 0x57,
-	0xf8, 0xfe, 0xb4, 0xba, 0x22, 0x6e, 0x48, 0xb3, 0x4e, 0xb7, 0x34, 0x27, 0x3f, 0x80, 0x02, 0x3f,
-	0xd2, 0x0c, 0x9c, 0x50, 0x36, 0xdc, 0x1b, 0x75, 0xa5, 0xc1, 0xa7, 0xba, 0x96, 0xc1, 0x53, 0x83,
-	0x33, 0xac, 0x4c, 0xa9, 0x9f, 0xf6, 0xe0, 0xa5, 0xfd, 0x21, 0x30, 0xf8, 0x89, 0x34, 0x43, 0x7c,
-	0xcd, 0x65, 0x82, 0x9d, 0x7a, 0x22, 0x13, 0x34, 0x7b, 0x8e, 0xc3, 0xd2, 0xe3, 0x5e, 0xda, 0x7a,
-	0x97, 0xf5, 0xa8, 0xa4, 0xc7, 0x99, 0x75, 0xe3, 0xa5, 0xe3, 0xbd, 0x0d, 0xb9, 0x24, 0xcf, 0x32,
-	0x3b, 0x2f, 0x28, 0x84, 0x04, 0x28, 0x8e, 0xbe, 0xd5, 0xe4, 0x18, 0xb9, 0x44, 0xc9, 0xdb, 0xb0,
-	0xd9, 0xb4, 0x6d, 0xde, 0x60, 0x4e, 0xfc, 0xa1, 0xc5, 0x48, 0x5a, 0xf9, 0x9b, 0x75, 0x31, 0x4d,
-	0x4d, 0x32, 0xf1, 0xc7, 0x16, 0x23, 0x12, 0x23, 0xea, 0xa1, 0xe1, 0x85, 0x23, 0x4a, 0x08, 0x7f,
-	0x69, 0xea, 0x7c, 0x58, 0xfa, 0xba, 0x35, 0x28, 0x3e, 0xa6, 0xcc, 0xf5, 0x9c, 0x27, 0xc4, 0x75,
-	0x46, 0xc9, 0xa5, 0x75, 0x3c, 0x27, 0x43, 0x27, 0x50, 0x4c, 0x2d, 0x8b, 0xb7, 0xa3, 0x8b, 0xb7,
-	0xf3, 0xee, 0xe5, 0xdf, 0xcd, 0x9c, 0x19, 0x3e, 0x2b, 0xd3, 0xbd, 0x9c, 0xd3, 0xd7, 0x9f, 0x1b,
-	0x67, 0x38, 0x83, 0x28, 0x57, 0x1d, 0xab, 0x43, 0xed, 0x12, 0x19, 0xbf, 0x03, 0xc6, 0x11, 0x1d,
-	0x12, 0x59, 0xd8, 0x5b, 0xf5, 0xec, 0x17, 0xc3, 0xa5, 0x89, 0x45, 0xde, 0x98, 0xf8, 0x4e, 0xf1,
-	0xf6, 0x45, 0x36, 0xa3, 0x2f, 0xe1, 0xaa, 0x02, 0xba, 0x19, 0xa5, 0x15, 0x2d, 0x66, 0xb0, 0xa6,
-	0x17, 0x63, 0xae, 0x50, 0xcc, 0x7f, 0xa7, 0x81, 0xf1, 0x98, 0x32, 0xf2, 0xaf, 0x4f, 0xb3, 0x25,
-	0x2a, 0xab, 0x84, 0xf1, 0x74, 0x56, 0x8c, 0xec, 0xc9, 0x6a, 0xca, 0x93, 0xdd, 0x86, 0xf5, 0x0e,
-	0x09, 0xed, 0xc0, 0xf5, 0x99, 0x4b, 0x3d, 0xf9, 0x9a, 0x55, 0x91, 0xfa, 0x97, 0xd1, 0x5f, 0xf1,
-	0x97, 0x51, 0xfc, 0xfe, 0xbc, 0x0a, 0xb9, 0x96, 0x35, 0x1e, 0x53, 0x36, 0xc7, 0x07, 0xed, 0x95,
-	0x7c, 0xe0, 0xac, 0xdc, 0x77, 0x3d, 0x6b, 0xec, 0x7e, 0xe3, 0x7a, 0x8e, 0xfc, 0x3d, 0x5e, 0x8d,
-	0x95, 0xaa, 0x19, 0xd4, 0x86, 0x0d, 0x5f, 0xba, 0xe8, 0x33, 0x8b, 0x25, 0x1d, 0x69, 0x73, 0xf7,
-	0x35, 0xe5, 0x32, 0x3c, 0xda, 0x2c, 0x22, 0x01, 0xc2, 0xf3, 0x67, 0xd0, 0xeb, 0xb0, 0xc6, 0x6b,
-	0x1a, 0x96, 0xd7, 0x04, 0x01, 0x36, 0xb2, 0xc3, 0x5c, 0x8a, 0x13, 0x5d, 0xed, 0x43, 0xd8, 0x98,
-	0x33, 0x82, 0x8a, 0x50, 0xe8, 0xe1, 0xe3, 0xde, 0x71, 0xbf, 0xdb, 0x29, 0xad, 0xf0, 0x5d, 0xf7,
-	0xd3, 0x6e, 0xfb, 0xc4, 0xec, 0x76, 0x4a, 0x1a, 0x02, 0xc8, 0xed, 0x37, 0x0f, 0x1f, 0x76, 0x3b,
-	0xa5, 0xd5, 0x56, 0xfb, 0xec, 0xa2, 0xa2, 0xfd, 0x76, 0x51, 0xd1, 0x7e, 0xbf, 0xa8, 0x68, 0x7f,
-	0x5e, 0x54, 0xb4, 0x5f, 0x9e, 0x55, 0xb4, 0xb3, 0x67, 0x15, 0xed, 0xb3, 0xdb, 0x2f, 0xbf, 0x39,
-	0x8b, 0xc2, 0x86, 0x8c, 0x64, 0x90, 0x13, 0xdf, 0xf5, 0xfb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x94, 0xcd, 0x56, 0xb9, 0x0c, 0x0c, 0x00, 0x00,
+	// 1150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x4d, 0x5a, 0x52, 0xc6, 0x92, 0x5f, 0x65, 0xf3, 0x01, 0xc2, 0xc0, 0x2b, 0x05, 0x6a,
+	0xd1, 0x3a, 0x69, 0x22, 0xb5, 0x4e, 0x3f, 0x50, 0xdf, 0x24, 0xf9, 0x23, 0x2e, 0x12, 0x5b, 0x5d,
+	0xd1, 0x49, 0xd1, 0xa2, 0x87, 0xb5, 0xb8, 0xa5, 0x08, 0x50, 0x5c, 0x96, 0x5c, 0xa5, 0x54, 0xcf,
+	0x3d, 0xf4, 0xde, 0x4b, 0x8f, 0xfe, 0x07, 0x45, 0xff, 0x41, 0x4f, 0x85, 0x8f, 0x3d, 0x16, 0x3d,
+	0x18, 0x85, 0x73, 0x29, 0x7a, 0xee, 0x0f, 0x28, 0x76, 0xb9, 0xa4, 0x28, 0x25, 0x48, 0x64, 0xb7,
+	0xe8, 0x6d, 0x67, 0xe6, 0xd9, 0x99, 0xd9, 0x99, 0x47, 0x33, 0x14, 0x54, 0x02, 0x32, 0xf1, 0x18,
+	0xb1, 0x9b, 0x41, 0xc8, 0x38, 0x43, 0x45, 0x25, 0xae, 0x5f, 0x77, 0x98, 0xc3, 0xa4, 0xae, 0x25,
+	0x4e, 0x89, 0x79, 0xbd, 0x1a, 0xd0, 0x70, 0xe4, 0x46, 0x91, 0xcb, 0x7c, 0xa5, 0x59, 0x0b, 0xa9,
+	0xe3, 0x46, 0x3c, 0x9c, 0x28, 0x19, 0xa2, 0x80, 0x0e, 0xd4, 0xf9, 0x8a, 0x43, 0x22, 0x75, 0xac,
+	0x8c, 0x03, 0x27, 0x24, 0x36, 0x4d, 0xc4, 0xc6, 0xcf, 0x3a, 0xe8, 0x6d, 0x7f, 0x82, 0xde, 0x84,
+	0x42, 0x97, 0x78, 0x9e, 0x15, 0x9b, 0xda, 0x2d, 0x6d, 0x63, 0x75, 0xf3, 0x7f, 0xcd, 0x34, 0x9d,
+	0x44, 0x8d, 0x95, 0x59, 0x00, 0xfb, 0xd4, 0xb7, 0xad, 0xd8, 0x5c, 0x9e, 0x03, 0x26, 0x6a, 0xac,
+	0xcc, 0x02, 0x78, 0x40, 0x46, 0xd4, 0x8a, 0x4d, 0x7d, 0x0e, 0x98, 0xa8, 0xb1, 0x32, 0xa3, 0x3b,
+	0x50, 0xec, 0xd1, 0x70, 0x14, 0x59, 0xb1, 0x69, 0x48, 0x64, 0x35, 0x43, 0x2a, 0x3d, 0x4e, 0x01,
+	0xe8, 0x75, 0x58, 0xd9, 0x63, 0x4f, 0xad, 0xd8, 0x5c, 0x91, 0xc8, 0xb5, 0x0c, 0x29, 0xb5, 0x38,
+	0x31, 0x8a, 0xd0, 0x1d, 0x26, 0x73, 0x2c, 0xcc, 0x85, 0x4e, 0xd4, 0x58, 0x99, 0xd1, 0x3d, 0x28,
+	0x1d, 0xf9, 0xc7, 0x09, 0xb4, 0x28, 0xa1, 0x57, 0x33, 0x68, 0x6a, 0xc0, 0x19, 0x44, 0x64, 0xda,
+	0x21, 0x7c, 0x30, 0xb4, 0x62, 0xb3, 0x34, 0x97, 0xa9, 0xd2, 0xe3, 0x14, 0x80, 0xee, 0x03, 0xf4,
+	0x42, 0x16, 0xb0, 0x88, 0x88, 0xa2, 0x5e, 0x91, 0xf0, 0x6b, 0xd3, 0x87, 0x65, 0x26, 0x9c, 0x83,
+	0x89, 0x4b, 0xfb, 0x36, 0xf5, 0xb9, 0xfb, 0xc5, 0xc4, 0x8a, 0x4d, 0x98, 0xbb, 0x34, 0x35, 0xe1,
+	0x1c, 0x6c, 0xcb, 0x38, 0x3d, 0xa9, 0x6b, 0x8d, 0xef, 0x34, 0x28, 0x5a, 0xf1, 0xbe, 0x1f, 0x8c,
+	0x39, 0x3a, 0x80, 0x62, 0xdb, 0xb6, 0x43, 0x1a, 0x45, 0xb2, 0x9b, 0xe5, 0xce, 0xbb, 0xa7, 0x67,
+	0xf5, 0xa5, 0xdf, 0xce, 0xea, 0x77, 0x1d, 0x97, 0x0f, 0xc7, 0xc7, 0xcd, 0x01, 0x1b, 0xb5, 0x86,
+	0x93, 0x80, 0x86, 0x1e, 0xb5, 0x1d, 0x1a, 0xb6, 0x8e, 0xc7, 0x61, 0xc8, 0xbe, 0x6a, 0x0d, 0xc2,
+	0x49, 0xc0, 0x59, 0x53, 0xdd, 0xc5, 0xa9, 0x13, 0x74, 0x13, 0x0a, 0xed, 0x11, 0x1b, 0xfb, 0x5c,
+	0xf6, 0xdc, 0xc0, 0x4a, 0x42, 0xeb, 0x50, 0xea, 0xd3, 0x2f, 0xc7, 0xd4, 0x1f, 0x50, 0xd9, 0x64,
+	0x03, 0x67, 0xf2, 0x96, 0xf1, 0xfd, 0x49, 0x7d, 0xa9, 0x11, 0x43, 0xc9, 0x8a, 0x0f, 0xc7, 0xfc,
+	0x3f, 0xcc, 0x4a, 0x45, 0xfe, 0x41, 0x4f, 0x19, 0x8d, 0xde, 0x80, 0x15, 0x59, 0x17, 0x53, 0x9b,
+	0x6b, 0x9a, 0xaa, 0x17, 0x4e, 0xcc, 0xe8, 0xa3, 0x69, 0x82, 0xcb, 0x32, 0xc1, 0xb7, 0x2f, 0x9f,
+	0xdc, 0x3a, 0x94, 0xf6, 0x48, 0xf4, 0xd0, 0x1d, 0xb9, 0x3c, 0x2d, 0x4d, 0x2a, 0xa3, 0x2a, 0xe8,
+	0xbb, 0x94, 0x4a, 0xb2, 0x1b, 0x58, 0x1c, 0xd1, 0x3e, 0x18, 0xdb, 0x84, 0x13, 0xc9, 0xea, 0x72,
+	0xe7, 0x3d, 0x55, 0x97, 0x7b, 0x2f, 0x0f, 0x7d, 0xec, 0xfa, 0x24, 0x9c, 0x34, 0x1f, 0xd0, 0xb8,
+	0x33, 0xe1, 0x34, 0xc2, 0xd2, 0x05, 0xfa, 0x0c, 0x8c, 0x27, 0xed, 0xfe, 0x23, 0xc9, 0xfc, 0x72,
+	0x67, 0xef, 0x52, 0xae, 0xfe, 0x3c, 0xab, 0xaf, 0x71, 0xe2, 0x44, 0x77, 0xd9, 0xc8, 0xe5, 0x74,
+	0x14, 0xf0, 0x09, 0x96, 0x4e, 0xd1, 0x87, 0x50, 0xee, 0x32, 0x9f, 0x87, 0x64, 0xc0, 0x1f, 0x51,
+	0x4e, 0xcc, 0xe2, 0x2d, 0x7d, 0x63, 0x75, 0xf3, 0xc6, 0x74, 0x56, 0xe4, 0x8c, 0x78, 0x06, 0xaa,
+	0x0a, 0xd2, 0x0b, 0xdd, 0x01, 0x35, 0x4b, 0x59, 0x41, 0xa4, 0xac, 0x3a, 0x36, 0x9e, 0x75, 0x8e,
+	0x3e, 0x86, 0x52, 0x97, 0xd9, 0xf4, 0x01, 0x89, 0x86, 0xa6, 0xf6, 0x4f, 0x0a, 0x93, 0xb9, 0x41,
+	0x08, 0x0c, 0x99, 0xb7, 0x68, 0xef, 0x15, 0x2c, 0xcf, 0x0d, 0x37, 0x1d, 0x68, 0x68, 0x03, 0x0a,
+	0x92, 0x08, 0x82, 0x9f, 0xfa, 0x0b, 0x89, 0xa2, 0xec, 0xe8, 0x2d, 0x28, 0x26, 0xa4, 0x16, 0x4c,
+	0xd1, 0x67, 0xc6, 0x46, 0x4a, 0x77, 0x9c, 0x22, 0xb6, 0x4a, 0xdf, 0x9e, 0xd4, 0x97, 0xe4, 0x0b,
+	0x59, 0x36, 0xe9, 0x16, 0xe6, 0xe4, 0xfb, 0x50, 0x12, 0x57, 0xda, 0xa1, 0x13, 0xa9, 0x81, 0x7b,
+	0xbd, 0x99, 0x1b, 0xfd, 0xa9, 0xad, 0x63, 0x88, 0xd2, 0xe0, 0x0c, 0xab, 0x4a, 0x1a, 0xa4, 0x33,
+	0x78, 0xe1, 0x78, 0x08, 0x0c, 0x71, 0x23, 0xad, 0x90, 0x38, 0x0b, 0x9d, 0x64, 0xa7, 0x9e, 0xe8,
+	0xc4, 0xf9, 0x79, 0x0e, 0xab, 0x88, 0x5b, 0xe9, 0xe8, 0x5d, 0x34, 0x62, 0xae, 0x3c, 0xce, 0x74,
+	0x1a, 0x2f, 0x9c, 0xef, 0x6d, 0x28, 0x24, 0x75, 0x56, 0xd5, 0x79, 0x41, 0x23, 0x14, 0x20, 0x17,
+	0xe8, 0x2f, 0x4d, 0xad, 0x91, 0x0b, 0xb4, 0xbc, 0x0b, 0x6b, 0xed, 0xc1, 0x40, 0x0c, 0x98, 0xa3,
+	0xc0, 0x26, 0x9c, 0xa6, 0x9d, 0xbf, 0xd1, 0x94, 0x7b, 0xd6, 0xa2, 0xa3, 0xc0, 0x23, 0x9c, 0x2a,
+	0x8c, 0xec, 0x87, 0x86, 0xe7, 0xae, 0xa0, 0x36, 0x5c, 0xdd, 0x23, 0x51, 0x7f, 0x30, 0xa4, 0xf6,
+	0xd8, 0xa3, 0x89, 0x56, 0xad, 0xc7, 0x6b, 0x4d, 0xb1, 0xa3, 0x67, 0x4d, 0xf8, 0x79, 0x34, 0x6a,
+	0xc1, 0xea, 0x51, 0xb2, 0xc1, 0x7b, 0x1e, 0xf1, 0xd5, 0xc6, 0xac, 0x34, 0xd3, 0xad, 0x2e, 0x94,
+	0x38, 0x8f, 0xc8, 0x3d, 0xfb, 0x0f, 0x2d, 0xbf, 0x93, 0x16, 0x2e, 0x71, 0x03, 0xca, 0x8f, 0x19,
+	0x77, 0x7d, 0xe7, 0x09, 0x75, 0x9d, 0x61, 0x52, 0x68, 0x1d, 0xcf, 0xe8, 0xd0, 0x11, 0x94, 0x53,
+	0xcf, 0xf2, 0xf7, 0xaa, 0xcb, 0xdf, 0xeb, 0x3b, 0x17, 0xff, 0xad, 0xce, 0xb8, 0x11, 0xfb, 0x39,
+	0x95, 0x4d, 0x63, 0xae, 0xbf, 0xa9, 0x01, 0x67, 0x90, 0xdc, 0x53, 0xbd, 0xfc, 0x22, 0xbd, 0x40,
+	0x97, 0xef, 0x80, 0x71, 0xc0, 0x6c, 0xaa, 0xc8, 0x74, 0xb3, 0x99, 0x7d, 0x53, 0x09, 0x6d, 0xe2,
+	0x51, 0x0c, 0x43, 0x21, 0xe5, 0xa2, 0x7d, 0x9e, 0x7d, 0x17, 0x5c, 0x20, 0x54, 0x0d, 0x74, 0x2b,
+	0x4e, 0x59, 0x54, 0xce, 0x60, 0x6d, 0x7f, 0x82, 0x85, 0x21, 0xe7, 0xfe, 0x1b, 0x0d, 0x8c, 0xc7,
+	0x8c, 0xd3, 0x7f, 0x7d, 0x83, 0x2e, 0xd0, 0xd9, 0x5c, 0x1a, 0x4f, 0xa7, 0xcd, 0xc8, 0xc6, 0x84,
+	0x96, 0x1b, 0x13, 0xb7, 0x60, 0x75, 0x9b, 0x46, 0x83, 0xd0, 0x0d, 0xb8, 0xcb, 0x7c, 0x35, 0x41,
+	0xf2, 0xaa, 0xfc, 0xf7, 0x93, 0xfe, 0x8a, 0xef, 0xa7, 0x5c, 0xdc, 0x1f, 0x97, 0xa1, 0xd0, 0x21,
+	0x9e, 0xc7, 0xf8, 0x0c, 0x1f, 0xb4, 0x57, 0xf2, 0x41, 0xb0, 0x72, 0xd7, 0xf5, 0x89, 0xe7, 0x7e,
+	0xed, 0xfa, 0x8e, 0xfa, 0x62, 0xbd, 0x1c, 0x2b, 0xf3, 0x6e, 0x50, 0x17, 0x2a, 0x81, 0x0a, 0xd1,
+	0xe7, 0xe2, 0x17, 0x2c, 0xa8, 0xb9, 0xb6, 0xf9, 0xff, 0xdc, 0x63, 0x44, 0xb6, 0xcd, 0x5e, 0x1e,
+	0x84, 0x67, 0xef, 0xa0, 0xd7, 0x60, 0x45, 0xf4, 0x34, 0x32, 0x57, 0x24, 0x01, 0x2a, 0xd9, 0x65,
+	0xa1, 0xc5, 0x89, 0xad, 0xf1, 0x01, 0x54, 0x66, 0x9c, 0xa0, 0x32, 0x94, 0x7a, 0xf8, 0xb0, 0x77,
+	0xd8, 0xdf, 0xd9, 0xae, 0x2e, 0x09, 0x69, 0xe7, 0x93, 0x9d, 0xee, 0x91, 0xb5, 0xb3, 0x5d, 0xd5,
+	0x10, 0x40, 0x61, 0xb7, 0xbd, 0xff, 0x70, 0x67, 0xbb, 0xba, 0xdc, 0xe9, 0x9e, 0x9e, 0xd7, 0xb4,
+	0x5f, 0xce, 0x6b, 0xda, 0xaf, 0xe7, 0x35, 0xed, 0xf7, 0xf3, 0x9a, 0xf6, 0xd3, 0xb3, 0x9a, 0x76,
+	0xfa, 0xac, 0xa6, 0x7d, 0x7a, 0xfb, 0xe5, 0x2f, 0xe7, 0x71, 0xd4, 0x52, 0x99, 0x1c, 0x17, 0xe4,
+	0x5f, 0x84, 0xfb, 0x7f, 0x0f, 0x00, 0x09, 0xb8, 0x6a, 0xfb, 0x9a, 0x0c, 0x00, 0x00,
 }
 
 func (m *Any) Marshal() (dAtA []byte, err error) {
@@ -1763,6 +1772,30 @@ func (m *GovTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.UpgradePlan != nil {
+		{
+			size, err := m.UpgradePlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasScheduleUpdate != nil {
+		{
+			size, err := m.GasScheduleUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AccountUpdates) > 0 {
 		for iNdEx := len(m.AccountUpdates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2415,6 +2448,14 @@ func (m *GovTx) Size() (n int) {
 			n += 1 + l + sovPayload(uint64(l))
 		}
 	}
+	if m.GasScheduleUpdate != nil {
+		l = m.GasScheduleUpdate.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.UpgradePlan != nil {
+		l = m.UpgradePlan.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4378,6 +4419,78 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasScheduleUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasScheduleUpdate == nil {
+				m.GasScheduleUpdate = &gas.ScheduleUpdate{}
+			}
+			if err := m.GasScheduleUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpgradePlan == nil {
+				m.UpgradePlan = &upgrade.Plan{}
+			}
+			if err := m.UpgradePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
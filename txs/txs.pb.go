@@ -59,11 +59,13 @@ func (Envelope_EncodingType) EnumDescriptor() ([]byte, []int) {
 type Envelope struct {
 	Signatories []Signatory `protobuf:"bytes,1,rep,name=Signatories,proto3" json:"Signatories"`
 	// Canonical bytes of the Tx ready to be signed
-	Tx                   *Tx                   `protobuf:"bytes,2,opt,name=Tx,proto3,customtype=Tx" json:"Tx,omitempty"`
-	Encoding             Envelope_EncodingType `protobuf:"varint,3,opt,name=Encoding,proto3,enum=txs.Envelope_EncodingType" json:"Encoding,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Tx       *Tx                   `protobuf:"bytes,2,opt,name=Tx,proto3,customtype=Tx" json:"Tx,omitempty"`
+	Encoding Envelope_EncodingType `protobuf:"varint,3,opt,name=Encoding,proto3,enum=txs.Envelope_EncodingType" json:"Encoding,omitempty"`
+	// For RLP encoded EIP-2718 typed Ethereum transactions the fields that are signed over but not carried by the Tx
+	EthTypedTx           *EthTypedTx `protobuf:"bytes,4,opt,name=EthTypedTx,proto3" json:"EthTypedTx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Envelope) Reset()      { *m = Envelope{} }
@@ -108,10 +110,125 @@ func (m *Envelope) GetEncoding() Envelope_EncodingType {
 	return Envelope_JSON
 }
 
+func (m *Envelope) GetEthTypedTx() *EthTypedTx {
+	if m != nil {
+		return m.EthTypedTx
+	}
+	return nil
+}
+
 func (*Envelope) XXX_MessageName() string {
 	return "txs.Envelope"
 }
 
+// The transaction type and additional signed fields of an EIP-2718 typed Ethereum transaction
+type EthTypedTx struct {
+	// EIP-2718 transaction type: 1 for EIP-2930 access list, 2 for EIP-1559 dynamic fee
+	Type uint32 `protobuf:"varint,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// For dynamic fee transactions the tip (the fee cap is carried as the GasPrice of the CallTx)
+	MaxPriorityFeePerGas uint64           `protobuf:"varint,2,opt,name=MaxPriorityFeePerGas,proto3" json:"MaxPriorityFeePerGas,omitempty"`
+	AccessList           []EthAccessTuple `protobuf:"bytes,3,rep,name=AccessList,proto3" json:"AccessList"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EthTypedTx) Reset()         { *m = EthTypedTx{} }
+func (m *EthTypedTx) String() string { return proto.CompactTextString(m) }
+func (*EthTypedTx) ProtoMessage()    {}
+func (*EthTypedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_372ebcf753025bdc, []int{1}
+}
+func (m *EthTypedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthTypedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EthTypedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthTypedTx.Merge(m, src)
+}
+func (m *EthTypedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthTypedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthTypedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthTypedTx proto.InternalMessageInfo
+
+func (m *EthTypedTx) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *EthTypedTx) GetMaxPriorityFeePerGas() uint64 {
+	if m != nil {
+		return m.MaxPriorityFeePerGas
+	}
+	return 0
+}
+
+func (m *EthTypedTx) GetAccessList() []EthAccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (*EthTypedTx) XXX_MessageName() string {
+	return "txs.EthTypedTx"
+}
+
+// An EIP-2930 access list entry
+type EthAccessTuple struct {
+	Address              github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	StorageKeys          []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,rep,name=StorageKeys,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"StorageKeys"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *EthAccessTuple) Reset()         { *m = EthAccessTuple{} }
+func (m *EthAccessTuple) String() string { return proto.CompactTextString(m) }
+func (*EthAccessTuple) ProtoMessage()    {}
+func (*EthAccessTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_372ebcf753025bdc, []int{2}
+}
+func (m *EthAccessTuple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthAccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EthAccessTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthAccessTuple.Merge(m, src)
+}
+func (m *EthAccessTuple) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthAccessTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthAccessTuple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthAccessTuple proto.InternalMessageInfo
+
+func (*EthAccessTuple) XXX_MessageName() string {
+	return "txs.EthAccessTuple"
+}
+
 // Signatory contains signature and one or both of Address and PublicKey to identify the signer
 type Signatory struct {
 	Address              *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
@@ -126,7 +243,7 @@ func (m *Signatory) Reset()         { *m = Signatory{} }
 func (m *Signatory) String() string { return proto.CompactTextString(m) }
 func (*Signatory) ProtoMessage()    {}
 func (*Signatory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_372ebcf753025bdc, []int{1}
+	return fileDescriptor_372ebcf753025bdc, []int{3}
 }
 func (m *Signatory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +305,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_372ebcf753025bdc, []int{2}
+	return fileDescriptor_372ebcf753025bdc, []int{4}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,6 +352,10 @@ func init() {
 	golang_proto.RegisterEnum("txs.Envelope_EncodingType", Envelope_EncodingType_name, Envelope_EncodingType_value)
 	proto.RegisterType((*Envelope)(nil), "txs.Envelope")
 	golang_proto.RegisterType((*Envelope)(nil), "txs.Envelope")
+	proto.RegisterType((*EthTypedTx)(nil), "txs.EthTypedTx")
+	golang_proto.RegisterType((*EthTypedTx)(nil), "txs.EthTypedTx")
+	proto.RegisterType((*EthAccessTuple)(nil), "txs.EthAccessTuple")
+	golang_proto.RegisterType((*EthAccessTuple)(nil), "txs.EthAccessTuple")
 	proto.RegisterType((*Signatory)(nil), "txs.Signatory")
 	golang_proto.RegisterType((*Signatory)(nil), "txs.Signatory")
 	proto.RegisterType((*Receipt)(nil), "txs.Receipt")
@@ -245,37 +366,44 @@ func init() { proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
 func init() { golang_proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
 
 var fileDescriptor_372ebcf753025bdc = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc6, 0x73, 0x8e, 0x95, 0x97, 0x4b, 0x68, 0xcb, 0x09, 0xa1, 0x28, 0x83, 0x9d, 0x66, 0xca,
-	0x00, 0x36, 0x0a, 0xd0, 0x01, 0x26, 0x5c, 0x21, 0x55, 0xe1, 0xad, 0xba, 0x7a, 0x62, 0x40, 0xf2,
-	0xcb, 0x5f, 0x8e, 0xa5, 0xe0, 0xb3, 0xce, 0x17, 0xb0, 0xbf, 0x09, 0x23, 0x9f, 0x80, 0x1d, 0xb1,
-	0x30, 0x66, 0x64, 0x44, 0x19, 0x2c, 0x94, 0x7e, 0x0b, 0x26, 0x74, 0xee, 0xd9, 0x2d, 0x1d, 0x8a,
-	0xd8, 0xee, 0xee, 0x79, 0xee, 0xe7, 0xe7, 0xfe, 0x8f, 0x71, 0x5f, 0xe4, 0x99, 0x95, 0x72, 0x26,
-	0x18, 0x69, 0x8b, 0x3c, 0x1b, 0xdf, 0x89, 0x58, 0xc4, 0xaa, 0xbd, 0x2d, 0x57, 0x17, 0xd2, 0x78,
-	0x18, 0xf0, 0x22, 0x15, 0x6a, 0x37, 0xfd, 0x86, 0x70, 0xef, 0x79, 0xf2, 0x01, 0x56, 0x2c, 0x05,
-	0x72, 0x84, 0x07, 0x67, 0x71, 0x94, 0x78, 0x82, 0xf1, 0x18, 0xb2, 0x11, 0x9a, 0xb4, 0x67, 0x83,
-	0xf9, 0x9e, 0x25, 0xb1, 0xf5, 0x79, 0xe1, 0xe8, 0x9b, 0xd2, 0x6c, 0xd1, 0xab, 0x46, 0x72, 0x17,
-	0x6b, 0x6e, 0x3e, 0xd2, 0x26, 0x68, 0x36, 0x74, 0x3a, 0xdb, 0xd2, 0xd4, 0xdc, 0x9c, 0x6a, 0x6e,
-	0x4e, 0x8e, 0x24, 0x3b, 0x60, 0x61, 0x9c, 0x44, 0xa3, 0xf6, 0x04, 0xcd, 0xf6, 0xe6, 0xe3, 0x0a,
-	0x56, 0x7f, 0xd0, 0xaa, 0x55, 0xb7, 0x48, 0x81, 0x36, 0xde, 0xe9, 0x21, 0x1e, 0x5e, 0x55, 0x48,
-	0x0f, 0xeb, 0x8b, 0xb3, 0x37, 0xaf, 0x0f, 0x5a, 0xa4, 0x8b, 0xdb, 0xf4, 0xe5, 0xe9, 0x01, 0x7a,
-	0xa2, 0x7f, 0xfa, 0x6c, 0xb6, 0xa6, 0x5f, 0x11, 0xee, 0x37, 0xc9, 0xc8, 0x02, 0x77, 0x9f, 0x85,
-	0x21, 0x87, 0x4c, 0x46, 0x97, 0x59, 0x1e, 0x6c, 0x4b, 0xf3, 0x5e, 0x14, 0x8b, 0xe5, 0xda, 0xb7,
-	0x02, 0xf6, 0xde, 0x5e, 0x16, 0x29, 0xf0, 0x15, 0x84, 0x11, 0x70, 0xdb, 0x5f, 0x73, 0xce, 0x3e,
-	0xda, 0x6a, 0x18, 0xea, 0x1e, 0xad, 0x01, 0xc4, 0xc6, 0xfd, 0xd3, 0xb5, 0xbf, 0x8a, 0x83, 0x17,
-	0x50, 0x54, 0x2f, 0x1b, 0xcc, 0x6f, 0x5b, 0xca, 0xdc, 0x08, 0xf4, 0xd2, 0x23, 0x2f, 0x5c, 0x24,
-	0x59, 0x73, 0x18, 0xe9, 0x7f, 0x5f, 0x68, 0x04, 0x7a, 0xe9, 0x99, 0x7e, 0xd1, 0x70, 0x97, 0x42,
-	0x00, 0x71, 0x2a, 0xc8, 0x02, 0x77, 0xdc, 0x5c, 0x3e, 0xb5, 0x0a, 0x7e, 0xcb, 0x99, 0xff, 0x2e,
-	0x4d, 0xeb, 0xe6, 0xe0, 0x22, 0xcf, 0xec, 0xd4, 0x2b, 0x56, 0xcc, 0x0b, 0xad, 0x6a, 0x7c, 0x8a,
-	0x40, 0x5e, 0x49, 0xd6, 0x89, 0x97, 0x2d, 0x55, 0x21, 0x8f, 0x65, 0x5f, 0xdb, 0xd2, 0xbc, 0x7f,
-	0x33, 0xcf, 0x8f, 0x13, 0x8f, 0x17, 0xd6, 0x09, 0xe4, 0x4e, 0x21, 0x20, 0xa3, 0x0a, 0x42, 0x66,
-	0x78, 0xff, 0x98, 0x83, 0x27, 0x20, 0x3b, 0x66, 0x89, 0xe0, 0x5e, 0x20, 0xaa, 0x2a, 0x7b, 0xf4,
-	0xfa, 0x31, 0x79, 0x87, 0xf7, 0xeb, 0x75, 0x5d, 0x83, 0x5e, 0x25, 0x78, 0xa4, 0x12, 0xfc, 0x5f,
-	0x15, 0xd7, 0x61, 0xce, 0xd3, 0xcd, 0xce, 0x40, 0x3f, 0x76, 0x06, 0xfa, 0xb9, 0x33, 0xd0, 0xaf,
-	0x9d, 0x81, 0xbe, 0x9f, 0x1b, 0x68, 0x73, 0x6e, 0xa0, 0xb7, 0x87, 0xff, 0x1c, 0x95, 0xdf, 0xa9,
-	0x7e, 0xf7, 0x87, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x9a, 0xbd, 0xcb, 0x24, 0x03, 0x00,
-	0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7b, 0xb1, 0xd5, 0x1f, 0x97, 0xd0, 0x94, 0xa3, 0x42, 0x56, 0x06, 0x27, 0xf5, 0xe4,
+	0x01, 0x6c, 0x64, 0x68, 0x24, 0x60, 0xaa, 0xab, 0x42, 0x95, 0xfe, 0x20, 0xba, 0x58, 0x20, 0x31,
+	0x20, 0x39, 0xf6, 0xc9, 0xb1, 0x14, 0x72, 0xd6, 0xdd, 0x05, 0xec, 0xbf, 0x82, 0x95, 0x91, 0xbf,
+	0x80, 0x19, 0x36, 0xc6, 0x8c, 0x8c, 0xa8, 0x43, 0x84, 0xd2, 0x95, 0xbf, 0x80, 0x09, 0xd9, 0xb1,
+	0x13, 0x37, 0x42, 0x50, 0xc1, 0xf6, 0xfc, 0xde, 0xf7, 0x7d, 0xf2, 0xee, 0x7b, 0xef, 0x02, 0xb7,
+	0x44, 0xcc, 0x8d, 0x88, 0x51, 0x41, 0x91, 0x24, 0x62, 0xde, 0xd8, 0x0d, 0x68, 0x40, 0xb3, 0x6f,
+	0x33, 0x8d, 0xe6, 0xa5, 0x46, 0xcd, 0x63, 0x49, 0x24, 0xf2, 0x2f, 0xed, 0x07, 0x80, 0x9b, 0x47,
+	0xa3, 0x37, 0x64, 0x48, 0x23, 0x82, 0xda, 0xb0, 0xda, 0x0b, 0x83, 0x91, 0x2b, 0x28, 0x0b, 0x09,
+	0x57, 0x40, 0x4b, 0xd2, 0xab, 0xd6, 0xb6, 0x91, 0x62, 0x8b, 0x7c, 0x62, 0xcb, 0x93, 0x69, 0x73,
+	0x0d, 0x97, 0x85, 0xe8, 0x36, 0xac, 0x38, 0xb1, 0x52, 0x69, 0x01, 0xbd, 0x66, 0xaf, 0x5f, 0x4c,
+	0x9b, 0x15, 0x27, 0xc6, 0x15, 0x27, 0x46, 0xed, 0x94, 0xed, 0x51, 0x3f, 0x1c, 0x05, 0x8a, 0xd4,
+	0x02, 0xfa, 0xb6, 0xd5, 0xc8, 0x60, 0xc5, 0x0f, 0x1a, 0x45, 0xd5, 0x49, 0x22, 0x82, 0x17, 0x5a,
+	0x64, 0x42, 0x78, 0x24, 0x06, 0x69, 0xd2, 0x77, 0x62, 0x45, 0x6e, 0x01, 0xbd, 0x6a, 0xd5, 0xe7,
+	0x9d, 0x8b, 0x34, 0x2e, 0x49, 0xb4, 0x3d, 0x58, 0x2b, 0xa3, 0xd0, 0x26, 0x94, 0x3b, 0xbd, 0x67,
+	0xe7, 0x3b, 0x6b, 0x68, 0x03, 0x4a, 0xf8, 0xb4, 0xbb, 0x03, 0x1e, 0xc9, 0xef, 0x3f, 0x34, 0xd7,
+	0xb4, 0x77, 0xa0, 0x8c, 0x46, 0x08, 0xca, 0x69, 0xa8, 0x80, 0x16, 0xd0, 0x6f, 0xe0, 0x2c, 0x46,
+	0x16, 0xdc, 0x3d, 0x73, 0xe3, 0x2e, 0x0b, 0x29, 0x0b, 0x45, 0xf2, 0x84, 0x90, 0x2e, 0x61, 0x4f,
+	0x5d, 0x9e, 0x1d, 0x4f, 0xc6, 0xbf, 0xad, 0xa1, 0x87, 0x10, 0x1e, 0x78, 0x1e, 0xe1, 0xfc, 0x34,
+	0xe4, 0x42, 0x91, 0x32, 0xdf, 0x6e, 0x15, 0x03, 0xcf, 0x2b, 0xce, 0x38, 0x1a, 0x92, 0xdc, 0xbc,
+	0x92, 0x58, 0xfb, 0x04, 0xe0, 0xf6, 0x55, 0x11, 0x3a, 0x87, 0x1b, 0x07, 0xbe, 0xcf, 0x08, 0xe7,
+	0xd9, 0x60, 0x35, 0xfb, 0x41, 0xda, 0x75, 0x31, 0x6d, 0xde, 0x09, 0x42, 0x31, 0x18, 0xf7, 0x0d,
+	0x8f, 0xbe, 0x36, 0x07, 0x49, 0x44, 0xd8, 0x90, 0xf8, 0x01, 0x61, 0x66, 0x7f, 0xcc, 0x18, 0x7d,
+	0x6b, 0xe6, 0x17, 0x9b, 0xf7, 0xe2, 0x02, 0x82, 0x9e, 0xc3, 0x6a, 0x4f, 0x50, 0xe6, 0x06, 0xe4,
+	0x84, 0x24, 0xe9, 0x41, 0xa4, 0xeb, 0x33, 0xfb, 0xe1, 0xc8, 0x65, 0x89, 0xf1, 0x82, 0x32, 0xdf,
+	0xda, 0x6f, 0xe3, 0x32, 0x48, 0xfb, 0x0c, 0xe0, 0xd6, 0x62, 0x2f, 0x50, 0x67, 0x75, 0xea, 0x7b,
+	0xff, 0x3e, 0xb1, 0x09, 0xb7, 0xba, 0xe3, 0xfe, 0x30, 0xf4, 0x4e, 0x48, 0x92, 0x19, 0x5f, 0xb5,
+	0x6e, 0x1a, 0xb9, 0x78, 0x51, 0xc0, 0x4b, 0x0d, 0x32, 0x8b, 0x49, 0xc6, 0x8c, 0x28, 0xf2, 0xd5,
+	0x86, 0x45, 0x01, 0x2f, 0x35, 0xda, 0xc7, 0x0a, 0xdc, 0xc0, 0xc4, 0x23, 0x61, 0x24, 0x50, 0x07,
+	0xae, 0x3b, 0xf1, 0x72, 0x0f, 0x6c, 0xeb, 0xe7, 0xb4, 0x69, 0xfc, 0x79, 0x70, 0x11, 0x73, 0x33,
+	0x72, 0x93, 0x21, 0x75, 0x7d, 0x23, 0x5b, 0xde, 0x9c, 0x80, 0xce, 0x52, 0xd6, 0xb1, 0xcb, 0x07,
+	0xf9, 0x73, 0xd8, 0xcf, 0x6d, 0xbe, 0x7b, 0x2d, 0x9b, 0x8f, 0x49, 0x6c, 0x27, 0x82, 0x70, 0x9c,
+	0x43, 0x90, 0x0e, 0xeb, 0x87, 0x8c, 0xb8, 0x82, 0xf0, 0x43, 0x3a, 0x12, 0xcc, 0xf5, 0x44, 0xf6,
+	0x90, 0x36, 0xf1, 0x6a, 0x1a, 0xbd, 0x82, 0xf5, 0x22, 0x2e, 0xae, 0x41, 0xfe, 0x8f, 0xe5, 0x59,
+	0x85, 0xd9, 0x8f, 0x27, 0x33, 0x15, 0x7c, 0x9d, 0xa9, 0xe0, 0xdb, 0x4c, 0x05, 0xdf, 0x67, 0x2a,
+	0xf8, 0x72, 0xa9, 0x82, 0xc9, 0xa5, 0x0a, 0x5e, 0xee, 0xfd, 0xd5, 0xaa, 0xfe, 0x7a, 0xf6, 0x67,
+	0x73, 0xff, 0xd7, 0x00, 0x51, 0x0f, 0x14, 0x48, 0xa2, 0x04, 0x00, 0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
@@ -302,6 +430,18 @@ func (m *Envelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.EthTypedTx != nil {
+		{
+			size, err := m.EthTypedTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Encoding != 0 {
 		i = encodeVarintTxs(dAtA, i, uint64(m.Encoding))
 		i--
@@ -336,6 +476,108 @@ func (m *Envelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EthTypedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthTypedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthTypedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxPriorityFeePerGas != 0 {
+		i = encodeVarintTxs(dAtA, i, uint64(m.MaxPriorityFeePerGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintTxs(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthAccessTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthAccessTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthAccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StorageKeys) > 0 {
+		for iNdEx := len(m.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.StorageKeys[iNdEx].Size()
+				i -= size
+				if _, err := m.StorageKeys[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTxs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Signatory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -491,6 +733,54 @@ func (m *Envelope) Size() (n int) {
 	if m.Encoding != 0 {
 		n += 1 + sovTxs(uint64(m.Encoding))
 	}
+	if m.EthTypedTx != nil {
+		l = m.EthTypedTx.Size()
+		n += 1 + l + sovTxs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EthTypedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTxs(uint64(m.Type))
+	}
+	if m.MaxPriorityFeePerGas != 0 {
+		n += 1 + sovTxs(uint64(m.MaxPriorityFeePerGas))
+	}
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovTxs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EthAccessTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovTxs(uint64(l))
+	if len(m.StorageKeys) > 0 {
+		for _, e := range m.StorageKeys {
+			l = e.Size()
+			n += 1 + l + sovTxs(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -666,6 +956,284 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTypedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EthTypedTx == nil {
+				m.EthTypedTx = &EthTypedTx{}
+			}
+			if err := m.EthTypedTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthTypedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthTypedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthTypedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriorityFeePerGas", wireType)
+			}
+			m.MaxPriorityFeePerGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriorityFeePerGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, EthAccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthAccessTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthAccessTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthAccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_binary.Word256
+			m.StorageKeys = append(m.StorageKeys, v)
+			if err := m.StorageKeys[len(m.StorageKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxs(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package config
 import (
 	"time"
 
+	"github.com/alecthomas/jsonschema"
+	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/vent/chain"
 	"github.com/hyperledger/burrow/vent/sqlsol"
@@ -11,6 +13,14 @@ import (
 
 const DefaultPostgresDBURL = "postgres://postgres@localhost:5432/postgres?sslmode=disable"
 
+// Overrides each field of the config from an environment variable with this prefix, such as VENT_DBURL
+const DefaultVentEnvironmentPrefix = "VENT"
+
+// Vent reads its config from the file named by this environment variable, or else vent.yaml in the working directory
+// if there is one
+const DefaultVentConfigFileEnvironmentVariable = "VENT_CONFIG_FILE"
+const DefaultVentConfigYAMLFileName = "vent.yaml"
+
 // VentConfig is a set of configuration parameters
 type VentConfig struct {
 	DBAdapter           string
@@ -41,3 +51,8 @@ func DefaultVentConfig() *VentConfig {
 		AnnounceEvery:     time.Second * 5,
 	}
 }
+
+// VentConfigSchema is the JSON schema of JSON and YAML config files
+func VentConfigSchema() *jsonschema.Schema {
+	return source.Schema(&VentConfig{})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hyperledger/burrow/vent/chain/burrow"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -18,7 +19,6 @@ import (
 	"github.com/hyperledger/burrow/vent/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 const chainID = "TestChainID"
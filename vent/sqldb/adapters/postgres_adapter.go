@@ -10,7 +10,6 @@ import (
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/vent/types"
 	"github.com/jmoiron/sqlx"
-	"github.com/prometheus/common/log"
 )
 
 var pgDataTypes = map[types.SQLColumnType]string{
@@ -47,12 +46,12 @@ func NewPostgresAdapter(schema string, sqlNames types.SQLNames, log *logging.Log
 func (pa *PostgresAdapter) Open(dbURL string) (*sqlx.DB, error) {
 	db, err := sqlx.Open("postgres", dbURL)
 	if err != nil {
-		log.Info("msg", "Error creating database connection", "err", err)
+		pa.Log.InfoMsg("Error creating database connection", "err", err)
 		return nil, err
 	}
 
 	if err := db.Ping(); err != nil {
-		log.Info("msg", "Error opening database connection", "err", err)
+		pa.Log.InfoMsg("Error opening database connection", "err", err)
 		return nil, err
 	}
 